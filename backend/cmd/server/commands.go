@@ -0,0 +1,56 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"log"
+	"os"
+
+	database "xpired/internal/db"
+)
+
+// runCommand executes a one-off maintenance subcommand instead of starting
+// the servers, e.g. `main export backup.jsonl` or `main import backup.jsonl`.
+// When no file is given, export writes to stdout and import reads from stdin.
+func runCommand(db *database.DB, args []string) error {
+	ctx := context.Background()
+
+	switch args[0] {
+	case "export":
+		var out io.Writer = os.Stdout
+		if len(args) > 1 {
+			f, err := os.Create(args[1])
+			if err != nil {
+				return fmt.Errorf("could not create export file: %w", err)
+			}
+			defer f.Close()
+			out = f
+		}
+		if err := db.Export(ctx, out); err != nil {
+			return err
+		}
+		log.Println("Export completed")
+		return nil
+
+	case "import":
+		var in io.Reader = os.Stdin
+		if len(args) > 1 {
+			f, err := os.Open(args[1])
+			if err != nil {
+				return fmt.Errorf("could not open import file: %w", err)
+			}
+			defer f.Close()
+			in = f
+		}
+		count, err := db.Import(ctx, in)
+		if err != nil {
+			return err
+		}
+		log.Printf("Import completed: %d rows restored", count)
+		return nil
+
+	default:
+		return fmt.Errorf("unknown command %q (expected export or import)", args[0])
+	}
+}
@@ -3,6 +3,7 @@ package main
 import (
 	"context"
 	"log"
+	"net"
 	"net/http"
 	"os"
 	"os/signal"
@@ -13,7 +14,16 @@ import (
 	"xpired/internal/auth"
 	"xpired/internal/config"
 	database "xpired/internal/db"
+	"xpired/internal/fieldcrypt"
+	"xpired/internal/grpcapi"
+	"xpired/internal/localredis"
+	"xpired/internal/lock"
+	"xpired/internal/scanner"
+	"xpired/internal/storage"
+	"xpired/internal/tenant"
 	worker "xpired/internal/worker"
+
+	"github.com/redis/go-redis/v9"
 )
 
 func main() {
@@ -22,7 +32,39 @@ func main() {
 		log.Fatal("Failed to load configuration:", err)
 	}
 
-	db, err := database.NewConnection(cfg.Database)
+	// Serve health and readiness probes while the database comes up; the
+	// API takes over once startup is done.
+	startup := api.NewStartupHandler()
+	httpServer := &http.Server{
+		Addr:    ":8080",
+		Handler: startup,
+	}
+
+	var wg sync.WaitGroup
+
+	runningCommand := len(os.Args) > 1
+	if !runningCommand {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			log.Println("Starting HTTP server on :8080")
+			if err := httpServer.ListenAndServe(); err != nil && err != http.ErrServerClosed {
+				log.Fatalf("HTTP server failed: %v", err)
+			}
+		}()
+	}
+
+	sqlite := cfg.Database.Driver == database.DriverSQLite
+	if sqlite && (cfg.Database.ReplicaURL != "" || len(cfg.Residency.Regions) > 0) {
+		log.Fatal("DB_REPLICA_URL and DATABASE_REGIONS need DB_DRIVER=postgres")
+	}
+
+	db, err := database.Connect(context.Background(), cfg.Database.ConnectAttempts, func() (*database.DB, error) {
+		if sqlite {
+			return database.NewSQLiteConnection(cfg.Database.SQLitePath)
+		}
+		return database.NewConnection(cfg.Database)
+	})
 	if err != nil {
 		log.Fatal("Failed to connect to database:", err)
 	}
@@ -32,33 +74,159 @@ func main() {
 		log.Fatal("Failed to run database migrations:", err)
 	}
 
-	auth.Init(cfg)
+	if !sqlite {
+		if enforced, err := db.RowSecurityEnforced(context.Background()); err != nil {
+			log.Printf("Failed to check row-level security: %v", err)
+		} else if !enforced {
+			log.Printf("Warning: DB_USER is a superuser or has BYPASSRLS, so row-level security does not isolate tenants")
+		}
+	}
+
+	fields, err := fieldCipher(cfg)
+	if err != nil {
+		log.Fatal("Failed to initialize field encryption:", err)
+	}
+	db.SetFieldCipher(fields)
+
+	if cfg.Database.ReplicaURL != "" {
+		replica, err := database.Connect(context.Background(), cfg.Database.ConnectAttempts, func() (*database.DB, error) {
+			return database.NewConnectionURL(cfg.Database.ReplicaURL)
+		})
+		if err != nil {
+			log.Printf("Failed to connect to read replica, reading from the primary: %v", err)
+		} else {
+			db.SetReplica(replica)
+		}
+	}
+
+	for region, url := range cfg.Residency.Regions {
+		regional, err := database.Connect(context.Background(), cfg.Database.ConnectAttempts, func() (*database.DB, error) {
+			return database.NewConnectionURL(url)
+		})
+		if err != nil {
+			log.Fatalf("Failed to connect to database for data region %s: %v", region, err)
+		}
+		if err := regional.RunMigrations("./migrations"); err != nil {
+			log.Fatalf("Failed to run migrations for data region %s: %v", region, err)
+		}
+		db.AddRegion(region, regional)
+	}
+	db.SetPoolSize(cfg.Database.MaxOpenConns)
+
+	if runningCommand {
+		if err := runCommand(db, os.Args[1:]); err != nil {
+			log.Fatal("Command failed: ", err)
+		}
+		return
+	}
+
+	if cfg.Redis.Embedded {
+		embedded, err := localredis.Start(cfg.Redis.EmbeddedSnapshot)
+		if err != nil {
+			log.Fatal("Failed to start embedded Redis:", err)
+		}
+		defer func() {
+			if err := embedded.Close(); err != nil {
+				log.Printf("Failed to save embedded Redis snapshot: %v", err)
+			}
+		}()
+		cfg.Redis.Addr, cfg.Redis.Password = embedded.Addr(), ""
+		log.Printf("Using embedded Redis on %s", embedded.Addr())
+	}
+
+	if err := auth.Init(cfg); err != nil {
+		log.Fatal("Failed to load JWT keys:", err)
+	}
 	worker.InitQueue(cfg)
 
 	repo := database.NewRepository(db)
+	auth.SetSuspensionChecker(repo.IsUserSuspended)
+	auth.SetAPIKeyResolver(repo.AuthenticateAPIKey)
 
-	r := api.SetupRoutes(db)
-	httpServer := &http.Server{
-		Addr:    ":8080",
-		Handler: r,
+	pinned, err := repo.ListPinnedDataRegions(context.Background())
+	if err != nil {
+		log.Fatal("Failed to list organization data regions:", err)
+	}
+	for _, region := range pinned {
+		if _, ok := cfg.Residency.Regions[region]; !ok {
+			log.Fatalf("Organizations are pinned to data region %s, but DATABASE_REGIONS has no database for it", region)
+		}
+	}
+
+	regionCtxs := []context.Context{context.Background()}
+	for _, region := range repo.DataRegions() {
+		regionCtxs = append(regionCtxs, tenant.WithRegion(context.Background(), region))
+	}
+	for _, ctx := range regionCtxs {
+		encrypted, err := repo.EncryptPlaintextIdentifiers(ctx)
+		if err != nil {
+			log.Printf("Failed to encrypt plaintext identifiers: %v", err)
+		} else if encrypted > 0 {
+			log.Printf("Encrypted %d plaintext document identifiers", encrypted)
+		}
+	}
+
+	store, err := storage.New(context.Background(), cfg.Storage)
+	if err != nil {
+		log.Fatal("Failed to initialize attachment storage:", err)
+	}
+
+	var attachmentScanner scanner.Scanner
+	if cfg.Attachments.ClamdAddr != "" {
+		attachmentScanner = scanner.NewClamAV(cfg.Attachments.ClamdAddr)
+	} else {
+		log.Println("CLAMD_ADDR not set: uploaded attachments will not be scanned")
+	}
+
+	if cfg.Notifications.DryRun {
+		log.Println("Notification dry-run mode enabled: providers will not be called")
 	}
 
 	workerServer := worker.NewServer(cfg)
-	workerMux := worker.NewMux(repo)
+	workerMux := worker.NewMux(repo, cfg, store, attachmentScanner)
+
+	rdb := redis.NewClient(&redis.Options{
+		Addr:     cfg.Redis.Addr,
+		Password: cfg.Redis.Password,
+		DB:       cfg.Redis.DB,
+	})
+	defer rdb.Close()
 
-	_, cancel := context.WithCancel(context.Background())
+	scheduler := worker.NewScheduler(lock.NewLocker(rdb))
+	scheduler.Register(worker.PurgeTrashJob(repo, store, cfg.Trash.RetentionDays))
+	scheduler.Register(worker.RefreshExpiringDocumentsJob(repo))
+	scheduler.Register(worker.MonitorHealthJob(repo, rdb, cfg))
+	scheduler.Register(worker.DetectAnomaliesJob(repo, rdb, cfg))
+	scheduler.Register(worker.AttachmentRetentionJob(repo, store, cfg))
+	scheduler.Register(worker.MeterUsageJob(repo))
+	scheduler.Register(worker.OrganizationRetentionJob(repo, store))
+	scheduler.Register(worker.PurgeDeletedAccountsJob(repo, store, cfg.Accounts.DeletionGraceDays))
+	workerMux.HandleFunc(worker.TaskRunScheduledJob, scheduler.HandleTriggeredJob)
+	worker.CheckQueuedTaskTypes(workerMux)
+
+	ctx, cancel := context.WithCancel(context.Background())
 	defer cancel()
 
-	var wg sync.WaitGroup
+	scheduler.Start(ctx)
 
-	wg.Add(1)
-	go func() {
-		defer wg.Done()
-		log.Println("Starting HTTP server on :8080")
-		if err := httpServer.ListenAndServe(); err != nil && err != http.ErrServerClosed {
-			log.Fatalf("HTTP server failed: %v", err)
+	startup.Serve(api.SetupRoutes(db, cfg, store))
+
+	grpcServer := grpcapi.NewServer(repo)
+	if cfg.GRPC.Addr != "" {
+		listener, err := net.Listen("tcp", cfg.GRPC.Addr)
+		if err != nil {
+			log.Fatalf("Failed to listen for gRPC on %s: %v", cfg.GRPC.Addr, err)
 		}
-	}()
+
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			log.Printf("Starting gRPC server on %s", cfg.GRPC.Addr)
+			if err := grpcServer.Serve(listener); err != nil {
+				log.Fatalf("gRPC server failed: %v", err)
+			}
+		}()
+	}
 
 	wg.Add(1)
 	go func() {
@@ -85,8 +253,17 @@ func main() {
 		log.Printf("HTTP server shutdown error: %v", err)
 	}
 
+	grpcServer.GracefulStop()
 	workerServer.Shutdown()
+	scheduler.Wait()
 
 	wg.Wait()
 	log.Println("Application shutdown complete")
 }
+
+// fieldCipher builds the cipher for sensitive columns from the configured
+// keys, which config.Load requires.
+func fieldCipher(cfg *config.Config) (*fieldcrypt.Cipher, error) {
+	enc := cfg.Encryption
+	return fieldcrypt.Load(context.Background(), enc.FieldKey, enc.FieldKeyKMS, enc.BlindIndexKey)
+}
@@ -0,0 +1,392 @@
+// Command xpiredctl is an operator tool for a running xpired server. It
+// talks to the admin API, so operators can inspect and nudge the service
+// without hand-writing curl calls:
+//
+//	xpiredctl login -email admin@example.com
+//	xpiredctl health
+//	xpiredctl tasks [-limit 50]
+//	xpiredctl requeue <queue> <task-id>
+//	xpiredctl suspend -reason "chargeback" <user-id>
+//	xpiredctl reinstate <user-id>
+//	xpiredctl plan <user-id> <free|pro>
+//	xpiredctl run <purge_trash|refresh_expiring_documents|monitor_health|detect_notification_anomalies|enforce_attachment_retention>
+//
+// The server is XPIRED_URL and the bearer token XPIRED_TOKEN, which must
+// belong to one of the ADMIN_EMAILS; login prints one. -json prints the
+// API's responses unformatted.
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+	"text/tabwriter"
+	"time"
+)
+
+const usage = `Usage: xpiredctl [-url URL] [-token TOKEN] [-json] <command> [arguments]
+
+Commands:
+  login -email EMAIL         sign in and print a token for XPIRED_TOKEN; the
+                             password is read from XPIRED_PASSWORD or stdin
+  health                     dump database, Redis, queue and provider health
+  tasks [-limit N]           list failed tasks
+  requeue QUEUE TASK_ID      run a failed task again now
+  suspend -reason R USER_ID  suspend a user
+  reinstate USER_ID          lift a user's suspension
+  plan USER_ID PLAN          move a user to the free or pro plan
+  run JOB                    run a scheduled job now: purge_trash,
+                             refresh_expiring_documents, monitor_health,
+                             detect_notification_anomalies or
+                             enforce_attachment_retention
+`
+
+func main() {
+	flag.Usage = func() {
+		fmt.Fprint(os.Stderr, usage)
+		flag.PrintDefaults()
+	}
+	baseURL := flag.String("url", envOr("XPIRED_URL", "http://localhost:8080"), "server URL")
+	token := flag.String("token", os.Getenv("XPIRED_TOKEN"), "admin bearer token")
+	raw := flag.Bool("json", false, "print raw JSON responses")
+	flag.Parse()
+
+	if flag.NArg() == 0 {
+		flag.Usage()
+		os.Exit(2)
+	}
+
+	ctl := &ctl{client: newClient(*baseURL, *token), raw: *raw, out: os.Stdout}
+	if err := ctl.run(flag.Arg(0), flag.Args()[1:]); err != nil {
+		fmt.Fprintln(os.Stderr, "xpiredctl:", err)
+		os.Exit(1)
+	}
+}
+
+func envOr(key, defaultValue string) string {
+	if value := os.Getenv(key); value != "" {
+		return value
+	}
+	return defaultValue
+}
+
+type ctl struct {
+	client *client
+	raw    bool
+	out    io.Writer
+}
+
+func (c *ctl) run(command string, args []string) error {
+	switch command {
+	case "login":
+		return c.login(args)
+	case "health":
+		return c.health(args)
+	case "tasks":
+		return c.tasks(args)
+	case "requeue":
+		return c.requeue(args)
+	case "suspend":
+		return c.suspend(args)
+	case "reinstate":
+		return c.reinstate(args)
+	case "plan":
+		return c.plan(args)
+	case "run":
+		return c.runJob(args)
+	default:
+		return fmt.Errorf("unknown command %q; run xpiredctl -h for the list", command)
+	}
+}
+
+// parse parses the flags of a command and checks it got exactly nargs
+// arguments besides them.
+func parse(fs *flag.FlagSet, args []string, nargs int, names string) error {
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if fs.NArg() != nargs {
+		return fmt.Errorf("usage: xpiredctl %s %s", fs.Name(), names)
+	}
+	return nil
+}
+
+// printRaw prints data as indented JSON when -json is set and reports
+// whether it did.
+func (c *ctl) printRaw(data []byte) bool {
+	if !c.raw {
+		return false
+	}
+	var indented bytes.Buffer
+	if err := json.Indent(&indented, data, "", "  "); err != nil {
+		c.out.Write(data)
+	} else {
+		indented.WriteTo(c.out)
+	}
+	fmt.Fprintln(c.out)
+	return true
+}
+
+// printMessage prints the message of a response, or the response itself
+// with -json.
+func (c *ctl) printMessage(data []byte) {
+	if c.printRaw(data) {
+		return
+	}
+	var resp struct {
+		Message string `json:"message"`
+	}
+	json.Unmarshal(data, &resp)
+	fmt.Fprintln(c.out, resp.Message)
+}
+
+func (c *ctl) login(args []string) error {
+	fs := flag.NewFlagSet("login", flag.ContinueOnError)
+	email := fs.String("email", "", "admin email")
+	if err := parse(fs, args, 0, "-email EMAIL"); err != nil {
+		return err
+	}
+	if *email == "" {
+		return errors.New("usage: xpiredctl login -email EMAIL")
+	}
+
+	password := os.Getenv("XPIRED_PASSWORD")
+	if password == "" {
+		fmt.Fprint(os.Stderr, "Password: ")
+		line, err := bufio.NewReader(os.Stdin).ReadString('\n')
+		if err != nil && line == "" {
+			return fmt.Errorf("failed to read password: %w", err)
+		}
+		password = strings.TrimRight(line, "\r\n")
+	}
+
+	var resp struct {
+		Token string `json:"token"`
+	}
+	data, err := c.client.call(http.MethodPost, "/api/auth/signin", map[string]string{
+		"email":    *email,
+		"password": password,
+	}, &resp)
+	if err != nil {
+		return err
+	}
+	if c.printRaw(data) {
+		return nil
+	}
+	fmt.Fprintf(c.out, "export XPIRED_TOKEN=%s\n", resp.Token)
+	return nil
+}
+
+func (c *ctl) health(args []string) error {
+	if err := parse(flag.NewFlagSet("health", flag.ContinueOnError), args, 0, ""); err != nil {
+		return err
+	}
+
+	var resp struct {
+		Status   string `json:"status"`
+		Database string `json:"database"`
+		Redis    string `json:"redis"`
+		Queues   []struct {
+			Queue          string  `json:"queue"`
+			Paused         bool    `json:"paused"`
+			Pending        int     `json:"pending"`
+			Active         int     `json:"active"`
+			Scheduled      int     `json:"scheduled"`
+			Retry          int     `json:"retry"`
+			Archived       int     `json:"archived"`
+			ProcessedToday int     `json:"processedToday"`
+			FailedToday    int     `json:"failedToday"`
+			LatencySeconds float64 `json:"latencySeconds"`
+		} `json:"queues"`
+		Providers []struct {
+			Channel             string     `json:"channel"`
+			Paused              bool       `json:"paused"`
+			RetryAt             *time.Time `json:"retryAt"`
+			ConsecutiveFailures int64      `json:"consecutiveFailures"`
+		} `json:"providers"`
+		Alerts []struct {
+			Name    string    `json:"name"`
+			FiredAt time.Time `json:"firedAt"`
+		} `json:"alerts"`
+	}
+	data, err := c.client.call(http.MethodGet, "/api/admin/health", nil, &resp)
+	if err != nil {
+		return err
+	}
+	if c.printRaw(data) {
+		return nil
+	}
+
+	fmt.Fprintf(c.out, "Status:   %s\nDatabase: %s\nRedis:    %s\n\n", resp.Status, resp.Database, resp.Redis)
+
+	w := tabwriter.NewWriter(c.out, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "QUEUE\tPENDING\tACTIVE\tSCHEDULED\tRETRY\tARCHIVED\tPROCESSED TODAY\tFAILED TODAY\tLATENCY")
+	for _, q := range resp.Queues {
+		name := q.Queue
+		if q.Paused {
+			name += " (paused)"
+		}
+		latency := time.Duration(q.LatencySeconds * float64(time.Second)).Round(time.Second)
+		fmt.Fprintf(w, "%s\t%d\t%d\t%d\t%d\t%d\t%d\t%d\t%s\n", name, q.Pending, q.Active, q.Scheduled, q.Retry, q.Archived, q.ProcessedToday, q.FailedToday, latency)
+	}
+	w.Flush()
+	fmt.Fprintln(c.out)
+
+	w = tabwriter.NewWriter(c.out, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "PROVIDER\tSTATE\tFAILURES\tRETRY AT")
+	for _, p := range resp.Providers {
+		state, retryAt := "ok", "-"
+		if p.Paused {
+			state = "paused"
+		}
+		if p.RetryAt != nil {
+			retryAt = p.RetryAt.Local().Format(time.DateTime)
+		}
+		fmt.Fprintf(w, "%s\t%s\t%d\t%s\n", p.Channel, state, p.ConsecutiveFailures, retryAt)
+	}
+	w.Flush()
+	fmt.Fprintln(c.out)
+
+	if len(resp.Alerts) == 0 {
+		fmt.Fprintln(c.out, "No alerts firing")
+		return nil
+	}
+	for _, a := range resp.Alerts {
+		fmt.Fprintf(c.out, "ALERT %s firing since %s\n", a.Name, a.FiredAt.Local().Format(time.DateTime))
+	}
+	return nil
+}
+
+func (c *ctl) tasks(args []string) error {
+	fs := flag.NewFlagSet("tasks", flag.ContinueOnError)
+	limit := fs.Int("limit", 50, "tasks to list per queue and state, up to 500")
+	if err := parse(fs, args, 0, "[-limit N]"); err != nil {
+		return err
+	}
+
+	var resp struct {
+		Tasks []struct {
+			ID           string     `json:"id"`
+			Queue        string     `json:"queue"`
+			Type         string     `json:"type"`
+			State        string     `json:"state"`
+			Retried      int        `json:"retried"`
+			MaxRetry     int        `json:"maxRetry"`
+			LastError    string     `json:"lastError"`
+			LastFailedAt *time.Time `json:"lastFailedAt"`
+		} `json:"tasks"`
+	}
+	query := url.Values{"limit": {fmt.Sprint(*limit)}}
+	data, err := c.client.call(http.MethodGet, "/api/admin/tasks/failed?"+query.Encode(), nil, &resp)
+	if err != nil {
+		return err
+	}
+	if c.printRaw(data) {
+		return nil
+	}
+	if len(resp.Tasks) == 0 {
+		fmt.Fprintln(c.out, "No failed tasks")
+		return nil
+	}
+
+	w := tabwriter.NewWriter(c.out, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "QUEUE\tID\tTYPE\tSTATE\tTRIES\tLAST FAILED\tERROR")
+	for _, t := range resp.Tasks {
+		lastFailed := "-"
+		if t.LastFailedAt != nil {
+			lastFailed = t.LastFailedAt.Local().Format(time.DateTime)
+		}
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%d/%d\t%s\t%s\n", t.Queue, t.ID, t.Type, t.State, t.Retried, t.MaxRetry, lastFailed, oneLine(t.LastError))
+	}
+	return w.Flush()
+}
+
+// oneLine keeps an error message on its table row.
+func oneLine(s string) string {
+	s = strings.Join(strings.Fields(s), " ")
+	if len(s) > 120 {
+		s = s[:117] + "..."
+	}
+	return s
+}
+
+func (c *ctl) requeue(args []string) error {
+	fs := flag.NewFlagSet("requeue", flag.ContinueOnError)
+	if err := parse(fs, args, 2, "QUEUE TASK_ID"); err != nil {
+		return err
+	}
+	data, err := c.client.call(http.MethodPost, "/api/admin/tasks/"+url.PathEscape(fs.Arg(0))+"/"+url.PathEscape(fs.Arg(1))+"/requeue", nil, nil)
+	if err != nil {
+		return err
+	}
+	c.printMessage(data)
+	return nil
+}
+
+func (c *ctl) suspend(args []string) error {
+	fs := flag.NewFlagSet("suspend", flag.ContinueOnError)
+	reason := fs.String("reason", "", "why the user is suspended, for the audit log")
+	if err := parse(fs, args, 1, "-reason REASON USER_ID"); err != nil {
+		return err
+	}
+	if strings.TrimSpace(*reason) == "" {
+		return errors.New("usage: xpiredctl suspend -reason REASON USER_ID")
+	}
+	data, err := c.client.call(http.MethodPost, "/api/admin/users/"+url.PathEscape(fs.Arg(0))+"/suspend", map[string]string{
+		"reason": *reason,
+	}, nil)
+	if err != nil {
+		return err
+	}
+	c.printMessage(data)
+	return nil
+}
+
+func (c *ctl) reinstate(args []string) error {
+	fs := flag.NewFlagSet("reinstate", flag.ContinueOnError)
+	if err := parse(fs, args, 1, "USER_ID"); err != nil {
+		return err
+	}
+	data, err := c.client.call(http.MethodPost, "/api/admin/users/"+url.PathEscape(fs.Arg(0))+"/reinstate", nil, nil)
+	if err != nil {
+		return err
+	}
+	c.printMessage(data)
+	return nil
+}
+
+func (c *ctl) plan(args []string) error {
+	fs := flag.NewFlagSet("plan", flag.ContinueOnError)
+	if err := parse(fs, args, 2, "USER_ID PLAN"); err != nil {
+		return err
+	}
+	data, err := c.client.call(http.MethodPut, "/api/admin/users/"+url.PathEscape(fs.Arg(0))+"/plan", map[string]string{
+		"plan": fs.Arg(1),
+	}, nil)
+	if err != nil {
+		return err
+	}
+	c.printMessage(data)
+	return nil
+}
+
+func (c *ctl) runJob(args []string) error {
+	fs := flag.NewFlagSet("run", flag.ContinueOnError)
+	if err := parse(fs, args, 1, "JOB"); err != nil {
+		return err
+	}
+	data, err := c.client.call(http.MethodPost, "/api/admin/scheduled-jobs/"+url.PathEscape(fs.Arg(0))+"/run", nil, nil)
+	if err != nil {
+		return err
+	}
+	c.printMessage(data)
+	return nil
+}
@@ -0,0 +1,139 @@
+package api
+
+import (
+	"encoding/json"
+	"errors"
+	"io"
+	"log"
+	"net/http"
+	"time"
+
+	"github.com/google/uuid"
+	"golang.org/x/crypto/bcrypt"
+
+	"xpired/internal/auth"
+	"xpired/internal/db"
+	"xpired/internal/worker"
+)
+
+// ensureNotDeactivated refuses to sign in to a deleted account with 403. It
+// fails closed, like ensureNotOnLegalHold.
+func (h *Handler) ensureNotDeactivated(w http.ResponseWriter, r *http.Request, userID string) bool {
+	deactivated, err := h.repo.IsUserDeactivated(r.Context(), userID)
+	if err != nil {
+		log.Printf("Failed to check deactivation of user %s: %v", userID, err)
+		errResp := InternalServerError("Failed to sign in")
+		WriteErrorResponse(w, errResp)
+		return false
+	}
+	if deactivated {
+		errResp := ForbiddenError("Account deleted")
+		WriteErrorResponse(w, errResp)
+		return false
+	}
+	return true
+}
+
+// DeleteAccountHandler deletes the caller's account. Sign-in is refused and
+// its sessions, API keys, calendar feed and queued notifications end right
+// away; its personal documents and their attachments are purged once the
+// grace period (ACCOUNT_DELETION_GRACE_DAYS) has passed. Organization
+// documents stay with their organization.
+func (h *Handler) DeleteAccountHandler(w http.ResponseWriter, r *http.Request) {
+	userID, err := auth.GetUserIDFromContext(r)
+	if err != nil {
+		errResp := UnauthorizedError("Unauthorized")
+		WriteErrorResponse(w, errResp)
+		return
+	}
+	if auth.ImpersonatorFromContext(r.Context()) != "" {
+		errResp := ForbiddenError("The account cannot be deleted while impersonating")
+		WriteErrorResponse(w, errResp)
+		return
+	}
+
+	// the body is optional for accounts without a password
+	var req DeleteAccountRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil && !errors.Is(err, io.EOF) {
+		errResp := BadRequestError("Invalid request body")
+		WriteErrorResponse(w, errResp)
+		return
+	}
+
+	user, err := h.repo.GetUserByID(r.Context(), userID)
+	if err != nil {
+		errResp := NotFoundError("User not found")
+		WriteErrorResponse(w, errResp)
+		return
+	}
+	if user.Password != "" && bcrypt.CompareHashAndPassword([]byte(user.Password), []byte(req.Password)) != nil {
+		errResp := ForbiddenError("Incorrect password")
+		WriteErrorResponse(w, errResp)
+		return
+	}
+
+	if err := h.repo.DeactivateUser(r.Context(), userID); err != nil {
+		if err.Error() == "user does not exist or is already deactivated" {
+			errResp := ConflictError("Account is already deleted")
+			WriteErrorResponse(w, errResp)
+			return
+		}
+		errResp := InternalServerError("Failed to delete account")
+		WriteErrorResponse(w, errResp)
+		return
+	}
+	purgeAt := time.Now().AddDate(0, 0, h.cfg.Accounts.DeletionGraceDays)
+
+	// the account is already refused everywhere, so what follows only tidies
+	// up and never fails the request
+	cancelled, err := worker.CancelUserTasks(userID)
+	if err != nil {
+		log.Printf("Failed to cancel queued tasks of deleted user %s: %v", userID, err)
+	}
+	sessions, err := h.repo.RevokeSessions(r.Context(), userID, "")
+	if err != nil {
+		log.Printf("Failed to revoke sessions of deleted user %s: %v", userID, err)
+	}
+	h.cacheRevokedSessions(r.Context(), sessions)
+	keys, err := h.repo.ListAPIKeys(r.Context(), userID)
+	if err != nil {
+		log.Printf("Failed to list API keys of deleted user %s: %v", userID, err)
+	}
+	for _, key := range keys {
+		if err := h.repo.DeleteAPIKey(r.Context(), userID, key.ID.String()); err != nil {
+			log.Printf("Failed to delete API key %s of deleted user %s: %v", key.ID.String(), userID, err)
+		}
+	}
+	if err := h.repo.DeleteFeedToken(r.Context(), userID); err != nil {
+		log.Printf("Failed to delete feed token of deleted user %s: %v", userID, err)
+	}
+
+	metadata, _ := json.Marshal(map[string]interface{}{
+		"purgeAt":        purgeAt,
+		"cancelledTasks": cancelled,
+	})
+	entry := &db.AuditLog{
+		ID:         uuid.New(),
+		ActorID:    &userID,
+		UserID:     &userID,
+		Action:     db.AuditActionUserDeleted,
+		EntityType: "user",
+		EntityID:   userID,
+		Metadata:   metadata,
+	}
+	if err := h.repo.CreateAuditLog(r.Context(), entry); err != nil {
+		log.Printf("Failed to record deletion of user %s in audit log: %v", userID, err)
+	}
+	clearAuthCookie(w)
+
+	resp := map[string]interface{}{
+		"message": "Account deleted",
+		"purgeAt": purgeAt,
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(resp); err != nil {
+		errResp := InternalServerError("Failed to encode response")
+		WriteErrorResponse(w, errResp)
+	}
+}
@@ -0,0 +1,148 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"log"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	"xpired/internal/auth"
+	"xpired/internal/db"
+)
+
+// isBootstrapAdmin reports whether email is one of the ADMIN_EMAILS, who
+// are admins whatever their stored role.
+func (h *Handler) isBootstrapAdmin(email string) bool {
+	for _, admin := range h.cfg.Admin.Emails {
+		if strings.EqualFold(admin, email) {
+			return true
+		}
+	}
+	return false
+}
+
+// userRole returns the role user acts with.
+func (h *Handler) userRole(ctx context.Context, user *db.User) (string, error) {
+	if h.isBootstrapAdmin(user.Email) {
+		return auth.RoleAdmin, nil
+	}
+	return h.repo.GetUserRole(ctx, user.ID.String())
+}
+
+// UserRole returns the role userID acts with. It is the auth.RoleResolver
+// of tokens issued before tokens carried a role.
+func (h *Handler) UserRole(ctx context.Context, userID string) (string, error) {
+	user, err := h.repo.GetUserByID(ctx, userID)
+	if err != nil {
+		return "", err
+	}
+	return h.userRole(ctx, user)
+}
+
+// isAdmin reports whether userID has the admin role.
+func (h *Handler) isAdmin(ctx context.Context, userID string) bool {
+	role, err := h.UserRole(ctx, userID)
+	return err == nil && role == auth.RoleAdmin
+}
+
+// generateToken issues a sign-in token for user carrying their role.
+func (h *Handler) generateToken(ctx context.Context, user *db.User) (string, *auth.Claims, error) {
+	role, err := h.userRole(ctx, user)
+	if err != nil {
+		return "", nil, err
+	}
+	return auth.GenerateToken(user.ID, role)
+}
+
+// ImpersonateUserHandler issues a short-lived token acting as another user,
+// so support staff can reproduce an issue without asking for credentials.
+// Every issuance is recorded in the audit log.
+func (h *Handler) ImpersonateUserHandler(w http.ResponseWriter, r *http.Request) {
+	adminID, err := auth.GetUserIDFromContext(r)
+	if err != nil {
+		errResp := UnauthorizedError("Unauthorized")
+		WriteErrorResponse(w, errResp)
+		return
+	}
+
+	var req ImpersonateRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		errResp := BadRequestError("Invalid request body")
+		WriteErrorResponse(w, errResp)
+		return
+	}
+	req.Reason = strings.TrimSpace(req.Reason)
+	if req.Reason == "" {
+		errResp := BadRequestError("reason is required")
+		WriteErrorResponse(w, errResp)
+		return
+	}
+	if _, err := uuid.Parse(req.UserID); err != nil {
+		errResp := BadRequestError("userId must be a valid UUID")
+		WriteErrorResponse(w, errResp)
+		return
+	}
+	if req.UserID == adminID {
+		errResp := BadRequestError("You cannot impersonate yourself")
+		WriteErrorResponse(w, errResp)
+		return
+	}
+
+	user, err := h.repo.GetUserByID(r.Context(), req.UserID)
+	if err != nil {
+		errResp := NotFoundError("User not found")
+		WriteErrorResponse(w, errResp)
+		return
+	}
+	if h.isAdmin(r.Context(), req.UserID) {
+		errResp := ForbiddenError("Admins cannot be impersonated")
+		WriteErrorResponse(w, errResp)
+		return
+	}
+
+	token, expiresAt, err := auth.GenerateImpersonationToken(user.ID, adminID)
+	if err != nil {
+		errResp := InternalServerError("Failed to generate token")
+		WriteErrorResponse(w, errResp)
+		return
+	}
+
+	metadata, _ := json.Marshal(map[string]interface{}{
+		"reason":    req.Reason,
+		"expiresAt": expiresAt,
+	})
+	entry := &db.AuditLog{
+		ID:         uuid.New(),
+		ActorID:    &adminID,
+		UserID:     &req.UserID,
+		Action:     db.AuditActionUserImpersonated,
+		EntityType: "user",
+		EntityID:   req.UserID,
+		Metadata:   metadata,
+	}
+	// Unlike document audits, a token is only handed out once its issuance
+	// is on record.
+	if err := h.repo.CreateAuditLog(r.Context(), entry); err != nil {
+		log.Printf("Failed to record impersonation of user %s by %s in audit log: %v", req.UserID, adminID, err)
+		errResp := InternalServerError("Failed to record impersonation")
+		WriteErrorResponse(w, errResp)
+		return
+	}
+	log.Printf("Admin %s issued an impersonation token for user %s: %s", adminID, req.UserID, req.Reason)
+
+	resp := map[string]interface{}{
+		"message":   "Impersonation token issued",
+		"token":     token,
+		"expiresAt": expiresAt.Format(time.RFC3339),
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(resp); err != nil {
+		errResp := InternalServerError("Failed to encode response")
+		WriteErrorResponse(w, errResp)
+	}
+}
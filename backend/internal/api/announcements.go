@@ -0,0 +1,166 @@
+package api
+
+import (
+	"encoding/json"
+	"log"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+
+	"xpired/internal/auth"
+	"xpired/internal/db"
+	"xpired/internal/worker"
+)
+
+// ListActiveAnnouncementsHandler returns the announcements the frontend
+// banner shows. It needs no sign-in, so maintenance notices also reach the
+// sign-in page.
+func (h *Handler) ListActiveAnnouncementsHandler(w http.ResponseWriter, r *http.Request) {
+	announcements, err := h.repo.ListActiveAnnouncements(r.Context())
+	if err != nil {
+		errResp := InternalServerError("Failed to fetch announcements")
+		WriteErrorResponse(w, errResp)
+		return
+	}
+	if announcements == nil {
+		announcements = []*db.Announcement{}
+	}
+
+	resp := map[string]interface{}{
+		"message":       "Announcements fetched successfully",
+		"announcements": announcements,
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(resp); err != nil {
+		errResp := InternalServerError("Failed to encode response")
+		WriteErrorResponse(w, errResp)
+	}
+}
+
+func (h *Handler) ListAnnouncementsHandler(w http.ResponseWriter, r *http.Request) {
+	announcements, err := h.repo.ListAnnouncements(r.Context())
+	if err != nil {
+		errResp := InternalServerError("Failed to fetch announcements")
+		WriteErrorResponse(w, errResp)
+		return
+	}
+	if announcements == nil {
+		announcements = []*db.Announcement{}
+	}
+
+	resp := map[string]interface{}{
+		"message":       "Announcements fetched successfully",
+		"announcements": announcements,
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(resp); err != nil {
+		errResp := InternalServerError("Failed to encode response")
+		WriteErrorResponse(w, errResp)
+	}
+}
+
+// CreateAnnouncementHandler publishes an announcement and, when asked,
+// schedules its email for when it starts.
+func (h *Handler) CreateAnnouncementHandler(w http.ResponseWriter, r *http.Request) {
+	adminID, err := auth.GetUserIDFromContext(r)
+	if err != nil {
+		errResp := UnauthorizedError("Unauthorized")
+		WriteErrorResponse(w, errResp)
+		return
+	}
+
+	var req AnnouncementRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		errResp := BadRequestError("Invalid request body")
+		WriteErrorResponse(w, errResp)
+		return
+	}
+	req.Title = strings.TrimSpace(req.Title)
+	req.Body = strings.TrimSpace(req.Body)
+	if req.Title == "" || req.Body == "" {
+		errResp := BadRequestError("title and body are required")
+		WriteErrorResponse(w, errResp)
+		return
+	}
+	if req.Kind == "" {
+		req.Kind = db.AnnouncementFeature
+	}
+	if req.Kind != db.AnnouncementMaintenance && req.Kind != db.AnnouncementFeature {
+		errResp := BadRequestError("kind must be one of maintenance, feature")
+		WriteErrorResponse(w, errResp)
+		return
+	}
+
+	startsAt := time.Now()
+	if req.StartsAt != nil {
+		startsAt = *req.StartsAt
+	}
+	if req.EndsAt != nil && !req.EndsAt.After(startsAt) {
+		errResp := BadRequestError("endsAt must be after startsAt")
+		WriteErrorResponse(w, errResp)
+		return
+	}
+
+	announcement := &db.Announcement{
+		ID:        uuid.New(),
+		Title:     req.Title,
+		Body:      req.Body,
+		Kind:      req.Kind,
+		StartsAt:  startsAt,
+		EndsAt:    req.EndsAt,
+		SendEmail: req.SendEmail,
+		CreatedBy: &adminID,
+	}
+	if err := h.repo.CreateAnnouncement(r.Context(), announcement); err != nil {
+		errResp := InternalServerError("Failed to create announcement")
+		WriteErrorResponse(w, errResp)
+		return
+	}
+
+	if announcement.SendEmail {
+		if err := worker.ScheduleAnnouncementEmail(r.Context(), announcement.ID.String(), announcement.StartsAt); err != nil {
+			log.Printf("Failed to schedule email for announcement %s: %v", announcement.ID.String(), err)
+		}
+	}
+
+	resp := map[string]interface{}{
+		"message":      "Announcement published successfully",
+		"announcement": announcement,
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusCreated)
+	if err := json.NewEncoder(w).Encode(resp); err != nil {
+		errResp := InternalServerError("Failed to encode response")
+		WriteErrorResponse(w, errResp)
+	}
+}
+
+// DeleteAnnouncementHandler withdraws an announcement. An email that has not
+// gone out yet is dropped with it.
+func (h *Handler) DeleteAnnouncementHandler(w http.ResponseWriter, r *http.Request) {
+	announcementID := chi.URLParam(r, "id")
+	if _, err := uuid.Parse(announcementID); err != nil {
+		errResp := NotFoundError("Announcement not found")
+		WriteErrorResponse(w, errResp)
+		return
+	}
+
+	if err := h.repo.DeleteAnnouncement(r.Context(), announcementID); err != nil {
+		if err.Error() == "announcement not found" {
+			errResp := NotFoundError("Announcement not found")
+			WriteErrorResponse(w, errResp)
+			return
+		}
+		errResp := InternalServerError("Failed to delete announcement")
+		WriteErrorResponse(w, errResp)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
@@ -0,0 +1,182 @@
+package api
+
+import (
+	"encoding/json"
+	"net/http"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+
+	"xpired/internal/auth"
+	"xpired/internal/db"
+)
+
+const (
+	maxAPIKeyNameLength = 100
+	// apiKeyPrefixLength is how much of a key is kept in the clear to tell
+	// keys apart: "xpk_" and eight hex digits.
+	apiKeyPrefixLength = 12
+)
+
+// parseScopes checks the scopes requested for an API key, dropping repeats.
+// It writes the error response when they are not valid.
+func parseScopes(w http.ResponseWriter, requested []string) ([]string, bool) {
+	if len(requested) == 0 {
+		errResp := BadRequestError("At least one scope is required")
+		WriteErrorResponse(w, errResp)
+		return nil, false
+	}
+	var scopes []string
+	for _, scope := range requested {
+		if !slices.Contains(auth.Scopes, scope) {
+			errResp := BadRequestError("scopes must be among " + strings.Join(auth.Scopes, ", "))
+			WriteErrorResponse(w, errResp)
+			return nil, false
+		}
+		if !slices.Contains(scopes, scope) {
+			scopes = append(scopes, scope)
+		}
+	}
+	return scopes, true
+}
+
+// newAPIKey generates an API key for userID. It returns the key to store and
+// the key itself, which is only ever shown once.
+func newAPIKey(userID, name string, scopes []string, expiresAt *time.Time) (*db.APIKey, string, error) {
+	secret, err := randomToken(32)
+	if err != nil {
+		return nil, "", err
+	}
+	plaintext := auth.APIKeyPrefix + secret
+	key := &db.APIKey{
+		ID:        uuid.New(),
+		UserID:    userID,
+		Name:      name,
+		KeyHash:   auth.HashAPIKey(plaintext),
+		Prefix:    plaintext[:apiKeyPrefixLength],
+		Scopes:    scopes,
+		ExpiresAt: expiresAt,
+	}
+	return key, plaintext, nil
+}
+
+// ListAPIKeysHandler returns the user's API keys. The keys themselves are
+// only shown when they are created.
+func (h *Handler) ListAPIKeysHandler(w http.ResponseWriter, r *http.Request) {
+	userID, err := auth.GetUserIDFromContext(r)
+	if err != nil {
+		errResp := UnauthorizedError("Unauthorized")
+		WriteErrorResponse(w, errResp)
+		return
+	}
+
+	keys, err := h.repo.ListAPIKeys(r.Context(), userID)
+	if err != nil {
+		errResp := InternalServerError("Failed to fetch API keys")
+		WriteErrorResponse(w, errResp)
+		return
+	}
+
+	resp := map[string]interface{}{
+		"message": "API keys retrieved successfully",
+		"apiKeys": keys,
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(resp); err != nil {
+		errResp := InternalServerError("Failed to encode response")
+		WriteErrorResponse(w, errResp)
+	}
+}
+
+// CreateAPIKeyHandler creates an API key for scripts and integrations,
+// limited to the scopes requested. API keys cannot create more of them, as
+// this route only accepts session tokens.
+func (h *Handler) CreateAPIKeyHandler(w http.ResponseWriter, r *http.Request) {
+	userID, err := auth.GetUserIDFromContext(r)
+	if err != nil {
+		errResp := UnauthorizedError("Unauthorized")
+		WriteErrorResponse(w, errResp)
+		return
+	}
+
+	var req CreateAPIKeyRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		errResp := BadRequestError("Invalid request body")
+		WriteErrorResponse(w, errResp)
+		return
+	}
+	req.Name = strings.TrimSpace(req.Name)
+	if req.Name == "" || len(req.Name) > maxAPIKeyNameLength {
+		errResp := BadRequestError("name must be between 1 and 100 characters")
+		WriteErrorResponse(w, errResp)
+		return
+	}
+	scopes, ok := parseScopes(w, req.Scopes)
+	if !ok {
+		return
+	}
+	if req.ExpiresAt != nil && !req.ExpiresAt.After(time.Now()) {
+		errResp := BadRequestError("expiresAt must be in the future")
+		WriteErrorResponse(w, errResp)
+		return
+	}
+
+	key, plaintext, err := newAPIKey(userID, req.Name, scopes, req.ExpiresAt)
+	if err != nil {
+		errResp := InternalServerError("Failed to generate API key")
+		WriteErrorResponse(w, errResp)
+		return
+	}
+	if err := h.repo.CreateAPIKey(r.Context(), key); err != nil {
+		errResp := InternalServerError("Failed to create API key")
+		WriteErrorResponse(w, errResp)
+		return
+	}
+
+	resp := map[string]interface{}{
+		"message": "API key created successfully",
+		"apiKey":  key,
+		"key":     plaintext,
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusCreated)
+	if err := json.NewEncoder(w).Encode(resp); err != nil {
+		errResp := InternalServerError("Failed to encode response")
+		WriteErrorResponse(w, errResp)
+	}
+}
+
+// DeleteAPIKeyHandler revokes one of the user's API keys.
+func (h *Handler) DeleteAPIKeyHandler(w http.ResponseWriter, r *http.Request) {
+	userID, err := auth.GetUserIDFromContext(r)
+	if err != nil {
+		errResp := UnauthorizedError("Unauthorized")
+		WriteErrorResponse(w, errResp)
+		return
+	}
+
+	keyID := chi.URLParam(r, "id")
+	if _, err := uuid.Parse(keyID); err != nil {
+		errResp := NotFoundError("API key not found")
+		WriteErrorResponse(w, errResp)
+		return
+	}
+
+	if err := h.repo.DeleteAPIKey(r.Context(), userID, keyID); err != nil {
+		if err.Error() == "api key not found" {
+			errResp := NotFoundError("API key not found")
+			WriteErrorResponse(w, errResp)
+			return
+		}
+		errResp := InternalServerError("Failed to delete API key")
+		WriteErrorResponse(w, errResp)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
@@ -0,0 +1,209 @@
+package api
+
+import (
+	"encoding/json"
+	"log"
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+
+	"xpired/internal/auth"
+	"xpired/internal/db"
+)
+
+// loadTeamDocument resolves the {id} URL parameter to an organization
+// document and returns the caller's membership in its organization. On
+// failure it writes the error response and returns ok=false.
+func (h *Handler) loadTeamDocument(w http.ResponseWriter, r *http.Request) (doc *db.Document, member *db.OrganizationMember, ok bool) {
+	documentID := chi.URLParam(r, "id")
+	if documentID == "" || documentID == "undefined" {
+		errResp := BadRequestError("Document ID is required")
+		WriteErrorResponse(w, errResp)
+		return nil, nil, false
+	}
+	userID, err := auth.GetUserIDFromContext(r)
+	if err != nil {
+		errResp := UnauthorizedError("Unauthorized")
+		WriteErrorResponse(w, errResp)
+		return nil, nil, false
+	}
+
+	doc, err = h.repo.GetDocumentByID(r.Context(), documentID)
+	if err != nil {
+		errResp := NotFoundError("Document not found")
+		WriteErrorResponse(w, errResp)
+		return nil, nil, false
+	}
+	if doc.OrganizationID == nil {
+		errResp := BadRequestError("Document does not belong to an organization")
+		WriteErrorResponse(w, errResp)
+		return nil, nil, false
+	}
+
+	member, err = h.repo.GetOrganizationMember(r.Context(), *doc.OrganizationID, userID)
+	if err != nil {
+		errResp := ForbiddenError("Forbidden")
+		WriteErrorResponse(w, errResp)
+		return nil, nil, false
+	}
+	return doc, member, true
+}
+
+// canAssignDocument reports whether member may change who doc is assigned
+// to: its owner and the organization's owners and admins can.
+func canAssignDocument(doc *db.Document, member *db.OrganizationMember) bool {
+	return doc.UserID.String() == member.UserID || canManageOrganization(member)
+}
+
+func (h *Handler) GetDocumentAssigneeHandler(w http.ResponseWriter, r *http.Request) {
+	doc, _, ok := h.loadTeamDocument(w, r)
+	if !ok {
+		return
+	}
+
+	assignment, err := h.repo.GetDocumentAssignment(r.Context(), doc.ID.String())
+	if err != nil {
+		if err.Error() == "document assignment not found" {
+			errResp := NotFoundError("Document is not assigned")
+			WriteErrorResponse(w, errResp)
+			return
+		}
+		errResp := InternalServerError("Failed to load document assignment")
+		WriteErrorResponse(w, errResp)
+		return
+	}
+
+	resp := map[string]interface{}{
+		"message":    "Document assignment retrieved successfully",
+		"assignment": assignment,
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(resp); err != nil {
+		errResp := InternalServerError("Failed to encode response")
+		WriteErrorResponse(w, errResp)
+	}
+}
+
+// AssignDocumentHandler makes an organization member responsible for a team
+// document. Its reminders go to them from then on, and reach the
+// organization's admins if they go unacknowledged.
+func (h *Handler) AssignDocumentHandler(w http.ResponseWriter, r *http.Request) {
+	doc, member, ok := h.loadTeamDocument(w, r)
+	if !ok {
+		return
+	}
+	if !canAssignDocument(doc, member) {
+		errResp := ForbiddenError("Only the document owner or an organization admin can assign it")
+		WriteErrorResponse(w, errResp)
+		return
+	}
+
+	var req AssignDocumentRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		errResp := BadRequestError("Invalid request body")
+		WriteErrorResponse(w, errResp)
+		return
+	}
+	if _, err := uuid.Parse(req.AssigneeID); err != nil {
+		errResp := BadRequestError("assigneeId must be a valid UUID")
+		WriteErrorResponse(w, errResp)
+		return
+	}
+	if _, err := h.repo.GetOrganizationMember(r.Context(), *doc.OrganizationID, req.AssigneeID); err != nil {
+		errResp := BadRequestError("The assignee must be a member of the organization")
+		WriteErrorResponse(w, errResp)
+		return
+	}
+
+	if err := h.repo.AssignDocument(r.Context(), doc.ID.String(), req.AssigneeID, member.UserID); err != nil {
+		errResp := InternalServerError("Failed to assign document")
+		WriteErrorResponse(w, errResp)
+		return
+	}
+
+	assignment, err := h.repo.GetDocumentAssignment(r.Context(), doc.ID.String())
+	if err != nil {
+		errResp := InternalServerError("Failed to load document assignment")
+		WriteErrorResponse(w, errResp)
+		return
+	}
+
+	resp := map[string]interface{}{
+		"message":    "Document assigned successfully",
+		"assignment": assignment,
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(resp); err != nil {
+		errResp := InternalServerError("Failed to encode response")
+		WriteErrorResponse(w, errResp)
+	}
+}
+
+// UnassignDocumentHandler sends a team document's reminders back to its
+// owner.
+func (h *Handler) UnassignDocumentHandler(w http.ResponseWriter, r *http.Request) {
+	doc, member, ok := h.loadTeamDocument(w, r)
+	if !ok {
+		return
+	}
+	if !canAssignDocument(doc, member) {
+		errResp := ForbiddenError("Only the document owner or an organization admin can unassign it")
+		WriteErrorResponse(w, errResp)
+		return
+	}
+
+	if err := h.repo.UnassignDocument(r.Context(), doc.ID.String()); err != nil {
+		if err.Error() == "document assignment not found" {
+			errResp := NotFoundError("Document is not assigned")
+			WriteErrorResponse(w, errResp)
+			return
+		}
+		errResp := InternalServerError("Failed to unassign document")
+		WriteErrorResponse(w, errResp)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// AcknowledgeDocumentAssignmentHandler lets the assignee confirm they have
+// seen the document's reminder, so it does not escalate to an admin.
+func (h *Handler) AcknowledgeDocumentAssignmentHandler(w http.ResponseWriter, r *http.Request) {
+	doc, member, ok := h.loadTeamDocument(w, r)
+	if !ok {
+		return
+	}
+
+	if err := h.repo.AcknowledgeDocumentAssignment(r.Context(), doc.ID.String(), member.UserID); err != nil {
+		if err.Error() == "document assignment not found" {
+			errResp := ForbiddenError("Only the assignee can acknowledge the document")
+			WriteErrorResponse(w, errResp)
+			return
+		}
+		errResp := InternalServerError("Failed to acknowledge document")
+		WriteErrorResponse(w, errResp)
+		return
+	}
+	event := &db.EventLogEntry{
+		OrganizationID: *doc.OrganizationID,
+		EventType:      db.EventReminderAcknowledged,
+		DocumentID:     doc.ID.String(),
+		ActorID:        &member.UserID,
+	}
+	if err := h.repo.AppendEvent(r.Context(), event); err != nil {
+		log.Printf("Failed to append %s event for document %s: %v", db.EventReminderAcknowledged, doc.ID.String(), err)
+	}
+
+	resp := map[string]interface{}{
+		"message": "Document acknowledged successfully",
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(resp); err != nil {
+		errResp := InternalServerError("Failed to encode response")
+		WriteErrorResponse(w, errResp)
+	}
+}
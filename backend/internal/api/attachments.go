@@ -0,0 +1,190 @@
+package api
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"mime/multipart"
+	"net/http"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	"xpired/internal/db"
+	"xpired/internal/storage"
+	"xpired/internal/worker"
+)
+
+var attachmentExtPattern = regexp.MustCompile(`^\.[a-z0-9]{1,10}$`)
+
+// UploadAttachmentHandler stores an uploaded file as the document's
+// attachment, replacing any previous upload, and queues it for a virus scan.
+// The attachment stays "pending" until the scan finishes. A file identical
+// to one already attached to another of the owner's documents is not stored
+// again; both documents point at the same object.
+func (h *Handler) UploadAttachmentHandler(w http.ResponseWriter, r *http.Request) {
+	doc, userID, ok := h.loadOwnedDocument(w, r)
+	if !ok {
+		return
+	}
+	if !h.ensureDocumentUnlocked(w, r, doc.ID.String(), userID) {
+		return
+	}
+
+	maxBytes := int64(h.cfg.Attachments.MaxUploadMB) << 20
+	r.Body = http.MaxBytesReader(w, r.Body, maxBytes+1<<20)
+	file, header, err := r.FormFile("file")
+	if err != nil {
+		var tooLarge *http.MaxBytesError
+		if errors.As(err, &tooLarge) {
+			errResp := BadRequestError(fmt.Sprintf("Attachment must be at most %d MB", h.cfg.Attachments.MaxUploadMB))
+			WriteErrorResponse(w, errResp)
+			return
+		}
+		errResp := BadRequestError("A file is required")
+		WriteErrorResponse(w, errResp)
+		return
+	}
+	defer file.Close()
+
+	if header.Size > maxBytes {
+		errResp := BadRequestError(fmt.Sprintf("Attachment must be at most %d MB", h.cfg.Attachments.MaxUploadMB))
+		WriteErrorResponse(w, errResp)
+		return
+	}
+
+	checksum, err := attachmentChecksum(file)
+	if err != nil {
+		errResp := BadRequestError("Failed to read attachment")
+		WriteErrorResponse(w, errResp)
+		return
+	}
+
+	url, stored, err := h.storeAttachment(r, doc, header, file, checksum)
+	if err != nil {
+		log.Printf("Failed to store attachment for document %s: %v", doc.ID.String(), err)
+		errResp := InternalServerError("Failed to store attachment")
+		WriteErrorResponse(w, errResp)
+		return
+	}
+
+	previous := doc.AttachmentURL
+	doc.AttachmentURL = &url
+	doc.UpdatedAt = time.Now()
+	if err := h.repo.UpdateDocument(r.Context(), doc); err != nil {
+		if stored {
+			h.releaseAttachment(r, url, doc.ID.String())
+		}
+		errResp := InternalServerError("Failed to update document")
+		WriteErrorResponse(w, errResp)
+		return
+	}
+
+	status := db.AttachmentPending
+	if h.cfg.Attachments.ClamdAddr == "" {
+		status = db.AttachmentUnscanned
+	}
+	if err := h.repo.SetAttachmentStatus(r.Context(), doc.ID.String(), url, status); err != nil {
+		log.Printf("Failed to set attachment status for document %s: %v", doc.ID.String(), err)
+	}
+	doc.AttachmentStatus = &status
+
+	if status == db.AttachmentPending {
+		if err := worker.ScheduleAttachmentScan(r.Context(), doc.ID.String(), url); err != nil {
+			log.Printf("Failed to schedule attachment scan for document %s: %v", doc.ID.String(), err)
+		}
+	}
+
+	if previous != nil && *previous != url {
+		h.releaseAttachment(r, *previous, doc.ID.String())
+	}
+	worker.EmitWebhookEvent(r.Context(), doc.UserID.String(), db.WebhookEventDocumentUpdated, doc)
+
+	resp := map[string]interface{}{
+		"message":  "Attachment uploaded successfully",
+		"document": h.humanizeDocument(r.Context(), doc),
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(resp); err != nil {
+		errResp := InternalServerError("Failed to encode response")
+		WriteErrorResponse(w, errResp)
+	}
+}
+
+// attachmentChecksum returns the hex SHA-256 of an uploaded file and rewinds
+// it for storing.
+func attachmentChecksum(file multipart.File) (string, error) {
+	hash := sha256.New()
+	if _, err := io.Copy(hash, file); err != nil {
+		return "", err
+	}
+	if _, err := file.Seek(0, io.SeekStart); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(hash.Sum(nil)), nil
+}
+
+// storeAttachment returns the URL of the document owner's attachment with
+// the given checksum, storing the file first unless an identical one is
+// already stored. stored reports whether this upload stored it.
+func (h *Handler) storeAttachment(r *http.Request, doc *db.Document, header *multipart.FileHeader, file multipart.File, checksum string) (string, bool, error) {
+	ownerID := doc.UserID.String()
+	if blob, err := h.repo.GetAttachmentBlob(r.Context(), ownerID, checksum); err == nil {
+		return blob.URL, false, nil
+	} else if err.Error() != "attachment blob not found" {
+		return "", false, err
+	}
+
+	ext := strings.ToLower(filepath.Ext(header.Filename))
+	if !attachmentExtPattern.MatchString(ext) {
+		ext = ""
+	}
+	key := fmt.Sprintf("attachments/%s/%s%s", doc.ID.String(), uuid.New().String(), ext)
+
+	contentType := header.Header.Get("Content-Type")
+	if contentType == "" {
+		contentType = "application/octet-stream"
+	}
+
+	url, err := h.store.Put(r.Context(), key, file, contentType)
+	if err != nil {
+		return "", false, err
+	}
+	blob := &db.AttachmentBlob{
+		UserID:   ownerID,
+		Checksum: checksum,
+		URL:      url,
+		Size:     header.Size,
+	}
+	canonical, err := h.repo.CreateAttachmentBlob(r.Context(), blob)
+	if err != nil || canonical != url {
+		// a concurrent upload of the same file won; use its copy
+		_ = h.store.Delete(r.Context(), url)
+		return canonical, false, err
+	}
+	return url, true, nil
+}
+
+// releaseAttachment drops the document's reference to the attachment at url
+// and deletes the file once no document refers to it anymore.
+func (h *Handler) releaseAttachment(r *http.Request, url, documentID string) {
+	unused, err := h.repo.ReleaseAttachmentBlob(r.Context(), url, documentID)
+	if err != nil {
+		log.Printf("Failed to release attachment of document %s: %v", documentID, err)
+		return
+	}
+	if !unused {
+		return
+	}
+	if err := h.store.Delete(r.Context(), url); err != nil && !errors.Is(err, storage.ErrNotManaged) {
+		log.Printf("Failed to delete replaced attachment of document %s: %v", documentID, err)
+	}
+}
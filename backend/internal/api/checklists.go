@@ -0,0 +1,176 @@
+package api
+
+import (
+	"encoding/json"
+	"net/http"
+	"strings"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+
+	"xpired/internal/db"
+)
+
+func toChecklistItemResponse(item *db.ChecklistItem) ChecklistItemResponse {
+	return ChecklistItemResponse{
+		ID:       item.ID.String(),
+		Position: item.Position,
+		Title:    item.Title,
+		Done:     item.Done,
+		DoneAt:   item.DoneAt,
+	}
+}
+
+func checklistProgress(items []*db.ChecklistItem) *ChecklistProgress {
+	if len(items) == 0 {
+		return nil
+	}
+	progress := &ChecklistProgress{Total: len(items)}
+	for _, item := range items {
+		if item.Done {
+			progress.Done++
+		}
+	}
+	return progress
+}
+
+func (h *Handler) GetChecklistHandler(w http.ResponseWriter, r *http.Request) {
+	doc, _, ok := h.loadOwnedDocument(w, r)
+	if !ok {
+		return
+	}
+
+	items, err := h.repo.ListChecklistItems(r.Context(), doc.ID.String())
+	if err != nil {
+		errResp := InternalServerError("Failed to fetch checklist")
+		WriteErrorResponse(w, errResp)
+		return
+	}
+
+	itemResps := []ChecklistItemResponse{}
+	for _, item := range items {
+		itemResps = append(itemResps, toChecklistItemResponse(item))
+	}
+
+	resp := map[string]interface{}{
+		"message":  "Checklist fetched successfully",
+		"items":    itemResps,
+		"progress": checklistProgress(items),
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(resp); err != nil {
+		errResp := InternalServerError("Failed to encode response")
+		WriteErrorResponse(w, errResp)
+	}
+}
+
+func (h *Handler) CreateChecklistItemHandler(w http.ResponseWriter, r *http.Request) {
+	doc, _, ok := h.loadOwnedDocument(w, r)
+	if !ok {
+		return
+	}
+
+	var req ChecklistItemRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		errResp := BadRequestError("Invalid request body")
+		WriteErrorResponse(w, errResp)
+		return
+	}
+	if req.Title == nil || strings.TrimSpace(*req.Title) == "" {
+		errResp := BadRequestError("Missing required fields")
+		WriteErrorResponse(w, errResp)
+		return
+	}
+
+	item := &db.ChecklistItem{
+		ID:         uuid.New(),
+		DocumentID: doc.ID.String(),
+		Title:      strings.TrimSpace(*req.Title),
+	}
+	if err := h.repo.CreateChecklistItem(r.Context(), item); err != nil {
+		errResp := InternalServerError("Failed to create checklist item")
+		WriteErrorResponse(w, errResp)
+		return
+	}
+
+	resp := map[string]interface{}{
+		"message": "Checklist item created successfully",
+		"item":    toChecklistItemResponse(item),
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusCreated)
+	if err := json.NewEncoder(w).Encode(resp); err != nil {
+		errResp := InternalServerError("Failed to encode response")
+		WriteErrorResponse(w, errResp)
+	}
+}
+
+func (h *Handler) UpdateChecklistItemHandler(w http.ResponseWriter, r *http.Request) {
+	doc, _, ok := h.loadOwnedDocument(w, r)
+	if !ok {
+		return
+	}
+
+	item, err := h.repo.GetChecklistItem(r.Context(), doc.ID.String(), chi.URLParam(r, "itemId"))
+	if err != nil {
+		errResp := NotFoundError("Checklist item not found")
+		WriteErrorResponse(w, errResp)
+		return
+	}
+
+	var req ChecklistItemRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		errResp := BadRequestError("Invalid request body")
+		WriteErrorResponse(w, errResp)
+		return
+	}
+
+	if req.Title != nil {
+		if strings.TrimSpace(*req.Title) == "" {
+			errResp := BadRequestError("Title cannot be empty")
+			WriteErrorResponse(w, errResp)
+			return
+		}
+		item.Title = strings.TrimSpace(*req.Title)
+	}
+	if req.Done != nil {
+		item.Done = *req.Done
+	}
+	if req.Position != nil {
+		item.Position = *req.Position
+	}
+
+	if err := h.repo.UpdateChecklistItem(r.Context(), item); err != nil {
+		errResp := InternalServerError("Failed to update checklist item")
+		WriteErrorResponse(w, errResp)
+		return
+	}
+
+	resp := map[string]interface{}{
+		"message": "Checklist item updated successfully",
+		"item":    toChecklistItemResponse(item),
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(resp); err != nil {
+		errResp := InternalServerError("Failed to encode response")
+		WriteErrorResponse(w, errResp)
+	}
+}
+
+func (h *Handler) DeleteChecklistItemHandler(w http.ResponseWriter, r *http.Request) {
+	doc, _, ok := h.loadOwnedDocument(w, r)
+	if !ok {
+		return
+	}
+
+	if err := h.repo.DeleteChecklistItem(r.Context(), doc.ID.String(), chi.URLParam(r, "itemId")); err != nil {
+		errResp := NotFoundError("Checklist item not found")
+		WriteErrorResponse(w, errResp)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
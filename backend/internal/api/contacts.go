@@ -0,0 +1,206 @@
+package api
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/mail"
+	"strings"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+
+	"xpired/internal/db"
+)
+
+func toDocumentContactResponse(contact *db.DocumentContact) DocumentContactResponse {
+	return DocumentContactResponse{
+		ID:           contact.ID.String(),
+		Name:         contact.Name,
+		Email:        contact.Email,
+		Unsubscribed: contact.UnsubscribedAt != nil,
+		CreatedAt:    contact.CreatedAt,
+	}
+}
+
+func (h *Handler) ListDocumentContactsHandler(w http.ResponseWriter, r *http.Request) {
+	doc, _, ok := h.loadOwnedDocument(w, r)
+	if !ok {
+		return
+	}
+
+	contacts, err := h.repo.ListDocumentContacts(r.Context(), doc.ID.String())
+	if err != nil {
+		errResp := InternalServerError("Failed to fetch document contacts")
+		WriteErrorResponse(w, errResp)
+		return
+	}
+
+	contactResps := []DocumentContactResponse{}
+	for _, contact := range contacts {
+		contactResps = append(contactResps, toDocumentContactResponse(contact))
+	}
+
+	resp := map[string]interface{}{
+		"message":  "Document contacts fetched successfully",
+		"contacts": contactResps,
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(resp); err != nil {
+		errResp := InternalServerError("Failed to encode response")
+		WriteErrorResponse(w, errResp)
+	}
+}
+
+func (h *Handler) CreateDocumentContactHandler(w http.ResponseWriter, r *http.Request) {
+	doc, _, ok := h.loadOwnedDocument(w, r)
+	if !ok {
+		return
+	}
+
+	var req DocumentContactRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		errResp := BadRequestError("Invalid request body")
+		WriteErrorResponse(w, errResp)
+		return
+	}
+
+	address, err := mail.ParseAddress(strings.TrimSpace(req.Email))
+	if err != nil {
+		errResp := BadRequestError("A valid email is required")
+		WriteErrorResponse(w, errResp)
+		return
+	}
+
+	token, err := randomToken(32)
+	if err != nil {
+		errResp := InternalServerError("Failed to generate unsubscribe token")
+		WriteErrorResponse(w, errResp)
+		return
+	}
+
+	contact := &db.DocumentContact{
+		ID:               uuid.New(),
+		DocumentID:       doc.ID.String(),
+		Name:             req.Name,
+		Email:            strings.ToLower(address.Address),
+		UnsubscribeToken: token,
+	}
+	if err := h.repo.CreateDocumentContact(r.Context(), contact); err != nil {
+		if err.Error() == "contact already exists" {
+			errResp := ConflictError("Contact already added to this document")
+			WriteErrorResponse(w, errResp)
+			return
+		}
+		errResp := InternalServerError("Failed to add document contact")
+		WriteErrorResponse(w, errResp)
+		return
+	}
+
+	resp := map[string]interface{}{
+		"message": "Document contact added successfully",
+		"contact": toDocumentContactResponse(contact),
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusCreated)
+	if err := json.NewEncoder(w).Encode(resp); err != nil {
+		errResp := InternalServerError("Failed to encode response")
+		WriteErrorResponse(w, errResp)
+	}
+}
+
+func (h *Handler) DeleteDocumentContactHandler(w http.ResponseWriter, r *http.Request) {
+	doc, _, ok := h.loadOwnedDocument(w, r)
+	if !ok {
+		return
+	}
+
+	contactID := chi.URLParam(r, "contactId")
+	if _, err := uuid.Parse(contactID); err != nil {
+		errResp := BadRequestError("Invalid contact ID")
+		WriteErrorResponse(w, errResp)
+		return
+	}
+
+	if err := h.repo.DeleteDocumentContact(r.Context(), doc.ID.String(), contactID); err != nil {
+		errResp := NotFoundError("Document contact not found")
+		WriteErrorResponse(w, errResp)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// UnsubscribeLinkHandler is public: it shows who an unsubscribe link is for,
+// for the page that asks to confirm it. It changes nothing, as mail scanners
+// follow links too; UnsubscribeContactHandler unsubscribes.
+func (h *Handler) UnsubscribeLinkHandler(w http.ResponseWriter, r *http.Request) {
+	token := chi.URLParam(r, "token")
+	if token == "" {
+		errResp := BadRequestError("Unsubscribe token is required")
+		WriteErrorResponse(w, errResp)
+		return
+	}
+
+	var contact *db.DocumentContact
+	for _, ctx := range h.regionContexts(r) {
+		if found, err := h.repo.GetDocumentContactByUnsubscribeToken(ctx, token); err == nil {
+			contact = found
+			break
+		}
+	}
+	if contact == nil {
+		errResp := NotFoundError("Invalid unsubscribe link")
+		WriteErrorResponse(w, errResp)
+		return
+	}
+
+	resp := map[string]interface{}{
+		"message":      "Unsubscribe link fetched successfully",
+		"email":        contact.Email,
+		"unsubscribed": contact.UnsubscribedAt != nil,
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(resp); err != nil {
+		errResp := InternalServerError("Failed to encode response")
+		WriteErrorResponse(w, errResp)
+	}
+}
+
+// UnsubscribeContactHandler is public: the token in the link sent to the
+// contact is the only credential needed to stop their reminders. It is also
+// the one-click unsubscribe target of the email's List-Unsubscribe header
+// (RFC 8058), which mail clients POST to with a form body.
+func (h *Handler) UnsubscribeContactHandler(w http.ResponseWriter, r *http.Request) {
+	token := chi.URLParam(r, "token")
+	if token == "" {
+		errResp := BadRequestError("Unsubscribe token is required")
+		WriteErrorResponse(w, errResp)
+		return
+	}
+
+	unsubscribed := false
+	for _, ctx := range h.regionContexts(r) {
+		if _, err := h.repo.UnsubscribeDocumentContact(ctx, token); err == nil {
+			unsubscribed = true
+			break
+		}
+	}
+	if !unsubscribed {
+		errResp := NotFoundError("Invalid unsubscribe link")
+		WriteErrorResponse(w, errResp)
+		return
+	}
+
+	resp := map[string]interface{}{
+		"message": "You have been unsubscribed from these reminders",
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(resp); err != nil {
+		errResp := InternalServerError("Failed to encode response")
+		WriteErrorResponse(w, errResp)
+	}
+}
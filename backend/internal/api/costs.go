@@ -0,0 +1,144 @@
+package api
+
+import (
+	"encoding/json"
+	"math"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"golang.org/x/text/currency"
+	"golang.org/x/text/message"
+
+	"xpired/internal/auth"
+	"xpired/internal/db"
+)
+
+const (
+	defaultRenewalCostMonths = 12
+	maxRenewalCostMonths     = 36
+	// maxRenewalCost keeps costs within the numeric(15, 3) column.
+	maxRenewalCost = 1e12
+)
+
+// applyRenewalCost sets the renewal cost and currency of a create or update
+// request on doc, normalizing the currency code and rounding the cost to the
+// currency's minor unit. It returns the message of the first problem, or "".
+func applyRenewalCost(doc *db.Document, cost *float64, code *string) string {
+	if code != nil {
+		unit, err := currency.ParseISO(strings.TrimSpace(*code))
+		if err != nil {
+			return "currency must be an ISO 4217 currency code"
+		}
+		normalized := unit.String()
+		doc.Currency = &normalized
+	}
+	if cost != nil {
+		if *cost < 0 || *cost >= maxRenewalCost || math.IsNaN(*cost) {
+			return "renewalCost must be a non-negative amount"
+		}
+		doc.RenewalCost = cost
+	}
+
+	if doc.RenewalCost != nil && doc.Currency == nil {
+		return "currency is required with renewalCost"
+	}
+	if doc.RenewalCost != nil {
+		unit := currency.MustParseISO(*doc.Currency)
+		scale, _ := currency.Standard.Rounding(unit)
+		factor := math.Pow10(scale)
+		rounded := math.Round(*doc.RenewalCost*factor) / factor
+		doc.RenewalCost = &rounded
+	}
+	return ""
+}
+
+func formatAmount(printer *message.Printer, code string, amount float64) string {
+	unit, err := currency.ParseISO(code)
+	if err != nil {
+		return printer.Sprintf("%.2f %s", amount, code)
+	}
+	return printer.Sprint(currency.Symbol(unit.Amount(amount)))
+}
+
+// RenewalCostStatsHandler sums the renewal costs of the user's documents
+// expiring over the coming months, per month and currency, to budget for
+// renewals. Currencies are never converted or added together; amounts are
+// also formatted for the request's locale.
+func (h *Handler) RenewalCostStatsHandler(w http.ResponseWriter, r *http.Request) {
+	userID, err := auth.GetUserIDFromContext(r)
+	if err != nil {
+		errResp := UnauthorizedError("Unauthorized")
+		WriteErrorResponse(w, errResp)
+		return
+	}
+
+	months := defaultRenewalCostMonths
+	if raw := r.URL.Query().Get("months"); raw != "" {
+		months, err = strconv.Atoi(raw)
+		if err != nil || months < 1 || months > maxRenewalCostMonths {
+			errResp := BadRequestError("months must be between 1 and 36")
+			WriteErrorResponse(w, errResp)
+			return
+		}
+	}
+
+	now := time.Now().UTC()
+	from := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC)
+	to := time.Date(now.Year(), now.Month()+time.Month(months), 1, 0, 0, 0, 0, time.UTC)
+
+	totals, err := h.repo.ListRenewalCosts(r.Context(), userID, from, to)
+	if err != nil {
+		errResp := InternalServerError("Failed to fetch renewal costs")
+		WriteErrorResponse(w, errResp)
+		return
+	}
+
+	locale := h.requestLocale(r.Context())
+	printer := message.NewPrinter(locale)
+
+	monthly := []RenewalCostResponse{}
+	byCurrency := map[string]*RenewalCostResponse{}
+	var currencies []string
+	for _, total := range totals {
+		monthly = append(monthly, RenewalCostResponse{
+			Month:     total.Month,
+			Currency:  total.Currency,
+			Total:     total.Total,
+			Formatted: formatAmount(printer, total.Currency, total.Total),
+			Documents: total.Documents,
+		})
+
+		sum, ok := byCurrency[total.Currency]
+		if !ok {
+			sum = &RenewalCostResponse{Currency: total.Currency}
+			byCurrency[total.Currency] = sum
+			currencies = append(currencies, total.Currency)
+		}
+		sum.Total += total.Total
+		sum.Documents += total.Documents
+	}
+
+	overall := []RenewalCostResponse{}
+	for _, code := range currencies {
+		sum := byCurrency[code]
+		sum.Formatted = formatAmount(printer, code, sum.Total)
+		overall = append(overall, *sum)
+	}
+
+	resp := map[string]interface{}{
+		"message": "Renewal costs fetched successfully",
+		"from":    from.Format("2006-01-02"),
+		"to":      to.AddDate(0, 0, -1).Format("2006-01-02"),
+		"locale":  locale.String(),
+		"months":  monthly,
+		"totals":  overall,
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(resp); err != nil {
+		errResp := InternalServerError("Failed to encode response")
+		WriteErrorResponse(w, errResp)
+	}
+}
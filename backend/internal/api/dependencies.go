@@ -0,0 +1,226 @@
+package api
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+
+	"xpired/internal/auth"
+	"xpired/internal/db"
+	"xpired/internal/worker"
+)
+
+func toDependencyDocumentResponse(doc *db.Document) DependencyDocumentResponse {
+	return DependencyDocumentResponse{
+		ID:             doc.ID.String(),
+		Name:           doc.Name,
+		ExpirationDate: doc.ExpirationDate.Format("Mon, 2 Jan, 2006"),
+	}
+}
+
+// sameOrganization reports whether a and b are both personal documents or
+// both belong to the same organization.
+func sameOrganization(a, b *db.Document) bool {
+	if a.OrganizationID == nil || b.OrganizationID == nil {
+		return a.OrganizationID == nil && b.OrganizationID == nil
+	}
+	return *a.OrganizationID == *b.OrganizationID
+}
+
+// ListDocumentDependenciesHandler returns the documents a document depends on
+// and every document depending on it, directly or through others. Dependents
+// valid for longer than the document are marked as impacted.
+func (h *Handler) ListDocumentDependenciesHandler(w http.ResponseWriter, r *http.Request) {
+	doc, _, ok := h.loadOwnedDocument(w, r)
+	if !ok {
+		return
+	}
+
+	dependencies, err := h.repo.ListDocumentDependencies(r.Context(), doc.ID.String())
+	if err != nil {
+		errResp := InternalServerError("Failed to fetch dependencies")
+		WriteErrorResponse(w, errResp)
+		return
+	}
+	dependents, err := h.repo.ListDocumentDependents(r.Context(), doc.ID.String())
+	if err != nil {
+		errResp := InternalServerError("Failed to fetch dependents")
+		WriteErrorResponse(w, errResp)
+		return
+	}
+
+	dependsOn := []DependencyDocumentResponse{}
+	for _, dependency := range dependencies {
+		dependsOn = append(dependsOn, toDependencyDocumentResponse(dependency))
+	}
+	dependentResps := []DependencyDocumentResponse{}
+	for _, dependent := range dependents {
+		dependentResp := toDependencyDocumentResponse(dependent)
+		dependentResp.Impacted = dependent.ExpirationDate.After(doc.ExpirationDate)
+		dependentResps = append(dependentResps, dependentResp)
+	}
+
+	resp := map[string]interface{}{
+		"message":    "Dependencies fetched successfully",
+		"dependsOn":  dependsOn,
+		"dependents": dependentResps,
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(resp); err != nil {
+		errResp := InternalServerError("Failed to encode response")
+		WriteErrorResponse(w, errResp)
+	}
+}
+
+// AddDocumentDependencyHandler makes a document depend on another one of the
+// caller's documents, e.g. a visa on the passport it is in. The owners of the
+// dependents are told when that document expires or its date changes.
+func (h *Handler) AddDocumentDependencyHandler(w http.ResponseWriter, r *http.Request) {
+	doc, userID, ok := h.loadOwnedDocument(w, r)
+	if !ok {
+		return
+	}
+
+	var req DocumentDependencyRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		errResp := BadRequestError("Invalid request body")
+		WriteErrorResponse(w, errResp)
+		return
+	}
+	if req.DependsOnID == "" {
+		errResp := BadRequestError("Missing required fields")
+		WriteErrorResponse(w, errResp)
+		return
+	}
+	if req.DependsOnID == doc.ID.String() {
+		errResp := BadRequestError("A document cannot depend on itself")
+		WriteErrorResponse(w, errResp)
+		return
+	}
+
+	parent, err := h.repo.GetDocumentByID(r.Context(), req.DependsOnID)
+	if err != nil || !h.canManageDocument(r.Context(), parent, userID) {
+		errResp := NotFoundError("Dependency document not found")
+		WriteErrorResponse(w, errResp)
+		return
+	}
+	if !sameOrganization(doc, parent) {
+		errResp := BadRequestError("Dependent documents must belong to the same organization")
+		WriteErrorResponse(w, errResp)
+		return
+	}
+
+	if err := h.repo.AddDocumentDependency(r.Context(), doc.ID.String(), parent.ID.String()); err != nil {
+		if err.Error() == "dependency cycle" {
+			errResp := ConflictError("The document already depends on this one, directly or indirectly")
+			WriteErrorResponse(w, errResp)
+			return
+		}
+		errResp := InternalServerError("Failed to add dependency")
+		WriteErrorResponse(w, errResp)
+		return
+	}
+	worker.ScheduleDependentsExpiryNotice(r.Context(), *parent)
+
+	resp := map[string]interface{}{
+		"message":   "Dependency added successfully",
+		"dependsOn": toDependencyDocumentResponse(parent),
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusCreated)
+	if err := json.NewEncoder(w).Encode(resp); err != nil {
+		errResp := InternalServerError("Failed to encode response")
+		WriteErrorResponse(w, errResp)
+	}
+}
+
+func (h *Handler) RemoveDocumentDependencyHandler(w http.ResponseWriter, r *http.Request) {
+	doc, _, ok := h.loadOwnedDocument(w, r)
+	if !ok {
+		return
+	}
+
+	err := h.repo.RemoveDocumentDependency(r.Context(), doc.ID.String(), chi.URLParam(r, "dependsOnId"))
+	if err != nil {
+		if err.Error() == "dependency not found" {
+			errResp := NotFoundError("Dependency not found")
+			WriteErrorResponse(w, errResp)
+			return
+		}
+		errResp := InternalServerError("Failed to remove dependency")
+		WriteErrorResponse(w, errResp)
+		return
+	}
+
+	resp := map[string]interface{}{
+		"message": "Dependency removed successfully",
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(resp); err != nil {
+		errResp := InternalServerError("Failed to encode response")
+		WriteErrorResponse(w, errResp)
+	}
+}
+
+// DependencyGraphHandler returns the dependency graph of the caller's
+// documents: every document that depends on or is depended on by another,
+// and the dependencies between them.
+func (h *Handler) DependencyGraphHandler(w http.ResponseWriter, r *http.Request) {
+	userID, err := auth.GetUserIDFromContext(r)
+	if err != nil {
+		errResp := UnauthorizedError("Unauthorized")
+		WriteErrorResponse(w, errResp)
+		return
+	}
+
+	documents, err := h.repo.ListDocumentsByUserID(r.Context(), userID)
+	if err != nil {
+		errResp := InternalServerError("Failed to fetch documents")
+		WriteErrorResponse(w, errResp)
+		return
+	}
+	documentIDs := make([]string, 0, len(documents))
+	for _, doc := range documents {
+		documentIDs = append(documentIDs, doc.ID.String())
+	}
+
+	edges, err := h.repo.ListDocumentDependencyEdges(r.Context(), documentIDs)
+	if err != nil {
+		errResp := InternalServerError("Failed to fetch dependencies")
+		WriteErrorResponse(w, errResp)
+		return
+	}
+
+	linked := map[string]bool{}
+	edgeResps := []DependencyEdgeResponse{}
+	for _, edge := range edges {
+		linked[edge.DocumentID] = true
+		linked[edge.DependsOnID] = true
+		edgeResps = append(edgeResps, DependencyEdgeResponse{
+			DocumentID:  edge.DocumentID,
+			DependsOnID: edge.DependsOnID,
+		})
+	}
+	nodes := []DependencyDocumentResponse{}
+	for _, doc := range documents {
+		if linked[doc.ID.String()] {
+			nodes = append(nodes, toDependencyDocumentResponse(doc))
+		}
+	}
+
+	resp := map[string]interface{}{
+		"message": "Dependency graph fetched successfully",
+		"nodes":   nodes,
+		"edges":   edgeResps,
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(resp); err != nil {
+		errResp := InternalServerError("Failed to encode response")
+		WriteErrorResponse(w, errResp)
+	}
+}
@@ -0,0 +1,149 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"log"
+	"net/http"
+	"strconv"
+	"time"
+
+	"xpired/internal/auth"
+)
+
+// deprecationUsageDays is how long daily call counts of deprecated endpoints
+// are kept, and the window the admin report sums over.
+const deprecationUsageDays = 30
+
+// Deprecation describes an endpoint clients should stop calling, announced
+// with the Deprecation (RFC 9745), Sunset (RFC 8594) and successor Link
+// headers on every response.
+type Deprecation struct {
+	Method string
+	// Route is the chi route pattern, e.g. "/api/documents/{id}".
+	Route string
+	// Since is when the endpoint was deprecated.
+	Since time.Time
+	// Sunset is when the endpoint goes away; zero while undecided.
+	Sunset time.Time
+	// Successor is the path of the endpoint replacing it, if any.
+	Successor string
+}
+
+func (d Deprecation) key() string {
+	return d.Method + " " + d.Route
+}
+
+func deprecationCallsKey(d Deprecation) string {
+	return "xpired:deprecated:calls:" + d.key()
+}
+
+func deprecationCallersKey(d Deprecation) string {
+	return "xpired:deprecated:callers:" + d.key()
+}
+
+// Deprecated marks the endpoint it wraps as deprecated and counts its calls,
+// so the admin deprecation report shows who still depends on it:
+//
+//	r.With(handler.Deprecated(Deprecation{
+//		Method:    http.MethodGet,
+//		Route:     "/api/documents/stats",
+//		Since:     time.Date(2026, 11, 1, 0, 0, 0, 0, time.UTC),
+//		Sunset:    time.Date(2027, 5, 1, 0, 0, 0, 0, time.UTC),
+//		Successor: "/api/v1/documents/stats",
+//	})).Get("/stats", handler.DocumentStatsHandler)
+//
+// Deprecated must be called while the routes are set up, before the server
+// starts.
+func (h *Handler) Deprecated(d Deprecation) func(http.Handler) http.Handler {
+	h.deprecations = append(h.deprecations, d)
+
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("Deprecation", "@"+strconv.FormatInt(d.Since.Unix(), 10))
+			if !d.Sunset.IsZero() {
+				w.Header().Set("Sunset", d.Sunset.UTC().Format(http.TimeFormat))
+			}
+			if d.Successor != "" {
+				w.Header().Add("Link", "<"+h.cfg.App.BaseURL+d.Successor+`>; rel="successor-version"`)
+			}
+
+			caller, err := auth.GetUserIDFromContext(r)
+			if err != nil {
+				caller = r.RemoteAddr
+			}
+			go h.recordDeprecatedCall(d, caller)
+
+			next.ServeHTTP(w, r)
+		})
+	}
+}
+
+// recordDeprecatedCall counts a call per day and adds its caller to the
+// endpoint's set of distinct callers. Failures only lose metrics.
+func (h *Handler) recordDeprecatedCall(d Deprecation, caller string) {
+	ctx, cancel := context.WithTimeout(context.Background(), 2*time.Second)
+	defer cancel()
+
+	calls := deprecationCallsKey(d)
+	callers := deprecationCallersKey(d)
+	pipe := h.cache.Pipeline()
+	pipe.HIncrBy(ctx, calls, time.Now().UTC().Format("2006-01-02"), 1)
+	pipe.Expire(ctx, calls, deprecationUsageDays*24*time.Hour)
+	pipe.PFAdd(ctx, callers, caller)
+	pipe.Expire(ctx, callers, deprecationUsageDays*24*time.Hour)
+	if _, err := pipe.Exec(ctx); err != nil {
+		log.Printf("Failed to record call to deprecated endpoint %s: %v", d.key(), err)
+	}
+}
+
+// ListDeprecationsHandler reports every deprecated endpoint with its
+// schedule and recent usage, to decide when a sunset is safe.
+func (h *Handler) ListDeprecationsHandler(w http.ResponseWriter, r *http.Request) {
+	since := time.Now().UTC().AddDate(0, 0, -deprecationUsageDays).Format("2006-01-02")
+
+	deprecations := []DeprecatedEndpointResponse{}
+	for _, d := range h.deprecations {
+		entry := DeprecatedEndpointResponse{
+			Method:     d.Method,
+			Route:      d.Route,
+			Since:      d.Since,
+			Successor:  d.Successor,
+			DailyCalls: map[string]int64{},
+		}
+		if !d.Sunset.IsZero() {
+			sunset := d.Sunset
+			entry.Sunset = &sunset
+		}
+
+		daily, err := h.cache.HGetAll(r.Context(), deprecationCallsKey(d)).Result()
+		if err != nil {
+			log.Printf("Failed to read usage of deprecated endpoint %s: %v", d.key(), err)
+		}
+		for day, raw := range daily {
+			count, err := strconv.ParseInt(raw, 10, 64)
+			if err != nil || day < since {
+				continue
+			}
+			entry.DailyCalls[day] = count
+			entry.Calls += count
+		}
+		if callers, err := h.cache.PFCount(r.Context(), deprecationCallersKey(d)).Result(); err == nil {
+			entry.DistinctCallers = callers
+		}
+
+		deprecations = append(deprecations, entry)
+	}
+
+	resp := map[string]interface{}{
+		"message":      "Deprecated endpoints retrieved successfully",
+		"windowDays":   deprecationUsageDays,
+		"deprecations": deprecations,
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(resp); err != nil {
+		errResp := InternalServerError("Failed to encode response")
+		WriteErrorResponse(w, errResp)
+	}
+}
@@ -11,14 +11,37 @@ type UserRequest struct {
 	Password    string  `json:"password"`
 	Name        string  `json:"name"`
 	PhoneNumber *string `json:"phoneNumber,omitempty"`
+	// CaptchaToken is the CAPTCHA widget's response, when sign-ups require one.
+	CaptchaToken string `json:"captchaToken,omitempty"`
+	// Website is a honeypot: the frontend hides it, so only bots fill it in.
+	Website string `json:"website,omitempty"`
 }
 
 type ErrorResponse struct {
 	Message   string    `json:"message"`
 	Timestamp time.Time `json:"timestamp"`
 	Status    int       `json:"status"`
+	// Code identifies errors clients are expected to handle specially.
+	Code string `json:"code,omitempty"`
 }
 
+// ErrorCodeComplianceReminderRequired is returned when a member tries to turn
+// off a reminder that organization compliance mode makes mandatory.
+const ErrorCodeComplianceReminderRequired = "compliance_reminder_required"
+
+// ErrorCodeRenewalApprovalRequired is returned when a document's expiration
+// date is changed directly while its organization requires renewals to go
+// through an approved renewal request.
+const ErrorCodeRenewalApprovalRequired = "renewal_approval_required"
+
+// ErrorCodeLegalHold is returned when deleting a document under legal hold.
+const ErrorCodeLegalHold = "legal_hold"
+
+// ErrorCodePossibleDuplicate is returned when a new document looks like one
+// the user already has; repeating the request with ?force=true creates it
+// anyway.
+const ErrorCodePossibleDuplicate = "possible_duplicate"
+
 type LoginRequest struct {
 	Email    string `json:"email"`
 	Password string `json:"password"`
@@ -29,6 +52,9 @@ type UserResponse struct {
 	Email       string  `json:"email"`
 	PhoneNumber *string `json:"phoneNumber,omitempty"`
 	Name        string  `json:"name"`
+	// PendingEmail is the address the user asked to switch to, until they
+	// confirm it.
+	PendingEmail *string `json:"pendingEmail,omitempty"`
 }
 
 type DocumentRequest struct {
@@ -38,21 +64,87 @@ type DocumentRequest struct {
 	ExpirationDate time.Time `json:"expirationDate"`
 	Timezone       string    `json:"timezone"`
 	AttachmentURL  *string   `json:"attachmentUrl,omitempty"`
-	Reminders      []string  `json:"reminders"`
+	Category       *string   `json:"category,omitempty"`
+	// RenewalCost is in Currency, an ISO 4217 code.
+	RenewalCost *float64 `json:"renewalCost,omitempty"`
+	Currency    *string  `json:"currency,omitempty"`
+	// IssuerID links the document to the issuer directory; an empty string
+	// unlinks it.
+	IssuerID *string `json:"issuerId,omitempty"`
+	// LeadTimeDays is how long renewing the document takes, overriding the
+	// issuer's processing time; 0 goes back to the issuer's.
+	LeadTimeDays *int `json:"leadTimeDays,omitempty"`
+	// TemplateID names a template of the gallery whose name, category and
+	// reminders fill in those left out when creating a document.
+	TemplateID *string `json:"templateId,omitempty"`
+	// CustomFields sets custom field values by key; a null value removes the
+	// field.
+	CustomFields map[string]*string `json:"customFields,omitempty"`
+	Reminders    []string           `json:"reminders"`
 }
 
 type DocumentResponse struct {
-	ID             string                     `json:"id"`
-	UserID         string                     `json:"userId"`
-	Name           string                     `json:"name"`
-	Description    *string                    `json:"description,omitempty"`
-	Identifier     *string                    `json:"identifier,omitempty"`
-	ExpirationDate string                     `json:"expirationDate"`
-	Timezone       string                     `json:"timezone"`
-	AttachmentURL  *string                    `json:"attachmentUrl,omitempty"`
-	Reminders      []ReminderIntervalResponse `json:"reminders"`
-	CreatedAt      time.Time                  `json:"createdAt"`
-	UpdatedAt      time.Time                  `json:"updatedAt"`
+	ID             string  `json:"id"`
+	UserID         string  `json:"userId"`
+	Name           string  `json:"name"`
+	Description    *string `json:"description,omitempty"`
+	Identifier     *string `json:"identifier,omitempty"`
+	ExpirationDate string  `json:"expirationDate"`
+	Timezone       string  `json:"timezone"`
+	AttachmentURL  *string `json:"attachmentUrl,omitempty"`
+	// AttachmentStatus is the virus scan state of an uploaded attachment.
+	AttachmentStatus *string         `json:"attachmentStatus,omitempty"`
+	AttachmentThreat *string         `json:"attachmentThreat,omitempty"`
+	Category         *string         `json:"category,omitempty"`
+	OrganizationID   *string         `json:"organizationId,omitempty"`
+	RenewalCost      *float64        `json:"renewalCost,omitempty"`
+	Currency         *string         `json:"currency,omitempty"`
+	IssuerID         *string         `json:"issuerId,omitempty"`
+	Issuer           *IssuerResponse `json:"issuer,omitempty"`
+	LeadTimeDays     *int            `json:"leadTimeDays,omitempty"`
+	// ExpiresIn says when the document expires relative to today, in the
+	// request's locale, e.g. "expires in 3 weeks".
+	ExpiresIn    string                     `json:"expiresIn"`
+	Reminders    []ReminderIntervalResponse `json:"reminders"`
+	CustomFields []CustomFieldResponse      `json:"customFields,omitempty"`
+	Checklist    *ChecklistProgress         `json:"checklist,omitempty"`
+	Lock         *DocumentLockResponse      `json:"lock,omitempty"`
+	CreatedAt    time.Time                  `json:"createdAt"`
+	UpdatedAt    time.Time                  `json:"updatedAt"`
+}
+
+type CustomFieldResponse struct {
+	Key   string `json:"key"`
+	Label string `json:"label"`
+	Value string `json:"value"`
+}
+
+type IssuerRequest struct {
+	Name        string  `json:"name"`
+	CountryCode *string `json:"countryCode,omitempty"`
+	RenewalURL  *string `json:"renewalUrl,omitempty"`
+	// ProcessingDays estimates how long the issuer takes to renew a document.
+	ProcessingDays *int `json:"processingDays,omitempty"`
+}
+
+// IssuerResponse is the issuer a document is linked to, with what its
+// reminders say about renewing.
+type IssuerResponse struct {
+	ID             string  `json:"id"`
+	Name           string  `json:"name"`
+	RenewalURL     *string `json:"renewalUrl,omitempty"`
+	ProcessingDays *int    `json:"processingDays,omitempty"`
+}
+
+// RenewalCostResponse is the renewal cost of documents in one currency,
+// either expiring in Month or, without it, over the whole period.
+type RenewalCostResponse struct {
+	Month    string  `json:"month,omitempty"`
+	Currency string  `json:"currency"`
+	Total    float64 `json:"total"`
+	// Formatted is Total formatted for the request's locale.
+	Formatted string `json:"formatted"`
+	Documents int    `json:"documents"`
 }
 
 type ReminderIntervalResponse struct {
@@ -69,6 +161,464 @@ type DocumentReminderIntervalResponse struct {
 	ID      string `json:"id"`
 	Label   string `json:"label"`
 	Enabled bool   `json:"enabled"`
+	// Status is where the reminder stands, one of the db.Reminder* statuses.
+	Status          string     `json:"status"`
+	StatusChangedAt *time.Time `json:"statusChangedAt,omitempty"`
+}
+
+// ReminderPreviewResponse is a reminder as it would reach the caller: emails
+// have Subject and HTML, texts only Text, push notifications Subject and Text.
+type ReminderPreviewResponse struct {
+	Channel string `json:"channel"`
+	Subject string `json:"subject,omitempty"`
+	HTML    string `json:"html,omitempty"`
+	Text    string `json:"text,omitempty"`
+}
+
+type DocumentContactRequest struct {
+	Name  *string `json:"name,omitempty"`
+	Email string  `json:"email"`
+}
+
+type DocumentContactResponse struct {
+	ID           string    `json:"id"`
+	Name         *string   `json:"name,omitempty"`
+	Email        string    `json:"email"`
+	Unsubscribed bool      `json:"unsubscribed"`
+	CreatedAt    time.Time `json:"createdAt"`
+}
+
+type HouseholdRequest struct {
+	Name string `json:"name"`
+}
+
+type HouseholdMemberRequest struct {
+	Email               string `json:"email"`
+	NotificationRouting string `json:"notificationRouting"`
+}
+
+type HouseholdMemberResponse struct {
+	UserID              string    `json:"userId"`
+	Name                string    `json:"name"`
+	Email               string    `json:"email"`
+	Role                string    `json:"role"`
+	NotificationRouting string    `json:"notificationRouting"`
+	JoinedAt            time.Time `json:"joinedAt"`
+}
+
+type HouseholdResponse struct {
+	ID            string                    `json:"id"`
+	Name          string                    `json:"name"`
+	PrimaryUserID string                    `json:"primaryUserId"`
+	Members       []HouseholdMemberResponse `json:"members"`
+	CreatedAt     time.Time                 `json:"createdAt"`
+}
+
+type ChecklistItemRequest struct {
+	Title    *string `json:"title,omitempty"`
+	Done     *bool   `json:"done,omitempty"`
+	Position *int    `json:"position,omitempty"`
+}
+
+type ChecklistItemResponse struct {
+	ID       string     `json:"id"`
+	Position int        `json:"position"`
+	Title    string     `json:"title"`
+	Done     bool       `json:"done"`
+	DoneAt   *time.Time `json:"doneAt,omitempty"`
+}
+
+type ChecklistProgress struct {
+	Total int `json:"total"`
+	Done  int `json:"done"`
+}
+
+// DuplicateDocumentResponse is an existing document a new one may
+// duplicate. Match is "identifier" when the identifiers are the same, "name"
+// when only the names are similar.
+type DuplicateDocumentResponse struct {
+	ID             string  `json:"id"`
+	Name           string  `json:"name"`
+	ExpirationDate string  `json:"expirationDate"`
+	Category       *string `json:"category,omitempty"`
+	Match          string  `json:"match"`
+}
+
+// DuplicateDocumentsResponse is the 409 returned when a new document looks
+// like one the user already has.
+type DuplicateDocumentsResponse struct {
+	ErrorResponse
+	Duplicates []DuplicateDocumentResponse `json:"duplicates"`
+}
+
+type DocumentDependencyRequest struct {
+	DependsOnID string `json:"dependsOnId"`
+}
+
+// DependencyDocumentResponse is a document in a dependency chain.
+type DependencyDocumentResponse struct {
+	ID             string `json:"id"`
+	Name           string `json:"name"`
+	ExpirationDate string `json:"expirationDate"`
+	// Impacted marks a dependent that is valid for longer than the document
+	// it depends on.
+	Impacted bool `json:"impacted,omitempty"`
+}
+
+type DependencyEdgeResponse struct {
+	DocumentID  string `json:"documentId"`
+	DependsOnID string `json:"dependsOnId"`
+}
+
+type DocumentCategoryResponse struct {
+	Slug             string   `json:"slug"`
+	Name             string   `json:"name"`
+	DefaultReminders []string `json:"defaultReminders"`
+}
+
+type DocumentTemplateResponse struct {
+	Slug            string                  `json:"slug"`
+	Name            string                  `json:"name"`
+	Description     string                  `json:"description"`
+	Category        *string                 `json:"category,omitempty"`
+	IdentifierLabel *string                 `json:"identifierLabel,omitempty"`
+	Reminders       []string                `json:"reminders"`
+	Fields          []TemplateFieldResponse `json:"fields"`
+}
+
+type TemplateFieldResponse struct {
+	Key   string `json:"key"`
+	Label string `json:"label"`
+}
+
+type ExpirationSuggestionResponse struct {
+	Category                string  `json:"category"`
+	IssueDate               string  `json:"issueDate"`
+	SuggestedExpirationDate string  `json:"suggestedExpirationDate"`
+	ValidityMonths          int     `json:"validityMonths"`
+	CountryCode             *string `json:"countryCode,omitempty"`
+	Notes                   *string `json:"notes,omitempty"`
+}
+
+type NotificationPreferencesRequest struct {
+	EscalationChannel   string              `json:"escalationChannel"`
+	EscalationAfterDays int                 `json:"escalationAfterDays"`
+	ChannelMatrix       map[string][]string `json:"channelMatrix"`
+	BatchWindowHours    int                 `json:"batchWindowHours"`
+	CalendarInvites     bool                `json:"calendarInvites"`
+	PausedUntil         *time.Time          `json:"pausedUntil"`
+	OptimizeSendTime    bool                `json:"optimizeSendTime"`
+}
+
+type EmailBounceRequest struct {
+	MessageID string `json:"messageId"`
+	Reason    string `json:"reason"`
+}
+
+type WebhookEndpointRequest struct {
+	URL     string   `json:"url"`
+	Events  []string `json:"events"`
+	Enabled *bool    `json:"enabled,omitempty"`
+}
+
+type RotateWebhookSecretRequest struct {
+	// GracePeriodHours is how long the old secret keeps signing deliveries.
+	GracePeriodHours *int `json:"gracePeriodHours,omitempty"`
+}
+
+type WebhookEndpointResponse struct {
+	ID      string   `json:"id"`
+	URL     string   `json:"url"`
+	Events  []string `json:"events"`
+	Enabled bool     `json:"enabled"`
+	// Secret is only returned when it is created or rotated.
+	Secret                  string     `json:"secret,omitempty"`
+	PreviousSecretExpiresAt *time.Time `json:"previousSecretExpiresAt,omitempty"`
+	CreatedAt               time.Time  `json:"createdAt"`
+	UpdatedAt               time.Time  `json:"updatedAt"`
+}
+
+type WebhookDeliveryResponse struct {
+	ID            string          `json:"id"`
+	Event         string          `json:"event"`
+	Status        string          `json:"status"`
+	Attempts      int             `json:"attempts"`
+	ResponseCode  *int            `json:"responseCode,omitempty"`
+	LatencyMs     *int            `json:"latencyMs,omitempty"`
+	Error         *string         `json:"error,omitempty"`
+	RedeliveryOf  *string         `json:"redeliveryOf,omitempty"`
+	Payload       json.RawMessage `json:"payload"`
+	CreatedAt     time.Time       `json:"createdAt"`
+	LastAttemptAt *time.Time      `json:"lastAttemptAt,omitempty"`
+}
+
+type AssignDocumentRequest struct {
+	AssigneeID string `json:"assigneeId"`
+}
+
+// RenewalDecisionRequest is the optional body of a renewal approval or
+// rejection.
+type RenewalDecisionRequest struct {
+	Note *string `json:"note,omitempty"`
+}
+
+type RenewalApprovalSettingsRequest struct {
+	Required bool `json:"required"`
+}
+
+type DocumentLockRequest struct {
+	TTLSeconds *int `json:"ttlSeconds,omitempty"`
+}
+
+type DocumentLockResponse struct {
+	UserID    string    `json:"userId"`
+	UserName  string    `json:"userName"`
+	HeldByYou bool      `json:"heldByYou"`
+	LockedAt  time.Time `json:"lockedAt"`
+	ExpiresAt time.Time `json:"expiresAt"`
+}
+
+type TrashedDocumentResponse struct {
+	ID             string    `json:"id"`
+	UserID         string    `json:"userId"`
+	Name           string    `json:"name"`
+	ExpirationDate string    `json:"expirationDate"`
+	ExpiresIn      string    `json:"expiresIn"`
+	Category       *string   `json:"category,omitempty"`
+	DeletedAt      time.Time `json:"deletedAt"`
+	// PurgeAt is when the document is deleted for good.
+	PurgeAt time.Time `json:"purgeAt"`
+}
+
+type OrganizationRequest struct {
+	Name       string  `json:"name"`
+	DataRegion *string `json:"dataRegion,omitempty"`
+}
+
+type OrganizationMemberRequest struct {
+	Email string `json:"email"`
+	Role  string `json:"role"`
+}
+
+type OrganizationResponse struct {
+	ID                   string    `json:"id"`
+	Name                 string    `json:"name"`
+	DataRegion           *string   `json:"dataRegion,omitempty"`
+	ComplianceMode       bool      `json:"complianceMode"`
+	ComplianceCategories []string  `json:"complianceCategories"`
+	RenewalApproval      bool      `json:"renewalApproval"`
+	Role                 string    `json:"role"`
+	CreatedAt            time.Time `json:"createdAt"`
+}
+
+type ComplianceSettingsRequest struct {
+	Enabled bool `json:"enabled"`
+	// Categories are the document category slugs whose reminders become
+	// mandatory.
+	Categories []string `json:"categories"`
+}
+
+type EmailSenderRequest struct {
+	// FromName defaults to the organization's name.
+	FromName    string `json:"fromName"`
+	FromAddress string `json:"fromAddress"`
+}
+
+// DNSRecord is a DNS record an organization has to publish.
+type DNSRecord struct {
+	Type  string `json:"type"`
+	Name  string `json:"name"`
+	Value string `json:"value"`
+}
+
+type EmailSenderResponse struct {
+	FromName    string `json:"fromName"`
+	FromAddress string `json:"fromAddress"`
+	Domain      string `json:"domain"`
+	// Verified reports whether reminders are sent from this identity yet;
+	// until then they use the default sender.
+	Verified   bool       `json:"verified"`
+	VerifiedAt *time.Time `json:"verifiedAt,omitempty"`
+	CheckedAt  *time.Time `json:"checkedAt,omitempty"`
+	// VerificationRecord is the record proving ownership of Domain.
+	VerificationRecord DNSRecord `json:"verificationRecord"`
+}
+
+// NotificationThemeRequest brands an organization's emails; blank fields keep
+// the default look.
+type NotificationThemeRequest struct {
+	LogoURL         *string `json:"logoUrl,omitempty"`
+	PrimaryColor    *string `json:"primaryColor,omitempty"`
+	BackgroundColor *string `json:"backgroundColor,omitempty"`
+	FooterText      *string `json:"footerText,omitempty"`
+}
+
+type OrganizationMemberResponse struct {
+	UserID   string    `json:"userId"`
+	Name     string    `json:"name"`
+	Email    string    `json:"email"`
+	Role     string    `json:"role"`
+	JoinedAt time.Time `json:"joinedAt"`
+}
+
+type EscalationStepRequest struct {
+	DaysBefore int    `json:"daysBefore"`
+	Audience   string `json:"audience"`
+}
+
+type EscalationPolicyRequest struct {
+	Steps []EscalationStepRequest `json:"steps"`
+}
+
+// RetentionRequest sets how many days an organization keeps its data; null
+// keeps it forever.
+type RetentionRequest struct {
+	ExpiredDocumentsDays    *int `json:"expiredDocumentsDays"`
+	EventLogDays            *int `json:"eventLogDays"`
+	NotificationHistoryDays *int `json:"notificationHistoryDays"`
+}
+
+// LegalHoldRequest places a legal hold, or removes it when Held is false.
+type LegalHoldRequest struct {
+	Held bool `json:"held"`
+}
+
+type LegalHoldResponse struct {
+	Held     bool       `json:"held"`
+	PlacedBy string     `json:"placedBy,omitempty"`
+	PlacedAt *time.Time `json:"placedAt,omitempty"`
+	// OrganizationHeld is set on documents held only because their whole
+	// organization is.
+	OrganizationHeld bool `json:"organizationHeld,omitempty"`
+}
+
+type ImpersonateRequest struct {
+	UserID string `json:"userId"`
+	// Reason is recorded in the audit log, e.g. a support ticket reference.
+	Reason string `json:"reason"`
+}
+
+type SuspendUserRequest struct {
+	// Reason is stored on the account and recorded in the audit log.
+	Reason string `json:"reason"`
+}
+
+type SetUserPlanRequest struct {
+	Plan string `json:"plan"`
+}
+
+type SetUserRoleRequest struct {
+	Role string `json:"role"`
+}
+
+// ChangeEmailRequest asks to switch the account to Email. Password is the
+// current one; accounts that only sign in through OAuth have none.
+type ChangeEmailRequest struct {
+	Email    string `json:"email"`
+	Password string `json:"password,omitempty"`
+}
+
+// DeleteAccountRequest confirms deleting the account with its Password;
+// accounts that only sign in through OAuth have none.
+type DeleteAccountRequest struct {
+	Password string `json:"password,omitempty"`
+}
+
+type AnnouncementRequest struct {
+	Title string `json:"title"`
+	Body  string `json:"body"`
+	Kind  string `json:"kind"`
+	// StartsAt defaults to now; EndsAt to never.
+	StartsAt  *time.Time `json:"startsAt,omitempty"`
+	EndsAt    *time.Time `json:"endsAt,omitempty"`
+	SendEmail bool       `json:"sendEmail"`
+}
+
+type NotificationProviderResponse struct {
+	Channel string `json:"channel"`
+	// Paused is true while the provider keeps failing; messages are queued
+	// until RetryAt, when a single send tests it again.
+	Paused              bool       `json:"paused"`
+	RetryAt             *time.Time `json:"retryAt,omitempty"`
+	ConsecutiveFailures int64      `json:"consecutiveFailures"`
+}
+
+type QuotaUsageResponse struct {
+	Quota string `json:"quota"`
+	Used  int    `json:"used"`
+	// Limit is the cap of the user's plan, or null when there is none.
+	Limit *int `json:"limit"`
+	// Warning is true once Used reaches the warning percentage of Limit.
+	Warning bool `json:"warning"`
+	// ResetsAt is when a monthly quota starts over.
+	ResetsAt *time.Time `json:"resetsAt,omitempty"`
+}
+
+type ShedRequestsResponse struct {
+	Reason string `json:"reason"`
+	// Shed sums DailyShed, keyed by UTC date, over the report window.
+	Shed      int64            `json:"shed"`
+	DailyShed map[string]int64 `json:"dailyShed"`
+}
+
+type FailedTaskResponse struct {
+	ID    string `json:"id"`
+	Queue string `json:"queue"`
+	Type  string `json:"type"`
+	// State is "retry" while the task will be retried at NextRetryAt, and
+	// "archived" once it has run out of retries.
+	State        string          `json:"state"`
+	Payload      json.RawMessage `json:"payload,omitempty"`
+	Retried      int             `json:"retried"`
+	MaxRetry     int             `json:"maxRetry"`
+	LastError    string          `json:"lastError"`
+	LastFailedAt *time.Time      `json:"lastFailedAt,omitempty"`
+	NextRetryAt  *time.Time      `json:"nextRetryAt,omitempty"`
+}
+
+type QueueStatsResponse struct {
+	Queue     string `json:"queue"`
+	Paused    bool   `json:"paused"`
+	Pending   int    `json:"pending"`
+	Active    int    `json:"active"`
+	Scheduled int    `json:"scheduled"`
+	Retry     int    `json:"retry"`
+	Archived  int    `json:"archived"`
+	// ProcessedToday and FailedToday count the task runs of the current UTC
+	// day.
+	ProcessedToday int `json:"processedToday"`
+	FailedToday    int `json:"failedToday"`
+	// LatencySeconds is how long the oldest pending task has been waiting.
+	LatencySeconds float64 `json:"latencySeconds"`
+}
+
+type FiringAlertResponse struct {
+	Name    string    `json:"name"`
+	FiredAt time.Time `json:"firedAt"`
+}
+
+type DeprecatedEndpointResponse struct {
+	Method    string     `json:"method"`
+	Route     string     `json:"route"`
+	Since     time.Time  `json:"since"`
+	Sunset    *time.Time `json:"sunset,omitempty"`
+	Successor string     `json:"successor,omitempty"`
+	// Calls sums DailyCalls, keyed by UTC date, over the report window.
+	Calls      int64            `json:"calls"`
+	DailyCalls map[string]int64 `json:"dailyCalls"`
+	// DistinctCallers estimates how many users (or addresses, for
+	// unauthenticated calls) have called the endpoint since it was last idle
+	// for a whole window.
+	DistinctCallers int64 `json:"distinctCallers"`
+}
+
+// ReadinessResponse reports whether the API can serve requests. Database is
+// "ok", "connecting" while the server waits for it at startup, or
+// "unavailable" when it stops answering.
+type ReadinessResponse struct {
+	Status    string `json:"status"`
+	Database  string `json:"database"`
+	Timestamp string `json:"timestamp"`
 }
 
 func NotFoundError(message string) ErrorResponse {
@@ -119,8 +669,93 @@ func ForbiddenError(message string) ErrorResponse {
 	return errResp
 }
 
+func LockedError(message string) ErrorResponse {
+	var errResp ErrorResponse
+	errResp.Message = message
+	errResp.Status = http.StatusLocked
+	errResp.Timestamp = time.Now()
+	return errResp
+}
+
+func TooManyRequestsError(message string) ErrorResponse {
+	var errResp ErrorResponse
+	errResp.Message = message
+	errResp.Status = http.StatusTooManyRequests
+	errResp.Timestamp = time.Now()
+	return errResp
+}
+
+func ServiceUnavailableError(message string) ErrorResponse {
+	var errResp ErrorResponse
+	errResp.Message = message
+	errResp.Status = http.StatusServiceUnavailable
+	errResp.Timestamp = time.Now()
+	return errResp
+}
+
 func WriteErrorResponse(w http.ResponseWriter, errResp ErrorResponse) {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(errResp.Status)
 	json.NewEncoder(w).Encode(errResp)
 }
+
+type FeedbackRequest struct {
+	Message string `json:"message"`
+	// Score answers "how likely are you to recommend xpired?" from 0 to 10.
+	Score *int `json:"score,omitempty"`
+	// Page is where in the app the feedback was sent from.
+	Page *string `json:"page,omitempty"`
+	// RequestID is the X-Request-Id of a request the feedback is about.
+	RequestID *string `json:"requestId,omitempty"`
+}
+
+// RoadmapFeatureRequest creates a roadmap feature, or updates the fields
+// present.
+type RoadmapFeatureRequest struct {
+	Title       *string `json:"title,omitempty"`
+	Description *string `json:"description,omitempty"`
+	Status      *string `json:"status,omitempty"`
+}
+
+// SessionResponse is a sign-in as listed to its user. Device summarizes the
+// user agent, such as "Firefox on Windows".
+type SessionResponse struct {
+	ID        string    `json:"id"`
+	Device    *string   `json:"device,omitempty"`
+	UserAgent *string   `json:"userAgent,omitempty"`
+	IP        *string   `json:"ip,omitempty"`
+	Country   *string   `json:"country,omitempty"`
+	Region    *string   `json:"region,omitempty"`
+	City      *string   `json:"city,omitempty"`
+	Current   bool      `json:"current"`
+	CreatedAt time.Time `json:"createdAt"`
+	ExpiresAt time.Time `json:"expiresAt"`
+}
+
+// CreateAPIKeyRequest creates an API key limited to Scopes. Without
+// ExpiresAt the key works until it is revoked.
+type CreateAPIKeyRequest struct {
+	Name      string     `json:"name"`
+	Scopes    []string   `json:"scopes"`
+	ExpiresAt *time.Time `json:"expiresAt,omitempty"`
+}
+
+// CreateServiceAccountRequest creates a service account of an organization.
+// Every token it is issued is limited to Scopes.
+type CreateServiceAccountRequest struct {
+	Name   string   `json:"name"`
+	Scopes []string `json:"scopes"`
+}
+
+// LocalePreferencesRequest sets how responses are written for requests that
+// do not name a locale or duration unit. A nil Locale means English.
+type LocalePreferencesRequest struct {
+	Locale       *string `json:"locale"`
+	DurationUnit string  `json:"durationUnit"`
+}
+
+// ReorderDocumentsRequest lists documents in the order the user arranged
+// them.
+type ReorderDocumentsRequest struct {
+	DocumentIDs []string `json:"documentIds"`
+}
@@ -0,0 +1,73 @@
+package api
+
+import (
+	"encoding/json"
+	"net/http"
+	"time"
+
+	"xpired/internal/db"
+	"xpired/internal/fieldcrypt"
+)
+
+const (
+	// duplicateNameSimilarity is the trigram similarity from which an
+	// existing document's name counts as a likely duplicate.
+	duplicateNameSimilarity = 0.6
+	// maxDuplicateSuggestions caps the duplicates listed in a 409 response.
+	maxDuplicateSuggestions = 5
+)
+
+// checkDuplicates rejects the creation of a document that looks like one the
+// user already has, listing the likely duplicates, unless the request is
+// forced. On failure it writes the response and returns false.
+func (h *Handler) checkDuplicates(w http.ResponseWriter, r *http.Request, userID string, req *DocumentRequest) bool {
+	if r.URL.Query().Get("force") == "true" {
+		return true
+	}
+
+	similar, err := h.repo.FindSimilarDocuments(r.Context(), userID, req.Name, req.Identifier, duplicateNameSimilarity, maxDuplicateSuggestions)
+	if err != nil {
+		errResp := InternalServerError("Failed to check for duplicate documents")
+		WriteErrorResponse(w, errResp)
+		return false
+	}
+	if len(similar) == 0 {
+		return true
+	}
+
+	duplicates := make([]DuplicateDocumentResponse, 0, len(similar))
+	for _, doc := range similar {
+		duplicates = append(duplicates, toDuplicateDocumentResponse(doc, req.Identifier))
+	}
+	resp := DuplicateDocumentsResponse{
+		ErrorResponse: ErrorResponse{
+			Message:   "A similar document already exists; repeat the request with ?force=true to create it anyway",
+			Timestamp: time.Now(),
+			Status:    http.StatusConflict,
+			Code:      ErrorCodePossibleDuplicate,
+		},
+		Duplicates: duplicates,
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusConflict)
+	if err := json.NewEncoder(w).Encode(resp); err != nil {
+		errResp := InternalServerError("Failed to encode response")
+		WriteErrorResponse(w, errResp)
+	}
+	return false
+}
+
+func toDuplicateDocumentResponse(doc *db.Document, identifier *string) DuplicateDocumentResponse {
+	match := "name"
+	if identifier != nil && doc.Identifier != nil && fieldcrypt.Normalize(*identifier) == fieldcrypt.Normalize(*doc.Identifier) {
+		match = "identifier"
+	}
+	return DuplicateDocumentResponse{
+		ID:             doc.ID.String(),
+		Name:           doc.Name,
+		ExpirationDate: doc.ExpirationDate.Format("Mon, 2 Jan, 2006"),
+		Category:       doc.Category,
+		Match:          match,
+	}
+}
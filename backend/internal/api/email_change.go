@@ -0,0 +1,203 @@
+package api
+
+import (
+	"encoding/json"
+	"log"
+	"net/http"
+	"net/mail"
+	"strings"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+	"golang.org/x/crypto/bcrypt"
+
+	"xpired/internal/auth"
+	"xpired/internal/db"
+	"xpired/internal/worker"
+)
+
+// ChangeEmailHandler starts switching the account to another address. The
+// address only takes over once it is confirmed from the link emailed there
+// (see ConfirmEmailChangeHandler), so reminders never go to an address nobody
+// confirmed reading. Asking again replaces the pending change.
+func (h *Handler) ChangeEmailHandler(w http.ResponseWriter, r *http.Request) {
+	userID, err := auth.GetUserIDFromContext(r)
+	if err != nil {
+		errResp := UnauthorizedError("Unauthorized")
+		WriteErrorResponse(w, errResp)
+		return
+	}
+	// whoever controls the address controls the account
+	if auth.ImpersonatorFromContext(r.Context()) != "" {
+		errResp := ForbiddenError("The email address cannot be changed while impersonating")
+		WriteErrorResponse(w, errResp)
+		return
+	}
+
+	var req ChangeEmailRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		errResp := BadRequestError("Invalid request body")
+		WriteErrorResponse(w, errResp)
+		return
+	}
+	address, err := mail.ParseAddress(strings.TrimSpace(req.Email))
+	if err != nil {
+		errResp := BadRequestError("Invalid email address")
+		WriteErrorResponse(w, errResp)
+		return
+	}
+	email := address.Address
+
+	user, err := h.repo.GetUserByID(r.Context(), userID)
+	if err != nil {
+		errResp := NotFoundError("User not found")
+		WriteErrorResponse(w, errResp)
+		return
+	}
+	if user.Password != "" && bcrypt.CompareHashAndPassword([]byte(user.Password), []byte(req.Password)) != nil {
+		errResp := ForbiddenError("Incorrect password")
+		WriteErrorResponse(w, errResp)
+		return
+	}
+	if strings.EqualFold(email, user.Email) {
+		errResp := BadRequestError("This is already your email address")
+		WriteErrorResponse(w, errResp)
+		return
+	}
+	if h.disposableDomains.Contains(email) {
+		errResp := BadRequestError("Disposable email addresses cannot be used")
+		WriteErrorResponse(w, errResp)
+		return
+	}
+	if err := h.repo.CheckUserExistsByEmail(r.Context(), email); err == nil {
+		errResp := ConflictError("Email address is already in use")
+		WriteErrorResponse(w, errResp)
+		return
+	}
+
+	if err := h.repo.SetPendingEmail(r.Context(), userID, email); err != nil {
+		errResp := InternalServerError("Failed to change email address")
+		WriteErrorResponse(w, errResp)
+		return
+	}
+	if err := worker.ScheduleEmailConfirmation(r.Context(), userID, email); err != nil {
+		log.Printf("Failed to schedule email confirmation for user %s: %v", userID, err)
+		errResp := InternalServerError("Failed to send confirmation email")
+		WriteErrorResponse(w, errResp)
+		return
+	}
+
+	resp := map[string]interface{}{
+		"message":      "Confirmation link sent to " + email,
+		"pendingEmail": email,
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusAccepted)
+	if err := json.NewEncoder(w).Encode(resp); err != nil {
+		errResp := InternalServerError("Failed to encode response")
+		WriteErrorResponse(w, errResp)
+	}
+}
+
+// loadEmailChange parses the signed token of an email change confirmation
+// link and loads the account it is for.
+func (h *Handler) loadEmailChange(w http.ResponseWriter, r *http.Request) (*auth.EmailChangeClaims, *db.User, bool) {
+	claims, err := auth.ParseEmailChangeToken(chi.URLParam(r, "token"))
+	if err != nil {
+		errResp := UnauthorizedError("This link is invalid or has expired")
+		WriteErrorResponse(w, errResp)
+		return nil, nil, false
+	}
+
+	user, err := h.repo.GetUserByID(r.Context(), claims.Subject)
+	if err != nil {
+		errResp := NotFoundError("User not found")
+		WriteErrorResponse(w, errResp)
+		return nil, nil, false
+	}
+	return claims, user, true
+}
+
+// EmailChangeLinkHandler is public: it shows the address a confirmation link
+// would switch to, for the page that asks to confirm it. It changes nothing,
+// as mail scanners follow links too; ConfirmEmailChangeHandler confirms.
+func (h *Handler) EmailChangeLinkHandler(w http.ResponseWriter, r *http.Request) {
+	claims, user, ok := h.loadEmailChange(w, r)
+	if !ok {
+		return
+	}
+
+	pending, err := h.repo.GetPendingEmail(r.Context(), user.ID.String())
+	if err != nil {
+		errResp := InternalServerError("Failed to check email change")
+		WriteErrorResponse(w, errResp)
+		return
+	}
+
+	resp := map[string]interface{}{
+		"message": "Email change fetched successfully",
+		"email":   claims.Email,
+		"pending": pending != nil && *pending == claims.Email,
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(resp); err != nil {
+		errResp := InternalServerError("Failed to encode response")
+		WriteErrorResponse(w, errResp)
+	}
+}
+
+// ConfirmEmailChangeHandler is public: the signed token from the
+// confirmation email switches its account to the address it was sent to,
+// if that is still the change pending.
+func (h *Handler) ConfirmEmailChangeHandler(w http.ResponseWriter, r *http.Request) {
+	claims, user, ok := h.loadEmailChange(w, r)
+	if !ok {
+		return
+	}
+	userID := claims.Subject
+
+	if err := h.repo.ConfirmEmailChange(r.Context(), userID, claims.Email); err != nil {
+		switch err.Error() {
+		case "email change not found":
+			errResp := NotFoundError("This email change was replaced or already confirmed")
+			WriteErrorResponse(w, errResp)
+		case "email already in use":
+			errResp := ConflictError("Email address is already in use")
+			WriteErrorResponse(w, errResp)
+		default:
+			errResp := InternalServerError("Failed to change email address")
+			WriteErrorResponse(w, errResp)
+		}
+		return
+	}
+
+	metadata, _ := json.Marshal(map[string]interface{}{
+		"from": user.Email,
+		"to":   claims.Email,
+	})
+	entry := &db.AuditLog{
+		ID:         uuid.New(),
+		ActorID:    &userID,
+		UserID:     &userID,
+		Action:     db.AuditActionUserEmailChanged,
+		EntityType: "user",
+		EntityID:   userID,
+		Metadata:   metadata,
+	}
+	if err := h.repo.CreateAuditLog(r.Context(), entry); err != nil {
+		log.Printf("Failed to record email change of user %s in audit log: %v", userID, err)
+	}
+
+	resp := map[string]interface{}{
+		"message": "Email address changed",
+		"email":   claims.Email,
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(resp); err != nil {
+		errResp := InternalServerError("Failed to encode response")
+		WriteErrorResponse(w, errResp)
+	}
+}
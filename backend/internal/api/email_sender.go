@@ -0,0 +1,214 @@
+package api
+
+import (
+	"encoding/json"
+	"log"
+	"net/http"
+	"net/mail"
+	"strings"
+
+	"xpired/internal/db"
+	"xpired/internal/worker"
+)
+
+// maxSenderNameLength caps the display name of a custom email sender.
+const maxSenderNameLength = 100
+
+func toEmailSenderResponse(sender *db.EmailSender) EmailSenderResponse {
+	name, value := worker.SenderVerificationRecord(sender)
+	return EmailSenderResponse{
+		FromName:    sender.FromName,
+		FromAddress: sender.FromAddress,
+		Domain:      sender.Domain(),
+		Verified:    sender.VerifiedAt != nil,
+		VerifiedAt:  sender.VerifiedAt,
+		CheckedAt:   sender.CheckedAt,
+		VerificationRecord: DNSRecord{
+			Type:  "TXT",
+			Name:  name,
+			Value: value,
+		},
+	}
+}
+
+// loadEmailSenderSettings authorizes a change to the email sender of the
+// organization in the URL. On failure it writes the error response and
+// returns false.
+func (h *Handler) loadEmailSenderSettings(w http.ResponseWriter, r *http.Request) (*db.Organization, bool) {
+	org, caller, ok := h.loadOrganizationMembership(w, r)
+	if !ok {
+		return nil, false
+	}
+	if !canManageOrganization(caller) {
+		errResp := ForbiddenError("Only organization owners and admins can manage the email sender")
+		WriteErrorResponse(w, errResp)
+		return nil, false
+	}
+	return org, true
+}
+
+func (h *Handler) GetEmailSenderHandler(w http.ResponseWriter, r *http.Request) {
+	org, ok := h.loadEmailSenderSettings(w, r)
+	if !ok {
+		return
+	}
+
+	sender, err := h.repo.GetEmailSender(r.Context(), org.ID.String())
+	if err != nil {
+		if err.Error() == "email sender not found" {
+			errResp := NotFoundError("Organization has no custom email sender")
+			WriteErrorResponse(w, errResp)
+			return
+		}
+		errResp := InternalServerError("Failed to fetch email sender")
+		WriteErrorResponse(w, errResp)
+		return
+	}
+
+	resp := map[string]interface{}{
+		"message": "Email sender retrieved successfully",
+		"sender":  toEmailSenderResponse(sender),
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(resp); err != nil {
+		errResp := InternalServerError("Failed to encode response")
+		WriteErrorResponse(w, errResp)
+	}
+}
+
+// UpdateEmailSenderHandler sets the From name and address of the
+// organization's reminder emails. The address is only used once its domain
+// publishes the returned verification record, which the worker starts
+// checking right away. Moving to another domain requires verifying it again.
+func (h *Handler) UpdateEmailSenderHandler(w http.ResponseWriter, r *http.Request) {
+	org, ok := h.loadEmailSenderSettings(w, r)
+	if !ok {
+		return
+	}
+
+	var req EmailSenderRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		errResp := BadRequestError("Invalid request body")
+		WriteErrorResponse(w, errResp)
+		return
+	}
+
+	address, err := mail.ParseAddress(strings.TrimSpace(req.FromAddress))
+	if err != nil || address.Name != "" || !strings.Contains(address.Address, "@") {
+		errResp := BadRequestError("fromAddress must be a plain email address")
+		WriteErrorResponse(w, errResp)
+		return
+	}
+	at := strings.LastIndex(address.Address, "@")
+	fromAddress := address.Address[:at] + strings.ToLower(address.Address[at:])
+
+	fromName := strings.TrimSpace(req.FromName)
+	if fromName == "" {
+		fromName = org.Name
+	}
+	if len(fromName) > maxSenderNameLength || strings.ContainsAny(fromName, "\r\n") {
+		errResp := BadRequestError("fromName must be a single line of at most 100 characters")
+		WriteErrorResponse(w, errResp)
+		return
+	}
+
+	token, err := randomToken(24)
+	if err != nil {
+		errResp := InternalServerError("Failed to generate verification token")
+		WriteErrorResponse(w, errResp)
+		return
+	}
+	sender := &db.EmailSender{
+		OrganizationID:    org.ID.String(),
+		FromName:          fromName,
+		FromAddress:       fromAddress,
+		VerificationToken: token,
+	}
+	if err := h.repo.SetEmailSender(r.Context(), sender); err != nil {
+		errResp := InternalServerError("Failed to save email sender")
+		WriteErrorResponse(w, errResp)
+		return
+	}
+
+	if sender.VerifiedAt == nil {
+		if err := worker.ScheduleSenderVerification(r.Context(), org.ID.String()); err != nil {
+			log.Printf("Failed to schedule sender verification for organization %s: %v", org.ID.String(), err)
+		}
+	}
+
+	resp := map[string]interface{}{
+		"message": "Email sender saved successfully",
+		"sender":  toEmailSenderResponse(sender),
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(resp); err != nil {
+		errResp := InternalServerError("Failed to encode response")
+		WriteErrorResponse(w, errResp)
+	}
+}
+
+// DeleteEmailSenderHandler goes back to sending the organization's reminders
+// from the default sender.
+func (h *Handler) DeleteEmailSenderHandler(w http.ResponseWriter, r *http.Request) {
+	org, ok := h.loadEmailSenderSettings(w, r)
+	if !ok {
+		return
+	}
+
+	if err := h.repo.DeleteEmailSender(r.Context(), org.ID.String()); err != nil {
+		errResp := InternalServerError("Failed to delete email sender")
+		WriteErrorResponse(w, errResp)
+		return
+	}
+
+	resp := map[string]interface{}{
+		"message": "Email sender removed",
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(resp); err != nil {
+		errResp := InternalServerError("Failed to encode response")
+		WriteErrorResponse(w, errResp)
+	}
+}
+
+// VerifyEmailSenderHandler queues a fresh check of the sender's verification
+// record, e.g. after the automatic checks gave up or the record was changed.
+func (h *Handler) VerifyEmailSenderHandler(w http.ResponseWriter, r *http.Request) {
+	org, ok := h.loadEmailSenderSettings(w, r)
+	if !ok {
+		return
+	}
+
+	sender, err := h.repo.GetEmailSender(r.Context(), org.ID.String())
+	if err != nil {
+		if err.Error() == "email sender not found" {
+			errResp := NotFoundError("Organization has no custom email sender")
+			WriteErrorResponse(w, errResp)
+			return
+		}
+		errResp := InternalServerError("Failed to fetch email sender")
+		WriteErrorResponse(w, errResp)
+		return
+	}
+
+	if err := worker.ScheduleSenderVerification(r.Context(), org.ID.String()); err != nil {
+		errResp := InternalServerError("Failed to schedule verification")
+		WriteErrorResponse(w, errResp)
+		return
+	}
+
+	resp := map[string]interface{}{
+		"message": "Verification of the email sender domain scheduled",
+		"sender":  toEmailSenderResponse(sender),
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusAccepted)
+	if err := json.NewEncoder(w).Encode(resp); err != nil {
+		errResp := InternalServerError("Failed to encode response")
+		WriteErrorResponse(w, errResp)
+	}
+}
@@ -0,0 +1,114 @@
+package api
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"xpired/internal/db"
+)
+
+// maxEscalationDaysBefore matches the longest reminder interval worth
+// escalating on.
+const maxEscalationDaysBefore = 365
+
+func validEscalationAudience(audience string) bool {
+	switch audience {
+	case db.EscalationAudienceOwner, db.EscalationAudienceManagers, db.EscalationAudienceEveryone:
+		return true
+	}
+	return false
+}
+
+func (h *Handler) GetEscalationPolicyHandler(w http.ResponseWriter, r *http.Request) {
+	org, _, ok := h.loadOrganizationMembership(w, r)
+	if !ok {
+		return
+	}
+
+	steps, err := h.repo.GetEscalationPolicy(r.Context(), org.ID.String())
+	if err != nil {
+		errResp := InternalServerError("Failed to fetch escalation policy")
+		WriteErrorResponse(w, errResp)
+		return
+	}
+
+	resp := map[string]interface{}{
+		"message": "Escalation policy fetched successfully",
+		"steps":   steps,
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(resp); err != nil {
+		errResp := InternalServerError("Failed to encode response")
+		WriteErrorResponse(w, errResp)
+	}
+}
+
+// UpdateEscalationPolicyHandler replaces the organization's escalation
+// chain. An empty list of steps turns it off, so reminders go to the
+// document's owner or assignee only.
+func (h *Handler) UpdateEscalationPolicyHandler(w http.ResponseWriter, r *http.Request) {
+	org, caller, ok := h.loadOrganizationMembership(w, r)
+	if !ok {
+		return
+	}
+	if !canManageOrganization(caller) {
+		errResp := ForbiddenError("Only organization owners and admins can change the escalation policy")
+		WriteErrorResponse(w, errResp)
+		return
+	}
+
+	var req EscalationPolicyRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		errResp := BadRequestError("Invalid request body")
+		WriteErrorResponse(w, errResp)
+		return
+	}
+
+	steps := []*db.EscalationStep{}
+	seen := map[int]bool{}
+	for _, step := range req.Steps {
+		if step.DaysBefore < 0 || step.DaysBefore > maxEscalationDaysBefore {
+			errResp := BadRequestError(fmt.Sprintf("daysBefore must be between 0 and %d", maxEscalationDaysBefore))
+			WriteErrorResponse(w, errResp)
+			return
+		}
+		if !validEscalationAudience(step.Audience) {
+			errResp := BadRequestError("audience must be one of owner, managers, everyone")
+			WriteErrorResponse(w, errResp)
+			return
+		}
+		if seen[step.DaysBefore] {
+			errResp := BadRequestError("Each step must have a different daysBefore")
+			WriteErrorResponse(w, errResp)
+			return
+		}
+		seen[step.DaysBefore] = true
+		steps = append(steps, &db.EscalationStep{DaysBefore: step.DaysBefore, Audience: step.Audience})
+	}
+
+	if err := h.repo.SetEscalationPolicy(r.Context(), org.ID.String(), steps); err != nil {
+		errResp := InternalServerError("Failed to update escalation policy")
+		WriteErrorResponse(w, errResp)
+		return
+	}
+
+	steps, err := h.repo.GetEscalationPolicy(r.Context(), org.ID.String())
+	if err != nil {
+		errResp := InternalServerError("Failed to fetch escalation policy")
+		WriteErrorResponse(w, errResp)
+		return
+	}
+
+	resp := map[string]interface{}{
+		"message": "Escalation policy updated successfully",
+		"steps":   steps,
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(resp); err != nil {
+		errResp := InternalServerError("Failed to encode response")
+		WriteErrorResponse(w, errResp)
+	}
+}
@@ -0,0 +1,94 @@
+package api
+
+import (
+	"encoding/json"
+	"log"
+	"net/http"
+
+	"github.com/google/uuid"
+
+	"xpired/internal/auth"
+	"xpired/internal/db"
+	"xpired/internal/worker"
+)
+
+const (
+	maxFeedbackLength  = 5000
+	maxFeedbackContext = 500
+)
+
+// CreateFeedbackHandler stores a message or NPS score sent from the app and
+// has a worker relay it to the team's email and Slack, when configured.
+func (h *Handler) CreateFeedbackHandler(w http.ResponseWriter, r *http.Request) {
+	userID, err := auth.GetUserIDFromContext(r)
+	if err != nil {
+		errResp := UnauthorizedError("Unauthorized")
+		WriteErrorResponse(w, errResp)
+		return
+	}
+
+	var req FeedbackRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		errResp := BadRequestError("Invalid request body")
+		WriteErrorResponse(w, errResp)
+		return
+	}
+	if req.Message == "" && req.Score == nil {
+		errResp := BadRequestError("message or score is required")
+		WriteErrorResponse(w, errResp)
+		return
+	}
+	if len(req.Message) > maxFeedbackLength {
+		errResp := BadRequestError("message must be at most 5000 characters")
+		WriteErrorResponse(w, errResp)
+		return
+	}
+	if req.Score != nil && (*req.Score < 0 || *req.Score > 10) {
+		errResp := BadRequestError("score must be between 0 and 10")
+		WriteErrorResponse(w, errResp)
+		return
+	}
+	if (req.Page != nil && len(*req.Page) > maxFeedbackContext) || (req.RequestID != nil && len(*req.RequestID) > maxFeedbackContext) {
+		errResp := BadRequestError("page and requestId must be at most 500 characters")
+		WriteErrorResponse(w, errResp)
+		return
+	}
+
+	feedback := &db.Feedback{
+		ID:        uuid.New(),
+		UserID:    &userID,
+		Message:   req.Message,
+		Score:     req.Score,
+		Page:      req.Page,
+		RequestID: req.RequestID,
+	}
+	if userAgent := r.UserAgent(); userAgent != "" {
+		if len(userAgent) > maxFeedbackContext {
+			userAgent = userAgent[:maxFeedbackContext]
+		}
+		feedback.UserAgent = &userAgent
+	}
+	if err := h.repo.CreateFeedback(r.Context(), feedback); err != nil {
+		errResp := InternalServerError("Failed to save feedback")
+		WriteErrorResponse(w, errResp)
+		return
+	}
+
+	if h.cfg.Feedback.Email != "" || h.cfg.Feedback.SlackWebhookURL != "" {
+		if err := worker.ScheduleFeedbackRelay(r.Context(), feedback.ID.String()); err != nil {
+			log.Printf("Failed to schedule relay of feedback %s: %v", feedback.ID.String(), err)
+		}
+	}
+
+	resp := map[string]interface{}{
+		"message":  "Thanks for your feedback",
+		"feedback": feedback,
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusCreated)
+	if err := json.NewEncoder(w).Encode(resp); err != nil {
+		errResp := InternalServerError("Failed to encode response")
+		WriteErrorResponse(w, errResp)
+	}
+}
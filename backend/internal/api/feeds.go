@@ -0,0 +1,254 @@
+package api
+
+import (
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"net/http"
+	"sort"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+
+	"xpired/internal/auth"
+	"xpired/internal/db"
+)
+
+type atomLink struct {
+	Href string `xml:"href,attr"`
+	Rel  string `xml:"rel,attr,omitempty"`
+}
+
+type atomEntry struct {
+	Title   string   `xml:"title"`
+	ID      string   `xml:"id"`
+	Link    atomLink `xml:"link"`
+	Updated string   `xml:"updated"`
+	Summary string   `xml:"summary"`
+}
+
+type atomFeed struct {
+	XMLName xml.Name    `xml:"http://www.w3.org/2005/Atom feed"`
+	Title   string      `xml:"title"`
+	ID      string      `xml:"id"`
+	Link    []atomLink  `xml:"link"`
+	Updated string      `xml:"updated"`
+	Entries []atomEntry `xml:"entry"`
+}
+
+type rssItem struct {
+	Title       string `xml:"title"`
+	Link        string `xml:"link"`
+	GUID        string `xml:"guid"`
+	PubDate     string `xml:"pubDate"`
+	Description string `xml:"description"`
+}
+
+type rssChannel struct {
+	Title       string    `xml:"title"`
+	Link        string    `xml:"link"`
+	Description string    `xml:"description"`
+	Items       []rssItem `xml:"item"`
+}
+
+type rssFeed struct {
+	XMLName xml.Name   `xml:"rss"`
+	Version string     `xml:"version,attr"`
+	Channel rssChannel `xml:"channel"`
+}
+
+func (h *Handler) feedURLs(token string) map[string]string {
+	base := h.cfg.App.BaseURL + "/api/feeds/" + token
+	return map[string]string{
+		"atom": base + "/atom.xml",
+		"rss":  base + "/rss.xml",
+	}
+}
+
+func (h *Handler) GetFeedHandler(w http.ResponseWriter, r *http.Request) {
+	userID, err := auth.GetUserIDFromContext(r)
+	if err != nil {
+		errResp := UnauthorizedError("Unauthorized")
+		WriteErrorResponse(w, errResp)
+		return
+	}
+
+	token, err := h.repo.GetFeedToken(r.Context(), userID)
+	if err != nil {
+		errResp := NotFoundError("Feed not enabled")
+		WriteErrorResponse(w, errResp)
+		return
+	}
+
+	resp := map[string]interface{}{
+		"message": "Feed fetched successfully",
+		"feeds":   h.feedURLs(token),
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(resp); err != nil {
+		errResp := InternalServerError("Failed to encode response")
+		WriteErrorResponse(w, errResp)
+	}
+}
+
+// RotateFeedTokenHandler enables the feed, or replaces its token so that
+// previously shared feed URLs stop working.
+func (h *Handler) RotateFeedTokenHandler(w http.ResponseWriter, r *http.Request) {
+	userID, err := auth.GetUserIDFromContext(r)
+	if err != nil {
+		errResp := UnauthorizedError("Unauthorized")
+		WriteErrorResponse(w, errResp)
+		return
+	}
+
+	token, err := randomToken(32)
+	if err != nil {
+		errResp := InternalServerError("Failed to generate feed token")
+		WriteErrorResponse(w, errResp)
+		return
+	}
+	if err := h.repo.SetFeedToken(r.Context(), userID, token); err != nil {
+		errResp := InternalServerError("Failed to save feed token")
+		WriteErrorResponse(w, errResp)
+		return
+	}
+
+	resp := map[string]interface{}{
+		"message": "Feed token generated successfully",
+		"feeds":   h.feedURLs(token),
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(resp); err != nil {
+		errResp := InternalServerError("Failed to encode response")
+		WriteErrorResponse(w, errResp)
+	}
+}
+
+func (h *Handler) DeleteFeedTokenHandler(w http.ResponseWriter, r *http.Request) {
+	userID, err := auth.GetUserIDFromContext(r)
+	if err != nil {
+		errResp := UnauthorizedError("Unauthorized")
+		WriteErrorResponse(w, errResp)
+		return
+	}
+
+	if err := h.repo.DeleteFeedToken(r.Context(), userID); err != nil {
+		errResp := InternalServerError("Failed to disable feed")
+		WriteErrorResponse(w, errResp)
+		return
+	}
+
+	resp := map[string]interface{}{
+		"message": "Feed disabled successfully",
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(resp); err != nil {
+		errResp := InternalServerError("Failed to encode response")
+		WriteErrorResponse(w, errResp)
+	}
+}
+
+// upcomingDocuments resolves a feed token to the user's documents that have
+// not expired yet, soonest first.
+func (h *Handler) upcomingDocuments(w http.ResponseWriter, r *http.Request) ([]*db.Document, bool) {
+	userID, err := h.repo.GetUserIDByFeedToken(r.Context(), chi.URLParam(r, "token"))
+	if err != nil {
+		errResp := NotFoundError("Feed not found")
+		WriteErrorResponse(w, errResp)
+		return nil, false
+	}
+
+	documents, err := h.repo.ListDocumentsByUserID(r.Context(), userID)
+	if err != nil {
+		errResp := InternalServerError("Failed to fetch documents")
+		WriteErrorResponse(w, errResp)
+		return nil, false
+	}
+
+	today := time.Now().UTC().Truncate(24 * time.Hour)
+	var upcoming []*db.Document
+	for _, doc := range documents {
+		if !doc.ExpirationDate.Before(today) {
+			upcoming = append(upcoming, doc)
+		}
+	}
+	sort.Slice(upcoming, func(i, j int) bool {
+		return upcoming[i].ExpirationDate.Before(upcoming[j].ExpirationDate)
+	})
+	return upcoming, true
+}
+
+func feedEntryTitle(doc *db.Document) string {
+	days := int(time.Until(doc.ExpirationDate).Hours() / 24)
+	switch {
+	case days <= 0:
+		return doc.Name + " expires today"
+	case days == 1:
+		return doc.Name + " expires tomorrow"
+	default:
+		return fmt.Sprintf("%s expires in %d days", doc.Name, days)
+	}
+}
+
+func feedEntrySummary(doc *db.Document) string {
+	return doc.Name + " expires on " + doc.ExpirationDate.Format("January 2, 2006") + "."
+}
+
+func (h *Handler) AtomFeedHandler(w http.ResponseWriter, r *http.Request) {
+	documents, ok := h.upcomingDocuments(w, r)
+	if !ok {
+		return
+	}
+
+	feed := atomFeed{
+		Title:   "xpired: upcoming expirations",
+		ID:      h.cfg.App.BaseURL + r.URL.Path,
+		Link:    []atomLink{{Href: h.cfg.App.BaseURL + r.URL.Path, Rel: "self"}, {Href: h.cfg.App.FrontendURL}},
+		Updated: time.Now().UTC().Format(time.RFC3339),
+	}
+	for _, doc := range documents {
+		feed.Entries = append(feed.Entries, atomEntry{
+			Title:   feedEntryTitle(doc),
+			ID:      "urn:uuid:" + doc.ID.String(),
+			Link:    atomLink{Href: h.cfg.App.FrontendURL + "/documents/" + doc.ID.String()},
+			Updated: doc.UpdatedAt.UTC().Format(time.RFC3339),
+			Summary: feedEntrySummary(doc),
+		})
+	}
+
+	w.Header().Set("Content-Type", "application/atom+xml; charset=utf-8")
+	w.Write([]byte(xml.Header))
+	xml.NewEncoder(w).Encode(feed)
+}
+
+func (h *Handler) RSSFeedHandler(w http.ResponseWriter, r *http.Request) {
+	documents, ok := h.upcomingDocuments(w, r)
+	if !ok {
+		return
+	}
+
+	feed := rssFeed{
+		Version: "2.0",
+		Channel: rssChannel{
+			Title:       "xpired: upcoming expirations",
+			Link:        h.cfg.App.FrontendURL,
+			Description: "Documents that are coming up for renewal",
+		},
+	}
+	for _, doc := range documents {
+		feed.Channel.Items = append(feed.Channel.Items, rssItem{
+			Title:       feedEntryTitle(doc),
+			Link:        h.cfg.App.FrontendURL + "/documents/" + doc.ID.String(),
+			GUID:        doc.ID.String(),
+			PubDate:     doc.UpdatedAt.UTC().Format(time.RFC1123Z),
+			Description: feedEntrySummary(doc),
+		})
+	}
+
+	w.Header().Set("Content-Type", "application/rss+xml; charset=utf-8")
+	w.Write([]byte(xml.Header))
+	xml.NewEncoder(w).Encode(feed)
+}
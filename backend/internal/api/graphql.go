@@ -0,0 +1,262 @@
+package api
+
+import (
+	"encoding/json"
+	"errors"
+	"log"
+	"net/http"
+
+	"github.com/graphql-go/graphql"
+
+	"xpired/internal/auth"
+	"xpired/internal/db"
+)
+
+type graphQLRequest struct {
+	Query         string                 `json:"query"`
+	OperationName string                 `json:"operationName"`
+	Variables     map[string]interface{} `json:"variables"`
+}
+
+// documentReminderNode pairs a document reminder with its interval for the
+// DocumentReminder GraphQL type.
+type documentReminderNode struct {
+	Interval *db.ReminderInterval
+	Reminder *db.DocumentReminder
+}
+
+// newGraphQLSchema builds the read-only schema served at /api/graphql. Every
+// resolver is scoped to the authenticated user.
+func (h *Handler) newGraphQLSchema() (graphql.Schema, error) {
+	reminderIntervalType := graphql.NewObject(graphql.ObjectConfig{
+		Name: "ReminderInterval",
+		Fields: graphql.Fields{
+			"id":         &graphql.Field{Type: graphql.NewNonNull(graphql.String), Resolve: field(func(i *db.ReminderInterval) interface{} { return i.IdLabel })},
+			"label":      &graphql.Field{Type: graphql.NewNonNull(graphql.String), Resolve: field(func(i *db.ReminderInterval) interface{} { return i.Label })},
+			"daysBefore": &graphql.Field{Type: graphql.NewNonNull(graphql.Int), Resolve: field(func(i *db.ReminderInterval) interface{} { return i.DaysBefore })},
+		},
+	})
+
+	documentReminderType := graphql.NewObject(graphql.ObjectConfig{
+		Name: "DocumentReminder",
+		Fields: graphql.Fields{
+			"interval":        &graphql.Field{Type: graphql.NewNonNull(reminderIntervalType), Resolve: field(func(n *documentReminderNode) interface{} { return n.Interval })},
+			"enabled":         &graphql.Field{Type: graphql.NewNonNull(graphql.Boolean), Resolve: field(func(n *documentReminderNode) interface{} { return n.Reminder.Enabled })},
+			"sentAt":          &graphql.Field{Type: graphql.DateTime, Resolve: field(func(n *documentReminderNode) interface{} { return n.Reminder.SentAt })},
+			"status":          &graphql.Field{Type: graphql.NewNonNull(graphql.String), Resolve: field(func(n *documentReminderNode) interface{} { return n.Reminder.Status })},
+			"statusChangedAt": &graphql.Field{Type: graphql.DateTime, Resolve: field(func(n *documentReminderNode) interface{} { return n.Reminder.StatusChangedAt })},
+		},
+	})
+
+	notificationType := graphql.NewObject(graphql.ObjectConfig{
+		Name: "Notification",
+		Fields: graphql.Fields{
+			"id":         &graphql.Field{Type: graphql.NewNonNull(graphql.ID), Resolve: field(func(l *db.NotificationLog) interface{} { return l.ID.String() })},
+			"documentId": &graphql.Field{Type: graphql.NewNonNull(graphql.ID), Resolve: field(func(l *db.NotificationLog) interface{} { return l.DocumentID })},
+			"channel":    &graphql.Field{Type: graphql.NewNonNull(graphql.String), Resolve: field(func(l *db.NotificationLog) interface{} { return l.Channel })},
+			"status":     &graphql.Field{Type: graphql.NewNonNull(graphql.String), Resolve: field(func(l *db.NotificationLog) interface{} { return l.Status })},
+			"openedAt":   &graphql.Field{Type: graphql.DateTime, Resolve: field(func(l *db.NotificationLog) interface{} { return l.OpenedAt })},
+			"bouncedAt":  &graphql.Field{Type: graphql.DateTime, Resolve: field(func(l *db.NotificationLog) interface{} { return l.BouncedAt })},
+			"readAt":     &graphql.Field{Type: graphql.DateTime, Resolve: field(func(l *db.NotificationLog) interface{} { return l.ReadAt })},
+			"requestId":  &graphql.Field{Type: graphql.String, Resolve: field(func(l *db.NotificationLog) interface{} { return l.RequestID })},
+			"createdAt":  &graphql.Field{Type: graphql.NewNonNull(graphql.DateTime), Resolve: field(func(l *db.NotificationLog) interface{} { return l.CreatedAt })},
+		},
+	})
+
+	limitArgs := graphql.FieldConfigArgument{
+		"limit": &graphql.ArgumentConfig{Type: graphql.Int, DefaultValue: 50},
+	}
+
+	documentType := graphql.NewObject(graphql.ObjectConfig{
+		Name: "Document",
+		Fields: graphql.Fields{
+			"id":             &graphql.Field{Type: graphql.NewNonNull(graphql.ID), Resolve: field(func(d *db.Document) interface{} { return d.ID.String() })},
+			"name":           &graphql.Field{Type: graphql.NewNonNull(graphql.String), Resolve: field(func(d *db.Document) interface{} { return d.Name })},
+			"description":    &graphql.Field{Type: graphql.String, Resolve: field(func(d *db.Document) interface{} { return d.Description })},
+			"identifier":     &graphql.Field{Type: graphql.String, Resolve: field(func(d *db.Document) interface{} { return d.Identifier })},
+			"expirationDate": &graphql.Field{Type: graphql.NewNonNull(graphql.DateTime), Resolve: field(func(d *db.Document) interface{} { return d.ExpirationDate })},
+			"timezone":       &graphql.Field{Type: graphql.NewNonNull(graphql.String), Resolve: field(func(d *db.Document) interface{} { return d.Timezone })},
+			"attachmentUrl":  &graphql.Field{Type: graphql.String, Resolve: field(func(d *db.Document) interface{} { return d.AttachmentURL })},
+			"category":       &graphql.Field{Type: graphql.String, Resolve: field(func(d *db.Document) interface{} { return d.Category })},
+			"renewalCost":    &graphql.Field{Type: graphql.Float, Resolve: field(func(d *db.Document) interface{} { return d.RenewalCost })},
+			"currency":       &graphql.Field{Type: graphql.String, Resolve: field(func(d *db.Document) interface{} { return d.Currency })},
+			"createdAt":      &graphql.Field{Type: graphql.NewNonNull(graphql.DateTime), Resolve: field(func(d *db.Document) interface{} { return d.CreatedAt })},
+			"updatedAt":      &graphql.Field{Type: graphql.NewNonNull(graphql.DateTime), Resolve: field(func(d *db.Document) interface{} { return d.UpdatedAt })},
+			"expiresIn": &graphql.Field{
+				Type: graphql.NewNonNull(graphql.String),
+				Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+					return h.expiresIn(p.Context, p.Source.(*db.Document).ExpirationDate), nil
+				},
+			},
+			"reminders": &graphql.Field{
+				Type: graphql.NewList(graphql.NewNonNull(documentReminderType)),
+				Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+					doc := p.Source.(*db.Document)
+					reminders, err := h.repo.GetDocumentRemindersByDocumentID(p.Context, doc.ID.String())
+					if err != nil {
+						return nil, err
+					}
+					var nodes []*documentReminderNode
+					for _, reminder := range reminders {
+						interval, err := h.repo.GetReminderIntervalByID(p.Context, reminder.ReminderIntervalID)
+						if err != nil {
+							continue
+						}
+						nodes = append(nodes, &documentReminderNode{Interval: interval, Reminder: reminder})
+					}
+					return nodes, nil
+				},
+			},
+			"notifications": &graphql.Field{
+				Type: graphql.NewList(graphql.NewNonNull(notificationType)),
+				Args: limitArgs,
+				Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+					doc := p.Source.(*db.Document)
+					return h.repo.ListNotificationLogs(p.Context, doc.UserID.String(), doc.ID.String(), graphQLLimit(p))
+				},
+			},
+		},
+	})
+
+	userType := graphql.NewObject(graphql.ObjectConfig{
+		Name: "User",
+		Fields: graphql.Fields{
+			"id":          &graphql.Field{Type: graphql.NewNonNull(graphql.ID), Resolve: field(func(u *db.User) interface{} { return u.ID.String() })},
+			"email":       &graphql.Field{Type: graphql.NewNonNull(graphql.String), Resolve: field(func(u *db.User) interface{} { return u.Email })},
+			"name":        &graphql.Field{Type: graphql.NewNonNull(graphql.String), Resolve: field(func(u *db.User) interface{} { return u.Name })},
+			"phoneNumber": &graphql.Field{Type: graphql.String, Resolve: field(func(u *db.User) interface{} { return u.PhoneNumber })},
+			"documents": &graphql.Field{
+				Type: graphql.NewList(graphql.NewNonNull(documentType)),
+				Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+					return h.repo.ListDocumentsByUserID(p.Context, p.Source.(*db.User).ID.String())
+				},
+			},
+		},
+	})
+
+	queryType := graphql.NewObject(graphql.ObjectConfig{
+		Name: "Query",
+		Fields: graphql.Fields{
+			"me": &graphql.Field{
+				Type: userType,
+				Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+					userID, err := auth.UserIDFromContext(p.Context)
+					if err != nil {
+						return nil, err
+					}
+					return h.repo.GetUserByID(p.Context, userID)
+				},
+			},
+			"documents": &graphql.Field{
+				Type: graphql.NewList(graphql.NewNonNull(documentType)),
+				Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+					userID, err := auth.UserIDFromContext(p.Context)
+					if err != nil {
+						return nil, err
+					}
+					return h.repo.ListDocumentsByUserID(p.Context, userID)
+				},
+			},
+			"document": &graphql.Field{
+				Type: documentType,
+				Args: graphql.FieldConfigArgument{
+					"id": &graphql.ArgumentConfig{Type: graphql.NewNonNull(graphql.ID)},
+				},
+				Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+					userID, err := auth.UserIDFromContext(p.Context)
+					if err != nil {
+						return nil, err
+					}
+					doc, err := h.repo.GetDocumentByID(p.Context, p.Args["id"].(string))
+					if err != nil || !h.canManageDocument(p.Context, doc, userID) {
+						return nil, errors.New("document not found")
+					}
+					return doc, nil
+				},
+			},
+			"reminderIntervals": &graphql.Field{
+				Type: graphql.NewList(graphql.NewNonNull(reminderIntervalType)),
+				Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+					return h.repo.GetAllReminderIntervals(p.Context)
+				},
+			},
+			"notifications": &graphql.Field{
+				Type: graphql.NewList(graphql.NewNonNull(notificationType)),
+				Args: limitArgs,
+				Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+					userID, err := auth.UserIDFromContext(p.Context)
+					if err != nil {
+						return nil, err
+					}
+					return h.repo.ListNotificationLogs(p.Context, userID, "", graphQLLimit(p))
+				},
+			},
+		},
+	})
+
+	return graphql.NewSchema(graphql.SchemaConfig{Query: queryType})
+}
+
+// field adapts a typed getter into a resolver over the field's source value.
+func field[T any](get func(T) interface{}) graphql.FieldResolveFn {
+	return func(p graphql.ResolveParams) (interface{}, error) {
+		source, ok := p.Source.(T)
+		if !ok {
+			return nil, nil
+		}
+		return get(source), nil
+	}
+}
+
+// graphQLLimit reads the "limit" argument, capped at 200.
+func graphQLLimit(p graphql.ResolveParams) int {
+	limit, _ := p.Args["limit"].(int)
+	if limit <= 0 || limit > 200 {
+		return 200
+	}
+	return limit
+}
+
+// GraphQLHandler returns the handler for /api/graphql. The schema is built
+// once, up front, so a broken schema fails at startup.
+func (h *Handler) GraphQLHandler() http.HandlerFunc {
+	schema, err := h.newGraphQLSchema()
+	if err != nil {
+		log.Fatalf("Failed to build GraphQL schema: %v", err)
+	}
+
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req graphQLRequest
+		if r.Method == http.MethodGet {
+			req.Query = r.URL.Query().Get("query")
+			req.OperationName = r.URL.Query().Get("operationName")
+			if raw := r.URL.Query().Get("variables"); raw != "" {
+				json.Unmarshal([]byte(raw), &req.Variables)
+			}
+		} else if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			errResp := BadRequestError("Invalid request body")
+			WriteErrorResponse(w, errResp)
+			return
+		}
+
+		if req.Query == "" {
+			errResp := BadRequestError("query is required")
+			WriteErrorResponse(w, errResp)
+			return
+		}
+
+		result := graphql.Do(graphql.Params{
+			Schema:         schema,
+			RequestString:  req.Query,
+			OperationName:  req.OperationName,
+			VariableValues: req.Variables,
+			Context:        r.Context(),
+		})
+
+		w.Header().Set("Content-Type", "application/json")
+		if err := json.NewEncoder(w).Encode(result); err != nil {
+			errResp := InternalServerError("Failed to encode response")
+			WriteErrorResponse(w, errResp)
+		}
+	}
+}
@@ -1,27 +1,92 @@
 package api
 
 import (
+	"context"
 	"encoding/json"
+	"log"
 	"net/http"
+	"strings"
 	"time"
 
 	"github.com/go-chi/chi/v5"
 	"github.com/google/uuid"
+	"github.com/redis/go-redis/v9"
 	"golang.org/x/crypto/bcrypt"
 
 	"xpired/internal/auth"
+	"xpired/internal/auth/oauth"
+	"xpired/internal/config"
 	"xpired/internal/db"
+	"xpired/internal/geoip"
+	"xpired/internal/password"
+	"xpired/internal/screening"
+	"xpired/internal/storage"
 	worker "xpired/internal/worker"
 )
 
+// captchaTimeout bounds the CAPTCHA check a sign-up waits for.
+const captchaTimeout = 10 * time.Second
+
+// breachCheckTimeout bounds the breached-password lookup of a new password.
+const breachCheckTimeout = 5 * time.Second
+
 type Handler struct {
-	repo db.Repository
+	repo  db.Repository
+	cfg   *config.Config
+	store storage.Storage
+	// cache holds short-lived values shared across API replicas, such as
+	// unread notification counts.
+	cache *redis.Client
+	// deprecations lists the endpoints wrapped with Deprecated.
+	deprecations []Deprecation
+	// disposableDomains and captcha screen sign-ups; captcha is nil when
+	// CAPTCHA verification is off.
+	disposableDomains screening.DomainList
+	captcha           screening.Verifier
+	// geoip locates the IP addresses sessions come from; nil when no
+	// database is configured.
+	geoip *geoip.DB
+	// oauth holds the providers users can sign in with, by name.
+	oauth map[string]*oauth.Provider
+	// passwordPolicy is what new passwords must satisfy.
+	passwordPolicy *password.Policy
 }
 
-func NewHandler(repo db.Repository) *Handler {
-	return &Handler{
-		repo: repo,
+func NewHandler(repo db.Repository, cfg *config.Config, store storage.Storage) *Handler {
+	h := &Handler{
+		repo:  repo,
+		cfg:   cfg,
+		store: store,
+		cache: redis.NewClient(&redis.Options{
+			Addr:     cfg.Redis.Addr,
+			Password: cfg.Redis.Password,
+			DB:       cfg.Redis.DB,
+		}),
+		disposableDomains: screening.NewDomainList(cfg.Registration.DisposableEmailDomains),
+		oauth:             oauthProviders(cfg),
+	}
+	var breaches *password.BreachChecker
+	if cfg.Password.BreachCheck {
+		breaches = password.NewBreachChecker(cfg.Password.BreachRangeURL, worker.EgressClient(cfg.Egress, breachCheckTimeout))
+	}
+	h.passwordPolicy = password.NewPolicy(cfg.Password.MinLength, cfg.Password.MinCharacterClasses, breaches)
+	if path := cfg.Registration.DisposableEmailDomainsFile; path != "" {
+		if err := h.disposableDomains.LoadFile(path); err != nil {
+			log.Printf("Failed to load disposable email domains: %v", err)
+		}
+	}
+	if path := cfg.GeoIP.DBFile; path != "" {
+		locator, err := geoip.Open(path)
+		if err != nil {
+			log.Printf("Failed to load GeoIP database: %v", err)
+		} else {
+			h.geoip = locator
+		}
 	}
+	if cfg.Registration.CaptchaSecret != "" {
+		h.captcha = screening.NewSiteVerify(cfg.Registration.CaptchaVerifyURL, cfg.Registration.CaptchaSecret, worker.EgressClient(cfg.Egress, captchaTimeout))
+	}
+	return h
 }
 
 func (h *Handler) HealthHandler(w http.ResponseWriter, r *http.Request) {
@@ -38,6 +103,19 @@ func (h *Handler) HealthHandler(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
+// JWKSHandler publishes the public keys tokens are signed with, so other
+// services can verify them without the HMAC secret.
+func JWKSHandler(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+	// verifiers refetch the set on an unknown kid, so caching briefly does
+	// not hold up a key rotation
+	w.Header().Set("Cache-Control", "public, max-age=300")
+	w.WriteHeader(http.StatusOK)
+	if err := json.NewEncoder(w).Encode(auth.PublicKeys()); err != nil {
+		http.Error(w, "Failed to encode response", http.StatusInternalServerError)
+	}
+}
+
 func (h *Handler) RegisterHandler(w http.ResponseWriter, r *http.Request) {
 	var req UserRequest
 	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
@@ -45,15 +123,22 @@ func (h *Handler) RegisterHandler(w http.ResponseWriter, r *http.Request) {
 		WriteErrorResponse(w, errResp)
 		return
 	}
-	hashedPassword, err := bcrypt.GenerateFromPassword([]byte(req.Password), bcrypt.DefaultCost)
-	if err != nil {
-		errResp := InternalServerError("Failed to hash password")
+	if req.Email == "" || req.Password == "" || req.Name == "" {
+		errResp := BadRequestError("Missing required fields")
 		WriteErrorResponse(w, errResp)
 		return
 	}
+	// before screening, so a rejected password does not use up the CAPTCHA
+	if !h.checkPassword(w, r, req.Password) {
+		return
+	}
+	if !h.screenRegistration(w, r, &req) {
+		return
+	}
 
-	if req.Email == "" || req.Password == "" || req.Name == "" {
-		errResp := BadRequestError("Missing required fields")
+	hashedPassword, err := bcrypt.GenerateFromPassword([]byte(req.Password), bcrypt.DefaultCost)
+	if err != nil {
+		errResp := InternalServerError("Failed to hash password")
 		WriteErrorResponse(w, errResp)
 		return
 	}
@@ -79,12 +164,18 @@ func (h *Handler) RegisterHandler(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	token, err := auth.GenerateToken(newUser.ID)
+	token, claims, err := h.generateToken(r.Context(), newUser)
 	if err != nil {
 		errResp := InternalServerError("Failed to generate token")
 		WriteErrorResponse(w, errResp)
 		return
 	}
+	if err := h.recordSession(r, claims); err != nil {
+		log.Printf("Failed to record session of user %s: %v", claims.Subject, err)
+		errResp := InternalServerError("Failed to create session")
+		WriteErrorResponse(w, errResp)
+		return
+	}
 
 	http.SetCookie(w, &http.Cookie{
 		Name:     "auth",
@@ -123,25 +214,50 @@ func (h *Handler) LoginHandler(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	subjects := h.loginSubjects(r, req.Email)
+	if lockout := h.loginLockout(r.Context(), subjects); lockout > 0 {
+		writeLoginLockout(w, lockout)
+		return
+	}
+
+	// unknown emails count as failures too, so lockouts do not tell which
+	// emails have accounts
 	user, err := h.repo.GetUserByEmail(r.Context(), req.Email)
+	if err == nil {
+		err = bcrypt.CompareHashAndPassword([]byte(user.Password), []byte(req.Password))
+	}
 	if err != nil {
+		if lockout := h.recordLoginFailure(r.Context(), subjects); lockout > 0 {
+			writeLoginLockout(w, lockout)
+			return
+		}
 		errResp := UnauthorizedError("Invalid email or password")
 		WriteErrorResponse(w, errResp)
 		return
 	}
+	h.clearLoginFailures(r.Context(), subjects)
 
-	if err := bcrypt.CompareHashAndPassword([]byte(user.Password), []byte(req.Password)); err != nil {
-		errResp := UnauthorizedError("Invalid email or password")
+	if user.SuspendedAt != nil {
+		errResp := ForbiddenError("Account suspended")
 		WriteErrorResponse(w, errResp)
 		return
 	}
+	if !h.ensureNotDeactivated(w, r, user.ID.String()) {
+		return
+	}
 
-	token, err := auth.GenerateToken(user.ID)
+	token, claims, err := h.generateToken(r.Context(), user)
 	if err != nil {
 		errResp := InternalServerError("Failed to generate token")
 		WriteErrorResponse(w, errResp)
 		return
 	}
+	if err := h.recordSession(r, claims); err != nil {
+		log.Printf("Failed to record session of user %s: %v", claims.Subject, err)
+		errResp := InternalServerError("Failed to create session")
+		WriteErrorResponse(w, errResp)
+		return
+	}
 
 	http.SetCookie(w, &http.Cookie{
 		Name:     "auth",
@@ -191,11 +307,18 @@ func (h *Handler) UserProfileHandler(w http.ResponseWriter, r *http.Request) {
 		Name:        user.Name,
 		PhoneNumber: user.PhoneNumber,
 	}
+	if userResp.PendingEmail, err = h.repo.GetPendingEmail(r.Context(), userID); err != nil {
+		log.Printf("Failed to load pending email of user %s: %v", userID, err)
+	}
 
 	resp := map[string]interface{}{
 		"message": "User Profile",
 		"user":    userResp,
 	}
+	// Lets the frontend show a banner while support staff act as the user.
+	if adminID := auth.ImpersonatorFromContext(r.Context()); adminID != "" {
+		resp["impersonatedBy"] = adminID
+	}
 
 	w.Header().Set("Content-Type", "application/json")
 	if err := json.NewEncoder(w).Encode(resp); err != nil {
@@ -204,7 +327,37 @@ func (h *Handler) UserProfileHandler(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
+// LogoutHandler signs the session of the request out, so its token stops
+// working even where it was copied from the cookie.
 func (h *Handler) LogoutHandler(w http.ResponseWriter, r *http.Request) {
+	userID, err := auth.GetUserIDFromContext(r)
+	if err != nil {
+		errResp := UnauthorizedError("Unauthorized")
+		WriteErrorResponse(w, errResp)
+		return
+	}
+	if sessionID := auth.SessionIDFromContext(r.Context()); sessionID != "" {
+		session, err := h.repo.RevokeSession(r.Context(), userID, sessionID)
+		if err == nil {
+			h.cacheRevokedSessions(r.Context(), []*db.Session{session})
+		} else if err.Error() != "session not found" {
+			log.Printf("Failed to revoke session %s of user %s: %v", sessionID, userID, err)
+		}
+	}
+	clearAuthCookie(w)
+
+	resp := map[string]interface{}{
+		"message": "Logout Successful",
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(resp); err != nil {
+		errResp := InternalServerError("Failed to encode response")
+		WriteErrorResponse(w, errResp)
+	}
+}
+
+func clearAuthCookie(w http.ResponseWriter) {
 	http.SetCookie(w, &http.Cookie{
 		Name:     "auth",
 		Value:    "",
@@ -214,16 +367,25 @@ func (h *Handler) LogoutHandler(w http.ResponseWriter, r *http.Request) {
 		MaxAge:   0,
 		Path:     "/",
 	})
+}
 
-	resp := map[string]interface{}{
-		"message": "Logout Successful",
-	}
+// humanizedDocument is a document as responses carry it, with when it
+// expires said as DocumentResponse.ExpiresIn.
+type humanizedDocument struct {
+	*db.Document
+	ExpiresIn string `json:"expiresIn"`
+}
 
-	w.Header().Set("Content-Type", "application/json")
-	if err := json.NewEncoder(w).Encode(resp); err != nil {
-		errResp := InternalServerError("Failed to encode response")
-		WriteErrorResponse(w, errResp)
+func (h *Handler) humanizeDocument(ctx context.Context, doc *db.Document) humanizedDocument {
+	return humanizedDocument{Document: doc, ExpiresIn: h.expiresIn(ctx, doc.ExpirationDate)}
+}
+
+func (h *Handler) humanizeDocuments(ctx context.Context, documents []*db.Document) []humanizedDocument {
+	humanized := []humanizedDocument{}
+	for _, doc := range documents {
+		humanized = append(humanized, h.humanizeDocument(ctx, doc))
 	}
+	return humanized
 }
 
 func (h *Handler) ListDocumentsHandler(w http.ResponseWriter, r *http.Request) {
@@ -241,7 +403,26 @@ func (h *Handler) ListDocumentsHandler(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	documents, err := h.repo.ListDocumentsByUserID(r.Context(), userID)
+	sortOrder := r.URL.Query().Get("sort")
+	if sortOrder != "" && sortOrder != "custom" {
+		errResp := BadRequestError("sort must be custom")
+		WriteErrorResponse(w, errResp)
+		return
+	}
+
+	var documents []*db.Document
+	if identifier := r.URL.Query().Get("identifier"); identifier != "" {
+		documents, err = h.repo.FindDocumentsByIdentifier(r.Context(), userID, identifier)
+	} else {
+		documents, err = h.repo.ListDocumentsByUserID(r.Context(), userID)
+	}
+	if err != nil {
+		errResp := InternalServerError("Failed to fetch documents")
+		WriteErrorResponse(w, errResp)
+		return
+	}
+
+	items, err := h.documentListItems(r.Context(), userID, documents, sortOrder == "custom")
 	if err != nil {
 		errResp := InternalServerError("Failed to fetch documents")
 		WriteErrorResponse(w, errResp)
@@ -250,7 +431,36 @@ func (h *Handler) ListDocumentsHandler(w http.ResponseWriter, r *http.Request) {
 
 	resp := map[string]interface{}{
 		"message":   "List of Documents",
-		"documents": documents,
+		"documents": items,
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(resp); err != nil {
+		errResp := InternalServerError("Failed to encode response")
+		WriteErrorResponse(w, errResp)
+	}
+}
+
+// DocumentStatsHandler returns counts of the user's documents, read from the
+// counters kept alongside document writes rather than counted.
+func (h *Handler) DocumentStatsHandler(w http.ResponseWriter, r *http.Request) {
+	userID, err := auth.GetUserIDFromContext(r)
+	if err != nil {
+		errResp := UnauthorizedError("Unauthorized")
+		WriteErrorResponse(w, errResp)
+		return
+	}
+
+	stats, err := h.repo.GetDocumentStats(r.Context(), userID)
+	if err != nil {
+		errResp := InternalServerError("Failed to fetch document stats")
+		WriteErrorResponse(w, errResp)
+		return
+	}
+
+	resp := map[string]interface{}{
+		"message": "Document stats fetched successfully",
+		"stats":   stats,
 	}
 
 	w.Header().Set("Content-Type", "application/json")
@@ -281,12 +491,54 @@ func (h *Handler) CreateDocumentHandler(w http.ResponseWriter, r *http.Request)
 		return
 	}
 
+	templateFields, msg := h.applyTemplate(r, &req)
+	if msg != "" {
+		errResp := BadRequestError(msg)
+		WriteErrorResponse(w, errResp)
+		return
+	}
+
 	if req.Name == "" || req.ExpirationDate.IsZero() || req.Timezone == "" {
 		errResp := BadRequestError("Missing required fields")
 		WriteErrorResponse(w, errResp)
 		return
 	}
 
+	if req.Category != nil {
+		category, err := h.repo.GetDocumentCategory(r.Context(), *req.Category)
+		if err != nil {
+			errResp := BadRequestError("Unknown document category")
+			WriteErrorResponse(w, errResp)
+			return
+		}
+		if len(req.Reminders) == 0 {
+			req.Reminders = category.DefaultReminders
+		}
+	}
+
+	customFields, msg := mergeCustomFields(templateFields, req.CustomFields)
+	if msg != "" {
+		errResp := BadRequestError(msg)
+		WriteErrorResponse(w, errResp)
+		return
+	}
+
+	if !h.checkDuplicates(w, r, userID, &req) {
+		return
+	}
+
+	quota, err := worker.DocumentQuotaUsage(r.Context(), h.repo, userID)
+	if err != nil {
+		errResp := InternalServerError("Failed to check document quota")
+		WriteErrorResponse(w, errResp)
+		return
+	}
+	if quota.Reached() {
+		errResp := ForbiddenError("You have reached the document limit of your plan")
+		WriteErrorResponse(w, errResp)
+		return
+	}
+
 	newDoc := &db.Document{
 		ID:             uuid.New(),
 		UserID:         uuid.MustParse(userID),
@@ -296,9 +548,25 @@ func (h *Handler) CreateDocumentHandler(w http.ResponseWriter, r *http.Request)
 		ExpirationDate: req.ExpirationDate,
 		Timezone:       req.Timezone,
 		AttachmentURL:  req.AttachmentURL,
+		Category:       req.Category,
 		CreatedAt:      time.Now(),
 		UpdatedAt:      time.Now(),
 	}
+	if msg := applyRenewalCost(newDoc, req.RenewalCost, req.Currency); msg != "" {
+		errResp := BadRequestError(msg)
+		WriteErrorResponse(w, errResp)
+		return
+	}
+	if msg := h.applyIssuer(r, newDoc, req.IssuerID); msg != "" {
+		errResp := BadRequestError(msg)
+		WriteErrorResponse(w, errResp)
+		return
+	}
+	if msg := applyLeadTime(newDoc, req.LeadTimeDays); msg != "" {
+		errResp := BadRequestError(msg)
+		WriteErrorResponse(w, errResp)
+		return
+	}
 
 	err = h.repo.CreateDocument(r.Context(), newDoc)
 	if err != nil {
@@ -306,6 +574,16 @@ func (h *Handler) CreateDocumentHandler(w http.ResponseWriter, r *http.Request)
 		WriteErrorResponse(w, errResp)
 		return
 	}
+	quota.Used++
+	worker.WarnNearQuota(r.Context(), h.repo, userID, quota)
+
+	if len(customFields) > 0 {
+		if err := h.repo.SetDocumentCustomFields(r.Context(), newDoc.ID.String(), customFields); err != nil {
+			errResp := InternalServerError("Failed to save custom fields")
+			WriteErrorResponse(w, errResp)
+			return
+		}
+	}
 
 	reminderIntervals, err := h.repo.GetReminderIntervalsFromIdLabels(r.Context(), req.Reminders)
 	if err != nil {
@@ -340,24 +618,36 @@ func (h *Handler) CreateDocumentHandler(w http.ResponseWriter, r *http.Request)
 	expiryDate := time.Date(expiryDateTime.Year(), expiryDateTime.Month(), expiryDateTime.Day(), 0, 0, 0, 0, expiryDateTime.Location())
 
 	doc := &DocumentResponse{
-		ID:             newDoc.ID.String(),
-		UserID:         newDoc.UserID.String(),
-		Name:           newDoc.Name,
-		Description:    newDoc.Description,
-		Identifier:     newDoc.Identifier,
-		ExpirationDate: expiryDate.Format("Mon, 2 Jan, 2006"),
-		Timezone:       newDoc.Timezone,
-		AttachmentURL:  newDoc.AttachmentURL,
-		Reminders:      reminders,
-		CreatedAt:      newDoc.CreatedAt,
-		UpdatedAt:      newDoc.UpdatedAt,
+		ID:               newDoc.ID.String(),
+		UserID:           newDoc.UserID.String(),
+		Name:             newDoc.Name,
+		Description:      newDoc.Description,
+		Identifier:       newDoc.Identifier,
+		ExpirationDate:   expiryDate.Format("Mon, 2 Jan, 2006"),
+		Timezone:         newDoc.Timezone,
+		AttachmentURL:    newDoc.AttachmentURL,
+		AttachmentStatus: newDoc.AttachmentStatus,
+		AttachmentThreat: newDoc.AttachmentThreat,
+		Category:         newDoc.Category,
+		OrganizationID:   newDoc.OrganizationID,
+		RenewalCost:      newDoc.RenewalCost,
+		Currency:         newDoc.Currency,
+		IssuerID:         newDoc.IssuerID,
+		LeadTimeDays:     newDoc.LeadTimeDays,
+		ExpiresIn:        h.expiresIn(r.Context(), newDoc.ExpirationDate),
+		Reminders:        reminders,
+		CustomFields:     customFieldResponses(customFields),
+		CreatedAt:        newDoc.CreatedAt,
+		UpdatedAt:        newDoc.UpdatedAt,
 	}
 
 	var reminderValues []db.ReminderInterval
 	for _, interval := range reminderIntervals {
 		reminderValues = append(reminderValues, *interval)
 	}
-	worker.ScheduleReminders(*newDoc, uuid.MustParse(userID), reminderValues)
+	worker.ScheduleReminders(r.Context(), *newDoc, uuid.MustParse(userID), reminderValues)
+	h.scheduleLeadTimeReminder(r.Context(), newDoc)
+	worker.EmitWebhookEvent(r.Context(), userID, db.WebhookEventDocumentCreated, newDoc)
 
 	resp := map[string]interface{}{
 		"message":  "Document created successfully",
@@ -400,7 +690,7 @@ func (h *Handler) GetDocumentHandler(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	if doc.UserID.String() != userID {
+	if !h.canManageDocument(r.Context(), doc, userID) {
 		errResp := ForbiddenError("Forbidden")
 		WriteErrorResponse(w, errResp)
 		return
@@ -425,18 +715,45 @@ func (h *Handler) GetDocumentHandler(w http.ResponseWriter, r *http.Request) {
 		}
 	}
 
+	checklist, err := h.repo.ListChecklistItems(r.Context(), documentId)
+	if err != nil {
+		errResp := InternalServerError("Failed to fetch checklist")
+		WriteErrorResponse(w, errResp)
+		return
+	}
+
+	customFields, err := h.repo.ListDocumentCustomFields(r.Context(), documentId)
+	if err != nil {
+		errResp := InternalServerError("Failed to fetch custom fields")
+		WriteErrorResponse(w, errResp)
+		return
+	}
+
 	docResp := &DocumentResponse{
-		ID:             doc.ID.String(),
-		UserID:         doc.UserID.String(),
-		Name:           doc.Name,
-		Description:    doc.Description,
-		Identifier:     doc.Identifier,
-		ExpirationDate: doc.ExpirationDate.Format("Mon, 2 Jan, 2006"),
-		Timezone:       doc.Timezone,
-		AttachmentURL:  doc.AttachmentURL,
-		Reminders:      rems,
-		CreatedAt:      doc.CreatedAt,
-		UpdatedAt:      doc.UpdatedAt,
+		ID:               doc.ID.String(),
+		UserID:           doc.UserID.String(),
+		Name:             doc.Name,
+		Description:      doc.Description,
+		Identifier:       doc.Identifier,
+		ExpirationDate:   doc.ExpirationDate.Format("Mon, 2 Jan, 2006"),
+		Timezone:         doc.Timezone,
+		AttachmentURL:    doc.AttachmentURL,
+		AttachmentStatus: doc.AttachmentStatus,
+		AttachmentThreat: doc.AttachmentThreat,
+		Category:         doc.Category,
+		OrganizationID:   doc.OrganizationID,
+		RenewalCost:      doc.RenewalCost,
+		Currency:         doc.Currency,
+		IssuerID:         doc.IssuerID,
+		LeadTimeDays:     doc.LeadTimeDays,
+		ExpiresIn:        h.expiresIn(r.Context(), doc.ExpirationDate),
+		Reminders:        rems,
+		Issuer:           h.documentIssuer(r.Context(), doc),
+		CustomFields:     customFieldResponses(customFields),
+		Checklist:        checklistProgress(checklist),
+		Lock:             h.documentLock(r.Context(), doc.ID.String(), userID),
+		CreatedAt:        doc.CreatedAt,
+		UpdatedAt:        doc.UpdatedAt,
 	}
 
 	resp := map[string]interface{}{
@@ -479,11 +796,14 @@ func (h *Handler) UpdateDocumentHandler(w http.ResponseWriter, r *http.Request)
 		return
 	}
 
-	if doc.UserID.String() != userID {
+	if !h.canManageDocument(r.Context(), doc, userID) {
 		errResp := ForbiddenError("Forbidden")
 		WriteErrorResponse(w, errResp)
 		return
 	}
+	if !h.ensureDocumentUnlocked(w, r, doc.ID.String(), userID) {
+		return
+	}
 	var req DocumentRequest
 	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
 		errResp := BadRequestError("Invalid request body")
@@ -500,7 +820,11 @@ func (h *Handler) UpdateDocumentHandler(w http.ResponseWriter, r *http.Request)
 	if req.Identifier != nil {
 		doc.Identifier = req.Identifier
 	}
+	previousExpiration := doc.ExpirationDate
 	if !req.ExpirationDate.IsZero() {
+		if !sameDate(req.ExpirationDate, doc.ExpirationDate) && !h.ensureDirectRenewalAllowed(w, r, doc) {
+			return
+		}
 		doc.ExpirationDate = req.ExpirationDate
 	}
 	if req.Timezone != "" {
@@ -509,6 +833,43 @@ func (h *Handler) UpdateDocumentHandler(w http.ResponseWriter, r *http.Request)
 	if req.AttachmentURL != nil {
 		doc.AttachmentURL = req.AttachmentURL
 	}
+	if req.Category != nil {
+		if _, err := h.repo.GetDocumentCategory(r.Context(), *req.Category); err != nil {
+			errResp := BadRequestError("Unknown document category")
+			WriteErrorResponse(w, errResp)
+			return
+		}
+		doc.Category = req.Category
+	}
+	if msg := applyRenewalCost(doc, req.RenewalCost, req.Currency); msg != "" {
+		errResp := BadRequestError(msg)
+		WriteErrorResponse(w, errResp)
+		return
+	}
+	if msg := h.applyIssuer(r, doc, req.IssuerID); msg != "" {
+		errResp := BadRequestError(msg)
+		WriteErrorResponse(w, errResp)
+		return
+	}
+	if msg := applyLeadTime(doc, req.LeadTimeDays); msg != "" {
+		errResp := BadRequestError(msg)
+		WriteErrorResponse(w, errResp)
+		return
+	}
+	customFields, err := h.repo.ListDocumentCustomFields(r.Context(), documentId)
+	if err != nil {
+		errResp := InternalServerError("Failed to fetch custom fields")
+		WriteErrorResponse(w, errResp)
+		return
+	}
+	if req.CustomFields != nil {
+		var msg string
+		if customFields, msg = mergeCustomFields(customFields, req.CustomFields); msg != "" {
+			errResp := BadRequestError(msg)
+			WriteErrorResponse(w, errResp)
+			return
+		}
+	}
 	doc.UpdatedAt = time.Now()
 
 	err = h.repo.UpdateDocument(r.Context(), doc)
@@ -517,6 +878,18 @@ func (h *Handler) UpdateDocumentHandler(w http.ResponseWriter, r *http.Request)
 		WriteErrorResponse(w, errResp)
 		return
 	}
+	if req.CustomFields != nil {
+		if err := h.repo.SetDocumentCustomFields(r.Context(), doc.ID.String(), customFields); err != nil {
+			errResp := InternalServerError("Failed to save custom fields")
+			WriteErrorResponse(w, errResp)
+			return
+		}
+	}
+	worker.EmitWebhookEvent(r.Context(), doc.UserID.String(), db.WebhookEventDocumentUpdated, doc)
+	h.scheduleLeadTimeReminder(r.Context(), doc)
+	if !sameDate(previousExpiration, doc.ExpirationDate) {
+		worker.ScheduleDependentsNotice(r.Context(), *doc, previousExpiration)
+	}
 
 	reminderIntervals, err := h.repo.GetReminderIntervalsFromIdLabels(r.Context(), req.Reminders)
 	if err != nil {
@@ -547,18 +920,32 @@ func (h *Handler) UpdateDocumentHandler(w http.ResponseWriter, r *http.Request)
 		}
 	}
 
+	checklist, _ := h.repo.ListChecklistItems(r.Context(), doc.ID.String())
+
 	updatedDoc := &DocumentResponse{
-		ID:             doc.ID.String(),
-		UserID:         doc.UserID.String(),
-		Name:           doc.Name,
-		Description:    doc.Description,
-		Identifier:     doc.Identifier,
-		ExpirationDate: doc.ExpirationDate.Format("Mon, 2 Jan, 2006"),
-		Timezone:       doc.Timezone,
-		AttachmentURL:  doc.AttachmentURL,
-		Reminders:      reminders,
-		CreatedAt:      doc.CreatedAt,
-		UpdatedAt:      doc.UpdatedAt,
+		ID:               doc.ID.String(),
+		UserID:           doc.UserID.String(),
+		Name:             doc.Name,
+		Description:      doc.Description,
+		Identifier:       doc.Identifier,
+		ExpirationDate:   doc.ExpirationDate.Format("Mon, 2 Jan, 2006"),
+		Timezone:         doc.Timezone,
+		AttachmentURL:    doc.AttachmentURL,
+		AttachmentStatus: doc.AttachmentStatus,
+		AttachmentThreat: doc.AttachmentThreat,
+		Category:         doc.Category,
+		OrganizationID:   doc.OrganizationID,
+		RenewalCost:      doc.RenewalCost,
+		Currency:         doc.Currency,
+		IssuerID:         doc.IssuerID,
+		LeadTimeDays:     doc.LeadTimeDays,
+		ExpiresIn:        h.expiresIn(r.Context(), doc.ExpirationDate),
+		Reminders:        reminders,
+		CustomFields:     customFieldResponses(customFields),
+		Checklist:        checklistProgress(checklist),
+		Lock:             h.documentLock(r.Context(), doc.ID.String(), userID),
+		CreatedAt:        doc.CreatedAt,
+		UpdatedAt:        doc.UpdatedAt,
 	}
 
 	resp := map[string]interface{}{
@@ -601,17 +988,25 @@ func (h *Handler) DeleteDocumentHandler(w http.ResponseWriter, r *http.Request)
 		return
 	}
 
-	if doc.UserID.String() != userID {
+	if !h.canManageDocument(r.Context(), doc, userID) {
 		errResp := ForbiddenError("Forbidden")
 		WriteErrorResponse(w, errResp)
 		return
 	}
+	if !h.ensureDocumentUnlocked(w, r, doc.ID.String(), userID) {
+		return
+	}
+	if !h.ensureNotOnLegalHold(w, r, doc) {
+		return
+	}
 	err = h.repo.DeleteDocument(r.Context(), documentId)
 	if err != nil {
 		errResp := InternalServerError("Failed to delete document")
 		WriteErrorResponse(w, errResp)
 		return
 	}
+	h.recordDocumentAudit(r.Context(), userID, doc, db.AuditActionDocumentTrashed)
+	worker.EmitWebhookEvent(r.Context(), doc.UserID.String(), db.WebhookEventDocumentDeleted, doc)
 
 	w.WriteHeader(http.StatusNoContent)
 }
@@ -673,7 +1068,7 @@ func (h *Handler) GetDocumentRemindersHandler(w http.ResponseWriter, r *http.Req
 		return
 	}
 
-	if doc.UserID.String() != userID {
+	if !h.canManageDocument(r.Context(), doc, userID) {
 		errResp := ForbiddenError("Forbidden")
 		WriteErrorResponse(w, errResp)
 		return
@@ -691,9 +1086,11 @@ func (h *Handler) GetDocumentRemindersHandler(w http.ResponseWriter, r *http.Req
 		interval, err := h.repo.GetReminderIntervalByID(r.Context(), reminder.ReminderIntervalID)
 		if err == nil {
 			remInterval := DocumentReminderIntervalResponse{
-				ID:      interval.IdLabel,
-				Label:   interval.Label,
-				Enabled: reminder.Enabled,
+				ID:              interval.IdLabel,
+				Label:           interval.Label,
+				Enabled:         reminder.Enabled,
+				Status:          reminder.Status,
+				StatusChangedAt: reminder.StatusChangedAt,
 			}
 			rems = append(rems, remInterval)
 		}
@@ -746,7 +1143,7 @@ func (h *Handler) ToggleDocumentReminderHandler(w http.ResponseWriter, r *http.R
 		return
 	}
 
-	if doc.UserID.String() != userID {
+	if !h.canManageDocument(r.Context(), doc, userID) {
 		errResp := ForbiddenError("Forbidden")
 		WriteErrorResponse(w, errResp)
 		return
@@ -766,6 +1163,22 @@ func (h *Handler) ToggleDocumentReminderHandler(w http.ResponseWriter, r *http.R
 		return
 	}
 	reminderInterval := reminderIntervals[0]
+
+	if !req.Enabled {
+		required, err := h.repo.IsComplianceDocument(r.Context(), doc)
+		if err != nil {
+			errResp := InternalServerError("Failed to check organization compliance policy")
+			WriteErrorResponse(w, errResp)
+			return
+		}
+		if required {
+			errResp := ForbiddenError("Reminders on compliance documents cannot be turned off")
+			errResp.Code = ErrorCodeComplianceReminderRequired
+			WriteErrorResponse(w, errResp)
+			return
+		}
+	}
+
 	err = h.repo.ToggleDocumentReminder(r.Context(), doc.ID.String(), reminderInterval.ID, req.Enabled)
 	if err != nil {
 		errResp := InternalServerError("Failed to toggle document reminder")
@@ -783,3 +1196,75 @@ func (h *Handler) ToggleDocumentReminderHandler(w http.ResponseWriter, r *http.R
 		WriteErrorResponse(w, errResp)
 	}
 }
+
+func (h *Handler) GetDocumentCategoriesHandler(w http.ResponseWriter, r *http.Request) {
+	categories, err := h.repo.ListDocumentCategories(r.Context())
+	if err != nil {
+		errResp := InternalServerError("Failed to fetch document categories")
+		WriteErrorResponse(w, errResp)
+		return
+	}
+
+	var respCategories []DocumentCategoryResponse
+	for _, category := range categories {
+		respCategories = append(respCategories, DocumentCategoryResponse{
+			Slug:             category.Slug,
+			Name:             category.Name,
+			DefaultReminders: category.DefaultReminders,
+		})
+	}
+
+	resp := map[string]interface{}{
+		"message":    "List of Document Categories",
+		"categories": respCategories,
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(resp); err != nil {
+		errResp := InternalServerError("Failed to encode response")
+		WriteErrorResponse(w, errResp)
+	}
+}
+
+func (h *Handler) SuggestExpirationHandler(w http.ResponseWriter, r *http.Request) {
+	slug := chi.URLParam(r, "slug")
+
+	issueDate := time.Now().UTC().Truncate(24 * time.Hour)
+	if raw := r.URL.Query().Get("issueDate"); raw != "" {
+		parsed, err := time.Parse("2006-01-02", raw)
+		if err != nil {
+			errResp := BadRequestError("issueDate must be formatted as YYYY-MM-DD")
+			WriteErrorResponse(w, errResp)
+			return
+		}
+		issueDate = parsed
+	}
+	country := strings.ToUpper(r.URL.Query().Get("country"))
+
+	period, err := h.repo.GetValidityPeriod(r.Context(), slug, country)
+	if err != nil {
+		errResp := NotFoundError("No typical validity period known for this category")
+		WriteErrorResponse(w, errResp)
+		return
+	}
+
+	suggestion := ExpirationSuggestionResponse{
+		Category:                period.CategorySlug,
+		IssueDate:               issueDate.Format("2006-01-02"),
+		SuggestedExpirationDate: issueDate.AddDate(0, period.ValidityMonths, 0).Format("2006-01-02"),
+		ValidityMonths:          period.ValidityMonths,
+		CountryCode:             period.CountryCode,
+		Notes:                   period.Notes,
+	}
+
+	resp := map[string]interface{}{
+		"message":    "Expiration date suggestion",
+		"suggestion": suggestion,
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(resp); err != nil {
+		errResp := InternalServerError("Failed to encode response")
+		WriteErrorResponse(w, errResp)
+	}
+}
@@ -0,0 +1,89 @@
+package api
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+	chiMiddleware "github.com/go-chi/chi/v5/middleware"
+
+	"xpired/internal/auth"
+	"xpired/internal/db"
+	"xpired/internal/tenant"
+)
+
+// loadOwnedDocument resolves the {id} URL parameter to a document owned by
+// the authenticated user. On failure it writes the error response and
+// returns ok=false.
+func (h *Handler) loadOwnedDocument(w http.ResponseWriter, r *http.Request) (doc *db.Document, userID string, ok bool) {
+	documentId := chi.URLParam(r, "id")
+	if documentId == "" || documentId == "undefined" {
+		errResp := BadRequestError("Document ID is required")
+		WriteErrorResponse(w, errResp)
+		return nil, "", false
+	}
+	userID, err := auth.GetUserIDFromContext(r)
+	if err != nil {
+		errResp := UnauthorizedError("Unauthorized")
+		WriteErrorResponse(w, errResp)
+		return nil, "", false
+	}
+
+	doc, err = h.repo.GetDocumentByID(r.Context(), documentId)
+	if err != nil {
+		errResp := NotFoundError("Document not found")
+		WriteErrorResponse(w, errResp)
+		return nil, "", false
+	}
+
+	if !h.canManageDocument(r.Context(), doc, userID) {
+		errResp := ForbiddenError("Forbidden")
+		WriteErrorResponse(w, errResp)
+		return nil, "", false
+	}
+
+	return doc, userID, true
+}
+
+// canManageDocument reports whether userID may view and modify doc: either
+// they own it, or they are the primary of the owner's household.
+func (h *Handler) canManageDocument(ctx context.Context, doc *db.Document, userID string) bool {
+	if doc.UserID.String() == userID {
+		return true
+	}
+	isPrimary, err := h.repo.IsHouseholdPrimaryOf(ctx, userID, doc.UserID.String())
+	return err == nil && isPrimary
+}
+
+// regionContexts returns the request context once per database: the home one
+// and each data region. Public endpoints that only know a message or token ID
+// use it to find the region the record lives in.
+func (h *Handler) regionContexts(r *http.Request) []context.Context {
+	contexts := []context.Context{r.Context()}
+	for _, region := range h.repo.DataRegions() {
+		contexts = append(contexts, tenant.WithRegion(r.Context(), region))
+	}
+	return contexts
+}
+
+// randomToken returns a hex-encoded random string built from n bytes.
+func randomToken(n int) (string, error) {
+	b := make([]byte, n)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(b), nil
+}
+
+// RequestIDMiddleware echoes the ID of the request in the X-Request-Id
+// response header, for clients to quote, say in feedback. The request log,
+// the logs of the tasks it queued and the notification_logs rows of the
+// reminders it scheduled carry the same ID.
+func RequestIDMiddleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set(chiMiddleware.RequestIDHeader, chiMiddleware.GetReqID(r.Context()))
+		next.ServeHTTP(w, r)
+	})
+}
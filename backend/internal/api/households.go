@@ -0,0 +1,320 @@
+package api
+
+import (
+	"encoding/json"
+	"net/http"
+	"strings"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+
+	"xpired/internal/auth"
+	"xpired/internal/db"
+)
+
+func validRouting(routing string) bool {
+	switch routing {
+	case db.RoutingMember, db.RoutingPrimary, db.RoutingBoth:
+		return true
+	}
+	return false
+}
+
+func (h *Handler) householdResponse(r *http.Request, household *db.Household) (*HouseholdResponse, error) {
+	members, err := h.repo.ListHouseholdMembers(r.Context(), household.ID.String())
+	if err != nil {
+		return nil, err
+	}
+
+	memberResps := []HouseholdMemberResponse{}
+	for _, member := range members {
+		memberResps = append(memberResps, HouseholdMemberResponse{
+			UserID:              member.UserID.String(),
+			Name:                member.Name,
+			Email:               member.Email,
+			Role:                member.Role,
+			NotificationRouting: member.NotificationRouting,
+			JoinedAt:            member.CreatedAt,
+		})
+	}
+
+	return &HouseholdResponse{
+		ID:            household.ID.String(),
+		Name:          household.Name,
+		PrimaryUserID: household.PrimaryUserID.String(),
+		Members:       memberResps,
+		CreatedAt:     household.CreatedAt,
+	}, nil
+}
+
+// loadManagedHousehold returns the household the authenticated user is the
+// primary of. Members who are not the primary get a 403.
+func (h *Handler) loadManagedHousehold(w http.ResponseWriter, r *http.Request) (*db.Household, bool) {
+	userID, err := auth.GetUserIDFromContext(r)
+	if err != nil {
+		errResp := UnauthorizedError("Unauthorized")
+		WriteErrorResponse(w, errResp)
+		return nil, false
+	}
+
+	household, err := h.repo.GetHouseholdByUserID(r.Context(), userID)
+	if err != nil {
+		errResp := NotFoundError("Household not found")
+		WriteErrorResponse(w, errResp)
+		return nil, false
+	}
+
+	if household.PrimaryUserID.String() != userID {
+		errResp := ForbiddenError("Only the household primary can manage members")
+		WriteErrorResponse(w, errResp)
+		return nil, false
+	}
+
+	return household, true
+}
+
+func (h *Handler) CreateHouseholdHandler(w http.ResponseWriter, r *http.Request) {
+	userID, err := auth.GetUserIDFromContext(r)
+	if err != nil {
+		errResp := UnauthorizedError("Unauthorized")
+		WriteErrorResponse(w, errResp)
+		return
+	}
+
+	var req HouseholdRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		errResp := BadRequestError("Invalid request body")
+		WriteErrorResponse(w, errResp)
+		return
+	}
+	if strings.TrimSpace(req.Name) == "" {
+		errResp := BadRequestError("Missing required fields")
+		WriteErrorResponse(w, errResp)
+		return
+	}
+
+	household := &db.Household{
+		ID:            uuid.New(),
+		Name:          strings.TrimSpace(req.Name),
+		PrimaryUserID: uuid.MustParse(userID),
+	}
+	if err := h.repo.CreateHousehold(r.Context(), household); err != nil {
+		if err.Error() == "user already belongs to a household" {
+			errResp := ConflictError("You already belong to a household")
+			WriteErrorResponse(w, errResp)
+			return
+		}
+		errResp := InternalServerError("Failed to create household")
+		WriteErrorResponse(w, errResp)
+		return
+	}
+
+	householdResp, err := h.householdResponse(r, household)
+	if err != nil {
+		errResp := InternalServerError("Failed to fetch household members")
+		WriteErrorResponse(w, errResp)
+		return
+	}
+
+	resp := map[string]interface{}{
+		"message":   "Household created successfully",
+		"household": householdResp,
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusCreated)
+	if err := json.NewEncoder(w).Encode(resp); err != nil {
+		errResp := InternalServerError("Failed to encode response")
+		WriteErrorResponse(w, errResp)
+	}
+}
+
+func (h *Handler) GetHouseholdHandler(w http.ResponseWriter, r *http.Request) {
+	userID, err := auth.GetUserIDFromContext(r)
+	if err != nil {
+		errResp := UnauthorizedError("Unauthorized")
+		WriteErrorResponse(w, errResp)
+		return
+	}
+
+	household, err := h.repo.GetHouseholdByUserID(r.Context(), userID)
+	if err != nil {
+		errResp := NotFoundError("Household not found")
+		WriteErrorResponse(w, errResp)
+		return
+	}
+
+	householdResp, err := h.householdResponse(r, household)
+	if err != nil {
+		errResp := InternalServerError("Failed to fetch household members")
+		WriteErrorResponse(w, errResp)
+		return
+	}
+
+	resp := map[string]interface{}{
+		"message":   "Household fetched successfully",
+		"household": householdResp,
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(resp); err != nil {
+		errResp := InternalServerError("Failed to encode response")
+		WriteErrorResponse(w, errResp)
+	}
+}
+
+func (h *Handler) AddHouseholdMemberHandler(w http.ResponseWriter, r *http.Request) {
+	household, ok := h.loadManagedHousehold(w, r)
+	if !ok {
+		return
+	}
+
+	var req HouseholdMemberRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		errResp := BadRequestError("Invalid request body")
+		WriteErrorResponse(w, errResp)
+		return
+	}
+	if req.Email == "" {
+		errResp := BadRequestError("Missing required fields")
+		WriteErrorResponse(w, errResp)
+		return
+	}
+	if req.NotificationRouting == "" {
+		req.NotificationRouting = db.RoutingMember
+	}
+	if !validRouting(req.NotificationRouting) {
+		errResp := BadRequestError("notificationRouting must be one of member, primary, both")
+		WriteErrorResponse(w, errResp)
+		return
+	}
+
+	user, err := h.repo.GetUserByEmail(r.Context(), strings.TrimSpace(req.Email))
+	if err != nil {
+		errResp := NotFoundError("User not found")
+		WriteErrorResponse(w, errResp)
+		return
+	}
+
+	member := &db.HouseholdMember{
+		HouseholdID:         household.ID,
+		UserID:              user.ID,
+		Role:                db.HouseholdRoleMember,
+		NotificationRouting: req.NotificationRouting,
+	}
+	if err := h.repo.AddHouseholdMember(r.Context(), member); err != nil {
+		if err.Error() == "user already belongs to a household" {
+			errResp := ConflictError("User already belongs to a household")
+			WriteErrorResponse(w, errResp)
+			return
+		}
+		errResp := InternalServerError("Failed to add household member")
+		WriteErrorResponse(w, errResp)
+		return
+	}
+
+	householdResp, err := h.householdResponse(r, household)
+	if err != nil {
+		errResp := InternalServerError("Failed to fetch household members")
+		WriteErrorResponse(w, errResp)
+		return
+	}
+
+	resp := map[string]interface{}{
+		"message":   "Household member added successfully",
+		"household": householdResp,
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusCreated)
+	if err := json.NewEncoder(w).Encode(resp); err != nil {
+		errResp := InternalServerError("Failed to encode response")
+		WriteErrorResponse(w, errResp)
+	}
+}
+
+func (h *Handler) UpdateHouseholdMemberHandler(w http.ResponseWriter, r *http.Request) {
+	household, ok := h.loadManagedHousehold(w, r)
+	if !ok {
+		return
+	}
+
+	var req HouseholdMemberRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		errResp := BadRequestError("Invalid request body")
+		WriteErrorResponse(w, errResp)
+		return
+	}
+	if !validRouting(req.NotificationRouting) {
+		errResp := BadRequestError("notificationRouting must be one of member, primary, both")
+		WriteErrorResponse(w, errResp)
+		return
+	}
+
+	memberID := chi.URLParam(r, "userId")
+	err := h.repo.UpdateHouseholdMemberRouting(r.Context(), household.ID.String(), memberID, req.NotificationRouting)
+	if err != nil {
+		errResp := NotFoundError("Household member not found")
+		WriteErrorResponse(w, errResp)
+		return
+	}
+
+	resp := map[string]interface{}{
+		"message": "Household member updated successfully",
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(resp); err != nil {
+		errResp := InternalServerError("Failed to encode response")
+		WriteErrorResponse(w, errResp)
+	}
+}
+
+func (h *Handler) RemoveHouseholdMemberHandler(w http.ResponseWriter, r *http.Request) {
+	household, ok := h.loadManagedHousehold(w, r)
+	if !ok {
+		return
+	}
+
+	memberID := chi.URLParam(r, "userId")
+	if err := h.repo.RemoveHouseholdMember(r.Context(), household.ID.String(), memberID); err != nil {
+		errResp := NotFoundError("Household member not found")
+		WriteErrorResponse(w, errResp)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (h *Handler) ListHouseholdMemberDocumentsHandler(w http.ResponseWriter, r *http.Request) {
+	household, ok := h.loadManagedHousehold(w, r)
+	if !ok {
+		return
+	}
+
+	memberID := chi.URLParam(r, "userId")
+	isPrimary, err := h.repo.IsHouseholdPrimaryOf(r.Context(), household.PrimaryUserID.String(), memberID)
+	if err != nil || !isPrimary {
+		errResp := NotFoundError("Household member not found")
+		WriteErrorResponse(w, errResp)
+		return
+	}
+
+	documents, err := h.repo.ListDocumentsByUserID(r.Context(), memberID)
+	if err != nil {
+		errResp := InternalServerError("Failed to fetch documents")
+		WriteErrorResponse(w, errResp)
+		return
+	}
+
+	resp := map[string]interface{}{
+		"message":   "List of Documents",
+		"documents": h.humanizeDocuments(r.Context(), documents),
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(resp); err != nil {
+		errResp := InternalServerError("Failed to encode response")
+		WriteErrorResponse(w, errResp)
+	}
+}
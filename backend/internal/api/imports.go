@@ -0,0 +1,131 @@
+package api
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"strings"
+
+	"github.com/google/uuid"
+
+	"xpired/internal/auth"
+	"xpired/internal/db"
+	"xpired/internal/importer"
+	worker "xpired/internal/worker"
+)
+
+// maxImportSize caps the export accepted by ImportDocumentsHandler.
+const maxImportSize = 5 << 20
+
+// maxSyncImportRows is the most rows ImportDocumentsHandler imports within
+// the request; a larger export is handed to an import job.
+const maxSyncImportRows = 500
+
+// importUpload returns the export uploaded to an import endpoint: the raw
+// request body or a multipart "file" field. On failure it writes the error
+// response and returns false.
+func importUpload(w http.ResponseWriter, r *http.Request) (io.ReadCloser, bool) {
+	if !strings.HasPrefix(r.Header.Get("Content-Type"), "multipart/form-data") {
+		return http.MaxBytesReader(w, r.Body, maxImportSize), true
+	}
+	if err := r.ParseMultipartForm(maxImportSize); err != nil {
+		errResp := BadRequestError("Invalid multipart body")
+		WriteErrorResponse(w, errResp)
+		return nil, false
+	}
+	file, _, err := r.FormFile("file")
+	if err != nil {
+		errResp := BadRequestError("Missing file field")
+		WriteErrorResponse(w, errResp)
+		return nil, false
+	}
+	return file, true
+}
+
+// ImportDocumentsHandler creates documents from another tool's CSV export.
+// The export is either the raw request body or a multipart "file" field.
+// Rows that cannot be mapped are reported back instead of failing the import.
+// An export of more than maxSyncImportRows rows is imported by a job instead,
+// answered like CreateImportJobHandler.
+func (h *Handler) ImportDocumentsHandler(w http.ResponseWriter, r *http.Request) {
+	userID, err := auth.GetUserIDFromContext(r)
+	if err != nil {
+		errResp := UnauthorizedError("Unauthorized")
+		WriteErrorResponse(w, errResp)
+		return
+	}
+
+	source := r.URL.Query().Get("source")
+	timezone := r.URL.Query().Get("timezone")
+	if timezone == "" {
+		timezone = "UTC"
+	}
+
+	body, ok := importUpload(w, r)
+	if !ok {
+		return
+	}
+	defer body.Close()
+	data, err := io.ReadAll(body)
+	if err != nil {
+		errResp := BadRequestError("Import must be at most 5 MB")
+		WriteErrorResponse(w, errResp)
+		return
+	}
+
+	result, err := importer.Parse(source, bytes.NewReader(data))
+	if err != nil {
+		if errors.Is(err, importer.ErrUnknownSource) {
+			errResp := BadRequestError("source must be one of " + strings.Join(importer.SourceNames(), ", "))
+			WriteErrorResponse(w, errResp)
+			return
+		}
+		errResp := BadRequestError(err.Error())
+		WriteErrorResponse(w, errResp)
+		return
+	}
+
+	var reminderLabels []string
+	if raw := r.URL.Query().Get("reminders"); raw != "" {
+		reminderLabels = strings.Split(raw, ",")
+	}
+
+	if len(result.Rows) > maxSyncImportRows {
+		params := worker.ImportJobParams{
+			Source:    source,
+			Timezone:  timezone,
+			Reminders: reminderLabels,
+			Data:      string(data),
+		}
+		h.startJob(w, r, userID, db.JobKindImport, params)
+		return
+	}
+
+	var created []*db.Document
+	rowErrors := result.Errors
+	for _, row := range result.Rows {
+		doc, err := worker.ImportDocument(r.Context(), h.repo, uuid.New(), userID, timezone, row, reminderLabels)
+		if err != nil {
+			rowErrors = append(rowErrors, importer.RowError{Line: row.Line, Error: worker.ImportRowError(err)})
+			continue
+		}
+		created = append(created, doc)
+	}
+
+	resp := map[string]interface{}{
+		"message":         "Documents imported successfully",
+		"source":          source,
+		"imported":        len(created),
+		"documents":       h.humanizeDocuments(r.Context(), created),
+		"errors":          rowErrors,
+		"unmappedColumns": result.UnmappedColumns,
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(resp); err != nil {
+		errResp := InternalServerError("Failed to encode response")
+		WriteErrorResponse(w, errResp)
+	}
+}
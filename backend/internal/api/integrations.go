@@ -0,0 +1,28 @@
+package api
+
+import (
+	"encoding/json"
+	"net/http"
+)
+
+// EgressIPsHandler lists the addresses webhook deliveries and other outbound
+// calls come from, for receivers behind firewalls to allowlist. The list is
+// empty when the deployment publishes none, in which case calls may come
+// from anywhere.
+func (h *Handler) EgressIPsHandler(w http.ResponseWriter, r *http.Request) {
+	ips := h.cfg.Egress.IPs
+	if ips == nil {
+		ips = []string{}
+	}
+
+	resp := map[string]interface{}{
+		"message": "Egress IPs retrieved successfully",
+		"ips":     ips,
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(resp); err != nil {
+		errResp := InternalServerError("Failed to encode response")
+		WriteErrorResponse(w, errResp)
+	}
+}
@@ -0,0 +1,221 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"regexp"
+	"strings"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+
+	"xpired/internal/db"
+)
+
+var countryCodePattern = regexp.MustCompile(`^[A-Z]{2}$`)
+
+// issuerFromRequest validates an issuer create or update request and copies
+// it onto issuer. It returns the message of the first problem, or "".
+func issuerFromRequest(req IssuerRequest, issuer *db.Issuer) string {
+	issuer.Name = strings.TrimSpace(req.Name)
+	if issuer.Name == "" {
+		return "name is required"
+	}
+
+	issuer.CountryCode = nil
+	if req.CountryCode != nil && *req.CountryCode != "" {
+		code := strings.ToUpper(*req.CountryCode)
+		if !countryCodePattern.MatchString(code) {
+			return "countryCode must be an ISO 3166-1 alpha-2 code"
+		}
+		issuer.CountryCode = &code
+	}
+
+	issuer.RenewalURL = nil
+	if req.RenewalURL != nil && *req.RenewalURL != "" {
+		parsed, err := url.Parse(*req.RenewalURL)
+		if err != nil || (parsed.Scheme != "https" && parsed.Scheme != "http") || parsed.Host == "" {
+			return "renewalUrl must be an http(s) URL"
+		}
+		issuer.RenewalURL = req.RenewalURL
+	}
+
+	if req.ProcessingDays != nil && *req.ProcessingDays < 0 {
+		return "processingDays cannot be negative"
+	}
+	issuer.ProcessingDays = req.ProcessingDays
+	return ""
+}
+
+// ListIssuersHandler returns the issuer directory documents can be linked to,
+// optionally narrowed to the issuers of one country.
+func (h *Handler) ListIssuersHandler(w http.ResponseWriter, r *http.Request) {
+	country := strings.ToUpper(r.URL.Query().Get("country"))
+
+	issuers, err := h.repo.ListIssuers(r.Context(), country)
+	if err != nil {
+		errResp := InternalServerError("Failed to fetch issuers")
+		WriteErrorResponse(w, errResp)
+		return
+	}
+	if issuers == nil {
+		issuers = []*db.Issuer{}
+	}
+
+	resp := map[string]interface{}{
+		"message": "Issuers retrieved successfully",
+		"issuers": issuers,
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(resp); err != nil {
+		errResp := InternalServerError("Failed to encode response")
+		WriteErrorResponse(w, errResp)
+	}
+}
+
+func (h *Handler) CreateIssuerHandler(w http.ResponseWriter, r *http.Request) {
+	var req IssuerRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		errResp := BadRequestError("Invalid request body")
+		WriteErrorResponse(w, errResp)
+		return
+	}
+
+	issuer := &db.Issuer{ID: uuid.New()}
+	if msg := issuerFromRequest(req, issuer); msg != "" {
+		errResp := BadRequestError(msg)
+		WriteErrorResponse(w, errResp)
+		return
+	}
+	if err := h.repo.CreateIssuer(r.Context(), issuer); err != nil {
+		errResp := InternalServerError("Failed to create issuer")
+		WriteErrorResponse(w, errResp)
+		return
+	}
+
+	resp := map[string]interface{}{
+		"message": "Issuer created successfully",
+		"issuer":  issuer,
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusCreated)
+	if err := json.NewEncoder(w).Encode(resp); err != nil {
+		errResp := InternalServerError("Failed to encode response")
+		WriteErrorResponse(w, errResp)
+	}
+}
+
+func (h *Handler) UpdateIssuerHandler(w http.ResponseWriter, r *http.Request) {
+	issuerID, err := uuid.Parse(chi.URLParam(r, "id"))
+	if err != nil {
+		errResp := NotFoundError("Issuer not found")
+		WriteErrorResponse(w, errResp)
+		return
+	}
+
+	var req IssuerRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		errResp := BadRequestError("Invalid request body")
+		WriteErrorResponse(w, errResp)
+		return
+	}
+
+	issuer := &db.Issuer{ID: issuerID}
+	if msg := issuerFromRequest(req, issuer); msg != "" {
+		errResp := BadRequestError(msg)
+		WriteErrorResponse(w, errResp)
+		return
+	}
+	if err := h.repo.UpdateIssuer(r.Context(), issuer); err != nil {
+		if err.Error() == "issuer not found" {
+			errResp := NotFoundError("Issuer not found")
+			WriteErrorResponse(w, errResp)
+			return
+		}
+		errResp := InternalServerError("Failed to update issuer")
+		WriteErrorResponse(w, errResp)
+		return
+	}
+
+	resp := map[string]interface{}{
+		"message": "Issuer updated successfully",
+		"issuer":  issuer,
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(resp); err != nil {
+		errResp := InternalServerError("Failed to encode response")
+		WriteErrorResponse(w, errResp)
+	}
+}
+
+func (h *Handler) DeleteIssuerHandler(w http.ResponseWriter, r *http.Request) {
+	issuerID, err := uuid.Parse(chi.URLParam(r, "id"))
+	if err != nil {
+		errResp := NotFoundError("Issuer not found")
+		WriteErrorResponse(w, errResp)
+		return
+	}
+
+	if err := h.repo.DeleteIssuer(r.Context(), issuerID.String()); err != nil {
+		if err.Error() == "issuer not found" {
+			errResp := NotFoundError("Issuer not found")
+			WriteErrorResponse(w, errResp)
+			return
+		}
+		errResp := InternalServerError("Failed to delete issuer")
+		WriteErrorResponse(w, errResp)
+		return
+	}
+
+	resp := map[string]interface{}{
+		"message": "Issuer deleted successfully",
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(resp); err != nil {
+		errResp := InternalServerError("Failed to encode response")
+		WriteErrorResponse(w, errResp)
+	}
+}
+
+// documentIssuer returns the issuer doc is linked to, or nil when it has none
+// or the issuer was removed from the directory.
+func (h *Handler) documentIssuer(ctx context.Context, doc *db.Document) *IssuerResponse {
+	if doc.IssuerID == nil {
+		return nil
+	}
+	issuer, err := h.repo.GetIssuer(ctx, *doc.IssuerID)
+	if err != nil {
+		return nil
+	}
+	return &IssuerResponse{
+		ID:             issuer.ID.String(),
+		Name:           issuer.Name,
+		RenewalURL:     issuer.RenewalURL,
+		ProcessingDays: issuer.ProcessingDays,
+	}
+}
+
+// applyIssuer links doc to the issuer of a create or update request; an empty
+// id unlinks it. It returns the message of the problem, or "".
+func (h *Handler) applyIssuer(r *http.Request, doc *db.Document, issuerID *string) string {
+	if issuerID == nil {
+		return ""
+	}
+	if *issuerID == "" {
+		doc.IssuerID = nil
+		return ""
+	}
+	issuer, err := h.repo.GetIssuer(r.Context(), *issuerID)
+	if err != nil {
+		return "Unknown issuer"
+	}
+	id := issuer.ID.String()
+	doc.IssuerID = &id
+	return ""
+}
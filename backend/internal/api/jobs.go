@@ -0,0 +1,194 @@
+package api
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"slices"
+	"strings"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+
+	"xpired/internal/auth"
+	"xpired/internal/db"
+	"xpired/internal/importer"
+	"xpired/internal/worker"
+)
+
+// maxListedJobs caps how many of a user's jobs are listed.
+const maxListedJobs = 50
+
+// startJob creates a job of kind for the caller and queues it for the
+// worker. It answers 202 with the job, which the client then polls at
+// GET /api/jobs/{id}.
+func (h *Handler) startJob(w http.ResponseWriter, r *http.Request, userID, kind string, params interface{}) {
+	raw, err := json.Marshal(params)
+	if err != nil {
+		errResp := InternalServerError("Failed to encode job parameters")
+		WriteErrorResponse(w, errResp)
+		return
+	}
+
+	job := &db.Job{
+		ID:     uuid.New(),
+		UserID: userID,
+		Kind:   kind,
+		Params: raw,
+	}
+	if err := h.repo.CreateJob(r.Context(), job); err != nil {
+		errResp := InternalServerError("Failed to create job")
+		WriteErrorResponse(w, errResp)
+		return
+	}
+	if err := worker.ScheduleJob(r.Context(), job); err != nil {
+		errResp := InternalServerError("Failed to queue job")
+		WriteErrorResponse(w, errResp)
+		return
+	}
+
+	resp := map[string]interface{}{
+		"message": "Job queued",
+		"job":     job,
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Location", "/api/jobs/"+job.ID.String())
+	w.WriteHeader(http.StatusAccepted)
+	if err := json.NewEncoder(w).Encode(resp); err != nil {
+		errResp := InternalServerError("Failed to encode response")
+		WriteErrorResponse(w, errResp)
+	}
+}
+
+// CreateImportJobHandler imports another tool's CSV export in the background.
+// It takes the same input as ImportDocumentsHandler; the job's result holds
+// what that endpoint would have answered.
+func (h *Handler) CreateImportJobHandler(w http.ResponseWriter, r *http.Request) {
+	userID, err := auth.GetUserIDFromContext(r)
+	if err != nil {
+		errResp := UnauthorizedError("Unauthorized")
+		WriteErrorResponse(w, errResp)
+		return
+	}
+
+	params := worker.ImportJobParams{
+		Source:   r.URL.Query().Get("source"),
+		Timezone: r.URL.Query().Get("timezone"),
+	}
+	if !slices.Contains(importer.SourceNames(), params.Source) {
+		errResp := BadRequestError("source must be one of " + strings.Join(importer.SourceNames(), ", "))
+		WriteErrorResponse(w, errResp)
+		return
+	}
+	if params.Timezone == "" {
+		params.Timezone = "UTC"
+	}
+	if raw := r.URL.Query().Get("reminders"); raw != "" {
+		params.Reminders = strings.Split(raw, ",")
+	}
+
+	body, ok := importUpload(w, r)
+	if !ok {
+		return
+	}
+	defer body.Close()
+	data, err := io.ReadAll(body)
+	if err != nil {
+		errResp := BadRequestError("Import must be at most 5 MB")
+		WriteErrorResponse(w, errResp)
+		return
+	}
+	params.Data = string(data)
+
+	h.startJob(w, r, userID, db.JobKindImport, params)
+}
+
+// CreateTakeoutJobHandler gathers the caller's account data into a file they
+// can download from the finished job's resultUrl.
+func (h *Handler) CreateTakeoutJobHandler(w http.ResponseWriter, r *http.Request) {
+	userID, err := auth.GetUserIDFromContext(r)
+	if err != nil {
+		errResp := UnauthorizedError("Unauthorized")
+		WriteErrorResponse(w, errResp)
+		return
+	}
+
+	h.startJob(w, r, userID, db.JobKindTakeout, map[string]interface{}{})
+}
+
+func (h *Handler) ListJobsHandler(w http.ResponseWriter, r *http.Request) {
+	userID, err := auth.GetUserIDFromContext(r)
+	if err != nil {
+		errResp := UnauthorizedError("Unauthorized")
+		WriteErrorResponse(w, errResp)
+		return
+	}
+
+	jobs, err := h.repo.ListJobs(r.Context(), userID, maxListedJobs)
+	if err != nil {
+		errResp := InternalServerError("Failed to fetch jobs")
+		WriteErrorResponse(w, errResp)
+		return
+	}
+	if jobs == nil {
+		jobs = []*db.Job{}
+	}
+
+	resp := map[string]interface{}{
+		"message": "Jobs retrieved successfully",
+		"jobs":    jobs,
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(resp); err != nil {
+		errResp := InternalServerError("Failed to encode response")
+		WriteErrorResponse(w, errResp)
+	}
+}
+
+// GetJobHandler reports the status of one of the caller's jobs and, once it
+// finished, its result or error.
+func (h *Handler) GetJobHandler(w http.ResponseWriter, r *http.Request) {
+	userID, err := auth.GetUserIDFromContext(r)
+	if err != nil {
+		errResp := UnauthorizedError("Unauthorized")
+		WriteErrorResponse(w, errResp)
+		return
+	}
+
+	jobID := chi.URLParam(r, "id")
+	if _, err := uuid.Parse(jobID); err != nil {
+		errResp := BadRequestError("Invalid job ID")
+		WriteErrorResponse(w, errResp)
+		return
+	}
+
+	job, err := h.repo.GetJob(r.Context(), jobID)
+	if err != nil {
+		if err.Error() == "job not found" {
+			errResp := NotFoundError("Job not found")
+			WriteErrorResponse(w, errResp)
+			return
+		}
+		errResp := InternalServerError("Failed to fetch job")
+		WriteErrorResponse(w, errResp)
+		return
+	}
+	if job.UserID != userID {
+		errResp := NotFoundError("Job not found")
+		WriteErrorResponse(w, errResp)
+		return
+	}
+
+	resp := map[string]interface{}{
+		"message": "Job retrieved successfully",
+		"job":     job,
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(resp); err != nil {
+		errResp := InternalServerError("Failed to encode response")
+		WriteErrorResponse(w, errResp)
+	}
+}
@@ -0,0 +1,36 @@
+package api
+
+import (
+	"context"
+
+	"xpired/internal/db"
+	"xpired/internal/worker"
+)
+
+// maxLeadTimeDays caps a document's lead time at a year.
+const maxLeadTimeDays = 365
+
+// applyLeadTime sets the lead time of a create or update request on doc; 0
+// clears it, so the issuer's processing time applies again. It returns the
+// message of the problem, or "".
+func applyLeadTime(doc *db.Document, leadTimeDays *int) string {
+	if leadTimeDays == nil {
+		return ""
+	}
+	if *leadTimeDays < 0 || *leadTimeDays > maxLeadTimeDays {
+		return "leadTimeDays must be between 0 and 365"
+	}
+	if *leadTimeDays == 0 {
+		doc.LeadTimeDays = nil
+		return ""
+	}
+	doc.LeadTimeDays = leadTimeDays
+	return ""
+}
+
+// scheduleLeadTimeReminder queues the "start your renewal" reminder of doc
+// after it was created, changed, renewed or restored. The worker sends it
+// once per expiration date however often it is queued.
+func (h *Handler) scheduleLeadTimeReminder(ctx context.Context, doc *db.Document) {
+	worker.ScheduleLeadTimeReminder(ctx, *doc, worker.DocumentLeadTime(ctx, h.repo, doc))
+}
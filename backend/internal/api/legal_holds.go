@@ -0,0 +1,266 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"log"
+	"net/http"
+
+	"github.com/google/uuid"
+
+	"xpired/internal/db"
+	"xpired/internal/worker"
+)
+
+// ensureNotOnLegalHold answers a request to delete doc with 409 when it is
+// under legal hold. It fails closed: when the hold cannot be checked, the
+// document is not deleted either.
+func (h *Handler) ensureNotOnLegalHold(w http.ResponseWriter, r *http.Request, doc *db.Document) bool {
+	held, err := h.repo.UnderLegalHold(r.Context(), doc)
+	if err != nil {
+		log.Printf("Failed to check legal hold of document %s: %v", doc.ID.String(), err)
+		errResp := InternalServerError("Failed to check legal hold")
+		WriteErrorResponse(w, errResp)
+		return false
+	}
+	if held {
+		errResp := ConflictError("Document is under legal hold and cannot be deleted")
+		errResp.Code = ErrorCodeLegalHold
+		WriteErrorResponse(w, errResp)
+		return false
+	}
+	return true
+}
+
+// recordLegalHoldAudit logs that userID placed or removed a legal hold on
+// the entity. Failures are only logged so they never undo the change. The
+// entry names no organization, so its retention never deletes the record
+// of a hold.
+func (h *Handler) recordLegalHoldAudit(ctx context.Context, userID, action, entityType, entityID string, ownerID *string, metadata map[string]interface{}) {
+	details, _ := json.Marshal(metadata)
+	entry := &db.AuditLog{
+		ID:         uuid.New(),
+		ActorID:    &userID,
+		UserID:     ownerID,
+		Action:     action,
+		EntityType: entityType,
+		EntityID:   entityID,
+		Metadata:   details,
+	}
+	if err := h.repo.CreateAuditLog(ctx, entry); err != nil {
+		log.Printf("Failed to record %s for %s %s in audit log: %v", action, entityType, entityID, err)
+	}
+}
+
+// setLegalHold places or removes hold. It returns the hold in force
+// afterwards, or nil, and whether anything changed; when the hold already
+// was as requested there is nothing to record in the audit log.
+func (h *Handler) setLegalHold(ctx context.Context, hold *db.LegalHold, held bool) (*db.LegalHold, bool, error) {
+	if !held {
+		removed, err := h.repo.RemoveLegalHold(ctx, hold.OrganizationID, hold.DocumentID)
+		return nil, removed, err
+	}
+
+	placed, err := h.repo.PlaceLegalHold(ctx, hold)
+	if err != nil || placed {
+		return hold, placed, err
+	}
+	existing, err := h.repo.GetLegalHold(ctx, hold.OrganizationID, hold.DocumentID)
+	return existing, false, err
+}
+
+func toLegalHoldResponse(hold *db.LegalHold) LegalHoldResponse {
+	if hold == nil {
+		return LegalHoldResponse{}
+	}
+	return LegalHoldResponse{Held: true, PlacedBy: hold.PlacedBy, PlacedAt: &hold.PlacedAt}
+}
+
+func (h *Handler) GetOrganizationLegalHoldHandler(w http.ResponseWriter, r *http.Request) {
+	org, _, ok := h.loadOrganizationMembership(w, r)
+	if !ok {
+		return
+	}
+
+	ctx, err := worker.OrganizationContext(r.Context(), h.repo, org.ID.String())
+	if err != nil {
+		errResp := InternalServerError("Failed to load organization")
+		WriteErrorResponse(w, errResp)
+		return
+	}
+	hold, err := h.repo.GetLegalHold(ctx, org.ID.String(), nil)
+	if err != nil && err.Error() != "legal hold not found" {
+		errResp := InternalServerError("Failed to fetch legal hold")
+		WriteErrorResponse(w, errResp)
+		return
+	}
+
+	resp := map[string]interface{}{
+		"message":   "Legal hold fetched successfully",
+		"legalHold": toLegalHoldResponse(hold),
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(resp); err != nil {
+		errResp := InternalServerError("Failed to encode response")
+		WriteErrorResponse(w, errResp)
+	}
+}
+
+// UpdateOrganizationLegalHoldHandler places or removes a legal hold on every
+// document of the organization. Only owners can.
+func (h *Handler) UpdateOrganizationLegalHoldHandler(w http.ResponseWriter, r *http.Request) {
+	org, caller, ok := h.loadOrganizationMembership(w, r)
+	if !ok {
+		return
+	}
+	if caller.Role != db.OrgRoleOwner {
+		errResp := ForbiddenError("Only organization owners can change legal holds")
+		WriteErrorResponse(w, errResp)
+		return
+	}
+
+	var req LegalHoldRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		errResp := BadRequestError("Invalid request body")
+		WriteErrorResponse(w, errResp)
+		return
+	}
+
+	ctx, err := worker.OrganizationContext(r.Context(), h.repo, org.ID.String())
+	if err != nil {
+		errResp := InternalServerError("Failed to load organization")
+		WriteErrorResponse(w, errResp)
+		return
+	}
+	hold, changed, err := h.setLegalHold(ctx, &db.LegalHold{OrganizationID: org.ID.String(), PlacedBy: caller.UserID}, req.Held)
+	if err != nil {
+		errResp := InternalServerError("Failed to update legal hold")
+		WriteErrorResponse(w, errResp)
+		return
+	}
+	if changed {
+		action := db.AuditActionLegalHoldRemoved
+		if req.Held {
+			action = db.AuditActionLegalHoldPlaced
+		}
+		h.recordLegalHoldAudit(r.Context(), caller.UserID, action, "organization", org.ID.String(), nil, map[string]interface{}{
+			"name": org.Name,
+		})
+	}
+
+	resp := map[string]interface{}{
+		"message":   "Legal hold updated successfully",
+		"legalHold": toLegalHoldResponse(hold),
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(resp); err != nil {
+		errResp := InternalServerError("Failed to encode response")
+		WriteErrorResponse(w, errResp)
+	}
+}
+
+// documentLegalHold reports the legal hold on doc, noting when it is held
+// only as part of its organization.
+func (h *Handler) documentLegalHold(ctx context.Context, doc *db.Document) (LegalHoldResponse, error) {
+	documentID := doc.ID.String()
+	hold, err := h.repo.GetLegalHold(ctx, *doc.OrganizationID, &documentID)
+	if err != nil && err.Error() != "legal hold not found" {
+		return LegalHoldResponse{}, err
+	}
+	held, err := h.repo.UnderLegalHold(ctx, doc)
+	if err != nil {
+		return LegalHoldResponse{}, err
+	}
+
+	legalHold := toLegalHoldResponse(hold)
+	legalHold.OrganizationHeld = held && hold == nil
+	return legalHold, nil
+}
+
+func (h *Handler) GetDocumentLegalHoldHandler(w http.ResponseWriter, r *http.Request) {
+	doc, _, ok := h.loadTeamDocument(w, r)
+	if !ok {
+		return
+	}
+
+	legalHold, err := h.documentLegalHold(r.Context(), doc)
+	if err != nil {
+		errResp := InternalServerError("Failed to fetch legal hold")
+		WriteErrorResponse(w, errResp)
+		return
+	}
+
+	resp := map[string]interface{}{
+		"message":   "Legal hold fetched successfully",
+		"legalHold": legalHold,
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(resp); err != nil {
+		errResp := InternalServerError("Failed to encode response")
+		WriteErrorResponse(w, errResp)
+	}
+}
+
+// UpdateDocumentLegalHoldHandler places or removes a legal hold on one
+// organization document. Only the organization's owners can. Removing it
+// leaves the document held while its organization is, as the response
+// tells.
+func (h *Handler) UpdateDocumentLegalHoldHandler(w http.ResponseWriter, r *http.Request) {
+	doc, member, ok := h.loadTeamDocument(w, r)
+	if !ok {
+		return
+	}
+	if member.Role != db.OrgRoleOwner {
+		errResp := ForbiddenError("Only organization owners can change legal holds")
+		WriteErrorResponse(w, errResp)
+		return
+	}
+
+	var req LegalHoldRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		errResp := BadRequestError("Invalid request body")
+		WriteErrorResponse(w, errResp)
+		return
+	}
+
+	documentID := doc.ID.String()
+	hold := &db.LegalHold{OrganizationID: *doc.OrganizationID, DocumentID: &documentID, PlacedBy: member.UserID}
+	_, changed, err := h.setLegalHold(r.Context(), hold, req.Held)
+	if err != nil {
+		errResp := InternalServerError("Failed to update legal hold")
+		WriteErrorResponse(w, errResp)
+		return
+	}
+	if changed {
+		action := db.AuditActionLegalHoldRemoved
+		if req.Held {
+			action = db.AuditActionLegalHoldPlaced
+		}
+		ownerID := doc.UserID.String()
+		h.recordLegalHoldAudit(r.Context(), member.UserID, action, "document", documentID, &ownerID, map[string]interface{}{
+			"name":           doc.Name,
+			"organizationId": *doc.OrganizationID,
+		})
+	}
+
+	legalHold, err := h.documentLegalHold(r.Context(), doc)
+	if err != nil {
+		errResp := InternalServerError("Failed to fetch legal hold")
+		WriteErrorResponse(w, errResp)
+		return
+	}
+
+	resp := map[string]interface{}{
+		"message":   "Legal hold updated successfully",
+		"legalHold": legalHold,
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(resp); err != nil {
+		errResp := InternalServerError("Failed to encode response")
+		WriteErrorResponse(w, errResp)
+	}
+}
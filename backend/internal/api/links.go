@@ -0,0 +1,276 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"net/http"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+
+	"xpired/internal/auth"
+	"xpired/internal/db"
+	worker "xpired/internal/worker"
+)
+
+// loadActionLink parses the signed token of a notification link and loads
+// the document it is for, in the organization's data region if it has one.
+func (h *Handler) loadActionLink(w http.ResponseWriter, r *http.Request) (*auth.ActionClaims, *db.Document, *http.Request, bool) {
+	claims, err := auth.ParseActionToken(chi.URLParam(r, "token"))
+	if err != nil {
+		errResp := UnauthorizedError("This link is invalid or has expired")
+		WriteErrorResponse(w, errResp)
+		return nil, nil, r, false
+	}
+
+	if claims.OrganizationID != "" {
+		ctx, err := worker.OrganizationContext(r.Context(), h.repo, claims.OrganizationID)
+		if err != nil {
+			errResp := NotFoundError("Document not found")
+			WriteErrorResponse(w, errResp)
+			return nil, nil, r, false
+		}
+		r = r.WithContext(ctx)
+	}
+
+	doc, err := h.repo.GetDocumentByID(r.Context(), claims.DocumentID)
+	if err != nil || doc.UserID.String() != claims.Subject {
+		errResp := NotFoundError("Document not found")
+		WriteErrorResponse(w, errResp)
+		return nil, nil, r, false
+	}
+	return claims, doc, r, true
+}
+
+// ActionLinkHandler is public: it shows what a notification link would do,
+// for the page that asks to confirm it. It changes nothing, as mail scanners
+// follow links too; PerformActionLinkHandler carries the action out.
+func (h *Handler) ActionLinkHandler(w http.ResponseWriter, r *http.Request) {
+	claims, doc, r, ok := h.loadActionLink(w, r)
+	if !ok {
+		return
+	}
+
+	used := false
+	if claims.Action != auth.ActionView {
+		var err error
+		if used, err = h.repo.IsActionLinkUsed(r.Context(), claims.ID); err != nil {
+			errResp := InternalServerError("Failed to check link")
+			WriteErrorResponse(w, errResp)
+			return
+		}
+	}
+
+	resp := map[string]interface{}{
+		"message":  "Link fetched successfully",
+		"action":   claims.Action,
+		"used":     used,
+		"document": h.humanizeDocument(r.Context(), doc),
+	}
+	if claims.Action == auth.ActionSnooze {
+		resp["snoozeDays"] = claims.SnoozeDays
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(resp); err != nil {
+		errResp := InternalServerError("Failed to encode response")
+		WriteErrorResponse(w, errResp)
+	}
+}
+
+// PerformActionLinkHandler is public: the signed token from a notification
+// link authorizes exactly one action on one document. Renewal and snooze
+// links work once; view links only acknowledge, so they can be reused.
+func (h *Handler) PerformActionLinkHandler(w http.ResponseWriter, r *http.Request) {
+	claims, doc, r, ok := h.loadActionLink(w, r)
+	if !ok {
+		return
+	}
+
+	var newExpiration time.Time
+	switch claims.Action {
+	case auth.ActionView, auth.ActionSnooze:
+	case auth.ActionRenewed:
+		if !h.ensureDirectRenewalAllowed(w, r, doc) {
+			return
+		}
+		var err error
+		newExpiration, err = h.nextExpirationDate(r.Context(), doc, r.URL.Query().Get("expirationDate"))
+		if err != nil {
+			errResp := BadRequestError(err.Error())
+			WriteErrorResponse(w, errResp)
+			return
+		}
+	default:
+		errResp := BadRequestError("Unknown link action")
+		WriteErrorResponse(w, errResp)
+		return
+	}
+
+	if claims.Action != auth.ActionView {
+		claimed, err := h.repo.ClaimActionLink(r.Context(), claims.ID, claims.ExpiresAt.Time)
+		if err != nil {
+			errResp := InternalServerError("Failed to check link")
+			WriteErrorResponse(w, errResp)
+			return
+		}
+		if !claimed {
+			errResp := ConflictError("This link has already been used")
+			WriteErrorResponse(w, errResp)
+			return
+		}
+	}
+	// a failed action leaves the link usable for another try
+	release := func() {
+		if err := h.repo.ReleaseActionLink(r.Context(), claims.ID); err != nil {
+			log.Printf("Failed to release action link %s: %v", claims.ID, err)
+		}
+	}
+
+	// viewing or renewing acknowledges the reminder the link came with, which
+	// drops its sends still pending on other channels; snoozing does too
+	if claims.IntervalID != 0 && claims.Action != auth.ActionSnooze {
+		if _, err := h.repo.TransitionDocumentReminder(r.Context(), doc.ID.String(), claims.IntervalID, db.ReminderAcknowledged); err != nil {
+			log.Printf("Failed to acknowledge reminder for doc %s: %v", doc.ID.String(), err)
+		}
+	}
+
+	var resp map[string]interface{}
+	switch claims.Action {
+	case auth.ActionView:
+		resp = map[string]interface{}{
+			"message":  "Document fetched successfully",
+			"document": h.humanizeDocument(r.Context(), doc),
+		}
+
+	case auth.ActionRenewed:
+		if err := h.renewDocument(r.Context(), doc, newExpiration); err != nil {
+			release()
+			errResp := InternalServerError("Failed to renew document")
+			WriteErrorResponse(w, errResp)
+			return
+		}
+		resp = map[string]interface{}{
+			"message":  "Document marked as renewed",
+			"document": h.humanizeDocument(r.Context(), doc),
+		}
+
+	case auth.ActionSnooze:
+		runAt, err := h.snoozeReminder(r.Context(), doc, claims.IntervalID, claims.SnoozeDays)
+		if err != nil {
+			release()
+			if errors.Is(err, errReminderNotSnoozable) {
+				errResp := ConflictError("This reminder can no longer be snoozed")
+				WriteErrorResponse(w, errResp)
+				return
+			}
+			errResp := InternalServerError("Failed to snooze reminder")
+			WriteErrorResponse(w, errResp)
+			return
+		}
+		resp = map[string]interface{}{
+			"message":     "Reminder snoozed",
+			"remindAgain": runAt.Format(time.RFC3339),
+		}
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(resp); err != nil {
+		errResp := InternalServerError("Failed to encode response")
+		WriteErrorResponse(w, errResp)
+	}
+}
+
+// errReminderNotSnoozable is returned by snoozeReminder for a reminder
+// renewed away or turned off since it was sent.
+var errReminderNotSnoozable = errors.New("reminder can no longer be snoozed")
+
+// snoozeReminder marks the reminder of doc for intervalID snoozed and queues
+// it again in days. Links and SMS replies both snooze through it. It returns
+// when the reminder is sent again.
+func (h *Handler) snoozeReminder(ctx context.Context, doc *db.Document, intervalID, days int) (time.Time, error) {
+	if intervalID != 0 {
+		snoozed, err := h.repo.TransitionDocumentReminder(ctx, doc.ID.String(), intervalID, db.ReminderSnoozed)
+		if err != nil {
+			return time.Time{}, err
+		}
+		if !snoozed {
+			return time.Time{}, errReminderNotSnoozable
+		}
+	}
+	runAt := time.Now().AddDate(0, 0, days)
+	if err := worker.ScheduleSnoozedReminder(ctx, doc.UserID.String(), doc.ID.String(), intervalID, runAt); err != nil {
+		return time.Time{}, err
+	}
+	return runAt, nil
+}
+
+// nextExpirationDate parses a YYYY-MM-DD renewal date, falling back to the
+// typical validity period of the document's category when raw is empty.
+func (h *Handler) nextExpirationDate(ctx context.Context, doc *db.Document, raw string) (time.Time, error) {
+	if raw != "" {
+		parsed, err := time.Parse("2006-01-02", raw)
+		if err != nil {
+			return time.Time{}, fmt.Errorf("expirationDate must be formatted as YYYY-MM-DD")
+		}
+		if !parsed.After(doc.ExpirationDate) {
+			return time.Time{}, fmt.Errorf("expirationDate must be after the current expiration date")
+		}
+		return parsed, nil
+	}
+
+	if doc.Category != nil {
+		period, err := h.repo.GetValidityPeriod(ctx, *doc.Category, "")
+		if err == nil {
+			return doc.ExpirationDate.AddDate(0, period.ValidityMonths, 0), nil
+		}
+	}
+	return time.Time{}, fmt.Errorf("expirationDate is required for this document")
+}
+
+// renewDocument moves the document to its new expiration date, re-arms every
+// enabled reminder and schedules them against the new date. Tasks queued for
+// the old date are skipped by the worker once they see the new date.
+func (h *Handler) renewDocument(ctx context.Context, doc *db.Document, newExpiration time.Time) error {
+	previousExpiration := doc.ExpirationDate
+	doc.ExpirationDate = newExpiration
+	if err := h.repo.UpdateDocument(ctx, doc); err != nil {
+		return err
+	}
+	if err := h.repo.ResetDocumentReminders(ctx, doc.ID.String()); err != nil {
+		return err
+	}
+
+	intervals, err := h.enabledReminderIntervals(ctx, doc.ID.String())
+	if err != nil {
+		return err
+	}
+	worker.ScheduleReminders(ctx, *doc, doc.UserID, intervals)
+	h.scheduleLeadTimeReminder(ctx, doc)
+	worker.ScheduleDependentsNotice(ctx, *doc, previousExpiration)
+	worker.EmitWebhookEvent(ctx, doc.UserID.String(), db.WebhookEventDocumentUpdated, doc)
+	return nil
+}
+
+func (h *Handler) enabledReminderIntervals(ctx context.Context, documentID string) ([]db.ReminderInterval, error) {
+	reminders, err := h.repo.GetDocumentRemindersByDocumentID(ctx, documentID)
+	if err != nil {
+		return nil, err
+	}
+
+	var intervals []db.ReminderInterval
+	for _, reminder := range reminders {
+		if !reminder.Enabled {
+			continue
+		}
+		interval, err := h.repo.GetReminderIntervalByID(ctx, reminder.ReminderIntervalID)
+		if err != nil {
+			continue
+		}
+		intervals = append(intervals, *interval)
+	}
+	return intervals, nil
+}
@@ -0,0 +1,152 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"log"
+	"net/http"
+	"strconv"
+	"sync/atomic"
+	"time"
+
+	database "xpired/internal/db"
+)
+
+const (
+	// poolSampleInterval is how often the database pool is checked for
+	// saturation.
+	poolSampleInterval = 250 * time.Millisecond
+	// shedRetryAfter is the Retry-After, in seconds, of shed requests.
+	shedRetryAfter = 1
+	// shedStatsDays is how long daily counts of shed requests are kept, and
+	// the window the admin report covers.
+	shedStatsDays = 7
+
+	shedReasonInFlight = "in_flight"
+	shedReasonDBPool   = "db_pool"
+)
+
+func shedRequestsKey(reason string) string {
+	return "xpired:shed:" + reason
+}
+
+// loadShedder turns requests away with a quick 503 while the replica is
+// saturated, rather than letting them queue for a database connection until
+// they time out.
+type loadShedder struct {
+	// slots bounds the requests served at once; nil is unlimited.
+	slots chan struct{}
+	// poolSaturated is set while every database connection is busy and
+	// queries keep queueing for one.
+	poolSaturated atomic.Bool
+}
+
+// LoadShedding returns the middleware that sheds load: a request is answered
+// with 503 and a Retry-After header when maxInFlight requests are already
+// being served, or when the connection pool of db is exhausted. Only db
+// itself is watched, as every request reads users from it. Each shed request
+// is counted for the admin report.
+func (h *Handler) LoadShedding(db *database.DB, maxInFlight int) func(http.Handler) http.Handler {
+	s := &loadShedder{}
+	if maxInFlight > 0 {
+		s.slots = make(chan struct{}, maxInFlight)
+	}
+	go s.monitorPool(db)
+
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if s.poolSaturated.Load() {
+				h.shed(w, shedReasonDBPool)
+				return
+			}
+			if s.slots != nil {
+				select {
+				case s.slots <- struct{}{}:
+					defer func() { <-s.slots }()
+				default:
+					h.shed(w, shedReasonInFlight)
+					return
+				}
+			}
+			next.ServeHTTP(w, r)
+		})
+	}
+}
+
+// monitorPool samples the pool of db for the life of the process. The pool
+// counts as saturated when all its connections were in use and more queries
+// had to wait for one since the previous sample.
+func (s *loadShedder) monitorPool(db *database.DB) {
+	ticker := time.NewTicker(poolSampleInterval)
+	defer ticker.Stop()
+
+	previous := db.Stats()
+	for range ticker.C {
+		stats := db.Stats()
+		exhausted := stats.MaxOpenConnections > 0 && stats.InUse >= stats.MaxOpenConnections
+		s.poolSaturated.Store(exhausted && stats.WaitCount > previous.WaitCount)
+		previous = stats
+	}
+}
+
+func (h *Handler) shed(w http.ResponseWriter, reason string) {
+	go h.recordShedRequest(reason)
+
+	w.Header().Set("Retry-After", strconv.Itoa(shedRetryAfter))
+	errResp := ServiceUnavailableError("Server is busy, please retry shortly")
+	WriteErrorResponse(w, errResp)
+}
+
+// recordShedRequest counts a shed request per day. Failures only lose
+// metrics.
+func (h *Handler) recordShedRequest(reason string) {
+	ctx, cancel := context.WithTimeout(context.Background(), 2*time.Second)
+	defer cancel()
+
+	key := shedRequestsKey(reason)
+	pipe := h.cache.Pipeline()
+	pipe.HIncrBy(ctx, key, time.Now().UTC().Format("2006-01-02"), 1)
+	pipe.Expire(ctx, key, shedStatsDays*24*time.Hour)
+	if _, err := pipe.Exec(ctx); err != nil {
+		log.Printf("Failed to record shed request (%s): %v", reason, err)
+	}
+}
+
+// LoadSheddingStatsHandler reports how many requests were shed per reason
+// and day, across all replicas.
+func (h *Handler) LoadSheddingStatsHandler(w http.ResponseWriter, r *http.Request) {
+	since := time.Now().UTC().AddDate(0, 0, -shedStatsDays).Format("2006-01-02")
+
+	reasons := []ShedRequestsResponse{}
+	for _, reason := range []string{shedReasonInFlight, shedReasonDBPool} {
+		entry := ShedRequestsResponse{
+			Reason:    reason,
+			DailyShed: map[string]int64{},
+		}
+		daily, err := h.cache.HGetAll(r.Context(), shedRequestsKey(reason)).Result()
+		if err != nil {
+			log.Printf("Failed to read shed requests (%s): %v", reason, err)
+		}
+		for day, raw := range daily {
+			count, err := strconv.ParseInt(raw, 10, 64)
+			if err != nil || day < since {
+				continue
+			}
+			entry.DailyShed[day] = count
+			entry.Shed += count
+		}
+		reasons = append(reasons, entry)
+	}
+
+	resp := map[string]interface{}{
+		"message":    "Load shedding statistics retrieved successfully",
+		"windowDays": shedStatsDays,
+		"reasons":    reasons,
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(resp); err != nil {
+		errResp := InternalServerError("Failed to encode response")
+		WriteErrorResponse(w, errResp)
+	}
+}
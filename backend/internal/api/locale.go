@@ -0,0 +1,275 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"log"
+	"net/http"
+	"time"
+
+	"golang.org/x/text/language"
+	"golang.org/x/text/message"
+
+	"xpired/internal/auth"
+	"xpired/internal/db"
+)
+
+type localeKey struct{}
+
+// localeSettings is what a request asked responses to be written for. The
+// user's preferences fill in what it did not ask for, loaded the first time
+// a handler needs them.
+type localeSettings struct {
+	// tag is language.Und when the request named no locale.
+	tag language.Tag
+	// unit is "" when the request named no duration unit.
+	unit  string
+	prefs *db.LocalePreferences
+}
+
+// LocaleMiddleware reads the locale responses should be written for from
+// the locale query parameter, else Accept-Language, and the unit durations
+// are counted in from the units query parameter. Handlers get them from
+// requestLocale and durationUnit, which fall back to the user's preferences.
+func (h *Handler) LocaleMiddleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		settings := &localeSettings{tag: language.Und}
+		if locale := r.URL.Query().Get("locale"); locale != "" {
+			if tag, err := language.Parse(locale); err == nil {
+				settings.tag = tag
+			}
+		}
+		if settings.tag == language.Und {
+			if tags, _, err := language.ParseAcceptLanguage(r.Header.Get("Accept-Language")); err == nil && len(tags) > 0 {
+				settings.tag = tags[0]
+			}
+		}
+		if unit := r.URL.Query().Get("units"); unit == db.DurationAuto || unit == db.DurationDays {
+			settings.unit = unit
+		}
+
+		w.Header().Add("Vary", "Accept-Language")
+		next.ServeHTTP(w, r.WithContext(context.WithValue(r.Context(), localeKey{}, settings)))
+	})
+}
+
+// localeSettings returns the settings LocaleMiddleware put in the request
+// context, with the preferences of the signed-in user loaded.
+func (h *Handler) localeSettings(ctx context.Context) *localeSettings {
+	settings, ok := ctx.Value(localeKey{}).(*localeSettings)
+	if !ok {
+		settings = &localeSettings{tag: language.Und}
+	}
+	if settings.prefs != nil {
+		return settings
+	}
+
+	settings.prefs = &db.LocalePreferences{DurationUnit: db.DurationAuto}
+	if userID, err := auth.UserIDFromContext(ctx); err == nil {
+		prefs, err := h.repo.GetLocalePreferences(ctx, userID)
+		if err != nil {
+			log.Printf("Failed to load locale preferences of user %s: %v", userID, err)
+		} else {
+			settings.prefs = prefs
+		}
+	}
+	return settings
+}
+
+// requestLocale picks the locale responses are written for: the one the
+// request named, else the user's preferred locale, else English.
+func (h *Handler) requestLocale(ctx context.Context) language.Tag {
+	settings := h.localeSettings(ctx)
+	if settings.tag != language.Und {
+		return settings.tag
+	}
+	if settings.prefs.Locale != nil {
+		if tag, err := language.Parse(*settings.prefs.Locale); err == nil {
+			return tag
+		}
+	}
+	return language.English
+}
+
+// durationUnit picks the unit of "expires in" strings: the one the request
+// named, else the user's preferred one.
+func (h *Handler) durationUnit(ctx context.Context) string {
+	settings := h.localeSettings(ctx)
+	if settings.unit != "" {
+		return settings.unit
+	}
+	return settings.prefs.DurationUnit
+}
+
+// expiresIn says when expiration is, relative to today, such as "expires
+// in 3 weeks", in the request's locale and duration unit.
+func (h *Handler) expiresIn(ctx context.Context, expiration time.Time) string {
+	printer := message.NewPrinter(h.requestLocale(ctx))
+	return humanizeExpiry(printer, expiration, time.Now(), h.durationUnit(ctx))
+}
+
+func humanizeExpiry(printer *message.Printer, expiration, now time.Time, unit string) string {
+	today := now.UTC().Truncate(24 * time.Hour)
+	days := int(expiration.UTC().Truncate(24*time.Hour).Sub(today).Hours() / 24)
+
+	switch days {
+	case 0:
+		return printer.Sprintf("expires today")
+	case 1:
+		return printer.Sprintf("expires tomorrow")
+	case -1:
+		return printer.Sprintf("expired yesterday")
+	}
+
+	count := days
+	if count < 0 {
+		count = -count
+	}
+	// beyond two weeks and two months, whole weeks and months read better
+	switch {
+	case unit == db.DurationDays || count < 14:
+		if days > 0 {
+			return printer.Sprintf("expires in %d days", count)
+		}
+		return printer.Sprintf("expired %d days ago", count)
+	case count < 60:
+		if days > 0 {
+			return printer.Sprintf("expires in %d weeks", count/7)
+		}
+		return printer.Sprintf("expired %d weeks ago", count/7)
+	default:
+		if days > 0 {
+			return printer.Sprintf("expires in %d months", count/30)
+		}
+		return printer.Sprintf("expired %d months ago", count/30)
+	}
+}
+
+// expiryTranslations are the humanized expiry strings in the languages
+// besides English they are translated to. Locales of other languages get
+// English.
+var expiryTranslations = map[language.Tag]map[string]string{
+	language.German: {
+		"expires today":         "läuft heute ab",
+		"expires tomorrow":      "läuft morgen ab",
+		"expired yesterday":     "ist gestern abgelaufen",
+		"expires in %d days":    "läuft in %d Tagen ab",
+		"expires in %d weeks":   "läuft in %d Wochen ab",
+		"expires in %d months":  "läuft in %d Monaten ab",
+		"expired %d days ago":   "ist vor %d Tagen abgelaufen",
+		"expired %d weeks ago":  "ist vor %d Wochen abgelaufen",
+		"expired %d months ago": "ist vor %d Monaten abgelaufen",
+	},
+	language.French: {
+		"expires today":         "expire aujourd'hui",
+		"expires tomorrow":      "expire demain",
+		"expired yesterday":     "a expiré hier",
+		"expires in %d days":    "expire dans %d jours",
+		"expires in %d weeks":   "expire dans %d semaines",
+		"expires in %d months":  "expire dans %d mois",
+		"expired %d days ago":   "a expiré il y a %d jours",
+		"expired %d weeks ago":  "a expiré il y a %d semaines",
+		"expired %d months ago": "a expiré il y a %d mois",
+	},
+	language.Spanish: {
+		"expires today":         "vence hoy",
+		"expires tomorrow":      "vence mañana",
+		"expired yesterday":     "venció ayer",
+		"expires in %d days":    "vence en %d días",
+		"expires in %d weeks":   "vence en %d semanas",
+		"expires in %d months":  "vence en %d meses",
+		"expired %d days ago":   "venció hace %d días",
+		"expired %d weeks ago":  "venció hace %d semanas",
+		"expired %d months ago": "venció hace %d meses",
+	},
+}
+
+func init() {
+	for tag, translations := range expiryTranslations {
+		for key, translation := range translations {
+			message.SetString(tag, key, translation)
+		}
+	}
+}
+
+func (h *Handler) GetLocalePreferencesHandler(w http.ResponseWriter, r *http.Request) {
+	userID, err := auth.GetUserIDFromContext(r)
+	if err != nil {
+		errResp := UnauthorizedError("Unauthorized")
+		WriteErrorResponse(w, errResp)
+		return
+	}
+
+	prefs, err := h.repo.GetLocalePreferences(r.Context(), userID)
+	if err != nil {
+		errResp := InternalServerError("Failed to fetch locale preferences")
+		WriteErrorResponse(w, errResp)
+		return
+	}
+
+	resp := map[string]interface{}{
+		"message":     "Locale preferences fetched successfully",
+		"preferences": prefs,
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(resp); err != nil {
+		errResp := InternalServerError("Failed to encode response")
+		WriteErrorResponse(w, errResp)
+	}
+}
+
+// UpdateLocalePreferencesHandler sets how responses are written for the
+// user's requests that name no locale or duration unit. Locales are stored
+// as canonical BCP 47 tags.
+func (h *Handler) UpdateLocalePreferencesHandler(w http.ResponseWriter, r *http.Request) {
+	userID, err := auth.GetUserIDFromContext(r)
+	if err != nil {
+		errResp := UnauthorizedError("Unauthorized")
+		WriteErrorResponse(w, errResp)
+		return
+	}
+
+	var req LocalePreferencesRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		errResp := BadRequestError("Invalid request body")
+		WriteErrorResponse(w, errResp)
+		return
+	}
+	prefs := &db.LocalePreferences{DurationUnit: req.DurationUnit}
+	if prefs.DurationUnit == "" {
+		prefs.DurationUnit = db.DurationAuto
+	}
+	if prefs.DurationUnit != db.DurationAuto && prefs.DurationUnit != db.DurationDays {
+		errResp := BadRequestError("durationUnit must be one of auto, days")
+		WriteErrorResponse(w, errResp)
+		return
+	}
+	if req.Locale != nil {
+		tag, err := language.Parse(*req.Locale)
+		if err != nil {
+			errResp := BadRequestError("locale must be a language tag such as en-GB")
+			WriteErrorResponse(w, errResp)
+			return
+		}
+		locale := tag.String()
+		prefs.Locale = &locale
+	}
+
+	if err := h.repo.SetLocalePreferences(r.Context(), userID, prefs); err != nil {
+		errResp := InternalServerError("Failed to save locale preferences")
+		WriteErrorResponse(w, errResp)
+		return
+	}
+
+	resp := map[string]interface{}{
+		"message":     "Locale preferences updated successfully",
+		"preferences": prefs,
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(resp); err != nil {
+		errResp := InternalServerError("Failed to encode response")
+		WriteErrorResponse(w, errResp)
+	}
+}
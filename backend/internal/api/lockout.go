@@ -0,0 +1,136 @@
+package api
+
+import (
+	"context"
+	"log"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+)
+
+// maxLockoutDuration caps how long repeated lockouts lock out sign-ins. A
+// lockout streak is forgotten once this long passes without another one.
+const maxLockoutDuration = 24 * time.Hour
+
+// loginFailureScript counts a failed sign-in of KEYS[1], a login subject,
+// within a window of ARGV[2] milliseconds. At the ARGV[1]th failure it locks
+// out the subject under KEYS[2] for ARGV[3] milliseconds, doubled for each
+// earlier lockout in the streak counted under KEYS[3] and capped at ARGV[4].
+// It returns the milliseconds the subject was locked out for, or 0.
+var loginFailureScript = redis.NewScript(`
+local max = tonumber(ARGV[1])
+local window = tonumber(ARGV[2])
+local lockout = tonumber(ARGV[3])
+local cap = tonumber(ARGV[4])
+
+local failures = redis.call("INCR", KEYS[1])
+if failures == 1 then
+	redis.call("PEXPIRE", KEYS[1], window)
+end
+if failures < max then
+	return 0
+end
+
+redis.call("DEL", KEYS[1])
+local streak = redis.call("INCR", KEYS[3])
+redis.call("PEXPIRE", KEYS[3], cap)
+local duration = math.floor(math.min(lockout * 2 ^ (streak - 1), cap))
+redis.call("SET", KEYS[2], "1", "PX", duration)
+return duration
+`)
+
+// loginSubject is what failed sign-ins are counted against: an email or a
+// client IP address.
+type loginSubject struct {
+	name        string
+	maxFailures int
+	ip          bool
+}
+
+func (s loginSubject) failuresKey() string { return "xpired:login:failures:" + s.name }
+func (s loginSubject) lockKey() string     { return "xpired:login:lock:" + s.name }
+func (s loginSubject) streakKey() string   { return "xpired:login:lockouts:" + s.name }
+
+// loginSubjects returns the subjects a sign-in to email from r is counted
+// against, leaving out those whose lockout is turned off.
+func (h *Handler) loginSubjects(r *http.Request, email string) []loginSubject {
+	var subjects []loginSubject
+	if max := h.cfg.Login.MaxFailures; max > 0 {
+		subjects = append(subjects, loginSubject{
+			name:        "email:" + strings.ToLower(strings.TrimSpace(email)),
+			maxFailures: max,
+		})
+	}
+	if max := h.cfg.Login.MaxFailuresPerIP; max > 0 {
+		if ip, ok := clientIP(r); ok {
+			subjects = append(subjects, loginSubject{name: "ip:" + ip.String(), maxFailures: max, ip: true})
+		}
+	}
+	return subjects
+}
+
+// loginLockout returns how long sign-ins are still locked out for any of
+// subjects, or 0. Sign-ins are let through when Redis is down.
+func (h *Handler) loginLockout(ctx context.Context, subjects []loginSubject) time.Duration {
+	var longest time.Duration
+	for _, subject := range subjects {
+		ttl, err := h.cache.PTTL(ctx, subject.lockKey()).Result()
+		if err != nil {
+			log.Printf("Failed to read sign-in lockout of %s: %v", subject.name, err)
+			continue
+		}
+		if ttl > longest {
+			longest = ttl
+		}
+	}
+	return longest
+}
+
+// recordLoginFailure counts a failed sign-in against subjects and returns
+// how long it locked sign-ins out for, or 0.
+func (h *Handler) recordLoginFailure(ctx context.Context, subjects []loginSubject) time.Duration {
+	var longest time.Duration
+	for _, subject := range subjects {
+		keys := []string{subject.failuresKey(), subject.lockKey(), subject.streakKey()}
+		ms, err := loginFailureScript.Run(ctx, h.cache, keys, subject.maxFailures,
+			h.cfg.Login.FailureWindow.Milliseconds(), h.cfg.Login.LockoutDuration.Milliseconds(),
+			maxLockoutDuration.Milliseconds()).Int64()
+		if err != nil {
+			log.Printf("Failed to count failed sign-in of %s: %v", subject.name, err)
+			continue
+		}
+		if ms > 0 {
+			duration := time.Duration(ms) * time.Millisecond
+			log.Printf("Locked out sign-ins of %s for %s after %d failed attempts", subject.name, duration, subject.maxFailures)
+			if duration > longest {
+				longest = duration
+			}
+		}
+	}
+	return longest
+}
+
+// clearLoginFailures forgets the failed sign-ins and lockout streak of the
+// email a user signed in to. Those of the IP address are kept, so signing
+// in to one's own account does not reset guessing at others.
+func (h *Handler) clearLoginFailures(ctx context.Context, subjects []loginSubject) {
+	for _, subject := range subjects {
+		if subject.ip {
+			continue
+		}
+		if err := h.cache.Del(ctx, subject.failuresKey(), subject.streakKey()).Err(); err != nil {
+			log.Printf("Failed to clear failed sign-ins of %s: %v", subject.name, err)
+		}
+	}
+}
+
+// writeLoginLockout answers a locked out sign-in with 429 and when to retry.
+func writeLoginLockout(w http.ResponseWriter, lockout time.Duration) {
+	seconds := int((lockout + time.Second - 1) / time.Second)
+	w.Header().Set("Retry-After", strconv.Itoa(seconds))
+	errResp := TooManyRequestsError("Too many failed sign-in attempts, please try again later")
+	WriteErrorResponse(w, errResp)
+}
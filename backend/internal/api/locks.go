@@ -0,0 +1,137 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"time"
+
+	"xpired/internal/db"
+)
+
+const (
+	defaultDocumentLockTTL = 5 * time.Minute
+	maxDocumentLockTTL     = time.Hour
+)
+
+func toDocumentLockResponse(lock *db.DocumentLock, userID string) *DocumentLockResponse {
+	return &DocumentLockResponse{
+		UserID:    lock.UserID,
+		UserName:  lock.UserName,
+		HeldByYou: lock.UserID == userID,
+		LockedAt:  lock.LockedAt,
+		ExpiresAt: lock.ExpiresAt,
+	}
+}
+
+// documentLock returns the active lock on a document as seen by userID, or
+// nil when it is unlocked.
+func (h *Handler) documentLock(ctx context.Context, documentID, userID string) *DocumentLockResponse {
+	lock, err := h.repo.GetDocumentLock(ctx, documentID)
+	if err != nil {
+		return nil
+	}
+	return toDocumentLockResponse(lock, userID)
+}
+
+// ensureDocumentUnlocked rejects an edit when another user holds the
+// document's lock. On failure it writes the error response and returns false.
+func (h *Handler) ensureDocumentUnlocked(w http.ResponseWriter, r *http.Request, documentID, userID string) bool {
+	lock, err := h.repo.GetDocumentLock(r.Context(), documentID)
+	if err != nil || lock.UserID == userID {
+		return true
+	}
+	errResp := LockedError("Document is being edited by " + lock.UserName)
+	WriteErrorResponse(w, errResp)
+	return false
+}
+
+// LockDocumentHandler takes the edit lock on a document, or extends it when
+// the caller already holds it. Clients keep calling it while the editor is
+// open; the lock lapses on its own once they stop.
+func (h *Handler) LockDocumentHandler(w http.ResponseWriter, r *http.Request) {
+	doc, userID, ok := h.loadOwnedDocument(w, r)
+	if !ok {
+		return
+	}
+
+	var req DocumentLockRequest
+	if r.ContentLength != 0 {
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			errResp := BadRequestError("Invalid request body")
+			WriteErrorResponse(w, errResp)
+			return
+		}
+	}
+
+	ttl := defaultDocumentLockTTL
+	if req.TTLSeconds != nil {
+		ttl = time.Duration(*req.TTLSeconds) * time.Second
+	}
+	if ttl <= 0 || ttl > maxDocumentLockTTL {
+		errResp := BadRequestError("ttlSeconds must be between 1 and 3600")
+		WriteErrorResponse(w, errResp)
+		return
+	}
+
+	lock, err := h.repo.AcquireDocumentLock(r.Context(), doc.ID.String(), userID, time.Now().Add(ttl))
+	if err != nil {
+		if err.Error() == "document is locked" {
+			msg := "Document is locked by another user"
+			if holder, err := h.repo.GetDocumentLock(r.Context(), doc.ID.String()); err == nil {
+				msg = "Document is being edited by " + holder.UserName
+			}
+			errResp := ConflictError(msg)
+			WriteErrorResponse(w, errResp)
+			return
+		}
+		errResp := InternalServerError("Failed to lock document")
+		WriteErrorResponse(w, errResp)
+		return
+	}
+
+	lockResp := h.documentLock(r.Context(), doc.ID.String(), userID)
+	if lockResp == nil {
+		lockResp = toDocumentLockResponse(lock, userID)
+	}
+
+	resp := map[string]interface{}{
+		"message": "Document locked successfully",
+		"lock":    lockResp,
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(resp); err != nil {
+		errResp := InternalServerError("Failed to encode response")
+		WriteErrorResponse(w, errResp)
+	}
+}
+
+// UnlockDocumentHandler releases the edit lock. The holder can always release
+// it; the document owner can also break a teammate's lock.
+func (h *Handler) UnlockDocumentHandler(w http.ResponseWriter, r *http.Request) {
+	doc, userID, ok := h.loadOwnedDocument(w, r)
+	if !ok {
+		return
+	}
+
+	lock, err := h.repo.GetDocumentLock(r.Context(), doc.ID.String())
+	if err != nil {
+		errResp := NotFoundError("Document is not locked")
+		WriteErrorResponse(w, errResp)
+		return
+	}
+	if lock.UserID != userID && doc.UserID.String() != userID {
+		errResp := ForbiddenError("Document is locked by another user")
+		WriteErrorResponse(w, errResp)
+		return
+	}
+
+	if err := h.repo.ReleaseDocumentLock(r.Context(), doc.ID.String()); err != nil && err.Error() != "document lock not found" {
+		errResp := InternalServerError("Failed to unlock document")
+		WriteErrorResponse(w, errResp)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
@@ -0,0 +1,128 @@
+package api
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	"xpired/internal/db"
+	"xpired/internal/worker"
+)
+
+// usageMonths reads the inclusive from and to months, 'YYYY-MM', of a usage
+// export. Both default to the current UTC month. On failure it writes the
+// error response and returns false.
+func usageMonths(w http.ResponseWriter, r *http.Request) (string, string, bool) {
+	current := time.Now().UTC().Format("2006-01")
+	from, to := r.URL.Query().Get("from"), r.URL.Query().Get("to")
+	if from == "" {
+		from = current
+	}
+	if to == "" {
+		to = current
+	}
+	for _, month := range []string{from, to} {
+		if _, err := time.Parse("2006-01", month); err != nil {
+			errResp := BadRequestError("from and to must be months formatted as YYYY-MM")
+			WriteErrorResponse(w, errResp)
+			return "", "", false
+		}
+	}
+	if from > to {
+		errResp := BadRequestError("from must not be after to")
+		WriteErrorResponse(w, errResp)
+		return "", "", false
+	}
+	return from, to, true
+}
+
+// writeUsageExport sends usage as a file named after name and the months, in
+// CSV when the format query parameter asks for it and JSON otherwise.
+func writeUsageExport(w http.ResponseWriter, r *http.Request, name, from, to string, usage []*db.OrganizationUsage) {
+	if usage == nil {
+		usage = []*db.OrganizationUsage{}
+	}
+	filename := fmt.Sprintf("usage-%s-%s-%s", name, from, to)
+
+	if r.URL.Query().Get("format") == "csv" {
+		w.Header().Set("Content-Type", "text/csv")
+		w.Header().Set("Content-Disposition", `attachment; filename="`+filename+`.csv"`)
+		out := csv.NewWriter(w)
+		_ = out.Write([]string{"organization_id", "month", "metric", "quantity", "recorded_at"})
+		for _, u := range usage {
+			_ = out.Write([]string{u.OrganizationID, u.Month, u.Metric, strconv.FormatInt(u.Quantity, 10), u.RecordedAt.UTC().Format(time.RFC3339)})
+		}
+		out.Flush()
+		return
+	}
+
+	resp := map[string]interface{}{
+		"from":  from,
+		"to":    to,
+		"usage": usage,
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Content-Disposition", `attachment; filename="`+filename+`.json"`)
+	if err := json.NewEncoder(w).Encode(resp); err != nil {
+		errResp := InternalServerError("Failed to encode response")
+		WriteErrorResponse(w, errResp)
+	}
+}
+
+// ExportOrganizationUsageHandler exports the monthly metered usage of an
+// organization, for chargeback reports.
+func (h *Handler) ExportOrganizationUsageHandler(w http.ResponseWriter, r *http.Request) {
+	org, caller, ok := h.loadOrganizationMembership(w, r)
+	if !ok {
+		return
+	}
+	if !canManageOrganization(caller) {
+		errResp := ForbiddenError("Only organization owners and admins can export usage")
+		WriteErrorResponse(w, errResp)
+		return
+	}
+	from, to, ok := usageMonths(w, r)
+	if !ok {
+		return
+	}
+
+	ctx, err := worker.OrganizationContext(r.Context(), h.repo, org.ID.String())
+	if err != nil {
+		errResp := InternalServerError("Failed to load organization")
+		WriteErrorResponse(w, errResp)
+		return
+	}
+	usage, err := h.repo.ListOrganizationUsage(ctx, org.ID.String(), from, to)
+	if err != nil {
+		errResp := InternalServerError("Failed to fetch usage")
+		WriteErrorResponse(w, errResp)
+		return
+	}
+
+	writeUsageExport(w, r, org.ID.String(), from, to, usage)
+}
+
+// ExportUsageHandler exports the monthly metered usage of every
+// organization, across data regions, for invoicing.
+func (h *Handler) ExportUsageHandler(w http.ResponseWriter, r *http.Request) {
+	from, to, ok := usageMonths(w, r)
+	if !ok {
+		return
+	}
+
+	var usage []*db.OrganizationUsage
+	for _, ctx := range h.regionContexts(r) {
+		regional, err := h.repo.ListOrganizationUsage(ctx, "", from, to)
+		if err != nil {
+			errResp := InternalServerError("Failed to fetch usage")
+			WriteErrorResponse(w, errResp)
+			return
+		}
+		usage = append(usage, regional...)
+	}
+
+	writeUsageExport(w, r, "all", from, to, usage)
+}
@@ -0,0 +1,165 @@
+package api
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"regexp"
+	"strings"
+
+	"xpired/internal/db"
+)
+
+var themeColorPattern = regexp.MustCompile(`^#[0-9a-fA-F]{6}$`)
+
+// maxThemeFooterLength caps the footer text of a notification theme.
+const maxThemeFooterLength = 500
+
+// themeField returns nil for an omitted or blank field, which keeps the
+// default look.
+func themeField(value *string) *string {
+	if value == nil {
+		return nil
+	}
+	trimmed := strings.TrimSpace(*value)
+	if trimmed == "" {
+		return nil
+	}
+	return &trimmed
+}
+
+// validateNotificationTheme checks the fields of a theme and returns the
+// message of the first problem, or "".
+func validateNotificationTheme(theme *db.NotificationTheme) string {
+	if theme.LogoURL != nil {
+		logo, err := url.Parse(*theme.LogoURL)
+		if err != nil || logo.Scheme != "https" || logo.Host == "" {
+			return "logoUrl must be an https URL"
+		}
+	}
+	if theme.PrimaryColor != nil && !themeColorPattern.MatchString(*theme.PrimaryColor) {
+		return "primaryColor must be a #rrggbb hex color"
+	}
+	if theme.BackgroundColor != nil && !themeColorPattern.MatchString(*theme.BackgroundColor) {
+		return "backgroundColor must be a #rrggbb hex color"
+	}
+	if theme.FooterText != nil && len(*theme.FooterText) > maxThemeFooterLength {
+		return "footerText must be at most 500 characters"
+	}
+	return ""
+}
+
+// loadThemeSettings authorizes access to the notification theme of the
+// organization in the URL. On failure it writes the error response and
+// returns nil.
+func (h *Handler) loadThemeSettings(w http.ResponseWriter, r *http.Request) *db.Organization {
+	org, caller, ok := h.loadOrganizationMembership(w, r)
+	if !ok {
+		return nil
+	}
+	if !canManageOrganization(caller) {
+		errResp := ForbiddenError("Only organization owners and admins can manage the notification theme")
+		WriteErrorResponse(w, errResp)
+		return nil
+	}
+	return org
+}
+
+func (h *Handler) GetNotificationThemeHandler(w http.ResponseWriter, r *http.Request) {
+	org := h.loadThemeSettings(w, r)
+	if org == nil {
+		return
+	}
+
+	theme, err := h.repo.GetNotificationTheme(r.Context(), org.ID.String())
+	if err != nil {
+		if err.Error() == "notification theme not found" {
+			errResp := NotFoundError("Organization has no notification theme")
+			WriteErrorResponse(w, errResp)
+			return
+		}
+		errResp := InternalServerError("Failed to fetch notification theme")
+		WriteErrorResponse(w, errResp)
+		return
+	}
+
+	resp := map[string]interface{}{
+		"message": "Notification theme retrieved successfully",
+		"theme":   theme,
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(resp); err != nil {
+		errResp := InternalServerError("Failed to encode response")
+		WriteErrorResponse(w, errResp)
+	}
+}
+
+// UpdateNotificationThemeHandler replaces the branding of the emails sent for
+// the organization's documents. Omitted fields fall back to the default look.
+func (h *Handler) UpdateNotificationThemeHandler(w http.ResponseWriter, r *http.Request) {
+	org := h.loadThemeSettings(w, r)
+	if org == nil {
+		return
+	}
+
+	var req NotificationThemeRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		errResp := BadRequestError("Invalid request body")
+		WriteErrorResponse(w, errResp)
+		return
+	}
+
+	theme := &db.NotificationTheme{
+		OrganizationID:  org.ID.String(),
+		LogoURL:         themeField(req.LogoURL),
+		PrimaryColor:    themeField(req.PrimaryColor),
+		BackgroundColor: themeField(req.BackgroundColor),
+		FooterText:      themeField(req.FooterText),
+	}
+	if msg := validateNotificationTheme(theme); msg != "" {
+		errResp := BadRequestError(msg)
+		WriteErrorResponse(w, errResp)
+		return
+	}
+
+	if err := h.repo.SetNotificationTheme(r.Context(), theme); err != nil {
+		errResp := InternalServerError("Failed to save notification theme")
+		WriteErrorResponse(w, errResp)
+		return
+	}
+
+	resp := map[string]interface{}{
+		"message": "Notification theme saved successfully",
+		"theme":   theme,
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(resp); err != nil {
+		errResp := InternalServerError("Failed to encode response")
+		WriteErrorResponse(w, errResp)
+	}
+}
+
+func (h *Handler) DeleteNotificationThemeHandler(w http.ResponseWriter, r *http.Request) {
+	org := h.loadThemeSettings(w, r)
+	if org == nil {
+		return
+	}
+
+	if err := h.repo.DeleteNotificationTheme(r.Context(), org.ID.String()); err != nil {
+		errResp := InternalServerError("Failed to delete notification theme")
+		WriteErrorResponse(w, errResp)
+		return
+	}
+
+	resp := map[string]interface{}{
+		"message": "Notification theme removed",
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(resp); err != nil {
+		errResp := InternalServerError("Failed to encode response")
+		WriteErrorResponse(w, errResp)
+	}
+}
@@ -0,0 +1,274 @@
+package api
+
+import (
+	"context"
+	"crypto/subtle"
+	"encoding/base64"
+	"encoding/json"
+	"log"
+	"net/http"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+
+	"xpired/internal/auth"
+	"xpired/internal/db"
+	worker "xpired/internal/worker"
+)
+
+// maxNotificationPause is how far ahead notifications can be paused.
+const maxNotificationPause = 365 * 24 * time.Hour
+
+// transparentGIF is a 1x1 transparent GIF served by the open-tracking pixel.
+var transparentGIF, _ = base64.StdEncoding.DecodeString("R0lGODlhAQABAIAAAAAAAP///yH5BAEAAAAALAAAAAABAAEAAAIBRAA7")
+
+// validateChannelMatrix returns a client-facing message describing what is
+// wrong with matrix, or "" if it is valid. Keys are reminder interval id
+// labels; an empty channel list silences that interval.
+func (h *Handler) validateChannelMatrix(r *http.Request, matrix map[string][]string) string {
+	if len(matrix) == 0 {
+		return ""
+	}
+
+	idLabels := make([]string, 0, len(matrix))
+	for idLabel, channels := range matrix {
+		idLabels = append(idLabels, idLabel)
+		for _, channel := range channels {
+			if channel != db.ChannelEmail && channel != db.ChannelSMS && channel != db.ChannelPush {
+				return "channelMatrix channels must be one of email, sms, push"
+			}
+		}
+	}
+
+	intervals, err := h.repo.GetReminderIntervalsFromIdLabels(r.Context(), idLabels)
+	if err != nil || len(intervals) != len(idLabels) {
+		return "channelMatrix keys must be valid reminder interval IDs"
+	}
+	return ""
+}
+
+func (h *Handler) GetNotificationPreferencesHandler(w http.ResponseWriter, r *http.Request) {
+	userID, err := auth.GetUserIDFromContext(r)
+	if err != nil {
+		errResp := UnauthorizedError("Unauthorized")
+		WriteErrorResponse(w, errResp)
+		return
+	}
+
+	prefs, err := h.repo.GetNotificationPreferences(r.Context(), userID)
+	if err != nil {
+		errResp := InternalServerError("Failed to fetch notification preferences")
+		WriteErrorResponse(w, errResp)
+		return
+	}
+
+	resp := map[string]interface{}{
+		"message":     "Notification preferences fetched successfully",
+		"preferences": prefs,
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(resp); err != nil {
+		errResp := InternalServerError("Failed to encode response")
+		WriteErrorResponse(w, errResp)
+	}
+}
+
+func (h *Handler) UpdateNotificationPreferencesHandler(w http.ResponseWriter, r *http.Request) {
+	userID, err := auth.GetUserIDFromContext(r)
+	if err != nil {
+		errResp := UnauthorizedError("Unauthorized")
+		WriteErrorResponse(w, errResp)
+		return
+	}
+
+	var req NotificationPreferencesRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		errResp := BadRequestError("Invalid request body")
+		WriteErrorResponse(w, errResp)
+		return
+	}
+	if req.EscalationChannel != db.EscalationNone && req.EscalationChannel != db.EscalationSMS {
+		errResp := BadRequestError("escalationChannel must be one of none, sms")
+		WriteErrorResponse(w, errResp)
+		return
+	}
+	if req.EscalationAfterDays < 1 || req.EscalationAfterDays > 30 {
+		errResp := BadRequestError("escalationAfterDays must be between 1 and 30")
+		WriteErrorResponse(w, errResp)
+		return
+	}
+
+	if req.BatchWindowHours < 0 || req.BatchWindowHours > 24 {
+		errResp := BadRequestError("batchWindowHours must be between 0 and 24")
+		WriteErrorResponse(w, errResp)
+		return
+	}
+	if msg := h.validateChannelMatrix(r, req.ChannelMatrix); msg != "" {
+		errResp := BadRequestError(msg)
+		WriteErrorResponse(w, errResp)
+		return
+	}
+	// a pause that already ended is the same as none, so clients can send
+	// back the preferences they fetched
+	pausedUntil := req.PausedUntil
+	if pausedUntil != nil && !pausedUntil.After(time.Now()) {
+		pausedUntil = nil
+	}
+	if pausedUntil != nil && time.Until(*pausedUntil) > maxNotificationPause {
+		errResp := BadRequestError("pausedUntil must be within a year")
+		WriteErrorResponse(w, errResp)
+		return
+	}
+
+	previous, err := h.repo.GetNotificationPreferences(r.Context(), userID)
+	if err != nil {
+		errResp := InternalServerError("Failed to fetch notification preferences")
+		WriteErrorResponse(w, errResp)
+		return
+	}
+
+	prefs := &db.NotificationPreferences{
+		UserID:              userID,
+		EscalationChannel:   req.EscalationChannel,
+		EscalationAfterDays: req.EscalationAfterDays,
+		ChannelMatrix:       req.ChannelMatrix,
+		BatchWindowHours:    req.BatchWindowHours,
+		CalendarInvites:     req.CalendarInvites,
+		PausedUntil:         pausedUntil,
+		OptimizeSendTime:    req.OptimizeSendTime,
+	}
+	if err := h.repo.UpsertNotificationPreferences(r.Context(), prefs); err != nil {
+		errResp := InternalServerError("Failed to save notification preferences")
+		WriteErrorResponse(w, errResp)
+		return
+	}
+	h.catchUpEndedPause(r, userID, previous.PausedUntil, pausedUntil)
+
+	resp := map[string]interface{}{
+		"message":     "Notification preferences updated successfully",
+		"preferences": prefs,
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(resp); err != nil {
+		errResp := InternalServerError("Failed to encode response")
+		WriteErrorResponse(w, errResp)
+	}
+}
+
+// catchUpEndedPause moves the catch-up of the reminders deferred while the
+// user paused notifications forward when the pause was cut short, since it
+// is scheduled for when the pause was to end. Deferred reminders are held in
+// the database of each data region, so the catch-up runs in each.
+func (h *Handler) catchUpEndedPause(r *http.Request, userID string, previous, current *time.Time) {
+	if previous == nil || !previous.After(time.Now()) {
+		return
+	}
+	runAt := time.Now()
+	if current != nil {
+		if !current.Before(*previous) {
+			return
+		}
+		runAt = *current
+	}
+
+	for _, ctx := range h.regionContexts(r) {
+		if err := worker.ScheduleReminderCatchUp(ctx, userID, runAt); err != nil {
+			log.Printf("Failed to schedule reminder catch-up for user %s: %v", userID, err)
+		}
+	}
+}
+
+// TrackOpenHandler serves the tracking pixel embedded in reminder emails and
+// records the first open of the message. It always returns the image so
+// mail clients never show a broken picture.
+func (h *Handler) TrackOpenHandler(w http.ResponseWriter, r *http.Request) {
+	messageID := chi.URLParam(r, "messageId")
+	if _, err := uuid.Parse(messageID); err == nil {
+		// The message may live in any data region; marking is a no-op where
+		// it does not.
+		for _, ctx := range h.regionContexts(r) {
+			if err := h.repo.MarkNotificationOpened(ctx, messageID); err != nil {
+				log.Printf("Failed to record open of message %s: %v", messageID, err)
+			}
+		}
+	}
+
+	w.Header().Set("Content-Type", "image/gif")
+	w.Header().Set("Cache-Control", "no-store, max-age=0")
+	w.Write(transparentGIF)
+}
+
+// EmailBounceWebhookHandler receives bounce callbacks from the email provider
+// and escalates the reminder right away instead of waiting for the
+// unopened-email grace period.
+func (h *Handler) EmailBounceWebhookHandler(w http.ResponseWriter, r *http.Request) {
+	// without the secret a forged bounce could not be told apart, and it
+	// escalates reminders to other, possibly paid, channels
+	secret := h.cfg.Notifications.EmailWebhookSecret
+	if secret == "" {
+		errResp := ForbiddenError("Bounce reports are not enabled")
+		WriteErrorResponse(w, errResp)
+		return
+	}
+	given := r.Header.Get("X-Webhook-Secret")
+	if subtle.ConstantTimeCompare([]byte(given), []byte(secret)) != 1 {
+		errResp := ForbiddenError("Invalid webhook secret")
+		WriteErrorResponse(w, errResp)
+		return
+	}
+
+	var req EmailBounceRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		errResp := BadRequestError("Invalid request body")
+		WriteErrorResponse(w, errResp)
+		return
+	}
+	if _, err := uuid.Parse(req.MessageID); err != nil {
+		errResp := BadRequestError("messageId must be a valid UUID")
+		WriteErrorResponse(w, errResp)
+		return
+	}
+
+	var (
+		messageCtx context.Context
+		err        error
+	)
+	for _, ctx := range h.regionContexts(r) {
+		err = h.repo.MarkNotificationBounced(ctx, req.MessageID)
+		if err == nil {
+			messageCtx = ctx
+			break
+		}
+		if err.Error() != "notification log not found" {
+			break
+		}
+	}
+	if messageCtx == nil {
+		if err.Error() == "notification log not found" {
+			errResp := NotFoundError("Message not found")
+			WriteErrorResponse(w, errResp)
+			return
+		}
+		errResp := InternalServerError("Failed to record bounce")
+		WriteErrorResponse(w, errResp)
+		return
+	}
+
+	log.Printf("Email message %s bounced: %s", req.MessageID, req.Reason)
+	if err := worker.ScheduleEscalation(messageCtx, req.MessageID, time.Now()); err != nil {
+		log.Printf("Failed to schedule escalation for message %s: %v", req.MessageID, err)
+	}
+
+	resp := map[string]interface{}{
+		"message": "Bounce recorded",
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(resp); err != nil {
+		errResp := InternalServerError("Failed to encode response")
+		WriteErrorResponse(w, errResp)
+	}
+}
@@ -0,0 +1,272 @@
+package api
+
+import (
+	"crypto/subtle"
+	"log"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+
+	"xpired/internal/auth/oauth"
+	"xpired/internal/config"
+	"xpired/internal/db"
+	"xpired/internal/worker"
+)
+
+const (
+	// oauthStateCookie holds the state a sign-in was started with until the
+	// provider sends the user back, so callbacks nobody started are refused.
+	oauthStateCookie = "oauth_state"
+	oauthStateTTL    = 10 * time.Minute
+	oauthTimeout     = 10 * time.Second
+)
+
+// oauthProviders returns the sign-in providers an application is configured
+// for, by name.
+func oauthProviders(cfg *config.Config) map[string]*oauth.Provider {
+	client := worker.EgressClient(cfg.Egress, oauthTimeout)
+	providers := map[string]*oauth.Provider{}
+	if google := cfg.OAuth.Google; google.ClientID != "" {
+		providers[oauth.ProviderGoogle] = oauth.Google(google.ClientID, google.ClientSecret, client)
+	}
+	if github := cfg.OAuth.GitHub; github.ClientID != "" {
+		providers[oauth.ProviderGitHub] = oauth.GitHub(github.ClientID, github.ClientSecret, client)
+	}
+	return providers
+}
+
+func (h *Handler) oauthRedirectURL(provider *oauth.Provider) string {
+	return h.cfg.App.BaseURL + "/api/auth/oauth/" + provider.Name + "/callback"
+}
+
+func (h *Handler) loadOAuthProvider(w http.ResponseWriter, r *http.Request) (*oauth.Provider, bool) {
+	provider, ok := h.oauth[chi.URLParam(r, "provider")]
+	if !ok {
+		errResp := NotFoundError("Sign-in provider not available")
+		WriteErrorResponse(w, errResp)
+		return nil, false
+	}
+	return provider, true
+}
+
+// OAuthLoginHandler sends the user to the provider to sign in, which sends
+// them back to OAuthCallbackHandler.
+func (h *Handler) OAuthLoginHandler(w http.ResponseWriter, r *http.Request) {
+	provider, ok := h.loadOAuthProvider(w, r)
+	if !ok {
+		return
+	}
+
+	state, err := randomToken(16)
+	if err != nil {
+		errResp := InternalServerError("Failed to start sign-in")
+		WriteErrorResponse(w, errResp)
+		return
+	}
+	// Lax, not Strict: the cookie must come along when the provider sends
+	// the user back
+	http.SetCookie(w, &http.Cookie{
+		Name:     oauthStateCookie,
+		Value:    state,
+		HttpOnly: true,
+		Path:     "/api/auth/oauth/",
+		Secure:   strings.HasPrefix(h.cfg.App.BaseURL, "https://"),
+		SameSite: http.SameSiteLaxMode,
+		MaxAge:   int(oauthStateTTL.Seconds()),
+	})
+
+	http.Redirect(w, r, provider.AuthCodeURL(h.oauthRedirectURL(provider), state), http.StatusFound)
+}
+
+// OAuthCallbackHandler signs in the user the provider sent back. Their
+// provider account signs them in to the user it is linked to, or is linked
+// to the user with the same email, which the provider must have verified,
+// dropping the password that user signed up with; otherwise a new user
+// without a password is created. The session token is
+// set as the auth cookie and handed to the frontend in the fragment of its
+// /auth/callback URL.
+func (h *Handler) OAuthCallbackHandler(w http.ResponseWriter, r *http.Request) {
+	provider, ok := h.loadOAuthProvider(w, r)
+	if !ok {
+		return
+	}
+
+	query := r.URL.Query()
+	if providerErr := query.Get("error"); providerErr != "" {
+		errResp := UnauthorizedError("Sign-in was not completed: " + providerErr)
+		WriteErrorResponse(w, errResp)
+		return
+	}
+	cookie, err := r.Cookie(oauthStateCookie)
+	if err != nil || cookie.Value == "" || subtle.ConstantTimeCompare([]byte(cookie.Value), []byte(query.Get("state"))) != 1 {
+		errResp := BadRequestError("Invalid or expired sign-in state, please try again")
+		WriteErrorResponse(w, errResp)
+		return
+	}
+	http.SetCookie(w, &http.Cookie{
+		Name:     oauthStateCookie,
+		HttpOnly: true,
+		Path:     "/api/auth/oauth/",
+		MaxAge:   -1,
+	})
+
+	identity, err := provider.Exchange(r.Context(), h.oauthRedirectURL(provider), query.Get("code"))
+	if err != nil {
+		log.Printf("Failed to sign in with %s: %v", provider.Name, err)
+		errResp := UnauthorizedError("Failed to sign in with " + provider.Name)
+		WriteErrorResponse(w, errResp)
+		return
+	}
+
+	user, ok := h.oauthUser(w, r, provider, identity)
+	if !ok {
+		return
+	}
+	if user.SuspendedAt != nil {
+		errResp := ForbiddenError("Account suspended")
+		WriteErrorResponse(w, errResp)
+		return
+	}
+	if !h.ensureNotDeactivated(w, r, user.ID.String()) {
+		return
+	}
+
+	token, claims, err := h.generateToken(r.Context(), user)
+	if err != nil {
+		errResp := InternalServerError("Failed to generate token")
+		WriteErrorResponse(w, errResp)
+		return
+	}
+	if err := h.recordSession(r, claims); err != nil {
+		log.Printf("Failed to record session of user %s: %v", claims.Subject, err)
+		errResp := InternalServerError("Failed to create session")
+		WriteErrorResponse(w, errResp)
+		return
+	}
+
+	http.SetCookie(w, &http.Cookie{
+		Name:     "auth",
+		Value:    token,
+		HttpOnly: true,
+		Path:     "/",
+		Secure:   false, // TODO: change to true in production
+		SameSite: http.SameSiteStrictMode,
+		MaxAge:   86400,
+	})
+	http.Redirect(w, r, h.cfg.App.FrontendURL+"/auth/callback#token="+token, http.StatusFound)
+}
+
+// oauthUser returns the user identity signs in as, linking or creating it as
+// OAuthCallbackHandler describes. It writes the error response on failure.
+func (h *Handler) oauthUser(w http.ResponseWriter, r *http.Request, provider *oauth.Provider, identity *oauth.Identity) (*db.User, bool) {
+	userID, err := h.repo.GetUserIDByProvider(r.Context(), provider.Name, identity.ID)
+	if err == nil {
+		user, err := h.repo.GetUserByID(r.Context(), userID)
+		if err != nil {
+			errResp := InternalServerError("Failed to fetch user")
+			WriteErrorResponse(w, errResp)
+			return nil, false
+		}
+		return user, true
+	}
+	if err.Error() != "user does not exist" {
+		errResp := InternalServerError("Failed to fetch user")
+		WriteErrorResponse(w, errResp)
+		return nil, false
+	}
+
+	// linking by email hands the account to whoever holds the address at the
+	// provider, so the provider must have checked they do
+	if identity.Email == "" || !identity.EmailVerified {
+		errResp := ForbiddenError("Your " + provider.Name + " account has no verified email address")
+		WriteErrorResponse(w, errResp)
+		return nil, false
+	}
+
+	user, err := h.repo.GetUserByEmail(r.Context(), identity.Email)
+	if err != nil {
+		if h.disposableDomains.Contains(identity.Email) {
+			log.Printf("Rejected registration of %s from %s: disposable email domain", identity.Email, r.RemoteAddr)
+			errResp := BadRequestError("Disposable email addresses cannot be used to sign up")
+			WriteErrorResponse(w, errResp)
+			return nil, false
+		}
+
+		name := identity.Name
+		if name == "" {
+			name = strings.Split(identity.Email, "@")[0]
+		}
+		user = &db.User{
+			ID:        uuid.New(),
+			Email:     identity.Email,
+			Name:      name,
+			CreatedAt: time.Now(),
+			UpdatedAt: time.Now(),
+		}
+		if err := h.repo.CreateUser(r.Context(), user); err != nil {
+			errResp := InternalServerError("Failed to create user")
+			WriteErrorResponse(w, errResp)
+			return nil, false
+		}
+	}
+
+	// before linking, so a failure here cannot leave a link that skips it
+	if user.Password != "" && !h.dropUnverifiedCredentials(w, r, user) {
+		return nil, false
+	}
+
+	// a user already linked to another provider account keeps that link and
+	// can sign in with this one by email too
+	if err := h.repo.LinkUserProvider(r.Context(), user.ID.String(), provider.Name, identity.ID); err != nil {
+		errResp := InternalServerError("Failed to link " + provider.Name + " account")
+		WriteErrorResponse(w, errResp)
+		return nil, false
+	}
+	return user, true
+}
+
+// dropUnverifiedCredentials makes the provider account the only way into
+// a user it was linked to by email. Sign-up by password never proves the
+// address, so whoever set the password may not own it: they could have
+// registered it ahead of its owner to share the account once linked. Their
+// password, sessions and API keys are dropped. It writes the error response
+// on failure.
+func (h *Handler) dropUnverifiedCredentials(w http.ResponseWriter, r *http.Request, user *db.User) bool {
+	userID := user.ID.String()
+	if err := h.repo.ClearUserPassword(r.Context(), userID); err != nil {
+		log.Printf("Failed to clear password of user %s linked by email: %v", userID, err)
+		errResp := InternalServerError("Failed to link account")
+		WriteErrorResponse(w, errResp)
+		return false
+	}
+	user.Password = ""
+
+	sessions, err := h.repo.RevokeSessions(r.Context(), userID, "")
+	if err != nil {
+		log.Printf("Failed to revoke sessions of user %s linked by email: %v", userID, err)
+		errResp := InternalServerError("Failed to link account")
+		WriteErrorResponse(w, errResp)
+		return false
+	}
+	h.cacheRevokedSessions(r.Context(), sessions)
+
+	keys, err := h.repo.ListAPIKeys(r.Context(), userID)
+	if err != nil {
+		log.Printf("Failed to list API keys of user %s linked by email: %v", userID, err)
+		errResp := InternalServerError("Failed to link account")
+		WriteErrorResponse(w, errResp)
+		return false
+	}
+	for _, key := range keys {
+		if err := h.repo.DeleteAPIKey(r.Context(), userID, key.ID.String()); err != nil {
+			log.Printf("Failed to delete API key %s of user %s linked by email: %v", key.ID.String(), userID, err)
+			errResp := InternalServerError("Failed to link account")
+			WriteErrorResponse(w, errResp)
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,223 @@
+package api
+
+import (
+	"encoding/json"
+	"net/http"
+	"time"
+
+	"github.com/google/uuid"
+
+	"xpired/internal/auth"
+	"xpired/internal/db"
+	"xpired/internal/worker"
+)
+
+// sampleDocument is an example document created by CreateSampleDataHandler
+// from a template of the gallery. Expirations are near enough for the first
+// reminders to go out within days.
+type sampleDocument struct {
+	template      string
+	name          string
+	identifier    string
+	expiresInDays int
+	fields        map[string]string
+}
+
+var sampleDocuments = []sampleDocument{
+	{
+		template:      "certificate",
+		name:          "Sample: example.com TLS certificate",
+		identifier:    "example.com",
+		expiresInDays: 10,
+		fields:        map[string]string{"issuer": "Let's Encrypt", "hosts": "example.com, www.example.com"},
+	},
+	{
+		template:      "domain",
+		name:          "Sample: example.com domain",
+		identifier:    "example.com",
+		expiresInDays: 21,
+		fields:        map[string]string{"registrar": "Example Registrar", "auto_renew": "No"},
+	},
+	{
+		template:      "insurance",
+		name:          "Sample: Car insurance",
+		identifier:    "POL-0012345",
+		expiresInDays: 35,
+		fields:        map[string]string{"insurer": "Example Insurance Co.", "coverage": "Comprehensive"},
+	},
+	{
+		template:      "passport",
+		name:          "Sample: Passport",
+		identifier:    "X0000000",
+		expiresInDays: 95,
+		fields:        map[string]string{"issuing_country": "Ghana"},
+	},
+}
+
+// CreateSampleDataHandler fills the account of a new user with a few example
+// documents, so reminders and the dashboard have something to show before
+// they add their own. It refuses while earlier sample data still exists;
+// DeleteSampleDataHandler removes it all. Sample documents fire no webhooks.
+func (h *Handler) CreateSampleDataHandler(w http.ResponseWriter, r *http.Request) {
+	userID, err := auth.GetUserIDFromContext(r)
+	if err != nil {
+		errResp := UnauthorizedError("Unauthorized")
+		WriteErrorResponse(w, errResp)
+		return
+	}
+	if err := h.repo.CheckUserExistsById(r.Context(), userID); err != nil {
+		errResp := NotFoundError("User not found")
+		WriteErrorResponse(w, errResp)
+		return
+	}
+
+	existing, err := h.repo.ListSampleDocumentIDs(r.Context(), userID)
+	if err != nil {
+		errResp := InternalServerError("Failed to fetch sample data")
+		WriteErrorResponse(w, errResp)
+		return
+	}
+	if len(existing) > 0 {
+		errResp := ConflictError("Sample data already exists")
+		WriteErrorResponse(w, errResp)
+		return
+	}
+
+	today := time.Now().UTC().Truncate(24 * time.Hour)
+	documents := []DocumentResponse{}
+	for _, sample := range sampleDocuments {
+		doc, err := h.createSampleDocument(r, userID, sample, today)
+		if err != nil {
+			errResp := InternalServerError("Failed to create sample data")
+			WriteErrorResponse(w, errResp)
+			return
+		}
+		documents = append(documents, *doc)
+	}
+
+	resp := map[string]interface{}{
+		"message":   "Sample data created successfully",
+		"documents": documents,
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusCreated)
+	if err := json.NewEncoder(w).Encode(resp); err != nil {
+		errResp := InternalServerError("Failed to encode response")
+		WriteErrorResponse(w, errResp)
+	}
+}
+
+func (h *Handler) createSampleDocument(r *http.Request, userID string, sample sampleDocument, today time.Time) (*DocumentResponse, error) {
+	template, err := h.repo.GetDocumentTemplate(r.Context(), sample.template)
+	if err != nil {
+		return nil, err
+	}
+
+	identifier := sample.identifier
+	doc := &db.Document{
+		ID:             uuid.New(),
+		UserID:         uuid.MustParse(userID),
+		Name:           sample.name,
+		Identifier:     &identifier,
+		ExpirationDate: today.AddDate(0, 0, sample.expiresInDays),
+		Timezone:       "UTC",
+		Category:       template.Category,
+		CreatedAt:      time.Now(),
+		UpdatedAt:      time.Now(),
+	}
+	if err := h.repo.CreateDocument(r.Context(), doc); err != nil {
+		return nil, err
+	}
+	if err := h.repo.MarkSampleDocument(r.Context(), userID, doc.ID.String()); err != nil {
+		return nil, err
+	}
+
+	values := map[string]*string{}
+	for key, value := range sample.fields {
+		values[key] = &value
+	}
+	customFields, _ := mergeCustomFields(template.Fields, values)
+	if err := h.repo.SetDocumentCustomFields(r.Context(), doc.ID.String(), customFields); err != nil {
+		return nil, err
+	}
+
+	intervals, err := h.repo.GetReminderIntervalsFromIdLabels(r.Context(), template.Reminders)
+	if err != nil {
+		return nil, err
+	}
+	var reminders []ReminderIntervalResponse
+	var reminderValues []db.ReminderInterval
+	for _, interval := range intervals {
+		docReminder := &db.DocumentReminder{
+			ID:                 uuid.New(),
+			DocumentID:         doc.ID.String(),
+			ReminderIntervalID: interval.ID,
+			Enabled:            true,
+		}
+		if err := h.repo.SetDocumentReminders(r.Context(), doc.ID.String(), docReminder); err != nil {
+			return nil, err
+		}
+		reminders = append(reminders, ReminderIntervalResponse{ID: interval.IdLabel, Label: interval.Label})
+		reminderValues = append(reminderValues, *interval)
+	}
+	worker.ScheduleReminders(r.Context(), *doc, doc.UserID, reminderValues)
+
+	return &DocumentResponse{
+		ID:             doc.ID.String(),
+		UserID:         doc.UserID.String(),
+		Name:           doc.Name,
+		Identifier:     doc.Identifier,
+		ExpirationDate: doc.ExpirationDate.Format("Mon, 2 Jan, 2006"),
+		Timezone:       doc.Timezone,
+		Category:       doc.Category,
+		ExpiresIn:      h.expiresIn(r.Context(), doc.ExpirationDate),
+		Reminders:      reminders,
+		CustomFields:   customFieldResponses(customFields),
+		CreatedAt:      doc.CreatedAt,
+		UpdatedAt:      doc.UpdatedAt,
+	}, nil
+}
+
+// DeleteSampleDataHandler permanently deletes the example documents created
+// by CreateSampleDataHandler, including any the user changed or trashed.
+func (h *Handler) DeleteSampleDataHandler(w http.ResponseWriter, r *http.Request) {
+	userID, err := auth.GetUserIDFromContext(r)
+	if err != nil {
+		errResp := UnauthorizedError("Unauthorized")
+		WriteErrorResponse(w, errResp)
+		return
+	}
+
+	ids, err := h.repo.ListSampleDocumentIDs(r.Context(), userID)
+	if err != nil {
+		errResp := InternalServerError("Failed to fetch sample data")
+		WriteErrorResponse(w, errResp)
+		return
+	}
+
+	for _, id := range ids {
+		// trash first, which keeps the document counters right, then purge
+		if err := h.repo.DeleteDocument(r.Context(), id); err != nil && err.Error() != "document not found" {
+			errResp := InternalServerError("Failed to delete sample data")
+			WriteErrorResponse(w, errResp)
+			return
+		}
+		if err := h.repo.PurgeDocument(r.Context(), id); err != nil {
+			errResp := InternalServerError("Failed to delete sample data")
+			WriteErrorResponse(w, errResp)
+			return
+		}
+	}
+
+	resp := map[string]interface{}{
+		"message": "Sample data deleted successfully",
+		"deleted": len(ids),
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(resp); err != nil {
+		errResp := InternalServerError("Failed to encode response")
+		WriteErrorResponse(w, errResp)
+	}
+}
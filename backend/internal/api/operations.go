@@ -0,0 +1,238 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"log"
+	"net/http"
+	"slices"
+	"strconv"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+
+	"xpired/internal/auth"
+	database "xpired/internal/db"
+	"xpired/internal/worker"
+)
+
+const (
+	defaultFailedTaskLimit = 50
+	maxFailedTaskLimit     = 500
+)
+
+// ListFailedTasksHandler lists the tasks that failed, whether still being
+// retried or archived after their last attempt, so operators can tell what
+// broke and requeue what is worth another try.
+func (h *Handler) ListFailedTasksHandler(w http.ResponseWriter, r *http.Request) {
+	limit := defaultFailedTaskLimit
+	if raw := r.URL.Query().Get("limit"); raw != "" {
+		parsed, err := strconv.Atoi(raw)
+		if err != nil || parsed < 1 || parsed > maxFailedTaskLimit {
+			errResp := BadRequestError("limit must be between 1 and 500")
+			WriteErrorResponse(w, errResp)
+			return
+		}
+		limit = parsed
+	}
+
+	failed, err := worker.FailedTasks(limit)
+	if err != nil {
+		log.Printf("Failed to list failed tasks: %v", err)
+		errResp := InternalServerError("Failed to list failed tasks")
+		WriteErrorResponse(w, errResp)
+		return
+	}
+
+	tasks := make([]FailedTaskResponse, 0, len(failed))
+	for _, task := range failed {
+		entry := FailedTaskResponse{
+			ID:          task.ID,
+			Queue:       task.Queue,
+			Type:        task.Type,
+			State:       task.State,
+			Retried:     task.Retried,
+			MaxRetry:    task.MaxRetry,
+			LastError:   task.LastError,
+			NextRetryAt: task.NextProcessAt,
+		}
+		if json.Valid(task.Payload) {
+			entry.Payload = task.Payload
+		}
+		if !task.LastFailedAt.IsZero() {
+			lastFailedAt := task.LastFailedAt
+			entry.LastFailedAt = &lastFailedAt
+		}
+		tasks = append(tasks, entry)
+	}
+
+	resp := map[string]interface{}{
+		"message": "Failed tasks retrieved successfully",
+		"tasks":   tasks,
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(resp); err != nil {
+		errResp := InternalServerError("Failed to encode response")
+		WriteErrorResponse(w, errResp)
+	}
+}
+
+// RequeueTaskHandler runs a failed task again right away.
+func (h *Handler) RequeueTaskHandler(w http.ResponseWriter, r *http.Request) {
+	adminID, err := auth.GetUserIDFromContext(r)
+	if err != nil {
+		errResp := UnauthorizedError("Unauthorized")
+		WriteErrorResponse(w, errResp)
+		return
+	}
+
+	queue, taskID := chi.URLParam(r, "queue"), chi.URLParam(r, "taskId")
+	if err := worker.RequeueTask(queue, taskID); err != nil {
+		if errors.Is(err, worker.ErrTaskNotFound) {
+			errResp := NotFoundError("Task not found")
+			WriteErrorResponse(w, errResp)
+			return
+		}
+		log.Printf("Failed to requeue task %s in %s: %v", taskID, queue, err)
+		errResp := ConflictError("Only a failed or scheduled task can be requeued")
+		WriteErrorResponse(w, errResp)
+		return
+	}
+	log.Printf("Admin %s requeued task %s in %s", adminID, taskID, queue)
+
+	resp := map[string]interface{}{
+		"message": "Task requeued",
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(resp); err != nil {
+		errResp := InternalServerError("Failed to encode response")
+		WriteErrorResponse(w, errResp)
+	}
+}
+
+// RunScheduledJobHandler queues one of the periodic sweeps, such as the trash
+// purge, to run now instead of at its next tick. It answers 202; a worker
+// runs the job shortly after.
+func (h *Handler) RunScheduledJobHandler(w http.ResponseWriter, r *http.Request) {
+	adminID, err := auth.GetUserIDFromContext(r)
+	if err != nil {
+		errResp := UnauthorizedError("Unauthorized")
+		WriteErrorResponse(w, errResp)
+		return
+	}
+
+	name := chi.URLParam(r, "name")
+	if !slices.Contains(worker.ScheduledJobs, name) {
+		errResp := NotFoundError("Scheduled job not found")
+		WriteErrorResponse(w, errResp)
+		return
+	}
+	if err := worker.TriggerJob(r.Context(), name); err != nil {
+		if errors.Is(err, worker.ErrJobAlreadyTriggered) {
+			errResp := ConflictError("Job is already queued to run")
+			WriteErrorResponse(w, errResp)
+			return
+		}
+		log.Printf("Failed to trigger job %s: %v", name, err)
+		errResp := InternalServerError("Failed to queue job")
+		WriteErrorResponse(w, errResp)
+		return
+	}
+	log.Printf("Admin %s triggered job %s", adminID, name)
+
+	resp := map[string]interface{}{
+		"message": "Job queued to run",
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusAccepted)
+	if err := json.NewEncoder(w).Encode(resp); err != nil {
+		errResp := InternalServerError("Failed to encode response")
+		WriteErrorResponse(w, errResp)
+	}
+}
+
+// AdminHealthHandler dumps what operators check first when reminders go
+// missing: whether the databases and Redis answer, the task queues, the
+// notification provider circuits and the delivery alerts that are firing.
+// Unlike /ready it answers 200 however unhealthy the service is, with status
+// "degraded" when a dependency is down.
+func (h *Handler) AdminHealthHandler(db *database.DB) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		status := "ok"
+		check := func(err error) string {
+			if err != nil {
+				status = "degraded"
+				return err.Error()
+			}
+			return "ok"
+		}
+		ctx, cancel := context.WithTimeout(r.Context(), readyTimeout)
+		dbStatus := check(db.PingAll(ctx))
+		redisStatus := check(h.cache.Ping(ctx).Err())
+		cancel()
+
+		queues := []QueueStatsResponse{}
+		if stats, err := worker.Queues(); err != nil {
+			log.Printf("Failed to inspect task queues: %v", err)
+		} else {
+			for _, queue := range stats {
+				queues = append(queues, QueueStatsResponse{
+					Queue:          queue.Queue,
+					Paused:         queue.Paused,
+					Pending:        queue.Pending,
+					Active:         queue.Active,
+					Scheduled:      queue.Scheduled,
+					Retry:          queue.Retry,
+					Archived:       queue.Archived,
+					ProcessedToday: queue.ProcessedToday,
+					FailedToday:    queue.FailedToday,
+					LatencySeconds: queue.Latency.Seconds(),
+				})
+			}
+		}
+
+		providers := []NotificationProviderResponse{}
+		if circuits, err := worker.ProviderCircuits(r.Context(), h.cache); err != nil {
+			log.Printf("Failed to read notification provider circuits: %v", err)
+		} else {
+			for _, circuit := range circuits {
+				providers = append(providers, NotificationProviderResponse{
+					Channel:             circuit.Channel,
+					Paused:              circuit.Open,
+					RetryAt:             circuit.RetryAt,
+					ConsecutiveFailures: circuit.ConsecutiveFailures,
+				})
+			}
+		}
+
+		alerts := []FiringAlertResponse{}
+		if firing, err := worker.FiringAlerts(r.Context(), h.cache); err != nil {
+			log.Printf("Failed to read firing alerts: %v", err)
+		} else {
+			for _, alert := range firing {
+				alerts = append(alerts, FiringAlertResponse{Name: alert.Name, FiredAt: alert.FiredAt})
+			}
+		}
+
+		resp := map[string]interface{}{
+			"message":   "Health retrieved successfully",
+			"status":    status,
+			"database":  dbStatus,
+			"redis":     redisStatus,
+			"queues":    queues,
+			"providers": providers,
+			"alerts":    alerts,
+			"timestamp": time.Now().Format(time.RFC3339),
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		if err := json.NewEncoder(w).Encode(resp); err != nil {
+			errResp := InternalServerError("Failed to encode response")
+			WriteErrorResponse(w, errResp)
+		}
+	}
+}
@@ -0,0 +1,91 @@
+package api
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"xpired/internal/db"
+)
+
+// minRetentionDays is the shortest retention an organization can set. It
+// keeps a whole month of notification history for SMS quotas and usage
+// metering, and expired documents around long enough to be renewed.
+const minRetentionDays = 31
+
+func (h *Handler) GetRetentionHandler(w http.ResponseWriter, r *http.Request) {
+	org, _, ok := h.loadOrganizationMembership(w, r)
+	if !ok {
+		return
+	}
+
+	retention, err := h.repo.GetOrganizationRetention(r.Context(), org.ID.String())
+	if err != nil {
+		errResp := InternalServerError("Failed to fetch retention settings")
+		WriteErrorResponse(w, errResp)
+		return
+	}
+
+	resp := map[string]interface{}{
+		"message":   "Retention settings fetched successfully",
+		"retention": retention,
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(resp); err != nil {
+		errResp := InternalServerError("Failed to encode response")
+		WriteErrorResponse(w, errResp)
+	}
+}
+
+// UpdateRetentionHandler replaces how long the organization keeps expired
+// documents, its event log and notification history. The retention job
+// deletes what is older on its next daily run.
+func (h *Handler) UpdateRetentionHandler(w http.ResponseWriter, r *http.Request) {
+	org, caller, ok := h.loadOrganizationMembership(w, r)
+	if !ok {
+		return
+	}
+	if !canManageOrganization(caller) {
+		errResp := ForbiddenError("Only organization owners and admins can change retention settings")
+		WriteErrorResponse(w, errResp)
+		return
+	}
+
+	var req RetentionRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		errResp := BadRequestError("Invalid request body")
+		WriteErrorResponse(w, errResp)
+		return
+	}
+	for _, days := range []*int{req.ExpiredDocumentsDays, req.EventLogDays, req.NotificationHistoryDays} {
+		if days != nil && *days < minRetentionDays {
+			errResp := BadRequestError(fmt.Sprintf("Retention must be at least %d days, or null to keep data forever", minRetentionDays))
+			WriteErrorResponse(w, errResp)
+			return
+		}
+	}
+
+	retention := &db.OrganizationRetention{
+		OrganizationID:          org.ID.String(),
+		ExpiredDocumentsDays:    req.ExpiredDocumentsDays,
+		EventLogDays:            req.EventLogDays,
+		NotificationHistoryDays: req.NotificationHistoryDays,
+	}
+	if err := h.repo.SetOrganizationRetention(r.Context(), retention); err != nil {
+		errResp := InternalServerError("Failed to update retention settings")
+		WriteErrorResponse(w, errResp)
+		return
+	}
+
+	resp := map[string]interface{}{
+		"message":   "Retention settings updated successfully",
+		"retention": retention,
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(resp); err != nil {
+		errResp := InternalServerError("Failed to encode response")
+		WriteErrorResponse(w, errResp)
+	}
+}
@@ -0,0 +1,435 @@
+package api
+
+import (
+	"encoding/json"
+	"net/http"
+	"strings"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+
+	"xpired/internal/auth"
+	"xpired/internal/db"
+	"xpired/internal/worker"
+)
+
+// OrganizationHeader selects the organization a request acts for. Document
+// endpoints then read and write that organization's documents, in its data
+// region when it has one.
+const OrganizationHeader = "X-Organization-ID"
+
+// OrganizationMiddleware puts the organization named by OrganizationHeader in
+// the request context after checking the caller belongs to it. Requests
+// without the header act on the caller's personal documents, except those of
+// service accounts, which act for the organization owning them.
+func (h *Handler) OrganizationMiddleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		userID, err := auth.GetUserIDFromContext(r)
+		if err != nil {
+			errResp := UnauthorizedError("Unauthorized")
+			WriteErrorResponse(w, errResp)
+			return
+		}
+
+		organizationID := r.Header.Get(OrganizationHeader)
+		if organizationID == "" && auth.IsAPIKeyRequest(r.Context()) {
+			account, err := h.repo.GetServiceAccountByUserID(r.Context(), userID)
+			if err == nil {
+				organizationID = account.OrganizationID
+			} else if err.Error() != "service account not found" {
+				errResp := InternalServerError("Failed to load organization")
+				WriteErrorResponse(w, errResp)
+				return
+			}
+		}
+		if organizationID == "" {
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		if _, err := uuid.Parse(organizationID); err != nil {
+			errResp := BadRequestError("Invalid " + OrganizationHeader + " header")
+			WriteErrorResponse(w, errResp)
+			return
+		}
+		if _, err := h.repo.GetOrganizationMember(r.Context(), organizationID, userID); err != nil {
+			errResp := ForbiddenError("You are not a member of this organization")
+			WriteErrorResponse(w, errResp)
+			return
+		}
+
+		ctx, err := worker.OrganizationContext(r.Context(), h.repo, organizationID)
+		if err != nil {
+			errResp := InternalServerError("Failed to load organization")
+			WriteErrorResponse(w, errResp)
+			return
+		}
+		next.ServeHTTP(w, r.WithContext(ctx))
+	})
+}
+
+func validOrgRole(role string) bool {
+	switch role {
+	case db.OrgRoleAdmin, db.OrgRoleMember:
+		return true
+	}
+	return false
+}
+
+func canManageOrganization(member *db.OrganizationMember) bool {
+	return member.Role == db.OrgRoleOwner || member.Role == db.OrgRoleAdmin
+}
+
+func toOrganizationResponse(org *db.Organization, role string) OrganizationResponse {
+	complianceCategories := org.ComplianceCategories
+	if complianceCategories == nil {
+		complianceCategories = []string{}
+	}
+	return OrganizationResponse{
+		ID:                   org.ID.String(),
+		Name:                 org.Name,
+		DataRegion:           org.DataRegion,
+		ComplianceMode:       org.ComplianceMode,
+		ComplianceCategories: complianceCategories,
+		RenewalApproval:      org.RenewalApproval,
+		Role:                 role,
+		CreatedAt:            org.CreatedAt,
+	}
+}
+
+// loadOrganizationMembership resolves the {id} URL parameter to an
+// organization and the caller's membership in it. Non-members get a 404.
+func (h *Handler) loadOrganizationMembership(w http.ResponseWriter, r *http.Request) (*db.Organization, *db.OrganizationMember, bool) {
+	userID, err := auth.GetUserIDFromContext(r)
+	if err != nil {
+		errResp := UnauthorizedError("Unauthorized")
+		WriteErrorResponse(w, errResp)
+		return nil, nil, false
+	}
+
+	organizationID := chi.URLParam(r, "id")
+	if _, err := uuid.Parse(organizationID); err != nil {
+		errResp := NotFoundError("Organization not found")
+		WriteErrorResponse(w, errResp)
+		return nil, nil, false
+	}
+
+	member, err := h.repo.GetOrganizationMember(r.Context(), organizationID, userID)
+	if err != nil {
+		errResp := NotFoundError("Organization not found")
+		WriteErrorResponse(w, errResp)
+		return nil, nil, false
+	}
+
+	org, err := h.repo.GetOrganization(r.Context(), organizationID)
+	if err != nil {
+		errResp := NotFoundError("Organization not found")
+		WriteErrorResponse(w, errResp)
+		return nil, nil, false
+	}
+	return org, member, true
+}
+
+func (h *Handler) ListOrganizationsHandler(w http.ResponseWriter, r *http.Request) {
+	userID, err := auth.GetUserIDFromContext(r)
+	if err != nil {
+		errResp := UnauthorizedError("Unauthorized")
+		WriteErrorResponse(w, errResp)
+		return
+	}
+
+	organizations, err := h.repo.ListOrganizationsByUserID(r.Context(), userID)
+	if err != nil {
+		errResp := InternalServerError("Failed to fetch organizations")
+		WriteErrorResponse(w, errResp)
+		return
+	}
+
+	orgResps := []OrganizationResponse{}
+	for _, org := range organizations {
+		member, err := h.repo.GetOrganizationMember(r.Context(), org.ID.String(), userID)
+		if err != nil {
+			continue
+		}
+		orgResps = append(orgResps, toOrganizationResponse(org, member.Role))
+	}
+
+	resp := map[string]interface{}{
+		"message":       "Organizations fetched successfully",
+		"organizations": orgResps,
+		"dataRegions":   h.repo.DataRegions(),
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(resp); err != nil {
+		errResp := InternalServerError("Failed to encode response")
+		WriteErrorResponse(w, errResp)
+	}
+}
+
+// CreateOrganizationHandler creates an organization owned by the caller. The
+// data region is fixed at creation: moving existing documents between
+// regional databases is not supported.
+func (h *Handler) CreateOrganizationHandler(w http.ResponseWriter, r *http.Request) {
+	userID, err := auth.GetUserIDFromContext(r)
+	if err != nil {
+		errResp := UnauthorizedError("Unauthorized")
+		WriteErrorResponse(w, errResp)
+		return
+	}
+
+	var req OrganizationRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		errResp := BadRequestError("Invalid request body")
+		WriteErrorResponse(w, errResp)
+		return
+	}
+	if strings.TrimSpace(req.Name) == "" {
+		errResp := BadRequestError("Missing required fields")
+		WriteErrorResponse(w, errResp)
+		return
+	}
+	if req.DataRegion != nil && *req.DataRegion == "" {
+		req.DataRegion = nil
+	}
+
+	org := &db.Organization{
+		ID:         uuid.New(),
+		Name:       strings.TrimSpace(req.Name),
+		DataRegion: req.DataRegion,
+	}
+	if err := h.repo.CreateOrganization(r.Context(), org, userID); err != nil {
+		if err.Error() == "unknown data region" {
+			errResp := BadRequestError("dataRegion must be one of the configured data regions")
+			WriteErrorResponse(w, errResp)
+			return
+		}
+		errResp := InternalServerError("Failed to create organization")
+		WriteErrorResponse(w, errResp)
+		return
+	}
+
+	resp := map[string]interface{}{
+		"message":      "Organization created successfully",
+		"organization": toOrganizationResponse(org, db.OrgRoleOwner),
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusCreated)
+	if err := json.NewEncoder(w).Encode(resp); err != nil {
+		errResp := InternalServerError("Failed to encode response")
+		WriteErrorResponse(w, errResp)
+	}
+}
+
+func (h *Handler) ListOrganizationMembersHandler(w http.ResponseWriter, r *http.Request) {
+	org, _, ok := h.loadOrganizationMembership(w, r)
+	if !ok {
+		return
+	}
+
+	members, err := h.repo.ListOrganizationMembers(r.Context(), org.ID.String())
+	if err != nil {
+		errResp := InternalServerError("Failed to fetch organization members")
+		WriteErrorResponse(w, errResp)
+		return
+	}
+
+	memberResps := []OrganizationMemberResponse{}
+	for _, member := range members {
+		memberResps = append(memberResps, OrganizationMemberResponse{
+			UserID:   member.UserID,
+			Name:     member.Name,
+			Email:    member.Email,
+			Role:     member.Role,
+			JoinedAt: member.CreatedAt,
+		})
+	}
+
+	resp := map[string]interface{}{
+		"message": "Organization members fetched successfully",
+		"members": memberResps,
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(resp); err != nil {
+		errResp := InternalServerError("Failed to encode response")
+		WriteErrorResponse(w, errResp)
+	}
+}
+
+// AddOrganizationMemberHandler adds an existing user to the organization, or
+// changes the role of a current member. Only owners and admins may do this.
+func (h *Handler) AddOrganizationMemberHandler(w http.ResponseWriter, r *http.Request) {
+	org, caller, ok := h.loadOrganizationMembership(w, r)
+	if !ok {
+		return
+	}
+	if !canManageOrganization(caller) {
+		errResp := ForbiddenError("Only organization owners and admins can manage members")
+		WriteErrorResponse(w, errResp)
+		return
+	}
+
+	var req OrganizationMemberRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		errResp := BadRequestError("Invalid request body")
+		WriteErrorResponse(w, errResp)
+		return
+	}
+	if req.Email == "" {
+		errResp := BadRequestError("Missing required fields")
+		WriteErrorResponse(w, errResp)
+		return
+	}
+	if req.Role == "" {
+		req.Role = db.OrgRoleMember
+	}
+	if !validOrgRole(req.Role) {
+		errResp := BadRequestError("role must be one of admin, member")
+		WriteErrorResponse(w, errResp)
+		return
+	}
+
+	user, err := h.repo.GetUserByEmail(r.Context(), strings.TrimSpace(req.Email))
+	if err != nil {
+		errResp := NotFoundError("User not found")
+		WriteErrorResponse(w, errResp)
+		return
+	}
+
+	if existing, err := h.repo.GetOrganizationMember(r.Context(), org.ID.String(), user.ID.String()); err == nil && existing.Role == db.OrgRoleOwner {
+		errResp := ConflictError("The owner's role cannot be changed")
+		WriteErrorResponse(w, errResp)
+		return
+	}
+
+	if err := h.repo.AddOrganizationMember(r.Context(), org, user.ID.String(), req.Role); err != nil {
+		errResp := InternalServerError("Failed to add organization member")
+		WriteErrorResponse(w, errResp)
+		return
+	}
+
+	resp := map[string]interface{}{
+		"message": "Organization member added successfully",
+		"member": OrganizationMemberResponse{
+			UserID: user.ID.String(),
+			Name:   user.Name,
+			Email:  user.Email,
+			Role:   req.Role,
+		},
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusCreated)
+	if err := json.NewEncoder(w).Encode(resp); err != nil {
+		errResp := InternalServerError("Failed to encode response")
+		WriteErrorResponse(w, errResp)
+	}
+}
+
+func (h *Handler) RemoveOrganizationMemberHandler(w http.ResponseWriter, r *http.Request) {
+	org, caller, ok := h.loadOrganizationMembership(w, r)
+	if !ok {
+		return
+	}
+
+	memberID := chi.URLParam(r, "userId")
+	if memberID != caller.UserID && !canManageOrganization(caller) {
+		errResp := ForbiddenError("Only organization owners and admins can manage members")
+		WriteErrorResponse(w, errResp)
+		return
+	}
+
+	member, err := h.repo.GetOrganizationMember(r.Context(), org.ID.String(), memberID)
+	if err != nil {
+		errResp := NotFoundError("Organization member not found")
+		WriteErrorResponse(w, errResp)
+		return
+	}
+	if member.Role == db.OrgRoleOwner {
+		errResp := ConflictError("The organization owner cannot be removed")
+		WriteErrorResponse(w, errResp)
+		return
+	}
+
+	if err := h.repo.RemoveOrganizationMember(r.Context(), org.ID.String(), memberID); err != nil {
+		errResp := InternalServerError("Failed to remove organization member")
+		WriteErrorResponse(w, errResp)
+		return
+	}
+
+	resp := map[string]interface{}{
+		"message": "Organization member removed successfully",
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(resp); err != nil {
+		errResp := InternalServerError("Failed to encode response")
+		WriteErrorResponse(w, errResp)
+	}
+}
+
+// UpdateComplianceSettingsHandler turns the organization's compliance mode on
+// or off. While it is on, reminders on its documents in the listed categories
+// cannot be turned off. Only owners and admins may change it.
+func (h *Handler) UpdateComplianceSettingsHandler(w http.ResponseWriter, r *http.Request) {
+	org, caller, ok := h.loadOrganizationMembership(w, r)
+	if !ok {
+		return
+	}
+	if !canManageOrganization(caller) {
+		errResp := ForbiddenError("Only organization owners and admins can change compliance settings")
+		WriteErrorResponse(w, errResp)
+		return
+	}
+
+	var req ComplianceSettingsRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		errResp := BadRequestError("Invalid request body")
+		WriteErrorResponse(w, errResp)
+		return
+	}
+	if req.Enabled && len(req.Categories) == 0 {
+		errResp := BadRequestError("categories are required when compliance mode is enabled")
+		WriteErrorResponse(w, errResp)
+		return
+	}
+
+	categories, err := h.repo.ListDocumentCategories(r.Context())
+	if err != nil {
+		errResp := InternalServerError("Failed to fetch document categories")
+		WriteErrorResponse(w, errResp)
+		return
+	}
+	known := map[string]bool{}
+	for _, category := range categories {
+		known[category.Slug] = true
+	}
+	for _, slug := range req.Categories {
+		if !known[slug] {
+			errResp := BadRequestError("Unknown document category: " + slug)
+			WriteErrorResponse(w, errResp)
+			return
+		}
+	}
+
+	if err := h.repo.UpdateOrganizationCompliance(r.Context(), org.ID.String(), req.Enabled, req.Categories); err != nil {
+		errResp := InternalServerError("Failed to update compliance settings")
+		WriteErrorResponse(w, errResp)
+		return
+	}
+	org.ComplianceMode = req.Enabled
+	org.ComplianceCategories = req.Categories
+
+	resp := map[string]interface{}{
+		"message":      "Compliance settings updated successfully",
+		"organization": toOrganizationResponse(org, caller.Role),
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(resp); err != nil {
+		errResp := InternalServerError("Failed to encode response")
+		WriteErrorResponse(w, errResp)
+	}
+}
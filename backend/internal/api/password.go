@@ -0,0 +1,23 @@
+package api
+
+import (
+	"log"
+	"net/http"
+)
+
+// checkPassword holds a new password to the configured policy, wherever a
+// user sets one. On failure it writes the error response and returns false.
+// Passwords are let through when the breach lookup fails, so an outage of
+// the lookup does not block sign-ups.
+func (h *Handler) checkPassword(w http.ResponseWriter, r *http.Request, password string) bool {
+	problem, err := h.passwordPolicy.Check(r.Context(), password)
+	if err != nil {
+		log.Printf("Failed to check password against breaches: %v", err)
+	}
+	if problem != "" {
+		errResp := BadRequestError(problem)
+		WriteErrorResponse(w, errResp)
+		return false
+	}
+	return true
+}
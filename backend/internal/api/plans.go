@@ -0,0 +1,89 @@
+package api
+
+import (
+	"encoding/json"
+	"log"
+	"net/http"
+	"slices"
+	"strings"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+
+	"xpired/internal/auth"
+	"xpired/internal/db"
+)
+
+// SetUserPlanHandler moves a user to another plan, which decides how long
+// the attachments of their expired documents are kept. The retention job
+// applies it on its next run, warning before anything is deleted.
+func (h *Handler) SetUserPlanHandler(w http.ResponseWriter, r *http.Request) {
+	adminID, err := auth.GetUserIDFromContext(r)
+	if err != nil {
+		errResp := UnauthorizedError("Unauthorized")
+		WriteErrorResponse(w, errResp)
+		return
+	}
+
+	var req SetUserPlanRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		errResp := BadRequestError("Invalid request body")
+		WriteErrorResponse(w, errResp)
+		return
+	}
+	if !slices.Contains(db.Plans, req.Plan) {
+		errResp := BadRequestError("plan must be one of " + strings.Join(db.Plans, ", "))
+		WriteErrorResponse(w, errResp)
+		return
+	}
+
+	userID := chi.URLParam(r, "id")
+	if _, err := uuid.Parse(userID); err != nil {
+		errResp := BadRequestError("Invalid user ID")
+		WriteErrorResponse(w, errResp)
+		return
+	}
+	previous, err := h.repo.GetUserPlan(r.Context(), userID)
+	if err != nil {
+		errResp := NotFoundError("User not found")
+		WriteErrorResponse(w, errResp)
+		return
+	}
+
+	if previous != req.Plan {
+		if err := h.repo.SetUserPlan(r.Context(), userID, req.Plan); err != nil {
+			errResp := InternalServerError("Failed to update plan")
+			WriteErrorResponse(w, errResp)
+			return
+		}
+
+		metadata, _ := json.Marshal(map[string]interface{}{
+			"from": previous,
+			"to":   req.Plan,
+		})
+		entry := &db.AuditLog{
+			ID:         uuid.New(),
+			ActorID:    &adminID,
+			UserID:     &userID,
+			Action:     db.AuditActionUserPlanChanged,
+			EntityType: "user",
+			EntityID:   userID,
+			Metadata:   metadata,
+		}
+		if err := h.repo.CreateAuditLog(r.Context(), entry); err != nil {
+			log.Printf("Failed to record plan change of user %s in audit log: %v", userID, err)
+		}
+		log.Printf("Admin %s moved user %s from the %s to the %s plan", adminID, userID, previous, req.Plan)
+	}
+
+	resp := map[string]interface{}{
+		"message": "Plan updated",
+		"plan":    req.Plan,
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(resp); err != nil {
+		errResp := InternalServerError("Failed to encode response")
+		WriteErrorResponse(w, errResp)
+	}
+}
@@ -0,0 +1,165 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"sort"
+
+	"xpired/internal/auth"
+	"xpired/internal/db"
+)
+
+// maxOrderedDocuments bounds the documents one reorder request can place.
+const maxOrderedDocuments = 1000
+
+// documentListItem is a document in the caller's list, with where they put
+// it in their own arrangement.
+type documentListItem struct {
+	humanizedDocument
+	Pinned   bool `json:"pinned"`
+	Position *int `json:"position,omitempty"`
+}
+
+// documentListItems arranges documents for userID. With custom set they are
+// sorted the way the user arranged them: pinned ones first, then the ones
+// they placed in that order, then the rest in the order they came in.
+func (h *Handler) documentListItems(ctx context.Context, userID string, documents []*db.Document, custom bool) ([]documentListItem, error) {
+	positions, err := h.repo.ListDocumentPositions(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	byDocument := make(map[string]*db.DocumentPosition, len(positions))
+	for _, position := range positions {
+		byDocument[position.DocumentID] = position
+	}
+
+	items := []documentListItem{}
+	for _, doc := range documents {
+		item := documentListItem{humanizedDocument: h.humanizeDocument(ctx, doc)}
+		if position, ok := byDocument[doc.ID.String()]; ok {
+			item.Pinned = position.Pinned
+			item.Position = position.Position
+		}
+		items = append(items, item)
+	}
+
+	if custom {
+		sort.SliceStable(items, func(i, j int) bool {
+			a, b := items[i], items[j]
+			if a.Pinned != b.Pinned {
+				return a.Pinned
+			}
+			if a.Position == nil || b.Position == nil {
+				return a.Position != nil && b.Position == nil
+			}
+			return *a.Position < *b.Position
+		})
+	}
+	return items, nil
+}
+
+// PinDocumentHandler pins a document to the top of the caller's ?sort=custom
+// list. Pins are the caller's own; others who see the document are not
+// affected.
+func (h *Handler) PinDocumentHandler(w http.ResponseWriter, r *http.Request) {
+	h.setDocumentPinned(w, r, true)
+}
+
+func (h *Handler) UnpinDocumentHandler(w http.ResponseWriter, r *http.Request) {
+	h.setDocumentPinned(w, r, false)
+}
+
+func (h *Handler) setDocumentPinned(w http.ResponseWriter, r *http.Request, pinned bool) {
+	doc, userID, ok := h.loadOwnedDocument(w, r)
+	if !ok {
+		return
+	}
+
+	if err := h.repo.SetDocumentPinned(r.Context(), userID, doc.ID.String(), pinned); err != nil {
+		errResp := InternalServerError("Failed to update document pin")
+		WriteErrorResponse(w, errResp)
+		return
+	}
+
+	if !pinned {
+		w.WriteHeader(http.StatusNoContent)
+		return
+	}
+
+	resp := map[string]interface{}{
+		"message": "Document pinned successfully",
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(resp); err != nil {
+		errResp := InternalServerError("Failed to encode response")
+		WriteErrorResponse(w, errResp)
+	}
+}
+
+// ReorderDocumentsHandler stores the order the caller arranged their
+// documents in, which the list endpoint returns them in with ?sort=custom.
+// Documents left out of documentIds lose their place and follow the placed
+// ones.
+func (h *Handler) ReorderDocumentsHandler(w http.ResponseWriter, r *http.Request) {
+	userID, err := auth.GetUserIDFromContext(r)
+	if err != nil {
+		errResp := UnauthorizedError("Unauthorized")
+		WriteErrorResponse(w, errResp)
+		return
+	}
+
+	var req ReorderDocumentsRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		errResp := BadRequestError("Invalid request body")
+		WriteErrorResponse(w, errResp)
+		return
+	}
+	if len(req.DocumentIDs) > maxOrderedDocuments {
+		errResp := BadRequestError("documentIds can list at most 1000 documents")
+		WriteErrorResponse(w, errResp)
+		return
+	}
+
+	documents, err := h.repo.ListDocumentsByUserID(r.Context(), userID)
+	if err != nil {
+		errResp := InternalServerError("Failed to fetch documents")
+		WriteErrorResponse(w, errResp)
+		return
+	}
+	listed := make(map[string]bool, len(documents))
+	for _, doc := range documents {
+		listed[doc.ID.String()] = true
+	}
+	seen := make(map[string]bool, len(req.DocumentIDs))
+	for _, documentID := range req.DocumentIDs {
+		if !listed[documentID] {
+			errResp := BadRequestError("documentIds must only list your documents: " + documentID)
+			WriteErrorResponse(w, errResp)
+			return
+		}
+		if seen[documentID] {
+			errResp := BadRequestError("documentIds lists a document more than once: " + documentID)
+			WriteErrorResponse(w, errResp)
+			return
+		}
+		seen[documentID] = true
+	}
+
+	if err := h.repo.SetDocumentOrder(r.Context(), userID, req.DocumentIDs); err != nil {
+		errResp := InternalServerError("Failed to save document order")
+		WriteErrorResponse(w, errResp)
+		return
+	}
+
+	resp := map[string]interface{}{
+		"message": "Document order saved successfully",
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(resp); err != nil {
+		errResp := InternalServerError("Failed to encode response")
+		WriteErrorResponse(w, errResp)
+	}
+}
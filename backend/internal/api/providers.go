@@ -0,0 +1,41 @@
+package api
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"xpired/internal/worker"
+)
+
+// ListNotificationProvidersHandler reports the circuit breaker of every
+// notification channel, so admins can tell whether reminders are being held
+// back because a provider keeps failing.
+func (h *Handler) ListNotificationProvidersHandler(w http.ResponseWriter, r *http.Request) {
+	circuits, err := worker.ProviderCircuits(r.Context(), h.cache)
+	if err != nil {
+		errResp := InternalServerError("Failed to fetch notification provider status")
+		WriteErrorResponse(w, errResp)
+		return
+	}
+
+	providers := make([]NotificationProviderResponse, 0, len(circuits))
+	for _, circuit := range circuits {
+		providers = append(providers, NotificationProviderResponse{
+			Channel:             circuit.Channel,
+			Paused:              circuit.Open,
+			RetryAt:             circuit.RetryAt,
+			ConsecutiveFailures: circuit.ConsecutiveFailures,
+		})
+	}
+
+	resp := map[string]interface{}{
+		"message":   "Notification providers retrieved successfully",
+		"providers": providers,
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(resp); err != nil {
+		errResp := InternalServerError("Failed to encode response")
+		WriteErrorResponse(w, errResp)
+	}
+}
@@ -0,0 +1,83 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"log"
+	"net/http"
+	"sync/atomic"
+	"time"
+
+	database "xpired/internal/db"
+)
+
+// readyTimeout bounds the database ping behind the readiness check.
+const readyTimeout = 2 * time.Second
+
+func writeReadiness(w http.ResponseWriter, dbStatus string) {
+	resp := ReadinessResponse{
+		Status:    "ready",
+		Database:  dbStatus,
+		Timestamp: time.Now().Format(time.RFC3339),
+	}
+	status := http.StatusOK
+	if dbStatus != "ok" {
+		resp.Status = "degraded"
+		status = http.StatusServiceUnavailable
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	if err := json.NewEncoder(w).Encode(resp); err != nil {
+		http.Error(w, "Failed to encode response", http.StatusInternalServerError)
+	}
+}
+
+// ReadyHandler reports the service ready while db and its regional databases
+// answer pings, and degraded otherwise.
+func ReadyHandler(db *database.DB) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		ctx, cancel := context.WithTimeout(r.Context(), readyTimeout)
+		defer cancel()
+
+		if err := db.PingAll(ctx); err != nil {
+			log.Printf("Readiness check failed: %v", err)
+			writeReadiness(w, "unavailable")
+			return
+		}
+		writeReadiness(w, "ok")
+	}
+}
+
+// StartupHandler lets the HTTP server listen before the database is
+// connected. Until Serve is called it answers /health, reports /ready as
+// degraded and rejects every other request with 503.
+type StartupHandler struct {
+	handler atomic.Pointer[http.Handler]
+}
+
+func NewStartupHandler() *StartupHandler {
+	return &StartupHandler{}
+}
+
+// Serve hands every later request to h.
+func (s *StartupHandler) Serve(h http.Handler) {
+	s.handler.Store(&h)
+}
+
+func (s *StartupHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if h := s.handler.Load(); h != nil {
+		(*h).ServeHTTP(w, r)
+		return
+	}
+
+	switch r.URL.Path {
+	case "/health":
+		(&Handler{}).HealthHandler(w, r)
+	case "/ready":
+		writeReadiness(w, "connecting")
+	default:
+		errResp := ServiceUnavailableError("Service is starting")
+		WriteErrorResponse(w, errResp)
+	}
+}
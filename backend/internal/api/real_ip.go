@@ -0,0 +1,78 @@
+package api
+
+import (
+	"net"
+	"net/http"
+	"net/netip"
+	"strings"
+)
+
+// RealIPMiddleware sets RemoteAddr to the address of the client when the
+// request came through one of the trusted proxies (TRUSTED_PROXIES), taking
+// it from X-Forwarded-For or X-Real-IP. Anyone can send those headers, so
+// they are ignored on requests from any other peer; without trusted proxies
+// RemoteAddr always stays the peer's address.
+func RealIPMiddleware(trustedProxies []string) func(http.Handler) http.Handler {
+	trusted := make([]netip.Prefix, 0, len(trustedProxies))
+	for _, proxy := range trustedProxies {
+		if prefix, err := netip.ParsePrefix(proxy); err == nil {
+			trusted = append(trusted, prefix.Masked())
+		} else if addr, err := netip.ParseAddr(proxy); err == nil {
+			trusted = append(trusted, netip.PrefixFrom(addr.Unmap(), addr.Unmap().BitLen()))
+		}
+	}
+	isTrusted := func(addr netip.Addr) bool {
+		for _, prefix := range trusted {
+			if prefix.Contains(addr) {
+				return true
+			}
+		}
+		return false
+	}
+
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if peer, ok := clientIP(r); ok && isTrusted(peer) {
+				if client, ok := forwardedClientIP(r, isTrusted); ok {
+					r.RemoteAddr = client.String()
+				}
+			}
+			next.ServeHTTP(w, r)
+		})
+	}
+}
+
+// forwardedClientIP returns the client address the trusted proxies forwarded.
+// X-Forwarded-For is read from the right, as each proxy appends the address
+// it got the request from: the first address that is not a trusted proxy is
+// the client, and what comes before it may be made up.
+func forwardedClientIP(r *http.Request, isTrusted func(netip.Addr) bool) (netip.Addr, bool) {
+	if forwarded := r.Header.Values("X-Forwarded-For"); len(forwarded) > 0 {
+		hops := strings.Split(strings.Join(forwarded, ","), ",")
+		var client netip.Addr
+		for i := len(hops) - 1; i >= 0; i-- {
+			addr, ok := parseForwardedAddr(hops[i])
+			if !ok {
+				break
+			}
+			client = addr
+			if !isTrusted(addr) {
+				break
+			}
+		}
+		return client, client.IsValid()
+	}
+	return parseForwardedAddr(r.Header.Get("X-Real-IP"))
+}
+
+func parseForwardedAddr(value string) (netip.Addr, bool) {
+	value = strings.TrimSpace(value)
+	if host, _, err := net.SplitHostPort(value); err == nil {
+		value = host
+	}
+	addr, err := netip.ParseAddr(value)
+	if err != nil {
+		return netip.Addr{}, false
+	}
+	return addr.Unmap(), true
+}
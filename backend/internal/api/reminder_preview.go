@@ -0,0 +1,68 @@
+package api
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+
+	"xpired/internal/auth"
+	"xpired/internal/worker"
+)
+
+// PreviewDocumentReminderHandler renders the reminder the caller would
+// receive about the document on the channel query parameter, email unless
+// given, so the UI can show what reminders look like. Nothing is sent.
+func (h *Handler) PreviewDocumentReminderHandler(w http.ResponseWriter, r *http.Request) {
+	userID, err := auth.GetUserIDFromContext(r)
+	if err != nil {
+		errResp := UnauthorizedError("Unauthorized")
+		WriteErrorResponse(w, errResp)
+		return
+	}
+
+	channel := r.URL.Query().Get("channel")
+	if channel == "" {
+		channel = worker.ChannelEmail
+	}
+	if channel != worker.ChannelEmail && channel != worker.ChannelSMS && channel != worker.ChannelPush {
+		errResp := BadRequestError("channel must be email, sms or push")
+		WriteErrorResponse(w, errResp)
+		return
+	}
+
+	doc, err := h.repo.GetDocumentByID(r.Context(), chi.URLParam(r, "id"))
+	if err != nil {
+		errResp := NotFoundError("Document not found")
+		WriteErrorResponse(w, errResp)
+		return
+	}
+	if !h.canManageDocument(r.Context(), doc, userID) {
+		errResp := ForbiddenError("Forbidden")
+		WriteErrorResponse(w, errResp)
+		return
+	}
+
+	preview, err := worker.PreviewReminder(r.Context(), h.repo, h.cfg, doc, userID, channel)
+	if err != nil {
+		errResp := InternalServerError("Failed to render reminder preview")
+		WriteErrorResponse(w, errResp)
+		return
+	}
+
+	resp := map[string]interface{}{
+		"message": "Reminder preview rendered successfully",
+		"preview": ReminderPreviewResponse{
+			Channel: preview.Channel,
+			Subject: preview.Subject,
+			HTML:    preview.HTML,
+			Text:    preview.Text,
+		},
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(resp); err != nil {
+		errResp := InternalServerError("Failed to encode response")
+		WriteErrorResponse(w, errResp)
+	}
+}
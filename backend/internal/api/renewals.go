@@ -0,0 +1,354 @@
+package api
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+
+	"xpired/internal/db"
+)
+
+// sameDate reports whether a and b fall on the same calendar day.
+func sameDate(a, b time.Time) bool {
+	return a.Format("2006-01-02") == b.Format("2006-01-02")
+}
+
+// ensureDirectRenewalAllowed rejects changing doc's expiration date outside a
+// renewal request when its organization requires renewals to be approved. On
+// failure it writes the error response and returns false.
+func (h *Handler) ensureDirectRenewalAllowed(w http.ResponseWriter, r *http.Request, doc *db.Document) bool {
+	required, err := h.repo.RenewalApprovalRequired(r.Context(), doc)
+	if err != nil {
+		errResp := InternalServerError("Failed to check organization renewal policy")
+		WriteErrorResponse(w, errResp)
+		return false
+	}
+	if required {
+		errResp := ConflictError("This organization requires renewals to be approved; submit a renewal request instead")
+		errResp.Code = ErrorCodeRenewalApprovalRequired
+		WriteErrorResponse(w, errResp)
+		return false
+	}
+	return true
+}
+
+// loadRenewalRequest resolves the {renewalId} URL parameter to a renewal
+// request of doc. On failure it writes the error response and returns nil.
+func (h *Handler) loadRenewalRequest(w http.ResponseWriter, r *http.Request, doc *db.Document) *db.RenewalRequest {
+	requestID := chi.URLParam(r, "renewalId")
+	if _, err := uuid.Parse(requestID); err != nil {
+		errResp := NotFoundError("Renewal request not found")
+		WriteErrorResponse(w, errResp)
+		return nil
+	}
+
+	request, err := h.repo.GetRenewalRequest(r.Context(), doc.ID.String(), requestID)
+	if err != nil {
+		if err.Error() == "renewal request not found" {
+			errResp := NotFoundError("Renewal request not found")
+			WriteErrorResponse(w, errResp)
+			return nil
+		}
+		errResp := InternalServerError("Failed to load renewal request")
+		WriteErrorResponse(w, errResp)
+		return nil
+	}
+	return request
+}
+
+// decodeRenewalDecision reads the optional note of an approval or rejection.
+func decodeRenewalDecision(r *http.Request) (RenewalDecisionRequest, error) {
+	var req RenewalDecisionRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil && !errors.Is(err, io.EOF) {
+		return req, err
+	}
+	return req, nil
+}
+
+func (h *Handler) ListRenewalRequestsHandler(w http.ResponseWriter, r *http.Request) {
+	doc, _, ok := h.loadTeamDocument(w, r)
+	if !ok {
+		return
+	}
+
+	requests, err := h.repo.ListRenewalRequests(r.Context(), doc.ID.String())
+	if err != nil {
+		errResp := InternalServerError("Failed to fetch renewal requests")
+		WriteErrorResponse(w, errResp)
+		return
+	}
+	if requests == nil {
+		requests = []*db.RenewalRequest{}
+	}
+
+	resp := map[string]interface{}{
+		"message":  "Renewal requests retrieved successfully",
+		"renewals": requests,
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(resp); err != nil {
+		errResp := InternalServerError("Failed to encode response")
+		WriteErrorResponse(w, errResp)
+	}
+}
+
+// CreateRenewalRequestHandler lets an organization member propose a new
+// expiration date for a team document, with a file as evidence of the
+// renewal. The document is left untouched until an approver confirms it.
+func (h *Handler) CreateRenewalRequestHandler(w http.ResponseWriter, r *http.Request) {
+	doc, member, ok := h.loadTeamDocument(w, r)
+	if !ok {
+		return
+	}
+
+	required, err := h.repo.RenewalApprovalRequired(r.Context(), doc)
+	if err != nil {
+		errResp := InternalServerError("Failed to check organization renewal policy")
+		WriteErrorResponse(w, errResp)
+		return
+	}
+	if !required {
+		errResp := BadRequestError("This organization does not require renewal approval; update the document directly")
+		WriteErrorResponse(w, errResp)
+		return
+	}
+
+	maxBytes := int64(h.cfg.Attachments.MaxUploadMB) << 20
+	r.Body = http.MaxBytesReader(w, r.Body, maxBytes+1<<20)
+	file, header, err := r.FormFile("evidence")
+	if err != nil {
+		var tooLarge *http.MaxBytesError
+		if errors.As(err, &tooLarge) {
+			errResp := BadRequestError(fmt.Sprintf("Evidence must be at most %d MB", h.cfg.Attachments.MaxUploadMB))
+			WriteErrorResponse(w, errResp)
+			return
+		}
+		errResp := BadRequestError("An evidence file is required")
+		WriteErrorResponse(w, errResp)
+		return
+	}
+	defer file.Close()
+
+	if header.Size > maxBytes {
+		errResp := BadRequestError(fmt.Sprintf("Evidence must be at most %d MB", h.cfg.Attachments.MaxUploadMB))
+		WriteErrorResponse(w, errResp)
+		return
+	}
+
+	expirationDate, err := time.Parse("2006-01-02", r.FormValue("expirationDate"))
+	if err != nil {
+		errResp := BadRequestError("expirationDate must be formatted as YYYY-MM-DD")
+		WriteErrorResponse(w, errResp)
+		return
+	}
+	if !expirationDate.After(doc.ExpirationDate) {
+		errResp := BadRequestError("expirationDate must be after the current expiration date")
+		WriteErrorResponse(w, errResp)
+		return
+	}
+
+	request := &db.RenewalRequest{
+		ID:             uuid.New(),
+		DocumentID:     doc.ID.String(),
+		RequestedBy:    member.UserID,
+		ExpirationDate: expirationDate,
+	}
+
+	ext := strings.ToLower(filepath.Ext(header.Filename))
+	if !attachmentExtPattern.MatchString(ext) {
+		ext = ""
+	}
+	key := fmt.Sprintf("renewals/%s/%s%s", doc.ID.String(), request.ID.String(), ext)
+
+	contentType := header.Header.Get("Content-Type")
+	if contentType == "" {
+		contentType = "application/octet-stream"
+	}
+
+	url, err := h.store.Put(r.Context(), key, file, contentType)
+	if err != nil {
+		log.Printf("Failed to store renewal evidence for document %s: %v", doc.ID.String(), err)
+		errResp := InternalServerError("Failed to store evidence")
+		WriteErrorResponse(w, errResp)
+		return
+	}
+	request.EvidenceURL = url
+
+	if err := h.repo.CreateRenewalRequest(r.Context(), request); err != nil {
+		_ = h.store.Delete(r.Context(), url)
+		if err.Error() == "document already has a pending renewal request" {
+			errResp := ConflictError("Document already has a pending renewal request")
+			WriteErrorResponse(w, errResp)
+			return
+		}
+		errResp := InternalServerError("Failed to create renewal request")
+		WriteErrorResponse(w, errResp)
+		return
+	}
+
+	resp := map[string]interface{}{
+		"message": "Renewal request submitted for approval",
+		"renewal": request,
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusCreated)
+	if err := json.NewEncoder(w).Encode(resp); err != nil {
+		errResp := InternalServerError("Failed to encode response")
+		WriteErrorResponse(w, errResp)
+	}
+}
+
+// ApproveRenewalRequestHandler confirms a pending renewal: the document moves
+// to the proposed expiration date and its reminders are rescheduled. Only
+// organization owners and admins other than the requester can approve.
+func (h *Handler) ApproveRenewalRequestHandler(w http.ResponseWriter, r *http.Request) {
+	doc, member, ok := h.loadTeamDocument(w, r)
+	if !ok {
+		return
+	}
+	if !canManageOrganization(member) {
+		errResp := ForbiddenError("Only organization owners and admins can approve renewals")
+		WriteErrorResponse(w, errResp)
+		return
+	}
+	request := h.loadRenewalRequest(w, r, doc)
+	if request == nil {
+		return
+	}
+	if request.RequestedBy == member.UserID {
+		errResp := ForbiddenError("A renewal must be approved by someone other than its requester")
+		WriteErrorResponse(w, errResp)
+		return
+	}
+	req, err := decodeRenewalDecision(r)
+	if err != nil {
+		errResp := BadRequestError("Invalid request body")
+		WriteErrorResponse(w, errResp)
+		return
+	}
+
+	if err := h.repo.DecideRenewalRequest(r.Context(), request.ID.String(), db.RenewalApproved, member.UserID, req.Note); err != nil {
+		if err.Error() == "renewal request is not pending" {
+			errResp := ConflictError("Renewal request has already been decided")
+			WriteErrorResponse(w, errResp)
+			return
+		}
+		errResp := InternalServerError("Failed to approve renewal request")
+		WriteErrorResponse(w, errResp)
+		return
+	}
+
+	doc.UpdatedAt = time.Now()
+	if err := h.renewDocument(r.Context(), doc, request.ExpirationDate); err != nil {
+		log.Printf("Failed to apply approved renewal %s of document %s: %v", request.ID.String(), doc.ID.String(), err)
+		errResp := InternalServerError("Failed to renew document")
+		WriteErrorResponse(w, errResp)
+		return
+	}
+
+	resp := map[string]interface{}{
+		"message":  "Renewal approved",
+		"document": h.humanizeDocument(r.Context(), doc),
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(resp); err != nil {
+		errResp := InternalServerError("Failed to encode response")
+		WriteErrorResponse(w, errResp)
+	}
+}
+
+// RejectRenewalRequestHandler declines a pending renewal, leaving the
+// document and its reminders unchanged.
+func (h *Handler) RejectRenewalRequestHandler(w http.ResponseWriter, r *http.Request) {
+	doc, member, ok := h.loadTeamDocument(w, r)
+	if !ok {
+		return
+	}
+	if !canManageOrganization(member) {
+		errResp := ForbiddenError("Only organization owners and admins can reject renewals")
+		WriteErrorResponse(w, errResp)
+		return
+	}
+	request := h.loadRenewalRequest(w, r, doc)
+	if request == nil {
+		return
+	}
+	req, err := decodeRenewalDecision(r)
+	if err != nil {
+		errResp := BadRequestError("Invalid request body")
+		WriteErrorResponse(w, errResp)
+		return
+	}
+
+	if err := h.repo.DecideRenewalRequest(r.Context(), request.ID.String(), db.RenewalRejected, member.UserID, req.Note); err != nil {
+		if err.Error() == "renewal request is not pending" {
+			errResp := ConflictError("Renewal request has already been decided")
+			WriteErrorResponse(w, errResp)
+			return
+		}
+		errResp := InternalServerError("Failed to reject renewal request")
+		WriteErrorResponse(w, errResp)
+		return
+	}
+
+	resp := map[string]interface{}{
+		"message": "Renewal rejected",
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(resp); err != nil {
+		errResp := InternalServerError("Failed to encode response")
+		WriteErrorResponse(w, errResp)
+	}
+}
+
+// UpdateRenewalApprovalHandler turns the approval step for renewals of the
+// organization's documents on or off.
+func (h *Handler) UpdateRenewalApprovalHandler(w http.ResponseWriter, r *http.Request) {
+	org, caller, ok := h.loadOrganizationMembership(w, r)
+	if !ok {
+		return
+	}
+	if !canManageOrganization(caller) {
+		errResp := ForbiddenError("Only organization owners and admins can change renewal settings")
+		WriteErrorResponse(w, errResp)
+		return
+	}
+
+	var req RenewalApprovalSettingsRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		errResp := BadRequestError("Invalid request body")
+		WriteErrorResponse(w, errResp)
+		return
+	}
+
+	if err := h.repo.UpdateOrganizationRenewalApproval(r.Context(), org.ID.String(), req.Required); err != nil {
+		errResp := InternalServerError("Failed to update renewal settings")
+		WriteErrorResponse(w, errResp)
+		return
+	}
+	org.RenewalApproval = req.Required
+
+	resp := map[string]interface{}{
+		"message":      "Renewal settings updated successfully",
+		"organization": toOrganizationResponse(org, caller.Role),
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(resp); err != nil {
+		errResp := InternalServerError("Failed to encode response")
+		WriteErrorResponse(w, errResp)
+	}
+}
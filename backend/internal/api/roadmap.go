@@ -0,0 +1,273 @@
+package api
+
+import (
+	"encoding/json"
+	"net/http"
+	"slices"
+	"strings"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+
+	"xpired/internal/auth"
+	"xpired/internal/db"
+)
+
+const maxRoadmapTitleLength = 200
+
+var roadmapStatuses = []string{db.RoadmapProposed, db.RoadmapPlanned, db.RoadmapInProgress, db.RoadmapShipped, db.RoadmapDeclined}
+
+// ListRoadmapHandler returns the roadmap, most voted first, with whether the
+// user voted for each feature.
+func (h *Handler) ListRoadmapHandler(w http.ResponseWriter, r *http.Request) {
+	userID, err := auth.GetUserIDFromContext(r)
+	if err != nil {
+		errResp := UnauthorizedError("Unauthorized")
+		WriteErrorResponse(w, errResp)
+		return
+	}
+
+	features, err := h.repo.ListRoadmapFeatures(r.Context(), userID)
+	if err != nil {
+		errResp := InternalServerError("Failed to fetch roadmap")
+		WriteErrorResponse(w, errResp)
+		return
+	}
+	if features == nil {
+		features = []*db.RoadmapFeature{}
+	}
+
+	resp := map[string]interface{}{
+		"message":  "Roadmap fetched successfully",
+		"features": features,
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(resp); err != nil {
+		errResp := InternalServerError("Failed to encode response")
+		WriteErrorResponse(w, errResp)
+	}
+}
+
+// VoteRoadmapFeatureHandler records the user's vote for a feature. A user
+// has one vote per feature, so voting again changes nothing.
+func (h *Handler) VoteRoadmapFeatureHandler(w http.ResponseWriter, r *http.Request) {
+	h.setRoadmapVote(w, r, true)
+}
+
+// UnvoteRoadmapFeatureHandler withdraws the user's vote for a feature.
+func (h *Handler) UnvoteRoadmapFeatureHandler(w http.ResponseWriter, r *http.Request) {
+	h.setRoadmapVote(w, r, false)
+}
+
+func (h *Handler) setRoadmapVote(w http.ResponseWriter, r *http.Request, vote bool) {
+	userID, err := auth.GetUserIDFromContext(r)
+	if err != nil {
+		errResp := UnauthorizedError("Unauthorized")
+		WriteErrorResponse(w, errResp)
+		return
+	}
+
+	feature, ok := h.roadmapFeature(w, r, userID)
+	if !ok {
+		return
+	}
+	if vote && (feature.Status == db.RoadmapShipped || feature.Status == db.RoadmapDeclined) {
+		errResp := ConflictError("Voting is closed for this feature")
+		WriteErrorResponse(w, errResp)
+		return
+	}
+
+	if vote {
+		err = h.repo.AddRoadmapVote(r.Context(), feature.ID.String(), userID)
+	} else {
+		err = h.repo.RemoveRoadmapVote(r.Context(), feature.ID.String(), userID)
+	}
+	if err != nil {
+		errResp := InternalServerError("Failed to record vote")
+		WriteErrorResponse(w, errResp)
+		return
+	}
+
+	feature, ok = h.roadmapFeature(w, r, userID)
+	if !ok {
+		return
+	}
+
+	message := "Vote recorded"
+	if !vote {
+		message = "Vote withdrawn"
+	}
+	resp := map[string]interface{}{
+		"message": message,
+		"feature": feature,
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(resp); err != nil {
+		errResp := InternalServerError("Failed to encode response")
+		WriteErrorResponse(w, errResp)
+	}
+}
+
+// CreateRoadmapFeatureHandler adds a feature to the roadmap.
+func (h *Handler) CreateRoadmapFeatureHandler(w http.ResponseWriter, r *http.Request) {
+	adminID, err := auth.GetUserIDFromContext(r)
+	if err != nil {
+		errResp := UnauthorizedError("Unauthorized")
+		WriteErrorResponse(w, errResp)
+		return
+	}
+
+	var req RoadmapFeatureRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		errResp := BadRequestError("Invalid request body")
+		WriteErrorResponse(w, errResp)
+		return
+	}
+
+	feature := &db.RoadmapFeature{
+		ID:        uuid.New(),
+		Status:    db.RoadmapProposed,
+		CreatedBy: &adminID,
+	}
+	if req.Title == nil {
+		errResp := BadRequestError("title is required")
+		WriteErrorResponse(w, errResp)
+		return
+	}
+	if msg := applyRoadmapFeature(feature, req); msg != "" {
+		errResp := BadRequestError(msg)
+		WriteErrorResponse(w, errResp)
+		return
+	}
+	if err := h.repo.CreateRoadmapFeature(r.Context(), feature); err != nil {
+		errResp := InternalServerError("Failed to create roadmap feature")
+		WriteErrorResponse(w, errResp)
+		return
+	}
+
+	resp := map[string]interface{}{
+		"message": "Roadmap feature created successfully",
+		"feature": feature,
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusCreated)
+	if err := json.NewEncoder(w).Encode(resp); err != nil {
+		errResp := InternalServerError("Failed to encode response")
+		WriteErrorResponse(w, errResp)
+	}
+}
+
+// UpdateRoadmapFeatureHandler changes the fields of a feature present in the
+// request, such as moving it to planned.
+func (h *Handler) UpdateRoadmapFeatureHandler(w http.ResponseWriter, r *http.Request) {
+	adminID, err := auth.GetUserIDFromContext(r)
+	if err != nil {
+		errResp := UnauthorizedError("Unauthorized")
+		WriteErrorResponse(w, errResp)
+		return
+	}
+
+	feature, ok := h.roadmapFeature(w, r, adminID)
+	if !ok {
+		return
+	}
+
+	var req RoadmapFeatureRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		errResp := BadRequestError("Invalid request body")
+		WriteErrorResponse(w, errResp)
+		return
+	}
+	if msg := applyRoadmapFeature(feature, req); msg != "" {
+		errResp := BadRequestError(msg)
+		WriteErrorResponse(w, errResp)
+		return
+	}
+	if err := h.repo.UpdateRoadmapFeature(r.Context(), feature); err != nil {
+		errResp := InternalServerError("Failed to update roadmap feature")
+		WriteErrorResponse(w, errResp)
+		return
+	}
+
+	resp := map[string]interface{}{
+		"message": "Roadmap feature updated successfully",
+		"feature": feature,
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(resp); err != nil {
+		errResp := InternalServerError("Failed to encode response")
+		WriteErrorResponse(w, errResp)
+	}
+}
+
+// DeleteRoadmapFeatureHandler removes a feature and its votes.
+func (h *Handler) DeleteRoadmapFeatureHandler(w http.ResponseWriter, r *http.Request) {
+	featureID := chi.URLParam(r, "id")
+	if _, err := uuid.Parse(featureID); err != nil {
+		errResp := NotFoundError("Roadmap feature not found")
+		WriteErrorResponse(w, errResp)
+		return
+	}
+
+	if err := h.repo.DeleteRoadmapFeature(r.Context(), featureID); err != nil {
+		if err.Error() == "roadmap feature not found" {
+			errResp := NotFoundError("Roadmap feature not found")
+			WriteErrorResponse(w, errResp)
+			return
+		}
+		errResp := InternalServerError("Failed to delete roadmap feature")
+		WriteErrorResponse(w, errResp)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// roadmapFeature loads the feature in the URL for userID. On failure it
+// writes the error response and returns false.
+func (h *Handler) roadmapFeature(w http.ResponseWriter, r *http.Request, userID string) (*db.RoadmapFeature, bool) {
+	featureID := chi.URLParam(r, "id")
+	if _, err := uuid.Parse(featureID); err != nil {
+		errResp := NotFoundError("Roadmap feature not found")
+		WriteErrorResponse(w, errResp)
+		return nil, false
+	}
+	feature, err := h.repo.GetRoadmapFeature(r.Context(), featureID, userID)
+	if err != nil {
+		if err.Error() == "roadmap feature not found" {
+			errResp := NotFoundError("Roadmap feature not found")
+			WriteErrorResponse(w, errResp)
+			return nil, false
+		}
+		errResp := InternalServerError("Failed to fetch roadmap feature")
+		WriteErrorResponse(w, errResp)
+		return nil, false
+	}
+	return feature, true
+}
+
+// applyRoadmapFeature sets the fields present in req on feature. It returns
+// the message of the problem, or "".
+func applyRoadmapFeature(feature *db.RoadmapFeature, req RoadmapFeatureRequest) string {
+	if req.Title != nil {
+		title := strings.TrimSpace(*req.Title)
+		if title == "" || len(title) > maxRoadmapTitleLength {
+			return "title must be 1 to 200 characters"
+		}
+		feature.Title = title
+	}
+	if req.Description != nil {
+		feature.Description = strings.TrimSpace(*req.Description)
+	}
+	if req.Status != nil {
+		if !slices.Contains(roadmapStatuses, *req.Status) {
+			return "status must be one of " + strings.Join(roadmapStatuses, ", ")
+		}
+		feature.Status = *req.Status
+	}
+	return ""
+}
@@ -0,0 +1,110 @@
+package api
+
+import (
+	"encoding/json"
+	"log"
+	"net/http"
+	"slices"
+	"strings"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+
+	"xpired/internal/auth"
+	"xpired/internal/db"
+)
+
+// SetUserRoleHandler gives a user another role. Their sessions are revoked,
+// since the tokens they hold carry the role they were issued with; they sign
+// in again with the new one.
+func (h *Handler) SetUserRoleHandler(w http.ResponseWriter, r *http.Request) {
+	adminID, err := auth.GetUserIDFromContext(r)
+	if err != nil {
+		errResp := UnauthorizedError("Unauthorized")
+		WriteErrorResponse(w, errResp)
+		return
+	}
+
+	var req SetUserRoleRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		errResp := BadRequestError("Invalid request body")
+		WriteErrorResponse(w, errResp)
+		return
+	}
+	if !slices.Contains(auth.Roles, req.Role) {
+		errResp := BadRequestError("role must be one of " + strings.Join(auth.Roles, ", "))
+		WriteErrorResponse(w, errResp)
+		return
+	}
+
+	userID := chi.URLParam(r, "id")
+	if _, err := uuid.Parse(userID); err != nil {
+		errResp := BadRequestError("Invalid user ID")
+		WriteErrorResponse(w, errResp)
+		return
+	}
+	if userID == adminID {
+		errResp := BadRequestError("You cannot change your own role")
+		WriteErrorResponse(w, errResp)
+		return
+	}
+	user, err := h.repo.GetUserByID(r.Context(), userID)
+	if err != nil {
+		errResp := NotFoundError("User not found")
+		WriteErrorResponse(w, errResp)
+		return
+	}
+	if h.isBootstrapAdmin(user.Email) {
+		errResp := ConflictError("Admins named in ADMIN_EMAILS keep the admin role")
+		WriteErrorResponse(w, errResp)
+		return
+	}
+	previous, err := h.repo.GetUserRole(r.Context(), userID)
+	if err != nil {
+		errResp := InternalServerError("Failed to update role")
+		WriteErrorResponse(w, errResp)
+		return
+	}
+
+	if previous != req.Role {
+		if err := h.repo.SetUserRole(r.Context(), userID, req.Role); err != nil {
+			errResp := InternalServerError("Failed to update role")
+			WriteErrorResponse(w, errResp)
+			return
+		}
+		sessions, err := h.repo.RevokeSessions(r.Context(), userID, "")
+		if err != nil {
+			log.Printf("Failed to revoke sessions of user %s after a role change: %v", userID, err)
+		}
+		h.cacheRevokedSessions(r.Context(), sessions)
+
+		metadata, _ := json.Marshal(map[string]interface{}{
+			"from": previous,
+			"to":   req.Role,
+		})
+		entry := &db.AuditLog{
+			ID:         uuid.New(),
+			ActorID:    &adminID,
+			UserID:     &userID,
+			Action:     db.AuditActionUserRoleChanged,
+			EntityType: "user",
+			EntityID:   userID,
+			Metadata:   metadata,
+		}
+		if err := h.repo.CreateAuditLog(r.Context(), entry); err != nil {
+			log.Printf("Failed to record role change of user %s in audit log: %v", userID, err)
+		}
+		log.Printf("Admin %s changed the role of user %s from %s to %s", adminID, userID, previous, req.Role)
+	}
+
+	resp := map[string]interface{}{
+		"message": "Role updated",
+		"role":    req.Role,
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(resp); err != nil {
+		errResp := InternalServerError("Failed to encode response")
+		WriteErrorResponse(w, errResp)
+	}
+}
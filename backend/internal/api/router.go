@@ -1,11 +1,16 @@
 package api
 
 import (
+	"errors"
+	"log"
 	"net/http"
 	"os"
 	"path/filepath"
 	"xpired/internal/auth"
+	"xpired/internal/config"
 	database "xpired/internal/db"
+	"xpired/internal/storage"
+	"xpired/internal/web"
 
 	"github.com/go-chi/chi/v5"
 	chiMiddleware "github.com/go-chi/chi/v5/middleware"
@@ -15,27 +20,40 @@ import (
 
 func SetupRoutes(
 	db *database.DB,
+	cfg *config.Config,
+	store storage.Storage,
 ) http.Handler {
 	r := chi.NewRouter()
 
+	// the request ID goes first so the request log shows it, as do the logs
+	// of the tasks the request queues
+	r.Use(chiMiddleware.RequestID)
+	r.Use(RequestIDMiddleware)
 	r.Use(chiMiddleware.Logger)
 	r.Use(chiMiddleware.Recoverer)
-	r.Use(chiMiddleware.RequestID)
-	r.Use(chiMiddleware.RealIP)
+	r.Use(RealIPMiddleware(cfg.API.TrustedProxies))
 
 	r.Use(cors.Handler(cors.Options{
 		AllowedOrigins:   []string{"*"},
 		AllowedMethods:   []string{"GET", "POST", "PUT", "DELETE", "OPTIONS"},
-		AllowedHeaders:   []string{"Accept", "Authorization", "Content-Type", "X-CSRF-Token", "Cookie"},
-		ExposedHeaders:   []string{"Link"},
+		AllowedHeaders:   []string{"Accept", "Authorization", "Content-Type", "X-CSRF-Token", "Cookie", OrganizationHeader},
+		ExposedHeaders:   []string{"Link", "Deprecation", "Sunset", chiMiddleware.RequestIDHeader},
 		AllowCredentials: true,
 		MaxAge:           300,
 	}))
 
 	repo := database.NewRepository(db)
-	handler := NewHandler(repo)
+	handler := NewHandler(repo, cfg, store)
+	auth.SetRevocationChecker(handler.IsSessionRevoked)
+	auth.SetRoleResolver(handler.UserRole)
 
 	r.Get("/health", handler.HealthHandler)
+	r.Get("/ready", ReadyHandler(db))
+	r.Get("/.well-known/jwks.json", JWKSHandler)
+
+	if local, ok := store.(*storage.Local); ok {
+		r.Handle("/uploads/*", local.Handler("/uploads/"))
+	}
 
 	r.Get("/openapi.yml", func(w http.ResponseWriter, r *http.Request) {
 		cwd, _ := os.Getwd()
@@ -49,33 +67,285 @@ func SetupRoutes(
 		httpSwagger.URL("/openapi.yml"),
 	))
 
+	r.Route("/scim/v2", func(r chi.Router) {
+		r.Use(handler.SCIMMiddleware)
+		r.Get("/ServiceProviderConfig", handler.SCIMServiceProviderConfigHandler)
+		r.Get("/Users", handler.SCIMListUsersHandler)
+		r.Post("/Users", handler.SCIMCreateUserHandler)
+		r.Get("/Users/{userId}", handler.SCIMGetUserHandler)
+		r.Put("/Users/{userId}", handler.SCIMReplaceUserHandler)
+		r.Patch("/Users/{userId}", handler.SCIMPatchUserHandler)
+		r.Delete("/Users/{userId}", handler.SCIMDeleteUserHandler)
+	})
+
 	r.Route("/api", func(r chi.Router) {
+		r.Use(handler.LoadShedding(db, cfg.API.MaxInFlight))
+		r.Use(handler.LocaleMiddleware)
+
 		r.Route("/auth", func(r chi.Router) {
 			r.Post("/register", handler.RegisterHandler)
 			r.Post("/signin", handler.LoginHandler)
+			r.Get("/oauth/{provider}", handler.OAuthLoginHandler)
+			r.Get("/oauth/{provider}/callback", handler.OAuthCallbackHandler)
+			r.Get("/email/confirm/{token}", handler.EmailChangeLinkHandler)
+			r.Post("/email/confirm/{token}", handler.ConfirmEmailChangeHandler)
 
 			r.Group(func(r chi.Router) {
 				r.Use(auth.AuthMiddleware)
 				r.Get("/me", handler.UserProfileHandler)
+				r.Delete("/me", handler.DeleteAccountHandler)
 				r.Post("/logout", handler.LogoutHandler)
+				r.Put("/email", handler.ChangeEmailHandler)
+				r.Get("/sessions", handler.ListSessionsHandler)
+				r.Delete("/sessions", handler.RevokeAllSessionsHandler)
+				r.Delete("/sessions/{id}", handler.RevokeSessionHandler)
 			})
 		})
 
+		r.Route("/views", func(r chi.Router) {
+			r.Use(auth.ScopedAuthMiddleware(auth.ScopeDocumentsRead, auth.ScopeDocumentsWrite))
+			r.Use(handler.OrganizationMiddleware)
+			r.Get("/", handler.ListSavedViewsHandler)
+			r.Post("/", handler.CreateSavedViewHandler)
+			r.Get("/{id}", handler.GetSavedViewHandler)
+			r.Put("/{id}", handler.UpdateSavedViewHandler)
+			r.Delete("/{id}", handler.DeleteSavedViewHandler)
+			r.Get("/{id}/documents", handler.SavedViewDocumentsHandler)
+		})
+
+		r.Route("/api-keys", func(r chi.Router) {
+			r.Use(auth.AuthMiddleware)
+			r.Get("/", handler.ListAPIKeysHandler)
+			r.Post("/", handler.CreateAPIKeyHandler)
+			r.Delete("/{id}", handler.DeleteAPIKeyHandler)
+		})
+
 		r.Route("/documents", func(r chi.Router) {
 			r.Group(func(r chi.Router) {
-				r.Use(auth.AuthMiddleware)
+				r.Use(auth.ScopedAuthMiddleware(auth.ScopeDocumentsRead, auth.ScopeDocumentsWrite))
+				r.Use(handler.OrganizationMiddleware)
 				r.Get("/", handler.ListDocumentsHandler)
 				r.Post("/", handler.CreateDocumentHandler)
+				r.Post("/import", handler.ImportDocumentsHandler)
+				r.Put("/order", handler.ReorderDocumentsHandler)
+				r.Get("/trash", handler.ListTrashHandler)
+				r.Get("/stats", handler.DocumentStatsHandler)
+				r.Get("/renewal-costs", handler.RenewalCostStatsHandler)
+				r.Get("/dependency-graph", handler.DependencyGraphHandler)
 				r.Get("/{id}", handler.GetDocumentHandler)
 				r.Put("/{id}", handler.UpdateDocumentHandler)
 				r.Delete("/{id}", handler.DeleteDocumentHandler)
+				r.Post("/{id}/lock", handler.LockDocumentHandler)
+				r.Delete("/{id}/lock", handler.UnlockDocumentHandler)
+				r.Post("/{id}/pin", handler.PinDocumentHandler)
+				r.Delete("/{id}/pin", handler.UnpinDocumentHandler)
+				r.Get("/{id}/assignee", handler.GetDocumentAssigneeHandler)
+				r.Put("/{id}/assignee", handler.AssignDocumentHandler)
+				r.Delete("/{id}/assignee", handler.UnassignDocumentHandler)
+				r.Post("/{id}/assignee/acknowledge", handler.AcknowledgeDocumentAssignmentHandler)
+				r.Get("/{id}/legal-hold", handler.GetDocumentLegalHoldHandler)
+				r.Put("/{id}/legal-hold", handler.UpdateDocumentLegalHoldHandler)
+				r.Get("/{id}/renewals", handler.ListRenewalRequestsHandler)
+				r.Post("/{id}/renewals", handler.CreateRenewalRequestHandler)
+				r.Post("/{id}/renewals/{renewalId}/approve", handler.ApproveRenewalRequestHandler)
+				r.Post("/{id}/renewals/{renewalId}/reject", handler.RejectRenewalRequestHandler)
+				r.Post("/{id}/restore", handler.RestoreDocumentHandler)
+				r.Put("/{id}/attachment", handler.UploadAttachmentHandler)
+				r.Get("/{id}/contacts", handler.ListDocumentContactsHandler)
+				r.Post("/{id}/contacts", handler.CreateDocumentContactHandler)
+				r.Delete("/{id}/contacts/{contactId}", handler.DeleteDocumentContactHandler)
+				r.Get("/{id}/checklist", handler.GetChecklistHandler)
+				r.Post("/{id}/checklist", handler.CreateChecklistItemHandler)
+				r.Put("/{id}/checklist/{itemId}", handler.UpdateChecklistItemHandler)
+				r.Delete("/{id}/checklist/{itemId}", handler.DeleteChecklistItemHandler)
+				r.Get("/{id}/dependencies", handler.ListDocumentDependenciesHandler)
+				r.Post("/{id}/dependencies", handler.AddDocumentDependencyHandler)
+				r.Delete("/{id}/dependencies/{dependsOnId}", handler.RemoveDocumentDependencyHandler)
+			})
+
+			r.Group(func(r chi.Router) {
+				r.Use(auth.ScopedAuthMiddleware(auth.ScopeRemindersManage, auth.ScopeRemindersManage))
+				r.Use(handler.OrganizationMiddleware)
 				r.Get("/{id}/reminders", handler.GetDocumentRemindersHandler)
+				r.Get("/{id}/reminders/preview", handler.PreviewDocumentReminderHandler)
 				r.Put("/{id}/reminders", handler.ToggleDocumentReminderHandler)
 			})
 		})
 
+		r.Route("/feedback", func(r chi.Router) {
+			r.Use(auth.AuthMiddleware)
+			r.Post("/", handler.CreateFeedbackHandler)
+		})
+
+		r.Route("/roadmap", func(r chi.Router) {
+			r.Use(auth.AuthMiddleware)
+			r.Get("/", handler.ListRoadmapHandler)
+			r.Post("/{id}/vote", handler.VoteRoadmapFeatureHandler)
+			r.Delete("/{id}/vote", handler.UnvoteRoadmapFeatureHandler)
+		})
+
+		r.Route("/onboarding", func(r chi.Router) {
+			r.Use(auth.AuthMiddleware)
+			r.Post("/sample-data", handler.CreateSampleDataHandler)
+			r.Delete("/sample-data", handler.DeleteSampleDataHandler)
+		})
+
+		r.Route("/household", func(r chi.Router) {
+			r.Use(auth.AuthMiddleware)
+			r.Get("/", handler.GetHouseholdHandler)
+			r.Post("/", handler.CreateHouseholdHandler)
+			r.Post("/members", handler.AddHouseholdMemberHandler)
+			r.Put("/members/{userId}", handler.UpdateHouseholdMemberHandler)
+			r.Delete("/members/{userId}", handler.RemoveHouseholdMemberHandler)
+			r.Get("/members/{userId}/documents", handler.ListHouseholdMemberDocumentsHandler)
+		})
+
+		r.Route("/admin", func(r chi.Router) {
+			r.Use(auth.AuthMiddleware)
+			r.Use(auth.RequireRole(auth.RoleAdmin))
+			r.Post("/impersonate", handler.ImpersonateUserHandler)
+			r.Get("/announcements", handler.ListAnnouncementsHandler)
+			r.Post("/announcements", handler.CreateAnnouncementHandler)
+			r.Delete("/announcements/{id}", handler.DeleteAnnouncementHandler)
+			r.Post("/roadmap", handler.CreateRoadmapFeatureHandler)
+			r.Put("/roadmap/{id}", handler.UpdateRoadmapFeatureHandler)
+			r.Delete("/roadmap/{id}", handler.DeleteRoadmapFeatureHandler)
+			r.Post("/users/{id}/suspend", handler.SuspendUserHandler)
+			r.Post("/users/{id}/reinstate", handler.ReinstateUserHandler)
+			r.Put("/users/{id}/plan", handler.SetUserPlanHandler)
+			r.Put("/users/{id}/role", handler.SetUserRoleHandler)
+			r.Get("/deprecations", handler.ListDeprecationsHandler)
+			r.Get("/notification-providers", handler.ListNotificationProvidersHandler)
+			r.Get("/load-shedding", handler.LoadSheddingStatsHandler)
+			r.Get("/health", handler.AdminHealthHandler(db))
+			r.Get("/tasks/failed", handler.ListFailedTasksHandler)
+			r.Post("/tasks/{queue}/{taskId}/requeue", handler.RequeueTaskHandler)
+			r.Post("/scheduled-jobs/{name}/run", handler.RunScheduledJobHandler)
+			r.Post("/issuers", handler.CreateIssuerHandler)
+			r.Put("/issuers/{id}", handler.UpdateIssuerHandler)
+			r.Delete("/issuers/{id}", handler.DeleteIssuerHandler)
+			r.Get("/usage/export", handler.ExportUsageHandler)
+		})
+
+		r.Route("/jobs", func(r chi.Router) {
+			r.Use(auth.AuthMiddleware)
+			r.Get("/", handler.ListJobsHandler)
+			r.Post("/import", handler.CreateImportJobHandler)
+			r.Post("/takeout", handler.CreateTakeoutJobHandler)
+			r.Get("/{id}", handler.GetJobHandler)
+		})
+
+		r.Route("/organizations", func(r chi.Router) {
+			r.Use(auth.AuthMiddleware)
+			r.Get("/", handler.ListOrganizationsHandler)
+			r.Post("/", handler.CreateOrganizationHandler)
+			r.Get("/{id}/members", handler.ListOrganizationMembersHandler)
+			r.Post("/{id}/members", handler.AddOrganizationMemberHandler)
+			r.Delete("/{id}/members/{userId}", handler.RemoveOrganizationMemberHandler)
+			r.Get("/{id}/escalation-policy", handler.GetEscalationPolicyHandler)
+			r.Put("/{id}/escalation-policy", handler.UpdateEscalationPolicyHandler)
+			r.Get("/{id}/retention", handler.GetRetentionHandler)
+			r.Put("/{id}/retention", handler.UpdateRetentionHandler)
+			r.Get("/{id}/legal-hold", handler.GetOrganizationLegalHoldHandler)
+			r.Put("/{id}/legal-hold", handler.UpdateOrganizationLegalHoldHandler)
+			r.Put("/{id}/compliance", handler.UpdateComplianceSettingsHandler)
+			r.Put("/{id}/renewal-approval", handler.UpdateRenewalApprovalHandler)
+			r.Post("/{id}/scim-token", handler.RotateSCIMTokenHandler)
+			r.Delete("/{id}/scim-token", handler.DeleteSCIMTokenHandler)
+			r.Get("/{id}/service-accounts", handler.ListServiceAccountsHandler)
+			r.Post("/{id}/service-accounts", handler.CreateServiceAccountHandler)
+			r.Post("/{id}/service-accounts/{accountId}/token", handler.RotateServiceAccountTokenHandler)
+			r.Delete("/{id}/service-accounts/{accountId}/token", handler.RevokeServiceAccountTokenHandler)
+			r.Get("/{id}/email-sender", handler.GetEmailSenderHandler)
+			r.Put("/{id}/email-sender", handler.UpdateEmailSenderHandler)
+			r.Delete("/{id}/email-sender", handler.DeleteEmailSenderHandler)
+			r.Post("/{id}/email-sender/verify", handler.VerifyEmailSenderHandler)
+			r.Get("/{id}/notification-theme", handler.GetNotificationThemeHandler)
+			r.Put("/{id}/notification-theme", handler.UpdateNotificationThemeHandler)
+			r.Delete("/{id}/notification-theme", handler.DeleteNotificationThemeHandler)
+			r.Get("/{id}/event-log/export", handler.ExportEventLogHandler)
+			r.Get("/{id}/usage/export", handler.ExportOrganizationUsageHandler)
+		})
+
+		r.Group(func(r chi.Router) {
+			r.Use(auth.AuthMiddleware)
+			r.Use(handler.OrganizationMiddleware)
+			graphqlHandler := handler.GraphQLHandler()
+			r.Get("/graphql", graphqlHandler)
+			r.Post("/graphql", graphqlHandler)
+		})
+
+		r.Route("/usage", func(r chi.Router) {
+			r.Use(auth.AuthMiddleware)
+			r.Use(handler.OrganizationMiddleware)
+			r.Get("/", handler.GetUsageHandler)
+		})
+
+		r.Route("/notifications", func(r chi.Router) {
+			r.Use(auth.AuthMiddleware)
+			r.Use(handler.OrganizationMiddleware)
+			r.Get("/unread-count", handler.UnreadNotificationCountHandler)
+			r.Post("/read", handler.MarkNotificationsReadHandler)
+		})
+
+		r.Route("/feed", func(r chi.Router) {
+			r.Use(auth.AuthMiddleware)
+			r.Get("/", handler.GetFeedHandler)
+			r.Post("/", handler.RotateFeedTokenHandler)
+			r.Delete("/", handler.DeleteFeedTokenHandler)
+		})
+
+		r.Route("/preferences", func(r chi.Router) {
+			r.Use(auth.AuthMiddleware)
+			r.Get("/notifications", handler.GetNotificationPreferencesHandler)
+			r.Put("/notifications", handler.UpdateNotificationPreferencesHandler)
+			r.Get("/locale", handler.GetLocalePreferencesHandler)
+			r.Put("/locale", handler.UpdateLocalePreferencesHandler)
+		})
+
+		r.Get("/announcements", handler.ListActiveAnnouncementsHandler)
 		r.Get("/reminder-intervals", handler.GetReminderIntervalsHandler)
+		r.Get("/categories", handler.GetDocumentCategoriesHandler)
+		r.Get("/categories/{slug}/suggest-expiration", handler.SuggestExpirationHandler)
+		r.Get("/templates", handler.ListDocumentTemplatesHandler)
+		r.Get("/issuers", handler.ListIssuersHandler)
+		r.Get("/integrations/egress-ips", handler.EgressIPsHandler)
+		r.Get("/unsubscribe/{token}", handler.UnsubscribeLinkHandler)
+		r.Post("/unsubscribe/{token}", handler.UnsubscribeContactHandler)
+		r.Get("/links/{token}", handler.ActionLinkHandler)
+		r.Post("/links/{token}", handler.PerformActionLinkHandler)
+		r.Get("/track/open/{messageId}", handler.TrackOpenHandler)
+		r.Get("/feeds/{token}/atom.xml", handler.AtomFeedHandler)
+		r.Get("/feeds/{token}/rss.xml", handler.RSSFeedHandler)
+
+		r.Route("/webhooks", func(r chi.Router) {
+			r.Post("/twilio/sms", handler.TwilioInboundSMSHandler)
+			r.Post("/email/bounce", handler.EmailBounceWebhookHandler)
+
+			r.Group(func(r chi.Router) {
+				r.Use(auth.AuthMiddleware)
+				r.Get("/endpoints", handler.ListWebhookEndpointsHandler)
+				r.Post("/endpoints", handler.CreateWebhookEndpointHandler)
+				r.Put("/endpoints/{id}", handler.UpdateWebhookEndpointHandler)
+				r.Delete("/endpoints/{id}", handler.DeleteWebhookEndpointHandler)
+				r.Post("/endpoints/{id}/rotate-secret", handler.RotateWebhookSecretHandler)
+				r.Get("/endpoints/{id}/deliveries", handler.ListWebhookDeliveriesHandler)
+				r.Post("/endpoints/{id}/deliveries/{deliveryId}/redeliver", handler.RedeliverWebhookHandler)
+			})
+		})
 	})
 
+	// The frontend takes every path no route above matches; /api and the
+	// other mounts keep their own 404s.
+	spa, err := web.New(cfg.Web.Dir)
+	switch {
+	case err == nil:
+		r.Handle("/*", spa)
+	case errors.Is(err, web.ErrNoBuild) && cfg.Web.Dir == "":
+		log.Println("No frontend build embedded: serving the API only")
+	default:
+		log.Printf("Failed to load frontend from %s, serving the API only: %v", cfg.Web.Dir, err)
+	}
+
 	return r
 }
@@ -0,0 +1,550 @@
+package api
+
+import (
+	"encoding/json"
+	"net/http"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+
+	"xpired/internal/auth"
+	"xpired/internal/db"
+	"xpired/internal/tenant"
+	"xpired/internal/worker"
+)
+
+// SCIM 2.0 (RFC 7643/7644) lets an organization's identity provider
+// provision and deprovision its members. A SCIM User is an organization
+// member: creating one adds the user with that email to the organization,
+// signing them up first if needed, and deleting or deactivating one removes
+// the membership. Profile fields belong to the user's account, which may be
+// shared with other organizations, so they are only used when the account is
+// created.
+
+const (
+	scimUserSchema         = "urn:ietf:params:scim:schemas:core:2.0:User"
+	scimListResponseSchema = "urn:ietf:params:scim:api:messages:2.0:ListResponse"
+	scimErrorSchema        = "urn:ietf:params:scim:api:messages:2.0:Error"
+	scimProviderSchema     = "urn:ietf:params:scim:schemas:core:2.0:ServiceProviderConfig"
+
+	scimContentType  = "application/scim+json"
+	scimMaxResults   = 200
+	scimDefaultCount = 100
+)
+
+type scimName struct {
+	Formatted  string `json:"formatted,omitempty"`
+	GivenName  string `json:"givenName,omitempty"`
+	FamilyName string `json:"familyName,omitempty"`
+}
+
+type scimEmail struct {
+	Value   string `json:"value"`
+	Type    string `json:"type,omitempty"`
+	Primary bool   `json:"primary,omitempty"`
+}
+
+type scimMeta struct {
+	ResourceType string    `json:"resourceType"`
+	Created      time.Time `json:"created"`
+	Location     string    `json:"location"`
+}
+
+type scimUser struct {
+	Schemas     []string    `json:"schemas"`
+	ID          string      `json:"id,omitempty"`
+	ExternalID  *string     `json:"externalId,omitempty"`
+	UserName    string      `json:"userName"`
+	Name        *scimName   `json:"name,omitempty"`
+	DisplayName string      `json:"displayName,omitempty"`
+	Emails      []scimEmail `json:"emails,omitempty"`
+	Active      *bool       `json:"active,omitempty"`
+	Meta        *scimMeta   `json:"meta,omitempty"`
+}
+
+type scimListResponse struct {
+	Schemas      []string   `json:"schemas"`
+	TotalResults int        `json:"totalResults"`
+	StartIndex   int        `json:"startIndex"`
+	ItemsPerPage int        `json:"itemsPerPage"`
+	Resources    []scimUser `json:"Resources"`
+}
+
+type scimPatchOperation struct {
+	Op    string          `json:"op"`
+	Path  string          `json:"path,omitempty"`
+	Value json.RawMessage `json:"value,omitempty"`
+}
+
+type scimPatchRequest struct {
+	Schemas    []string             `json:"schemas"`
+	Operations []scimPatchOperation `json:"Operations"`
+}
+
+type scimError struct {
+	Schemas  []string `json:"schemas"`
+	Status   string   `json:"status"`
+	ScimType string   `json:"scimType,omitempty"`
+	Detail   string   `json:"detail"`
+}
+
+// scimFilterPattern matches the only filters identity providers need to
+// look members up: `userName eq "..."` and `externalId eq "..."`.
+var scimFilterPattern = regexp.MustCompile(`^(?i)(userName|externalId)\s+eq\s+"([^"]*)"$`)
+
+func writeSCIM(w http.ResponseWriter, status int, body interface{}) {
+	w.Header().Set("Content-Type", scimContentType)
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(body)
+}
+
+func writeSCIMError(w http.ResponseWriter, status int, scimType, detail string) {
+	writeSCIM(w, status, scimError{
+		Schemas:  []string{scimErrorSchema},
+		Status:   strconv.Itoa(status),
+		ScimType: scimType,
+		Detail:   detail,
+	})
+}
+
+func (h *Handler) scimBaseURL() string {
+	return h.cfg.App.BaseURL + "/scim/v2"
+}
+
+func (h *Handler) toSCIMUser(member *db.OrganizationMember) scimUser {
+	active := true
+	return scimUser{
+		Schemas:     []string{scimUserSchema},
+		ID:          member.UserID,
+		ExternalID:  member.ExternalID,
+		UserName:    member.Email,
+		Name:        &scimName{Formatted: member.Name},
+		DisplayName: member.Name,
+		Emails:      []scimEmail{{Value: member.Email, Type: "work", Primary: true}},
+		Active:      &active,
+		Meta: &scimMeta{
+			ResourceType: "User",
+			Created:      member.CreatedAt,
+			Location:     h.scimBaseURL() + "/Users/" + member.UserID,
+		},
+	}
+}
+
+// displayName picks the name to give an account created for u.
+func (u scimUser) displayName() string {
+	if u.DisplayName != "" {
+		return u.DisplayName
+	}
+	if u.Name != nil {
+		if u.Name.Formatted != "" {
+			return u.Name.Formatted
+		}
+		if full := strings.TrimSpace(u.Name.GivenName + " " + u.Name.FamilyName); full != "" {
+			return full
+		}
+	}
+	return u.UserName
+}
+
+// SCIMMiddleware authenticates the identity provider by its organization's
+// SCIM bearer token and puts that organization in the request context.
+func (h *Handler) SCIMMiddleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		token, found := strings.CutPrefix(r.Header.Get("Authorization"), "Bearer ")
+		if !found || token == "" {
+			writeSCIMError(w, http.StatusUnauthorized, "", "A SCIM bearer token is required")
+			return
+		}
+
+		organizationID, err := h.repo.GetOrganizationIDBySCIMToken(r.Context(), auth.HashAPIKey(token))
+		if err != nil {
+			writeSCIMError(w, http.StatusUnauthorized, "", "Invalid SCIM token")
+			return
+		}
+
+		ctx, err := worker.OrganizationContext(r.Context(), h.repo, organizationID)
+		if err != nil {
+			writeSCIMError(w, http.StatusInternalServerError, "", "Failed to load organization")
+			return
+		}
+		next.ServeHTTP(w, r.WithContext(ctx))
+	})
+}
+
+// loadSCIMMember resolves the {userId} URL parameter to a member of the
+// SCIM caller's organization. On failure it writes the error response and
+// returns nil.
+func (h *Handler) loadSCIMMember(w http.ResponseWriter, r *http.Request) *db.OrganizationMember {
+	userID := chi.URLParam(r, "userId")
+	if _, err := uuid.Parse(userID); err != nil {
+		writeSCIMError(w, http.StatusNotFound, "", "User not found")
+		return nil
+	}
+
+	member, err := h.repo.GetOrganizationMember(r.Context(), tenant.OrganizationID(r.Context()), userID)
+	if err != nil {
+		writeSCIMError(w, http.StatusNotFound, "", "User not found")
+		return nil
+	}
+	return member
+}
+
+// deprovisionSCIMMember removes member from the organization. The owner
+// cannot be deprovisioned. On failure it writes the error response and
+// returns false.
+func (h *Handler) deprovisionSCIMMember(w http.ResponseWriter, r *http.Request, member *db.OrganizationMember) bool {
+	if member.Role == db.OrgRoleOwner {
+		writeSCIMError(w, http.StatusConflict, "mutability", "The organization owner cannot be deprovisioned")
+		return false
+	}
+	if err := h.repo.RemoveOrganizationMember(r.Context(), member.OrganizationID, member.UserID); err != nil {
+		writeSCIMError(w, http.StatusInternalServerError, "", "Failed to deprovision user")
+		return false
+	}
+	return true
+}
+
+func (h *Handler) SCIMServiceProviderConfigHandler(w http.ResponseWriter, r *http.Request) {
+	supported := func(ok bool) map[string]interface{} {
+		return map[string]interface{}{"supported": ok}
+	}
+	writeSCIM(w, http.StatusOK, map[string]interface{}{
+		"schemas":        []string{scimProviderSchema},
+		"patch":          supported(true),
+		"bulk":           map[string]interface{}{"supported": false, "maxOperations": 0, "maxPayloadSize": 0},
+		"filter":         map[string]interface{}{"supported": true, "maxResults": scimMaxResults},
+		"changePassword": supported(false),
+		"sort":           supported(false),
+		"etag":           supported(false),
+		"authenticationSchemes": []map[string]interface{}{{
+			"type":        "oauthbearertoken",
+			"name":        "Bearer token",
+			"description": "The organization's SCIM token",
+			"primary":     true,
+		}},
+	})
+}
+
+func (h *Handler) SCIMListUsersHandler(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+
+	var filterAttr, filterValue string
+	if filter := strings.TrimSpace(query.Get("filter")); filter != "" {
+		match := scimFilterPattern.FindStringSubmatch(filter)
+		if match == nil {
+			writeSCIMError(w, http.StatusBadRequest, "invalidFilter", `Only userName eq "..." and externalId eq "..." filters are supported`)
+			return
+		}
+		filterAttr, filterValue = strings.ToLower(match[1]), match[2]
+	}
+
+	startIndex := 1
+	if raw := query.Get("startIndex"); raw != "" {
+		if parsed, err := strconv.Atoi(raw); err == nil && parsed > 1 {
+			startIndex = parsed
+		}
+	}
+	count := scimDefaultCount
+	if raw := query.Get("count"); raw != "" {
+		if parsed, err := strconv.Atoi(raw); err == nil && parsed >= 0 {
+			count = min(parsed, scimMaxResults)
+		}
+	}
+
+	members, err := h.repo.ListOrganizationMembers(r.Context(), tenant.OrganizationID(r.Context()))
+	if err != nil {
+		writeSCIMError(w, http.StatusInternalServerError, "", "Failed to list users")
+		return
+	}
+
+	var matched []*db.OrganizationMember
+	for _, member := range members {
+		switch filterAttr {
+		case "username":
+			if !strings.EqualFold(member.Email, filterValue) {
+				continue
+			}
+		case "externalid":
+			if member.ExternalID == nil || *member.ExternalID != filterValue {
+				continue
+			}
+		}
+		matched = append(matched, member)
+	}
+
+	resources := []scimUser{}
+	for i := startIndex - 1; i < len(matched) && len(resources) < count; i++ {
+		resources = append(resources, h.toSCIMUser(matched[i]))
+	}
+
+	writeSCIM(w, http.StatusOK, scimListResponse{
+		Schemas:      []string{scimListResponseSchema},
+		TotalResults: len(matched),
+		StartIndex:   startIndex,
+		ItemsPerPage: len(resources),
+		Resources:    resources,
+	})
+}
+
+func (h *Handler) SCIMGetUserHandler(w http.ResponseWriter, r *http.Request) {
+	member := h.loadSCIMMember(w, r)
+	if member == nil {
+		return
+	}
+	writeSCIM(w, http.StatusOK, h.toSCIMUser(member))
+}
+
+// SCIMCreateUserHandler provisions a member. Accounts created here have no
+// password yet.
+func (h *Handler) SCIMCreateUserHandler(w http.ResponseWriter, r *http.Request) {
+	var req scimUser
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeSCIMError(w, http.StatusBadRequest, "invalidSyntax", "Invalid request body")
+		return
+	}
+	email := strings.TrimSpace(req.UserName)
+	if email == "" || !strings.Contains(email, "@") {
+		writeSCIMError(w, http.StatusBadRequest, "invalidValue", "userName must be the user's email address")
+		return
+	}
+	if req.Active != nil && !*req.Active {
+		writeSCIMError(w, http.StatusBadRequest, "invalidValue", "Inactive users cannot be provisioned")
+		return
+	}
+
+	org, err := h.repo.GetOrganization(r.Context(), tenant.OrganizationID(r.Context()))
+	if err != nil {
+		writeSCIMError(w, http.StatusInternalServerError, "", "Failed to load organization")
+		return
+	}
+
+	user, err := h.repo.GetUserByEmail(r.Context(), email)
+	if err != nil {
+		user = &db.User{
+			ID:        uuid.New(),
+			Email:     email,
+			Name:      req.displayName(),
+			CreatedAt: time.Now(),
+			UpdatedAt: time.Now(),
+		}
+		if err := h.repo.CreateUser(r.Context(), user); err != nil {
+			writeSCIMError(w, http.StatusInternalServerError, "", "Failed to create user")
+			return
+		}
+	} else if _, err := h.repo.GetOrganizationMember(r.Context(), org.ID.String(), user.ID.String()); err == nil {
+		writeSCIMError(w, http.StatusConflict, "uniqueness", "User is already a member of the organization")
+		return
+	}
+
+	if err := h.repo.AddOrganizationMember(r.Context(), org, user.ID.String(), db.OrgRoleMember); err != nil {
+		writeSCIMError(w, http.StatusInternalServerError, "", "Failed to add organization member")
+		return
+	}
+	if req.ExternalID != nil {
+		if err := h.repo.SetOrganizationMemberExternalID(r.Context(), org.ID.String(), user.ID.String(), req.ExternalID); err != nil {
+			writeSCIMError(w, http.StatusInternalServerError, "", "Failed to save externalId")
+			return
+		}
+	}
+
+	member, err := h.repo.GetOrganizationMember(r.Context(), org.ID.String(), user.ID.String())
+	if err != nil {
+		writeSCIMError(w, http.StatusInternalServerError, "", "Failed to load organization member")
+		return
+	}
+	resource := h.toSCIMUser(member)
+	w.Header().Set("Location", resource.Meta.Location)
+	writeSCIM(w, http.StatusCreated, resource)
+}
+
+// SCIMReplaceUserHandler applies a full User resource. Only active and
+// externalId are taken from it; active=false deprovisions the member.
+func (h *Handler) SCIMReplaceUserHandler(w http.ResponseWriter, r *http.Request) {
+	member := h.loadSCIMMember(w, r)
+	if member == nil {
+		return
+	}
+
+	var req scimUser
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeSCIMError(w, http.StatusBadRequest, "invalidSyntax", "Invalid request body")
+		return
+	}
+
+	h.updateSCIMMember(w, r, member, req.Active, req.ExternalID, true)
+}
+
+// SCIMPatchUserHandler applies PatchOp operations on active and externalId,
+// the attributes identity providers use to deactivate and link members.
+func (h *Handler) SCIMPatchUserHandler(w http.ResponseWriter, r *http.Request) {
+	member := h.loadSCIMMember(w, r)
+	if member == nil {
+		return
+	}
+
+	var req scimPatchRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeSCIMError(w, http.StatusBadRequest, "invalidSyntax", "Invalid request body")
+		return
+	}
+
+	var active *bool
+	var externalID *string
+	setExternalID := false
+	for _, op := range req.Operations {
+		switch strings.ToLower(op.Op) {
+		case "replace", "add":
+		case "remove":
+			if strings.EqualFold(op.Path, "externalId") {
+				externalID, setExternalID = nil, true
+				continue
+			}
+			writeSCIMError(w, http.StatusBadRequest, "invalidPath", "Only externalId can be removed")
+			return
+		default:
+			writeSCIMError(w, http.StatusBadRequest, "invalidSyntax", "Unsupported patch operation: "+op.Op)
+			return
+		}
+
+		// Without a path the value is an object of attributes to set.
+		values := map[string]json.RawMessage{}
+		if op.Path == "" {
+			if err := json.Unmarshal(op.Value, &values); err != nil {
+				writeSCIMError(w, http.StatusBadRequest, "invalidValue", "Patch value must be an object when no path is given")
+				return
+			}
+		} else {
+			values[op.Path] = op.Value
+		}
+
+		for attr, raw := range values {
+			switch strings.ToLower(attr) {
+			case "active":
+				var value bool
+				if err := json.Unmarshal(raw, &value); err != nil {
+					// Some identity providers send booleans as strings.
+					var text string
+					if err := json.Unmarshal(raw, &text); err != nil {
+						writeSCIMError(w, http.StatusBadRequest, "invalidValue", "active must be a boolean")
+						return
+					}
+					value = strings.EqualFold(text, "true")
+				}
+				active = &value
+			case "externalid":
+				var value string
+				if err := json.Unmarshal(raw, &value); err != nil {
+					writeSCIMError(w, http.StatusBadRequest, "invalidValue", "externalId must be a string")
+					return
+				}
+				externalID, setExternalID = &value, true
+			}
+			// Other attributes describe the user's account and are ignored.
+		}
+	}
+
+	h.updateSCIMMember(w, r, member, active, externalID, setExternalID)
+}
+
+func (h *Handler) updateSCIMMember(w http.ResponseWriter, r *http.Request, member *db.OrganizationMember, active *bool, externalID *string, setExternalID bool) {
+	if active != nil && !*active {
+		if !h.deprovisionSCIMMember(w, r, member) {
+			return
+		}
+		resource := h.toSCIMUser(member)
+		resource.Active = active
+		writeSCIM(w, http.StatusOK, resource)
+		return
+	}
+
+	if setExternalID {
+		if err := h.repo.SetOrganizationMemberExternalID(r.Context(), member.OrganizationID, member.UserID, externalID); err != nil {
+			writeSCIMError(w, http.StatusInternalServerError, "", "Failed to save externalId")
+			return
+		}
+		member.ExternalID = externalID
+	}
+	writeSCIM(w, http.StatusOK, h.toSCIMUser(member))
+}
+
+func (h *Handler) SCIMDeleteUserHandler(w http.ResponseWriter, r *http.Request) {
+	member := h.loadSCIMMember(w, r)
+	if member == nil {
+		return
+	}
+	if !h.deprovisionSCIMMember(w, r, member) {
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// RotateSCIMTokenHandler issues the organization's SCIM token, replacing any
+// previous one. Only its hash is stored, so the token is only shown in this
+// response.
+func (h *Handler) RotateSCIMTokenHandler(w http.ResponseWriter, r *http.Request) {
+	org, caller, ok := h.loadOrganizationMembership(w, r)
+	if !ok {
+		return
+	}
+	if !canManageOrganization(caller) {
+		errResp := ForbiddenError("Only organization owners and admins can manage SCIM provisioning")
+		WriteErrorResponse(w, errResp)
+		return
+	}
+
+	token, err := randomToken(32)
+	if err != nil {
+		errResp := InternalServerError("Failed to generate SCIM token")
+		WriteErrorResponse(w, errResp)
+		return
+	}
+	if err := h.repo.SetSCIMToken(r.Context(), org.ID.String(), auth.HashAPIKey(token)); err != nil {
+		errResp := InternalServerError("Failed to save SCIM token")
+		WriteErrorResponse(w, errResp)
+		return
+	}
+
+	resp := map[string]interface{}{
+		"message": "SCIM token generated successfully",
+		"token":   token,
+		"baseUrl": h.scimBaseURL(),
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(resp); err != nil {
+		errResp := InternalServerError("Failed to encode response")
+		WriteErrorResponse(w, errResp)
+	}
+}
+
+func (h *Handler) DeleteSCIMTokenHandler(w http.ResponseWriter, r *http.Request) {
+	org, caller, ok := h.loadOrganizationMembership(w, r)
+	if !ok {
+		return
+	}
+	if !canManageOrganization(caller) {
+		errResp := ForbiddenError("Only organization owners and admins can manage SCIM provisioning")
+		WriteErrorResponse(w, errResp)
+		return
+	}
+
+	if err := h.repo.DeleteSCIMToken(r.Context(), org.ID.String()); err != nil {
+		errResp := InternalServerError("Failed to delete SCIM token")
+		WriteErrorResponse(w, errResp)
+		return
+	}
+
+	resp := map[string]interface{}{
+		"message": "SCIM provisioning disabled",
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(resp); err != nil {
+		errResp := InternalServerError("Failed to encode response")
+		WriteErrorResponse(w, errResp)
+	}
+}
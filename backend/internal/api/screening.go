@@ -0,0 +1,46 @@
+package api
+
+import (
+	"log"
+	"net/http"
+)
+
+// screenRegistration runs the configured sign-up checks against req. On
+// failure it writes the error response and returns false.
+func (h *Handler) screenRegistration(w http.ResponseWriter, r *http.Request, req *UserRequest) bool {
+	if h.cfg.Registration.Honeypot && req.Website != "" {
+		log.Printf("Rejected registration of %s from %s: honeypot filled in", req.Email, r.RemoteAddr)
+		errResp := BadRequestError("Registration rejected")
+		WriteErrorResponse(w, errResp)
+		return false
+	}
+
+	if h.disposableDomains.Contains(req.Email) {
+		log.Printf("Rejected registration of %s from %s: disposable email domain", req.Email, r.RemoteAddr)
+		errResp := BadRequestError("Disposable email addresses cannot be used to sign up")
+		WriteErrorResponse(w, errResp)
+		return false
+	}
+
+	if h.captcha != nil {
+		if req.CaptchaToken == "" {
+			errResp := BadRequestError("CAPTCHA verification is required")
+			WriteErrorResponse(w, errResp)
+			return false
+		}
+		ok, err := h.captcha.Verify(r.Context(), req.CaptchaToken, r.RemoteAddr)
+		if err != nil {
+			log.Printf("Failed to verify CAPTCHA for registration of %s: %v", req.Email, err)
+			errResp := ServiceUnavailableError("CAPTCHA verification is unavailable, please try again")
+			WriteErrorResponse(w, errResp)
+			return false
+		}
+		if !ok {
+			log.Printf("Rejected registration of %s from %s: CAPTCHA failed", req.Email, r.RemoteAddr)
+			errResp := BadRequestError("CAPTCHA verification failed")
+			WriteErrorResponse(w, errResp)
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,262 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"log"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+
+	"xpired/internal/db"
+)
+
+// serviceAccountEmailDomain is where the users behind service accounts get
+// their addresses. The .invalid TLD never delivers, and they have no
+// password, so nobody can sign in as one.
+const serviceAccountEmailDomain = "service-accounts.invalid"
+
+// recordServiceAccountAudit logs an action actorID took on account. Audit
+// entries of what the service account does itself carry account.UserID as
+// their actor.
+func (h *Handler) recordServiceAccountAudit(ctx context.Context, actorID string, account *db.ServiceAccount, action string) {
+	metadata, _ := json.Marshal(map[string]interface{}{
+		"name":           account.Name,
+		"organizationId": account.OrganizationID,
+	})
+	entry := &db.AuditLog{
+		ID:             uuid.New(),
+		ActorID:        &actorID,
+		UserID:         &account.UserID,
+		OrganizationID: &account.OrganizationID,
+		Action:         action,
+		EntityType:     "service_account",
+		EntityID:       account.ID.String(),
+		Metadata:       metadata,
+	}
+	if err := h.repo.CreateAuditLog(ctx, entry); err != nil {
+		log.Printf("Failed to record %s for service account %s in audit log: %v", action, account.ID.String(), err)
+	}
+}
+
+// loadServiceAccount returns the caller and the service account of the
+// request when the caller may manage it, writing the error response
+// otherwise.
+func (h *Handler) loadServiceAccount(w http.ResponseWriter, r *http.Request) (*db.OrganizationMember, *db.ServiceAccount, bool) {
+	org, caller, ok := h.loadOrganizationMembership(w, r)
+	if !ok {
+		return nil, nil, false
+	}
+	if !canManageOrganization(caller) {
+		errResp := ForbiddenError("Only organization owners and admins can manage service accounts")
+		WriteErrorResponse(w, errResp)
+		return nil, nil, false
+	}
+
+	accountID := chi.URLParam(r, "accountId")
+	if _, err := uuid.Parse(accountID); err != nil {
+		errResp := NotFoundError("Service account not found")
+		WriteErrorResponse(w, errResp)
+		return nil, nil, false
+	}
+	account, err := h.repo.GetServiceAccount(r.Context(), org.ID.String(), accountID)
+	if err != nil {
+		if err.Error() == "service account not found" {
+			errResp := NotFoundError("Service account not found")
+			WriteErrorResponse(w, errResp)
+			return nil, nil, false
+		}
+		errResp := InternalServerError("Failed to fetch service account")
+		WriteErrorResponse(w, errResp)
+		return nil, nil, false
+	}
+	return caller, account, true
+}
+
+// ListServiceAccountsHandler returns the organization's service accounts.
+// Their tokens are only shown when they are issued.
+func (h *Handler) ListServiceAccountsHandler(w http.ResponseWriter, r *http.Request) {
+	org, caller, ok := h.loadOrganizationMembership(w, r)
+	if !ok {
+		return
+	}
+	if !canManageOrganization(caller) {
+		errResp := ForbiddenError("Only organization owners and admins can manage service accounts")
+		WriteErrorResponse(w, errResp)
+		return
+	}
+
+	accounts, err := h.repo.ListServiceAccounts(r.Context(), org.ID.String())
+	if err != nil {
+		errResp := InternalServerError("Failed to fetch service accounts")
+		WriteErrorResponse(w, errResp)
+		return
+	}
+
+	resp := map[string]interface{}{
+		"message":         "Service accounts retrieved successfully",
+		"serviceAccounts": accounts,
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(resp); err != nil {
+		errResp := InternalServerError("Failed to encode response")
+		WriteErrorResponse(w, errResp)
+	}
+}
+
+// CreateServiceAccountHandler creates a service account owned by the
+// organization and issues its first token. The service account is a member
+// of the organization, so its token works with the organization's documents
+// whichever person created it, and keeps working after they leave.
+func (h *Handler) CreateServiceAccountHandler(w http.ResponseWriter, r *http.Request) {
+	org, caller, ok := h.loadOrganizationMembership(w, r)
+	if !ok {
+		return
+	}
+	if !canManageOrganization(caller) {
+		errResp := ForbiddenError("Only organization owners and admins can manage service accounts")
+		WriteErrorResponse(w, errResp)
+		return
+	}
+
+	var req CreateServiceAccountRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		errResp := BadRequestError("Invalid request body")
+		WriteErrorResponse(w, errResp)
+		return
+	}
+	req.Name = strings.TrimSpace(req.Name)
+	if req.Name == "" || len(req.Name) > maxAPIKeyNameLength {
+		errResp := BadRequestError("name must be between 1 and 100 characters")
+		WriteErrorResponse(w, errResp)
+		return
+	}
+	scopes, ok := parseScopes(w, req.Scopes)
+	if !ok {
+		return
+	}
+
+	accountID := uuid.New()
+	user := &db.User{
+		ID:        uuid.New(),
+		Email:     accountID.String() + "@" + serviceAccountEmailDomain,
+		Name:      req.Name,
+		CreatedAt: time.Now(),
+		UpdatedAt: time.Now(),
+	}
+	if err := h.repo.CreateUser(r.Context(), user); err != nil {
+		errResp := InternalServerError("Failed to create service account")
+		WriteErrorResponse(w, errResp)
+		return
+	}
+	if err := h.repo.AddOrganizationMember(r.Context(), org, user.ID.String(), db.OrgRoleMember); err != nil {
+		errResp := InternalServerError("Failed to create service account")
+		WriteErrorResponse(w, errResp)
+		return
+	}
+	account := &db.ServiceAccount{
+		ID:             accountID,
+		OrganizationID: org.ID.String(),
+		UserID:         user.ID.String(),
+		Name:           req.Name,
+		Scopes:         scopes,
+		CreatedBy:      &caller.UserID,
+	}
+	if err := h.repo.CreateServiceAccount(r.Context(), account); err != nil {
+		errResp := InternalServerError("Failed to create service account")
+		WriteErrorResponse(w, errResp)
+		return
+	}
+	h.recordServiceAccountAudit(r.Context(), caller.UserID, account, db.AuditActionServiceAccountCreated)
+
+	plaintext, ok := h.issueServiceAccountToken(w, r, account)
+	if !ok {
+		return
+	}
+
+	resp := map[string]interface{}{
+		"message":        "Service account created successfully",
+		"serviceAccount": account,
+		"token":          plaintext,
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusCreated)
+	if err := json.NewEncoder(w).Encode(resp); err != nil {
+		errResp := InternalServerError("Failed to encode response")
+		WriteErrorResponse(w, errResp)
+	}
+}
+
+// issueServiceAccountToken gives account a new token with its scopes,
+// replacing the one it had, and returns the token.
+func (h *Handler) issueServiceAccountToken(w http.ResponseWriter, r *http.Request, account *db.ServiceAccount) (string, bool) {
+	key, plaintext, err := newAPIKey(account.UserID, account.Name, account.Scopes, nil)
+	if err != nil {
+		errResp := InternalServerError("Failed to generate service account token")
+		WriteErrorResponse(w, errResp)
+		return "", false
+	}
+	if err := h.repo.RotateServiceAccountToken(r.Context(), key); err != nil {
+		errResp := InternalServerError("Failed to save service account token")
+		WriteErrorResponse(w, errResp)
+		return "", false
+	}
+	account.Token = key
+	return plaintext, true
+}
+
+// RotateServiceAccountTokenHandler issues the service account a new token.
+// The previous one stops working at once; the new one is only shown in this
+// response.
+func (h *Handler) RotateServiceAccountTokenHandler(w http.ResponseWriter, r *http.Request) {
+	caller, account, ok := h.loadServiceAccount(w, r)
+	if !ok {
+		return
+	}
+
+	plaintext, ok := h.issueServiceAccountToken(w, r, account)
+	if !ok {
+		return
+	}
+	h.recordServiceAccountAudit(r.Context(), caller.UserID, account, db.AuditActionServiceAccountTokenRotated)
+
+	resp := map[string]interface{}{
+		"message":        "Service account token rotated successfully",
+		"serviceAccount": account,
+		"token":          plaintext,
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(resp); err != nil {
+		errResp := InternalServerError("Failed to encode response")
+		WriteErrorResponse(w, errResp)
+	}
+}
+
+// RevokeServiceAccountTokenHandler revokes the service account's token. The
+// account, and what it created, stays until it is issued a new one.
+func (h *Handler) RevokeServiceAccountTokenHandler(w http.ResponseWriter, r *http.Request) {
+	caller, account, ok := h.loadServiceAccount(w, r)
+	if !ok {
+		return
+	}
+
+	if err := h.repo.RevokeServiceAccountToken(r.Context(), account.UserID); err != nil {
+		if err.Error() == "service account token not found" {
+			errResp := NotFoundError("Service account has no token")
+			WriteErrorResponse(w, errResp)
+			return
+		}
+		errResp := InternalServerError("Failed to revoke service account token")
+		WriteErrorResponse(w, errResp)
+		return
+	}
+	h.recordServiceAccountAudit(r.Context(), caller.UserID, account, db.AuditActionServiceAccountTokenRevoked)
+
+	w.WriteHeader(http.StatusNoContent)
+}
@@ -0,0 +1,304 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"log"
+	"net"
+	"net/http"
+	"net/netip"
+	"strings"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+	"github.com/redis/go-redis/v9"
+
+	"xpired/internal/auth"
+	"xpired/internal/db"
+)
+
+const maxSessionUserAgentLength = 500
+
+// sessionStatusTTL bounds how long whether a session is active is cached.
+// Revoking a session caches it as revoked right away, so this only matters
+// when that fails.
+const sessionStatusTTL = 5 * time.Minute
+
+const (
+	sessionActive  = "active"
+	sessionRevoked = "revoked"
+)
+
+func sessionStatusKey(sessionID string) string {
+	return "xpired:session:" + sessionID
+}
+
+// IsSessionRevoked is the auth.RevocationChecker of AuthMiddleware. Whether
+// a session is revoked is cached in Redis so requests rarely reach the
+// database; when Redis is down it comes straight from the database.
+func (h *Handler) IsSessionRevoked(ctx context.Context, sessionID string) (bool, error) {
+	key := sessionStatusKey(sessionID)
+	status, err := h.cache.Get(ctx, key).Result()
+	if err == nil {
+		return status == sessionRevoked, nil
+	}
+	if err != redis.Nil {
+		log.Printf("Failed to read cached status of session %s: %v", sessionID, err)
+	}
+
+	revoked, err := h.repo.IsSessionRevoked(ctx, sessionID)
+	if err != nil {
+		return false, err
+	}
+	status = sessionActive
+	if revoked {
+		status = sessionRevoked
+	}
+	if err := h.cache.Set(ctx, key, status, sessionStatusTTL).Err(); err != nil {
+		log.Printf("Failed to cache status of session %s: %v", sessionID, err)
+	}
+	return revoked, nil
+}
+
+// cacheRevokedSessions marks sessions revoked in the cache until their
+// tokens expire, so every API replica refuses them at once.
+func (h *Handler) cacheRevokedSessions(ctx context.Context, sessions []*db.Session) {
+	for _, session := range sessions {
+		ttl := time.Until(session.ExpiresAt)
+		if ttl <= 0 {
+			continue
+		}
+		if err := h.cache.Set(ctx, sessionStatusKey(session.ID.String()), sessionRevoked, ttl).Err(); err != nil {
+			log.Printf("Failed to cache revocation of session %s: %v", session.ID.String(), err)
+		}
+	}
+}
+
+// recordSession stores the sign-in that issued the token with claims, with
+// the device and location it came from. The sign-in must fail when this
+// does: a session that was never stored cannot be revoked, so its token
+// would stay valid until it expires.
+func (h *Handler) recordSession(r *http.Request, claims *auth.Claims) error {
+	id, err := uuid.Parse(claims.ID)
+	if err != nil {
+		return err
+	}
+	session := &db.Session{
+		ID:        id,
+		UserID:    claims.Subject,
+		ExpiresAt: claims.ExpiresAt.Time,
+	}
+	if userAgent := r.UserAgent(); userAgent != "" {
+		if len(userAgent) > maxSessionUserAgentLength {
+			userAgent = userAgent[:maxSessionUserAgentLength]
+		}
+		session.UserAgent = &userAgent
+	}
+	if ip, ok := clientIP(r); ok {
+		address := ip.String()
+		session.IP = &address
+		if location, ok := h.geoip.Lookup(ip); ok {
+			session.Country = nonEmpty(location.Country)
+			session.Region = nonEmpty(location.Region)
+			session.City = nonEmpty(location.City)
+		}
+	}
+
+	if err := h.repo.CreateSession(r.Context(), session); err != nil {
+		return err
+	}
+	return nil
+}
+
+// ListSessionsHandler lists the user's active sign-ins with the device and
+// approximate location of each, so they can spot ones that were not theirs.
+func (h *Handler) ListSessionsHandler(w http.ResponseWriter, r *http.Request) {
+	userID, err := auth.GetUserIDFromContext(r)
+	if err != nil {
+		errResp := UnauthorizedError("Unauthorized")
+		WriteErrorResponse(w, errResp)
+		return
+	}
+
+	sessions, err := h.repo.ListSessions(r.Context(), userID)
+	if err != nil {
+		errResp := InternalServerError("Failed to fetch sessions")
+		WriteErrorResponse(w, errResp)
+		return
+	}
+
+	current := auth.SessionIDFromContext(r.Context())
+	respSessions := []SessionResponse{}
+	for _, session := range sessions {
+		respSessions = append(respSessions, SessionResponse{
+			ID:        session.ID.String(),
+			Device:    deviceName(session.UserAgent),
+			UserAgent: session.UserAgent,
+			IP:        session.IP,
+			Country:   session.Country,
+			Region:    session.Region,
+			City:      session.City,
+			Current:   session.ID.String() == current,
+			CreatedAt: session.CreatedAt,
+			ExpiresAt: session.ExpiresAt,
+		})
+	}
+
+	resp := map[string]interface{}{
+		"message":  "Sessions fetched successfully",
+		"sessions": respSessions,
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(resp); err != nil {
+		errResp := InternalServerError("Failed to encode response")
+		WriteErrorResponse(w, errResp)
+	}
+}
+
+// RevokeSessionHandler signs one of the user's sessions out. Its token is
+// refused from then on, on whichever device holds it.
+func (h *Handler) RevokeSessionHandler(w http.ResponseWriter, r *http.Request) {
+	userID, err := auth.GetUserIDFromContext(r)
+	if err != nil {
+		errResp := UnauthorizedError("Unauthorized")
+		WriteErrorResponse(w, errResp)
+		return
+	}
+
+	sessionID := chi.URLParam(r, "id")
+	if _, err := uuid.Parse(sessionID); err != nil {
+		errResp := NotFoundError("Session not found")
+		WriteErrorResponse(w, errResp)
+		return
+	}
+	session, err := h.repo.RevokeSession(r.Context(), userID, sessionID)
+	if err != nil {
+		if err.Error() == "session not found" {
+			errResp := NotFoundError("Session not found")
+			WriteErrorResponse(w, errResp)
+			return
+		}
+		errResp := InternalServerError("Failed to revoke session")
+		WriteErrorResponse(w, errResp)
+		return
+	}
+	h.cacheRevokedSessions(r.Context(), []*db.Session{session})
+
+	if sessionID == auth.SessionIDFromContext(r.Context()) {
+		clearAuthCookie(w)
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// RevokeAllSessionsHandler logs the user out everywhere, including the
+// session of the request unless keepCurrent is true.
+func (h *Handler) RevokeAllSessionsHandler(w http.ResponseWriter, r *http.Request) {
+	userID, err := auth.GetUserIDFromContext(r)
+	if err != nil {
+		errResp := UnauthorizedError("Unauthorized")
+		WriteErrorResponse(w, errResp)
+		return
+	}
+
+	var except string
+	keepCurrent := r.URL.Query().Get("keepCurrent") == "true"
+	if keepCurrent {
+		except = auth.SessionIDFromContext(r.Context())
+	}
+	sessions, err := h.repo.RevokeSessions(r.Context(), userID, except)
+	if err != nil {
+		errResp := InternalServerError("Failed to revoke sessions")
+		WriteErrorResponse(w, errResp)
+		return
+	}
+	h.cacheRevokedSessions(r.Context(), sessions)
+
+	if !keepCurrent {
+		clearAuthCookie(w)
+	}
+
+	resp := map[string]interface{}{
+		"message": "Sessions revoked successfully",
+		"revoked": len(sessions),
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(resp); err != nil {
+		errResp := InternalServerError("Failed to encode response")
+		WriteErrorResponse(w, errResp)
+	}
+}
+
+// clientIP returns the address the request came from. Behind a trusted
+// proxy, RealIPMiddleware has already replaced RemoteAddr with the address
+// it forwarded.
+func clientIP(r *http.Request) (netip.Addr, bool) {
+	host := r.RemoteAddr
+	if h, _, err := net.SplitHostPort(host); err == nil {
+		host = h
+	}
+	ip, err := netip.ParseAddr(host)
+	if err != nil {
+		return netip.Addr{}, false
+	}
+	return ip.Unmap(), true
+}
+
+// deviceName summarizes a user agent as "<browser> on <OS>", or nil when
+// neither is recognized.
+func deviceName(userAgent *string) *string {
+	if userAgent == nil {
+		return nil
+	}
+	ua := *userAgent
+
+	var browser string
+	switch {
+	case strings.HasPrefix(ua, "xpiredctl/"):
+		browser = "xpiredctl"
+	case strings.Contains(ua, "Edg/"):
+		browser = "Edge"
+	case strings.Contains(ua, "OPR/"):
+		browser = "Opera"
+	case strings.Contains(ua, "Firefox/"), strings.Contains(ua, "FxiOS/"):
+		browser = "Firefox"
+	case strings.Contains(ua, "Chrome/"), strings.Contains(ua, "CriOS/"):
+		browser = "Chrome"
+	case strings.Contains(ua, "Safari/"):
+		browser = "Safari"
+	}
+
+	var os string
+	switch {
+	case strings.Contains(ua, "iPhone"), strings.Contains(ua, "iPad"):
+		os = "iOS"
+	case strings.Contains(ua, "Android"):
+		os = "Android"
+	case strings.Contains(ua, "Windows"):
+		os = "Windows"
+	case strings.Contains(ua, "Mac OS X"), strings.Contains(ua, "Macintosh"):
+		os = "macOS"
+	case strings.Contains(ua, "CrOS"):
+		os = "ChromeOS"
+	case strings.Contains(ua, "Linux"):
+		os = "Linux"
+	}
+
+	name := browser
+	if browser == "" {
+		name = os
+	} else if os != "" {
+		name = browser + " on " + os
+	}
+	return nonEmpty(name)
+}
+
+// nonEmpty returns a pointer to s, or nil for "".
+func nonEmpty(s string) *string {
+	if s == "" {
+		return nil
+	}
+	return &s
+}
@@ -0,0 +1,162 @@
+package api
+
+import (
+	"crypto/hmac"
+	"crypto/sha1"
+	"encoding/base64"
+	"encoding/xml"
+	"errors"
+	"net/http"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+
+	"xpired/internal/db"
+	worker "xpired/internal/worker"
+)
+
+type twimlResponse struct {
+	XMLName xml.Name `xml:"Response"`
+	Message string   `xml:"Message,omitempty"`
+}
+
+func writeTwiML(w http.ResponseWriter, message string) {
+	w.Header().Set("Content-Type", "application/xml")
+	w.Write([]byte(xml.Header))
+	xml.NewEncoder(w).Encode(twimlResponse{Message: message})
+}
+
+// validTwilioSignature implements Twilio's request validation: an HMAC-SHA1,
+// keyed with the auth token, over the full URL followed by every POST
+// parameter name and value sorted by name.
+func validTwilioSignature(authToken, fullURL string, form url.Values, signature string) bool {
+	keys := make([]string, 0, len(form))
+	for key := range form {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	var b strings.Builder
+	b.WriteString(fullURL)
+	for _, key := range keys {
+		for _, value := range form[key] {
+			b.WriteString(key)
+			b.WriteString(value)
+		}
+	}
+
+	mac := hmac.New(sha1.New, []byte(authToken))
+	mac.Write([]byte(b.String()))
+	expected := base64.StdEncoding.EncodeToString(mac.Sum(nil))
+	return hmac.Equal([]byte(expected), []byte(signature))
+}
+
+// TwilioInboundSMSHandler handles replies to reminder texts. The sender is
+// matched to a user by phone number and the command applies to the document
+// from the most recent SMS reminder they were sent:
+//
+//	RENEWED [YYYY-MM-DD]  mark the document renewed
+//	SNOOZE [days]         send the reminder again later (default 7 days)
+func (h *Handler) TwilioInboundSMSHandler(w http.ResponseWriter, r *http.Request) {
+	if err := r.ParseForm(); err != nil {
+		errResp := BadRequestError("Invalid form body")
+		WriteErrorResponse(w, errResp)
+		return
+	}
+
+	// without the auth token no reply can be told apart from a forged one,
+	// and a forged one acts on the documents of whoever's number it names
+	if h.cfg.Twilio.AuthToken == "" {
+		errResp := ForbiddenError("SMS replies are not enabled")
+		WriteErrorResponse(w, errResp)
+		return
+	}
+	fullURL := h.cfg.App.BaseURL + r.URL.RequestURI()
+	if !validTwilioSignature(h.cfg.Twilio.AuthToken, fullURL, r.PostForm, r.Header.Get("X-Twilio-Signature")) {
+		errResp := ForbiddenError("Invalid Twilio signature")
+		WriteErrorResponse(w, errResp)
+		return
+	}
+
+	user, err := h.repo.GetUserByPhoneNumber(r.Context(), r.PostForm.Get("From"))
+	if err != nil {
+		writeTwiML(w, "We couldn't find an xpired account for this number.")
+		return
+	}
+
+	fields := strings.Fields(strings.ToUpper(r.PostForm.Get("Body")))
+	if len(fields) == 0 {
+		writeTwiML(w, "Reply RENEWED or SNOOZE 7 to manage your reminder.")
+		return
+	}
+
+	// Only reminders logged in the home database are found here; documents
+	// kept in an organization's data region are acted on through their links.
+	latest, err := h.repo.GetLatestNotificationLog(r.Context(), user.ID.String(), worker.ChannelSMS)
+	if err != nil {
+		writeTwiML(w, "There is no recent reminder to act on.")
+		return
+	}
+
+	doc, err := h.repo.GetDocumentByID(r.Context(), latest.DocumentID)
+	if err != nil {
+		writeTwiML(w, "That document no longer exists.")
+		return
+	}
+
+	switch fields[0] {
+	case "RENEWED", "RENEW":
+		writeTwiML(w, h.renewFromSMS(r, doc, fields[1:]))
+
+	case "SNOOZE":
+		days := 7
+		if len(fields) > 1 {
+			parsed, err := strconv.Atoi(fields[1])
+			if err != nil || parsed < 1 || parsed > 90 {
+				writeTwiML(w, "Reply SNOOZE followed by a number of days between 1 and 90.")
+				return
+			}
+			days = parsed
+		}
+		if _, err := h.snoozeReminder(r.Context(), doc, latest.ReminderIntervalID, days); err != nil {
+			if errors.Is(err, errReminderNotSnoozable) {
+				writeTwiML(w, "That reminder can no longer be snoozed.")
+				return
+			}
+			writeTwiML(w, "Sorry, we couldn't snooze that reminder. Please try again.")
+			return
+		}
+		writeTwiML(w, "OK, we'll remind you about '"+doc.Name+"' again in "+strconv.Itoa(days)+" days.")
+
+	case "HELP":
+		writeTwiML(w, "Reply RENEWED (optionally with the new expiry as YYYY-MM-DD) or SNOOZE followed by a number of days.")
+
+	default:
+		writeTwiML(w, "Sorry, we didn't understand that. Reply RENEWED or SNOOZE 7.")
+	}
+}
+
+func (h *Handler) renewFromSMS(r *http.Request, doc *db.Document, args []string) string {
+	raw := ""
+	if len(args) > 0 {
+		raw = args[0]
+	}
+
+	required, err := h.repo.RenewalApprovalRequired(r.Context(), doc)
+	if err != nil {
+		return "Sorry, we couldn't update that document. Please try again."
+	}
+	if required {
+		return "'" + doc.Name + "' needs an approved renewal request. Please submit the new expiry date with evidence in the xpired app."
+	}
+
+	newExpiration, err := h.nextExpirationDate(r.Context(), doc, raw)
+	if err != nil {
+		return "Please reply RENEWED followed by the new expiry date, e.g. RENEWED 2030-05-31."
+	}
+	if err := h.renewDocument(r.Context(), doc, newExpiration); err != nil {
+		return "Sorry, we couldn't update that document. Please try again."
+	}
+	return "Done! '" + doc.Name + "' now expires on " + newExpiration.Format("January 2, 2006") + "."
+}
@@ -0,0 +1,150 @@
+package api
+
+import (
+	"encoding/json"
+	"log"
+	"net/http"
+	"strings"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+
+	"xpired/internal/auth"
+	"xpired/internal/db"
+)
+
+// SuspendUserHandler blocks an account: sign-in and every authenticated
+// request are refused, and the worker stops sending its notifications until
+// the account is reinstated.
+func (h *Handler) SuspendUserHandler(w http.ResponseWriter, r *http.Request) {
+	adminID, err := auth.GetUserIDFromContext(r)
+	if err != nil {
+		errResp := UnauthorizedError("Unauthorized")
+		WriteErrorResponse(w, errResp)
+		return
+	}
+
+	var req SuspendUserRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		errResp := BadRequestError("Invalid request body")
+		WriteErrorResponse(w, errResp)
+		return
+	}
+	req.Reason = strings.TrimSpace(req.Reason)
+	if req.Reason == "" {
+		errResp := BadRequestError("reason is required")
+		WriteErrorResponse(w, errResp)
+		return
+	}
+
+	userID := chi.URLParam(r, "id")
+	if _, err := uuid.Parse(userID); err != nil {
+		errResp := BadRequestError("Invalid user ID")
+		WriteErrorResponse(w, errResp)
+		return
+	}
+	if userID == adminID {
+		errResp := BadRequestError("You cannot suspend yourself")
+		WriteErrorResponse(w, errResp)
+		return
+	}
+	if _, err := h.repo.GetUserByID(r.Context(), userID); err != nil {
+		errResp := NotFoundError("User not found")
+		WriteErrorResponse(w, errResp)
+		return
+	}
+	if h.isAdmin(r.Context(), userID) {
+		errResp := ForbiddenError("Admins cannot be suspended")
+		WriteErrorResponse(w, errResp)
+		return
+	}
+
+	if err := h.repo.SuspendUser(r.Context(), userID, req.Reason); err != nil {
+		if err.Error() == "user does not exist or is already suspended" {
+			errResp := ConflictError("User is already suspended")
+			WriteErrorResponse(w, errResp)
+			return
+		}
+		errResp := InternalServerError("Failed to suspend user")
+		WriteErrorResponse(w, errResp)
+		return
+	}
+	h.recordSuspensionChange(r, adminID, userID, db.AuditActionUserSuspended, req.Reason)
+	log.Printf("Admin %s suspended user %s: %s", adminID, userID, req.Reason)
+
+	resp := map[string]interface{}{
+		"message": "User suspended",
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(resp); err != nil {
+		errResp := InternalServerError("Failed to encode response")
+		WriteErrorResponse(w, errResp)
+	}
+}
+
+// ReinstateUserHandler lifts a suspension. Reminders that came due while the
+// account was suspended are not sent retroactively.
+func (h *Handler) ReinstateUserHandler(w http.ResponseWriter, r *http.Request) {
+	adminID, err := auth.GetUserIDFromContext(r)
+	if err != nil {
+		errResp := UnauthorizedError("Unauthorized")
+		WriteErrorResponse(w, errResp)
+		return
+	}
+
+	userID := chi.URLParam(r, "id")
+	if _, err := uuid.Parse(userID); err != nil {
+		errResp := BadRequestError("Invalid user ID")
+		WriteErrorResponse(w, errResp)
+		return
+	}
+	if _, err := h.repo.GetUserByID(r.Context(), userID); err != nil {
+		errResp := NotFoundError("User not found")
+		WriteErrorResponse(w, errResp)
+		return
+	}
+
+	if err := h.repo.ReinstateUser(r.Context(), userID); err != nil {
+		if err.Error() == "user does not exist or is not suspended" {
+			errResp := ConflictError("User is not suspended")
+			WriteErrorResponse(w, errResp)
+			return
+		}
+		errResp := InternalServerError("Failed to reinstate user")
+		WriteErrorResponse(w, errResp)
+		return
+	}
+	h.recordSuspensionChange(r, adminID, userID, db.AuditActionUserReinstated, "")
+	log.Printf("Admin %s reinstated user %s", adminID, userID)
+
+	resp := map[string]interface{}{
+		"message": "User reinstated",
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(resp); err != nil {
+		errResp := InternalServerError("Failed to encode response")
+		WriteErrorResponse(w, errResp)
+	}
+}
+
+func (h *Handler) recordSuspensionChange(r *http.Request, adminID, userID, action, reason string) {
+	details := map[string]interface{}{}
+	if reason != "" {
+		details["reason"] = reason
+	}
+	metadata, _ := json.Marshal(details)
+	entry := &db.AuditLog{
+		ID:         uuid.New(),
+		ActorID:    &adminID,
+		UserID:     &userID,
+		Action:     action,
+		EntityType: "user",
+		EntityID:   userID,
+		Metadata:   metadata,
+	}
+	if err := h.repo.CreateAuditLog(r.Context(), entry); err != nil {
+		log.Printf("Failed to record %s of user %s in audit log: %v", action, userID, err)
+	}
+}
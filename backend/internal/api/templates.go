@@ -0,0 +1,139 @@
+package api
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sort"
+
+	"xpired/internal/db"
+)
+
+const (
+	// maxCustomFields caps the custom fields of a document.
+	maxCustomFields      = 20
+	maxCustomFieldKey    = 64
+	maxCustomFieldLength = 500
+)
+
+// ListDocumentTemplatesHandler returns the gallery of built-in templates that
+// a document can be created from.
+func (h *Handler) ListDocumentTemplatesHandler(w http.ResponseWriter, r *http.Request) {
+	templates, err := h.repo.ListDocumentTemplates(r.Context())
+	if err != nil {
+		errResp := InternalServerError("Failed to fetch document templates")
+		WriteErrorResponse(w, errResp)
+		return
+	}
+
+	respTemplates := []DocumentTemplateResponse{}
+	for _, template := range templates {
+		respTemplates = append(respTemplates, documentTemplateResponse(template))
+	}
+
+	resp := map[string]interface{}{
+		"message":   "Document templates retrieved successfully",
+		"templates": respTemplates,
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(resp); err != nil {
+		errResp := InternalServerError("Failed to encode response")
+		WriteErrorResponse(w, errResp)
+	}
+}
+
+func documentTemplateResponse(template *db.DocumentTemplate) DocumentTemplateResponse {
+	reminders := template.Reminders
+	if reminders == nil {
+		reminders = []string{}
+	}
+	fields := make([]TemplateFieldResponse, 0, len(template.Fields))
+	for _, field := range template.Fields {
+		fields = append(fields, TemplateFieldResponse{Key: field.Key, Label: field.Label})
+	}
+	return DocumentTemplateResponse{
+		Slug:            template.Slug,
+		Name:            template.Name,
+		Description:     template.Description,
+		Category:        template.Category,
+		IdentifierLabel: template.IdentifierLabel,
+		Reminders:       reminders,
+		Fields:          fields,
+	}
+}
+
+// applyTemplate fills in what a create request leaves out from the template
+// it names: the name, category and reminders. It returns the template's
+// custom fields, for the request's values to go into, and the message of the
+// problem, or "".
+func (h *Handler) applyTemplate(r *http.Request, req *DocumentRequest) ([]*db.CustomField, string) {
+	if req.TemplateID == nil || *req.TemplateID == "" {
+		return nil, ""
+	}
+	template, err := h.repo.GetDocumentTemplate(r.Context(), *req.TemplateID)
+	if err != nil {
+		return nil, "Unknown document template"
+	}
+	if req.Name == "" {
+		req.Name = template.Name
+	}
+	if req.Category == nil {
+		req.Category = template.Category
+	}
+	if len(req.Reminders) == 0 {
+		req.Reminders = template.Reminders
+	}
+	return template.Fields, ""
+}
+
+// mergeCustomFields sets values on fields, keeping their order. Keys not in
+// fields are added after them, sorted, with the key as label; a null value
+// removes a field. It returns the message of the problem, or "".
+func mergeCustomFields(fields []*db.CustomField, values map[string]*string) ([]*db.CustomField, string) {
+	merged := []*db.CustomField{}
+	known := map[string]bool{}
+	for _, field := range fields {
+		known[field.Key] = true
+		value, ok := values[field.Key]
+		if ok && value == nil {
+			continue
+		}
+		if ok {
+			field.Value = *value
+		}
+		merged = append(merged, field)
+	}
+
+	var added []string
+	for key, value := range values {
+		if !known[key] && value != nil && *value != "" {
+			added = append(added, key)
+		}
+	}
+	sort.Strings(added)
+	for _, key := range added {
+		if key == "" || len(key) > maxCustomFieldKey {
+			return nil, fmt.Sprintf("custom field keys must be 1 to %d characters", maxCustomFieldKey)
+		}
+		merged = append(merged, &db.CustomField{Key: key, Label: key, Value: *values[key]})
+	}
+
+	if len(merged) > maxCustomFields {
+		return nil, fmt.Sprintf("a document can have at most %d custom fields", maxCustomFields)
+	}
+	for _, field := range merged {
+		if len(field.Value) > maxCustomFieldLength {
+			return nil, fmt.Sprintf("custom field values must be at most %d characters", maxCustomFieldLength)
+		}
+	}
+	return merged, ""
+}
+
+func customFieldResponses(fields []*db.CustomField) []CustomFieldResponse {
+	var resp []CustomFieldResponse
+	for _, field := range fields {
+		resp = append(resp, CustomFieldResponse{Key: field.Key, Label: field.Label, Value: field.Value})
+	}
+	return resp
+}
@@ -0,0 +1,130 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"log"
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+
+	"xpired/internal/auth"
+	"xpired/internal/db"
+	"xpired/internal/worker"
+)
+
+// recordDocumentAudit logs an action userID took on doc. Failures are only
+// logged so they never undo the action itself.
+func (h *Handler) recordDocumentAudit(ctx context.Context, userID string, doc *db.Document, action string) {
+	ownerID := doc.UserID.String()
+	metadata, _ := json.Marshal(map[string]interface{}{
+		"name": doc.Name,
+	})
+	entry := &db.AuditLog{
+		ID:             uuid.New(),
+		ActorID:        &userID,
+		UserID:         &ownerID,
+		OrganizationID: doc.OrganizationID,
+		Action:         action,
+		EntityType:     "document",
+		EntityID:       doc.ID.String(),
+		Metadata:       metadata,
+	}
+	if err := h.repo.CreateAuditLog(ctx, entry); err != nil {
+		log.Printf("Failed to record %s for document %s in audit log: %v", action, doc.ID.String(), err)
+	}
+}
+
+func (h *Handler) ListTrashHandler(w http.ResponseWriter, r *http.Request) {
+	userID, err := auth.GetUserIDFromContext(r)
+	if err != nil {
+		errResp := UnauthorizedError("Unauthorized")
+		WriteErrorResponse(w, errResp)
+		return
+	}
+
+	documents, err := h.repo.ListTrashedDocuments(r.Context(), userID)
+	if err != nil {
+		errResp := InternalServerError("Failed to fetch trash")
+		WriteErrorResponse(w, errResp)
+		return
+	}
+
+	trashed := []TrashedDocumentResponse{}
+	for _, doc := range documents {
+		trashed = append(trashed, TrashedDocumentResponse{
+			ID:             doc.ID.String(),
+			UserID:         doc.UserID.String(),
+			Name:           doc.Name,
+			ExpirationDate: doc.ExpirationDate.Format("Mon, 2 Jan, 2006"),
+			ExpiresIn:      h.expiresIn(r.Context(), doc.ExpirationDate),
+			Category:       doc.Category,
+			DeletedAt:      *doc.DeletedAt,
+			PurgeAt:        doc.DeletedAt.AddDate(0, 0, h.cfg.Trash.RetentionDays),
+		})
+	}
+
+	resp := map[string]interface{}{
+		"message":       "Trash fetched successfully",
+		"documents":     trashed,
+		"retentionDays": h.cfg.Trash.RetentionDays,
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(resp); err != nil {
+		errResp := InternalServerError("Failed to encode response")
+		WriteErrorResponse(w, errResp)
+	}
+}
+
+// RestoreDocumentHandler takes a document out of the trash and schedules its
+// remaining reminders again.
+func (h *Handler) RestoreDocumentHandler(w http.ResponseWriter, r *http.Request) {
+	userID, err := auth.GetUserIDFromContext(r)
+	if err != nil {
+		errResp := UnauthorizedError("Unauthorized")
+		WriteErrorResponse(w, errResp)
+		return
+	}
+
+	doc, err := h.repo.GetTrashedDocument(r.Context(), chi.URLParam(r, "id"))
+	if err != nil {
+		errResp := NotFoundError("Document not found in trash")
+		WriteErrorResponse(w, errResp)
+		return
+	}
+
+	if !h.canManageDocument(r.Context(), doc, userID) {
+		errResp := ForbiddenError("Forbidden")
+		WriteErrorResponse(w, errResp)
+		return
+	}
+
+	if err := h.repo.RestoreDocument(r.Context(), doc.ID.String()); err != nil {
+		errResp := InternalServerError("Failed to restore document")
+		WriteErrorResponse(w, errResp)
+		return
+	}
+	doc.DeletedAt = nil
+	h.recordDocumentAudit(r.Context(), userID, doc, db.AuditActionDocumentRestored)
+
+	intervals, err := h.enabledReminderIntervals(r.Context(), doc.ID.String())
+	if err != nil {
+		log.Printf("Failed to reschedule reminders for restored document %s: %v", doc.ID.String(), err)
+	} else {
+		worker.ScheduleReminders(r.Context(), *doc, doc.UserID, intervals)
+	}
+	h.scheduleLeadTimeReminder(r.Context(), doc)
+
+	resp := map[string]interface{}{
+		"message":  "Document restored successfully",
+		"document": h.humanizeDocument(r.Context(), doc),
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(resp); err != nil {
+		errResp := InternalServerError("Failed to encode response")
+		WriteErrorResponse(w, errResp)
+	}
+}
@@ -0,0 +1,95 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"log"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+
+	"xpired/internal/auth"
+	"xpired/internal/tenant"
+)
+
+// unreadCountTTL bounds how stale a cached badge count can be: new
+// notifications are picked up once it expires, while marking read clears it
+// right away.
+const unreadCountTTL = 30 * time.Second
+
+func unreadCountKey(ctx context.Context, userID string) string {
+	return "xpired:unread:" + userID + ":" + tenant.OrganizationID(ctx)
+}
+
+// UnreadNotificationCountHandler returns the number of unread notifications,
+// cached in Redis so the frontend can poll it cheaply. When Redis is down the
+// count comes straight from the database.
+func (h *Handler) UnreadNotificationCountHandler(w http.ResponseWriter, r *http.Request) {
+	userID, err := auth.GetUserIDFromContext(r)
+	if err != nil {
+		errResp := UnauthorizedError("Unauthorized")
+		WriteErrorResponse(w, errResp)
+		return
+	}
+
+	key := unreadCountKey(r.Context(), userID)
+	count, err := h.cache.Get(r.Context(), key).Int()
+	if err != nil {
+		if err != redis.Nil {
+			log.Printf("Failed to read cached unread count for user %s: %v", userID, err)
+		}
+		count, err = h.repo.CountUnreadNotifications(r.Context(), userID)
+		if err != nil {
+			errResp := InternalServerError("Failed to count unread notifications")
+			WriteErrorResponse(w, errResp)
+			return
+		}
+		if err := h.cache.Set(r.Context(), key, strconv.Itoa(count), unreadCountTTL).Err(); err != nil {
+			log.Printf("Failed to cache unread count for user %s: %v", userID, err)
+		}
+	}
+
+	resp := map[string]interface{}{
+		"unreadCount": count,
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(resp); err != nil {
+		errResp := InternalServerError("Failed to encode response")
+		WriteErrorResponse(w, errResp)
+	}
+}
+
+// MarkNotificationsReadHandler marks every notification read and resets the
+// badge.
+func (h *Handler) MarkNotificationsReadHandler(w http.ResponseWriter, r *http.Request) {
+	userID, err := auth.GetUserIDFromContext(r)
+	if err != nil {
+		errResp := UnauthorizedError("Unauthorized")
+		WriteErrorResponse(w, errResp)
+		return
+	}
+
+	marked, err := h.repo.MarkNotificationsRead(r.Context(), userID)
+	if err != nil {
+		errResp := InternalServerError("Failed to mark notifications read")
+		WriteErrorResponse(w, errResp)
+		return
+	}
+	if err := h.cache.Del(r.Context(), unreadCountKey(r.Context(), userID)).Err(); err != nil {
+		log.Printf("Failed to clear cached unread count for user %s: %v", userID, err)
+	}
+
+	resp := map[string]interface{}{
+		"message": "Notifications marked read",
+		"marked":  marked,
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(resp); err != nil {
+		errResp := InternalServerError("Failed to encode response")
+		WriteErrorResponse(w, errResp)
+	}
+}
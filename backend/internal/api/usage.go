@@ -0,0 +1,64 @@
+package api
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"xpired/internal/auth"
+	"xpired/internal/worker"
+)
+
+// GetUsageHandler returns how much of each quota of their plan the user
+// used, so they can see a limit coming before they run into it.
+func (h *Handler) GetUsageHandler(w http.ResponseWriter, r *http.Request) {
+	userID, err := auth.GetUserIDFromContext(r)
+	if err != nil {
+		errResp := UnauthorizedError("Unauthorized")
+		WriteErrorResponse(w, errResp)
+		return
+	}
+
+	plan, err := h.repo.GetUserPlan(r.Context(), userID)
+	if err != nil {
+		errResp := InternalServerError("Failed to fetch plan")
+		WriteErrorResponse(w, errResp)
+		return
+	}
+	usage, err := worker.UserQuotaUsage(r.Context(), h.repo, userID)
+	if err != nil {
+		errResp := InternalServerError("Failed to fetch usage")
+		WriteErrorResponse(w, errResp)
+		return
+	}
+
+	quotas := make([]QuotaUsageResponse, 0, len(usage))
+	for _, u := range usage {
+		quota := QuotaUsageResponse{
+			Quota:   u.Quota,
+			Used:    u.Used,
+			Warning: u.Near(),
+		}
+		if u.Limit > 0 {
+			limit := u.Limit
+			quota.Limit = &limit
+		}
+		if !u.ResetsAt.IsZero() {
+			resetsAt := u.ResetsAt
+			quota.ResetsAt = &resetsAt
+		}
+		quotas = append(quotas, quota)
+	}
+
+	resp := map[string]interface{}{
+		"message":        "Usage fetched successfully",
+		"plan":           plan,
+		"warningPercent": h.cfg.Quotas.WarningPercent,
+		"usage":          quotas,
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(resp); err != nil {
+		errResp := InternalServerError("Failed to encode response")
+		WriteErrorResponse(w, errResp)
+	}
+}
@@ -0,0 +1,339 @@
+package api
+
+import (
+	"encoding/json"
+	"net/http"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+
+	"xpired/internal/auth"
+	"xpired/internal/db"
+)
+
+const (
+	maxSavedViews                 = 50
+	maxSavedViewNameLength        = 100
+	maxSavedViewQueryLength       = 200
+	maxSavedViewExpiresWithinDays = 3650
+	maxSavedViewCategoryFilter    = 20
+)
+
+// savedViewRequest creates or replaces a saved view.
+type savedViewRequest struct {
+	Name    string         `json:"name"`
+	Filters db.ViewFilters `json:"filters"`
+}
+
+// validateSavedViewRequest trims and checks req. It returns the message to
+// report, or "" when valid.
+func (h *Handler) validateSavedViewRequest(r *http.Request, req *savedViewRequest) string {
+	req.Name = strings.TrimSpace(req.Name)
+	if req.Name == "" || len(req.Name) > maxSavedViewNameLength {
+		return "name must be between 1 and 100 characters"
+	}
+
+	filters := &req.Filters
+	filters.Query = strings.TrimSpace(filters.Query)
+	if len(filters.Query) > maxSavedViewQueryLength {
+		return "filters.query must be at most 200 characters"
+	}
+	if len(filters.Categories) > maxSavedViewCategoryFilter {
+		return "filters.categories can list at most 20 categories"
+	}
+	for _, slug := range filters.Categories {
+		if _, err := h.repo.GetDocumentCategory(r.Context(), slug); err != nil {
+			return "Unknown category: " + slug
+		}
+	}
+	if days := filters.ExpiresWithinDays; days != nil && (*days < 0 || *days > maxSavedViewExpiresWithinDays) {
+		return "filters.expiresWithinDays must be between 0 and 3650"
+	}
+	if filters.Status != "" && filters.Status != db.ViewStatusActive && filters.Status != db.ViewStatusExpired {
+		return "filters.status must be one of active, expired"
+	}
+	return ""
+}
+
+// matchesView reports whether a document in the user's list meets the
+// filters of a saved view.
+func matchesView(filters db.ViewFilters, item documentListItem, now time.Time) bool {
+	doc := item.Document
+	if filters.Query != "" && !strings.Contains(strings.ToLower(doc.Name), strings.ToLower(filters.Query)) {
+		return false
+	}
+	if len(filters.Categories) > 0 && (doc.Category == nil || !slices.Contains(filters.Categories, *doc.Category)) {
+		return false
+	}
+
+	expired := doc.ExpirationDate.Before(now)
+	switch filters.Status {
+	case db.ViewStatusActive:
+		if expired {
+			return false
+		}
+	case db.ViewStatusExpired:
+		if !expired {
+			return false
+		}
+	}
+	if days := filters.ExpiresWithinDays; days != nil && doc.ExpirationDate.After(now.AddDate(0, 0, *days)) {
+		return false
+	}
+	if filters.PinnedOnly && !item.Pinned {
+		return false
+	}
+	return true
+}
+
+// loadOwnedSavedView resolves the {id} URL parameter to a saved view of the
+// authenticated user. On failure it writes the error response and returns
+// ok=false.
+func (h *Handler) loadOwnedSavedView(w http.ResponseWriter, r *http.Request) (*db.SavedView, bool) {
+	userID, err := auth.GetUserIDFromContext(r)
+	if err != nil {
+		errResp := UnauthorizedError("Unauthorized")
+		WriteErrorResponse(w, errResp)
+		return nil, false
+	}
+
+	viewID := chi.URLParam(r, "id")
+	if _, err := uuid.Parse(viewID); err != nil {
+		errResp := NotFoundError("Saved view not found")
+		WriteErrorResponse(w, errResp)
+		return nil, false
+	}
+
+	view, err := h.repo.GetSavedView(r.Context(), viewID)
+	if err != nil {
+		if err.Error() == "saved view not found" {
+			errResp := NotFoundError("Saved view not found")
+			WriteErrorResponse(w, errResp)
+			return nil, false
+		}
+		errResp := InternalServerError("Failed to fetch saved view")
+		WriteErrorResponse(w, errResp)
+		return nil, false
+	}
+
+	// others' views are reported missing rather than forbidden so their IDs
+	// cannot be probed
+	if view.UserID != userID {
+		errResp := NotFoundError("Saved view not found")
+		WriteErrorResponse(w, errResp)
+		return nil, false
+	}
+	return view, true
+}
+
+func (h *Handler) ListSavedViewsHandler(w http.ResponseWriter, r *http.Request) {
+	userID, err := auth.GetUserIDFromContext(r)
+	if err != nil {
+		errResp := UnauthorizedError("Unauthorized")
+		WriteErrorResponse(w, errResp)
+		return
+	}
+
+	views, err := h.repo.ListSavedViews(r.Context(), userID)
+	if err != nil {
+		errResp := InternalServerError("Failed to fetch saved views")
+		WriteErrorResponse(w, errResp)
+		return
+	}
+	if views == nil {
+		views = []*db.SavedView{}
+	}
+
+	resp := map[string]interface{}{
+		"message": "Saved views fetched successfully",
+		"views":   views,
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(resp); err != nil {
+		errResp := InternalServerError("Failed to encode response")
+		WriteErrorResponse(w, errResp)
+	}
+}
+
+func (h *Handler) CreateSavedViewHandler(w http.ResponseWriter, r *http.Request) {
+	userID, err := auth.GetUserIDFromContext(r)
+	if err != nil {
+		errResp := UnauthorizedError("Unauthorized")
+		WriteErrorResponse(w, errResp)
+		return
+	}
+
+	var req savedViewRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		errResp := BadRequestError("Invalid request body")
+		WriteErrorResponse(w, errResp)
+		return
+	}
+	if msg := h.validateSavedViewRequest(r, &req); msg != "" {
+		errResp := BadRequestError(msg)
+		WriteErrorResponse(w, errResp)
+		return
+	}
+
+	existing, err := h.repo.ListSavedViews(r.Context(), userID)
+	if err != nil {
+		errResp := InternalServerError("Failed to fetch saved views")
+		WriteErrorResponse(w, errResp)
+		return
+	}
+	if len(existing) >= maxSavedViews {
+		errResp := BadRequestError("You can save at most 50 views")
+		WriteErrorResponse(w, errResp)
+		return
+	}
+
+	view := &db.SavedView{
+		ID:      uuid.New(),
+		UserID:  userID,
+		Name:    req.Name,
+		Filters: req.Filters,
+	}
+	if err := h.repo.CreateSavedView(r.Context(), view); err != nil {
+		errResp := InternalServerError("Failed to create saved view")
+		WriteErrorResponse(w, errResp)
+		return
+	}
+
+	resp := map[string]interface{}{
+		"message": "Saved view created successfully",
+		"view":    view,
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusCreated)
+	if err := json.NewEncoder(w).Encode(resp); err != nil {
+		errResp := InternalServerError("Failed to encode response")
+		WriteErrorResponse(w, errResp)
+	}
+}
+
+func (h *Handler) GetSavedViewHandler(w http.ResponseWriter, r *http.Request) {
+	view, ok := h.loadOwnedSavedView(w, r)
+	if !ok {
+		return
+	}
+
+	resp := map[string]interface{}{
+		"message": "Saved view fetched successfully",
+		"view":    view,
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(resp); err != nil {
+		errResp := InternalServerError("Failed to encode response")
+		WriteErrorResponse(w, errResp)
+	}
+}
+
+// UpdateSavedViewHandler replaces the name and filters of a saved view.
+func (h *Handler) UpdateSavedViewHandler(w http.ResponseWriter, r *http.Request) {
+	view, ok := h.loadOwnedSavedView(w, r)
+	if !ok {
+		return
+	}
+
+	var req savedViewRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		errResp := BadRequestError("Invalid request body")
+		WriteErrorResponse(w, errResp)
+		return
+	}
+	if msg := h.validateSavedViewRequest(r, &req); msg != "" {
+		errResp := BadRequestError(msg)
+		WriteErrorResponse(w, errResp)
+		return
+	}
+
+	view.Name = req.Name
+	view.Filters = req.Filters
+	if err := h.repo.UpdateSavedView(r.Context(), view); err != nil {
+		errResp := InternalServerError("Failed to update saved view")
+		WriteErrorResponse(w, errResp)
+		return
+	}
+
+	resp := map[string]interface{}{
+		"message": "Saved view updated successfully",
+		"view":    view,
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(resp); err != nil {
+		errResp := InternalServerError("Failed to encode response")
+		WriteErrorResponse(w, errResp)
+	}
+}
+
+func (h *Handler) DeleteSavedViewHandler(w http.ResponseWriter, r *http.Request) {
+	view, ok := h.loadOwnedSavedView(w, r)
+	if !ok {
+		return
+	}
+
+	if err := h.repo.DeleteSavedView(r.Context(), view.ID.String()); err != nil {
+		errResp := InternalServerError("Failed to delete saved view")
+		WriteErrorResponse(w, errResp)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// SavedViewDocumentsHandler returns the documents that meet the filters of a
+// saved view, out of the ones /api/documents lists for the same request, in
+// the same order.
+func (h *Handler) SavedViewDocumentsHandler(w http.ResponseWriter, r *http.Request) {
+	view, ok := h.loadOwnedSavedView(w, r)
+	if !ok {
+		return
+	}
+
+	sortOrder := r.URL.Query().Get("sort")
+	if sortOrder != "" && sortOrder != "custom" {
+		errResp := BadRequestError("sort must be custom")
+		WriteErrorResponse(w, errResp)
+		return
+	}
+
+	documents, err := h.repo.ListDocumentsByUserID(r.Context(), view.UserID)
+	if err != nil {
+		errResp := InternalServerError("Failed to fetch documents")
+		WriteErrorResponse(w, errResp)
+		return
+	}
+	items, err := h.documentListItems(r.Context(), view.UserID, documents, sortOrder == "custom")
+	if err != nil {
+		errResp := InternalServerError("Failed to fetch documents")
+		WriteErrorResponse(w, errResp)
+		return
+	}
+
+	now := time.Now()
+	matching := []documentListItem{}
+	for _, item := range items {
+		if matchesView(view.Filters, item, now) {
+			matching = append(matching, item)
+		}
+	}
+
+	resp := map[string]interface{}{
+		"message":   "List of Documents",
+		"view":      view,
+		"documents": matching,
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(resp); err != nil {
+		errResp := InternalServerError("Failed to encode response")
+		WriteErrorResponse(w, errResp)
+	}
+}
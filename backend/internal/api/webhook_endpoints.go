@@ -0,0 +1,443 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/url"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+
+	"xpired/internal/auth"
+	"xpired/internal/db"
+	"xpired/internal/worker"
+)
+
+const (
+	defaultWebhookGracePeriodHours = 24
+	maxWebhookGracePeriodHours     = 7 * 24
+	defaultWebhookDeliveryLimit    = 50
+	maxWebhookDeliveryLimit        = 200
+)
+
+func toWebhookEndpointResponse(endpoint *db.WebhookEndpoint) WebhookEndpointResponse {
+	events := endpoint.Events
+	if events == nil {
+		events = []string{}
+	}
+	resp := WebhookEndpointResponse{
+		ID:        endpoint.ID.String(),
+		URL:       endpoint.URL,
+		Events:    events,
+		Enabled:   endpoint.Enabled,
+		CreatedAt: endpoint.CreatedAt,
+		UpdatedAt: endpoint.UpdatedAt,
+	}
+	if expires := endpoint.PreviousSecretExpiresAt; expires != nil && time.Now().Before(*expires) {
+		resp.PreviousSecretExpiresAt = expires
+	}
+	return resp
+}
+
+func toWebhookDeliveryResponse(delivery *db.WebhookDelivery) WebhookDeliveryResponse {
+	resp := WebhookDeliveryResponse{
+		ID:            delivery.ID.String(),
+		Event:         delivery.Event,
+		Status:        delivery.Status,
+		Attempts:      delivery.Attempts,
+		ResponseCode:  delivery.ResponseCode,
+		LatencyMs:     delivery.LatencyMs,
+		Error:         delivery.Error,
+		Payload:       delivery.Payload,
+		CreatedAt:     delivery.CreatedAt,
+		LastAttemptAt: delivery.LastAttemptAt,
+	}
+	if delivery.RedeliveryOf != nil {
+		original := delivery.RedeliveryOf.String()
+		resp.RedeliveryOf = &original
+	}
+	return resp
+}
+
+func newWebhookSecret() (string, error) {
+	token, err := randomToken(24)
+	if err != nil {
+		return "", err
+	}
+	return "whsec_" + token, nil
+}
+
+// validateWebhookEndpointRequest checks the URL is absolute http(s) on a
+// public host and every event is known. It returns the message to report, or
+// "" when valid. The host is checked again on every delivery, as what it
+// resolves to can change.
+func validateWebhookEndpointRequest(ctx context.Context, req *WebhookEndpointRequest) string {
+	req.URL = strings.TrimSpace(req.URL)
+	parsed, err := url.Parse(req.URL)
+	if err != nil || (parsed.Scheme != "http" && parsed.Scheme != "https") || parsed.Host == "" {
+		return "A valid http(s) url is required"
+	}
+	if err := worker.CheckPublicHost(ctx, parsed.Hostname()); err != nil {
+		if errors.Is(err, worker.ErrNonPublicAddress) {
+			return "The url must point to a public address"
+		}
+		return "The url host could not be resolved"
+	}
+	for _, event := range req.Events {
+		if !slices.Contains(db.WebhookEvents, event) {
+			return "Unknown webhook event: " + event
+		}
+	}
+	return ""
+}
+
+// loadOwnedWebhookEndpoint resolves the {id} URL parameter to a webhook
+// endpoint of the authenticated user. On failure it writes the error
+// response and returns ok=false.
+func (h *Handler) loadOwnedWebhookEndpoint(w http.ResponseWriter, r *http.Request) (*db.WebhookEndpoint, bool) {
+	userID, err := auth.GetUserIDFromContext(r)
+	if err != nil {
+		errResp := UnauthorizedError("Unauthorized")
+		WriteErrorResponse(w, errResp)
+		return nil, false
+	}
+
+	endpointID := chi.URLParam(r, "id")
+	if _, err := uuid.Parse(endpointID); err != nil {
+		errResp := BadRequestError("Invalid webhook endpoint ID")
+		WriteErrorResponse(w, errResp)
+		return nil, false
+	}
+
+	endpoint, err := h.repo.GetWebhookEndpoint(r.Context(), endpointID)
+	if err != nil {
+		if err.Error() == "webhook endpoint not found" {
+			errResp := NotFoundError("Webhook endpoint not found")
+			WriteErrorResponse(w, errResp)
+			return nil, false
+		}
+		errResp := InternalServerError("Failed to fetch webhook endpoint")
+		WriteErrorResponse(w, errResp)
+		return nil, false
+	}
+
+	if endpoint.UserID != userID {
+		errResp := ForbiddenError("Forbidden")
+		WriteErrorResponse(w, errResp)
+		return nil, false
+	}
+	return endpoint, true
+}
+
+func (h *Handler) ListWebhookEndpointsHandler(w http.ResponseWriter, r *http.Request) {
+	userID, err := auth.GetUserIDFromContext(r)
+	if err != nil {
+		errResp := UnauthorizedError("Unauthorized")
+		WriteErrorResponse(w, errResp)
+		return
+	}
+
+	endpoints, err := h.repo.ListWebhookEndpoints(r.Context(), userID)
+	if err != nil {
+		errResp := InternalServerError("Failed to fetch webhook endpoints")
+		WriteErrorResponse(w, errResp)
+		return
+	}
+
+	endpointResps := []WebhookEndpointResponse{}
+	for _, endpoint := range endpoints {
+		endpointResps = append(endpointResps, toWebhookEndpointResponse(endpoint))
+	}
+
+	resp := map[string]interface{}{
+		"message":   "Webhook endpoints fetched successfully",
+		"endpoints": endpointResps,
+		"events":    db.WebhookEvents,
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(resp); err != nil {
+		errResp := InternalServerError("Failed to encode response")
+		WriteErrorResponse(w, errResp)
+	}
+}
+
+// CreateWebhookEndpointHandler registers an endpoint and returns its signing
+// secret. This is the only time the secret is shown until it is rotated.
+func (h *Handler) CreateWebhookEndpointHandler(w http.ResponseWriter, r *http.Request) {
+	userID, err := auth.GetUserIDFromContext(r)
+	if err != nil {
+		errResp := UnauthorizedError("Unauthorized")
+		WriteErrorResponse(w, errResp)
+		return
+	}
+
+	var req WebhookEndpointRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		errResp := BadRequestError("Invalid request body")
+		WriteErrorResponse(w, errResp)
+		return
+	}
+	if msg := validateWebhookEndpointRequest(r.Context(), &req); msg != "" {
+		errResp := BadRequestError(msg)
+		WriteErrorResponse(w, errResp)
+		return
+	}
+
+	secret, err := newWebhookSecret()
+	if err != nil {
+		errResp := InternalServerError("Failed to generate webhook secret")
+		WriteErrorResponse(w, errResp)
+		return
+	}
+
+	endpoint := &db.WebhookEndpoint{
+		ID:      uuid.New(),
+		UserID:  userID,
+		URL:     req.URL,
+		Events:  req.Events,
+		Secret:  secret,
+		Enabled: req.Enabled == nil || *req.Enabled,
+	}
+	if err := h.repo.CreateWebhookEndpoint(r.Context(), endpoint); err != nil {
+		errResp := InternalServerError("Failed to create webhook endpoint")
+		WriteErrorResponse(w, errResp)
+		return
+	}
+
+	endpointResp := toWebhookEndpointResponse(endpoint)
+	endpointResp.Secret = secret
+
+	resp := map[string]interface{}{
+		"message":  "Webhook endpoint created successfully",
+		"endpoint": endpointResp,
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusCreated)
+	if err := json.NewEncoder(w).Encode(resp); err != nil {
+		errResp := InternalServerError("Failed to encode response")
+		WriteErrorResponse(w, errResp)
+	}
+}
+
+func (h *Handler) UpdateWebhookEndpointHandler(w http.ResponseWriter, r *http.Request) {
+	endpoint, ok := h.loadOwnedWebhookEndpoint(w, r)
+	if !ok {
+		return
+	}
+
+	var req WebhookEndpointRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		errResp := BadRequestError("Invalid request body")
+		WriteErrorResponse(w, errResp)
+		return
+	}
+	if msg := validateWebhookEndpointRequest(r.Context(), &req); msg != "" {
+		errResp := BadRequestError(msg)
+		WriteErrorResponse(w, errResp)
+		return
+	}
+
+	endpoint.URL = req.URL
+	endpoint.Events = req.Events
+	if req.Enabled != nil {
+		endpoint.Enabled = *req.Enabled
+	}
+	if err := h.repo.UpdateWebhookEndpoint(r.Context(), endpoint); err != nil {
+		errResp := InternalServerError("Failed to update webhook endpoint")
+		WriteErrorResponse(w, errResp)
+		return
+	}
+
+	resp := map[string]interface{}{
+		"message":  "Webhook endpoint updated successfully",
+		"endpoint": toWebhookEndpointResponse(endpoint),
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(resp); err != nil {
+		errResp := InternalServerError("Failed to encode response")
+		WriteErrorResponse(w, errResp)
+	}
+}
+
+func (h *Handler) DeleteWebhookEndpointHandler(w http.ResponseWriter, r *http.Request) {
+	endpoint, ok := h.loadOwnedWebhookEndpoint(w, r)
+	if !ok {
+		return
+	}
+
+	if err := h.repo.DeleteWebhookEndpoint(r.Context(), endpoint.ID.String()); err != nil {
+		errResp := InternalServerError("Failed to delete webhook endpoint")
+		WriteErrorResponse(w, errResp)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// RotateWebhookSecretHandler issues a new signing secret. Deliveries are
+// signed with both secrets until the grace period ends, giving receivers
+// time to switch over.
+func (h *Handler) RotateWebhookSecretHandler(w http.ResponseWriter, r *http.Request) {
+	endpoint, ok := h.loadOwnedWebhookEndpoint(w, r)
+	if !ok {
+		return
+	}
+
+	var req RotateWebhookSecretRequest
+	if r.ContentLength != 0 {
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			errResp := BadRequestError("Invalid request body")
+			WriteErrorResponse(w, errResp)
+			return
+		}
+	}
+
+	graceHours := defaultWebhookGracePeriodHours
+	if req.GracePeriodHours != nil {
+		graceHours = *req.GracePeriodHours
+	}
+	if graceHours < 0 || graceHours > maxWebhookGracePeriodHours {
+		errResp := BadRequestError("gracePeriodHours must be between 0 and 168")
+		WriteErrorResponse(w, errResp)
+		return
+	}
+
+	secret, err := newWebhookSecret()
+	if err != nil {
+		errResp := InternalServerError("Failed to generate webhook secret")
+		WriteErrorResponse(w, errResp)
+		return
+	}
+
+	expiresAt := time.Now().Add(time.Duration(graceHours) * time.Hour)
+	if err := h.repo.RotateWebhookSecret(r.Context(), endpoint.ID.String(), secret, expiresAt); err != nil {
+		errResp := InternalServerError("Failed to rotate webhook secret")
+		WriteErrorResponse(w, errResp)
+		return
+	}
+
+	endpoint.PreviousSecretExpiresAt = &expiresAt
+	endpointResp := toWebhookEndpointResponse(endpoint)
+	endpointResp.Secret = secret
+
+	resp := map[string]interface{}{
+		"message":  "Webhook secret rotated successfully",
+		"endpoint": endpointResp,
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(resp); err != nil {
+		errResp := InternalServerError("Failed to encode response")
+		WriteErrorResponse(w, errResp)
+	}
+}
+
+func (h *Handler) ListWebhookDeliveriesHandler(w http.ResponseWriter, r *http.Request) {
+	endpoint, ok := h.loadOwnedWebhookEndpoint(w, r)
+	if !ok {
+		return
+	}
+
+	limit := defaultWebhookDeliveryLimit
+	if raw := r.URL.Query().Get("limit"); raw != "" {
+		parsed, err := strconv.Atoi(raw)
+		if err != nil || parsed < 1 || parsed > maxWebhookDeliveryLimit {
+			errResp := BadRequestError("limit must be between 1 and 200")
+			WriteErrorResponse(w, errResp)
+			return
+		}
+		limit = parsed
+	}
+
+	deliveries, err := h.repo.ListWebhookDeliveries(r.Context(), endpoint.ID.String(), limit)
+	if err != nil {
+		errResp := InternalServerError("Failed to fetch webhook deliveries")
+		WriteErrorResponse(w, errResp)
+		return
+	}
+
+	deliveryResps := []WebhookDeliveryResponse{}
+	for _, delivery := range deliveries {
+		deliveryResps = append(deliveryResps, toWebhookDeliveryResponse(delivery))
+	}
+
+	resp := map[string]interface{}{
+		"message":    "Webhook deliveries fetched successfully",
+		"deliveries": deliveryResps,
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(resp); err != nil {
+		errResp := InternalServerError("Failed to encode response")
+		WriteErrorResponse(w, errResp)
+	}
+}
+
+// RedeliverWebhookHandler sends a failed delivery again as a new delivery
+// log entry. The payload is unchanged, so its "id" still lets receivers
+// deduplicate.
+func (h *Handler) RedeliverWebhookHandler(w http.ResponseWriter, r *http.Request) {
+	endpoint, ok := h.loadOwnedWebhookEndpoint(w, r)
+	if !ok {
+		return
+	}
+
+	deliveryID := chi.URLParam(r, "deliveryId")
+	if _, err := uuid.Parse(deliveryID); err != nil {
+		errResp := BadRequestError("Invalid webhook delivery ID")
+		WriteErrorResponse(w, errResp)
+		return
+	}
+
+	original, err := h.repo.GetWebhookDelivery(r.Context(), deliveryID)
+	if err != nil || original.EndpointID != endpoint.ID {
+		errResp := NotFoundError("Webhook delivery not found")
+		WriteErrorResponse(w, errResp)
+		return
+	}
+	if original.Status != db.WebhookDeliveryFailed {
+		errResp := ConflictError("Only failed deliveries can be redelivered")
+		WriteErrorResponse(w, errResp)
+		return
+	}
+
+	delivery := &db.WebhookDelivery{
+		ID:           uuid.New(),
+		EndpointID:   endpoint.ID,
+		Event:        original.Event,
+		Payload:      original.Payload,
+		Status:       db.WebhookDeliveryPending,
+		RedeliveryOf: &original.ID,
+	}
+	if err := h.repo.CreateWebhookDelivery(r.Context(), delivery); err != nil {
+		errResp := InternalServerError("Failed to create webhook delivery")
+		WriteErrorResponse(w, errResp)
+		return
+	}
+	if err := worker.ScheduleWebhookDelivery(r.Context(), delivery.ID.String()); err != nil {
+		errResp := InternalServerError("Failed to queue webhook delivery")
+		WriteErrorResponse(w, errResp)
+		return
+	}
+
+	resp := map[string]interface{}{
+		"message":  "Webhook redelivery queued",
+		"delivery": toWebhookDeliveryResponse(delivery),
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusAccepted)
+	if err := json.NewEncoder(w).Encode(resp); err != nil {
+		errResp := InternalServerError("Failed to encode response")
+		WriteErrorResponse(w, errResp)
+	}
+}
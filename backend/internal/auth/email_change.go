@@ -0,0 +1,52 @@
+package auth
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/google/uuid"
+)
+
+const emailChangeAudience = "email_change"
+
+// EmailChangeTTL is how long the link confirming a new email address stays
+// valid.
+const EmailChangeTTL = 24 * time.Hour
+
+// EmailChangeClaims confirm that whoever reads mail at Email agrees to it
+// becoming the address of the account. Like ActionClaims they carry an
+// audience of their own, so they never pass for a session token.
+type EmailChangeClaims struct {
+	Email string `json:"email"`
+	jwt.RegisteredClaims
+}
+
+func GenerateEmailChangeToken(userID, email string) (string, error) {
+	now := time.Now()
+	claims := EmailChangeClaims{
+		Email: email,
+		RegisteredClaims: jwt.RegisteredClaims{
+			ExpiresAt: jwt.NewNumericDate(now.Add(EmailChangeTTL)),
+			IssuedAt:  jwt.NewNumericDate(now),
+			Issuer:    "XPIRED",
+			Subject:   userID,
+			ID:        uuid.New().String(),
+			Audience:  []string{emailChangeAudience},
+		},
+	}
+
+	return sign(claims)
+}
+
+func ParseEmailChangeToken(tokenString string) (*EmailChangeClaims, error) {
+	token, err := jwt.ParseWithClaims(tokenString, &EmailChangeClaims{}, verificationKey, jwt.WithAudience(emailChangeAudience))
+	if err != nil {
+		return nil, err
+	}
+
+	if claims, ok := token.Claims.(*EmailChangeClaims); ok && token.Valid {
+		return claims, nil
+	}
+	return nil, fmt.Errorf("invalid token")
+}
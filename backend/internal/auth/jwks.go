@@ -0,0 +1,135 @@
+package auth
+
+import (
+	"crypto/ed25519"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
+	"fmt"
+	"math/big"
+	"os"
+	"sort"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+// minRSABits is the smallest RSA key tokens are signed or verified with.
+const minRSABits = 2048
+
+// JWK is the public half of an asymmetric signing key, as a JSON Web Key
+// (RFC 7517).
+type JWK struct {
+	KeyType   string `json:"kty"`
+	KeyID     string `json:"kid"`
+	Use       string `json:"use"`
+	Algorithm string `json:"alg"`
+	// N and E are the modulus and exponent of RSA keys.
+	N string `json:"n,omitempty"`
+	E string `json:"e,omitempty"`
+	// Curve and X are the curve and public key of Ed25519 keys.
+	Curve string `json:"crv,omitempty"`
+	X     string `json:"x,omitempty"`
+}
+
+// JWKSet is a JSON Web Key Set.
+type JWKSet struct {
+	Keys []JWK `json:"keys"`
+}
+
+// PublicKeys returns the public keys of every asymmetric key tokens are
+// verified with, by key ID. HMAC secrets are never published, so the set is
+// empty when tokens are signed with HS256 only.
+func PublicKeys() JWKSet {
+	set := JWKSet{Keys: []JWK{}}
+	for keyID, key := range signingKeys.keys {
+		jwk := JWK{KeyID: keyID, Use: "sig", Algorithm: key.method.Alg()}
+		switch public := key.verify.(type) {
+		case *rsa.PublicKey:
+			jwk.KeyType = "RSA"
+			jwk.N = base64.RawURLEncoding.EncodeToString(public.N.Bytes())
+			jwk.E = base64.RawURLEncoding.EncodeToString(big.NewInt(int64(public.E)).Bytes())
+		case ed25519.PublicKey:
+			jwk.KeyType = "OKP"
+			jwk.Curve = "Ed25519"
+			jwk.X = base64.RawURLEncoding.EncodeToString(public)
+		default:
+			continue
+		}
+		set.Keys = append(set.Keys, jwk)
+	}
+	sort.Slice(set.Keys, func(i, j int) bool { return set.Keys[i].KeyID < set.Keys[j].KeyID })
+	return set
+}
+
+// loadPrivateKey reads the RSA or Ed25519 private key tokens are signed
+// with from the PEM file at path.
+func loadPrivateKey(path string) (signingKey, error) {
+	key, err := loadKey(path)
+	if err != nil {
+		return signingKey{}, err
+	}
+	if key.sign == nil {
+		return signingKey{}, fmt.Errorf("%s holds no private key", path)
+	}
+	return key, nil
+}
+
+// loadPublicKey reads an RSA or Ed25519 key tokens are only verified with
+// from the PEM file at path. A private key file will do; only its public
+// key is kept.
+func loadPublicKey(path string) (signingKey, error) {
+	key, err := loadKey(path)
+	if err != nil {
+		return signingKey{}, err
+	}
+	key.sign = nil
+	return key, nil
+}
+
+func loadKey(path string) (signingKey, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return signingKey{}, fmt.Errorf("failed to read key file: %w", err)
+	}
+	block, _ := pem.Decode(data)
+	if block == nil {
+		return signingKey{}, fmt.Errorf("%s is not a PEM file", path)
+	}
+
+	var parsed interface{}
+	switch block.Type {
+	case "PRIVATE KEY":
+		parsed, err = x509.ParsePKCS8PrivateKey(block.Bytes)
+	case "RSA PRIVATE KEY":
+		parsed, err = x509.ParsePKCS1PrivateKey(block.Bytes)
+	case "PUBLIC KEY":
+		parsed, err = x509.ParsePKIXPublicKey(block.Bytes)
+	case "RSA PUBLIC KEY":
+		parsed, err = x509.ParsePKCS1PublicKey(block.Bytes)
+	default:
+		return signingKey{}, fmt.Errorf("unsupported PEM block %q in %s", block.Type, path)
+	}
+	if err != nil {
+		return signingKey{}, fmt.Errorf("failed to parse key in %s: %w", path, err)
+	}
+
+	switch key := parsed.(type) {
+	case *rsa.PrivateKey:
+		if key.N.BitLen() < minRSABits {
+			return signingKey{}, fmt.Errorf("RSA key in %s is shorter than %d bits", path, minRSABits)
+		}
+		return signingKey{method: jwt.SigningMethodRS256, sign: key, verify: &key.PublicKey}, nil
+	case *rsa.PublicKey:
+		if key.N.BitLen() < minRSABits {
+			return signingKey{}, fmt.Errorf("RSA key in %s is shorter than %d bits", path, minRSABits)
+		}
+		return signingKey{method: jwt.SigningMethodRS256, verify: key}, nil
+	case ed25519.PrivateKey:
+		return signingKey{method: jwt.SigningMethodEdDSA, sign: key, verify: key.Public()}, nil
+	case ed25519.PublicKey:
+		return signingKey{method: jwt.SigningMethodEdDSA, verify: key}, nil
+	default:
+		return signingKey{}, fmt.Errorf("%s holds neither an RSA nor an Ed25519 key", path)
+	}
+}
@@ -9,15 +9,145 @@ import (
 	"github.com/google/uuid"
 )
 
-var jwtSecret []byte
+// keyring holds the keys tokens are signed and verified with, by the key
+// ID named in their kid header.
+type keyring struct {
+	primaryID string
+	keys      map[string]signingKey
+}
+
+// signingKey is a key of the keyring. HMAC keys sign and verify with the
+// same secret; asymmetric ones verify with the public key, and sign only
+// when the private key is at hand.
+type signingKey struct {
+	method jwt.SigningMethod
+	sign   interface{}
+	verify interface{}
+}
+
+var signingKeys keyring
+
+// impersonationTTL is how long an impersonation token stays valid. It is
+// kept short since the token acts with the user's full access.
+const impersonationTTL = time.Hour
+
+// Claims are the claims of the tokens this package issues.
+type Claims struct {
+	jwt.RegisteredClaims
+	// Actor is set on impersonation tokens to the admin acting as Subject,
+	// following the "act" claim of RFC 8693.
+	Actor *Actor `json:"act,omitempty"`
+	// Role is the subject's role when the token was issued; tokens issued
+	// before roles have none.
+	Role string `json:"role,omitempty"`
+}
+
+// Actor identifies who is acting on behalf of a token's subject.
+type Actor struct {
+	Subject string `json:"sub"`
+}
+
+// Init loads the keys tokens are signed and verified with.
+func Init(cfg *config.Config) error {
+	keys := map[string]signingKey{}
+	for keyID, secret := range cfg.JWT.SecondaryKeys {
+		keys[keyID] = hmacKey(secret)
+	}
+	for keyID, path := range cfg.JWT.SecondaryPublicKeyFiles {
+		key, err := loadPublicKey(path)
+		if err != nil {
+			return fmt.Errorf("failed to load JWT key %q: %w", keyID, err)
+		}
+		keys[keyID] = key
+	}
+
+	if cfg.JWT.Algorithm == "HS256" {
+		keys[cfg.JWT.KeyID] = hmacKey(cfg.JWT.Secret)
+	} else {
+		key, err := loadPrivateKey(cfg.JWT.PrivateKeyFile)
+		if err != nil {
+			return fmt.Errorf("failed to load JWT private key: %w", err)
+		}
+		if key.method.Alg() != cfg.JWT.Algorithm {
+			return fmt.Errorf("JWT private key is for %s, not %s", key.method.Alg(), cfg.JWT.Algorithm)
+		}
+		keys[cfg.JWT.KeyID] = key
+	}
+
+	signingKeys = keyring{primaryID: cfg.JWT.KeyID, keys: keys}
+	return nil
+}
+
+func hmacKey(secret string) signingKey {
+	return signingKey{method: jwt.SigningMethodHS256, sign: []byte(secret), verify: []byte(secret)}
+}
 
-func Init(cfg *config.Config) {
-	jwtSecret = []byte(cfg.JWT.Secret)
+// sign signs claims with the primary key, naming it in the kid header.
+func sign(claims jwt.Claims) (string, error) {
+	key := signingKeys.keys[signingKeys.primaryID]
+	token := jwt.NewWithClaims(key.method, claims)
+	token.Header["kid"] = signingKeys.primaryID
+	return token.SignedString(key.sign)
 }
 
-func GenerateToken(userID uuid.UUID) (string, error) {
-	claims := jwt.RegisteredClaims{
-		ExpiresAt: jwt.NewNumericDate(time.Now().Add(24 * time.Hour)),
+// verificationKey is the jwt.Keyfunc of every token this package parses. It
+// returns the key named by the token's kid header, provided the token was
+// signed with that key's algorithm, so a public key is never taken for an
+// HMAC secret. Tokens issued before their key had an ID have none, and are
+// checked against every HMAC key.
+func verificationKey(token *jwt.Token) (interface{}, error) {
+	keyID, ok := token.Header["kid"].(string)
+	if !ok {
+		if _, ok := token.Method.(*jwt.SigningMethodHMAC); !ok {
+			return nil, fmt.Errorf("unexpected signing method: %v", token.Header["alg"])
+		}
+		set := jwt.VerificationKeySet{}
+		for _, key := range signingKeys.keys {
+			if key.method == jwt.SigningMethodHS256 {
+				set.Keys = append(set.Keys, key.verify)
+			}
+		}
+		return set, nil
+	}
+	key, ok := signingKeys.keys[keyID]
+	if !ok {
+		return nil, fmt.Errorf("unknown signing key %q", keyID)
+	}
+	if token.Method.Alg() != key.method.Alg() {
+		return nil, fmt.Errorf("unexpected signing method: %v", token.Header["alg"])
+	}
+	return key.verify, nil
+}
+
+// GenerateToken issues a sign-in token for userID with role. Its claims
+// carry the ID (jti) and expiry the session is stored under.
+func GenerateToken(userID uuid.UUID, role string) (string, *Claims, error) {
+	claims := &Claims{
+		RegisteredClaims: registeredClaims(userID, 24*time.Hour),
+		Role:             role,
+	}
+
+	signed, err := sign(claims)
+	return signed, claims, err
+}
+
+// GenerateImpersonationToken issues a short-lived token that lets adminID
+// act as userID. The admin is named in the token's "act" claim. The token
+// only ever has the user role, so it can never reach admin endpoints.
+func GenerateImpersonationToken(userID uuid.UUID, adminID string) (string, time.Time, error) {
+	claims := Claims{
+		RegisteredClaims: registeredClaims(userID, impersonationTTL),
+		Actor:            &Actor{Subject: adminID},
+		Role:             RoleUser,
+	}
+
+	signed, err := sign(claims)
+	return signed, claims.ExpiresAt.Time, err
+}
+
+func registeredClaims(userID uuid.UUID, ttl time.Duration) jwt.RegisteredClaims {
+	return jwt.RegisteredClaims{
+		ExpiresAt: jwt.NewNumericDate(time.Now().Add(ttl)),
 		IssuedAt:  jwt.NewNumericDate(time.Now()),
 		NotBefore: jwt.NewNumericDate(time.Now()),
 		Issuer:    "XPIRED",
@@ -25,23 +155,15 @@ func GenerateToken(userID uuid.UUID) (string, error) {
 		ID:        uuid.New().String(),
 		Audience:  []string{"user"},
 	}
-
-	token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
-	return token.SignedString(jwtSecret)
 }
 
-func ParseToken(tokenString string) (*jwt.RegisteredClaims, error) {
-	token, err := jwt.ParseWithClaims(tokenString, &jwt.RegisteredClaims{}, func(token *jwt.Token) (interface{}, error) {
-		if _, ok := token.Method.(*jwt.SigningMethodHMAC); !ok {
-			return nil, fmt.Errorf("unexpected signing method: %v", token.Header["alg"])
-		}
-		return jwtSecret, nil
-	})
+func ParseToken(tokenString string) (*Claims, error) {
+	token, err := jwt.ParseWithClaims(tokenString, &Claims{}, verificationKey, jwt.WithAudience("user"))
 	if err != nil {
 		return nil, err
 	}
 
-	if claims, ok := token.Claims.(*jwt.RegisteredClaims); ok && token.Valid {
+	if claims, ok := token.Claims.(*Claims); ok && token.Valid {
 		return claims, nil
 	}
 	return nil, fmt.Errorf("invalid token")
@@ -5,15 +5,87 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"log"
 	"net/http"
+	"slices"
+	"strings"
 	"time"
+
+	"xpired/internal/tenant"
 )
 
+// SuspensionChecker reports whether an admin has suspended userID.
+type SuspensionChecker func(ctx context.Context, userID string) (bool, error)
+
+var suspensionChecker SuspensionChecker
+
+// ErrAccountSuspended is returned by CheckSuspended for a suspended account.
+var ErrAccountSuspended = errors.New("account suspended")
+
+// SetSuspensionChecker makes AuthMiddleware refuse requests from suspended
+// accounts, including ones holding a token issued before the suspension.
+func SetSuspensionChecker(checker SuspensionChecker) {
+	suspensionChecker = checker
+}
+
+// CheckSuspended returns ErrAccountSuspended if userID is suspended.
+func CheckSuspended(ctx context.Context, userID string) error {
+	if suspensionChecker == nil {
+		return nil
+	}
+	suspended, err := suspensionChecker(ctx, userID)
+	if err != nil {
+		return err
+	}
+	if suspended {
+		return ErrAccountSuspended
+	}
+	return nil
+}
+
+// RevocationChecker reports whether the session whose token has the ID (jti)
+// sessionID was revoked.
+type RevocationChecker func(ctx context.Context, sessionID string) (bool, error)
+
+var revocationChecker RevocationChecker
+
+// ErrSessionRevoked is returned by CheckRevoked for a revoked session.
+var ErrSessionRevoked = errors.New("session revoked")
+
+// SetRevocationChecker makes AuthMiddleware refuse the tokens of sessions
+// the user signed out, which stay valid JWTs until they expire.
+func SetRevocationChecker(checker RevocationChecker) {
+	revocationChecker = checker
+}
+
+// CheckRevoked returns ErrSessionRevoked if the session of the token with
+// claims was revoked.
+func CheckRevoked(ctx context.Context, claims *Claims) error {
+	if revocationChecker == nil || claims.ID == "" {
+		return nil
+	}
+	revoked, err := revocationChecker(ctx, claims.ID)
+	if err != nil {
+		return err
+	}
+	if revoked {
+		return ErrSessionRevoked
+	}
+	return nil
+}
+
+// AuthMiddleware authenticates requests by their session token, from the
+// Authorization header or the auth cookie. API keys are refused; routes that
+// accept them use ScopedAuthMiddleware.
 func AuthMiddleware(next http.Handler) http.Handler {
+	return authenticate(next, nil)
+}
+
+// authenticate is AuthMiddleware, accepting API keys when scopeFor names the
+// scope a request needs.
+func authenticate(next http.Handler, scopeFor func(r *http.Request) string) http.Handler {
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		var tokenString string
-		var errResp ErrorResponse
-		errResp.Timestamp = time.Now()
 
 		authHeader := r.Header.Get("Authorization")
 		if authHeader != "" && len(authHeader) > 7 && authHeader[:7] == "Bearer " {
@@ -21,44 +93,147 @@ func AuthMiddleware(next http.Handler) http.Handler {
 		} else {
 			cookie, err := r.Cookie("auth")
 			if err != nil {
-				errResp.Message = "Unauthorized: missing auth token"
-				errResp.Status = http.StatusUnauthorized
-				w.Header().Set("Content-Type", "application/json")
-				w.WriteHeader(errResp.Status)
-				json.NewEncoder(w).Encode(errResp)
+				writeError(w, http.StatusUnauthorized, "Unauthorized: missing auth token")
 				return
 			}
 			tokenString = cookie.Value
 		}
 
+		if strings.HasPrefix(tokenString, APIKeyPrefix) {
+			authenticateAPIKey(w, r, next, tokenString, scopeFor)
+			return
+		}
+
 		claims, err := ParseToken(tokenString)
 		if err != nil {
-			errResp.Message = fmt.Sprintf("Invalid token: %v", err)
-			errResp.Status = http.StatusUnauthorized
-			w.Header().Set("Content-Type", "application/json")
-			w.WriteHeader(errResp.Status)
-			json.NewEncoder(w).Encode(errResp)
+			writeError(w, http.StatusUnauthorized, fmt.Sprintf("Invalid token: %v", err))
+			return
+		}
+
+		if err := CheckRevoked(r.Context(), claims); err != nil {
+			if errors.Is(err, ErrSessionRevoked) {
+				writeError(w, http.StatusUnauthorized, "Unauthorized: session revoked")
+			} else {
+				log.Printf("Failed to check revocation of session %s: %v", claims.ID, err)
+				writeError(w, http.StatusUnauthorized, "Unauthorized: session unavailable")
+			}
+			return
+		}
+
+		if !checkSuspended(w, r, claims.Subject) {
 			return
 		}
 
 		ctx := WithUserID(r.Context(), claims.Subject)
+		ctx = context.WithValue(ctx, sessionIDKey, claims.ID)
+		ctx = WithRole(ctx, TokenRole(ctx, claims))
+		if claims.Actor != nil {
+			log.Printf("Admin %s impersonating user %s: %s %s", claims.Actor.Subject, claims.Subject, r.Method, r.URL.Path)
+			ctx = WithImpersonator(ctx, claims.Actor.Subject)
+		}
 		next.ServeHTTP(w, r.WithContext(ctx))
 
 	})
 }
 
+func authenticateAPIKey(w http.ResponseWriter, r *http.Request, next http.Handler, key string, scopeFor func(r *http.Request) string) {
+	if scopeFor == nil || apiKeyResolver == nil {
+		writeError(w, http.StatusForbidden, "Forbidden: API keys cannot access this endpoint")
+		return
+	}
+	userID, scopes, err := apiKeyResolver(r.Context(), HashAPIKey(key))
+	if err != nil {
+		writeError(w, http.StatusUnauthorized, "Invalid API key")
+		return
+	}
+	if scope := scopeFor(r); !slices.Contains(scopes, scope) {
+		writeError(w, http.StatusForbidden, fmt.Sprintf("Forbidden: API key lacks the %s scope", scope))
+		return
+	}
+
+	if !checkSuspended(w, r, userID) {
+		return
+	}
+
+	ctx := WithUserID(r.Context(), userID)
+	ctx = WithScopes(ctx, scopes)
+	ctx = WithRole(ctx, RoleUser)
+	next.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// checkSuspended refuses the request of a suspended userID, writing the
+// error response and returning false.
+func checkSuspended(w http.ResponseWriter, r *http.Request, userID string) bool {
+	err := CheckSuspended(r.Context(), userID)
+	if err == nil {
+		return true
+	}
+	if errors.Is(err, ErrAccountSuspended) {
+		writeError(w, http.StatusForbidden, "Forbidden: account suspended")
+	} else {
+		log.Printf("Failed to check suspension of user %s: %v", userID, err)
+		writeError(w, http.StatusUnauthorized, "Unauthorized: account unavailable")
+	}
+	return false
+}
+
+func writeError(w http.ResponseWriter, status int, message string) {
+	errResp := ErrorResponse{
+		Message:   message,
+		Timestamp: time.Now(),
+		Status:    status,
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(errResp.Status)
+	json.NewEncoder(w).Encode(errResp)
+}
+
 type contextKey string
 
-const userIDKey contextKey = "userID"
+const (
+	userIDKey       contextKey = "userID"
+	impersonatorKey contextKey = "impersonator"
+	sessionIDKey    contextKey = "sessionID"
+	scopesKey       contextKey = "scopes"
+	roleKey         contextKey = "role"
+)
 
+// WithUserID authenticates ctx as userID. It also scopes the database's
+// row-level security to that user.
 func WithUserID(ctx context.Context, userID string) context.Context {
+	ctx = tenant.WithUser(ctx, userID)
 	return context.WithValue(ctx, userIDKey, userID)
 }
 
 func GetUserIDFromContext(r *http.Request) (string, error) {
-	userID, ok := r.Context().Value(userIDKey).(string)
+	return UserIDFromContext(r.Context())
+}
+
+// UserIDFromContext is GetUserIDFromContext for callers without an
+// *http.Request, such as gRPC handlers.
+func UserIDFromContext(ctx context.Context) (string, error) {
+	userID, ok := ctx.Value(userIDKey).(string)
 	if !ok || userID == "" {
 		return "", errors.New("user ID not found in context")
 	}
 	return userID, nil
 }
+
+// SessionIDFromContext returns the ID (jti) of the token the request was
+// authenticated with.
+func SessionIDFromContext(ctx context.Context) string {
+	sessionID, _ := ctx.Value(sessionIDKey).(string)
+	return sessionID
+}
+
+// WithImpersonator marks ctx as acting for its user on behalf of adminID.
+func WithImpersonator(ctx context.Context, adminID string) context.Context {
+	return context.WithValue(ctx, impersonatorKey, adminID)
+}
+
+// ImpersonatorFromContext returns the admin impersonating the user of ctx,
+// or "" when the user is acting for themselves.
+func ImpersonatorFromContext(ctx context.Context) string {
+	adminID, _ := ctx.Value(impersonatorKey).(string)
+	return adminID
+}
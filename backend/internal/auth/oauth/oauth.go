@@ -0,0 +1,211 @@
+// Package oauth signs users in with their Google or GitHub account through
+// the OAuth 2.0 authorization code flow.
+package oauth
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+)
+
+// Providers users can sign in with, as named in URLs and on the users table.
+const (
+	ProviderGoogle = "google"
+	ProviderGitHub = "github"
+)
+
+// Identity is the account a user signed in with at a provider.
+type Identity struct {
+	// ID is the provider's stable id of the account; emails can change.
+	ID    string
+	Email string
+	// EmailVerified is whether the provider checked the user owns Email,
+	// which is required to link the identity to an existing account.
+	EmailVerified bool
+	Name          string
+}
+
+// Provider is an OAuth 2.0 identity provider an application was registered
+// with.
+type Provider struct {
+	Name         string
+	authURL      string
+	tokenURL     string
+	scopes       []string
+	clientID     string
+	clientSecret string
+	client       *http.Client
+	// identify fetches the identity an access token belongs to.
+	identify func(ctx context.Context, p *Provider, accessToken string) (*Identity, error)
+}
+
+// Google signs users in with their Google account, through OpenID Connect's
+// userinfo endpoint.
+func Google(clientID, clientSecret string, client *http.Client) *Provider {
+	return &Provider{
+		Name:         ProviderGoogle,
+		authURL:      "https://accounts.google.com/o/oauth2/v2/auth",
+		tokenURL:     "https://oauth2.googleapis.com/token",
+		scopes:       []string{"openid", "email", "profile"},
+		clientID:     clientID,
+		clientSecret: clientSecret,
+		client:       client,
+		identify:     identifyGoogle,
+	}
+}
+
+// GitHub signs users in with their GitHub account. Its email is the primary
+// address of the account, which GitHub tells whether was verified.
+func GitHub(clientID, clientSecret string, client *http.Client) *Provider {
+	return &Provider{
+		Name:         ProviderGitHub,
+		authURL:      "https://github.com/login/oauth/authorize",
+		tokenURL:     "https://github.com/login/oauth/access_token",
+		scopes:       []string{"read:user", "user:email"},
+		clientID:     clientID,
+		clientSecret: clientSecret,
+		client:       client,
+		identify:     identifyGitHub,
+	}
+}
+
+// AuthCodeURL is where users are sent to sign in. The provider sends them
+// back to redirectURL with a code and state, which must be checked to be the
+// one given here.
+func (p *Provider) AuthCodeURL(redirectURL, state string) string {
+	params := url.Values{
+		"client_id":     {p.clientID},
+		"redirect_uri":  {redirectURL},
+		"response_type": {"code"},
+		"scope":         {strings.Join(p.scopes, " ")},
+		"state":         {state},
+	}
+	return p.authURL + "?" + params.Encode()
+}
+
+// Exchange trades the code the provider sent the user back with for the
+// identity they signed in as.
+func (p *Provider) Exchange(ctx context.Context, redirectURL, code string) (*Identity, error) {
+	form := url.Values{
+		"client_id":     {p.clientID},
+		"client_secret": {p.clientSecret},
+		"code":          {code},
+		"grant_type":    {"authorization_code"},
+		"redirect_uri":  {redirectURL},
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, p.tokenURL, strings.NewReader(form.Encode()))
+	if err != nil {
+		return nil, fmt.Errorf("failed to build token request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req.Header.Set("Accept", "application/json")
+
+	var token struct {
+		AccessToken      string `json:"access_token"`
+		Error            string `json:"error"`
+		ErrorDescription string `json:"error_description"`
+	}
+	if err := p.do(req, &token); err != nil {
+		return nil, fmt.Errorf("failed to exchange code: %w", err)
+	}
+	// GitHub reports a bad code with 200 and an error field
+	if token.Error != "" {
+		return nil, fmt.Errorf("failed to exchange code: %s: %s", token.Error, token.ErrorDescription)
+	}
+	if token.AccessToken == "" {
+		return nil, fmt.Errorf("failed to exchange code: no access token")
+	}
+
+	identity, err := p.identify(ctx, p, token.AccessToken)
+	if err != nil {
+		return nil, err
+	}
+	if identity.ID == "" {
+		return nil, fmt.Errorf("%s returned no account id", p.Name)
+	}
+	return identity, nil
+}
+
+// get decodes the JSON at apiURL, fetched with accessToken, into v.
+func (p *Provider) get(ctx context.Context, apiURL, accessToken string, v interface{}) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, apiURL, nil)
+	if err != nil {
+		return fmt.Errorf("failed to build request: %w", err)
+	}
+	req.Header.Set("Authorization", "Bearer "+accessToken)
+	req.Header.Set("Accept", "application/json")
+	return p.do(req, v)
+}
+
+func (p *Provider) do(req *http.Request, v interface{}) error {
+	resp, err := p.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("%s returned %s", req.URL.Host, resp.Status)
+	}
+	if err := json.NewDecoder(resp.Body).Decode(v); err != nil {
+		return fmt.Errorf("failed to decode response of %s: %w", req.URL.Host, err)
+	}
+	return nil
+}
+
+func identifyGoogle(ctx context.Context, p *Provider, accessToken string) (*Identity, error) {
+	var info struct {
+		Sub           string `json:"sub"`
+		Email         string `json:"email"`
+		EmailVerified bool   `json:"email_verified"`
+		Name          string `json:"name"`
+	}
+	if err := p.get(ctx, "https://openidconnect.googleapis.com/v1/userinfo", accessToken, &info); err != nil {
+		return nil, fmt.Errorf("failed to fetch Google account: %w", err)
+	}
+	return &Identity{
+		ID:            info.Sub,
+		Email:         info.Email,
+		EmailVerified: info.EmailVerified,
+		Name:          info.Name,
+	}, nil
+}
+
+func identifyGitHub(ctx context.Context, p *Provider, accessToken string) (*Identity, error) {
+	var user struct {
+		ID    int64  `json:"id"`
+		Login string `json:"login"`
+		Name  string `json:"name"`
+	}
+	if err := p.get(ctx, "https://api.github.com/user", accessToken, &user); err != nil {
+		return nil, fmt.Errorf("failed to fetch GitHub account: %w", err)
+	}
+
+	// the profile email is optional and unverified; the primary one is not
+	var emails []struct {
+		Email    string `json:"email"`
+		Primary  bool   `json:"primary"`
+		Verified bool   `json:"verified"`
+	}
+	if err := p.get(ctx, "https://api.github.com/user/emails", accessToken, &emails); err != nil {
+		return nil, fmt.Errorf("failed to fetch GitHub emails: %w", err)
+	}
+
+	identity := &Identity{
+		ID:   strconv.FormatInt(user.ID, 10),
+		Name: user.Name,
+	}
+	if identity.Name == "" {
+		identity.Name = user.Login
+	}
+	for _, email := range emails {
+		if email.Primary {
+			identity.Email = email.Email
+			identity.EmailVerified = email.Verified
+		}
+	}
+	return identity, nil
+}
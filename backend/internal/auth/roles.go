@@ -0,0 +1,76 @@
+package auth
+
+import (
+	"context"
+	"log"
+	"net/http"
+	"slices"
+	"strings"
+)
+
+// Roles of users across the service, as opposed to their roles within an
+// organization.
+const (
+	RoleUser  = "user"
+	RoleAdmin = "admin"
+)
+
+// Roles are every role a user can have.
+var Roles = []string{RoleUser, RoleAdmin}
+
+// RoleResolver returns the role of userID.
+type RoleResolver func(ctx context.Context, userID string) (string, error)
+
+var roleResolver RoleResolver
+
+// SetRoleResolver makes AuthMiddleware look up the role of tokens issued
+// before tokens carried one. Without it such tokens have the user role.
+func SetRoleResolver(resolver RoleResolver) {
+	roleResolver = resolver
+}
+
+// TokenRole returns the role a request authenticated with claims acts with.
+// Impersonation tokens act with the user role, whatever they claim.
+func TokenRole(ctx context.Context, claims *Claims) string {
+	if claims.Actor != nil {
+		return RoleUser
+	}
+	if claims.Role != "" {
+		return claims.Role
+	}
+	if roleResolver == nil {
+		return RoleUser
+	}
+	role, err := roleResolver(ctx, claims.Subject)
+	if err != nil {
+		log.Printf("Failed to resolve role of user %s: %v", claims.Subject, err)
+		return RoleUser
+	}
+	return role
+}
+
+// WithRole gives the request of ctx role.
+func WithRole(ctx context.Context, role string) context.Context {
+	return context.WithValue(ctx, roleKey, role)
+}
+
+// RoleFromContext returns the role the request of ctx acts with.
+func RoleFromContext(ctx context.Context) string {
+	role, _ := ctx.Value(roleKey).(string)
+	return role
+}
+
+// RequireRole only lets requests acting with one of roles through. It goes
+// after AuthMiddleware, which sets the role from the token: API keys and
+// impersonation tokens always act with the user role.
+func RequireRole(roles ...string) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if !slices.Contains(roles, RoleFromContext(r.Context())) {
+				writeError(w, http.StatusForbidden, "Forbidden: "+strings.Join(roles, " or ")+" role required")
+				return
+			}
+			next.ServeHTTP(w, r)
+		})
+	}
+}
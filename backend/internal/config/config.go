@@ -1,16 +1,47 @@
 package config
 
 import (
+	"fmt"
+	"net"
+	"net/url"
 	"os"
+	"strconv"
+	"strings"
+	"time"
 	"xpired/internal/db"
+	"xpired/internal/password"
+	"xpired/internal/screening"
 
 	"github.com/joho/godotenv"
 )
 
 type Config struct {
-	Database db.Config
-	JWT      JWTConfig
-	Redis    RedisConfig
+	App           AppConfig
+	Database      db.Config
+	JWT           JWTConfig
+	Redis         RedisConfig
+	Notifications NotificationsConfig
+	Twilio        TwilioConfig
+	GRPC          GRPCConfig
+	Storage       StorageConfig
+	Trash         TrashConfig
+	Accounts      AccountsConfig
+	Attachments   AttachmentsConfig
+	Quotas        QuotasConfig
+	Encryption    EncryptionConfig
+	Residency     ResidencyConfig
+	Admin         AdminConfig
+	Registration  RegistrationConfig
+	Login         LoginConfig
+	Password      PasswordConfig
+	Worker        WorkerConfig
+	Alerts        AlertsConfig
+	API           APIConfig
+	Web           WebConfig
+	Feedback      FeedbackConfig
+	GeoIP         GeoIPConfig
+	Egress        EgressConfig
+	OAuth         OAuthConfig
 }
 
 type ServerConfig struct {
@@ -19,36 +50,518 @@ type ServerConfig struct {
 	Env  string
 }
 
+type AppConfig struct {
+	// BaseURL is the public URL used to build links in notifications.
+	BaseURL string
+	// FrontendURL is where links to documents in notifications point.
+	FrontendURL string
+}
+
 type JWTConfig struct {
+	// Secret signs new tokens, which name it in their kid header as KeyID.
 	Secret string
+	KeyID  string
+	// SecondaryKeys are secrets by key ID that tokens are still accepted
+	// with but no longer signed with, such as the previous Secret while
+	// the tokens it signed are outstanding.
+	SecondaryKeys map[string]string
+	// Algorithm is what new tokens are signed with: HS256 with Secret, or
+	// RS256 or EdDSA with the private key in PrivateKeyFile, whose public
+	// key is then published at /.well-known/jwks.json so other services
+	// can verify tokens without the secret.
+	Algorithm      string
+	PrivateKeyFile string
+	// SecondaryPublicKeyFiles are PEM public (or private) key files by key
+	// ID that tokens are still accepted with and that stay published, such
+	// as the previous private key while the tokens it signed are
+	// outstanding.
+	SecondaryPublicKeyFiles map[string]string
 }
 
 type RedisConfig struct {
 	Addr     string
 	Password string
 	DB       int
+	// Embedded runs Redis inside the server process instead of connecting
+	// to Addr, for self-hosting as a single binary. Its data is saved to
+	// EmbeddedSnapshot so queued tasks survive restarts.
+	Embedded         bool
+	EmbeddedSnapshot string
+}
+
+type NotificationsConfig struct {
+	// DryRun renders and logs notifications without calling any provider.
+	DryRun bool
+	// BatchEmails groups reminders for the same user and interval that fire
+	// together into a single email.
+	BatchEmails bool
+	// EmailWebhookSecret authenticates bounce callbacks from the email
+	// provider; they are refused while it is empty.
+	EmailWebhookSecret string
+	// EmailRate, SMSRate and PushRate cap the messages per second sent to
+	// each provider across all workers, to stay within its quota; 0 means
+	// unlimited. Sends beyond the cap are delayed, never dropped.
+	EmailRate int
+	SMSRate   int
+	PushRate  int
+}
+
+type TwilioConfig struct {
+	// AuthToken verifies the X-Twilio-Signature of inbound webhooks. SMS
+	// replies are refused without it.
+	AuthToken string
+}
+
+type GRPCConfig struct {
+	// Addr is the listen address of the gRPC API; empty disables it.
+	Addr string
+}
+
+type StorageConfig struct {
+	// Driver selects where attachments live: "local" or "s3".
+	Driver string
+	// PublicURL is the prefix of attachment URLs served from this storage;
+	// attachment URLs outside it are links the storage does not own.
+	PublicURL string
+	// LocalDir is the directory the local driver stores files in.
+	LocalDir string
+	S3Bucket string
+	S3Region string
+	// S3Endpoint overrides the S3 endpoint, e.g. for MinIO.
+	S3Endpoint string
+}
+
+type TrashConfig struct {
+	// RetentionDays is how long deleted documents stay restorable before the
+	// purge job removes them and their attachments for good.
+	RetentionDays int
+}
+
+type AccountsConfig struct {
+	// DeletionGraceDays is how long after a user deletes their account the
+	// purge job waits before removing its documents and attachments for good.
+	DeletionGraceDays int
+}
+
+type AttachmentsConfig struct {
+	// MaxUploadMB caps the size of uploaded attachments.
+	MaxUploadMB int
+	// ClamdAddr is the host:port of the clamd daemon uploads are scanned
+	// with; empty disables scanning.
+	ClamdAddr string
+	// RetentionDays is how many days after a document expired each plan
+	// keeps its attachment; a plan missing or at 0 keeps it forever.
+	RetentionDays map[string]int
+	// RetentionWarningDays is how long before deleting an attachment the
+	// retention job warns its owner.
+	RetentionWarningDays int
+}
+
+// QuotasConfig caps what users of each plan can use; a plan missing or at 0
+// in a map has no cap.
+type QuotasConfig struct {
+	// Documents caps the personal documents a user can have.
+	Documents map[string]int
+	// MonthlySMS caps the text messages sent to a user per calendar month,
+	// in UTC.
+	MonthlySMS map[string]int
+	// WarningPercent is how much of a quota, in percent, a user can use
+	// before they are warned that they are nearing it.
+	WarningPercent int
+}
+
+// EncryptionConfig holds the keys for sensitive columns. The server refuses
+// to start without them. Deployments that ran without them had their keys
+// derived from JWT_SECRET; they keep their data readable by setting the keys
+// to what was derived, e.g. for FIELD_ENCRYPTION_KEY:
+//
+//	printf xpired:field-encryption | openssl dgst -sha256 -hmac "$JWT_SECRET" -binary | base64
+//
+// and likewise with xpired:blind-index for BLIND_INDEX_KEY.
+type EncryptionConfig struct {
+	// FieldKey is the base64 AES-256 key sensitive columns are encrypted with.
+	FieldKey string
+	// FieldKeyKMS is the base64 KMS-encrypted form of the field key; when set
+	// it is decrypted with AWS KMS at startup and FieldKey is ignored.
+	FieldKeyKMS string
+	// BlindIndexKey is the base64 HMAC key for blind indexes. It must stay
+	// the same for existing indexes to keep matching.
+	BlindIndexKey string
+}
+
+type ResidencyConfig struct {
+	// Regions maps a data region name to the Postgres URL of the regional
+	// database that organizations pinned to it keep their documents in.
+	Regions map[string]string
+}
+
+type AdminConfig struct {
+	// Emails are the users allowed to use the admin endpoints, such as
+	// impersonating users for support.
+	Emails []string
+}
+
+// RegistrationConfig screens sign-ups for spam accounts, which would
+// otherwise use up the SMS quota. Every check is off unless configured.
+type RegistrationConfig struct {
+	// DisposableEmailDomains are email domains, subdomains included, that
+	// cannot be used to sign up.
+	DisposableEmailDomains []string
+	// DisposableEmailDomainsFile adds the domains listed in a file, one per
+	// line, for lists too long for an environment variable.
+	DisposableEmailDomainsFile string
+	// Honeypot rejects sign-ups that fill in the "website" field, which the
+	// frontend hides from people.
+	Honeypot bool
+	// CaptchaSecret turns on CAPTCHA verification of sign-ups against
+	// CaptchaVerifyURL: Cloudflare Turnstile by default, or the hCaptcha or
+	// reCAPTCHA siteverify URL.
+	CaptchaSecret    string
+	CaptchaVerifyURL string
+}
+
+// LoginConfig throttles password sign-ins against brute force. Failed
+// attempts are counted in Redis, per email and per client IP address.
+type LoginConfig struct {
+	// MaxFailures is how many failed sign-ins an email may have within
+	// FailureWindow before signing in to it is locked for LockoutDuration,
+	// which doubles with each lockout in a row, up to a day. 0 turns
+	// lockout off.
+	MaxFailures     int
+	FailureWindow   time.Duration
+	LockoutDuration time.Duration
+	// MaxFailuresPerIP locks out an IP address the same way once it failed
+	// that many sign-ins, to any emails, so one client cannot guess across
+	// many accounts. 0 turns it off.
+	MaxFailuresPerIP int
+}
+
+// PasswordConfig is the policy new passwords must satisfy. Existing
+// passwords keep working when it is tightened.
+type PasswordConfig struct {
+	MinLength int
+	// MinCharacterClasses is how many of lowercase letters, uppercase
+	// letters, digits and symbols a password must mix, from 1 to 4.
+	MinCharacterClasses int
+	// BreachCheck refuses passwords found in known data breaches, looked up
+	// at BreachRangeURL with the Have I Been Pwned k-anonymity range API.
+	// Passwords are let through when the lookup fails.
+	BreachCheck    bool
+	BreachRangeURL string
+}
+
+type WorkerConfig struct {
+	// TaskTimeout is the deadline of a task, including every query and
+	// provider call it makes, unless TaskTimeouts or the worker's own
+	// defaults give its type another one.
+	TaskTimeout time.Duration
+	// TaskTimeouts sets the deadline per task type, e.g.
+	// "send_announcement=20m". asynq itself stops tasks after 30 minutes.
+	TaskTimeouts map[string]time.Duration
+}
+
+type APIConfig struct {
+	// MaxInFlight is how many API requests a replica serves at once; more
+	// are answered with 503 right away. 0 means unlimited.
+	MaxInFlight int
+	// TrustedProxies are the addresses or CIDR ranges of the reverse proxies
+	// in front of the API. Only requests from them have their client address
+	// taken from X-Forwarded-For or X-Real-IP, for sign-in lockouts and
+	// session locations; without any, the peer address is used.
+	TrustedProxies []string
+}
+
+// AlertsConfig sets when operators are alerted about delivery health, so a
+// scheduling failure that silently stops reminders gets noticed.
+type AlertsConfig struct {
+	// WebhookURL receives a JSON POST whenever an alert fires or resolves;
+	// alerts are only logged when it is empty.
+	WebhookURL string
+	// QueueDepth is how many tasks may be waiting in the queue before an
+	// alert fires.
+	QueueDepth int
+	// FailurePercent is the share of notifications failing over the last
+	// hour above which an alert fires.
+	FailurePercent int
+	// SilenceHours is how long no notification may go out before an alert
+	// fires.
+	SilenceHours int
+	// AnomalyFactor is how many times their usual daily volume of
+	// notifications a user must get in a day for the nightly anomaly check
+	// to alert, once they got at least AnomalyMinNotifications.
+	AnomalyFactor           int
+	AnomalyMinNotifications int
+	// AnomalyNotifyUser also emails users who got unusually many
+	// notifications that the team is looking into it.
+	AnomalyNotifyUser bool
+}
+
+// WebConfig sets where the single-page frontend served under / comes from.
+type WebConfig struct {
+	// Dir serves the frontend's static build from disk instead of the copy
+	// embedded in the binary; empty uses the embedded one.
+	Dir string
+}
+
+// FeedbackConfig sets where feedback sent from the app is relayed to. It is
+// only stored when neither is set.
+type FeedbackConfig struct {
+	// Email is the address feedback is emailed to.
+	Email string
+	// SlackWebhookURL is a Slack incoming webhook feedback is posted to.
+	SlackWebhookURL string
+}
+
+// GeoIPConfig sets the offline database sessions are located with.
+type GeoIPConfig struct {
+	// DBFile is a DB-IP Lite "IP to Country" or "IP to City" CSV file,
+	// optionally gzipped. Without one, sessions record no location.
+	DBFile string
+}
+
+// OAuthConfig holds the applications registered with each provider users
+// can sign in with. A provider is offered once its client ID is set; its
+// callback URL is APP_BASE_URL/api/auth/oauth/{provider}/callback.
+type OAuthConfig struct {
+	Google OAuthClientConfig
+	GitHub OAuthClientConfig
+}
+
+type OAuthClientConfig struct {
+	ClientID     string
+	ClientSecret string
+}
+
+// EgressConfig sets where outbound calls to webhooks and providers come
+// from, for receivers that only accept known addresses.
+type EgressConfig struct {
+	// IPs are the addresses or CIDR ranges outbound calls come from, as
+	// published to receivers; the server only announces them, they must
+	// match how it is deployed.
+	IPs []string
+	// ProxyURL is an HTTP(S) proxy outbound calls to webhooks and providers
+	// are sent through, e.g. one with a static address. Without it they are
+	// sent directly, or through HTTPS_PROXY when that is set.
+	ProxyURL string
 }
 
 func Load() (*Config, error) {
 	_ = godotenv.Load()
 
 	config := &Config{
+		App: AppConfig{
+			BaseURL:     getEnv("APP_BASE_URL", "http://localhost:8080"),
+			FrontendURL: getEnv("FRONTEND_URL", "http://localhost:3000"),
+		},
 		Database: db.Config{
-			Host:     getEnv("DB_HOST", "localhost"),
-			Port:     getEnv("DB_PORT", "5432"),
-			User:     getEnv("DB_USER", "postgres"),
-			Password: getEnv("DB_PASSWORD", ""),
-			DBName:   getEnv("DB_NAME", "xpired_db"),
-			SSLMode:  getEnv("DB_SSL_MODE", "disable"),
+			Driver:          getEnv("DB_DRIVER", db.DriverPostgres),
+			SQLitePath:      getEnv("SQLITE_PATH", "./xpired.db"),
+			Host:            getEnv("DB_HOST", "localhost"),
+			Port:            getEnv("DB_PORT", "5432"),
+			User:            getEnv("DB_USER", "postgres"),
+			Password:        getEnv("DB_PASSWORD", ""),
+			DBName:          getEnv("DB_NAME", "xpired_db"),
+			SSLMode:         getEnv("DB_SSL_MODE", "disable"),
+			ConnectAttempts: getEnvInt("DB_CONNECT_ATTEMPTS", 10),
+			ReplicaURL:      getEnv("DB_REPLICA_URL", ""),
+			MaxOpenConns:    getEnvInt("DB_MAX_OPEN_CONNS", 25),
 		},
 		JWT: JWTConfig{
-			Secret: getEnv("JWT_SECRET", "your-super-secret-jwt-key-change-in-production"),
+			Secret:                  getEnv("JWT_SECRET", "your-super-secret-jwt-key-change-in-production"),
+			KeyID:                   getEnv("JWT_KEY_ID", "primary"),
+			SecondaryKeys:           getEnvMap("JWT_SECONDARY_KEYS"),
+			Algorithm:               getEnv("JWT_ALGORITHM", "HS256"),
+			PrivateKeyFile:          getEnv("JWT_PRIVATE_KEY_FILE", ""),
+			SecondaryPublicKeyFiles: getEnvMap("JWT_SECONDARY_PUBLIC_KEY_FILES"),
 		},
 		Redis: RedisConfig{
 			Addr:     getEnv("REDIS_ADDR", "localhost:6379"),
 			Password: getEnv("REDIS_PASSWORD", ""),
 			DB:       0,
+
+			Embedded:         getEnvBool("REDIS_EMBEDDED", false),
+			EmbeddedSnapshot: getEnv("REDIS_EMBEDDED_SNAPSHOT", "./xpired-redis.snapshot"),
+		},
+		Notifications: NotificationsConfig{
+			DryRun:             getEnvBool("NOTIFICATIONS_DRY_RUN", false),
+			BatchEmails:        getEnvBool("NOTIFICATIONS_BATCH_EMAILS", false),
+			EmailWebhookSecret: getEnv("EMAIL_WEBHOOK_SECRET", ""),
+			EmailRate:          getEnvInt("NOTIFICATIONS_EMAIL_RATE", 14),
+			SMSRate:            getEnvInt("NOTIFICATIONS_SMS_RATE", 1),
+			PushRate:           getEnvInt("NOTIFICATIONS_PUSH_RATE", 0),
+		},
+		Twilio: TwilioConfig{
+			AuthToken: getEnv("TWILIO_AUTH_TOKEN", ""),
+		},
+		GRPC: GRPCConfig{
+			Addr: getEnv("GRPC_ADDR", ":9090"),
+		},
+		Storage: StorageConfig{
+			Driver:     getEnv("STORAGE_DRIVER", "local"),
+			PublicURL:  getEnv("STORAGE_PUBLIC_URL", "http://localhost:8080/uploads"),
+			LocalDir:   getEnv("STORAGE_LOCAL_DIR", "./uploads"),
+			S3Bucket:   getEnv("S3_BUCKET", ""),
+			S3Region:   getEnv("S3_REGION", "us-east-1"),
+			S3Endpoint: getEnv("S3_ENDPOINT", ""),
+		},
+		Trash: TrashConfig{
+			RetentionDays: getEnvInt("TRASH_RETENTION_DAYS", 30),
 		},
+		Accounts: AccountsConfig{
+			DeletionGraceDays: getEnvInt("ACCOUNT_DELETION_GRACE_DAYS", 30),
+		},
+		Attachments: AttachmentsConfig{
+			MaxUploadMB: getEnvInt("ATTACHMENT_MAX_UPLOAD_MB", 10),
+			ClamdAddr:   getEnv("CLAMD_ADDR", ""),
+			RetentionDays: map[string]int{
+				"free": getEnvInt("ATTACHMENT_RETENTION_FREE_DAYS", 0),
+				"pro":  getEnvInt("ATTACHMENT_RETENTION_PRO_DAYS", 0),
+			},
+			RetentionWarningDays: getEnvInt("ATTACHMENT_RETENTION_WARNING_DAYS", 14),
+		},
+		Quotas: QuotasConfig{
+			Documents: map[string]int{
+				"free": getEnvInt("QUOTA_FREE_DOCUMENTS", 0),
+				"pro":  getEnvInt("QUOTA_PRO_DOCUMENTS", 0),
+			},
+			MonthlySMS: map[string]int{
+				"free": getEnvInt("QUOTA_FREE_MONTHLY_SMS", 0),
+				"pro":  getEnvInt("QUOTA_PRO_MONTHLY_SMS", 0),
+			},
+			WarningPercent: getEnvInt("QUOTA_WARNING_PERCENT", 90),
+		},
+		Encryption: EncryptionConfig{
+			FieldKey:      getEnv("FIELD_ENCRYPTION_KEY", ""),
+			FieldKeyKMS:   getEnv("FIELD_ENCRYPTION_KMS_KEY", ""),
+			BlindIndexKey: getEnv("BLIND_INDEX_KEY", ""),
+		},
+		Residency: ResidencyConfig{
+			Regions: getEnvMap("DATABASE_REGIONS"),
+		},
+		Admin: AdminConfig{
+			Emails: getEnvList("ADMIN_EMAILS"),
+		},
+		Registration: RegistrationConfig{
+			DisposableEmailDomains:     getEnvList("DISPOSABLE_EMAIL_DOMAINS"),
+			DisposableEmailDomainsFile: getEnv("DISPOSABLE_EMAIL_DOMAINS_FILE", ""),
+			Honeypot:                   getEnvBool("REGISTRATION_HONEYPOT", false),
+			CaptchaSecret:              getEnv("CAPTCHA_SECRET", ""),
+			CaptchaVerifyURL:           getEnv("CAPTCHA_VERIFY_URL", screening.DefaultVerifyURL),
+		},
+		Login: LoginConfig{
+			MaxFailures:      getEnvInt("LOGIN_MAX_FAILURES", 5),
+			FailureWindow:    getEnvDuration("LOGIN_FAILURE_WINDOW", 15*time.Minute),
+			LockoutDuration:  getEnvDuration("LOGIN_LOCKOUT_DURATION", 15*time.Minute),
+			MaxFailuresPerIP: getEnvInt("LOGIN_MAX_FAILURES_PER_IP", 50),
+		},
+		Password: PasswordConfig{
+			MinLength:           getEnvInt("PASSWORD_MIN_LENGTH", 8),
+			MinCharacterClasses: getEnvInt("PASSWORD_MIN_CHARACTER_CLASSES", 1),
+			BreachCheck:         getEnvBool("PASSWORD_BREACH_CHECK", false),
+			BreachRangeURL:      getEnv("PASSWORD_BREACH_RANGE_URL", password.DefaultBreachRangeURL),
+		},
+		Worker: WorkerConfig{
+			TaskTimeout:  getEnvDuration("WORKER_TASK_TIMEOUT", 2*time.Minute),
+			TaskTimeouts: getEnvDurationMap("WORKER_TASK_TIMEOUTS"),
+		},
+		API: APIConfig{
+			MaxInFlight:    getEnvInt("API_MAX_IN_FLIGHT", 200),
+			TrustedProxies: getEnvList("TRUSTED_PROXIES"),
+		},
+		Alerts: AlertsConfig{
+			WebhookURL:              getEnv("ALERT_WEBHOOK_URL", ""),
+			QueueDepth:              getEnvInt("ALERT_QUEUE_DEPTH", 1000),
+			FailurePercent:          getEnvInt("ALERT_FAILURE_PERCENT", 5),
+			SilenceHours:            getEnvInt("ALERT_SILENCE_HOURS", 24),
+			AnomalyFactor:           getEnvInt("ALERT_ANOMALY_FACTOR", 5),
+			AnomalyMinNotifications: getEnvInt("ALERT_ANOMALY_MIN_NOTIFICATIONS", 10),
+			AnomalyNotifyUser:       getEnvBool("ALERT_ANOMALY_NOTIFY_USER", false),
+		},
+		Web: WebConfig{
+			Dir: getEnv("WEB_DIR", ""),
+		},
+		Feedback: FeedbackConfig{
+			Email:           getEnv("FEEDBACK_EMAIL", ""),
+			SlackWebhookURL: getEnv("FEEDBACK_SLACK_WEBHOOK_URL", ""),
+		},
+		GeoIP: GeoIPConfig{
+			DBFile: getEnv("GEOIP_DB_FILE", ""),
+		},
+		Egress: EgressConfig{
+			IPs:      getEnvList("EGRESS_IPS"),
+			ProxyURL: getEnv("EGRESS_PROXY_URL", ""),
+		},
+		OAuth: OAuthConfig{
+			Google: OAuthClientConfig{
+				ClientID:     getEnv("GOOGLE_CLIENT_ID", ""),
+				ClientSecret: getEnv("GOOGLE_CLIENT_SECRET", ""),
+			},
+			GitHub: OAuthClientConfig{
+				ClientID:     getEnv("GITHUB_CLIENT_ID", ""),
+				ClientSecret: getEnv("GITHUB_CLIENT_SECRET", ""),
+			},
+		},
+	}
+
+	if config.Password.MinLength < 1 || config.Password.MinLength > password.MaxLength {
+		return nil, fmt.Errorf("PASSWORD_MIN_LENGTH must be between 1 and %d", password.MaxLength)
+	}
+	if config.Password.MinCharacterClasses < 1 || config.Password.MinCharacterClasses > 4 {
+		return nil, fmt.Errorf("PASSWORD_MIN_CHARACTER_CLASSES must be between 1 and 4")
+	}
+	if config.Quotas.WarningPercent < 1 || config.Quotas.WarningPercent > 100 {
+		return nil, fmt.Errorf("QUOTA_WARNING_PERCENT must be between 1 and 100")
+	}
+	if config.JWT.KeyID == "" {
+		return nil, fmt.Errorf("JWT_KEY_ID must not be empty")
+	}
+	if _, ok := config.JWT.SecondaryKeys[config.JWT.KeyID]; ok {
+		return nil, fmt.Errorf("JWT_SECONDARY_KEYS must not reuse JWT_KEY_ID %q", config.JWT.KeyID)
+	}
+	switch config.JWT.Algorithm {
+	case "HS256":
+	case "RS256", "EdDSA":
+		if config.JWT.PrivateKeyFile == "" {
+			return nil, fmt.Errorf("JWT_ALGORITHM %s needs JWT_PRIVATE_KEY_FILE", config.JWT.Algorithm)
+		}
+	default:
+		return nil, fmt.Errorf("JWT_ALGORITHM must be HS256, RS256 or EdDSA")
+	}
+	for keyID := range config.JWT.SecondaryPublicKeyFiles {
+		if _, ok := config.JWT.SecondaryKeys[keyID]; ok || keyID == config.JWT.KeyID {
+			return nil, fmt.Errorf("JWT_SECONDARY_PUBLIC_KEY_FILES must not reuse key ID %q", keyID)
+		}
+	}
+
+	for _, ip := range config.Egress.IPs {
+		if net.ParseIP(ip) != nil {
+			continue
+		}
+		if _, _, err := net.ParseCIDR(ip); err != nil {
+			return nil, fmt.Errorf("invalid address %q in EGRESS_IPS", ip)
+		}
+	}
+	for _, proxy := range config.API.TrustedProxies {
+		if net.ParseIP(proxy) != nil {
+			continue
+		}
+		if _, _, err := net.ParseCIDR(proxy); err != nil {
+			return nil, fmt.Errorf("invalid address %q in TRUSTED_PROXIES", proxy)
+		}
+	}
+	// the field keys are never derived from another secret, which may be
+	// unset or rotated while data stays encrypted under them
+	if config.Encryption.FieldKey == "" && config.Encryption.FieldKeyKMS == "" {
+		return nil, fmt.Errorf("FIELD_ENCRYPTION_KEY or FIELD_ENCRYPTION_KMS_KEY must be set")
+	}
+	if config.Encryption.BlindIndexKey == "" {
+		return nil, fmt.Errorf("BLIND_INDEX_KEY must be set")
+	}
+	if config.Egress.ProxyURL != "" {
+		proxyURL, err := url.Parse(config.Egress.ProxyURL)
+		if err != nil || proxyURL.Host == "" || (proxyURL.Scheme != "http" && proxyURL.Scheme != "https") {
+			return nil, fmt.Errorf("EGRESS_PROXY_URL must be an http or https URL")
+		}
 	}
 
 	return config, nil
@@ -60,3 +573,68 @@ func getEnv(key, defaultValue string) string {
 	}
 	return defaultValue
 }
+
+func getEnvBool(key string, defaultValue bool) bool {
+	if value := os.Getenv(key); value != "" {
+		parsed, err := strconv.ParseBool(value)
+		if err == nil {
+			return parsed
+		}
+	}
+	return defaultValue
+}
+
+func getEnvInt(key string, defaultValue int) int {
+	if value := os.Getenv(key); value != "" {
+		parsed, err := strconv.Atoi(value)
+		if err == nil {
+			return parsed
+		}
+	}
+	return defaultValue
+}
+
+func getEnvDuration(key string, defaultValue time.Duration) time.Duration {
+	if value := os.Getenv(key); value != "" {
+		parsed, err := time.ParseDuration(value)
+		if err == nil && parsed > 0 {
+			return parsed
+		}
+	}
+	return defaultValue
+}
+
+// getEnvList parses a comma-separated list, dropping empty entries.
+func getEnvList(key string) []string {
+	var values []string
+	for _, value := range strings.Split(os.Getenv(key), ",") {
+		if value = strings.TrimSpace(value); value != "" {
+			values = append(values, value)
+		}
+	}
+	return values
+}
+
+// getEnvMap parses a comma-separated list of name=value pairs.
+func getEnvMap(key string) map[string]string {
+	values := map[string]string{}
+	for _, pair := range strings.Split(os.Getenv(key), ",") {
+		name, value, ok := strings.Cut(strings.TrimSpace(pair), "=")
+		if ok && name != "" && value != "" {
+			values[name] = value
+		}
+	}
+	return values
+}
+
+// getEnvDurationMap parses a comma-separated list of name=duration pairs,
+// dropping pairs whose duration does not parse.
+func getEnvDurationMap(key string) map[string]time.Duration {
+	values := map[string]time.Duration{}
+	for name, raw := range getEnvMap(key) {
+		if value, err := time.ParseDuration(raw); err == nil && value > 0 {
+			values[name] = value
+		}
+	}
+	return values
+}
@@ -0,0 +1,58 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+)
+
+// DeactivateUser marks the account deleted, starting its grace period. It
+// fails when the account is already deactivated.
+func (r *repository) DeactivateUser(ctx context.Context, userID string) error {
+	result, err := r.db.DB.ExecContext(ctx, `
+		UPDATE users SET deactivated_at = NOW(), updated_at = NOW()
+		WHERE id = $1 AND deactivated_at IS NULL
+	`, userID)
+	if err != nil {
+		return fmt.Errorf("failed to deactivate user: %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to get rows affected: %w", err)
+	}
+	if rowsAffected == 0 {
+		return fmt.Errorf("user does not exist or is already deactivated")
+	}
+	return nil
+}
+
+func (r *repository) IsUserDeactivated(ctx context.Context, userID string) (bool, error) {
+	var deactivated bool
+	err := r.db.DB.QueryRowContext(ctx, `SELECT deactivated_at IS NOT NULL FROM users WHERE id = $1`, userID).Scan(&deactivated)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return false, fmt.Errorf("user does not exist")
+		}
+		return false, fmt.Errorf("failed to check user deactivation: %w", err)
+	}
+	return deactivated, nil
+}
+
+// ListDeletedAccountDocuments lists up to limit personal documents, trashed
+// or not, of accounts deactivated before cutoff. Organization documents stay
+// with their organization when their creator leaves. Documents under legal
+// hold are left out.
+func (r *repository) ListDeletedAccountDocuments(ctx context.Context, cutoff time.Time, limit int) ([]*Document, error) {
+	query := `
+		SELECT ` + trashedDocumentColumns + `
+		FROM documents
+		WHERE organization_id IS NULL AND user_id IN (
+			SELECT id FROM users WHERE deactivated_at IS NOT NULL AND deactivated_at < $1
+		) AND ` + notOnLegalHold + `
+		ORDER BY created_at
+		LIMIT $2
+	`
+	return r.queryTrashedDocuments(ctx, query, cutoff, limit)
+}
@@ -0,0 +1,52 @@
+package db
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+// ClaimActionLink records that the action of the link with ID jti, valid
+// until expiresAt, is being carried out. It reports false when the link was
+// already used. Links past their expiry are forgotten along the way, as
+// their tokens are refused anyway.
+func (r *repository) ClaimActionLink(ctx context.Context, jti string, expiresAt time.Time) (bool, error) {
+	if _, err := r.db.DB.ExecContext(ctx, `DELETE FROM used_action_links WHERE expires_at < $1`, time.Now()); err != nil {
+		return false, fmt.Errorf("failed to prune used action links: %w", err)
+	}
+
+	result, err := r.db.DB.ExecContext(ctx, `
+		INSERT INTO used_action_links (jti, expires_at)
+		VALUES ($1, $2)
+		ON CONFLICT (jti) DO NOTHING
+	`, jti, expiresAt)
+	if err != nil {
+		return false, fmt.Errorf("failed to claim action link: %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return false, fmt.Errorf("failed to get rows affected: %w", err)
+	}
+	return rowsAffected > 0, nil
+}
+
+// ReleaseActionLink makes the link with ID jti usable again, after its
+// action failed.
+func (r *repository) ReleaseActionLink(ctx context.Context, jti string) error {
+	if _, err := r.db.DB.ExecContext(ctx, `DELETE FROM used_action_links WHERE jti = $1`, jti); err != nil {
+		return fmt.Errorf("failed to release action link: %w", err)
+	}
+	return nil
+}
+
+// IsActionLinkUsed reports whether the action of the link with ID jti was
+// carried out.
+func (r *repository) IsActionLinkUsed(ctx context.Context, jti string) (bool, error) {
+	var used bool
+	err := r.db.DB.QueryRowContext(ctx, `SELECT EXISTS (SELECT 1 FROM used_action_links WHERE jti = $1)`, jti).Scan(&used)
+	if err != nil {
+		return false, fmt.Errorf("failed to check action link: %w", err)
+	}
+	return used, nil
+}
@@ -0,0 +1,142 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+)
+
+const announcementColumns = `id, title, body, kind, starts_at, ends_at, send_email, emailed_at, created_by, created_at`
+
+func scanAnnouncement(row interface{ Scan(...interface{}) error }) (*Announcement, error) {
+	var announcement Announcement
+	err := row.Scan(
+		&announcement.ID,
+		&announcement.Title,
+		&announcement.Body,
+		&announcement.Kind,
+		&announcement.StartsAt,
+		&announcement.EndsAt,
+		&announcement.SendEmail,
+		&announcement.EmailedAt,
+		&announcement.CreatedBy,
+		&announcement.CreatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return &announcement, nil
+}
+
+func (r *repository) CreateAnnouncement(ctx context.Context, announcement *Announcement) error {
+	query := `
+		INSERT INTO announcements (id, title, body, kind, starts_at, ends_at, send_email, created_by)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+		RETURNING created_at
+	`
+	err := r.db.DB.QueryRowContext(
+		ctx,
+		query,
+		announcement.ID,
+		announcement.Title,
+		announcement.Body,
+		announcement.Kind,
+		announcement.StartsAt,
+		announcement.EndsAt,
+		announcement.SendEmail,
+		announcement.CreatedBy,
+	).Scan(&announcement.CreatedAt)
+	if err != nil {
+		return fmt.Errorf("failed to create announcement: %w", err)
+	}
+	return nil
+}
+
+func (r *repository) GetAnnouncement(ctx context.Context, announcementID string) (*Announcement, error) {
+	query := `SELECT ` + announcementColumns + ` FROM announcements WHERE id = $1`
+	announcement, err := scanAnnouncement(r.db.DB.QueryRowContext(ctx, query, announcementID))
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, fmt.Errorf("announcement not found")
+		}
+		return nil, fmt.Errorf("failed to get announcement: %w", err)
+	}
+	return announcement, nil
+}
+
+// ListAnnouncements returns every announcement, newest first.
+func (r *repository) ListAnnouncements(ctx context.Context) ([]*Announcement, error) {
+	query := `SELECT ` + announcementColumns + ` FROM announcements ORDER BY starts_at DESC`
+	return r.queryAnnouncements(ctx, query)
+}
+
+// ListActiveAnnouncements returns the announcements that have started and
+// not yet ended, newest first.
+func (r *repository) ListActiveAnnouncements(ctx context.Context) ([]*Announcement, error) {
+	query := `
+		SELECT ` + announcementColumns + `
+		FROM announcements
+		WHERE starts_at <= NOW() AND (ends_at IS NULL OR ends_at > NOW())
+		ORDER BY starts_at DESC
+	`
+	return r.queryAnnouncements(ctx, query)
+}
+
+func (r *repository) queryAnnouncements(ctx context.Context, query string, args ...interface{}) ([]*Announcement, error) {
+	rows, err := r.db.DB.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list announcements: %w", err)
+	}
+	defer rows.Close()
+
+	var announcements []*Announcement
+	for rows.Next() {
+		announcement, err := scanAnnouncement(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan announcement: %w", err)
+		}
+		announcements = append(announcements, announcement)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("row iteration error: %w", err)
+	}
+	return announcements, nil
+}
+
+func (r *repository) DeleteAnnouncement(ctx context.Context, announcementID string) error {
+	result, err := r.db.DB.ExecContext(ctx, `DELETE FROM announcements WHERE id = $1`, announcementID)
+	if err != nil {
+		return fmt.Errorf("failed to delete announcement: %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to get rows affected: %w", err)
+	}
+	if rowsAffected == 0 {
+		return fmt.Errorf("announcement not found")
+	}
+	return nil
+}
+
+// ClaimAnnouncementEmail marks the announcement's email as sent and reports
+// whether this call did so, so it goes out at most once even if the send
+// task is retried.
+func (r *repository) ClaimAnnouncementEmail(ctx context.Context, announcementID string) (bool, error) {
+	query := `
+		UPDATE announcements
+		SET emailed_at = NOW()
+		WHERE id = $1 AND send_email AND emailed_at IS NULL
+	`
+	result, err := r.db.DB.ExecContext(ctx, query, announcementID)
+	if err != nil {
+		return false, fmt.Errorf("failed to claim announcement email: %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return false, fmt.Errorf("failed to get rows affected: %w", err)
+	}
+	return rowsAffected == 1, nil
+}
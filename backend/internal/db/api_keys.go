@@ -0,0 +1,122 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/lib/pq"
+)
+
+// apiKeyUseResolution is how often the last use of an API key is recorded,
+// so a busy script does not write on every request.
+const apiKeyUseResolution = time.Minute
+
+func (r *repository) CreateAPIKey(ctx context.Context, key *APIKey) error {
+	query := `
+		INSERT INTO api_keys (id, user_id, name, key_hash, prefix, scopes, expires_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7)
+		RETURNING created_at
+	`
+	err := r.db.DB.QueryRowContext(
+		ctx,
+		query,
+		key.ID,
+		key.UserID,
+		key.Name,
+		key.KeyHash,
+		key.Prefix,
+		pq.Array(key.Scopes),
+		key.ExpiresAt,
+	).Scan(&key.CreatedAt)
+	if err != nil {
+		return fmt.Errorf("failed to create api key: %w", err)
+	}
+	return nil
+}
+
+// ListAPIKeys returns the user's API keys, expired ones included, oldest
+// first.
+func (r *repository) ListAPIKeys(ctx context.Context, userID string) ([]*APIKey, error) {
+	query := `
+		SELECT id, user_id, name, prefix, scopes, expires_at, last_used_at, created_at
+		FROM api_keys
+		WHERE user_id = $1
+		ORDER BY created_at
+	`
+	rows, err := r.db.DB.QueryContext(ctx, query, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list api keys: %w", err)
+	}
+	defer rows.Close()
+
+	keys := []*APIKey{}
+	for rows.Next() {
+		var key APIKey
+		err := rows.Scan(
+			&key.ID,
+			&key.UserID,
+			&key.Name,
+			&key.Prefix,
+			pq.Array(&key.Scopes),
+			&key.ExpiresAt,
+			&key.LastUsedAt,
+			&key.CreatedAt,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan api key: %w", err)
+		}
+		keys = append(keys, &key)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("row iteration error: %w", err)
+	}
+	return keys, nil
+}
+
+// DeleteAPIKey revokes one of the user's API keys; it stops working at once.
+func (r *repository) DeleteAPIKey(ctx context.Context, userID, keyID string) error {
+	result, err := r.db.DB.ExecContext(ctx, `DELETE FROM api_keys WHERE id = $1 AND user_id = $2`, keyID, userID)
+	if err != nil {
+		return fmt.Errorf("failed to delete api key: %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to get rows affected: %w", err)
+	}
+	if rowsAffected == 0 {
+		return fmt.Errorf("api key not found")
+	}
+	return nil
+}
+
+// AuthenticateAPIKey returns the user and scopes of the unexpired API key
+// with keyHash, recording that it was used.
+func (r *repository) AuthenticateAPIKey(ctx context.Context, keyHash string) (string, []string, error) {
+	query := `
+		SELECT id, user_id, scopes
+		FROM api_keys
+		WHERE key_hash = $1 AND (expires_at IS NULL OR expires_at > NOW())
+	`
+	var keyID, userID string
+	var scopes []string
+	err := r.db.DB.QueryRowContext(ctx, query, keyHash).Scan(&keyID, &userID, pq.Array(&scopes))
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return "", nil, fmt.Errorf("api key not found")
+		}
+		return "", nil, fmt.Errorf("failed to get api key: %w", err)
+	}
+
+	_, err = r.db.DB.ExecContext(ctx, `
+		UPDATE api_keys SET last_used_at = NOW()
+		WHERE id = $1 AND (last_used_at IS NULL OR last_used_at < $2)
+	`, keyID, time.Now().Add(-apiKeyUseResolution))
+	if err != nil {
+		return "", nil, fmt.Errorf("failed to record api key use: %w", err)
+	}
+	return userID, scopes, nil
+}
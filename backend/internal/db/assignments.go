@@ -0,0 +1,87 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+)
+
+// AssignDocument makes assigneeID responsible for a document, replacing any
+// previous assignee. Reassigning clears the acknowledgement.
+func (r *repository) AssignDocument(ctx context.Context, documentID, assigneeID, assignedBy string) error {
+	_, err := r.conn(ctx).ExecContext(ctx, `
+		INSERT INTO document_assignments (document_id, assignee_id, assigned_by, assigned_at)
+		VALUES ($1, $2, $3, NOW())
+		ON CONFLICT (document_id) DO UPDATE
+		SET assignee_id = EXCLUDED.assignee_id,
+			assigned_by = EXCLUDED.assigned_by,
+			assigned_at = EXCLUDED.assigned_at,
+			acknowledged_at = NULL
+	`, documentID, assigneeID, assignedBy)
+	if err != nil {
+		return fmt.Errorf("failed to assign document: %w", err)
+	}
+	return nil
+}
+
+func (r *repository) GetDocumentAssignment(ctx context.Context, documentID string) (*DocumentAssignment, error) {
+	var assignment DocumentAssignment
+	err := r.conn(ctx).QueryRowContext(ctx, `
+		SELECT a.document_id, a.assignee_id, u.name, a.assigned_by, a.assigned_at, a.acknowledged_at
+		FROM document_assignments a
+		JOIN users u ON u.id = a.assignee_id
+		WHERE a.document_id = $1
+	`, documentID).Scan(
+		&assignment.DocumentID,
+		&assignment.AssigneeID,
+		&assignment.AssigneeName,
+		&assignment.AssignedBy,
+		&assignment.AssignedAt,
+		&assignment.AcknowledgedAt,
+	)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, fmt.Errorf("document assignment not found")
+		}
+		return nil, fmt.Errorf("failed to get document assignment: %w", err)
+	}
+	return &assignment, nil
+}
+
+func (r *repository) UnassignDocument(ctx context.Context, documentID string) error {
+	result, err := r.conn(ctx).ExecContext(ctx, `DELETE FROM document_assignments WHERE document_id = $1`, documentID)
+	if err != nil {
+		return fmt.Errorf("failed to unassign document: %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to get rows affected: %w", err)
+	}
+	if rowsAffected == 0 {
+		return fmt.Errorf("document assignment not found")
+	}
+	return nil
+}
+
+// AcknowledgeDocumentAssignment records that the assignee has seen the
+// document's latest reminder, which stops it escalating to an admin.
+func (r *repository) AcknowledgeDocumentAssignment(ctx context.Context, documentID, assigneeID string) error {
+	result, err := r.conn(ctx).ExecContext(ctx, `
+		UPDATE document_assignments
+		SET acknowledged_at = NOW()
+		WHERE document_id = $1 AND assignee_id = $2
+	`, documentID, assigneeID)
+	if err != nil {
+		return fmt.Errorf("failed to acknowledge document assignment: %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to get rows affected: %w", err)
+	}
+	if rowsAffected == 0 {
+		return fmt.Errorf("document assignment not found")
+	}
+	return nil
+}
@@ -0,0 +1,261 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+)
+
+// SetAttachmentStatus records the scan state of the document's attachment.
+// It only applies while the attachment is still attachmentURL, so a scan
+// finishing after a re-upload does not overwrite the new file's state.
+func (r *repository) SetAttachmentStatus(ctx context.Context, documentID, attachmentURL, status string) error {
+	query := `
+		UPDATE documents
+		SET attachment_status = $1,
+			attachment_threat = NULL,
+			attachment_scanned_at = CASE WHEN $1 IN ('clean', 'infected') THEN NOW() ELSE NULL END
+		WHERE id = $2 AND attachment_url = $3
+	`
+	result, err := r.conn(ctx).ExecContext(ctx, query, status, documentID, attachmentURL)
+	if err != nil {
+		return fmt.Errorf("failed to set attachment status: %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to get rows affected: %w", err)
+	}
+	if rowsAffected == 0 {
+		return fmt.Errorf("attachment not found")
+	}
+	return nil
+}
+
+// QuarantineAttachment marks the document's attachment as infected and
+// detaches it; the file itself has already been moved into quarantine. Other
+// documents sharing the file through its blob are detached along with it.
+func (r *repository) QuarantineAttachment(ctx context.Context, documentID, attachmentURL, threat string) error {
+	tx, err := r.conn(ctx).BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	query := `
+		UPDATE documents
+		SET attachment_status = 'infected', attachment_threat = $1, attachment_scanned_at = NOW(), attachment_url = NULL
+		WHERE attachment_url = $3
+			AND (id = $2 OR attachment_url IN (SELECT url FROM attachment_blobs))
+	`
+	result, err := tx.ExecContext(ctx, query, threat, documentID, attachmentURL)
+	if err != nil {
+		return fmt.Errorf("failed to quarantine attachment: %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to get rows affected: %w", err)
+	}
+	if rowsAffected == 0 {
+		return fmt.Errorf("attachment not found")
+	}
+
+	if _, err := tx.ExecContext(ctx, `DELETE FROM attachment_blobs WHERE url = $1`, attachmentURL); err != nil {
+		return fmt.Errorf("failed to quarantine attachment: %w", err)
+	}
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+	return nil
+}
+
+// GetAttachmentBlob returns the blob of userID's attachments with the given
+// contents.
+func (r *repository) GetAttachmentBlob(ctx context.Context, userID, checksum string) (*AttachmentBlob, error) {
+	query := `
+		SELECT user_id, checksum, url, size, created_at
+		FROM attachment_blobs
+		WHERE user_id = $1 AND checksum = $2
+	`
+	var blob AttachmentBlob
+	err := r.conn(ctx).QueryRowContext(ctx, query, userID, checksum).Scan(
+		&blob.UserID,
+		&blob.Checksum,
+		&blob.URL,
+		&blob.Size,
+		&blob.CreatedAt,
+	)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, fmt.Errorf("attachment blob not found")
+		}
+		return nil, fmt.Errorf("failed to get attachment blob: %w", err)
+	}
+	return &blob, nil
+}
+
+// CreateAttachmentBlob records the file just stored at blob.URL as the blob
+// of the owner's attachments with its contents. It returns the URL documents
+// should point at, which is blob.URL unless a concurrent upload of the same
+// file created the blob first.
+func (r *repository) CreateAttachmentBlob(ctx context.Context, blob *AttachmentBlob) (string, error) {
+	query := `
+		INSERT INTO attachment_blobs (user_id, checksum, url, size)
+		VALUES ($1, $2, $3, $4)
+		ON CONFLICT (user_id, checksum) DO UPDATE
+		SET checksum = excluded.checksum
+		RETURNING url
+	`
+	var url string
+	err := r.conn(ctx).QueryRowContext(ctx, query, blob.UserID, blob.Checksum, blob.URL, blob.Size).Scan(&url)
+	if err != nil {
+		return "", fmt.Errorf("failed to create attachment blob: %w", err)
+	}
+	return url, nil
+}
+
+// ReleaseAttachmentBlob drops documentID's reference to the attachment at
+// url and reports whether no other document, trashed ones included, still
+// refers to it, so the caller can delete the file. The blob goes along with
+// the last reference. Releasing the same reference twice is harmless.
+func (r *repository) ReleaseAttachmentBlob(ctx context.Context, url, documentID string) (bool, error) {
+	var references int
+	query := `SELECT COUNT(*) FROM documents WHERE attachment_url = $1 AND id <> $2`
+	if err := r.conn(ctx).QueryRowContext(ctx, query, url, documentID).Scan(&references); err != nil {
+		return false, fmt.Errorf("failed to count attachment references: %w", err)
+	}
+	if references > 0 {
+		return false, nil
+	}
+
+	if _, err := r.conn(ctx).ExecContext(ctx, `DELETE FROM attachment_blobs WHERE url = $1`, url); err != nil {
+		return false, fmt.Errorf("failed to release attachment blob: %w", err)
+	}
+	return true, nil
+}
+
+// ListDocumentsWithAttachmentsExpiredBefore returns the documents that
+// expired before the given day and still have an uploaded attachment,
+// ordered by ID and starting after afterID, for the retention job to page
+// through. Trashed documents are left to the trash purge, and documents
+// under legal hold keep their attachments.
+func (r *repository) ListDocumentsWithAttachmentsExpiredBefore(ctx context.Context, before time.Time, afterID string, limit int) ([]*Document, error) {
+	query := `
+		SELECT id, user_id, name, expiration_date, attachment_url
+		FROM documents
+		WHERE deleted_at IS NULL
+			AND attachment_url IS NOT NULL AND attachment_status IS NOT NULL
+			AND expiration_date < $1 AND id > $2 AND ` + notOnLegalHold + `
+		ORDER BY id
+		LIMIT $3
+	`
+	rows, err := r.conn(ctx).QueryContext(ctx, query, before, afterID, limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list documents with expired attachments: %w", err)
+	}
+	defer rows.Close()
+
+	var documents []*Document
+	for rows.Next() {
+		var doc Document
+		if err := rows.Scan(&doc.ID, &doc.UserID, &doc.Name, &doc.ExpirationDate, &doc.AttachmentURL); err != nil {
+			return nil, fmt.Errorf("failed to scan document: %w", err)
+		}
+		documents = append(documents, &doc)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("row iteration error: %w", err)
+	}
+	return documents, nil
+}
+
+// GetAttachmentRetentionWarning returns the retention warning sent for the
+// document's attachment.
+func (r *repository) GetAttachmentRetentionWarning(ctx context.Context, documentID string) (*AttachmentRetentionWarning, error) {
+	query := `
+		SELECT document_id, attachment_url, delete_after, warned_at
+		FROM attachment_retention_warnings
+		WHERE document_id = $1
+	`
+	var warning AttachmentRetentionWarning
+	err := r.conn(ctx).QueryRowContext(ctx, query, documentID).Scan(
+		&warning.DocumentID,
+		&warning.AttachmentURL,
+		&warning.DeleteAfter,
+		&warning.WarnedAt,
+	)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, fmt.Errorf("attachment retention warning not found")
+		}
+		return nil, fmt.Errorf("failed to get attachment retention warning: %w", err)
+	}
+	return &warning, nil
+}
+
+// CreateAttachmentRetentionWarning records that the owner was warned about
+// the deletion of the document's attachment, replacing a warning about an
+// attachment the document no longer has.
+func (r *repository) CreateAttachmentRetentionWarning(ctx context.Context, warning *AttachmentRetentionWarning) error {
+	query := `
+		INSERT INTO attachment_retention_warnings (document_id, attachment_url, delete_after)
+		VALUES ($1, $2, $3)
+		ON CONFLICT (document_id) DO UPDATE
+		SET attachment_url = EXCLUDED.attachment_url, delete_after = EXCLUDED.delete_after, warned_at = NOW()
+		RETURNING warned_at
+	`
+	err := r.conn(ctx).QueryRowContext(ctx, query, warning.DocumentID, warning.AttachmentURL, warning.DeleteAfter).Scan(&warning.WarnedAt)
+	if err != nil {
+		return fmt.Errorf("failed to create attachment retention warning: %w", err)
+	}
+	return nil
+}
+
+// RemoveAttachment detaches attachmentURL from the document once retention
+// deleted it, along with its scan state and retention warning.
+func (r *repository) RemoveAttachment(ctx context.Context, documentID, attachmentURL string) error {
+	tx, err := r.conn(ctx).BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	query := `
+		UPDATE documents
+		SET attachment_url = NULL, attachment_status = NULL, attachment_threat = NULL, attachment_scanned_at = NULL
+		WHERE id = $1 AND attachment_url = $2
+	`
+	result, err := tx.ExecContext(ctx, query, documentID, attachmentURL)
+	if err != nil {
+		return fmt.Errorf("failed to remove attachment: %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to get rows affected: %w", err)
+	}
+	if rowsAffected == 0 {
+		return fmt.Errorf("attachment not found")
+	}
+
+	if _, err := tx.ExecContext(ctx, `DELETE FROM attachment_retention_warnings WHERE document_id = $1`, documentID); err != nil {
+		return fmt.Errorf("failed to remove attachment: %w", err)
+	}
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+	return nil
+}
+
+// DeleteAttachmentRetentionWarning withdraws the retention warning of the
+// document's attachment, once its owner's plan keeps it after all.
+func (r *repository) DeleteAttachmentRetentionWarning(ctx context.Context, documentID string) error {
+	_, err := r.conn(ctx).ExecContext(ctx, `DELETE FROM attachment_retention_warnings WHERE document_id = $1`, documentID)
+	if err != nil {
+		return fmt.Errorf("failed to delete attachment retention warning: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,382 @@
+package db
+
+import (
+	"bufio"
+	"context"
+	"crypto/sha256"
+	"database/sql"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/lib/pq"
+)
+
+// backupTables lists the tables included in a logical backup, ordered so that
+// rows can be restored without violating foreign keys. Only the home database
+// is exported; data regions are backed up on their own.
+var backupTables = []string{
+	"users",
+	"organizations",
+	"organization_members",
+	"scim_tokens",
+	"email_senders",
+	"notification_themes",
+	"escalation_policy_steps",
+	"announcements",
+	"feedback",
+	"roadmap_features",
+	"roadmap_votes",
+	"notification_preferences",
+	"feed_tokens",
+	"saved_views",
+	"webhook_endpoints",
+	"households",
+	"household_members",
+	"reminder_intervals",
+	"document_categories",
+	"category_default_reminders",
+	"category_validity_periods",
+	"document_templates",
+	"document_template_fields",
+	"issuers",
+	"documents",
+	"document_counters",
+	"document_reminders",
+	"document_contacts",
+	"document_checklist_items",
+	"document_custom_fields",
+	"sample_documents",
+	"document_positions",
+	"document_dependencies",
+	"document_assignments",
+	"renewal_requests",
+	"held_reminders",
+	"lead_time_reminders",
+	"notification_logs",
+	"webhook_deliveries",
+	"audit_logs",
+	"event_log",
+}
+
+const (
+	// exportFormat names the format in the header of every export.
+	exportFormat = "xpired-export"
+	// exportVersion is the version of the export format itself. It only
+	// changes when the layout of the lines changes, not with the database
+	// schema, which the header records separately.
+	exportVersion = 1
+)
+
+// exportHeader is the first line of an export. It says which schema the rows
+// were read from, so that an export moves to a deployment at the same or a
+// newer schema version.
+type exportHeader struct {
+	Format        string    `json:"format"`
+	Version       int       `json:"version"`
+	SchemaVersion uint      `json:"schemaVersion"`
+	ExportedAt    time.Time `json:"exportedAt"`
+	Tables        []string  `json:"tables"`
+}
+
+type backupRecord struct {
+	Table string          `json:"table"`
+	Row   json.RawMessage `json:"row"`
+}
+
+// exportTrailer is the last line of an export. Its row counts let Import
+// tell a complete export from a truncated one.
+type exportTrailer struct {
+	End  bool           `json:"end"`
+	Rows map[string]int `json:"rows"`
+}
+
+// exportLine is any line of an export; which fields are set tells them apart.
+type exportLine struct {
+	exportHeader
+	backupRecord
+	exportTrailer
+}
+
+// schemaVersion returns the latest migration applied to the database.
+func schemaVersion(ctx context.Context, q interface {
+	QueryRowContext(ctx context.Context, query string, args ...interface{}) *sql.Row
+}) (uint, error) {
+	var version uint
+	var dirty bool
+	err := q.QueryRowContext(ctx, `SELECT version, dirty FROM schema_migrations LIMIT 1`).Scan(&version, &dirty)
+	if err != nil {
+		return 0, fmt.Errorf("failed to read schema version: %w", err)
+	}
+	if dirty {
+		return 0, fmt.Errorf("schema version %d is dirty, fix the failed migration first", version)
+	}
+	return version, nil
+}
+
+// Export streams every row of the backup tables to w as JSON lines: a header
+// naming the format and schema version, one line per row, and a trailer with
+// the row count of each table. All tables are read inside a single
+// repeatable-read transaction so the export is a consistent snapshot even
+// while the API keeps serving writes.
+func (db *DB) Export(ctx context.Context, w io.Writer) error {
+	if db.sqlite {
+		return fmt.Errorf("export is only supported on Postgres")
+	}
+
+	tx, err := db.DB.BeginTx(ctx, &sql.TxOptions{Isolation: sql.LevelRepeatableRead, ReadOnly: true})
+	if err != nil {
+		return fmt.Errorf("failed to begin export transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	version, err := schemaVersion(ctx, tx)
+	if err != nil {
+		return err
+	}
+
+	enc := json.NewEncoder(w)
+	header := exportHeader{
+		Format:        exportFormat,
+		Version:       exportVersion,
+		SchemaVersion: version,
+		ExportedAt:    time.Now().UTC(),
+		Tables:        backupTables,
+	}
+	if err := enc.Encode(header); err != nil {
+		return fmt.Errorf("failed to write export header: %w", err)
+	}
+
+	counts := make(map[string]int, len(backupTables))
+	for _, table := range backupTables {
+		query := fmt.Sprintf(`SELECT row_to_json(t) FROM %s t`, table)
+		rows, err := tx.QueryContext(ctx, query)
+		if err != nil {
+			return fmt.Errorf("failed to export %s: %w", table, err)
+		}
+
+		for rows.Next() {
+			var row []byte
+			if err := rows.Scan(&row); err != nil {
+				rows.Close()
+				return fmt.Errorf("failed to scan %s row: %w", table, err)
+			}
+			if err := enc.Encode(backupRecord{Table: table, Row: row}); err != nil {
+				rows.Close()
+				return fmt.Errorf("failed to write %s row: %w", table, err)
+			}
+			counts[table]++
+		}
+		if err := rows.Err(); err != nil {
+			rows.Close()
+			return fmt.Errorf("row iteration error: %w", err)
+		}
+		rows.Close()
+	}
+
+	if err := enc.Encode(exportTrailer{End: true, Rows: counts}); err != nil {
+		return fmt.Errorf("failed to write export trailer: %w", err)
+	}
+	return tx.Commit()
+}
+
+// Import restores rows produced by Export, possibly on another deployment.
+// The export must come from the same or an older schema version: columns
+// added since are left to their defaults. Existing rows with the same
+// primary key are left untouched, so importing the same file twice is
+// harmless. Nothing is imported unless the whole export is read intact.
+// Exports written before the header was introduced are still accepted.
+func (db *DB) Import(ctx context.Context, r io.Reader) (int, error) {
+	if db.sqlite {
+		return 0, fmt.Errorf("import is only supported on Postgres")
+	}
+
+	allowed := make(map[string]bool, len(backupTables))
+	for _, table := range backupTables {
+		allowed[table] = true
+	}
+
+	tx, err := db.DB.BeginTx(ctx, nil)
+	if err != nil {
+		return 0, fmt.Errorf("failed to begin import transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	localVersion, err := schemaVersion(ctx, tx)
+	if err != nil {
+		return 0, err
+	}
+
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 0, 64*1024), 16*1024*1024)
+
+	columns := map[string]map[string]bool{}
+	counts := map[string]int{}
+	var header *exportHeader
+	var trailer *exportTrailer
+	imported := 0
+	line := 0
+	for scanner.Scan() {
+		line++
+		if len(scanner.Bytes()) == 0 {
+			continue
+		}
+
+		var record exportLine
+		if err := json.Unmarshal(scanner.Bytes(), &record); err != nil {
+			return 0, fmt.Errorf("invalid record on line %d: %w", line, err)
+		}
+		if trailer != nil {
+			return 0, fmt.Errorf("unexpected record after the end of the export on line %d", line)
+		}
+
+		switch {
+		case record.Format != "":
+			if header != nil || len(counts) > 0 {
+				return 0, fmt.Errorf("unexpected export header on line %d", line)
+			}
+			if record.Format != exportFormat {
+				return 0, fmt.Errorf("unknown export format %q", record.Format)
+			}
+			if record.Version > exportVersion {
+				return 0, fmt.Errorf("export format version %d is newer than the supported version %d", record.Version, exportVersion)
+			}
+			if record.SchemaVersion > localVersion {
+				return 0, fmt.Errorf("export is from schema version %d, newer than this database's %d; upgrade this deployment first", record.SchemaVersion, localVersion)
+			}
+			header = &record.exportHeader
+			continue
+
+		case record.End:
+			if header == nil {
+				return 0, fmt.Errorf("unexpected end of export on line %d", line)
+			}
+			trailer = &record.exportTrailer
+			continue
+		}
+
+		if !allowed[record.Table] {
+			return 0, fmt.Errorf("unknown table %q on line %d", record.Table, line)
+		}
+		if columns[record.Table] == nil {
+			columns[record.Table], err = tableColumns(ctx, tx, record.Table)
+			if err != nil {
+				return 0, err
+			}
+		}
+
+		var fields map[string]json.RawMessage
+		if err := json.Unmarshal(record.Row, &fields); err != nil {
+			return 0, fmt.Errorf("invalid %s row on line %d: %w", record.Table, line, err)
+		}
+		if upgradeImportRow(record.Table, fields) {
+			if record.Row, err = json.Marshal(fields); err != nil {
+				return 0, fmt.Errorf("failed to upgrade %s row on line %d: %w", record.Table, line, err)
+			}
+		}
+		// only the exported columns are inserted, so columns an older export
+		// lacks get their defaults rather than NULL
+		names := make([]string, 0, len(fields))
+		for name := range fields {
+			if columns[record.Table][name] {
+				names = append(names, pq.QuoteIdentifier(name))
+			}
+		}
+		sort.Strings(names)
+		counts[record.Table]++
+		if len(names) == 0 {
+			continue
+		}
+
+		query := fmt.Sprintf(`
+			INSERT INTO %[1]s (%[2]s)
+			SELECT %[2]s FROM json_populate_record(NULL::%[1]s, $1)
+			ON CONFLICT DO NOTHING
+		`, record.Table, strings.Join(names, ", "))
+		result, err := tx.ExecContext(ctx, query, string(record.Row))
+		if err != nil {
+			return 0, fmt.Errorf("failed to import %s row on line %d: %w", record.Table, line, err)
+		}
+		affected, _ := result.RowsAffected()
+		imported += int(affected)
+	}
+	if err := scanner.Err(); err != nil {
+		return 0, fmt.Errorf("failed to read import: %w", err)
+	}
+
+	if header != nil {
+		if trailer == nil {
+			return 0, fmt.Errorf("export is incomplete: its end is missing")
+		}
+		for table, expected := range trailer.Rows {
+			if counts[table] != expected {
+				return 0, fmt.Errorf("export is incomplete: %s has %d of %d rows", table, counts[table], expected)
+			}
+		}
+	}
+
+	// reminder_intervals uses a serial key; keep the sequence ahead of restored ids.
+	_, err = tx.ExecContext(ctx, `
+		SELECT setval(pg_get_serial_sequence('reminder_intervals', 'id'), COALESCE(MAX(id), 1))
+		FROM reminder_intervals
+	`)
+	if err != nil {
+		return 0, fmt.Errorf("failed to reset reminder interval sequence: %w", err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return 0, fmt.Errorf("failed to commit import: %w", err)
+	}
+	return imported, nil
+}
+
+// upgradeImportRow brings a row of an export from an older schema to the
+// shape of this one, reporting whether it changed fields.
+func upgradeImportRow(table string, fields map[string]json.RawMessage) bool {
+	switch table {
+	case "scim_tokens":
+		// SCIM tokens were exported in plaintext before they were hashed
+		raw, ok := fields["token"]
+		if !ok {
+			return false
+		}
+		var token string
+		if err := json.Unmarshal(raw, &token); err != nil {
+			return false
+		}
+		sum := sha256.Sum256([]byte(token))
+		fields["token_hash"], _ = json.Marshal(hex.EncodeToString(sum[:]))
+		delete(fields, "token")
+		return true
+	}
+	return false
+}
+
+// tableColumns returns the names of the columns of table in this database.
+func tableColumns(ctx context.Context, tx *sql.Tx, table string) (map[string]bool, error) {
+	rows, err := tx.QueryContext(ctx, `
+		SELECT column_name FROM information_schema.columns
+		WHERE table_schema = current_schema() AND table_name = $1
+	`, table)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list %s columns: %w", table, err)
+	}
+	defer rows.Close()
+
+	columns := map[string]bool{}
+	for rows.Next() {
+		var name string
+		if err := rows.Scan(&name); err != nil {
+			return nil, fmt.Errorf("failed to scan %s column: %w", table, err)
+		}
+		columns[name] = true
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("row iteration error: %w", err)
+	}
+	return columns, nil
+}
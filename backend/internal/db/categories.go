@@ -0,0 +1,96 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/lib/pq"
+)
+
+const categoryQuery = `
+	SELECT c.slug, c.name,
+		COALESCE(array_agg(ri.id_label ORDER BY ri.days_before DESC) FILTER (WHERE ri.id IS NOT NULL), '{}')
+	FROM document_categories c
+	LEFT JOIN category_default_reminders cdr ON cdr.category_slug = c.slug
+	LEFT JOIN reminder_intervals ri ON ri.id = cdr.reminder_interval_id
+`
+
+func (r *repository) ListDocumentCategories(ctx context.Context) ([]*DocumentCategory, error) {
+	query := categoryQuery + `
+		GROUP BY c.slug, c.name
+		ORDER BY c.name
+	`
+	rows, err := r.db.DB.QueryContext(ctx, query)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list document categories: %w", err)
+	}
+	defer rows.Close()
+
+	var categories []*DocumentCategory
+	for rows.Next() {
+		var category DocumentCategory
+		err := rows.Scan(
+			&category.Slug,
+			&category.Name,
+			pq.Array(&category.DefaultReminders),
+		)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan document category: %w", err)
+		}
+		categories = append(categories, &category)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("row iteration error: %w", err)
+	}
+
+	return categories, nil
+}
+
+func (r *repository) GetDocumentCategory(ctx context.Context, slug string) (*DocumentCategory, error) {
+	query := categoryQuery + `
+		WHERE c.slug = $1
+		GROUP BY c.slug, c.name
+	`
+	var category DocumentCategory
+	err := r.db.DB.QueryRowContext(ctx, query, slug).Scan(
+		&category.Slug,
+		&category.Name,
+		pq.Array(&category.DefaultReminders),
+	)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, fmt.Errorf("document category not found")
+		}
+		return nil, fmt.Errorf("failed to get document category: %w", err)
+	}
+	return &category, nil
+}
+
+// GetValidityPeriod returns the typical validity for a category, preferring a
+// country-specific entry and falling back to the general default.
+func (r *repository) GetValidityPeriod(ctx context.Context, categorySlug, countryCode string) (*ValidityPeriod, error) {
+	query := `
+		SELECT id, category_slug, country_code, validity_months, notes
+		FROM category_validity_periods
+		WHERE category_slug = $1 AND (country_code IS NULL OR country_code = $2)
+		ORDER BY country_code NULLS LAST
+		LIMIT 1
+	`
+	var period ValidityPeriod
+	err := r.db.DB.QueryRowContext(ctx, query, categorySlug, countryCode).Scan(
+		&period.ID,
+		&period.CategorySlug,
+		&period.CountryCode,
+		&period.ValidityMonths,
+		&period.Notes,
+	)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, fmt.Errorf("validity period not found")
+		}
+		return nil, fmt.Errorf("failed to get validity period: %w", err)
+	}
+	return &period, nil
+}
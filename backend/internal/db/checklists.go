@@ -0,0 +1,147 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+)
+
+func (r *repository) ListChecklistItems(ctx context.Context, documentID string) ([]*ChecklistItem, error) {
+	query := `
+		SELECT id, document_id, position, title, done, done_at, created_at, updated_at
+		FROM document_checklist_items
+		WHERE document_id = $1
+		ORDER BY position, created_at
+	`
+	rows, err := r.conn(ctx).QueryContext(ctx, query, documentID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list checklist items: %w", err)
+	}
+	defer rows.Close()
+
+	var items []*ChecklistItem
+	for rows.Next() {
+		var item ChecklistItem
+		err := rows.Scan(
+			&item.ID,
+			&item.DocumentID,
+			&item.Position,
+			&item.Title,
+			&item.Done,
+			&item.DoneAt,
+			&item.CreatedAt,
+			&item.UpdatedAt,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan checklist item: %w", err)
+		}
+		items = append(items, &item)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("row iteration error: %w", err)
+	}
+
+	return items, nil
+}
+
+func (r *repository) GetChecklistItem(ctx context.Context, documentID, itemID string) (*ChecklistItem, error) {
+	query := `
+		SELECT id, document_id, position, title, done, done_at, created_at, updated_at
+		FROM document_checklist_items
+		WHERE id = $1 AND document_id = $2
+	`
+	var item ChecklistItem
+	err := r.conn(ctx).QueryRowContext(ctx, query, itemID, documentID).Scan(
+		&item.ID,
+		&item.DocumentID,
+		&item.Position,
+		&item.Title,
+		&item.Done,
+		&item.DoneAt,
+		&item.CreatedAt,
+		&item.UpdatedAt,
+	)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, fmt.Errorf("checklist item not found")
+		}
+		return nil, fmt.Errorf("failed to get checklist item: %w", err)
+	}
+	return &item, nil
+}
+
+// CreateChecklistItem appends the item to the end of the document's checklist.
+func (r *repository) CreateChecklistItem(ctx context.Context, item *ChecklistItem) error {
+	query := `
+		INSERT INTO document_checklist_items (id, document_id, position, title)
+		VALUES (
+			$1, $2,
+			(SELECT COALESCE(MAX(position) + 1, 0) FROM document_checklist_items WHERE document_id = $2),
+			$3
+		)
+		RETURNING position, done, done_at, created_at, updated_at
+	`
+	err := r.conn(ctx).QueryRowContext(ctx, query, item.ID, item.DocumentID, item.Title).Scan(
+		&item.Position,
+		&item.Done,
+		&item.DoneAt,
+		&item.CreatedAt,
+		&item.UpdatedAt,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to create checklist item: %w", err)
+	}
+	return nil
+}
+
+func (r *repository) UpdateChecklistItem(ctx context.Context, item *ChecklistItem) error {
+	query := `
+		UPDATE document_checklist_items
+		SET title = $1,
+			position = $2,
+			done = $3,
+			done_at = CASE WHEN $3 THEN COALESCE(done_at, NOW()) ELSE NULL END,
+			updated_at = NOW()
+		WHERE id = $4 AND document_id = $5
+		RETURNING done_at, updated_at
+	`
+	err := r.conn(ctx).QueryRowContext(
+		ctx,
+		query,
+		item.Title,
+		item.Position,
+		item.Done,
+		item.ID,
+		item.DocumentID,
+	).Scan(&item.DoneAt, &item.UpdatedAt)
+
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return fmt.Errorf("checklist item not found")
+		}
+		return fmt.Errorf("failed to update checklist item: %w", err)
+	}
+	return nil
+}
+
+func (r *repository) DeleteChecklistItem(ctx context.Context, documentID, itemID string) error {
+	query := `
+		DELETE FROM document_checklist_items
+		WHERE id = $1 AND document_id = $2
+	`
+	result, err := r.conn(ctx).ExecContext(ctx, query, itemID, documentID)
+	if err != nil {
+		return fmt.Errorf("failed to delete checklist item: %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to get rows affected: %w", err)
+	}
+	if rowsAffected == 0 {
+		return fmt.Errorf("checklist item not found")
+	}
+
+	return nil
+}
@@ -0,0 +1,40 @@
+package db
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+// ListRenewalCosts sums the renewal costs of userID's live documents expiring
+// in [from, to), in the organization of ctx or outside any organization, per
+// month of expiration and currency. Months are formatted YYYY-MM.
+func (r *repository) ListRenewalCosts(ctx context.Context, userID string, from, to time.Time) ([]*RenewalCostTotal, error) {
+	query := `
+		SELECT to_char(expiration_date, 'YYYY-MM') AS month, currency, SUM(renewal_cost), COUNT(*)
+		FROM documents
+		WHERE user_id = $1 AND organization_id IS NOT DISTINCT FROM $2 AND deleted_at IS NULL
+			AND renewal_cost IS NOT NULL AND currency IS NOT NULL
+			AND expiration_date >= $3::date AND expiration_date < $4::date
+		GROUP BY month, currency
+		ORDER BY month, currency
+	`
+	rows, err := r.readConn(ctx).QueryContext(ctx, query, userID, organizationFilter(ctx), from, to)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list renewal costs: %w", err)
+	}
+	defer rows.Close()
+
+	var totals []*RenewalCostTotal
+	for rows.Next() {
+		var total RenewalCostTotal
+		if err := rows.Scan(&total.Month, &total.Currency, &total.Total, &total.Documents); err != nil {
+			return nil, fmt.Errorf("failed to scan renewal cost: %w", err)
+		}
+		totals = append(totals, &total)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("row iteration error: %w", err)
+	}
+	return totals, nil
+}
@@ -0,0 +1,89 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// documentCounterKey is the document_counters bucket a live document counts
+// towards (see migration 020).
+type documentCounterKey struct {
+	UserID         uuid.UUID
+	OrganizationID *string
+	ExpirationDate time.Time
+}
+
+// sameDay reports whether t falls in the same day bucket as k.
+func (k documentCounterKey) sameDay(t time.Time) bool {
+	return k.ExpirationDate.UTC().Format(time.DateOnly) == t.UTC().Format(time.DateOnly)
+}
+
+// lockDocumentCounterKey locks the document for the rest of tx and returns
+// the bucket it counts towards. trashed selects trashed rather than live
+// documents.
+func lockDocumentCounterKey(ctx context.Context, tx *sql.Tx, documentID uuid.UUID, trashed bool) (*documentCounterKey, error) {
+	query := `
+		SELECT user_id, organization_id, expiration_date
+		FROM documents
+		WHERE id = $1 AND (deleted_at IS NOT NULL) = $2
+		FOR UPDATE
+	`
+	var key documentCounterKey
+	err := tx.QueryRowContext(ctx, query, documentID, trashed).Scan(&key.UserID, &key.OrganizationID, &key.ExpirationDate)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, fmt.Errorf("document not found")
+		}
+		return nil, fmt.Errorf("failed to get document: %w", err)
+	}
+	return &key, nil
+}
+
+// utcDay returns midnight UTC of the UTC day of t.
+func utcDay(t time.Time) time.Time {
+	year, month, day := t.UTC().Date()
+	return time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+}
+
+// adjustDocumentCounter adds delta to the bucket of key within tx.
+func adjustDocumentCounter(ctx context.Context, tx *sql.Tx, key documentCounterKey, delta int) error {
+	query := `
+		INSERT INTO document_counters (user_id, organization_id, expiration_day, documents)
+		VALUES ($1, $2, $3::date, $4)
+		ON CONFLICT (user_id, COALESCE(organization_id, '00000000-0000-0000-0000-000000000000'::uuid), expiration_day)
+		DO UPDATE SET documents = document_counters.documents + EXCLUDED.documents
+	`
+	if _, err := tx.ExecContext(ctx, query, key.UserID, key.OrganizationID, utcDay(key.ExpirationDate), delta); err != nil {
+		return fmt.Errorf("failed to update document counters: %w", err)
+	}
+	return nil
+}
+
+// GetDocumentStats counts userID's live documents in the organization of
+// ctx, or their personal ones outside an organization. Days are UTC, so a
+// document counts as expired from the day after its expiration date.
+func (r *repository) GetDocumentStats(ctx context.Context, userID string) (*DocumentStats, error) {
+	query := `
+		SELECT
+			COALESCE(SUM(documents), 0),
+			COALESCE(SUM(documents) FILTER (WHERE expiration_day < $3::date), 0),
+			COALESCE(SUM(documents) FILTER (WHERE expiration_day >= $3::date AND expiration_day < $4::date), 0)
+		FROM document_counters
+		WHERE user_id = $1 AND organization_id IS NOT DISTINCT FROM $2
+	`
+	today := utcDay(time.Now())
+	var stats DocumentStats
+	err := r.readConn(ctx).QueryRowContext(ctx, query, userID, organizationFilter(ctx), today, today.AddDate(0, 0, 30)).Scan(
+		&stats.Total,
+		&stats.Expired,
+		&stats.ExpiringIn30Days,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get document stats: %w", err)
+	}
+	return &stats, nil
+}
@@ -1,47 +1,121 @@
 package db
 
 import (
+	"context"
 	"database/sql"
 	"fmt"
 	"log"
+	"sort"
+	"time"
+
+	"xpired/internal/fieldcrypt"
 
 	"github.com/golang-migrate/migrate/v4"
 	"github.com/golang-migrate/migrate/v4/database/postgres"
 	_ "github.com/golang-migrate/migrate/v4/source/file"
-	_ "github.com/lib/pq"
 )
 
 type DB struct {
 	*sql.DB
+	// fields encrypts sensitive columns; nil stores them as plaintext.
+	fields *fieldcrypt.Cipher
+	// regions are the regional databases organizations can pin their
+	// documents to, by region name.
+	regions map[string]*DB
+	// replica is a read-only copy of db that list and search queries use;
+	// nil sends them to db itself.
+	replica *DB
+	// sqlite is set for a SQLite database rather than Postgres.
+	sqlite bool
 }
 
+// Database drivers Config.Driver selects between.
+const (
+	DriverPostgres = "postgres"
+	DriverSQLite   = "sqlite"
+)
+
 type Config struct {
+	// Driver is DriverPostgres, or DriverSQLite to keep everything in the
+	// single file at SQLitePath, for self-hosting without Postgres.
+	Driver     string
+	SQLitePath string
+
 	Host     string
 	Port     string
 	User     string
 	Password string
 	DBName   string
 	SSLMode  string
+	// ConnectAttempts is how many times Connect tries to reach the database
+	// before giving up; values below 1 mean a single attempt.
+	ConnectAttempts int
+	// ReplicaURL is the postgres:// URL of a read replica for list and
+	// search queries. Empty sends all queries to the primary.
+	ReplicaURL string
+	// MaxOpenConns caps the connections kept open to each database; 0
+	// leaves them unlimited.
+	MaxOpenConns int
 }
 
+// maxConnectBackoff caps the wait between connection attempts.
+const maxConnectBackoff = 30 * time.Second
+
 func NewConnection(config Config) (*DB, error) {
 	dsn := fmt.Sprintf("host=%s port=%s user=%s password=%s dbname=%s sslmode=%s",
 		config.Host, config.Port, config.User, config.Password, config.DBName, config.SSLMode)
 
-	db, err := sql.Open("postgres", dsn)
+	return open(dsn)
+}
+
+// NewConnectionURL connects to the database at a postgres:// URL.
+func NewConnectionURL(url string) (*DB, error) {
+	return open(url)
+}
+
+// Connect calls connect until it succeeds, up to attempts times, doubling the
+// wait between attempts from one second. It lets the server start before a
+// slower database, as in docker-compose.
+func Connect(ctx context.Context, attempts int, connect func() (*DB, error)) (*DB, error) {
+	backoff := time.Second
+	for attempt := 1; ; attempt++ {
+		db, err := connect()
+		if err == nil || attempt >= attempts {
+			return db, err
+		}
+
+		log.Printf("Database not ready (attempt %d of %d): %v; retrying in %s", attempt, attempts, err, backoff)
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-time.After(backoff):
+		}
+		backoff = min(backoff*2, maxConnectBackoff)
+	}
+}
+
+func open(dsn string) (*DB, error) {
+	connector, err := newTenantConnector(dsn)
 	if err != nil {
 		return nil, fmt.Errorf("error opening database: %w", err)
 	}
+	db := sql.OpenDB(connector)
 
 	if err := db.Ping(); err != nil {
 		return nil, fmt.Errorf("error connecting to database: %w", err)
 	}
 
 	log.Println("Successfully connected to database")
-	return &DB{db}, nil
+	return &DB{DB: db}, nil
 }
 
+// RunMigrations brings the schema up to date. SQLite databases take the
+// migrations in the sqlite subdirectory of migrationsPath instead.
 func (db *DB) RunMigrations(migrationsPath string) error {
+	if db.sqlite {
+		return db.runSQLiteMigrations(migrationsPath + "/sqlite")
+	}
+
 	driver, err := postgres.WithInstance(db.DB, &postgres.Config{})
 	if err != nil {
 		return fmt.Errorf("could not create postgres driver: %w", err)
@@ -64,6 +138,90 @@ func (db *DB) RunMigrations(migrationsPath string) error {
 	return nil
 }
 
+// Driver returns DriverPostgres or DriverSQLite.
+func (db *DB) Driver() string {
+	if db.sqlite {
+		return DriverSQLite
+	}
+	return DriverPostgres
+}
+
+// SetFieldCipher enables encryption of sensitive columns, such as document
+// identifiers, for every repository built on db.
+func (db *DB) SetFieldCipher(c *fieldcrypt.Cipher) {
+	db.fields = c
+	for _, regional := range db.regions {
+		regional.fields = c
+	}
+}
+
+// AddRegion registers the database holding documents of organizations pinned
+// to region. It shares db's field cipher.
+func (db *DB) AddRegion(region string, regional *DB) {
+	if db.regions == nil {
+		db.regions = map[string]*DB{}
+	}
+	regional.fields = db.fields
+	db.regions[region] = regional
+}
+
+// SetReplica sends db's list and search queries to replica. Replica lag
+// means a document may show up in lists a moment after it is written.
+func (db *DB) SetReplica(replica *DB) {
+	db.replica = replica
+}
+
+// SetPoolSize caps the open connections of db, its replica and every
+// regional database at n each; 0 leaves them unlimited. Call it once they
+// are all added.
+func (db *DB) SetPoolSize(n int) {
+	db.SetMaxOpenConns(n)
+	if db.replica != nil {
+		db.replica.SetMaxOpenConns(n)
+	}
+	for _, regional := range db.regions {
+		regional.SetMaxOpenConns(n)
+	}
+}
+
+// reader is the connection pool for read-only queries: the replica when one
+// is configured, db otherwise.
+func (db *DB) reader() *sql.DB {
+	if db.replica != nil {
+		return db.replica.DB
+	}
+	return db.DB
+}
+
+// Regions returns the names of the configured data regions.
+func (db *DB) Regions() []string {
+	regions := make([]string, 0, len(db.regions))
+	for region := range db.regions {
+		regions = append(regions, region)
+	}
+	sort.Strings(regions)
+	return regions
+}
+
+// PingAll checks that db and every regional database are reachable.
+func (db *DB) PingAll(ctx context.Context) error {
+	if err := db.PingContext(ctx); err != nil {
+		return err
+	}
+	for _, region := range db.Regions() {
+		if err := db.regions[region].PingContext(ctx); err != nil {
+			return fmt.Errorf("data region %s: %w", region, err)
+		}
+	}
+	return nil
+}
+
 func (db *DB) Close() error {
+	for _, regional := range db.regions {
+		regional.Close()
+	}
+	if db.replica != nil {
+		db.replica.Close()
+	}
 	return db.DB.Close()
 }
@@ -0,0 +1,139 @@
+package db
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/lib/pq"
+)
+
+// AddDocumentDependency records that documentID depends on dependsOnID.
+// Adding an existing dependency is a no-op; one that would close a cycle is
+// refused with "dependency cycle".
+func (r *repository) AddDocumentDependency(ctx context.Context, documentID, dependsOnID string) error {
+	tx, err := r.conn(ctx).BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	// serialize changes to the graph so concurrent additions cannot form a cycle together
+	if _, err := tx.ExecContext(ctx, `LOCK TABLE document_dependencies IN SHARE ROW EXCLUSIVE MODE`); err != nil {
+		return fmt.Errorf("failed to lock document dependencies: %w", err)
+	}
+
+	query := `
+		WITH RECURSIVE upstream(id) AS (
+			SELECT $2::uuid
+			UNION
+			SELECT d.depends_on_id
+			FROM document_dependencies d
+			JOIN upstream u ON d.document_id = u.id
+		)
+		SELECT EXISTS (SELECT 1 FROM upstream WHERE id = $1)
+	`
+	var cycle bool
+	if err := tx.QueryRowContext(ctx, query, documentID, dependsOnID).Scan(&cycle); err != nil {
+		return fmt.Errorf("failed to check document dependencies: %w", err)
+	}
+	if cycle {
+		return fmt.Errorf("dependency cycle")
+	}
+
+	query = `
+		INSERT INTO document_dependencies (document_id, depends_on_id)
+		VALUES ($1, $2)
+		ON CONFLICT DO NOTHING
+	`
+	if _, err := tx.ExecContext(ctx, query, documentID, dependsOnID); err != nil {
+		return fmt.Errorf("failed to add document dependency: %w", err)
+	}
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+	return nil
+}
+
+func (r *repository) RemoveDocumentDependency(ctx context.Context, documentID, dependsOnID string) error {
+	query := `
+		DELETE FROM document_dependencies
+		WHERE document_id = $1 AND depends_on_id = $2
+	`
+	result, err := r.conn(ctx).ExecContext(ctx, query, documentID, dependsOnID)
+	if err != nil {
+		return fmt.Errorf("failed to remove document dependency: %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to get rows affected: %w", err)
+	}
+	if rowsAffected == 0 {
+		return fmt.Errorf("dependency not found")
+	}
+	return nil
+}
+
+// ListDocumentDependencies returns the live documents documentID directly
+// depends on.
+func (r *repository) ListDocumentDependencies(ctx context.Context, documentID string) ([]*Document, error) {
+	query := `
+		SELECT ` + documentColumns + `
+		FROM documents
+		WHERE id IN (SELECT depends_on_id FROM document_dependencies WHERE document_id = $1)
+			AND deleted_at IS NULL
+		ORDER BY expiration_date, name
+	`
+	return r.queryDocuments(ctx, query, documentID)
+}
+
+// ListDocumentDependents returns the live documents that depend on
+// documentID, directly or through other documents, soonest expiring first.
+func (r *repository) ListDocumentDependents(ctx context.Context, documentID string) ([]*Document, error) {
+	query := `
+		WITH RECURSIVE downstream(id) AS (
+			SELECT document_id FROM document_dependencies WHERE depends_on_id = $1
+			UNION
+			SELECT d.document_id
+			FROM document_dependencies d
+			JOIN downstream s ON d.depends_on_id = s.id
+		)
+		SELECT ` + documentColumns + `
+		FROM documents
+		WHERE id IN (SELECT id FROM downstream) AND id <> $1
+			AND deleted_at IS NULL
+		ORDER BY expiration_date, name
+	`
+	return r.queryDocuments(ctx, query, documentID)
+}
+
+// ListDocumentDependencyEdges returns the dependencies between the given
+// documents, for drawing their dependency graph.
+func (r *repository) ListDocumentDependencyEdges(ctx context.Context, documentIDs []string) ([]*DocumentDependency, error) {
+	query := `
+		SELECT document_id, depends_on_id, created_at
+		FROM document_dependencies
+		WHERE document_id = ANY($1::uuid[]) AND depends_on_id = ANY($1::uuid[])
+		ORDER BY created_at
+	`
+	rows, err := r.readConn(ctx).QueryContext(ctx, query, pq.Array(documentIDs))
+	if err != nil {
+		return nil, fmt.Errorf("failed to list document dependencies: %w", err)
+	}
+	defer rows.Close()
+
+	var edges []*DocumentDependency
+	for rows.Next() {
+		var edge DocumentDependency
+		if err := rows.Scan(&edge.DocumentID, &edge.DependsOnID, &edge.CreatedAt); err != nil {
+			return nil, fmt.Errorf("failed to scan document dependency: %w", err)
+		}
+		edges = append(edges, &edge)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("row iteration error: %w", err)
+	}
+
+	return edges, nil
+}
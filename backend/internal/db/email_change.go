@@ -0,0 +1,67 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/lib/pq"
+)
+
+// GetPendingEmail returns the address the user asked to switch to, or nil
+// when no change is waiting for confirmation.
+func (r *repository) GetPendingEmail(ctx context.Context, userID string) (*string, error) {
+	var email *string
+	err := r.db.DB.QueryRowContext(ctx, `SELECT pending_email FROM users WHERE id = $1`, userID).Scan(&email)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, fmt.Errorf("user does not exist")
+		}
+		return nil, fmt.Errorf("failed to get pending email: %w", err)
+	}
+	return email, nil
+}
+
+// SetPendingEmail records that the user asked to switch to email, replacing
+// any change still waiting for confirmation.
+func (r *repository) SetPendingEmail(ctx context.Context, userID, email string) error {
+	result, err := r.db.DB.ExecContext(ctx, `UPDATE users SET pending_email = $1, updated_at = NOW() WHERE id = $2`, email, userID)
+	if err != nil {
+		return fmt.Errorf("failed to set pending email: %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to get rows affected: %w", err)
+	}
+	if rowsAffected == 0 {
+		return fmt.Errorf("user does not exist")
+	}
+	return nil
+}
+
+// ConfirmEmailChange switches the user to email, when that is the change
+// waiting for confirmation. A change since replaced by another, or already
+// confirmed, is not found.
+func (r *repository) ConfirmEmailChange(ctx context.Context, userID, email string) error {
+	result, err := r.db.DB.ExecContext(ctx, `
+		UPDATE users
+		SET email = pending_email, pending_email = NULL, updated_at = NOW()
+		WHERE id = $1 AND pending_email = $2
+	`, userID, email)
+	if err != nil {
+		if pqErr, ok := err.(*pq.Error); ok && pqErr.Code == "23505" {
+			return fmt.Errorf("email already in use")
+		}
+		return fmt.Errorf("failed to confirm email change: %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to get rows affected: %w", err)
+	}
+	if rowsAffected == 0 {
+		return fmt.Errorf("email change not found")
+	}
+	return nil
+}
@@ -0,0 +1,94 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+)
+
+// SetEmailSender creates or updates the organization's email sender. Changing
+// the address to another domain issues sender.VerificationToken as the new
+// token and drops the verification; otherwise the existing token and
+// verification are kept and copied into sender.
+func (r *repository) SetEmailSender(ctx context.Context, sender *EmailSender) error {
+	query := `
+		INSERT INTO email_senders (organization_id, from_name, from_address, verification_token)
+		VALUES ($1, $2, $3, $4)
+		ON CONFLICT (organization_id) DO UPDATE
+		SET from_name = EXCLUDED.from_name,
+			from_address = EXCLUDED.from_address,
+			verification_token = CASE
+				WHEN split_part(email_senders.from_address, '@', 2) = split_part(EXCLUDED.from_address, '@', 2)
+				THEN email_senders.verification_token
+				ELSE EXCLUDED.verification_token
+			END,
+			verified_at = CASE
+				WHEN split_part(email_senders.from_address, '@', 2) = split_part(EXCLUDED.from_address, '@', 2)
+				THEN email_senders.verified_at
+			END,
+			checked_at = CASE
+				WHEN split_part(email_senders.from_address, '@', 2) = split_part(EXCLUDED.from_address, '@', 2)
+				THEN email_senders.checked_at
+			END,
+			updated_at = NOW()
+		RETURNING verification_token, verified_at, checked_at, updated_at
+	`
+	err := r.db.DB.QueryRowContext(ctx, query,
+		sender.OrganizationID,
+		sender.FromName,
+		sender.FromAddress,
+		sender.VerificationToken,
+	).Scan(&sender.VerificationToken, &sender.VerifiedAt, &sender.CheckedAt, &sender.UpdatedAt)
+	if err != nil {
+		return fmt.Errorf("failed to set email sender: %w", err)
+	}
+	return nil
+}
+
+func (r *repository) GetEmailSender(ctx context.Context, organizationID string) (*EmailSender, error) {
+	query := `
+		SELECT organization_id, from_name, from_address, verification_token, verified_at, checked_at, updated_at
+		FROM email_senders
+		WHERE organization_id = $1
+	`
+	var sender EmailSender
+	err := r.db.DB.QueryRowContext(ctx, query, organizationID).Scan(
+		&sender.OrganizationID,
+		&sender.FromName,
+		&sender.FromAddress,
+		&sender.VerificationToken,
+		&sender.VerifiedAt,
+		&sender.CheckedAt,
+		&sender.UpdatedAt,
+	)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, fmt.Errorf("email sender not found")
+		}
+		return nil, fmt.Errorf("failed to get email sender: %w", err)
+	}
+	return &sender, nil
+}
+
+func (r *repository) DeleteEmailSender(ctx context.Context, organizationID string) error {
+	if _, err := r.db.DB.ExecContext(ctx, `DELETE FROM email_senders WHERE organization_id = $1`, organizationID); err != nil {
+		return fmt.Errorf("failed to delete email sender: %w", err)
+	}
+	return nil
+}
+
+// RecordEmailSenderCheck stores the outcome of looking up the verification
+// record of token. A record that disappears unverifies the sender. Checks of
+// a token that was replaced meanwhile are ignored.
+func (r *repository) RecordEmailSenderCheck(ctx context.Context, organizationID, token string, verified bool) error {
+	query := `
+		UPDATE email_senders
+		SET checked_at = NOW(),
+			verified_at = CASE WHEN $3 THEN COALESCE(verified_at, NOW()) END
+		WHERE organization_id = $1 AND verification_token = $2
+	`
+	if _, err := r.db.DB.ExecContext(ctx, query, organizationID, token, verified); err != nil {
+		return fmt.Errorf("failed to record email sender check: %w", err)
+	}
+	return nil
+}
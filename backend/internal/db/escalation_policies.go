@@ -0,0 +1,64 @@
+package db
+
+import (
+	"context"
+	"fmt"
+)
+
+// GetEscalationPolicy returns an organization's escalation chain, earliest
+// step (most days before expiration) first. It is empty when the
+// organization has none.
+func (r *repository) GetEscalationPolicy(ctx context.Context, organizationID string) ([]*EscalationStep, error) {
+	rows, err := r.db.DB.QueryContext(ctx, `
+		SELECT days_before, audience
+		FROM escalation_policy_steps
+		WHERE organization_id = $1
+		ORDER BY days_before DESC
+	`, organizationID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get escalation policy: %w", err)
+	}
+	defer rows.Close()
+
+	steps := []*EscalationStep{}
+	for rows.Next() {
+		var step EscalationStep
+		if err := rows.Scan(&step.DaysBefore, &step.Audience); err != nil {
+			return nil, fmt.Errorf("failed to scan escalation step: %w", err)
+		}
+		steps = append(steps, &step)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("row iteration error: %w", err)
+	}
+	return steps, nil
+}
+
+// SetEscalationPolicy replaces an organization's escalation chain. No steps
+// removes it.
+func (r *repository) SetEscalationPolicy(ctx context.Context, organizationID string, steps []*EscalationStep) error {
+	tx, err := r.db.DB.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	if _, err := tx.ExecContext(ctx, `DELETE FROM escalation_policy_steps WHERE organization_id = $1`, organizationID); err != nil {
+		return fmt.Errorf("failed to clear escalation policy: %w", err)
+	}
+	for _, step := range steps {
+		_, err := tx.ExecContext(ctx, `
+			INSERT INTO escalation_policy_steps (organization_id, days_before, audience)
+			VALUES ($1, $2, $3)
+		`, organizationID, step.DaysBefore, step.Audience)
+		if err != nil {
+			return fmt.Errorf("failed to save escalation step: %w", err)
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,251 @@
+package db
+
+import (
+	"context"
+	"crypto/sha256"
+	"database/sql"
+	"encoding/hex"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// EventLogGenesisHash is the prevHash of the first entry of every chain.
+var EventLogGenesisHash = strings.Repeat("0", 64)
+
+// EventLogHashAlgorithm describes how ComputeHash derives an entry's hash, for
+// whoever verifies an exported log without this code.
+const EventLogHashAlgorithm = `hex(sha256(prevHash + "\n" + organizationId + "\n" + seq + "\n" + eventType + "\n" + documentId + "\n" + actorId + "\n" + recipientId + "\n" + channel + "\n" + detail + "\n" + occurredAt)), with absent fields as empty strings and occurredAt in RFC 3339 UTC with microseconds`
+
+func optional(s *string) string {
+	if s == nil {
+		return ""
+	}
+	return *s
+}
+
+// ComputeHash returns the hash of e chained onto e.PrevHash.
+func (e *EventLogEntry) ComputeHash() string {
+	fields := []string{
+		e.PrevHash,
+		e.OrganizationID,
+		strconv.FormatInt(e.Seq, 10),
+		e.EventType,
+		e.DocumentID,
+		optional(e.ActorID),
+		optional(e.RecipientID),
+		optional(e.Channel),
+		e.Detail,
+		e.OccurredAt.UTC().Format("2006-01-02T15:04:05.000000Z07:00"),
+	}
+	sum := sha256.Sum256([]byte(strings.Join(fields, "\n")))
+	return hex.EncodeToString(sum[:])
+}
+
+// VerifyEventChain checks that entries, in sequence order from the start of
+// an organization's log, form an unbroken chain onto the entry anchorSeq
+// whose hash is anchorHash: the last entry pruned by retention, or 0 and
+// EventLogGenesisHash. It returns the sequence number of the first entry
+// that does not, or 0 when the chain is intact.
+func VerifyEventChain(entries []*EventLogEntry, anchorSeq int64, anchorHash string) int64 {
+	prevHash := anchorHash
+	for i, entry := range entries {
+		seq := anchorSeq + int64(i+1)
+		if entry.Seq != seq || entry.PrevHash != prevHash || entry.ComputeHash() != entry.Hash {
+			return seq
+		}
+		prevHash = entry.Hash
+	}
+	return 0
+}
+
+// AppendEvent adds entry to the end of its organization's event log, filling
+// in its sequence number, timestamp and hashes. Appends to the same
+// organization are serialized so the chain never forks.
+func (r *repository) AppendEvent(ctx context.Context, entry *EventLogEntry) error {
+	tx, err := r.conn(ctx).BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	if _, err := tx.ExecContext(ctx, `SELECT pg_advisory_xact_lock(hashtext('event_log:' || $1))`, entry.OrganizationID); err != nil {
+		return fmt.Errorf("failed to lock event log: %w", err)
+	}
+
+	var lastSeq int64
+	var lastHash string
+	err = tx.QueryRowContext(ctx, `
+		SELECT seq, hash FROM event_log
+		WHERE organization_id = $1
+		ORDER BY seq DESC
+		LIMIT 1
+	`, entry.OrganizationID).Scan(&lastSeq, &lastHash)
+	if err == sql.ErrNoRows {
+		// a log retention emptied chains onto the last entry it pruned
+		lastSeq, lastHash, err = eventLogAnchor(ctx, tx, entry.OrganizationID)
+	}
+	if err != nil {
+		return fmt.Errorf("failed to read event log head: %w", err)
+	}
+	entry.Seq, entry.PrevHash = lastSeq+1, lastHash
+
+	if entry.Detail == "" {
+		entry.Detail = "{}"
+	}
+	// Postgres keeps microseconds; hash exactly what is stored.
+	entry.OccurredAt = time.Now().UTC().Truncate(time.Microsecond)
+	entry.Hash = entry.ComputeHash()
+
+	_, err = tx.ExecContext(ctx, `
+		INSERT INTO event_log (
+			organization_id, seq, event_type, document_id, actor_id, recipient_id,
+			channel, detail, occurred_at, prev_hash, hash
+		) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11)
+	`, entry.OrganizationID, entry.Seq, entry.EventType, entry.DocumentID, entry.ActorID, entry.RecipientID,
+		entry.Channel, entry.Detail, entry.OccurredAt, entry.PrevHash, entry.Hash)
+	if err != nil {
+		return fmt.Errorf("failed to append event: %w", err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+	return nil
+}
+
+// eventLogAnchor returns the sequence number and hash of the last entry of
+// an organization's event log pruned by retention, or 0 and
+// EventLogGenesisHash when none was.
+func eventLogAnchor(ctx context.Context, q interface {
+	QueryRowContext(ctx context.Context, query string, args ...interface{}) *sql.Row
+}, organizationID string) (int64, string, error) {
+	var seq int64
+	var hash string
+	err := q.QueryRowContext(ctx, `
+		SELECT through_seq, through_hash FROM event_log_prunes WHERE organization_id = $1
+	`, organizationID).Scan(&seq, &hash)
+	if err == sql.ErrNoRows {
+		return 0, EventLogGenesisHash, nil
+	}
+	if err != nil {
+		return 0, "", err
+	}
+	return seq, hash, nil
+}
+
+// GetEventLogAnchor returns what the retained event log of an organization
+// chains onto: the sequence number and hash of the last entry retention
+// pruned, or 0 and EventLogGenesisHash.
+func (r *repository) GetEventLogAnchor(ctx context.Context, organizationID string) (int64, string, error) {
+	seq, hash, err := eventLogAnchor(ctx, r.conn(ctx), organizationID)
+	if err != nil {
+		return 0, "", fmt.Errorf("failed to get event log anchor: %w", err)
+	}
+	return seq, hash, nil
+}
+
+// PruneEventsBefore deletes the entries of an organization's event log
+// recorded before cutoff, returning how many it deleted. The hash of the
+// last of them is kept to anchor the rest of the chain.
+func (r *repository) PruneEventsBefore(ctx context.Context, organizationID string, cutoff time.Time) (int64, error) {
+	tx, err := r.conn(ctx).BeginTx(ctx, nil)
+	if err != nil {
+		return 0, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	if _, err := tx.ExecContext(ctx, `SELECT pg_advisory_xact_lock(hashtext('event_log:' || $1))`, organizationID); err != nil {
+		return 0, fmt.Errorf("failed to lock event log: %w", err)
+	}
+
+	var throughSeq int64
+	var throughHash string
+	// pruning stops short of the first entry about a document under legal
+	// hold, since entries past it cannot go without breaking the chain
+	err = tx.QueryRowContext(ctx, `
+		SELECT seq, hash FROM event_log
+		WHERE organization_id = $1 AND occurred_at < $2 AND seq < COALESCE((
+			SELECT MIN(e.seq) FROM event_log e
+			JOIN legal_holds h ON h.organization_id = e.organization_id
+				AND (h.document_id IS NULL OR h.document_id = e.document_id)
+			WHERE e.organization_id = $1
+		), seq + 1)
+		ORDER BY seq DESC
+		LIMIT 1
+	`, organizationID, cutoff).Scan(&throughSeq, &throughHash)
+	if err == sql.ErrNoRows {
+		return 0, nil
+	}
+	if err != nil {
+		return 0, fmt.Errorf("failed to find prunable events: %w", err)
+	}
+
+	_, err = tx.ExecContext(ctx, `
+		INSERT INTO event_log_prunes (organization_id, through_seq, through_hash, pruned_at)
+		VALUES ($1, $2, $3, now())
+		ON CONFLICT (organization_id) DO UPDATE
+		SET through_seq = EXCLUDED.through_seq, through_hash = EXCLUDED.through_hash, pruned_at = EXCLUDED.pruned_at
+	`, organizationID, throughSeq, throughHash)
+	if err != nil {
+		return 0, fmt.Errorf("failed to record event log prune: %w", err)
+	}
+	result, err := tx.ExecContext(ctx, `
+		DELETE FROM event_log WHERE organization_id = $1 AND seq <= $2
+	`, organizationID, throughSeq)
+	if err != nil {
+		return 0, fmt.Errorf("failed to prune events: %w", err)
+	}
+	pruned, err := result.RowsAffected()
+	if err != nil {
+		return 0, fmt.Errorf("failed to get rows affected: %w", err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return 0, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+	return pruned, nil
+}
+
+// ListEvents returns an organization's whole event log in sequence order.
+func (r *repository) ListEvents(ctx context.Context, organizationID string) ([]*EventLogEntry, error) {
+	rows, err := r.conn(ctx).QueryContext(ctx, `
+		SELECT organization_id, seq, event_type, document_id, actor_id, recipient_id,
+			channel, detail, occurred_at, prev_hash, hash
+		FROM event_log
+		WHERE organization_id = $1
+		ORDER BY seq
+	`, organizationID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list events: %w", err)
+	}
+	defer rows.Close()
+
+	var entries []*EventLogEntry
+	for rows.Next() {
+		var entry EventLogEntry
+		err := rows.Scan(
+			&entry.OrganizationID,
+			&entry.Seq,
+			&entry.EventType,
+			&entry.DocumentID,
+			&entry.ActorID,
+			&entry.RecipientID,
+			&entry.Channel,
+			&entry.Detail,
+			&entry.OccurredAt,
+			&entry.PrevHash,
+			&entry.Hash,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan event: %w", err)
+		}
+		entries = append(entries, &entry)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("row iteration error: %w", err)
+	}
+	return entries, nil
+}
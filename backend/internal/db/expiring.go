@@ -0,0 +1,81 @@
+package db
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+// The expiring_documents view covers documents expiring from
+// expiringDocumentsPast ago to expiringDocumentsAhead from its last refresh
+// (see migration 019). Windows reaching outside it, less a day of slack for
+// refresh lag, are answered from the documents table instead.
+const (
+	expiringDocumentsPast  = 30 * 24 * time.Hour
+	expiringDocumentsAhead = 90 * 24 * time.Hour
+	expiringDocumentsSlack = 24 * time.Hour
+)
+
+const expiringDocumentColumns = `document_id, user_id, organization_id, name, category, expiration_date, timezone`
+
+// ListExpiringDocuments returns the documents expiring in [from, to), soonest
+// first. It reads the expiring_documents view, which may trail document
+// writes by up to one refresh interval.
+func (r *repository) ListExpiringDocuments(ctx context.Context, from, to time.Time) ([]*ExpiringDocument, error) {
+	now := time.Now()
+	source := `expiring_documents`
+	if from.Before(now.Add(-expiringDocumentsPast+expiringDocumentsSlack)) || to.After(now.Add(expiringDocumentsAhead-expiringDocumentsSlack)) {
+		source = `(
+			SELECT id AS document_id, user_id, organization_id, name, category, expiration_date, timezone
+			FROM documents
+			WHERE deleted_at IS NULL
+		) d`
+	}
+
+	// The view bypasses row-level security, so scope it to the user ourselves
+	// when the caller acts for one.
+	query := `
+		SELECT ` + expiringDocumentColumns + `
+		FROM ` + source + `
+		WHERE expiration_date >= $1 AND expiration_date < $2
+			AND (app_user_id() IS NULL OR (user_id = app_user_id() AND organization_id IS NOT DISTINCT FROM app_organization_id()))
+		ORDER BY expiration_date, document_id
+	`
+	rows, err := r.readConn(ctx).QueryContext(ctx, query, from, to)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list expiring documents: %w", err)
+	}
+	defer rows.Close()
+
+	var documents []*ExpiringDocument
+	for rows.Next() {
+		var doc ExpiringDocument
+		err := rows.Scan(
+			&doc.DocumentID,
+			&doc.UserID,
+			&doc.OrganizationID,
+			&doc.Name,
+			&doc.Category,
+			&doc.ExpirationDate,
+			&doc.Timezone,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan expiring document: %w", err)
+		}
+		documents = append(documents, &doc)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("row iteration error: %w", err)
+	}
+	return documents, nil
+}
+
+// RefreshExpiringDocuments brings the expiring_documents view up to date
+// without blocking readers.
+func (r *repository) RefreshExpiringDocuments(ctx context.Context) error {
+	if _, err := r.conn(ctx).ExecContext(ctx, `REFRESH MATERIALIZED VIEW CONCURRENTLY expiring_documents`); err != nil {
+		return fmt.Errorf("failed to refresh expiring documents: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,66 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+)
+
+func (r *repository) CreateFeedback(ctx context.Context, feedback *Feedback) error {
+	query := `
+		INSERT INTO feedback (id, user_id, message, score, page, request_id, user_agent)
+		VALUES ($1, $2, $3, $4, $5, $6, $7)
+		RETURNING created_at
+	`
+	err := r.db.DB.QueryRowContext(
+		ctx,
+		query,
+		feedback.ID,
+		feedback.UserID,
+		feedback.Message,
+		feedback.Score,
+		feedback.Page,
+		feedback.RequestID,
+		feedback.UserAgent,
+	).Scan(&feedback.CreatedAt)
+	if err != nil {
+		return fmt.Errorf("failed to create feedback: %w", err)
+	}
+	return nil
+}
+
+func (r *repository) GetFeedback(ctx context.Context, feedbackID string) (*Feedback, error) {
+	query := `
+		SELECT id, user_id, message, score, page, request_id, user_agent, relayed_at, created_at
+		FROM feedback
+		WHERE id = $1
+	`
+	var feedback Feedback
+	err := r.db.DB.QueryRowContext(ctx, query, feedbackID).Scan(
+		&feedback.ID,
+		&feedback.UserID,
+		&feedback.Message,
+		&feedback.Score,
+		&feedback.Page,
+		&feedback.RequestID,
+		&feedback.UserAgent,
+		&feedback.RelayedAt,
+		&feedback.CreatedAt,
+	)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, fmt.Errorf("feedback not found")
+		}
+		return nil, fmt.Errorf("failed to get feedback: %w", err)
+	}
+	return &feedback, nil
+}
+
+// MarkFeedbackRelayed records that the feedback was passed on to the team.
+func (r *repository) MarkFeedbackRelayed(ctx context.Context, feedbackID string) error {
+	query := `UPDATE feedback SET relayed_at = NOW() WHERE id = $1`
+	if _, err := r.db.DB.ExecContext(ctx, query, feedbackID); err != nil {
+		return fmt.Errorf("failed to mark feedback relayed: %w", err)
+	}
+	return nil
+}
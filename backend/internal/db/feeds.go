@@ -0,0 +1,53 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+)
+
+func (r *repository) GetFeedToken(ctx context.Context, userID string) (string, error) {
+	var token string
+	err := r.db.DB.QueryRowContext(ctx, `SELECT token FROM feed_tokens WHERE user_id = $1`, userID).Scan(&token)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return "", fmt.Errorf("feed token not found")
+		}
+		return "", fmt.Errorf("failed to get feed token: %w", err)
+	}
+	return token, nil
+}
+
+// SetFeedToken creates or rotates the user's feed token; the previous token
+// stops working immediately.
+func (r *repository) SetFeedToken(ctx context.Context, userID, token string) error {
+	query := `
+		INSERT INTO feed_tokens (user_id, token)
+		VALUES ($1, $2)
+		ON CONFLICT (user_id) DO UPDATE
+		SET token = EXCLUDED.token, created_at = NOW()
+	`
+	if _, err := r.db.DB.ExecContext(ctx, query, userID, token); err != nil {
+		return fmt.Errorf("failed to set feed token: %w", err)
+	}
+	return nil
+}
+
+func (r *repository) DeleteFeedToken(ctx context.Context, userID string) error {
+	if _, err := r.db.DB.ExecContext(ctx, `DELETE FROM feed_tokens WHERE user_id = $1`, userID); err != nil {
+		return fmt.Errorf("failed to delete feed token: %w", err)
+	}
+	return nil
+}
+
+func (r *repository) GetUserIDByFeedToken(ctx context.Context, token string) (string, error) {
+	var userID string
+	err := r.db.DB.QueryRowContext(ctx, `SELECT user_id FROM feed_tokens WHERE token = $1`, token).Scan(&userID)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return "", fmt.Errorf("feed token not found")
+		}
+		return "", fmt.Errorf("failed to get feed token: %w", err)
+	}
+	return userID, nil
+}
@@ -0,0 +1,214 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/lib/pq"
+)
+
+func (r *repository) CreateHousehold(ctx context.Context, household *Household) error {
+	tx, err := r.db.DB.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	query := `
+		INSERT INTO households (id, name, primary_user_id)
+		VALUES ($1, $2, $3)
+		RETURNING created_at, updated_at
+	`
+	err = tx.QueryRowContext(ctx, query, household.ID, household.Name, household.PrimaryUserID).
+		Scan(&household.CreatedAt, &household.UpdatedAt)
+	if err != nil {
+		return fmt.Errorf("failed to create household: %w", err)
+	}
+
+	_, err = tx.ExecContext(ctx, `
+		INSERT INTO household_members (household_id, user_id, role, notification_routing)
+		VALUES ($1, $2, $3, $4)
+	`, household.ID, household.PrimaryUserID, HouseholdRolePrimary, RoutingMember)
+	if err != nil {
+		if pqErr, ok := err.(*pq.Error); ok && pqErr.Code == "23505" {
+			return fmt.Errorf("user already belongs to a household")
+		}
+		return fmt.Errorf("failed to add household primary: %w", err)
+	}
+
+	return tx.Commit()
+}
+
+func (r *repository) GetHouseholdByUserID(ctx context.Context, userID string) (*Household, error) {
+	query := `
+		SELECT h.id, h.name, h.primary_user_id, h.created_at, h.updated_at
+		FROM households h
+		JOIN household_members m ON m.household_id = h.id
+		WHERE m.user_id = $1
+	`
+	var household Household
+	err := r.db.DB.QueryRowContext(ctx, query, userID).Scan(
+		&household.ID,
+		&household.Name,
+		&household.PrimaryUserID,
+		&household.CreatedAt,
+		&household.UpdatedAt,
+	)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, fmt.Errorf("household not found")
+		}
+		return nil, fmt.Errorf("failed to get household: %w", err)
+	}
+	return &household, nil
+}
+
+func (r *repository) GetHouseholdMember(ctx context.Context, userID string) (*HouseholdMember, error) {
+	query := `
+		SELECT m.household_id, m.user_id, u.name, u.email, m.role, m.notification_routing, m.created_at
+		FROM household_members m
+		JOIN users u ON u.id = m.user_id
+		WHERE m.user_id = $1
+	`
+	var member HouseholdMember
+	err := r.db.DB.QueryRowContext(ctx, query, userID).Scan(
+		&member.HouseholdID,
+		&member.UserID,
+		&member.Name,
+		&member.Email,
+		&member.Role,
+		&member.NotificationRouting,
+		&member.CreatedAt,
+	)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, fmt.Errorf("household member not found")
+		}
+		return nil, fmt.Errorf("failed to get household member: %w", err)
+	}
+	return &member, nil
+}
+
+func (r *repository) ListHouseholdMembers(ctx context.Context, householdID string) ([]*HouseholdMember, error) {
+	query := `
+		SELECT m.household_id, m.user_id, u.name, u.email, m.role, m.notification_routing, m.created_at
+		FROM household_members m
+		JOIN users u ON u.id = m.user_id
+		WHERE m.household_id = $1
+		ORDER BY m.created_at
+	`
+	rows, err := r.db.DB.QueryContext(ctx, query, householdID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list household members: %w", err)
+	}
+	defer rows.Close()
+
+	var members []*HouseholdMember
+	for rows.Next() {
+		var member HouseholdMember
+		err := rows.Scan(
+			&member.HouseholdID,
+			&member.UserID,
+			&member.Name,
+			&member.Email,
+			&member.Role,
+			&member.NotificationRouting,
+			&member.CreatedAt,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan household member: %w", err)
+		}
+		members = append(members, &member)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("row iteration error: %w", err)
+	}
+
+	return members, nil
+}
+
+func (r *repository) AddHouseholdMember(ctx context.Context, member *HouseholdMember) error {
+	query := `
+		INSERT INTO household_members (household_id, user_id, role, notification_routing)
+		VALUES ($1, $2, $3, $4)
+		RETURNING created_at
+	`
+	err := r.db.DB.QueryRowContext(
+		ctx,
+		query,
+		member.HouseholdID,
+		member.UserID,
+		member.Role,
+		member.NotificationRouting,
+	).Scan(&member.CreatedAt)
+
+	if err != nil {
+		if pqErr, ok := err.(*pq.Error); ok && pqErr.Code == "23505" {
+			return fmt.Errorf("user already belongs to a household")
+		}
+		return fmt.Errorf("failed to add household member: %w", err)
+	}
+
+	return nil
+}
+
+func (r *repository) UpdateHouseholdMemberRouting(ctx context.Context, householdID, userID, routing string) error {
+	query := `
+		UPDATE household_members
+		SET notification_routing = $1
+		WHERE household_id = $2 AND user_id = $3
+	`
+	result, err := r.db.DB.ExecContext(ctx, query, routing, householdID, userID)
+	if err != nil {
+		return fmt.Errorf("failed to update household member: %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to get rows affected: %w", err)
+	}
+	if rowsAffected == 0 {
+		return fmt.Errorf("household member not found")
+	}
+
+	return nil
+}
+
+func (r *repository) RemoveHouseholdMember(ctx context.Context, householdID, userID string) error {
+	query := `
+		DELETE FROM household_members
+		WHERE household_id = $1 AND user_id = $2 AND role <> 'primary'
+	`
+	result, err := r.db.DB.ExecContext(ctx, query, householdID, userID)
+	if err != nil {
+		return fmt.Errorf("failed to remove household member: %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to get rows affected: %w", err)
+	}
+	if rowsAffected == 0 {
+		return fmt.Errorf("household member not found")
+	}
+
+	return nil
+}
+
+func (r *repository) IsHouseholdPrimaryOf(ctx context.Context, primaryUserID, memberUserID string) (bool, error) {
+	query := `
+		SELECT EXISTS (
+			SELECT 1
+			FROM households h
+			JOIN household_members m ON m.household_id = h.id
+			WHERE h.primary_user_id = $1 AND m.user_id = $2
+		)
+	`
+	var exists bool
+	if err := r.db.DB.QueryRowContext(ctx, query, primaryUserID, memberUserID).Scan(&exists); err != nil {
+		return false, fmt.Errorf("failed to check household membership: %w", err)
+	}
+	return exists, nil
+}
@@ -0,0 +1,152 @@
+package db
+
+import (
+	"context"
+	"fmt"
+
+	"xpired/internal/fieldcrypt"
+)
+
+const identifierBackfillBatch = 500
+
+// sealIdentifier returns the stored form of a document identifier and its
+// blind index. Without a field cipher both are passed through unchanged.
+func (r *repository) sealIdentifier(identifier *string) (*string, *string, error) {
+	if identifier == nil || r.db.fields == nil {
+		return identifier, nil, nil
+	}
+	sealed, err := r.db.fields.Encrypt(*identifier)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to encrypt identifier: %w", err)
+	}
+	index := r.db.fields.BlindIndex(*identifier)
+	return &sealed, &index, nil
+}
+
+// openIdentifier decrypts doc.Identifier in place.
+func (r *repository) openIdentifier(doc *Document) error {
+	if doc.Identifier == nil || !fieldcrypt.IsEncrypted(*doc.Identifier) {
+		return nil
+	}
+	if r.db.fields == nil {
+		return fmt.Errorf("failed to decrypt identifier: field encryption is not configured")
+	}
+	plaintext, err := r.db.fields.Decrypt(*doc.Identifier)
+	if err != nil {
+		return fmt.Errorf("failed to decrypt identifier of document %s: %w", doc.ID, err)
+	}
+	doc.Identifier = &plaintext
+	return nil
+}
+
+// normalizedIdentifier is fieldcrypt.Normalize in SQL, applied to the
+// plaintext identifier column so it matches as the blind index does.
+const normalizedIdentifier = `upper(replace(replace(replace(identifier, ' ', ''), '-', ''), '` + "\t" + `', ''))`
+
+// FindDocumentsByIdentifier returns the user's documents whose identifier
+// matches exactly, ignoring case, spaces and dashes. The lookup goes through
+// the blind index, so identifiers are never decrypted to compare them;
+// without field encryption the plaintext is normalized the same way.
+func (r *repository) FindDocumentsByIdentifier(ctx context.Context, userID, identifier string) ([]*Document, error) {
+	if r.db.fields == nil {
+		query := `
+			SELECT ` + documentColumns + `
+			FROM documents
+			WHERE user_id = $1 AND deleted_at IS NULL AND organization_id IS NOT DISTINCT FROM $2 AND ` + normalizedIdentifier + ` = $3
+			ORDER BY created_at DESC
+		`
+		return r.queryDocuments(ctx, query, userID, organizationFilter(ctx), fieldcrypt.Normalize(identifier))
+	}
+
+	query := `
+		SELECT ` + documentColumns + `
+		FROM documents
+		WHERE user_id = $1 AND deleted_at IS NULL AND organization_id IS NOT DISTINCT FROM $2 AND identifier_index = $3
+		ORDER BY created_at DESC
+	`
+	return r.queryDocuments(ctx, query, userID, organizationFilter(ctx), r.db.fields.BlindIndex(identifier))
+}
+
+// FindSimilarDocuments returns the user's documents that are likely
+// duplicates of a new document: its name is at least minSimilarity similar by
+// trigrams, ignoring case, or the identifiers match as in
+// FindDocumentsByIdentifier. Identifier matches come first, then the most
+// similar names.
+func (r *repository) FindSimilarDocuments(ctx context.Context, userID, name string, identifier *string, minSimilarity float64, limit int) ([]*Document, error) {
+	identifierColumn, identifierValue := normalizedIdentifier, (*string)(nil)
+	if identifier != nil && r.db.fields != nil {
+		index := r.db.fields.BlindIndex(*identifier)
+		identifierColumn, identifierValue = "identifier_index", &index
+	} else if identifier != nil {
+		normalized := fieldcrypt.Normalize(*identifier)
+		identifierValue = &normalized
+	}
+
+	query := `
+		SELECT ` + documentColumns + `
+		FROM documents
+		WHERE user_id = $1 AND deleted_at IS NULL AND organization_id IS NOT DISTINCT FROM $2
+			AND (similarity(lower(name), lower($3)) >= $4 OR ` + identifierColumn + ` = $5)
+		ORDER BY COALESCE(` + identifierColumn + ` = $5, false) DESC, similarity(lower(name), lower($3)) DESC, created_at DESC
+		LIMIT $6
+	`
+	return r.queryDocuments(ctx, query, userID, organizationFilter(ctx), name, minSimilarity, identifierValue, limit)
+}
+
+// EncryptPlaintextIdentifiers encrypts identifiers written before field
+// encryption was enabled and fills in their blind index. It returns how many
+// documents it updated; running it again after it finishes is a no-op.
+func (r *repository) EncryptPlaintextIdentifiers(ctx context.Context) (int, error) {
+	if r.db.fields == nil {
+		return 0, nil
+	}
+
+	updated := 0
+	for {
+		rows, err := r.conn(ctx).QueryContext(ctx, `
+			SELECT id, identifier
+			FROM documents
+			WHERE identifier IS NOT NULL AND (identifier NOT LIKE 'enc:v1:%' OR identifier_index IS NULL)
+			LIMIT $1
+		`, identifierBackfillBatch)
+		if err != nil {
+			return updated, fmt.Errorf("failed to list plaintext identifiers: %w", err)
+		}
+
+		var docs []*Document
+		for rows.Next() {
+			var doc Document
+			if err := rows.Scan(&doc.ID, &doc.Identifier); err != nil {
+				rows.Close()
+				return updated, fmt.Errorf("failed to scan identifier: %w", err)
+			}
+			docs = append(docs, &doc)
+		}
+		if err := rows.Err(); err != nil {
+			rows.Close()
+			return updated, fmt.Errorf("row iteration error: %w", err)
+		}
+		rows.Close()
+
+		if len(docs) == 0 {
+			return updated, nil
+		}
+
+		for _, doc := range docs {
+			if err := r.openIdentifier(doc); err != nil {
+				return updated, err
+			}
+			identifier, identifierIndex, err := r.sealIdentifier(doc.Identifier)
+			if err != nil {
+				return updated, err
+			}
+			_, err = r.conn(ctx).ExecContext(ctx, `
+				UPDATE documents SET identifier = $1, identifier_index = $2 WHERE id = $3
+			`, identifier, identifierIndex, doc.ID)
+			if err != nil {
+				return updated, fmt.Errorf("failed to encrypt identifier: %w", err)
+			}
+			updated++
+		}
+	}
+}
@@ -0,0 +1,132 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/google/uuid"
+)
+
+const issuerColumns = `id, name, country_code, renewal_url, processing_days, created_at, updated_at`
+
+func scanIssuer(row interface{ Scan(...interface{}) error }) (*Issuer, error) {
+	var issuer Issuer
+	err := row.Scan(
+		&issuer.ID,
+		&issuer.Name,
+		&issuer.CountryCode,
+		&issuer.RenewalURL,
+		&issuer.ProcessingDays,
+		&issuer.CreatedAt,
+		&issuer.UpdatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return &issuer, nil
+}
+
+// ListIssuers returns the issuer directory by name. A non-empty countryCode
+// limits it to that country's issuers and those not tied to any country.
+func (r *repository) ListIssuers(ctx context.Context, countryCode string) ([]*Issuer, error) {
+	query := `
+		SELECT ` + issuerColumns + `
+		FROM issuers
+		WHERE $1 = '' OR country_code IS NULL OR country_code = $1
+		ORDER BY name
+	`
+	rows, err := r.db.DB.QueryContext(ctx, query, countryCode)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list issuers: %w", err)
+	}
+	defer rows.Close()
+
+	var issuers []*Issuer
+	for rows.Next() {
+		issuer, err := scanIssuer(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan issuer: %w", err)
+		}
+		issuers = append(issuers, issuer)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("row iteration error: %w", err)
+	}
+	return issuers, nil
+}
+
+func (r *repository) GetIssuer(ctx context.Context, issuerID string) (*Issuer, error) {
+	if _, err := uuid.Parse(issuerID); err != nil {
+		return nil, fmt.Errorf("issuer not found")
+	}
+	query := `SELECT ` + issuerColumns + ` FROM issuers WHERE id = $1`
+	issuer, err := scanIssuer(r.db.DB.QueryRowContext(ctx, query, issuerID))
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, fmt.Errorf("issuer not found")
+		}
+		return nil, fmt.Errorf("failed to get issuer: %w", err)
+	}
+	return issuer, nil
+}
+
+func (r *repository) CreateIssuer(ctx context.Context, issuer *Issuer) error {
+	query := `
+		INSERT INTO issuers (id, name, country_code, renewal_url, processing_days)
+		VALUES ($1, $2, $3, $4, $5)
+		RETURNING created_at, updated_at
+	`
+	err := r.db.DB.QueryRowContext(ctx, query,
+		issuer.ID,
+		issuer.Name,
+		issuer.CountryCode,
+		issuer.RenewalURL,
+		issuer.ProcessingDays,
+	).Scan(&issuer.CreatedAt, &issuer.UpdatedAt)
+	if err != nil {
+		return fmt.Errorf("failed to create issuer: %w", err)
+	}
+	return nil
+}
+
+func (r *repository) UpdateIssuer(ctx context.Context, issuer *Issuer) error {
+	query := `
+		UPDATE issuers
+		SET name = $2, country_code = $3, renewal_url = $4, processing_days = $5, updated_at = NOW()
+		WHERE id = $1
+		RETURNING updated_at
+	`
+	err := r.db.DB.QueryRowContext(ctx, query,
+		issuer.ID,
+		issuer.Name,
+		issuer.CountryCode,
+		issuer.RenewalURL,
+		issuer.ProcessingDays,
+	).Scan(&issuer.UpdatedAt)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return fmt.Errorf("issuer not found")
+		}
+		return fmt.Errorf("failed to update issuer: %w", err)
+	}
+	return nil
+}
+
+// DeleteIssuer removes an issuer from the directory. Documents linked to it
+// keep the stale id, which resolves to no issuer.
+func (r *repository) DeleteIssuer(ctx context.Context, issuerID string) error {
+	result, err := r.db.DB.ExecContext(ctx, `DELETE FROM issuers WHERE id = $1`, issuerID)
+	if err != nil {
+		return fmt.Errorf("failed to delete issuer: %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to get rows affected: %w", err)
+	}
+	if rowsAffected == 0 {
+		return fmt.Errorf("issuer not found")
+	}
+	return nil
+}
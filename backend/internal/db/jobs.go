@@ -0,0 +1,281 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+)
+
+const jobColumns = `id, user_id, kind, status, params, result, result_url, error, items_total, items_done, created_at, started_at, finished_at`
+
+func scanJob(row interface{ Scan(...interface{}) error }) (*Job, error) {
+	var job Job
+	var params, result []byte
+	var itemsTotal *int
+	var itemsDone int
+	err := row.Scan(
+		&job.ID,
+		&job.UserID,
+		&job.Kind,
+		&job.Status,
+		&params,
+		&result,
+		&job.ResultURL,
+		&job.Error,
+		&itemsTotal,
+		&itemsDone,
+		&job.CreatedAt,
+		&job.StartedAt,
+		&job.FinishedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+	job.Params = params
+	if result != nil {
+		job.Result = result
+	}
+	if itemsTotal != nil {
+		job.Progress = &JobProgress{Done: itemsDone, Total: *itemsTotal}
+	}
+	return &job, nil
+}
+
+func (r *repository) CreateJob(ctx context.Context, job *Job) error {
+	query := `
+		INSERT INTO jobs (id, user_id, kind, status, params)
+		VALUES ($1, $2, $3, $4, $5)
+		RETURNING created_at
+	`
+	params := "{}"
+	if len(job.Params) > 0 {
+		params = string(job.Params)
+	}
+	err := r.db.DB.QueryRowContext(ctx, query, job.ID, job.UserID, job.Kind, JobQueued, params).Scan(&job.CreatedAt)
+	if err != nil {
+		return fmt.Errorf("failed to create job: %w", err)
+	}
+	job.Status = JobQueued
+	return nil
+}
+
+func (r *repository) GetJob(ctx context.Context, jobID string) (*Job, error) {
+	query := `SELECT ` + jobColumns + ` FROM jobs WHERE id = $1`
+	job, err := scanJob(r.db.DB.QueryRowContext(ctx, query, jobID))
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, fmt.Errorf("job not found")
+		}
+		return nil, fmt.Errorf("failed to get job: %w", err)
+	}
+	return job, nil
+}
+
+// ListJobs returns the user's most recent jobs first.
+func (r *repository) ListJobs(ctx context.Context, userID string, limit int) ([]*Job, error) {
+	query := `
+		SELECT ` + jobColumns + `
+		FROM jobs
+		WHERE user_id = $1
+		ORDER BY created_at DESC
+		LIMIT $2
+	`
+	rows, err := r.db.DB.QueryContext(ctx, query, userID, limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list jobs: %w", err)
+	}
+	defer rows.Close()
+
+	var jobs []*Job
+	for rows.Next() {
+		job, err := scanJob(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan job: %w", err)
+		}
+		jobs = append(jobs, job)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("row iteration error: %w", err)
+	}
+	return jobs, nil
+}
+
+// StartJob marks a queued job as running. It returns false if the job
+// already started, so a redelivered task does not run it twice.
+func (r *repository) StartJob(ctx context.Context, jobID string) (bool, error) {
+	query := `
+		UPDATE jobs
+		SET status = $2, started_at = now()
+		WHERE id = $1 AND status = $3
+	`
+	result, err := r.db.DB.ExecContext(ctx, query, jobID, JobRunning, JobQueued)
+	if err != nil {
+		return false, fmt.Errorf("failed to start job: %w", err)
+	}
+	affected, _ := result.RowsAffected()
+	return affected > 0, nil
+}
+
+// FinishJob records the outcome of a job: its Status, Result, ResultURL and
+// Error. The input it ran on is dropped, import chunks included.
+func (r *repository) FinishJob(ctx context.Context, job *Job) error {
+	query := `
+		UPDATE jobs
+		SET status = $2, result = $3, result_url = $4, error = $5, params = '{}', finished_at = now()
+		WHERE id = $1
+		RETURNING finished_at
+	`
+	err := r.db.DB.QueryRowContext(ctx, query, job.ID, job.Status, jsonText(job.Result), job.ResultURL, job.Error).Scan(&job.FinishedAt)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return fmt.Errorf("job not found")
+		}
+		return fmt.Errorf("failed to finish job: %w", err)
+	}
+	job.Params = nil
+
+	if _, err := r.db.DB.ExecContext(ctx, `DELETE FROM import_chunks WHERE job_id = $1`, job.ID); err != nil {
+		return fmt.Errorf("failed to drop import chunks: %w", err)
+	}
+	return nil
+}
+
+// CreateImportChunks splits the import job into chunks of rows, each a JSON
+// array, and replaces its params with params, which no longer need the
+// upload. total is the number of rows, reported as the job's progress.
+func (r *repository) CreateImportChunks(ctx context.Context, jobID string, params json.RawMessage, chunks []json.RawMessage, total int) error {
+	tx, err := r.db.DB.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	query := `INSERT INTO import_chunks (job_id, chunk, rows) VALUES ($1, $2, $3)`
+	for i, rows := range chunks {
+		if _, err := tx.ExecContext(ctx, query, jobID, i, string(rows)); err != nil {
+			return fmt.Errorf("failed to create import chunk: %w", err)
+		}
+	}
+
+	query = `UPDATE jobs SET params = $2, items_total = $3, items_done = 0 WHERE id = $1`
+	if _, err := tx.ExecContext(ctx, query, jobID, string(params), total); err != nil {
+		return fmt.Errorf("failed to update job: %w", err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+	return nil
+}
+
+func scanImportChunk(row interface{ Scan(...interface{}) error }) (*ImportChunk, error) {
+	var chunk ImportChunk
+	var rows, result []byte
+	if err := row.Scan(&chunk.JobID, &chunk.Chunk, &rows, &result, &chunk.DoneAt); err != nil {
+		return nil, err
+	}
+	chunk.Rows = rows
+	if result != nil {
+		chunk.Result = result
+	}
+	return &chunk, nil
+}
+
+func (r *repository) GetImportChunk(ctx context.Context, jobID string, chunk int) (*ImportChunk, error) {
+	query := `
+		SELECT job_id, chunk, rows, result, done_at
+		FROM import_chunks
+		WHERE job_id = $1 AND chunk = $2
+	`
+	found, err := scanImportChunk(r.db.DB.QueryRowContext(ctx, query, jobID, chunk))
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, fmt.Errorf("import chunk not found")
+		}
+		return nil, fmt.Errorf("failed to get import chunk: %w", err)
+	}
+	return found, nil
+}
+
+// NextImportChunk returns the first chunk of the job that is not done yet.
+func (r *repository) NextImportChunk(ctx context.Context, jobID string) (*ImportChunk, error) {
+	query := `
+		SELECT job_id, chunk, rows, result, done_at
+		FROM import_chunks
+		WHERE job_id = $1 AND done_at IS NULL
+		ORDER BY chunk
+		LIMIT 1
+	`
+	found, err := scanImportChunk(r.db.DB.QueryRowContext(ctx, query, jobID))
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, fmt.Errorf("import chunk not found")
+		}
+		return nil, fmt.Errorf("failed to get import chunk: %w", err)
+	}
+	return found, nil
+}
+
+// CompleteImportChunk records the result of a chunk and counts its rows as
+// done. It returns false if the chunk was done already, so a redelivered
+// task does not count them twice.
+func (r *repository) CompleteImportChunk(ctx context.Context, jobID string, chunk int, result json.RawMessage, rows int) (bool, error) {
+	tx, err := r.db.DB.BeginTx(ctx, nil)
+	if err != nil {
+		return false, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	query := `
+		UPDATE import_chunks
+		SET result = $3, rows = '[]', done_at = now()
+		WHERE job_id = $1 AND chunk = $2 AND done_at IS NULL
+	`
+	res, err := tx.ExecContext(ctx, query, jobID, chunk, string(result))
+	if err != nil {
+		return false, fmt.Errorf("failed to complete import chunk: %w", err)
+	}
+	if affected, _ := res.RowsAffected(); affected == 0 {
+		return false, nil
+	}
+
+	query = `UPDATE jobs SET items_done = items_done + $2 WHERE id = $1`
+	if _, err := tx.ExecContext(ctx, query, jobID, rows); err != nil {
+		return false, fmt.Errorf("failed to update job progress: %w", err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return false, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+	return true, nil
+}
+
+// ListImportChunkResults returns the results of the job's done chunks, in
+// order.
+func (r *repository) ListImportChunkResults(ctx context.Context, jobID string) ([]json.RawMessage, error) {
+	query := `
+		SELECT result
+		FROM import_chunks
+		WHERE job_id = $1 AND done_at IS NOT NULL
+		ORDER BY chunk
+	`
+	rows, err := r.db.DB.QueryContext(ctx, query, jobID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list import chunk results: %w", err)
+	}
+	defer rows.Close()
+
+	var results []json.RawMessage
+	for rows.Next() {
+		var result []byte
+		if err := rows.Scan(&result); err != nil {
+			return nil, fmt.Errorf("failed to scan import chunk result: %w", err)
+		}
+		results = append(results, result)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("row iteration error: %w", err)
+	}
+	return results, nil
+}
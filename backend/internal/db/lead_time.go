@@ -0,0 +1,30 @@
+package db
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+// ClaimLeadTimeReminder records that the lead-time reminder of a document
+// goes out for expirationDate. It returns false when it was already sent for
+// that date, so a reminder queued twice (e.g. after an edit) is sent once.
+func (r *repository) ClaimLeadTimeReminder(ctx context.Context, documentID string, expirationDate time.Time) (bool, error) {
+	query := `
+		INSERT INTO lead_time_reminders (document_id, expiration_date)
+		VALUES ($1, $2)
+		ON CONFLICT (document_id) DO UPDATE
+		SET expiration_date = EXCLUDED.expiration_date, sent_at = NOW()
+		WHERE lead_time_reminders.expiration_date <> EXCLUDED.expiration_date
+	`
+	result, err := r.conn(ctx).ExecContext(ctx, query, documentID, expirationDate)
+	if err != nil {
+		return false, fmt.Errorf("failed to claim lead-time reminder: %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return false, fmt.Errorf("failed to get rows affected: %w", err)
+	}
+	return rowsAffected > 0, nil
+}
@@ -0,0 +1,118 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+)
+
+// notOnLegalHold is a condition on documents leaving out those under a legal
+// hold, of their own or of their organization.
+const notOnLegalHold = `NOT EXISTS (
+			SELECT 1 FROM legal_holds h
+			WHERE h.organization_id = documents.organization_id
+				AND (h.document_id IS NULL OR h.document_id = documents.id)
+		)`
+
+// GetLegalHold returns the legal hold on a document of an organization, or
+// on the whole organization when documentID is nil.
+func (r *repository) GetLegalHold(ctx context.Context, organizationID string, documentID *string) (*LegalHold, error) {
+	hold := LegalHold{OrganizationID: organizationID, DocumentID: documentID}
+	err := r.conn(ctx).QueryRowContext(ctx, `
+		SELECT placed_by, placed_at
+		FROM legal_holds
+		WHERE organization_id = $1 AND document_id IS NOT DISTINCT FROM $2
+	`, organizationID, documentID).Scan(&hold.PlacedBy, &hold.PlacedAt)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, fmt.Errorf("legal hold not found")
+		}
+		return nil, fmt.Errorf("failed to get legal hold: %w", err)
+	}
+	return &hold, nil
+}
+
+// ListLegalHolds returns the legal holds of an organization, that on the
+// whole organization included.
+func (r *repository) ListLegalHolds(ctx context.Context, organizationID string) ([]*LegalHold, error) {
+	rows, err := r.conn(ctx).QueryContext(ctx, `
+		SELECT organization_id, document_id, placed_by, placed_at
+		FROM legal_holds
+		WHERE organization_id = $1
+	`, organizationID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list legal holds: %w", err)
+	}
+	defer rows.Close()
+
+	var holds []*LegalHold
+	for rows.Next() {
+		var hold LegalHold
+		if err := rows.Scan(&hold.OrganizationID, &hold.DocumentID, &hold.PlacedBy, &hold.PlacedAt); err != nil {
+			return nil, fmt.Errorf("failed to scan legal hold: %w", err)
+		}
+		holds = append(holds, &hold)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("row iteration error: %w", err)
+	}
+	return holds, nil
+}
+
+// PlaceLegalHold puts hold in place, filling in when. It reports false,
+// leaving hold as is, when the document or organization is already held.
+func (r *repository) PlaceLegalHold(ctx context.Context, hold *LegalHold) (bool, error) {
+	err := r.conn(ctx).QueryRowContext(ctx, `
+		INSERT INTO legal_holds (organization_id, document_id, placed_by)
+		VALUES ($1, $2, $3)
+		ON CONFLICT DO NOTHING
+		RETURNING placed_at
+	`, hold.OrganizationID, hold.DocumentID, hold.PlacedBy).Scan(&hold.PlacedAt)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return false, nil
+		}
+		return false, fmt.Errorf("failed to place legal hold: %w", err)
+	}
+	return true, nil
+}
+
+// RemoveLegalHold lifts the legal hold on a document of an organization, or
+// on the whole organization when documentID is nil. It reports false when
+// there was none.
+func (r *repository) RemoveLegalHold(ctx context.Context, organizationID string, documentID *string) (bool, error) {
+	result, err := r.conn(ctx).ExecContext(ctx, `
+		DELETE FROM legal_holds
+		WHERE organization_id = $1 AND document_id IS NOT DISTINCT FROM $2
+	`, organizationID, documentID)
+	if err != nil {
+		return false, fmt.Errorf("failed to remove legal hold: %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return false, fmt.Errorf("failed to get rows affected: %w", err)
+	}
+	return rowsAffected > 0, nil
+}
+
+// UnderLegalHold reports whether doc is held, on its own or with the rest of
+// its organization. Personal documents never are.
+func (r *repository) UnderLegalHold(ctx context.Context, doc *Document) (bool, error) {
+	if doc.OrganizationID == nil {
+		return false, nil
+	}
+
+	var held bool
+	err := r.conn(ctx).QueryRowContext(ctx, `
+		SELECT EXISTS (
+			SELECT 1 FROM legal_holds
+			WHERE organization_id = $1 AND (document_id IS NULL OR document_id = $2)
+		)
+	`, *doc.OrganizationID, doc.ID.String()).Scan(&held)
+	if err != nil {
+		return false, fmt.Errorf("failed to check legal hold: %w", err)
+	}
+	return held, nil
+}
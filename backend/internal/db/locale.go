@@ -0,0 +1,39 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+)
+
+func (r *repository) GetLocalePreferences(ctx context.Context, userID string) (*LocalePreferences, error) {
+	var prefs LocalePreferences
+	err := r.db.DB.QueryRowContext(ctx, `
+		SELECT locale, duration_unit FROM users WHERE id = $1
+	`, userID).Scan(&prefs.Locale, &prefs.DurationUnit)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, fmt.Errorf("user does not exist")
+		}
+		return nil, fmt.Errorf("failed to get locale preferences: %w", err)
+	}
+	return &prefs, nil
+}
+
+func (r *repository) SetLocalePreferences(ctx context.Context, userID string, prefs *LocalePreferences) error {
+	result, err := r.db.DB.ExecContext(ctx, `
+		UPDATE users SET locale = $1, duration_unit = $2, updated_at = NOW() WHERE id = $3
+	`, prefs.Locale, prefs.DurationUnit, userID)
+	if err != nil {
+		return fmt.Errorf("failed to set locale preferences: %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to get rows affected: %w", err)
+	}
+	if rowsAffected == 0 {
+		return fmt.Errorf("user does not exist")
+	}
+	return nil
+}
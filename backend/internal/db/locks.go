@@ -0,0 +1,77 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+)
+
+// AcquireDocumentLock takes the edit lock on a document for userID until
+// expiresAt, or extends it if userID already holds it. It fails with
+// "document is locked" while another user holds an unexpired lock.
+func (r *repository) AcquireDocumentLock(ctx context.Context, documentID, userID string, expiresAt time.Time) (*DocumentLock, error) {
+	query := `
+		INSERT INTO document_locks (document_id, user_id, locked_at, expires_at)
+		VALUES ($1, $2, NOW(), $3)
+		ON CONFLICT (document_id) DO UPDATE
+		SET user_id = EXCLUDED.user_id,
+			locked_at = CASE
+				WHEN document_locks.user_id = EXCLUDED.user_id AND document_locks.expires_at > NOW() THEN document_locks.locked_at
+				ELSE NOW()
+			END,
+			expires_at = EXCLUDED.expires_at
+		WHERE document_locks.user_id = EXCLUDED.user_id OR document_locks.expires_at <= NOW()
+		RETURNING locked_at
+	`
+	lock := DocumentLock{DocumentID: documentID, UserID: userID, ExpiresAt: expiresAt}
+	err := r.conn(ctx).QueryRowContext(ctx, query, documentID, userID, expiresAt).Scan(&lock.LockedAt)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, fmt.Errorf("document is locked")
+		}
+		return nil, fmt.Errorf("failed to acquire document lock: %w", err)
+	}
+	return &lock, nil
+}
+
+// GetDocumentLock returns the unexpired lock on a document, if any.
+func (r *repository) GetDocumentLock(ctx context.Context, documentID string) (*DocumentLock, error) {
+	query := `
+		SELECT l.document_id, l.user_id, u.name, l.locked_at, l.expires_at
+		FROM document_locks l
+		JOIN users u ON u.id = l.user_id
+		WHERE l.document_id = $1 AND l.expires_at > NOW()
+	`
+	var lock DocumentLock
+	err := r.conn(ctx).QueryRowContext(ctx, query, documentID).Scan(
+		&lock.DocumentID,
+		&lock.UserID,
+		&lock.UserName,
+		&lock.LockedAt,
+		&lock.ExpiresAt,
+	)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, fmt.Errorf("document lock not found")
+		}
+		return nil, fmt.Errorf("failed to get document lock: %w", err)
+	}
+	return &lock, nil
+}
+
+func (r *repository) ReleaseDocumentLock(ctx context.Context, documentID string) error {
+	result, err := r.conn(ctx).ExecContext(ctx, `DELETE FROM document_locks WHERE document_id = $1 AND expires_at > NOW()`, documentID)
+	if err != nil {
+		return fmt.Errorf("failed to release document lock: %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to get rows affected: %w", err)
+	}
+	if rowsAffected == 0 {
+		return fmt.Errorf("document lock not found")
+	}
+	return nil
+}
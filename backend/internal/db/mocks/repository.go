@@ -0,0 +1,7213 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: repository.go
+//
+// Generated by this command:
+//
+//	mockgen -source=repository.go -destination=mocks/repository.go -package=mocks
+//
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	json "encoding/json"
+	reflect "reflect"
+	time "time"
+	db "xpired/internal/db"
+
+	uuid "github.com/google/uuid"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockRepository is a mock of Repository interface.
+type MockRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockRepositoryMockRecorder is the mock recorder for MockRepository.
+type MockRepositoryMockRecorder struct {
+	mock *MockRepository
+}
+
+// NewMockRepository creates a new mock instance.
+func NewMockRepository(ctrl *gomock.Controller) *MockRepository {
+	mock := &MockRepository{ctrl: ctrl}
+	mock.recorder = &MockRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockRepository) EXPECT() *MockRepositoryMockRecorder {
+	return m.recorder
+}
+
+// AcknowledgeDocumentAssignment mocks base method.
+func (m *MockRepository) AcknowledgeDocumentAssignment(ctx context.Context, documentID, assigneeID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AcknowledgeDocumentAssignment", ctx, documentID, assigneeID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AcknowledgeDocumentAssignment indicates an expected call of AcknowledgeDocumentAssignment.
+func (mr *MockRepositoryMockRecorder) AcknowledgeDocumentAssignment(ctx, documentID, assigneeID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AcknowledgeDocumentAssignment", reflect.TypeOf((*MockRepository)(nil).AcknowledgeDocumentAssignment), ctx, documentID, assigneeID)
+}
+
+// AcquireDocumentLock mocks base method.
+func (m *MockRepository) AcquireDocumentLock(ctx context.Context, documentID, userID string, expiresAt time.Time) (*db.DocumentLock, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AcquireDocumentLock", ctx, documentID, userID, expiresAt)
+	ret0, _ := ret[0].(*db.DocumentLock)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AcquireDocumentLock indicates an expected call of AcquireDocumentLock.
+func (mr *MockRepositoryMockRecorder) AcquireDocumentLock(ctx, documentID, userID, expiresAt any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AcquireDocumentLock", reflect.TypeOf((*MockRepository)(nil).AcquireDocumentLock), ctx, documentID, userID, expiresAt)
+}
+
+// AddDocumentDependency mocks base method.
+func (m *MockRepository) AddDocumentDependency(ctx context.Context, documentID, dependsOnID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddDocumentDependency", ctx, documentID, dependsOnID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AddDocumentDependency indicates an expected call of AddDocumentDependency.
+func (mr *MockRepositoryMockRecorder) AddDocumentDependency(ctx, documentID, dependsOnID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddDocumentDependency", reflect.TypeOf((*MockRepository)(nil).AddDocumentDependency), ctx, documentID, dependsOnID)
+}
+
+// AddHouseholdMember mocks base method.
+func (m *MockRepository) AddHouseholdMember(ctx context.Context, member *db.HouseholdMember) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddHouseholdMember", ctx, member)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AddHouseholdMember indicates an expected call of AddHouseholdMember.
+func (mr *MockRepositoryMockRecorder) AddHouseholdMember(ctx, member any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddHouseholdMember", reflect.TypeOf((*MockRepository)(nil).AddHouseholdMember), ctx, member)
+}
+
+// AddOrganizationMember mocks base method.
+func (m *MockRepository) AddOrganizationMember(ctx context.Context, org *db.Organization, userID, role string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddOrganizationMember", ctx, org, userID, role)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AddOrganizationMember indicates an expected call of AddOrganizationMember.
+func (mr *MockRepositoryMockRecorder) AddOrganizationMember(ctx, org, userID, role any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddOrganizationMember", reflect.TypeOf((*MockRepository)(nil).AddOrganizationMember), ctx, org, userID, role)
+}
+
+// AddRoadmapVote mocks base method.
+func (m *MockRepository) AddRoadmapVote(ctx context.Context, featureID, userID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddRoadmapVote", ctx, featureID, userID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AddRoadmapVote indicates an expected call of AddRoadmapVote.
+func (mr *MockRepositoryMockRecorder) AddRoadmapVote(ctx, featureID, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddRoadmapVote", reflect.TypeOf((*MockRepository)(nil).AddRoadmapVote), ctx, featureID, userID)
+}
+
+// AppendEvent mocks base method.
+func (m *MockRepository) AppendEvent(ctx context.Context, entry *db.EventLogEntry) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AppendEvent", ctx, entry)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AppendEvent indicates an expected call of AppendEvent.
+func (mr *MockRepositoryMockRecorder) AppendEvent(ctx, entry any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AppendEvent", reflect.TypeOf((*MockRepository)(nil).AppendEvent), ctx, entry)
+}
+
+// AssignDocument mocks base method.
+func (m *MockRepository) AssignDocument(ctx context.Context, documentID, assigneeID, assignedBy string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AssignDocument", ctx, documentID, assigneeID, assignedBy)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AssignDocument indicates an expected call of AssignDocument.
+func (mr *MockRepositoryMockRecorder) AssignDocument(ctx, documentID, assigneeID, assignedBy any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AssignDocument", reflect.TypeOf((*MockRepository)(nil).AssignDocument), ctx, documentID, assigneeID, assignedBy)
+}
+
+// AuthenticateAPIKey mocks base method.
+func (m *MockRepository) AuthenticateAPIKey(ctx context.Context, keyHash string) (string, []string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AuthenticateAPIKey", ctx, keyHash)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].([]string)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// AuthenticateAPIKey indicates an expected call of AuthenticateAPIKey.
+func (mr *MockRepositoryMockRecorder) AuthenticateAPIKey(ctx, keyHash any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AuthenticateAPIKey", reflect.TypeOf((*MockRepository)(nil).AuthenticateAPIKey), ctx, keyHash)
+}
+
+// CheckUserExistsByEmail mocks base method.
+func (m *MockRepository) CheckUserExistsByEmail(ctx context.Context, email string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CheckUserExistsByEmail", ctx, email)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CheckUserExistsByEmail indicates an expected call of CheckUserExistsByEmail.
+func (mr *MockRepositoryMockRecorder) CheckUserExistsByEmail(ctx, email any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CheckUserExistsByEmail", reflect.TypeOf((*MockRepository)(nil).CheckUserExistsByEmail), ctx, email)
+}
+
+// CheckUserExistsById mocks base method.
+func (m *MockRepository) CheckUserExistsById(ctx context.Context, userID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CheckUserExistsById", ctx, userID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CheckUserExistsById indicates an expected call of CheckUserExistsById.
+func (mr *MockRepositoryMockRecorder) CheckUserExistsById(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CheckUserExistsById", reflect.TypeOf((*MockRepository)(nil).CheckUserExistsById), ctx, userID)
+}
+
+// ClaimActionLink mocks base method.
+func (m *MockRepository) ClaimActionLink(ctx context.Context, jti string, expiresAt time.Time) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ClaimActionLink", ctx, jti, expiresAt)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ClaimActionLink indicates an expected call of ClaimActionLink.
+func (mr *MockRepositoryMockRecorder) ClaimActionLink(ctx, jti, expiresAt any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ClaimActionLink", reflect.TypeOf((*MockRepository)(nil).ClaimActionLink), ctx, jti, expiresAt)
+}
+
+// ClaimAnnouncementEmail mocks base method.
+func (m *MockRepository) ClaimAnnouncementEmail(ctx context.Context, announcementID string) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ClaimAnnouncementEmail", ctx, announcementID)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ClaimAnnouncementEmail indicates an expected call of ClaimAnnouncementEmail.
+func (mr *MockRepositoryMockRecorder) ClaimAnnouncementEmail(ctx, announcementID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ClaimAnnouncementEmail", reflect.TypeOf((*MockRepository)(nil).ClaimAnnouncementEmail), ctx, announcementID)
+}
+
+// ClaimLeadTimeReminder mocks base method.
+func (m *MockRepository) ClaimLeadTimeReminder(ctx context.Context, documentID string, expirationDate time.Time) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ClaimLeadTimeReminder", ctx, documentID, expirationDate)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ClaimLeadTimeReminder indicates an expected call of ClaimLeadTimeReminder.
+func (mr *MockRepositoryMockRecorder) ClaimLeadTimeReminder(ctx, documentID, expirationDate any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ClaimLeadTimeReminder", reflect.TypeOf((*MockRepository)(nil).ClaimLeadTimeReminder), ctx, documentID, expirationDate)
+}
+
+// ClaimQuotaWarning mocks base method.
+func (m *MockRepository) ClaimQuotaWarning(ctx context.Context, userID, quota, month string) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ClaimQuotaWarning", ctx, userID, quota, month)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ClaimQuotaWarning indicates an expected call of ClaimQuotaWarning.
+func (mr *MockRepositoryMockRecorder) ClaimQuotaWarning(ctx, userID, quota, month any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ClaimQuotaWarning", reflect.TypeOf((*MockRepository)(nil).ClaimQuotaWarning), ctx, userID, quota, month)
+}
+
+// ClearUserPassword mocks base method.
+func (m *MockRepository) ClearUserPassword(ctx context.Context, userID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ClearUserPassword", ctx, userID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ClearUserPassword indicates an expected call of ClearUserPassword.
+func (mr *MockRepositoryMockRecorder) ClearUserPassword(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ClearUserPassword", reflect.TypeOf((*MockRepository)(nil).ClearUserPassword), ctx, userID)
+}
+
+// CompleteImportChunk mocks base method.
+func (m *MockRepository) CompleteImportChunk(ctx context.Context, jobID string, chunk int, result json.RawMessage, rows int) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CompleteImportChunk", ctx, jobID, chunk, result, rows)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CompleteImportChunk indicates an expected call of CompleteImportChunk.
+func (mr *MockRepositoryMockRecorder) CompleteImportChunk(ctx, jobID, chunk, result, rows any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CompleteImportChunk", reflect.TypeOf((*MockRepository)(nil).CompleteImportChunk), ctx, jobID, chunk, result, rows)
+}
+
+// ConfirmEmailChange mocks base method.
+func (m *MockRepository) ConfirmEmailChange(ctx context.Context, userID, email string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ConfirmEmailChange", ctx, userID, email)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ConfirmEmailChange indicates an expected call of ConfirmEmailChange.
+func (mr *MockRepositoryMockRecorder) ConfirmEmailChange(ctx, userID, email any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ConfirmEmailChange", reflect.TypeOf((*MockRepository)(nil).ConfirmEmailChange), ctx, userID, email)
+}
+
+// CountNotificationOutcomes mocks base method.
+func (m *MockRepository) CountNotificationOutcomes(ctx context.Context, since time.Time) (*db.NotificationStats, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountNotificationOutcomes", ctx, since)
+	ret0, _ := ret[0].(*db.NotificationStats)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountNotificationOutcomes indicates an expected call of CountNotificationOutcomes.
+func (mr *MockRepositoryMockRecorder) CountNotificationOutcomes(ctx, since any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountNotificationOutcomes", reflect.TypeOf((*MockRepository)(nil).CountNotificationOutcomes), ctx, since)
+}
+
+// CountNotificationsByUser mocks base method.
+func (m *MockRepository) CountNotificationsByUser(ctx context.Context, since, recentSince time.Time, minRecent int) ([]*db.NotificationVolume, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountNotificationsByUser", ctx, since, recentSince, minRecent)
+	ret0, _ := ret[0].([]*db.NotificationVolume)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountNotificationsByUser indicates an expected call of CountNotificationsByUser.
+func (mr *MockRepositoryMockRecorder) CountNotificationsByUser(ctx, since, recentSince, minRecent any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountNotificationsByUser", reflect.TypeOf((*MockRepository)(nil).CountNotificationsByUser), ctx, since, recentSince, minRecent)
+}
+
+// CountSMSSent mocks base method.
+func (m *MockRepository) CountSMSSent(ctx context.Context, recipientID string, since time.Time) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountSMSSent", ctx, recipientID, since)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountSMSSent indicates an expected call of CountSMSSent.
+func (mr *MockRepositoryMockRecorder) CountSMSSent(ctx, recipientID, since any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountSMSSent", reflect.TypeOf((*MockRepository)(nil).CountSMSSent), ctx, recipientID, since)
+}
+
+// CountUnreadNotifications mocks base method.
+func (m *MockRepository) CountUnreadNotifications(ctx context.Context, userID string) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountUnreadNotifications", ctx, userID)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountUnreadNotifications indicates an expected call of CountUnreadNotifications.
+func (mr *MockRepositoryMockRecorder) CountUnreadNotifications(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountUnreadNotifications", reflect.TypeOf((*MockRepository)(nil).CountUnreadNotifications), ctx, userID)
+}
+
+// CreateAPIKey mocks base method.
+func (m *MockRepository) CreateAPIKey(ctx context.Context, key *db.APIKey) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateAPIKey", ctx, key)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateAPIKey indicates an expected call of CreateAPIKey.
+func (mr *MockRepositoryMockRecorder) CreateAPIKey(ctx, key any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateAPIKey", reflect.TypeOf((*MockRepository)(nil).CreateAPIKey), ctx, key)
+}
+
+// CreateAnnouncement mocks base method.
+func (m *MockRepository) CreateAnnouncement(ctx context.Context, announcement *db.Announcement) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateAnnouncement", ctx, announcement)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateAnnouncement indicates an expected call of CreateAnnouncement.
+func (mr *MockRepositoryMockRecorder) CreateAnnouncement(ctx, announcement any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateAnnouncement", reflect.TypeOf((*MockRepository)(nil).CreateAnnouncement), ctx, announcement)
+}
+
+// CreateAttachmentBlob mocks base method.
+func (m *MockRepository) CreateAttachmentBlob(ctx context.Context, blob *db.AttachmentBlob) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateAttachmentBlob", ctx, blob)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateAttachmentBlob indicates an expected call of CreateAttachmentBlob.
+func (mr *MockRepositoryMockRecorder) CreateAttachmentBlob(ctx, blob any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateAttachmentBlob", reflect.TypeOf((*MockRepository)(nil).CreateAttachmentBlob), ctx, blob)
+}
+
+// CreateAttachmentRetentionWarning mocks base method.
+func (m *MockRepository) CreateAttachmentRetentionWarning(ctx context.Context, warning *db.AttachmentRetentionWarning) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateAttachmentRetentionWarning", ctx, warning)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateAttachmentRetentionWarning indicates an expected call of CreateAttachmentRetentionWarning.
+func (mr *MockRepositoryMockRecorder) CreateAttachmentRetentionWarning(ctx, warning any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateAttachmentRetentionWarning", reflect.TypeOf((*MockRepository)(nil).CreateAttachmentRetentionWarning), ctx, warning)
+}
+
+// CreateAuditLog mocks base method.
+func (m *MockRepository) CreateAuditLog(ctx context.Context, entry *db.AuditLog) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateAuditLog", ctx, entry)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateAuditLog indicates an expected call of CreateAuditLog.
+func (mr *MockRepositoryMockRecorder) CreateAuditLog(ctx, entry any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateAuditLog", reflect.TypeOf((*MockRepository)(nil).CreateAuditLog), ctx, entry)
+}
+
+// CreateChecklistItem mocks base method.
+func (m *MockRepository) CreateChecklistItem(ctx context.Context, item *db.ChecklistItem) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateChecklistItem", ctx, item)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateChecklistItem indicates an expected call of CreateChecklistItem.
+func (mr *MockRepositoryMockRecorder) CreateChecklistItem(ctx, item any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateChecklistItem", reflect.TypeOf((*MockRepository)(nil).CreateChecklistItem), ctx, item)
+}
+
+// CreateDocument mocks base method.
+func (m *MockRepository) CreateDocument(ctx context.Context, document *db.Document) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateDocument", ctx, document)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateDocument indicates an expected call of CreateDocument.
+func (mr *MockRepositoryMockRecorder) CreateDocument(ctx, document any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateDocument", reflect.TypeOf((*MockRepository)(nil).CreateDocument), ctx, document)
+}
+
+// CreateDocumentContact mocks base method.
+func (m *MockRepository) CreateDocumentContact(ctx context.Context, contact *db.DocumentContact) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateDocumentContact", ctx, contact)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateDocumentContact indicates an expected call of CreateDocumentContact.
+func (mr *MockRepositoryMockRecorder) CreateDocumentContact(ctx, contact any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateDocumentContact", reflect.TypeOf((*MockRepository)(nil).CreateDocumentContact), ctx, contact)
+}
+
+// CreateFeedback mocks base method.
+func (m *MockRepository) CreateFeedback(ctx context.Context, feedback *db.Feedback) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateFeedback", ctx, feedback)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateFeedback indicates an expected call of CreateFeedback.
+func (mr *MockRepositoryMockRecorder) CreateFeedback(ctx, feedback any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateFeedback", reflect.TypeOf((*MockRepository)(nil).CreateFeedback), ctx, feedback)
+}
+
+// CreateHousehold mocks base method.
+func (m *MockRepository) CreateHousehold(ctx context.Context, household *db.Household) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateHousehold", ctx, household)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateHousehold indicates an expected call of CreateHousehold.
+func (mr *MockRepositoryMockRecorder) CreateHousehold(ctx, household any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateHousehold", reflect.TypeOf((*MockRepository)(nil).CreateHousehold), ctx, household)
+}
+
+// CreateImportChunks mocks base method.
+func (m *MockRepository) CreateImportChunks(ctx context.Context, jobID string, params json.RawMessage, chunks []json.RawMessage, total int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateImportChunks", ctx, jobID, params, chunks, total)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateImportChunks indicates an expected call of CreateImportChunks.
+func (mr *MockRepositoryMockRecorder) CreateImportChunks(ctx, jobID, params, chunks, total any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateImportChunks", reflect.TypeOf((*MockRepository)(nil).CreateImportChunks), ctx, jobID, params, chunks, total)
+}
+
+// CreateIssuer mocks base method.
+func (m *MockRepository) CreateIssuer(ctx context.Context, issuer *db.Issuer) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateIssuer", ctx, issuer)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateIssuer indicates an expected call of CreateIssuer.
+func (mr *MockRepositoryMockRecorder) CreateIssuer(ctx, issuer any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateIssuer", reflect.TypeOf((*MockRepository)(nil).CreateIssuer), ctx, issuer)
+}
+
+// CreateJob mocks base method.
+func (m *MockRepository) CreateJob(ctx context.Context, job *db.Job) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateJob", ctx, job)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateJob indicates an expected call of CreateJob.
+func (mr *MockRepositoryMockRecorder) CreateJob(ctx, job any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateJob", reflect.TypeOf((*MockRepository)(nil).CreateJob), ctx, job)
+}
+
+// CreateNotificationLog mocks base method.
+func (m *MockRepository) CreateNotificationLog(ctx context.Context, log *db.NotificationLog) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateNotificationLog", ctx, log)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateNotificationLog indicates an expected call of CreateNotificationLog.
+func (mr *MockRepositoryMockRecorder) CreateNotificationLog(ctx, log any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateNotificationLog", reflect.TypeOf((*MockRepository)(nil).CreateNotificationLog), ctx, log)
+}
+
+// CreateOrganization mocks base method.
+func (m *MockRepository) CreateOrganization(ctx context.Context, org *db.Organization, ownerID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateOrganization", ctx, org, ownerID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateOrganization indicates an expected call of CreateOrganization.
+func (mr *MockRepositoryMockRecorder) CreateOrganization(ctx, org, ownerID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateOrganization", reflect.TypeOf((*MockRepository)(nil).CreateOrganization), ctx, org, ownerID)
+}
+
+// CreateRenewalRequest mocks base method.
+func (m *MockRepository) CreateRenewalRequest(ctx context.Context, request *db.RenewalRequest) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateRenewalRequest", ctx, request)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateRenewalRequest indicates an expected call of CreateRenewalRequest.
+func (mr *MockRepositoryMockRecorder) CreateRenewalRequest(ctx, request any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateRenewalRequest", reflect.TypeOf((*MockRepository)(nil).CreateRenewalRequest), ctx, request)
+}
+
+// CreateRoadmapFeature mocks base method.
+func (m *MockRepository) CreateRoadmapFeature(ctx context.Context, feature *db.RoadmapFeature) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateRoadmapFeature", ctx, feature)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateRoadmapFeature indicates an expected call of CreateRoadmapFeature.
+func (mr *MockRepositoryMockRecorder) CreateRoadmapFeature(ctx, feature any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateRoadmapFeature", reflect.TypeOf((*MockRepository)(nil).CreateRoadmapFeature), ctx, feature)
+}
+
+// CreateSavedView mocks base method.
+func (m *MockRepository) CreateSavedView(ctx context.Context, view *db.SavedView) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateSavedView", ctx, view)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateSavedView indicates an expected call of CreateSavedView.
+func (mr *MockRepositoryMockRecorder) CreateSavedView(ctx, view any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateSavedView", reflect.TypeOf((*MockRepository)(nil).CreateSavedView), ctx, view)
+}
+
+// CreateServiceAccount mocks base method.
+func (m *MockRepository) CreateServiceAccount(ctx context.Context, account *db.ServiceAccount) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateServiceAccount", ctx, account)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateServiceAccount indicates an expected call of CreateServiceAccount.
+func (mr *MockRepositoryMockRecorder) CreateServiceAccount(ctx, account any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateServiceAccount", reflect.TypeOf((*MockRepository)(nil).CreateServiceAccount), ctx, account)
+}
+
+// CreateSession mocks base method.
+func (m *MockRepository) CreateSession(ctx context.Context, session *db.Session) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateSession", ctx, session)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateSession indicates an expected call of CreateSession.
+func (mr *MockRepositoryMockRecorder) CreateSession(ctx, session any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateSession", reflect.TypeOf((*MockRepository)(nil).CreateSession), ctx, session)
+}
+
+// CreateUser mocks base method.
+func (m *MockRepository) CreateUser(ctx context.Context, user *db.User) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateUser", ctx, user)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateUser indicates an expected call of CreateUser.
+func (mr *MockRepositoryMockRecorder) CreateUser(ctx, user any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateUser", reflect.TypeOf((*MockRepository)(nil).CreateUser), ctx, user)
+}
+
+// CreateWebhookDelivery mocks base method.
+func (m *MockRepository) CreateWebhookDelivery(ctx context.Context, delivery *db.WebhookDelivery) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateWebhookDelivery", ctx, delivery)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateWebhookDelivery indicates an expected call of CreateWebhookDelivery.
+func (mr *MockRepositoryMockRecorder) CreateWebhookDelivery(ctx, delivery any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateWebhookDelivery", reflect.TypeOf((*MockRepository)(nil).CreateWebhookDelivery), ctx, delivery)
+}
+
+// CreateWebhookEndpoint mocks base method.
+func (m *MockRepository) CreateWebhookEndpoint(ctx context.Context, endpoint *db.WebhookEndpoint) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateWebhookEndpoint", ctx, endpoint)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateWebhookEndpoint indicates an expected call of CreateWebhookEndpoint.
+func (mr *MockRepositoryMockRecorder) CreateWebhookEndpoint(ctx, endpoint any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateWebhookEndpoint", reflect.TypeOf((*MockRepository)(nil).CreateWebhookEndpoint), ctx, endpoint)
+}
+
+// DataRegions mocks base method.
+func (m *MockRepository) DataRegions() []string {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DataRegions")
+	ret0, _ := ret[0].([]string)
+	return ret0
+}
+
+// DataRegions indicates an expected call of DataRegions.
+func (mr *MockRepositoryMockRecorder) DataRegions() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DataRegions", reflect.TypeOf((*MockRepository)(nil).DataRegions))
+}
+
+// DeactivateUser mocks base method.
+func (m *MockRepository) DeactivateUser(ctx context.Context, userID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeactivateUser", ctx, userID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeactivateUser indicates an expected call of DeactivateUser.
+func (mr *MockRepositoryMockRecorder) DeactivateUser(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeactivateUser", reflect.TypeOf((*MockRepository)(nil).DeactivateUser), ctx, userID)
+}
+
+// DecideRenewalRequest mocks base method.
+func (m *MockRepository) DecideRenewalRequest(ctx context.Context, requestID, status, decidedBy string, note *string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DecideRenewalRequest", ctx, requestID, status, decidedBy, note)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DecideRenewalRequest indicates an expected call of DecideRenewalRequest.
+func (mr *MockRepositoryMockRecorder) DecideRenewalRequest(ctx, requestID, status, decidedBy, note any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DecideRenewalRequest", reflect.TypeOf((*MockRepository)(nil).DecideRenewalRequest), ctx, requestID, status, decidedBy, note)
+}
+
+// DeleteAPIKey mocks base method.
+func (m *MockRepository) DeleteAPIKey(ctx context.Context, userID, keyID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteAPIKey", ctx, userID, keyID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteAPIKey indicates an expected call of DeleteAPIKey.
+func (mr *MockRepositoryMockRecorder) DeleteAPIKey(ctx, userID, keyID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteAPIKey", reflect.TypeOf((*MockRepository)(nil).DeleteAPIKey), ctx, userID, keyID)
+}
+
+// DeleteAnnouncement mocks base method.
+func (m *MockRepository) DeleteAnnouncement(ctx context.Context, announcementID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteAnnouncement", ctx, announcementID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteAnnouncement indicates an expected call of DeleteAnnouncement.
+func (mr *MockRepositoryMockRecorder) DeleteAnnouncement(ctx, announcementID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteAnnouncement", reflect.TypeOf((*MockRepository)(nil).DeleteAnnouncement), ctx, announcementID)
+}
+
+// DeleteAttachmentRetentionWarning mocks base method.
+func (m *MockRepository) DeleteAttachmentRetentionWarning(ctx context.Context, documentID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteAttachmentRetentionWarning", ctx, documentID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteAttachmentRetentionWarning indicates an expected call of DeleteAttachmentRetentionWarning.
+func (mr *MockRepositoryMockRecorder) DeleteAttachmentRetentionWarning(ctx, documentID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteAttachmentRetentionWarning", reflect.TypeOf((*MockRepository)(nil).DeleteAttachmentRetentionWarning), ctx, documentID)
+}
+
+// DeleteChecklistItem mocks base method.
+func (m *MockRepository) DeleteChecklistItem(ctx context.Context, documentID, itemID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteChecklistItem", ctx, documentID, itemID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteChecklistItem indicates an expected call of DeleteChecklistItem.
+func (mr *MockRepositoryMockRecorder) DeleteChecklistItem(ctx, documentID, itemID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteChecklistItem", reflect.TypeOf((*MockRepository)(nil).DeleteChecklistItem), ctx, documentID, itemID)
+}
+
+// DeleteDocument mocks base method.
+func (m *MockRepository) DeleteDocument(ctx context.Context, documentID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteDocument", ctx, documentID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteDocument indicates an expected call of DeleteDocument.
+func (mr *MockRepositoryMockRecorder) DeleteDocument(ctx, documentID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteDocument", reflect.TypeOf((*MockRepository)(nil).DeleteDocument), ctx, documentID)
+}
+
+// DeleteDocumentContact mocks base method.
+func (m *MockRepository) DeleteDocumentContact(ctx context.Context, documentID, contactID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteDocumentContact", ctx, documentID, contactID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteDocumentContact indicates an expected call of DeleteDocumentContact.
+func (mr *MockRepositoryMockRecorder) DeleteDocumentContact(ctx, documentID, contactID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteDocumentContact", reflect.TypeOf((*MockRepository)(nil).DeleteDocumentContact), ctx, documentID, contactID)
+}
+
+// DeleteEmailSender mocks base method.
+func (m *MockRepository) DeleteEmailSender(ctx context.Context, organizationID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteEmailSender", ctx, organizationID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteEmailSender indicates an expected call of DeleteEmailSender.
+func (mr *MockRepositoryMockRecorder) DeleteEmailSender(ctx, organizationID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteEmailSender", reflect.TypeOf((*MockRepository)(nil).DeleteEmailSender), ctx, organizationID)
+}
+
+// DeleteFeedToken mocks base method.
+func (m *MockRepository) DeleteFeedToken(ctx context.Context, userID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteFeedToken", ctx, userID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteFeedToken indicates an expected call of DeleteFeedToken.
+func (mr *MockRepositoryMockRecorder) DeleteFeedToken(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteFeedToken", reflect.TypeOf((*MockRepository)(nil).DeleteFeedToken), ctx, userID)
+}
+
+// DeleteIssuer mocks base method.
+func (m *MockRepository) DeleteIssuer(ctx context.Context, issuerID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteIssuer", ctx, issuerID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteIssuer indicates an expected call of DeleteIssuer.
+func (mr *MockRepositoryMockRecorder) DeleteIssuer(ctx, issuerID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteIssuer", reflect.TypeOf((*MockRepository)(nil).DeleteIssuer), ctx, issuerID)
+}
+
+// DeleteNotificationTheme mocks base method.
+func (m *MockRepository) DeleteNotificationTheme(ctx context.Context, organizationID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteNotificationTheme", ctx, organizationID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteNotificationTheme indicates an expected call of DeleteNotificationTheme.
+func (mr *MockRepositoryMockRecorder) DeleteNotificationTheme(ctx, organizationID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteNotificationTheme", reflect.TypeOf((*MockRepository)(nil).DeleteNotificationTheme), ctx, organizationID)
+}
+
+// DeleteOrganizationAuditLogsBefore mocks base method.
+func (m *MockRepository) DeleteOrganizationAuditLogsBefore(ctx context.Context, organizationID string, cutoff time.Time, keepDocumentIDs []string) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteOrganizationAuditLogsBefore", ctx, organizationID, cutoff, keepDocumentIDs)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteOrganizationAuditLogsBefore indicates an expected call of DeleteOrganizationAuditLogsBefore.
+func (mr *MockRepositoryMockRecorder) DeleteOrganizationAuditLogsBefore(ctx, organizationID, cutoff, keepDocumentIDs any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteOrganizationAuditLogsBefore", reflect.TypeOf((*MockRepository)(nil).DeleteOrganizationAuditLogsBefore), ctx, organizationID, cutoff, keepDocumentIDs)
+}
+
+// DeleteOrganizationNotificationsBefore mocks base method.
+func (m *MockRepository) DeleteOrganizationNotificationsBefore(ctx context.Context, organizationID string, cutoff time.Time) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteOrganizationNotificationsBefore", ctx, organizationID, cutoff)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteOrganizationNotificationsBefore indicates an expected call of DeleteOrganizationNotificationsBefore.
+func (mr *MockRepositoryMockRecorder) DeleteOrganizationNotificationsBefore(ctx, organizationID, cutoff any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteOrganizationNotificationsBefore", reflect.TypeOf((*MockRepository)(nil).DeleteOrganizationNotificationsBefore), ctx, organizationID, cutoff)
+}
+
+// DeleteRoadmapFeature mocks base method.
+func (m *MockRepository) DeleteRoadmapFeature(ctx context.Context, featureID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteRoadmapFeature", ctx, featureID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteRoadmapFeature indicates an expected call of DeleteRoadmapFeature.
+func (mr *MockRepositoryMockRecorder) DeleteRoadmapFeature(ctx, featureID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteRoadmapFeature", reflect.TypeOf((*MockRepository)(nil).DeleteRoadmapFeature), ctx, featureID)
+}
+
+// DeleteSCIMToken mocks base method.
+func (m *MockRepository) DeleteSCIMToken(ctx context.Context, organizationID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteSCIMToken", ctx, organizationID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteSCIMToken indicates an expected call of DeleteSCIMToken.
+func (mr *MockRepositoryMockRecorder) DeleteSCIMToken(ctx, organizationID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteSCIMToken", reflect.TypeOf((*MockRepository)(nil).DeleteSCIMToken), ctx, organizationID)
+}
+
+// DeleteSavedView mocks base method.
+func (m *MockRepository) DeleteSavedView(ctx context.Context, viewID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteSavedView", ctx, viewID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteSavedView indicates an expected call of DeleteSavedView.
+func (mr *MockRepositoryMockRecorder) DeleteSavedView(ctx, viewID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteSavedView", reflect.TypeOf((*MockRepository)(nil).DeleteSavedView), ctx, viewID)
+}
+
+// DeleteWebhookEndpoint mocks base method.
+func (m *MockRepository) DeleteWebhookEndpoint(ctx context.Context, endpointID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteWebhookEndpoint", ctx, endpointID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteWebhookEndpoint indicates an expected call of DeleteWebhookEndpoint.
+func (mr *MockRepositoryMockRecorder) DeleteWebhookEndpoint(ctx, endpointID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteWebhookEndpoint", reflect.TypeOf((*MockRepository)(nil).DeleteWebhookEndpoint), ctx, endpointID)
+}
+
+// EncryptPlaintextIdentifiers mocks base method.
+func (m *MockRepository) EncryptPlaintextIdentifiers(ctx context.Context) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EncryptPlaintextIdentifiers", ctx)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// EncryptPlaintextIdentifiers indicates an expected call of EncryptPlaintextIdentifiers.
+func (mr *MockRepositoryMockRecorder) EncryptPlaintextIdentifiers(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EncryptPlaintextIdentifiers", reflect.TypeOf((*MockRepository)(nil).EncryptPlaintextIdentifiers), ctx)
+}
+
+// FindDocumentsByIdentifier mocks base method.
+func (m *MockRepository) FindDocumentsByIdentifier(ctx context.Context, userID, identifier string) ([]*db.Document, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindDocumentsByIdentifier", ctx, userID, identifier)
+	ret0, _ := ret[0].([]*db.Document)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindDocumentsByIdentifier indicates an expected call of FindDocumentsByIdentifier.
+func (mr *MockRepositoryMockRecorder) FindDocumentsByIdentifier(ctx, userID, identifier any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindDocumentsByIdentifier", reflect.TypeOf((*MockRepository)(nil).FindDocumentsByIdentifier), ctx, userID, identifier)
+}
+
+// FindSimilarDocuments mocks base method.
+func (m *MockRepository) FindSimilarDocuments(ctx context.Context, userID, name string, identifier *string, minSimilarity float64, limit int) ([]*db.Document, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindSimilarDocuments", ctx, userID, name, identifier, minSimilarity, limit)
+	ret0, _ := ret[0].([]*db.Document)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindSimilarDocuments indicates an expected call of FindSimilarDocuments.
+func (mr *MockRepositoryMockRecorder) FindSimilarDocuments(ctx, userID, name, identifier, minSimilarity, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindSimilarDocuments", reflect.TypeOf((*MockRepository)(nil).FindSimilarDocuments), ctx, userID, name, identifier, minSimilarity, limit)
+}
+
+// FinishJob mocks base method.
+func (m *MockRepository) FinishJob(ctx context.Context, job *db.Job) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FinishJob", ctx, job)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// FinishJob indicates an expected call of FinishJob.
+func (mr *MockRepositoryMockRecorder) FinishJob(ctx, job any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FinishJob", reflect.TypeOf((*MockRepository)(nil).FinishJob), ctx, job)
+}
+
+// GetAllReminderIntervals mocks base method.
+func (m *MockRepository) GetAllReminderIntervals(ctx context.Context) ([]*db.ReminderInterval, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAllReminderIntervals", ctx)
+	ret0, _ := ret[0].([]*db.ReminderInterval)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAllReminderIntervals indicates an expected call of GetAllReminderIntervals.
+func (mr *MockRepositoryMockRecorder) GetAllReminderIntervals(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAllReminderIntervals", reflect.TypeOf((*MockRepository)(nil).GetAllReminderIntervals), ctx)
+}
+
+// GetAnnouncement mocks base method.
+func (m *MockRepository) GetAnnouncement(ctx context.Context, announcementID string) (*db.Announcement, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAnnouncement", ctx, announcementID)
+	ret0, _ := ret[0].(*db.Announcement)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAnnouncement indicates an expected call of GetAnnouncement.
+func (mr *MockRepositoryMockRecorder) GetAnnouncement(ctx, announcementID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAnnouncement", reflect.TypeOf((*MockRepository)(nil).GetAnnouncement), ctx, announcementID)
+}
+
+// GetAttachmentBlob mocks base method.
+func (m *MockRepository) GetAttachmentBlob(ctx context.Context, userID, checksum string) (*db.AttachmentBlob, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAttachmentBlob", ctx, userID, checksum)
+	ret0, _ := ret[0].(*db.AttachmentBlob)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAttachmentBlob indicates an expected call of GetAttachmentBlob.
+func (mr *MockRepositoryMockRecorder) GetAttachmentBlob(ctx, userID, checksum any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAttachmentBlob", reflect.TypeOf((*MockRepository)(nil).GetAttachmentBlob), ctx, userID, checksum)
+}
+
+// GetAttachmentRetentionWarning mocks base method.
+func (m *MockRepository) GetAttachmentRetentionWarning(ctx context.Context, documentID string) (*db.AttachmentRetentionWarning, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAttachmentRetentionWarning", ctx, documentID)
+	ret0, _ := ret[0].(*db.AttachmentRetentionWarning)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAttachmentRetentionWarning indicates an expected call of GetAttachmentRetentionWarning.
+func (mr *MockRepositoryMockRecorder) GetAttachmentRetentionWarning(ctx, documentID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAttachmentRetentionWarning", reflect.TypeOf((*MockRepository)(nil).GetAttachmentRetentionWarning), ctx, documentID)
+}
+
+// GetChecklistItem mocks base method.
+func (m *MockRepository) GetChecklistItem(ctx context.Context, documentID, itemID string) (*db.ChecklistItem, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetChecklistItem", ctx, documentID, itemID)
+	ret0, _ := ret[0].(*db.ChecklistItem)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetChecklistItem indicates an expected call of GetChecklistItem.
+func (mr *MockRepositoryMockRecorder) GetChecklistItem(ctx, documentID, itemID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetChecklistItem", reflect.TypeOf((*MockRepository)(nil).GetChecklistItem), ctx, documentID, itemID)
+}
+
+// GetDocumentAssignment mocks base method.
+func (m *MockRepository) GetDocumentAssignment(ctx context.Context, documentID string) (*db.DocumentAssignment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDocumentAssignment", ctx, documentID)
+	ret0, _ := ret[0].(*db.DocumentAssignment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDocumentAssignment indicates an expected call of GetDocumentAssignment.
+func (mr *MockRepositoryMockRecorder) GetDocumentAssignment(ctx, documentID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDocumentAssignment", reflect.TypeOf((*MockRepository)(nil).GetDocumentAssignment), ctx, documentID)
+}
+
+// GetDocumentByID mocks base method.
+func (m *MockRepository) GetDocumentByID(ctx context.Context, documentID string) (*db.Document, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDocumentByID", ctx, documentID)
+	ret0, _ := ret[0].(*db.Document)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDocumentByID indicates an expected call of GetDocumentByID.
+func (mr *MockRepositoryMockRecorder) GetDocumentByID(ctx, documentID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDocumentByID", reflect.TypeOf((*MockRepository)(nil).GetDocumentByID), ctx, documentID)
+}
+
+// GetDocumentCategory mocks base method.
+func (m *MockRepository) GetDocumentCategory(ctx context.Context, slug string) (*db.DocumentCategory, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDocumentCategory", ctx, slug)
+	ret0, _ := ret[0].(*db.DocumentCategory)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDocumentCategory indicates an expected call of GetDocumentCategory.
+func (mr *MockRepositoryMockRecorder) GetDocumentCategory(ctx, slug any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDocumentCategory", reflect.TypeOf((*MockRepository)(nil).GetDocumentCategory), ctx, slug)
+}
+
+// GetDocumentContactByUnsubscribeToken mocks base method.
+func (m *MockRepository) GetDocumentContactByUnsubscribeToken(ctx context.Context, token string) (*db.DocumentContact, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDocumentContactByUnsubscribeToken", ctx, token)
+	ret0, _ := ret[0].(*db.DocumentContact)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDocumentContactByUnsubscribeToken indicates an expected call of GetDocumentContactByUnsubscribeToken.
+func (mr *MockRepositoryMockRecorder) GetDocumentContactByUnsubscribeToken(ctx, token any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDocumentContactByUnsubscribeToken", reflect.TypeOf((*MockRepository)(nil).GetDocumentContactByUnsubscribeToken), ctx, token)
+}
+
+// GetDocumentLock mocks base method.
+func (m *MockRepository) GetDocumentLock(ctx context.Context, documentID string) (*db.DocumentLock, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDocumentLock", ctx, documentID)
+	ret0, _ := ret[0].(*db.DocumentLock)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDocumentLock indicates an expected call of GetDocumentLock.
+func (mr *MockRepositoryMockRecorder) GetDocumentLock(ctx, documentID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDocumentLock", reflect.TypeOf((*MockRepository)(nil).GetDocumentLock), ctx, documentID)
+}
+
+// GetDocumentRemindersByDocumentID mocks base method.
+func (m *MockRepository) GetDocumentRemindersByDocumentID(ctx context.Context, documentID string) ([]*db.DocumentReminder, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDocumentRemindersByDocumentID", ctx, documentID)
+	ret0, _ := ret[0].([]*db.DocumentReminder)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDocumentRemindersByDocumentID indicates an expected call of GetDocumentRemindersByDocumentID.
+func (mr *MockRepositoryMockRecorder) GetDocumentRemindersByDocumentID(ctx, documentID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDocumentRemindersByDocumentID", reflect.TypeOf((*MockRepository)(nil).GetDocumentRemindersByDocumentID), ctx, documentID)
+}
+
+// GetDocumentStats mocks base method.
+func (m *MockRepository) GetDocumentStats(ctx context.Context, userID string) (*db.DocumentStats, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDocumentStats", ctx, userID)
+	ret0, _ := ret[0].(*db.DocumentStats)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDocumentStats indicates an expected call of GetDocumentStats.
+func (mr *MockRepositoryMockRecorder) GetDocumentStats(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDocumentStats", reflect.TypeOf((*MockRepository)(nil).GetDocumentStats), ctx, userID)
+}
+
+// GetDocumentTemplate mocks base method.
+func (m *MockRepository) GetDocumentTemplate(ctx context.Context, slug string) (*db.DocumentTemplate, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDocumentTemplate", ctx, slug)
+	ret0, _ := ret[0].(*db.DocumentTemplate)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDocumentTemplate indicates an expected call of GetDocumentTemplate.
+func (mr *MockRepositoryMockRecorder) GetDocumentTemplate(ctx, slug any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDocumentTemplate", reflect.TypeOf((*MockRepository)(nil).GetDocumentTemplate), ctx, slug)
+}
+
+// GetEmailSender mocks base method.
+func (m *MockRepository) GetEmailSender(ctx context.Context, organizationID string) (*db.EmailSender, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetEmailSender", ctx, organizationID)
+	ret0, _ := ret[0].(*db.EmailSender)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetEmailSender indicates an expected call of GetEmailSender.
+func (mr *MockRepositoryMockRecorder) GetEmailSender(ctx, organizationID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetEmailSender", reflect.TypeOf((*MockRepository)(nil).GetEmailSender), ctx, organizationID)
+}
+
+// GetEscalationPolicy mocks base method.
+func (m *MockRepository) GetEscalationPolicy(ctx context.Context, organizationID string) ([]*db.EscalationStep, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetEscalationPolicy", ctx, organizationID)
+	ret0, _ := ret[0].([]*db.EscalationStep)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetEscalationPolicy indicates an expected call of GetEscalationPolicy.
+func (mr *MockRepositoryMockRecorder) GetEscalationPolicy(ctx, organizationID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetEscalationPolicy", reflect.TypeOf((*MockRepository)(nil).GetEscalationPolicy), ctx, organizationID)
+}
+
+// GetEventLogAnchor mocks base method.
+func (m *MockRepository) GetEventLogAnchor(ctx context.Context, organizationID string) (int64, string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetEventLogAnchor", ctx, organizationID)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(string)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetEventLogAnchor indicates an expected call of GetEventLogAnchor.
+func (mr *MockRepositoryMockRecorder) GetEventLogAnchor(ctx, organizationID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetEventLogAnchor", reflect.TypeOf((*MockRepository)(nil).GetEventLogAnchor), ctx, organizationID)
+}
+
+// GetFeedToken mocks base method.
+func (m *MockRepository) GetFeedToken(ctx context.Context, userID string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetFeedToken", ctx, userID)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetFeedToken indicates an expected call of GetFeedToken.
+func (mr *MockRepositoryMockRecorder) GetFeedToken(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFeedToken", reflect.TypeOf((*MockRepository)(nil).GetFeedToken), ctx, userID)
+}
+
+// GetFeedback mocks base method.
+func (m *MockRepository) GetFeedback(ctx context.Context, feedbackID string) (*db.Feedback, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetFeedback", ctx, feedbackID)
+	ret0, _ := ret[0].(*db.Feedback)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetFeedback indicates an expected call of GetFeedback.
+func (mr *MockRepositoryMockRecorder) GetFeedback(ctx, feedbackID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFeedback", reflect.TypeOf((*MockRepository)(nil).GetFeedback), ctx, feedbackID)
+}
+
+// GetHouseholdByUserID mocks base method.
+func (m *MockRepository) GetHouseholdByUserID(ctx context.Context, userID string) (*db.Household, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetHouseholdByUserID", ctx, userID)
+	ret0, _ := ret[0].(*db.Household)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetHouseholdByUserID indicates an expected call of GetHouseholdByUserID.
+func (mr *MockRepositoryMockRecorder) GetHouseholdByUserID(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetHouseholdByUserID", reflect.TypeOf((*MockRepository)(nil).GetHouseholdByUserID), ctx, userID)
+}
+
+// GetHouseholdMember mocks base method.
+func (m *MockRepository) GetHouseholdMember(ctx context.Context, userID string) (*db.HouseholdMember, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetHouseholdMember", ctx, userID)
+	ret0, _ := ret[0].(*db.HouseholdMember)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetHouseholdMember indicates an expected call of GetHouseholdMember.
+func (mr *MockRepositoryMockRecorder) GetHouseholdMember(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetHouseholdMember", reflect.TypeOf((*MockRepository)(nil).GetHouseholdMember), ctx, userID)
+}
+
+// GetImportChunk mocks base method.
+func (m *MockRepository) GetImportChunk(ctx context.Context, jobID string, chunk int) (*db.ImportChunk, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetImportChunk", ctx, jobID, chunk)
+	ret0, _ := ret[0].(*db.ImportChunk)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetImportChunk indicates an expected call of GetImportChunk.
+func (mr *MockRepositoryMockRecorder) GetImportChunk(ctx, jobID, chunk any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetImportChunk", reflect.TypeOf((*MockRepository)(nil).GetImportChunk), ctx, jobID, chunk)
+}
+
+// GetIssuer mocks base method.
+func (m *MockRepository) GetIssuer(ctx context.Context, issuerID string) (*db.Issuer, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetIssuer", ctx, issuerID)
+	ret0, _ := ret[0].(*db.Issuer)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetIssuer indicates an expected call of GetIssuer.
+func (mr *MockRepositoryMockRecorder) GetIssuer(ctx, issuerID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetIssuer", reflect.TypeOf((*MockRepository)(nil).GetIssuer), ctx, issuerID)
+}
+
+// GetJob mocks base method.
+func (m *MockRepository) GetJob(ctx context.Context, jobID string) (*db.Job, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetJob", ctx, jobID)
+	ret0, _ := ret[0].(*db.Job)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetJob indicates an expected call of GetJob.
+func (mr *MockRepositoryMockRecorder) GetJob(ctx, jobID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetJob", reflect.TypeOf((*MockRepository)(nil).GetJob), ctx, jobID)
+}
+
+// GetLatestNotificationLog mocks base method.
+func (m *MockRepository) GetLatestNotificationLog(ctx context.Context, userID, channel string) (*db.NotificationLog, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetLatestNotificationLog", ctx, userID, channel)
+	ret0, _ := ret[0].(*db.NotificationLog)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetLatestNotificationLog indicates an expected call of GetLatestNotificationLog.
+func (mr *MockRepositoryMockRecorder) GetLatestNotificationLog(ctx, userID, channel any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLatestNotificationLog", reflect.TypeOf((*MockRepository)(nil).GetLatestNotificationLog), ctx, userID, channel)
+}
+
+// GetLegalHold mocks base method.
+func (m *MockRepository) GetLegalHold(ctx context.Context, organizationID string, documentID *string) (*db.LegalHold, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetLegalHold", ctx, organizationID, documentID)
+	ret0, _ := ret[0].(*db.LegalHold)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetLegalHold indicates an expected call of GetLegalHold.
+func (mr *MockRepositoryMockRecorder) GetLegalHold(ctx, organizationID, documentID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLegalHold", reflect.TypeOf((*MockRepository)(nil).GetLegalHold), ctx, organizationID, documentID)
+}
+
+// GetLocalePreferences mocks base method.
+func (m *MockRepository) GetLocalePreferences(ctx context.Context, userID string) (*db.LocalePreferences, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetLocalePreferences", ctx, userID)
+	ret0, _ := ret[0].(*db.LocalePreferences)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetLocalePreferences indicates an expected call of GetLocalePreferences.
+func (mr *MockRepositoryMockRecorder) GetLocalePreferences(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLocalePreferences", reflect.TypeOf((*MockRepository)(nil).GetLocalePreferences), ctx, userID)
+}
+
+// GetNotificationPreferences mocks base method.
+func (m *MockRepository) GetNotificationPreferences(ctx context.Context, userID string) (*db.NotificationPreferences, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetNotificationPreferences", ctx, userID)
+	ret0, _ := ret[0].(*db.NotificationPreferences)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetNotificationPreferences indicates an expected call of GetNotificationPreferences.
+func (mr *MockRepositoryMockRecorder) GetNotificationPreferences(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetNotificationPreferences", reflect.TypeOf((*MockRepository)(nil).GetNotificationPreferences), ctx, userID)
+}
+
+// GetNotificationTheme mocks base method.
+func (m *MockRepository) GetNotificationTheme(ctx context.Context, organizationID string) (*db.NotificationTheme, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetNotificationTheme", ctx, organizationID)
+	ret0, _ := ret[0].(*db.NotificationTheme)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetNotificationTheme indicates an expected call of GetNotificationTheme.
+func (mr *MockRepositoryMockRecorder) GetNotificationTheme(ctx, organizationID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetNotificationTheme", reflect.TypeOf((*MockRepository)(nil).GetNotificationTheme), ctx, organizationID)
+}
+
+// GetOrganization mocks base method.
+func (m *MockRepository) GetOrganization(ctx context.Context, organizationID string) (*db.Organization, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetOrganization", ctx, organizationID)
+	ret0, _ := ret[0].(*db.Organization)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetOrganization indicates an expected call of GetOrganization.
+func (mr *MockRepositoryMockRecorder) GetOrganization(ctx, organizationID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOrganization", reflect.TypeOf((*MockRepository)(nil).GetOrganization), ctx, organizationID)
+}
+
+// GetOrganizationIDBySCIMToken mocks base method.
+func (m *MockRepository) GetOrganizationIDBySCIMToken(ctx context.Context, tokenHash string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetOrganizationIDBySCIMToken", ctx, tokenHash)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetOrganizationIDBySCIMToken indicates an expected call of GetOrganizationIDBySCIMToken.
+func (mr *MockRepositoryMockRecorder) GetOrganizationIDBySCIMToken(ctx, tokenHash any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOrganizationIDBySCIMToken", reflect.TypeOf((*MockRepository)(nil).GetOrganizationIDBySCIMToken), ctx, tokenHash)
+}
+
+// GetOrganizationMember mocks base method.
+func (m *MockRepository) GetOrganizationMember(ctx context.Context, organizationID, userID string) (*db.OrganizationMember, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetOrganizationMember", ctx, organizationID, userID)
+	ret0, _ := ret[0].(*db.OrganizationMember)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetOrganizationMember indicates an expected call of GetOrganizationMember.
+func (mr *MockRepositoryMockRecorder) GetOrganizationMember(ctx, organizationID, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOrganizationMember", reflect.TypeOf((*MockRepository)(nil).GetOrganizationMember), ctx, organizationID, userID)
+}
+
+// GetOrganizationRetention mocks base method.
+func (m *MockRepository) GetOrganizationRetention(ctx context.Context, organizationID string) (*db.OrganizationRetention, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetOrganizationRetention", ctx, organizationID)
+	ret0, _ := ret[0].(*db.OrganizationRetention)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetOrganizationRetention indicates an expected call of GetOrganizationRetention.
+func (mr *MockRepositoryMockRecorder) GetOrganizationRetention(ctx, organizationID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOrganizationRetention", reflect.TypeOf((*MockRepository)(nil).GetOrganizationRetention), ctx, organizationID)
+}
+
+// GetPendingEmail mocks base method.
+func (m *MockRepository) GetPendingEmail(ctx context.Context, userID string) (*string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPendingEmail", ctx, userID)
+	ret0, _ := ret[0].(*string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPendingEmail indicates an expected call of GetPendingEmail.
+func (mr *MockRepositoryMockRecorder) GetPendingEmail(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPendingEmail", reflect.TypeOf((*MockRepository)(nil).GetPendingEmail), ctx, userID)
+}
+
+// GetReminderIntervalByID mocks base method.
+func (m *MockRepository) GetReminderIntervalByID(ctx context.Context, id int) (*db.ReminderInterval, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetReminderIntervalByID", ctx, id)
+	ret0, _ := ret[0].(*db.ReminderInterval)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetReminderIntervalByID indicates an expected call of GetReminderIntervalByID.
+func (mr *MockRepositoryMockRecorder) GetReminderIntervalByID(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetReminderIntervalByID", reflect.TypeOf((*MockRepository)(nil).GetReminderIntervalByID), ctx, id)
+}
+
+// GetReminderIntervalsFromIdLabels mocks base method.
+func (m *MockRepository) GetReminderIntervalsFromIdLabels(ctx context.Context, idLabels []string) ([]*db.ReminderInterval, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetReminderIntervalsFromIdLabels", ctx, idLabels)
+	ret0, _ := ret[0].([]*db.ReminderInterval)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetReminderIntervalsFromIdLabels indicates an expected call of GetReminderIntervalsFromIdLabels.
+func (mr *MockRepositoryMockRecorder) GetReminderIntervalsFromIdLabels(ctx, idLabels any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetReminderIntervalsFromIdLabels", reflect.TypeOf((*MockRepository)(nil).GetReminderIntervalsFromIdLabels), ctx, idLabels)
+}
+
+// GetRenewalRequest mocks base method.
+func (m *MockRepository) GetRenewalRequest(ctx context.Context, documentID, requestID string) (*db.RenewalRequest, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRenewalRequest", ctx, documentID, requestID)
+	ret0, _ := ret[0].(*db.RenewalRequest)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetRenewalRequest indicates an expected call of GetRenewalRequest.
+func (mr *MockRepositoryMockRecorder) GetRenewalRequest(ctx, documentID, requestID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRenewalRequest", reflect.TypeOf((*MockRepository)(nil).GetRenewalRequest), ctx, documentID, requestID)
+}
+
+// GetRoadmapFeature mocks base method.
+func (m *MockRepository) GetRoadmapFeature(ctx context.Context, featureID, userID string) (*db.RoadmapFeature, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRoadmapFeature", ctx, featureID, userID)
+	ret0, _ := ret[0].(*db.RoadmapFeature)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetRoadmapFeature indicates an expected call of GetRoadmapFeature.
+func (mr *MockRepositoryMockRecorder) GetRoadmapFeature(ctx, featureID, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRoadmapFeature", reflect.TypeOf((*MockRepository)(nil).GetRoadmapFeature), ctx, featureID, userID)
+}
+
+// GetSavedView mocks base method.
+func (m *MockRepository) GetSavedView(ctx context.Context, viewID string) (*db.SavedView, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSavedView", ctx, viewID)
+	ret0, _ := ret[0].(*db.SavedView)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSavedView indicates an expected call of GetSavedView.
+func (mr *MockRepositoryMockRecorder) GetSavedView(ctx, viewID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSavedView", reflect.TypeOf((*MockRepository)(nil).GetSavedView), ctx, viewID)
+}
+
+// GetServiceAccount mocks base method.
+func (m *MockRepository) GetServiceAccount(ctx context.Context, organizationID, accountID string) (*db.ServiceAccount, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetServiceAccount", ctx, organizationID, accountID)
+	ret0, _ := ret[0].(*db.ServiceAccount)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetServiceAccount indicates an expected call of GetServiceAccount.
+func (mr *MockRepositoryMockRecorder) GetServiceAccount(ctx, organizationID, accountID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetServiceAccount", reflect.TypeOf((*MockRepository)(nil).GetServiceAccount), ctx, organizationID, accountID)
+}
+
+// GetServiceAccountByUserID mocks base method.
+func (m *MockRepository) GetServiceAccountByUserID(ctx context.Context, userID string) (*db.ServiceAccount, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetServiceAccountByUserID", ctx, userID)
+	ret0, _ := ret[0].(*db.ServiceAccount)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetServiceAccountByUserID indicates an expected call of GetServiceAccountByUserID.
+func (mr *MockRepositoryMockRecorder) GetServiceAccountByUserID(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetServiceAccountByUserID", reflect.TypeOf((*MockRepository)(nil).GetServiceAccountByUserID), ctx, userID)
+}
+
+// GetTrashedDocument mocks base method.
+func (m *MockRepository) GetTrashedDocument(ctx context.Context, documentID string) (*db.Document, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTrashedDocument", ctx, documentID)
+	ret0, _ := ret[0].(*db.Document)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTrashedDocument indicates an expected call of GetTrashedDocument.
+func (mr *MockRepositoryMockRecorder) GetTrashedDocument(ctx, documentID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTrashedDocument", reflect.TypeOf((*MockRepository)(nil).GetTrashedDocument), ctx, documentID)
+}
+
+// GetUserByEmail mocks base method.
+func (m *MockRepository) GetUserByEmail(ctx context.Context, email string) (*db.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUserByEmail", ctx, email)
+	ret0, _ := ret[0].(*db.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUserByEmail indicates an expected call of GetUserByEmail.
+func (mr *MockRepositoryMockRecorder) GetUserByEmail(ctx, email any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserByEmail", reflect.TypeOf((*MockRepository)(nil).GetUserByEmail), ctx, email)
+}
+
+// GetUserByID mocks base method.
+func (m *MockRepository) GetUserByID(ctx context.Context, userID string) (*db.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUserByID", ctx, userID)
+	ret0, _ := ret[0].(*db.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUserByID indicates an expected call of GetUserByID.
+func (mr *MockRepositoryMockRecorder) GetUserByID(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserByID", reflect.TypeOf((*MockRepository)(nil).GetUserByID), ctx, userID)
+}
+
+// GetUserByPhoneNumber mocks base method.
+func (m *MockRepository) GetUserByPhoneNumber(ctx context.Context, phoneNumber string) (*db.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUserByPhoneNumber", ctx, phoneNumber)
+	ret0, _ := ret[0].(*db.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUserByPhoneNumber indicates an expected call of GetUserByPhoneNumber.
+func (mr *MockRepositoryMockRecorder) GetUserByPhoneNumber(ctx, phoneNumber any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserByPhoneNumber", reflect.TypeOf((*MockRepository)(nil).GetUserByPhoneNumber), ctx, phoneNumber)
+}
+
+// GetUserEmail mocks base method.
+func (m *MockRepository) GetUserEmail(ctx context.Context, userID string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUserEmail", ctx, userID)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUserEmail indicates an expected call of GetUserEmail.
+func (mr *MockRepositoryMockRecorder) GetUserEmail(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserEmail", reflect.TypeOf((*MockRepository)(nil).GetUserEmail), ctx, userID)
+}
+
+// GetUserIDByFeedToken mocks base method.
+func (m *MockRepository) GetUserIDByFeedToken(ctx context.Context, token string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUserIDByFeedToken", ctx, token)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUserIDByFeedToken indicates an expected call of GetUserIDByFeedToken.
+func (mr *MockRepositoryMockRecorder) GetUserIDByFeedToken(ctx, token any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserIDByFeedToken", reflect.TypeOf((*MockRepository)(nil).GetUserIDByFeedToken), ctx, token)
+}
+
+// GetUserIDByProvider mocks base method.
+func (m *MockRepository) GetUserIDByProvider(ctx context.Context, provider, providerID string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUserIDByProvider", ctx, provider, providerID)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUserIDByProvider indicates an expected call of GetUserIDByProvider.
+func (mr *MockRepositoryMockRecorder) GetUserIDByProvider(ctx, provider, providerID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserIDByProvider", reflect.TypeOf((*MockRepository)(nil).GetUserIDByProvider), ctx, provider, providerID)
+}
+
+// GetUserPhoneNumber mocks base method.
+func (m *MockRepository) GetUserPhoneNumber(ctx context.Context, userID string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUserPhoneNumber", ctx, userID)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUserPhoneNumber indicates an expected call of GetUserPhoneNumber.
+func (mr *MockRepositoryMockRecorder) GetUserPhoneNumber(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserPhoneNumber", reflect.TypeOf((*MockRepository)(nil).GetUserPhoneNumber), ctx, userID)
+}
+
+// GetUserPlan mocks base method.
+func (m *MockRepository) GetUserPlan(ctx context.Context, userID string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUserPlan", ctx, userID)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUserPlan indicates an expected call of GetUserPlan.
+func (mr *MockRepositoryMockRecorder) GetUserPlan(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserPlan", reflect.TypeOf((*MockRepository)(nil).GetUserPlan), ctx, userID)
+}
+
+// GetUserRole mocks base method.
+func (m *MockRepository) GetUserRole(ctx context.Context, userID string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUserRole", ctx, userID)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUserRole indicates an expected call of GetUserRole.
+func (mr *MockRepositoryMockRecorder) GetUserRole(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserRole", reflect.TypeOf((*MockRepository)(nil).GetUserRole), ctx, userID)
+}
+
+// GetValidityPeriod mocks base method.
+func (m *MockRepository) GetValidityPeriod(ctx context.Context, categorySlug, countryCode string) (*db.ValidityPeriod, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetValidityPeriod", ctx, categorySlug, countryCode)
+	ret0, _ := ret[0].(*db.ValidityPeriod)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetValidityPeriod indicates an expected call of GetValidityPeriod.
+func (mr *MockRepositoryMockRecorder) GetValidityPeriod(ctx, categorySlug, countryCode any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetValidityPeriod", reflect.TypeOf((*MockRepository)(nil).GetValidityPeriod), ctx, categorySlug, countryCode)
+}
+
+// GetWebhookDelivery mocks base method.
+func (m *MockRepository) GetWebhookDelivery(ctx context.Context, deliveryID string) (*db.WebhookDelivery, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetWebhookDelivery", ctx, deliveryID)
+	ret0, _ := ret[0].(*db.WebhookDelivery)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetWebhookDelivery indicates an expected call of GetWebhookDelivery.
+func (mr *MockRepositoryMockRecorder) GetWebhookDelivery(ctx, deliveryID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetWebhookDelivery", reflect.TypeOf((*MockRepository)(nil).GetWebhookDelivery), ctx, deliveryID)
+}
+
+// GetWebhookEndpoint mocks base method.
+func (m *MockRepository) GetWebhookEndpoint(ctx context.Context, endpointID string) (*db.WebhookEndpoint, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetWebhookEndpoint", ctx, endpointID)
+	ret0, _ := ret[0].(*db.WebhookEndpoint)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetWebhookEndpoint indicates an expected call of GetWebhookEndpoint.
+func (mr *MockRepositoryMockRecorder) GetWebhookEndpoint(ctx, endpointID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetWebhookEndpoint", reflect.TypeOf((*MockRepository)(nil).GetWebhookEndpoint), ctx, endpointID)
+}
+
+// HoldReminder mocks base method.
+func (m *MockRepository) HoldReminder(ctx context.Context, userID, documentID string, intervalID int, requestID string) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "HoldReminder", ctx, userID, documentID, intervalID, requestID)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// HoldReminder indicates an expected call of HoldReminder.
+func (mr *MockRepositoryMockRecorder) HoldReminder(ctx, userID, documentID, intervalID, requestID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HoldReminder", reflect.TypeOf((*MockRepository)(nil).HoldReminder), ctx, userID, documentID, intervalID, requestID)
+}
+
+// IsActionLinkUsed mocks base method.
+func (m *MockRepository) IsActionLinkUsed(ctx context.Context, jti string) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IsActionLinkUsed", ctx, jti)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// IsActionLinkUsed indicates an expected call of IsActionLinkUsed.
+func (mr *MockRepositoryMockRecorder) IsActionLinkUsed(ctx, jti any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsActionLinkUsed", reflect.TypeOf((*MockRepository)(nil).IsActionLinkUsed), ctx, jti)
+}
+
+// IsComplianceDocument mocks base method.
+func (m *MockRepository) IsComplianceDocument(ctx context.Context, doc *db.Document) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IsComplianceDocument", ctx, doc)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// IsComplianceDocument indicates an expected call of IsComplianceDocument.
+func (mr *MockRepositoryMockRecorder) IsComplianceDocument(ctx, doc any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsComplianceDocument", reflect.TypeOf((*MockRepository)(nil).IsComplianceDocument), ctx, doc)
+}
+
+// IsHouseholdPrimaryOf mocks base method.
+func (m *MockRepository) IsHouseholdPrimaryOf(ctx context.Context, primaryUserID, memberUserID string) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IsHouseholdPrimaryOf", ctx, primaryUserID, memberUserID)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// IsHouseholdPrimaryOf indicates an expected call of IsHouseholdPrimaryOf.
+func (mr *MockRepositoryMockRecorder) IsHouseholdPrimaryOf(ctx, primaryUserID, memberUserID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsHouseholdPrimaryOf", reflect.TypeOf((*MockRepository)(nil).IsHouseholdPrimaryOf), ctx, primaryUserID, memberUserID)
+}
+
+// IsSessionRevoked mocks base method.
+func (m *MockRepository) IsSessionRevoked(ctx context.Context, sessionID string) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IsSessionRevoked", ctx, sessionID)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// IsSessionRevoked indicates an expected call of IsSessionRevoked.
+func (mr *MockRepositoryMockRecorder) IsSessionRevoked(ctx, sessionID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsSessionRevoked", reflect.TypeOf((*MockRepository)(nil).IsSessionRevoked), ctx, sessionID)
+}
+
+// IsUserDeactivated mocks base method.
+func (m *MockRepository) IsUserDeactivated(ctx context.Context, userID string) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IsUserDeactivated", ctx, userID)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// IsUserDeactivated indicates an expected call of IsUserDeactivated.
+func (mr *MockRepositoryMockRecorder) IsUserDeactivated(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsUserDeactivated", reflect.TypeOf((*MockRepository)(nil).IsUserDeactivated), ctx, userID)
+}
+
+// IsUserSuspended mocks base method.
+func (m *MockRepository) IsUserSuspended(ctx context.Context, userID string) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IsUserSuspended", ctx, userID)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// IsUserSuspended indicates an expected call of IsUserSuspended.
+func (mr *MockRepositoryMockRecorder) IsUserSuspended(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsUserSuspended", reflect.TypeOf((*MockRepository)(nil).IsUserSuspended), ctx, userID)
+}
+
+// LinkUserProvider mocks base method.
+func (m *MockRepository) LinkUserProvider(ctx context.Context, userID, provider, providerID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LinkUserProvider", ctx, userID, provider, providerID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// LinkUserProvider indicates an expected call of LinkUserProvider.
+func (mr *MockRepositoryMockRecorder) LinkUserProvider(ctx, userID, provider, providerID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LinkUserProvider", reflect.TypeOf((*MockRepository)(nil).LinkUserProvider), ctx, userID, provider, providerID)
+}
+
+// ListAPIKeys mocks base method.
+func (m *MockRepository) ListAPIKeys(ctx context.Context, userID string) ([]*db.APIKey, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListAPIKeys", ctx, userID)
+	ret0, _ := ret[0].([]*db.APIKey)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListAPIKeys indicates an expected call of ListAPIKeys.
+func (mr *MockRepositoryMockRecorder) ListAPIKeys(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAPIKeys", reflect.TypeOf((*MockRepository)(nil).ListAPIKeys), ctx, userID)
+}
+
+// ListActiveAnnouncements mocks base method.
+func (m *MockRepository) ListActiveAnnouncements(ctx context.Context) ([]*db.Announcement, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListActiveAnnouncements", ctx)
+	ret0, _ := ret[0].([]*db.Announcement)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListActiveAnnouncements indicates an expected call of ListActiveAnnouncements.
+func (mr *MockRepositoryMockRecorder) ListActiveAnnouncements(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListActiveAnnouncements", reflect.TypeOf((*MockRepository)(nil).ListActiveAnnouncements), ctx)
+}
+
+// ListAnnouncements mocks base method.
+func (m *MockRepository) ListAnnouncements(ctx context.Context) ([]*db.Announcement, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListAnnouncements", ctx)
+	ret0, _ := ret[0].([]*db.Announcement)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListAnnouncements indicates an expected call of ListAnnouncements.
+func (mr *MockRepositoryMockRecorder) ListAnnouncements(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAnnouncements", reflect.TypeOf((*MockRepository)(nil).ListAnnouncements), ctx)
+}
+
+// ListChecklistItems mocks base method.
+func (m *MockRepository) ListChecklistItems(ctx context.Context, documentID string) ([]*db.ChecklistItem, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListChecklistItems", ctx, documentID)
+	ret0, _ := ret[0].([]*db.ChecklistItem)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListChecklistItems indicates an expected call of ListChecklistItems.
+func (mr *MockRepositoryMockRecorder) ListChecklistItems(ctx, documentID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListChecklistItems", reflect.TypeOf((*MockRepository)(nil).ListChecklistItems), ctx, documentID)
+}
+
+// ListDeletedAccountDocuments mocks base method.
+func (m *MockRepository) ListDeletedAccountDocuments(ctx context.Context, cutoff time.Time, limit int) ([]*db.Document, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListDeletedAccountDocuments", ctx, cutoff, limit)
+	ret0, _ := ret[0].([]*db.Document)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListDeletedAccountDocuments indicates an expected call of ListDeletedAccountDocuments.
+func (mr *MockRepositoryMockRecorder) ListDeletedAccountDocuments(ctx, cutoff, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListDeletedAccountDocuments", reflect.TypeOf((*MockRepository)(nil).ListDeletedAccountDocuments), ctx, cutoff, limit)
+}
+
+// ListDocumentCategories mocks base method.
+func (m *MockRepository) ListDocumentCategories(ctx context.Context) ([]*db.DocumentCategory, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListDocumentCategories", ctx)
+	ret0, _ := ret[0].([]*db.DocumentCategory)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListDocumentCategories indicates an expected call of ListDocumentCategories.
+func (mr *MockRepositoryMockRecorder) ListDocumentCategories(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListDocumentCategories", reflect.TypeOf((*MockRepository)(nil).ListDocumentCategories), ctx)
+}
+
+// ListDocumentContacts mocks base method.
+func (m *MockRepository) ListDocumentContacts(ctx context.Context, documentID string) ([]*db.DocumentContact, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListDocumentContacts", ctx, documentID)
+	ret0, _ := ret[0].([]*db.DocumentContact)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListDocumentContacts indicates an expected call of ListDocumentContacts.
+func (mr *MockRepositoryMockRecorder) ListDocumentContacts(ctx, documentID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListDocumentContacts", reflect.TypeOf((*MockRepository)(nil).ListDocumentContacts), ctx, documentID)
+}
+
+// ListDocumentCustomFields mocks base method.
+func (m *MockRepository) ListDocumentCustomFields(ctx context.Context, documentID string) ([]*db.CustomField, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListDocumentCustomFields", ctx, documentID)
+	ret0, _ := ret[0].([]*db.CustomField)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListDocumentCustomFields indicates an expected call of ListDocumentCustomFields.
+func (mr *MockRepositoryMockRecorder) ListDocumentCustomFields(ctx, documentID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListDocumentCustomFields", reflect.TypeOf((*MockRepository)(nil).ListDocumentCustomFields), ctx, documentID)
+}
+
+// ListDocumentDependencies mocks base method.
+func (m *MockRepository) ListDocumentDependencies(ctx context.Context, documentID string) ([]*db.Document, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListDocumentDependencies", ctx, documentID)
+	ret0, _ := ret[0].([]*db.Document)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListDocumentDependencies indicates an expected call of ListDocumentDependencies.
+func (mr *MockRepositoryMockRecorder) ListDocumentDependencies(ctx, documentID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListDocumentDependencies", reflect.TypeOf((*MockRepository)(nil).ListDocumentDependencies), ctx, documentID)
+}
+
+// ListDocumentDependencyEdges mocks base method.
+func (m *MockRepository) ListDocumentDependencyEdges(ctx context.Context, documentIDs []string) ([]*db.DocumentDependency, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListDocumentDependencyEdges", ctx, documentIDs)
+	ret0, _ := ret[0].([]*db.DocumentDependency)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListDocumentDependencyEdges indicates an expected call of ListDocumentDependencyEdges.
+func (mr *MockRepositoryMockRecorder) ListDocumentDependencyEdges(ctx, documentIDs any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListDocumentDependencyEdges", reflect.TypeOf((*MockRepository)(nil).ListDocumentDependencyEdges), ctx, documentIDs)
+}
+
+// ListDocumentDependents mocks base method.
+func (m *MockRepository) ListDocumentDependents(ctx context.Context, documentID string) ([]*db.Document, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListDocumentDependents", ctx, documentID)
+	ret0, _ := ret[0].([]*db.Document)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListDocumentDependents indicates an expected call of ListDocumentDependents.
+func (mr *MockRepositoryMockRecorder) ListDocumentDependents(ctx, documentID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListDocumentDependents", reflect.TypeOf((*MockRepository)(nil).ListDocumentDependents), ctx, documentID)
+}
+
+// ListDocumentPositions mocks base method.
+func (m *MockRepository) ListDocumentPositions(ctx context.Context, userID string) ([]*db.DocumentPosition, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListDocumentPositions", ctx, userID)
+	ret0, _ := ret[0].([]*db.DocumentPosition)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListDocumentPositions indicates an expected call of ListDocumentPositions.
+func (mr *MockRepositoryMockRecorder) ListDocumentPositions(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListDocumentPositions", reflect.TypeOf((*MockRepository)(nil).ListDocumentPositions), ctx, userID)
+}
+
+// ListDocumentTemplates mocks base method.
+func (m *MockRepository) ListDocumentTemplates(ctx context.Context) ([]*db.DocumentTemplate, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListDocumentTemplates", ctx)
+	ret0, _ := ret[0].([]*db.DocumentTemplate)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListDocumentTemplates indicates an expected call of ListDocumentTemplates.
+func (mr *MockRepositoryMockRecorder) ListDocumentTemplates(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListDocumentTemplates", reflect.TypeOf((*MockRepository)(nil).ListDocumentTemplates), ctx)
+}
+
+// ListDocumentsByUserID mocks base method.
+func (m *MockRepository) ListDocumentsByUserID(ctx context.Context, userID string) ([]*db.Document, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListDocumentsByUserID", ctx, userID)
+	ret0, _ := ret[0].([]*db.Document)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListDocumentsByUserID indicates an expected call of ListDocumentsByUserID.
+func (mr *MockRepositoryMockRecorder) ListDocumentsByUserID(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListDocumentsByUserID", reflect.TypeOf((*MockRepository)(nil).ListDocumentsByUserID), ctx, userID)
+}
+
+// ListDocumentsTrashedBefore mocks base method.
+func (m *MockRepository) ListDocumentsTrashedBefore(ctx context.Context, cutoff time.Time, limit int) ([]*db.Document, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListDocumentsTrashedBefore", ctx, cutoff, limit)
+	ret0, _ := ret[0].([]*db.Document)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListDocumentsTrashedBefore indicates an expected call of ListDocumentsTrashedBefore.
+func (mr *MockRepositoryMockRecorder) ListDocumentsTrashedBefore(ctx, cutoff, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListDocumentsTrashedBefore", reflect.TypeOf((*MockRepository)(nil).ListDocumentsTrashedBefore), ctx, cutoff, limit)
+}
+
+// ListDocumentsWithAttachmentsExpiredBefore mocks base method.
+func (m *MockRepository) ListDocumentsWithAttachmentsExpiredBefore(ctx context.Context, before time.Time, afterID string, limit int) ([]*db.Document, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListDocumentsWithAttachmentsExpiredBefore", ctx, before, afterID, limit)
+	ret0, _ := ret[0].([]*db.Document)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListDocumentsWithAttachmentsExpiredBefore indicates an expected call of ListDocumentsWithAttachmentsExpiredBefore.
+func (mr *MockRepositoryMockRecorder) ListDocumentsWithAttachmentsExpiredBefore(ctx, before, afterID, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListDocumentsWithAttachmentsExpiredBefore", reflect.TypeOf((*MockRepository)(nil).ListDocumentsWithAttachmentsExpiredBefore), ctx, before, afterID, limit)
+}
+
+// ListEmailOpenTimes mocks base method.
+func (m *MockRepository) ListEmailOpenTimes(ctx context.Context, recipientID string, since time.Time, limit int) ([]time.Time, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListEmailOpenTimes", ctx, recipientID, since, limit)
+	ret0, _ := ret[0].([]time.Time)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListEmailOpenTimes indicates an expected call of ListEmailOpenTimes.
+func (mr *MockRepositoryMockRecorder) ListEmailOpenTimes(ctx, recipientID, since, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListEmailOpenTimes", reflect.TypeOf((*MockRepository)(nil).ListEmailOpenTimes), ctx, recipientID, since, limit)
+}
+
+// ListEvents mocks base method.
+func (m *MockRepository) ListEvents(ctx context.Context, organizationID string) ([]*db.EventLogEntry, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListEvents", ctx, organizationID)
+	ret0, _ := ret[0].([]*db.EventLogEntry)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListEvents indicates an expected call of ListEvents.
+func (mr *MockRepositoryMockRecorder) ListEvents(ctx, organizationID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListEvents", reflect.TypeOf((*MockRepository)(nil).ListEvents), ctx, organizationID)
+}
+
+// ListExpiringDocuments mocks base method.
+func (m *MockRepository) ListExpiringDocuments(ctx context.Context, from, to time.Time) ([]*db.ExpiringDocument, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListExpiringDocuments", ctx, from, to)
+	ret0, _ := ret[0].([]*db.ExpiringDocument)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListExpiringDocuments indicates an expected call of ListExpiringDocuments.
+func (mr *MockRepositoryMockRecorder) ListExpiringDocuments(ctx, from, to any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListExpiringDocuments", reflect.TypeOf((*MockRepository)(nil).ListExpiringDocuments), ctx, from, to)
+}
+
+// ListHouseholdMembers mocks base method.
+func (m *MockRepository) ListHouseholdMembers(ctx context.Context, householdID string) ([]*db.HouseholdMember, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListHouseholdMembers", ctx, householdID)
+	ret0, _ := ret[0].([]*db.HouseholdMember)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListHouseholdMembers indicates an expected call of ListHouseholdMembers.
+func (mr *MockRepositoryMockRecorder) ListHouseholdMembers(ctx, householdID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListHouseholdMembers", reflect.TypeOf((*MockRepository)(nil).ListHouseholdMembers), ctx, householdID)
+}
+
+// ListImportChunkResults mocks base method.
+func (m *MockRepository) ListImportChunkResults(ctx context.Context, jobID string) ([]json.RawMessage, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListImportChunkResults", ctx, jobID)
+	ret0, _ := ret[0].([]json.RawMessage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListImportChunkResults indicates an expected call of ListImportChunkResults.
+func (mr *MockRepositoryMockRecorder) ListImportChunkResults(ctx, jobID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListImportChunkResults", reflect.TypeOf((*MockRepository)(nil).ListImportChunkResults), ctx, jobID)
+}
+
+// ListIssuers mocks base method.
+func (m *MockRepository) ListIssuers(ctx context.Context, countryCode string) ([]*db.Issuer, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListIssuers", ctx, countryCode)
+	ret0, _ := ret[0].([]*db.Issuer)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListIssuers indicates an expected call of ListIssuers.
+func (mr *MockRepositoryMockRecorder) ListIssuers(ctx, countryCode any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListIssuers", reflect.TypeOf((*MockRepository)(nil).ListIssuers), ctx, countryCode)
+}
+
+// ListJobs mocks base method.
+func (m *MockRepository) ListJobs(ctx context.Context, userID string, limit int) ([]*db.Job, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListJobs", ctx, userID, limit)
+	ret0, _ := ret[0].([]*db.Job)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListJobs indicates an expected call of ListJobs.
+func (mr *MockRepositoryMockRecorder) ListJobs(ctx, userID, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListJobs", reflect.TypeOf((*MockRepository)(nil).ListJobs), ctx, userID, limit)
+}
+
+// ListLegalHolds mocks base method.
+func (m *MockRepository) ListLegalHolds(ctx context.Context, organizationID string) ([]*db.LegalHold, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListLegalHolds", ctx, organizationID)
+	ret0, _ := ret[0].([]*db.LegalHold)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListLegalHolds indicates an expected call of ListLegalHolds.
+func (mr *MockRepositoryMockRecorder) ListLegalHolds(ctx, organizationID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListLegalHolds", reflect.TypeOf((*MockRepository)(nil).ListLegalHolds), ctx, organizationID)
+}
+
+// ListNotificationLogs mocks base method.
+func (m *MockRepository) ListNotificationLogs(ctx context.Context, userID, documentID string, limit int) ([]*db.NotificationLog, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListNotificationLogs", ctx, userID, documentID, limit)
+	ret0, _ := ret[0].([]*db.NotificationLog)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListNotificationLogs indicates an expected call of ListNotificationLogs.
+func (mr *MockRepositoryMockRecorder) ListNotificationLogs(ctx, userID, documentID, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListNotificationLogs", reflect.TypeOf((*MockRepository)(nil).ListNotificationLogs), ctx, userID, documentID, limit)
+}
+
+// ListNotificationLogsByMessageID mocks base method.
+func (m *MockRepository) ListNotificationLogsByMessageID(ctx context.Context, messageID string) ([]*db.NotificationLog, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListNotificationLogsByMessageID", ctx, messageID)
+	ret0, _ := ret[0].([]*db.NotificationLog)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListNotificationLogsByMessageID indicates an expected call of ListNotificationLogsByMessageID.
+func (mr *MockRepositoryMockRecorder) ListNotificationLogsByMessageID(ctx, messageID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListNotificationLogsByMessageID", reflect.TypeOf((*MockRepository)(nil).ListNotificationLogsByMessageID), ctx, messageID)
+}
+
+// ListOrganizationDocumentsExpiredBefore mocks base method.
+func (m *MockRepository) ListOrganizationDocumentsExpiredBefore(ctx context.Context, organizationID string, cutoff time.Time, limit int) ([]*db.Document, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListOrganizationDocumentsExpiredBefore", ctx, organizationID, cutoff, limit)
+	ret0, _ := ret[0].([]*db.Document)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListOrganizationDocumentsExpiredBefore indicates an expected call of ListOrganizationDocumentsExpiredBefore.
+func (mr *MockRepositoryMockRecorder) ListOrganizationDocumentsExpiredBefore(ctx, organizationID, cutoff, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListOrganizationDocumentsExpiredBefore", reflect.TypeOf((*MockRepository)(nil).ListOrganizationDocumentsExpiredBefore), ctx, organizationID, cutoff, limit)
+}
+
+// ListOrganizationMembers mocks base method.
+func (m *MockRepository) ListOrganizationMembers(ctx context.Context, organizationID string) ([]*db.OrganizationMember, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListOrganizationMembers", ctx, organizationID)
+	ret0, _ := ret[0].([]*db.OrganizationMember)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListOrganizationMembers indicates an expected call of ListOrganizationMembers.
+func (mr *MockRepositoryMockRecorder) ListOrganizationMembers(ctx, organizationID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListOrganizationMembers", reflect.TypeOf((*MockRepository)(nil).ListOrganizationMembers), ctx, organizationID)
+}
+
+// ListOrganizationRetention mocks base method.
+func (m *MockRepository) ListOrganizationRetention(ctx context.Context) ([]*db.OrganizationRetention, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListOrganizationRetention", ctx)
+	ret0, _ := ret[0].([]*db.OrganizationRetention)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListOrganizationRetention indicates an expected call of ListOrganizationRetention.
+func (mr *MockRepositoryMockRecorder) ListOrganizationRetention(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListOrganizationRetention", reflect.TypeOf((*MockRepository)(nil).ListOrganizationRetention), ctx)
+}
+
+// ListOrganizationUsage mocks base method.
+func (m *MockRepository) ListOrganizationUsage(ctx context.Context, organizationID, from, to string) ([]*db.OrganizationUsage, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListOrganizationUsage", ctx, organizationID, from, to)
+	ret0, _ := ret[0].([]*db.OrganizationUsage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListOrganizationUsage indicates an expected call of ListOrganizationUsage.
+func (mr *MockRepositoryMockRecorder) ListOrganizationUsage(ctx, organizationID, from, to any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListOrganizationUsage", reflect.TypeOf((*MockRepository)(nil).ListOrganizationUsage), ctx, organizationID, from, to)
+}
+
+// ListOrganizationsByUserID mocks base method.
+func (m *MockRepository) ListOrganizationsByUserID(ctx context.Context, userID string) ([]*db.Organization, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListOrganizationsByUserID", ctx, userID)
+	ret0, _ := ret[0].([]*db.Organization)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListOrganizationsByUserID indicates an expected call of ListOrganizationsByUserID.
+func (mr *MockRepositoryMockRecorder) ListOrganizationsByUserID(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListOrganizationsByUserID", reflect.TypeOf((*MockRepository)(nil).ListOrganizationsByUserID), ctx, userID)
+}
+
+// ListPinnedDataRegions mocks base method.
+func (m *MockRepository) ListPinnedDataRegions(ctx context.Context) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListPinnedDataRegions", ctx)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListPinnedDataRegions indicates an expected call of ListPinnedDataRegions.
+func (mr *MockRepositoryMockRecorder) ListPinnedDataRegions(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListPinnedDataRegions", reflect.TypeOf((*MockRepository)(nil).ListPinnedDataRegions), ctx)
+}
+
+// ListRenewalCosts mocks base method.
+func (m *MockRepository) ListRenewalCosts(ctx context.Context, userID string, from, to time.Time) ([]*db.RenewalCostTotal, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListRenewalCosts", ctx, userID, from, to)
+	ret0, _ := ret[0].([]*db.RenewalCostTotal)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListRenewalCosts indicates an expected call of ListRenewalCosts.
+func (mr *MockRepositoryMockRecorder) ListRenewalCosts(ctx, userID, from, to any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListRenewalCosts", reflect.TypeOf((*MockRepository)(nil).ListRenewalCosts), ctx, userID, from, to)
+}
+
+// ListRenewalRequests mocks base method.
+func (m *MockRepository) ListRenewalRequests(ctx context.Context, documentID string) ([]*db.RenewalRequest, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListRenewalRequests", ctx, documentID)
+	ret0, _ := ret[0].([]*db.RenewalRequest)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListRenewalRequests indicates an expected call of ListRenewalRequests.
+func (mr *MockRepositoryMockRecorder) ListRenewalRequests(ctx, documentID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListRenewalRequests", reflect.TypeOf((*MockRepository)(nil).ListRenewalRequests), ctx, documentID)
+}
+
+// ListRoadmapFeatures mocks base method.
+func (m *MockRepository) ListRoadmapFeatures(ctx context.Context, userID string) ([]*db.RoadmapFeature, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListRoadmapFeatures", ctx, userID)
+	ret0, _ := ret[0].([]*db.RoadmapFeature)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListRoadmapFeatures indicates an expected call of ListRoadmapFeatures.
+func (mr *MockRepositoryMockRecorder) ListRoadmapFeatures(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListRoadmapFeatures", reflect.TypeOf((*MockRepository)(nil).ListRoadmapFeatures), ctx, userID)
+}
+
+// ListSampleDocumentIDs mocks base method.
+func (m *MockRepository) ListSampleDocumentIDs(ctx context.Context, userID string) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListSampleDocumentIDs", ctx, userID)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListSampleDocumentIDs indicates an expected call of ListSampleDocumentIDs.
+func (mr *MockRepositoryMockRecorder) ListSampleDocumentIDs(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListSampleDocumentIDs", reflect.TypeOf((*MockRepository)(nil).ListSampleDocumentIDs), ctx, userID)
+}
+
+// ListSavedViews mocks base method.
+func (m *MockRepository) ListSavedViews(ctx context.Context, userID string) ([]*db.SavedView, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListSavedViews", ctx, userID)
+	ret0, _ := ret[0].([]*db.SavedView)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListSavedViews indicates an expected call of ListSavedViews.
+func (mr *MockRepositoryMockRecorder) ListSavedViews(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListSavedViews", reflect.TypeOf((*MockRepository)(nil).ListSavedViews), ctx, userID)
+}
+
+// ListServiceAccounts mocks base method.
+func (m *MockRepository) ListServiceAccounts(ctx context.Context, organizationID string) ([]*db.ServiceAccount, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListServiceAccounts", ctx, organizationID)
+	ret0, _ := ret[0].([]*db.ServiceAccount)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListServiceAccounts indicates an expected call of ListServiceAccounts.
+func (mr *MockRepositoryMockRecorder) ListServiceAccounts(ctx, organizationID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListServiceAccounts", reflect.TypeOf((*MockRepository)(nil).ListServiceAccounts), ctx, organizationID)
+}
+
+// ListSessions mocks base method.
+func (m *MockRepository) ListSessions(ctx context.Context, userID string) ([]*db.Session, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListSessions", ctx, userID)
+	ret0, _ := ret[0].([]*db.Session)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListSessions indicates an expected call of ListSessions.
+func (mr *MockRepositoryMockRecorder) ListSessions(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListSessions", reflect.TypeOf((*MockRepository)(nil).ListSessions), ctx, userID)
+}
+
+// ListTrashedDocuments mocks base method.
+func (m *MockRepository) ListTrashedDocuments(ctx context.Context, userID string) ([]*db.Document, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListTrashedDocuments", ctx, userID)
+	ret0, _ := ret[0].([]*db.Document)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListTrashedDocuments indicates an expected call of ListTrashedDocuments.
+func (mr *MockRepositoryMockRecorder) ListTrashedDocuments(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListTrashedDocuments", reflect.TypeOf((*MockRepository)(nil).ListTrashedDocuments), ctx, userID)
+}
+
+// ListUsersAfter mocks base method.
+func (m *MockRepository) ListUsersAfter(ctx context.Context, afterID uuid.UUID, limit int) ([]*db.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListUsersAfter", ctx, afterID, limit)
+	ret0, _ := ret[0].([]*db.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListUsersAfter indicates an expected call of ListUsersAfter.
+func (mr *MockRepositoryMockRecorder) ListUsersAfter(ctx, afterID, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListUsersAfter", reflect.TypeOf((*MockRepository)(nil).ListUsersAfter), ctx, afterID, limit)
+}
+
+// ListWebhookDeliveries mocks base method.
+func (m *MockRepository) ListWebhookDeliveries(ctx context.Context, endpointID string, limit int) ([]*db.WebhookDelivery, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListWebhookDeliveries", ctx, endpointID, limit)
+	ret0, _ := ret[0].([]*db.WebhookDelivery)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListWebhookDeliveries indicates an expected call of ListWebhookDeliveries.
+func (mr *MockRepositoryMockRecorder) ListWebhookDeliveries(ctx, endpointID, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListWebhookDeliveries", reflect.TypeOf((*MockRepository)(nil).ListWebhookDeliveries), ctx, endpointID, limit)
+}
+
+// ListWebhookEndpoints mocks base method.
+func (m *MockRepository) ListWebhookEndpoints(ctx context.Context, userID string) ([]*db.WebhookEndpoint, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListWebhookEndpoints", ctx, userID)
+	ret0, _ := ret[0].([]*db.WebhookEndpoint)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListWebhookEndpoints indicates an expected call of ListWebhookEndpoints.
+func (mr *MockRepositoryMockRecorder) ListWebhookEndpoints(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListWebhookEndpoints", reflect.TypeOf((*MockRepository)(nil).ListWebhookEndpoints), ctx, userID)
+}
+
+// ListWebhookEndpointsForEvent mocks base method.
+func (m *MockRepository) ListWebhookEndpointsForEvent(ctx context.Context, userID, event string) ([]*db.WebhookEndpoint, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListWebhookEndpointsForEvent", ctx, userID, event)
+	ret0, _ := ret[0].([]*db.WebhookEndpoint)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListWebhookEndpointsForEvent indicates an expected call of ListWebhookEndpointsForEvent.
+func (mr *MockRepositoryMockRecorder) ListWebhookEndpointsForEvent(ctx, userID, event any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListWebhookEndpointsForEvent", reflect.TypeOf((*MockRepository)(nil).ListWebhookEndpointsForEvent), ctx, userID, event)
+}
+
+// MarkDocumentReminderSent mocks base method.
+func (m *MockRepository) MarkDocumentReminderSent(ctx context.Context, documentID string, reminderIntervalID int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MarkDocumentReminderSent", ctx, documentID, reminderIntervalID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// MarkDocumentReminderSent indicates an expected call of MarkDocumentReminderSent.
+func (mr *MockRepositoryMockRecorder) MarkDocumentReminderSent(ctx, documentID, reminderIntervalID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkDocumentReminderSent", reflect.TypeOf((*MockRepository)(nil).MarkDocumentReminderSent), ctx, documentID, reminderIntervalID)
+}
+
+// MarkFeedbackRelayed mocks base method.
+func (m *MockRepository) MarkFeedbackRelayed(ctx context.Context, feedbackID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MarkFeedbackRelayed", ctx, feedbackID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// MarkFeedbackRelayed indicates an expected call of MarkFeedbackRelayed.
+func (mr *MockRepositoryMockRecorder) MarkFeedbackRelayed(ctx, feedbackID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkFeedbackRelayed", reflect.TypeOf((*MockRepository)(nil).MarkFeedbackRelayed), ctx, feedbackID)
+}
+
+// MarkNotificationBounced mocks base method.
+func (m *MockRepository) MarkNotificationBounced(ctx context.Context, messageID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MarkNotificationBounced", ctx, messageID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// MarkNotificationBounced indicates an expected call of MarkNotificationBounced.
+func (mr *MockRepositoryMockRecorder) MarkNotificationBounced(ctx, messageID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkNotificationBounced", reflect.TypeOf((*MockRepository)(nil).MarkNotificationBounced), ctx, messageID)
+}
+
+// MarkNotificationEscalated mocks base method.
+func (m *MockRepository) MarkNotificationEscalated(ctx context.Context, messageID string) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MarkNotificationEscalated", ctx, messageID)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MarkNotificationEscalated indicates an expected call of MarkNotificationEscalated.
+func (mr *MockRepositoryMockRecorder) MarkNotificationEscalated(ctx, messageID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkNotificationEscalated", reflect.TypeOf((*MockRepository)(nil).MarkNotificationEscalated), ctx, messageID)
+}
+
+// MarkNotificationOpened mocks base method.
+func (m *MockRepository) MarkNotificationOpened(ctx context.Context, messageID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MarkNotificationOpened", ctx, messageID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// MarkNotificationOpened indicates an expected call of MarkNotificationOpened.
+func (mr *MockRepositoryMockRecorder) MarkNotificationOpened(ctx, messageID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkNotificationOpened", reflect.TypeOf((*MockRepository)(nil).MarkNotificationOpened), ctx, messageID)
+}
+
+// MarkNotificationsRead mocks base method.
+func (m *MockRepository) MarkNotificationsRead(ctx context.Context, userID string) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MarkNotificationsRead", ctx, userID)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MarkNotificationsRead indicates an expected call of MarkNotificationsRead.
+func (mr *MockRepositoryMockRecorder) MarkNotificationsRead(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkNotificationsRead", reflect.TypeOf((*MockRepository)(nil).MarkNotificationsRead), ctx, userID)
+}
+
+// MarkSampleDocument mocks base method.
+func (m *MockRepository) MarkSampleDocument(ctx context.Context, userID, documentID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MarkSampleDocument", ctx, userID, documentID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// MarkSampleDocument indicates an expected call of MarkSampleDocument.
+func (mr *MockRepositoryMockRecorder) MarkSampleDocument(ctx, userID, documentID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkSampleDocument", reflect.TypeOf((*MockRepository)(nil).MarkSampleDocument), ctx, userID, documentID)
+}
+
+// MeterOrganizationUsage mocks base method.
+func (m *MockRepository) MeterOrganizationUsage(ctx context.Context, month time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MeterOrganizationUsage", ctx, month)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// MeterOrganizationUsage indicates an expected call of MeterOrganizationUsage.
+func (mr *MockRepositoryMockRecorder) MeterOrganizationUsage(ctx, month any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MeterOrganizationUsage", reflect.TypeOf((*MockRepository)(nil).MeterOrganizationUsage), ctx, month)
+}
+
+// NextImportChunk mocks base method.
+func (m *MockRepository) NextImportChunk(ctx context.Context, jobID string) (*db.ImportChunk, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NextImportChunk", ctx, jobID)
+	ret0, _ := ret[0].(*db.ImportChunk)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// NextImportChunk indicates an expected call of NextImportChunk.
+func (mr *MockRepositoryMockRecorder) NextImportChunk(ctx, jobID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NextImportChunk", reflect.TypeOf((*MockRepository)(nil).NextImportChunk), ctx, jobID)
+}
+
+// PlaceLegalHold mocks base method.
+func (m *MockRepository) PlaceLegalHold(ctx context.Context, hold *db.LegalHold) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PlaceLegalHold", ctx, hold)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PlaceLegalHold indicates an expected call of PlaceLegalHold.
+func (mr *MockRepositoryMockRecorder) PlaceLegalHold(ctx, hold any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PlaceLegalHold", reflect.TypeOf((*MockRepository)(nil).PlaceLegalHold), ctx, hold)
+}
+
+// PruneEventsBefore mocks base method.
+func (m *MockRepository) PruneEventsBefore(ctx context.Context, organizationID string, cutoff time.Time) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PruneEventsBefore", ctx, organizationID, cutoff)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PruneEventsBefore indicates an expected call of PruneEventsBefore.
+func (mr *MockRepositoryMockRecorder) PruneEventsBefore(ctx, organizationID, cutoff any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PruneEventsBefore", reflect.TypeOf((*MockRepository)(nil).PruneEventsBefore), ctx, organizationID, cutoff)
+}
+
+// PurgeDocument mocks base method.
+func (m *MockRepository) PurgeDocument(ctx context.Context, documentID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PurgeDocument", ctx, documentID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// PurgeDocument indicates an expected call of PurgeDocument.
+func (mr *MockRepositoryMockRecorder) PurgeDocument(ctx, documentID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PurgeDocument", reflect.TypeOf((*MockRepository)(nil).PurgeDocument), ctx, documentID)
+}
+
+// QuarantineAttachment mocks base method.
+func (m *MockRepository) QuarantineAttachment(ctx context.Context, documentID, attachmentURL, threat string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "QuarantineAttachment", ctx, documentID, attachmentURL, threat)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// QuarantineAttachment indicates an expected call of QuarantineAttachment.
+func (mr *MockRepositoryMockRecorder) QuarantineAttachment(ctx, documentID, attachmentURL, threat any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "QuarantineAttachment", reflect.TypeOf((*MockRepository)(nil).QuarantineAttachment), ctx, documentID, attachmentURL, threat)
+}
+
+// RecordEmailSenderCheck mocks base method.
+func (m *MockRepository) RecordEmailSenderCheck(ctx context.Context, organizationID, token string, verified bool) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RecordEmailSenderCheck", ctx, organizationID, token, verified)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RecordEmailSenderCheck indicates an expected call of RecordEmailSenderCheck.
+func (mr *MockRepositoryMockRecorder) RecordEmailSenderCheck(ctx, organizationID, token, verified any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecordEmailSenderCheck", reflect.TypeOf((*MockRepository)(nil).RecordEmailSenderCheck), ctx, organizationID, token, verified)
+}
+
+// RecordWebhookDeliveryAttempt mocks base method.
+func (m *MockRepository) RecordWebhookDeliveryAttempt(ctx context.Context, delivery *db.WebhookDelivery) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RecordWebhookDeliveryAttempt", ctx, delivery)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RecordWebhookDeliveryAttempt indicates an expected call of RecordWebhookDeliveryAttempt.
+func (mr *MockRepositoryMockRecorder) RecordWebhookDeliveryAttempt(ctx, delivery any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecordWebhookDeliveryAttempt", reflect.TypeOf((*MockRepository)(nil).RecordWebhookDeliveryAttempt), ctx, delivery)
+}
+
+// RefreshExpiringDocuments mocks base method.
+func (m *MockRepository) RefreshExpiringDocuments(ctx context.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RefreshExpiringDocuments", ctx)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RefreshExpiringDocuments indicates an expected call of RefreshExpiringDocuments.
+func (mr *MockRepositoryMockRecorder) RefreshExpiringDocuments(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RefreshExpiringDocuments", reflect.TypeOf((*MockRepository)(nil).RefreshExpiringDocuments), ctx)
+}
+
+// ReinstateUser mocks base method.
+func (m *MockRepository) ReinstateUser(ctx context.Context, userID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReinstateUser", ctx, userID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ReinstateUser indicates an expected call of ReinstateUser.
+func (mr *MockRepositoryMockRecorder) ReinstateUser(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReinstateUser", reflect.TypeOf((*MockRepository)(nil).ReinstateUser), ctx, userID)
+}
+
+// ReleaseActionLink mocks base method.
+func (m *MockRepository) ReleaseActionLink(ctx context.Context, jti string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReleaseActionLink", ctx, jti)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ReleaseActionLink indicates an expected call of ReleaseActionLink.
+func (mr *MockRepositoryMockRecorder) ReleaseActionLink(ctx, jti any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReleaseActionLink", reflect.TypeOf((*MockRepository)(nil).ReleaseActionLink), ctx, jti)
+}
+
+// ReleaseAttachmentBlob mocks base method.
+func (m *MockRepository) ReleaseAttachmentBlob(ctx context.Context, url, documentID string) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReleaseAttachmentBlob", ctx, url, documentID)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReleaseAttachmentBlob indicates an expected call of ReleaseAttachmentBlob.
+func (mr *MockRepositoryMockRecorder) ReleaseAttachmentBlob(ctx, url, documentID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReleaseAttachmentBlob", reflect.TypeOf((*MockRepository)(nil).ReleaseAttachmentBlob), ctx, url, documentID)
+}
+
+// ReleaseDocumentLock mocks base method.
+func (m *MockRepository) ReleaseDocumentLock(ctx context.Context, documentID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReleaseDocumentLock", ctx, documentID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ReleaseDocumentLock indicates an expected call of ReleaseDocumentLock.
+func (mr *MockRepositoryMockRecorder) ReleaseDocumentLock(ctx, documentID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReleaseDocumentLock", reflect.TypeOf((*MockRepository)(nil).ReleaseDocumentLock), ctx, documentID)
+}
+
+// RemoveAttachment mocks base method.
+func (m *MockRepository) RemoveAttachment(ctx context.Context, documentID, attachmentURL string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveAttachment", ctx, documentID, attachmentURL)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RemoveAttachment indicates an expected call of RemoveAttachment.
+func (mr *MockRepositoryMockRecorder) RemoveAttachment(ctx, documentID, attachmentURL any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveAttachment", reflect.TypeOf((*MockRepository)(nil).RemoveAttachment), ctx, documentID, attachmentURL)
+}
+
+// RemoveDocumentDependency mocks base method.
+func (m *MockRepository) RemoveDocumentDependency(ctx context.Context, documentID, dependsOnID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveDocumentDependency", ctx, documentID, dependsOnID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RemoveDocumentDependency indicates an expected call of RemoveDocumentDependency.
+func (mr *MockRepositoryMockRecorder) RemoveDocumentDependency(ctx, documentID, dependsOnID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveDocumentDependency", reflect.TypeOf((*MockRepository)(nil).RemoveDocumentDependency), ctx, documentID, dependsOnID)
+}
+
+// RemoveHouseholdMember mocks base method.
+func (m *MockRepository) RemoveHouseholdMember(ctx context.Context, householdID, userID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveHouseholdMember", ctx, householdID, userID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RemoveHouseholdMember indicates an expected call of RemoveHouseholdMember.
+func (mr *MockRepositoryMockRecorder) RemoveHouseholdMember(ctx, householdID, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveHouseholdMember", reflect.TypeOf((*MockRepository)(nil).RemoveHouseholdMember), ctx, householdID, userID)
+}
+
+// RemoveLegalHold mocks base method.
+func (m *MockRepository) RemoveLegalHold(ctx context.Context, organizationID string, documentID *string) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveLegalHold", ctx, organizationID, documentID)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RemoveLegalHold indicates an expected call of RemoveLegalHold.
+func (mr *MockRepositoryMockRecorder) RemoveLegalHold(ctx, organizationID, documentID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveLegalHold", reflect.TypeOf((*MockRepository)(nil).RemoveLegalHold), ctx, organizationID, documentID)
+}
+
+// RemoveOrganizationMember mocks base method.
+func (m *MockRepository) RemoveOrganizationMember(ctx context.Context, organizationID, userID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveOrganizationMember", ctx, organizationID, userID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RemoveOrganizationMember indicates an expected call of RemoveOrganizationMember.
+func (mr *MockRepositoryMockRecorder) RemoveOrganizationMember(ctx, organizationID, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveOrganizationMember", reflect.TypeOf((*MockRepository)(nil).RemoveOrganizationMember), ctx, organizationID, userID)
+}
+
+// RemoveRoadmapVote mocks base method.
+func (m *MockRepository) RemoveRoadmapVote(ctx context.Context, featureID, userID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveRoadmapVote", ctx, featureID, userID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RemoveRoadmapVote indicates an expected call of RemoveRoadmapVote.
+func (mr *MockRepositoryMockRecorder) RemoveRoadmapVote(ctx, featureID, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveRoadmapVote", reflect.TypeOf((*MockRepository)(nil).RemoveRoadmapVote), ctx, featureID, userID)
+}
+
+// RenewalApprovalRequired mocks base method.
+func (m *MockRepository) RenewalApprovalRequired(ctx context.Context, doc *db.Document) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RenewalApprovalRequired", ctx, doc)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RenewalApprovalRequired indicates an expected call of RenewalApprovalRequired.
+func (mr *MockRepositoryMockRecorder) RenewalApprovalRequired(ctx, doc any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RenewalApprovalRequired", reflect.TypeOf((*MockRepository)(nil).RenewalApprovalRequired), ctx, doc)
+}
+
+// ResetDocumentReminders mocks base method.
+func (m *MockRepository) ResetDocumentReminders(ctx context.Context, documentID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ResetDocumentReminders", ctx, documentID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ResetDocumentReminders indicates an expected call of ResetDocumentReminders.
+func (mr *MockRepositoryMockRecorder) ResetDocumentReminders(ctx, documentID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResetDocumentReminders", reflect.TypeOf((*MockRepository)(nil).ResetDocumentReminders), ctx, documentID)
+}
+
+// RestoreDocument mocks base method.
+func (m *MockRepository) RestoreDocument(ctx context.Context, documentID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RestoreDocument", ctx, documentID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RestoreDocument indicates an expected call of RestoreDocument.
+func (mr *MockRepositoryMockRecorder) RestoreDocument(ctx, documentID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RestoreDocument", reflect.TypeOf((*MockRepository)(nil).RestoreDocument), ctx, documentID)
+}
+
+// RevokeServiceAccountToken mocks base method.
+func (m *MockRepository) RevokeServiceAccountToken(ctx context.Context, userID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RevokeServiceAccountToken", ctx, userID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RevokeServiceAccountToken indicates an expected call of RevokeServiceAccountToken.
+func (mr *MockRepositoryMockRecorder) RevokeServiceAccountToken(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RevokeServiceAccountToken", reflect.TypeOf((*MockRepository)(nil).RevokeServiceAccountToken), ctx, userID)
+}
+
+// RevokeSession mocks base method.
+func (m *MockRepository) RevokeSession(ctx context.Context, userID, sessionID string) (*db.Session, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RevokeSession", ctx, userID, sessionID)
+	ret0, _ := ret[0].(*db.Session)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RevokeSession indicates an expected call of RevokeSession.
+func (mr *MockRepositoryMockRecorder) RevokeSession(ctx, userID, sessionID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RevokeSession", reflect.TypeOf((*MockRepository)(nil).RevokeSession), ctx, userID, sessionID)
+}
+
+// RevokeSessions mocks base method.
+func (m *MockRepository) RevokeSessions(ctx context.Context, userID, exceptID string) ([]*db.Session, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RevokeSessions", ctx, userID, exceptID)
+	ret0, _ := ret[0].([]*db.Session)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RevokeSessions indicates an expected call of RevokeSessions.
+func (mr *MockRepositoryMockRecorder) RevokeSessions(ctx, userID, exceptID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RevokeSessions", reflect.TypeOf((*MockRepository)(nil).RevokeSessions), ctx, userID, exceptID)
+}
+
+// RotateServiceAccountToken mocks base method.
+func (m *MockRepository) RotateServiceAccountToken(ctx context.Context, key *db.APIKey) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RotateServiceAccountToken", ctx, key)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RotateServiceAccountToken indicates an expected call of RotateServiceAccountToken.
+func (mr *MockRepositoryMockRecorder) RotateServiceAccountToken(ctx, key any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RotateServiceAccountToken", reflect.TypeOf((*MockRepository)(nil).RotateServiceAccountToken), ctx, key)
+}
+
+// RotateWebhookSecret mocks base method.
+func (m *MockRepository) RotateWebhookSecret(ctx context.Context, endpointID, secret string, previousExpiresAt time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RotateWebhookSecret", ctx, endpointID, secret, previousExpiresAt)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RotateWebhookSecret indicates an expected call of RotateWebhookSecret.
+func (mr *MockRepositoryMockRecorder) RotateWebhookSecret(ctx, endpointID, secret, previousExpiresAt any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RotateWebhookSecret", reflect.TypeOf((*MockRepository)(nil).RotateWebhookSecret), ctx, endpointID, secret, previousExpiresAt)
+}
+
+// SetAttachmentStatus mocks base method.
+func (m *MockRepository) SetAttachmentStatus(ctx context.Context, documentID, attachmentURL, status string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetAttachmentStatus", ctx, documentID, attachmentURL, status)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetAttachmentStatus indicates an expected call of SetAttachmentStatus.
+func (mr *MockRepositoryMockRecorder) SetAttachmentStatus(ctx, documentID, attachmentURL, status any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetAttachmentStatus", reflect.TypeOf((*MockRepository)(nil).SetAttachmentStatus), ctx, documentID, attachmentURL, status)
+}
+
+// SetDocumentCustomFields mocks base method.
+func (m *MockRepository) SetDocumentCustomFields(ctx context.Context, documentID string, fields []*db.CustomField) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetDocumentCustomFields", ctx, documentID, fields)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetDocumentCustomFields indicates an expected call of SetDocumentCustomFields.
+func (mr *MockRepositoryMockRecorder) SetDocumentCustomFields(ctx, documentID, fields any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetDocumentCustomFields", reflect.TypeOf((*MockRepository)(nil).SetDocumentCustomFields), ctx, documentID, fields)
+}
+
+// SetDocumentOrder mocks base method.
+func (m *MockRepository) SetDocumentOrder(ctx context.Context, userID string, documentIDs []string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetDocumentOrder", ctx, userID, documentIDs)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetDocumentOrder indicates an expected call of SetDocumentOrder.
+func (mr *MockRepositoryMockRecorder) SetDocumentOrder(ctx, userID, documentIDs any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetDocumentOrder", reflect.TypeOf((*MockRepository)(nil).SetDocumentOrder), ctx, userID, documentIDs)
+}
+
+// SetDocumentPinned mocks base method.
+func (m *MockRepository) SetDocumentPinned(ctx context.Context, userID, documentID string, pinned bool) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetDocumentPinned", ctx, userID, documentID, pinned)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetDocumentPinned indicates an expected call of SetDocumentPinned.
+func (mr *MockRepositoryMockRecorder) SetDocumentPinned(ctx, userID, documentID, pinned any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetDocumentPinned", reflect.TypeOf((*MockRepository)(nil).SetDocumentPinned), ctx, userID, documentID, pinned)
+}
+
+// SetDocumentReminders mocks base method.
+func (m *MockRepository) SetDocumentReminders(ctx context.Context, documentID string, reminder *db.DocumentReminder) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetDocumentReminders", ctx, documentID, reminder)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetDocumentReminders indicates an expected call of SetDocumentReminders.
+func (mr *MockRepositoryMockRecorder) SetDocumentReminders(ctx, documentID, reminder any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetDocumentReminders", reflect.TypeOf((*MockRepository)(nil).SetDocumentReminders), ctx, documentID, reminder)
+}
+
+// SetEmailSender mocks base method.
+func (m *MockRepository) SetEmailSender(ctx context.Context, sender *db.EmailSender) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetEmailSender", ctx, sender)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetEmailSender indicates an expected call of SetEmailSender.
+func (mr *MockRepositoryMockRecorder) SetEmailSender(ctx, sender any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetEmailSender", reflect.TypeOf((*MockRepository)(nil).SetEmailSender), ctx, sender)
+}
+
+// SetEscalationPolicy mocks base method.
+func (m *MockRepository) SetEscalationPolicy(ctx context.Context, organizationID string, steps []*db.EscalationStep) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetEscalationPolicy", ctx, organizationID, steps)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetEscalationPolicy indicates an expected call of SetEscalationPolicy.
+func (mr *MockRepositoryMockRecorder) SetEscalationPolicy(ctx, organizationID, steps any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetEscalationPolicy", reflect.TypeOf((*MockRepository)(nil).SetEscalationPolicy), ctx, organizationID, steps)
+}
+
+// SetFeedToken mocks base method.
+func (m *MockRepository) SetFeedToken(ctx context.Context, userID, token string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetFeedToken", ctx, userID, token)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetFeedToken indicates an expected call of SetFeedToken.
+func (mr *MockRepositoryMockRecorder) SetFeedToken(ctx, userID, token any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetFeedToken", reflect.TypeOf((*MockRepository)(nil).SetFeedToken), ctx, userID, token)
+}
+
+// SetLocalePreferences mocks base method.
+func (m *MockRepository) SetLocalePreferences(ctx context.Context, userID string, prefs *db.LocalePreferences) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetLocalePreferences", ctx, userID, prefs)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetLocalePreferences indicates an expected call of SetLocalePreferences.
+func (mr *MockRepositoryMockRecorder) SetLocalePreferences(ctx, userID, prefs any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetLocalePreferences", reflect.TypeOf((*MockRepository)(nil).SetLocalePreferences), ctx, userID, prefs)
+}
+
+// SetNotificationTheme mocks base method.
+func (m *MockRepository) SetNotificationTheme(ctx context.Context, theme *db.NotificationTheme) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetNotificationTheme", ctx, theme)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetNotificationTheme indicates an expected call of SetNotificationTheme.
+func (mr *MockRepositoryMockRecorder) SetNotificationTheme(ctx, theme any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetNotificationTheme", reflect.TypeOf((*MockRepository)(nil).SetNotificationTheme), ctx, theme)
+}
+
+// SetOrganizationMemberExternalID mocks base method.
+func (m *MockRepository) SetOrganizationMemberExternalID(ctx context.Context, organizationID, userID string, externalID *string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetOrganizationMemberExternalID", ctx, organizationID, userID, externalID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetOrganizationMemberExternalID indicates an expected call of SetOrganizationMemberExternalID.
+func (mr *MockRepositoryMockRecorder) SetOrganizationMemberExternalID(ctx, organizationID, userID, externalID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetOrganizationMemberExternalID", reflect.TypeOf((*MockRepository)(nil).SetOrganizationMemberExternalID), ctx, organizationID, userID, externalID)
+}
+
+// SetOrganizationRetention mocks base method.
+func (m *MockRepository) SetOrganizationRetention(ctx context.Context, retention *db.OrganizationRetention) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetOrganizationRetention", ctx, retention)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetOrganizationRetention indicates an expected call of SetOrganizationRetention.
+func (mr *MockRepositoryMockRecorder) SetOrganizationRetention(ctx, retention any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetOrganizationRetention", reflect.TypeOf((*MockRepository)(nil).SetOrganizationRetention), ctx, retention)
+}
+
+// SetPendingEmail mocks base method.
+func (m *MockRepository) SetPendingEmail(ctx context.Context, userID, email string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetPendingEmail", ctx, userID, email)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetPendingEmail indicates an expected call of SetPendingEmail.
+func (mr *MockRepositoryMockRecorder) SetPendingEmail(ctx, userID, email any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetPendingEmail", reflect.TypeOf((*MockRepository)(nil).SetPendingEmail), ctx, userID, email)
+}
+
+// SetSCIMToken mocks base method.
+func (m *MockRepository) SetSCIMToken(ctx context.Context, organizationID, tokenHash string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetSCIMToken", ctx, organizationID, tokenHash)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetSCIMToken indicates an expected call of SetSCIMToken.
+func (mr *MockRepositoryMockRecorder) SetSCIMToken(ctx, organizationID, tokenHash any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetSCIMToken", reflect.TypeOf((*MockRepository)(nil).SetSCIMToken), ctx, organizationID, tokenHash)
+}
+
+// SetUserPlan mocks base method.
+func (m *MockRepository) SetUserPlan(ctx context.Context, userID, plan string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetUserPlan", ctx, userID, plan)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetUserPlan indicates an expected call of SetUserPlan.
+func (mr *MockRepositoryMockRecorder) SetUserPlan(ctx, userID, plan any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetUserPlan", reflect.TypeOf((*MockRepository)(nil).SetUserPlan), ctx, userID, plan)
+}
+
+// SetUserRole mocks base method.
+func (m *MockRepository) SetUserRole(ctx context.Context, userID, role string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetUserRole", ctx, userID, role)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetUserRole indicates an expected call of SetUserRole.
+func (mr *MockRepositoryMockRecorder) SetUserRole(ctx, userID, role any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetUserRole", reflect.TypeOf((*MockRepository)(nil).SetUserRole), ctx, userID, role)
+}
+
+// StartJob mocks base method.
+func (m *MockRepository) StartJob(ctx context.Context, jobID string) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StartJob", ctx, jobID)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// StartJob indicates an expected call of StartJob.
+func (mr *MockRepositoryMockRecorder) StartJob(ctx, jobID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StartJob", reflect.TypeOf((*MockRepository)(nil).StartJob), ctx, jobID)
+}
+
+// SuspendUser mocks base method.
+func (m *MockRepository) SuspendUser(ctx context.Context, userID, reason string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SuspendUser", ctx, userID, reason)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SuspendUser indicates an expected call of SuspendUser.
+func (mr *MockRepositoryMockRecorder) SuspendUser(ctx, userID, reason any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SuspendUser", reflect.TypeOf((*MockRepository)(nil).SuspendUser), ctx, userID, reason)
+}
+
+// TakeHeldReminders mocks base method.
+func (m *MockRepository) TakeHeldReminders(ctx context.Context, userID string) ([]*db.HeldReminder, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TakeHeldReminders", ctx, userID)
+	ret0, _ := ret[0].([]*db.HeldReminder)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// TakeHeldReminders indicates an expected call of TakeHeldReminders.
+func (mr *MockRepositoryMockRecorder) TakeHeldReminders(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TakeHeldReminders", reflect.TypeOf((*MockRepository)(nil).TakeHeldReminders), ctx, userID)
+}
+
+// ToggleDocumentReminder mocks base method.
+func (m *MockRepository) ToggleDocumentReminder(ctx context.Context, documentID string, reminderIntervalID int, enabled bool) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ToggleDocumentReminder", ctx, documentID, reminderIntervalID, enabled)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ToggleDocumentReminder indicates an expected call of ToggleDocumentReminder.
+func (mr *MockRepositoryMockRecorder) ToggleDocumentReminder(ctx, documentID, reminderIntervalID, enabled any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ToggleDocumentReminder", reflect.TypeOf((*MockRepository)(nil).ToggleDocumentReminder), ctx, documentID, reminderIntervalID, enabled)
+}
+
+// TransitionDocumentReminder mocks base method.
+func (m *MockRepository) TransitionDocumentReminder(ctx context.Context, documentID string, reminderIntervalID int, status string) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TransitionDocumentReminder", ctx, documentID, reminderIntervalID, status)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// TransitionDocumentReminder indicates an expected call of TransitionDocumentReminder.
+func (mr *MockRepositoryMockRecorder) TransitionDocumentReminder(ctx, documentID, reminderIntervalID, status any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TransitionDocumentReminder", reflect.TypeOf((*MockRepository)(nil).TransitionDocumentReminder), ctx, documentID, reminderIntervalID, status)
+}
+
+// UnassignDocument mocks base method.
+func (m *MockRepository) UnassignDocument(ctx context.Context, documentID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UnassignDocument", ctx, documentID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UnassignDocument indicates an expected call of UnassignDocument.
+func (mr *MockRepositoryMockRecorder) UnassignDocument(ctx, documentID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UnassignDocument", reflect.TypeOf((*MockRepository)(nil).UnassignDocument), ctx, documentID)
+}
+
+// UnderLegalHold mocks base method.
+func (m *MockRepository) UnderLegalHold(ctx context.Context, doc *db.Document) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UnderLegalHold", ctx, doc)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UnderLegalHold indicates an expected call of UnderLegalHold.
+func (mr *MockRepositoryMockRecorder) UnderLegalHold(ctx, doc any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UnderLegalHold", reflect.TypeOf((*MockRepository)(nil).UnderLegalHold), ctx, doc)
+}
+
+// UnsubscribeDocumentContact mocks base method.
+func (m *MockRepository) UnsubscribeDocumentContact(ctx context.Context, token string) (*db.DocumentContact, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UnsubscribeDocumentContact", ctx, token)
+	ret0, _ := ret[0].(*db.DocumentContact)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UnsubscribeDocumentContact indicates an expected call of UnsubscribeDocumentContact.
+func (mr *MockRepositoryMockRecorder) UnsubscribeDocumentContact(ctx, token any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UnsubscribeDocumentContact", reflect.TypeOf((*MockRepository)(nil).UnsubscribeDocumentContact), ctx, token)
+}
+
+// UpdateChecklistItem mocks base method.
+func (m *MockRepository) UpdateChecklistItem(ctx context.Context, item *db.ChecklistItem) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateChecklistItem", ctx, item)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateChecklistItem indicates an expected call of UpdateChecklistItem.
+func (mr *MockRepositoryMockRecorder) UpdateChecklistItem(ctx, item any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateChecklistItem", reflect.TypeOf((*MockRepository)(nil).UpdateChecklistItem), ctx, item)
+}
+
+// UpdateDocument mocks base method.
+func (m *MockRepository) UpdateDocument(ctx context.Context, document *db.Document) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateDocument", ctx, document)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateDocument indicates an expected call of UpdateDocument.
+func (mr *MockRepositoryMockRecorder) UpdateDocument(ctx, document any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateDocument", reflect.TypeOf((*MockRepository)(nil).UpdateDocument), ctx, document)
+}
+
+// UpdateHouseholdMemberRouting mocks base method.
+func (m *MockRepository) UpdateHouseholdMemberRouting(ctx context.Context, householdID, userID, routing string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateHouseholdMemberRouting", ctx, householdID, userID, routing)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateHouseholdMemberRouting indicates an expected call of UpdateHouseholdMemberRouting.
+func (mr *MockRepositoryMockRecorder) UpdateHouseholdMemberRouting(ctx, householdID, userID, routing any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateHouseholdMemberRouting", reflect.TypeOf((*MockRepository)(nil).UpdateHouseholdMemberRouting), ctx, householdID, userID, routing)
+}
+
+// UpdateIssuer mocks base method.
+func (m *MockRepository) UpdateIssuer(ctx context.Context, issuer *db.Issuer) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateIssuer", ctx, issuer)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateIssuer indicates an expected call of UpdateIssuer.
+func (mr *MockRepositoryMockRecorder) UpdateIssuer(ctx, issuer any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateIssuer", reflect.TypeOf((*MockRepository)(nil).UpdateIssuer), ctx, issuer)
+}
+
+// UpdateOrganizationCompliance mocks base method.
+func (m *MockRepository) UpdateOrganizationCompliance(ctx context.Context, organizationID string, enabled bool, categories []string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateOrganizationCompliance", ctx, organizationID, enabled, categories)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateOrganizationCompliance indicates an expected call of UpdateOrganizationCompliance.
+func (mr *MockRepositoryMockRecorder) UpdateOrganizationCompliance(ctx, organizationID, enabled, categories any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateOrganizationCompliance", reflect.TypeOf((*MockRepository)(nil).UpdateOrganizationCompliance), ctx, organizationID, enabled, categories)
+}
+
+// UpdateOrganizationRenewalApproval mocks base method.
+func (m *MockRepository) UpdateOrganizationRenewalApproval(ctx context.Context, organizationID string, required bool) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateOrganizationRenewalApproval", ctx, organizationID, required)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateOrganizationRenewalApproval indicates an expected call of UpdateOrganizationRenewalApproval.
+func (mr *MockRepositoryMockRecorder) UpdateOrganizationRenewalApproval(ctx, organizationID, required any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateOrganizationRenewalApproval", reflect.TypeOf((*MockRepository)(nil).UpdateOrganizationRenewalApproval), ctx, organizationID, required)
+}
+
+// UpdateRoadmapFeature mocks base method.
+func (m *MockRepository) UpdateRoadmapFeature(ctx context.Context, feature *db.RoadmapFeature) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateRoadmapFeature", ctx, feature)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateRoadmapFeature indicates an expected call of UpdateRoadmapFeature.
+func (mr *MockRepositoryMockRecorder) UpdateRoadmapFeature(ctx, feature any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateRoadmapFeature", reflect.TypeOf((*MockRepository)(nil).UpdateRoadmapFeature), ctx, feature)
+}
+
+// UpdateSavedView mocks base method.
+func (m *MockRepository) UpdateSavedView(ctx context.Context, view *db.SavedView) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateSavedView", ctx, view)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateSavedView indicates an expected call of UpdateSavedView.
+func (mr *MockRepositoryMockRecorder) UpdateSavedView(ctx, view any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateSavedView", reflect.TypeOf((*MockRepository)(nil).UpdateSavedView), ctx, view)
+}
+
+// UpdateWebhookEndpoint mocks base method.
+func (m *MockRepository) UpdateWebhookEndpoint(ctx context.Context, endpoint *db.WebhookEndpoint) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateWebhookEndpoint", ctx, endpoint)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateWebhookEndpoint indicates an expected call of UpdateWebhookEndpoint.
+func (mr *MockRepositoryMockRecorder) UpdateWebhookEndpoint(ctx, endpoint any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateWebhookEndpoint", reflect.TypeOf((*MockRepository)(nil).UpdateWebhookEndpoint), ctx, endpoint)
+}
+
+// UpsertNotificationPreferences mocks base method.
+func (m *MockRepository) UpsertNotificationPreferences(ctx context.Context, prefs *db.NotificationPreferences) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpsertNotificationPreferences", ctx, prefs)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpsertNotificationPreferences indicates an expected call of UpsertNotificationPreferences.
+func (mr *MockRepositoryMockRecorder) UpsertNotificationPreferences(ctx, prefs any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpsertNotificationPreferences", reflect.TypeOf((*MockRepository)(nil).UpsertNotificationPreferences), ctx, prefs)
+}
+
+// MockUserRepository is a mock of UserRepository interface.
+type MockUserRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockUserRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockUserRepositoryMockRecorder is the mock recorder for MockUserRepository.
+type MockUserRepositoryMockRecorder struct {
+	mock *MockUserRepository
+}
+
+// NewMockUserRepository creates a new mock instance.
+func NewMockUserRepository(ctrl *gomock.Controller) *MockUserRepository {
+	mock := &MockUserRepository{ctrl: ctrl}
+	mock.recorder = &MockUserRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockUserRepository) EXPECT() *MockUserRepositoryMockRecorder {
+	return m.recorder
+}
+
+// AddHouseholdMember mocks base method.
+func (m *MockUserRepository) AddHouseholdMember(ctx context.Context, member *db.HouseholdMember) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddHouseholdMember", ctx, member)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AddHouseholdMember indicates an expected call of AddHouseholdMember.
+func (mr *MockUserRepositoryMockRecorder) AddHouseholdMember(ctx, member any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddHouseholdMember", reflect.TypeOf((*MockUserRepository)(nil).AddHouseholdMember), ctx, member)
+}
+
+// AddOrganizationMember mocks base method.
+func (m *MockUserRepository) AddOrganizationMember(ctx context.Context, org *db.Organization, userID, role string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddOrganizationMember", ctx, org, userID, role)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AddOrganizationMember indicates an expected call of AddOrganizationMember.
+func (mr *MockUserRepositoryMockRecorder) AddOrganizationMember(ctx, org, userID, role any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddOrganizationMember", reflect.TypeOf((*MockUserRepository)(nil).AddOrganizationMember), ctx, org, userID, role)
+}
+
+// AuthenticateAPIKey mocks base method.
+func (m *MockUserRepository) AuthenticateAPIKey(ctx context.Context, keyHash string) (string, []string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AuthenticateAPIKey", ctx, keyHash)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].([]string)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// AuthenticateAPIKey indicates an expected call of AuthenticateAPIKey.
+func (mr *MockUserRepositoryMockRecorder) AuthenticateAPIKey(ctx, keyHash any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AuthenticateAPIKey", reflect.TypeOf((*MockUserRepository)(nil).AuthenticateAPIKey), ctx, keyHash)
+}
+
+// CheckUserExistsByEmail mocks base method.
+func (m *MockUserRepository) CheckUserExistsByEmail(ctx context.Context, email string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CheckUserExistsByEmail", ctx, email)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CheckUserExistsByEmail indicates an expected call of CheckUserExistsByEmail.
+func (mr *MockUserRepositoryMockRecorder) CheckUserExistsByEmail(ctx, email any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CheckUserExistsByEmail", reflect.TypeOf((*MockUserRepository)(nil).CheckUserExistsByEmail), ctx, email)
+}
+
+// CheckUserExistsById mocks base method.
+func (m *MockUserRepository) CheckUserExistsById(ctx context.Context, userID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CheckUserExistsById", ctx, userID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CheckUserExistsById indicates an expected call of CheckUserExistsById.
+func (mr *MockUserRepositoryMockRecorder) CheckUserExistsById(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CheckUserExistsById", reflect.TypeOf((*MockUserRepository)(nil).CheckUserExistsById), ctx, userID)
+}
+
+// ClaimQuotaWarning mocks base method.
+func (m *MockUserRepository) ClaimQuotaWarning(ctx context.Context, userID, quota, month string) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ClaimQuotaWarning", ctx, userID, quota, month)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ClaimQuotaWarning indicates an expected call of ClaimQuotaWarning.
+func (mr *MockUserRepositoryMockRecorder) ClaimQuotaWarning(ctx, userID, quota, month any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ClaimQuotaWarning", reflect.TypeOf((*MockUserRepository)(nil).ClaimQuotaWarning), ctx, userID, quota, month)
+}
+
+// ClearUserPassword mocks base method.
+func (m *MockUserRepository) ClearUserPassword(ctx context.Context, userID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ClearUserPassword", ctx, userID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ClearUserPassword indicates an expected call of ClearUserPassword.
+func (mr *MockUserRepositoryMockRecorder) ClearUserPassword(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ClearUserPassword", reflect.TypeOf((*MockUserRepository)(nil).ClearUserPassword), ctx, userID)
+}
+
+// ConfirmEmailChange mocks base method.
+func (m *MockUserRepository) ConfirmEmailChange(ctx context.Context, userID, email string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ConfirmEmailChange", ctx, userID, email)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ConfirmEmailChange indicates an expected call of ConfirmEmailChange.
+func (mr *MockUserRepositoryMockRecorder) ConfirmEmailChange(ctx, userID, email any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ConfirmEmailChange", reflect.TypeOf((*MockUserRepository)(nil).ConfirmEmailChange), ctx, userID, email)
+}
+
+// CreateAPIKey mocks base method.
+func (m *MockUserRepository) CreateAPIKey(ctx context.Context, key *db.APIKey) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateAPIKey", ctx, key)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateAPIKey indicates an expected call of CreateAPIKey.
+func (mr *MockUserRepositoryMockRecorder) CreateAPIKey(ctx, key any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateAPIKey", reflect.TypeOf((*MockUserRepository)(nil).CreateAPIKey), ctx, key)
+}
+
+// CreateHousehold mocks base method.
+func (m *MockUserRepository) CreateHousehold(ctx context.Context, household *db.Household) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateHousehold", ctx, household)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateHousehold indicates an expected call of CreateHousehold.
+func (mr *MockUserRepositoryMockRecorder) CreateHousehold(ctx, household any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateHousehold", reflect.TypeOf((*MockUserRepository)(nil).CreateHousehold), ctx, household)
+}
+
+// CreateOrganization mocks base method.
+func (m *MockUserRepository) CreateOrganization(ctx context.Context, org *db.Organization, ownerID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateOrganization", ctx, org, ownerID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateOrganization indicates an expected call of CreateOrganization.
+func (mr *MockUserRepositoryMockRecorder) CreateOrganization(ctx, org, ownerID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateOrganization", reflect.TypeOf((*MockUserRepository)(nil).CreateOrganization), ctx, org, ownerID)
+}
+
+// CreateServiceAccount mocks base method.
+func (m *MockUserRepository) CreateServiceAccount(ctx context.Context, account *db.ServiceAccount) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateServiceAccount", ctx, account)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateServiceAccount indicates an expected call of CreateServiceAccount.
+func (mr *MockUserRepositoryMockRecorder) CreateServiceAccount(ctx, account any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateServiceAccount", reflect.TypeOf((*MockUserRepository)(nil).CreateServiceAccount), ctx, account)
+}
+
+// CreateSession mocks base method.
+func (m *MockUserRepository) CreateSession(ctx context.Context, session *db.Session) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateSession", ctx, session)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateSession indicates an expected call of CreateSession.
+func (mr *MockUserRepositoryMockRecorder) CreateSession(ctx, session any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateSession", reflect.TypeOf((*MockUserRepository)(nil).CreateSession), ctx, session)
+}
+
+// CreateUser mocks base method.
+func (m *MockUserRepository) CreateUser(ctx context.Context, user *db.User) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateUser", ctx, user)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateUser indicates an expected call of CreateUser.
+func (mr *MockUserRepositoryMockRecorder) CreateUser(ctx, user any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateUser", reflect.TypeOf((*MockUserRepository)(nil).CreateUser), ctx, user)
+}
+
+// DeactivateUser mocks base method.
+func (m *MockUserRepository) DeactivateUser(ctx context.Context, userID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeactivateUser", ctx, userID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeactivateUser indicates an expected call of DeactivateUser.
+func (mr *MockUserRepositoryMockRecorder) DeactivateUser(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeactivateUser", reflect.TypeOf((*MockUserRepository)(nil).DeactivateUser), ctx, userID)
+}
+
+// DeleteAPIKey mocks base method.
+func (m *MockUserRepository) DeleteAPIKey(ctx context.Context, userID, keyID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteAPIKey", ctx, userID, keyID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteAPIKey indicates an expected call of DeleteAPIKey.
+func (mr *MockUserRepositoryMockRecorder) DeleteAPIKey(ctx, userID, keyID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteAPIKey", reflect.TypeOf((*MockUserRepository)(nil).DeleteAPIKey), ctx, userID, keyID)
+}
+
+// DeleteFeedToken mocks base method.
+func (m *MockUserRepository) DeleteFeedToken(ctx context.Context, userID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteFeedToken", ctx, userID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteFeedToken indicates an expected call of DeleteFeedToken.
+func (mr *MockUserRepositoryMockRecorder) DeleteFeedToken(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteFeedToken", reflect.TypeOf((*MockUserRepository)(nil).DeleteFeedToken), ctx, userID)
+}
+
+// DeleteSCIMToken mocks base method.
+func (m *MockUserRepository) DeleteSCIMToken(ctx context.Context, organizationID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteSCIMToken", ctx, organizationID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteSCIMToken indicates an expected call of DeleteSCIMToken.
+func (mr *MockUserRepositoryMockRecorder) DeleteSCIMToken(ctx, organizationID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteSCIMToken", reflect.TypeOf((*MockUserRepository)(nil).DeleteSCIMToken), ctx, organizationID)
+}
+
+// GetEscalationPolicy mocks base method.
+func (m *MockUserRepository) GetEscalationPolicy(ctx context.Context, organizationID string) ([]*db.EscalationStep, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetEscalationPolicy", ctx, organizationID)
+	ret0, _ := ret[0].([]*db.EscalationStep)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetEscalationPolicy indicates an expected call of GetEscalationPolicy.
+func (mr *MockUserRepositoryMockRecorder) GetEscalationPolicy(ctx, organizationID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetEscalationPolicy", reflect.TypeOf((*MockUserRepository)(nil).GetEscalationPolicy), ctx, organizationID)
+}
+
+// GetFeedToken mocks base method.
+func (m *MockUserRepository) GetFeedToken(ctx context.Context, userID string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetFeedToken", ctx, userID)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetFeedToken indicates an expected call of GetFeedToken.
+func (mr *MockUserRepositoryMockRecorder) GetFeedToken(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFeedToken", reflect.TypeOf((*MockUserRepository)(nil).GetFeedToken), ctx, userID)
+}
+
+// GetHouseholdByUserID mocks base method.
+func (m *MockUserRepository) GetHouseholdByUserID(ctx context.Context, userID string) (*db.Household, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetHouseholdByUserID", ctx, userID)
+	ret0, _ := ret[0].(*db.Household)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetHouseholdByUserID indicates an expected call of GetHouseholdByUserID.
+func (mr *MockUserRepositoryMockRecorder) GetHouseholdByUserID(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetHouseholdByUserID", reflect.TypeOf((*MockUserRepository)(nil).GetHouseholdByUserID), ctx, userID)
+}
+
+// GetHouseholdMember mocks base method.
+func (m *MockUserRepository) GetHouseholdMember(ctx context.Context, userID string) (*db.HouseholdMember, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetHouseholdMember", ctx, userID)
+	ret0, _ := ret[0].(*db.HouseholdMember)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetHouseholdMember indicates an expected call of GetHouseholdMember.
+func (mr *MockUserRepositoryMockRecorder) GetHouseholdMember(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetHouseholdMember", reflect.TypeOf((*MockUserRepository)(nil).GetHouseholdMember), ctx, userID)
+}
+
+// GetLocalePreferences mocks base method.
+func (m *MockUserRepository) GetLocalePreferences(ctx context.Context, userID string) (*db.LocalePreferences, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetLocalePreferences", ctx, userID)
+	ret0, _ := ret[0].(*db.LocalePreferences)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetLocalePreferences indicates an expected call of GetLocalePreferences.
+func (mr *MockUserRepositoryMockRecorder) GetLocalePreferences(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLocalePreferences", reflect.TypeOf((*MockUserRepository)(nil).GetLocalePreferences), ctx, userID)
+}
+
+// GetOrganization mocks base method.
+func (m *MockUserRepository) GetOrganization(ctx context.Context, organizationID string) (*db.Organization, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetOrganization", ctx, organizationID)
+	ret0, _ := ret[0].(*db.Organization)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetOrganization indicates an expected call of GetOrganization.
+func (mr *MockUserRepositoryMockRecorder) GetOrganization(ctx, organizationID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOrganization", reflect.TypeOf((*MockUserRepository)(nil).GetOrganization), ctx, organizationID)
+}
+
+// GetOrganizationIDBySCIMToken mocks base method.
+func (m *MockUserRepository) GetOrganizationIDBySCIMToken(ctx context.Context, tokenHash string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetOrganizationIDBySCIMToken", ctx, tokenHash)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetOrganizationIDBySCIMToken indicates an expected call of GetOrganizationIDBySCIMToken.
+func (mr *MockUserRepositoryMockRecorder) GetOrganizationIDBySCIMToken(ctx, tokenHash any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOrganizationIDBySCIMToken", reflect.TypeOf((*MockUserRepository)(nil).GetOrganizationIDBySCIMToken), ctx, tokenHash)
+}
+
+// GetOrganizationMember mocks base method.
+func (m *MockUserRepository) GetOrganizationMember(ctx context.Context, organizationID, userID string) (*db.OrganizationMember, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetOrganizationMember", ctx, organizationID, userID)
+	ret0, _ := ret[0].(*db.OrganizationMember)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetOrganizationMember indicates an expected call of GetOrganizationMember.
+func (mr *MockUserRepositoryMockRecorder) GetOrganizationMember(ctx, organizationID, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOrganizationMember", reflect.TypeOf((*MockUserRepository)(nil).GetOrganizationMember), ctx, organizationID, userID)
+}
+
+// GetOrganizationRetention mocks base method.
+func (m *MockUserRepository) GetOrganizationRetention(ctx context.Context, organizationID string) (*db.OrganizationRetention, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetOrganizationRetention", ctx, organizationID)
+	ret0, _ := ret[0].(*db.OrganizationRetention)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetOrganizationRetention indicates an expected call of GetOrganizationRetention.
+func (mr *MockUserRepositoryMockRecorder) GetOrganizationRetention(ctx, organizationID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOrganizationRetention", reflect.TypeOf((*MockUserRepository)(nil).GetOrganizationRetention), ctx, organizationID)
+}
+
+// GetPendingEmail mocks base method.
+func (m *MockUserRepository) GetPendingEmail(ctx context.Context, userID string) (*string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPendingEmail", ctx, userID)
+	ret0, _ := ret[0].(*string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPendingEmail indicates an expected call of GetPendingEmail.
+func (mr *MockUserRepositoryMockRecorder) GetPendingEmail(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPendingEmail", reflect.TypeOf((*MockUserRepository)(nil).GetPendingEmail), ctx, userID)
+}
+
+// GetServiceAccount mocks base method.
+func (m *MockUserRepository) GetServiceAccount(ctx context.Context, organizationID, accountID string) (*db.ServiceAccount, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetServiceAccount", ctx, organizationID, accountID)
+	ret0, _ := ret[0].(*db.ServiceAccount)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetServiceAccount indicates an expected call of GetServiceAccount.
+func (mr *MockUserRepositoryMockRecorder) GetServiceAccount(ctx, organizationID, accountID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetServiceAccount", reflect.TypeOf((*MockUserRepository)(nil).GetServiceAccount), ctx, organizationID, accountID)
+}
+
+// GetServiceAccountByUserID mocks base method.
+func (m *MockUserRepository) GetServiceAccountByUserID(ctx context.Context, userID string) (*db.ServiceAccount, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetServiceAccountByUserID", ctx, userID)
+	ret0, _ := ret[0].(*db.ServiceAccount)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetServiceAccountByUserID indicates an expected call of GetServiceAccountByUserID.
+func (mr *MockUserRepositoryMockRecorder) GetServiceAccountByUserID(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetServiceAccountByUserID", reflect.TypeOf((*MockUserRepository)(nil).GetServiceAccountByUserID), ctx, userID)
+}
+
+// GetUserByEmail mocks base method.
+func (m *MockUserRepository) GetUserByEmail(ctx context.Context, email string) (*db.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUserByEmail", ctx, email)
+	ret0, _ := ret[0].(*db.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUserByEmail indicates an expected call of GetUserByEmail.
+func (mr *MockUserRepositoryMockRecorder) GetUserByEmail(ctx, email any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserByEmail", reflect.TypeOf((*MockUserRepository)(nil).GetUserByEmail), ctx, email)
+}
+
+// GetUserByID mocks base method.
+func (m *MockUserRepository) GetUserByID(ctx context.Context, userID string) (*db.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUserByID", ctx, userID)
+	ret0, _ := ret[0].(*db.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUserByID indicates an expected call of GetUserByID.
+func (mr *MockUserRepositoryMockRecorder) GetUserByID(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserByID", reflect.TypeOf((*MockUserRepository)(nil).GetUserByID), ctx, userID)
+}
+
+// GetUserByPhoneNumber mocks base method.
+func (m *MockUserRepository) GetUserByPhoneNumber(ctx context.Context, phoneNumber string) (*db.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUserByPhoneNumber", ctx, phoneNumber)
+	ret0, _ := ret[0].(*db.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUserByPhoneNumber indicates an expected call of GetUserByPhoneNumber.
+func (mr *MockUserRepositoryMockRecorder) GetUserByPhoneNumber(ctx, phoneNumber any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserByPhoneNumber", reflect.TypeOf((*MockUserRepository)(nil).GetUserByPhoneNumber), ctx, phoneNumber)
+}
+
+// GetUserEmail mocks base method.
+func (m *MockUserRepository) GetUserEmail(ctx context.Context, userID string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUserEmail", ctx, userID)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUserEmail indicates an expected call of GetUserEmail.
+func (mr *MockUserRepositoryMockRecorder) GetUserEmail(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserEmail", reflect.TypeOf((*MockUserRepository)(nil).GetUserEmail), ctx, userID)
+}
+
+// GetUserIDByFeedToken mocks base method.
+func (m *MockUserRepository) GetUserIDByFeedToken(ctx context.Context, token string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUserIDByFeedToken", ctx, token)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUserIDByFeedToken indicates an expected call of GetUserIDByFeedToken.
+func (mr *MockUserRepositoryMockRecorder) GetUserIDByFeedToken(ctx, token any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserIDByFeedToken", reflect.TypeOf((*MockUserRepository)(nil).GetUserIDByFeedToken), ctx, token)
+}
+
+// GetUserIDByProvider mocks base method.
+func (m *MockUserRepository) GetUserIDByProvider(ctx context.Context, provider, providerID string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUserIDByProvider", ctx, provider, providerID)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUserIDByProvider indicates an expected call of GetUserIDByProvider.
+func (mr *MockUserRepositoryMockRecorder) GetUserIDByProvider(ctx, provider, providerID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserIDByProvider", reflect.TypeOf((*MockUserRepository)(nil).GetUserIDByProvider), ctx, provider, providerID)
+}
+
+// GetUserPhoneNumber mocks base method.
+func (m *MockUserRepository) GetUserPhoneNumber(ctx context.Context, userID string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUserPhoneNumber", ctx, userID)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUserPhoneNumber indicates an expected call of GetUserPhoneNumber.
+func (mr *MockUserRepositoryMockRecorder) GetUserPhoneNumber(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserPhoneNumber", reflect.TypeOf((*MockUserRepository)(nil).GetUserPhoneNumber), ctx, userID)
+}
+
+// GetUserPlan mocks base method.
+func (m *MockUserRepository) GetUserPlan(ctx context.Context, userID string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUserPlan", ctx, userID)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUserPlan indicates an expected call of GetUserPlan.
+func (mr *MockUserRepositoryMockRecorder) GetUserPlan(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserPlan", reflect.TypeOf((*MockUserRepository)(nil).GetUserPlan), ctx, userID)
+}
+
+// GetUserRole mocks base method.
+func (m *MockUserRepository) GetUserRole(ctx context.Context, userID string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUserRole", ctx, userID)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUserRole indicates an expected call of GetUserRole.
+func (mr *MockUserRepositoryMockRecorder) GetUserRole(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserRole", reflect.TypeOf((*MockUserRepository)(nil).GetUserRole), ctx, userID)
+}
+
+// IsComplianceDocument mocks base method.
+func (m *MockUserRepository) IsComplianceDocument(ctx context.Context, doc *db.Document) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IsComplianceDocument", ctx, doc)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// IsComplianceDocument indicates an expected call of IsComplianceDocument.
+func (mr *MockUserRepositoryMockRecorder) IsComplianceDocument(ctx, doc any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsComplianceDocument", reflect.TypeOf((*MockUserRepository)(nil).IsComplianceDocument), ctx, doc)
+}
+
+// IsHouseholdPrimaryOf mocks base method.
+func (m *MockUserRepository) IsHouseholdPrimaryOf(ctx context.Context, primaryUserID, memberUserID string) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IsHouseholdPrimaryOf", ctx, primaryUserID, memberUserID)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// IsHouseholdPrimaryOf indicates an expected call of IsHouseholdPrimaryOf.
+func (mr *MockUserRepositoryMockRecorder) IsHouseholdPrimaryOf(ctx, primaryUserID, memberUserID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsHouseholdPrimaryOf", reflect.TypeOf((*MockUserRepository)(nil).IsHouseholdPrimaryOf), ctx, primaryUserID, memberUserID)
+}
+
+// IsSessionRevoked mocks base method.
+func (m *MockUserRepository) IsSessionRevoked(ctx context.Context, sessionID string) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IsSessionRevoked", ctx, sessionID)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// IsSessionRevoked indicates an expected call of IsSessionRevoked.
+func (mr *MockUserRepositoryMockRecorder) IsSessionRevoked(ctx, sessionID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsSessionRevoked", reflect.TypeOf((*MockUserRepository)(nil).IsSessionRevoked), ctx, sessionID)
+}
+
+// IsUserDeactivated mocks base method.
+func (m *MockUserRepository) IsUserDeactivated(ctx context.Context, userID string) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IsUserDeactivated", ctx, userID)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// IsUserDeactivated indicates an expected call of IsUserDeactivated.
+func (mr *MockUserRepositoryMockRecorder) IsUserDeactivated(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsUserDeactivated", reflect.TypeOf((*MockUserRepository)(nil).IsUserDeactivated), ctx, userID)
+}
+
+// IsUserSuspended mocks base method.
+func (m *MockUserRepository) IsUserSuspended(ctx context.Context, userID string) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IsUserSuspended", ctx, userID)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// IsUserSuspended indicates an expected call of IsUserSuspended.
+func (mr *MockUserRepositoryMockRecorder) IsUserSuspended(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsUserSuspended", reflect.TypeOf((*MockUserRepository)(nil).IsUserSuspended), ctx, userID)
+}
+
+// LinkUserProvider mocks base method.
+func (m *MockUserRepository) LinkUserProvider(ctx context.Context, userID, provider, providerID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LinkUserProvider", ctx, userID, provider, providerID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// LinkUserProvider indicates an expected call of LinkUserProvider.
+func (mr *MockUserRepositoryMockRecorder) LinkUserProvider(ctx, userID, provider, providerID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LinkUserProvider", reflect.TypeOf((*MockUserRepository)(nil).LinkUserProvider), ctx, userID, provider, providerID)
+}
+
+// ListAPIKeys mocks base method.
+func (m *MockUserRepository) ListAPIKeys(ctx context.Context, userID string) ([]*db.APIKey, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListAPIKeys", ctx, userID)
+	ret0, _ := ret[0].([]*db.APIKey)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListAPIKeys indicates an expected call of ListAPIKeys.
+func (mr *MockUserRepositoryMockRecorder) ListAPIKeys(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAPIKeys", reflect.TypeOf((*MockUserRepository)(nil).ListAPIKeys), ctx, userID)
+}
+
+// ListHouseholdMembers mocks base method.
+func (m *MockUserRepository) ListHouseholdMembers(ctx context.Context, householdID string) ([]*db.HouseholdMember, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListHouseholdMembers", ctx, householdID)
+	ret0, _ := ret[0].([]*db.HouseholdMember)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListHouseholdMembers indicates an expected call of ListHouseholdMembers.
+func (mr *MockUserRepositoryMockRecorder) ListHouseholdMembers(ctx, householdID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListHouseholdMembers", reflect.TypeOf((*MockUserRepository)(nil).ListHouseholdMembers), ctx, householdID)
+}
+
+// ListOrganizationMembers mocks base method.
+func (m *MockUserRepository) ListOrganizationMembers(ctx context.Context, organizationID string) ([]*db.OrganizationMember, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListOrganizationMembers", ctx, organizationID)
+	ret0, _ := ret[0].([]*db.OrganizationMember)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListOrganizationMembers indicates an expected call of ListOrganizationMembers.
+func (mr *MockUserRepositoryMockRecorder) ListOrganizationMembers(ctx, organizationID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListOrganizationMembers", reflect.TypeOf((*MockUserRepository)(nil).ListOrganizationMembers), ctx, organizationID)
+}
+
+// ListOrganizationRetention mocks base method.
+func (m *MockUserRepository) ListOrganizationRetention(ctx context.Context) ([]*db.OrganizationRetention, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListOrganizationRetention", ctx)
+	ret0, _ := ret[0].([]*db.OrganizationRetention)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListOrganizationRetention indicates an expected call of ListOrganizationRetention.
+func (mr *MockUserRepositoryMockRecorder) ListOrganizationRetention(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListOrganizationRetention", reflect.TypeOf((*MockUserRepository)(nil).ListOrganizationRetention), ctx)
+}
+
+// ListOrganizationsByUserID mocks base method.
+func (m *MockUserRepository) ListOrganizationsByUserID(ctx context.Context, userID string) ([]*db.Organization, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListOrganizationsByUserID", ctx, userID)
+	ret0, _ := ret[0].([]*db.Organization)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListOrganizationsByUserID indicates an expected call of ListOrganizationsByUserID.
+func (mr *MockUserRepositoryMockRecorder) ListOrganizationsByUserID(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListOrganizationsByUserID", reflect.TypeOf((*MockUserRepository)(nil).ListOrganizationsByUserID), ctx, userID)
+}
+
+// ListPinnedDataRegions mocks base method.
+func (m *MockUserRepository) ListPinnedDataRegions(ctx context.Context) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListPinnedDataRegions", ctx)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListPinnedDataRegions indicates an expected call of ListPinnedDataRegions.
+func (mr *MockUserRepositoryMockRecorder) ListPinnedDataRegions(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListPinnedDataRegions", reflect.TypeOf((*MockUserRepository)(nil).ListPinnedDataRegions), ctx)
+}
+
+// ListServiceAccounts mocks base method.
+func (m *MockUserRepository) ListServiceAccounts(ctx context.Context, organizationID string) ([]*db.ServiceAccount, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListServiceAccounts", ctx, organizationID)
+	ret0, _ := ret[0].([]*db.ServiceAccount)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListServiceAccounts indicates an expected call of ListServiceAccounts.
+func (mr *MockUserRepositoryMockRecorder) ListServiceAccounts(ctx, organizationID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListServiceAccounts", reflect.TypeOf((*MockUserRepository)(nil).ListServiceAccounts), ctx, organizationID)
+}
+
+// ListSessions mocks base method.
+func (m *MockUserRepository) ListSessions(ctx context.Context, userID string) ([]*db.Session, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListSessions", ctx, userID)
+	ret0, _ := ret[0].([]*db.Session)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListSessions indicates an expected call of ListSessions.
+func (mr *MockUserRepositoryMockRecorder) ListSessions(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListSessions", reflect.TypeOf((*MockUserRepository)(nil).ListSessions), ctx, userID)
+}
+
+// ListUsersAfter mocks base method.
+func (m *MockUserRepository) ListUsersAfter(ctx context.Context, afterID uuid.UUID, limit int) ([]*db.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListUsersAfter", ctx, afterID, limit)
+	ret0, _ := ret[0].([]*db.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListUsersAfter indicates an expected call of ListUsersAfter.
+func (mr *MockUserRepositoryMockRecorder) ListUsersAfter(ctx, afterID, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListUsersAfter", reflect.TypeOf((*MockUserRepository)(nil).ListUsersAfter), ctx, afterID, limit)
+}
+
+// ReinstateUser mocks base method.
+func (m *MockUserRepository) ReinstateUser(ctx context.Context, userID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReinstateUser", ctx, userID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ReinstateUser indicates an expected call of ReinstateUser.
+func (mr *MockUserRepositoryMockRecorder) ReinstateUser(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReinstateUser", reflect.TypeOf((*MockUserRepository)(nil).ReinstateUser), ctx, userID)
+}
+
+// RemoveHouseholdMember mocks base method.
+func (m *MockUserRepository) RemoveHouseholdMember(ctx context.Context, householdID, userID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveHouseholdMember", ctx, householdID, userID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RemoveHouseholdMember indicates an expected call of RemoveHouseholdMember.
+func (mr *MockUserRepositoryMockRecorder) RemoveHouseholdMember(ctx, householdID, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveHouseholdMember", reflect.TypeOf((*MockUserRepository)(nil).RemoveHouseholdMember), ctx, householdID, userID)
+}
+
+// RemoveOrganizationMember mocks base method.
+func (m *MockUserRepository) RemoveOrganizationMember(ctx context.Context, organizationID, userID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveOrganizationMember", ctx, organizationID, userID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RemoveOrganizationMember indicates an expected call of RemoveOrganizationMember.
+func (mr *MockUserRepositoryMockRecorder) RemoveOrganizationMember(ctx, organizationID, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveOrganizationMember", reflect.TypeOf((*MockUserRepository)(nil).RemoveOrganizationMember), ctx, organizationID, userID)
+}
+
+// RenewalApprovalRequired mocks base method.
+func (m *MockUserRepository) RenewalApprovalRequired(ctx context.Context, doc *db.Document) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RenewalApprovalRequired", ctx, doc)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RenewalApprovalRequired indicates an expected call of RenewalApprovalRequired.
+func (mr *MockUserRepositoryMockRecorder) RenewalApprovalRequired(ctx, doc any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RenewalApprovalRequired", reflect.TypeOf((*MockUserRepository)(nil).RenewalApprovalRequired), ctx, doc)
+}
+
+// RevokeServiceAccountToken mocks base method.
+func (m *MockUserRepository) RevokeServiceAccountToken(ctx context.Context, userID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RevokeServiceAccountToken", ctx, userID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RevokeServiceAccountToken indicates an expected call of RevokeServiceAccountToken.
+func (mr *MockUserRepositoryMockRecorder) RevokeServiceAccountToken(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RevokeServiceAccountToken", reflect.TypeOf((*MockUserRepository)(nil).RevokeServiceAccountToken), ctx, userID)
+}
+
+// RevokeSession mocks base method.
+func (m *MockUserRepository) RevokeSession(ctx context.Context, userID, sessionID string) (*db.Session, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RevokeSession", ctx, userID, sessionID)
+	ret0, _ := ret[0].(*db.Session)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RevokeSession indicates an expected call of RevokeSession.
+func (mr *MockUserRepositoryMockRecorder) RevokeSession(ctx, userID, sessionID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RevokeSession", reflect.TypeOf((*MockUserRepository)(nil).RevokeSession), ctx, userID, sessionID)
+}
+
+// RevokeSessions mocks base method.
+func (m *MockUserRepository) RevokeSessions(ctx context.Context, userID, exceptID string) ([]*db.Session, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RevokeSessions", ctx, userID, exceptID)
+	ret0, _ := ret[0].([]*db.Session)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RevokeSessions indicates an expected call of RevokeSessions.
+func (mr *MockUserRepositoryMockRecorder) RevokeSessions(ctx, userID, exceptID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RevokeSessions", reflect.TypeOf((*MockUserRepository)(nil).RevokeSessions), ctx, userID, exceptID)
+}
+
+// RotateServiceAccountToken mocks base method.
+func (m *MockUserRepository) RotateServiceAccountToken(ctx context.Context, key *db.APIKey) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RotateServiceAccountToken", ctx, key)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RotateServiceAccountToken indicates an expected call of RotateServiceAccountToken.
+func (mr *MockUserRepositoryMockRecorder) RotateServiceAccountToken(ctx, key any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RotateServiceAccountToken", reflect.TypeOf((*MockUserRepository)(nil).RotateServiceAccountToken), ctx, key)
+}
+
+// SetEscalationPolicy mocks base method.
+func (m *MockUserRepository) SetEscalationPolicy(ctx context.Context, organizationID string, steps []*db.EscalationStep) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetEscalationPolicy", ctx, organizationID, steps)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetEscalationPolicy indicates an expected call of SetEscalationPolicy.
+func (mr *MockUserRepositoryMockRecorder) SetEscalationPolicy(ctx, organizationID, steps any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetEscalationPolicy", reflect.TypeOf((*MockUserRepository)(nil).SetEscalationPolicy), ctx, organizationID, steps)
+}
+
+// SetFeedToken mocks base method.
+func (m *MockUserRepository) SetFeedToken(ctx context.Context, userID, token string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetFeedToken", ctx, userID, token)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetFeedToken indicates an expected call of SetFeedToken.
+func (mr *MockUserRepositoryMockRecorder) SetFeedToken(ctx, userID, token any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetFeedToken", reflect.TypeOf((*MockUserRepository)(nil).SetFeedToken), ctx, userID, token)
+}
+
+// SetLocalePreferences mocks base method.
+func (m *MockUserRepository) SetLocalePreferences(ctx context.Context, userID string, prefs *db.LocalePreferences) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetLocalePreferences", ctx, userID, prefs)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetLocalePreferences indicates an expected call of SetLocalePreferences.
+func (mr *MockUserRepositoryMockRecorder) SetLocalePreferences(ctx, userID, prefs any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetLocalePreferences", reflect.TypeOf((*MockUserRepository)(nil).SetLocalePreferences), ctx, userID, prefs)
+}
+
+// SetOrganizationMemberExternalID mocks base method.
+func (m *MockUserRepository) SetOrganizationMemberExternalID(ctx context.Context, organizationID, userID string, externalID *string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetOrganizationMemberExternalID", ctx, organizationID, userID, externalID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetOrganizationMemberExternalID indicates an expected call of SetOrganizationMemberExternalID.
+func (mr *MockUserRepositoryMockRecorder) SetOrganizationMemberExternalID(ctx, organizationID, userID, externalID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetOrganizationMemberExternalID", reflect.TypeOf((*MockUserRepository)(nil).SetOrganizationMemberExternalID), ctx, organizationID, userID, externalID)
+}
+
+// SetOrganizationRetention mocks base method.
+func (m *MockUserRepository) SetOrganizationRetention(ctx context.Context, retention *db.OrganizationRetention) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetOrganizationRetention", ctx, retention)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetOrganizationRetention indicates an expected call of SetOrganizationRetention.
+func (mr *MockUserRepositoryMockRecorder) SetOrganizationRetention(ctx, retention any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetOrganizationRetention", reflect.TypeOf((*MockUserRepository)(nil).SetOrganizationRetention), ctx, retention)
+}
+
+// SetPendingEmail mocks base method.
+func (m *MockUserRepository) SetPendingEmail(ctx context.Context, userID, email string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetPendingEmail", ctx, userID, email)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetPendingEmail indicates an expected call of SetPendingEmail.
+func (mr *MockUserRepositoryMockRecorder) SetPendingEmail(ctx, userID, email any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetPendingEmail", reflect.TypeOf((*MockUserRepository)(nil).SetPendingEmail), ctx, userID, email)
+}
+
+// SetSCIMToken mocks base method.
+func (m *MockUserRepository) SetSCIMToken(ctx context.Context, organizationID, tokenHash string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetSCIMToken", ctx, organizationID, tokenHash)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetSCIMToken indicates an expected call of SetSCIMToken.
+func (mr *MockUserRepositoryMockRecorder) SetSCIMToken(ctx, organizationID, tokenHash any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetSCIMToken", reflect.TypeOf((*MockUserRepository)(nil).SetSCIMToken), ctx, organizationID, tokenHash)
+}
+
+// SetUserPlan mocks base method.
+func (m *MockUserRepository) SetUserPlan(ctx context.Context, userID, plan string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetUserPlan", ctx, userID, plan)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetUserPlan indicates an expected call of SetUserPlan.
+func (mr *MockUserRepositoryMockRecorder) SetUserPlan(ctx, userID, plan any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetUserPlan", reflect.TypeOf((*MockUserRepository)(nil).SetUserPlan), ctx, userID, plan)
+}
+
+// SetUserRole mocks base method.
+func (m *MockUserRepository) SetUserRole(ctx context.Context, userID, role string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetUserRole", ctx, userID, role)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetUserRole indicates an expected call of SetUserRole.
+func (mr *MockUserRepositoryMockRecorder) SetUserRole(ctx, userID, role any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetUserRole", reflect.TypeOf((*MockUserRepository)(nil).SetUserRole), ctx, userID, role)
+}
+
+// SuspendUser mocks base method.
+func (m *MockUserRepository) SuspendUser(ctx context.Context, userID, reason string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SuspendUser", ctx, userID, reason)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SuspendUser indicates an expected call of SuspendUser.
+func (mr *MockUserRepositoryMockRecorder) SuspendUser(ctx, userID, reason any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SuspendUser", reflect.TypeOf((*MockUserRepository)(nil).SuspendUser), ctx, userID, reason)
+}
+
+// UpdateHouseholdMemberRouting mocks base method.
+func (m *MockUserRepository) UpdateHouseholdMemberRouting(ctx context.Context, householdID, userID, routing string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateHouseholdMemberRouting", ctx, householdID, userID, routing)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateHouseholdMemberRouting indicates an expected call of UpdateHouseholdMemberRouting.
+func (mr *MockUserRepositoryMockRecorder) UpdateHouseholdMemberRouting(ctx, householdID, userID, routing any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateHouseholdMemberRouting", reflect.TypeOf((*MockUserRepository)(nil).UpdateHouseholdMemberRouting), ctx, householdID, userID, routing)
+}
+
+// UpdateOrganizationCompliance mocks base method.
+func (m *MockUserRepository) UpdateOrganizationCompliance(ctx context.Context, organizationID string, enabled bool, categories []string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateOrganizationCompliance", ctx, organizationID, enabled, categories)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateOrganizationCompliance indicates an expected call of UpdateOrganizationCompliance.
+func (mr *MockUserRepositoryMockRecorder) UpdateOrganizationCompliance(ctx, organizationID, enabled, categories any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateOrganizationCompliance", reflect.TypeOf((*MockUserRepository)(nil).UpdateOrganizationCompliance), ctx, organizationID, enabled, categories)
+}
+
+// UpdateOrganizationRenewalApproval mocks base method.
+func (m *MockUserRepository) UpdateOrganizationRenewalApproval(ctx context.Context, organizationID string, required bool) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateOrganizationRenewalApproval", ctx, organizationID, required)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateOrganizationRenewalApproval indicates an expected call of UpdateOrganizationRenewalApproval.
+func (mr *MockUserRepositoryMockRecorder) UpdateOrganizationRenewalApproval(ctx, organizationID, required any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateOrganizationRenewalApproval", reflect.TypeOf((*MockUserRepository)(nil).UpdateOrganizationRenewalApproval), ctx, organizationID, required)
+}
+
+// MockDocumentRepository is a mock of DocumentRepository interface.
+type MockDocumentRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockDocumentRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockDocumentRepositoryMockRecorder is the mock recorder for MockDocumentRepository.
+type MockDocumentRepositoryMockRecorder struct {
+	mock *MockDocumentRepository
+}
+
+// NewMockDocumentRepository creates a new mock instance.
+func NewMockDocumentRepository(ctrl *gomock.Controller) *MockDocumentRepository {
+	mock := &MockDocumentRepository{ctrl: ctrl}
+	mock.recorder = &MockDocumentRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockDocumentRepository) EXPECT() *MockDocumentRepositoryMockRecorder {
+	return m.recorder
+}
+
+// AcknowledgeDocumentAssignment mocks base method.
+func (m *MockDocumentRepository) AcknowledgeDocumentAssignment(ctx context.Context, documentID, assigneeID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AcknowledgeDocumentAssignment", ctx, documentID, assigneeID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AcknowledgeDocumentAssignment indicates an expected call of AcknowledgeDocumentAssignment.
+func (mr *MockDocumentRepositoryMockRecorder) AcknowledgeDocumentAssignment(ctx, documentID, assigneeID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AcknowledgeDocumentAssignment", reflect.TypeOf((*MockDocumentRepository)(nil).AcknowledgeDocumentAssignment), ctx, documentID, assigneeID)
+}
+
+// AcquireDocumentLock mocks base method.
+func (m *MockDocumentRepository) AcquireDocumentLock(ctx context.Context, documentID, userID string, expiresAt time.Time) (*db.DocumentLock, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AcquireDocumentLock", ctx, documentID, userID, expiresAt)
+	ret0, _ := ret[0].(*db.DocumentLock)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AcquireDocumentLock indicates an expected call of AcquireDocumentLock.
+func (mr *MockDocumentRepositoryMockRecorder) AcquireDocumentLock(ctx, documentID, userID, expiresAt any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AcquireDocumentLock", reflect.TypeOf((*MockDocumentRepository)(nil).AcquireDocumentLock), ctx, documentID, userID, expiresAt)
+}
+
+// AddDocumentDependency mocks base method.
+func (m *MockDocumentRepository) AddDocumentDependency(ctx context.Context, documentID, dependsOnID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddDocumentDependency", ctx, documentID, dependsOnID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AddDocumentDependency indicates an expected call of AddDocumentDependency.
+func (mr *MockDocumentRepositoryMockRecorder) AddDocumentDependency(ctx, documentID, dependsOnID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddDocumentDependency", reflect.TypeOf((*MockDocumentRepository)(nil).AddDocumentDependency), ctx, documentID, dependsOnID)
+}
+
+// AddRoadmapVote mocks base method.
+func (m *MockDocumentRepository) AddRoadmapVote(ctx context.Context, featureID, userID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddRoadmapVote", ctx, featureID, userID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AddRoadmapVote indicates an expected call of AddRoadmapVote.
+func (mr *MockDocumentRepositoryMockRecorder) AddRoadmapVote(ctx, featureID, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddRoadmapVote", reflect.TypeOf((*MockDocumentRepository)(nil).AddRoadmapVote), ctx, featureID, userID)
+}
+
+// AssignDocument mocks base method.
+func (m *MockDocumentRepository) AssignDocument(ctx context.Context, documentID, assigneeID, assignedBy string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AssignDocument", ctx, documentID, assigneeID, assignedBy)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AssignDocument indicates an expected call of AssignDocument.
+func (mr *MockDocumentRepositoryMockRecorder) AssignDocument(ctx, documentID, assigneeID, assignedBy any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AssignDocument", reflect.TypeOf((*MockDocumentRepository)(nil).AssignDocument), ctx, documentID, assigneeID, assignedBy)
+}
+
+// CreateAttachmentBlob mocks base method.
+func (m *MockDocumentRepository) CreateAttachmentBlob(ctx context.Context, blob *db.AttachmentBlob) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateAttachmentBlob", ctx, blob)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateAttachmentBlob indicates an expected call of CreateAttachmentBlob.
+func (mr *MockDocumentRepositoryMockRecorder) CreateAttachmentBlob(ctx, blob any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateAttachmentBlob", reflect.TypeOf((*MockDocumentRepository)(nil).CreateAttachmentBlob), ctx, blob)
+}
+
+// CreateAttachmentRetentionWarning mocks base method.
+func (m *MockDocumentRepository) CreateAttachmentRetentionWarning(ctx context.Context, warning *db.AttachmentRetentionWarning) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateAttachmentRetentionWarning", ctx, warning)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateAttachmentRetentionWarning indicates an expected call of CreateAttachmentRetentionWarning.
+func (mr *MockDocumentRepositoryMockRecorder) CreateAttachmentRetentionWarning(ctx, warning any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateAttachmentRetentionWarning", reflect.TypeOf((*MockDocumentRepository)(nil).CreateAttachmentRetentionWarning), ctx, warning)
+}
+
+// CreateAuditLog mocks base method.
+func (m *MockDocumentRepository) CreateAuditLog(ctx context.Context, entry *db.AuditLog) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateAuditLog", ctx, entry)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateAuditLog indicates an expected call of CreateAuditLog.
+func (mr *MockDocumentRepositoryMockRecorder) CreateAuditLog(ctx, entry any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateAuditLog", reflect.TypeOf((*MockDocumentRepository)(nil).CreateAuditLog), ctx, entry)
+}
+
+// CreateChecklistItem mocks base method.
+func (m *MockDocumentRepository) CreateChecklistItem(ctx context.Context, item *db.ChecklistItem) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateChecklistItem", ctx, item)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateChecklistItem indicates an expected call of CreateChecklistItem.
+func (mr *MockDocumentRepositoryMockRecorder) CreateChecklistItem(ctx, item any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateChecklistItem", reflect.TypeOf((*MockDocumentRepository)(nil).CreateChecklistItem), ctx, item)
+}
+
+// CreateDocument mocks base method.
+func (m *MockDocumentRepository) CreateDocument(ctx context.Context, document *db.Document) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateDocument", ctx, document)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateDocument indicates an expected call of CreateDocument.
+func (mr *MockDocumentRepositoryMockRecorder) CreateDocument(ctx, document any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateDocument", reflect.TypeOf((*MockDocumentRepository)(nil).CreateDocument), ctx, document)
+}
+
+// CreateDocumentContact mocks base method.
+func (m *MockDocumentRepository) CreateDocumentContact(ctx context.Context, contact *db.DocumentContact) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateDocumentContact", ctx, contact)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateDocumentContact indicates an expected call of CreateDocumentContact.
+func (mr *MockDocumentRepositoryMockRecorder) CreateDocumentContact(ctx, contact any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateDocumentContact", reflect.TypeOf((*MockDocumentRepository)(nil).CreateDocumentContact), ctx, contact)
+}
+
+// CreateFeedback mocks base method.
+func (m *MockDocumentRepository) CreateFeedback(ctx context.Context, feedback *db.Feedback) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateFeedback", ctx, feedback)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateFeedback indicates an expected call of CreateFeedback.
+func (mr *MockDocumentRepositoryMockRecorder) CreateFeedback(ctx, feedback any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateFeedback", reflect.TypeOf((*MockDocumentRepository)(nil).CreateFeedback), ctx, feedback)
+}
+
+// CreateIssuer mocks base method.
+func (m *MockDocumentRepository) CreateIssuer(ctx context.Context, issuer *db.Issuer) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateIssuer", ctx, issuer)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateIssuer indicates an expected call of CreateIssuer.
+func (mr *MockDocumentRepositoryMockRecorder) CreateIssuer(ctx, issuer any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateIssuer", reflect.TypeOf((*MockDocumentRepository)(nil).CreateIssuer), ctx, issuer)
+}
+
+// CreateRenewalRequest mocks base method.
+func (m *MockDocumentRepository) CreateRenewalRequest(ctx context.Context, request *db.RenewalRequest) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateRenewalRequest", ctx, request)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateRenewalRequest indicates an expected call of CreateRenewalRequest.
+func (mr *MockDocumentRepositoryMockRecorder) CreateRenewalRequest(ctx, request any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateRenewalRequest", reflect.TypeOf((*MockDocumentRepository)(nil).CreateRenewalRequest), ctx, request)
+}
+
+// CreateRoadmapFeature mocks base method.
+func (m *MockDocumentRepository) CreateRoadmapFeature(ctx context.Context, feature *db.RoadmapFeature) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateRoadmapFeature", ctx, feature)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateRoadmapFeature indicates an expected call of CreateRoadmapFeature.
+func (mr *MockDocumentRepositoryMockRecorder) CreateRoadmapFeature(ctx, feature any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateRoadmapFeature", reflect.TypeOf((*MockDocumentRepository)(nil).CreateRoadmapFeature), ctx, feature)
+}
+
+// CreateSavedView mocks base method.
+func (m *MockDocumentRepository) CreateSavedView(ctx context.Context, view *db.SavedView) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateSavedView", ctx, view)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateSavedView indicates an expected call of CreateSavedView.
+func (mr *MockDocumentRepositoryMockRecorder) CreateSavedView(ctx, view any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateSavedView", reflect.TypeOf((*MockDocumentRepository)(nil).CreateSavedView), ctx, view)
+}
+
+// DataRegions mocks base method.
+func (m *MockDocumentRepository) DataRegions() []string {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DataRegions")
+	ret0, _ := ret[0].([]string)
+	return ret0
+}
+
+// DataRegions indicates an expected call of DataRegions.
+func (mr *MockDocumentRepositoryMockRecorder) DataRegions() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DataRegions", reflect.TypeOf((*MockDocumentRepository)(nil).DataRegions))
+}
+
+// DecideRenewalRequest mocks base method.
+func (m *MockDocumentRepository) DecideRenewalRequest(ctx context.Context, requestID, status, decidedBy string, note *string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DecideRenewalRequest", ctx, requestID, status, decidedBy, note)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DecideRenewalRequest indicates an expected call of DecideRenewalRequest.
+func (mr *MockDocumentRepositoryMockRecorder) DecideRenewalRequest(ctx, requestID, status, decidedBy, note any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DecideRenewalRequest", reflect.TypeOf((*MockDocumentRepository)(nil).DecideRenewalRequest), ctx, requestID, status, decidedBy, note)
+}
+
+// DeleteAttachmentRetentionWarning mocks base method.
+func (m *MockDocumentRepository) DeleteAttachmentRetentionWarning(ctx context.Context, documentID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteAttachmentRetentionWarning", ctx, documentID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteAttachmentRetentionWarning indicates an expected call of DeleteAttachmentRetentionWarning.
+func (mr *MockDocumentRepositoryMockRecorder) DeleteAttachmentRetentionWarning(ctx, documentID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteAttachmentRetentionWarning", reflect.TypeOf((*MockDocumentRepository)(nil).DeleteAttachmentRetentionWarning), ctx, documentID)
+}
+
+// DeleteChecklistItem mocks base method.
+func (m *MockDocumentRepository) DeleteChecklistItem(ctx context.Context, documentID, itemID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteChecklistItem", ctx, documentID, itemID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteChecklistItem indicates an expected call of DeleteChecklistItem.
+func (mr *MockDocumentRepositoryMockRecorder) DeleteChecklistItem(ctx, documentID, itemID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteChecklistItem", reflect.TypeOf((*MockDocumentRepository)(nil).DeleteChecklistItem), ctx, documentID, itemID)
+}
+
+// DeleteDocument mocks base method.
+func (m *MockDocumentRepository) DeleteDocument(ctx context.Context, documentID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteDocument", ctx, documentID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteDocument indicates an expected call of DeleteDocument.
+func (mr *MockDocumentRepositoryMockRecorder) DeleteDocument(ctx, documentID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteDocument", reflect.TypeOf((*MockDocumentRepository)(nil).DeleteDocument), ctx, documentID)
+}
+
+// DeleteDocumentContact mocks base method.
+func (m *MockDocumentRepository) DeleteDocumentContact(ctx context.Context, documentID, contactID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteDocumentContact", ctx, documentID, contactID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteDocumentContact indicates an expected call of DeleteDocumentContact.
+func (mr *MockDocumentRepositoryMockRecorder) DeleteDocumentContact(ctx, documentID, contactID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteDocumentContact", reflect.TypeOf((*MockDocumentRepository)(nil).DeleteDocumentContact), ctx, documentID, contactID)
+}
+
+// DeleteIssuer mocks base method.
+func (m *MockDocumentRepository) DeleteIssuer(ctx context.Context, issuerID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteIssuer", ctx, issuerID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteIssuer indicates an expected call of DeleteIssuer.
+func (mr *MockDocumentRepositoryMockRecorder) DeleteIssuer(ctx, issuerID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteIssuer", reflect.TypeOf((*MockDocumentRepository)(nil).DeleteIssuer), ctx, issuerID)
+}
+
+// DeleteRoadmapFeature mocks base method.
+func (m *MockDocumentRepository) DeleteRoadmapFeature(ctx context.Context, featureID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteRoadmapFeature", ctx, featureID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteRoadmapFeature indicates an expected call of DeleteRoadmapFeature.
+func (mr *MockDocumentRepositoryMockRecorder) DeleteRoadmapFeature(ctx, featureID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteRoadmapFeature", reflect.TypeOf((*MockDocumentRepository)(nil).DeleteRoadmapFeature), ctx, featureID)
+}
+
+// DeleteSavedView mocks base method.
+func (m *MockDocumentRepository) DeleteSavedView(ctx context.Context, viewID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteSavedView", ctx, viewID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteSavedView indicates an expected call of DeleteSavedView.
+func (mr *MockDocumentRepositoryMockRecorder) DeleteSavedView(ctx, viewID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteSavedView", reflect.TypeOf((*MockDocumentRepository)(nil).DeleteSavedView), ctx, viewID)
+}
+
+// EncryptPlaintextIdentifiers mocks base method.
+func (m *MockDocumentRepository) EncryptPlaintextIdentifiers(ctx context.Context) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EncryptPlaintextIdentifiers", ctx)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// EncryptPlaintextIdentifiers indicates an expected call of EncryptPlaintextIdentifiers.
+func (mr *MockDocumentRepositoryMockRecorder) EncryptPlaintextIdentifiers(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EncryptPlaintextIdentifiers", reflect.TypeOf((*MockDocumentRepository)(nil).EncryptPlaintextIdentifiers), ctx)
+}
+
+// FindDocumentsByIdentifier mocks base method.
+func (m *MockDocumentRepository) FindDocumentsByIdentifier(ctx context.Context, userID, identifier string) ([]*db.Document, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindDocumentsByIdentifier", ctx, userID, identifier)
+	ret0, _ := ret[0].([]*db.Document)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindDocumentsByIdentifier indicates an expected call of FindDocumentsByIdentifier.
+func (mr *MockDocumentRepositoryMockRecorder) FindDocumentsByIdentifier(ctx, userID, identifier any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindDocumentsByIdentifier", reflect.TypeOf((*MockDocumentRepository)(nil).FindDocumentsByIdentifier), ctx, userID, identifier)
+}
+
+// FindSimilarDocuments mocks base method.
+func (m *MockDocumentRepository) FindSimilarDocuments(ctx context.Context, userID, name string, identifier *string, minSimilarity float64, limit int) ([]*db.Document, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindSimilarDocuments", ctx, userID, name, identifier, minSimilarity, limit)
+	ret0, _ := ret[0].([]*db.Document)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindSimilarDocuments indicates an expected call of FindSimilarDocuments.
+func (mr *MockDocumentRepositoryMockRecorder) FindSimilarDocuments(ctx, userID, name, identifier, minSimilarity, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindSimilarDocuments", reflect.TypeOf((*MockDocumentRepository)(nil).FindSimilarDocuments), ctx, userID, name, identifier, minSimilarity, limit)
+}
+
+// GetAttachmentBlob mocks base method.
+func (m *MockDocumentRepository) GetAttachmentBlob(ctx context.Context, userID, checksum string) (*db.AttachmentBlob, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAttachmentBlob", ctx, userID, checksum)
+	ret0, _ := ret[0].(*db.AttachmentBlob)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAttachmentBlob indicates an expected call of GetAttachmentBlob.
+func (mr *MockDocumentRepositoryMockRecorder) GetAttachmentBlob(ctx, userID, checksum any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAttachmentBlob", reflect.TypeOf((*MockDocumentRepository)(nil).GetAttachmentBlob), ctx, userID, checksum)
+}
+
+// GetAttachmentRetentionWarning mocks base method.
+func (m *MockDocumentRepository) GetAttachmentRetentionWarning(ctx context.Context, documentID string) (*db.AttachmentRetentionWarning, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAttachmentRetentionWarning", ctx, documentID)
+	ret0, _ := ret[0].(*db.AttachmentRetentionWarning)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAttachmentRetentionWarning indicates an expected call of GetAttachmentRetentionWarning.
+func (mr *MockDocumentRepositoryMockRecorder) GetAttachmentRetentionWarning(ctx, documentID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAttachmentRetentionWarning", reflect.TypeOf((*MockDocumentRepository)(nil).GetAttachmentRetentionWarning), ctx, documentID)
+}
+
+// GetChecklistItem mocks base method.
+func (m *MockDocumentRepository) GetChecklistItem(ctx context.Context, documentID, itemID string) (*db.ChecklistItem, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetChecklistItem", ctx, documentID, itemID)
+	ret0, _ := ret[0].(*db.ChecklistItem)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetChecklistItem indicates an expected call of GetChecklistItem.
+func (mr *MockDocumentRepositoryMockRecorder) GetChecklistItem(ctx, documentID, itemID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetChecklistItem", reflect.TypeOf((*MockDocumentRepository)(nil).GetChecklistItem), ctx, documentID, itemID)
+}
+
+// GetDocumentAssignment mocks base method.
+func (m *MockDocumentRepository) GetDocumentAssignment(ctx context.Context, documentID string) (*db.DocumentAssignment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDocumentAssignment", ctx, documentID)
+	ret0, _ := ret[0].(*db.DocumentAssignment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDocumentAssignment indicates an expected call of GetDocumentAssignment.
+func (mr *MockDocumentRepositoryMockRecorder) GetDocumentAssignment(ctx, documentID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDocumentAssignment", reflect.TypeOf((*MockDocumentRepository)(nil).GetDocumentAssignment), ctx, documentID)
+}
+
+// GetDocumentByID mocks base method.
+func (m *MockDocumentRepository) GetDocumentByID(ctx context.Context, documentID string) (*db.Document, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDocumentByID", ctx, documentID)
+	ret0, _ := ret[0].(*db.Document)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDocumentByID indicates an expected call of GetDocumentByID.
+func (mr *MockDocumentRepositoryMockRecorder) GetDocumentByID(ctx, documentID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDocumentByID", reflect.TypeOf((*MockDocumentRepository)(nil).GetDocumentByID), ctx, documentID)
+}
+
+// GetDocumentCategory mocks base method.
+func (m *MockDocumentRepository) GetDocumentCategory(ctx context.Context, slug string) (*db.DocumentCategory, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDocumentCategory", ctx, slug)
+	ret0, _ := ret[0].(*db.DocumentCategory)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDocumentCategory indicates an expected call of GetDocumentCategory.
+func (mr *MockDocumentRepositoryMockRecorder) GetDocumentCategory(ctx, slug any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDocumentCategory", reflect.TypeOf((*MockDocumentRepository)(nil).GetDocumentCategory), ctx, slug)
+}
+
+// GetDocumentContactByUnsubscribeToken mocks base method.
+func (m *MockDocumentRepository) GetDocumentContactByUnsubscribeToken(ctx context.Context, token string) (*db.DocumentContact, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDocumentContactByUnsubscribeToken", ctx, token)
+	ret0, _ := ret[0].(*db.DocumentContact)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDocumentContactByUnsubscribeToken indicates an expected call of GetDocumentContactByUnsubscribeToken.
+func (mr *MockDocumentRepositoryMockRecorder) GetDocumentContactByUnsubscribeToken(ctx, token any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDocumentContactByUnsubscribeToken", reflect.TypeOf((*MockDocumentRepository)(nil).GetDocumentContactByUnsubscribeToken), ctx, token)
+}
+
+// GetDocumentLock mocks base method.
+func (m *MockDocumentRepository) GetDocumentLock(ctx context.Context, documentID string) (*db.DocumentLock, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDocumentLock", ctx, documentID)
+	ret0, _ := ret[0].(*db.DocumentLock)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDocumentLock indicates an expected call of GetDocumentLock.
+func (mr *MockDocumentRepositoryMockRecorder) GetDocumentLock(ctx, documentID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDocumentLock", reflect.TypeOf((*MockDocumentRepository)(nil).GetDocumentLock), ctx, documentID)
+}
+
+// GetDocumentStats mocks base method.
+func (m *MockDocumentRepository) GetDocumentStats(ctx context.Context, userID string) (*db.DocumentStats, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDocumentStats", ctx, userID)
+	ret0, _ := ret[0].(*db.DocumentStats)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDocumentStats indicates an expected call of GetDocumentStats.
+func (mr *MockDocumentRepositoryMockRecorder) GetDocumentStats(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDocumentStats", reflect.TypeOf((*MockDocumentRepository)(nil).GetDocumentStats), ctx, userID)
+}
+
+// GetDocumentTemplate mocks base method.
+func (m *MockDocumentRepository) GetDocumentTemplate(ctx context.Context, slug string) (*db.DocumentTemplate, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDocumentTemplate", ctx, slug)
+	ret0, _ := ret[0].(*db.DocumentTemplate)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDocumentTemplate indicates an expected call of GetDocumentTemplate.
+func (mr *MockDocumentRepositoryMockRecorder) GetDocumentTemplate(ctx, slug any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDocumentTemplate", reflect.TypeOf((*MockDocumentRepository)(nil).GetDocumentTemplate), ctx, slug)
+}
+
+// GetFeedback mocks base method.
+func (m *MockDocumentRepository) GetFeedback(ctx context.Context, feedbackID string) (*db.Feedback, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetFeedback", ctx, feedbackID)
+	ret0, _ := ret[0].(*db.Feedback)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetFeedback indicates an expected call of GetFeedback.
+func (mr *MockDocumentRepositoryMockRecorder) GetFeedback(ctx, feedbackID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFeedback", reflect.TypeOf((*MockDocumentRepository)(nil).GetFeedback), ctx, feedbackID)
+}
+
+// GetIssuer mocks base method.
+func (m *MockDocumentRepository) GetIssuer(ctx context.Context, issuerID string) (*db.Issuer, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetIssuer", ctx, issuerID)
+	ret0, _ := ret[0].(*db.Issuer)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetIssuer indicates an expected call of GetIssuer.
+func (mr *MockDocumentRepositoryMockRecorder) GetIssuer(ctx, issuerID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetIssuer", reflect.TypeOf((*MockDocumentRepository)(nil).GetIssuer), ctx, issuerID)
+}
+
+// GetLegalHold mocks base method.
+func (m *MockDocumentRepository) GetLegalHold(ctx context.Context, organizationID string, documentID *string) (*db.LegalHold, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetLegalHold", ctx, organizationID, documentID)
+	ret0, _ := ret[0].(*db.LegalHold)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetLegalHold indicates an expected call of GetLegalHold.
+func (mr *MockDocumentRepositoryMockRecorder) GetLegalHold(ctx, organizationID, documentID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLegalHold", reflect.TypeOf((*MockDocumentRepository)(nil).GetLegalHold), ctx, organizationID, documentID)
+}
+
+// GetRenewalRequest mocks base method.
+func (m *MockDocumentRepository) GetRenewalRequest(ctx context.Context, documentID, requestID string) (*db.RenewalRequest, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRenewalRequest", ctx, documentID, requestID)
+	ret0, _ := ret[0].(*db.RenewalRequest)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetRenewalRequest indicates an expected call of GetRenewalRequest.
+func (mr *MockDocumentRepositoryMockRecorder) GetRenewalRequest(ctx, documentID, requestID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRenewalRequest", reflect.TypeOf((*MockDocumentRepository)(nil).GetRenewalRequest), ctx, documentID, requestID)
+}
+
+// GetRoadmapFeature mocks base method.
+func (m *MockDocumentRepository) GetRoadmapFeature(ctx context.Context, featureID, userID string) (*db.RoadmapFeature, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRoadmapFeature", ctx, featureID, userID)
+	ret0, _ := ret[0].(*db.RoadmapFeature)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetRoadmapFeature indicates an expected call of GetRoadmapFeature.
+func (mr *MockDocumentRepositoryMockRecorder) GetRoadmapFeature(ctx, featureID, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRoadmapFeature", reflect.TypeOf((*MockDocumentRepository)(nil).GetRoadmapFeature), ctx, featureID, userID)
+}
+
+// GetSavedView mocks base method.
+func (m *MockDocumentRepository) GetSavedView(ctx context.Context, viewID string) (*db.SavedView, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSavedView", ctx, viewID)
+	ret0, _ := ret[0].(*db.SavedView)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSavedView indicates an expected call of GetSavedView.
+func (mr *MockDocumentRepositoryMockRecorder) GetSavedView(ctx, viewID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSavedView", reflect.TypeOf((*MockDocumentRepository)(nil).GetSavedView), ctx, viewID)
+}
+
+// GetTrashedDocument mocks base method.
+func (m *MockDocumentRepository) GetTrashedDocument(ctx context.Context, documentID string) (*db.Document, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTrashedDocument", ctx, documentID)
+	ret0, _ := ret[0].(*db.Document)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTrashedDocument indicates an expected call of GetTrashedDocument.
+func (mr *MockDocumentRepositoryMockRecorder) GetTrashedDocument(ctx, documentID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTrashedDocument", reflect.TypeOf((*MockDocumentRepository)(nil).GetTrashedDocument), ctx, documentID)
+}
+
+// GetValidityPeriod mocks base method.
+func (m *MockDocumentRepository) GetValidityPeriod(ctx context.Context, categorySlug, countryCode string) (*db.ValidityPeriod, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetValidityPeriod", ctx, categorySlug, countryCode)
+	ret0, _ := ret[0].(*db.ValidityPeriod)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetValidityPeriod indicates an expected call of GetValidityPeriod.
+func (mr *MockDocumentRepositoryMockRecorder) GetValidityPeriod(ctx, categorySlug, countryCode any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetValidityPeriod", reflect.TypeOf((*MockDocumentRepository)(nil).GetValidityPeriod), ctx, categorySlug, countryCode)
+}
+
+// ListChecklistItems mocks base method.
+func (m *MockDocumentRepository) ListChecklistItems(ctx context.Context, documentID string) ([]*db.ChecklistItem, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListChecklistItems", ctx, documentID)
+	ret0, _ := ret[0].([]*db.ChecklistItem)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListChecklistItems indicates an expected call of ListChecklistItems.
+func (mr *MockDocumentRepositoryMockRecorder) ListChecklistItems(ctx, documentID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListChecklistItems", reflect.TypeOf((*MockDocumentRepository)(nil).ListChecklistItems), ctx, documentID)
+}
+
+// ListDeletedAccountDocuments mocks base method.
+func (m *MockDocumentRepository) ListDeletedAccountDocuments(ctx context.Context, cutoff time.Time, limit int) ([]*db.Document, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListDeletedAccountDocuments", ctx, cutoff, limit)
+	ret0, _ := ret[0].([]*db.Document)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListDeletedAccountDocuments indicates an expected call of ListDeletedAccountDocuments.
+func (mr *MockDocumentRepositoryMockRecorder) ListDeletedAccountDocuments(ctx, cutoff, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListDeletedAccountDocuments", reflect.TypeOf((*MockDocumentRepository)(nil).ListDeletedAccountDocuments), ctx, cutoff, limit)
+}
+
+// ListDocumentCategories mocks base method.
+func (m *MockDocumentRepository) ListDocumentCategories(ctx context.Context) ([]*db.DocumentCategory, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListDocumentCategories", ctx)
+	ret0, _ := ret[0].([]*db.DocumentCategory)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListDocumentCategories indicates an expected call of ListDocumentCategories.
+func (mr *MockDocumentRepositoryMockRecorder) ListDocumentCategories(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListDocumentCategories", reflect.TypeOf((*MockDocumentRepository)(nil).ListDocumentCategories), ctx)
+}
+
+// ListDocumentContacts mocks base method.
+func (m *MockDocumentRepository) ListDocumentContacts(ctx context.Context, documentID string) ([]*db.DocumentContact, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListDocumentContacts", ctx, documentID)
+	ret0, _ := ret[0].([]*db.DocumentContact)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListDocumentContacts indicates an expected call of ListDocumentContacts.
+func (mr *MockDocumentRepositoryMockRecorder) ListDocumentContacts(ctx, documentID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListDocumentContacts", reflect.TypeOf((*MockDocumentRepository)(nil).ListDocumentContacts), ctx, documentID)
+}
+
+// ListDocumentCustomFields mocks base method.
+func (m *MockDocumentRepository) ListDocumentCustomFields(ctx context.Context, documentID string) ([]*db.CustomField, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListDocumentCustomFields", ctx, documentID)
+	ret0, _ := ret[0].([]*db.CustomField)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListDocumentCustomFields indicates an expected call of ListDocumentCustomFields.
+func (mr *MockDocumentRepositoryMockRecorder) ListDocumentCustomFields(ctx, documentID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListDocumentCustomFields", reflect.TypeOf((*MockDocumentRepository)(nil).ListDocumentCustomFields), ctx, documentID)
+}
+
+// ListDocumentDependencies mocks base method.
+func (m *MockDocumentRepository) ListDocumentDependencies(ctx context.Context, documentID string) ([]*db.Document, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListDocumentDependencies", ctx, documentID)
+	ret0, _ := ret[0].([]*db.Document)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListDocumentDependencies indicates an expected call of ListDocumentDependencies.
+func (mr *MockDocumentRepositoryMockRecorder) ListDocumentDependencies(ctx, documentID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListDocumentDependencies", reflect.TypeOf((*MockDocumentRepository)(nil).ListDocumentDependencies), ctx, documentID)
+}
+
+// ListDocumentDependencyEdges mocks base method.
+func (m *MockDocumentRepository) ListDocumentDependencyEdges(ctx context.Context, documentIDs []string) ([]*db.DocumentDependency, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListDocumentDependencyEdges", ctx, documentIDs)
+	ret0, _ := ret[0].([]*db.DocumentDependency)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListDocumentDependencyEdges indicates an expected call of ListDocumentDependencyEdges.
+func (mr *MockDocumentRepositoryMockRecorder) ListDocumentDependencyEdges(ctx, documentIDs any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListDocumentDependencyEdges", reflect.TypeOf((*MockDocumentRepository)(nil).ListDocumentDependencyEdges), ctx, documentIDs)
+}
+
+// ListDocumentDependents mocks base method.
+func (m *MockDocumentRepository) ListDocumentDependents(ctx context.Context, documentID string) ([]*db.Document, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListDocumentDependents", ctx, documentID)
+	ret0, _ := ret[0].([]*db.Document)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListDocumentDependents indicates an expected call of ListDocumentDependents.
+func (mr *MockDocumentRepositoryMockRecorder) ListDocumentDependents(ctx, documentID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListDocumentDependents", reflect.TypeOf((*MockDocumentRepository)(nil).ListDocumentDependents), ctx, documentID)
+}
+
+// ListDocumentPositions mocks base method.
+func (m *MockDocumentRepository) ListDocumentPositions(ctx context.Context, userID string) ([]*db.DocumentPosition, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListDocumentPositions", ctx, userID)
+	ret0, _ := ret[0].([]*db.DocumentPosition)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListDocumentPositions indicates an expected call of ListDocumentPositions.
+func (mr *MockDocumentRepositoryMockRecorder) ListDocumentPositions(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListDocumentPositions", reflect.TypeOf((*MockDocumentRepository)(nil).ListDocumentPositions), ctx, userID)
+}
+
+// ListDocumentTemplates mocks base method.
+func (m *MockDocumentRepository) ListDocumentTemplates(ctx context.Context) ([]*db.DocumentTemplate, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListDocumentTemplates", ctx)
+	ret0, _ := ret[0].([]*db.DocumentTemplate)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListDocumentTemplates indicates an expected call of ListDocumentTemplates.
+func (mr *MockDocumentRepositoryMockRecorder) ListDocumentTemplates(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListDocumentTemplates", reflect.TypeOf((*MockDocumentRepository)(nil).ListDocumentTemplates), ctx)
+}
+
+// ListDocumentsByUserID mocks base method.
+func (m *MockDocumentRepository) ListDocumentsByUserID(ctx context.Context, userID string) ([]*db.Document, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListDocumentsByUserID", ctx, userID)
+	ret0, _ := ret[0].([]*db.Document)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListDocumentsByUserID indicates an expected call of ListDocumentsByUserID.
+func (mr *MockDocumentRepositoryMockRecorder) ListDocumentsByUserID(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListDocumentsByUserID", reflect.TypeOf((*MockDocumentRepository)(nil).ListDocumentsByUserID), ctx, userID)
+}
+
+// ListDocumentsTrashedBefore mocks base method.
+func (m *MockDocumentRepository) ListDocumentsTrashedBefore(ctx context.Context, cutoff time.Time, limit int) ([]*db.Document, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListDocumentsTrashedBefore", ctx, cutoff, limit)
+	ret0, _ := ret[0].([]*db.Document)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListDocumentsTrashedBefore indicates an expected call of ListDocumentsTrashedBefore.
+func (mr *MockDocumentRepositoryMockRecorder) ListDocumentsTrashedBefore(ctx, cutoff, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListDocumentsTrashedBefore", reflect.TypeOf((*MockDocumentRepository)(nil).ListDocumentsTrashedBefore), ctx, cutoff, limit)
+}
+
+// ListDocumentsWithAttachmentsExpiredBefore mocks base method.
+func (m *MockDocumentRepository) ListDocumentsWithAttachmentsExpiredBefore(ctx context.Context, before time.Time, afterID string, limit int) ([]*db.Document, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListDocumentsWithAttachmentsExpiredBefore", ctx, before, afterID, limit)
+	ret0, _ := ret[0].([]*db.Document)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListDocumentsWithAttachmentsExpiredBefore indicates an expected call of ListDocumentsWithAttachmentsExpiredBefore.
+func (mr *MockDocumentRepositoryMockRecorder) ListDocumentsWithAttachmentsExpiredBefore(ctx, before, afterID, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListDocumentsWithAttachmentsExpiredBefore", reflect.TypeOf((*MockDocumentRepository)(nil).ListDocumentsWithAttachmentsExpiredBefore), ctx, before, afterID, limit)
+}
+
+// ListExpiringDocuments mocks base method.
+func (m *MockDocumentRepository) ListExpiringDocuments(ctx context.Context, from, to time.Time) ([]*db.ExpiringDocument, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListExpiringDocuments", ctx, from, to)
+	ret0, _ := ret[0].([]*db.ExpiringDocument)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListExpiringDocuments indicates an expected call of ListExpiringDocuments.
+func (mr *MockDocumentRepositoryMockRecorder) ListExpiringDocuments(ctx, from, to any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListExpiringDocuments", reflect.TypeOf((*MockDocumentRepository)(nil).ListExpiringDocuments), ctx, from, to)
+}
+
+// ListIssuers mocks base method.
+func (m *MockDocumentRepository) ListIssuers(ctx context.Context, countryCode string) ([]*db.Issuer, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListIssuers", ctx, countryCode)
+	ret0, _ := ret[0].([]*db.Issuer)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListIssuers indicates an expected call of ListIssuers.
+func (mr *MockDocumentRepositoryMockRecorder) ListIssuers(ctx, countryCode any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListIssuers", reflect.TypeOf((*MockDocumentRepository)(nil).ListIssuers), ctx, countryCode)
+}
+
+// ListLegalHolds mocks base method.
+func (m *MockDocumentRepository) ListLegalHolds(ctx context.Context, organizationID string) ([]*db.LegalHold, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListLegalHolds", ctx, organizationID)
+	ret0, _ := ret[0].([]*db.LegalHold)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListLegalHolds indicates an expected call of ListLegalHolds.
+func (mr *MockDocumentRepositoryMockRecorder) ListLegalHolds(ctx, organizationID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListLegalHolds", reflect.TypeOf((*MockDocumentRepository)(nil).ListLegalHolds), ctx, organizationID)
+}
+
+// ListOrganizationDocumentsExpiredBefore mocks base method.
+func (m *MockDocumentRepository) ListOrganizationDocumentsExpiredBefore(ctx context.Context, organizationID string, cutoff time.Time, limit int) ([]*db.Document, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListOrganizationDocumentsExpiredBefore", ctx, organizationID, cutoff, limit)
+	ret0, _ := ret[0].([]*db.Document)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListOrganizationDocumentsExpiredBefore indicates an expected call of ListOrganizationDocumentsExpiredBefore.
+func (mr *MockDocumentRepositoryMockRecorder) ListOrganizationDocumentsExpiredBefore(ctx, organizationID, cutoff, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListOrganizationDocumentsExpiredBefore", reflect.TypeOf((*MockDocumentRepository)(nil).ListOrganizationDocumentsExpiredBefore), ctx, organizationID, cutoff, limit)
+}
+
+// ListRenewalCosts mocks base method.
+func (m *MockDocumentRepository) ListRenewalCosts(ctx context.Context, userID string, from, to time.Time) ([]*db.RenewalCostTotal, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListRenewalCosts", ctx, userID, from, to)
+	ret0, _ := ret[0].([]*db.RenewalCostTotal)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListRenewalCosts indicates an expected call of ListRenewalCosts.
+func (mr *MockDocumentRepositoryMockRecorder) ListRenewalCosts(ctx, userID, from, to any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListRenewalCosts", reflect.TypeOf((*MockDocumentRepository)(nil).ListRenewalCosts), ctx, userID, from, to)
+}
+
+// ListRenewalRequests mocks base method.
+func (m *MockDocumentRepository) ListRenewalRequests(ctx context.Context, documentID string) ([]*db.RenewalRequest, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListRenewalRequests", ctx, documentID)
+	ret0, _ := ret[0].([]*db.RenewalRequest)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListRenewalRequests indicates an expected call of ListRenewalRequests.
+func (mr *MockDocumentRepositoryMockRecorder) ListRenewalRequests(ctx, documentID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListRenewalRequests", reflect.TypeOf((*MockDocumentRepository)(nil).ListRenewalRequests), ctx, documentID)
+}
+
+// ListRoadmapFeatures mocks base method.
+func (m *MockDocumentRepository) ListRoadmapFeatures(ctx context.Context, userID string) ([]*db.RoadmapFeature, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListRoadmapFeatures", ctx, userID)
+	ret0, _ := ret[0].([]*db.RoadmapFeature)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListRoadmapFeatures indicates an expected call of ListRoadmapFeatures.
+func (mr *MockDocumentRepositoryMockRecorder) ListRoadmapFeatures(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListRoadmapFeatures", reflect.TypeOf((*MockDocumentRepository)(nil).ListRoadmapFeatures), ctx, userID)
+}
+
+// ListSampleDocumentIDs mocks base method.
+func (m *MockDocumentRepository) ListSampleDocumentIDs(ctx context.Context, userID string) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListSampleDocumentIDs", ctx, userID)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListSampleDocumentIDs indicates an expected call of ListSampleDocumentIDs.
+func (mr *MockDocumentRepositoryMockRecorder) ListSampleDocumentIDs(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListSampleDocumentIDs", reflect.TypeOf((*MockDocumentRepository)(nil).ListSampleDocumentIDs), ctx, userID)
+}
+
+// ListSavedViews mocks base method.
+func (m *MockDocumentRepository) ListSavedViews(ctx context.Context, userID string) ([]*db.SavedView, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListSavedViews", ctx, userID)
+	ret0, _ := ret[0].([]*db.SavedView)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListSavedViews indicates an expected call of ListSavedViews.
+func (mr *MockDocumentRepositoryMockRecorder) ListSavedViews(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListSavedViews", reflect.TypeOf((*MockDocumentRepository)(nil).ListSavedViews), ctx, userID)
+}
+
+// ListTrashedDocuments mocks base method.
+func (m *MockDocumentRepository) ListTrashedDocuments(ctx context.Context, userID string) ([]*db.Document, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListTrashedDocuments", ctx, userID)
+	ret0, _ := ret[0].([]*db.Document)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListTrashedDocuments indicates an expected call of ListTrashedDocuments.
+func (mr *MockDocumentRepositoryMockRecorder) ListTrashedDocuments(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListTrashedDocuments", reflect.TypeOf((*MockDocumentRepository)(nil).ListTrashedDocuments), ctx, userID)
+}
+
+// MarkFeedbackRelayed mocks base method.
+func (m *MockDocumentRepository) MarkFeedbackRelayed(ctx context.Context, feedbackID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MarkFeedbackRelayed", ctx, feedbackID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// MarkFeedbackRelayed indicates an expected call of MarkFeedbackRelayed.
+func (mr *MockDocumentRepositoryMockRecorder) MarkFeedbackRelayed(ctx, feedbackID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkFeedbackRelayed", reflect.TypeOf((*MockDocumentRepository)(nil).MarkFeedbackRelayed), ctx, feedbackID)
+}
+
+// MarkSampleDocument mocks base method.
+func (m *MockDocumentRepository) MarkSampleDocument(ctx context.Context, userID, documentID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MarkSampleDocument", ctx, userID, documentID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// MarkSampleDocument indicates an expected call of MarkSampleDocument.
+func (mr *MockDocumentRepositoryMockRecorder) MarkSampleDocument(ctx, userID, documentID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkSampleDocument", reflect.TypeOf((*MockDocumentRepository)(nil).MarkSampleDocument), ctx, userID, documentID)
+}
+
+// PlaceLegalHold mocks base method.
+func (m *MockDocumentRepository) PlaceLegalHold(ctx context.Context, hold *db.LegalHold) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PlaceLegalHold", ctx, hold)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PlaceLegalHold indicates an expected call of PlaceLegalHold.
+func (mr *MockDocumentRepositoryMockRecorder) PlaceLegalHold(ctx, hold any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PlaceLegalHold", reflect.TypeOf((*MockDocumentRepository)(nil).PlaceLegalHold), ctx, hold)
+}
+
+// PurgeDocument mocks base method.
+func (m *MockDocumentRepository) PurgeDocument(ctx context.Context, documentID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PurgeDocument", ctx, documentID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// PurgeDocument indicates an expected call of PurgeDocument.
+func (mr *MockDocumentRepositoryMockRecorder) PurgeDocument(ctx, documentID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PurgeDocument", reflect.TypeOf((*MockDocumentRepository)(nil).PurgeDocument), ctx, documentID)
+}
+
+// QuarantineAttachment mocks base method.
+func (m *MockDocumentRepository) QuarantineAttachment(ctx context.Context, documentID, attachmentURL, threat string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "QuarantineAttachment", ctx, documentID, attachmentURL, threat)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// QuarantineAttachment indicates an expected call of QuarantineAttachment.
+func (mr *MockDocumentRepositoryMockRecorder) QuarantineAttachment(ctx, documentID, attachmentURL, threat any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "QuarantineAttachment", reflect.TypeOf((*MockDocumentRepository)(nil).QuarantineAttachment), ctx, documentID, attachmentURL, threat)
+}
+
+// RefreshExpiringDocuments mocks base method.
+func (m *MockDocumentRepository) RefreshExpiringDocuments(ctx context.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RefreshExpiringDocuments", ctx)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RefreshExpiringDocuments indicates an expected call of RefreshExpiringDocuments.
+func (mr *MockDocumentRepositoryMockRecorder) RefreshExpiringDocuments(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RefreshExpiringDocuments", reflect.TypeOf((*MockDocumentRepository)(nil).RefreshExpiringDocuments), ctx)
+}
+
+// ReleaseAttachmentBlob mocks base method.
+func (m *MockDocumentRepository) ReleaseAttachmentBlob(ctx context.Context, url, documentID string) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReleaseAttachmentBlob", ctx, url, documentID)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReleaseAttachmentBlob indicates an expected call of ReleaseAttachmentBlob.
+func (mr *MockDocumentRepositoryMockRecorder) ReleaseAttachmentBlob(ctx, url, documentID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReleaseAttachmentBlob", reflect.TypeOf((*MockDocumentRepository)(nil).ReleaseAttachmentBlob), ctx, url, documentID)
+}
+
+// ReleaseDocumentLock mocks base method.
+func (m *MockDocumentRepository) ReleaseDocumentLock(ctx context.Context, documentID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReleaseDocumentLock", ctx, documentID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ReleaseDocumentLock indicates an expected call of ReleaseDocumentLock.
+func (mr *MockDocumentRepositoryMockRecorder) ReleaseDocumentLock(ctx, documentID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReleaseDocumentLock", reflect.TypeOf((*MockDocumentRepository)(nil).ReleaseDocumentLock), ctx, documentID)
+}
+
+// RemoveAttachment mocks base method.
+func (m *MockDocumentRepository) RemoveAttachment(ctx context.Context, documentID, attachmentURL string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveAttachment", ctx, documentID, attachmentURL)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RemoveAttachment indicates an expected call of RemoveAttachment.
+func (mr *MockDocumentRepositoryMockRecorder) RemoveAttachment(ctx, documentID, attachmentURL any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveAttachment", reflect.TypeOf((*MockDocumentRepository)(nil).RemoveAttachment), ctx, documentID, attachmentURL)
+}
+
+// RemoveDocumentDependency mocks base method.
+func (m *MockDocumentRepository) RemoveDocumentDependency(ctx context.Context, documentID, dependsOnID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveDocumentDependency", ctx, documentID, dependsOnID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RemoveDocumentDependency indicates an expected call of RemoveDocumentDependency.
+func (mr *MockDocumentRepositoryMockRecorder) RemoveDocumentDependency(ctx, documentID, dependsOnID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveDocumentDependency", reflect.TypeOf((*MockDocumentRepository)(nil).RemoveDocumentDependency), ctx, documentID, dependsOnID)
+}
+
+// RemoveLegalHold mocks base method.
+func (m *MockDocumentRepository) RemoveLegalHold(ctx context.Context, organizationID string, documentID *string) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveLegalHold", ctx, organizationID, documentID)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RemoveLegalHold indicates an expected call of RemoveLegalHold.
+func (mr *MockDocumentRepositoryMockRecorder) RemoveLegalHold(ctx, organizationID, documentID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveLegalHold", reflect.TypeOf((*MockDocumentRepository)(nil).RemoveLegalHold), ctx, organizationID, documentID)
+}
+
+// RemoveRoadmapVote mocks base method.
+func (m *MockDocumentRepository) RemoveRoadmapVote(ctx context.Context, featureID, userID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveRoadmapVote", ctx, featureID, userID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RemoveRoadmapVote indicates an expected call of RemoveRoadmapVote.
+func (mr *MockDocumentRepositoryMockRecorder) RemoveRoadmapVote(ctx, featureID, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveRoadmapVote", reflect.TypeOf((*MockDocumentRepository)(nil).RemoveRoadmapVote), ctx, featureID, userID)
+}
+
+// RestoreDocument mocks base method.
+func (m *MockDocumentRepository) RestoreDocument(ctx context.Context, documentID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RestoreDocument", ctx, documentID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RestoreDocument indicates an expected call of RestoreDocument.
+func (mr *MockDocumentRepositoryMockRecorder) RestoreDocument(ctx, documentID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RestoreDocument", reflect.TypeOf((*MockDocumentRepository)(nil).RestoreDocument), ctx, documentID)
+}
+
+// SetAttachmentStatus mocks base method.
+func (m *MockDocumentRepository) SetAttachmentStatus(ctx context.Context, documentID, attachmentURL, status string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetAttachmentStatus", ctx, documentID, attachmentURL, status)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetAttachmentStatus indicates an expected call of SetAttachmentStatus.
+func (mr *MockDocumentRepositoryMockRecorder) SetAttachmentStatus(ctx, documentID, attachmentURL, status any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetAttachmentStatus", reflect.TypeOf((*MockDocumentRepository)(nil).SetAttachmentStatus), ctx, documentID, attachmentURL, status)
+}
+
+// SetDocumentCustomFields mocks base method.
+func (m *MockDocumentRepository) SetDocumentCustomFields(ctx context.Context, documentID string, fields []*db.CustomField) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetDocumentCustomFields", ctx, documentID, fields)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetDocumentCustomFields indicates an expected call of SetDocumentCustomFields.
+func (mr *MockDocumentRepositoryMockRecorder) SetDocumentCustomFields(ctx, documentID, fields any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetDocumentCustomFields", reflect.TypeOf((*MockDocumentRepository)(nil).SetDocumentCustomFields), ctx, documentID, fields)
+}
+
+// SetDocumentOrder mocks base method.
+func (m *MockDocumentRepository) SetDocumentOrder(ctx context.Context, userID string, documentIDs []string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetDocumentOrder", ctx, userID, documentIDs)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetDocumentOrder indicates an expected call of SetDocumentOrder.
+func (mr *MockDocumentRepositoryMockRecorder) SetDocumentOrder(ctx, userID, documentIDs any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetDocumentOrder", reflect.TypeOf((*MockDocumentRepository)(nil).SetDocumentOrder), ctx, userID, documentIDs)
+}
+
+// SetDocumentPinned mocks base method.
+func (m *MockDocumentRepository) SetDocumentPinned(ctx context.Context, userID, documentID string, pinned bool) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetDocumentPinned", ctx, userID, documentID, pinned)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetDocumentPinned indicates an expected call of SetDocumentPinned.
+func (mr *MockDocumentRepositoryMockRecorder) SetDocumentPinned(ctx, userID, documentID, pinned any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetDocumentPinned", reflect.TypeOf((*MockDocumentRepository)(nil).SetDocumentPinned), ctx, userID, documentID, pinned)
+}
+
+// UnassignDocument mocks base method.
+func (m *MockDocumentRepository) UnassignDocument(ctx context.Context, documentID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UnassignDocument", ctx, documentID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UnassignDocument indicates an expected call of UnassignDocument.
+func (mr *MockDocumentRepositoryMockRecorder) UnassignDocument(ctx, documentID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UnassignDocument", reflect.TypeOf((*MockDocumentRepository)(nil).UnassignDocument), ctx, documentID)
+}
+
+// UnderLegalHold mocks base method.
+func (m *MockDocumentRepository) UnderLegalHold(ctx context.Context, doc *db.Document) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UnderLegalHold", ctx, doc)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UnderLegalHold indicates an expected call of UnderLegalHold.
+func (mr *MockDocumentRepositoryMockRecorder) UnderLegalHold(ctx, doc any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UnderLegalHold", reflect.TypeOf((*MockDocumentRepository)(nil).UnderLegalHold), ctx, doc)
+}
+
+// UnsubscribeDocumentContact mocks base method.
+func (m *MockDocumentRepository) UnsubscribeDocumentContact(ctx context.Context, token string) (*db.DocumentContact, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UnsubscribeDocumentContact", ctx, token)
+	ret0, _ := ret[0].(*db.DocumentContact)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UnsubscribeDocumentContact indicates an expected call of UnsubscribeDocumentContact.
+func (mr *MockDocumentRepositoryMockRecorder) UnsubscribeDocumentContact(ctx, token any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UnsubscribeDocumentContact", reflect.TypeOf((*MockDocumentRepository)(nil).UnsubscribeDocumentContact), ctx, token)
+}
+
+// UpdateChecklistItem mocks base method.
+func (m *MockDocumentRepository) UpdateChecklistItem(ctx context.Context, item *db.ChecklistItem) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateChecklistItem", ctx, item)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateChecklistItem indicates an expected call of UpdateChecklistItem.
+func (mr *MockDocumentRepositoryMockRecorder) UpdateChecklistItem(ctx, item any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateChecklistItem", reflect.TypeOf((*MockDocumentRepository)(nil).UpdateChecklistItem), ctx, item)
+}
+
+// UpdateDocument mocks base method.
+func (m *MockDocumentRepository) UpdateDocument(ctx context.Context, document *db.Document) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateDocument", ctx, document)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateDocument indicates an expected call of UpdateDocument.
+func (mr *MockDocumentRepositoryMockRecorder) UpdateDocument(ctx, document any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateDocument", reflect.TypeOf((*MockDocumentRepository)(nil).UpdateDocument), ctx, document)
+}
+
+// UpdateIssuer mocks base method.
+func (m *MockDocumentRepository) UpdateIssuer(ctx context.Context, issuer *db.Issuer) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateIssuer", ctx, issuer)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateIssuer indicates an expected call of UpdateIssuer.
+func (mr *MockDocumentRepositoryMockRecorder) UpdateIssuer(ctx, issuer any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateIssuer", reflect.TypeOf((*MockDocumentRepository)(nil).UpdateIssuer), ctx, issuer)
+}
+
+// UpdateRoadmapFeature mocks base method.
+func (m *MockDocumentRepository) UpdateRoadmapFeature(ctx context.Context, feature *db.RoadmapFeature) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateRoadmapFeature", ctx, feature)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateRoadmapFeature indicates an expected call of UpdateRoadmapFeature.
+func (mr *MockDocumentRepositoryMockRecorder) UpdateRoadmapFeature(ctx, feature any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateRoadmapFeature", reflect.TypeOf((*MockDocumentRepository)(nil).UpdateRoadmapFeature), ctx, feature)
+}
+
+// UpdateSavedView mocks base method.
+func (m *MockDocumentRepository) UpdateSavedView(ctx context.Context, view *db.SavedView) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateSavedView", ctx, view)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateSavedView indicates an expected call of UpdateSavedView.
+func (mr *MockDocumentRepositoryMockRecorder) UpdateSavedView(ctx, view any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateSavedView", reflect.TypeOf((*MockDocumentRepository)(nil).UpdateSavedView), ctx, view)
+}
+
+// MockReminderRepository is a mock of ReminderRepository interface.
+type MockReminderRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockReminderRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockReminderRepositoryMockRecorder is the mock recorder for MockReminderRepository.
+type MockReminderRepositoryMockRecorder struct {
+	mock *MockReminderRepository
+}
+
+// NewMockReminderRepository creates a new mock instance.
+func NewMockReminderRepository(ctrl *gomock.Controller) *MockReminderRepository {
+	mock := &MockReminderRepository{ctrl: ctrl}
+	mock.recorder = &MockReminderRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockReminderRepository) EXPECT() *MockReminderRepositoryMockRecorder {
+	return m.recorder
+}
+
+// AppendEvent mocks base method.
+func (m *MockReminderRepository) AppendEvent(ctx context.Context, entry *db.EventLogEntry) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AppendEvent", ctx, entry)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AppendEvent indicates an expected call of AppendEvent.
+func (mr *MockReminderRepositoryMockRecorder) AppendEvent(ctx, entry any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AppendEvent", reflect.TypeOf((*MockReminderRepository)(nil).AppendEvent), ctx, entry)
+}
+
+// ClaimActionLink mocks base method.
+func (m *MockReminderRepository) ClaimActionLink(ctx context.Context, jti string, expiresAt time.Time) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ClaimActionLink", ctx, jti, expiresAt)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ClaimActionLink indicates an expected call of ClaimActionLink.
+func (mr *MockReminderRepositoryMockRecorder) ClaimActionLink(ctx, jti, expiresAt any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ClaimActionLink", reflect.TypeOf((*MockReminderRepository)(nil).ClaimActionLink), ctx, jti, expiresAt)
+}
+
+// ClaimLeadTimeReminder mocks base method.
+func (m *MockReminderRepository) ClaimLeadTimeReminder(ctx context.Context, documentID string, expirationDate time.Time) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ClaimLeadTimeReminder", ctx, documentID, expirationDate)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ClaimLeadTimeReminder indicates an expected call of ClaimLeadTimeReminder.
+func (mr *MockReminderRepositoryMockRecorder) ClaimLeadTimeReminder(ctx, documentID, expirationDate any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ClaimLeadTimeReminder", reflect.TypeOf((*MockReminderRepository)(nil).ClaimLeadTimeReminder), ctx, documentID, expirationDate)
+}
+
+// CountNotificationOutcomes mocks base method.
+func (m *MockReminderRepository) CountNotificationOutcomes(ctx context.Context, since time.Time) (*db.NotificationStats, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountNotificationOutcomes", ctx, since)
+	ret0, _ := ret[0].(*db.NotificationStats)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountNotificationOutcomes indicates an expected call of CountNotificationOutcomes.
+func (mr *MockReminderRepositoryMockRecorder) CountNotificationOutcomes(ctx, since any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountNotificationOutcomes", reflect.TypeOf((*MockReminderRepository)(nil).CountNotificationOutcomes), ctx, since)
+}
+
+// CountNotificationsByUser mocks base method.
+func (m *MockReminderRepository) CountNotificationsByUser(ctx context.Context, since, recentSince time.Time, minRecent int) ([]*db.NotificationVolume, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountNotificationsByUser", ctx, since, recentSince, minRecent)
+	ret0, _ := ret[0].([]*db.NotificationVolume)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountNotificationsByUser indicates an expected call of CountNotificationsByUser.
+func (mr *MockReminderRepositoryMockRecorder) CountNotificationsByUser(ctx, since, recentSince, minRecent any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountNotificationsByUser", reflect.TypeOf((*MockReminderRepository)(nil).CountNotificationsByUser), ctx, since, recentSince, minRecent)
+}
+
+// CountSMSSent mocks base method.
+func (m *MockReminderRepository) CountSMSSent(ctx context.Context, recipientID string, since time.Time) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountSMSSent", ctx, recipientID, since)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountSMSSent indicates an expected call of CountSMSSent.
+func (mr *MockReminderRepositoryMockRecorder) CountSMSSent(ctx, recipientID, since any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountSMSSent", reflect.TypeOf((*MockReminderRepository)(nil).CountSMSSent), ctx, recipientID, since)
+}
+
+// CountUnreadNotifications mocks base method.
+func (m *MockReminderRepository) CountUnreadNotifications(ctx context.Context, userID string) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountUnreadNotifications", ctx, userID)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountUnreadNotifications indicates an expected call of CountUnreadNotifications.
+func (mr *MockReminderRepositoryMockRecorder) CountUnreadNotifications(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountUnreadNotifications", reflect.TypeOf((*MockReminderRepository)(nil).CountUnreadNotifications), ctx, userID)
+}
+
+// CreateNotificationLog mocks base method.
+func (m *MockReminderRepository) CreateNotificationLog(ctx context.Context, log *db.NotificationLog) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateNotificationLog", ctx, log)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateNotificationLog indicates an expected call of CreateNotificationLog.
+func (mr *MockReminderRepositoryMockRecorder) CreateNotificationLog(ctx, log any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateNotificationLog", reflect.TypeOf((*MockReminderRepository)(nil).CreateNotificationLog), ctx, log)
+}
+
+// DeleteEmailSender mocks base method.
+func (m *MockReminderRepository) DeleteEmailSender(ctx context.Context, organizationID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteEmailSender", ctx, organizationID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteEmailSender indicates an expected call of DeleteEmailSender.
+func (mr *MockReminderRepositoryMockRecorder) DeleteEmailSender(ctx, organizationID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteEmailSender", reflect.TypeOf((*MockReminderRepository)(nil).DeleteEmailSender), ctx, organizationID)
+}
+
+// DeleteNotificationTheme mocks base method.
+func (m *MockReminderRepository) DeleteNotificationTheme(ctx context.Context, organizationID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteNotificationTheme", ctx, organizationID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteNotificationTheme indicates an expected call of DeleteNotificationTheme.
+func (mr *MockReminderRepositoryMockRecorder) DeleteNotificationTheme(ctx, organizationID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteNotificationTheme", reflect.TypeOf((*MockReminderRepository)(nil).DeleteNotificationTheme), ctx, organizationID)
+}
+
+// DeleteOrganizationAuditLogsBefore mocks base method.
+func (m *MockReminderRepository) DeleteOrganizationAuditLogsBefore(ctx context.Context, organizationID string, cutoff time.Time, keepDocumentIDs []string) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteOrganizationAuditLogsBefore", ctx, organizationID, cutoff, keepDocumentIDs)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteOrganizationAuditLogsBefore indicates an expected call of DeleteOrganizationAuditLogsBefore.
+func (mr *MockReminderRepositoryMockRecorder) DeleteOrganizationAuditLogsBefore(ctx, organizationID, cutoff, keepDocumentIDs any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteOrganizationAuditLogsBefore", reflect.TypeOf((*MockReminderRepository)(nil).DeleteOrganizationAuditLogsBefore), ctx, organizationID, cutoff, keepDocumentIDs)
+}
+
+// DeleteOrganizationNotificationsBefore mocks base method.
+func (m *MockReminderRepository) DeleteOrganizationNotificationsBefore(ctx context.Context, organizationID string, cutoff time.Time) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteOrganizationNotificationsBefore", ctx, organizationID, cutoff)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteOrganizationNotificationsBefore indicates an expected call of DeleteOrganizationNotificationsBefore.
+func (mr *MockReminderRepositoryMockRecorder) DeleteOrganizationNotificationsBefore(ctx, organizationID, cutoff any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteOrganizationNotificationsBefore", reflect.TypeOf((*MockReminderRepository)(nil).DeleteOrganizationNotificationsBefore), ctx, organizationID, cutoff)
+}
+
+// GetAllReminderIntervals mocks base method.
+func (m *MockReminderRepository) GetAllReminderIntervals(ctx context.Context) ([]*db.ReminderInterval, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAllReminderIntervals", ctx)
+	ret0, _ := ret[0].([]*db.ReminderInterval)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAllReminderIntervals indicates an expected call of GetAllReminderIntervals.
+func (mr *MockReminderRepositoryMockRecorder) GetAllReminderIntervals(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAllReminderIntervals", reflect.TypeOf((*MockReminderRepository)(nil).GetAllReminderIntervals), ctx)
+}
+
+// GetDocumentRemindersByDocumentID mocks base method.
+func (m *MockReminderRepository) GetDocumentRemindersByDocumentID(ctx context.Context, documentID string) ([]*db.DocumentReminder, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDocumentRemindersByDocumentID", ctx, documentID)
+	ret0, _ := ret[0].([]*db.DocumentReminder)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDocumentRemindersByDocumentID indicates an expected call of GetDocumentRemindersByDocumentID.
+func (mr *MockReminderRepositoryMockRecorder) GetDocumentRemindersByDocumentID(ctx, documentID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDocumentRemindersByDocumentID", reflect.TypeOf((*MockReminderRepository)(nil).GetDocumentRemindersByDocumentID), ctx, documentID)
+}
+
+// GetEmailSender mocks base method.
+func (m *MockReminderRepository) GetEmailSender(ctx context.Context, organizationID string) (*db.EmailSender, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetEmailSender", ctx, organizationID)
+	ret0, _ := ret[0].(*db.EmailSender)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetEmailSender indicates an expected call of GetEmailSender.
+func (mr *MockReminderRepositoryMockRecorder) GetEmailSender(ctx, organizationID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetEmailSender", reflect.TypeOf((*MockReminderRepository)(nil).GetEmailSender), ctx, organizationID)
+}
+
+// GetEventLogAnchor mocks base method.
+func (m *MockReminderRepository) GetEventLogAnchor(ctx context.Context, organizationID string) (int64, string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetEventLogAnchor", ctx, organizationID)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(string)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetEventLogAnchor indicates an expected call of GetEventLogAnchor.
+func (mr *MockReminderRepositoryMockRecorder) GetEventLogAnchor(ctx, organizationID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetEventLogAnchor", reflect.TypeOf((*MockReminderRepository)(nil).GetEventLogAnchor), ctx, organizationID)
+}
+
+// GetLatestNotificationLog mocks base method.
+func (m *MockReminderRepository) GetLatestNotificationLog(ctx context.Context, userID, channel string) (*db.NotificationLog, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetLatestNotificationLog", ctx, userID, channel)
+	ret0, _ := ret[0].(*db.NotificationLog)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetLatestNotificationLog indicates an expected call of GetLatestNotificationLog.
+func (mr *MockReminderRepositoryMockRecorder) GetLatestNotificationLog(ctx, userID, channel any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLatestNotificationLog", reflect.TypeOf((*MockReminderRepository)(nil).GetLatestNotificationLog), ctx, userID, channel)
+}
+
+// GetNotificationPreferences mocks base method.
+func (m *MockReminderRepository) GetNotificationPreferences(ctx context.Context, userID string) (*db.NotificationPreferences, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetNotificationPreferences", ctx, userID)
+	ret0, _ := ret[0].(*db.NotificationPreferences)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetNotificationPreferences indicates an expected call of GetNotificationPreferences.
+func (mr *MockReminderRepositoryMockRecorder) GetNotificationPreferences(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetNotificationPreferences", reflect.TypeOf((*MockReminderRepository)(nil).GetNotificationPreferences), ctx, userID)
+}
+
+// GetNotificationTheme mocks base method.
+func (m *MockReminderRepository) GetNotificationTheme(ctx context.Context, organizationID string) (*db.NotificationTheme, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetNotificationTheme", ctx, organizationID)
+	ret0, _ := ret[0].(*db.NotificationTheme)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetNotificationTheme indicates an expected call of GetNotificationTheme.
+func (mr *MockReminderRepositoryMockRecorder) GetNotificationTheme(ctx, organizationID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetNotificationTheme", reflect.TypeOf((*MockReminderRepository)(nil).GetNotificationTheme), ctx, organizationID)
+}
+
+// GetReminderIntervalByID mocks base method.
+func (m *MockReminderRepository) GetReminderIntervalByID(ctx context.Context, id int) (*db.ReminderInterval, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetReminderIntervalByID", ctx, id)
+	ret0, _ := ret[0].(*db.ReminderInterval)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetReminderIntervalByID indicates an expected call of GetReminderIntervalByID.
+func (mr *MockReminderRepositoryMockRecorder) GetReminderIntervalByID(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetReminderIntervalByID", reflect.TypeOf((*MockReminderRepository)(nil).GetReminderIntervalByID), ctx, id)
+}
+
+// GetReminderIntervalsFromIdLabels mocks base method.
+func (m *MockReminderRepository) GetReminderIntervalsFromIdLabels(ctx context.Context, idLabels []string) ([]*db.ReminderInterval, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetReminderIntervalsFromIdLabels", ctx, idLabels)
+	ret0, _ := ret[0].([]*db.ReminderInterval)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetReminderIntervalsFromIdLabels indicates an expected call of GetReminderIntervalsFromIdLabels.
+func (mr *MockReminderRepositoryMockRecorder) GetReminderIntervalsFromIdLabels(ctx, idLabels any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetReminderIntervalsFromIdLabels", reflect.TypeOf((*MockReminderRepository)(nil).GetReminderIntervalsFromIdLabels), ctx, idLabels)
+}
+
+// HoldReminder mocks base method.
+func (m *MockReminderRepository) HoldReminder(ctx context.Context, userID, documentID string, intervalID int, requestID string) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "HoldReminder", ctx, userID, documentID, intervalID, requestID)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// HoldReminder indicates an expected call of HoldReminder.
+func (mr *MockReminderRepositoryMockRecorder) HoldReminder(ctx, userID, documentID, intervalID, requestID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HoldReminder", reflect.TypeOf((*MockReminderRepository)(nil).HoldReminder), ctx, userID, documentID, intervalID, requestID)
+}
+
+// IsActionLinkUsed mocks base method.
+func (m *MockReminderRepository) IsActionLinkUsed(ctx context.Context, jti string) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IsActionLinkUsed", ctx, jti)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// IsActionLinkUsed indicates an expected call of IsActionLinkUsed.
+func (mr *MockReminderRepositoryMockRecorder) IsActionLinkUsed(ctx, jti any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsActionLinkUsed", reflect.TypeOf((*MockReminderRepository)(nil).IsActionLinkUsed), ctx, jti)
+}
+
+// ListEmailOpenTimes mocks base method.
+func (m *MockReminderRepository) ListEmailOpenTimes(ctx context.Context, recipientID string, since time.Time, limit int) ([]time.Time, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListEmailOpenTimes", ctx, recipientID, since, limit)
+	ret0, _ := ret[0].([]time.Time)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListEmailOpenTimes indicates an expected call of ListEmailOpenTimes.
+func (mr *MockReminderRepositoryMockRecorder) ListEmailOpenTimes(ctx, recipientID, since, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListEmailOpenTimes", reflect.TypeOf((*MockReminderRepository)(nil).ListEmailOpenTimes), ctx, recipientID, since, limit)
+}
+
+// ListEvents mocks base method.
+func (m *MockReminderRepository) ListEvents(ctx context.Context, organizationID string) ([]*db.EventLogEntry, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListEvents", ctx, organizationID)
+	ret0, _ := ret[0].([]*db.EventLogEntry)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListEvents indicates an expected call of ListEvents.
+func (mr *MockReminderRepositoryMockRecorder) ListEvents(ctx, organizationID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListEvents", reflect.TypeOf((*MockReminderRepository)(nil).ListEvents), ctx, organizationID)
+}
+
+// ListNotificationLogs mocks base method.
+func (m *MockReminderRepository) ListNotificationLogs(ctx context.Context, userID, documentID string, limit int) ([]*db.NotificationLog, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListNotificationLogs", ctx, userID, documentID, limit)
+	ret0, _ := ret[0].([]*db.NotificationLog)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListNotificationLogs indicates an expected call of ListNotificationLogs.
+func (mr *MockReminderRepositoryMockRecorder) ListNotificationLogs(ctx, userID, documentID, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListNotificationLogs", reflect.TypeOf((*MockReminderRepository)(nil).ListNotificationLogs), ctx, userID, documentID, limit)
+}
+
+// ListNotificationLogsByMessageID mocks base method.
+func (m *MockReminderRepository) ListNotificationLogsByMessageID(ctx context.Context, messageID string) ([]*db.NotificationLog, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListNotificationLogsByMessageID", ctx, messageID)
+	ret0, _ := ret[0].([]*db.NotificationLog)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListNotificationLogsByMessageID indicates an expected call of ListNotificationLogsByMessageID.
+func (mr *MockReminderRepositoryMockRecorder) ListNotificationLogsByMessageID(ctx, messageID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListNotificationLogsByMessageID", reflect.TypeOf((*MockReminderRepository)(nil).ListNotificationLogsByMessageID), ctx, messageID)
+}
+
+// ListOrganizationUsage mocks base method.
+func (m *MockReminderRepository) ListOrganizationUsage(ctx context.Context, organizationID, from, to string) ([]*db.OrganizationUsage, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListOrganizationUsage", ctx, organizationID, from, to)
+	ret0, _ := ret[0].([]*db.OrganizationUsage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListOrganizationUsage indicates an expected call of ListOrganizationUsage.
+func (mr *MockReminderRepositoryMockRecorder) ListOrganizationUsage(ctx, organizationID, from, to any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListOrganizationUsage", reflect.TypeOf((*MockReminderRepository)(nil).ListOrganizationUsage), ctx, organizationID, from, to)
+}
+
+// MarkDocumentReminderSent mocks base method.
+func (m *MockReminderRepository) MarkDocumentReminderSent(ctx context.Context, documentID string, reminderIntervalID int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MarkDocumentReminderSent", ctx, documentID, reminderIntervalID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// MarkDocumentReminderSent indicates an expected call of MarkDocumentReminderSent.
+func (mr *MockReminderRepositoryMockRecorder) MarkDocumentReminderSent(ctx, documentID, reminderIntervalID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkDocumentReminderSent", reflect.TypeOf((*MockReminderRepository)(nil).MarkDocumentReminderSent), ctx, documentID, reminderIntervalID)
+}
+
+// MarkNotificationBounced mocks base method.
+func (m *MockReminderRepository) MarkNotificationBounced(ctx context.Context, messageID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MarkNotificationBounced", ctx, messageID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// MarkNotificationBounced indicates an expected call of MarkNotificationBounced.
+func (mr *MockReminderRepositoryMockRecorder) MarkNotificationBounced(ctx, messageID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkNotificationBounced", reflect.TypeOf((*MockReminderRepository)(nil).MarkNotificationBounced), ctx, messageID)
+}
+
+// MarkNotificationEscalated mocks base method.
+func (m *MockReminderRepository) MarkNotificationEscalated(ctx context.Context, messageID string) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MarkNotificationEscalated", ctx, messageID)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MarkNotificationEscalated indicates an expected call of MarkNotificationEscalated.
+func (mr *MockReminderRepositoryMockRecorder) MarkNotificationEscalated(ctx, messageID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkNotificationEscalated", reflect.TypeOf((*MockReminderRepository)(nil).MarkNotificationEscalated), ctx, messageID)
+}
+
+// MarkNotificationOpened mocks base method.
+func (m *MockReminderRepository) MarkNotificationOpened(ctx context.Context, messageID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MarkNotificationOpened", ctx, messageID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// MarkNotificationOpened indicates an expected call of MarkNotificationOpened.
+func (mr *MockReminderRepositoryMockRecorder) MarkNotificationOpened(ctx, messageID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkNotificationOpened", reflect.TypeOf((*MockReminderRepository)(nil).MarkNotificationOpened), ctx, messageID)
+}
+
+// MarkNotificationsRead mocks base method.
+func (m *MockReminderRepository) MarkNotificationsRead(ctx context.Context, userID string) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MarkNotificationsRead", ctx, userID)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MarkNotificationsRead indicates an expected call of MarkNotificationsRead.
+func (mr *MockReminderRepositoryMockRecorder) MarkNotificationsRead(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkNotificationsRead", reflect.TypeOf((*MockReminderRepository)(nil).MarkNotificationsRead), ctx, userID)
+}
+
+// MeterOrganizationUsage mocks base method.
+func (m *MockReminderRepository) MeterOrganizationUsage(ctx context.Context, month time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MeterOrganizationUsage", ctx, month)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// MeterOrganizationUsage indicates an expected call of MeterOrganizationUsage.
+func (mr *MockReminderRepositoryMockRecorder) MeterOrganizationUsage(ctx, month any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MeterOrganizationUsage", reflect.TypeOf((*MockReminderRepository)(nil).MeterOrganizationUsage), ctx, month)
+}
+
+// PruneEventsBefore mocks base method.
+func (m *MockReminderRepository) PruneEventsBefore(ctx context.Context, organizationID string, cutoff time.Time) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PruneEventsBefore", ctx, organizationID, cutoff)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PruneEventsBefore indicates an expected call of PruneEventsBefore.
+func (mr *MockReminderRepositoryMockRecorder) PruneEventsBefore(ctx, organizationID, cutoff any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PruneEventsBefore", reflect.TypeOf((*MockReminderRepository)(nil).PruneEventsBefore), ctx, organizationID, cutoff)
+}
+
+// RecordEmailSenderCheck mocks base method.
+func (m *MockReminderRepository) RecordEmailSenderCheck(ctx context.Context, organizationID, token string, verified bool) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RecordEmailSenderCheck", ctx, organizationID, token, verified)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RecordEmailSenderCheck indicates an expected call of RecordEmailSenderCheck.
+func (mr *MockReminderRepositoryMockRecorder) RecordEmailSenderCheck(ctx, organizationID, token, verified any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecordEmailSenderCheck", reflect.TypeOf((*MockReminderRepository)(nil).RecordEmailSenderCheck), ctx, organizationID, token, verified)
+}
+
+// ReleaseActionLink mocks base method.
+func (m *MockReminderRepository) ReleaseActionLink(ctx context.Context, jti string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReleaseActionLink", ctx, jti)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ReleaseActionLink indicates an expected call of ReleaseActionLink.
+func (mr *MockReminderRepositoryMockRecorder) ReleaseActionLink(ctx, jti any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReleaseActionLink", reflect.TypeOf((*MockReminderRepository)(nil).ReleaseActionLink), ctx, jti)
+}
+
+// ResetDocumentReminders mocks base method.
+func (m *MockReminderRepository) ResetDocumentReminders(ctx context.Context, documentID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ResetDocumentReminders", ctx, documentID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ResetDocumentReminders indicates an expected call of ResetDocumentReminders.
+func (mr *MockReminderRepositoryMockRecorder) ResetDocumentReminders(ctx, documentID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResetDocumentReminders", reflect.TypeOf((*MockReminderRepository)(nil).ResetDocumentReminders), ctx, documentID)
+}
+
+// SetDocumentReminders mocks base method.
+func (m *MockReminderRepository) SetDocumentReminders(ctx context.Context, documentID string, reminder *db.DocumentReminder) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetDocumentReminders", ctx, documentID, reminder)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetDocumentReminders indicates an expected call of SetDocumentReminders.
+func (mr *MockReminderRepositoryMockRecorder) SetDocumentReminders(ctx, documentID, reminder any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetDocumentReminders", reflect.TypeOf((*MockReminderRepository)(nil).SetDocumentReminders), ctx, documentID, reminder)
+}
+
+// SetEmailSender mocks base method.
+func (m *MockReminderRepository) SetEmailSender(ctx context.Context, sender *db.EmailSender) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetEmailSender", ctx, sender)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetEmailSender indicates an expected call of SetEmailSender.
+func (mr *MockReminderRepositoryMockRecorder) SetEmailSender(ctx, sender any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetEmailSender", reflect.TypeOf((*MockReminderRepository)(nil).SetEmailSender), ctx, sender)
+}
+
+// SetNotificationTheme mocks base method.
+func (m *MockReminderRepository) SetNotificationTheme(ctx context.Context, theme *db.NotificationTheme) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetNotificationTheme", ctx, theme)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetNotificationTheme indicates an expected call of SetNotificationTheme.
+func (mr *MockReminderRepositoryMockRecorder) SetNotificationTheme(ctx, theme any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetNotificationTheme", reflect.TypeOf((*MockReminderRepository)(nil).SetNotificationTheme), ctx, theme)
+}
+
+// TakeHeldReminders mocks base method.
+func (m *MockReminderRepository) TakeHeldReminders(ctx context.Context, userID string) ([]*db.HeldReminder, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TakeHeldReminders", ctx, userID)
+	ret0, _ := ret[0].([]*db.HeldReminder)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// TakeHeldReminders indicates an expected call of TakeHeldReminders.
+func (mr *MockReminderRepositoryMockRecorder) TakeHeldReminders(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TakeHeldReminders", reflect.TypeOf((*MockReminderRepository)(nil).TakeHeldReminders), ctx, userID)
+}
+
+// ToggleDocumentReminder mocks base method.
+func (m *MockReminderRepository) ToggleDocumentReminder(ctx context.Context, documentID string, reminderIntervalID int, enabled bool) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ToggleDocumentReminder", ctx, documentID, reminderIntervalID, enabled)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ToggleDocumentReminder indicates an expected call of ToggleDocumentReminder.
+func (mr *MockReminderRepositoryMockRecorder) ToggleDocumentReminder(ctx, documentID, reminderIntervalID, enabled any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ToggleDocumentReminder", reflect.TypeOf((*MockReminderRepository)(nil).ToggleDocumentReminder), ctx, documentID, reminderIntervalID, enabled)
+}
+
+// TransitionDocumentReminder mocks base method.
+func (m *MockReminderRepository) TransitionDocumentReminder(ctx context.Context, documentID string, reminderIntervalID int, status string) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TransitionDocumentReminder", ctx, documentID, reminderIntervalID, status)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// TransitionDocumentReminder indicates an expected call of TransitionDocumentReminder.
+func (mr *MockReminderRepositoryMockRecorder) TransitionDocumentReminder(ctx, documentID, reminderIntervalID, status any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TransitionDocumentReminder", reflect.TypeOf((*MockReminderRepository)(nil).TransitionDocumentReminder), ctx, documentID, reminderIntervalID, status)
+}
+
+// UpsertNotificationPreferences mocks base method.
+func (m *MockReminderRepository) UpsertNotificationPreferences(ctx context.Context, prefs *db.NotificationPreferences) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpsertNotificationPreferences", ctx, prefs)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpsertNotificationPreferences indicates an expected call of UpsertNotificationPreferences.
+func (mr *MockReminderRepositoryMockRecorder) UpsertNotificationPreferences(ctx, prefs any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpsertNotificationPreferences", reflect.TypeOf((*MockReminderRepository)(nil).UpsertNotificationPreferences), ctx, prefs)
+}
+
+// MockWebhookRepository is a mock of WebhookRepository interface.
+type MockWebhookRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockWebhookRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockWebhookRepositoryMockRecorder is the mock recorder for MockWebhookRepository.
+type MockWebhookRepositoryMockRecorder struct {
+	mock *MockWebhookRepository
+}
+
+// NewMockWebhookRepository creates a new mock instance.
+func NewMockWebhookRepository(ctrl *gomock.Controller) *MockWebhookRepository {
+	mock := &MockWebhookRepository{ctrl: ctrl}
+	mock.recorder = &MockWebhookRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockWebhookRepository) EXPECT() *MockWebhookRepositoryMockRecorder {
+	return m.recorder
+}
+
+// CreateWebhookDelivery mocks base method.
+func (m *MockWebhookRepository) CreateWebhookDelivery(ctx context.Context, delivery *db.WebhookDelivery) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateWebhookDelivery", ctx, delivery)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateWebhookDelivery indicates an expected call of CreateWebhookDelivery.
+func (mr *MockWebhookRepositoryMockRecorder) CreateWebhookDelivery(ctx, delivery any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateWebhookDelivery", reflect.TypeOf((*MockWebhookRepository)(nil).CreateWebhookDelivery), ctx, delivery)
+}
+
+// CreateWebhookEndpoint mocks base method.
+func (m *MockWebhookRepository) CreateWebhookEndpoint(ctx context.Context, endpoint *db.WebhookEndpoint) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateWebhookEndpoint", ctx, endpoint)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateWebhookEndpoint indicates an expected call of CreateWebhookEndpoint.
+func (mr *MockWebhookRepositoryMockRecorder) CreateWebhookEndpoint(ctx, endpoint any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateWebhookEndpoint", reflect.TypeOf((*MockWebhookRepository)(nil).CreateWebhookEndpoint), ctx, endpoint)
+}
+
+// DeleteWebhookEndpoint mocks base method.
+func (m *MockWebhookRepository) DeleteWebhookEndpoint(ctx context.Context, endpointID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteWebhookEndpoint", ctx, endpointID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteWebhookEndpoint indicates an expected call of DeleteWebhookEndpoint.
+func (mr *MockWebhookRepositoryMockRecorder) DeleteWebhookEndpoint(ctx, endpointID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteWebhookEndpoint", reflect.TypeOf((*MockWebhookRepository)(nil).DeleteWebhookEndpoint), ctx, endpointID)
+}
+
+// GetWebhookDelivery mocks base method.
+func (m *MockWebhookRepository) GetWebhookDelivery(ctx context.Context, deliveryID string) (*db.WebhookDelivery, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetWebhookDelivery", ctx, deliveryID)
+	ret0, _ := ret[0].(*db.WebhookDelivery)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetWebhookDelivery indicates an expected call of GetWebhookDelivery.
+func (mr *MockWebhookRepositoryMockRecorder) GetWebhookDelivery(ctx, deliveryID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetWebhookDelivery", reflect.TypeOf((*MockWebhookRepository)(nil).GetWebhookDelivery), ctx, deliveryID)
+}
+
+// GetWebhookEndpoint mocks base method.
+func (m *MockWebhookRepository) GetWebhookEndpoint(ctx context.Context, endpointID string) (*db.WebhookEndpoint, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetWebhookEndpoint", ctx, endpointID)
+	ret0, _ := ret[0].(*db.WebhookEndpoint)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetWebhookEndpoint indicates an expected call of GetWebhookEndpoint.
+func (mr *MockWebhookRepositoryMockRecorder) GetWebhookEndpoint(ctx, endpointID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetWebhookEndpoint", reflect.TypeOf((*MockWebhookRepository)(nil).GetWebhookEndpoint), ctx, endpointID)
+}
+
+// ListWebhookDeliveries mocks base method.
+func (m *MockWebhookRepository) ListWebhookDeliveries(ctx context.Context, endpointID string, limit int) ([]*db.WebhookDelivery, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListWebhookDeliveries", ctx, endpointID, limit)
+	ret0, _ := ret[0].([]*db.WebhookDelivery)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListWebhookDeliveries indicates an expected call of ListWebhookDeliveries.
+func (mr *MockWebhookRepositoryMockRecorder) ListWebhookDeliveries(ctx, endpointID, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListWebhookDeliveries", reflect.TypeOf((*MockWebhookRepository)(nil).ListWebhookDeliveries), ctx, endpointID, limit)
+}
+
+// ListWebhookEndpoints mocks base method.
+func (m *MockWebhookRepository) ListWebhookEndpoints(ctx context.Context, userID string) ([]*db.WebhookEndpoint, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListWebhookEndpoints", ctx, userID)
+	ret0, _ := ret[0].([]*db.WebhookEndpoint)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListWebhookEndpoints indicates an expected call of ListWebhookEndpoints.
+func (mr *MockWebhookRepositoryMockRecorder) ListWebhookEndpoints(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListWebhookEndpoints", reflect.TypeOf((*MockWebhookRepository)(nil).ListWebhookEndpoints), ctx, userID)
+}
+
+// ListWebhookEndpointsForEvent mocks base method.
+func (m *MockWebhookRepository) ListWebhookEndpointsForEvent(ctx context.Context, userID, event string) ([]*db.WebhookEndpoint, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListWebhookEndpointsForEvent", ctx, userID, event)
+	ret0, _ := ret[0].([]*db.WebhookEndpoint)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListWebhookEndpointsForEvent indicates an expected call of ListWebhookEndpointsForEvent.
+func (mr *MockWebhookRepositoryMockRecorder) ListWebhookEndpointsForEvent(ctx, userID, event any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListWebhookEndpointsForEvent", reflect.TypeOf((*MockWebhookRepository)(nil).ListWebhookEndpointsForEvent), ctx, userID, event)
+}
+
+// RecordWebhookDeliveryAttempt mocks base method.
+func (m *MockWebhookRepository) RecordWebhookDeliveryAttempt(ctx context.Context, delivery *db.WebhookDelivery) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RecordWebhookDeliveryAttempt", ctx, delivery)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RecordWebhookDeliveryAttempt indicates an expected call of RecordWebhookDeliveryAttempt.
+func (mr *MockWebhookRepositoryMockRecorder) RecordWebhookDeliveryAttempt(ctx, delivery any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecordWebhookDeliveryAttempt", reflect.TypeOf((*MockWebhookRepository)(nil).RecordWebhookDeliveryAttempt), ctx, delivery)
+}
+
+// RotateWebhookSecret mocks base method.
+func (m *MockWebhookRepository) RotateWebhookSecret(ctx context.Context, endpointID, secret string, previousExpiresAt time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RotateWebhookSecret", ctx, endpointID, secret, previousExpiresAt)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RotateWebhookSecret indicates an expected call of RotateWebhookSecret.
+func (mr *MockWebhookRepositoryMockRecorder) RotateWebhookSecret(ctx, endpointID, secret, previousExpiresAt any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RotateWebhookSecret", reflect.TypeOf((*MockWebhookRepository)(nil).RotateWebhookSecret), ctx, endpointID, secret, previousExpiresAt)
+}
+
+// UpdateWebhookEndpoint mocks base method.
+func (m *MockWebhookRepository) UpdateWebhookEndpoint(ctx context.Context, endpoint *db.WebhookEndpoint) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateWebhookEndpoint", ctx, endpoint)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateWebhookEndpoint indicates an expected call of UpdateWebhookEndpoint.
+func (mr *MockWebhookRepositoryMockRecorder) UpdateWebhookEndpoint(ctx, endpoint any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateWebhookEndpoint", reflect.TypeOf((*MockWebhookRepository)(nil).UpdateWebhookEndpoint), ctx, endpoint)
+}
+
+// MockAnnouncementRepository is a mock of AnnouncementRepository interface.
+type MockAnnouncementRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockAnnouncementRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockAnnouncementRepositoryMockRecorder is the mock recorder for MockAnnouncementRepository.
+type MockAnnouncementRepositoryMockRecorder struct {
+	mock *MockAnnouncementRepository
+}
+
+// NewMockAnnouncementRepository creates a new mock instance.
+func NewMockAnnouncementRepository(ctrl *gomock.Controller) *MockAnnouncementRepository {
+	mock := &MockAnnouncementRepository{ctrl: ctrl}
+	mock.recorder = &MockAnnouncementRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockAnnouncementRepository) EXPECT() *MockAnnouncementRepositoryMockRecorder {
+	return m.recorder
+}
+
+// ClaimAnnouncementEmail mocks base method.
+func (m *MockAnnouncementRepository) ClaimAnnouncementEmail(ctx context.Context, announcementID string) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ClaimAnnouncementEmail", ctx, announcementID)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ClaimAnnouncementEmail indicates an expected call of ClaimAnnouncementEmail.
+func (mr *MockAnnouncementRepositoryMockRecorder) ClaimAnnouncementEmail(ctx, announcementID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ClaimAnnouncementEmail", reflect.TypeOf((*MockAnnouncementRepository)(nil).ClaimAnnouncementEmail), ctx, announcementID)
+}
+
+// CreateAnnouncement mocks base method.
+func (m *MockAnnouncementRepository) CreateAnnouncement(ctx context.Context, announcement *db.Announcement) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateAnnouncement", ctx, announcement)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateAnnouncement indicates an expected call of CreateAnnouncement.
+func (mr *MockAnnouncementRepositoryMockRecorder) CreateAnnouncement(ctx, announcement any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateAnnouncement", reflect.TypeOf((*MockAnnouncementRepository)(nil).CreateAnnouncement), ctx, announcement)
+}
+
+// DeleteAnnouncement mocks base method.
+func (m *MockAnnouncementRepository) DeleteAnnouncement(ctx context.Context, announcementID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteAnnouncement", ctx, announcementID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteAnnouncement indicates an expected call of DeleteAnnouncement.
+func (mr *MockAnnouncementRepositoryMockRecorder) DeleteAnnouncement(ctx, announcementID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteAnnouncement", reflect.TypeOf((*MockAnnouncementRepository)(nil).DeleteAnnouncement), ctx, announcementID)
+}
+
+// GetAnnouncement mocks base method.
+func (m *MockAnnouncementRepository) GetAnnouncement(ctx context.Context, announcementID string) (*db.Announcement, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAnnouncement", ctx, announcementID)
+	ret0, _ := ret[0].(*db.Announcement)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAnnouncement indicates an expected call of GetAnnouncement.
+func (mr *MockAnnouncementRepositoryMockRecorder) GetAnnouncement(ctx, announcementID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAnnouncement", reflect.TypeOf((*MockAnnouncementRepository)(nil).GetAnnouncement), ctx, announcementID)
+}
+
+// ListActiveAnnouncements mocks base method.
+func (m *MockAnnouncementRepository) ListActiveAnnouncements(ctx context.Context) ([]*db.Announcement, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListActiveAnnouncements", ctx)
+	ret0, _ := ret[0].([]*db.Announcement)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListActiveAnnouncements indicates an expected call of ListActiveAnnouncements.
+func (mr *MockAnnouncementRepositoryMockRecorder) ListActiveAnnouncements(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListActiveAnnouncements", reflect.TypeOf((*MockAnnouncementRepository)(nil).ListActiveAnnouncements), ctx)
+}
+
+// ListAnnouncements mocks base method.
+func (m *MockAnnouncementRepository) ListAnnouncements(ctx context.Context) ([]*db.Announcement, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListAnnouncements", ctx)
+	ret0, _ := ret[0].([]*db.Announcement)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListAnnouncements indicates an expected call of ListAnnouncements.
+func (mr *MockAnnouncementRepositoryMockRecorder) ListAnnouncements(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAnnouncements", reflect.TypeOf((*MockAnnouncementRepository)(nil).ListAnnouncements), ctx)
+}
+
+// MockJobRepository is a mock of JobRepository interface.
+type MockJobRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockJobRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockJobRepositoryMockRecorder is the mock recorder for MockJobRepository.
+type MockJobRepositoryMockRecorder struct {
+	mock *MockJobRepository
+}
+
+// NewMockJobRepository creates a new mock instance.
+func NewMockJobRepository(ctrl *gomock.Controller) *MockJobRepository {
+	mock := &MockJobRepository{ctrl: ctrl}
+	mock.recorder = &MockJobRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockJobRepository) EXPECT() *MockJobRepositoryMockRecorder {
+	return m.recorder
+}
+
+// CompleteImportChunk mocks base method.
+func (m *MockJobRepository) CompleteImportChunk(ctx context.Context, jobID string, chunk int, result json.RawMessage, rows int) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CompleteImportChunk", ctx, jobID, chunk, result, rows)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CompleteImportChunk indicates an expected call of CompleteImportChunk.
+func (mr *MockJobRepositoryMockRecorder) CompleteImportChunk(ctx, jobID, chunk, result, rows any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CompleteImportChunk", reflect.TypeOf((*MockJobRepository)(nil).CompleteImportChunk), ctx, jobID, chunk, result, rows)
+}
+
+// CreateImportChunks mocks base method.
+func (m *MockJobRepository) CreateImportChunks(ctx context.Context, jobID string, params json.RawMessage, chunks []json.RawMessage, total int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateImportChunks", ctx, jobID, params, chunks, total)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateImportChunks indicates an expected call of CreateImportChunks.
+func (mr *MockJobRepositoryMockRecorder) CreateImportChunks(ctx, jobID, params, chunks, total any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateImportChunks", reflect.TypeOf((*MockJobRepository)(nil).CreateImportChunks), ctx, jobID, params, chunks, total)
+}
+
+// CreateJob mocks base method.
+func (m *MockJobRepository) CreateJob(ctx context.Context, job *db.Job) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateJob", ctx, job)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateJob indicates an expected call of CreateJob.
+func (mr *MockJobRepositoryMockRecorder) CreateJob(ctx, job any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateJob", reflect.TypeOf((*MockJobRepository)(nil).CreateJob), ctx, job)
+}
+
+// FinishJob mocks base method.
+func (m *MockJobRepository) FinishJob(ctx context.Context, job *db.Job) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FinishJob", ctx, job)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// FinishJob indicates an expected call of FinishJob.
+func (mr *MockJobRepositoryMockRecorder) FinishJob(ctx, job any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FinishJob", reflect.TypeOf((*MockJobRepository)(nil).FinishJob), ctx, job)
+}
+
+// GetImportChunk mocks base method.
+func (m *MockJobRepository) GetImportChunk(ctx context.Context, jobID string, chunk int) (*db.ImportChunk, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetImportChunk", ctx, jobID, chunk)
+	ret0, _ := ret[0].(*db.ImportChunk)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetImportChunk indicates an expected call of GetImportChunk.
+func (mr *MockJobRepositoryMockRecorder) GetImportChunk(ctx, jobID, chunk any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetImportChunk", reflect.TypeOf((*MockJobRepository)(nil).GetImportChunk), ctx, jobID, chunk)
+}
+
+// GetJob mocks base method.
+func (m *MockJobRepository) GetJob(ctx context.Context, jobID string) (*db.Job, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetJob", ctx, jobID)
+	ret0, _ := ret[0].(*db.Job)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetJob indicates an expected call of GetJob.
+func (mr *MockJobRepositoryMockRecorder) GetJob(ctx, jobID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetJob", reflect.TypeOf((*MockJobRepository)(nil).GetJob), ctx, jobID)
+}
+
+// ListImportChunkResults mocks base method.
+func (m *MockJobRepository) ListImportChunkResults(ctx context.Context, jobID string) ([]json.RawMessage, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListImportChunkResults", ctx, jobID)
+	ret0, _ := ret[0].([]json.RawMessage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListImportChunkResults indicates an expected call of ListImportChunkResults.
+func (mr *MockJobRepositoryMockRecorder) ListImportChunkResults(ctx, jobID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListImportChunkResults", reflect.TypeOf((*MockJobRepository)(nil).ListImportChunkResults), ctx, jobID)
+}
+
+// ListJobs mocks base method.
+func (m *MockJobRepository) ListJobs(ctx context.Context, userID string, limit int) ([]*db.Job, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListJobs", ctx, userID, limit)
+	ret0, _ := ret[0].([]*db.Job)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListJobs indicates an expected call of ListJobs.
+func (mr *MockJobRepositoryMockRecorder) ListJobs(ctx, userID, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListJobs", reflect.TypeOf((*MockJobRepository)(nil).ListJobs), ctx, userID, limit)
+}
+
+// NextImportChunk mocks base method.
+func (m *MockJobRepository) NextImportChunk(ctx context.Context, jobID string) (*db.ImportChunk, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NextImportChunk", ctx, jobID)
+	ret0, _ := ret[0].(*db.ImportChunk)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// NextImportChunk indicates an expected call of NextImportChunk.
+func (mr *MockJobRepositoryMockRecorder) NextImportChunk(ctx, jobID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NextImportChunk", reflect.TypeOf((*MockJobRepository)(nil).NextImportChunk), ctx, jobID)
+}
+
+// StartJob mocks base method.
+func (m *MockJobRepository) StartJob(ctx context.Context, jobID string) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StartJob", ctx, jobID)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// StartJob indicates an expected call of StartJob.
+func (mr *MockJobRepositoryMockRecorder) StartJob(ctx, jobID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StartJob", reflect.TypeOf((*MockJobRepository)(nil).StartJob), ctx, jobID)
+}
@@ -1,6 +1,8 @@
 package db
 
 import (
+	"encoding/json"
+	"strings"
 	"time"
 
 	"github.com/google/uuid"
@@ -14,6 +16,9 @@ type User struct {
 	Name        string    `json:"name" db:"name"`
 	CreatedAt   time.Time `json:"createdAt" db:"created_at"`
 	UpdatedAt   time.Time `json:"updatedAt" db:"updated_at"`
+	// SuspendedAt is set while an admin has suspended the account.
+	SuspendedAt      *time.Time `json:"suspendedAt,omitempty" db:"suspended_at"`
+	SuspensionReason *string    `json:"suspensionReason,omitempty" db:"suspension_reason"`
 }
 
 type Document struct {
@@ -25,8 +30,27 @@ type Document struct {
 	ExpirationDate time.Time `json:"expirationDate" db:"expiration_date"`
 	Timezone       string    `json:"timezone" db:"timezone"`
 	AttachmentURL  *string   `json:"attachmentUrl,omitempty" db:"attachment_url"`
-	CreatedAt      time.Time `json:"createdAt" db:"created_at"`
-	UpdatedAt      time.Time `json:"updatedAt" db:"updated_at"`
+	// AttachmentStatus is the virus scan state of an uploaded attachment;
+	// nil for external links.
+	AttachmentStatus *string `json:"attachmentStatus,omitempty" db:"attachment_status"`
+	// AttachmentThreat names the malware found in a quarantined attachment.
+	AttachmentThreat *string `json:"attachmentThreat,omitempty" db:"attachment_threat"`
+	Category         *string `json:"category,omitempty" db:"category"`
+	// OrganizationID is the organization the document belongs to; nil for
+	// personal documents.
+	OrganizationID *string `json:"organizationId,omitempty" db:"organization_id"`
+	// RenewalCost is what renewing the document costs, in Currency, an ISO
+	// 4217 code; both are nil when untracked.
+	RenewalCost *float64 `json:"renewalCost,omitempty" db:"renewal_cost"`
+	Currency    *string  `json:"currency,omitempty" db:"currency"`
+	// IssuerID is the issuer in the directory that renews the document.
+	IssuerID *string `json:"issuerId,omitempty" db:"issuer_id"`
+	// LeadTimeDays is how long a renewal takes, overriding the issuer's
+	// processing time for the "start your renewal" reminder.
+	LeadTimeDays *int       `json:"leadTimeDays,omitempty" db:"lead_time_days"`
+	CreatedAt    time.Time  `json:"createdAt" db:"created_at"`
+	UpdatedAt    time.Time  `json:"updatedAt" db:"updated_at"`
+	DeletedAt    *time.Time `json:"deletedAt,omitempty" db:"deleted_at"`
 }
 
 type ReminderInterval struct {
@@ -42,15 +66,777 @@ type DocumentReminder struct {
 	ReminderIntervalID int        `json:"reminderIntervalId" db:"reminder_interval_id"`
 	Enabled            bool       `json:"enabled" db:"enabled"`
 	SentAt             *time.Time `json:"sentAt,omitempty" db:"sent_at"`
+	// Status is one of the Reminder* statuses; see ReminderTransitions.
+	Status          string     `json:"status" db:"status"`
+	StatusChangedAt *time.Time `json:"statusChangedAt,omitempty" db:"status_changed_at"`
 }
 
 type NotificationLog struct {
-	ID                 uuid.UUID `json:"id" db:"id"`
-	UserID             string    `json:"userId" db:"user_id"`
+	ID                 uuid.UUID  `json:"id" db:"id"`
+	MessageID          uuid.UUID  `json:"messageId" db:"message_id"`
+	UserID             string     `json:"userId" db:"user_id"`
+	RecipientID        *string    `json:"recipientId,omitempty" db:"recipient_id"`
+	DocumentID         string     `json:"documentId" db:"document_id"`
+	ReminderIntervalID int        `json:"reminderIntervalId" db:"reminder_interval_id"`
+	Channel            string     `json:"channel" db:"channel"`
+	Status             string     `json:"status" db:"status"`
+	Response           []byte     `json:"response" db:"response"`
+	OpenedAt           *time.Time `json:"openedAt,omitempty" db:"opened_at"`
+	BouncedAt          *time.Time `json:"bouncedAt,omitempty" db:"bounced_at"`
+	EscalatedAt        *time.Time `json:"escalatedAt,omitempty" db:"escalated_at"`
+	ReadAt             *time.Time `json:"readAt,omitempty" db:"read_at"`
+	RequestID          *string    `json:"requestId,omitempty" db:"request_id"`
+	CreatedAt          time.Time  `json:"createdAt" db:"created_at"`
+}
+
+// NotificationStats summarizes recent send attempts for delivery health
+// alerts.
+type NotificationStats struct {
+	Sent   int
+	Failed int
+	// LastSentAt is when a notification last went out, however long ago;
+	// nil if none ever did.
+	LastSentAt *time.Time
+}
+
+// NotificationVolume counts the notifications a user got recently and over
+// the baseline period before, for spotting notification floods.
+type NotificationVolume struct {
+	UserID   string
+	Recent   int
+	Baseline int
+}
+
+type DocumentContact struct {
+	ID               uuid.UUID  `json:"id" db:"id"`
+	DocumentID       string     `json:"documentId" db:"document_id"`
+	Name             *string    `json:"name,omitempty" db:"name"`
+	Email            string     `json:"email" db:"email"`
+	UnsubscribeToken string     `json:"-" db:"unsubscribe_token"`
+	UnsubscribedAt   *time.Time `json:"unsubscribedAt,omitempty" db:"unsubscribed_at"`
+	CreatedAt        time.Time  `json:"createdAt" db:"created_at"`
+}
+
+const (
+	HouseholdRolePrimary = "primary"
+	HouseholdRoleMember  = "member"
+
+	RoutingMember  = "member"
+	RoutingPrimary = "primary"
+	RoutingBoth    = "both"
+)
+
+type Household struct {
+	ID            uuid.UUID `json:"id" db:"id"`
+	Name          string    `json:"name" db:"name"`
+	PrimaryUserID uuid.UUID `json:"primaryUserId" db:"primary_user_id"`
+	CreatedAt     time.Time `json:"createdAt" db:"created_at"`
+	UpdatedAt     time.Time `json:"updatedAt" db:"updated_at"`
+}
+
+type HouseholdMember struct {
+	HouseholdID         uuid.UUID `json:"householdId" db:"household_id"`
+	UserID              uuid.UUID `json:"userId" db:"user_id"`
+	Name                string    `json:"name" db:"name"`
+	Email               string    `json:"email" db:"email"`
+	Role                string    `json:"role" db:"role"`
+	NotificationRouting string    `json:"notificationRouting" db:"notification_routing"`
+	CreatedAt           time.Time `json:"createdAt" db:"created_at"`
+}
+
+type ChecklistItem struct {
+	ID         uuid.UUID  `json:"id" db:"id"`
+	DocumentID string     `json:"documentId" db:"document_id"`
+	Position   int        `json:"position" db:"position"`
+	Title      string     `json:"title" db:"title"`
+	Done       bool       `json:"done" db:"done"`
+	DoneAt     *time.Time `json:"doneAt,omitempty" db:"done_at"`
+	CreatedAt  time.Time  `json:"createdAt" db:"created_at"`
+	UpdatedAt  time.Time  `json:"updatedAt" db:"updated_at"`
+}
+
+// DocumentDependency records that a document depends on another, e.g. a
+// visa on the passport it is in.
+type DocumentDependency struct {
+	DocumentID  string    `json:"documentId" db:"document_id"`
+	DependsOnID string    `json:"dependsOnId" db:"depends_on_id"`
+	CreatedAt   time.Time `json:"createdAt" db:"created_at"`
+}
+
+// Issuer is an authority in the issuer directory that issues and renews
+// documents, such as a passport office.
+type Issuer struct {
+	ID          uuid.UUID `json:"id" db:"id"`
+	Name        string    `json:"name" db:"name"`
+	CountryCode *string   `json:"countryCode,omitempty" db:"country_code"`
+	RenewalURL  *string   `json:"renewalUrl,omitempty" db:"renewal_url"`
+	// ProcessingDays estimates how long a renewal takes to process.
+	ProcessingDays *int      `json:"processingDays,omitempty" db:"processing_days"`
+	CreatedAt      time.Time `json:"createdAt" db:"created_at"`
+	UpdatedAt      time.Time `json:"updatedAt" db:"updated_at"`
+}
+
+type DocumentCategory struct {
+	Slug             string   `json:"slug" db:"slug"`
+	Name             string   `json:"name" db:"name"`
+	DefaultReminders []string `json:"defaultReminders" db:"-"`
+}
+
+// DocumentTemplate is an entry of the built-in gallery of common documents,
+// with the defaults a document created from it starts with.
+type DocumentTemplate struct {
+	Slug            string   `json:"slug" db:"slug"`
+	Name            string   `json:"name" db:"name"`
+	Description     string   `json:"description" db:"description"`
+	Category        *string  `json:"category,omitempty" db:"category"`
+	IdentifierLabel *string  `json:"identifierLabel,omitempty" db:"identifier_label"`
+	Reminders       []string `json:"reminders" db:"reminders"`
+	// Fields are the custom fields of a new document, without values.
+	Fields []*CustomField `json:"fields" db:"-"`
+}
+
+// CustomField is a free-form detail of a document, such as the registrar of
+// a domain.
+type CustomField struct {
+	Key      string `json:"key" db:"key"`
+	Label    string `json:"label" db:"label"`
+	Value    string `json:"value" db:"value"`
+	Position int    `json:"position" db:"position"`
+}
+
+type ValidityPeriod struct {
+	ID             int     `json:"id" db:"id"`
+	CategorySlug   string  `json:"categorySlug" db:"category_slug"`
+	CountryCode    *string `json:"countryCode,omitempty" db:"country_code"`
+	ValidityMonths int     `json:"validityMonths" db:"validity_months"`
+	Notes          *string `json:"notes,omitempty" db:"notes"`
+}
+
+// Statuses of a document reminder, i.e. of the reminder of a document for
+// one interval.
+const (
+	// ReminderScheduled waits for the interval to come due.
+	ReminderScheduled = "scheduled"
+	// ReminderQueued came due but is held back: for a batching window, a
+	// notification pause, the user's send time or a paused channel.
+	ReminderQueued = "queued"
+	// ReminderSent went out on at least one channel.
+	ReminderSent = "sent"
+	// ReminderAcknowledged was followed up by the user from one of its links.
+	ReminderAcknowledged = "acknowledged"
+	// ReminderSnoozed is to be sent again after the snooze.
+	ReminderSnoozed = "snoozed"
+	// ReminderCanceled is turned off for the document.
+	ReminderCanceled = "canceled"
+	// ReminderFailed came due but failed on every channel tried.
+	ReminderFailed = "failed"
+)
+
+const (
+	EscalationNone = "none"
+	EscalationSMS  = "sms"
+)
+
+const (
+	ChannelEmail = "email"
+	ChannelSMS   = "sms"
+	ChannelPush  = "push"
+)
+
+type NotificationPreferences struct {
+	UserID              string `json:"userId" db:"user_id"`
+	EscalationChannel   string `json:"escalationChannel" db:"escalation_channel"`
+	EscalationAfterDays int    `json:"escalationAfterDays" db:"escalation_after_days"`
+	// ChannelMatrix maps a reminder interval id_label to the channels used
+	// for it. Intervals without an entry use the default channels.
+	ChannelMatrix map[string][]string `json:"channelMatrix" db:"channel_matrix"`
+	// BatchWindowHours holds reminders for up to this many hours so they
+	// can be sent together; 0 sends them right away.
+	BatchWindowHours int `json:"batchWindowHours" db:"batch_window_hours"`
+	// CalendarInvites sends reminder emails as calendar invites for the
+	// expiration date, with an alarm.
+	CalendarInvites bool `json:"calendarInvites" db:"calendar_invites"`
+	// PausedUntil defers the user's reminders until then, when one catch-up
+	// message sums them up; nil sends them as they come due.
+	PausedUntil *time.Time `json:"pausedUntil" db:"paused_until"`
+	// OptimizeSendTime delays reminders, within the day they come due, to
+	// the hour the user usually opens reminder emails in.
+	OptimizeSendTime bool      `json:"optimizeSendTime" db:"optimize_send_time"`
+	CreatedAt        time.Time `json:"createdAt" db:"created_at"`
+	UpdatedAt        time.Time `json:"updatedAt" db:"updated_at"`
+}
+
+// DefaultNotificationPreferences applies to users who never saved preferences.
+func DefaultNotificationPreferences(userID string) *NotificationPreferences {
+	return &NotificationPreferences{
+		UserID:              userID,
+		EscalationChannel:   EscalationNone,
+		EscalationAfterDays: 2,
+		ChannelMatrix:       map[string][]string{},
+	}
+}
+
+type HeldReminder struct {
 	DocumentID         string    `json:"documentId" db:"document_id"`
 	ReminderIntervalID int       `json:"reminderIntervalId" db:"reminder_interval_id"`
-	Channel            string    `json:"channel" db:"channel"`
-	Status             string    `json:"status" db:"status"`
-	Response           []byte    `json:"response" db:"response"`
-	CreatedAt          time.Time `json:"createdAt" db:"created_at"`
+	UserID             string    `json:"userId" db:"user_id"`
+	RequestID          *string   `json:"requestId,omitempty" db:"request_id"`
+	HeldAt             time.Time `json:"heldAt" db:"held_at"`
+}
+
+const (
+	WebhookEventDocumentCreated = "document.created"
+	WebhookEventDocumentUpdated = "document.updated"
+	WebhookEventDocumentDeleted = "document.deleted"
+	WebhookEventReminderSent    = "reminder.sent"
+)
+
+// WebhookEvents lists the events an endpoint can subscribe to.
+var WebhookEvents = []string{
+	WebhookEventDocumentCreated,
+	WebhookEventDocumentUpdated,
+	WebhookEventDocumentDeleted,
+	WebhookEventReminderSent,
+}
+
+const (
+	WebhookDeliveryPending   = "pending"
+	WebhookDeliverySucceeded = "succeeded"
+	WebhookDeliveryFailed    = "failed"
+)
+
+type WebhookEndpoint struct {
+	ID     uuid.UUID `json:"id" db:"id"`
+	UserID string    `json:"userId" db:"user_id"`
+	URL    string    `json:"url" db:"url"`
+	// Events the endpoint receives; empty means every event.
+	Events []string `json:"events" db:"events"`
+	Secret string   `json:"-" db:"secret"`
+	// PreviousSecret is still used to sign deliveries until
+	// PreviousSecretExpiresAt, so receivers can roll over without downtime.
+	PreviousSecret          *string    `json:"-" db:"previous_secret"`
+	PreviousSecretExpiresAt *time.Time `json:"previousSecretExpiresAt,omitempty" db:"previous_secret_expires_at"`
+	Enabled                 bool       `json:"enabled" db:"enabled"`
+	CreatedAt               time.Time  `json:"createdAt" db:"created_at"`
+	UpdatedAt               time.Time  `json:"updatedAt" db:"updated_at"`
+}
+
+// SigningSecrets returns the secrets deliveries are signed with at now: the
+// current one, plus the previous one while it is still valid.
+func (e *WebhookEndpoint) SigningSecrets(now time.Time) []string {
+	secrets := []string{e.Secret}
+	if e.PreviousSecret != nil && e.PreviousSecretExpiresAt != nil && now.Before(*e.PreviousSecretExpiresAt) {
+		secrets = append(secrets, *e.PreviousSecret)
+	}
+	return secrets
+}
+
+type WebhookDelivery struct {
+	ID            uuid.UUID  `json:"id" db:"id"`
+	EndpointID    uuid.UUID  `json:"endpointId" db:"endpoint_id"`
+	Event         string     `json:"event" db:"event"`
+	Payload       []byte     `json:"-" db:"payload"`
+	Status        string     `json:"status" db:"status"`
+	Attempts      int        `json:"attempts" db:"attempts"`
+	ResponseCode  *int       `json:"responseCode,omitempty" db:"response_code"`
+	LatencyMs     *int       `json:"latencyMs,omitempty" db:"latency_ms"`
+	Error         *string    `json:"error,omitempty" db:"error"`
+	RedeliveryOf  *uuid.UUID `json:"redeliveryOf,omitempty" db:"redelivery_of"`
+	CreatedAt     time.Time  `json:"createdAt" db:"created_at"`
+	LastAttemptAt *time.Time `json:"lastAttemptAt,omitempty" db:"last_attempt_at"`
+}
+
+// DocumentLock marks a document as being edited by one user until ExpiresAt.
+type DocumentLock struct {
+	DocumentID string    `json:"documentId" db:"document_id"`
+	UserID     string    `json:"userId" db:"user_id"`
+	UserName   string    `json:"userName" db:"-"`
+	LockedAt   time.Time `json:"lockedAt" db:"locked_at"`
+	ExpiresAt  time.Time `json:"expiresAt" db:"expires_at"`
+}
+
+// OrganizationRetention is how many days an organization keeps its data; nil
+// keeps it forever. Expired documents are purged ExpiredDocumentsDays after
+// they expire, event log entries and notification history that long after
+// they were recorded.
+type OrganizationRetention struct {
+	OrganizationID          string    `json:"organizationId" db:"organization_id"`
+	ExpiredDocumentsDays    *int      `json:"expiredDocumentsDays" db:"expired_documents_days"`
+	EventLogDays            *int      `json:"eventLogDays" db:"event_log_days"`
+	NotificationHistoryDays *int      `json:"notificationHistoryDays" db:"notification_history_days"`
+	UpdatedAt               time.Time `json:"updatedAt" db:"updated_at"`
+}
+
+// LegalHold preserves a document, or every document of an organization
+// when DocumentID is nil: held documents cannot be deleted and no purge or
+// retention touches them or their history.
+type LegalHold struct {
+	OrganizationID string    `json:"organizationId" db:"organization_id"`
+	DocumentID     *string   `json:"documentId,omitempty" db:"document_id"`
+	PlacedBy       string    `json:"placedBy" db:"placed_by"`
+	PlacedAt       time.Time `json:"placedAt" db:"placed_at"`
+}
+
+// EscalationStep is one step of an organization's escalation chain: reminders
+// sent DaysBefore or fewer days before expiration also reach Audience.
+type EscalationStep struct {
+	DaysBefore int    `json:"daysBefore" db:"days_before"`
+	Audience   string `json:"audience" db:"audience"`
+}
+
+const (
+	// EscalationAudienceOwner is the document's assignee, or its owner when
+	// it is unassigned.
+	EscalationAudienceOwner    = "owner"
+	EscalationAudienceManagers = "managers"
+	EscalationAudienceEveryone = "everyone"
+)
+
+// DocumentAssignment names the organization member responsible for a team
+// document; its reminders go to them instead of the owner.
+type DocumentAssignment struct {
+	DocumentID     string     `json:"documentId" db:"document_id"`
+	AssigneeID     string     `json:"assigneeId" db:"assignee_id"`
+	AssigneeName   string     `json:"assigneeName" db:"-"`
+	AssignedBy     *string    `json:"assignedBy,omitempty" db:"assigned_by"`
+	AssignedAt     time.Time  `json:"assignedAt" db:"assigned_at"`
+	AcknowledgedAt *time.Time `json:"acknowledgedAt,omitempty" db:"acknowledged_at"`
+}
+
+// SavedView is a named document filter a user saved.
+type SavedView struct {
+	ID        uuid.UUID   `json:"id" db:"id"`
+	UserID    string      `json:"userId" db:"user_id"`
+	Name      string      `json:"name" db:"name"`
+	Filters   ViewFilters `json:"filters" db:"filters"`
+	CreatedAt time.Time   `json:"createdAt" db:"created_at"`
+	UpdatedAt time.Time   `json:"updatedAt" db:"updated_at"`
+}
+
+// Statuses a saved view can narrow documents down to.
+const (
+	ViewStatusActive  = "active"
+	ViewStatusExpired = "expired"
+)
+
+// ViewFilters are the criteria of a saved view. A document must meet all
+// that are set.
+type ViewFilters struct {
+	// Query matches documents whose name contains it, ignoring case.
+	Query string `json:"query,omitempty"`
+	// Categories matches documents in any of these categories, by slug.
+	Categories []string `json:"categories,omitempty"`
+	// ExpiresWithinDays matches documents expiring within this many days
+	// from now, expired ones included unless Status is active.
+	ExpiresWithinDays *int `json:"expiresWithinDays,omitempty"`
+	// Status is ViewStatusActive or ViewStatusExpired; "" matches both.
+	Status string `json:"status,omitempty"`
+	// PinnedOnly matches the documents the user pinned.
+	PinnedOnly bool `json:"pinnedOnly,omitempty"`
+}
+
+// DocumentPosition is where a user put a document in their own arrangement
+// of the documents they see.
+type DocumentPosition struct {
+	DocumentID string `json:"documentId" db:"document_id"`
+	Pinned     bool   `json:"pinned" db:"pinned"`
+	// Position orders the document among the others; nil when the user
+	// never placed it.
+	Position *int `json:"position,omitempty" db:"position"`
+}
+
+// Organization groups users. When DataRegion is set, the organization's
+// documents live in that region's database instead of the home one.
+type Organization struct {
+	ID         uuid.UUID `json:"id" db:"id"`
+	Name       string    `json:"name" db:"name"`
+	DataRegion *string   `json:"dataRegion,omitempty" db:"data_region"`
+	// ComplianceMode makes reminders mandatory on the organization's
+	// documents in ComplianceCategories.
+	ComplianceMode       bool     `json:"complianceMode" db:"compliance_mode"`
+	ComplianceCategories []string `json:"complianceCategories" db:"compliance_categories"`
+	// RenewalApproval makes renewals of the organization's documents wait for
+	// an owner or admin to approve them.
+	RenewalApproval bool      `json:"renewalApproval" db:"renewal_approval"`
+	CreatedAt       time.Time `json:"createdAt" db:"created_at"`
+}
+
+// RenewalRequest is a proposed renewal of an organization document awaiting
+// approval.
+type RenewalRequest struct {
+	ID             uuid.UUID  `json:"id" db:"id"`
+	DocumentID     string     `json:"documentId" db:"document_id"`
+	RequestedBy    string     `json:"requestedBy" db:"requested_by"`
+	ExpirationDate time.Time  `json:"expirationDate" db:"expiration_date"`
+	EvidenceURL    string     `json:"evidenceUrl" db:"evidence_url"`
+	Status         string     `json:"status" db:"status"`
+	DecidedBy      *string    `json:"decidedBy,omitempty" db:"decided_by"`
+	DecidedAt      *time.Time `json:"decidedAt,omitempty" db:"decided_at"`
+	Note           *string    `json:"note,omitempty" db:"note"`
+	CreatedAt      time.Time  `json:"createdAt" db:"created_at"`
+}
+
+const (
+	RenewalPending  = "pending"
+	RenewalApproved = "approved"
+	RenewalRejected = "rejected"
+)
+
+type OrganizationMember struct {
+	OrganizationID string    `json:"organizationId" db:"organization_id"`
+	UserID         string    `json:"userId" db:"user_id"`
+	Name           string    `json:"name" db:"-"`
+	Email          string    `json:"email" db:"-"`
+	Role           string    `json:"role" db:"role"`
+	CreatedAt      time.Time `json:"createdAt" db:"created_at"`
+	// ExternalID is the identity provider's id for a member provisioned
+	// over SCIM.
+	ExternalID *string `json:"externalId,omitempty" db:"scim_external_id"`
+}
+
+const (
+	OrgRoleOwner  = "owner"
+	OrgRoleAdmin  = "admin"
+	OrgRoleMember = "member"
+)
+
+const (
+	PlanFree = "free"
+	PlanPro  = "pro"
+)
+
+// Plans are the plans a user can be on.
+var Plans = []string{PlanFree, PlanPro}
+
+// Units durations such as "expires in 3 weeks" are counted in: DurationAuto
+// picks days, weeks or months by how far off the date is.
+const (
+	DurationAuto = "auto"
+	DurationDays = "days"
+)
+
+// LocalePreferences are how a user wants responses written when a request
+// does not say.
+type LocalePreferences struct {
+	// Locale is a BCP 47 language tag; nil means English.
+	Locale       *string `json:"locale" db:"locale"`
+	DurationUnit string  `json:"durationUnit" db:"duration_unit"`
+}
+
+const (
+	AttachmentPending   = "pending"
+	AttachmentClean     = "clean"
+	AttachmentInfected  = "infected"
+	AttachmentUnscanned = "unscanned"
+	AttachmentError     = "error"
+)
+
+const (
+	AuditActionDocumentTrashed  = "document.trashed"
+	AuditActionDocumentRestored = "document.restored"
+	AuditActionDocumentPurged   = "document.purged"
+	AuditActionUserImpersonated = "user.impersonated"
+	AuditActionUserSuspended    = "user.suspended"
+	AuditActionUserReinstated   = "user.reinstated"
+	AuditActionUserPlanChanged  = "user.plan_changed"
+	AuditActionUserRoleChanged  = "user.role_changed"
+	AuditActionUserEmailChanged = "user.email_changed"
+	AuditActionUserDeleted      = "user.deleted"
+	AuditActionLegalHoldPlaced  = "legal_hold.placed"
+	AuditActionLegalHoldRemoved = "legal_hold.removed"
+
+	AuditActionServiceAccountCreated      = "service_account.created"
+	AuditActionServiceAccountTokenRotated = "service_account.token_rotated"
+	AuditActionServiceAccountTokenRevoked = "service_account.token_revoked"
+)
+
+type AuditLog struct {
+	ID uuid.UUID `json:"id" db:"id"`
+	// ActorID is the user who acted; nil for system jobs.
+	ActorID *string `json:"actorId,omitempty" db:"actor_id"`
+	// UserID is the user whose data the entry is about.
+	UserID *string `json:"userId,omitempty" db:"user_id"`
+	// OrganizationID is the organization whose data the entry is about, if
+	// any; its EventLogDays retention applies to the entry.
+	OrganizationID *string   `json:"organizationId,omitempty" db:"organization_id"`
+	Action         string    `json:"action" db:"action"`
+	EntityType     string    `json:"entityType" db:"entity_type"`
+	EntityID       string    `json:"entityId" db:"entity_id"`
+	Metadata       []byte    `json:"metadata,omitempty" db:"metadata"`
+	CreatedAt      time.Time `json:"createdAt" db:"created_at"`
+}
+
+// EventLogEntry is a row of the append-only, hash-chained event log kept for
+// organization documents.
+type EventLogEntry struct {
+	OrganizationID string    `json:"organizationId" db:"organization_id"`
+	Seq            int64     `json:"seq" db:"seq"`
+	EventType      string    `json:"eventType" db:"event_type"`
+	DocumentID     string    `json:"documentId" db:"document_id"`
+	ActorID        *string   `json:"actorId,omitempty" db:"actor_id"`
+	RecipientID    *string   `json:"recipientId,omitempty" db:"recipient_id"`
+	Channel        *string   `json:"channel,omitempty" db:"channel"`
+	Detail         string    `json:"detail" db:"detail"`
+	OccurredAt     time.Time `json:"occurredAt" db:"occurred_at"`
+	PrevHash       string    `json:"prevHash" db:"prev_hash"`
+	Hash           string    `json:"hash" db:"hash"`
+}
+
+const (
+	EventReminderSent         = "reminder.sent"
+	EventReminderAcknowledged = "reminder.acknowledged"
+)
+
+// OrganizationUsage is how much of a metric an organization used in a UTC
+// month, 'YYYY-MM': the peak the metric reached within it.
+type OrganizationUsage struct {
+	OrganizationID string    `json:"organizationId" db:"organization_id"`
+	Month          string    `json:"month" db:"month"`
+	Metric         string    `json:"metric" db:"metric"`
+	Quantity       int64     `json:"quantity" db:"quantity"`
+	RecordedAt     time.Time `json:"recordedAt" db:"recorded_at"`
+}
+
+// Metrics of OrganizationUsage.
+const (
+	UsageDocuments    = "documents"
+	UsageStorageBytes = "storage_bytes"
+	UsageSMSSent      = "sms_sent"
+)
+
+// EmailSender is the From identity an organization's reminder emails are sent
+// with. It is only used once the organization proves it owns the address's
+// domain by publishing VerificationToken in DNS.
+type EmailSender struct {
+	OrganizationID    string     `json:"organizationId" db:"organization_id"`
+	FromName          string     `json:"fromName" db:"from_name"`
+	FromAddress       string     `json:"fromAddress" db:"from_address"`
+	VerificationToken string     `json:"-" db:"verification_token"`
+	VerifiedAt        *time.Time `json:"verifiedAt,omitempty" db:"verified_at"`
+	// CheckedAt is when the domain's DNS records were last looked up.
+	CheckedAt *time.Time `json:"checkedAt,omitempty" db:"checked_at"`
+	UpdatedAt time.Time  `json:"updatedAt" db:"updated_at"`
+}
+
+// Domain is the domain of FromAddress, the one that has to be verified.
+func (s *EmailSender) Domain() string {
+	return s.FromAddress[strings.LastIndex(s.FromAddress, "@")+1:]
+}
+
+// NotificationTheme brands the emails sent for an organization's documents,
+// so the organization can offer reminders under its own name. Unset fields
+// keep the default look.
+type NotificationTheme struct {
+	OrganizationID string  `json:"organizationId" db:"organization_id"`
+	LogoURL        *string `json:"logoUrl,omitempty" db:"logo_url"`
+	// PrimaryColor and BackgroundColor are #rrggbb hex colors.
+	PrimaryColor    *string   `json:"primaryColor,omitempty" db:"primary_color"`
+	BackgroundColor *string   `json:"backgroundColor,omitempty" db:"background_color"`
+	FooterText      *string   `json:"footerText,omitempty" db:"footer_text"`
+	UpdatedAt       time.Time `json:"updatedAt" db:"updated_at"`
+}
+
+// ExpiringDocument is a document listed in the expiring_documents view.
+type ExpiringDocument struct {
+	DocumentID     uuid.UUID `json:"documentId" db:"document_id"`
+	UserID         uuid.UUID `json:"userId" db:"user_id"`
+	OrganizationID *string   `json:"organizationId,omitempty" db:"organization_id"`
+	Name           string    `json:"name" db:"name"`
+	Category       *string   `json:"category,omitempty" db:"category"`
+	ExpirationDate time.Time `json:"expirationDate" db:"expiration_date"`
+	Timezone       string    `json:"timezone" db:"timezone"`
+}
+
+// DocumentStats counts a user's live documents.
+type DocumentStats struct {
+	Total            int `json:"total"`
+	Expired          int `json:"expired"`
+	ExpiringIn30Days int `json:"expiringIn30Days"`
+}
+
+// RenewalCostTotal is the renewal cost of the documents expiring in a month,
+// in one currency.
+type RenewalCostTotal struct {
+	Month     string  `json:"month"`
+	Currency  string  `json:"currency"`
+	Total     float64 `json:"total"`
+	Documents int     `json:"documents"`
+}
+
+const (
+	AnnouncementMaintenance = "maintenance"
+	AnnouncementFeature     = "feature"
+)
+
+// Announcement is a notice shown to every user while it is active, and
+// optionally emailed to them when it starts.
+const (
+	RoadmapProposed   = "proposed"
+	RoadmapPlanned    = "planned"
+	RoadmapInProgress = "in_progress"
+	RoadmapShipped    = "shipped"
+	RoadmapDeclined   = "declined"
+)
+
+// RoadmapFeature is a feature on the public roadmap that users vote on.
+type RoadmapFeature struct {
+	ID          uuid.UUID `json:"id" db:"id"`
+	Title       string    `json:"title" db:"title"`
+	Description string    `json:"description" db:"description"`
+	// Status is one of the Roadmap constants; shipped and declined features
+	// take no more votes.
+	Status    string  `json:"status" db:"status"`
+	VoteCount int     `json:"voteCount" db:"-"`
+	CreatedBy *string `json:"createdBy,omitempty" db:"created_by"`
+	// Voted tells whether the user the feature was loaded for voted on it.
+	Voted     bool      `json:"voted" db:"-"`
+	CreatedAt time.Time `json:"createdAt" db:"created_at"`
+	UpdatedAt time.Time `json:"updatedAt" db:"updated_at"`
+}
+
+// Feedback is a message or NPS score a user sent from the app.
+type Feedback struct {
+	ID      uuid.UUID `json:"id" db:"id"`
+	UserID  *string   `json:"userId,omitempty" db:"user_id"`
+	Message string    `json:"message" db:"message"`
+	// Score answers "how likely are you to recommend xpired?" from 0 to 10.
+	Score     *int       `json:"score,omitempty" db:"score"`
+	Page      *string    `json:"page,omitempty" db:"page"`
+	RequestID *string    `json:"requestId,omitempty" db:"request_id"`
+	UserAgent *string    `json:"userAgent,omitempty" db:"user_agent"`
+	RelayedAt *time.Time `json:"relayedAt,omitempty" db:"relayed_at"`
+	CreatedAt time.Time  `json:"createdAt" db:"created_at"`
+}
+
+// Session is a sign-in of a user: the token it issued and where it came
+// from. Country, Region and City are nil when no GeoIP database is
+// configured or it does not cover IP.
+type Session struct {
+	// ID is the ID (jti) of the session's token.
+	ID        uuid.UUID `json:"id" db:"id"`
+	UserID    string    `json:"userId" db:"user_id"`
+	UserAgent *string   `json:"userAgent,omitempty" db:"user_agent"`
+	IP        *string   `json:"ip,omitempty" db:"ip"`
+	Country   *string   `json:"country,omitempty" db:"country"`
+	Region    *string   `json:"region,omitempty" db:"region"`
+	City      *string   `json:"city,omitempty" db:"city"`
+	CreatedAt time.Time `json:"createdAt" db:"created_at"`
+	ExpiresAt time.Time `json:"expiresAt" db:"expires_at"`
+}
+
+// APIKey is a long-lived key a user created for scripts and integrations.
+// Requests made with it can only use its Scopes.
+type APIKey struct {
+	ID     uuid.UUID `json:"id" db:"id"`
+	UserID string    `json:"userId" db:"user_id"`
+	Name   string    `json:"name" db:"name"`
+	// KeyHash is the SHA-256 of the key, which is only shown once.
+	KeyHash    string     `json:"-" db:"key_hash"`
+	Prefix     string     `json:"prefix" db:"prefix"`
+	Scopes     []string   `json:"scopes" db:"scopes"`
+	ExpiresAt  *time.Time `json:"expiresAt,omitempty" db:"expires_at"`
+	LastUsedAt *time.Time `json:"lastUsedAt,omitempty" db:"last_used_at"`
+	CreatedAt  time.Time  `json:"createdAt" db:"created_at"`
+}
+
+// ServiceAccount is a machine account an organization owns for its
+// integrations. It acts as UserID, a member that cannot sign in, through
+// the one API key it holds at a time.
+type ServiceAccount struct {
+	ID             uuid.UUID `json:"id" db:"id"`
+	OrganizationID string    `json:"organizationId" db:"organization_id"`
+	UserID         string    `json:"userId" db:"user_id"`
+	Name           string    `json:"name" db:"name"`
+	Scopes         []string  `json:"scopes" db:"scopes"`
+	CreatedBy      *string   `json:"createdBy,omitempty" db:"created_by"`
+	CreatedAt      time.Time `json:"createdAt" db:"created_at"`
+	// Token is the current token, without the key itself; nil once revoked.
+	Token *APIKey `json:"token,omitempty" db:"-"`
+}
+
+type Announcement struct {
+	ID    uuid.UUID `json:"id" db:"id"`
+	Title string    `json:"title" db:"title"`
+	Body  string    `json:"body" db:"body"`
+	// Kind is AnnouncementMaintenance or AnnouncementFeature.
+	Kind     string     `json:"kind" db:"kind"`
+	StartsAt time.Time  `json:"startsAt" db:"starts_at"`
+	EndsAt   *time.Time `json:"endsAt,omitempty" db:"ends_at"`
+	// SendEmail delivers the announcement by email at StartsAt.
+	SendEmail bool       `json:"sendEmail" db:"send_email"`
+	EmailedAt *time.Time `json:"emailedAt,omitempty" db:"emailed_at"`
+	CreatedBy *string    `json:"createdBy,omitempty" db:"created_by"`
+	CreatedAt time.Time  `json:"createdAt" db:"created_at"`
+}
+
+const (
+	JobKindImport  = "import"
+	JobKindTakeout = "takeout"
+)
+
+const (
+	JobQueued    = "queued"
+	JobRunning   = "running"
+	JobSucceeded = "succeeded"
+	JobFailed    = "failed"
+)
+
+// Job is a long-running operation a user started through the API. The
+// worker runs it while the client polls its status.
+type Job struct {
+	ID     uuid.UUID `json:"id" db:"id"`
+	UserID string    `json:"userId" db:"user_id"`
+	// Kind is one of the JobKind constants.
+	Kind   string `json:"kind" db:"kind"`
+	Status string `json:"status" db:"status"`
+	// Params is the input of the job; it is cleared once the job finishes.
+	Params json.RawMessage `json:"-" db:"params"`
+	// Result summarizes the outcome of a succeeded job, and ResultURL is
+	// the file it produced, if any.
+	Result    json.RawMessage `json:"result,omitempty" db:"result"`
+	ResultURL *string         `json:"resultUrl,omitempty" db:"result_url"`
+	Error     *string         `json:"error,omitempty" db:"error"`
+	// Progress counts the items a running job has processed, for jobs that
+	// know how many there are.
+	Progress   *JobProgress `json:"progress,omitempty" db:"-"`
+	CreatedAt  time.Time    `json:"createdAt" db:"created_at"`
+	StartedAt  *time.Time   `json:"startedAt,omitempty" db:"started_at"`
+	FinishedAt *time.Time   `json:"finishedAt,omitempty" db:"finished_at"`
+}
+
+// JobProgress is how many of a job's items, such as the rows of an import,
+// are done.
+type JobProgress struct {
+	Done  int `json:"done"`
+	Total int `json:"total"`
+}
+
+// ImportChunk is a slice of the rows of an import job, imported by one
+// worker task. Result is set when it is done.
+type ImportChunk struct {
+	JobID  string          `json:"jobId" db:"job_id"`
+	Chunk  int             `json:"chunk" db:"chunk"`
+	Rows   json.RawMessage `json:"rows" db:"rows"`
+	Result json.RawMessage `json:"result,omitempty" db:"result"`
+	DoneAt *time.Time      `json:"doneAt,omitempty" db:"done_at"`
+}
+
+// AttachmentBlob is an uploaded attachment stored once for all documents of
+// its owner with the same contents.
+type AttachmentBlob struct {
+	UserID    string    `json:"userId" db:"user_id"`
+	Checksum  string    `json:"checksum" db:"checksum"`
+	URL       string    `json:"url" db:"url"`
+	Size      int64     `json:"size" db:"size"`
+	CreatedAt time.Time `json:"createdAt" db:"created_at"`
+}
+
+// AttachmentRetentionWarning records that the owner of a document was told
+// its attachment will be deleted after DeleteAfter, as the document expired
+// longer ago than their plan keeps attachments.
+type AttachmentRetentionWarning struct {
+	DocumentID    string    `json:"documentId" db:"document_id"`
+	AttachmentURL string    `json:"attachmentUrl" db:"attachment_url"`
+	DeleteAfter   time.Time `json:"deleteAfter" db:"delete_after"`
+	WarnedAt      time.Time `json:"warnedAt" db:"warned_at"`
 }
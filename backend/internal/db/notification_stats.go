@@ -0,0 +1,62 @@
+package db
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+// CountNotificationOutcomes counts the send attempts recorded since since, and
+// when a notification last went out at all.
+func (r *repository) CountNotificationOutcomes(ctx context.Context, since time.Time) (*NotificationStats, error) {
+	query := `
+		SELECT
+			COUNT(*) FILTER (WHERE status IN ('sent', 'dry_run') AND created_at >= $1),
+			COUNT(*) FILTER (WHERE status = 'failed' AND created_at >= $1),
+			(SELECT MAX(created_at) FROM notification_logs WHERE status IN ('sent', 'dry_run'))
+		FROM notification_logs
+		WHERE created_at >= $1
+	`
+	var stats NotificationStats
+	err := r.readConn(ctx).QueryRowContext(ctx, query, since).Scan(&stats.Sent, &stats.Failed, &stats.LastSentAt)
+	if err != nil {
+		return nil, fmt.Errorf("failed to count notification outcomes: %w", err)
+	}
+	return &stats, nil
+}
+
+// CountNotificationsByUser counts, per user, the notifications sent since
+// recentSince and those sent from since up to recentSince. Only users with at
+// least minRecent recent notifications are returned.
+func (r *repository) CountNotificationsByUser(ctx context.Context, since, recentSince time.Time, minRecent int) ([]*NotificationVolume, error) {
+	query := `
+		SELECT
+			user_id,
+			COUNT(*) FILTER (WHERE created_at >= $2),
+			COUNT(*) FILTER (WHERE created_at < $2)
+		FROM notification_logs
+		WHERE created_at >= $1 AND user_id IS NOT NULL AND status IN ('sent', 'dry_run')
+		GROUP BY user_id
+		HAVING COUNT(*) FILTER (WHERE created_at >= $2) >= $3
+	`
+	rows, err := r.readConn(ctx).QueryContext(ctx, query, since, recentSince, minRecent)
+	if err != nil {
+		return nil, fmt.Errorf("failed to count notifications by user: %w", err)
+	}
+	defer rows.Close()
+
+	var volumes []*NotificationVolume
+	for rows.Next() {
+		var volume NotificationVolume
+		if err := rows.Scan(&volume.UserID, &volume.Recent, &volume.Baseline); err != nil {
+			return nil, fmt.Errorf("failed to scan notification volume: %w", err)
+		}
+		volumes = append(volumes, &volume)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("row iteration error: %w", err)
+	}
+
+	return volumes, nil
+}
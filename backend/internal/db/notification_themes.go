@@ -0,0 +1,64 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+)
+
+func (r *repository) GetNotificationTheme(ctx context.Context, organizationID string) (*NotificationTheme, error) {
+	query := `
+		SELECT organization_id, logo_url, primary_color, background_color, footer_text, updated_at
+		FROM notification_themes
+		WHERE organization_id = $1
+	`
+	var theme NotificationTheme
+	err := r.db.DB.QueryRowContext(ctx, query, organizationID).Scan(
+		&theme.OrganizationID,
+		&theme.LogoURL,
+		&theme.PrimaryColor,
+		&theme.BackgroundColor,
+		&theme.FooterText,
+		&theme.UpdatedAt,
+	)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, fmt.Errorf("notification theme not found")
+		}
+		return nil, fmt.Errorf("failed to get notification theme: %w", err)
+	}
+	return &theme, nil
+}
+
+// SetNotificationTheme creates or replaces the organization's theme.
+func (r *repository) SetNotificationTheme(ctx context.Context, theme *NotificationTheme) error {
+	query := `
+		INSERT INTO notification_themes (organization_id, logo_url, primary_color, background_color, footer_text)
+		VALUES ($1, $2, $3, $4, $5)
+		ON CONFLICT (organization_id) DO UPDATE
+		SET logo_url = EXCLUDED.logo_url,
+			primary_color = EXCLUDED.primary_color,
+			background_color = EXCLUDED.background_color,
+			footer_text = EXCLUDED.footer_text,
+			updated_at = NOW()
+		RETURNING updated_at
+	`
+	err := r.db.DB.QueryRowContext(ctx, query,
+		theme.OrganizationID,
+		theme.LogoURL,
+		theme.PrimaryColor,
+		theme.BackgroundColor,
+		theme.FooterText,
+	).Scan(&theme.UpdatedAt)
+	if err != nil {
+		return fmt.Errorf("failed to set notification theme: %w", err)
+	}
+	return nil
+}
+
+func (r *repository) DeleteNotificationTheme(ctx context.Context, organizationID string) error {
+	if _, err := r.db.DB.ExecContext(ctx, `DELETE FROM notification_themes WHERE organization_id = $1`, organizationID); err != nil {
+		return fmt.Errorf("failed to delete notification theme: %w", err)
+	}
+	return nil
+}
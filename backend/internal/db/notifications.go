@@ -0,0 +1,349 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"time"
+)
+
+const notificationLogColumns = `
+	id, message_id, user_id, recipient_id, document_id, reminder_interval_id, channel, status, response,
+	opened_at, bounced_at, escalated_at, read_at, request_id, created_at
+`
+
+func scanNotificationLogs(rows *sql.Rows) ([]*NotificationLog, error) {
+	var logs []*NotificationLog
+	for rows.Next() {
+		var log NotificationLog
+		err := rows.Scan(
+			&log.ID,
+			&log.MessageID,
+			&log.UserID,
+			&log.RecipientID,
+			&log.DocumentID,
+			&log.ReminderIntervalID,
+			&log.Channel,
+			&log.Status,
+			&log.Response,
+			&log.OpenedAt,
+			&log.BouncedAt,
+			&log.EscalatedAt,
+			&log.ReadAt,
+			&log.RequestID,
+			&log.CreatedAt,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan notification log: %w", err)
+		}
+		logs = append(logs, &log)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to iterate notification logs: %w", err)
+	}
+	return logs, nil
+}
+
+// ListNotificationLogsByMessageID returns every log row of a message. A
+// batched email shares one message ID across one row per document.
+func (r *repository) ListNotificationLogsByMessageID(ctx context.Context, messageID string) ([]*NotificationLog, error) {
+	query := `SELECT ` + notificationLogColumns + `
+		FROM notification_logs
+		WHERE message_id = $1
+		ORDER BY created_at
+	`
+	rows, err := r.conn(ctx).QueryContext(ctx, query, messageID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list notification logs: %w", err)
+	}
+	defer rows.Close()
+
+	return scanNotificationLogs(rows)
+}
+
+// ListNotificationLogs returns the most recent notifications about userID's
+// documents, newest first, optionally narrowed to one document.
+func (r *repository) ListNotificationLogs(ctx context.Context, userID, documentID string, limit int) ([]*NotificationLog, error) {
+	query := `SELECT ` + notificationLogColumns + `
+		FROM notification_logs
+		WHERE user_id = $1 AND ($2 = '' OR document_id::text = $2)
+		ORDER BY created_at DESC
+		LIMIT $3
+	`
+	rows, err := r.readConn(ctx).QueryContext(ctx, query, userID, documentID, limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list notification logs: %w", err)
+	}
+	defer rows.Close()
+
+	return scanNotificationLogs(rows)
+}
+
+// CountUnreadNotifications returns how many messages about userID's
+// documents are unread. A batched email counts once.
+func (r *repository) CountUnreadNotifications(ctx context.Context, userID string) (int, error) {
+	var count int
+	err := r.conn(ctx).QueryRowContext(ctx, `
+		SELECT COUNT(DISTINCT message_id)
+		FROM notification_logs
+		WHERE user_id = $1 AND read_at IS NULL
+	`, userID).Scan(&count)
+	if err != nil {
+		return 0, fmt.Errorf("failed to count unread notifications: %w", err)
+	}
+	return count, nil
+}
+
+// ListEmailOpenTimes returns when recipientID opened the reminder emails
+// they were sent since since, newest first, at most limit. A batched email
+// counts once.
+func (r *repository) ListEmailOpenTimes(ctx context.Context, recipientID string, since time.Time, limit int) ([]time.Time, error) {
+	query := `
+		SELECT DISTINCT message_id, opened_at
+		FROM notification_logs
+		WHERE recipient_id = $1 AND channel = $2 AND opened_at >= $3
+		ORDER BY opened_at DESC
+		LIMIT $4
+	`
+	rows, err := r.readConn(ctx).QueryContext(ctx, query, recipientID, ChannelEmail, since, limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list email open times: %w", err)
+	}
+	defer rows.Close()
+
+	var opens []time.Time
+	for rows.Next() {
+		var messageID string
+		var openedAt time.Time
+		if err := rows.Scan(&messageID, &openedAt); err != nil {
+			return nil, fmt.Errorf("failed to scan email open time: %w", err)
+		}
+		opens = append(opens, openedAt)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to iterate email open times: %w", err)
+	}
+	return opens, nil
+}
+
+// MarkNotificationsRead marks every notification about userID's documents
+// read and returns how many log rows changed.
+func (r *repository) MarkNotificationsRead(ctx context.Context, userID string) (int64, error) {
+	result, err := r.conn(ctx).ExecContext(ctx, `
+		UPDATE notification_logs
+		SET read_at = NOW()
+		WHERE user_id = $1 AND read_at IS NULL
+	`, userID)
+	if err != nil {
+		return 0, fmt.Errorf("failed to mark notifications read: %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return 0, fmt.Errorf("failed to get rows affected: %w", err)
+	}
+	return rowsAffected, nil
+}
+
+func (r *repository) MarkNotificationOpened(ctx context.Context, messageID string) error {
+	query := `
+		UPDATE notification_logs
+		SET opened_at = COALESCE(opened_at, NOW())
+		WHERE message_id = $1
+	`
+	if _, err := r.conn(ctx).ExecContext(ctx, query, messageID); err != nil {
+		return fmt.Errorf("failed to mark notification opened: %w", err)
+	}
+	return nil
+}
+
+func (r *repository) MarkNotificationBounced(ctx context.Context, messageID string) error {
+	query := `
+		UPDATE notification_logs
+		SET bounced_at = COALESCE(bounced_at, NOW()), status = 'bounced'
+		WHERE message_id = $1
+	`
+	result, err := r.conn(ctx).ExecContext(ctx, query, messageID)
+	if err != nil {
+		return fmt.Errorf("failed to mark notification bounced: %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to get rows affected: %w", err)
+	}
+	if rowsAffected == 0 {
+		return fmt.Errorf("notification log not found")
+	}
+	return nil
+}
+
+// MarkNotificationEscalated claims the escalation of a message. It returns
+// false when the message was already escalated, so concurrent escalation
+// tasks (a bounce and the unopened-check) send at most one fallback.
+func (r *repository) MarkNotificationEscalated(ctx context.Context, messageID string) (bool, error) {
+	query := `
+		UPDATE notification_logs
+		SET escalated_at = NOW()
+		WHERE message_id = $1 AND escalated_at IS NULL
+	`
+	result, err := r.conn(ctx).ExecContext(ctx, query, messageID)
+	if err != nil {
+		return false, fmt.Errorf("failed to mark notification escalated: %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return false, fmt.Errorf("failed to get rows affected: %w", err)
+	}
+	return rowsAffected > 0, nil
+}
+
+func (r *repository) GetNotificationPreferences(ctx context.Context, userID string) (*NotificationPreferences, error) {
+	query := `
+		SELECT user_id, escalation_channel, escalation_after_days, channel_matrix, batch_window_hours, calendar_invites, paused_until,
+			optimize_send_time, created_at, updated_at
+		FROM notification_preferences
+		WHERE user_id = $1
+	`
+	var prefs NotificationPreferences
+	var matrix []byte
+	err := r.db.DB.QueryRowContext(ctx, query, userID).Scan(
+		&prefs.UserID,
+		&prefs.EscalationChannel,
+		&prefs.EscalationAfterDays,
+		&matrix,
+		&prefs.BatchWindowHours,
+		&prefs.CalendarInvites,
+		&prefs.PausedUntil,
+		&prefs.OptimizeSendTime,
+		&prefs.CreatedAt,
+		&prefs.UpdatedAt,
+	)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return DefaultNotificationPreferences(userID), nil
+		}
+		return nil, fmt.Errorf("failed to get notification preferences: %w", err)
+	}
+
+	prefs.ChannelMatrix = map[string][]string{}
+	if err := json.Unmarshal(matrix, &prefs.ChannelMatrix); err != nil {
+		return nil, fmt.Errorf("failed to decode channel matrix: %w", err)
+	}
+	return &prefs, nil
+}
+
+func (r *repository) UpsertNotificationPreferences(ctx context.Context, prefs *NotificationPreferences) error {
+	query := `
+		INSERT INTO notification_preferences (user_id, escalation_channel, escalation_after_days, channel_matrix, batch_window_hours, calendar_invites,
+			paused_until, optimize_send_time)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+		ON CONFLICT (user_id) DO UPDATE
+		SET escalation_channel = EXCLUDED.escalation_channel,
+			escalation_after_days = EXCLUDED.escalation_after_days,
+			channel_matrix = EXCLUDED.channel_matrix,
+			batch_window_hours = EXCLUDED.batch_window_hours,
+			calendar_invites = EXCLUDED.calendar_invites,
+			paused_until = EXCLUDED.paused_until,
+			optimize_send_time = EXCLUDED.optimize_send_time,
+			updated_at = NOW()
+		RETURNING created_at, updated_at
+	`
+	if prefs.ChannelMatrix == nil {
+		prefs.ChannelMatrix = map[string][]string{}
+	}
+	matrix, err := json.Marshal(prefs.ChannelMatrix)
+	if err != nil {
+		return fmt.Errorf("failed to encode channel matrix: %w", err)
+	}
+
+	err = r.db.DB.QueryRowContext(
+		ctx,
+		query,
+		prefs.UserID,
+		prefs.EscalationChannel,
+		prefs.EscalationAfterDays,
+		string(matrix),
+		prefs.BatchWindowHours,
+		prefs.CalendarInvites,
+		prefs.PausedUntil,
+		prefs.OptimizeSendTime,
+	).Scan(&prefs.CreatedAt, &prefs.UpdatedAt)
+
+	if err != nil {
+		return fmt.Errorf("failed to save notification preferences: %w", err)
+	}
+	return nil
+}
+
+// HoldReminder parks a reminder until the user's batching window closes. It
+// reports whether this opened a new window, i.e. no other reminder of the
+// user was already waiting; the caller then schedules the flush. requestID
+// is the API request that scheduled the reminder, or "".
+func (r *repository) HoldReminder(ctx context.Context, userID, documentID string, intervalID int, requestID string) (bool, error) {
+	// two statements rather than a data-modifying CTE, which SQLite lacks
+	tx, err := r.conn(ctx).BeginTx(ctx, nil)
+	if err != nil {
+		return false, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	var waiting bool
+	err = tx.QueryRowContext(ctx, `SELECT EXISTS (SELECT 1 FROM held_reminders WHERE user_id = $1)`, userID).Scan(&waiting)
+	if err != nil {
+		return false, fmt.Errorf("failed to hold reminder: %w", err)
+	}
+
+	_, err = tx.ExecContext(ctx, `
+		INSERT INTO held_reminders (user_id, document_id, reminder_interval_id, request_id)
+		VALUES ($1, $2, $3, NULLIF($4, ''))
+		ON CONFLICT (document_id, reminder_interval_id) DO NOTHING
+	`, userID, documentID, intervalID, requestID)
+	if err != nil {
+		return false, fmt.Errorf("failed to hold reminder: %w", err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return false, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+	return !waiting, nil
+}
+
+// TakeHeldReminders removes and returns every reminder held for userID.
+func (r *repository) TakeHeldReminders(ctx context.Context, userID string) ([]*HeldReminder, error) {
+	query := `
+		DELETE FROM held_reminders
+		WHERE user_id = $1
+		RETURNING document_id, reminder_interval_id, user_id, request_id, held_at
+	`
+	rows, err := r.conn(ctx).QueryContext(ctx, query, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to take held reminders: %w", err)
+	}
+	defer rows.Close()
+
+	var held []*HeldReminder
+	for rows.Next() {
+		var reminder HeldReminder
+		err := rows.Scan(
+			&reminder.DocumentID,
+			&reminder.ReminderIntervalID,
+			&reminder.UserID,
+			&reminder.RequestID,
+			&reminder.HeldAt,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan held reminder: %w", err)
+		}
+		held = append(held, &reminder)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to iterate held reminders: %w", err)
+	}
+	return held, nil
+}
@@ -0,0 +1,50 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+)
+
+// GetUserIDByProvider returns the user who signs in with the provider
+// account providerID.
+func (r *repository) GetUserIDByProvider(ctx context.Context, provider, providerID string) (string, error) {
+	var userID string
+	err := r.db.DB.QueryRowContext(ctx, `
+		SELECT id FROM users WHERE provider = $1 AND provider_id = $2
+	`, provider, providerID).Scan(&userID)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return "", fmt.Errorf("user does not exist")
+		}
+		return "", fmt.Errorf("failed to get user by provider: %w", err)
+	}
+	return userID, nil
+}
+
+// LinkUserProvider lets the user sign in with the provider account
+// providerID. A user signs in with one provider account at most, so users
+// who already have one keep it.
+func (r *repository) LinkUserProvider(ctx context.Context, userID, provider, providerID string) error {
+	_, err := r.db.DB.ExecContext(ctx, `
+		UPDATE users SET provider = $1, provider_id = $2, updated_at = NOW()
+		WHERE id = $3 AND provider IS NULL
+	`, provider, providerID, userID)
+	if err != nil {
+		return fmt.Errorf("failed to link user provider: %w", err)
+	}
+	return nil
+}
+
+// ClearUserPassword removes the user's password, so the account can only be
+// signed in to through its linked provider.
+func (r *repository) ClearUserPassword(ctx context.Context, userID string) error {
+	_, err := r.db.DB.ExecContext(ctx, `
+		UPDATE users SET password = '', updated_at = NOW()
+		WHERE id = $1
+	`, userID)
+	if err != nil {
+		return fmt.Errorf("failed to clear user password: %w", err)
+	}
+	return nil
+}
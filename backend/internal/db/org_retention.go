@@ -0,0 +1,142 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/lib/pq"
+)
+
+// GetOrganizationRetention returns how long an organization keeps its data.
+// Every period is nil when the organization never set one.
+func (r *repository) GetOrganizationRetention(ctx context.Context, organizationID string) (*OrganizationRetention, error) {
+	retention := OrganizationRetention{OrganizationID: organizationID}
+	err := r.db.DB.QueryRowContext(ctx, `
+		SELECT expired_documents_days, event_log_days, notification_history_days, updated_at
+		FROM organization_retention
+		WHERE organization_id = $1
+	`, organizationID).Scan(
+		&retention.ExpiredDocumentsDays,
+		&retention.EventLogDays,
+		&retention.NotificationHistoryDays,
+		&retention.UpdatedAt,
+	)
+	if err != nil && err != sql.ErrNoRows {
+		return nil, fmt.Errorf("failed to get organization retention: %w", err)
+	}
+	return &retention, nil
+}
+
+// SetOrganizationRetention replaces how long an organization keeps its data.
+func (r *repository) SetOrganizationRetention(ctx context.Context, retention *OrganizationRetention) error {
+	err := r.db.DB.QueryRowContext(ctx, `
+		INSERT INTO organization_retention (organization_id, expired_documents_days, event_log_days, notification_history_days, updated_at)
+		VALUES ($1, $2, $3, $4, now())
+		ON CONFLICT (organization_id) DO UPDATE
+		SET expired_documents_days = EXCLUDED.expired_documents_days,
+			event_log_days = EXCLUDED.event_log_days,
+			notification_history_days = EXCLUDED.notification_history_days,
+			updated_at = EXCLUDED.updated_at
+		RETURNING updated_at
+	`, retention.OrganizationID, retention.ExpiredDocumentsDays, retention.EventLogDays,
+		retention.NotificationHistoryDays).Scan(&retention.UpdatedAt)
+	if err != nil {
+		return fmt.Errorf("failed to set organization retention: %w", err)
+	}
+	return nil
+}
+
+// ListOrganizationRetention returns the retention of the organizations that
+// limit how long they keep any of their data.
+func (r *repository) ListOrganizationRetention(ctx context.Context) ([]*OrganizationRetention, error) {
+	rows, err := r.db.DB.QueryContext(ctx, `
+		SELECT organization_id, expired_documents_days, event_log_days, notification_history_days, updated_at
+		FROM organization_retention
+		WHERE expired_documents_days IS NOT NULL OR event_log_days IS NOT NULL OR notification_history_days IS NOT NULL
+		ORDER BY organization_id
+	`)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list organization retention: %w", err)
+	}
+	defer rows.Close()
+
+	var retentions []*OrganizationRetention
+	for rows.Next() {
+		var retention OrganizationRetention
+		err := rows.Scan(
+			&retention.OrganizationID,
+			&retention.ExpiredDocumentsDays,
+			&retention.EventLogDays,
+			&retention.NotificationHistoryDays,
+			&retention.UpdatedAt,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan organization retention: %w", err)
+		}
+		retentions = append(retentions, &retention)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("row iteration error: %w", err)
+	}
+	return retentions, nil
+}
+
+// ListOrganizationDocumentsExpiredBefore returns up to limit documents of an
+// organization, trashed ones included, that expired before cutoff, those
+// that expired first first. Documents under legal hold are left out.
+func (r *repository) ListOrganizationDocumentsExpiredBefore(ctx context.Context, organizationID string, cutoff time.Time, limit int) ([]*Document, error) {
+	query := `
+		SELECT ` + trashedDocumentColumns + `
+		FROM documents
+		WHERE organization_id = $1 AND expiration_date < $2 AND ` + notOnLegalHold + `
+		ORDER BY expiration_date
+		LIMIT $3
+	`
+	return r.queryTrashedDocuments(ctx, query, organizationID, cutoff, limit)
+}
+
+// DeleteOrganizationAuditLogsBefore deletes the audit entries about an
+// organization's data recorded before cutoff, except those about the
+// documents in keepDocumentIDs, returning how many entries it deleted. The
+// audit log is kept in the home database, so the caller finds the documents
+// under legal hold in the organization's region.
+func (r *repository) DeleteOrganizationAuditLogsBefore(ctx context.Context, organizationID string, cutoff time.Time, keepDocumentIDs []string) (int64, error) {
+	result, err := r.db.DB.ExecContext(ctx, `
+		DELETE FROM audit_logs
+		WHERE organization_id = $1 AND created_at < $2
+			AND NOT (entity_type = 'document' AND entity_id = ANY($3))
+	`, organizationID, cutoff, pq.Array(keepDocumentIDs))
+	if err != nil {
+		return 0, fmt.Errorf("failed to delete audit logs: %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return 0, fmt.Errorf("failed to get rows affected: %w", err)
+	}
+	return rowsAffected, nil
+}
+
+// DeleteOrganizationNotificationsBefore deletes the notification history of
+// an organization's documents recorded before cutoff, returning how many
+// notifications it deleted. That of documents under legal hold is kept.
+func (r *repository) DeleteOrganizationNotificationsBefore(ctx context.Context, organizationID string, cutoff time.Time) (int64, error) {
+	result, err := r.conn(ctx).ExecContext(ctx, `
+		DELETE FROM notification_logs
+		WHERE created_at < $2 AND document_id IN (
+			SELECT id FROM documents WHERE organization_id = $1 AND `+notOnLegalHold+`
+		)
+	`, organizationID, cutoff)
+	if err != nil {
+		return 0, fmt.Errorf("failed to delete notification history: %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return 0, fmt.Errorf("failed to get rows affected: %w", err)
+	}
+	return rowsAffected, nil
+}
@@ -0,0 +1,383 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/lib/pq"
+
+	"xpired/internal/tenant"
+)
+
+// conn returns the database holding document data for the tenant in ctx:
+// the regional database its organization is pinned to, or the home one.
+// Users, organizations and other account data always stay in the home
+// database.
+func (r *repository) conn(ctx context.Context) *sql.DB {
+	region := tenant.FromContext(ctx).Region
+	if region == "" {
+		return r.db.DB
+	}
+	regional, ok := r.db.regions[region]
+	if !ok {
+		// Organizations can only be pinned to configured regions and startup
+		// refuses to run with a pinned region missing, so this is a bug.
+		panic(fmt.Sprintf("no database configured for data region %q", region))
+	}
+	return regional.DB
+}
+
+// readConn is conn for read-only queries that can tolerate replica lag. It
+// falls back to the primary when no replica is configured.
+func (r *repository) readConn(ctx context.Context) *sql.DB {
+	region := tenant.FromContext(ctx).Region
+	if region == "" {
+		return r.db.reader()
+	}
+	if regional, ok := r.db.regions[region]; ok {
+		return regional.reader()
+	}
+	return r.conn(ctx)
+}
+
+// organizationFilter is the organization_id documents listed in ctx must have.
+func organizationFilter(ctx context.Context) *string {
+	if organizationID := tenant.OrganizationID(ctx); organizationID != "" {
+		return &organizationID
+	}
+	return nil
+}
+
+func (r *repository) DataRegions() []string {
+	return r.db.Regions()
+}
+
+func (r *repository) CreateOrganization(ctx context.Context, org *Organization, ownerID string) error {
+	if org.DataRegion != nil {
+		if _, ok := r.db.regions[*org.DataRegion]; !ok {
+			return fmt.Errorf("unknown data region")
+		}
+	}
+
+	tx, err := r.db.DB.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	err = tx.QueryRowContext(ctx, `
+		INSERT INTO organizations (id, name, data_region)
+		VALUES ($1, $2, $3)
+		RETURNING created_at
+	`, org.ID, org.Name, org.DataRegion).Scan(&org.CreatedAt)
+	if err != nil {
+		return fmt.Errorf("failed to create organization: %w", err)
+	}
+
+	_, err = tx.ExecContext(ctx, `
+		INSERT INTO organization_members (organization_id, user_id, role)
+		VALUES ($1, $2, $3)
+	`, org.ID, ownerID, OrgRoleOwner)
+	if err != nil {
+		return fmt.Errorf("failed to add organization owner: %w", err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+	return r.mirrorUser(ctx, org, ownerID)
+}
+
+func (r *repository) GetOrganization(ctx context.Context, organizationID string) (*Organization, error) {
+	var org Organization
+	err := r.db.DB.QueryRowContext(ctx, `
+		SELECT id, name, data_region, compliance_mode, compliance_categories, renewal_approval, created_at
+		FROM organizations
+		WHERE id = $1
+	`, organizationID).Scan(
+		&org.ID,
+		&org.Name,
+		&org.DataRegion,
+		&org.ComplianceMode,
+		pq.Array(&org.ComplianceCategories),
+		&org.RenewalApproval,
+		&org.CreatedAt,
+	)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, fmt.Errorf("organization not found")
+		}
+		return nil, fmt.Errorf("failed to get organization: %w", err)
+	}
+	return &org, nil
+}
+
+// ListOrganizationsByUserID returns the organizations userID belongs to.
+func (r *repository) ListOrganizationsByUserID(ctx context.Context, userID string) ([]*Organization, error) {
+	rows, err := r.db.DB.QueryContext(ctx, `
+		SELECT o.id, o.name, o.data_region, o.compliance_mode, o.compliance_categories, o.renewal_approval, o.created_at
+		FROM organizations o
+		JOIN organization_members m ON m.organization_id = o.id
+		WHERE m.user_id = $1
+		ORDER BY o.name
+	`, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list organizations: %w", err)
+	}
+	defer rows.Close()
+
+	var organizations []*Organization
+	for rows.Next() {
+		var org Organization
+		err := rows.Scan(
+			&org.ID,
+			&org.Name,
+			&org.DataRegion,
+			&org.ComplianceMode,
+			pq.Array(&org.ComplianceCategories),
+			&org.RenewalApproval,
+			&org.CreatedAt,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan organization: %w", err)
+		}
+		organizations = append(organizations, &org)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("row iteration error: %w", err)
+	}
+	return organizations, nil
+}
+
+// UpdateOrganizationCompliance turns compliance mode on or off and sets the
+// document categories it covers.
+func (r *repository) UpdateOrganizationCompliance(ctx context.Context, organizationID string, enabled bool, categories []string) error {
+	if categories == nil {
+		categories = []string{}
+	}
+	result, err := r.db.DB.ExecContext(ctx, `
+		UPDATE organizations
+		SET compliance_mode = $2, compliance_categories = $3
+		WHERE id = $1
+	`, organizationID, enabled, pq.Array(categories))
+	if err != nil {
+		return fmt.Errorf("failed to update organization compliance: %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to get rows affected: %w", err)
+	}
+	if rowsAffected == 0 {
+		return fmt.Errorf("organization not found")
+	}
+	return nil
+}
+
+// IsComplianceDocument reports whether doc's reminders are mandatory: it
+// belongs to an organization in compliance mode and is in one of the
+// categories compliance covers.
+func (r *repository) IsComplianceDocument(ctx context.Context, doc *Document) (bool, error) {
+	if doc.OrganizationID == nil || doc.Category == nil {
+		return false, nil
+	}
+	org, err := r.GetOrganization(ctx, *doc.OrganizationID)
+	if err != nil {
+		return false, err
+	}
+	if !org.ComplianceMode {
+		return false, nil
+	}
+	for _, category := range org.ComplianceCategories {
+		if category == *doc.Category {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// UpdateOrganizationRenewalApproval turns the approval step for document
+// renewals on or off.
+func (r *repository) UpdateOrganizationRenewalApproval(ctx context.Context, organizationID string, required bool) error {
+	result, err := r.db.DB.ExecContext(ctx, `
+		UPDATE organizations SET renewal_approval = $2 WHERE id = $1
+	`, organizationID, required)
+	if err != nil {
+		return fmt.Errorf("failed to update organization renewal approval: %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to get rows affected: %w", err)
+	}
+	if rowsAffected == 0 {
+		return fmt.Errorf("organization not found")
+	}
+	return nil
+}
+
+// RenewalApprovalRequired reports whether renewing doc has to go through a
+// renewal request: it belongs to an organization that requires approval.
+func (r *repository) RenewalApprovalRequired(ctx context.Context, doc *Document) (bool, error) {
+	if doc.OrganizationID == nil {
+		return false, nil
+	}
+	org, err := r.GetOrganization(ctx, *doc.OrganizationID)
+	if err != nil {
+		return false, err
+	}
+	return org.RenewalApproval, nil
+}
+
+// ListPinnedDataRegions returns every data region some organization is
+// pinned to.
+func (r *repository) ListPinnedDataRegions(ctx context.Context) ([]string, error) {
+	rows, err := r.db.DB.QueryContext(ctx, `
+		SELECT DISTINCT data_region FROM organizations WHERE data_region IS NOT NULL
+	`)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list data regions: %w", err)
+	}
+	defer rows.Close()
+
+	var regions []string
+	for rows.Next() {
+		var region string
+		if err := rows.Scan(&region); err != nil {
+			return nil, fmt.Errorf("failed to scan data region: %w", err)
+		}
+		regions = append(regions, region)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("row iteration error: %w", err)
+	}
+	return regions, nil
+}
+
+func (r *repository) GetOrganizationMember(ctx context.Context, organizationID, userID string) (*OrganizationMember, error) {
+	var member OrganizationMember
+	err := r.db.DB.QueryRowContext(ctx, `
+		SELECT m.organization_id, m.user_id, u.name, u.email, m.role, m.scim_external_id, m.created_at
+		FROM organization_members m
+		JOIN users u ON u.id = m.user_id
+		WHERE m.organization_id = $1 AND m.user_id = $2
+	`, organizationID, userID).Scan(
+		&member.OrganizationID,
+		&member.UserID,
+		&member.Name,
+		&member.Email,
+		&member.Role,
+		&member.ExternalID,
+		&member.CreatedAt,
+	)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, fmt.Errorf("organization member not found")
+		}
+		return nil, fmt.Errorf("failed to get organization member: %w", err)
+	}
+	return &member, nil
+}
+
+// ListOrganizationMembers returns the people in the organization; its
+// service accounts are listed by ListServiceAccounts instead.
+func (r *repository) ListOrganizationMembers(ctx context.Context, organizationID string) ([]*OrganizationMember, error) {
+	rows, err := r.db.DB.QueryContext(ctx, `
+		SELECT m.organization_id, m.user_id, u.name, u.email, m.role, m.scim_external_id, m.created_at
+		FROM organization_members m
+		JOIN users u ON u.id = m.user_id
+		WHERE m.organization_id = $1
+			AND NOT EXISTS (SELECT 1 FROM service_accounts s WHERE s.user_id = m.user_id)
+		ORDER BY m.created_at
+	`, organizationID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list organization members: %w", err)
+	}
+	defer rows.Close()
+
+	var members []*OrganizationMember
+	for rows.Next() {
+		var member OrganizationMember
+		err := rows.Scan(
+			&member.OrganizationID,
+			&member.UserID,
+			&member.Name,
+			&member.Email,
+			&member.Role,
+			&member.ExternalID,
+			&member.CreatedAt,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan organization member: %w", err)
+		}
+		members = append(members, &member)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("row iteration error: %w", err)
+	}
+	return members, nil
+}
+
+// AddOrganizationMember adds userID to the organization, or changes their
+// role if they are already a member.
+func (r *repository) AddOrganizationMember(ctx context.Context, org *Organization, userID, role string) error {
+	_, err := r.db.DB.ExecContext(ctx, `
+		INSERT INTO organization_members (organization_id, user_id, role)
+		VALUES ($1, $2, $3)
+		ON CONFLICT (organization_id, user_id) DO UPDATE SET role = EXCLUDED.role
+	`, org.ID, userID, role)
+	if err != nil {
+		return fmt.Errorf("failed to add organization member: %w", err)
+	}
+	return r.mirrorUser(ctx, org, userID)
+}
+
+func (r *repository) RemoveOrganizationMember(ctx context.Context, organizationID, userID string) error {
+	result, err := r.db.DB.ExecContext(ctx, `
+		DELETE FROM organization_members WHERE organization_id = $1 AND user_id = $2
+	`, organizationID, userID)
+	if err != nil {
+		return fmt.Errorf("failed to remove organization member: %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to get rows affected: %w", err)
+	}
+	if rowsAffected == 0 {
+		return fmt.Errorf("organization member not found")
+	}
+	return nil
+}
+
+// mirrorUser copies a member's user row into their organization's regional
+// database so its documents can reference them. The copy carries no
+// password: sign-in always goes through the home database.
+func (r *repository) mirrorUser(ctx context.Context, org *Organization, userID string) error {
+	if org.DataRegion == nil {
+		return nil
+	}
+	regional, ok := r.db.regions[*org.DataRegion]
+	if !ok {
+		return fmt.Errorf("unknown data region")
+	}
+
+	user, err := r.GetUserByID(ctx, userID)
+	if err != nil {
+		return err
+	}
+	_, err = regional.DB.ExecContext(ctx, `
+		INSERT INTO users (id, email, password, phone_number, name)
+		VALUES ($1, $2, '', $3, $4)
+		ON CONFLICT (id) DO UPDATE
+		SET email = EXCLUDED.email, phone_number = EXCLUDED.phone_number, name = EXCLUDED.name
+	`, user.ID, user.Email, user.PhoneNumber, user.Name)
+	if err != nil {
+		return fmt.Errorf("failed to mirror user to data region: %w", err)
+	}
+	return nil
+}
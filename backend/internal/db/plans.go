@@ -0,0 +1,37 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+)
+
+// GetUserPlan returns the plan the user is on.
+func (r *repository) GetUserPlan(ctx context.Context, userID string) (string, error) {
+	var plan string
+	err := r.db.DB.QueryRowContext(ctx, `SELECT plan FROM users WHERE id = $1`, userID).Scan(&plan)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return "", fmt.Errorf("user does not exist")
+		}
+		return "", fmt.Errorf("failed to get user plan: %w", err)
+	}
+	return plan, nil
+}
+
+// SetUserPlan moves the user to plan.
+func (r *repository) SetUserPlan(ctx context.Context, userID, plan string) error {
+	result, err := r.db.DB.ExecContext(ctx, `UPDATE users SET plan = $1, updated_at = NOW() WHERE id = $2`, plan, userID)
+	if err != nil {
+		return fmt.Errorf("failed to set user plan: %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to get rows affected: %w", err)
+	}
+	if rowsAffected == 0 {
+		return fmt.Errorf("user does not exist")
+	}
+	return nil
+}
@@ -0,0 +1,90 @@
+package db
+
+import (
+	"context"
+	"fmt"
+)
+
+// ListDocumentPositions returns the documents userID pinned or placed.
+func (r *repository) ListDocumentPositions(ctx context.Context, userID string) ([]*DocumentPosition, error) {
+	query := `
+		SELECT document_id, pinned, position
+		FROM document_positions
+		WHERE user_id = $1
+	`
+	rows, err := r.readConn(ctx).QueryContext(ctx, query, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list document positions: %w", err)
+	}
+	defer rows.Close()
+
+	var positions []*DocumentPosition
+	for rows.Next() {
+		var position DocumentPosition
+		if err := rows.Scan(&position.DocumentID, &position.Pinned, &position.Position); err != nil {
+			return nil, fmt.Errorf("failed to scan document position: %w", err)
+		}
+		positions = append(positions, &position)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("row iteration error: %w", err)
+	}
+
+	return positions, nil
+}
+
+// SetDocumentPinned pins or unpins a document for userID, keeping its
+// position.
+func (r *repository) SetDocumentPinned(ctx context.Context, userID, documentID string, pinned bool) error {
+	_, err := r.conn(ctx).ExecContext(ctx, `
+		INSERT INTO document_positions (user_id, document_id, pinned, updated_at)
+		VALUES ($1, $2, $3, NOW())
+		ON CONFLICT (user_id, document_id) DO UPDATE
+		SET pinned = EXCLUDED.pinned,
+			updated_at = EXCLUDED.updated_at
+	`, userID, documentID, pinned)
+	if err != nil {
+		return fmt.Errorf("failed to pin document: %w", err)
+	}
+	return nil
+}
+
+// SetDocumentOrder places documentIDs in that order for userID. Documents
+// of the organization in ctx, or personal ones outside one, that are left
+// out lose their position, and with it their place among the others; pins
+// are kept.
+func (r *repository) SetDocumentOrder(ctx context.Context, userID string, documentIDs []string) error {
+	tx, err := r.conn(ctx).BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	if _, err := tx.ExecContext(ctx, `
+		UPDATE document_positions SET position = NULL, updated_at = NOW()
+		WHERE user_id = $1 AND document_id IN (
+			SELECT id FROM documents WHERE organization_id IS NOT DISTINCT FROM $2::uuid
+		)
+	`, userID, organizationFilter(ctx)); err != nil {
+		return fmt.Errorf("failed to clear document order: %w", err)
+	}
+
+	for position, documentID := range documentIDs {
+		_, err := tx.ExecContext(ctx, `
+			INSERT INTO document_positions (user_id, document_id, position, updated_at)
+			VALUES ($1, $2, $3, NOW())
+			ON CONFLICT (user_id, document_id) DO UPDATE
+			SET position = EXCLUDED.position,
+				updated_at = EXCLUDED.updated_at
+		`, userID, documentID, position)
+		if err != nil {
+			return fmt.Errorf("failed to set document order: %w", err)
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+	return nil
+}
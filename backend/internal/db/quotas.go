@@ -0,0 +1,42 @@
+package db
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+// CountSMSSent returns how many text messages went out to recipientID since
+// since. A batched message counts once; failed sends do not count.
+func (r *repository) CountSMSSent(ctx context.Context, recipientID string, since time.Time) (int, error) {
+	var count int
+	err := r.readConn(ctx).QueryRowContext(ctx, `
+		SELECT COUNT(DISTINCT message_id)
+		FROM notification_logs
+		WHERE recipient_id = $1 AND channel = $2 AND status IN ('sent', 'dry_run') AND created_at >= $3
+	`, recipientID, ChannelSMS, since).Scan(&count)
+	if err != nil {
+		return 0, fmt.Errorf("failed to count sent text messages: %w", err)
+	}
+	return count, nil
+}
+
+// ClaimQuotaWarning records that userID is warned about nearing quota in
+// month. It returns false when they already were, so each quota warns once
+// a month.
+func (r *repository) ClaimQuotaWarning(ctx context.Context, userID, quota, month string) (bool, error) {
+	result, err := r.db.DB.ExecContext(ctx, `
+		INSERT INTO quota_warnings (user_id, quota, month)
+		VALUES ($1, $2, $3)
+		ON CONFLICT (user_id, quota, month) DO NOTHING
+	`, userID, quota, month)
+	if err != nil {
+		return false, fmt.Errorf("failed to claim quota warning: %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return false, fmt.Errorf("failed to get rows affected: %w", err)
+	}
+	return rowsAffected > 0, nil
+}
@@ -0,0 +1,54 @@
+package db
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/lib/pq"
+)
+
+// ReminderTransitions lists, for each status a document reminder can be
+// moved to by TransitionDocumentReminder, the statuses it can be moved from.
+// ToggleDocumentReminder and ResetDocumentReminders schedule and cancel
+// reminders whatever their status.
+var ReminderTransitions = map[string][]string{
+	ReminderQueued:       {ReminderScheduled, ReminderSnoozed, ReminderQueued},
+	ReminderSent:         {ReminderScheduled, ReminderQueued, ReminderSnoozed, ReminderFailed, ReminderSent},
+	ReminderFailed:       {ReminderScheduled, ReminderQueued, ReminderSnoozed},
+	ReminderAcknowledged: {ReminderSent},
+	ReminderSnoozed:      {ReminderSent, ReminderAcknowledged, ReminderSnoozed},
+}
+
+// TransitionDocumentReminder moves the document's reminder for
+// reminderIntervalID to status, if ReminderTransitions allows it from the
+// status it is in. It reports whether the reminder moved; one canceled or
+// acknowledged meanwhile, say, stays as it is.
+func (r *repository) TransitionDocumentReminder(ctx context.Context, documentID string, reminderIntervalID int, status string) (bool, error) {
+	from, ok := ReminderTransitions[status]
+	if !ok {
+		return false, fmt.Errorf("no transition to reminder status %q", status)
+	}
+
+	query := `
+		UPDATE document_reminders
+		SET status = $1, status_changed_at = NOW()
+		WHERE document_id = $2 AND reminder_interval_id = $3 AND status = ANY($4::text[])
+	`
+	if status == ReminderAcknowledged {
+		query = `
+			UPDATE document_reminders
+			SET status = $1, status_changed_at = NOW(), acknowledged_at = NOW()
+			WHERE document_id = $2 AND reminder_interval_id = $3 AND status = ANY($4::text[])
+		`
+	}
+	result, err := r.conn(ctx).ExecContext(ctx, query, status, documentID, reminderIntervalID, pq.Array(from))
+	if err != nil {
+		return false, fmt.Errorf("failed to transition document reminder: %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return false, fmt.Errorf("failed to get rows affected: %w", err)
+	}
+	return rowsAffected > 0, nil
+}
@@ -0,0 +1,110 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/lib/pq"
+)
+
+const renewalRequestColumns = `id, document_id, requested_by, expiration_date, evidence_url, status, decided_by, decided_at, note, created_at`
+
+func scanRenewalRequest(row interface{ Scan(...interface{}) error }) (*RenewalRequest, error) {
+	var request RenewalRequest
+	err := row.Scan(
+		&request.ID,
+		&request.DocumentID,
+		&request.RequestedBy,
+		&request.ExpirationDate,
+		&request.EvidenceURL,
+		&request.Status,
+		&request.DecidedBy,
+		&request.DecidedAt,
+		&request.Note,
+		&request.CreatedAt,
+	)
+	return &request, err
+}
+
+// CreateRenewalRequest records a proposed renewal. A document has at most one
+// pending request at a time.
+func (r *repository) CreateRenewalRequest(ctx context.Context, request *RenewalRequest) error {
+	err := r.conn(ctx).QueryRowContext(ctx, `
+		INSERT INTO renewal_requests (id, document_id, requested_by, expiration_date, evidence_url, status)
+		VALUES ($1, $2, $3, $4, $5, $6)
+		RETURNING created_at
+	`, request.ID, request.DocumentID, request.RequestedBy, request.ExpirationDate, request.EvidenceURL, RenewalPending).Scan(&request.CreatedAt)
+	if err != nil {
+		if pqErr, ok := err.(*pq.Error); ok && pqErr.Code == "23505" {
+			return fmt.Errorf("document already has a pending renewal request")
+		}
+		return fmt.Errorf("failed to create renewal request: %w", err)
+	}
+	request.Status = RenewalPending
+	return nil
+}
+
+func (r *repository) GetRenewalRequest(ctx context.Context, documentID, requestID string) (*RenewalRequest, error) {
+	request, err := scanRenewalRequest(r.conn(ctx).QueryRowContext(ctx, `
+		SELECT `+renewalRequestColumns+`
+		FROM renewal_requests
+		WHERE id = $1 AND document_id = $2
+	`, requestID, documentID))
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, fmt.Errorf("renewal request not found")
+		}
+		return nil, fmt.Errorf("failed to get renewal request: %w", err)
+	}
+	return request, nil
+}
+
+// ListRenewalRequests returns a document's renewal requests, newest first.
+func (r *repository) ListRenewalRequests(ctx context.Context, documentID string) ([]*RenewalRequest, error) {
+	rows, err := r.readConn(ctx).QueryContext(ctx, `
+		SELECT `+renewalRequestColumns+`
+		FROM renewal_requests
+		WHERE document_id = $1
+		ORDER BY created_at DESC
+	`, documentID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list renewal requests: %w", err)
+	}
+	defer rows.Close()
+
+	var requests []*RenewalRequest
+	for rows.Next() {
+		request, err := scanRenewalRequest(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan renewal request: %w", err)
+		}
+		requests = append(requests, request)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to iterate renewal requests: %w", err)
+	}
+	return requests, nil
+}
+
+// DecideRenewalRequest approves or rejects a pending renewal request. It
+// fails if the request was already decided, so only one decision wins.
+func (r *repository) DecideRenewalRequest(ctx context.Context, requestID, status, decidedBy string, note *string) error {
+	result, err := r.conn(ctx).ExecContext(ctx, `
+		UPDATE renewal_requests
+		SET status = $2, decided_by = $3, decided_at = NOW(), note = $4
+		WHERE id = $1 AND status = $5
+	`, requestID, status, decidedBy, note, RenewalPending)
+	if err != nil {
+		return fmt.Errorf("failed to decide renewal request: %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to get rows affected: %w", err)
+	}
+	if rowsAffected == 0 {
+		return fmt.Errorf("renewal request is not pending")
+	}
+	return nil
+}
@@ -3,12 +3,33 @@ package db
 import (
 	"context"
 	"database/sql"
+	"encoding/json"
 	"fmt"
+	"time"
 
+	"github.com/google/uuid"
 	"github.com/lib/pq"
+
+	"xpired/internal/db/sqlcdb"
 )
 
+//go:generate mockgen -source=repository.go -destination=mocks/repository.go -package=mocks
+
+// Repository is the whole data access layer. Components that only need part
+// of it should depend on one of the narrower interfaces it is composed of, so
+// tests can mock just that part; mocks live in internal/db/mocks.
 type Repository interface {
+	UserRepository
+	DocumentRepository
+	ReminderRepository
+	WebhookRepository
+	AnnouncementRepository
+	JobRepository
+}
+
+// UserRepository stores accounts and the groups users belong to: households
+// and organizations.
+type UserRepository interface {
 	CreateUser(ctx context.Context, user *User) error
 	CheckUserExistsByEmail(ctx context.Context, email string) error
 	CheckUserExistsById(ctx context.Context, userID string) error
@@ -16,17 +37,286 @@ type Repository interface {
 	GetUserByEmail(ctx context.Context, email string) (*User, error)
 	GetUserEmail(ctx context.Context, userID string) (string, error)
 	GetUserPhoneNumber(ctx context.Context, userID string) (string, error)
+	GetUserByPhoneNumber(ctx context.Context, phoneNumber string) (*User, error)
+	ListUsersAfter(ctx context.Context, afterID uuid.UUID, limit int) ([]*User, error)
+	SuspendUser(ctx context.Context, userID, reason string) error
+	ReinstateUser(ctx context.Context, userID string) error
+	IsUserSuspended(ctx context.Context, userID string) (bool, error)
+	DeactivateUser(ctx context.Context, userID string) error
+	IsUserDeactivated(ctx context.Context, userID string) (bool, error)
+	GetUserPlan(ctx context.Context, userID string) (string, error)
+	SetUserPlan(ctx context.Context, userID, plan string) error
+	GetUserRole(ctx context.Context, userID string) (string, error)
+	SetUserRole(ctx context.Context, userID, role string) error
+	GetPendingEmail(ctx context.Context, userID string) (*string, error)
+	SetPendingEmail(ctx context.Context, userID, email string) error
+	ConfirmEmailChange(ctx context.Context, userID, email string) error
+	ClaimQuotaWarning(ctx context.Context, userID, quota, month string) (bool, error)
+	GetUserIDByProvider(ctx context.Context, provider, providerID string) (string, error)
+	LinkUserProvider(ctx context.Context, userID, provider, providerID string) error
+	ClearUserPassword(ctx context.Context, userID string) error
+	GetLocalePreferences(ctx context.Context, userID string) (*LocalePreferences, error)
+	SetLocalePreferences(ctx context.Context, userID string, prefs *LocalePreferences) error
+
+	GetFeedToken(ctx context.Context, userID string) (string, error)
+	SetFeedToken(ctx context.Context, userID, token string) error
+	DeleteFeedToken(ctx context.Context, userID string) error
+	GetUserIDByFeedToken(ctx context.Context, token string) (string, error)
+
+	CreateSession(ctx context.Context, session *Session) error
+	ListSessions(ctx context.Context, userID string) ([]*Session, error)
+	IsSessionRevoked(ctx context.Context, sessionID string) (bool, error)
+	RevokeSession(ctx context.Context, userID, sessionID string) (*Session, error)
+	RevokeSessions(ctx context.Context, userID, exceptID string) ([]*Session, error)
+	CreateAPIKey(ctx context.Context, key *APIKey) error
+	ListAPIKeys(ctx context.Context, userID string) ([]*APIKey, error)
+	DeleteAPIKey(ctx context.Context, userID, keyID string) error
+	AuthenticateAPIKey(ctx context.Context, keyHash string) (string, []string, error)
+
+	CreateHousehold(ctx context.Context, household *Household) error
+	GetHouseholdByUserID(ctx context.Context, userID string) (*Household, error)
+	GetHouseholdMember(ctx context.Context, userID string) (*HouseholdMember, error)
+	ListHouseholdMembers(ctx context.Context, householdID string) ([]*HouseholdMember, error)
+	AddHouseholdMember(ctx context.Context, member *HouseholdMember) error
+	UpdateHouseholdMemberRouting(ctx context.Context, householdID, userID, routing string) error
+	RemoveHouseholdMember(ctx context.Context, householdID, userID string) error
+	IsHouseholdPrimaryOf(ctx context.Context, primaryUserID, memberUserID string) (bool, error)
+
+	CreateOrganization(ctx context.Context, org *Organization, ownerID string) error
+	GetOrganization(ctx context.Context, organizationID string) (*Organization, error)
+	ListOrganizationsByUserID(ctx context.Context, userID string) ([]*Organization, error)
+	ListPinnedDataRegions(ctx context.Context) ([]string, error)
+	GetOrganizationMember(ctx context.Context, organizationID, userID string) (*OrganizationMember, error)
+	ListOrganizationMembers(ctx context.Context, organizationID string) ([]*OrganizationMember, error)
+	AddOrganizationMember(ctx context.Context, org *Organization, userID, role string) error
+	RemoveOrganizationMember(ctx context.Context, organizationID, userID string) error
+	UpdateOrganizationCompliance(ctx context.Context, organizationID string, enabled bool, categories []string) error
+	IsComplianceDocument(ctx context.Context, doc *Document) (bool, error)
+	UpdateOrganizationRenewalApproval(ctx context.Context, organizationID string, required bool) error
+	RenewalApprovalRequired(ctx context.Context, doc *Document) (bool, error)
+	SetSCIMToken(ctx context.Context, organizationID, tokenHash string) error
+	DeleteSCIMToken(ctx context.Context, organizationID string) error
+	GetOrganizationIDBySCIMToken(ctx context.Context, tokenHash string) (string, error)
+	CreateServiceAccount(ctx context.Context, account *ServiceAccount) error
+	ListServiceAccounts(ctx context.Context, organizationID string) ([]*ServiceAccount, error)
+	GetServiceAccount(ctx context.Context, organizationID, accountID string) (*ServiceAccount, error)
+	GetServiceAccountByUserID(ctx context.Context, userID string) (*ServiceAccount, error)
+	RotateServiceAccountToken(ctx context.Context, key *APIKey) error
+	RevokeServiceAccountToken(ctx context.Context, userID string) error
+	SetOrganizationMemberExternalID(ctx context.Context, organizationID, userID string, externalID *string) error
+	GetEscalationPolicy(ctx context.Context, organizationID string) ([]*EscalationStep, error)
+	SetEscalationPolicy(ctx context.Context, organizationID string, steps []*EscalationStep) error
+	GetOrganizationRetention(ctx context.Context, organizationID string) (*OrganizationRetention, error)
+	SetOrganizationRetention(ctx context.Context, retention *OrganizationRetention) error
+	ListOrganizationRetention(ctx context.Context) ([]*OrganizationRetention, error)
+}
+
+// DocumentRepository stores documents and the data attached to them. Its
+// methods use the data region of the tenant in ctx.
+type DocumentRepository interface {
+	DataRegions() []string
+
 	CreateDocument(ctx context.Context, document *Document) error
 	GetDocumentByID(ctx context.Context, documentID string) (*Document, error)
+	GetDocumentStats(ctx context.Context, userID string) (*DocumentStats, error)
+	ListRenewalCosts(ctx context.Context, userID string, from, to time.Time) ([]*RenewalCostTotal, error)
 	UpdateDocument(ctx context.Context, document *Document) error
 	DeleteDocument(ctx context.Context, documentID string) error
 	ListDocumentsByUserID(ctx context.Context, userID string) ([]*Document, error)
+	FindDocumentsByIdentifier(ctx context.Context, userID, identifier string) ([]*Document, error)
+	FindSimilarDocuments(ctx context.Context, userID, name string, identifier *string, minSimilarity float64, limit int) ([]*Document, error)
+	EncryptPlaintextIdentifiers(ctx context.Context) (int, error)
+	ListExpiringDocuments(ctx context.Context, from, to time.Time) ([]*ExpiringDocument, error)
+	RefreshExpiringDocuments(ctx context.Context) error
+
+	AcquireDocumentLock(ctx context.Context, documentID, userID string, expiresAt time.Time) (*DocumentLock, error)
+	GetDocumentLock(ctx context.Context, documentID string) (*DocumentLock, error)
+	ReleaseDocumentLock(ctx context.Context, documentID string) error
+	AssignDocument(ctx context.Context, documentID, assigneeID, assignedBy string) error
+	GetDocumentAssignment(ctx context.Context, documentID string) (*DocumentAssignment, error)
+	UnassignDocument(ctx context.Context, documentID string) error
+	AcknowledgeDocumentAssignment(ctx context.Context, documentID, assigneeID string) error
+	CreateRenewalRequest(ctx context.Context, request *RenewalRequest) error
+	GetRenewalRequest(ctx context.Context, documentID, requestID string) (*RenewalRequest, error)
+	ListRenewalRequests(ctx context.Context, documentID string) ([]*RenewalRequest, error)
+	DecideRenewalRequest(ctx context.Context, requestID, status, decidedBy string, note *string) error
+
+	ListTrashedDocuments(ctx context.Context, userID string) ([]*Document, error)
+	GetTrashedDocument(ctx context.Context, documentID string) (*Document, error)
+	RestoreDocument(ctx context.Context, documentID string) error
+	ListDocumentsTrashedBefore(ctx context.Context, cutoff time.Time, limit int) ([]*Document, error)
+	PurgeDocument(ctx context.Context, documentID string) error
+	ListDeletedAccountDocuments(ctx context.Context, cutoff time.Time, limit int) ([]*Document, error)
+	ListOrganizationDocumentsExpiredBefore(ctx context.Context, organizationID string, cutoff time.Time, limit int) ([]*Document, error)
+	GetLegalHold(ctx context.Context, organizationID string, documentID *string) (*LegalHold, error)
+	PlaceLegalHold(ctx context.Context, hold *LegalHold) (bool, error)
+	RemoveLegalHold(ctx context.Context, organizationID string, documentID *string) (bool, error)
+	UnderLegalHold(ctx context.Context, doc *Document) (bool, error)
+	ListLegalHolds(ctx context.Context, organizationID string) ([]*LegalHold, error)
+	CreateAuditLog(ctx context.Context, entry *AuditLog) error
+
+	SetAttachmentStatus(ctx context.Context, documentID, attachmentURL, status string) error
+	QuarantineAttachment(ctx context.Context, documentID, attachmentURL, threat string) error
+	GetAttachmentBlob(ctx context.Context, userID, checksum string) (*AttachmentBlob, error)
+	CreateAttachmentBlob(ctx context.Context, blob *AttachmentBlob) (string, error)
+	ReleaseAttachmentBlob(ctx context.Context, url, documentID string) (bool, error)
+	ListDocumentsWithAttachmentsExpiredBefore(ctx context.Context, before time.Time, afterID string, limit int) ([]*Document, error)
+	GetAttachmentRetentionWarning(ctx context.Context, documentID string) (*AttachmentRetentionWarning, error)
+	CreateAttachmentRetentionWarning(ctx context.Context, warning *AttachmentRetentionWarning) error
+	DeleteAttachmentRetentionWarning(ctx context.Context, documentID string) error
+	RemoveAttachment(ctx context.Context, documentID, attachmentURL string) error
+
+	CreateDocumentContact(ctx context.Context, contact *DocumentContact) error
+	ListDocumentContacts(ctx context.Context, documentID string) ([]*DocumentContact, error)
+	DeleteDocumentContact(ctx context.Context, documentID, contactID string) error
+	GetDocumentContactByUnsubscribeToken(ctx context.Context, token string) (*DocumentContact, error)
+	UnsubscribeDocumentContact(ctx context.Context, token string) (*DocumentContact, error)
+
+	ListChecklistItems(ctx context.Context, documentID string) ([]*ChecklistItem, error)
+	GetChecklistItem(ctx context.Context, documentID, itemID string) (*ChecklistItem, error)
+	CreateChecklistItem(ctx context.Context, item *ChecklistItem) error
+	UpdateChecklistItem(ctx context.Context, item *ChecklistItem) error
+	DeleteChecklistItem(ctx context.Context, documentID, itemID string) error
+
+	AddDocumentDependency(ctx context.Context, documentID, dependsOnID string) error
+	RemoveDocumentDependency(ctx context.Context, documentID, dependsOnID string) error
+	ListDocumentDependencies(ctx context.Context, documentID string) ([]*Document, error)
+	ListDocumentDependents(ctx context.Context, documentID string) ([]*Document, error)
+	ListDocumentDependencyEdges(ctx context.Context, documentIDs []string) ([]*DocumentDependency, error)
+
+	ListDocumentCategories(ctx context.Context) ([]*DocumentCategory, error)
+	GetDocumentCategory(ctx context.Context, slug string) (*DocumentCategory, error)
+	GetValidityPeriod(ctx context.Context, categorySlug, countryCode string) (*ValidityPeriod, error)
+
+	ListDocumentTemplates(ctx context.Context) ([]*DocumentTemplate, error)
+	GetDocumentTemplate(ctx context.Context, slug string) (*DocumentTemplate, error)
+	ListDocumentCustomFields(ctx context.Context, documentID string) ([]*CustomField, error)
+	SetDocumentCustomFields(ctx context.Context, documentID string, fields []*CustomField) error
+
+	MarkSampleDocument(ctx context.Context, userID, documentID string) error
+	ListSampleDocumentIDs(ctx context.Context, userID string) ([]string, error)
+
+	ListDocumentPositions(ctx context.Context, userID string) ([]*DocumentPosition, error)
+	SetDocumentPinned(ctx context.Context, userID, documentID string, pinned bool) error
+	SetDocumentOrder(ctx context.Context, userID string, documentIDs []string) error
+
+	CreateSavedView(ctx context.Context, view *SavedView) error
+	GetSavedView(ctx context.Context, viewID string) (*SavedView, error)
+	ListSavedViews(ctx context.Context, userID string) ([]*SavedView, error)
+	UpdateSavedView(ctx context.Context, view *SavedView) error
+	DeleteSavedView(ctx context.Context, viewID string) error
+
+	CreateFeedback(ctx context.Context, feedback *Feedback) error
+	GetFeedback(ctx context.Context, feedbackID string) (*Feedback, error)
+	MarkFeedbackRelayed(ctx context.Context, feedbackID string) error
+
+	ListRoadmapFeatures(ctx context.Context, userID string) ([]*RoadmapFeature, error)
+	GetRoadmapFeature(ctx context.Context, featureID, userID string) (*RoadmapFeature, error)
+	CreateRoadmapFeature(ctx context.Context, feature *RoadmapFeature) error
+	UpdateRoadmapFeature(ctx context.Context, feature *RoadmapFeature) error
+	DeleteRoadmapFeature(ctx context.Context, featureID string) error
+	AddRoadmapVote(ctx context.Context, featureID, userID string) error
+	RemoveRoadmapVote(ctx context.Context, featureID, userID string) error
+
+	ListIssuers(ctx context.Context, countryCode string) ([]*Issuer, error)
+	GetIssuer(ctx context.Context, issuerID string) (*Issuer, error)
+	CreateIssuer(ctx context.Context, issuer *Issuer) error
+	UpdateIssuer(ctx context.Context, issuer *Issuer) error
+	DeleteIssuer(ctx context.Context, issuerID string) error
+}
+
+// ReminderRepository stores reminder schedules, notification preferences and
+// the log of notifications sent.
+type ReminderRepository interface {
 	GetAllReminderIntervals(ctx context.Context) ([]*ReminderInterval, error)
 	GetReminderIntervalsFromIdLabels(ctx context.Context, idLabels []string) ([]*ReminderInterval, error)
 	GetReminderIntervalByID(ctx context.Context, id int) (*ReminderInterval, error)
 	SetDocumentReminders(ctx context.Context, documentID string, reminder *DocumentReminder) error
 	ToggleDocumentReminder(ctx context.Context, documentID string, reminderIntervalID int, enabled bool) error
 	GetDocumentRemindersByDocumentID(ctx context.Context, documentID string) ([]*DocumentReminder, error)
+	MarkDocumentReminderSent(ctx context.Context, documentID string, reminderIntervalID int) error
+	ResetDocumentReminders(ctx context.Context, documentID string) error
+	TransitionDocumentReminder(ctx context.Context, documentID string, reminderIntervalID int, status string) (bool, error)
+	ClaimLeadTimeReminder(ctx context.Context, documentID string, expirationDate time.Time) (bool, error)
+	ClaimActionLink(ctx context.Context, jti string, expiresAt time.Time) (bool, error)
+	ReleaseActionLink(ctx context.Context, jti string) error
+	IsActionLinkUsed(ctx context.Context, jti string) (bool, error)
+
+	CreateNotificationLog(ctx context.Context, log *NotificationLog) error
+	GetLatestNotificationLog(ctx context.Context, userID, channel string) (*NotificationLog, error)
+	ListNotificationLogsByMessageID(ctx context.Context, messageID string) ([]*NotificationLog, error)
+	ListNotificationLogs(ctx context.Context, userID, documentID string, limit int) ([]*NotificationLog, error)
+	CountUnreadNotifications(ctx context.Context, userID string) (int, error)
+	ListEmailOpenTimes(ctx context.Context, recipientID string, since time.Time, limit int) ([]time.Time, error)
+	CountSMSSent(ctx context.Context, recipientID string, since time.Time) (int, error)
+	MarkNotificationsRead(ctx context.Context, userID string) (int64, error)
+	MarkNotificationOpened(ctx context.Context, messageID string) error
+	MarkNotificationBounced(ctx context.Context, messageID string) error
+	MarkNotificationEscalated(ctx context.Context, messageID string) (bool, error)
+	CountNotificationOutcomes(ctx context.Context, since time.Time) (*NotificationStats, error)
+	CountNotificationsByUser(ctx context.Context, since, recentSince time.Time, minRecent int) ([]*NotificationVolume, error)
+
+	AppendEvent(ctx context.Context, entry *EventLogEntry) error
+	ListEvents(ctx context.Context, organizationID string) ([]*EventLogEntry, error)
+	GetEventLogAnchor(ctx context.Context, organizationID string) (int64, string, error)
+	PruneEventsBefore(ctx context.Context, organizationID string, cutoff time.Time) (int64, error)
+	DeleteOrganizationNotificationsBefore(ctx context.Context, organizationID string, cutoff time.Time) (int64, error)
+	DeleteOrganizationAuditLogsBefore(ctx context.Context, organizationID string, cutoff time.Time, keepDocumentIDs []string) (int64, error)
+	MeterOrganizationUsage(ctx context.Context, month time.Time) error
+	ListOrganizationUsage(ctx context.Context, organizationID, from, to string) ([]*OrganizationUsage, error)
+
+	GetNotificationPreferences(ctx context.Context, userID string) (*NotificationPreferences, error)
+	UpsertNotificationPreferences(ctx context.Context, prefs *NotificationPreferences) error
+	HoldReminder(ctx context.Context, userID, documentID string, intervalID int, requestID string) (bool, error)
+	TakeHeldReminders(ctx context.Context, userID string) ([]*HeldReminder, error)
+
+	SetEmailSender(ctx context.Context, sender *EmailSender) error
+	GetEmailSender(ctx context.Context, organizationID string) (*EmailSender, error)
+	DeleteEmailSender(ctx context.Context, organizationID string) error
+	RecordEmailSenderCheck(ctx context.Context, organizationID, token string, verified bool) error
+	GetNotificationTheme(ctx context.Context, organizationID string) (*NotificationTheme, error)
+	SetNotificationTheme(ctx context.Context, theme *NotificationTheme) error
+	DeleteNotificationTheme(ctx context.Context, organizationID string) error
+}
+
+// WebhookRepository stores outbound webhook endpoints and their deliveries.
+type WebhookRepository interface {
+	CreateWebhookEndpoint(ctx context.Context, endpoint *WebhookEndpoint) error
+	GetWebhookEndpoint(ctx context.Context, endpointID string) (*WebhookEndpoint, error)
+	ListWebhookEndpoints(ctx context.Context, userID string) ([]*WebhookEndpoint, error)
+	ListWebhookEndpointsForEvent(ctx context.Context, userID, event string) ([]*WebhookEndpoint, error)
+	UpdateWebhookEndpoint(ctx context.Context, endpoint *WebhookEndpoint) error
+	RotateWebhookSecret(ctx context.Context, endpointID, secret string, previousExpiresAt time.Time) error
+	DeleteWebhookEndpoint(ctx context.Context, endpointID string) error
+	CreateWebhookDelivery(ctx context.Context, delivery *WebhookDelivery) error
+	GetWebhookDelivery(ctx context.Context, deliveryID string) (*WebhookDelivery, error)
+	ListWebhookDeliveries(ctx context.Context, endpointID string, limit int) ([]*WebhookDelivery, error)
+	RecordWebhookDeliveryAttempt(ctx context.Context, delivery *WebhookDelivery) error
+}
+
+// AnnouncementRepository stores the notices admins publish to every user.
+type AnnouncementRepository interface {
+	CreateAnnouncement(ctx context.Context, announcement *Announcement) error
+	GetAnnouncement(ctx context.Context, announcementID string) (*Announcement, error)
+	ListAnnouncements(ctx context.Context) ([]*Announcement, error)
+	ListActiveAnnouncements(ctx context.Context) ([]*Announcement, error)
+	DeleteAnnouncement(ctx context.Context, announcementID string) error
+	ClaimAnnouncementEmail(ctx context.Context, announcementID string) (bool, error)
+}
+
+// JobRepository stores the long-running operations users start through the
+// API.
+type JobRepository interface {
+	CreateJob(ctx context.Context, job *Job) error
+	GetJob(ctx context.Context, jobID string) (*Job, error)
+	ListJobs(ctx context.Context, userID string, limit int) ([]*Job, error)
+	StartJob(ctx context.Context, jobID string) (bool, error)
+	FinishJob(ctx context.Context, job *Job) error
+	CreateImportChunks(ctx context.Context, jobID string, params json.RawMessage, chunks []json.RawMessage, total int) error
+	GetImportChunk(ctx context.Context, jobID string, chunk int) (*ImportChunk, error)
+	NextImportChunk(ctx context.Context, jobID string) (*ImportChunk, error)
+	CompleteImportChunk(ctx context.Context, jobID string, chunk int, result json.RawMessage, rows int) (bool, error)
+	ListImportChunkResults(ctx context.Context, jobID string) ([]json.RawMessage, error)
 }
 
 type repository struct {
@@ -37,34 +327,42 @@ func NewRepository(db *DB) Repository {
 	return &repository{db: db}
 }
 
-func (r *repository) CreateUser(ctx context.Context, user *User) error {
-	query := `
-		INSERT INTO users (id, email, password, phone_number, name)
-		VALUES ($1, $2, $3, $4, $5)
-		RETURNING created_at, updated_at
-	`
-	err := r.db.DB.QueryRow(
-		query,
-		user.ID,
-		user.Email,
-		user.Password,
-		user.PhoneNumber,
-		user.Name,
-	).Scan(
-		&user.CreatedAt, &user.UpdatedAt,
-	)
+// queries returns the sqlc-generated queries for the home database. Use
+// documentQueries for tables routed by data region.
+func (r *repository) queries() *sqlcdb.Queries {
+	return sqlcdb.New(r.db.DB)
+}
+
+// documentQueries returns the sqlc-generated queries for the database holding
+// the document data of the tenant in ctx.
+func (r *repository) documentQueries(ctx context.Context) *sqlcdb.Queries {
+	return sqlcdb.New(r.conn(ctx))
+}
+
+// readDocumentQueries is documentQueries for list and search queries, which
+// run on the read replica when one is configured.
+func (r *repository) readDocumentQueries(ctx context.Context) *sqlcdb.Queries {
+	return sqlcdb.New(r.readConn(ctx))
+}
 
+func (r *repository) CreateUser(ctx context.Context, user *User) error {
+	row, err := r.queries().CreateUser(ctx, sqlcdb.CreateUserParams{
+		ID:          user.ID,
+		Email:       user.Email,
+		Password:    user.Password,
+		PhoneNumber: user.PhoneNumber,
+		Name:        user.Name,
+	})
 	if err != nil {
 		return fmt.Errorf("failed to create user: %w", err)
 	}
 
+	user.CreatedAt, user.UpdatedAt = row.CreatedAt, row.UpdatedAt
 	return nil
 }
 
 func (r *repository) CheckUserExistsByEmail(ctx context.Context, email string) error {
-	var userEmail string
-	query := `SELECT id FROM users WHERE email = $1`
-	err := r.db.DB.QueryRowContext(ctx, query, email).Scan(&userEmail)
+	_, err := r.queries().GetUserIDByEmail(ctx, email)
 	if err != nil {
 		if err == sql.ErrNoRows {
 			return fmt.Errorf("user does not exist")
@@ -75,64 +373,46 @@ func (r *repository) CheckUserExistsByEmail(ctx context.Context, email string) e
 }
 
 func (r *repository) CheckUserExistsById(ctx context.Context, userID string) error {
-	var id string
-	query := `SELECT id FROM users WHERE id = $1`
-	err := r.db.DB.QueryRowContext(ctx, query, userID).Scan(&id)
+	id, err := uuid.Parse(userID)
+	if err != nil {
+		return fmt.Errorf("user does not exist")
+	}
+	exists, err := r.queries().UserExists(ctx, id)
 	if err != nil {
-		if err == sql.ErrNoRows {
-			return fmt.Errorf("user does not exist")
-		}
 		return fmt.Errorf("failed to check user: %w", err)
 	}
+	if !exists {
+		return fmt.Errorf("user does not exist")
+	}
 	return nil
 }
 
 func (r *repository) GetUserByID(ctx context.Context, userID string) (*User, error) {
-	query := `
-		SELECT id, email, password, phone_number, name, created_at, updated_at FROM users WHERE id = $1
-	`
-	row := r.db.DB.QueryRow(query, userID)
-	var user User
-	err := row.Scan(
-		&user.ID,
-		&user.Email,
-		&user.Password,
-		&user.PhoneNumber,
-		&user.Name,
-		&user.CreatedAt,
-		&user.UpdatedAt,
-	)
+	id, err := uuid.Parse(userID)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get user by ID: %w", err)
 	}
-	return &user, nil
+	row, err := r.queries().GetUserByID(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get user by ID: %w", err)
+	}
+	return userFromRow(row), nil
 }
 
 func (r *repository) GetUserByEmail(ctx context.Context, email string) (*User, error) {
-	query := `
-		SELECT id, email, password, phone_number, name, created_at, updated_at FROM users WHERE email = $1
-	`
-	row := r.db.DB.QueryRow(query, email)
-	var user User
-	err := row.Scan(
-		&user.ID,
-		&user.Email,
-		&user.Password,
-		&user.PhoneNumber,
-		&user.Name,
-		&user.CreatedAt,
-		&user.UpdatedAt,
-	)
+	row, err := r.queries().GetUserByEmail(ctx, email)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get user by ID: %w", err)
 	}
-	return &user, nil
+	return userFromRow(row), nil
 }
 
 func (r *repository) GetUserEmail(ctx context.Context, userID string) (string, error) {
-	var email string
-	query := `SELECT email FROM users WHERE id = $1`
-	err := r.db.DB.QueryRowContext(ctx, query, userID).Scan(&email)
+	id, err := uuid.Parse(userID)
+	if err != nil {
+		return "", fmt.Errorf("user does not exist")
+	}
+	email, err := r.queries().GetUserEmail(ctx, id)
 	if err != nil {
 		if err == sql.ErrNoRows {
 			return "", fmt.Errorf("user does not exist")
@@ -143,53 +423,188 @@ func (r *repository) GetUserEmail(ctx context.Context, userID string) (string, e
 }
 
 func (r *repository) GetUserPhoneNumber(ctx context.Context, userID string) (string, error) {
-	var phoneNumber string
-	query := `SELECT phone_number FROM users WHERE id = $1`
-	err := r.db.DB.QueryRowContext(ctx, query, userID).Scan(&phoneNumber)
+	id, err := uuid.Parse(userID)
+	if err != nil {
+		return "", fmt.Errorf("user does not exist")
+	}
+	phoneNumber, err := r.queries().GetUserPhoneNumber(ctx, id)
 	if err != nil {
 		if err == sql.ErrNoRows {
 			return "", fmt.Errorf("user does not exist")
 		}
 		return "", fmt.Errorf("failed to get user phone number: %w", err)
 	}
-	return phoneNumber, nil
+	if phoneNumber == nil {
+		return "", fmt.Errorf("user has no phone number")
+	}
+	return *phoneNumber, nil
+}
+
+// GetUserByPhoneNumber matches on digits only, so "+233 50 474 6610" and
+// "233504746610" refer to the same user.
+func (r *repository) GetUserByPhoneNumber(ctx context.Context, phoneNumber string) (*User, error) {
+	row, err := r.queries().GetUserByPhoneNumber(ctx, phoneNumber)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, fmt.Errorf("user does not exist")
+		}
+		return nil, fmt.Errorf("failed to get user by phone number: %w", err)
+	}
+	return userFromRow(row), nil
+}
+
+// ListUsersAfter returns up to limit users with IDs after afterID, in ID
+// order; pass uuid.Nil to start from the beginning. Deleted accounts are
+// left out.
+func (r *repository) ListUsersAfter(ctx context.Context, afterID uuid.UUID, limit int) ([]*User, error) {
+	rows, err := r.queries().ListUsersAfter(ctx, sqlcdb.ListUsersAfterParams{
+		ID:    afterID,
+		Limit: int32(limit),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list users: %w", err)
+	}
+
+	users := make([]*User, 0, len(rows))
+	for _, row := range rows {
+		users = append(users, userFromRow(row))
+	}
+	return users, nil
+}
+
+func userFromRow(row sqlcdb.User) *User {
+	return &User{
+		ID:          row.ID,
+		Email:       row.Email,
+		Password:    row.Password,
+		PhoneNumber: row.PhoneNumber,
+		Name:        row.Name,
+		CreatedAt:   row.CreatedAt,
+		UpdatedAt:   row.UpdatedAt,
+
+		SuspendedAt:      row.SuspendedAt,
+		SuspensionReason: row.SuspensionReason,
+	}
+}
+
+// SuspendUser blocks the user from signing in and pauses their
+// notifications until ReinstateUser.
+func (r *repository) SuspendUser(ctx context.Context, userID, reason string) error {
+	id, err := uuid.Parse(userID)
+	if err != nil {
+		return fmt.Errorf("user does not exist")
+	}
+	var suspensionReason *string
+	if reason != "" {
+		suspensionReason = &reason
+	}
+
+	rowsAffected, err := r.queries().SuspendUser(ctx, sqlcdb.SuspendUserParams{
+		ID:               id,
+		SuspensionReason: suspensionReason,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to suspend user: %w", err)
+	}
+	if rowsAffected == 0 {
+		return fmt.Errorf("user does not exist or is already suspended")
+	}
+	return nil
+}
+
+func (r *repository) ReinstateUser(ctx context.Context, userID string) error {
+	id, err := uuid.Parse(userID)
+	if err != nil {
+		return fmt.Errorf("user does not exist")
+	}
+	rowsAffected, err := r.queries().ReinstateUser(ctx, id)
+	if err != nil {
+		return fmt.Errorf("failed to reinstate user: %w", err)
+	}
+	if rowsAffected == 0 {
+		return fmt.Errorf("user does not exist or is not suspended")
+	}
+	return nil
+}
+
+func (r *repository) IsUserSuspended(ctx context.Context, userID string) (bool, error) {
+	id, err := uuid.Parse(userID)
+	if err != nil {
+		return false, fmt.Errorf("user does not exist")
+	}
+	suspended, err := r.queries().UserSuspended(ctx, id)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return false, fmt.Errorf("user does not exist")
+		}
+		return false, fmt.Errorf("failed to check user suspension: %w", err)
+	}
+	return suspended, nil
 }
 
 func (r *repository) CreateDocument(ctx context.Context, document *Document) error {
-	query := `
-		INSERT INTO documents (id, user_id, name, description, identifier, expiration_date, timezone, attachment_url)
-		VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
-		RETURNING created_at, updated_at
-	`
-	err := r.db.DB.QueryRow(
-		query,
-		document.ID,
-		document.UserID,
-		document.Name,
-		document.Description,
-		document.Identifier,
-		document.ExpirationDate,
-		document.Timezone,
-		document.AttachmentURL,
-	).Scan(
-		&document.CreatedAt, &document.UpdatedAt,
-	)
+	identifier, identifierIndex, err := r.sealIdentifier(document.Identifier)
+	if err != nil {
+		return err
+	}
+	if document.OrganizationID == nil {
+		document.OrganizationID = organizationFilter(ctx)
+	}
+	organizationID, err := optionalUUID(document.OrganizationID)
+	if err != nil {
+		return fmt.Errorf("failed to create document: %w", err)
+	}
+	issuerID, err := optionalUUID(document.IssuerID)
+	if err != nil {
+		return fmt.Errorf("failed to create document: %w", err)
+	}
 
+	tx, err := r.conn(ctx).BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	row, err := r.documentQueries(ctx).WithTx(tx).CreateDocument(ctx, sqlcdb.CreateDocumentParams{
+		ID:              document.ID,
+		UserID:          document.UserID,
+		Name:            document.Name,
+		Description:     document.Description,
+		Identifier:      identifier,
+		IdentifierIndex: identifierIndex,
+		ExpirationDate:  document.ExpirationDate,
+		Timezone:        document.Timezone,
+		AttachmentUrl:   document.AttachmentURL,
+		Category:        document.Category,
+		OrganizationID:  organizationID,
+		RenewalCost:     document.RenewalCost,
+		Currency:        document.Currency,
+		IssuerID:        issuerID,
+		LeadTimeDays:    document.LeadTimeDays,
+	})
 	if err != nil {
 		return fmt.Errorf("failed to create document: %w", err)
 	}
 
+	key := documentCounterKey{UserID: document.UserID, OrganizationID: document.OrganizationID, ExpirationDate: document.ExpirationDate}
+	if err := adjustDocumentCounter(ctx, tx, key, 1); err != nil {
+		return err
+	}
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	document.CreatedAt, document.UpdatedAt = row.CreatedAt, row.UpdatedAt
 	return nil
 }
 
-func (r *repository) ListDocumentsByUserID(ctx context.Context, userID string) ([]*Document, error) {
-	query := `
-		SELECT id, user_id, name, description, identifier, expiration_date, timezone, attachment_url, created_at, updated_at
-		FROM documents
-		WHERE user_id = $1
-		ORDER BY created_at DESC
-	`
-	rows, err := r.db.DB.QueryContext(ctx, query, userID)
+const documentColumns = `id, user_id, name, description, identifier, expiration_date, timezone, attachment_url, attachment_status, attachment_threat, category, organization_id, renewal_cost, currency, issuer_id, lead_time_days, created_at, updated_at`
+
+// queryDocuments runs a hand-written query selecting documentColumns, for
+// lookups whose filters sqlc cannot express. It reads from the replica when
+// one is configured, so it suits searches only.
+func (r *repository) queryDocuments(ctx context.Context, query string, args ...interface{}) ([]*Document, error) {
+	rows, err := r.readConn(ctx).QueryContext(ctx, query, args...)
 	if err != nil {
 		return nil, fmt.Errorf("failed to list documents: %w", err)
 	}
@@ -207,12 +622,23 @@ func (r *repository) ListDocumentsByUserID(ctx context.Context, userID string) (
 			&doc.ExpirationDate,
 			&doc.Timezone,
 			&doc.AttachmentURL,
+			&doc.AttachmentStatus,
+			&doc.AttachmentThreat,
+			&doc.Category,
+			&doc.OrganizationID,
+			&doc.RenewalCost,
+			&doc.Currency,
+			&doc.IssuerID,
+			&doc.LeadTimeDays,
 			&doc.CreatedAt,
 			&doc.UpdatedAt,
 		)
 		if err != nil {
 			return nil, fmt.Errorf("failed to scan document: %w", err)
 		}
+		if err := r.openIdentifier(&doc); err != nil {
+			return nil, err
+		}
 		documents = append(documents, &doc)
 	}
 
@@ -223,54 +649,115 @@ func (r *repository) ListDocumentsByUserID(ctx context.Context, userID string) (
 	return documents, nil
 }
 
+// documentFromRow converts a generated row, decrypting its identifier.
+func (r *repository) documentFromRow(row sqlcdb.Document) (*Document, error) {
+	doc := &Document{
+		ID:               row.ID,
+		UserID:           row.UserID,
+		Name:             row.Name,
+		Description:      row.Description,
+		Identifier:       row.Identifier,
+		ExpirationDate:   row.ExpirationDate,
+		Timezone:         row.Timezone,
+		AttachmentURL:    row.AttachmentUrl,
+		AttachmentStatus: row.AttachmentStatus,
+		AttachmentThreat: row.AttachmentThreat,
+		Category:         row.Category,
+		OrganizationID:   uuidString(row.OrganizationID),
+		RenewalCost:      row.RenewalCost,
+		Currency:         row.Currency,
+		IssuerID:         uuidString(row.IssuerID),
+		LeadTimeDays:     row.LeadTimeDays,
+		CreatedAt:        row.CreatedAt,
+		UpdatedAt:        row.UpdatedAt,
+		DeletedAt:        row.DeletedAt,
+	}
+	if err := r.openIdentifier(doc); err != nil {
+		return nil, err
+	}
+	return doc, nil
+}
+
+func (r *repository) ListDocumentsByUserID(ctx context.Context, userID string) ([]*Document, error) {
+	id, err := uuid.Parse(userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list documents: %w", err)
+	}
+	organizationID, err := optionalUUID(organizationFilter(ctx))
+	if err != nil {
+		return nil, fmt.Errorf("failed to list documents: %w", err)
+	}
+
+	rows, err := r.readDocumentQueries(ctx).ListDocumentsByUserID(ctx, sqlcdb.ListDocumentsByUserIDParams{
+		UserID:         id,
+		OrganizationID: organizationID,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list documents: %w", err)
+	}
+
+	var documents []*Document
+	for _, row := range rows {
+		doc, err := r.documentFromRow(row)
+		if err != nil {
+			return nil, err
+		}
+		documents = append(documents, doc)
+	}
+	return documents, nil
+}
+
 func (r *repository) GetDocumentByID(ctx context.Context, documentID string) (*Document, error) {
-	query := `
-		SELECT id, user_id, name, description, identifier, expiration_date, timezone, attachment_url, created_at, updated_at
-		FROM documents
-		WHERE id = $1
-	`
-	row := r.db.DB.QueryRowContext(ctx, query, documentID)
-	var doc Document
-	err := row.Scan(
-		&doc.ID,
-		&doc.UserID,
-		&doc.Name,
-		&doc.Description,
-		&doc.Identifier,
-		&doc.ExpirationDate,
-		&doc.Timezone,
-		&doc.AttachmentURL,
-		&doc.CreatedAt,
-		&doc.UpdatedAt,
-	)
+	id, err := uuid.Parse(documentID)
+	if err != nil {
+		return nil, fmt.Errorf("document not found")
+	}
+	row, err := r.documentQueries(ctx).GetDocumentByID(ctx, id)
 	if err != nil {
 		if err == sql.ErrNoRows {
 			return nil, fmt.Errorf("document not found")
 		}
 		return nil, fmt.Errorf("failed to get document: %w", err)
 	}
-	return &doc, nil
+	return r.documentFromRow(row)
 }
 
 func (r *repository) UpdateDocument(ctx context.Context, document *Document) error {
-	query := `
-		UPDATE documents
-		SET name = $1, description = $2, identifier = $3, expiration_date = $4, timezone = $5, attachment_url = $6, updated_at = NOW()
-		WHERE id = $7
-		RETURNING updated_at
-	`
-	err := r.db.DB.QueryRowContext(
-		ctx,
-		query,
-		document.Name,
-		document.Description,
-		document.Identifier,
-		document.ExpirationDate,
-		document.Timezone,
-		document.AttachmentURL,
-		document.ID,
-	).Scan(&document.UpdatedAt)
+	identifier, identifierIndex, err := r.sealIdentifier(document.Identifier)
+	if err != nil {
+		return err
+	}
+	issuerID, err := optionalUUID(document.IssuerID)
+	if err != nil {
+		return fmt.Errorf("failed to update document: %w", err)
+	}
 
+	tx, err := r.conn(ctx).BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	previous, err := lockDocumentCounterKey(ctx, tx, document.ID, false)
+	if err != nil {
+		return err
+	}
+
+	row, err := r.documentQueries(ctx).WithTx(tx).UpdateDocument(ctx, sqlcdb.UpdateDocumentParams{
+		Name:            document.Name,
+		Description:     document.Description,
+		Identifier:      identifier,
+		ExpirationDate:  document.ExpirationDate,
+		Timezone:        document.Timezone,
+		AttachmentUrl:   document.AttachmentURL,
+		Category:        document.Category,
+		ID:              document.ID,
+		IdentifierIndex: identifierIndex,
+		RenewalCost:     document.RenewalCost,
+		Currency:        document.Currency,
+		IssuerID:        issuerID,
+		LeadTimeDays:    document.LeadTimeDays,
+	})
 	if err != nil {
 		if err == sql.ErrNoRows {
 			return fmt.Errorf("document not found")
@@ -278,154 +765,146 @@ func (r *repository) UpdateDocument(ctx context.Context, document *Document) err
 		return fmt.Errorf("failed to update document: %w", err)
 	}
 
+	if !previous.sameDay(document.ExpirationDate) {
+		if err := adjustDocumentCounter(ctx, tx, *previous, -1); err != nil {
+			return err
+		}
+		moved := *previous
+		moved.ExpirationDate = document.ExpirationDate
+		if err := adjustDocumentCounter(ctx, tx, moved, 1); err != nil {
+			return err
+		}
+	}
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	document.UpdatedAt = row.UpdatedAt
+	document.AttachmentStatus, document.AttachmentThreat = row.AttachmentStatus, row.AttachmentThreat
 	return nil
 }
 
+// DeleteDocument moves a document to the trash. It stays restorable until
+// PurgeDocument removes it for good.
 func (r *repository) DeleteDocument(ctx context.Context, documentID string) error {
-	query := `
-		DELETE FROM documents
-		WHERE id = $1
-	`
-	result, err := r.db.DB.ExecContext(ctx, query, documentID)
+	id, err := uuid.Parse(documentID)
 	if err != nil {
-		return fmt.Errorf("failed to delete document: %w", err)
+		return fmt.Errorf("document not found")
+	}
+
+	tx, err := r.conn(ctx).BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
 	}
+	defer tx.Rollback()
 
-	rowsAffected, err := result.RowsAffected()
+	key, err := lockDocumentCounterKey(ctx, tx, id, false)
 	if err != nil {
-		return fmt.Errorf("failed to get rows affected: %w", err)
+		return err
+	}
+	rowsAffected, err := r.documentQueries(ctx).WithTx(tx).TrashDocument(ctx, id)
+	if err != nil {
+		return fmt.Errorf("failed to delete document: %w", err)
 	}
 	if rowsAffected == 0 {
 		return fmt.Errorf("document not found")
 	}
+	if err := adjustDocumentCounter(ctx, tx, *key, -1); err != nil {
+		return err
+	}
 
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
 	return nil
 }
 
 func (r *repository) GetAllReminderIntervals(ctx context.Context) ([]*ReminderInterval, error) {
-	query := `
-		SELECT id, label, days_before, id_label
-		FROM reminder_intervals
-	`
-	rows, err := r.db.DB.QueryContext(ctx, query)
+	rows, err := r.queries().ListReminderIntervals(ctx)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get reminder intervals: %w", err)
 	}
-	defer rows.Close()
-
-	var intervals []*ReminderInterval
-	for rows.Next() {
-		var interval ReminderInterval
-		err := rows.Scan(
-			&interval.ID,
-			&interval.Label,
-			&interval.DaysBefore,
-			&interval.IdLabel,
-		)
-		if err != nil {
-			return nil, fmt.Errorf("failed to scan reminder interval: %w", err)
-		}
-		intervals = append(intervals, &interval)
-	}
-
-	if err = rows.Err(); err != nil {
-		return nil, fmt.Errorf("row iteration error: %w", err)
-	}
-	return intervals, nil
+	return reminderIntervalsFromRows(rows), nil
 }
 
 func (r *repository) GetReminderIntervalsFromIdLabels(ctx context.Context, idLabels []string) ([]*ReminderInterval, error) {
-	query := `
-		SELECT id, label, days_before, id_label
-		FROM reminder_intervals
-		WHERE id_label = ANY($1)
-	`
-	rows, err := r.db.DB.QueryContext(ctx, query, pq.Array(idLabels))
+	rows, err := r.queries().ListReminderIntervalsByIDLabels(ctx, idLabels)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get reminder intervals: %w", err)
 	}
-	defer rows.Close()
-
-	var intervals []*ReminderInterval
-	for rows.Next() {
-		var interval ReminderInterval
-		err := rows.Scan(
-			&interval.ID,
-			&interval.Label,
-			&interval.DaysBefore,
-			&interval.IdLabel,
-		)
-		if err != nil {
-			return nil, fmt.Errorf("failed to scan reminder interval: %w", err)
-		}
-		intervals = append(intervals, &interval)
-	}
-
-	if err = rows.Err(); err != nil {
-		return nil, fmt.Errorf("row iteration error: %w", err)
-	}
-	return intervals, nil
+	return reminderIntervalsFromRows(rows), nil
 }
 
 func (r *repository) GetReminderIntervalByID(ctx context.Context, id int) (*ReminderInterval, error) {
-	query := `
-		SELECT id, label, days_before, id_label
-		FROM reminder_intervals
-		WHERE id = $1
-	`
-	row := r.db.DB.QueryRowContext(ctx, query, id)
-	var interval ReminderInterval
-	err := row.Scan(
-		&interval.ID,
-		&interval.Label,
-		&interval.DaysBefore,
-		&interval.IdLabel,
-	)
+	row, err := r.queries().GetReminderIntervalByID(ctx, int32(id))
 	if err != nil {
 		if err == sql.ErrNoRows {
 			return nil, fmt.Errorf("reminder interval not found")
 		}
 		return nil, fmt.Errorf("failed to get reminder interval: %w", err)
 	}
-	return &interval, nil
+	return reminderIntervalFromRow(row), nil
 }
 
-func (r *repository) SetDocumentReminders(ctx context.Context, documentID string, reminder *DocumentReminder) error {
-	query := `
-		INSERT INTO document_reminders (id, document_id, reminder_interval_id, enabled)
-		VALUES ($1, $2, $3, $4)
-		RETURNING sent_at
-	`
-	err := r.db.DB.QueryRowContext(
-		ctx,
-		query,
-		reminder.ID,
-		documentID,
-		reminder.ReminderIntervalID,
-		reminder.Enabled,
-	).Scan(&reminder.SentAt)
+func reminderIntervalFromRow(row sqlcdb.ReminderInterval) *ReminderInterval {
+	return &ReminderInterval{
+		ID:         int(row.ID),
+		Label:      row.Label,
+		DaysBefore: int(row.DaysBefore),
+		IdLabel:    row.IDLabel,
+	}
+}
+
+func reminderIntervalsFromRows(rows []sqlcdb.ReminderInterval) []*ReminderInterval {
+	var intervals []*ReminderInterval
+	for _, row := range rows {
+		intervals = append(intervals, reminderIntervalFromRow(row))
+	}
+	return intervals
+}
 
+func (r *repository) SetDocumentReminders(ctx context.Context, documentID string, reminder *DocumentReminder) error {
+	id, err := uuid.Parse(documentID)
+	if err != nil {
+		return fmt.Errorf("failed to create document reminder: %w", err)
+	}
+	status := ReminderScheduled
+	if !reminder.Enabled {
+		status = ReminderCanceled
+	}
+	sentAt, err := r.documentQueries(ctx).CreateDocumentReminder(ctx, sqlcdb.CreateDocumentReminderParams{
+		ID:                 reminder.ID,
+		DocumentID:         id,
+		ReminderIntervalID: int32(reminder.ReminderIntervalID),
+		Enabled:            reminder.Enabled,
+		Status:             status,
+	})
 	if err != nil {
 		return fmt.Errorf("failed to create document reminder: %w", err)
 	}
 
+	reminder.SentAt = sentAt
+	reminder.Status = status
 	return nil
 }
 
 func (r *repository) ToggleDocumentReminder(ctx context.Context, documentID string, reminderIntervalID int, enabled bool) error {
-	query := `
-		UPDATE document_reminders
-		SET enabled = $1, sent_at = NULL
-		WHERE document_id = $2 AND reminder_interval_id = $3
-	`
-	result, err := r.db.DB.ExecContext(ctx, query, enabled, documentID, reminderIntervalID)
+	id, err := uuid.Parse(documentID)
 	if err != nil {
-		return fmt.Errorf("failed to toggle document reminder: %w", err)
+		return fmt.Errorf("document reminder not found")
 	}
-
-	rowsAffected, err := result.RowsAffected()
+	status := ReminderScheduled
+	if !enabled {
+		status = ReminderCanceled
+	}
+	rowsAffected, err := r.documentQueries(ctx).ToggleDocumentReminder(ctx, sqlcdb.ToggleDocumentReminderParams{
+		Enabled:            enabled,
+		Status:             status,
+		DocumentID:         id,
+		ReminderIntervalID: int32(reminderIntervalID),
+	})
 	if err != nil {
-		return fmt.Errorf("failed to get rows affected: %w", err)
+		return fmt.Errorf("failed to toggle document reminder: %w", err)
 	}
 	if rowsAffected == 0 {
 		return fmt.Errorf("document reminder not found")
@@ -435,36 +914,254 @@ func (r *repository) ToggleDocumentReminder(ctx context.Context, documentID stri
 }
 
 func (r *repository) GetDocumentRemindersByDocumentID(ctx context.Context, documentID string) ([]*DocumentReminder, error) {
-	query := `
-		SELECT id, document_id, reminder_interval_id, enabled, sent_at
-		FROM document_reminders
-		WHERE document_id = $1
-	`
-	rows, err := r.db.DB.QueryContext(ctx, query, documentID)
+	id, err := uuid.Parse(documentID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get document reminders: %w", err)
+	}
+	rows, err := r.documentQueries(ctx).ListDocumentReminders(ctx, id)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get document reminders: %w", err)
 	}
-	defer rows.Close()
 
 	var reminders []*DocumentReminder
-	for rows.Next() {
-		var reminder DocumentReminder
-		err := rows.Scan(
-			&reminder.ID,
-			&reminder.DocumentID,
-			&reminder.ReminderIntervalID,
-			&reminder.Enabled,
-			&reminder.SentAt,
-		)
-		if err != nil {
-			return nil, fmt.Errorf("failed to scan document reminder: %w", err)
+	for _, row := range rows {
+		reminders = append(reminders, &DocumentReminder{
+			ID:                 row.ID,
+			DocumentID:         row.DocumentID.String(),
+			ReminderIntervalID: int(row.ReminderIntervalID),
+			Enabled:            row.Enabled,
+			SentAt:             row.SentAt,
+			Status:             row.Status,
+			StatusChangedAt:    row.StatusChangedAt,
+		})
+	}
+	return reminders, nil
+}
+
+func (r *repository) MarkDocumentReminderSent(ctx context.Context, documentID string, reminderIntervalID int) error {
+	id, err := uuid.Parse(documentID)
+	if err != nil {
+		return fmt.Errorf("failed to mark document reminder sent: %w", err)
+	}
+	err = r.documentQueries(ctx).MarkDocumentReminderSent(ctx, sqlcdb.MarkDocumentReminderSentParams{
+		DocumentID:         id,
+		ReminderIntervalID: int32(reminderIntervalID),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to mark document reminder sent: %w", err)
+	}
+	return nil
+}
+
+func (r *repository) ResetDocumentReminders(ctx context.Context, documentID string) error {
+	id, err := uuid.Parse(documentID)
+	if err != nil {
+		return fmt.Errorf("failed to reset document reminders: %w", err)
+	}
+	if err := r.documentQueries(ctx).ResetDocumentReminders(ctx, id); err != nil {
+		return fmt.Errorf("failed to reset document reminders: %w", err)
+	}
+	return nil
+}
+
+func (r *repository) CreateNotificationLog(ctx context.Context, log *NotificationLog) error {
+	userID, err := uuid.Parse(log.UserID)
+	if err != nil {
+		return fmt.Errorf("failed to create notification log: %w", err)
+	}
+	documentID, err := uuid.Parse(log.DocumentID)
+	if err != nil {
+		return fmt.Errorf("failed to create notification log: %w", err)
+	}
+	recipientID, err := optionalUUID(log.RecipientID)
+	if err != nil {
+		return fmt.Errorf("failed to create notification log: %w", err)
+	}
+
+	createdAt, err := r.documentQueries(ctx).CreateNotificationLog(ctx, sqlcdb.CreateNotificationLogParams{
+		ID:                 log.ID,
+		MessageID:          &log.MessageID,
+		UserID:             userID,
+		RecipientID:        recipientID,
+		DocumentID:         documentID,
+		ReminderIntervalID: int32(log.ReminderIntervalID),
+		Channel:            log.Channel,
+		Status:             log.Status,
+		Response:           jsonText(log.Response),
+		RequestID:          log.RequestID,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to create notification log: %w", err)
+	}
+
+	log.CreatedAt = createdAt
+	return nil
+}
+
+func (r *repository) CreateDocumentContact(ctx context.Context, contact *DocumentContact) error {
+	documentID, err := uuid.Parse(contact.DocumentID)
+	if err != nil {
+		return fmt.Errorf("failed to create document contact: %w", err)
+	}
+	createdAt, err := r.documentQueries(ctx).CreateDocumentContact(ctx, sqlcdb.CreateDocumentContactParams{
+		ID:               contact.ID,
+		DocumentID:       documentID,
+		Name:             contact.Name,
+		Email:            contact.Email,
+		UnsubscribeToken: contact.UnsubscribeToken,
+	})
+	if err != nil {
+		if pqErr, ok := err.(*pq.Error); ok && pqErr.Code == "23505" {
+			return fmt.Errorf("contact already exists")
 		}
-		reminders = append(reminders, &reminder)
+		return fmt.Errorf("failed to create document contact: %w", err)
 	}
 
-	if err = rows.Err(); err != nil {
-		return nil, fmt.Errorf("row iteration error: %w", err)
+	contact.CreatedAt = createdAt
+	return nil
+}
+
+func (r *repository) ListDocumentContacts(ctx context.Context, documentID string) ([]*DocumentContact, error) {
+	id, err := uuid.Parse(documentID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list document contacts: %w", err)
+	}
+	rows, err := r.documentQueries(ctx).ListDocumentContacts(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list document contacts: %w", err)
 	}
 
-	return reminders, nil
+	var contacts []*DocumentContact
+	for _, row := range rows {
+		contacts = append(contacts, documentContactFromRow(row))
+	}
+	return contacts, nil
+}
+
+func (r *repository) DeleteDocumentContact(ctx context.Context, documentID, contactID string) error {
+	docID, err := uuid.Parse(documentID)
+	if err != nil {
+		return fmt.Errorf("document contact not found")
+	}
+	id, err := uuid.Parse(contactID)
+	if err != nil {
+		return fmt.Errorf("document contact not found")
+	}
+	rowsAffected, err := r.documentQueries(ctx).DeleteDocumentContact(ctx, sqlcdb.DeleteDocumentContactParams{
+		ID:         id,
+		DocumentID: docID,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to delete document contact: %w", err)
+	}
+	if rowsAffected == 0 {
+		return fmt.Errorf("document contact not found")
+	}
+
+	return nil
+}
+
+func (r *repository) GetDocumentContactByUnsubscribeToken(ctx context.Context, token string) (*DocumentContact, error) {
+	row, err := r.documentQueries(ctx).GetDocumentContactByUnsubscribeToken(ctx, token)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, fmt.Errorf("document contact not found")
+		}
+		return nil, fmt.Errorf("failed to get document contact: %w", err)
+	}
+	return documentContactFromRow(row), nil
+}
+
+func (r *repository) UnsubscribeDocumentContact(ctx context.Context, token string) (*DocumentContact, error) {
+	row, err := r.documentQueries(ctx).UnsubscribeDocumentContact(ctx, token)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, fmt.Errorf("document contact not found")
+		}
+		return nil, fmt.Errorf("failed to unsubscribe document contact: %w", err)
+	}
+	return documentContactFromRow(row), nil
+}
+
+func documentContactFromRow(row sqlcdb.DocumentContact) *DocumentContact {
+	return &DocumentContact{
+		ID:               row.ID,
+		DocumentID:       row.DocumentID.String(),
+		Name:             row.Name,
+		Email:            row.Email,
+		UnsubscribeToken: row.UnsubscribeToken,
+		UnsubscribedAt:   row.UnsubscribedAt,
+		CreatedAt:        row.CreatedAt,
+	}
+}
+
+func (r *repository) GetLatestNotificationLog(ctx context.Context, userID, channel string) (*NotificationLog, error) {
+	id, err := uuid.Parse(userID)
+	if err != nil {
+		return nil, fmt.Errorf("notification log not found")
+	}
+	row, err := r.documentQueries(ctx).GetLatestNotificationLog(ctx, sqlcdb.GetLatestNotificationLogParams{
+		UserID:  id,
+		Channel: channel,
+	})
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, fmt.Errorf("notification log not found")
+		}
+		return nil, fmt.Errorf("failed to get notification log: %w", err)
+	}
+
+	log := &NotificationLog{
+		ID:                 row.ID,
+		UserID:             row.UserID.String(),
+		DocumentID:         row.DocumentID.String(),
+		ReminderIntervalID: int(row.ReminderIntervalID),
+		Channel:            row.Channel,
+		Status:             row.Status,
+		CreatedAt:          row.CreatedAt,
+	}
+	if row.Response != nil {
+		log.Response = []byte(*row.Response)
+	}
+	return log, nil
+}
+
+// nullableJSON converts raw JSON bytes into a value lib/pq will send as text,
+// so it can be stored in a jsonb column.
+func nullableJSON(raw []byte) interface{} {
+	if len(raw) == 0 {
+		return nil
+	}
+	return string(raw)
+}
+
+// jsonText is nullableJSON for the generated queries, which take jsonb
+// parameters as *string.
+func jsonText(raw []byte) *string {
+	if len(raw) == 0 {
+		return nil
+	}
+	text := string(raw)
+	return &text
+}
+
+// optionalUUID parses an optional ID for the generated queries.
+func optionalUUID(id *string) (*uuid.UUID, error) {
+	if id == nil {
+		return nil, nil
+	}
+	parsed, err := uuid.Parse(*id)
+	if err != nil {
+		return nil, err
+	}
+	return &parsed, nil
+}
+
+// uuidString is the inverse of optionalUUID.
+func uuidString(id *uuid.UUID) *string {
+	if id == nil {
+		return nil
+	}
+	s := id.String()
+	return &s
 }
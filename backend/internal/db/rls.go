@@ -0,0 +1,135 @@
+package db
+
+import (
+	"context"
+	"database/sql/driver"
+	"fmt"
+
+	"github.com/lib/pq"
+
+	"xpired/internal/tenant"
+)
+
+// setTenantQuery sets the session variables the row-level security policies
+// in migration 018 read. Empty values mean no user or organization.
+const setTenantQuery = `SELECT set_config('app.user_id', $1, false), set_config('app.organization_id', $2, false)`
+
+// pqConn is the set of driver interfaces lib/pq connections implement.
+type pqConn interface {
+	driver.Conn
+	driver.ConnBeginTx
+	driver.ConnPrepareContext
+	driver.QueryerContext
+	driver.ExecerContext
+	driver.Pinger
+	driver.SessionResetter
+	driver.Validator
+}
+
+// tenantConnector opens lib/pq connections that pass the tenant in each
+// statement's context on to Postgres, so row-level security scopes every
+// query to the requesting user even if its WHERE clause forgets to.
+type tenantConnector struct {
+	base *pq.Connector
+}
+
+func newTenantConnector(dsn string) (*tenantConnector, error) {
+	base, err := pq.NewConnector(dsn)
+	if err != nil {
+		return nil, err
+	}
+	return &tenantConnector{base: base}, nil
+}
+
+func (c *tenantConnector) Connect(ctx context.Context) (driver.Conn, error) {
+	conn, err := c.base.Connect(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &tenantConn{pqConn: conn.(pqConn)}, nil
+}
+
+func (c *tenantConnector) Driver() driver.Driver {
+	return c.base.Driver()
+}
+
+// tenantConn sets the session variables before a statement whenever the
+// statement's tenant differs from the one last set on the connection.
+type tenantConn struct {
+	pqConn
+	// userID and organizationID are the values currently set on the
+	// session; stale is set when a rollback may have reverted them.
+	userID, organizationID string
+	stale                  bool
+}
+
+func (c *tenantConn) setTenant(ctx context.Context) error {
+	t := tenant.FromContext(ctx)
+	if !c.stale && t.UserID == c.userID && t.OrganizationID == c.organizationID {
+		return nil
+	}
+
+	args := []driver.NamedValue{
+		{Ordinal: 1, Value: t.UserID},
+		{Ordinal: 2, Value: t.OrganizationID},
+	}
+	if _, err := c.pqConn.ExecContext(ctx, setTenantQuery, args); err != nil {
+		return err
+	}
+	c.userID, c.organizationID, c.stale = t.UserID, t.OrganizationID, false
+	return nil
+}
+
+func (c *tenantConn) QueryContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Rows, error) {
+	if err := c.setTenant(ctx); err != nil {
+		return nil, err
+	}
+	return c.pqConn.QueryContext(ctx, query, args)
+}
+
+func (c *tenantConn) ExecContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Result, error) {
+	if err := c.setTenant(ctx); err != nil {
+		return nil, err
+	}
+	return c.pqConn.ExecContext(ctx, query, args)
+}
+
+// PrepareContext sets the tenant of ctx for the prepared statement's
+// executions; statements are only prepared explicitly and run right away.
+func (c *tenantConn) PrepareContext(ctx context.Context, query string) (driver.Stmt, error) {
+	if err := c.setTenant(ctx); err != nil {
+		return nil, err
+	}
+	return c.pqConn.PrepareContext(ctx, query)
+}
+
+func (c *tenantConn) BeginTx(ctx context.Context, opts driver.TxOptions) (driver.Tx, error) {
+	tx, err := c.pqConn.BeginTx(ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+	return &tenantTx{Tx: tx, conn: c}, nil
+}
+
+// tenantTx marks the connection's tenant stale on rollback, which undoes any
+// set_config run inside the transaction.
+type tenantTx struct {
+	driver.Tx
+	conn *tenantConn
+}
+
+func (tx *tenantTx) Rollback() error {
+	tx.conn.stale = true
+	return tx.Tx.Rollback()
+}
+
+// RowSecurityEnforced reports whether the row-level security policies apply
+// to the role db connects as. Superusers and BYPASSRLS roles skip them.
+func (db *DB) RowSecurityEnforced(ctx context.Context) (bool, error) {
+	var enforced bool
+	query := `SELECT NOT (rolsuper OR rolbypassrls) FROM pg_roles WHERE rolname = current_user`
+	if err := db.QueryRowContext(ctx, query).Scan(&enforced); err != nil {
+		return false, fmt.Errorf("failed to check row-level security: %w", err)
+	}
+	return enforced, nil
+}
@@ -0,0 +1,153 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+)
+
+// roadmapQuery selects features with their vote count and whether the user
+// in $1 voted for them.
+const roadmapQuery = `
+	SELECT f.id, f.title, f.description, f.status, f.created_by, f.created_at, f.updated_at,
+		(SELECT COUNT(*) FROM roadmap_votes v WHERE v.feature_id = f.id) AS vote_count,
+		EXISTS (SELECT 1 FROM roadmap_votes v WHERE v.feature_id = f.id AND v.user_id = $1)
+	FROM roadmap_features f
+`
+
+func scanRoadmapFeature(row interface{ Scan(...interface{}) error }) (*RoadmapFeature, error) {
+	var feature RoadmapFeature
+	err := row.Scan(
+		&feature.ID,
+		&feature.Title,
+		&feature.Description,
+		&feature.Status,
+		&feature.CreatedBy,
+		&feature.CreatedAt,
+		&feature.UpdatedAt,
+		&feature.VoteCount,
+		&feature.Voted,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return &feature, nil
+}
+
+// ListRoadmapFeatures returns the roadmap, most voted first, with Voted set
+// for userID.
+func (r *repository) ListRoadmapFeatures(ctx context.Context, userID string) ([]*RoadmapFeature, error) {
+	query := roadmapQuery + `
+		ORDER BY vote_count DESC, f.created_at DESC
+	`
+	rows, err := r.db.DB.QueryContext(ctx, query, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list roadmap features: %w", err)
+	}
+	defer rows.Close()
+
+	var features []*RoadmapFeature
+	for rows.Next() {
+		feature, err := scanRoadmapFeature(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan roadmap feature: %w", err)
+		}
+		features = append(features, feature)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("row iteration error: %w", err)
+	}
+
+	return features, nil
+}
+
+func (r *repository) GetRoadmapFeature(ctx context.Context, featureID, userID string) (*RoadmapFeature, error) {
+	query := roadmapQuery + `
+		WHERE f.id = $2
+	`
+	feature, err := scanRoadmapFeature(r.db.DB.QueryRowContext(ctx, query, userID, featureID))
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, fmt.Errorf("roadmap feature not found")
+		}
+		return nil, fmt.Errorf("failed to get roadmap feature: %w", err)
+	}
+	return feature, nil
+}
+
+func (r *repository) CreateRoadmapFeature(ctx context.Context, feature *RoadmapFeature) error {
+	query := `
+		INSERT INTO roadmap_features (id, title, description, status, created_by)
+		VALUES ($1, $2, $3, $4, $5)
+		RETURNING created_at, updated_at
+	`
+	err := r.db.DB.QueryRowContext(
+		ctx,
+		query,
+		feature.ID,
+		feature.Title,
+		feature.Description,
+		feature.Status,
+		feature.CreatedBy,
+	).Scan(&feature.CreatedAt, &feature.UpdatedAt)
+	if err != nil {
+		return fmt.Errorf("failed to create roadmap feature: %w", err)
+	}
+	return nil
+}
+
+func (r *repository) UpdateRoadmapFeature(ctx context.Context, feature *RoadmapFeature) error {
+	query := `
+		UPDATE roadmap_features
+		SET title = $2, description = $3, status = $4, updated_at = NOW()
+		WHERE id = $1
+		RETURNING updated_at
+	`
+	err := r.db.DB.QueryRowContext(ctx, query, feature.ID, feature.Title, feature.Description, feature.Status).Scan(&feature.UpdatedAt)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return fmt.Errorf("roadmap feature not found")
+		}
+		return fmt.Errorf("failed to update roadmap feature: %w", err)
+	}
+	return nil
+}
+
+func (r *repository) DeleteRoadmapFeature(ctx context.Context, featureID string) error {
+	result, err := r.db.DB.ExecContext(ctx, `DELETE FROM roadmap_features WHERE id = $1`, featureID)
+	if err != nil {
+		return fmt.Errorf("failed to delete roadmap feature: %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to get rows affected: %w", err)
+	}
+	if rowsAffected == 0 {
+		return fmt.Errorf("roadmap feature not found")
+	}
+	return nil
+}
+
+// AddRoadmapVote records userID's vote for the feature; voting again is a
+// no-op.
+func (r *repository) AddRoadmapVote(ctx context.Context, featureID, userID string) error {
+	query := `
+		INSERT INTO roadmap_votes (feature_id, user_id)
+		VALUES ($1, $2)
+		ON CONFLICT DO NOTHING
+	`
+	if _, err := r.db.DB.ExecContext(ctx, query, featureID, userID); err != nil {
+		return fmt.Errorf("failed to add roadmap vote: %w", err)
+	}
+	return nil
+}
+
+func (r *repository) RemoveRoadmapVote(ctx context.Context, featureID, userID string) error {
+	query := `DELETE FROM roadmap_votes WHERE feature_id = $1 AND user_id = $2`
+	if _, err := r.db.DB.ExecContext(ctx, query, featureID, userID); err != nil {
+		return fmt.Errorf("failed to remove roadmap vote: %w", err)
+	}
+	return nil
+}
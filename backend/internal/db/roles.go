@@ -0,0 +1,37 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+)
+
+// GetUserRole returns the role stored for the user.
+func (r *repository) GetUserRole(ctx context.Context, userID string) (string, error) {
+	var role string
+	err := r.db.DB.QueryRowContext(ctx, `SELECT role FROM users WHERE id = $1`, userID).Scan(&role)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return "", fmt.Errorf("user does not exist")
+		}
+		return "", fmt.Errorf("failed to get user role: %w", err)
+	}
+	return role, nil
+}
+
+// SetUserRole gives the user role.
+func (r *repository) SetUserRole(ctx context.Context, userID, role string) error {
+	result, err := r.db.DB.ExecContext(ctx, `UPDATE users SET role = $1, updated_at = NOW() WHERE id = $2`, role, userID)
+	if err != nil {
+		return fmt.Errorf("failed to set user role: %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to get rows affected: %w", err)
+	}
+	if rowsAffected == 0 {
+		return fmt.Errorf("user does not exist")
+	}
+	return nil
+}
@@ -0,0 +1,51 @@
+package db
+
+import (
+	"context"
+	"fmt"
+)
+
+// MarkSampleDocument records that documentID is an example document created
+// for userID during onboarding.
+func (r *repository) MarkSampleDocument(ctx context.Context, userID, documentID string) error {
+	query := `
+		INSERT INTO sample_documents (document_id, user_id)
+		VALUES ($1, $2)
+		ON CONFLICT DO NOTHING
+	`
+	if _, err := r.conn(ctx).ExecContext(ctx, query, documentID, userID); err != nil {
+		return fmt.Errorf("failed to mark sample document: %w", err)
+	}
+	return nil
+}
+
+// ListSampleDocumentIDs returns the example documents of userID, trashed or
+// not.
+func (r *repository) ListSampleDocumentIDs(ctx context.Context, userID string) ([]string, error) {
+	query := `
+		SELECT document_id
+		FROM sample_documents
+		WHERE user_id = $1
+		ORDER BY created_at
+	`
+	rows, err := r.conn(ctx).QueryContext(ctx, query, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list sample documents: %w", err)
+	}
+	defer rows.Close()
+
+	var ids []string
+	for rows.Next() {
+		var id string
+		if err := rows.Scan(&id); err != nil {
+			return nil, fmt.Errorf("failed to scan sample document: %w", err)
+		}
+		ids = append(ids, id)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("row iteration error: %w", err)
+	}
+
+	return ids, nil
+}
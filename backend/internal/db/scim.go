@@ -0,0 +1,62 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+)
+
+// SetSCIMToken creates or rotates the organization's SCIM token, stored as
+// its hash; the previous token stops working immediately.
+func (r *repository) SetSCIMToken(ctx context.Context, organizationID, tokenHash string) error {
+	query := `
+		INSERT INTO scim_tokens (organization_id, token_hash)
+		VALUES ($1, $2)
+		ON CONFLICT (organization_id) DO UPDATE
+		SET token_hash = EXCLUDED.token_hash, created_at = NOW()
+	`
+	if _, err := r.db.DB.ExecContext(ctx, query, organizationID, tokenHash); err != nil {
+		return fmt.Errorf("failed to set scim token: %w", err)
+	}
+	return nil
+}
+
+func (r *repository) DeleteSCIMToken(ctx context.Context, organizationID string) error {
+	if _, err := r.db.DB.ExecContext(ctx, `DELETE FROM scim_tokens WHERE organization_id = $1`, organizationID); err != nil {
+		return fmt.Errorf("failed to delete scim token: %w", err)
+	}
+	return nil
+}
+
+func (r *repository) GetOrganizationIDBySCIMToken(ctx context.Context, tokenHash string) (string, error) {
+	var organizationID string
+	err := r.db.DB.QueryRowContext(ctx, `SELECT organization_id FROM scim_tokens WHERE token_hash = $1`, tokenHash).Scan(&organizationID)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return "", fmt.Errorf("scim token not found")
+		}
+		return "", fmt.Errorf("failed to get scim token: %w", err)
+	}
+	return organizationID, nil
+}
+
+// SetOrganizationMemberExternalID records the identity provider's id for a
+// member provisioned over SCIM.
+func (r *repository) SetOrganizationMemberExternalID(ctx context.Context, organizationID, userID string, externalID *string) error {
+	result, err := r.db.DB.ExecContext(ctx, `
+		UPDATE organization_members SET scim_external_id = $3
+		WHERE organization_id = $1 AND user_id = $2
+	`, organizationID, userID, externalID)
+	if err != nil {
+		return fmt.Errorf("failed to set organization member external id: %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to get rows affected: %w", err)
+	}
+	if rowsAffected == 0 {
+		return fmt.Errorf("organization member not found")
+	}
+	return nil
+}
@@ -0,0 +1,199 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+)
+
+// serviceAccountColumns are read from service_accounts s left joined with
+// the api_keys k of its token.
+const serviceAccountColumns = `s.id, s.organization_id, s.user_id, s.name, s.scopes, s.created_by, s.created_at, k.id, k.prefix, k.last_used_at, k.created_at`
+
+// CreateServiceAccount records account for the user already created and
+// added to the organization for it.
+func (r *repository) CreateServiceAccount(ctx context.Context, account *ServiceAccount) error {
+	query := `
+		INSERT INTO service_accounts (id, organization_id, user_id, name, scopes, created_by)
+		VALUES ($1, $2, $3, $4, $5, $6)
+		RETURNING created_at
+	`
+	err := r.db.DB.QueryRowContext(
+		ctx,
+		query,
+		account.ID,
+		account.OrganizationID,
+		account.UserID,
+		account.Name,
+		pq.Array(account.Scopes),
+		account.CreatedBy,
+	).Scan(&account.CreatedAt)
+	if err != nil {
+		return fmt.Errorf("failed to create service account: %w", err)
+	}
+	return nil
+}
+
+// ListServiceAccounts returns the organization's service accounts with their
+// current token, oldest first.
+func (r *repository) ListServiceAccounts(ctx context.Context, organizationID string) ([]*ServiceAccount, error) {
+	query := `
+		SELECT ` + serviceAccountColumns + `
+		FROM service_accounts s
+		LEFT JOIN api_keys k ON k.user_id = s.user_id
+		WHERE s.organization_id = $1
+		ORDER BY s.created_at
+	`
+	rows, err := r.db.DB.QueryContext(ctx, query, organizationID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list service accounts: %w", err)
+	}
+	defer rows.Close()
+
+	accounts := []*ServiceAccount{}
+	for rows.Next() {
+		account, err := scanServiceAccount(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan service account: %w", err)
+		}
+		accounts = append(accounts, account)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("row iteration error: %w", err)
+	}
+	return accounts, nil
+}
+
+func (r *repository) GetServiceAccount(ctx context.Context, organizationID, accountID string) (*ServiceAccount, error) {
+	query := `
+		SELECT ` + serviceAccountColumns + `
+		FROM service_accounts s
+		LEFT JOIN api_keys k ON k.user_id = s.user_id
+		WHERE s.organization_id = $1 AND s.id = $2
+	`
+	account, err := scanServiceAccount(r.db.DB.QueryRowContext(ctx, query, organizationID, accountID))
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, fmt.Errorf("service account not found")
+		}
+		return nil, fmt.Errorf("failed to get service account: %w", err)
+	}
+	return account, nil
+}
+
+// GetServiceAccountByUserID returns the service account acting as userID,
+// which is how requests made with its token are told apart.
+func (r *repository) GetServiceAccountByUserID(ctx context.Context, userID string) (*ServiceAccount, error) {
+	query := `
+		SELECT ` + serviceAccountColumns + `
+		FROM service_accounts s
+		LEFT JOIN api_keys k ON k.user_id = s.user_id
+		WHERE s.user_id = $1
+	`
+	account, err := scanServiceAccount(r.db.DB.QueryRowContext(ctx, query, userID))
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, fmt.Errorf("service account not found")
+		}
+		return nil, fmt.Errorf("failed to get service account: %w", err)
+	}
+	return account, nil
+}
+
+// RotateServiceAccountToken makes key the only token of the service account
+// it belongs to; earlier tokens stop working at once.
+func (r *repository) RotateServiceAccountToken(ctx context.Context, key *APIKey) error {
+	tx, err := r.db.DB.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	if _, err := tx.ExecContext(ctx, `DELETE FROM api_keys WHERE user_id = $1`, key.UserID); err != nil {
+		return fmt.Errorf("failed to revoke service account token: %w", err)
+	}
+
+	query := `
+		INSERT INTO api_keys (id, user_id, name, key_hash, prefix, scopes, expires_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7)
+		RETURNING created_at
+	`
+	err = tx.QueryRowContext(
+		ctx,
+		query,
+		key.ID,
+		key.UserID,
+		key.Name,
+		key.KeyHash,
+		key.Prefix,
+		pq.Array(key.Scopes),
+		key.ExpiresAt,
+	).Scan(&key.CreatedAt)
+	if err != nil {
+		return fmt.Errorf("failed to create service account token: %w", err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+	return nil
+}
+
+// RevokeServiceAccountToken deletes the token of the service account acting
+// as userID, leaving it without one until it is rotated.
+func (r *repository) RevokeServiceAccountToken(ctx context.Context, userID string) error {
+	result, err := r.db.DB.ExecContext(ctx, `DELETE FROM api_keys WHERE user_id = $1`, userID)
+	if err != nil {
+		return fmt.Errorf("failed to revoke service account token: %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to get rows affected: %w", err)
+	}
+	if rowsAffected == 0 {
+		return fmt.Errorf("service account token not found")
+	}
+	return nil
+}
+
+func scanServiceAccount(row interface{ Scan(...interface{}) error }) (*ServiceAccount, error) {
+	var account ServiceAccount
+	var keyID *uuid.UUID
+	var keyPrefix *string
+	var keyLastUsedAt, keyCreatedAt *time.Time
+	err := row.Scan(
+		&account.ID,
+		&account.OrganizationID,
+		&account.UserID,
+		&account.Name,
+		pq.Array(&account.Scopes),
+		&account.CreatedBy,
+		&account.CreatedAt,
+		&keyID,
+		&keyPrefix,
+		&keyLastUsedAt,
+		&keyCreatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	if keyID != nil {
+		account.Token = &APIKey{
+			ID:         *keyID,
+			UserID:     account.UserID,
+			Name:       account.Name,
+			Prefix:     *keyPrefix,
+			Scopes:     account.Scopes,
+			LastUsedAt: keyLastUsedAt,
+			CreatedAt:  *keyCreatedAt,
+		}
+	}
+	return &account, nil
+}
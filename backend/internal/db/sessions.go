@@ -0,0 +1,145 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+)
+
+// CreateSession stores a new sign-in, pruning the user's expired sessions.
+func (r *repository) CreateSession(ctx context.Context, session *Session) error {
+	if _, err := r.db.DB.ExecContext(ctx, `DELETE FROM sessions WHERE user_id = $1 AND expires_at < NOW()`, session.UserID); err != nil {
+		return fmt.Errorf("failed to prune sessions: %w", err)
+	}
+
+	query := `
+		INSERT INTO sessions (id, user_id, user_agent, ip, country, region, city, expires_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+		RETURNING created_at
+	`
+	err := r.db.DB.QueryRowContext(
+		ctx,
+		query,
+		session.ID,
+		session.UserID,
+		session.UserAgent,
+		session.IP,
+		session.Country,
+		session.Region,
+		session.City,
+		session.ExpiresAt,
+	).Scan(&session.CreatedAt)
+	if err != nil {
+		return fmt.Errorf("failed to create session: %w", err)
+	}
+	return nil
+}
+
+// ListSessions returns the user's unexpired, unrevoked sessions, newest
+// first.
+func (r *repository) ListSessions(ctx context.Context, userID string) ([]*Session, error) {
+	query := `
+		SELECT id, user_id, user_agent, ip, country, region, city, created_at, expires_at
+		FROM sessions
+		WHERE user_id = $1 AND expires_at > NOW() AND revoked_at IS NULL
+		ORDER BY created_at DESC
+	`
+	rows, err := r.db.DB.QueryContext(ctx, query, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list sessions: %w", err)
+	}
+	defer rows.Close()
+
+	var sessions []*Session
+	for rows.Next() {
+		var session Session
+		err := rows.Scan(
+			&session.ID,
+			&session.UserID,
+			&session.UserAgent,
+			&session.IP,
+			&session.Country,
+			&session.Region,
+			&session.City,
+			&session.CreatedAt,
+			&session.ExpiresAt,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan session: %w", err)
+		}
+		sessions = append(sessions, &session)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("row iteration error: %w", err)
+	}
+
+	return sessions, nil
+}
+
+// IsSessionRevoked reports whether the session with the token ID sessionID
+// was revoked. Tokens without a session, such as ones issued before sessions
+// were recorded, are not.
+func (r *repository) IsSessionRevoked(ctx context.Context, sessionID string) (bool, error) {
+	var revoked bool
+	err := r.db.DB.QueryRowContext(ctx, `
+		SELECT revoked_at IS NOT NULL FROM sessions WHERE id = $1
+	`, sessionID).Scan(&revoked)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return false, nil
+		}
+		return false, fmt.Errorf("failed to check session revocation: %w", err)
+	}
+	return revoked, nil
+}
+
+// RevokeSession revokes one of the user's active sessions and returns it.
+func (r *repository) RevokeSession(ctx context.Context, userID, sessionID string) (*Session, error) {
+	session := Session{UserID: userID}
+	err := r.db.DB.QueryRowContext(ctx, `
+		UPDATE sessions SET revoked_at = NOW()
+		WHERE id = $1 AND user_id = $2 AND revoked_at IS NULL AND expires_at > NOW()
+		RETURNING id, expires_at
+	`, sessionID, userID).Scan(&session.ID, &session.ExpiresAt)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, fmt.Errorf("session not found")
+		}
+		return nil, fmt.Errorf("failed to revoke session: %w", err)
+	}
+	return &session, nil
+}
+
+// RevokeSessions revokes all of the user's active sessions but exceptID,
+// which may be "", and returns the ones it revoked.
+func (r *repository) RevokeSessions(ctx context.Context, userID, exceptID string) ([]*Session, error) {
+	var except *string
+	if exceptID != "" {
+		except = &exceptID
+	}
+	rows, err := r.db.DB.QueryContext(ctx, `
+		UPDATE sessions SET revoked_at = NOW()
+		WHERE user_id = $1 AND id IS DISTINCT FROM $2 AND revoked_at IS NULL AND expires_at > NOW()
+		RETURNING id, expires_at
+	`, userID, except)
+	if err != nil {
+		return nil, fmt.Errorf("failed to revoke sessions: %w", err)
+	}
+	defer rows.Close()
+
+	var sessions []*Session
+	for rows.Next() {
+		session := Session{UserID: userID}
+		if err := rows.Scan(&session.ID, &session.ExpiresAt); err != nil {
+			return nil, fmt.Errorf("failed to scan session: %w", err)
+		}
+		sessions = append(sessions, &session)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("row iteration error: %w", err)
+	}
+
+	return sessions, nil
+}
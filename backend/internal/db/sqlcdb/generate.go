@@ -0,0 +1,6 @@
+// Package sqlcdb holds the type-safe queries sqlc generates from the SQL
+// under /queries, against the schema in /migrations. Regenerate after
+// editing a query or adding a migration with `go generate ./...`.
+package sqlcdb
+
+//go:generate sqlc generate -f ../../../sqlc.yaml
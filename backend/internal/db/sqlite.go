@@ -0,0 +1,339 @@
+//go:build cgo
+
+package db
+
+import (
+	"context"
+	"crypto/sha256"
+	"database/sql"
+	"database/sql/driver"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"hash/fnv"
+	"io"
+	"log"
+	"net/url"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/golang-migrate/migrate/v4"
+	"github.com/golang-migrate/migrate/v4/database/sqlite3"
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+	sqlite "github.com/mattn/go-sqlite3"
+
+	"xpired/internal/tenant"
+)
+
+// NewSQLiteConnection opens, or creates, the SQLite database file at path,
+// for running xpired as a single binary without Postgres. Queries written
+// for Postgres are translated on the way in (see rewriteSQLiteQuery); row
+// level security has no SQLite equivalent, so tenants are kept apart by the
+// repository's WHERE clauses alone.
+func NewSQLiteConnection(path string) (*DB, error) {
+	params := url.Values{}
+	// take the write lock when a transaction starts rather than failing
+	// with SQLITE_BUSY when a reader in it later writes
+	params.Set("_txlock", "immediate")
+	params.Set("_foreign_keys", "on")
+	params.Set("_busy_timeout", "10000")
+	params.Set("_journal_mode", "WAL")
+	params.Set("_loc", "UTC")
+
+	db := sql.OpenDB(&sqliteConnector{dsn: "file:" + path + "?" + params.Encode()})
+	if err := db.Ping(); err != nil {
+		return nil, fmt.Errorf("error opening SQLite database: %w", err)
+	}
+
+	log.Printf("Successfully opened SQLite database %s", path)
+	return &DB{DB: db, sqlite: true}, nil
+}
+
+// runSQLiteMigrations applies the SQLite schema in migrationsPath, which
+// mirrors the Postgres migrations next to it.
+func (db *DB) runSQLiteMigrations(migrationsPath string) error {
+	driver, err := sqlite3.WithInstance(db.DB, &sqlite3.Config{})
+	if err != nil {
+		return fmt.Errorf("could not create sqlite driver: %w", err)
+	}
+
+	m, err := migrate.NewWithDatabaseInstance(
+		fmt.Sprintf("file://%s", migrationsPath),
+		"sqlite3",
+		driver,
+	)
+	if err != nil {
+		return fmt.Errorf("could not create migrate instance: %w", err)
+	}
+
+	if err := m.Up(); err != nil && err != migrate.ErrNoChange {
+		return fmt.Errorf("could not run migrations: %w", err)
+	}
+
+	log.Println("Migrations ran successfully")
+	return nil
+}
+
+type sqliteConnector struct {
+	dsn string
+}
+
+func (c *sqliteConnector) Connect(ctx context.Context) (driver.Conn, error) {
+	conn, err := c.Driver().Open(c.dsn)
+	if err != nil {
+		return nil, err
+	}
+	sc := &sqliteConn{SQLiteConn: conn.(*sqlite.SQLiteConn)}
+	if err := sc.registerFunctions(); err != nil {
+		conn.Close()
+		return nil, err
+	}
+	return sc, nil
+}
+
+func (c *sqliteConnector) Driver() driver.Driver {
+	return &sqlite.SQLiteDriver{}
+}
+
+// sqliteConn translates each statement from Postgres and its errors back,
+// and remembers the tenant of the statement's context for app_user_id() and
+// app_organization_id().
+type sqliteConn struct {
+	*sqlite.SQLiteConn
+	userID, organizationID string
+}
+
+// registerFunctions defines the Postgres functions the repository calls.
+func (c *sqliteConn) registerFunctions() error {
+	functions := []struct {
+		name string
+		impl interface{}
+		pure bool
+	}{
+		{"now", func() string { return time.Now().UTC().Format(sqliteTimeLayout) }, false},
+		{"gen_random_uuid", func() string { return uuid.NewString() }, false},
+		{"app_user_id", func() interface{} { return nullIfEmpty(c.userID) }, false},
+		{"app_organization_id", func() interface{} { return nullIfEmpty(c.organizationID) }, false},
+		{"similarity", trigramSimilarity, true},
+		{"split_part", splitPart, true},
+		{"regexp_replace", regexpReplace, true},
+		{"to_char", toChar, true},
+		{"cardinality", func(literal string) int { return len(parsePgArray(literal)) }, true},
+		{"pg_array_json", pgArrayJSON, true},
+		// write transactions are serialized already
+		{"pg_advisory_xact_lock", func(key int64) interface{} { return nil }, false},
+		{"hashtext", hashText, true},
+		// for migrations hashing stored secrets, as Postgres does with
+		// encode(sha256(...), 'hex')
+		{"sha256_hex", sha256Hex, true},
+	}
+	for _, f := range functions {
+		if err := c.RegisterFunc(f.name, f.impl, f.pure); err != nil {
+			return fmt.Errorf("failed to register %s: %w", f.name, err)
+		}
+	}
+	if err := c.RegisterAggregator("array_agg", newArrayAgg, true); err != nil {
+		return fmt.Errorf("failed to register array_agg: %w", err)
+	}
+	return nil
+}
+
+func (c *sqliteConn) setTenant(ctx context.Context) {
+	t := tenant.FromContext(ctx)
+	c.userID, c.organizationID = t.UserID, t.OrganizationID
+}
+
+func (c *sqliteConn) QueryContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Rows, error) {
+	c.setTenant(ctx)
+	rows, err := c.SQLiteConn.QueryContext(ctx, rewriteSQLiteQuery(query), sqliteArgs(args))
+	if err != nil {
+		return nil, sqliteError(err)
+	}
+	return &sqliteRows{SQLiteRows: rows.(*sqlite.SQLiteRows)}, nil
+}
+
+func (c *sqliteConn) ExecContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Result, error) {
+	c.setTenant(ctx)
+	result, err := c.SQLiteConn.ExecContext(ctx, rewriteSQLiteQuery(query), sqliteArgs(args))
+	return result, sqliteError(err)
+}
+
+func (c *sqliteConn) PrepareContext(ctx context.Context, query string) (driver.Stmt, error) {
+	c.setTenant(ctx)
+	stmt, err := c.SQLiteConn.PrepareContext(ctx, rewriteSQLiteQuery(query))
+	if err != nil {
+		return nil, sqliteError(err)
+	}
+	return &sqliteStmt{SQLiteStmt: stmt.(*sqlite.SQLiteStmt)}, nil
+}
+
+type sqliteStmt struct {
+	*sqlite.SQLiteStmt
+}
+
+func (s *sqliteStmt) QueryContext(ctx context.Context, args []driver.NamedValue) (driver.Rows, error) {
+	rows, err := s.SQLiteStmt.QueryContext(ctx, sqliteArgs(args))
+	if err != nil {
+		return nil, sqliteError(err)
+	}
+	return &sqliteRows{SQLiteRows: rows.(*sqlite.SQLiteRows)}, nil
+}
+
+func (s *sqliteStmt) ExecContext(ctx context.Context, args []driver.NamedValue) (driver.Result, error) {
+	result, err := s.SQLiteStmt.ExecContext(ctx, sqliteArgs(args))
+	return result, sqliteError(err)
+}
+
+// sqliteRows returns times computed by expressions, such as MAX(created_at),
+// as time.Time. SQLite only knows a column holds times from its declared
+// type, which expressions lack.
+type sqliteRows struct {
+	*sqlite.SQLiteRows
+	declTypes []string
+}
+
+func (r *sqliteRows) Next(dest []driver.Value) error {
+	if err := r.SQLiteRows.Next(dest); err != nil {
+		if err == io.EOF {
+			return err
+		}
+		return sqliteError(err)
+	}
+	if r.declTypes == nil {
+		r.declTypes = r.DeclTypes()
+	}
+	for i, value := range dest {
+		s, ok := value.(string)
+		if !ok || r.declTypes[i] != "" || len(s) != len(sqliteTimeLayout) {
+			continue
+		}
+		if t, err := time.Parse(sqliteTimeLayout, s); err == nil {
+			dest[i] = t.UTC()
+		}
+	}
+	return nil
+}
+
+// sqliteArgs stores times in UTC in sqliteTimeLayout, so they compare
+// correctly with each other and with now().
+func sqliteArgs(args []driver.NamedValue) []driver.NamedValue {
+	for i, arg := range args {
+		if t, ok := arg.Value.(time.Time); ok {
+			args[i].Value = t.UTC().Format(sqliteTimeLayout)
+		}
+	}
+	return args
+}
+
+// sqliteError reports constraint violations as the *pq.Error Postgres would
+// have returned, which is what the repository checks for.
+func sqliteError(err error) error {
+	var sqliteErr sqlite.Error
+	if !errors.As(err, &sqliteErr) {
+		return err
+	}
+	codes := map[sqlite.ErrNoExtended]pq.ErrorCode{
+		sqlite.ErrConstraintUnique:     "23505",
+		sqlite.ErrConstraintPrimaryKey: "23505",
+		sqlite.ErrConstraintForeignKey: "23503",
+		sqlite.ErrConstraintNotNull:    "23502",
+		sqlite.ErrConstraintCheck:      "23514",
+	}
+	if code, ok := codes[sqliteErr.ExtendedCode]; ok {
+		return &pq.Error{Code: code, Message: sqliteErr.Error()}
+	}
+	return err
+}
+
+func nullIfEmpty(s string) interface{} {
+	if s == "" {
+		return nil
+	}
+	return s
+}
+
+// splitPart is Postgres' split_part: the nth field of s split by delimiter,
+// counting from 1, or "" past the last field.
+func splitPart(s, delimiter string, n int) string {
+	fields := strings.Split(s, delimiter)
+	if n < 1 || n > len(fields) {
+		return ""
+	}
+	return fields[n-1]
+}
+
+// regexpReplace is Postgres' regexp_replace for the patterns Go's regexp
+// understands; flag g replaces every match rather than the first. A NULL
+// source gives NULL.
+func regexpReplace(source interface{}, pattern, replacement string, flags ...string) (interface{}, error) {
+	s, ok := source.(string)
+	if !ok {
+		if source == nil {
+			return nil, nil
+		}
+		s = fmt.Sprint(source)
+	}
+	re, err := regexp.Compile(pattern)
+	if err != nil {
+		return nil, err
+	}
+	if len(flags) > 0 && strings.Contains(flags[0], "g") {
+		return re.ReplaceAllString(s, replacement), nil
+	}
+	if match := re.FindStringSubmatchIndex(s); match != nil {
+		return s[:match[0]] + string(re.ExpandString(nil, replacement, s, match)) + s[match[1]:], nil
+	}
+	return s, nil
+}
+
+// toChar is Postgres' to_char for times, limited to the numeric date and
+// time patterns.
+func toChar(value, format string) interface{} {
+	t, ok := parseSQLiteTime(value)
+	if !ok {
+		return nil
+	}
+	return t.Format(pgDateFormat.Replace(format))
+}
+
+func hashText(s string) int64 {
+	h := fnv.New32a()
+	h.Write([]byte(s))
+	return int64(int32(h.Sum32()))
+}
+
+func sha256Hex(s string) string {
+	sum := sha256.Sum256([]byte(s))
+	return hex.EncodeToString(sum[:])
+}
+
+// arrayAgg is Postgres' array_agg, collecting values into an array literal
+// that pq.Array scans.
+type arrayAgg struct {
+	elements []*string
+}
+
+func newArrayAgg() *arrayAgg {
+	return &arrayAgg{}
+}
+
+func (a *arrayAgg) Step(value interface{}) {
+	if value == nil {
+		a.elements = append(a.elements, nil)
+		return
+	}
+	s := fmt.Sprint(value)
+	if b, ok := value.([]byte); ok {
+		s = string(b)
+	}
+	a.elements = append(a.elements, &s)
+}
+
+func (a *arrayAgg) Done() interface{} {
+	if a.elements == nil {
+		return nil
+	}
+	return formatPgArray(a.elements)
+}
@@ -0,0 +1,226 @@
+package db
+
+import (
+	"encoding/json"
+	"regexp"
+	"strings"
+	"sync"
+	"time"
+	"unicode"
+)
+
+// sqliteTimeLayout is how times are stored in SQLite: UTC with a fixed
+// number of fractional digits, so that comparing them as text orders them
+// in time. The column defaults of migrations/sqlite write the same layout.
+const sqliteTimeLayout = "2006-01-02 15:04:05.000000-07:00"
+
+// The repository is written in Postgres' dialect. sqliteRewrites turn the
+// handful of Postgres-only constructs it uses into SQLite, everywhere outside
+// string literals and comments; the functions it calls are registered on
+// each SQLite connection.
+var sqliteRewrites = []struct {
+	pattern *regexp.Regexp
+	replace string
+}{
+	// $1 -> ?1, which SQLite binds by number like Postgres
+	{regexp.MustCompile(`\$(\d+)`), `?$1`},
+	// casts; SQLite converts values by column affinity instead
+	{regexp.MustCompile(`::\s*[A-Za-z_]+(\s*\[\s*\])?`), ``},
+	// SQLite locks the whole database for a write transaction
+	{regexp.MustCompile(`(?i)\bFOR\s+UPDATE(\s+SKIP\s+LOCKED)?\b`), ``},
+	// x = ANY(array) -> x IN (elements of array)
+	{regexp.MustCompile(`(?i)=\s*ANY\s*\(\s*([^()]+?)\s*\)`), `IN (SELECT value FROM json_each(pg_array_json($1)))`},
+}
+
+// sqliteNoOps are statements with nothing to do in SQLite, which serializes
+// write transactions and has plain views instead of materialized ones.
+var sqliteNoOps = []string{"LOCK TABLE", "REFRESH MATERIALIZED VIEW"}
+
+// sqliteQueries caches rewritten queries, as the repository runs the same
+// few hundred over and over.
+var sqliteQueries sync.Map
+
+// rewriteSQLiteQuery translates a query in the repository's Postgres dialect
+// into SQLite.
+func rewriteSQLiteQuery(query string) string {
+	if rewritten, ok := sqliteQueries.Load(query); ok {
+		return rewritten.(string)
+	}
+
+	trimmed := strings.ToUpper(strings.TrimSpace(query))
+	for _, prefix := range sqliteNoOps {
+		if strings.HasPrefix(trimmed, prefix) {
+			sqliteQueries.Store(query, "SELECT 1")
+			return "SELECT 1"
+		}
+	}
+
+	var b strings.Builder
+	code := func(segment string) {
+		for _, rewrite := range sqliteRewrites {
+			segment = rewrite.pattern.ReplaceAllString(segment, rewrite.replace)
+		}
+		b.WriteString(segment)
+	}
+	start := 0
+	for i := 0; i < len(query); i++ {
+		var end int
+		switch {
+		case query[i] == '\'':
+			end = i + 1
+			for end < len(query) {
+				if query[end] == '\'' {
+					if end+1 < len(query) && query[end+1] == '\'' {
+						end += 2
+						continue
+					}
+					end++
+					break
+				}
+				end++
+			}
+		case strings.HasPrefix(query[i:], "--"):
+			end = strings.IndexByte(query[i:], '\n')
+			if end < 0 {
+				end = len(query)
+			} else {
+				end += i
+			}
+		default:
+			continue
+		}
+		code(query[start:i])
+		b.WriteString(query[i:end])
+		start, i = end, end-1
+	}
+	code(query[start:])
+
+	rewritten := b.String()
+	sqliteQueries.Store(query, rewritten)
+	return rewritten
+}
+
+// parsePgArray splits a Postgres array literal, such as pq.Array writes
+// and text[] columns hold in SQLite, into its elements. NULL elements are
+// nil.
+func parsePgArray(literal string) []*string {
+	literal = strings.TrimSpace(literal)
+	if len(literal) < 2 || literal[0] != '{' || literal[len(literal)-1] != '}' {
+		return nil
+	}
+	body := literal[1 : len(literal)-1]
+
+	var elements []*string
+	for i := 0; i < len(body); {
+		var element strings.Builder
+		quoted := body[i] == '"'
+		if quoted {
+			i++
+			for i < len(body) && body[i] != '"' {
+				if body[i] == '\\' && i+1 < len(body) {
+					i++
+				}
+				element.WriteByte(body[i])
+				i++
+			}
+			i++
+		} else {
+			for i < len(body) && body[i] != ',' {
+				element.WriteByte(body[i])
+				i++
+			}
+		}
+		// skip the separator
+		i++
+
+		value := element.String()
+		if !quoted {
+			value = strings.TrimSpace(value)
+			if strings.EqualFold(value, "NULL") {
+				elements = append(elements, nil)
+				continue
+			}
+		}
+		elements = append(elements, &value)
+	}
+	return elements
+}
+
+// formatPgArray writes elements as a Postgres array literal.
+func formatPgArray(elements []*string) string {
+	var b strings.Builder
+	b.WriteByte('{')
+	for i, element := range elements {
+		if i > 0 {
+			b.WriteByte(',')
+		}
+		if element == nil {
+			b.WriteString("NULL")
+			continue
+		}
+		b.WriteByte('"')
+		b.WriteString(strings.NewReplacer(`\`, `\\`, `"`, `\"`).Replace(*element))
+		b.WriteByte('"')
+	}
+	b.WriteByte('}')
+	return b.String()
+}
+
+// pgArrayJSON converts a Postgres array literal to a JSON array, for
+// json_each to expand. A NULL array, which pq.Array makes of a nil slice,
+// expands to nothing, as = ANY(NULL) matches nothing in Postgres.
+func pgArrayJSON(literal interface{}) string {
+	s, _ := literal.(string)
+	elements := parsePgArray(s)
+	if elements == nil {
+		elements = []*string{}
+	}
+	encoded, _ := json.Marshal(elements)
+	return string(encoded)
+}
+
+// trigrams returns the trigrams of s the way pg_trgm extracts them: per word
+// of letters and digits, padded with two spaces in front and one behind.
+func trigrams(s string) map[string]struct{} {
+	set := map[string]struct{}{}
+	words := strings.FieldsFunc(strings.ToLower(s), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+	for _, word := range words {
+		padded := []rune("  " + word + " ")
+		for i := 0; i+3 <= len(padded); i++ {
+			set[string(padded[i:i+3])] = struct{}{}
+		}
+	}
+	return set
+}
+
+// trigramSimilarity is pg_trgm's similarity(): the share of trigrams the two
+// strings have in common.
+func trigramSimilarity(a, b string) float64 {
+	ta, tb := trigrams(a), trigrams(b)
+	if len(ta) == 0 || len(tb) == 0 {
+		return 0
+	}
+	shared := 0
+	for t := range ta {
+		if _, ok := tb[t]; ok {
+			shared++
+		}
+	}
+	return float64(shared) / float64(len(ta)+len(tb)-shared)
+}
+
+// pgDateFormat converts the template patterns of Postgres' to_char that the
+// repository uses into a Go layout.
+var pgDateFormat = strings.NewReplacer("YYYY", "2006", "MM", "01", "DD", "02", "HH24", "15", "MI", "04", "SS", "05")
+
+// parseSQLiteTime parses a time stored in SQLite.
+func parseSQLiteTime(s string) (time.Time, bool) {
+	for _, layout := range []string{sqliteTimeLayout, "2006-01-02 15:04:05.999999999-07:00", time.DateOnly} {
+		if t, err := time.Parse(layout, s); err == nil {
+			return t.UTC(), true
+		}
+	}
+	return time.Time{}, false
+}
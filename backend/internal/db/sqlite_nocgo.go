@@ -0,0 +1,15 @@
+//go:build !cgo
+
+package db
+
+import "fmt"
+
+// NewSQLiteConnection fails in builds without cgo, which the SQLite driver
+// needs. Build with CGO_ENABLED=1 to run on SQLite.
+func NewSQLiteConnection(path string) (*DB, error) {
+	return nil, fmt.Errorf("SQLite support is not compiled in: build with CGO_ENABLED=1")
+}
+
+func (db *DB) runSQLiteMigrations(migrationsPath string) error {
+	return fmt.Errorf("SQLite support is not compiled in: build with CGO_ENABLED=1")
+}
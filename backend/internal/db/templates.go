@@ -0,0 +1,182 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/lib/pq"
+)
+
+const templateQuery = `
+	SELECT slug, name, description, category, identifier_label, reminders
+	FROM document_templates
+`
+
+// ListDocumentTemplates returns the template gallery in display order, each
+// template with its fields.
+func (r *repository) ListDocumentTemplates(ctx context.Context) ([]*DocumentTemplate, error) {
+	query := templateQuery + `
+		ORDER BY position, name
+	`
+	rows, err := r.db.DB.QueryContext(ctx, query)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list document templates: %w", err)
+	}
+	defer rows.Close()
+
+	var templates []*DocumentTemplate
+	bySlug := map[string]*DocumentTemplate{}
+	for rows.Next() {
+		var template DocumentTemplate
+		err := rows.Scan(
+			&template.Slug,
+			&template.Name,
+			&template.Description,
+			&template.Category,
+			&template.IdentifierLabel,
+			pq.Array(&template.Reminders),
+		)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan document template: %w", err)
+		}
+		template.Fields = []*CustomField{}
+		templates = append(templates, &template)
+		bySlug[template.Slug] = &template
+	}
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("row iteration error: %w", err)
+	}
+
+	fields, err := r.templateFields(ctx, "")
+	if err != nil {
+		return nil, err
+	}
+	for slug, templateFields := range fields {
+		if template, ok := bySlug[slug]; ok {
+			template.Fields = templateFields
+		}
+	}
+
+	return templates, nil
+}
+
+func (r *repository) GetDocumentTemplate(ctx context.Context, slug string) (*DocumentTemplate, error) {
+	query := templateQuery + `
+		WHERE slug = $1
+	`
+	var template DocumentTemplate
+	err := r.db.DB.QueryRowContext(ctx, query, slug).Scan(
+		&template.Slug,
+		&template.Name,
+		&template.Description,
+		&template.Category,
+		&template.IdentifierLabel,
+		pq.Array(&template.Reminders),
+	)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, fmt.Errorf("document template not found")
+		}
+		return nil, fmt.Errorf("failed to get document template: %w", err)
+	}
+
+	fields, err := r.templateFields(ctx, slug)
+	if err != nil {
+		return nil, err
+	}
+	template.Fields = fields[slug]
+	if template.Fields == nil {
+		template.Fields = []*CustomField{}
+	}
+	return &template, nil
+}
+
+// templateFields returns the fields of the template with the given slug, or
+// of every template for an empty slug, keyed by template slug.
+func (r *repository) templateFields(ctx context.Context, slug string) (map[string][]*CustomField, error) {
+	query := `
+		SELECT template_slug, key, label, position
+		FROM document_template_fields
+		WHERE $1 = '' OR template_slug = $1
+		ORDER BY template_slug, position, key
+	`
+	rows, err := r.db.DB.QueryContext(ctx, query, slug)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list template fields: %w", err)
+	}
+	defer rows.Close()
+
+	fields := map[string][]*CustomField{}
+	for rows.Next() {
+		var templateSlug string
+		var field CustomField
+		if err := rows.Scan(&templateSlug, &field.Key, &field.Label, &field.Position); err != nil {
+			return nil, fmt.Errorf("failed to scan template field: %w", err)
+		}
+		fields[templateSlug] = append(fields[templateSlug], &field)
+	}
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("row iteration error: %w", err)
+	}
+	return fields, nil
+}
+
+func (r *repository) ListDocumentCustomFields(ctx context.Context, documentID string) ([]*CustomField, error) {
+	query := `
+		SELECT key, label, value, position
+		FROM document_custom_fields
+		WHERE document_id = $1
+		ORDER BY position, key
+	`
+	rows, err := r.conn(ctx).QueryContext(ctx, query, documentID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list document custom fields: %w", err)
+	}
+	defer rows.Close()
+
+	var fields []*CustomField
+	for rows.Next() {
+		var field CustomField
+		if err := rows.Scan(&field.Key, &field.Label, &field.Value, &field.Position); err != nil {
+			return nil, fmt.Errorf("failed to scan document custom field: %w", err)
+		}
+		fields = append(fields, &field)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("row iteration error: %w", err)
+	}
+
+	return fields, nil
+}
+
+// SetDocumentCustomFields replaces the custom fields of a document with
+// fields, in their order.
+func (r *repository) SetDocumentCustomFields(ctx context.Context, documentID string, fields []*CustomField) error {
+	tx, err := r.conn(ctx).BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	if _, err := tx.ExecContext(ctx, `DELETE FROM document_custom_fields WHERE document_id = $1`, documentID); err != nil {
+		return fmt.Errorf("failed to clear document custom fields: %w", err)
+	}
+
+	query := `
+		INSERT INTO document_custom_fields (document_id, key, label, value, position)
+		VALUES ($1, $2, $3, $4, $5)
+	`
+	for i, field := range fields {
+		field.Position = i
+		if _, err := tx.ExecContext(ctx, query, documentID, field.Key, field.Label, field.Value, field.Position); err != nil {
+			return fmt.Errorf("failed to insert document custom field: %w", err)
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+	return nil
+}
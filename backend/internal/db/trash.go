@@ -0,0 +1,192 @@
+package db
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const trashedDocumentColumns = `id, user_id, name, description, identifier, expiration_date, timezone, attachment_url, attachment_status, attachment_threat, category, organization_id, renewal_cost, currency, issuer_id, lead_time_days, created_at, updated_at, deleted_at`
+
+func (r *repository) queryTrashedDocuments(ctx context.Context, query string, args ...interface{}) ([]*Document, error) {
+	rows, err := r.conn(ctx).QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list trashed documents: %w", err)
+	}
+	defer rows.Close()
+
+	var documents []*Document
+	for rows.Next() {
+		var doc Document
+		err := rows.Scan(
+			&doc.ID,
+			&doc.UserID,
+			&doc.Name,
+			&doc.Description,
+			&doc.Identifier,
+			&doc.ExpirationDate,
+			&doc.Timezone,
+			&doc.AttachmentURL,
+			&doc.AttachmentStatus,
+			&doc.AttachmentThreat,
+			&doc.Category,
+			&doc.OrganizationID,
+			&doc.RenewalCost,
+			&doc.Currency,
+			&doc.IssuerID,
+			&doc.LeadTimeDays,
+			&doc.CreatedAt,
+			&doc.UpdatedAt,
+			&doc.DeletedAt,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan document: %w", err)
+		}
+		if err := r.openIdentifier(&doc); err != nil {
+			return nil, err
+		}
+		documents = append(documents, &doc)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("row iteration error: %w", err)
+	}
+	return documents, nil
+}
+
+func (r *repository) ListTrashedDocuments(ctx context.Context, userID string) ([]*Document, error) {
+	query := `
+		SELECT ` + trashedDocumentColumns + `
+		FROM documents
+		WHERE user_id = $1 AND deleted_at IS NOT NULL AND organization_id IS NOT DISTINCT FROM $2
+		ORDER BY deleted_at DESC
+	`
+	return r.queryTrashedDocuments(ctx, query, userID, organizationFilter(ctx))
+}
+
+func (r *repository) GetTrashedDocument(ctx context.Context, documentID string) (*Document, error) {
+	query := `
+		SELECT ` + trashedDocumentColumns + `
+		FROM documents
+		WHERE id = $1 AND deleted_at IS NOT NULL
+	`
+	documents, err := r.queryTrashedDocuments(ctx, query, documentID)
+	if err != nil {
+		return nil, err
+	}
+	if len(documents) == 0 {
+		return nil, fmt.Errorf("document not found")
+	}
+	return documents[0], nil
+}
+
+func (r *repository) RestoreDocument(ctx context.Context, documentID string) error {
+	id, err := uuid.Parse(documentID)
+	if err != nil {
+		return fmt.Errorf("document not found")
+	}
+
+	tx, err := r.conn(ctx).BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	key, err := lockDocumentCounterKey(ctx, tx, id, true)
+	if err != nil {
+		return err
+	}
+
+	query := `
+		UPDATE documents
+		SET deleted_at = NULL, updated_at = NOW()
+		WHERE id = $1 AND deleted_at IS NOT NULL
+	`
+	if _, err := tx.ExecContext(ctx, query, id); err != nil {
+		return fmt.Errorf("failed to restore document: %w", err)
+	}
+	if err := adjustDocumentCounter(ctx, tx, *key, 1); err != nil {
+		return err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+	return nil
+}
+
+// ListDocumentsTrashedBefore returns up to limit documents that have been in
+// the trash since before cutoff, oldest first. Documents under legal hold
+// are left out.
+func (r *repository) ListDocumentsTrashedBefore(ctx context.Context, cutoff time.Time, limit int) ([]*Document, error) {
+	query := `
+		SELECT ` + trashedDocumentColumns + `
+		FROM documents
+		WHERE deleted_at IS NOT NULL AND deleted_at < $1 AND ` + notOnLegalHold + `
+		ORDER BY deleted_at
+		LIMIT $2
+	`
+	return r.queryTrashedDocuments(ctx, query, cutoff, limit)
+}
+
+// PurgeDocument permanently deletes a trashed document; its reminders,
+// contacts, checklist and notification history go with it.
+func (r *repository) PurgeDocument(ctx context.Context, documentID string) error {
+	tx, err := r.conn(ctx).BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	// notification_logs references documents without cascading
+	_, err = tx.ExecContext(ctx, `
+		DELETE FROM notification_logs
+		WHERE document_id IN (SELECT id FROM documents WHERE id = $1 AND deleted_at IS NOT NULL)
+	`, documentID)
+	if err != nil {
+		return fmt.Errorf("failed to purge notification history: %w", err)
+	}
+	result, err := tx.ExecContext(ctx, `DELETE FROM documents WHERE id = $1 AND deleted_at IS NOT NULL`, documentID)
+	if err != nil {
+		return fmt.Errorf("failed to purge document: %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to get rows affected: %w", err)
+	}
+	if rowsAffected == 0 {
+		return fmt.Errorf("document not found")
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+	return nil
+}
+
+func (r *repository) CreateAuditLog(ctx context.Context, entry *AuditLog) error {
+	query := `
+		INSERT INTO audit_logs (id, actor_id, user_id, organization_id, action, entity_type, entity_id, metadata)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+		RETURNING created_at
+	`
+	err := r.db.DB.QueryRowContext(
+		ctx,
+		query,
+		entry.ID,
+		entry.ActorID,
+		entry.UserID,
+		entry.OrganizationID,
+		entry.Action,
+		entry.EntityType,
+		entry.EntityID,
+		nullableJSON(entry.Metadata),
+	).Scan(&entry.CreatedAt)
+	if err != nil {
+		return fmt.Errorf("failed to create audit log: %w", err)
+	}
+	return nil
+}
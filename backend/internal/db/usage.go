@@ -0,0 +1,102 @@
+package db
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+// meterUsageQueries measure each metric per organization into
+// organization_usage for month $1, keeping the highest quantity measured in
+// the month. $2 is the metric.
+var meterUsageQueries = map[string]string{
+	UsageDocuments: `
+		INSERT INTO organization_usage (organization_id, month, metric, quantity, recorded_at)
+		SELECT organization_id, $1, $2, COUNT(*), now()
+		FROM documents
+		WHERE organization_id IS NOT NULL AND deleted_at IS NULL
+		GROUP BY organization_id
+		ON CONFLICT (organization_id, month, metric) DO UPDATE
+		SET quantity = EXCLUDED.quantity, recorded_at = EXCLUDED.recorded_at
+		WHERE EXCLUDED.quantity > organization_usage.quantity
+	`,
+	// a blob attached to several documents of an organization, trashed
+	// ones included, is stored once
+	UsageStorageBytes: `
+		INSERT INTO organization_usage (organization_id, month, metric, quantity, recorded_at)
+		SELECT organization_id, $1, $2, SUM(size), now()
+		FROM (
+			SELECT DISTINCT d.organization_id, b.url, b.size
+			FROM documents d
+			JOIN attachment_blobs b ON b.url = d.attachment_url
+			WHERE d.organization_id IS NOT NULL
+		) stored
+		GROUP BY organization_id
+		ON CONFLICT (organization_id, month, metric) DO UPDATE
+		SET quantity = EXCLUDED.quantity, recorded_at = EXCLUDED.recorded_at
+		WHERE EXCLUDED.quantity > organization_usage.quantity
+	`,
+	// a batched message counts once; failed sends do not count
+	UsageSMSSent: `
+		INSERT INTO organization_usage (organization_id, month, metric, quantity, recorded_at)
+		SELECT d.organization_id, $1, $2, COUNT(DISTINCT l.message_id), now()
+		FROM notification_logs l
+		JOIN documents d ON d.id = l.document_id
+		WHERE d.organization_id IS NOT NULL AND l.channel = $3 AND l.status IN ('sent', 'dry_run')
+			AND l.created_at >= $4 AND l.created_at < $5
+		GROUP BY d.organization_id
+		ON CONFLICT (organization_id, month, metric) DO UPDATE
+		SET quantity = EXCLUDED.quantity, recorded_at = EXCLUDED.recorded_at
+		WHERE EXCLUDED.quantity > organization_usage.quantity
+	`,
+}
+
+// MeterOrganizationUsage records the usage of the organizations in the
+// database of ctx for the UTC month starting at month. Text messages are
+// counted over the whole month, so metering a month once more after it ended
+// catches those sent since the last run; documents and storage are
+// snapshots, taken only while the month lasts.
+func (r *repository) MeterOrganizationUsage(ctx context.Context, month time.Time) error {
+	start := month.UTC()
+	end := start.AddDate(0, 1, 0)
+	label := start.Format("2006-01")
+
+	if _, err := r.conn(ctx).ExecContext(ctx, meterUsageQueries[UsageSMSSent], label, UsageSMSSent, ChannelSMS, start, end); err != nil {
+		return fmt.Errorf("failed to meter %s: %w", UsageSMSSent, err)
+	}
+	if !time.Now().Before(end) {
+		return nil
+	}
+	for _, metric := range []string{UsageDocuments, UsageStorageBytes} {
+		if _, err := r.conn(ctx).ExecContext(ctx, meterUsageQueries[metric], label, metric); err != nil {
+			return fmt.Errorf("failed to meter %s: %w", metric, err)
+		}
+	}
+	return nil
+}
+
+// ListOrganizationUsage returns the metered usage of organizationID, or of
+// every organization in the database of ctx when it is "", for the months
+// from through to, both 'YYYY-MM' and inclusive.
+func (r *repository) ListOrganizationUsage(ctx context.Context, organizationID, from, to string) ([]*OrganizationUsage, error) {
+	rows, err := r.readConn(ctx).QueryContext(ctx, `
+		SELECT organization_id, month, metric, quantity, recorded_at
+		FROM organization_usage
+		WHERE ($1 = '' OR organization_id::text = $1) AND month >= $2 AND month <= $3
+		ORDER BY organization_id, month, metric
+	`, organizationID, from, to)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list organization usage: %w", err)
+	}
+	defer rows.Close()
+
+	var usage []*OrganizationUsage
+	for rows.Next() {
+		var u OrganizationUsage
+		if err := rows.Scan(&u.OrganizationID, &u.Month, &u.Metric, &u.Quantity, &u.RecordedAt); err != nil {
+			return nil, fmt.Errorf("failed to scan organization usage: %w", err)
+		}
+		usage = append(usage, &u)
+	}
+	return usage, rows.Err()
+}
@@ -0,0 +1,128 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+)
+
+const savedViewColumns = `id, user_id, name, filters, created_at, updated_at`
+
+func scanSavedView(row interface{ Scan(...interface{}) error }) (*SavedView, error) {
+	var view SavedView
+	var filters []byte
+	err := row.Scan(
+		&view.ID,
+		&view.UserID,
+		&view.Name,
+		&filters,
+		&view.CreatedAt,
+		&view.UpdatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+	if err := json.Unmarshal(filters, &view.Filters); err != nil {
+		return nil, fmt.Errorf("failed to decode view filters: %w", err)
+	}
+	return &view, nil
+}
+
+func (r *repository) CreateSavedView(ctx context.Context, view *SavedView) error {
+	filters, err := json.Marshal(view.Filters)
+	if err != nil {
+		return fmt.Errorf("failed to encode view filters: %w", err)
+	}
+
+	query := `
+		INSERT INTO saved_views (id, user_id, name, filters)
+		VALUES ($1, $2, $3, $4)
+		RETURNING created_at, updated_at
+	`
+	err = r.db.DB.QueryRowContext(
+		ctx,
+		query,
+		view.ID,
+		view.UserID,
+		view.Name,
+		string(filters),
+	).Scan(&view.CreatedAt, &view.UpdatedAt)
+	if err != nil {
+		return fmt.Errorf("failed to create saved view: %w", err)
+	}
+	return nil
+}
+
+func (r *repository) GetSavedView(ctx context.Context, viewID string) (*SavedView, error) {
+	query := `SELECT ` + savedViewColumns + ` FROM saved_views WHERE id = $1`
+	view, err := scanSavedView(r.db.DB.QueryRowContext(ctx, query, viewID))
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, fmt.Errorf("saved view not found")
+		}
+		return nil, fmt.Errorf("failed to get saved view: %w", err)
+	}
+	return view, nil
+}
+
+func (r *repository) ListSavedViews(ctx context.Context, userID string) ([]*SavedView, error) {
+	query := `SELECT ` + savedViewColumns + ` FROM saved_views WHERE user_id = $1 ORDER BY created_at`
+	rows, err := r.db.DB.QueryContext(ctx, query, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list saved views: %w", err)
+	}
+	defer rows.Close()
+
+	var views []*SavedView
+	for rows.Next() {
+		view, err := scanSavedView(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan saved view: %w", err)
+		}
+		views = append(views, view)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("row iteration error: %w", err)
+	}
+	return views, nil
+}
+
+func (r *repository) UpdateSavedView(ctx context.Context, view *SavedView) error {
+	filters, err := json.Marshal(view.Filters)
+	if err != nil {
+		return fmt.Errorf("failed to encode view filters: %w", err)
+	}
+
+	query := `
+		UPDATE saved_views
+		SET name = $1, filters = $2, updated_at = NOW()
+		WHERE id = $3
+		RETURNING updated_at
+	`
+	err = r.db.DB.QueryRowContext(ctx, query, view.Name, string(filters), view.ID).Scan(&view.UpdatedAt)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return fmt.Errorf("saved view not found")
+		}
+		return fmt.Errorf("failed to update saved view: %w", err)
+	}
+	return nil
+}
+
+func (r *repository) DeleteSavedView(ctx context.Context, viewID string) error {
+	result, err := r.db.DB.ExecContext(ctx, `DELETE FROM saved_views WHERE id = $1`, viewID)
+	if err != nil {
+		return fmt.Errorf("failed to delete saved view: %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to get rows affected: %w", err)
+	}
+	if rowsAffected == 0 {
+		return fmt.Errorf("saved view not found")
+	}
+	return nil
+}
@@ -0,0 +1,275 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/lib/pq"
+)
+
+const webhookEndpointColumns = `id, user_id, url, events, secret, previous_secret, previous_secret_expires_at, enabled, created_at, updated_at`
+
+func scanWebhookEndpoint(row interface{ Scan(...interface{}) error }) (*WebhookEndpoint, error) {
+	var endpoint WebhookEndpoint
+	err := row.Scan(
+		&endpoint.ID,
+		&endpoint.UserID,
+		&endpoint.URL,
+		pq.Array(&endpoint.Events),
+		&endpoint.Secret,
+		&endpoint.PreviousSecret,
+		&endpoint.PreviousSecretExpiresAt,
+		&endpoint.Enabled,
+		&endpoint.CreatedAt,
+		&endpoint.UpdatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return &endpoint, nil
+}
+
+func (r *repository) CreateWebhookEndpoint(ctx context.Context, endpoint *WebhookEndpoint) error {
+	query := `
+		INSERT INTO webhook_endpoints (id, user_id, url, events, secret, enabled)
+		VALUES ($1, $2, $3, $4, $5, $6)
+		RETURNING created_at, updated_at
+	`
+	err := r.db.DB.QueryRowContext(
+		ctx,
+		query,
+		endpoint.ID,
+		endpoint.UserID,
+		endpoint.URL,
+		pq.Array(endpoint.Events),
+		endpoint.Secret,
+		endpoint.Enabled,
+	).Scan(&endpoint.CreatedAt, &endpoint.UpdatedAt)
+	if err != nil {
+		return fmt.Errorf("failed to create webhook endpoint: %w", err)
+	}
+	return nil
+}
+
+func (r *repository) GetWebhookEndpoint(ctx context.Context, endpointID string) (*WebhookEndpoint, error) {
+	query := `SELECT ` + webhookEndpointColumns + ` FROM webhook_endpoints WHERE id = $1`
+	endpoint, err := scanWebhookEndpoint(r.db.DB.QueryRowContext(ctx, query, endpointID))
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, fmt.Errorf("webhook endpoint not found")
+		}
+		return nil, fmt.Errorf("failed to get webhook endpoint: %w", err)
+	}
+	return endpoint, nil
+}
+
+func (r *repository) ListWebhookEndpoints(ctx context.Context, userID string) ([]*WebhookEndpoint, error) {
+	query := `SELECT ` + webhookEndpointColumns + ` FROM webhook_endpoints WHERE user_id = $1 ORDER BY created_at`
+	return r.queryWebhookEndpoints(ctx, query, userID)
+}
+
+// ListWebhookEndpointsForEvent returns the user's enabled endpoints that
+// subscribe to event, either explicitly or by listing no events.
+func (r *repository) ListWebhookEndpointsForEvent(ctx context.Context, userID, event string) ([]*WebhookEndpoint, error) {
+	query := `
+		SELECT ` + webhookEndpointColumns + `
+		FROM webhook_endpoints
+		WHERE user_id = $1 AND enabled AND (cardinality(events) = 0 OR $2 = ANY(events))
+		ORDER BY created_at
+	`
+	return r.queryWebhookEndpoints(ctx, query, userID, event)
+}
+
+func (r *repository) queryWebhookEndpoints(ctx context.Context, query string, args ...interface{}) ([]*WebhookEndpoint, error) {
+	rows, err := r.db.DB.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list webhook endpoints: %w", err)
+	}
+	defer rows.Close()
+
+	var endpoints []*WebhookEndpoint
+	for rows.Next() {
+		endpoint, err := scanWebhookEndpoint(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan webhook endpoint: %w", err)
+		}
+		endpoints = append(endpoints, endpoint)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("row iteration error: %w", err)
+	}
+	return endpoints, nil
+}
+
+func (r *repository) UpdateWebhookEndpoint(ctx context.Context, endpoint *WebhookEndpoint) error {
+	query := `
+		UPDATE webhook_endpoints
+		SET url = $1, events = $2, enabled = $3, updated_at = NOW()
+		WHERE id = $4
+		RETURNING updated_at
+	`
+	err := r.db.DB.QueryRowContext(ctx, query, endpoint.URL, pq.Array(endpoint.Events), endpoint.Enabled, endpoint.ID).Scan(&endpoint.UpdatedAt)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return fmt.Errorf("webhook endpoint not found")
+		}
+		return fmt.Errorf("failed to update webhook endpoint: %w", err)
+	}
+	return nil
+}
+
+// RotateWebhookSecret makes secret the endpoint's signing secret and keeps
+// the current one valid until previousExpiresAt.
+func (r *repository) RotateWebhookSecret(ctx context.Context, endpointID, secret string, previousExpiresAt time.Time) error {
+	query := `
+		UPDATE webhook_endpoints
+		SET previous_secret = secret, previous_secret_expires_at = $1, secret = $2, updated_at = NOW()
+		WHERE id = $3
+	`
+	result, err := r.db.DB.ExecContext(ctx, query, previousExpiresAt, secret, endpointID)
+	if err != nil {
+		return fmt.Errorf("failed to rotate webhook secret: %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to get rows affected: %w", err)
+	}
+	if rowsAffected == 0 {
+		return fmt.Errorf("webhook endpoint not found")
+	}
+	return nil
+}
+
+func (r *repository) DeleteWebhookEndpoint(ctx context.Context, endpointID string) error {
+	result, err := r.db.DB.ExecContext(ctx, `DELETE FROM webhook_endpoints WHERE id = $1`, endpointID)
+	if err != nil {
+		return fmt.Errorf("failed to delete webhook endpoint: %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to get rows affected: %w", err)
+	}
+	if rowsAffected == 0 {
+		return fmt.Errorf("webhook endpoint not found")
+	}
+	return nil
+}
+
+const webhookDeliveryColumns = `id, endpoint_id, event, payload, status, attempts, response_code, latency_ms, error, redelivery_of, created_at, last_attempt_at`
+
+func scanWebhookDelivery(row interface{ Scan(...interface{}) error }) (*WebhookDelivery, error) {
+	var delivery WebhookDelivery
+	err := row.Scan(
+		&delivery.ID,
+		&delivery.EndpointID,
+		&delivery.Event,
+		&delivery.Payload,
+		&delivery.Status,
+		&delivery.Attempts,
+		&delivery.ResponseCode,
+		&delivery.LatencyMs,
+		&delivery.Error,
+		&delivery.RedeliveryOf,
+		&delivery.CreatedAt,
+		&delivery.LastAttemptAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return &delivery, nil
+}
+
+func (r *repository) CreateWebhookDelivery(ctx context.Context, delivery *WebhookDelivery) error {
+	query := `
+		INSERT INTO webhook_deliveries (id, endpoint_id, event, payload, status, redelivery_of)
+		VALUES ($1, $2, $3, $4, $5, $6)
+		RETURNING created_at
+	`
+	err := r.db.DB.QueryRowContext(
+		ctx,
+		query,
+		delivery.ID,
+		delivery.EndpointID,
+		delivery.Event,
+		string(delivery.Payload),
+		delivery.Status,
+		delivery.RedeliveryOf,
+	).Scan(&delivery.CreatedAt)
+	if err != nil {
+		return fmt.Errorf("failed to create webhook delivery: %w", err)
+	}
+	return nil
+}
+
+func (r *repository) GetWebhookDelivery(ctx context.Context, deliveryID string) (*WebhookDelivery, error) {
+	query := `SELECT ` + webhookDeliveryColumns + ` FROM webhook_deliveries WHERE id = $1`
+	delivery, err := scanWebhookDelivery(r.db.DB.QueryRowContext(ctx, query, deliveryID))
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, fmt.Errorf("webhook delivery not found")
+		}
+		return nil, fmt.Errorf("failed to get webhook delivery: %w", err)
+	}
+	return delivery, nil
+}
+
+func (r *repository) ListWebhookDeliveries(ctx context.Context, endpointID string, limit int) ([]*WebhookDelivery, error) {
+	query := `
+		SELECT ` + webhookDeliveryColumns + `
+		FROM webhook_deliveries
+		WHERE endpoint_id = $1
+		ORDER BY created_at DESC
+		LIMIT $2
+	`
+	rows, err := r.db.DB.QueryContext(ctx, query, endpointID, limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list webhook deliveries: %w", err)
+	}
+	defer rows.Close()
+
+	var deliveries []*WebhookDelivery
+	for rows.Next() {
+		delivery, err := scanWebhookDelivery(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan webhook delivery: %w", err)
+		}
+		deliveries = append(deliveries, delivery)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("row iteration error: %w", err)
+	}
+	return deliveries, nil
+}
+
+// RecordWebhookDeliveryAttempt stores the outcome of one attempt, counting it
+// towards the delivery's attempts.
+func (r *repository) RecordWebhookDeliveryAttempt(ctx context.Context, delivery *WebhookDelivery) error {
+	query := `
+		UPDATE webhook_deliveries
+		SET status = $1, attempts = attempts + 1, response_code = $2, latency_ms = $3, error = $4, last_attempt_at = NOW()
+		WHERE id = $5
+		RETURNING attempts, last_attempt_at
+	`
+	err := r.db.DB.QueryRowContext(
+		ctx,
+		query,
+		delivery.Status,
+		delivery.ResponseCode,
+		delivery.LatencyMs,
+		delivery.Error,
+		delivery.ID,
+	).Scan(&delivery.Attempts, &delivery.LastAttemptAt)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return fmt.Errorf("webhook delivery not found")
+		}
+		return fmt.Errorf("failed to record webhook delivery attempt: %w", err)
+	}
+	return nil
+}
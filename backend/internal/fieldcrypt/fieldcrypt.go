@@ -0,0 +1,101 @@
+// Package fieldcrypt encrypts sensitive column values, such as document
+// identifiers, before they are written to the database. Alongside each
+// ciphertext a keyed blind index is stored so exact-match lookups still work
+// without decrypting every row.
+package fieldcrypt
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// prefix marks an encrypted value. Values without it are legacy plaintext
+// written before encryption was enabled.
+const prefix = "enc:v1:"
+
+// KeySize is the length of both the encryption and the blind index key.
+const KeySize = 32
+
+var ErrMalformed = errors.New("malformed encrypted value")
+
+// Cipher seals values with AES-256-GCM and derives their blind index with
+// HMAC-SHA256 under a separate key.
+type Cipher struct {
+	aead     cipher.AEAD
+	indexKey []byte
+}
+
+func New(key, indexKey []byte) (*Cipher, error) {
+	if len(key) != KeySize || len(indexKey) != KeySize {
+		return nil, fmt.Errorf("field encryption keys must be %d bytes", KeySize)
+	}
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	aead, err := cipher.NewGCM(block)
+	if err != nil {
+		return nil, err
+	}
+	return &Cipher{aead: aead, indexKey: indexKey}, nil
+}
+
+// Encrypt returns the sealed form of plaintext. Each call uses a fresh
+// nonce, so equal plaintexts produce different ciphertexts.
+func (c *Cipher) Encrypt(plaintext string) (string, error) {
+	nonce := make([]byte, c.aead.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return "", err
+	}
+	sealed := c.aead.Seal(nonce, nonce, []byte(plaintext), nil)
+	return prefix + base64.StdEncoding.EncodeToString(sealed), nil
+}
+
+// Decrypt opens a value produced by Encrypt. Legacy plaintext values are
+// returned unchanged.
+func (c *Cipher) Decrypt(value string) (string, error) {
+	if !IsEncrypted(value) {
+		return value, nil
+	}
+	sealed, err := base64.StdEncoding.DecodeString(strings.TrimPrefix(value, prefix))
+	if err != nil || len(sealed) < c.aead.NonceSize() {
+		return "", ErrMalformed
+	}
+	nonce, ciphertext := sealed[:c.aead.NonceSize()], sealed[c.aead.NonceSize():]
+	plaintext, err := c.aead.Open(nil, nonce, ciphertext, nil)
+	if err != nil {
+		return "", fmt.Errorf("failed to decrypt value: %w", err)
+	}
+	return string(plaintext), nil
+}
+
+// BlindIndex returns a deterministic, keyed digest of value for equality
+// lookups. Case, spaces and dashes are ignored, so "ab-123 45" and "AB12345"
+// match.
+func (c *Cipher) BlindIndex(value string) string {
+	mac := hmac.New(sha256.New, c.indexKey)
+	mac.Write([]byte(Normalize(value)))
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// Normalize is the form of a value its blind index is computed over.
+func Normalize(value string) string {
+	return strings.Map(func(r rune) rune {
+		if r == ' ' || r == '-' || r == '\t' {
+			return -1
+		}
+		return r
+	}, strings.ToUpper(strings.TrimSpace(value)))
+}
+
+func IsEncrypted(value string) bool {
+	return strings.HasPrefix(value, prefix)
+}
@@ -0,0 +1,49 @@
+package fieldcrypt
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+
+	awsconfig "github.com/aws/aws-sdk-go-v2/config"
+	"github.com/aws/aws-sdk-go-v2/service/kms"
+)
+
+// Load builds a cipher from base64-encoded keys. When kmsDataKey is set it
+// takes precedence over key: it is a data key encrypted under an AWS KMS key
+// (as returned by GenerateDataKey) and is decrypted with KMS at startup, so
+// the plaintext key never sits in the environment.
+func Load(ctx context.Context, key, kmsDataKey, indexKey string) (*Cipher, error) {
+	var rawKey []byte
+	var err error
+	if kmsDataKey != "" {
+		rawKey, err = decryptWithKMS(ctx, kmsDataKey)
+	} else {
+		rawKey, err = base64.StdEncoding.DecodeString(key)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("invalid field encryption key: %w", err)
+	}
+
+	rawIndexKey, err := base64.StdEncoding.DecodeString(indexKey)
+	if err != nil {
+		return nil, fmt.Errorf("invalid blind index key: %w", err)
+	}
+	return New(rawKey, rawIndexKey)
+}
+
+func decryptWithKMS(ctx context.Context, dataKey string) ([]byte, error) {
+	blob, err := base64.StdEncoding.DecodeString(dataKey)
+	if err != nil {
+		return nil, err
+	}
+	awsCfg, err := awsconfig.LoadDefaultConfig(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load AWS config: %w", err)
+	}
+	out, err := kms.NewFromConfig(awsCfg).Decrypt(ctx, &kms.DecryptInput{CiphertextBlob: blob})
+	if err != nil {
+		return nil, fmt.Errorf("failed to decrypt data key with KMS: %w", err)
+	}
+	return out.Plaintext, nil
+}
@@ -0,0 +1,131 @@
+// Package geoip locates IP addresses offline, from a DB-IP Lite CSV
+// database, so no request leaves the server to find where a login came from.
+package geoip
+
+import (
+	"compress/gzip"
+	"encoding/csv"
+	"fmt"
+	"io"
+	"net/netip"
+	"os"
+	"sort"
+	"strings"
+)
+
+// Location is the approximate place an IP address is registered to. Fields
+// the database does not have are empty.
+type Location struct {
+	// Country is an ISO 3166-1 alpha-2 code.
+	Country string
+	Region  string
+	City    string
+}
+
+type ipRange struct {
+	start, end netip.Addr
+	location   *Location
+}
+
+// DB is an IP to location database. A nil *DB locates nothing.
+type DB struct {
+	ranges []ipRange
+}
+
+// Open reads a DB-IP Lite database in CSV format, gzipped when path ends in
+// .gz: either "IP to Country" (ip_start, ip_end, country) or "IP to City"
+// (ip_start, ip_end, continent, country, region, city, latitude,
+// longitude). See https://db-ip.com/db/lite.php.
+func Open(path string) (*DB, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open geoip database: %w", err)
+	}
+	defer f.Close()
+
+	var src io.Reader = f
+	if strings.HasSuffix(path, ".gz") {
+		gz, err := gzip.NewReader(f)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read geoip database: %w", err)
+		}
+		defer gz.Close()
+		src = gz
+	}
+
+	reader := csv.NewReader(src)
+	reader.FieldsPerRecord = -1
+	reader.ReuseRecord = true
+
+	db := &DB{}
+	// consecutive ranges mostly share a location, which saves memory on the
+	// millions of rows of the city database
+	var last *Location
+	for line := 1; ; line++ {
+		record, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to read geoip database: %w", err)
+		}
+
+		var location Location
+		switch {
+		case len(record) == 3:
+			location.Country = record[2]
+		case len(record) >= 6:
+			location.Country, location.Region, location.City = record[3], record[4], record[5]
+		default:
+			return nil, fmt.Errorf("geoip database line %d: unexpected %d columns", line, len(record))
+		}
+		start, err := netip.ParseAddr(record[0])
+		if err != nil {
+			return nil, fmt.Errorf("geoip database line %d: %w", line, err)
+		}
+		end, err := netip.ParseAddr(record[1])
+		if err != nil {
+			return nil, fmt.Errorf("geoip database line %d: %w", line, err)
+		}
+
+		if last == nil || *last != location {
+			last = &location
+		}
+		db.ranges = append(db.ranges, ipRange{start: start.Unmap(), end: end.Unmap(), location: last})
+	}
+
+	sort.Slice(db.ranges, func(i, j int) bool {
+		return db.ranges[i].start.Less(db.ranges[j].start)
+	})
+	return db, nil
+}
+
+// Lookup returns the location of ip, and false when the database does not
+// cover it, as with private addresses.
+func (db *DB) Lookup(ip netip.Addr) (Location, bool) {
+	if db == nil || !ip.IsValid() {
+		return Location{}, false
+	}
+	ip = ip.Unmap()
+
+	// the last range starting at or before ip
+	i := sort.Search(len(db.ranges), func(i int) bool {
+		return ip.Less(db.ranges[i].start)
+	}) - 1
+	if i < 0 {
+		return Location{}, false
+	}
+	found := db.ranges[i]
+	if found.end.Less(ip) || found.start.BitLen() != ip.BitLen() {
+		return Location{}, false
+	}
+	return *found.location, true
+}
+
+// Len returns the number of address ranges in the database.
+func (db *DB) Len() int {
+	if db == nil {
+		return 0
+	}
+	return len(db.ranges)
+}
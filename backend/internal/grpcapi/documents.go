@@ -0,0 +1,259 @@
+package grpcapi
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"xpired/internal/auth"
+	"xpired/internal/db"
+	xpiredv1 "xpired/internal/pb/xpired/v1"
+	worker "xpired/internal/worker"
+)
+
+type documentService struct {
+	xpiredv1.UnimplementedDocumentServiceServer
+	repo db.Repository
+}
+
+func toProtoDocument(doc *db.Document, intervals []*db.ReminderInterval) *xpiredv1.Document {
+	pbDoc := &xpiredv1.Document{
+		Id:             doc.ID.String(),
+		UserId:         doc.UserID.String(),
+		Name:           doc.Name,
+		Description:    doc.Description,
+		Identifier:     doc.Identifier,
+		ExpirationDate: timestamppb.New(doc.ExpirationDate),
+		Timezone:       doc.Timezone,
+		AttachmentUrl:  doc.AttachmentURL,
+		Category:       doc.Category,
+		CreatedAt:      timestamppb.New(doc.CreatedAt),
+		UpdatedAt:      timestamppb.New(doc.UpdatedAt),
+	}
+	for _, interval := range intervals {
+		pbDoc.Reminders = append(pbDoc.Reminders, &xpiredv1.ReminderInterval{
+			Id:    interval.IdLabel,
+			Label: interval.Label,
+		})
+	}
+	return pbDoc
+}
+
+// documentIntervals returns the reminder intervals configured on a document.
+func (s *documentService) documentIntervals(ctx context.Context, documentID string) []*db.ReminderInterval {
+	reminders, err := s.repo.GetDocumentRemindersByDocumentID(ctx, documentID)
+	if err != nil {
+		return nil
+	}
+
+	var intervals []*db.ReminderInterval
+	for _, reminder := range reminders {
+		interval, err := s.repo.GetReminderIntervalByID(ctx, reminder.ReminderIntervalID)
+		if err == nil {
+			intervals = append(intervals, interval)
+		}
+	}
+	return intervals
+}
+
+// setReminders enables the given intervals on doc and returns them.
+func (s *documentService) setReminders(ctx context.Context, doc *db.Document, idLabels []string) ([]*db.ReminderInterval, error) {
+	intervals, err := s.repo.GetReminderIntervalsFromIdLabels(ctx, idLabels)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "failed to fetch reminder intervals")
+	}
+
+	for _, interval := range intervals {
+		docReminder := &db.DocumentReminder{
+			ID:                 uuid.New(),
+			DocumentID:         doc.ID.String(),
+			ReminderIntervalID: interval.ID,
+			Enabled:            true,
+		}
+		if err := s.repo.SetDocumentReminders(ctx, doc.ID.String(), docReminder); err != nil {
+			return nil, status.Error(codes.Internal, "failed to set document reminders")
+		}
+	}
+	return intervals, nil
+}
+
+func (s *documentService) ListDocuments(ctx context.Context, req *xpiredv1.ListDocumentsRequest) (*xpiredv1.ListDocumentsResponse, error) {
+	userID, err := auth.UserIDFromContext(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, "unauthorized")
+	}
+
+	documents, err := s.repo.ListDocumentsByUserID(ctx, userID)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "failed to fetch documents")
+	}
+
+	resp := &xpiredv1.ListDocumentsResponse{}
+	for _, doc := range documents {
+		resp.Documents = append(resp.Documents, toProtoDocument(doc, s.documentIntervals(ctx, doc.ID.String())))
+	}
+	return resp, nil
+}
+
+func (s *documentService) GetDocument(ctx context.Context, req *xpiredv1.GetDocumentRequest) (*xpiredv1.Document, error) {
+	doc, err := loadManagedDocument(ctx, s.repo, req.GetId())
+	if err != nil {
+		return nil, err
+	}
+	return toProtoDocument(doc, s.documentIntervals(ctx, doc.ID.String())), nil
+}
+
+func (s *documentService) CreateDocument(ctx context.Context, req *xpiredv1.CreateDocumentRequest) (*xpiredv1.Document, error) {
+	userID, err := auth.UserIDFromContext(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, "unauthorized")
+	}
+	if req.GetName() == "" || req.GetExpirationDate() == nil || req.GetTimezone() == "" {
+		return nil, status.Error(codes.InvalidArgument, "missing required fields")
+	}
+
+	reminders := req.GetReminders()
+	if req.Category != nil {
+		category, err := s.repo.GetDocumentCategory(ctx, req.GetCategory())
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, "unknown document category")
+		}
+		if len(reminders) == 0 {
+			reminders = category.DefaultReminders
+		}
+	}
+
+	quota, err := worker.DocumentQuotaUsage(ctx, s.repo, userID)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "failed to check document quota")
+	}
+	if quota.Reached() {
+		return nil, status.Error(codes.ResourceExhausted, "document limit of the plan reached")
+	}
+
+	doc := &db.Document{
+		ID:             uuid.New(),
+		UserID:         uuid.MustParse(userID),
+		Name:           req.GetName(),
+		Description:    req.Description,
+		Identifier:     req.Identifier,
+		ExpirationDate: req.GetExpirationDate().AsTime(),
+		Timezone:       req.GetTimezone(),
+		AttachmentURL:  req.AttachmentUrl,
+		Category:       req.Category,
+		CreatedAt:      time.Now(),
+		UpdatedAt:      time.Now(),
+	}
+	if err := s.repo.CreateDocument(ctx, doc); err != nil {
+		return nil, status.Error(codes.Internal, "failed to create document")
+	}
+	quota.Used++
+	worker.WarnNearQuota(ctx, s.repo, userID, quota)
+
+	intervals, err := s.setReminders(ctx, doc, reminders)
+	if err != nil {
+		return nil, err
+	}
+
+	var reminderValues []db.ReminderInterval
+	for _, interval := range intervals {
+		reminderValues = append(reminderValues, *interval)
+	}
+	worker.ScheduleReminders(ctx, *doc, doc.UserID, reminderValues)
+	worker.EmitWebhookEvent(ctx, doc.UserID.String(), db.WebhookEventDocumentCreated, doc)
+
+	return toProtoDocument(doc, intervals), nil
+}
+
+func (s *documentService) UpdateDocument(ctx context.Context, req *xpiredv1.UpdateDocumentRequest) (*xpiredv1.Document, error) {
+	doc, err := loadManagedDocument(ctx, s.repo, req.GetId())
+	if err != nil {
+		return nil, err
+	}
+	if err := ensureDocumentUnlocked(ctx, s.repo, doc); err != nil {
+		return nil, err
+	}
+
+	if req.GetName() != "" {
+		doc.Name = req.GetName()
+	}
+	if req.Description != nil {
+		doc.Description = req.Description
+	}
+	if req.Identifier != nil {
+		doc.Identifier = req.Identifier
+	}
+	previousExpiration := doc.ExpirationDate
+	if req.ExpirationDate != nil {
+		expirationDate := req.GetExpirationDate().AsTime()
+		if !sameDate(expirationDate, doc.ExpirationDate) {
+			required, err := s.repo.RenewalApprovalRequired(ctx, doc)
+			if err != nil {
+				return nil, status.Error(codes.Internal, "failed to check organization renewal policy")
+			}
+			if required {
+				return nil, status.Error(codes.FailedPrecondition, "the organization requires renewals to be approved; submit a renewal request instead")
+			}
+		}
+		doc.ExpirationDate = expirationDate
+	}
+	if req.GetTimezone() != "" {
+		doc.Timezone = req.GetTimezone()
+	}
+	if req.AttachmentUrl != nil {
+		doc.AttachmentURL = req.AttachmentUrl
+	}
+	if req.Category != nil {
+		if _, err := s.repo.GetDocumentCategory(ctx, req.GetCategory()); err != nil {
+			return nil, status.Error(codes.InvalidArgument, "unknown document category")
+		}
+		doc.Category = req.Category
+	}
+	doc.UpdatedAt = time.Now()
+
+	if err := s.repo.UpdateDocument(ctx, doc); err != nil {
+		return nil, status.Error(codes.Internal, "failed to update document")
+	}
+
+	if _, err := s.setReminders(ctx, doc, req.GetReminders()); err != nil {
+		return nil, err
+	}
+	worker.EmitWebhookEvent(ctx, doc.UserID.String(), db.WebhookEventDocumentUpdated, doc)
+	if !sameDate(previousExpiration, doc.ExpirationDate) {
+		worker.ScheduleDependentsNotice(ctx, *doc, previousExpiration)
+	}
+	return toProtoDocument(doc, s.documentIntervals(ctx, doc.ID.String())), nil
+}
+
+func (s *documentService) DeleteDocument(ctx context.Context, req *xpiredv1.DeleteDocumentRequest) (*xpiredv1.DeleteDocumentResponse, error) {
+	doc, err := loadManagedDocument(ctx, s.repo, req.GetId())
+	if err != nil {
+		return nil, err
+	}
+	if err := ensureDocumentUnlocked(ctx, s.repo, doc); err != nil {
+		return nil, err
+	}
+	held, err := s.repo.UnderLegalHold(ctx, doc)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "failed to check legal hold")
+	}
+	if held {
+		return nil, status.Error(codes.FailedPrecondition, "document is under legal hold and cannot be deleted")
+	}
+
+	if err := s.repo.DeleteDocument(ctx, doc.ID.String()); err != nil {
+		return nil, status.Error(codes.Internal, "failed to delete document")
+	}
+	recordDocumentTrashed(ctx, s.repo, doc)
+	worker.EmitWebhookEvent(ctx, doc.UserID.String(), db.WebhookEventDocumentDeleted, doc)
+	return &xpiredv1.DeleteDocumentResponse{}, nil
+}
+
+// sameDate reports whether a and b fall on the same calendar day.
+func sameDate(a, b time.Time) bool {
+	return a.Format("2006-01-02") == b.Format("2006-01-02")
+}
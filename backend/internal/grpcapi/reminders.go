@@ -0,0 +1,85 @@
+package grpcapi
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"xpired/internal/db"
+	xpiredv1 "xpired/internal/pb/xpired/v1"
+)
+
+type reminderService struct {
+	xpiredv1.UnimplementedReminderServiceServer
+	repo db.Repository
+}
+
+func (s *reminderService) ListReminderIntervals(ctx context.Context, req *xpiredv1.ListReminderIntervalsRequest) (*xpiredv1.ListReminderIntervalsResponse, error) {
+	intervals, err := s.repo.GetAllReminderIntervals(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "failed to fetch reminder intervals")
+	}
+
+	resp := &xpiredv1.ListReminderIntervalsResponse{}
+	for _, interval := range intervals {
+		resp.ReminderIntervals = append(resp.ReminderIntervals, &xpiredv1.ReminderInterval{
+			Id:    interval.IdLabel,
+			Label: interval.Label,
+		})
+	}
+	return resp, nil
+}
+
+func (s *reminderService) GetDocumentReminders(ctx context.Context, req *xpiredv1.GetDocumentRemindersRequest) (*xpiredv1.GetDocumentRemindersResponse, error) {
+	doc, err := loadManagedDocument(ctx, s.repo, req.GetDocumentId())
+	if err != nil {
+		return nil, err
+	}
+
+	reminders, err := s.repo.GetDocumentRemindersByDocumentID(ctx, doc.ID.String())
+	if err != nil {
+		return nil, status.Error(codes.Internal, "failed to fetch document reminders")
+	}
+
+	resp := &xpiredv1.GetDocumentRemindersResponse{}
+	for _, reminder := range reminders {
+		interval, err := s.repo.GetReminderIntervalByID(ctx, reminder.ReminderIntervalID)
+		if err != nil {
+			continue
+		}
+		resp.Reminders = append(resp.Reminders, &xpiredv1.DocumentReminder{
+			Id:      interval.IdLabel,
+			Label:   interval.Label,
+			Enabled: reminder.Enabled,
+		})
+	}
+	return resp, nil
+}
+
+func (s *reminderService) ToggleDocumentReminder(ctx context.Context, req *xpiredv1.ToggleDocumentReminderRequest) (*xpiredv1.ToggleDocumentReminderResponse, error) {
+	doc, err := loadManagedDocument(ctx, s.repo, req.GetDocumentId())
+	if err != nil {
+		return nil, err
+	}
+
+	intervals, err := s.repo.GetReminderIntervalsFromIdLabels(ctx, []string{req.GetIntervalId()})
+	if err != nil || len(intervals) == 0 {
+		return nil, status.Error(codes.NotFound, "reminder interval not found")
+	}
+
+	if !req.GetEnabled() {
+		required, err := s.repo.IsComplianceDocument(ctx, doc)
+		if err != nil {
+			return nil, status.Error(codes.Internal, "failed to check organization compliance policy")
+		}
+		if required {
+			return nil, status.Error(codes.FailedPrecondition, "reminders on compliance documents cannot be turned off")
+		}
+	}
+
+	if err := s.repo.ToggleDocumentReminder(ctx, doc.ID.String(), intervals[0].ID, req.GetEnabled()); err != nil {
+		return nil, status.Error(codes.Internal, "failed to toggle document reminder")
+	}
+	return &xpiredv1.ToggleDocumentReminderResponse{}, nil
+}
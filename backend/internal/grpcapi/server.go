@@ -0,0 +1,139 @@
+// Package grpcapi serves the core document and reminder operations over gRPC
+// for internal consumers, alongside the REST API in package api.
+package grpcapi
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"log"
+	"strings"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	"xpired/internal/auth"
+	"xpired/internal/db"
+	xpiredv1 "xpired/internal/pb/xpired/v1"
+	"xpired/internal/worker"
+)
+
+// NewServer returns a gRPC server with every service registered. Calls are
+// authenticated with the REST API's JWT, sent as "authorization" metadata.
+func NewServer(repo db.Repository) *grpc.Server {
+	server := grpc.NewServer(grpc.UnaryInterceptor(authInterceptor))
+
+	xpiredv1.RegisterDocumentServiceServer(server, &documentService{repo: repo})
+	xpiredv1.RegisterReminderServiceServer(server, &reminderService{repo: repo})
+	return server
+}
+
+// requestID returns the "x-request-id" metadata of a call, as the REST API
+// takes the X-Request-Id header, or a new ID when there is none.
+func requestID(md metadata.MD) string {
+	if values := md.Get("x-request-id"); len(values) > 0 && values[0] != "" {
+		return values[0]
+	}
+	return uuid.NewString()
+}
+
+func authInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	md, _ := metadata.FromIncomingContext(ctx)
+	id := requestID(md)
+	ctx = worker.WithRequestID(ctx, id)
+	_ = grpc.SetHeader(ctx, metadata.Pairs("x-request-id", id))
+
+	values := md.Get("authorization")
+	if len(values) == 0 || !strings.HasPrefix(values[0], "Bearer ") {
+		return nil, status.Error(codes.Unauthenticated, "missing auth token")
+	}
+
+	claims, err := auth.ParseToken(strings.TrimPrefix(values[0], "Bearer "))
+	if err != nil {
+		return nil, status.Errorf(codes.Unauthenticated, "invalid token: %v", err)
+	}
+
+	if err := auth.CheckRevoked(ctx, claims); err != nil {
+		if errors.Is(err, auth.ErrSessionRevoked) {
+			return nil, status.Error(codes.Unauthenticated, "session revoked")
+		}
+		log.Printf("Failed to check revocation of session %s: %v", claims.ID, err)
+		return nil, status.Error(codes.Unauthenticated, "session unavailable")
+	}
+
+	if err := auth.CheckSuspended(ctx, claims.Subject); err != nil {
+		if errors.Is(err, auth.ErrAccountSuspended) {
+			return nil, status.Error(codes.PermissionDenied, "account suspended")
+		}
+		log.Printf("Failed to check suspension of user %s: %v", claims.Subject, err)
+		return nil, status.Error(codes.Unauthenticated, "account unavailable")
+	}
+
+	ctx = auth.WithUserID(ctx, claims.Subject)
+	ctx = auth.WithRole(ctx, auth.TokenRole(ctx, claims))
+	if claims.Actor != nil {
+		log.Printf("Admin %s impersonating user %s: %s", claims.Actor.Subject, claims.Subject, info.FullMethod)
+		ctx = auth.WithImpersonator(ctx, claims.Actor.Subject)
+	}
+	return handler(ctx, req)
+}
+
+// loadManagedDocument returns the document if the caller may view and modify
+// it: they own it, or they are the primary of the owner's household.
+func loadManagedDocument(ctx context.Context, repo db.Repository, documentID string) (*db.Document, error) {
+	userID, err := auth.UserIDFromContext(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, "unauthorized")
+	}
+	if documentID == "" {
+		return nil, status.Error(codes.InvalidArgument, "document ID is required")
+	}
+
+	doc, err := repo.GetDocumentByID(ctx, documentID)
+	if err != nil {
+		return nil, status.Error(codes.NotFound, "document not found")
+	}
+
+	if doc.UserID.String() != userID {
+		isPrimary, err := repo.IsHouseholdPrimaryOf(ctx, userID, doc.UserID.String())
+		if err != nil || !isPrimary {
+			return nil, status.Error(codes.PermissionDenied, "forbidden")
+		}
+	}
+	return doc, nil
+}
+
+// ensureDocumentUnlocked rejects an edit while another user holds the
+// document's edit lock.
+func ensureDocumentUnlocked(ctx context.Context, repo db.Repository, doc *db.Document) error {
+	userID, _ := auth.UserIDFromContext(ctx)
+	lock, err := repo.GetDocumentLock(ctx, doc.ID.String())
+	if err != nil || lock.UserID == userID {
+		return nil
+	}
+	return status.Errorf(codes.FailedPrecondition, "document is being edited by %s", lock.UserName)
+}
+
+func recordDocumentTrashed(ctx context.Context, repo db.Repository, doc *db.Document) {
+	userID, _ := auth.UserIDFromContext(ctx)
+	ownerID := doc.UserID.String()
+	details, _ := json.Marshal(map[string]interface{}{
+		"name": doc.Name,
+	})
+	entry := &db.AuditLog{
+		ID:             uuid.New(),
+		ActorID:        &userID,
+		UserID:         &ownerID,
+		OrganizationID: doc.OrganizationID,
+		Action:         db.AuditActionDocumentTrashed,
+		EntityType:     "document",
+		EntityID:       doc.ID.String(),
+		Metadata:       details,
+	}
+	if err := repo.CreateAuditLog(ctx, entry); err != nil {
+		log.Printf("Failed to record trash of document %s in audit log: %v", doc.ID.String(), err)
+	}
+}
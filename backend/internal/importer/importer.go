@@ -0,0 +1,259 @@
+// Package importer maps document lists exported from other tools onto
+// xpired documents.
+package importer
+
+import (
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+	"time"
+	"unicode"
+)
+
+// ErrUnknownSource is returned by Parse for a source it has no mapping for.
+var ErrUnknownSource = errors.New("unknown import source")
+
+// Fields of an imported document.
+const (
+	FieldName           = "name"
+	FieldDescription    = "description"
+	FieldIdentifier     = "identifier"
+	FieldExpirationDate = "expirationDate"
+	FieldCategory       = "category"
+)
+
+// Source describes one export format: which column headers feed which
+// document field, and the category its documents default to.
+type Source struct {
+	Name            string
+	Columns         map[string][]string
+	DefaultCategory string
+}
+
+// Row is one document parsed from an export.
+type Row struct {
+	Line           int
+	Name           string
+	Description    *string
+	Identifier     *string
+	ExpirationDate time.Time
+	Category       *string
+}
+
+// RowError reports a line that could not be mapped to a document.
+type RowError struct {
+	Line  int    `json:"line"`
+	Error string `json:"error"`
+}
+
+// Result is the outcome of parsing an export.
+type Result struct {
+	Rows            []*Row
+	Errors          []RowError
+	UnmappedColumns []string
+}
+
+var sources = map[string]*Source{
+	"1password": {
+		Name: "1Password document list",
+		Columns: map[string][]string{
+			FieldName:           {"title", "name"},
+			FieldDescription:    {"notes", "notesplain"},
+			FieldIdentifier:     {"number", "documentnumber", "licensenumber", "membershipnumber", "policynumber"},
+			FieldExpirationDate: {"expirydate", "expires", "expirationdate", "validuntil"},
+			FieldCategory:       {"type", "category"},
+		},
+	},
+	"google-sheets": {
+		Name: "Google Sheets CSV template",
+		Columns: map[string][]string{
+			FieldName:           {"name", "document", "documentname", "title"},
+			FieldDescription:    {"description", "notes"},
+			FieldIdentifier:     {"identifier", "number", "documentnumber", "id"},
+			FieldExpirationDate: {"expirationdate", "expirydate", "expires", "expiry", "renewby"},
+			FieldCategory:       {"category", "type"},
+		},
+	},
+	"google-keep": {
+		Name: "Google Keep CSV template",
+		Columns: map[string][]string{
+			FieldName:           {"title"},
+			FieldDescription:    {"text", "content"},
+			FieldExpirationDate: {"reminder", "reminderdate", "date"},
+			FieldCategory:       {"labels", "label"},
+		},
+	},
+	"certificates": {
+		Name: "Certificate inventory",
+		Columns: map[string][]string{
+			FieldName:           {"commonname", "cn", "subject", "domain", "hostname"},
+			FieldDescription:    {"issuer", "issuedby"},
+			FieldIdentifier:     {"serial", "serialnumber", "fingerprint", "sha256fingerprint"},
+			FieldExpirationDate: {"notafter", "validto", "expires", "expirationdate", "expirydate"},
+		},
+		DefaultCategory: "certificate",
+	},
+}
+
+// SourceNames lists the supported sources, sorted.
+func SourceNames() []string {
+	names := make([]string, 0, len(sources))
+	for name := range sources {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// dateLayouts covers the formats seen in the supported exports, including
+// OpenSSL's notAfter output.
+var dateLayouts = []string{
+	"2006-01-02",
+	time.RFC3339,
+	"2006-01-02 15:04:05",
+	"01/02/2006",
+	"1/2/2006",
+	"2006/01/02",
+	"Jan 2, 2006",
+	"January 2, 2006",
+	"2 Jan 2006",
+	"2 January 2006",
+	"Jan _2 15:04:05 2006 MST",
+}
+
+func parseDate(value string) (time.Time, error) {
+	value = strings.TrimSpace(value)
+	for _, layout := range dateLayouts {
+		if t, err := time.Parse(layout, value); err == nil {
+			return t, nil
+		}
+	}
+	return time.Time{}, fmt.Errorf("unrecognized date %q", value)
+}
+
+// normalizeHeader lowercases a header and drops everything but letters and
+// digits, so "Expiry Date", "expiry_date" and "ExpiryDate" all match.
+func normalizeHeader(header string) string {
+	var b strings.Builder
+	for _, r := range strings.ToLower(header) {
+		if unicode.IsLetter(r) || unicode.IsDigit(r) {
+			b.WriteRune(r)
+		}
+	}
+	return b.String()
+}
+
+// Parse reads a CSV export of the given source. Columns that do not map to a
+// document field are reported in UnmappedColumns; rows missing a name or a
+// usable expiration date are reported in Errors and skipped.
+func Parse(source string, r io.Reader) (*Result, error) {
+	src, ok := sources[source]
+	if !ok {
+		return nil, ErrUnknownSource
+	}
+
+	reader := csv.NewReader(r)
+	reader.FieldsPerRecord = -1
+	reader.TrimLeadingSpace = true
+
+	headers, err := reader.Read()
+	if err != nil {
+		return nil, fmt.Errorf("failed to read header row: %w", err)
+	}
+
+	fieldByAlias := make(map[string]string)
+	for field, aliases := range src.Columns {
+		for _, alias := range aliases {
+			fieldByAlias[alias] = field
+		}
+	}
+
+	result := &Result{}
+	columnField := make(map[int]string)
+	for i, header := range headers {
+		field, ok := fieldByAlias[normalizeHeader(header)]
+		if !ok || containsValue(columnField, field) {
+			result.UnmappedColumns = append(result.UnmappedColumns, strings.TrimSpace(header))
+			continue
+		}
+		columnField[i] = field
+	}
+	if !containsValue(columnField, FieldName) || !containsValue(columnField, FieldExpirationDate) {
+		return nil, fmt.Errorf("%s export must have a name and an expiration date column", src.Name)
+	}
+
+	line := 1
+	for {
+		record, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+		line++
+		if err != nil {
+			result.Errors = append(result.Errors, RowError{Line: line, Error: err.Error()})
+			continue
+		}
+
+		row, err := mapRecord(src, columnField, record)
+		if err != nil {
+			result.Errors = append(result.Errors, RowError{Line: line, Error: err.Error()})
+			continue
+		}
+		row.Line = line
+		result.Rows = append(result.Rows, row)
+	}
+
+	return result, nil
+}
+
+func mapRecord(src *Source, columnField map[int]string, record []string) (*Row, error) {
+	row := &Row{}
+	for i, value := range record {
+		field, ok := columnField[i]
+		value = strings.TrimSpace(value)
+		if !ok || value == "" {
+			continue
+		}
+
+		switch field {
+		case FieldName:
+			row.Name = value
+		case FieldDescription:
+			row.Description = &value
+		case FieldIdentifier:
+			row.Identifier = &value
+		case FieldCategory:
+			row.Category = &value
+		case FieldExpirationDate:
+			date, err := parseDate(value)
+			if err != nil {
+				return nil, err
+			}
+			row.ExpirationDate = date
+		}
+	}
+
+	if row.Name == "" {
+		return nil, errors.New("missing name")
+	}
+	if row.ExpirationDate.IsZero() {
+		return nil, errors.New("missing expiration date")
+	}
+	if row.Category == nil && src.DefaultCategory != "" {
+		category := src.DefaultCategory
+		row.Category = &category
+	}
+	return row, nil
+}
+
+func containsValue(m map[int]string, value string) bool {
+	for _, v := range m {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,103 @@
+//go:build integration
+
+package integration
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+
+	"xpired/internal/db"
+	"xpired/internal/fieldcrypt"
+)
+
+// TestIdentifierLookup checks identifiers match ignoring case, spaces and
+// dashes both when they are stored as plaintext and when they are encrypted
+// and looked up through their blind index.
+func TestIdentifierLookup(t *testing.T) {
+	requireStack(t)
+
+	cipher, err := fieldcrypt.New(bytes.Repeat([]byte{1}, fieldcrypt.KeySize), bytes.Repeat([]byte{2}, fieldcrypt.KeySize))
+	if err != nil {
+		t.Fatalf("create field cipher: %v", err)
+	}
+
+	for _, mode := range []struct {
+		name   string
+		cipher *fieldcrypt.Cipher
+	}{
+		{"plaintext", nil},
+		{"encrypted", cipher},
+	} {
+		t.Run(mode.name, func(t *testing.T) {
+			ctx := context.Background()
+
+			// a connection of its own, so the field cipher does not leak
+			// into the other tests
+			conn, err := db.NewConnection(env.cfg.Database)
+			if err != nil {
+				t.Fatalf("connect: %v", err)
+			}
+			defer conn.Close()
+			if mode.cipher != nil {
+				conn.SetFieldCipher(mode.cipher)
+			}
+			repo := db.NewRepository(conn)
+
+			user := &db.User{
+				ID:        uuid.New(),
+				Email:     fmt.Sprintf("integration-%s@example.com", uuid.NewString()),
+				Name:      "Integration Test",
+				CreatedAt: time.Now(),
+				UpdatedAt: time.Now(),
+			}
+			if err := repo.CreateUser(ctx, user); err != nil {
+				t.Fatalf("create user: %v", err)
+			}
+			userID := user.ID.String()
+
+			stored := "ab-123 45"
+			doc := &db.Document{
+				ID:             uuid.New(),
+				UserID:         user.ID,
+				Name:           "Passport",
+				Identifier:     &stored,
+				ExpirationDate: time.Now().AddDate(1, 0, 0).UTC(),
+				Timezone:       "UTC",
+			}
+			if err := repo.CreateDocument(ctx, doc); err != nil {
+				t.Fatalf("create document: %v", err)
+			}
+
+			for _, lookup := range []string{"ab-123 45", "AB12345", " Ab 123-45 "} {
+				docs, err := repo.FindDocumentsByIdentifier(ctx, userID, lookup)
+				if err != nil {
+					t.Fatalf("find documents by identifier %q: %v", lookup, err)
+				}
+				if len(docs) != 1 || docs[0].ID != doc.ID {
+					t.Errorf("find documents by identifier %q: got %d documents, want document %s", lookup, len(docs), doc.ID)
+				}
+
+				similar, err := repo.FindSimilarDocuments(ctx, userID, "Unrelated name", &lookup, 0.9, 10)
+				if err != nil {
+					t.Fatalf("find similar documents to identifier %q: %v", lookup, err)
+				}
+				if len(similar) != 1 || similar[0].ID != doc.ID {
+					t.Errorf("find similar documents to identifier %q: got %d documents, want document %s", lookup, len(similar), doc.ID)
+				}
+			}
+
+			docs, err := repo.FindDocumentsByIdentifier(ctx, userID, "AB123456")
+			if err != nil {
+				t.Fatalf("find documents by identifier: %v", err)
+			}
+			if len(docs) != 0 {
+				t.Errorf("find documents by a different identifier: got %d documents, want none", len(docs))
+			}
+		})
+	}
+}
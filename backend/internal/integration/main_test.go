@@ -0,0 +1,162 @@
+//go:build integration
+
+// Package integration runs the API and the asynq worker against real
+// Postgres and Redis containers started with dockertest. Run it with
+//
+//	go test -tags integration ./internal/integration/...
+//
+// Docker must be reachable; the tests are skipped when it is not.
+package integration
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/http/httptest"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/hibiken/asynq"
+	"github.com/ory/dockertest/v3"
+	"github.com/redis/go-redis/v9"
+
+	"xpired/internal/api"
+	"xpired/internal/auth"
+	"xpired/internal/config"
+	"xpired/internal/db"
+	"xpired/internal/storage"
+	"xpired/internal/worker"
+)
+
+// env is the stack shared by every test in the package.
+var env struct {
+	skip      string
+	cfg       *config.Config
+	db        *db.DB
+	repo      db.Repository
+	api       *httptest.Server
+	inspector *asynq.Inspector
+}
+
+func TestMain(m *testing.M) {
+	os.Exit(run(m))
+}
+
+func run(m *testing.M) int {
+	pool, err := dockertest.NewPool("")
+	if err == nil {
+		err = pool.Client.Ping()
+	}
+	if err != nil {
+		env.skip = fmt.Sprintf("docker is not available: %v", err)
+		return m.Run()
+	}
+	pool.MaxWait = 2 * time.Minute
+
+	postgres, err := pool.RunWithOptions(&dockertest.RunOptions{
+		Repository: "postgres",
+		Tag:        "16-alpine",
+		Env:        []string{"POSTGRES_USER=xpired", "POSTGRES_PASSWORD=xpired", "POSTGRES_DB=xpired_test"},
+	})
+	if err != nil {
+		log.Printf("Failed to start postgres: %v", err)
+		return 1
+	}
+	defer pool.Purge(postgres)
+
+	redisContainer, err := pool.RunWithOptions(&dockertest.RunOptions{
+		Repository: "redis",
+		Tag:        "7-alpine",
+	})
+	if err != nil {
+		log.Printf("Failed to start redis: %v", err)
+		return 1
+	}
+	defer pool.Purge(redisContainer)
+
+	cfg := &config.Config{
+		App: config.AppConfig{
+			BaseURL:     "http://localhost:8080",
+			FrontendURL: "http://localhost:3000",
+		},
+		Database: db.Config{
+			Host:     "localhost",
+			Port:     postgres.GetPort("5432/tcp"),
+			User:     "xpired",
+			Password: "xpired",
+			DBName:   "xpired_test",
+			SSLMode:  "disable",
+		},
+		JWT:           config.JWTConfig{Secret: "integration-test-secret"},
+		Redis:         config.RedisConfig{Addr: redisContainer.GetHostPort("6379/tcp")},
+		Notifications: config.NotificationsConfig{DryRun: true},
+		Storage:       config.StorageConfig{Driver: "local", LocalDir: os.TempDir(), PublicURL: "http://localhost:8080/uploads"},
+		Trash:         config.TrashConfig{RetentionDays: 30},
+		Attachments:   config.AttachmentsConfig{MaxUploadMB: 10},
+	}
+
+	if err := pool.Retry(func() error {
+		conn, err := db.NewConnection(cfg.Database)
+		if err != nil {
+			return err
+		}
+		env.db = conn
+		return nil
+	}); err != nil {
+		log.Printf("Postgres did not become ready: %v", err)
+		return 1
+	}
+	defer env.db.Close()
+
+	if err := pool.Retry(func() error {
+		rdb := redis.NewClient(&redis.Options{Addr: cfg.Redis.Addr})
+		defer rdb.Close()
+		return rdb.Ping(context.Background()).Err()
+	}); err != nil {
+		log.Printf("Redis did not become ready: %v", err)
+		return 1
+	}
+
+	if err := env.db.RunMigrations("../../migrations"); err != nil {
+		log.Printf("Failed to run migrations: %v", err)
+		return 1
+	}
+
+	if err := auth.Init(cfg); err != nil {
+		log.Printf("Failed to load JWT keys: %v", err)
+		return 1
+	}
+	worker.InitQueue(cfg)
+
+	store, err := storage.New(context.Background(), cfg.Storage)
+	if err != nil {
+		log.Printf("Failed to create storage: %v", err)
+		return 1
+	}
+
+	env.cfg = cfg
+	env.repo = db.NewRepository(env.db)
+	env.api = httptest.NewServer(api.SetupRoutes(env.db, cfg, store))
+	defer env.api.Close()
+
+	workerServer := worker.NewServer(cfg)
+	if err := workerServer.Start(worker.NewMux(env.repo, cfg, store, nil)); err != nil {
+		log.Printf("Failed to start worker: %v", err)
+		return 1
+	}
+	defer workerServer.Shutdown()
+
+	env.inspector = asynq.NewInspector(asynq.RedisClientOpt{Addr: cfg.Redis.Addr})
+	defer env.inspector.Close()
+
+	return m.Run()
+}
+
+// requireStack skips t when the containers could not be started.
+func requireStack(t *testing.T) {
+	t.Helper()
+	if env.skip != "" {
+		t.Skip(env.skip)
+	}
+}
@@ -0,0 +1,136 @@
+//go:build integration
+
+package integration
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+
+	"xpired/internal/worker"
+)
+
+// postJSON sends body to path on the API server and decodes the response
+// into out, failing t unless the status is want.
+func postJSON(t *testing.T, path, token string, body, out interface{}, want int) {
+	t.Helper()
+
+	raw, err := json.Marshal(body)
+	if err != nil {
+		t.Fatalf("encode %s request: %v", path, err)
+	}
+	req, err := http.NewRequest(http.MethodPost, env.api.URL+path, bytes.NewReader(raw))
+	if err != nil {
+		t.Fatalf("build %s request: %v", path, err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	if token != "" {
+		req.Header.Set("Authorization", "Bearer "+token)
+	}
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		t.Fatalf("POST %s: %v", path, err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != want {
+		t.Fatalf("POST %s: status %d, want %d", path, resp.StatusCode, want)
+	}
+	if err := json.NewDecoder(resp.Body).Decode(out); err != nil {
+		t.Fatalf("decode %s response: %v", path, err)
+	}
+}
+
+// TestReminderPipeline creates a document through the API, then runs the
+// reminder task it scheduled through the asynq worker and checks the
+// reminder is marked sent and the (dry-run) email is logged.
+func TestReminderPipeline(t *testing.T) {
+	requireStack(t)
+	ctx := context.Background()
+
+	var registered struct {
+		User struct {
+			ID string `json:"id"`
+		} `json:"user"`
+		Token string `json:"token"`
+	}
+	postJSON(t, "/api/auth/register", "", map[string]interface{}{
+		"email":    fmt.Sprintf("integration-%s@example.com", uuid.NewString()),
+		"password": "correct-horse-battery",
+		"name":     "Integration Test",
+	}, &registered, http.StatusCreated)
+
+	var created struct {
+		Document struct {
+			ID string `json:"id"`
+		} `json:"document"`
+	}
+	postJSON(t, "/api/documents", registered.Token, map[string]interface{}{
+		"name":           "Passport",
+		"expirationDate": time.Now().AddDate(0, 0, 30).UTC().Format(time.RFC3339),
+		"timezone":       "UTC",
+		"reminders":      []string{"7d"},
+	}, &created, http.StatusCreated)
+
+	taskID := scheduledReminderTask(t, created.Document.ID)
+	if err := env.inspector.RunTask("default", taskID); err != nil {
+		t.Fatalf("run reminder task: %v", err)
+	}
+
+	deadline := time.Now().Add(30 * time.Second)
+	for {
+		reminders, err := env.repo.GetDocumentRemindersByDocumentID(ctx, created.Document.ID)
+		if err != nil {
+			t.Fatalf("get document reminders: %v", err)
+		}
+		if len(reminders) == 1 && reminders[0].SentAt != nil {
+			break
+		}
+		if time.Now().After(deadline) {
+			t.Fatalf("reminder for document %s was not marked sent", created.Document.ID)
+		}
+		time.Sleep(250 * time.Millisecond)
+	}
+
+	entry, err := env.repo.GetLatestNotificationLog(ctx, registered.User.ID, worker.ChannelEmail)
+	if err != nil {
+		t.Fatalf("get notification log: %v", err)
+	}
+	if entry.DocumentID != created.Document.ID {
+		t.Errorf("notification logged for document %s, want %s", entry.DocumentID, created.Document.ID)
+	}
+	if entry.Status != worker.StatusDryRun {
+		t.Errorf("notification status %q, want %q", entry.Status, worker.StatusDryRun)
+	}
+}
+
+// scheduledReminderTask returns the ID of the send-reminder task scheduled
+// for documentID.
+func scheduledReminderTask(t *testing.T, documentID string) string {
+	t.Helper()
+
+	tasks, err := env.inspector.ListScheduledTasks("default")
+	if err != nil {
+		t.Fatalf("list scheduled tasks: %v", err)
+	}
+	for _, task := range tasks {
+		if task.Type != worker.TaskSendReminder {
+			continue
+		}
+		var payload struct {
+			DocumentID string `json:"document_id"`
+		}
+		if err := json.Unmarshal(task.Payload, &payload); err == nil && payload.DocumentID == documentID {
+			return task.ID
+		}
+	}
+	t.Fatalf("no reminder task scheduled for document %s", documentID)
+	return ""
+}
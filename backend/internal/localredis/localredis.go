@@ -0,0 +1,218 @@
+// Package localredis runs an in-process Redis for self-hosting xpired as a
+// single binary. The task queue, locks, rate limits and caches talk to it
+// over the Redis protocol like to a real server, so nothing else changes.
+// Its data is kept in memory and saved to a snapshot file periodically and
+// on Close, so queued reminders survive a restart.
+package localredis
+
+import (
+	"encoding/gob"
+	"errors"
+	"fmt"
+	"log"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/alicebob/miniredis/v2"
+)
+
+const (
+	// snapshotInterval is how often the data is saved; a crash loses at
+	// most the changes since.
+	snapshotInterval = 30 * time.Second
+	// tick is how often time is advanced for expiring keys.
+	tick = time.Second
+)
+
+// entry is one key of a snapshot.
+type entry struct {
+	Key    string
+	Type   string
+	String string
+	List   []string
+	Set    []string
+	Hash   map[string]string
+	ZSet   map[string]float64
+	// TTL is the time left to live; 0 means the key does not expire.
+	TTL time.Duration
+}
+
+// Server is an in-process Redis listening on a loopback port.
+type Server struct {
+	redis *miniredis.Miniredis
+	path  string
+	stop  chan struct{}
+	done  sync.WaitGroup
+}
+
+// Start restores the snapshot at path, if there is one, and starts serving
+// on a free loopback port. An empty path keeps the data in memory only.
+func Start(path string) (*Server, error) {
+	s := &Server{
+		redis: miniredis.NewMiniRedis(),
+		path:  path,
+		stop:  make(chan struct{}),
+	}
+	if err := s.restore(); err != nil {
+		return nil, err
+	}
+	if err := s.redis.Start(); err != nil {
+		return nil, fmt.Errorf("failed to start embedded Redis: %w", err)
+	}
+
+	s.done.Add(1)
+	go s.run()
+	return s, nil
+}
+
+// Addr is the host:port the server listens on.
+func (s *Server) Addr() string {
+	return s.redis.Addr()
+}
+
+// Close saves a final snapshot and stops the server. Call it once everything
+// using the server has stopped.
+func (s *Server) Close() error {
+	close(s.stop)
+	s.done.Wait()
+	err := s.save()
+	s.redis.Close()
+	return err
+}
+
+// run expires keys as time passes, which the server only does when told, and
+// saves a snapshot every snapshotInterval.
+func (s *Server) run() {
+	defer s.done.Done()
+
+	ticker := time.NewTicker(tick)
+	defer ticker.Stop()
+	last, lastSave := time.Now(), time.Now()
+	for {
+		select {
+		case <-s.stop:
+			return
+		case now := <-ticker.C:
+			s.redis.FastForward(now.Sub(last))
+			last = now
+			if now.Sub(lastSave) >= snapshotInterval {
+				if err := s.save(); err != nil {
+					log.Printf("Failed to save embedded Redis snapshot: %v", err)
+				}
+				lastSave = now
+			}
+		}
+	}
+}
+
+// save writes every key to the snapshot file, replacing it atomically. Keys
+// are read one at a time, so a snapshot taken while the queue is busy may
+// catch a task between two of its keys; asynq recovers such tasks on start.
+func (s *Server) save() error {
+	if s.path == "" {
+		return nil
+	}
+
+	var entries []entry
+	for _, key := range s.redis.Keys() {
+		e := entry{Key: key, Type: s.redis.Type(key), TTL: s.redis.TTL(key)}
+		var err error
+		switch e.Type {
+		case "string":
+			e.String, err = s.redis.Get(key)
+		case "list":
+			e.List, err = s.redis.List(key)
+		case "set":
+			e.Set, err = s.redis.Members(key)
+		case "hash":
+			var fields []string
+			fields, err = s.redis.HKeys(key)
+			e.Hash = make(map[string]string, len(fields))
+			for _, field := range fields {
+				e.Hash[field] = s.redis.HGet(key, field)
+			}
+		case "zset":
+			e.ZSet, err = s.redis.SortedSet(key)
+		default:
+			// expired since Keys, or a type xpired does not use
+			continue
+		}
+		if errors.Is(err, miniredis.ErrKeyNotFound) {
+			continue
+		}
+		if err != nil {
+			return fmt.Errorf("failed to read %s: %w", key, err)
+		}
+		entries = append(entries, e)
+	}
+
+	tmp, err := os.CreateTemp(filepath.Dir(s.path), filepath.Base(s.path)+".*")
+	if err != nil {
+		return fmt.Errorf("failed to create snapshot: %w", err)
+	}
+	defer os.Remove(tmp.Name())
+	if err := gob.NewEncoder(tmp).Encode(entries); err != nil {
+		tmp.Close()
+		return fmt.Errorf("failed to write snapshot: %w", err)
+	}
+	if err := tmp.Close(); err != nil {
+		return fmt.Errorf("failed to write snapshot: %w", err)
+	}
+	if err := os.Rename(tmp.Name(), s.path); err != nil {
+		return fmt.Errorf("failed to replace snapshot: %w", err)
+	}
+	return nil
+}
+
+// restore loads the snapshot file into the server. A missing file is a
+// fresh start.
+func (s *Server) restore() error {
+	if s.path == "" {
+		return nil
+	}
+	f, err := os.Open(s.path)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("failed to open embedded Redis snapshot: %w", err)
+	}
+	defer f.Close()
+
+	var entries []entry
+	if err := gob.NewDecoder(f).Decode(&entries); err != nil {
+		return fmt.Errorf("failed to read embedded Redis snapshot %s: %w", s.path, err)
+	}
+
+	for _, e := range entries {
+		var err error
+		switch e.Type {
+		case "string":
+			err = s.redis.Set(e.Key, e.String)
+		case "list":
+			_, err = s.redis.Push(e.Key, e.List...)
+		case "set":
+			_, err = s.redis.SetAdd(e.Key, e.Set...)
+		case "hash":
+			for field, value := range e.Hash {
+				s.redis.HSet(e.Key, field, value)
+			}
+		case "zset":
+			for member, score := range e.ZSet {
+				if _, err = s.redis.ZAdd(e.Key, score, member); err != nil {
+					break
+				}
+			}
+		}
+		if err != nil {
+			return fmt.Errorf("failed to restore %s: %w", e.Key, err)
+		}
+		if e.TTL > 0 {
+			s.redis.SetTTL(e.Key, e.TTL)
+		}
+	}
+	log.Printf("Restored %d keys from embedded Redis snapshot %s", len(entries), s.path)
+	return nil
+}
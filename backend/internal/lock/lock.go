@@ -0,0 +1,78 @@
+package lock
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+)
+
+// ErrNotAcquired is returned when another holder already owns the lock.
+var ErrNotAcquired = errors.New("lock not acquired")
+
+const keyPrefix = "xpired:lock:"
+
+// releaseScript deletes the key only if it still holds our token, so a holder
+// whose lock expired cannot release a lock that was since taken by someone else.
+var releaseScript = redis.NewScript(`
+if redis.call("GET", KEYS[1]) == ARGV[1] then
+	return redis.call("DEL", KEYS[1])
+end
+return 0
+`)
+
+// Locker hands out Redis-backed mutual exclusion locks shared by every
+// replica connected to the same Redis instance.
+type Locker struct {
+	rdb *redis.Client
+}
+
+func NewLocker(rdb *redis.Client) *Locker {
+	return &Locker{rdb: rdb}
+}
+
+// Lock is a held lock. It expires on its own after its TTL if never released.
+type Lock struct {
+	locker *Locker
+	key    string
+	token  string
+}
+
+// Acquire tries once to take the named lock for ttl.
+func (l *Locker) Acquire(ctx context.Context, name string, ttl time.Duration) (*Lock, error) {
+	token, err := newToken()
+	if err != nil {
+		return nil, err
+	}
+
+	key := keyPrefix + name
+	ok, err := l.rdb.SetNX(ctx, key, token, ttl).Result()
+	if err != nil {
+		return nil, fmt.Errorf("failed to acquire lock %s: %w", name, err)
+	}
+	if !ok {
+		return nil, ErrNotAcquired
+	}
+
+	return &Lock{locker: l, key: key, token: token}, nil
+}
+
+// Release gives up the lock if it is still held by this holder.
+func (lk *Lock) Release(ctx context.Context) error {
+	if err := releaseScript.Run(ctx, lk.locker.rdb, []string{lk.key}, lk.token).Err(); err != nil {
+		return fmt.Errorf("failed to release lock %s: %w", lk.key, err)
+	}
+	return nil
+}
+
+func newToken() (string, error) {
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		return "", fmt.Errorf("failed to generate lock token: %w", err)
+	}
+	return hex.EncodeToString(b), nil
+}
@@ -0,0 +1,151 @@
+// Package password checks new passwords against the configured policy: a
+// minimum length, a mix of character classes and, optionally, whether the
+// password appeared in a known data breach.
+package password
+
+import (
+	"bufio"
+	"context"
+	"crypto/sha1"
+	"encoding/hex"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// MaxLength is the longest password in bytes; bcrypt ignores anything past
+// it, and newer versions refuse to hash it.
+const MaxLength = 72
+
+// DefaultBreachRangeURL is the Have I Been Pwned range API, which is sent
+// only the first five characters of the password's SHA-1 hash.
+const DefaultBreachRangeURL = "https://api.pwnedpasswords.com/range/"
+
+// Policy is what new passwords must satisfy.
+type Policy struct {
+	MinLength int
+	// MinCharacterClasses is how many of lowercase letters, uppercase
+	// letters, digits and other characters a password must mix.
+	MinCharacterClasses int
+	// breaches looks passwords up in known breaches; nil skips the check.
+	breaches *BreachChecker
+}
+
+// NewPolicy returns a policy checking passwords against breaches too when
+// breaches is not nil.
+func NewPolicy(minLength, minCharacterClasses int, breaches *BreachChecker) *Policy {
+	return &Policy{
+		MinLength:           minLength,
+		MinCharacterClasses: minCharacterClasses,
+		breaches:            breaches,
+	}
+}
+
+// Check returns a client-facing message describing why password does not
+// satisfy the policy, or "" if it does. The error reports that the breach
+// check could not be done; the message is then about the other rules only.
+func (p *Policy) Check(ctx context.Context, password string) (string, error) {
+	if utf8.RuneCountInString(password) < p.MinLength {
+		return fmt.Sprintf("Password must be at least %d characters", p.MinLength), nil
+	}
+	if len(password) > MaxLength {
+		return fmt.Sprintf("Password must be at most %d bytes", MaxLength), nil
+	}
+	if characterClasses(password) < p.MinCharacterClasses {
+		return fmt.Sprintf("Password must mix at least %d of lowercase letters, uppercase letters, digits and symbols", p.MinCharacterClasses), nil
+	}
+
+	if p.breaches == nil {
+		return "", nil
+	}
+	breached, err := p.breaches.Breached(ctx, password)
+	if err != nil {
+		return "", err
+	}
+	if breached {
+		return "Password appeared in a data breach, please choose another", nil
+	}
+	return "", nil
+}
+
+func characterClasses(password string) int {
+	var lower, upper, digit, other bool
+	for _, r := range password {
+		switch {
+		case unicode.IsLower(r):
+			lower = true
+		case unicode.IsUpper(r):
+			upper = true
+		case unicode.IsDigit(r):
+			digit = true
+		default:
+			other = true
+		}
+	}
+
+	classes := 0
+	for _, present := range []bool{lower, upper, digit, other} {
+		if present {
+			classes++
+		}
+	}
+	return classes
+}
+
+// BreachChecker looks passwords up with the k-anonymity range protocol of
+// Have I Been Pwned: the first five hex characters of the SHA-1 hash are
+// sent, and the suffixes of every breached hash sharing them come back.
+type BreachChecker struct {
+	rangeURL string
+	client   *http.Client
+}
+
+// NewBreachChecker looks passwords up at rangeURL with client, which should
+// time out after a few seconds.
+func NewBreachChecker(rangeURL string, client *http.Client) *BreachChecker {
+	return &BreachChecker{
+		rangeURL: rangeURL,
+		client:   client,
+	}
+}
+
+// Breached reports whether password appeared in a known breach.
+func (b *BreachChecker) Breached(ctx context.Context, password string) (bool, error) {
+	sum := sha1.Sum([]byte(password))
+	hash := strings.ToUpper(hex.EncodeToString(sum[:]))
+	prefix, suffix := hash[:5], hash[5:]
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, b.rangeURL+prefix, nil)
+	if err != nil {
+		return false, fmt.Errorf("failed to build breach range request: %w", err)
+	}
+	// padding hides from onlookers how many suffixes came back
+	req.Header.Set("Add-Padding", "true")
+
+	resp, err := b.client.Do(req)
+	if err != nil {
+		return false, fmt.Errorf("failed to fetch breach range: %w", err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return false, fmt.Errorf("breach range returned %s", resp.Status)
+	}
+
+	// each line is SUFFIX:COUNT; padding lines have a count of 0
+	scanner := bufio.NewScanner(resp.Body)
+	for scanner.Scan() {
+		candidate, count, ok := strings.Cut(strings.TrimSpace(scanner.Text()), ":")
+		if !ok || !strings.EqualFold(candidate, suffix) {
+			continue
+		}
+		n, err := strconv.Atoi(count)
+		return err == nil && n > 0, nil
+	}
+	if err := scanner.Err(); err != nil {
+		return false, fmt.Errorf("failed to read breach range: %w", err)
+	}
+	return false, nil
+}
@@ -0,0 +1,1151 @@
+// gRPC contract for internal service-to-service consumers. Messages mirror
+// the REST DTOs in internal/api/dtos.go; keep the two in sync.
+//
+// Authenticate by sending the same JWT used by the REST API in the
+// "authorization" metadata key: "Bearer <token>".
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.9
+// 	protoc        (unknown)
+// source: xpired/v1/xpired.proto
+
+package xpiredv1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// ReminderInterval mirrors ReminderIntervalResponse.
+type ReminderInterval struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Interval ID label, e.g. "7d".
+	Id            string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Label         string `protobuf:"bytes,2,opt,name=label,proto3" json:"label,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReminderInterval) Reset() {
+	*x = ReminderInterval{}
+	mi := &file_xpired_v1_xpired_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReminderInterval) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReminderInterval) ProtoMessage() {}
+
+func (x *ReminderInterval) ProtoReflect() protoreflect.Message {
+	mi := &file_xpired_v1_xpired_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReminderInterval.ProtoReflect.Descriptor instead.
+func (*ReminderInterval) Descriptor() ([]byte, []int) {
+	return file_xpired_v1_xpired_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *ReminderInterval) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ReminderInterval) GetLabel() string {
+	if x != nil {
+		return x.Label
+	}
+	return ""
+}
+
+// DocumentReminder mirrors DocumentReminderIntervalResponse.
+type DocumentReminder struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Label         string                 `protobuf:"bytes,2,opt,name=label,proto3" json:"label,omitempty"`
+	Enabled       bool                   `protobuf:"varint,3,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DocumentReminder) Reset() {
+	*x = DocumentReminder{}
+	mi := &file_xpired_v1_xpired_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DocumentReminder) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DocumentReminder) ProtoMessage() {}
+
+func (x *DocumentReminder) ProtoReflect() protoreflect.Message {
+	mi := &file_xpired_v1_xpired_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DocumentReminder.ProtoReflect.Descriptor instead.
+func (*DocumentReminder) Descriptor() ([]byte, []int) {
+	return file_xpired_v1_xpired_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *DocumentReminder) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *DocumentReminder) GetLabel() string {
+	if x != nil {
+		return x.Label
+	}
+	return ""
+}
+
+func (x *DocumentReminder) GetEnabled() bool {
+	if x != nil {
+		return x.Enabled
+	}
+	return false
+}
+
+// Document mirrors DocumentResponse.
+type Document struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Id             string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	UserId         string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Name           string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Description    *string                `protobuf:"bytes,4,opt,name=description,proto3,oneof" json:"description,omitempty"`
+	Identifier     *string                `protobuf:"bytes,5,opt,name=identifier,proto3,oneof" json:"identifier,omitempty"`
+	ExpirationDate *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=expiration_date,json=expirationDate,proto3" json:"expiration_date,omitempty"`
+	Timezone       string                 `protobuf:"bytes,7,opt,name=timezone,proto3" json:"timezone,omitempty"`
+	AttachmentUrl  *string                `protobuf:"bytes,8,opt,name=attachment_url,json=attachmentUrl,proto3,oneof" json:"attachment_url,omitempty"`
+	Category       *string                `protobuf:"bytes,9,opt,name=category,proto3,oneof" json:"category,omitempty"`
+	Reminders      []*ReminderInterval    `protobuf:"bytes,10,rep,name=reminders,proto3" json:"reminders,omitempty"`
+	CreatedAt      *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt      *timestamppb.Timestamp `protobuf:"bytes,12,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *Document) Reset() {
+	*x = Document{}
+	mi := &file_xpired_v1_xpired_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Document) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Document) ProtoMessage() {}
+
+func (x *Document) ProtoReflect() protoreflect.Message {
+	mi := &file_xpired_v1_xpired_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Document.ProtoReflect.Descriptor instead.
+func (*Document) Descriptor() ([]byte, []int) {
+	return file_xpired_v1_xpired_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *Document) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Document) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *Document) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Document) GetDescription() string {
+	if x != nil && x.Description != nil {
+		return *x.Description
+	}
+	return ""
+}
+
+func (x *Document) GetIdentifier() string {
+	if x != nil && x.Identifier != nil {
+		return *x.Identifier
+	}
+	return ""
+}
+
+func (x *Document) GetExpirationDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpirationDate
+	}
+	return nil
+}
+
+func (x *Document) GetTimezone() string {
+	if x != nil {
+		return x.Timezone
+	}
+	return ""
+}
+
+func (x *Document) GetAttachmentUrl() string {
+	if x != nil && x.AttachmentUrl != nil {
+		return *x.AttachmentUrl
+	}
+	return ""
+}
+
+func (x *Document) GetCategory() string {
+	if x != nil && x.Category != nil {
+		return *x.Category
+	}
+	return ""
+}
+
+func (x *Document) GetReminders() []*ReminderInterval {
+	if x != nil {
+		return x.Reminders
+	}
+	return nil
+}
+
+func (x *Document) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Document) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+type ListDocumentsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListDocumentsRequest) Reset() {
+	*x = ListDocumentsRequest{}
+	mi := &file_xpired_v1_xpired_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListDocumentsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListDocumentsRequest) ProtoMessage() {}
+
+func (x *ListDocumentsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_xpired_v1_xpired_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListDocumentsRequest.ProtoReflect.Descriptor instead.
+func (*ListDocumentsRequest) Descriptor() ([]byte, []int) {
+	return file_xpired_v1_xpired_proto_rawDescGZIP(), []int{3}
+}
+
+type ListDocumentsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Documents     []*Document            `protobuf:"bytes,1,rep,name=documents,proto3" json:"documents,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListDocumentsResponse) Reset() {
+	*x = ListDocumentsResponse{}
+	mi := &file_xpired_v1_xpired_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListDocumentsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListDocumentsResponse) ProtoMessage() {}
+
+func (x *ListDocumentsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_xpired_v1_xpired_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListDocumentsResponse.ProtoReflect.Descriptor instead.
+func (*ListDocumentsResponse) Descriptor() ([]byte, []int) {
+	return file_xpired_v1_xpired_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *ListDocumentsResponse) GetDocuments() []*Document {
+	if x != nil {
+		return x.Documents
+	}
+	return nil
+}
+
+type GetDocumentRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetDocumentRequest) Reset() {
+	*x = GetDocumentRequest{}
+	mi := &file_xpired_v1_xpired_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetDocumentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDocumentRequest) ProtoMessage() {}
+
+func (x *GetDocumentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_xpired_v1_xpired_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDocumentRequest.ProtoReflect.Descriptor instead.
+func (*GetDocumentRequest) Descriptor() ([]byte, []int) {
+	return file_xpired_v1_xpired_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *GetDocumentRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+// CreateDocumentRequest mirrors DocumentRequest.
+type CreateDocumentRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Name           string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Description    *string                `protobuf:"bytes,2,opt,name=description,proto3,oneof" json:"description,omitempty"`
+	Identifier     *string                `protobuf:"bytes,3,opt,name=identifier,proto3,oneof" json:"identifier,omitempty"`
+	ExpirationDate *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=expiration_date,json=expirationDate,proto3" json:"expiration_date,omitempty"`
+	Timezone       string                 `protobuf:"bytes,5,opt,name=timezone,proto3" json:"timezone,omitempty"`
+	AttachmentUrl  *string                `protobuf:"bytes,6,opt,name=attachment_url,json=attachmentUrl,proto3,oneof" json:"attachment_url,omitempty"`
+	Category       *string                `protobuf:"bytes,7,opt,name=category,proto3,oneof" json:"category,omitempty"`
+	// Interval ID labels; defaults to the category's presets when empty.
+	Reminders     []string `protobuf:"bytes,8,rep,name=reminders,proto3" json:"reminders,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateDocumentRequest) Reset() {
+	*x = CreateDocumentRequest{}
+	mi := &file_xpired_v1_xpired_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateDocumentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateDocumentRequest) ProtoMessage() {}
+
+func (x *CreateDocumentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_xpired_v1_xpired_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateDocumentRequest.ProtoReflect.Descriptor instead.
+func (*CreateDocumentRequest) Descriptor() ([]byte, []int) {
+	return file_xpired_v1_xpired_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *CreateDocumentRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateDocumentRequest) GetDescription() string {
+	if x != nil && x.Description != nil {
+		return *x.Description
+	}
+	return ""
+}
+
+func (x *CreateDocumentRequest) GetIdentifier() string {
+	if x != nil && x.Identifier != nil {
+		return *x.Identifier
+	}
+	return ""
+}
+
+func (x *CreateDocumentRequest) GetExpirationDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpirationDate
+	}
+	return nil
+}
+
+func (x *CreateDocumentRequest) GetTimezone() string {
+	if x != nil {
+		return x.Timezone
+	}
+	return ""
+}
+
+func (x *CreateDocumentRequest) GetAttachmentUrl() string {
+	if x != nil && x.AttachmentUrl != nil {
+		return *x.AttachmentUrl
+	}
+	return ""
+}
+
+func (x *CreateDocumentRequest) GetCategory() string {
+	if x != nil && x.Category != nil {
+		return *x.Category
+	}
+	return ""
+}
+
+func (x *CreateDocumentRequest) GetReminders() []string {
+	if x != nil {
+		return x.Reminders
+	}
+	return nil
+}
+
+// UpdateDocumentRequest mirrors DocumentRequest; unset fields are left as is.
+type UpdateDocumentRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Id             string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name           *string                `protobuf:"bytes,2,opt,name=name,proto3,oneof" json:"name,omitempty"`
+	Description    *string                `protobuf:"bytes,3,opt,name=description,proto3,oneof" json:"description,omitempty"`
+	Identifier     *string                `protobuf:"bytes,4,opt,name=identifier,proto3,oneof" json:"identifier,omitempty"`
+	ExpirationDate *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=expiration_date,json=expirationDate,proto3" json:"expiration_date,omitempty"`
+	Timezone       *string                `protobuf:"bytes,6,opt,name=timezone,proto3,oneof" json:"timezone,omitempty"`
+	AttachmentUrl  *string                `protobuf:"bytes,7,opt,name=attachment_url,json=attachmentUrl,proto3,oneof" json:"attachment_url,omitempty"`
+	Category       *string                `protobuf:"bytes,8,opt,name=category,proto3,oneof" json:"category,omitempty"`
+	// Interval ID labels to enable in addition to the existing ones.
+	Reminders     []string `protobuf:"bytes,9,rep,name=reminders,proto3" json:"reminders,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateDocumentRequest) Reset() {
+	*x = UpdateDocumentRequest{}
+	mi := &file_xpired_v1_xpired_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateDocumentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateDocumentRequest) ProtoMessage() {}
+
+func (x *UpdateDocumentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_xpired_v1_xpired_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateDocumentRequest.ProtoReflect.Descriptor instead.
+func (*UpdateDocumentRequest) Descriptor() ([]byte, []int) {
+	return file_xpired_v1_xpired_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *UpdateDocumentRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *UpdateDocumentRequest) GetName() string {
+	if x != nil && x.Name != nil {
+		return *x.Name
+	}
+	return ""
+}
+
+func (x *UpdateDocumentRequest) GetDescription() string {
+	if x != nil && x.Description != nil {
+		return *x.Description
+	}
+	return ""
+}
+
+func (x *UpdateDocumentRequest) GetIdentifier() string {
+	if x != nil && x.Identifier != nil {
+		return *x.Identifier
+	}
+	return ""
+}
+
+func (x *UpdateDocumentRequest) GetExpirationDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpirationDate
+	}
+	return nil
+}
+
+func (x *UpdateDocumentRequest) GetTimezone() string {
+	if x != nil && x.Timezone != nil {
+		return *x.Timezone
+	}
+	return ""
+}
+
+func (x *UpdateDocumentRequest) GetAttachmentUrl() string {
+	if x != nil && x.AttachmentUrl != nil {
+		return *x.AttachmentUrl
+	}
+	return ""
+}
+
+func (x *UpdateDocumentRequest) GetCategory() string {
+	if x != nil && x.Category != nil {
+		return *x.Category
+	}
+	return ""
+}
+
+func (x *UpdateDocumentRequest) GetReminders() []string {
+	if x != nil {
+		return x.Reminders
+	}
+	return nil
+}
+
+type DeleteDocumentRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteDocumentRequest) Reset() {
+	*x = DeleteDocumentRequest{}
+	mi := &file_xpired_v1_xpired_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteDocumentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteDocumentRequest) ProtoMessage() {}
+
+func (x *DeleteDocumentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_xpired_v1_xpired_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteDocumentRequest.ProtoReflect.Descriptor instead.
+func (*DeleteDocumentRequest) Descriptor() ([]byte, []int) {
+	return file_xpired_v1_xpired_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *DeleteDocumentRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type DeleteDocumentResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteDocumentResponse) Reset() {
+	*x = DeleteDocumentResponse{}
+	mi := &file_xpired_v1_xpired_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteDocumentResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteDocumentResponse) ProtoMessage() {}
+
+func (x *DeleteDocumentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_xpired_v1_xpired_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteDocumentResponse.ProtoReflect.Descriptor instead.
+func (*DeleteDocumentResponse) Descriptor() ([]byte, []int) {
+	return file_xpired_v1_xpired_proto_rawDescGZIP(), []int{9}
+}
+
+type ListReminderIntervalsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListReminderIntervalsRequest) Reset() {
+	*x = ListReminderIntervalsRequest{}
+	mi := &file_xpired_v1_xpired_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListReminderIntervalsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListReminderIntervalsRequest) ProtoMessage() {}
+
+func (x *ListReminderIntervalsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_xpired_v1_xpired_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListReminderIntervalsRequest.ProtoReflect.Descriptor instead.
+func (*ListReminderIntervalsRequest) Descriptor() ([]byte, []int) {
+	return file_xpired_v1_xpired_proto_rawDescGZIP(), []int{10}
+}
+
+type ListReminderIntervalsResponse struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	ReminderIntervals []*ReminderInterval    `protobuf:"bytes,1,rep,name=reminder_intervals,json=reminderIntervals,proto3" json:"reminder_intervals,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *ListReminderIntervalsResponse) Reset() {
+	*x = ListReminderIntervalsResponse{}
+	mi := &file_xpired_v1_xpired_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListReminderIntervalsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListReminderIntervalsResponse) ProtoMessage() {}
+
+func (x *ListReminderIntervalsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_xpired_v1_xpired_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListReminderIntervalsResponse.ProtoReflect.Descriptor instead.
+func (*ListReminderIntervalsResponse) Descriptor() ([]byte, []int) {
+	return file_xpired_v1_xpired_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *ListReminderIntervalsResponse) GetReminderIntervals() []*ReminderInterval {
+	if x != nil {
+		return x.ReminderIntervals
+	}
+	return nil
+}
+
+type GetDocumentRemindersRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	DocumentId    string                 `protobuf:"bytes,1,opt,name=document_id,json=documentId,proto3" json:"document_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetDocumentRemindersRequest) Reset() {
+	*x = GetDocumentRemindersRequest{}
+	mi := &file_xpired_v1_xpired_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetDocumentRemindersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDocumentRemindersRequest) ProtoMessage() {}
+
+func (x *GetDocumentRemindersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_xpired_v1_xpired_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDocumentRemindersRequest.ProtoReflect.Descriptor instead.
+func (*GetDocumentRemindersRequest) Descriptor() ([]byte, []int) {
+	return file_xpired_v1_xpired_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *GetDocumentRemindersRequest) GetDocumentId() string {
+	if x != nil {
+		return x.DocumentId
+	}
+	return ""
+}
+
+type GetDocumentRemindersResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Reminders     []*DocumentReminder    `protobuf:"bytes,1,rep,name=reminders,proto3" json:"reminders,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetDocumentRemindersResponse) Reset() {
+	*x = GetDocumentRemindersResponse{}
+	mi := &file_xpired_v1_xpired_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetDocumentRemindersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDocumentRemindersResponse) ProtoMessage() {}
+
+func (x *GetDocumentRemindersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_xpired_v1_xpired_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDocumentRemindersResponse.ProtoReflect.Descriptor instead.
+func (*GetDocumentRemindersResponse) Descriptor() ([]byte, []int) {
+	return file_xpired_v1_xpired_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *GetDocumentRemindersResponse) GetReminders() []*DocumentReminder {
+	if x != nil {
+		return x.Reminders
+	}
+	return nil
+}
+
+// ToggleDocumentReminderRequest mirrors ToggleDocumentReminderRequest.
+type ToggleDocumentReminderRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	DocumentId    string                 `protobuf:"bytes,1,opt,name=document_id,json=documentId,proto3" json:"document_id,omitempty"`
+	IntervalId    string                 `protobuf:"bytes,2,opt,name=interval_id,json=intervalId,proto3" json:"interval_id,omitempty"`
+	Enabled       bool                   `protobuf:"varint,3,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ToggleDocumentReminderRequest) Reset() {
+	*x = ToggleDocumentReminderRequest{}
+	mi := &file_xpired_v1_xpired_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ToggleDocumentReminderRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ToggleDocumentReminderRequest) ProtoMessage() {}
+
+func (x *ToggleDocumentReminderRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_xpired_v1_xpired_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ToggleDocumentReminderRequest.ProtoReflect.Descriptor instead.
+func (*ToggleDocumentReminderRequest) Descriptor() ([]byte, []int) {
+	return file_xpired_v1_xpired_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *ToggleDocumentReminderRequest) GetDocumentId() string {
+	if x != nil {
+		return x.DocumentId
+	}
+	return ""
+}
+
+func (x *ToggleDocumentReminderRequest) GetIntervalId() string {
+	if x != nil {
+		return x.IntervalId
+	}
+	return ""
+}
+
+func (x *ToggleDocumentReminderRequest) GetEnabled() bool {
+	if x != nil {
+		return x.Enabled
+	}
+	return false
+}
+
+type ToggleDocumentReminderResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ToggleDocumentReminderResponse) Reset() {
+	*x = ToggleDocumentReminderResponse{}
+	mi := &file_xpired_v1_xpired_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ToggleDocumentReminderResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ToggleDocumentReminderResponse) ProtoMessage() {}
+
+func (x *ToggleDocumentReminderResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_xpired_v1_xpired_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ToggleDocumentReminderResponse.ProtoReflect.Descriptor instead.
+func (*ToggleDocumentReminderResponse) Descriptor() ([]byte, []int) {
+	return file_xpired_v1_xpired_proto_rawDescGZIP(), []int{15}
+}
+
+var File_xpired_v1_xpired_proto protoreflect.FileDescriptor
+
+const file_xpired_v1_xpired_proto_rawDesc = "" +
+	"\n" +
+	"\x16xpired/v1/xpired.proto\x12\txpired.v1\x1a\x1fgoogle/protobuf/timestamp.proto\"8\n" +
+	"\x10ReminderInterval\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x14\n" +
+	"\x05label\x18\x02 \x01(\tR\x05label\"R\n" +
+	"\x10DocumentReminder\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x14\n" +
+	"\x05label\x18\x02 \x01(\tR\x05label\x12\x18\n" +
+	"\aenabled\x18\x03 \x01(\bR\aenabled\"\xb1\x04\n" +
+	"\bDocument\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x12%\n" +
+	"\vdescription\x18\x04 \x01(\tH\x00R\vdescription\x88\x01\x01\x12#\n" +
+	"\n" +
+	"identifier\x18\x05 \x01(\tH\x01R\n" +
+	"identifier\x88\x01\x01\x12C\n" +
+	"\x0fexpiration_date\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\x0eexpirationDate\x12\x1a\n" +
+	"\btimezone\x18\a \x01(\tR\btimezone\x12*\n" +
+	"\x0eattachment_url\x18\b \x01(\tH\x02R\rattachmentUrl\x88\x01\x01\x12\x1f\n" +
+	"\bcategory\x18\t \x01(\tH\x03R\bcategory\x88\x01\x01\x129\n" +
+	"\treminders\x18\n" +
+	" \x03(\v2\x1b.xpired.v1.ReminderIntervalR\treminders\x129\n" +
+	"\n" +
+	"created_at\x18\v \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\f \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAtB\x0e\n" +
+	"\f_descriptionB\r\n" +
+	"\v_identifierB\x11\n" +
+	"\x0f_attachment_urlB\v\n" +
+	"\t_category\"\x16\n" +
+	"\x14ListDocumentsRequest\"J\n" +
+	"\x15ListDocumentsResponse\x121\n" +
+	"\tdocuments\x18\x01 \x03(\v2\x13.xpired.v1.DocumentR\tdocuments\"$\n" +
+	"\x12GetDocumentRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"\x82\x03\n" +
+	"\x15CreateDocumentRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12%\n" +
+	"\vdescription\x18\x02 \x01(\tH\x00R\vdescription\x88\x01\x01\x12#\n" +
+	"\n" +
+	"identifier\x18\x03 \x01(\tH\x01R\n" +
+	"identifier\x88\x01\x01\x12C\n" +
+	"\x0fexpiration_date\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\x0eexpirationDate\x12\x1a\n" +
+	"\btimezone\x18\x05 \x01(\tR\btimezone\x12*\n" +
+	"\x0eattachment_url\x18\x06 \x01(\tH\x02R\rattachmentUrl\x88\x01\x01\x12\x1f\n" +
+	"\bcategory\x18\a \x01(\tH\x03R\bcategory\x88\x01\x01\x12\x1c\n" +
+	"\treminders\x18\b \x03(\tR\tremindersB\x0e\n" +
+	"\f_descriptionB\r\n" +
+	"\v_identifierB\x11\n" +
+	"\x0f_attachment_urlB\v\n" +
+	"\t_category\"\xb2\x03\n" +
+	"\x15UpdateDocumentRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x17\n" +
+	"\x04name\x18\x02 \x01(\tH\x00R\x04name\x88\x01\x01\x12%\n" +
+	"\vdescription\x18\x03 \x01(\tH\x01R\vdescription\x88\x01\x01\x12#\n" +
+	"\n" +
+	"identifier\x18\x04 \x01(\tH\x02R\n" +
+	"identifier\x88\x01\x01\x12C\n" +
+	"\x0fexpiration_date\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\x0eexpirationDate\x12\x1f\n" +
+	"\btimezone\x18\x06 \x01(\tH\x03R\btimezone\x88\x01\x01\x12*\n" +
+	"\x0eattachment_url\x18\a \x01(\tH\x04R\rattachmentUrl\x88\x01\x01\x12\x1f\n" +
+	"\bcategory\x18\b \x01(\tH\x05R\bcategory\x88\x01\x01\x12\x1c\n" +
+	"\treminders\x18\t \x03(\tR\tremindersB\a\n" +
+	"\x05_nameB\x0e\n" +
+	"\f_descriptionB\r\n" +
+	"\v_identifierB\v\n" +
+	"\t_timezoneB\x11\n" +
+	"\x0f_attachment_urlB\v\n" +
+	"\t_category\"'\n" +
+	"\x15DeleteDocumentRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"\x18\n" +
+	"\x16DeleteDocumentResponse\"\x1e\n" +
+	"\x1cListReminderIntervalsRequest\"k\n" +
+	"\x1dListReminderIntervalsResponse\x12J\n" +
+	"\x12reminder_intervals\x18\x01 \x03(\v2\x1b.xpired.v1.ReminderIntervalR\x11reminderIntervals\">\n" +
+	"\x1bGetDocumentRemindersRequest\x12\x1f\n" +
+	"\vdocument_id\x18\x01 \x01(\tR\n" +
+	"documentId\"Y\n" +
+	"\x1cGetDocumentRemindersResponse\x129\n" +
+	"\treminders\x18\x01 \x03(\v2\x1b.xpired.v1.DocumentReminderR\treminders\"{\n" +
+	"\x1dToggleDocumentReminderRequest\x12\x1f\n" +
+	"\vdocument_id\x18\x01 \x01(\tR\n" +
+	"documentId\x12\x1f\n" +
+	"\vinterval_id\x18\x02 \x01(\tR\n" +
+	"intervalId\x12\x18\n" +
+	"\aenabled\x18\x03 \x01(\bR\aenabled\" \n" +
+	"\x1eToggleDocumentReminderResponse2\x91\x03\n" +
+	"\x0fDocumentService\x12R\n" +
+	"\rListDocuments\x12\x1f.xpired.v1.ListDocumentsRequest\x1a .xpired.v1.ListDocumentsResponse\x12A\n" +
+	"\vGetDocument\x12\x1d.xpired.v1.GetDocumentRequest\x1a\x13.xpired.v1.Document\x12G\n" +
+	"\x0eCreateDocument\x12 .xpired.v1.CreateDocumentRequest\x1a\x13.xpired.v1.Document\x12G\n" +
+	"\x0eUpdateDocument\x12 .xpired.v1.UpdateDocumentRequest\x1a\x13.xpired.v1.Document\x12U\n" +
+	"\x0eDeleteDocument\x12 .xpired.v1.DeleteDocumentRequest\x1a!.xpired.v1.DeleteDocumentResponse2\xd5\x02\n" +
+	"\x0fReminderService\x12j\n" +
+	"\x15ListReminderIntervals\x12'.xpired.v1.ListReminderIntervalsRequest\x1a(.xpired.v1.ListReminderIntervalsResponse\x12g\n" +
+	"\x14GetDocumentReminders\x12&.xpired.v1.GetDocumentRemindersRequest\x1a'.xpired.v1.GetDocumentRemindersResponse\x12m\n" +
+	"\x16ToggleDocumentReminder\x12(.xpired.v1.ToggleDocumentReminderRequest\x1a).xpired.v1.ToggleDocumentReminderResponseB'Z%xpired/internal/pb/xpired/v1;xpiredv1b\x06proto3"
+
+var (
+	file_xpired_v1_xpired_proto_rawDescOnce sync.Once
+	file_xpired_v1_xpired_proto_rawDescData []byte
+)
+
+func file_xpired_v1_xpired_proto_rawDescGZIP() []byte {
+	file_xpired_v1_xpired_proto_rawDescOnce.Do(func() {
+		file_xpired_v1_xpired_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_xpired_v1_xpired_proto_rawDesc), len(file_xpired_v1_xpired_proto_rawDesc)))
+	})
+	return file_xpired_v1_xpired_proto_rawDescData
+}
+
+var file_xpired_v1_xpired_proto_msgTypes = make([]protoimpl.MessageInfo, 16)
+var file_xpired_v1_xpired_proto_goTypes = []any{
+	(*ReminderInterval)(nil),               // 0: xpired.v1.ReminderInterval
+	(*DocumentReminder)(nil),               // 1: xpired.v1.DocumentReminder
+	(*Document)(nil),                       // 2: xpired.v1.Document
+	(*ListDocumentsRequest)(nil),           // 3: xpired.v1.ListDocumentsRequest
+	(*ListDocumentsResponse)(nil),          // 4: xpired.v1.ListDocumentsResponse
+	(*GetDocumentRequest)(nil),             // 5: xpired.v1.GetDocumentRequest
+	(*CreateDocumentRequest)(nil),          // 6: xpired.v1.CreateDocumentRequest
+	(*UpdateDocumentRequest)(nil),          // 7: xpired.v1.UpdateDocumentRequest
+	(*DeleteDocumentRequest)(nil),          // 8: xpired.v1.DeleteDocumentRequest
+	(*DeleteDocumentResponse)(nil),         // 9: xpired.v1.DeleteDocumentResponse
+	(*ListReminderIntervalsRequest)(nil),   // 10: xpired.v1.ListReminderIntervalsRequest
+	(*ListReminderIntervalsResponse)(nil),  // 11: xpired.v1.ListReminderIntervalsResponse
+	(*GetDocumentRemindersRequest)(nil),    // 12: xpired.v1.GetDocumentRemindersRequest
+	(*GetDocumentRemindersResponse)(nil),   // 13: xpired.v1.GetDocumentRemindersResponse
+	(*ToggleDocumentReminderRequest)(nil),  // 14: xpired.v1.ToggleDocumentReminderRequest
+	(*ToggleDocumentReminderResponse)(nil), // 15: xpired.v1.ToggleDocumentReminderResponse
+	(*timestamppb.Timestamp)(nil),          // 16: google.protobuf.Timestamp
+}
+var file_xpired_v1_xpired_proto_depIdxs = []int32{
+	16, // 0: xpired.v1.Document.expiration_date:type_name -> google.protobuf.Timestamp
+	0,  // 1: xpired.v1.Document.reminders:type_name -> xpired.v1.ReminderInterval
+	16, // 2: xpired.v1.Document.created_at:type_name -> google.protobuf.Timestamp
+	16, // 3: xpired.v1.Document.updated_at:type_name -> google.protobuf.Timestamp
+	2,  // 4: xpired.v1.ListDocumentsResponse.documents:type_name -> xpired.v1.Document
+	16, // 5: xpired.v1.CreateDocumentRequest.expiration_date:type_name -> google.protobuf.Timestamp
+	16, // 6: xpired.v1.UpdateDocumentRequest.expiration_date:type_name -> google.protobuf.Timestamp
+	0,  // 7: xpired.v1.ListReminderIntervalsResponse.reminder_intervals:type_name -> xpired.v1.ReminderInterval
+	1,  // 8: xpired.v1.GetDocumentRemindersResponse.reminders:type_name -> xpired.v1.DocumentReminder
+	3,  // 9: xpired.v1.DocumentService.ListDocuments:input_type -> xpired.v1.ListDocumentsRequest
+	5,  // 10: xpired.v1.DocumentService.GetDocument:input_type -> xpired.v1.GetDocumentRequest
+	6,  // 11: xpired.v1.DocumentService.CreateDocument:input_type -> xpired.v1.CreateDocumentRequest
+	7,  // 12: xpired.v1.DocumentService.UpdateDocument:input_type -> xpired.v1.UpdateDocumentRequest
+	8,  // 13: xpired.v1.DocumentService.DeleteDocument:input_type -> xpired.v1.DeleteDocumentRequest
+	10, // 14: xpired.v1.ReminderService.ListReminderIntervals:input_type -> xpired.v1.ListReminderIntervalsRequest
+	12, // 15: xpired.v1.ReminderService.GetDocumentReminders:input_type -> xpired.v1.GetDocumentRemindersRequest
+	14, // 16: xpired.v1.ReminderService.ToggleDocumentReminder:input_type -> xpired.v1.ToggleDocumentReminderRequest
+	4,  // 17: xpired.v1.DocumentService.ListDocuments:output_type -> xpired.v1.ListDocumentsResponse
+	2,  // 18: xpired.v1.DocumentService.GetDocument:output_type -> xpired.v1.Document
+	2,  // 19: xpired.v1.DocumentService.CreateDocument:output_type -> xpired.v1.Document
+	2,  // 20: xpired.v1.DocumentService.UpdateDocument:output_type -> xpired.v1.Document
+	9,  // 21: xpired.v1.DocumentService.DeleteDocument:output_type -> xpired.v1.DeleteDocumentResponse
+	11, // 22: xpired.v1.ReminderService.ListReminderIntervals:output_type -> xpired.v1.ListReminderIntervalsResponse
+	13, // 23: xpired.v1.ReminderService.GetDocumentReminders:output_type -> xpired.v1.GetDocumentRemindersResponse
+	15, // 24: xpired.v1.ReminderService.ToggleDocumentReminder:output_type -> xpired.v1.ToggleDocumentReminderResponse
+	17, // [17:25] is the sub-list for method output_type
+	9,  // [9:17] is the sub-list for method input_type
+	9,  // [9:9] is the sub-list for extension type_name
+	9,  // [9:9] is the sub-list for extension extendee
+	0,  // [0:9] is the sub-list for field type_name
+}
+
+func init() { file_xpired_v1_xpired_proto_init() }
+func file_xpired_v1_xpired_proto_init() {
+	if File_xpired_v1_xpired_proto != nil {
+		return
+	}
+	file_xpired_v1_xpired_proto_msgTypes[2].OneofWrappers = []any{}
+	file_xpired_v1_xpired_proto_msgTypes[6].OneofWrappers = []any{}
+	file_xpired_v1_xpired_proto_msgTypes[7].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_xpired_v1_xpired_proto_rawDesc), len(file_xpired_v1_xpired_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   16,
+			NumExtensions: 0,
+			NumServices:   2,
+		},
+		GoTypes:           file_xpired_v1_xpired_proto_goTypes,
+		DependencyIndexes: file_xpired_v1_xpired_proto_depIdxs,
+		MessageInfos:      file_xpired_v1_xpired_proto_msgTypes,
+	}.Build()
+	File_xpired_v1_xpired_proto = out.File
+	file_xpired_v1_xpired_proto_goTypes = nil
+	file_xpired_v1_xpired_proto_depIdxs = nil
+}
@@ -0,0 +1,80 @@
+package ratelimit
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+)
+
+const keyPrefix = "xpired:ratelimit:"
+
+// takeScript refills the bucket for the time since it was last used and
+// takes a token when one is available. It returns 0 when it took one,
+// otherwise the milliseconds until the next token. Redis' clock is used so
+// replicas with skewed clocks share one bucket fairly.
+var takeScript = redis.NewScript(`
+local rate = tonumber(ARGV[1])
+local burst = tonumber(ARGV[2])
+local time = redis.call("TIME")
+local now = tonumber(time[1]) * 1000 + math.floor(tonumber(time[2]) / 1000)
+
+local state = redis.call("HMGET", KEYS[1], "tokens", "at")
+local tokens = tonumber(state[1]) or burst
+local at = tonumber(state[2]) or now
+tokens = math.min(burst, tokens + (now - at) * rate / 1000)
+
+local wait = 0
+if tokens >= 1 then
+	tokens = tokens - 1
+else
+	wait = math.ceil((1 - tokens) * 1000 / rate)
+end
+
+redis.call("HSET", KEYS[1], "tokens", tostring(tokens), "at", now)
+redis.call("PEXPIRE", KEYS[1], math.ceil(burst * 1000 / rate) + 1000)
+return wait
+`)
+
+// Limiter hands out Redis-backed token buckets shared by every replica
+// connected to the same Redis instance.
+type Limiter struct {
+	rdb *redis.Client
+}
+
+func NewLimiter(rdb *redis.Client) *Limiter {
+	return &Limiter{rdb: rdb}
+}
+
+// Take tries once to take a token from the named bucket, which refills at
+// rate tokens per second and holds at most burst. It returns 0 when it took
+// one, otherwise how long until one is available.
+func (l *Limiter) Take(ctx context.Context, name string, rate, burst int) (time.Duration, error) {
+	wait, err := takeScript.Run(ctx, l.rdb, []string{keyPrefix + name}, rate, burst).Int64()
+	if err != nil {
+		return 0, fmt.Errorf("failed to take token from %s: %w", name, err)
+	}
+	return time.Duration(wait) * time.Millisecond, nil
+}
+
+// Wait blocks until it took a token from the named bucket or ctx is done.
+func (l *Limiter) Wait(ctx context.Context, name string, rate, burst int) error {
+	for {
+		wait, err := l.Take(ctx, name, rate, burst)
+		if err != nil {
+			return err
+		}
+		if wait == 0 {
+			return nil
+		}
+
+		timer := time.NewTimer(wait)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return ctx.Err()
+		case <-timer.C:
+		}
+	}
+}
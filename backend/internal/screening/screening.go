@@ -0,0 +1,130 @@
+// Package screening checks sign-ups for spam accounts: throwaway email
+// domains and failed CAPTCHA challenges.
+package screening
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+)
+
+// DefaultVerifyURL is Cloudflare Turnstile's verification endpoint.
+const DefaultVerifyURL = "https://challenges.cloudflare.com/turnstile/v0/siteverify"
+
+// Verifier checks the token a CAPTCHA widget gave the client. It returns
+// false when the challenge failed and an error when it could not be checked.
+type Verifier interface {
+	Verify(ctx context.Context, token, remoteIP string) (bool, error)
+}
+
+// SiteVerify checks tokens with the siteverify protocol shared by Turnstile,
+// hCaptcha and reCAPTCHA: a form POST of secret, response and remoteip
+// answered with {"success": bool}.
+type SiteVerify struct {
+	url    string
+	secret string
+	client *http.Client
+}
+
+// NewSiteVerify verifies tokens at verifyURL with client, which should time
+// out after a few seconds.
+func NewSiteVerify(verifyURL, secret string, client *http.Client) *SiteVerify {
+	return &SiteVerify{
+		url:    verifyURL,
+		secret: secret,
+		client: client,
+	}
+}
+
+func (s *SiteVerify) Verify(ctx context.Context, token, remoteIP string) (bool, error) {
+	form := url.Values{
+		"secret":   {s.secret},
+		"response": {token},
+	}
+	if remoteIP != "" {
+		form.Set("remoteip", remoteIP)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, s.url, strings.NewReader(form.Encode()))
+	if err != nil {
+		return false, fmt.Errorf("failed to build captcha request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return false, fmt.Errorf("failed to verify captcha: %w", err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return false, fmt.Errorf("captcha verification returned %s", resp.Status)
+	}
+
+	var result struct {
+		Success bool `json:"success"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+		return false, fmt.Errorf("failed to decode captcha verification: %w", err)
+	}
+	return result.Success, nil
+}
+
+// DomainList is a set of email domains. A listed domain also covers its
+// subdomains.
+type DomainList map[string]bool
+
+func NewDomainList(domains []string) DomainList {
+	list := DomainList{}
+	for _, domain := range domains {
+		if domain = strings.ToLower(strings.TrimSpace(domain)); domain != "" {
+			list[domain] = true
+		}
+	}
+	return list
+}
+
+// LoadFile reads one domain per line from path, skipping blank lines and #
+// comments, and adds them to list.
+func (list DomainList) LoadFile(path string) error {
+	f, err := os.Open(path)
+	if err != nil {
+		return fmt.Errorf("failed to open domain list: %w", err)
+	}
+	defer f.Close()
+
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		line, _, _ := strings.Cut(scanner.Text(), "#")
+		if line = strings.ToLower(strings.TrimSpace(line)); line != "" {
+			list[line] = true
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return fmt.Errorf("failed to read domain list: %w", err)
+	}
+	return nil
+}
+
+// Contains reports whether email's domain, or a parent of it, is listed.
+func (list DomainList) Contains(email string) bool {
+	_, domain, ok := strings.Cut(strings.ToLower(strings.TrimSpace(email)), "@")
+	if !ok {
+		return false
+	}
+	for domain != "" {
+		if list[domain] {
+			return true
+		}
+		_, parent, found := strings.Cut(domain, ".")
+		if !found {
+			break
+		}
+		domain = parent
+	}
+	return false
+}
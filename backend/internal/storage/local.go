@@ -1 +1,119 @@
 package storage
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// Local stores attachments as files under a directory.
+type Local struct {
+	dir       string
+	publicURL string
+}
+
+func NewLocal(dir, publicURL string) *Local {
+	return &Local{dir: dir, publicURL: publicURL}
+}
+
+// path maps a key to a file under the storage directory, rejecting keys like
+// "../x" that would escape it.
+func (l *Local) path(key string) (string, error) {
+	path := filepath.Join(l.dir, filepath.FromSlash(key))
+	if rel, err := filepath.Rel(l.dir, path); err != nil || strings.HasPrefix(rel, "..") {
+		return "", ErrNotManaged
+	}
+	return path, nil
+}
+
+func (l *Local) pathForURL(url string) (string, error) {
+	key, err := objectKey(l.publicURL, url)
+	if err != nil {
+		return "", err
+	}
+	return l.path(key)
+}
+
+func (l *Local) Put(ctx context.Context, key string, r io.Reader, contentType string) (string, error) {
+	path, err := l.path(key)
+	if err != nil {
+		return "", err
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return "", err
+	}
+
+	f, err := os.Create(path)
+	if err != nil {
+		return "", err
+	}
+	if _, err := io.Copy(f, r); err != nil {
+		f.Close()
+		os.Remove(path)
+		return "", err
+	}
+	if err := f.Close(); err != nil {
+		return "", err
+	}
+	return strings.TrimSuffix(l.publicURL, "/") + "/" + key, nil
+}
+
+func (l *Local) Open(ctx context.Context, url string) (io.ReadCloser, error) {
+	path, err := l.pathForURL(url)
+	if err != nil {
+		return nil, err
+	}
+	return os.Open(path)
+}
+
+func (l *Local) Quarantine(ctx context.Context, url string) (string, error) {
+	key, err := objectKey(l.publicURL, url)
+	if err != nil {
+		return "", err
+	}
+	src, err := l.path(key)
+	if err != nil {
+		return "", err
+	}
+	quarantineKey := QuarantinePrefix + key
+	dst, err := l.path(quarantineKey)
+	if err != nil {
+		return "", err
+	}
+
+	if err := os.MkdirAll(filepath.Dir(dst), 0o700); err != nil {
+		return "", err
+	}
+	if err := os.Rename(src, dst); err != nil {
+		return "", err
+	}
+	return quarantineKey, nil
+}
+
+func (l *Local) Delete(ctx context.Context, url string) error {
+	path, err := l.pathForURL(url)
+	if err != nil {
+		return err
+	}
+	if err := os.Remove(path); err != nil && !os.IsNotExist(err) {
+		return err
+	}
+	return nil
+}
+
+// Handler serves stored files at the storage's public URL path. Quarantined
+// files are never served.
+func (l *Local) Handler(prefix string) http.Handler {
+	files := http.StripPrefix(prefix, http.FileServer(http.Dir(l.dir)))
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		key := strings.TrimPrefix(r.URL.Path, prefix)
+		if strings.HasPrefix(key, QuarantinePrefix) || strings.HasSuffix(r.URL.Path, "/") {
+			http.NotFound(w, r)
+			return
+		}
+		files.ServeHTTP(w, r)
+	})
+}
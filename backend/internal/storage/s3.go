@@ -1 +1,111 @@
 package storage
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/url"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awsconfig "github.com/aws/aws-sdk-go-v2/config"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+
+	"xpired/internal/config"
+)
+
+// S3 stores attachments in an S3-compatible bucket. Credentials come from
+// the standard AWS environment variables or instance role.
+type S3 struct {
+	client    *s3.Client
+	bucket    string
+	publicURL string
+}
+
+func NewS3(ctx context.Context, cfg config.StorageConfig) (*S3, error) {
+	if cfg.S3Bucket == "" {
+		return nil, fmt.Errorf("S3_BUCKET is required for the s3 storage driver")
+	}
+
+	awsCfg, err := awsconfig.LoadDefaultConfig(ctx, awsconfig.WithRegion(cfg.S3Region))
+	if err != nil {
+		return nil, fmt.Errorf("failed to load AWS config: %w", err)
+	}
+
+	client := s3.NewFromConfig(awsCfg, func(o *s3.Options) {
+		if cfg.S3Endpoint != "" {
+			o.BaseEndpoint = aws.String(cfg.S3Endpoint)
+			o.UsePathStyle = true
+		}
+	})
+
+	return &S3{client: client, bucket: cfg.S3Bucket, publicURL: cfg.PublicURL}, nil
+}
+
+func (s *S3) Put(ctx context.Context, key string, r io.Reader, contentType string) (string, error) {
+	_, err := s.client.PutObject(ctx, &s3.PutObjectInput{
+		Bucket:      aws.String(s.bucket),
+		Key:         aws.String(key),
+		Body:        r,
+		ContentType: aws.String(contentType),
+	})
+	if err != nil {
+		return "", fmt.Errorf("failed to upload s3 object %s: %w", key, err)
+	}
+	return strings.TrimSuffix(s.publicURL, "/") + "/" + key, nil
+}
+
+func (s *S3) Open(ctx context.Context, url string) (io.ReadCloser, error) {
+	key, err := objectKey(s.publicURL, url)
+	if err != nil {
+		return nil, err
+	}
+
+	out, err := s.client.GetObject(ctx, &s3.GetObjectInput{
+		Bucket: aws.String(s.bucket),
+		Key:    aws.String(key),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get s3 object %s: %w", key, err)
+	}
+	return out.Body, nil
+}
+
+// Quarantine copies the object under QuarantinePrefix and deletes the
+// original. Keep the quarantine prefix private in the bucket policy.
+func (s *S3) Quarantine(ctx context.Context, rawURL string) (string, error) {
+	key, err := objectKey(s.publicURL, rawURL)
+	if err != nil {
+		return "", err
+	}
+	quarantineKey := QuarantinePrefix + key
+
+	_, err = s.client.CopyObject(ctx, &s3.CopyObjectInput{
+		Bucket:     aws.String(s.bucket),
+		Key:        aws.String(quarantineKey),
+		CopySource: aws.String((&url.URL{Path: s.bucket + "/" + key}).EscapedPath()),
+	})
+	if err != nil {
+		return "", fmt.Errorf("failed to quarantine s3 object %s: %w", key, err)
+	}
+	if err := s.Delete(ctx, rawURL); err != nil {
+		return "", err
+	}
+	return quarantineKey, nil
+}
+
+func (s *S3) Delete(ctx context.Context, url string) error {
+	key, err := objectKey(s.publicURL, url)
+	if err != nil {
+		return err
+	}
+
+	_, err = s.client.DeleteObject(ctx, &s3.DeleteObjectInput{
+		Bucket: aws.String(s.bucket),
+		Key:    aws.String(key),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to delete s3 object %s: %w", key, err)
+	}
+	return nil
+}
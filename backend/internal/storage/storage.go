@@ -0,0 +1,57 @@
+package storage
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+
+	"xpired/internal/config"
+)
+
+// ErrNotManaged is returned for attachment URLs that do not point into the
+// configured storage, such as links users pasted in themselves.
+var ErrNotManaged = errors.New("attachment is not managed by this storage")
+
+// Storage is where document attachments are kept. Documents refer to
+// attachments by their public URL.
+type Storage interface {
+	// Put stores r under key and returns the object's public URL.
+	Put(ctx context.Context, key string, r io.Reader, contentType string) (string, error)
+	// Open returns the contents of the object behind url.
+	Open(ctx context.Context, url string) (io.ReadCloser, error)
+	// Quarantine moves the object behind url under QuarantinePrefix, where it
+	// is no longer served, and returns its new key.
+	Quarantine(ctx context.Context, url string) (string, error)
+	// Delete removes the object behind url. Deleting an object that is
+	// already gone is not an error.
+	Delete(ctx context.Context, url string) error
+}
+
+// QuarantinePrefix is the key prefix infected attachments are moved under.
+const QuarantinePrefix = "quarantine/"
+
+func New(ctx context.Context, cfg config.StorageConfig) (Storage, error) {
+	switch cfg.Driver {
+	case "local":
+		return NewLocal(cfg.LocalDir, cfg.PublicURL), nil
+	case "s3":
+		return NewS3(ctx, cfg)
+	default:
+		return nil, fmt.Errorf("unknown storage driver %q", cfg.Driver)
+	}
+}
+
+// objectKey returns the part of url after publicURL, or ErrNotManaged.
+func objectKey(publicURL, url string) (string, error) {
+	prefix := strings.TrimSuffix(publicURL, "/") + "/"
+	if publicURL == "" || !strings.HasPrefix(url, prefix) {
+		return "", ErrNotManaged
+	}
+	key := strings.TrimPrefix(url, prefix)
+	if key == "" {
+		return "", ErrNotManaged
+	}
+	return key, nil
+}
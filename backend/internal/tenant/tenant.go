@@ -0,0 +1,51 @@
+// Package tenant carries the user and organization a request or task acts
+// for. The repository reads it to pick the database holding that
+// organization's data and to scope row-level security to the tenant.
+package tenant
+
+import "context"
+
+type contextKey string
+
+const tenantKey contextKey = "tenant"
+
+// Tenant is the user and organization in context and the data region the
+// organization's documents live in. An empty Region means the home database;
+// an empty UserID means a background job that is not scoped to any user.
+type Tenant struct {
+	UserID         string
+	OrganizationID string
+	Region         string
+}
+
+// WithUser scopes ctx to the documents userID may access.
+func WithUser(ctx context.Context, userID string) context.Context {
+	t := FromContext(ctx)
+	t.UserID = userID
+	return context.WithValue(ctx, tenantKey, t)
+}
+
+func WithOrganization(ctx context.Context, organizationID, region string) context.Context {
+	t := FromContext(ctx)
+	t.OrganizationID, t.Region = organizationID, region
+	return context.WithValue(ctx, tenantKey, t)
+}
+
+// WithRegion targets a region without an organization, for jobs that sweep
+// every region in turn.
+func WithRegion(ctx context.Context, region string) context.Context {
+	t := FromContext(ctx)
+	t.OrganizationID, t.Region = "", region
+	return context.WithValue(ctx, tenantKey, t)
+}
+
+// FromContext returns the tenant in ctx; the zero Tenant when there is none.
+func FromContext(ctx context.Context) Tenant {
+	t, _ := ctx.Value(tenantKey).(Tenant)
+	return t
+}
+
+// OrganizationID returns the organization in ctx, or "".
+func OrganizationID(ctx context.Context) string {
+	return FromContext(ctx).OrganizationID
+}
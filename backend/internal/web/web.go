@@ -0,0 +1,126 @@
+// Package web serves the single-page frontend from the same binary as the
+// API, so that a self-hosted install is a single container.
+//
+// The frontend is built as a static export (output: "export" in
+// next.config.ts) and its out directory copied to internal/web/dist before
+// go build. A binary built without one serves the API alone.
+package web
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"embed"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io/fs"
+	"net/http"
+	"os"
+	"path"
+	"strings"
+	"time"
+)
+
+//go:embed all:dist
+var dist embed.FS
+
+// immutablePrefix holds the build's content-hashed assets, which never change
+// under the same name and may be cached for good.
+const immutablePrefix = "_next/static/"
+
+// ErrNoBuild is returned by New when there is no frontend build to serve.
+var ErrNoBuild = errors.New("no frontend build: index.html is missing")
+
+type file struct {
+	name string
+	data []byte
+	etag string
+}
+
+// Handler serves the frontend build. Paths that are not a file of the build
+// get index.html, so that client-side routes survive a reload.
+type Handler struct {
+	files map[string]*file
+}
+
+// New loads the frontend build in dir, or the embedded one when dir is empty.
+// The build is read once; changes to dir need a restart.
+func New(dir string) (*Handler, error) {
+	var build fs.FS
+	if dir != "" {
+		build = os.DirFS(dir)
+	} else {
+		var err error
+		if build, err = fs.Sub(dist, "dist"); err != nil {
+			return nil, err
+		}
+	}
+
+	h := &Handler{files: map[string]*file{}}
+	err := fs.WalkDir(build, ".", func(name string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if d.IsDir() || strings.HasPrefix(d.Name(), ".") {
+			return nil
+		}
+		data, err := fs.ReadFile(build, name)
+		if err != nil {
+			return err
+		}
+		sum := sha256.Sum256(data)
+		h.files[name] = &file{
+			name: name,
+			data: data,
+			etag: `"` + hex.EncodeToString(sum[:8]) + `"`,
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to read frontend build: %w", err)
+	}
+	if _, ok := h.files["index.html"]; !ok {
+		return nil, ErrNoBuild
+	}
+	return h, nil
+}
+
+// lookup finds the file for a request path the way the static export lays
+// pages out: /documents is documents.html or documents/index.html.
+func (h *Handler) lookup(name string) (*file, bool) {
+	for _, candidate := range []string{name, name + ".html", path.Join(name, "index.html")} {
+		if f, ok := h.files[candidate]; ok {
+			return f, true
+		}
+	}
+	return nil, false
+}
+
+func (h *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet && r.Method != http.MethodHead {
+		w.Header().Set("Allow", "GET, HEAD")
+		http.Error(w, http.StatusText(http.StatusMethodNotAllowed), http.StatusMethodNotAllowed)
+		return
+	}
+
+	name := strings.TrimPrefix(path.Clean("/"+r.URL.Path), "/")
+	f, ok := h.lookup(name)
+	if !ok {
+		// a missing asset rather than a client-side route
+		if path.Ext(name) != "" {
+			http.NotFound(w, r)
+			return
+		}
+		f = h.files["index.html"]
+	}
+
+	if strings.HasPrefix(f.name, immutablePrefix) {
+		w.Header().Set("Cache-Control", "public, max-age=31536000, immutable")
+	} else {
+		// pages name the current assets, so they are revalidated every time
+		w.Header().Set("Cache-Control", "no-cache")
+	}
+	w.Header().Set("ETag", f.etag)
+	w.Header().Set("X-Content-Type-Options", "nosniff")
+	http.ServeContent(w, r, f.name, time.Time{}, bytes.NewReader(f.data))
+}
@@ -0,0 +1,154 @@
+package worker
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"xpired/internal/db"
+	"xpired/internal/storage"
+
+	"github.com/google/uuid"
+	"github.com/hibiken/asynq"
+)
+
+const (
+	purgeAccountsBatchSize = 100
+	// cancelTasksPageSize is how many tasks of a queue CancelUserTasks reads
+	// at a time.
+	cancelTasksPageSize = 500
+)
+
+// CancelUserTasks deletes the tasks queued for userID that have not run
+// yet: scheduled, pending and retrying ones, and reminders waiting to be
+// batched. It returns how many it deleted. Tasks it misses, like ones queued
+// meanwhile, are dropped when they run (see suspensionMiddleware).
+func CancelUserTasks(userID string) (int, error) {
+	queues, err := inspector.Queues()
+	if err != nil {
+		return 0, fmt.Errorf("failed to list queues: %w", err)
+	}
+
+	cancelled := 0
+	for _, queue := range queues {
+		// collect first: deleting while paging would shift the pages
+		var ids []string
+		for _, list := range []func(string, ...asynq.ListOption) ([]*asynq.TaskInfo, error){
+			inspector.ListScheduledTasks, inspector.ListPendingTasks, inspector.ListRetryTasks,
+		} {
+			for page := 1; ; page++ {
+				tasks, err := list(queue, asynq.PageSize(cancelTasksPageSize), asynq.Page(page))
+				if err != nil {
+					return cancelled, fmt.Errorf("failed to list tasks of %s: %w", queue, err)
+				}
+				for _, info := range tasks {
+					if taskUserID(info.Payload) == userID {
+						ids = append(ids, info.ID)
+					}
+				}
+				if len(tasks) < cancelTasksPageSize {
+					break
+				}
+			}
+		}
+		for _, id := range ids {
+			err := inspector.DeleteTask(queue, id)
+			if err != nil && !errors.Is(err, asynq.ErrTaskNotFound) {
+				return cancelled, fmt.Errorf("failed to delete task %s: %w", id, err)
+			}
+			if err == nil {
+				cancelled++
+			}
+		}
+
+		groups, err := inspector.Groups(queue)
+		if err != nil {
+			return cancelled, fmt.Errorf("failed to list groups of %s: %w", queue, err)
+		}
+		for _, group := range groups {
+			if !strings.HasPrefix(group.Group, "reminders:"+userID+":") {
+				continue
+			}
+			deleted, err := inspector.DeleteAllAggregatingTasks(queue, group.Group)
+			if err != nil {
+				return cancelled, fmt.Errorf("failed to delete batched reminders of %s: %w", group.Group, err)
+			}
+			cancelled += deleted
+		}
+	}
+	return cancelled, nil
+}
+
+func taskUserID(payload []byte) string {
+	var task struct {
+		UserID string `json:"user_id"`
+	}
+	if err := json.Unmarshal(payload, &task); err != nil {
+		return ""
+	}
+	return task.UserID
+}
+
+// PurgeDeletedAccountsJob permanently deletes the personal documents of
+// accounts deleted more than graceDays ago, along with their stored
+// attachments unless other documents share them.
+func PurgeDeletedAccountsJob(repo db.DocumentRepository, store storage.Storage, graceDays int) Job {
+	return Job{
+		Name:     JobPurgeDeletedAccounts,
+		Interval: time.Hour,
+		Run: func(ctx context.Context) error {
+			return purgeDeletedAccounts(ctx, repo, store, time.Now().AddDate(0, 0, -graceDays))
+		},
+	}
+}
+
+func purgeDeletedAccounts(ctx context.Context, repo db.DocumentRepository, store storage.Storage, cutoff time.Time) error {
+	purged := 0
+	// as in purgeTrash, documents that fail are retried on the next run
+	skipped := map[uuid.UUID]bool{}
+	for {
+		docs, err := repo.ListDeletedAccountDocuments(ctx, cutoff, purgeAccountsBatchSize+len(skipped))
+		if err != nil {
+			return err
+		}
+
+		progressed := false
+		for _, doc := range docs {
+			if skipped[doc.ID] {
+				continue
+			}
+			if err := purgeAccountDocument(ctx, repo, store, doc); err != nil {
+				logf(ctx, "Failed to purge document %s of deleted account %s: %v", doc.ID.String(), doc.UserID.String(), err)
+				skipped[doc.ID] = true
+				continue
+			}
+			purged++
+			progressed = true
+		}
+
+		if !progressed || len(docs) < purgeAccountsBatchSize+len(skipped) {
+			break
+		}
+	}
+
+	if purged > 0 {
+		logf(ctx, "Purged %d documents of deleted accounts", purged)
+	}
+	return nil
+}
+
+// purgeAccountDocument trashes doc first, if it is not yet, as only trashed
+// documents can be purged.
+func purgeAccountDocument(ctx context.Context, repo db.DocumentRepository, store storage.Storage, doc *db.Document) error {
+	if doc.DeletedAt == nil {
+		if err := repo.DeleteDocument(ctx, doc.ID.String()); err != nil {
+			return err
+		}
+		now := time.Now()
+		doc.DeletedAt = &now
+	}
+	return purgeDocument(ctx, repo, store, doc)
+}
@@ -0,0 +1,169 @@
+package worker
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"xpired/internal/config"
+	"xpired/internal/db"
+
+	"github.com/hibiken/asynq"
+	"github.com/redis/go-redis/v9"
+)
+
+const (
+	AlertNotificationsSilent  = "notifications_silent"
+	AlertQueueBacklog         = "queue_backlog"
+	AlertNotificationFailures = "notification_failures"
+	AlertNotificationAnomaly  = "notification_anomaly"
+
+	// alertFailureMinSamples keeps a couple of failures on a quiet hour from
+	// counting as a high failure rate.
+	alertFailureMinSamples = 20
+	alertWebhookTimeout    = 10 * time.Second
+)
+
+// Alert is posted to the alert webhook when a delivery health check starts
+// or stops failing.
+type Alert struct {
+	Name string `json:"alert"`
+	// Status is "firing" or "resolved".
+	Status    string  `json:"status"`
+	Value     float64 `json:"value"`
+	Threshold float64 `json:"threshold"`
+	// Text describes the alert for chat tools that show a message as is.
+	Text    string    `json:"text"`
+	FiredAt time.Time `json:"firedAt"`
+}
+
+type healthMonitor struct {
+	repo      db.Repository
+	rdb       *redis.Client
+	inspector *asynq.Inspector
+	cfg       config.AlertsConfig
+	client    *http.Client
+}
+
+// MonitorHealthJob checks that reminders keep flowing: that notifications
+// went out recently, that the task queue is not backing up and that few
+// sends fail. An alert is logged and posted to the alert webhook when a
+// check starts failing and again when it recovers.
+func MonitorHealthJob(repo db.Repository, rdb *redis.Client, cfg *config.Config) Job {
+	m := &healthMonitor{
+		repo: repo,
+		rdb:  rdb,
+		inspector: asynq.NewInspector(asynq.RedisClientOpt{
+			Addr:     cfg.Redis.Addr,
+			Password: cfg.Redis.Password,
+		}),
+		cfg:    cfg.Alerts,
+		client: EgressClient(cfg.Egress, alertWebhookTimeout),
+	}
+	return Job{
+		Name:     JobMonitorHealth,
+		Interval: 5 * time.Minute,
+		Run:      m.run,
+	}
+}
+
+func (m *healthMonitor) run(ctx context.Context) error {
+	now := time.Now()
+	var sent, failed int
+	var lastSentAt *time.Time
+	for _, regionCtx := range regionContexts(ctx, m.repo) {
+		stats, err := m.repo.CountNotificationOutcomes(regionCtx, now.Add(-time.Hour))
+		if err != nil {
+			return err
+		}
+		sent += stats.Sent
+		failed += stats.Failed
+		if stats.LastSentAt != nil && (lastSentAt == nil || stats.LastSentAt.After(*lastSentAt)) {
+			lastSentAt = stats.LastSentAt
+		}
+	}
+
+	// an instance that never sent anything has nothing to fall silent
+	if lastSentAt != nil {
+		silentHours := now.Sub(*lastSentAt).Hours()
+		m.check(ctx, AlertNotificationsSilent, silentHours > float64(m.cfg.SilenceHours), silentHours, float64(m.cfg.SilenceHours),
+			fmt.Sprintf("No notification has been sent for %.0f hours", silentHours))
+	}
+
+	if total := sent + failed; total >= alertFailureMinSamples {
+		percent := float64(failed) * 100 / float64(total)
+		m.check(ctx, AlertNotificationFailures, percent > float64(m.cfg.FailurePercent), percent, float64(m.cfg.FailurePercent),
+			fmt.Sprintf("%.1f%% of the notifications of the last hour failed (%d of %d)", percent, failed, total))
+	}
+
+	pending := 0
+	queue, err := m.inspector.GetQueueInfo("default")
+	if err != nil && !errors.Is(err, asynq.ErrQueueNotFound) {
+		return fmt.Errorf("failed to inspect queue: %w", err)
+	}
+	if queue != nil {
+		pending = queue.Pending
+	}
+	m.check(ctx, AlertQueueBacklog, pending > m.cfg.QueueDepth, float64(pending), float64(m.cfg.QueueDepth),
+		fmt.Sprintf("%d tasks are waiting in the queue", pending))
+	return nil
+}
+
+// check fires the named alert when failing turns true and resolves it when
+// it turns false again. Whether an alert is firing is kept in Redis, so each
+// change is announced once however many replicas run the job.
+func (m *healthMonitor) check(ctx context.Context, name string, failing bool, value, threshold float64, text string) {
+	key := "xpired:alert:" + name
+	alert := Alert{Name: name, Value: value, Threshold: threshold, Text: text, FiredAt: time.Now().UTC()}
+	if failing {
+		fired, err := m.rdb.SetNX(ctx, key, alert.FiredAt.Format(time.RFC3339), 0).Result()
+		if err != nil || !fired {
+			return
+		}
+		alert.Status = "firing"
+	} else {
+		resolved, err := m.rdb.Del(ctx, key).Result()
+		if err != nil || resolved == 0 {
+			return
+		}
+		alert.Status = "resolved"
+		alert.Text = "Resolved: " + text
+	}
+
+	logf(ctx, "Alert %s %s: %s", name, alert.Status, text)
+	if err := postAlert(ctx, m.client, m.cfg.WebhookURL, alert); err != nil {
+		logf(ctx, "Failed to post alert %s: %v", name, err)
+		// fire again on the next run rather than never
+		if failing {
+			m.rdb.Del(ctx, key)
+		}
+	}
+}
+
+// postAlert posts alert to webhookURL, if set.
+func postAlert(ctx context.Context, client *http.Client, webhookURL string, alert Alert) error {
+	if webhookURL == "" {
+		return nil
+	}
+	body, _ := json.Marshal(alert)
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, webhookURL, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("User-Agent", "xpired-alerts/1.0")
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode >= 300 {
+		return fmt.Errorf("alert webhook responded %d", resp.StatusCode)
+	}
+	return nil
+}
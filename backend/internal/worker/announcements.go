@@ -0,0 +1,96 @@
+package worker
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"xpired/internal/db"
+
+	"github.com/google/uuid"
+	"github.com/hibiken/asynq"
+)
+
+// announcementEmailBatch is how many users are loaded at a time while
+// emailing an announcement.
+const announcementEmailBatch = 500
+
+type announcementPayload struct {
+	AnnouncementID string `json:"announcement_id"`
+}
+
+type announcementProcessor struct {
+	repo     db.Repository
+	dryRun   bool
+	throttle *providerThrottle
+}
+
+// handleSendAnnouncement emails an announcement to every user. The email is
+// claimed before sending, so a retried task never emails anyone twice; a
+// failure partway is logged rather than retried.
+func (p *announcementProcessor) handleSendAnnouncement(ctx context.Context, t *asynq.Task) error {
+	var payload announcementPayload
+	if err := json.Unmarshal(t.Payload(), &payload); err != nil {
+		return fmt.Errorf("invalid payload: %v: %w", err, asynq.SkipRetry)
+	}
+
+	announcement, err := p.repo.GetAnnouncement(ctx, payload.AnnouncementID)
+	if err != nil {
+		if err.Error() == "announcement not found" {
+			logf(ctx, "Announcement %s was deleted before it was emailed", payload.AnnouncementID)
+			return nil
+		}
+		return err
+	}
+
+	claimed, err := p.repo.ClaimAnnouncementEmail(ctx, announcement.ID.String())
+	if err != nil {
+		return err
+	}
+	if !claimed {
+		return nil
+	}
+
+	sent := 0
+	after := uuid.Nil
+	for {
+		users, err := p.repo.ListUsersAfter(ctx, after, announcementEmailBatch)
+		if err != nil {
+			logf(ctx, "Stopped emailing announcement %s after %d users: %v", announcement.ID.String(), sent, err)
+			return nil
+		}
+
+		for _, user := range users {
+			if user.SuspendedAt != nil {
+				continue
+			}
+			body := AnnouncementEmailTemplate(user.Name, announcement.Title, announcement.Body)
+			if p.dryRun {
+				logf(ctx, "[dry-run] announcement %s to %s not sent", announcement.ID.String(), user.Email)
+			} else if err := p.sendEmail(ctx, user.Email, announcement.Title, body); err != nil {
+				logf(ctx, "Failed to email announcement %s to user %s: %v", announcement.ID.String(), user.ID.String(), err)
+				continue
+			}
+			sent++
+		}
+
+		if len(users) < announcementEmailBatch {
+			break
+		}
+		after = users[len(users)-1].ID
+	}
+
+	logf(ctx, "Emailed announcement %s to %d users", announcement.ID.String(), sent)
+	return nil
+}
+
+// sendEmail sends one announcement email, paced with the reminders so an
+// announcement to every user does not exhaust the email provider's quota.
+func (p *announcementProcessor) sendEmail(ctx context.Context, to, subject, body string) error {
+	if p.throttle != nil {
+		if err := p.throttle.wait(ctx, ChannelEmail); err != nil {
+			return err
+		}
+	}
+	return SendEmail(ctx, "", to, subject, body)
+}
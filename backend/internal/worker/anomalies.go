@@ -0,0 +1,155 @@
+package worker
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+	"time"
+
+	"xpired/internal/config"
+	"xpired/internal/db"
+
+	"github.com/redis/go-redis/v9"
+)
+
+// anomalyBaselineDays is the period before the last day a user's usual
+// daily notification volume is averaged over.
+const anomalyBaselineDays = 28
+
+// NotificationAnomaly is a user who got unusually many notifications in the
+// last day.
+type NotificationAnomaly struct {
+	UserID string
+	Email  string
+	Name   string
+	// Recent is the notifications of the last day and DailyBaseline the
+	// user's average per day before.
+	Recent        int
+	DailyBaseline float64
+}
+
+type anomalyDetector struct {
+	repo   db.Repository
+	rdb    *redis.Client
+	cfg    config.AlertsConfig
+	admins []string
+	client *http.Client
+	dryRun bool
+}
+
+// DetectAnomaliesJob compares the notifications each user got in the last
+// day with their usual daily volume, as a safety net against scheduling bugs
+// that flood users. Anomalies are logged, posted to the alert webhook and
+// emailed to the admins, and with ALERT_ANOMALY_NOTIFY_USER to the users.
+func DetectAnomaliesJob(repo db.Repository, rdb *redis.Client, cfg *config.Config) Job {
+	d := &anomalyDetector{
+		repo:   repo,
+		rdb:    rdb,
+		cfg:    cfg.Alerts,
+		admins: cfg.Admin.Emails,
+		client: EgressClient(cfg.Egress, alertWebhookTimeout),
+		dryRun: cfg.Notifications.DryRun,
+	}
+	return Job{
+		Name:     JobDetectAnomalies,
+		Interval: 24 * time.Hour,
+		Run:      d.run,
+	}
+}
+
+func (d *anomalyDetector) run(ctx context.Context) error {
+	recentSince := time.Now().Add(-24 * time.Hour)
+	since := recentSince.AddDate(0, 0, -anomalyBaselineDays)
+
+	var anomalies []NotificationAnomaly
+	for _, regionCtx := range regionContexts(ctx, d.repo) {
+		volumes, err := d.repo.CountNotificationsByUser(regionCtx, since, recentSince, d.cfg.AnomalyMinNotifications)
+		if err != nil {
+			return err
+		}
+		for _, volume := range volumes {
+			// a user without history has a baseline of zero, so any volume
+			// above the minimum counts
+			baseline := float64(volume.Baseline) / anomalyBaselineDays
+			if float64(volume.Recent) <= baseline*float64(d.cfg.AnomalyFactor) {
+				continue
+			}
+			anomaly := NotificationAnomaly{UserID: volume.UserID, Recent: volume.Recent, DailyBaseline: baseline}
+			if user, err := d.repo.GetUserByID(ctx, volume.UserID); err == nil {
+				anomaly.Email = user.Email
+				anomaly.Name = user.Name
+			}
+			anomalies = append(anomalies, anomaly)
+		}
+	}
+	if len(anomalies) == 0 {
+		return nil
+	}
+	sort.Slice(anomalies, func(i, j int) bool { return anomalies[i].Recent > anomalies[j].Recent })
+
+	d.alert(ctx, anomalies)
+	if d.cfg.AnomalyNotifyUser {
+		for _, anomaly := range anomalies {
+			if anomaly.Email == "" {
+				continue
+			}
+			if d.dryRun {
+				logf(ctx, "[dry-run] notification volume email to %s not sent", anomaly.Email)
+				continue
+			}
+			body := UserNotificationAnomalyEmailTemplate(anomaly.Name, anomaly.Recent)
+			if err := SendEmail(ctx, "", anomaly.Email, "You got more reminders than usual", body); err != nil {
+				logf(ctx, "Failed to tell user %s about their notification volume: %v", anomaly.UserID, err)
+			}
+		}
+	}
+	return nil
+}
+
+// alert tells the admins about anomalies through the alert webhook and by
+// email. The alert is listed as firing for a day; there is no resolution,
+// since the next run only looks at the next day.
+func (d *anomalyDetector) alert(ctx context.Context, anomalies []NotificationAnomaly) {
+	lines := make([]string, 0, len(anomalies))
+	for _, anomaly := range anomalies {
+		lines = append(lines, fmt.Sprintf("%s: %d (usually %.1f a day)", anomaly.label(), anomaly.Recent, anomaly.DailyBaseline))
+	}
+	text := fmt.Sprintf("%d users got unusually many notifications in the last 24 hours: %s", len(anomalies), strings.Join(lines, ", "))
+	alert := Alert{
+		Name:      AlertNotificationAnomaly,
+		Status:    "firing",
+		Value:     float64(len(anomalies)),
+		Threshold: float64(d.cfg.AnomalyFactor),
+		Text:      text,
+		FiredAt:   time.Now().UTC(),
+	}
+
+	logf(ctx, "Alert %s %s: %s", alert.Name, alert.Status, text)
+	if err := d.rdb.Set(ctx, "xpired:alert:"+alert.Name, alert.FiredAt.Format(time.RFC3339), 24*time.Hour).Err(); err != nil {
+		logf(ctx, "Failed to record alert %s: %v", alert.Name, err)
+	}
+	if err := postAlert(ctx, d.client, d.cfg.WebhookURL, alert); err != nil {
+		logf(ctx, "Failed to post alert %s: %v", alert.Name, err)
+	}
+
+	if d.dryRun {
+		logf(ctx, "[dry-run] notification volume alert to %d admins not sent", len(d.admins))
+		return
+	}
+	body := NotificationAnomalyEmailTemplate(anomalies, d.cfg.AnomalyFactor)
+	for _, admin := range d.admins {
+		if err := SendEmail(ctx, "", admin, "Unusual notification volume", body); err != nil {
+			logf(ctx, "Failed to alert admin %s about notification volume: %v", admin, err)
+		}
+	}
+}
+
+// label names the user of the anomaly by email, or by ID if it is unknown.
+func (a NotificationAnomaly) label() string {
+	if a.Email != "" {
+		return a.Email
+	}
+	return a.UserID
+}
@@ -0,0 +1,156 @@
+package worker
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"xpired/internal/db"
+	"xpired/internal/tenant"
+
+	"github.com/google/uuid"
+	"github.com/hibiken/asynq"
+)
+
+type assignmentEscalationPayload struct {
+	MessageID string `json:"message_id"`
+}
+
+// documentAssignee returns the organization member doc is assigned to, or ""
+// when its reminders follow the owner: it is unassigned, or the assignee has
+// since left the organization.
+func (p *reminderProcessor) documentAssignee(ctx context.Context, doc *db.Document) string {
+	if doc.OrganizationID == nil {
+		return ""
+	}
+	assignment, err := p.repo.GetDocumentAssignment(ctx, doc.ID.String())
+	if err != nil {
+		if err.Error() != "document assignment not found" {
+			logf(ctx, "Failed to load assignment of document %s: %v", doc.ID.String(), err)
+		}
+		return ""
+	}
+	if _, err := p.repo.GetOrganizationMember(ctx, *doc.OrganizationID, assignment.AssigneeID); err != nil {
+		logf(ctx, "Assignee %s of document %s is no longer an organization member", assignment.AssigneeID, doc.ID.String())
+		return ""
+	}
+	return assignment.AssigneeID
+}
+
+// notifyAssignee sends the reminder for docs to the member they are assigned
+// to, and queues an escalation to the organization's admins in case the
+// assignee does not acknowledge it within their escalation grace period.
+func (p *reminderProcessor) notifyAssignee(ctx context.Context, assigneeID string, docs []*db.Document, ownerID string, intervalID int) {
+	var messageID uuid.UUID
+	if len(docs) == 1 {
+		messageID = p.notifyUser(ctx, assigneeID, docs[0], ownerID, intervalID)
+	} else {
+		messageID = p.notifyUserBatch(ctx, assigneeID, docs, ownerID, intervalID)
+	}
+	if messageID == uuid.Nil {
+		return
+	}
+
+	prefs := p.notificationPreferences(ctx, assigneeID)
+	runAt := time.Now().AddDate(0, 0, prefs.EscalationAfterDays)
+	if err := ScheduleAssignmentEscalation(ctx, messageID.String(), runAt); err != nil {
+		logf(ctx, "Failed to schedule assignment escalation for message %s: %v", messageID.String(), err)
+	}
+}
+
+// handleEscalateAssignment emails the organization's owners and admins about
+// assigned documents whose reminder the assignee neither opened nor
+// acknowledged. Documents renewed or reassigned in the meantime are left out.
+func (p *reminderProcessor) handleEscalateAssignment(ctx context.Context, t *asynq.Task) error {
+	var payload assignmentEscalationPayload
+	if err := json.Unmarshal(t.Payload(), &payload); err != nil {
+		return err
+	}
+
+	organizationID := tenant.OrganizationID(ctx)
+	if organizationID == "" {
+		return nil
+	}
+
+	logs, err := p.repo.ListNotificationLogsByMessageID(ctx, payload.MessageID)
+	if err != nil {
+		return err
+	}
+	if len(logs) == 0 || logs[0].RecipientID == nil {
+		return nil
+	}
+	first := logs[0]
+	if first.OpenedAt != nil {
+		return nil
+	}
+	assigneeID := *first.RecipientID
+
+	var docs []*db.Document
+	for _, entry := range logs {
+		doc, err := p.repo.GetDocumentByID(ctx, entry.DocumentID)
+		if err != nil {
+			continue
+		}
+		if !p.reminderStillDue(ctx, doc, entry.ReminderIntervalID) {
+			continue
+		}
+		assignment, err := p.repo.GetDocumentAssignment(ctx, doc.ID.String())
+		if err != nil || assignment.AssigneeID != assigneeID {
+			continue
+		}
+		if assignment.AcknowledgedAt != nil && assignment.AcknowledgedAt.After(first.CreatedAt) {
+			continue
+		}
+		docs = append(docs, doc)
+	}
+	if len(docs) == 0 {
+		return nil
+	}
+
+	assignee, err := p.repo.GetUserByID(ctx, assigneeID)
+	if err != nil {
+		return err
+	}
+	members, err := p.repo.ListOrganizationMembers(ctx, organizationID)
+	if err != nil {
+		return err
+	}
+
+	var items []DigestItem
+	var documentIDs []string
+	for _, doc := range docs {
+		items = append(items, DigestItem{
+			DocumentName:   doc.Name,
+			ExpirationDate: doc.ExpirationDate.Format("January 2, 2006"),
+			ViewURL:        p.cfg.App.FrontendURL + "/documents/" + doc.ID.String(),
+		})
+		documentIDs = append(documentIDs, doc.ID.String())
+	}
+
+	theme := loadTheme(ctx, p.repo)
+	for _, member := range members {
+		if member.Role != db.OrgRoleOwner && member.Role != db.OrgRoleAdmin {
+			continue
+		}
+		if member.UserID == assigneeID {
+			continue
+		}
+		err := p.dispatcher.Send(ctx, Notification{
+			RecipientID:      member.UserID,
+			UserID:           first.UserID,
+			DocumentID:       documentIDs[0],
+			IntervalID:       first.ReminderIntervalID,
+			Channel:          ChannelEmail,
+			To:               member.Email,
+			Subject:          "Unacknowledged document reminder",
+			Body:             AssignmentEscalationEmailTemplate(member.Name, assignee.Name, items, theme),
+			BatchDocumentIDs: documentIDs,
+		})
+		if err != nil {
+			logf(ctx, "Failed to send assignment escalation to %s: %v", member.Email, err)
+		}
+	}
+
+	logf(ctx, "Escalated message %s to the admins of organization %s: unacknowledged by %s", payload.MessageID, organizationID, assigneeID)
+	return nil
+}
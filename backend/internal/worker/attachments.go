@@ -0,0 +1,144 @@
+package worker
+
+import (
+	"context"
+	"encoding/json"
+
+	"xpired/internal/config"
+	"xpired/internal/db"
+	"xpired/internal/scanner"
+	"xpired/internal/storage"
+
+	"github.com/hibiken/asynq"
+)
+
+const scanAttachmentMaxRetry = 5
+
+type scanAttachmentPayload struct {
+	DocumentID    string `json:"document_id"`
+	AttachmentURL string `json:"attachment_url"`
+}
+
+type attachmentProcessor struct {
+	repo       db.Repository
+	cfg        *config.Config
+	store      storage.Storage
+	scanner    scanner.Scanner
+	dispatcher *Dispatcher
+}
+
+// handleScanAttachment scans an uploaded attachment. Clean files are marked
+// clean; infected ones are moved into quarantine, detached from the document
+// and any other document sharing the file, and reported to the owner. Scanner errors are retried, and the attachment
+// is marked "error" once retries run out.
+func (p *attachmentProcessor) handleScanAttachment(ctx context.Context, t *asynq.Task) error {
+	var payload scanAttachmentPayload
+	if err := json.Unmarshal(t.Payload(), &payload); err != nil {
+		return err
+	}
+
+	doc, err := p.repo.GetDocumentByID(ctx, payload.DocumentID)
+	if err != nil {
+		return nil
+	}
+	if doc.AttachmentURL == nil || *doc.AttachmentURL != payload.AttachmentURL {
+		// Replaced or removed since the upload; the new file has its own task.
+		return nil
+	}
+
+	result, err := p.scan(ctx, payload.AttachmentURL)
+	if err != nil {
+		retried, _ := asynq.GetRetryCount(ctx)
+		maxRetry, _ := asynq.GetMaxRetry(ctx)
+		if retried >= maxRetry {
+			logf(ctx, "Giving up scanning attachment of doc %s: %v", payload.DocumentID, err)
+			p.setStatus(ctx, payload, db.AttachmentError)
+			return nil
+		}
+		return err
+	}
+
+	if !result.Infected {
+		p.setStatus(ctx, payload, db.AttachmentClean)
+		return nil
+	}
+
+	logf(ctx, "Attachment of doc %s is infected (%s); quarantining", payload.DocumentID, result.Threat)
+	if _, err := p.store.Quarantine(ctx, payload.AttachmentURL); err != nil {
+		return err
+	}
+	if err := p.repo.QuarantineAttachment(ctx, payload.DocumentID, payload.AttachmentURL, result.Threat); err != nil {
+		logf(ctx, "Failed to mark attachment of doc %s quarantined: %v", payload.DocumentID, err)
+	}
+	p.notifyQuarantined(ctx, doc, result.Threat)
+	return nil
+}
+
+func (p *attachmentProcessor) scan(ctx context.Context, url string) (*scanner.Result, error) {
+	file, err := p.store.Open(ctx, url)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+	return p.scanner.Scan(ctx, file)
+}
+
+func (p *attachmentProcessor) setStatus(ctx context.Context, payload scanAttachmentPayload, status string) {
+	if err := p.repo.SetAttachmentStatus(ctx, payload.DocumentID, payload.AttachmentURL, status); err != nil {
+		logf(ctx, "Failed to set attachment status of doc %s to %s: %v", payload.DocumentID, status, err)
+	}
+}
+
+// notifyQuarantined tells the document owner on their preferred channels.
+func (p *attachmentProcessor) notifyQuarantined(ctx context.Context, doc *db.Document, threat string) {
+	ownerID := doc.UserID.String()
+	prefs, err := p.repo.GetNotificationPreferences(ctx, ownerID)
+	if err != nil {
+		prefs = db.DefaultNotificationPreferences(ownerID)
+	}
+	channels := defaultChannels(prefs)
+	viewURL := p.cfg.App.FrontendURL + "/documents/" + doc.ID.String()
+
+	if channels[ChannelEmail] {
+		userEmail, err := p.repo.GetUserEmail(ctx, ownerID)
+		if err == nil {
+			err = p.dispatcher.Send(ctx, Notification{
+				RecipientID: ownerID,
+				UserID:      ownerID,
+				DocumentID:  doc.ID.String(),
+				Channel:     ChannelEmail,
+				To:          userEmail,
+				Subject:     "Attachment Quarantined",
+				Body:        AttachmentQuarantinedEmailTemplate(userEmail, doc.Name, threat, viewURL, loadTheme(ctx, p.repo)),
+			})
+		}
+		if err != nil {
+			logf(ctx, "Failed to email quarantine notice for doc %s: %v", doc.ID.String(), err)
+		}
+	}
+
+	if channels[ChannelSMS] {
+		userPhone, _ := p.repo.GetUserPhoneNumber(ctx, ownerID)
+		if userPhone != "" {
+			_ = p.dispatcher.Send(ctx, Notification{
+				RecipientID: ownerID,
+				UserID:      ownerID,
+				DocumentID:  doc.ID.String(),
+				Channel:     ChannelSMS,
+				To:          userPhone,
+				Body:        AttachmentQuarantinedSMSMessage(doc.Name),
+			})
+		}
+	}
+	if channels[ChannelPush] {
+		_ = p.dispatcher.Send(ctx, Notification{
+			RecipientID: ownerID,
+			UserID:      ownerID,
+			DocumentID:  doc.ID.String(),
+			Channel:     ChannelPush,
+			To:          ownerID,
+			Subject:     "Attachment Quarantined",
+			Body:        AttachmentQuarantinedSMSMessage(doc.Name),
+		})
+	}
+}
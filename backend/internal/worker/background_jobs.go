@@ -0,0 +1,187 @@
+package worker
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"xpired/internal/db"
+	"xpired/internal/importer"
+	"xpired/internal/storage"
+
+	"github.com/hibiken/asynq"
+)
+
+// takeoutNotificationLimit caps the notification history included in a
+// takeout.
+const takeoutNotificationLimit = 1000
+
+type runJobPayload struct {
+	JobID string `json:"job_id"`
+}
+
+// errJobContinues is returned by a jobRunner that handed the rest of the job
+// to other tasks, which record its outcome.
+var errJobContinues = errors.New("job continues in other tasks")
+
+// ImportJobParams is the input of an import job.
+type ImportJobParams struct {
+	Source    string   `json:"source"`
+	Timezone  string   `json:"timezone"`
+	Reminders []string `json:"reminders,omitempty"`
+	// Data is the uploaded export. Once it is parsed into chunks it is
+	// dropped, and Errors and UnmappedColumns keep what parsing found.
+	Data            string              `json:"data,omitempty"`
+	Errors          []importer.RowError `json:"errors,omitempty"`
+	UnmappedColumns []string            `json:"unmappedColumns,omitempty"`
+}
+
+// jobRunner does the work of one kind of job. It returns the summary stored
+// as the job's result, and the URL of the file it produced, if any. An error
+// fails the job with its message, which the user sees.
+type jobRunner func(ctx context.Context, job *db.Job) (interface{}, *string, error)
+
+type jobProcessor struct {
+	repo  db.Repository
+	store storage.Storage
+	// backlog is the number of waiting tasks above which import chunks are
+	// held back.
+	backlog int
+}
+
+func (p *jobProcessor) runners() map[string]jobRunner {
+	return map[string]jobRunner{
+		db.JobKindImport:  p.runImport,
+		db.JobKindTakeout: p.runTakeout,
+	}
+}
+
+// handleRunJob runs a job the API queued and records its outcome for the
+// client polling it. A job runs at most once: a failure is recorded rather
+// than retried, and the user starts a new job instead. Only an import is
+// picked up again when its task is redelivered after a crash, as it
+// continues from the chunks it stored.
+func (p *jobProcessor) handleRunJob(ctx context.Context, t *asynq.Task) error {
+	var payload runJobPayload
+	if err := json.Unmarshal(t.Payload(), &payload); err != nil {
+		return fmt.Errorf("invalid payload: %v: %w", err, asynq.SkipRetry)
+	}
+
+	job, err := p.repo.GetJob(ctx, payload.JobID)
+	if err != nil {
+		if err.Error() == "job not found" {
+			return nil
+		}
+		return err
+	}
+	run, ok := p.runners()[job.Kind]
+	if !ok {
+		return fmt.Errorf("unknown job kind %q: %w", job.Kind, asynq.SkipRetry)
+	}
+
+	started, err := p.repo.StartJob(ctx, job.ID.String())
+	if err != nil {
+		return err
+	}
+	if !started && !(job.Status == db.JobRunning && job.Kind == db.JobKindImport) {
+		return nil
+	}
+
+	result, resultURL, runErr := run(ctx, job)
+	if errors.Is(runErr, errJobContinues) {
+		return nil
+	}
+	job.Status = db.JobSucceeded
+	job.ResultURL = resultURL
+	if runErr != nil {
+		logf(ctx, "Job %s (%s) failed: %v", job.ID.String(), job.Kind, runErr)
+		msg := runErr.Error()
+		job.Status = db.JobFailed
+		job.Error = &msg
+		job.ResultURL = nil
+	} else if result != nil {
+		job.Result, _ = json.Marshal(result)
+	}
+
+	// record the outcome even when the task ran out of time
+	if err := p.repo.FinishJob(context.WithoutCancel(ctx), job); err != nil {
+		logf(ctx, "Failed to record outcome of job %s: %v", job.ID.String(), err)
+	}
+	return nil
+}
+
+// runImport creates documents from an uploaded export, like the synchronous
+// import endpoint, for exports too large to import within a request. It
+// splits the export into chunks, which handleImportChunk imports one task at
+// a time while the job reports its progress. Run again after a crash, it
+// continues with the first chunk not done.
+func (p *jobProcessor) runImport(ctx context.Context, job *db.Job) (interface{}, *string, error) {
+	if job.Progress == nil {
+		var params ImportJobParams
+		if err := json.Unmarshal(job.Params, &params); err != nil {
+			return nil, nil, fmt.Errorf("invalid import parameters")
+		}
+		if err := p.chunkImport(ctx, job, params); err != nil {
+			return nil, nil, err
+		}
+	}
+
+	if err := p.continueImport(ctx, job); err != nil {
+		logf(ctx, "Failed to continue import job %s: %v", job.ID.String(), err)
+		return nil, nil, fmt.Errorf("failed to queue import")
+	}
+	return nil, nil, errJobContinues
+}
+
+// runTakeout gathers the user's account data into a JSON file they can
+// download: their profile, personal documents, notification preferences and
+// recent notifications.
+func (p *jobProcessor) runTakeout(ctx context.Context, job *db.Job) (interface{}, *string, error) {
+	user, err := p.repo.GetUserByID(ctx, job.UserID)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to load account")
+	}
+	documents, err := p.repo.ListDocumentsByUserID(ctx, job.UserID)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to load documents")
+	}
+	if documents == nil {
+		documents = []*db.Document{}
+	}
+	notifications, err := p.repo.ListNotificationLogs(ctx, job.UserID, "", takeoutNotificationLimit)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to load notifications")
+	}
+	if notifications == nil {
+		notifications = []*db.NotificationLog{}
+	}
+
+	takeout := map[string]interface{}{
+		"exportedAt":    time.Now().UTC(),
+		"user":          user,
+		"documents":     documents,
+		"notifications": notifications,
+	}
+	if prefs, err := p.repo.GetNotificationPreferences(ctx, job.UserID); err == nil {
+		takeout["notificationPreferences"] = prefs
+	}
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(takeout); err != nil {
+		return nil, nil, fmt.Errorf("failed to encode takeout")
+	}
+	key := "takeout/" + job.ID.String() + "/xpired-takeout.json"
+	url, err := p.store.Put(ctx, key, &buf, "application/json")
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to store takeout")
+	}
+
+	result := map[string]interface{}{
+		"documents":     len(documents),
+		"notifications": len(notifications),
+	}
+	return result, &url, nil
+}
@@ -0,0 +1,114 @@
+package worker
+
+import (
+	"context"
+	"encoding/json"
+	"sort"
+	"time"
+
+	"xpired/internal/db"
+
+	"github.com/hibiken/asynq"
+)
+
+type flushHeldRemindersPayload struct {
+	UserID string `json:"user_id"`
+	// CatchUp sends the held reminders as one catch-up message, for the end
+	// of a notification pause.
+	CatchUp bool `json:"catch_up,omitempty"`
+}
+
+// holdReminders parks the reminders when userID has a batching window set,
+// scheduling the flush if this opens the window. It reports whether the
+// reminders were held; if not, the caller sends them right away.
+func (p *reminderProcessor) holdReminders(ctx context.Context, userID string, documentIDs []string, intervalID int) bool {
+	prefs := p.notificationPreferences(ctx, userID)
+	if prefs.BatchWindowHours <= 0 {
+		return false
+	}
+
+	opened := false
+	for _, documentID := range documentIDs {
+		first, err := p.repo.HoldReminder(ctx, userID, documentID, intervalID, documentRequestID(ctx, documentID))
+		if err != nil {
+			logf(ctx, "Failed to hold reminder for doc %s, sending now: %v", documentID, err)
+			return false
+		}
+		opened = opened || first
+	}
+
+	if opened {
+		runAt := time.Now().Add(time.Duration(prefs.BatchWindowHours) * time.Hour)
+		if err := ScheduleHeldReminderFlush(ctx, userID, runAt); err != nil {
+			logf(ctx, "Failed to schedule held reminder flush for user %s: %v", userID, err)
+		}
+	}
+
+	transitionReminders(ctx, p.repo, documentIDs, intervalID, db.ReminderQueued)
+	logf(ctx, "Holding %d reminder(s) for user %s (interval=%d)", len(documentIDs), userID, intervalID)
+	return true
+}
+
+// handleFlushHeldReminders sends everything held for a user once their
+// batching window closes. Reminders are grouped per interval, so documents
+// that hit the same threshold arrive as one message. While the user paused
+// notifications, the flush waits for the pause to end and sends a catch-up
+// message instead.
+func (p *reminderProcessor) handleFlushHeldReminders(ctx context.Context, t *asynq.Task) error {
+	var payload flushHeldRemindersPayload
+	if err := json.Unmarshal(t.Payload(), &payload); err != nil {
+		return err
+	}
+
+	// the pause may have started or been extended since the flush was
+	// scheduled
+	if until := p.pausedUntil(ctx, payload.UserID); !until.IsZero() {
+		return ScheduleReminderCatchUp(ctx, payload.UserID, until)
+	}
+
+	held, err := p.repo.TakeHeldReminders(ctx, payload.UserID)
+	if err != nil {
+		return err
+	}
+	if payload.CatchUp {
+		p.sendCatchUp(ctx, payload.UserID, held)
+		return nil
+	}
+
+	byInterval := make(map[int][]string)
+	requestIDs := heldRequestIDs(held)
+	for _, reminder := range held {
+		byInterval[reminder.ReminderIntervalID] = append(byInterval[reminder.ReminderIntervalID], reminder.DocumentID)
+	}
+
+	intervalIDs := make([]int, 0, len(byInterval))
+	for intervalID := range byInterval {
+		intervalIDs = append(intervalIDs, intervalID)
+	}
+	sort.Ints(intervalIDs)
+
+	for _, intervalID := range intervalIDs {
+		batch := reminderBatchPayload{
+			Version:     reminderBatchPayloadVersion,
+			UserID:      payload.UserID,
+			IntervalID:  intervalID,
+			DocumentIDs: byInterval[intervalID],
+		}
+		if err := p.sendReminderBatch(withDocumentRequestIDs(ctx, requestIDs), batch); err != nil {
+			logf(ctx, "Failed to send held reminders for user %s (interval %d): %v", payload.UserID, intervalID, err)
+		}
+	}
+	return nil
+}
+
+// heldRequestIDs maps the documents of held reminders to the requests that
+// scheduled them, for the message they are flushed in.
+func heldRequestIDs(held []*db.HeldReminder) map[string]string {
+	ids := map[string]string{}
+	for _, reminder := range held {
+		if reminder.RequestID != nil {
+			ids[reminder.DocumentID] = *reminder.RequestID
+		}
+	}
+	return ids
+}
@@ -0,0 +1,237 @@
+package worker
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"xpired/internal/db"
+
+	"github.com/hibiken/asynq"
+	"github.com/redis/go-redis/v9"
+)
+
+const (
+	// breakerThreshold is how many sends to a provider must fail in a row,
+	// across all workers, before its channel is paused.
+	breakerThreshold = 10
+	// breakerCooldown is how long a paused channel waits before one probe
+	// send tests whether the provider recovered.
+	breakerCooldown = 5 * time.Minute
+	// breakerProbeWait is how long the other sends wait for the outcome of a
+	// probe, and how soon a probe that never reported back is retried.
+	breakerProbeWait = time.Minute
+	// breakerFailureWindow forgets failures that stopped occurring, so a few
+	// failures spread over days never open the circuit.
+	breakerFailureWindow = time.Hour
+)
+
+// ErrChannelPaused is returned by Dispatcher.Send when the channel's provider
+// is failing consistently, or sends to it cannot be paced. The message was
+// queued to be sent once the provider is tried again, not dropped.
+var ErrChannelPaused = errors.New("notification channel paused")
+
+// ProviderCircuit is the circuit breaker state of one notification provider.
+type ProviderCircuit struct {
+	Channel string
+	// Open is true while the channel is paused; sends are deferred to
+	// RetryAt, when one probe send tests the provider again.
+	Open                bool
+	RetryAt             *time.Time
+	ConsecutiveFailures int64
+}
+
+func breakerKey(channel, part string) string {
+	return "xpired:breaker:" + channel + ":" + part
+}
+
+// ProviderCircuits reports the circuit of every notification channel.
+func ProviderCircuits(ctx context.Context, rdb *redis.Client) ([]ProviderCircuit, error) {
+	circuits := []ProviderCircuit{}
+	for _, channel := range []string{ChannelEmail, ChannelSMS, ChannelPush} {
+		circuit := ProviderCircuit{Channel: channel}
+
+		failures, err := rdb.Get(ctx, breakerKey(channel, "failures")).Int64()
+		if err != nil && err != redis.Nil {
+			return nil, fmt.Errorf("failed to read %s circuit: %w", channel, err)
+		}
+		circuit.ConsecutiveFailures = failures
+
+		raw, err := rdb.Get(ctx, breakerKey(channel, "open")).Result()
+		if err != nil && err != redis.Nil {
+			return nil, fmt.Errorf("failed to read %s circuit: %w", channel, err)
+		}
+		if retryAt, err := time.Parse(time.RFC3339, raw); err == nil {
+			circuit.Open = true
+			circuit.RetryAt = &retryAt
+		}
+
+		circuits = append(circuits, circuit)
+	}
+	return circuits, nil
+}
+
+// providerBreaker pauses a notification channel whose provider keeps
+// failing, so reminders wait for it to recover instead of each one failing
+// against it. Its state lives in Redis and is shared by all workers; when
+// Redis is unavailable every send is let through.
+type providerBreaker struct {
+	rdb *redis.Client
+	// admins are emailed when a channel is paused and when it recovers.
+	admins []string
+}
+
+// allow reports whether a message may be handed to the provider of channel
+// now. Once a paused channel's cooldown is over, a single send is let
+// through as a probe; otherwise allow returns when to try again.
+func (b *providerBreaker) allow(ctx context.Context, channel string) (time.Time, bool) {
+	raw, err := b.rdb.Get(ctx, breakerKey(channel, "open")).Result()
+	if err != nil {
+		if err != redis.Nil {
+			logf(ctx, "Failed to check %s circuit: %v", channel, err)
+		}
+		return time.Time{}, true
+	}
+	retryAt, err := time.Parse(time.RFC3339, raw)
+	if err != nil {
+		return time.Time{}, true
+	}
+	if time.Now().Before(retryAt) {
+		return retryAt, false
+	}
+
+	probe, err := b.rdb.SetNX(ctx, breakerKey(channel, "probe"), "1", breakerProbeWait).Result()
+	if err != nil || probe {
+		return time.Time{}, true
+	}
+	return time.Now().Add(breakerProbeWait), false
+}
+
+// record counts the outcome of a send. A success closes the circuit; the
+// breakerThreshold-th failure in a row opens it, and a failed probe keeps it
+// open for another cooldown.
+func (b *providerBreaker) record(ctx context.Context, channel string, sendErr error) {
+	if sendErr == nil {
+		pipe := b.rdb.Pipeline()
+		pipe.Del(ctx, breakerKey(channel, "failures"), breakerKey(channel, "probe"))
+		closed := pipe.Del(ctx, breakerKey(channel, "open"))
+		if _, err := pipe.Exec(ctx); err != nil {
+			logf(ctx, "Failed to reset %s circuit: %v", channel, err)
+			return
+		}
+		if closed.Val() > 0 {
+			logf(ctx, "Notification provider for %s recovered, resuming sends", channel)
+			b.alert(ctx, channel, false, time.Time{}, nil)
+		}
+		return
+	}
+
+	pipe := b.rdb.Pipeline()
+	failures := pipe.Incr(ctx, breakerKey(channel, "failures"))
+	pipe.Expire(ctx, breakerKey(channel, "failures"), breakerFailureWindow)
+	if _, err := pipe.Exec(ctx); err != nil {
+		logf(ctx, "Failed to count %s failure: %v", channel, err)
+		return
+	}
+	if failures.Val() < breakerThreshold {
+		return
+	}
+
+	retryAt := time.Now().Add(breakerCooldown).UTC()
+	pipe = b.rdb.Pipeline()
+	previous := pipe.SetArgs(ctx, breakerKey(channel, "open"), retryAt.Format(time.RFC3339), redis.SetArgs{Get: true})
+	pipe.Del(ctx, breakerKey(channel, "probe"))
+	if _, err := pipe.Exec(ctx); err != nil && err != redis.Nil {
+		logf(ctx, "Failed to open %s circuit: %v", channel, err)
+		return
+	}
+	if previous.Err() == redis.Nil {
+		logf(ctx, "Notification provider for %s failed %d times in a row, pausing sends until %s: %v", channel, failures.Val(), retryAt.Format(time.RFC3339), sendErr)
+		b.alert(ctx, channel, true, retryAt, sendErr)
+	}
+}
+
+// alert emails the admins that channel was paused or resumed. It goes
+// straight to the provider: it is not about a document, and a paused email
+// channel must not hold back the alert about itself.
+func (b *providerBreaker) alert(ctx context.Context, channel string, open bool, retryAt time.Time, cause error) {
+	subject := "Notification provider for " + channel + " recovered"
+	if open {
+		subject = "Notification provider for " + channel + " is failing"
+	}
+	body := ProviderCircuitEmailTemplate(channel, open, retryAt, cause)
+	for _, admin := range b.admins {
+		if err := SendEmail(ctx, "", admin, subject, body); err != nil {
+			logf(ctx, "Failed to alert admin %s about the %s provider: %v", admin, channel, err)
+		}
+	}
+}
+
+type deferredNotificationPayload struct {
+	Notification Notification `json:"notification"`
+}
+
+// handleSendDeferredNotification sends a message that was held back while
+// its channel was paused, unless it is a reminder the user has meanwhile
+// acknowledged or snoozed on another channel. If the channel is still
+// paused, Send queues it again; a failure is recorded like any other and not
+// retried.
+func (d *Dispatcher) handleSendDeferredNotification(ctx context.Context, t *asynq.Task) error {
+	var payload deferredNotificationPayload
+	if err := json.Unmarshal(t.Payload(), &payload); err != nil {
+		return fmt.Errorf("invalid payload: %v: %w", err, asynq.SkipRetry)
+	}
+
+	n := payload.Notification
+	if d.followedUpElsewhere(ctx, n) {
+		logf(ctx, "Dropping deferred %s notification %s: reminder followed up on another channel", n.Channel, n.MessageID.String())
+		return nil
+	}
+
+	err := d.Send(ctx, n)
+	if errors.Is(err, ErrChannelPaused) {
+		return nil
+	}
+	if err != nil {
+		logf(ctx, "Failed to send deferred %s notification %s: %v", n.Channel, n.MessageID.String(), err)
+	}
+	d.settleDeferredReminders(ctx, n, err == nil)
+	return nil
+}
+
+// followedUpElsewhere reports whether n is a reminder whose every document
+// the user followed up on since it was sent. A batch with a document not
+// followed up on goes out whole.
+func (d *Dispatcher) followedUpElsewhere(ctx context.Context, n Notification) bool {
+	if n.IntervalID == 0 || n.DocumentID == "" {
+		return false
+	}
+	for _, documentID := range n.documentIDs() {
+		if !reminderFollowedUp(ctx, d.repo, documentID, n.IntervalID) {
+			return false
+		}
+	}
+	return true
+}
+
+// settleDeferredReminders moves the reminders n covers out of the status
+// they were left in while n was held back: to sent once it went out, or to
+// failed if it failed too. Reminders another message went out for are left
+// as they are.
+func (d *Dispatcher) settleDeferredReminders(ctx context.Context, n Notification, delivered bool) {
+	if n.IntervalID == 0 || n.DocumentID == "" {
+		return
+	}
+	status := db.ReminderFailed
+	if delivered {
+		status = db.ReminderSent
+	}
+	for _, documentID := range n.documentIDs() {
+		current := currentReminderStatus(ctx, d.repo, documentID, n.IntervalID)
+		if current == db.ReminderQueued || (delivered && current == db.ReminderFailed) {
+			transitionReminders(ctx, d.repo, []string{documentID}, n.IntervalID, status)
+		}
+	}
+}
@@ -0,0 +1,103 @@
+package worker
+
+import (
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+
+	"xpired/internal/db"
+)
+
+// calendarAlarm is when the alarm of an invite goes off, relative to the
+// start of the all-day event: 9:00 the day before the document expires.
+const calendarAlarm = "-PT15H"
+
+// CalendarInvite renders an iCalendar invite (RFC 5546 METHOD:REQUEST) for
+// an all-day event on doc's expiration date, with an alarm the day before.
+// Mail clients add such an invite to the attendee's calendar, which makes it
+// a backup to the email itself being missed. The event's UID is the document
+// and its expiration date, so every reminder before the same expiration
+// updates one event, and a renewal creates a new one.
+func CalendarInvite(doc *db.Document, organizer, attendee, viewURL string, now time.Time) string {
+	day := doc.ExpirationDate.Format("20060102")
+	summary := doc.Name + " expires"
+
+	lines := []string{
+		"BEGIN:VCALENDAR",
+		"PRODID:-//xpired//Reminders//EN",
+		"VERSION:2.0",
+		"CALSCALE:GREGORIAN",
+		"METHOD:REQUEST",
+		"BEGIN:VEVENT",
+		"UID:" + doc.ID.String() + "-" + day + "@xpired",
+		"DTSTAMP:" + now.UTC().Format("20060102T150405Z"),
+		"SEQUENCE:0",
+		"DTSTART;VALUE=DATE:" + day,
+		"DTEND;VALUE=DATE:" + doc.ExpirationDate.AddDate(0, 0, 1).Format("20060102"),
+		"SUMMARY:" + escapeICalText(summary),
+		"DESCRIPTION:" + escapeICalText(fmt.Sprintf("%s expires on %s. Renew it in time: %s",
+			doc.Name, doc.ExpirationDate.Format("January 2, 2006"), viewURL)),
+		"URL:" + viewURL,
+		"ORGANIZER;CN=xpired:mailto:" + organizer,
+		"ATTENDEE;ROLE=REQ-PARTICIPANT;PARTSTAT=ACCEPTED;RSVP=FALSE:mailto:" + attendee,
+		"STATUS:CONFIRMED",
+		// an all-day reminder should not show the attendee as busy
+		"TRANSP:TRANSPARENT",
+		"BEGIN:VALARM",
+		"ACTION:DISPLAY",
+		"DESCRIPTION:" + escapeICalText(summary+" tomorrow"),
+		"TRIGGER;RELATED=START:" + calendarAlarm,
+		"END:VALARM",
+		"END:VEVENT",
+		"END:VCALENDAR",
+	}
+
+	var b strings.Builder
+	for _, line := range lines {
+		b.WriteString(foldICalLine(line))
+		b.WriteString("\r\n")
+	}
+	return b.String()
+}
+
+// calendarOrganizer is the address invites are sent on behalf of: a
+// no-reply mailbox at the host of baseURL.
+func calendarOrganizer(baseURL string) string {
+	host := "localhost"
+	if u, err := url.Parse(baseURL); err == nil && u.Hostname() != "" {
+		host = u.Hostname()
+	}
+	return "noreply@" + host
+}
+
+// escapeICalText escapes a TEXT value (RFC 5545 section 3.3.11).
+func escapeICalText(s string) string {
+	return strings.NewReplacer(`\`, `\\`, ";", `\;`, ",", `\,`, "\r\n", `\n`, "\n", `\n`).Replace(s)
+}
+
+// foldICalLine splits a content line into lines of at most 75 octets, each
+// continuation starting with a space, without splitting a UTF-8 sequence.
+func foldICalLine(line string) string {
+	const limit = 75
+	if len(line) <= limit {
+		return line
+	}
+
+	var b strings.Builder
+	width := limit
+	for len(line) > width {
+		cut := width
+		// back up to the start of a rune
+		for cut > 0 && line[cut]&0xC0 == 0x80 {
+			cut--
+		}
+		b.WriteString(line[:cut])
+		b.WriteString("\r\n ")
+		line = line[cut:]
+		// the leading space counts against the limit
+		width = limit - 1
+	}
+	b.WriteString(line)
+	return b.String()
+}
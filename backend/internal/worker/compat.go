@@ -0,0 +1,103 @@
+package worker
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"sort"
+
+	"github.com/hibiken/asynq"
+)
+
+// taskAlias routes tasks a previous release queued under an older type name
+// to the handler of the type that replaced it.
+type taskAlias struct {
+	// Type is the current task type.
+	Type string
+	// Upgrade, if set, converts the payload of the old type to the shape
+	// the current type expects. Changes within one type are handled by
+	// versioning its payload instead (see payloads.go).
+	Upgrade func(payload []byte) ([]byte, error)
+}
+
+// taskAliases maps retired task type names to their replacements. Queued
+// tasks outlive deploys: during a rolling deploy the old API keeps queueing
+// the old type, and reminders are scheduled months ahead. When renaming a
+// task type, add its old name here and keep it until no task of that type
+// can still be queued.
+var taskAliases = map[string]taskAlias{}
+
+// registerTaskAliases registers on mux a handler for every alias of a type
+// in handlers, which runs the handler of the current type.
+func registerTaskAliases(mux *asynq.ServeMux, handlers map[string]asynq.HandlerFunc, aliases map[string]taskAlias) {
+	for old, alias := range aliases {
+		handler, ok := handlers[alias.Type]
+		if !ok {
+			log.Printf("Not handling task type %s: its replacement %s has no handler", old, alias.Type)
+			continue
+		}
+		mux.HandleFunc(old, func(ctx context.Context, t *asynq.Task) error {
+			payload := t.Payload()
+			if alias.Upgrade != nil {
+				var err error
+				if payload, err = alias.Upgrade(payload); err != nil {
+					return fmt.Errorf("failed to upgrade %s payload to %s: %w: %w", old, alias.Type, err, asynq.SkipRetry)
+				}
+			}
+			return handler(ctx, asynq.NewTask(alias.Type, payload))
+		})
+	}
+}
+
+// handlesTaskType reports whether mux has a handler registered for exactly
+// taskType. asynq would also route it to the handler of a type it starts
+// with, which is never the one meant.
+func handlesTaskType(mux *asynq.ServeMux, taskType string) bool {
+	_, pattern := mux.Handler(asynq.NewTask(taskType, nil))
+	return pattern == taskType
+}
+
+// CheckQueuedTaskTypes warns about tasks waiting in the queues that mux has
+// no handler for, as when a deploy is rolled back past the release that
+// introduced their type or a rename lacks its alias. Such tasks fail each
+// time they run until they are archived, so the warning is the chance to
+// fix the deploy before they are lost. It runs once when the worker starts.
+func CheckQueuedTaskTypes(mux *asynq.ServeMux) {
+	queues, err := inspector.Queues()
+	if err != nil {
+		log.Printf("Failed to check queued task types: %v", err)
+		return
+	}
+
+	unknown := map[string]int{}
+	for _, queue := range queues {
+		for _, list := range []func(string, ...asynq.ListOption) ([]*asynq.TaskInfo, error){
+			inspector.ListScheduledTasks, inspector.ListPendingTasks, inspector.ListRetryTasks,
+		} {
+			for page := 1; ; page++ {
+				tasks, err := list(queue, asynq.PageSize(cancelTasksPageSize), asynq.Page(page))
+				if err != nil {
+					log.Printf("Failed to check queued task types of %s: %v", queue, err)
+					break
+				}
+				for _, info := range tasks {
+					if !handlesTaskType(mux, info.Type) {
+						unknown[info.Type]++
+					}
+				}
+				if len(tasks) < cancelTasksPageSize {
+					break
+				}
+			}
+		}
+	}
+
+	types := make([]string, 0, len(unknown))
+	for taskType := range unknown {
+		types = append(types, taskType)
+	}
+	sort.Strings(types)
+	for _, taskType := range types {
+		log.Printf("Warning: %d queued %s tasks have no handler in this worker; they will fail until a worker that handles them runs", unknown[taskType], taskType)
+	}
+}
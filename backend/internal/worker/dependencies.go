@@ -0,0 +1,132 @@
+package worker
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"xpired/internal/db"
+
+	"github.com/hibiken/asynq"
+)
+
+// Events of a document that its dependents are told about.
+const (
+	DependentsExpirationChanged = "expiration_changed"
+	DependentsExpired           = "expired"
+)
+
+type notifyDependentsPayload struct {
+	UserID                 string `json:"user_id"`
+	DocumentID             string `json:"document_id"`
+	Event                  string `json:"event"`
+	ExpirationDate         string `json:"expiration_date"`
+	PreviousExpirationDate string `json:"previous_expiration_date,omitempty"`
+	OrganizationID         string `json:"organization_id,omitempty"`
+}
+
+// handleNotifyDependents tells the owners of the documents that depend on a
+// document, directly or transitively, that it expired or its expiration date
+// changed. Each owner gets one message listing their dependents; those valid
+// for longer than the document are marked as affected. A notice whose date no
+// longer matches the document is skipped, as a newer one replaced it.
+func (p *reminderProcessor) handleNotifyDependents(ctx context.Context, t *asynq.Task) error {
+	var payload notifyDependentsPayload
+	if err := json.Unmarshal(t.Payload(), &payload); err != nil {
+		return err
+	}
+
+	doc, err := p.repo.GetDocumentByID(ctx, payload.DocumentID)
+	if err != nil {
+		if err.Error() == "document not found" {
+			return nil
+		}
+		return err
+	}
+	if doc.ExpirationDate.Format("2006-01-02") != payload.ExpirationDate {
+		logf(ctx, "Skipping stale dependents notice for doc %s", payload.DocumentID)
+		return nil
+	}
+
+	dependents, err := p.repo.ListDocumentDependents(ctx, doc.ID.String())
+	if err != nil {
+		return err
+	}
+	if len(dependents) == 0 {
+		return nil
+	}
+
+	expirationDate := doc.ExpirationDate.Format("January 2, 2006")
+	change := "expired on " + expirationDate
+	if payload.Event == DependentsExpirationChanged {
+		ScheduleDependentsExpiryNotice(ctx, *doc)
+		change = "now expires on " + expirationDate
+		if previous, err := time.Parse("2006-01-02", payload.PreviousExpirationDate); err == nil {
+			change += " instead of " + previous.Format("January 2, 2006")
+		}
+	}
+
+	var owners []string
+	itemsByOwner := map[string][]DependentItem{}
+	for _, dependent := range dependents {
+		ownerID := dependent.UserID.String()
+		if _, ok := itemsByOwner[ownerID]; !ok {
+			owners = append(owners, ownerID)
+		}
+		itemsByOwner[ownerID] = append(itemsByOwner[ownerID], DependentItem{
+			DocumentName:   dependent.Name,
+			ExpirationDate: dependent.ExpirationDate.Format("January 2, 2006"),
+			ViewURL:        p.cfg.App.FrontendURL + "/documents/" + dependent.ID.String(),
+			Impacted:       payload.Event == DependentsExpired || dependent.ExpirationDate.After(doc.ExpirationDate),
+		})
+	}
+
+	for _, ownerID := range owners {
+		for _, recipientID := range p.recipientUserIDs(ctx, ownerID) {
+			p.notifyDependents(ctx, recipientID, ownerID, doc, change, itemsByOwner[ownerID])
+		}
+	}
+
+	logf(ctx, "Dependents notice: %d documents depend on document %s (%s)", len(dependents), doc.Name, payload.Event)
+	return nil
+}
+
+// notifyDependents sends one dependency impact message to recipientID on
+// their default channels.
+func (p *reminderProcessor) notifyDependents(ctx context.Context, recipientID, ownerID string, doc *db.Document, change string, items []DependentItem) {
+	channels := defaultChannels(p.notificationPreferences(ctx, recipientID))
+
+	if channels[ChannelEmail] {
+		userEmail, err := p.repo.GetUserEmail(ctx, recipientID)
+		if err != nil {
+			logf(ctx, "Failed to load email for user %s: %v", recipientID, err)
+			return
+		}
+		err = p.dispatcher.Send(ctx, Notification{
+			RecipientID: recipientID,
+			UserID:      ownerID,
+			DocumentID:  doc.ID.String(),
+			Channel:     ChannelEmail,
+			To:          userEmail,
+			Subject:     "Dependent Documents Need Your Attention",
+			Body:        DependencyImpactEmailTemplate(userEmail, doc.Name, change, items, loadTheme(ctx, p.repo)),
+		})
+		if err != nil {
+			logf(ctx, "Failed to send email to %s: %v", userEmail, err)
+		}
+	}
+
+	if channels[ChannelSMS] {
+		userPhone, _ := p.repo.GetUserPhoneNumber(ctx, recipientID)
+		if userPhone != "" {
+			_ = p.dispatcher.Send(ctx, Notification{
+				RecipientID: recipientID,
+				UserID:      ownerID,
+				DocumentID:  doc.ID.String(),
+				Channel:     ChannelSMS,
+				To:          userPhone,
+				Body:        DependencyImpactSMSMessage(doc.Name, change, len(items)),
+			})
+		}
+	}
+}
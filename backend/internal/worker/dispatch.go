@@ -0,0 +1,266 @@
+package worker
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/mail"
+	"time"
+
+	"xpired/internal/db"
+	"xpired/internal/tenant"
+
+	"github.com/google/uuid"
+)
+
+const (
+	ChannelEmail = db.ChannelEmail
+	ChannelSMS   = db.ChannelSMS
+	ChannelPush  = db.ChannelPush
+
+	StatusSent   = "sent"
+	StatusFailed = "failed"
+	StatusDryRun = "dry_run"
+)
+
+// Notification is a rendered message ready to be handed to a provider.
+type Notification struct {
+	// MessageID identifies the message for open/bounce tracking. Callers that
+	// embed it in the body (e.g. a tracking pixel) set it; otherwise Send
+	// generates one.
+	MessageID uuid.UUID
+	// RecipientID is the user receiving the message, when it is a user.
+	RecipientID string
+	UserID      string
+	DocumentID  string
+	IntervalID  int
+	Channel     string
+	// From is the From header of an email; Send fills it in with the
+	// organization's verified sender, if any, when left empty.
+	From    string
+	To      string
+	Subject string
+	Body    string
+	// Calendar is an iCalendar invite sent along with an email, if any; see
+	// CalendarInvite.
+	Calendar string
+	// UnsubscribeURL is where the recipient of an email can stop getting
+	// it, if anywhere; it is sent as the List-Unsubscribe header, for
+	// one-click unsubscribe from the mail client, so it must accept a POST.
+	UnsubscribeURL string
+	// BatchDocumentIDs lists every document covered by a batched message;
+	// one log row is recorded per document.
+	BatchDocumentIDs []string
+}
+
+// documentIDs returns every document n covers.
+func (n Notification) documentIDs() []string {
+	if len(n.BatchDocumentIDs) == 0 {
+		return []string{n.DocumentID}
+	}
+	return n.BatchDocumentIDs
+}
+
+// Dispatcher delivers notifications through the configured providers and
+// records every attempt in notification_logs.
+type Dispatcher struct {
+	repo   db.ReminderRepository
+	dryRun bool
+	// throttle paces sends to the providers; nil sends unpaced.
+	throttle *providerThrottle
+	// breaker pauses channels whose provider keeps failing; nil never pauses.
+	breaker *providerBreaker
+	// smsQuota holds text messages to users to their plan; nil never does.
+	smsQuota *smsQuota
+}
+
+func NewDispatcher(repo db.ReminderRepository, dryRun bool) *Dispatcher {
+	return &Dispatcher{repo: repo, dryRun: dryRun}
+}
+
+// Send delivers n and records the attempt. While n's channel is paused by
+// the circuit breaker, n is queued for when the provider is tried again and
+// ErrChannelPaused is returned; nothing is recorded until it is sent. The
+// same goes when n cannot be paced by the throttle, with a retry shortly. Text
+// messages to a user past the monthly SMS quota of their plan are recorded
+// as failed with ErrSMSQuotaReached.
+func (d *Dispatcher) Send(ctx context.Context, n Notification) error {
+	if n.MessageID == uuid.Nil {
+		n.MessageID = uuid.New()
+	}
+
+	// texts logged in dry-run mode cost nothing, so they are not held to
+	// the quota and do not warn about nearing it
+	smsQuota := !d.dryRun && d.smsQuota != nil && n.Channel == ChannelSMS && n.RecipientID != ""
+	if smsQuota && d.smsQuota.reached(ctx, n.RecipientID) {
+		d.record(ctx, n, StatusFailed, map[string]interface{}{
+			"to":    n.To,
+			"error": ErrSMSQuotaReached.Error(),
+		})
+		noteDelivery(ctx, n, deliveryFailed)
+		return ErrSMSQuotaReached
+	}
+
+	if !d.dryRun && d.breaker != nil {
+		if retryAt, ok := d.breaker.allow(ctx, n.Channel); !ok {
+			if err := deferNotification(ctx, n, retryAt); err != nil {
+				return fmt.Errorf("failed to defer %s notification while its channel is paused: %w", n.Channel, err)
+			}
+			noteDelivery(ctx, n, deliveryDeferred)
+			return ErrChannelPaused
+		}
+	}
+
+	if n.Channel == ChannelEmail && n.From == "" {
+		n.From = d.sender(ctx)
+	}
+
+	status := StatusSent
+	response := map[string]interface{}{
+		"to": n.To,
+	}
+	if n.From != "" {
+		response["from"] = n.From
+	}
+
+	var sendErr error
+	if d.dryRun {
+		status = StatusDryRun
+		response["subject"] = n.Subject
+		response["body"] = n.Body
+		if n.Calendar != "" {
+			response["calendar"] = n.Calendar
+		}
+		if n.UnsubscribeURL != "" {
+			response["unsubscribeUrl"] = n.UnsubscribeURL
+		}
+		logf(ctx, "[dry-run] %s notification to %s for document %s not sent", n.Channel, n.To, n.DocumentID)
+	} else {
+		if d.throttle != nil {
+			if err := d.throttle.wait(ctx, n.Channel); err != nil {
+				logf(ctx, "Deferring %s notification %s: %v", n.Channel, n.MessageID.String(), err)
+				if err := deferNotification(ctx, n, time.Now().Add(throttleRetryDelay)); err != nil {
+					return fmt.Errorf("failed to defer %s notification that could not be paced: %w", n.Channel, err)
+				}
+				noteDelivery(ctx, n, deliveryDeferred)
+				return ErrChannelPaused
+			}
+		}
+		switch n.Channel {
+		case ChannelEmail:
+			if n.Calendar != "" {
+				sendErr = SendCalendarEmail(ctx, n.From, n.To, n.Subject, n.Body, n.Calendar)
+			} else if n.UnsubscribeURL != "" {
+				sendErr = SendListEmail(ctx, n.From, n.To, n.Subject, n.Body, n.UnsubscribeURL)
+			} else {
+				sendErr = SendEmail(ctx, n.From, n.To, n.Subject, n.Body)
+			}
+		case ChannelSMS:
+			sendErr = SendSMS(ctx, n.To, n.Body)
+		case ChannelPush:
+			sendErr = SendPush(ctx, n.To, n.Subject, n.Body)
+		}
+		if sendErr != nil {
+			status = StatusFailed
+			response["error"] = sendErr.Error()
+		}
+		// A send cut short by the task's own deadline says nothing about
+		// the provider.
+		if d.breaker != nil && ctx.Err() == nil {
+			d.breaker.record(ctx, n.Channel, sendErr)
+		}
+	}
+
+	d.record(ctx, n, status, response)
+	if sendErr != nil {
+		noteDelivery(ctx, n, deliveryFailed)
+	} else {
+		noteDelivery(ctx, n, deliveryDelivered)
+		if smsQuota {
+			d.smsQuota.sent(ctx, n.RecipientID)
+		}
+	}
+	return sendErr
+}
+
+// sender returns the From header of emails sent for the organization in ctx:
+// its custom sender once the domain is verified, or "" for the platform's
+// default.
+func (d *Dispatcher) sender(ctx context.Context) string {
+	organizationID := tenant.OrganizationID(ctx)
+	if organizationID == "" {
+		return ""
+	}
+
+	sender, err := d.repo.GetEmailSender(ctx, organizationID)
+	if err != nil {
+		if err.Error() != "email sender not found" {
+			logf(ctx, "Failed to load email sender of organization %s: %v", organizationID, err)
+		}
+		return ""
+	}
+	if sender.VerifiedAt == nil {
+		return ""
+	}
+	from := mail.Address{Name: sender.FromName, Address: sender.FromAddress}
+	return from.String()
+}
+
+func (d *Dispatcher) record(ctx context.Context, n Notification, status string, response map[string]interface{}) {
+	raw, _ := json.Marshal(response)
+
+	var recipientID *string
+	if n.RecipientID != "" {
+		recipientID = &n.RecipientID
+	}
+
+	for _, documentID := range n.documentIDs() {
+		var requestID *string
+		if id := documentRequestID(ctx, documentID); id != "" {
+			requestID = &id
+		}
+		entry := &db.NotificationLog{
+			ID:                 uuid.New(),
+			MessageID:          n.MessageID,
+			UserID:             n.UserID,
+			RecipientID:        recipientID,
+			DocumentID:         documentID,
+			ReminderIntervalID: n.IntervalID,
+			Channel:            n.Channel,
+			Status:             status,
+			Response:           raw,
+			RequestID:          requestID,
+		}
+		if err := d.repo.CreateNotificationLog(ctx, entry); err != nil {
+			logf(ctx, "Failed to record %s notification log for document %s: %v", n.Channel, documentID, err)
+		}
+		d.recordEvent(ctx, n, documentID, recipientID, status)
+	}
+}
+
+// recordEvent appends a send attempt on an organization document to the
+// organization's event log.
+func (d *Dispatcher) recordEvent(ctx context.Context, n Notification, documentID string, recipientID *string, status string) {
+	organizationID := tenant.OrganizationID(ctx)
+	if organizationID == "" {
+		return
+	}
+
+	detail, _ := json.Marshal(map[string]interface{}{
+		"messageId":  n.MessageID.String(),
+		"intervalId": n.IntervalID,
+		"status":     status,
+	})
+	channel := n.Channel
+	event := &db.EventLogEntry{
+		OrganizationID: organizationID,
+		EventType:      db.EventReminderSent,
+		DocumentID:     documentID,
+		RecipientID:    recipientID,
+		Channel:        &channel,
+		Detail:         string(detail),
+	}
+	if err := d.repo.AppendEvent(ctx, event); err != nil {
+		logf(ctx, "Failed to append %s event for document %s: %v", db.EventReminderSent, documentID, err)
+	}
+}
@@ -0,0 +1,114 @@
+package worker
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"net/netip"
+	"net/url"
+	"syscall"
+	"time"
+
+	"xpired/internal/config"
+)
+
+// ErrNonPublicAddress is returned for user-supplied URLs whose host is, or
+// resolves to, an address inside the platform's network.
+var ErrNonPublicAddress = errors.New("address is not public")
+
+// sharedAddressSpace is the carrier-grade NAT range (RFC 6598), internal
+// to many cloud networks though not private by net/netip's definition.
+var sharedAddressSpace = netip.MustParsePrefix("100.64.0.0/10")
+
+// EgressClient returns the HTTP client for calls to webhooks and providers
+// outside the platform. With an egress proxy configured they go through it,
+// so receivers see the addresses published in EGRESS_IPS.
+func EgressClient(cfg config.EgressConfig, timeout time.Duration) *http.Client {
+	client := &http.Client{Timeout: timeout}
+	if cfg.ProxyURL == "" {
+		return client
+	}
+
+	// config.Load has checked the URL
+	proxyURL, _ := url.Parse(cfg.ProxyURL)
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.Proxy = http.ProxyURL(proxyURL)
+	client.Transport = transport
+	return client
+}
+
+// PublicEgressClient is EgressClient for URLs users supply, such as their
+// webhook endpoints: it refuses to connect to loopback, private, link-local
+// and other non-public addresses, so the URL cannot reach into the
+// platform's network. The check is made on the address actually dialed, so
+// it also holds for redirects and for hosts that resolve differently after
+// the URL was saved. Through the egress proxy it is the proxy that dials, so
+// the target is resolved and checked before the request is handed to it;
+// HTTPS_PROXY is not used, as the dial check would refuse a proxy inside the
+// network.
+func PublicEgressClient(cfg config.EgressConfig, timeout time.Duration) *http.Client {
+	client := EgressClient(cfg, timeout)
+	if cfg.ProxyURL != "" {
+		transport := client.Transport.(*http.Transport)
+		proxy := transport.Proxy
+		transport.Proxy = func(req *http.Request) (*url.URL, error) {
+			if err := CheckPublicHost(req.Context(), req.URL.Hostname()); err != nil {
+				return nil, err
+			}
+			return proxy(req)
+		}
+		return client
+	}
+
+	dialer := &net.Dialer{
+		Timeout:   30 * time.Second,
+		KeepAlive: 30 * time.Second,
+		Control: func(network, address string, _ syscall.RawConn) error {
+			addrPort, err := netip.ParseAddrPort(address)
+			if err != nil {
+				return err
+			}
+			if !IsPublicAddr(addrPort.Addr()) {
+				return fmt.Errorf("%s: %w", addrPort.Addr(), ErrNonPublicAddress)
+			}
+			return nil
+		},
+	}
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.Proxy = nil
+	transport.DialContext = dialer.DialContext
+	client.Transport = transport
+	return client
+}
+
+// IsPublicAddr reports whether addr is routable on the internet, rather
+// than loopback, private, link-local (such as the 169.254.169.254 cloud
+// metadata service), multicast or unspecified.
+func IsPublicAddr(addr netip.Addr) bool {
+	addr = addr.Unmap()
+	return addr.IsValid() && addr.IsGlobalUnicast() && !addr.IsPrivate() && !sharedAddressSpace.Contains(addr)
+}
+
+// CheckPublicHost resolves host and fails with ErrNonPublicAddress when any
+// of its addresses is not public, or when it cannot be resolved.
+func CheckPublicHost(ctx context.Context, host string) error {
+	if addr, err := netip.ParseAddr(host); err == nil {
+		if !IsPublicAddr(addr) {
+			return fmt.Errorf("%s: %w", host, ErrNonPublicAddress)
+		}
+		return nil
+	}
+
+	addrs, err := net.DefaultResolver.LookupNetIP(ctx, "ip", host)
+	if err != nil {
+		return fmt.Errorf("failed to resolve %s: %w", host, err)
+	}
+	for _, addr := range addrs {
+		if !IsPublicAddr(addr) {
+			return fmt.Errorf("%s resolves to %s: %w", host, addr, ErrNonPublicAddress)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,58 @@
+package worker
+
+import (
+	"context"
+	"encoding/json"
+
+	"xpired/internal/auth"
+	"xpired/internal/db"
+
+	"github.com/hibiken/asynq"
+)
+
+type emailConfirmationPayload struct {
+	UserID string `json:"user_id"`
+	Email  string `json:"email"`
+}
+
+type emailChangeProcessor struct {
+	repo        db.Repository
+	frontendURL string
+	dryRun      bool
+}
+
+// handleSendEmailConfirmation emails the address a user asked to switch to
+// the link that confirms it. Changes replaced by a newer one, or confirmed
+// meanwhile, get nothing.
+func (p *emailChangeProcessor) handleSendEmailConfirmation(ctx context.Context, t *asynq.Task) error {
+	var payload emailConfirmationPayload
+	if err := json.Unmarshal(t.Payload(), &payload); err != nil {
+		return err
+	}
+
+	pending, err := p.repo.GetPendingEmail(ctx, payload.UserID)
+	if err != nil {
+		return err
+	}
+	if pending == nil || *pending != payload.Email {
+		logf(ctx, "Skipping confirmation of %s for user %s: no longer pending", payload.Email, payload.UserID)
+		return nil
+	}
+	user, err := p.repo.GetUserByID(ctx, payload.UserID)
+	if err != nil {
+		return err
+	}
+
+	token, err := auth.GenerateEmailChangeToken(payload.UserID, payload.Email)
+	if err != nil {
+		return err
+	}
+	// the link opens a page that asks to confirm, as mail scanners follow
+	// links; confirming POSTs to /api/auth/email/confirm/{token}
+	confirmURL := p.frontendURL + "/email/confirm/" + token
+	if p.dryRun {
+		logf(ctx, "[dry-run] email confirmation to %s for user %s not sent: %s", payload.Email, payload.UserID, confirmURL)
+		return nil
+	}
+	return SendEmail(ctx, "", payload.Email, "Confirm your new email address", EmailConfirmationTemplate(user.Name, payload.Email, confirmURL))
+}
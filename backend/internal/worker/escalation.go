@@ -0,0 +1,129 @@
+package worker
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"time"
+
+	"xpired/internal/db"
+
+	"github.com/google/uuid"
+	"github.com/hibiken/asynq"
+)
+
+type escalationPayload struct {
+	MessageID string `json:"message_id"`
+}
+
+func (p *reminderProcessor) trackOpenURL(messageID uuid.UUID) string {
+	return p.cfg.App.BaseURL + "/api/track/open/" + messageID.String()
+}
+
+// scheduleEscalation chains the escalation check onto an email send: right
+// away if the send failed, otherwise once the recipient's grace period ends.
+// An email held back by a paused channel is not a failure yet.
+func (p *reminderProcessor) scheduleEscalation(ctx context.Context, messageID uuid.UUID, prefs *db.NotificationPreferences, sendErr error) {
+	runAt := time.Now().AddDate(0, 0, prefs.EscalationAfterDays)
+	if sendErr != nil && !errors.Is(sendErr, ErrChannelPaused) {
+		runAt = time.Now()
+	}
+	if err := ScheduleEscalation(ctx, messageID.String(), runAt); err != nil {
+		logf(ctx, "Failed to schedule escalation for message %s: %v", messageID.String(), err)
+	}
+}
+
+// handleEscalateReminder re-sends an email reminder by SMS when the email
+// bounced, failed, or was never opened. Documents whose reminder the user
+// acknowledged from another channel meanwhile are left out. Each message
+// escalates at most once.
+func (p *reminderProcessor) handleEscalateReminder(ctx context.Context, t *asynq.Task) error {
+	var payload escalationPayload
+	if err := json.Unmarshal(t.Payload(), &payload); err != nil {
+		return err
+	}
+
+	logs, err := p.repo.ListNotificationLogsByMessageID(ctx, payload.MessageID)
+	if err != nil {
+		return err
+	}
+	if len(logs) == 0 || logs[0].RecipientID == nil {
+		return nil
+	}
+	first := logs[0]
+
+	prefs := p.notificationPreferences(ctx, *first.RecipientID)
+	if prefs.EscalationChannel != db.EscalationSMS {
+		return nil
+	}
+
+	delivered := first.BouncedAt == nil && first.Status != StatusFailed
+	if delivered {
+		if first.OpenedAt != nil {
+			return nil
+		}
+		grace := time.Duration(prefs.EscalationAfterDays) * 24 * time.Hour
+		if time.Since(first.CreatedAt) < grace {
+			return nil
+		}
+	}
+
+	userPhone, _ := p.repo.GetUserPhoneNumber(ctx, *first.RecipientID)
+	if userPhone == "" {
+		logf(ctx, "Cannot escalate message %s: user %s has no phone number", payload.MessageID, *first.RecipientID)
+		return nil
+	}
+
+	var docs []*db.Document
+	for _, entry := range logs {
+		doc, err := p.repo.GetDocumentByID(ctx, entry.DocumentID)
+		if err != nil {
+			continue
+		}
+		if !p.reminderStillDue(ctx, doc, entry.ReminderIntervalID) {
+			continue
+		}
+		if reminderFollowedUp(ctx, p.repo, entry.DocumentID, entry.ReminderIntervalID) {
+			continue
+		}
+		docs = append(docs, doc)
+	}
+	if len(docs) == 0 {
+		return nil
+	}
+
+	claimed, err := p.repo.MarkNotificationEscalated(ctx, payload.MessageID)
+	if err != nil {
+		return err
+	}
+	if !claimed {
+		return nil
+	}
+
+	n := Notification{
+		RecipientID: *first.RecipientID,
+		UserID:      first.UserID,
+		DocumentID:  docs[0].ID.String(),
+		IntervalID:  first.ReminderIntervalID,
+		Channel:     ChannelSMS,
+		To:          userPhone,
+	}
+	if len(docs) == 1 {
+		links := p.actionLinks(ctx, first.UserID, docs[0].ID.String(), first.ReminderIntervalID)
+		n.Body = SMSMessage(docs[0].Name, docs[0].ExpirationDate.Format("January 2, 2006"), links.View)
+	} else {
+		n.Body = BatchSMSMessage(len(docs))
+		for _, doc := range docs {
+			n.BatchDocumentIDs = append(n.BatchDocumentIDs, doc.ID.String())
+		}
+	}
+
+	logf(ctx, "Escalating email message %s to SMS for user %s", payload.MessageID, *first.RecipientID)
+	if err := p.dispatcher.Send(ctx, n); err != nil {
+		logf(ctx, "Failed to send escalation SMS to %s: %v", userPhone, err)
+		return nil
+	}
+	// the text may be all that went out of a reminder whose email failed
+	transitionReminders(ctx, p.repo, n.documentIDs(), n.IntervalID, db.ReminderSent)
+	return nil
+}
@@ -0,0 +1,60 @@
+package worker
+
+import (
+	"context"
+
+	"xpired/internal/db"
+)
+
+// escalationRecipients widens responsible, the users a reminder goes to
+// anyway, by the audiences of every step of the organization's escalation
+// policy that the reminder's interval has reached. Without a policy, or
+// before its first step, only responsible is notified.
+func (p *reminderProcessor) escalationRecipients(ctx context.Context, organizationID string, intervalID int, responsible []reminderRecipient) []reminderRecipient {
+	steps, err := p.repo.GetEscalationPolicy(ctx, organizationID)
+	if err != nil {
+		logf(ctx, "Failed to load escalation policy of organization %s: %v", organizationID, err)
+		return responsible
+	}
+	if len(steps) == 0 {
+		return responsible
+	}
+	interval, err := p.repo.GetReminderIntervalByID(ctx, intervalID)
+	if err != nil {
+		logf(ctx, "Failed to load reminder interval %d: %v", intervalID, err)
+		return responsible
+	}
+
+	audiences := map[string]bool{}
+	for _, step := range steps {
+		if interval.DaysBefore <= step.DaysBefore {
+			audiences[step.Audience] = true
+		}
+	}
+	if !audiences[db.EscalationAudienceManagers] && !audiences[db.EscalationAudienceEveryone] {
+		return responsible
+	}
+
+	members, err := p.repo.ListOrganizationMembers(ctx, organizationID)
+	if err != nil {
+		logf(ctx, "Failed to load members of organization %s: %v", organizationID, err)
+		return responsible
+	}
+
+	recipients := responsible
+	seen := map[string]bool{}
+	for _, recipient := range responsible {
+		seen[recipient.UserID] = true
+	}
+	for _, member := range members {
+		if seen[member.UserID] {
+			continue
+		}
+		manager := member.Role == db.OrgRoleOwner || member.Role == db.OrgRoleAdmin
+		if audiences[db.EscalationAudienceEveryone] || (manager && audiences[db.EscalationAudienceManagers]) {
+			recipients = append(recipients, reminderRecipient{UserID: member.UserID})
+			seen[member.UserID] = true
+		}
+	}
+	return recipients
+}
@@ -0,0 +1,25 @@
+package worker
+
+import (
+	"context"
+	"time"
+
+	"xpired/internal/db"
+)
+
+// RefreshExpiringDocumentsJob keeps the expiring_documents view that digest
+// and sweeper jobs read current in every data region.
+func RefreshExpiringDocumentsJob(repo db.DocumentRepository) Job {
+	return Job{
+		Name:     JobRefreshExpiringDocuments,
+		Interval: 15 * time.Minute,
+		Run: func(ctx context.Context) error {
+			for _, regionCtx := range regionContexts(ctx, repo) {
+				if err := repo.RefreshExpiringDocuments(regionCtx); err != nil {
+					return err
+				}
+			}
+			return nil
+		},
+	}
+}
@@ -0,0 +1,128 @@
+package worker
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"xpired/internal/config"
+	"xpired/internal/db"
+
+	"github.com/hibiken/asynq"
+)
+
+const (
+	relayFeedbackMaxRetry = 5
+	slackWebhookTimeout   = 10 * time.Second
+)
+
+// slackEscaper escapes the control characters of Slack's message format, so
+// user text cannot mention @channel or forge links.
+var slackEscaper = strings.NewReplacer("&", "&amp;", "<", "&lt;", ">", "&gt;")
+
+type feedbackPayload struct {
+	FeedbackID string `json:"feedback_id"`
+}
+
+type feedbackProcessor struct {
+	repo   db.Repository
+	cfg    config.FeedbackConfig
+	dryRun bool
+	client *http.Client
+}
+
+// handleRelayFeedback passes feedback on to the team's email and Slack. It is
+// retried until both went out, so a retry after only one of them failed can
+// repeat the other.
+func (p *feedbackProcessor) handleRelayFeedback(ctx context.Context, t *asynq.Task) error {
+	var payload feedbackPayload
+	if err := json.Unmarshal(t.Payload(), &payload); err != nil {
+		return fmt.Errorf("invalid payload: %v: %w", err, asynq.SkipRetry)
+	}
+
+	feedback, err := p.repo.GetFeedback(ctx, payload.FeedbackID)
+	if err != nil {
+		if err.Error() == "feedback not found" {
+			return nil
+		}
+		return err
+	}
+	if feedback.RelayedAt != nil {
+		return nil
+	}
+
+	from := "an anonymous user"
+	if feedback.UserID != nil {
+		if user, err := p.repo.GetUserByID(ctx, *feedback.UserID); err == nil {
+			from = fmt.Sprintf("%s <%s>", user.Name, user.Email)
+		}
+	}
+
+	if p.dryRun {
+		logf(ctx, "[dry-run] feedback %s not relayed", feedback.ID.String())
+		return p.repo.MarkFeedbackRelayed(ctx, feedback.ID.String())
+	}
+	if p.cfg.Email != "" {
+		subject := "New feedback from " + from
+		if err := SendEmail(ctx, "", p.cfg.Email, subject, FeedbackEmailTemplate(from, feedback)); err != nil {
+			return fmt.Errorf("failed to email feedback: %w", err)
+		}
+	}
+	if p.cfg.SlackWebhookURL != "" {
+		if err := p.postToSlack(ctx, from, feedback); err != nil {
+			return fmt.Errorf("failed to post feedback to Slack: %w", err)
+		}
+	}
+	return p.repo.MarkFeedbackRelayed(ctx, feedback.ID.String())
+}
+
+func (p *feedbackProcessor) postToSlack(ctx context.Context, from string, feedback *db.Feedback) error {
+	var text strings.Builder
+	text.WriteString("*New feedback*\n")
+	for _, detail := range feedbackDetails(from, feedback) {
+		text.WriteString(detail[0] + ": " + slackEscaper.Replace(detail[1]) + "\n")
+	}
+	if feedback.Message != "" {
+		text.WriteString("> " + strings.ReplaceAll(slackEscaper.Replace(feedback.Message), "\n", "\n> "))
+	}
+
+	body, _ := json.Marshal(map[string]string{"text": text.String()})
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, p.cfg.SlackWebhookURL, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := p.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode >= 300 {
+		return fmt.Errorf("slack webhook responded %d", resp.StatusCode)
+	}
+	return nil
+}
+
+// feedbackDetails lists the context of feedback as label and value pairs.
+func feedbackDetails(from string, feedback *db.Feedback) [][2]string {
+	details := [][2]string{{"From", from}}
+	if feedback.Score != nil {
+		details = append(details, [2]string{"Score", strconv.Itoa(*feedback.Score) + "/10"})
+	}
+	if feedback.Page != nil {
+		details = append(details, [2]string{"Page", *feedback.Page})
+	}
+	if feedback.RequestID != nil {
+		details = append(details, [2]string{"Request ID", *feedback.RequestID})
+	}
+	if feedback.UserAgent != nil {
+		details = append(details, [2]string{"User agent", *feedback.UserAgent})
+	}
+	return details
+}
@@ -0,0 +1,291 @@
+package worker
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/hibiken/asynq"
+
+	"xpired/internal/db"
+	"xpired/internal/importer"
+)
+
+const (
+	// importChunkSize is how many rows of an import job one task imports.
+	importChunkSize = 250
+	// importBackpressureDelay holds back the next chunk of an import while
+	// the queue is backed up.
+	importBackpressureDelay = 30 * time.Second
+)
+
+type importChunkPayload struct {
+	JobID string `json:"job_id"`
+	Chunk int    `json:"chunk"`
+}
+
+// importCategory maps a free-form type from an export ("Driver's License")
+// onto a known category slug, or nil if there is none.
+func importCategory(ctx context.Context, repo db.DocumentRepository, raw *string) *db.DocumentCategory {
+	if raw == nil {
+		return nil
+	}
+	slug := strings.ToLower(strings.TrimSpace(*raw))
+	slug = strings.NewReplacer("'", "", " ", "_", "-", "_").Replace(slug)
+	category, err := repo.GetDocumentCategory(ctx, slug)
+	if err != nil {
+		return nil
+	}
+	return category
+}
+
+// ImportDocument creates a personal document with the given ID for userID
+// from a row of another tool's export and schedules its reminders: those
+// named by reminderLabels, or its category's defaults when there are none.
+// It returns ErrDocumentQuotaReached once userID has as many documents as
+// their plan allows.
+func ImportDocument(ctx context.Context, repo db.Repository, id uuid.UUID, userID, timezone string, row *importer.Row, reminderLabels []string) (*db.Document, error) {
+	usage, err := DocumentQuotaUsage(ctx, repo, userID)
+	if err != nil {
+		return nil, err
+	}
+	if usage.Reached() {
+		return nil, ErrDocumentQuotaReached
+	}
+
+	doc := &db.Document{
+		ID:             id,
+		UserID:         uuid.MustParse(userID),
+		Name:           row.Name,
+		Description:    row.Description,
+		Identifier:     row.Identifier,
+		ExpirationDate: row.ExpirationDate,
+		Timezone:       timezone,
+		CreatedAt:      time.Now(),
+		UpdatedAt:      time.Now(),
+	}
+
+	if category := importCategory(ctx, repo, row.Category); category != nil {
+		doc.Category = &category.Slug
+		if len(reminderLabels) == 0 {
+			reminderLabels = category.DefaultReminders
+		}
+	}
+
+	if err := repo.CreateDocument(ctx, doc); err != nil {
+		return nil, err
+	}
+	usage.Used++
+	WarnNearQuota(ctx, repo, userID, usage)
+
+	reminderIntervals, err := repo.GetReminderIntervalsFromIdLabels(ctx, reminderLabels)
+	if err != nil {
+		return nil, err
+	}
+
+	var reminderValues []db.ReminderInterval
+	for _, interval := range reminderIntervals {
+		docReminder := &db.DocumentReminder{
+			ID:                 uuid.New(),
+			DocumentID:         doc.ID.String(),
+			ReminderIntervalID: interval.ID,
+			Enabled:            true,
+		}
+		if err := repo.SetDocumentReminders(ctx, doc.ID.String(), docReminder); err != nil {
+			return nil, err
+		}
+		reminderValues = append(reminderValues, *interval)
+	}
+
+	ScheduleReminders(ctx, *doc, doc.UserID, reminderValues)
+	EmitWebhookEvent(ctx, doc.UserID.String(), db.WebhookEventDocumentCreated, doc)
+	return doc, nil
+}
+
+// ImportRowError describes to the user why ImportDocument failed for a row.
+func ImportRowError(err error) string {
+	if errors.Is(err, ErrDocumentQuotaReached) {
+		return "document limit of your plan reached"
+	}
+	return "failed to create document"
+}
+
+// importChunkResult is the outcome of one chunk of an import job.
+type importChunkResult struct {
+	DocumentIDs []string            `json:"documentIds"`
+	Errors      []importer.RowError `json:"errors"`
+}
+
+// importDocumentID is the ID of the document an import job creates from the
+// row on line, the same every time the row's chunk runs. A chunk retried
+// after a crash finds the documents it created before and does not create
+// them again.
+func importDocumentID(jobID uuid.UUID, line int) uuid.UUID {
+	return uuid.NewSHA1(jobID, []byte(strconv.Itoa(line)))
+}
+
+// chunkImport parses the export of an import job and stores its rows in
+// chunks of importChunkSize, dropping the upload from the job's params.
+func (p *jobProcessor) chunkImport(ctx context.Context, job *db.Job, params ImportJobParams) error {
+	parsed, err := importer.Parse(params.Source, strings.NewReader(params.Data))
+	if err != nil {
+		return err
+	}
+
+	var chunks []json.RawMessage
+	for start := 0; start < len(parsed.Rows); start += importChunkSize {
+		end := min(start+importChunkSize, len(parsed.Rows))
+		rows, err := json.Marshal(parsed.Rows[start:end])
+		if err != nil {
+			return fmt.Errorf("failed to encode import rows")
+		}
+		chunks = append(chunks, rows)
+	}
+
+	params.Data = ""
+	params.Errors = parsed.Errors
+	params.UnmappedColumns = parsed.UnmappedColumns
+	raw, _ := json.Marshal(params)
+	if err := p.repo.CreateImportChunks(ctx, job.ID.String(), raw, chunks, len(parsed.Rows)); err != nil {
+		logf(ctx, "Failed to chunk import job %s: %v", job.ID.String(), err)
+		return fmt.Errorf("failed to prepare import")
+	}
+	job.Params = raw
+	return nil
+}
+
+// handleImportChunk imports one chunk of an import job, then queues the
+// next, or finishes the job after the last. Chunks of a job run one after
+// another, so a large import takes one worker at a time and reminders keep
+// flowing next to it.
+func (p *jobProcessor) handleImportChunk(ctx context.Context, t *asynq.Task) error {
+	var payload importChunkPayload
+	if err := json.Unmarshal(t.Payload(), &payload); err != nil {
+		return fmt.Errorf("invalid payload: %v: %w", err, asynq.SkipRetry)
+	}
+
+	job, err := p.repo.GetJob(ctx, payload.JobID)
+	if err != nil {
+		if err.Error() == "job not found" {
+			return nil
+		}
+		return err
+	}
+	if job.Status != db.JobRunning {
+		return nil
+	}
+	chunk, err := p.repo.GetImportChunk(ctx, payload.JobID, payload.Chunk)
+	if err != nil {
+		if err.Error() == "import chunk not found" {
+			return nil
+		}
+		return err
+	}
+
+	if chunk.DoneAt == nil {
+		var params ImportJobParams
+		var rows []*importer.Row
+		if err := json.Unmarshal(job.Params, &params); err != nil {
+			return fmt.Errorf("invalid import parameters: %w", asynq.SkipRetry)
+		}
+		if err := json.Unmarshal(chunk.Rows, &rows); err != nil {
+			return fmt.Errorf("invalid import chunk: %w", asynq.SkipRetry)
+		}
+
+		result := importChunkResult{DocumentIDs: []string{}, Errors: []importer.RowError{}}
+		for _, row := range rows {
+			if err := ctx.Err(); err != nil {
+				return err
+			}
+			id := importDocumentID(job.ID, row.Line)
+			if _, err := p.repo.GetDocumentByID(ctx, id.String()); err == nil {
+				result.DocumentIDs = append(result.DocumentIDs, id.String())
+				continue
+			}
+			if _, err := ImportDocument(ctx, p.repo, id, job.UserID, params.Timezone, row, params.Reminders); err != nil {
+				result.Errors = append(result.Errors, importer.RowError{Line: row.Line, Error: ImportRowError(err)})
+				continue
+			}
+			result.DocumentIDs = append(result.DocumentIDs, id.String())
+		}
+
+		raw, _ := json.Marshal(result)
+		if _, err := p.repo.CompleteImportChunk(ctx, payload.JobID, payload.Chunk, raw, len(rows)); err != nil {
+			return err
+		}
+	}
+	return p.continueImport(ctx, job)
+}
+
+// continueImport queues the next chunk of the import job, or finishes the
+// job when every chunk is done. While the queue is backed up the chunk is
+// held back, so an import does not delay reminders further.
+func (p *jobProcessor) continueImport(ctx context.Context, job *db.Job) error {
+	next, err := p.repo.NextImportChunk(ctx, job.ID.String())
+	if err != nil {
+		if err.Error() == "import chunk not found" {
+			return p.finishImport(ctx, job)
+		}
+		return err
+	}
+
+	runAt := time.Now()
+	if inspector != nil {
+		if queue, err := inspector.GetQueueInfo("default"); err == nil && queue.Pending > p.backlog {
+			runAt = runAt.Add(importBackpressureDelay)
+		}
+	}
+	payload := map[string]interface{}{
+		"job_id":  job.ID.String(),
+		"user_id": job.UserID,
+		"chunk":   next.Chunk,
+	}
+	taskID := fmt.Sprintf("import:%s:%d", job.ID.String(), next.Chunk)
+	err = enqueueDelayedTask(ctx, TaskImportChunk, payload, runAt, asynq.TaskID(taskID))
+	if errors.Is(err, asynq.ErrTaskIDConflict) {
+		return nil
+	}
+	return err
+}
+
+// finishImport records the result of an import job whose chunks are all
+// done: what the synchronous import endpoint would have answered.
+func (p *jobProcessor) finishImport(ctx context.Context, job *db.Job) error {
+	var params ImportJobParams
+	if err := json.Unmarshal(job.Params, &params); err != nil {
+		return fmt.Errorf("invalid import parameters: %w", asynq.SkipRetry)
+	}
+	results, err := p.repo.ListImportChunkResults(ctx, job.ID.String())
+	if err != nil {
+		return err
+	}
+
+	documentIDs := []string{}
+	rowErrors := params.Errors
+	for _, raw := range results {
+		var result importChunkResult
+		if err := json.Unmarshal(raw, &result); err != nil {
+			continue
+		}
+		documentIDs = append(documentIDs, result.DocumentIDs...)
+		rowErrors = append(rowErrors, result.Errors...)
+	}
+	sort.SliceStable(rowErrors, func(i, j int) bool { return rowErrors[i].Line < rowErrors[j].Line })
+
+	result := map[string]interface{}{
+		"source":          params.Source,
+		"imported":        len(documentIDs),
+		"documentIds":     documentIDs,
+		"errors":          rowErrors,
+		"unmappedColumns": params.UnmappedColumns,
+	}
+	job.Status = db.JobSucceeded
+	job.Result, _ = json.Marshal(result)
+	return p.repo.FinishJob(ctx, job)
+}
@@ -0,0 +1,178 @@
+package worker
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/hibiken/asynq"
+	"github.com/redis/go-redis/v9"
+)
+
+// inspector reads and manages the task queue for the admin API; it is set
+// up by InitQueue next to the client.
+var inspector *asynq.Inspector
+
+var (
+	// ErrTaskNotFound is returned by RequeueTask for a task that does not
+	// exist.
+	ErrTaskNotFound = errors.New("task not found")
+	// ErrJobAlreadyTriggered is returned by TriggerJob while an earlier
+	// trigger of the job has not run yet.
+	ErrJobAlreadyTriggered = errors.New("job already triggered")
+)
+
+// triggeredJobUniqueness is how long a triggered job keeps the same job from
+// being triggered again, unless it finishes sooner.
+const triggeredJobUniqueness = 15 * time.Minute
+
+// FailedTask is a task whose last run failed: it either waits for a retry
+// or was archived after running out of them.
+type FailedTask struct {
+	ID    string
+	Queue string
+	Type  string
+	// State is "retry" or "archived".
+	State         string
+	Payload       []byte
+	Retried       int
+	MaxRetry      int
+	LastError     string
+	LastFailedAt  time.Time
+	NextProcessAt *time.Time
+}
+
+// FailedTasks lists up to limit retrying and limit archived tasks of every
+// queue.
+func FailedTasks(limit int) ([]FailedTask, error) {
+	queues, err := inspector.Queues()
+	if err != nil {
+		return nil, fmt.Errorf("failed to list queues: %w", err)
+	}
+
+	tasks := []FailedTask{}
+	for _, queue := range queues {
+		retrying, err := inspector.ListRetryTasks(queue, asynq.PageSize(limit))
+		if err != nil {
+			return nil, fmt.Errorf("failed to list retrying tasks of %s: %w", queue, err)
+		}
+		archived, err := inspector.ListArchivedTasks(queue, asynq.PageSize(limit))
+		if err != nil {
+			return nil, fmt.Errorf("failed to list archived tasks of %s: %w", queue, err)
+		}
+		for _, info := range append(retrying, archived...) {
+			task := FailedTask{
+				ID:           info.ID,
+				Queue:        info.Queue,
+				Type:         info.Type,
+				State:        info.State.String(),
+				Payload:      info.Payload,
+				Retried:      info.Retried,
+				MaxRetry:     info.MaxRetry,
+				LastError:    info.LastErr,
+				LastFailedAt: info.LastFailedAt,
+			}
+			if info.State == asynq.TaskStateRetry {
+				next := info.NextProcessAt
+				task.NextProcessAt = &next
+			}
+			tasks = append(tasks, task)
+		}
+	}
+	return tasks, nil
+}
+
+// RequeueTask makes a retrying or archived task run right away.
+func RequeueTask(queue, id string) error {
+	err := inspector.RunTask(queue, id)
+	if errors.Is(err, asynq.ErrQueueNotFound) || errors.Is(err, asynq.ErrTaskNotFound) {
+		return ErrTaskNotFound
+	}
+	return err
+}
+
+// QueueStats is a snapshot of one task queue.
+type QueueStats struct {
+	Queue     string
+	Paused    bool
+	Pending   int
+	Active    int
+	Scheduled int
+	Retry     int
+	Archived  int
+	// ProcessedToday and FailedToday count the runs of the current UTC day.
+	ProcessedToday int
+	FailedToday    int
+	// Latency is how long the oldest pending task has been waiting.
+	Latency time.Duration
+}
+
+// Queues reports the stats of every task queue.
+func Queues() ([]QueueStats, error) {
+	queues, err := inspector.Queues()
+	if err != nil {
+		return nil, fmt.Errorf("failed to list queues: %w", err)
+	}
+
+	stats := make([]QueueStats, 0, len(queues))
+	for _, queue := range queues {
+		info, err := inspector.GetQueueInfo(queue)
+		if err != nil {
+			return nil, fmt.Errorf("failed to inspect queue %s: %w", queue, err)
+		}
+		stats = append(stats, QueueStats{
+			Queue:          info.Queue,
+			Paused:         info.Paused,
+			Pending:        info.Pending,
+			Active:         info.Active,
+			Scheduled:      info.Scheduled,
+			Retry:          info.Retry,
+			Archived:       info.Archived,
+			ProcessedToday: info.Processed,
+			FailedToday:    info.Failed,
+			Latency:        info.Latency,
+		})
+	}
+	return stats, nil
+}
+
+// FiringAlert is a delivery health alert that has fired and not resolved.
+type FiringAlert struct {
+	Name    string
+	FiredAt time.Time
+}
+
+// FiringAlerts lists the alerts of MonitorHealthJob that are firing, and a
+// notification anomaly found by DetectAnomaliesJob within the last day.
+func FiringAlerts(ctx context.Context, rdb *redis.Client) ([]FiringAlert, error) {
+	alerts := []FiringAlert{}
+	for _, name := range []string{AlertNotificationsSilent, AlertQueueBacklog, AlertNotificationFailures, AlertNotificationAnomaly} {
+		raw, err := rdb.Get(ctx, "xpired:alert:"+name).Result()
+		if err == redis.Nil {
+			continue
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to read alert %s: %w", name, err)
+		}
+		firedAt, _ := time.Parse(time.RFC3339, raw)
+		alerts = append(alerts, FiringAlert{Name: name, FiredAt: firedAt})
+	}
+	return alerts, nil
+}
+
+// TriggerJob queues the named scheduled job to run on a worker right away,
+// on top of its schedule.
+func TriggerJob(ctx context.Context, name string) error {
+	data, _ := json.Marshal(withRequestID(ctx, map[string]interface{}{"name": name}))
+	_, err := client.Enqueue(
+		asynq.NewTask(TaskRunScheduledJob, data),
+		asynq.MaxRetry(0),
+		asynq.Unique(triggeredJobUniqueness),
+	)
+	if errors.Is(err, asynq.ErrDuplicateTask) {
+		return ErrJobAlreadyTriggered
+	}
+	return err
+}
@@ -1,12 +1,16 @@
 package worker
 
 import (
+	"context"
 	"encoding/json"
+	"errors"
+	"fmt"
 	"log"
 	"time"
 
 	"xpired/internal/config"
 	"xpired/internal/db"
+	"xpired/internal/tenant"
 
 	"github.com/google/uuid"
 	"github.com/hibiken/asynq"
@@ -14,41 +18,292 @@ import (
 
 var client *asynq.Client
 
+// batchReminders mirrors NotificationsConfig.BatchEmails for the scheduling side.
+var batchReminders bool
+
 func InitQueue(cfg *config.Config) {
+	batchReminders = cfg.Notifications.BatchEmails
+	quotas = cfg.Quotas
 	client = asynq.NewClient(asynq.RedisClientOpt{
 		Addr:     cfg.Redis.Addr,
 		Password: cfg.Redis.Password,
 	})
 	client.Ping()
+	inspector = asynq.NewInspector(asynq.RedisClientOpt{
+		Addr:     cfg.Redis.Addr,
+		Password: cfg.Redis.Password,
+	})
 	log.Println("Asynq client initialized")
 }
 
-func enqueueDelayedTask(taskType string, payload map[string]interface{}, runAt time.Time) error {
-	data, _ := json.Marshal(payload)
+// enqueueDelayedTask queues a task of taskType with payload to run at runAt,
+// tagged with the request ID in ctx (see withRequestID).
+func enqueueDelayedTask(ctx context.Context, taskType string, payload map[string]interface{}, runAt time.Time, opts ...asynq.Option) error {
+	data, _ := json.Marshal(withRequestID(ctx, payload))
 	task := asynq.NewTask(taskType, data)
 
-	_, err := client.Enqueue(task, asynq.ProcessAt(runAt))
+	opts = append([]asynq.Option{asynq.ProcessAt(runAt)}, opts...)
+	_, err := client.Enqueue(task, opts...)
 	return err
 }
 
-func ScheduleReminders(doc db.Document, userID uuid.UUID, enabledIntervals []db.ReminderInterval) {
+// withTenant tags a task payload with the organization (or, for sweeps, the
+// data region) in ctx; the worker restores it into the task context before
+// running the handler.
+func withTenant(ctx context.Context, payload map[string]interface{}) map[string]interface{} {
+	t := tenant.FromContext(ctx)
+	if t.OrganizationID != "" {
+		payload["organization_id"] = t.OrganizationID
+	} else if t.Region != "" {
+		payload["data_region"] = t.Region
+	}
+	return payload
+}
+
+// reminderGroup is the asynq aggregation group for reminders of one user and
+// interval, so reminders that come due together can be sent as one email.
+// Reminders of different organizations are never batched together.
+func reminderGroup(userID, organizationID string, intervalID int) string {
+	if organizationID != "" {
+		return fmt.Sprintf("reminders:%s:%s:%d", userID, organizationID, intervalID)
+	}
+	return fmt.Sprintf("reminders:%s:%d", userID, intervalID)
+}
+
+func ScheduleReminders(ctx context.Context, doc db.Document, userID uuid.UUID, enabledIntervals []db.ReminderInterval) {
+	var organizationID string
+	if doc.OrganizationID != nil {
+		organizationID = *doc.OrganizationID
+	}
 	for _, interval := range enabledIntervals {
 		reminderTime := doc.ExpirationDate.AddDate(0, 0, -interval.DaysBefore)
 
 		if reminderTime.Before(time.Now()) {
-			log.Printf("Skipping past reminder for doc %s (interval %d)", doc.ID.String(), interval.ID)
+			logf(ctx, "Skipping past reminder for doc %s (interval %d)", doc.ID.String(), interval.ID)
 			continue
 		}
 
 		reminderTimeUTC := reminderTime.UTC()
 		payload := map[string]interface{}{
+			"version":     reminderPayloadVersion,
 			"user_id":     userID.String(),
 			"document_id": doc.ID.String(),
 			"interval_id": interval.ID,
 		}
+		if organizationID != "" {
+			payload["organization_id"] = organizationID
+		}
 
-		if err := enqueueDelayedTask("send_reminder", payload, reminderTimeUTC); err != nil {
-			log.Printf("Failed to enqueue reminder for doc %s: %v", doc.ID.String(), err)
+		var opts []asynq.Option
+		if batchReminders {
+			opts = append(opts, asynq.Group(reminderGroup(userID.String(), organizationID, interval.ID)))
 		}
+
+		if err := enqueueDelayedTask(ctx, TaskSendReminder, payload, reminderTimeUTC, opts...); err != nil {
+			logf(ctx, "Failed to enqueue reminder for doc %s: %v", doc.ID.String(), err)
+		}
+	}
+}
+
+// ScheduleLeadTimeReminder queues the "start your renewal" reminder of doc
+// leadTimeDays before it expires, or right away when that moment has already
+// passed but the document is still valid. See DocumentLeadTime.
+func ScheduleLeadTimeReminder(ctx context.Context, doc db.Document, leadTimeDays int) {
+	if leadTimeDays <= 0 || !doc.ExpirationDate.After(time.Now()) {
+		return
+	}
+
+	reminderTime := doc.ExpirationDate.AddDate(0, 0, -leadTimeDays)
+	if reminderTime.Before(time.Now()) {
+		reminderTime = time.Now()
+	}
+
+	payload := map[string]interface{}{
+		"user_id":     doc.UserID.String(),
+		"document_id": doc.ID.String(),
+	}
+	if doc.OrganizationID != nil {
+		payload["organization_id"] = *doc.OrganizationID
+	}
+
+	if err := enqueueDelayedTask(ctx, TaskSendLeadTimeReminder, payload, reminderTime.UTC()); err != nil {
+		logf(ctx, "Failed to enqueue lead-time reminder for doc %s: %v", doc.ID.String(), err)
+	}
+}
+
+// ScheduleDependentsNotice tells the owners of the documents depending on doc
+// that its expiration date moved from previousExpiration.
+func ScheduleDependentsNotice(ctx context.Context, doc db.Document, previousExpiration time.Time) {
+	payload := dependentsPayload(doc, DependentsExpirationChanged)
+	payload["previous_expiration_date"] = previousExpiration.Format("2006-01-02")
+	if err := enqueueDelayedTask(ctx, TaskNotifyDependents, payload, time.Now()); err != nil {
+		logf(ctx, "Failed to enqueue dependents notice for doc %s: %v", doc.ID.String(), err)
+	}
+}
+
+// ScheduleDependentsExpiryNotice tells the owners of the documents depending
+// on doc once it expires. It is queued at most once per expiration date.
+func ScheduleDependentsExpiryNotice(ctx context.Context, doc db.Document) {
+	if doc.ExpirationDate.Before(time.Now()) {
+		return
+	}
+	payload := dependentsPayload(doc, DependentsExpired)
+	taskID := fmt.Sprintf("%s:%s:%s", TaskNotifyDependents, doc.ID.String(), doc.ExpirationDate.Format("2006-01-02"))
+	err := enqueueDelayedTask(ctx, TaskNotifyDependents, payload, doc.ExpirationDate.UTC(), asynq.TaskID(taskID))
+	if err != nil && !errors.Is(err, asynq.ErrTaskIDConflict) {
+		logf(ctx, "Failed to enqueue dependents expiry notice for doc %s: %v", doc.ID.String(), err)
+	}
+}
+
+func dependentsPayload(doc db.Document, event string) map[string]interface{} {
+	payload := map[string]interface{}{
+		"user_id":         doc.UserID.String(),
+		"document_id":     doc.ID.String(),
+		"event":           event,
+		"expiration_date": doc.ExpirationDate.Format("2006-01-02"),
+	}
+	if doc.OrganizationID != nil {
+		payload["organization_id"] = *doc.OrganizationID
+	}
+	return payload
+}
+
+// ScheduleSnoozedReminder re-sends the reminder for one document and interval
+// at runAt, regardless of whether that interval already fired.
+func ScheduleSnoozedReminder(ctx context.Context, userID, documentID string, intervalID int, runAt time.Time) error {
+	payload := withTenant(ctx, map[string]interface{}{
+		"version":     reminderPayloadVersion,
+		"user_id":     userID,
+		"document_id": documentID,
+		"interval_id": intervalID,
+		"snoozed":     true,
+	})
+	return enqueueDelayedTask(ctx, TaskSendReminder, payload, runAt.UTC())
+}
+
+// ScheduleEscalation queues the escalation check for an email message at
+// runAt. The check is idempotent, so a bounce may schedule it again early.
+func ScheduleEscalation(ctx context.Context, messageID string, runAt time.Time) error {
+	payload := withTenant(ctx, map[string]interface{}{
+		"message_id": messageID,
+	})
+	return enqueueDelayedTask(ctx, TaskEscalateReminder, payload, runAt.UTC())
+}
+
+// ScheduleAssignmentEscalation queues the check, at runAt, that the assignee
+// of the documents in an email message acknowledged it.
+func ScheduleAssignmentEscalation(ctx context.Context, messageID string, runAt time.Time) error {
+	payload := withTenant(ctx, map[string]interface{}{
+		"message_id": messageID,
+	})
+	return enqueueDelayedTask(ctx, TaskEscalateAssignment, payload, runAt.UTC())
+}
+
+// ScheduleHeldReminderFlush sends the reminders held for userID at runAt,
+// when their batching window closes.
+func ScheduleHeldReminderFlush(ctx context.Context, userID string, runAt time.Time) error {
+	payload := withTenant(ctx, map[string]interface{}{
+		"user_id": userID,
+	})
+	return enqueueDelayedTask(ctx, TaskFlushHeldReminders, payload, runAt.UTC())
+}
+
+// ScheduleReminderCatchUp sends the reminders deferred while userID paused
+// notifications at runAt, when the pause ends, as one catch-up message.
+func ScheduleReminderCatchUp(ctx context.Context, userID string, runAt time.Time) error {
+	payload := withTenant(ctx, map[string]interface{}{
+		"user_id":  userID,
+		"catch_up": true,
+	})
+	return enqueueDelayedTask(ctx, TaskFlushHeldReminders, payload, runAt.UTC())
+}
+
+// EmitWebhookEvent queues event for delivery to the user's webhook endpoints.
+// data becomes the "data" field of the delivered payload.
+func EmitWebhookEvent(ctx context.Context, userID, event string, data interface{}) {
+	raw, err := json.Marshal(data)
+	if err != nil {
+		logf(ctx, "Failed to encode %s webhook event for user %s: %v", event, userID, err)
+		return
+	}
+	payload := map[string]interface{}{
+		"user_id": userID,
+		"event":   event,
+		"data":    json.RawMessage(raw),
+	}
+	if err := enqueueDelayedTask(ctx, TaskEmitWebhookEvent, payload, time.Now()); err != nil {
+		logf(ctx, "Failed to enqueue %s webhook event for user %s: %v", event, userID, err)
+	}
+}
+
+// ScheduleWebhookDelivery sends a logged webhook delivery now, retrying with
+// backoff while the endpoint keeps failing.
+func ScheduleWebhookDelivery(ctx context.Context, deliveryID string) error {
+	payload := map[string]interface{}{
+		"delivery_id": deliveryID,
+	}
+	return enqueueDelayedTask(ctx, TaskDeliverWebhook, payload, time.Now(), asynq.MaxRetry(webhookMaxRetry))
+}
+
+// ScheduleAttachmentScan queues a virus scan of a freshly uploaded attachment.
+func ScheduleAttachmentScan(ctx context.Context, documentID, attachmentURL string) error {
+	payload := withTenant(ctx, map[string]interface{}{
+		"document_id":    documentID,
+		"attachment_url": attachmentURL,
+	})
+	return enqueueDelayedTask(ctx, TaskScanAttachment, payload, time.Now(), asynq.MaxRetry(scanAttachmentMaxRetry))
+}
+
+// ScheduleAnnouncementEmail emails an announcement to every user at runAt.
+func ScheduleAnnouncementEmail(ctx context.Context, announcementID string, runAt time.Time) error {
+	payload := map[string]interface{}{
+		"announcement_id": announcementID,
+	}
+	return enqueueDelayedTask(ctx, TaskSendAnnouncement, payload, runAt.UTC())
+}
+
+// ScheduleFeedbackRelay passes feedback on to the team right away.
+func ScheduleFeedbackRelay(ctx context.Context, feedbackID string) error {
+	payload := map[string]interface{}{
+		"feedback_id": feedbackID,
+	}
+	return enqueueDelayedTask(ctx, TaskRelayFeedback, payload, time.Now(), asynq.MaxRetry(relayFeedbackMaxRetry))
+}
+
+// ScheduleSenderVerification checks the DNS record of the organization's email
+// sender now, and keeps re-checking with backoff until it shows up.
+func ScheduleSenderVerification(ctx context.Context, organizationID string) error {
+	payload := map[string]interface{}{
+		"organization_id": organizationID,
+	}
+	return enqueueDelayedTask(ctx, TaskVerifySenderDomain, payload, time.Now(), asynq.MaxRetry(senderVerificationMaxRetry))
+}
+
+// deferNotification queues n to be sent at runAt, once its paused channel is
+// tried again.
+func deferNotification(ctx context.Context, n Notification, runAt time.Time) error {
+	payload := withTenant(ctx, map[string]interface{}{
+		"notification": n,
+	})
+	return enqueueDelayedTask(ctx, TaskSendDeferredNotification, payload, runAt.UTC())
+}
+
+// ScheduleEmailConfirmation emails the link confirming that userID switches
+// to email, right away.
+func ScheduleEmailConfirmation(ctx context.Context, userID, email string) error {
+	payload := map[string]interface{}{
+		"user_id": userID,
+		"email":   email,
+	}
+	return enqueueDelayedTask(ctx, TaskSendEmailConfirmation, payload, time.Now())
+}
+
+// ScheduleJob runs a job the API created. The user it belongs to is in the
+// payload so jobs of suspended users are skipped.
+func ScheduleJob(ctx context.Context, job *db.Job) error {
+	payload := map[string]interface{}{
+		"job_id":  job.ID.String(),
+		"user_id": job.UserID,
 	}
+	return enqueueDelayedTask(ctx, TaskRunJob, payload, time.Now())
 }
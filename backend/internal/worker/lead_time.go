@@ -0,0 +1,136 @@
+package worker
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"xpired/internal/db"
+
+	"github.com/hibiken/asynq"
+)
+
+type leadTimeReminderPayload struct {
+	UserID         string `json:"user_id"`
+	DocumentID     string `json:"document_id"`
+	OrganizationID string `json:"organization_id,omitempty"`
+}
+
+// DocumentLeadTime returns how many days before expiry the "start your
+// renewal" reminder of doc goes out: its own lead time when set, otherwise
+// the processing time of its issuer. Zero means the document gets none.
+func DocumentLeadTime(ctx context.Context, repo db.DocumentRepository, doc *db.Document) int {
+	if doc.LeadTimeDays != nil {
+		return *doc.LeadTimeDays
+	}
+	if doc.IssuerID == nil {
+		return 0
+	}
+	issuer, err := repo.GetIssuer(ctx, *doc.IssuerID)
+	if err != nil {
+		if err.Error() != "issuer not found" {
+			logf(ctx, "Failed to load issuer of document %s: %v", doc.ID.String(), err)
+		}
+		return 0
+	}
+	if issuer.ProcessingDays == nil {
+		return 0
+	}
+	return *issuer.ProcessingDays
+}
+
+// handleSendLeadTimeReminder sends the "start your renewal" reminder. The
+// lead time is resolved again when it fires, so a shorter lead time set, or
+// a faster issuer processing time recorded, since the task was queued moves
+// the reminder to its new date. The reminder goes out once per expiration
+// date: tasks queued twice are harmless, and renewing the document re-arms
+// it.
+func (p *reminderProcessor) handleSendLeadTimeReminder(ctx context.Context, t *asynq.Task) error {
+	var payload leadTimeReminderPayload
+	if err := json.Unmarshal(t.Payload(), &payload); err != nil {
+		return err
+	}
+
+	doc, err := p.repo.GetDocumentByID(ctx, payload.DocumentID)
+	if err != nil {
+		if err.Error() == "document not found" {
+			logf(ctx, "Skipping lead-time reminder for deleted doc %s", payload.DocumentID)
+			return nil
+		}
+		return err
+	}
+
+	leadTimeDays := DocumentLeadTime(ctx, p.repo, doc)
+	if leadTimeDays <= 0 || !doc.ExpirationDate.After(time.Now()) {
+		return nil
+	}
+	dueAt := doc.ExpirationDate.AddDate(0, 0, -leadTimeDays)
+	if time.Until(dueAt) >= 24*time.Hour {
+		logf(ctx, "Moving lead-time reminder for doc %s to %s", payload.DocumentID, dueAt.Format("2006-01-02"))
+		ScheduleLeadTimeReminder(ctx, *doc, leadTimeDays)
+		return nil
+	}
+
+	claimed, err := p.repo.ClaimLeadTimeReminder(ctx, doc.ID.String(), doc.ExpirationDate)
+	if err != nil {
+		return err
+	}
+	if !claimed {
+		return nil
+	}
+
+	recipients := p.recipientUserIDs(ctx, payload.UserID)
+	if assigneeID := p.documentAssignee(ctx, doc); assigneeID != "" {
+		recipients = []string{assigneeID}
+	}
+	for _, recipientID := range recipients {
+		p.notifyLeadTime(ctx, recipientID, doc, payload.UserID, leadTimeDays)
+	}
+
+	logf(ctx, "Lead-time reminder: User %s should start renewing document %s (%d days)",
+		payload.UserID, doc.Name, leadTimeDays)
+	return nil
+}
+
+// notifyLeadTime sends the lead-time reminder for doc to recipientID on their
+// default channels; it is not one of the intervals of the channel matrix.
+func (p *reminderProcessor) notifyLeadTime(ctx context.Context, recipientID string, doc *db.Document, ownerID string, leadTimeDays int) {
+	channels := defaultChannels(p.notificationPreferences(ctx, recipientID))
+	expirationDate := doc.ExpirationDate.Format("January 2, 2006")
+	leadTime := processingTime(leadTimeDays)
+	viewURL := p.cfg.App.FrontendURL + "/documents/" + doc.ID.String()
+
+	if channels[ChannelEmail] {
+		userEmail, err := p.repo.GetUserEmail(ctx, recipientID)
+		if err != nil {
+			logf(ctx, "Failed to load email for user %s: %v", recipientID, err)
+			return
+		}
+		err = p.dispatcher.Send(ctx, Notification{
+			RecipientID: recipientID,
+			UserID:      ownerID,
+			DocumentID:  doc.ID.String(),
+			Channel:     ChannelEmail,
+			To:          userEmail,
+			Subject:     "Start Your Document Renewal",
+			Body:        LeadTimeEmailTemplate(userEmail, doc.Name, expirationDate, leadTime, viewURL, p.documentIssuer(ctx, doc), loadTheme(ctx, p.repo)),
+		})
+		if err != nil {
+			logf(ctx, "Failed to send email to %s: %v", userEmail, err)
+		}
+	}
+
+	if channels[ChannelSMS] {
+		userPhone, _ := p.repo.GetUserPhoneNumber(ctx, recipientID)
+		if userPhone != "" {
+			_ = p.dispatcher.Send(ctx, Notification{
+				RecipientID: recipientID,
+				UserID:      ownerID,
+				DocumentID:  doc.ID.String(),
+				Channel:     ChannelSMS,
+				To:          userPhone,
+				Body:        LeadTimeSMSMessage(doc.Name, expirationDate, leadTime),
+			})
+		}
+	}
+}
@@ -0,0 +1,30 @@
+package worker
+
+import (
+	"context"
+	"time"
+
+	"xpired/internal/db"
+)
+
+// MeterUsageJob records the monthly usage of every organization, in every
+// data region, for billing and chargeback reports. Each run meters the
+// month before too, so text messages sent in its last hour are counted.
+func MeterUsageJob(repo db.Repository) Job {
+	return Job{
+		Name:     JobMeterUsage,
+		Interval: time.Hour,
+		Run: func(ctx context.Context) error {
+			now := time.Now().UTC()
+			month := time.Date(now.Year(), now.Month(), 1, 0, 0, 0, 0, time.UTC)
+			for _, regionCtx := range regionContexts(ctx, repo) {
+				for _, m := range []time.Time{month.AddDate(0, -1, 0), month} {
+					if err := repo.MeterOrganizationUsage(regionCtx, m); err != nil {
+						return err
+					}
+				}
+			}
+			return nil
+		},
+	}
+}
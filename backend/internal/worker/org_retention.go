@@ -0,0 +1,146 @@
+package worker
+
+import (
+	"context"
+	"time"
+
+	"xpired/internal/db"
+	"xpired/internal/storage"
+
+	"github.com/google/uuid"
+)
+
+const purgeExpiredBatchSize = 100
+
+// OrganizationRetentionJob enforces the retention organizations set: it
+// purges documents that expired longer ago than they keep expired documents,
+// and deletes event log and audit log entries and notification history older
+// than they keep those. Whatever is under legal hold is kept.
+func OrganizationRetentionJob(repo db.Repository, store storage.Storage) Job {
+	return Job{
+		Name:     JobOrganizationRetention,
+		Interval: 24 * time.Hour,
+		Run: func(ctx context.Context) error {
+			retentions, err := repo.ListOrganizationRetention(ctx)
+			if err != nil {
+				return err
+			}
+			for _, retention := range retentions {
+				orgCtx, err := OrganizationContext(ctx, repo, retention.OrganizationID)
+				if err != nil {
+					logf(ctx, "Skipping retention of organization %s: %v", retention.OrganizationID, err)
+					continue
+				}
+				if err := enforceRetention(orgCtx, repo, store, retention); err != nil {
+					return err
+				}
+			}
+			return nil
+		},
+	}
+}
+
+func enforceRetention(ctx context.Context, repo db.Repository, store storage.Storage, retention *db.OrganizationRetention) error {
+	organizationID := retention.OrganizationID
+	now := time.Now()
+
+	if days := retention.ExpiredDocumentsDays; days != nil {
+		purged, err := purgeExpiredDocuments(ctx, repo, store, organizationID, now.AddDate(0, 0, -*days))
+		if err != nil {
+			return err
+		}
+		if purged > 0 {
+			logf(ctx, "Retention: purged %d documents of organization %s expired for over %d days", purged, organizationID, *days)
+		}
+	}
+
+	if days := retention.NotificationHistoryDays; days != nil {
+		deleted, err := repo.DeleteOrganizationNotificationsBefore(ctx, organizationID, now.AddDate(0, 0, -*days))
+		if err != nil {
+			return err
+		}
+		if deleted > 0 {
+			logf(ctx, "Retention: deleted %d notifications of organization %s older than %d days", deleted, organizationID, *days)
+		}
+	}
+
+	if days := retention.EventLogDays; days != nil {
+		pruned, err := repo.PruneEventsBefore(ctx, organizationID, now.AddDate(0, 0, -*days))
+		if err != nil {
+			return err
+		}
+		if pruned > 0 {
+			logf(ctx, "Retention: pruned %d event log entries of organization %s older than %d days", pruned, organizationID, *days)
+		}
+
+		deleted, err := deleteAuditLogsBefore(ctx, repo, organizationID, now.AddDate(0, 0, -*days))
+		if err != nil {
+			return err
+		}
+		if deleted > 0 {
+			logf(ctx, "Retention: deleted %d audit log entries of organization %s older than %d days", deleted, organizationID, *days)
+		}
+	}
+	return nil
+}
+
+// deleteAuditLogsBefore deletes the audit entries about an organization's
+// data recorded before cutoff, keeping those about documents under legal
+// hold, and all of them while the whole organization is.
+func deleteAuditLogsBefore(ctx context.Context, repo db.Repository, organizationID string, cutoff time.Time) (int64, error) {
+	holds, err := repo.ListLegalHolds(ctx, organizationID)
+	if err != nil {
+		return 0, err
+	}
+	var held []string
+	for _, hold := range holds {
+		if hold.DocumentID == nil {
+			return 0, nil
+		}
+		held = append(held, *hold.DocumentID)
+	}
+	return repo.DeleteOrganizationAuditLogsBefore(ctx, organizationID, cutoff, held)
+}
+
+// purgeExpiredDocuments purges the documents of an organization that
+// expired before cutoff, moving those still live to the trash first.
+func purgeExpiredDocuments(ctx context.Context, repo db.Repository, store storage.Storage, organizationID string, cutoff time.Time) (int, error) {
+	purged := 0
+	// as in purgeTrash, documents that fail are retried on the next run
+	skipped := map[uuid.UUID]bool{}
+	for {
+		docs, err := repo.ListOrganizationDocumentsExpiredBefore(ctx, organizationID, cutoff, purgeExpiredBatchSize+len(skipped))
+		if err != nil {
+			return purged, err
+		}
+
+		progressed := false
+		for _, doc := range docs {
+			if skipped[doc.ID] {
+				continue
+			}
+			if err := purgeExpiredDocument(ctx, repo, store, doc); err != nil {
+				logf(ctx, "Failed to purge expired document %s: %v", doc.ID.String(), err)
+				skipped[doc.ID] = true
+				continue
+			}
+			purged++
+			progressed = true
+		}
+
+		if !progressed || len(docs) < purgeExpiredBatchSize+len(skipped) {
+			return purged, nil
+		}
+	}
+}
+
+func purgeExpiredDocument(ctx context.Context, repo db.Repository, store storage.Storage, doc *db.Document) error {
+	if doc.DeletedAt == nil {
+		if err := repo.DeleteDocument(ctx, doc.ID.String()); err != nil {
+			return err
+		}
+		trashedAt := time.Now()
+		doc.DeletedAt = &trashedAt
+	}
+	return purgeDocument(ctx, repo, store, doc)
+}
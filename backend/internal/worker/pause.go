@@ -0,0 +1,172 @@
+package worker
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"xpired/internal/db"
+
+	"github.com/google/uuid"
+)
+
+// pausedUntil returns when userID's notification pause ends, or the zero
+// time when their notifications are not paused.
+func (p *reminderProcessor) pausedUntil(ctx context.Context, userID string) time.Time {
+	prefs := p.notificationPreferences(ctx, userID)
+	if prefs.PausedUntil == nil || !prefs.PausedUntil.After(time.Now()) {
+		return time.Time{}
+	}
+	return *prefs.PausedUntil
+}
+
+// deferPausedReminders holds the reminders while userID paused
+// notifications, scheduling the catch-up for when the pause ends if none is
+// waiting yet. It reports whether the reminders were deferred; if not, the
+// caller sends them right away.
+func (p *reminderProcessor) deferPausedReminders(ctx context.Context, userID string, documentIDs []string, intervalID int) bool {
+	until := p.pausedUntil(ctx, userID)
+	if until.IsZero() {
+		return false
+	}
+
+	opened := false
+	for _, documentID := range documentIDs {
+		first, err := p.repo.HoldReminder(ctx, userID, documentID, intervalID, documentRequestID(ctx, documentID))
+		if err != nil {
+			logf(ctx, "Failed to defer reminder for doc %s, sending now: %v", documentID, err)
+			return false
+		}
+		opened = opened || first
+	}
+
+	// reminders already held for a batching window are flushed into the
+	// catch-up by their own flush
+	if opened {
+		if err := ScheduleReminderCatchUp(ctx, userID, until); err != nil {
+			logf(ctx, "Failed to schedule reminder catch-up for user %s: %v", userID, err)
+		}
+	}
+
+	transitionReminders(ctx, p.repo, documentIDs, intervalID, db.ReminderQueued)
+	logf(ctx, "Deferring %d reminder(s) for user %s until %s (interval=%d)",
+		len(documentIDs), userID, until.Format(time.RFC3339), intervalID)
+	return true
+}
+
+// sendCatchUp sends the reminders deferred while userID paused
+// notifications that are still due, as one message per recipient and
+// channel listing each document once, however many of its reminders came
+// due.
+func (p *reminderProcessor) sendCatchUp(ctx context.Context, userID string, held []*db.HeldReminder) {
+	var (
+		docs        []*db.Document
+		items       []DigestItem
+		documentIDs []string
+		intervalID  int
+		sent        []*db.HeldReminder
+	)
+	ctx = withDocumentRequestIDs(ctx, heldRequestIDs(held))
+	due := map[string]*db.Document{}
+	for _, reminder := range held {
+		doc, ok := due[reminder.DocumentID]
+		if !ok {
+			var err error
+			doc, err = p.repo.GetDocumentByID(ctx, reminder.DocumentID)
+			if err != nil {
+				logf(ctx, "Skipping document %s in reminder catch-up: %v", reminder.DocumentID, err)
+				continue
+			}
+		}
+		if !p.reminderStillDue(ctx, doc, reminder.ReminderIntervalID) {
+			logf(ctx, "Skipping stale reminder for doc %s (interval %d)", reminder.DocumentID, reminder.ReminderIntervalID)
+			continue
+		}
+		sent = append(sent, reminder)
+		if ok {
+			continue
+		}
+
+		due[reminder.DocumentID] = doc
+		docs = append(docs, doc)
+		items = append(items, DigestItem{
+			DocumentName:   doc.Name,
+			ExpirationDate: doc.ExpirationDate.Format("January 2, 2006"),
+			ViewURL:        p.actionLinks(ctx, userID, doc.ID.String(), reminder.ReminderIntervalID).View,
+			Issuer:         p.documentIssuer(ctx, doc),
+		})
+		documentIDs = append(documentIDs, doc.ID.String())
+		// notification logs take one interval; the first still due stands
+		// in for the rest
+		if intervalID == 0 {
+			intervalID = reminder.ReminderIntervalID
+		}
+	}
+	if len(docs) == 0 {
+		return
+	}
+
+	ctx = trackDeliveries(ctx)
+	for _, doc := range docs {
+		p.notifyDocumentContacts(ctx, doc, userID, intervalID)
+	}
+	for _, recipientID := range p.recipientUserIDs(ctx, userID) {
+		p.notifyCatchUp(ctx, recipientID, items, documentIDs, userID, intervalID)
+	}
+	for _, reminder := range sent {
+		p.markReminderSent(ctx, due[reminder.DocumentID], userID, reminder.ReminderIntervalID)
+	}
+
+	logf(ctx, "Reminder catch-up: User %s notified about %d documents after their pause", userID, len(docs))
+}
+
+// notifyCatchUp sends the catch-up message to recipientID on their default
+// channels. The channel matrix does not apply, as the message spans
+// intervals.
+func (p *reminderProcessor) notifyCatchUp(ctx context.Context, recipientID string, items []DigestItem, documentIDs []string, ownerID string, intervalID int) {
+	prefs := p.notificationPreferences(ctx, recipientID)
+	channels := defaultChannels(prefs)
+	subject := fmt.Sprintf("While you were away: %d documents need attention", len(items))
+
+	if channels[ChannelEmail] {
+		userEmail, err := p.repo.GetUserEmail(ctx, recipientID)
+		if err != nil {
+			logf(ctx, "Failed to load email for user %s: %v", recipientID, err)
+			return
+		}
+
+		messageID := uuid.New()
+		email := CatchUpEmailTemplate(userEmail, items, p.trackOpenURL(messageID), loadTheme(ctx, p.repo))
+		err = p.dispatcher.Send(ctx, Notification{
+			MessageID:        messageID,
+			RecipientID:      recipientID,
+			UserID:           ownerID,
+			DocumentID:       documentIDs[0],
+			IntervalID:       intervalID,
+			Channel:          ChannelEmail,
+			To:               userEmail,
+			Subject:          subject,
+			Body:             email,
+			BatchDocumentIDs: documentIDs,
+		})
+		if err != nil {
+			logf(ctx, "Failed to send catch-up email to %s: %v", userEmail, err)
+		}
+	}
+
+	if channels[ChannelSMS] {
+		userPhone, _ := p.repo.GetUserPhoneNumber(ctx, recipientID)
+		if userPhone != "" {
+			_ = p.dispatcher.Send(ctx, Notification{
+				RecipientID:      recipientID,
+				UserID:           ownerID,
+				DocumentID:       documentIDs[0],
+				IntervalID:       intervalID,
+				Channel:          ChannelSMS,
+				To:               userPhone,
+				Body:             CatchUpSMSMessage(len(items)),
+				BatchDocumentIDs: documentIDs,
+			})
+		}
+	}
+}
@@ -0,0 +1,127 @@
+package worker
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// Versions of the reminder payloads this worker writes. Queued tasks outlive
+// deploys, so a worker may pick up a payload an older API queued, or a newer
+// one during a rolling deploy. Decoding migrates older payloads a version at
+// a time up to the current one; a payload newer than the worker fails
+// without skipping retries, so it waits in the queue for an upgraded worker.
+//
+// Bump a version whenever a field changes meaning or is renamed or removed,
+// adding a step to the matching migrate function. Adding an optional field
+// needs no new version.
+const (
+	reminderPayloadVersion      = 1
+	reminderBatchPayloadVersion = 1
+)
+
+type reminderPayload struct {
+	// Version is 0 in payloads queued before payloads were versioned, which
+	// have the shape of version 1.
+	Version        int    `json:"version"`
+	UserID         string `json:"user_id"`
+	DocumentID     string `json:"document_id"`
+	IntervalID     int    `json:"interval_id"`
+	Snoozed        bool   `json:"snoozed,omitempty"`
+	OrganizationID string `json:"organization_id,omitempty"`
+	// Delayed marks a reminder already delayed to the user's send time.
+	Delayed   bool   `json:"delayed,omitempty"`
+	RequestID string `json:"request_id,omitempty"`
+}
+
+type reminderBatchPayload struct {
+	Version        int      `json:"version"`
+	UserID         string   `json:"user_id"`
+	IntervalID     int      `json:"interval_id"`
+	DocumentIDs    []string `json:"document_ids"`
+	OrganizationID string   `json:"organization_id,omitempty"`
+	Delayed        bool     `json:"delayed,omitempty"`
+	// RequestIDs traces each document to the request that scheduled its
+	// reminder, as the reminders of a batch are scheduled one by one.
+	RequestIDs map[string]string `json:"request_ids,omitempty"`
+}
+
+func decodeReminderPayload(data []byte) (reminderPayload, error) {
+	var raw map[string]json.RawMessage
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return reminderPayload{}, err
+	}
+	version, err := payloadVersion(raw, reminderPayloadVersion)
+	if err != nil {
+		return reminderPayload{}, err
+	}
+	for ; version < reminderPayloadVersion; version++ {
+		migrateReminderPayload(raw, version)
+	}
+
+	var payload reminderPayload
+	if err := remarshal(raw, &payload); err != nil {
+		return reminderPayload{}, err
+	}
+	payload.Version = reminderPayloadVersion
+	return payload, nil
+}
+
+// migrateReminderPayload upgrades raw from version to the next one.
+func migrateReminderPayload(raw map[string]json.RawMessage, version int) {
+	switch version {
+	case 0:
+		// unversioned payloads already have the shape of version 1
+	}
+}
+
+func decodeReminderBatchPayload(data []byte) (reminderBatchPayload, error) {
+	var raw map[string]json.RawMessage
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return reminderBatchPayload{}, err
+	}
+	version, err := payloadVersion(raw, reminderBatchPayloadVersion)
+	if err != nil {
+		return reminderBatchPayload{}, err
+	}
+	for ; version < reminderBatchPayloadVersion; version++ {
+		migrateReminderBatchPayload(raw, version)
+	}
+
+	var payload reminderBatchPayload
+	if err := remarshal(raw, &payload); err != nil {
+		return reminderBatchPayload{}, err
+	}
+	payload.Version = reminderBatchPayloadVersion
+	return payload, nil
+}
+
+// migrateReminderBatchPayload upgrades raw from version to the next one.
+func migrateReminderBatchPayload(raw map[string]json.RawMessage, version int) {
+	switch version {
+	case 0:
+		// unversioned payloads already have the shape of version 1
+	}
+}
+
+// payloadVersion returns the version of the raw payload, failing when it is
+// newer than current, the version this worker understands.
+func payloadVersion(raw map[string]json.RawMessage, current int) (int, error) {
+	var version int
+	if v, ok := raw["version"]; ok {
+		if err := json.Unmarshal(v, &version); err != nil {
+			return 0, fmt.Errorf("invalid payload version: %w", err)
+		}
+	}
+	if version > current {
+		return 0, fmt.Errorf("payload version %d is newer than supported version %d", version, current)
+	}
+	return version, nil
+}
+
+func remarshal(raw map[string]json.RawMessage, v interface{}) error {
+	data, err := json.Marshal(raw)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(data, v)
+}
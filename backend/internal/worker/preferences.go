@@ -0,0 +1,49 @@
+package worker
+
+import (
+	"context"
+
+	"xpired/internal/db"
+)
+
+func (p *reminderProcessor) notificationPreferences(ctx context.Context, userID string) *db.NotificationPreferences {
+	prefs, err := p.repo.GetNotificationPreferences(ctx, userID)
+	if err != nil {
+		logf(ctx, "Failed to load notification preferences for user %s: %v", userID, err)
+		return db.DefaultNotificationPreferences(userID)
+	}
+	return prefs
+}
+
+// deliveryChannels returns the channels a reminder for intervalID goes out
+// on. An entry in the user's channel matrix wins; otherwise reminders go by
+// email, plus SMS unless the user only wants SMS as an escalation fallback.
+func (p *reminderProcessor) deliveryChannels(ctx context.Context, prefs *db.NotificationPreferences, intervalID int) map[string]bool {
+	interval, err := p.repo.GetReminderIntervalByID(ctx, intervalID)
+	if err == nil {
+		if channels, ok := prefs.ChannelMatrix[interval.IdLabel]; ok {
+			set := make(map[string]bool, len(channels))
+			for _, channel := range channels {
+				set[channel] = true
+			}
+			return set
+		}
+	}
+
+	return defaultChannels(prefs)
+}
+
+// defaultChannels are the user's preferred channels outside the channel
+// matrix: email, plus SMS unless SMS is only an escalation fallback.
+func defaultChannels(prefs *db.NotificationPreferences) map[string]bool {
+	return map[string]bool{
+		ChannelEmail: true,
+		ChannelSMS:   prefs.EscalationChannel != db.EscalationSMS,
+	}
+}
+
+// escalates reports whether an email sent on channels should be followed
+// up by SMS when it bounces or goes unopened.
+func escalates(prefs *db.NotificationPreferences, channels map[string]bool) bool {
+	return prefs.EscalationChannel == db.EscalationSMS && channels[ChannelEmail] && !channels[ChannelSMS]
+}
@@ -0,0 +1,50 @@
+package worker
+
+import (
+	"context"
+	"fmt"
+
+	"xpired/internal/config"
+	"xpired/internal/db"
+)
+
+// ReminderPreview is a reminder as it would reach its recipient on one
+// channel. Emails have a subject and HTML, texts only Text, and push
+// notifications a subject and Text.
+type ReminderPreview struct {
+	Channel string
+	Subject string
+	HTML    string
+	Text    string
+}
+
+// PreviewReminder renders the reminder about doc that recipientID would
+// receive on channel, with the theme of the organization in ctx, without
+// sending anything. Its links lead to the document rather than being signed
+// action links, and emails carry no open-tracking pixel, so opening a
+// preview is never taken for opening a reminder.
+func PreviewReminder(ctx context.Context, repo db.Repository, cfg *config.Config, doc *db.Document, recipientID, channel string) (*ReminderPreview, error) {
+	p := &reminderProcessor{repo: repo, cfg: cfg}
+	expirationDate := doc.ExpirationDate.Format("January 2, 2006")
+	viewURL := cfg.App.FrontendURL + "/documents/" + doc.ID.String()
+
+	preview := &ReminderPreview{Channel: channel}
+	switch channel {
+	case ChannelEmail:
+		userEmail, err := repo.GetUserEmail(ctx, recipientID)
+		if err != nil {
+			return nil, err
+		}
+		links := ReminderLinks{View: viewURL, Renewed: viewURL, Snooze: viewURL}
+		preview.Subject = reminderSubject
+		preview.HTML = EmailTemplate(userEmail, doc.Name, expirationDate, links, p.documentIssuer(ctx, doc), loadTheme(ctx, repo))
+	case ChannelSMS:
+		preview.Text = SMSMessage(doc.Name, expirationDate, viewURL)
+	case ChannelPush:
+		preview.Subject = reminderSubject
+		preview.Text = SMSMessage(doc.Name, expirationDate, viewURL)
+	default:
+		return nil, fmt.Errorf("unsupported channel %q", channel)
+	}
+	return preview, nil
+}
@@ -1,15 +1,59 @@
 package worker
 
-import "log"
+import (
+	"context"
+)
 
-func SendEmail(to, subject, body string) error {
+// SendEmail sends an email from the given From header; an empty from uses
+// the platform's default sender. Like the other providers, it gives up when
+// ctx is done.
+func SendEmail(ctx context.Context, from, to, subject, body string) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
 	// Simulate sending email
-	log.Printf("Sending email to: %s, Subject: %s", to, subject)
+	logf(ctx, "Sending email from: %q to: %s, Subject: %s", from, to, subject)
 	return nil
 }
 
-func SendSMS(to, message string) error {
+// SendCalendarEmail sends an email whose body comes with invite, an
+// iCalendar REQUEST, as a text/calendar alternative part, which mail clients
+// offer to add to the calendar.
+func SendCalendarEmail(ctx context.Context, from, to, subject, body, invite string) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	// Simulate sending email
+	logf(ctx, "Sending calendar invite email from: %q to: %s, Subject: %s", from, to, subject)
+	return nil
+}
+
+// SendListEmail sends an email with List-Unsubscribe and List-Unsubscribe-Post
+// headers (RFC 8058), so mail clients offer to unsubscribe in one click by
+// POSTing to unsubscribeURL.
+func SendListEmail(ctx context.Context, from, to, subject, body, unsubscribeURL string) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	// Simulate sending email
+	logf(ctx, "Sending email from: %q to: %s, Subject: %s, List-Unsubscribe: <%s>, List-Unsubscribe-Post: List-Unsubscribe=One-Click", from, to, subject, unsubscribeURL)
+	return nil
+}
+
+func SendSMS(ctx context.Context, to, message string) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
 	// Simulate sending SMS
-	log.Printf("Sending SMS to: %s, Message: %s", to, message)
+	logf(ctx, "Sending SMS to: %s, Message: %s", to, message)
+	return nil
+}
+
+func SendPush(ctx context.Context, userID, title, message string) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	// Simulate sending a push notification to the user's devices
+	logf(ctx, "Sending push to user: %s, Title: %s, Message: %s", userID, title, message)
 	return nil
 }
@@ -0,0 +1,188 @@
+package worker
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"time"
+
+	"xpired/internal/config"
+	"xpired/internal/db"
+	"xpired/internal/tenant"
+
+	"github.com/hibiken/asynq"
+)
+
+// Quotas a plan can cap; see config.QuotasConfig.
+const (
+	QuotaDocuments  = "documents"
+	QuotaMonthlySMS = "monthly_sms"
+)
+
+// ErrDocumentQuotaReached is returned when creating a document would take
+// its owner past the document quota of their plan.
+var ErrDocumentQuotaReached = errors.New("document quota of the plan reached")
+
+// ErrSMSQuotaReached is returned by Send for a text message to a user who
+// used up the monthly SMS quota of their plan.
+var ErrSMSQuotaReached = errors.New("monthly SMS quota of the plan reached")
+
+// quotas mirrors config.QuotasConfig for both the API and the worker.
+var quotas config.QuotasConfig
+
+// QuotaUsage is how much of a quota of their plan a user used.
+type QuotaUsage struct {
+	Quota string
+	Used  int
+	// Limit is the cap of the user's plan; 0 means there is none.
+	Limit int
+	// ResetsAt is when a monthly quota starts over; zero for the others.
+	ResetsAt time.Time
+}
+
+// Reached reports whether the user is at the limit.
+func (u QuotaUsage) Reached() bool {
+	return u.Limit > 0 && u.Used >= u.Limit
+}
+
+// Near reports whether the user used enough of the quota to be warned.
+func (u QuotaUsage) Near() bool {
+	return u.Limit > 0 && u.Used*100 >= u.Limit*quotas.WarningPercent
+}
+
+// UserQuotaUsage returns what userID used of each quota of their plan.
+func UserQuotaUsage(ctx context.Context, repo db.Repository, userID string) ([]QuotaUsage, error) {
+	documents, err := DocumentQuotaUsage(ctx, repo, userID)
+	if err != nil {
+		return nil, err
+	}
+	sms, err := smsQuotaUsage(ctx, repo, userID, time.Now())
+	if err != nil {
+		return nil, err
+	}
+	return []QuotaUsage{documents, sms}, nil
+}
+
+// DocumentQuotaUsage returns how many of the documents their plan allows
+// userID has in the tenant of ctx. Plans cap personal documents only, so
+// within an organization there is no limit.
+func DocumentQuotaUsage(ctx context.Context, repo db.Repository, userID string) (QuotaUsage, error) {
+	stats, err := repo.GetDocumentStats(ctx, userID)
+	if err != nil {
+		return QuotaUsage{}, err
+	}
+	usage := QuotaUsage{Quota: QuotaDocuments, Used: stats.Total}
+	if tenant.OrganizationID(ctx) != "" {
+		return usage, nil
+	}
+
+	plan, err := repo.GetUserPlan(ctx, userID)
+	if err != nil {
+		return QuotaUsage{}, err
+	}
+	usage.Limit = quotas.Documents[plan]
+	return usage, nil
+}
+
+// smsQuotaUsage returns how many of the text messages their plan allows per
+// month userID was sent in the month of now.
+func smsQuotaUsage(ctx context.Context, repo db.Repository, userID string, now time.Time) (QuotaUsage, error) {
+	plan, err := repo.GetUserPlan(ctx, userID)
+	if err != nil {
+		return QuotaUsage{}, err
+	}
+	now = now.UTC()
+	month := time.Date(now.Year(), now.Month(), 1, 0, 0, 0, 0, time.UTC)
+	sent, err := repo.CountSMSSent(ctx, userID, month)
+	if err != nil {
+		return QuotaUsage{}, err
+	}
+	return QuotaUsage{Quota: QuotaMonthlySMS, Used: sent, Limit: quotas.MonthlySMS[plan], ResetsAt: month.AddDate(0, 1, 0)}, nil
+}
+
+// WarnNearQuota emails userID that they are nearing a quota when usage is
+// past the warning threshold, at most once a month per quota.
+func WarnNearQuota(ctx context.Context, repo db.Repository, userID string, usage QuotaUsage) {
+	if !usage.Near() {
+		return
+	}
+
+	claimed, err := repo.ClaimQuotaWarning(ctx, userID, usage.Quota, time.Now().UTC().Format("2006-01"))
+	if err != nil {
+		logf(ctx, "Failed to claim %s quota warning of user %s: %v", usage.Quota, userID, err)
+		return
+	}
+	if !claimed {
+		return
+	}
+	if err := enqueueDelayedTask(ctx, TaskSendQuotaWarning, map[string]interface{}{
+		"user_id":   userID,
+		"quota":     usage.Quota,
+		"used":      usage.Used,
+		"limit":     usage.Limit,
+		"resets_at": usage.ResetsAt,
+	}, time.Now()); err != nil {
+		logf(ctx, "Failed to schedule %s quota warning of user %s: %v", usage.Quota, userID, err)
+	}
+}
+
+type quotaWarningPayload struct {
+	UserID   string    `json:"user_id"`
+	Quota    string    `json:"quota"`
+	Used     int       `json:"used"`
+	Limit    int       `json:"limit"`
+	ResetsAt time.Time `json:"resets_at"`
+}
+
+type quotaProcessor struct {
+	repo        db.Repository
+	frontendURL string
+	dryRun      bool
+}
+
+// handleSendQuotaWarning emails a user that they are nearing a quota.
+func (p *quotaProcessor) handleSendQuotaWarning(ctx context.Context, t *asynq.Task) error {
+	var payload quotaWarningPayload
+	if err := json.Unmarshal(t.Payload(), &payload); err != nil {
+		return err
+	}
+
+	user, err := p.repo.GetUserByID(ctx, payload.UserID)
+	if err != nil {
+		return err
+	}
+	usage := QuotaUsage{Quota: payload.Quota, Used: payload.Used, Limit: payload.Limit, ResetsAt: payload.ResetsAt}
+	if p.dryRun {
+		logf(ctx, "[dry-run] %s quota warning to %s not sent (%d of %d)", usage.Quota, user.Email, usage.Used, usage.Limit)
+		return nil
+	}
+	return SendEmail(ctx, "", user.Email, "You are nearing a limit of your plan", QuotaWarningEmailTemplate(user.Name, usage, p.frontendURL))
+}
+
+// smsQuota holds text messages to users to the monthly SMS quota of their
+// plan.
+type smsQuota struct {
+	repo db.Repository
+}
+
+// reached reports whether recipientID used up their SMS quota. Messages go
+// out when usage cannot be read.
+func (q *smsQuota) reached(ctx context.Context, recipientID string) bool {
+	usage, err := smsQuotaUsage(ctx, q.repo, recipientID, time.Now())
+	if err != nil {
+		logf(ctx, "Failed to read SMS quota usage of user %s: %v", recipientID, err)
+		return false
+	}
+	return usage.Reached()
+}
+
+// sent warns recipientID, after a text message went out to them, when they
+// are nearing their SMS quota.
+func (q *smsQuota) sent(ctx context.Context, recipientID string) {
+	usage, err := smsQuotaUsage(ctx, q.repo, recipientID, time.Now())
+	if err != nil {
+		logf(ctx, "Failed to read SMS quota usage of user %s: %v", recipientID, err)
+		return
+	}
+	WarnNearQuota(ctx, q.repo, recipientID, usage)
+}
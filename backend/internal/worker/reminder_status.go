@@ -0,0 +1,86 @@
+package worker
+
+import (
+	"context"
+
+	"xpired/internal/db"
+)
+
+// Outcomes of the sends of a reminder, worst first. A document's reminder
+// takes the best outcome of the messages it went out in.
+const (
+	deliveryFailed = iota + 1
+	deliveryDeferred
+	deliveryDelivered
+)
+
+type deliveriesKey struct{}
+
+// trackDeliveries returns ctx tallying the outcome of the sends made with it
+// per document, for reminderStatus to read once every channel was tried.
+func trackDeliveries(ctx context.Context) context.Context {
+	return context.WithValue(ctx, deliveriesKey{}, map[string]int{})
+}
+
+// noteDelivery records outcome for every document n covers, when ctx tracks
+// deliveries.
+func noteDelivery(ctx context.Context, n Notification, outcome int) {
+	tally, ok := ctx.Value(deliveriesKey{}).(map[string]int)
+	if !ok {
+		return
+	}
+	for _, documentID := range n.documentIDs() {
+		if outcome > tally[documentID] {
+			tally[documentID] = outcome
+		}
+	}
+}
+
+// reminderStatus returns the status the reminder of documentID is left in
+// by the sends tracked in ctx: sent when a message went out, or none was
+// due on any channel; queued when held back by a paused channel; failed
+// when every send failed.
+func reminderStatus(ctx context.Context, documentID string) string {
+	tally, _ := ctx.Value(deliveriesKey{}).(map[string]int)
+	switch tally[documentID] {
+	case deliveryFailed:
+		return db.ReminderFailed
+	case deliveryDeferred:
+		return db.ReminderQueued
+	}
+	return db.ReminderSent
+}
+
+// transitionReminders moves the reminders of documentIDs for intervalID to
+// status, where db.ReminderTransitions allows it.
+func transitionReminders(ctx context.Context, repo db.ReminderRepository, documentIDs []string, intervalID int, status string) {
+	for _, documentID := range documentIDs {
+		if _, err := repo.TransitionDocumentReminder(ctx, documentID, intervalID, status); err != nil {
+			logf(ctx, "Failed to move reminder for doc %s (interval %d) to %s: %v", documentID, intervalID, status, err)
+		}
+	}
+}
+
+// currentReminderStatus returns the status of the reminder of documentID for
+// intervalID, or "" when it cannot be told.
+func currentReminderStatus(ctx context.Context, repo db.ReminderRepository, documentID string, intervalID int) string {
+	reminders, err := repo.GetDocumentRemindersByDocumentID(ctx, documentID)
+	if err != nil {
+		logf(ctx, "Failed to load reminders for doc %s: %v", documentID, err)
+		return ""
+	}
+	for _, reminder := range reminders {
+		if reminder.ReminderIntervalID == intervalID {
+			return reminder.Status
+		}
+	}
+	return ""
+}
+
+// reminderFollowedUp reports whether the user acknowledged or snoozed the
+// reminder of documentID for intervalID from one of its links, on any
+// channel. Sends of it still pending on other channels are then dropped.
+func reminderFollowedUp(ctx context.Context, repo db.ReminderRepository, documentID string, intervalID int) bool {
+	status := currentReminderStatus(ctx, repo, documentID, intervalID)
+	return status == db.ReminderAcknowledged || status == db.ReminderSnoozed
+}
@@ -0,0 +1,538 @@
+package worker
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"time"
+
+	"xpired/internal/auth"
+	"xpired/internal/config"
+	"xpired/internal/db"
+	"xpired/internal/tenant"
+
+	"github.com/google/uuid"
+	"github.com/hibiken/asynq"
+)
+
+// reminderSubject is the subject of reminders about a single document.
+const reminderSubject = "Document Expiration Reminder"
+
+type reminderProcessor struct {
+	repo       db.Repository
+	cfg        *config.Config
+	dispatcher *Dispatcher
+}
+
+// aggregateReminders merges the send_reminder tasks of one reminder group into
+// a single send_reminder_batch task. A group of one is passed through as-is.
+func aggregateReminders(group string, tasks []*asynq.Task) *asynq.Task {
+	if len(tasks) == 1 {
+		return tasks[0]
+	}
+
+	batch := reminderBatchPayload{Version: reminderBatchPayloadVersion}
+	for _, t := range tasks {
+		// the batch takes only fields every version of the payload has, so
+		// reminders queued by a newer API are batched too
+		var payload reminderPayload
+		if err := json.Unmarshal(t.Payload(), &payload); err != nil {
+			log.Printf("Dropping malformed reminder in group %s: %v", group, err)
+			continue
+		}
+		batch.UserID = payload.UserID
+		batch.IntervalID = payload.IntervalID
+		batch.OrganizationID = payload.OrganizationID
+		batch.DocumentIDs = append(batch.DocumentIDs, payload.DocumentID)
+		if payload.RequestID != "" {
+			if batch.RequestIDs == nil {
+				batch.RequestIDs = map[string]string{}
+			}
+			batch.RequestIDs[payload.DocumentID] = payload.RequestID
+		}
+	}
+
+	data, _ := json.Marshal(batch)
+	return asynq.NewTask(TaskSendReminderBatch, data)
+}
+
+func (p *reminderProcessor) handleSendReminder(ctx context.Context, t *asynq.Task) error {
+	payload, err := decodeReminderPayload(t.Payload())
+	if err != nil {
+		return err
+	}
+
+	if _, err := p.repo.GetUserEmail(ctx, payload.UserID); err != nil {
+		return err
+	}
+
+	doc, err := p.repo.GetDocumentByID(ctx, payload.DocumentID)
+	if err != nil {
+		return err
+	}
+
+	if !payload.Snoozed && !p.reminderStillDue(ctx, doc, payload.IntervalID) {
+		logf(ctx, "Skipping stale reminder for doc %s (interval %d)", payload.DocumentID, payload.IntervalID)
+		return nil
+	}
+
+	if p.deferPausedReminders(ctx, payload.UserID, []string{payload.DocumentID}, payload.IntervalID) {
+		return nil
+	}
+
+	if !payload.Delayed {
+		delayed := payload
+		delayed.Delayed = true
+		if p.delayToSendTime(ctx, TaskSendReminder, payload.UserID, []string{payload.DocumentID}, payload.IntervalID, delayed) {
+			return nil
+		}
+	}
+
+	if !payload.Snoozed && p.holdReminders(ctx, payload.UserID, []string{payload.DocumentID}, payload.IntervalID) {
+		return nil
+	}
+
+	ctx = trackDeliveries(ctx)
+	p.notifyRecipients(ctx, []*db.Document{doc}, payload.UserID, payload.IntervalID)
+
+	p.notifyDocumentContacts(ctx, doc, payload.UserID, payload.IntervalID)
+
+	p.markReminderSent(ctx, doc, payload.UserID, payload.IntervalID)
+
+	logf(ctx, "Reminder: User %s should be notified about document %s (interval=%d)",
+		payload.UserID, doc.Name, payload.IntervalID)
+
+	return nil
+}
+
+// markReminderSent records that the interval fired for doc, moving its
+// reminder to the status the sends tracked in ctx left it in, and notifies
+// the owner's webhooks.
+func (p *reminderProcessor) markReminderSent(ctx context.Context, doc *db.Document, ownerID string, intervalID int) {
+	if err := p.repo.MarkDocumentReminderSent(ctx, doc.ID.String(), intervalID); err != nil {
+		logf(ctx, "Failed to mark reminder sent for doc %s: %v", doc.ID.String(), err)
+	}
+	transitionReminders(ctx, p.repo, []string{doc.ID.String()}, intervalID, reminderStatus(ctx, doc.ID.String()))
+
+	EmitWebhookEvent(ctx, ownerID, db.WebhookEventReminderSent, map[string]interface{}{
+		"documentId":     doc.ID.String(),
+		"documentName":   doc.Name,
+		"expirationDate": doc.ExpirationDate.Format("2006-01-02"),
+		"intervalId":     intervalID,
+	})
+}
+
+// reminderStillDue reports whether a queued reminder should still fire: its
+// interval must be enabled on the document, and the document must not have
+// been renewed since the task was scheduled. A renewal pushes the reminder
+// date out and schedules fresh tasks, so the old task becomes a no-op.
+func (p *reminderProcessor) reminderStillDue(ctx context.Context, doc *db.Document, intervalID int) bool {
+	reminders, err := p.repo.GetDocumentRemindersByDocumentID(ctx, doc.ID.String())
+	if err != nil {
+		logf(ctx, "Failed to load reminders for doc %s: %v", doc.ID.String(), err)
+		return true
+	}
+
+	enabled := false
+	for _, reminder := range reminders {
+		if reminder.ReminderIntervalID == intervalID && reminder.Enabled {
+			enabled = true
+		}
+	}
+	if !enabled {
+		return false
+	}
+
+	interval, err := p.repo.GetReminderIntervalByID(ctx, intervalID)
+	if err != nil {
+		return true
+	}
+	dueAt := doc.ExpirationDate.AddDate(0, 0, -interval.DaysBefore)
+	return time.Until(dueAt) < 24*time.Hour
+}
+
+// actionLinks signs the view/renewed/snooze links for a reminder.
+func (p *reminderProcessor) actionLinks(ctx context.Context, ownerID, documentID string, intervalID int) ReminderLinks {
+	sign := func(action string) string {
+		token, err := auth.GenerateActionToken(ownerID, auth.ActionClaims{
+			Action:         action,
+			DocumentID:     documentID,
+			IntervalID:     intervalID,
+			SnoozeDays:     snoozeDays(action),
+			OrganizationID: tenant.OrganizationID(ctx),
+		}, auth.ActionLinkTTL)
+		if err != nil {
+			logf(ctx, "Failed to sign %s link for doc %s: %v", action, documentID, err)
+			return p.cfg.App.FrontendURL + "/documents/" + documentID
+		}
+		return p.cfg.App.FrontendURL + "/links/" + token
+	}
+
+	return ReminderLinks{
+		View:    sign(auth.ActionView),
+		Renewed: sign(auth.ActionRenewed),
+		Snooze:  sign(auth.ActionSnooze),
+	}
+}
+
+func snoozeDays(action string) int {
+	if action == auth.ActionSnooze {
+		return 7
+	}
+	return 0
+}
+
+// reminderRecipient is a user a reminder goes to. Assignee marks the member
+// the documents are assigned to, whose reminders escalate to the
+// organization's admins when unacknowledged.
+type reminderRecipient struct {
+	UserID   string
+	Assignee bool
+}
+
+// documentRecipients returns who the reminder for doc at intervalID goes to:
+// its assignee, or the owner as routed by their household, widened by the
+// organization's escalation policy if it has one.
+func (p *reminderProcessor) documentRecipients(ctx context.Context, doc *db.Document, ownerID string, intervalID int) []reminderRecipient {
+	var responsible []reminderRecipient
+	if assigneeID := p.documentAssignee(ctx, doc); assigneeID != "" {
+		responsible = []reminderRecipient{{UserID: assigneeID, Assignee: true}}
+	} else {
+		for _, recipientID := range p.recipientUserIDs(ctx, ownerID) {
+			responsible = append(responsible, reminderRecipient{UserID: recipientID})
+		}
+	}
+
+	if doc.OrganizationID == nil {
+		return responsible
+	}
+	return p.escalationRecipients(ctx, *doc.OrganizationID, intervalID, responsible)
+}
+
+// notifyRecipients sends the reminders for docs, one message per recipient
+// and channel: a recipient of several documents gets a batched message.
+func (p *reminderProcessor) notifyRecipients(ctx context.Context, docs []*db.Document, ownerID string, intervalID int) {
+	var recipients []reminderRecipient
+	docsByRecipient := map[reminderRecipient][]*db.Document{}
+	for _, doc := range docs {
+		for _, recipient := range p.documentRecipients(ctx, doc, ownerID, intervalID) {
+			if _, ok := docsByRecipient[recipient]; !ok {
+				recipients = append(recipients, recipient)
+			}
+			docsByRecipient[recipient] = append(docsByRecipient[recipient], doc)
+		}
+	}
+
+	for _, recipient := range recipients {
+		recipientDocs := docsByRecipient[recipient]
+		switch {
+		case recipient.Assignee:
+			p.notifyAssignee(ctx, recipient.UserID, recipientDocs, ownerID, intervalID)
+		case len(recipientDocs) == 1:
+			p.notifyUser(ctx, recipient.UserID, recipientDocs[0], ownerID, intervalID)
+		default:
+			p.notifyUserBatch(ctx, recipient.UserID, recipientDocs, ownerID, intervalID)
+		}
+	}
+}
+
+// documentIssuer returns the issuer doc is linked to, or nil when it has none
+// or the issuer was removed from the directory.
+func (p *reminderProcessor) documentIssuer(ctx context.Context, doc *db.Document) *db.Issuer {
+	if doc.IssuerID == nil {
+		return nil
+	}
+	issuer, err := p.repo.GetIssuer(ctx, *doc.IssuerID)
+	if err != nil {
+		if err.Error() != "issuer not found" {
+			logf(ctx, "Failed to load issuer of document %s: %v", doc.ID.String(), err)
+		}
+		return nil
+	}
+	return issuer
+}
+
+// recipientUserIDs applies household notification routing: a member's
+// reminders can go to them, to the household primary, or to both.
+func (p *reminderProcessor) recipientUserIDs(ctx context.Context, userID string) []string {
+	member, err := p.repo.GetHouseholdMember(ctx, userID)
+	if err != nil || member.Role == db.HouseholdRolePrimary || member.NotificationRouting == db.RoutingMember {
+		return []string{userID}
+	}
+
+	household, err := p.repo.GetHouseholdByUserID(ctx, userID)
+	if err != nil {
+		logf(ctx, "Failed to load household for user %s: %v", userID, err)
+		return []string{userID}
+	}
+
+	primaryID := household.PrimaryUserID.String()
+	if member.NotificationRouting == db.RoutingPrimary {
+		return []string{primaryID}
+	}
+	return []string{userID, primaryID}
+}
+
+// notifyUser sends the reminder for doc to recipientID on the channels their
+// preferences select for the interval. ownerID is the user the document
+// belongs to. When SMS is only an escalation fallback, the text goes out only
+// if the email bounces or goes unopened; see handleEscalateReminder. It
+// returns the ID of the email message, or uuid.Nil when no email was sent.
+func (p *reminderProcessor) notifyUser(ctx context.Context, recipientID string, doc *db.Document, ownerID string, intervalID int) uuid.UUID {
+	prefs := p.notificationPreferences(ctx, recipientID)
+	channels := p.deliveryChannels(ctx, prefs, intervalID)
+
+	expirationDate := doc.ExpirationDate.Format("January 2, 2006")
+	messageID := uuid.New()
+	links := p.actionLinks(ctx, ownerID, doc.ID.String(), intervalID)
+	links.TrackOpen = p.trackOpenURL(messageID)
+
+	emailMessageID := uuid.Nil
+	if channels[ChannelEmail] {
+		userEmail, err := p.repo.GetUserEmail(ctx, recipientID)
+		if err != nil {
+			logf(ctx, "Failed to load email for user %s: %v", recipientID, err)
+			return uuid.Nil
+		}
+
+		emailMessageID = messageID
+		email := EmailTemplate(userEmail, doc.Name, expirationDate, links, p.documentIssuer(ctx, doc), loadTheme(ctx, p.repo))
+		var invite string
+		if prefs.CalendarInvites {
+			invite = CalendarInvite(doc, calendarOrganizer(p.cfg.App.BaseURL), userEmail, links.View, time.Now())
+		}
+		err = p.dispatcher.Send(ctx, Notification{
+			MessageID:   messageID,
+			RecipientID: recipientID,
+			UserID:      ownerID,
+			DocumentID:  doc.ID.String(),
+			IntervalID:  intervalID,
+			Channel:     ChannelEmail,
+			To:          userEmail,
+			Subject:     reminderSubject,
+			Body:        email,
+			Calendar:    invite,
+		})
+		if err != nil {
+			logf(ctx, "Failed to send email to %s: %v", userEmail, err)
+		}
+
+		if escalates(prefs, channels) {
+			p.scheduleEscalation(ctx, messageID, prefs, err)
+		}
+	}
+
+	if channels[ChannelSMS] {
+		userPhone, _ := p.repo.GetUserPhoneNumber(ctx, recipientID)
+		if userPhone != "" {
+			sms := SMSMessage(doc.Name, expirationDate, links.View)
+			_ = p.dispatcher.Send(ctx, Notification{
+				RecipientID: recipientID,
+				UserID:      ownerID,
+				DocumentID:  doc.ID.String(),
+				IntervalID:  intervalID,
+				Channel:     ChannelSMS,
+				To:          userPhone,
+				Body:        sms,
+			})
+		}
+	}
+
+	if channels[ChannelPush] {
+		_ = p.dispatcher.Send(ctx, Notification{
+			RecipientID: recipientID,
+			UserID:      ownerID,
+			DocumentID:  doc.ID.String(),
+			IntervalID:  intervalID,
+			Channel:     ChannelPush,
+			To:          recipientID,
+			Subject:     reminderSubject,
+			Body:        SMSMessage(doc.Name, expirationDate, links.View),
+		})
+	}
+	return emailMessageID
+}
+
+func (p *reminderProcessor) handleSendReminderBatch(ctx context.Context, t *asynq.Task) error {
+	payload, err := decodeReminderBatchPayload(t.Payload())
+	if err != nil {
+		return err
+	}
+	ctx = withDocumentRequestIDs(ctx, payload.RequestIDs)
+
+	if p.deferPausedReminders(ctx, payload.UserID, payload.DocumentIDs, payload.IntervalID) {
+		return nil
+	}
+	if !payload.Delayed {
+		delayed := payload
+		delayed.Delayed = true
+		if p.delayToSendTime(ctx, TaskSendReminderBatch, payload.UserID, payload.DocumentIDs, payload.IntervalID, delayed) {
+			return nil
+		}
+	}
+	if p.holdReminders(ctx, payload.UserID, payload.DocumentIDs, payload.IntervalID) {
+		return nil
+	}
+	return p.sendReminderBatch(ctx, payload)
+}
+
+// sendReminderBatch sends the reminders for every document in payload that
+// is still due, as one message per recipient and channel.
+func (p *reminderProcessor) sendReminderBatch(ctx context.Context, payload reminderBatchPayload) error {
+	var docs []*db.Document
+	for _, documentID := range payload.DocumentIDs {
+		doc, err := p.repo.GetDocumentByID(ctx, documentID)
+		if err != nil {
+			logf(ctx, "Skipping document %s in reminder batch: %v", documentID, err)
+			continue
+		}
+		if !p.reminderStillDue(ctx, doc, payload.IntervalID) {
+			logf(ctx, "Skipping stale reminder for doc %s (interval %d)", documentID, payload.IntervalID)
+			continue
+		}
+		docs = append(docs, doc)
+	}
+	if len(docs) == 0 {
+		return nil
+	}
+
+	ctx = trackDeliveries(ctx)
+	p.notifyRecipients(ctx, docs, payload.UserID, payload.IntervalID)
+	for _, doc := range docs {
+		p.notifyDocumentContacts(ctx, doc, payload.UserID, payload.IntervalID)
+	}
+
+	for _, doc := range docs {
+		p.markReminderSent(ctx, doc, payload.UserID, payload.IntervalID)
+	}
+
+	logf(ctx, "Reminder batch: User %s notified about %d documents (interval=%d)",
+		payload.UserID, len(docs), payload.IntervalID)
+
+	return nil
+}
+
+// notifyUserBatch sends one message per channel listing every document in
+// docs, instead of one message per document. Like notifyUser, it returns the
+// ID of the email message.
+func (p *reminderProcessor) notifyUserBatch(ctx context.Context, recipientID string, docs []*db.Document, ownerID string, intervalID int) uuid.UUID {
+	prefs := p.notificationPreferences(ctx, recipientID)
+	channels := p.deliveryChannels(ctx, prefs, intervalID)
+
+	var items []DigestItem
+	var documentIDs []string
+	for _, doc := range docs {
+		items = append(items, DigestItem{
+			DocumentName:   doc.Name,
+			ExpirationDate: doc.ExpirationDate.Format("January 2, 2006"),
+			ViewURL:        p.actionLinks(ctx, ownerID, doc.ID.String(), intervalID).View,
+			Issuer:         p.documentIssuer(ctx, doc),
+		})
+		documentIDs = append(documentIDs, doc.ID.String())
+	}
+
+	emailMessageID := uuid.Nil
+	if channels[ChannelEmail] {
+		userEmail, err := p.repo.GetUserEmail(ctx, recipientID)
+		if err != nil {
+			logf(ctx, "Failed to load email for user %s: %v", recipientID, err)
+			return uuid.Nil
+		}
+
+		messageID := uuid.New()
+		emailMessageID = messageID
+		email := DigestEmailTemplate(userEmail, items, p.trackOpenURL(messageID), loadTheme(ctx, p.repo))
+		err = p.dispatcher.Send(ctx, Notification{
+			MessageID:        messageID,
+			RecipientID:      recipientID,
+			UserID:           ownerID,
+			DocumentID:       documentIDs[0],
+			IntervalID:       intervalID,
+			Channel:          ChannelEmail,
+			To:               userEmail,
+			Subject:          fmt.Sprintf("%d documents are expiring soon", len(docs)),
+			Body:             email,
+			BatchDocumentIDs: documentIDs,
+		})
+		if err != nil {
+			logf(ctx, "Failed to send batch email to %s: %v", userEmail, err)
+		}
+
+		if escalates(prefs, channels) {
+			p.scheduleEscalation(ctx, messageID, prefs, err)
+		}
+	}
+
+	if channels[ChannelSMS] {
+		userPhone, _ := p.repo.GetUserPhoneNumber(ctx, recipientID)
+		if userPhone != "" {
+			_ = p.dispatcher.Send(ctx, Notification{
+				RecipientID:      recipientID,
+				UserID:           ownerID,
+				DocumentID:       documentIDs[0],
+				IntervalID:       intervalID,
+				Channel:          ChannelSMS,
+				To:               userPhone,
+				Body:             BatchSMSMessage(len(docs)),
+				BatchDocumentIDs: documentIDs,
+			})
+		}
+	}
+
+	if channels[ChannelPush] {
+		_ = p.dispatcher.Send(ctx, Notification{
+			RecipientID:      recipientID,
+			UserID:           ownerID,
+			DocumentID:       documentIDs[0],
+			IntervalID:       intervalID,
+			Channel:          ChannelPush,
+			To:               recipientID,
+			Subject:          fmt.Sprintf("%d documents are expiring soon", len(docs)),
+			Body:             BatchSMSMessage(len(docs)),
+			BatchDocumentIDs: documentIDs,
+		})
+	}
+	return emailMessageID
+}
+
+func (p *reminderProcessor) notifyDocumentContacts(ctx context.Context, doc *db.Document, userID string, intervalID int) {
+	contacts, err := p.repo.ListDocumentContacts(ctx, doc.ID.String())
+	if err != nil {
+		logf(ctx, "Failed to load contacts for document %s: %v", doc.ID.String(), err)
+		return
+	}
+	if len(contacts) == 0 {
+		return
+	}
+
+	owner, err := p.repo.GetUserByID(ctx, userID)
+	if err != nil {
+		logf(ctx, "Failed to load owner of document %s: %v", doc.ID.String(), err)
+		return
+	}
+
+	theme := loadTheme(ctx, p.repo)
+	for _, contact := range contacts {
+		if contact.UnsubscribedAt != nil {
+			continue
+		}
+		// the link in the body opens a page that asks to confirm, as mail
+		// scanners follow links; mail clients POST to the API directly
+		unsubscribeURL := p.cfg.App.FrontendURL + "/unsubscribe/" + contact.UnsubscribeToken
+		email := ContactEmailTemplate(owner.Name, doc.Name, doc.ExpirationDate.Format("January 2, 2006"), unsubscribeURL, theme)
+		err := p.dispatcher.Send(ctx, Notification{
+			UserID:         userID,
+			DocumentID:     doc.ID.String(),
+			IntervalID:     intervalID,
+			Channel:        ChannelEmail,
+			To:             contact.Email,
+			Subject:        reminderSubject,
+			Body:           email,
+			UnsubscribeURL: p.cfg.App.BaseURL + "/api/unsubscribe/" + contact.UnsubscribeToken,
+		})
+		if err != nil {
+			logf(ctx, "Failed to send contact email to %s: %v", contact.Email, err)
+		}
+	}
+}
@@ -0,0 +1,197 @@
+package worker
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"time"
+
+	"xpired/internal/config"
+	"xpired/internal/db"
+	"xpired/internal/storage"
+
+	"github.com/google/uuid"
+)
+
+const retentionBatchSize = 100
+
+// RetainedAttachment is an attachment whose owner is warned it will be
+// deleted on DeleteAfter.
+type RetainedAttachment struct {
+	DocumentID   string
+	DocumentName string
+	DeleteAfter  time.Time
+}
+
+type attachmentRetention struct {
+	repo        db.Repository
+	store       storage.Storage
+	cfg         config.AttachmentsConfig
+	frontendURL string
+	dryRun      bool
+}
+
+// AttachmentRetentionJob deletes the attachments of documents that expired
+// longer ago than their owner's plan keeps them (ATTACHMENT_RETENTION_<PLAN>_DAYS).
+// Owners are emailed RetentionWarningDays ahead, and an attachment is never
+// deleted sooner than that after its warning, also when a plan change
+// shortens retention at once. Files other documents share are kept.
+func AttachmentRetentionJob(repo db.Repository, store storage.Storage, cfg *config.Config) Job {
+	a := &attachmentRetention{
+		repo:        repo,
+		store:       store,
+		cfg:         cfg.Attachments,
+		frontendURL: cfg.App.FrontendURL,
+		dryRun:      cfg.Notifications.DryRun,
+	}
+	return Job{
+		Name:     JobAttachmentRetention,
+		Interval: 24 * time.Hour,
+		Run:      a.run,
+	}
+}
+
+func (a *attachmentRetention) run(ctx context.Context) error {
+	shortest := 0
+	for _, days := range a.cfg.RetentionDays {
+		if days > 0 && (shortest == 0 || days < shortest) {
+			shortest = days
+		}
+	}
+	if shortest == 0 {
+		return nil
+	}
+
+	today := time.Now().UTC().Truncate(24 * time.Hour)
+	// Documents that expired before this are due for a warning on the plan
+	// keeping attachments the shortest; the rest are not due on any.
+	before := today.AddDate(0, 0, a.cfg.RetentionWarningDays-shortest+1)
+	plans := map[string]string{}
+	warnings := map[string][]RetainedAttachment{}
+	deleted := 0
+	for _, regionCtx := range regionContexts(ctx, a.repo) {
+		afterID := uuid.Nil.String()
+		for {
+			docs, err := a.repo.ListDocumentsWithAttachmentsExpiredBefore(regionCtx, before, afterID, retentionBatchSize)
+			if err != nil {
+				return err
+			}
+
+			for _, doc := range docs {
+				afterID = doc.ID.String()
+				ownerID := doc.UserID.String()
+				warning, removed, err := a.enforce(regionCtx, doc, a.plan(ctx, ownerID, plans), today)
+				if err != nil {
+					logf(ctx, "Failed to enforce attachment retention for document %s: %v", doc.ID.String(), err)
+					continue
+				}
+				if warning != nil {
+					warnings[ownerID] = append(warnings[ownerID], *warning)
+				}
+				if removed {
+					deleted++
+				}
+			}
+
+			if len(docs) < retentionBatchSize {
+				break
+			}
+		}
+	}
+
+	for userID, attachments := range warnings {
+		a.warn(ctx, userID, attachments)
+	}
+	if deleted > 0 {
+		logf(ctx, "Deleted %d attachments past their plan's retention", deleted)
+	}
+	return nil
+}
+
+// plan returns the plan of userID, looked up once per run. Owners whose
+// plan cannot be read keep their attachments this time.
+func (a *attachmentRetention) plan(ctx context.Context, userID string, plans map[string]string) string {
+	if plan, ok := plans[userID]; ok {
+		return plan
+	}
+	plan, err := a.repo.GetUserPlan(ctx, userID)
+	if err != nil {
+		logf(ctx, "Failed to get plan of user %s: %v", userID, err)
+	}
+	plans[userID] = plan
+	return plan
+}
+
+// enforce applies the retention of plan to the document's attachment: it
+// records a warning to send when the attachment comes due, deletes it once
+// the warning's date has come, and withdraws the warning when the plan keeps
+// the attachment after all.
+func (a *attachmentRetention) enforce(ctx context.Context, doc *db.Document, plan string, today time.Time) (*RetainedAttachment, bool, error) {
+	url := *doc.AttachmentURL
+	warning, err := a.repo.GetAttachmentRetentionWarning(ctx, doc.ID.String())
+	if err != nil && err.Error() != "attachment retention warning not found" {
+		return nil, false, err
+	}
+
+	days := a.cfg.RetentionDays[plan]
+	due := doc.ExpirationDate.UTC().Truncate(24*time.Hour).AddDate(0, 0, days)
+	if days <= 0 || due.AddDate(0, 0, -a.cfg.RetentionWarningDays).After(today) {
+		if warning != nil {
+			return nil, false, a.repo.DeleteAttachmentRetentionWarning(ctx, doc.ID.String())
+		}
+		return nil, false, nil
+	}
+
+	if warning == nil || warning.AttachmentURL != url {
+		deleteAfter := today.AddDate(0, 0, a.cfg.RetentionWarningDays)
+		if due.After(deleteAfter) {
+			deleteAfter = due
+		}
+		warning = &db.AttachmentRetentionWarning{
+			DocumentID:    doc.ID.String(),
+			AttachmentURL: url,
+			DeleteAfter:   deleteAfter,
+		}
+		if err := a.repo.CreateAttachmentRetentionWarning(ctx, warning); err != nil {
+			return nil, false, err
+		}
+		return &RetainedAttachment{DocumentID: doc.ID.String(), DocumentName: doc.Name, DeleteAfter: deleteAfter}, false, nil
+	}
+	if today.Before(warning.DeleteAfter) {
+		return nil, false, nil
+	}
+
+	// The file stays while other documents share it.
+	unused, err := a.repo.ReleaseAttachmentBlob(ctx, url, doc.ID.String())
+	if err != nil {
+		return nil, false, err
+	}
+	if unused {
+		if err := a.store.Delete(ctx, url); err != nil && !errors.Is(err, storage.ErrNotManaged) {
+			return nil, false, err
+		}
+	}
+	if err := a.repo.RemoveAttachment(ctx, doc.ID.String(), url); err != nil {
+		return nil, false, err
+	}
+	return nil, true, nil
+}
+
+// warn emails the owner the attachments that will be deleted, soonest first.
+func (a *attachmentRetention) warn(ctx context.Context, userID string, attachments []RetainedAttachment) {
+	user, err := a.repo.GetUserByID(ctx, userID)
+	if err != nil {
+		logf(ctx, "Failed to get user %s to warn about attachment retention: %v", userID, err)
+		return
+	}
+	sort.Slice(attachments, func(i, j int) bool { return attachments[i].DeleteAfter.Before(attachments[j].DeleteAfter) })
+
+	if a.dryRun {
+		logf(ctx, "[dry-run] attachment retention warning to %s not sent (%d attachments)", user.Email, len(attachments))
+		return
+	}
+	body := AttachmentRetentionEmailTemplate(user.Name, attachments, a.frontendURL)
+	if err := SendEmail(ctx, "", user.Email, "Attachments of expired documents will be deleted", body); err != nil {
+		logf(ctx, "Failed to warn user %s about attachment retention: %v", userID, err)
+	}
+}
@@ -0,0 +1,156 @@
+package worker
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"slices"
+	"sync"
+	"time"
+
+	"xpired/internal/lock"
+
+	"github.com/hibiken/asynq"
+)
+
+const (
+	JobPurgeTrash               = "purge_trash"
+	JobRefreshExpiringDocuments = "refresh_expiring_documents"
+	JobMonitorHealth            = "monitor_health"
+	JobDetectAnomalies          = "detect_notification_anomalies"
+	JobAttachmentRetention      = "enforce_attachment_retention"
+	JobMeterUsage               = "meter_usage"
+	JobOrganizationRetention    = "enforce_organization_retention"
+	JobPurgeDeletedAccounts     = "purge_deleted_accounts"
+)
+
+// ScheduledJobs names the periodic jobs, which admins may also trigger on
+// demand with TriggerJob.
+var ScheduledJobs = []string{JobPurgeTrash, JobRefreshExpiringDocuments, JobMonitorHealth, JobDetectAnomalies, JobAttachmentRetention, JobMeterUsage, JobOrganizationRetention, JobPurgeDeletedAccounts}
+
+// Job is a periodic task that must run on exactly one replica per interval.
+type Job struct {
+	Name     string
+	Interval time.Duration
+	Run      func(ctx context.Context) error
+}
+
+// Scheduler runs periodic jobs on every replica but uses a distributed lock so
+// only the replica that wins the lock for a given tick actually executes it.
+type Scheduler struct {
+	locker *lock.Locker
+	jobs   []Job
+	wg     sync.WaitGroup
+}
+
+func NewScheduler(locker *lock.Locker) *Scheduler {
+	return &Scheduler{locker: locker}
+}
+
+func (s *Scheduler) Register(job Job) {
+	s.jobs = append(s.jobs, job)
+}
+
+// Start launches one ticker loop per registered job. The loops exit when ctx
+// is canceled; call Wait to block until they have finished.
+func (s *Scheduler) Start(ctx context.Context) {
+	for _, job := range s.jobs {
+		s.wg.Add(1)
+		go func(job Job) {
+			defer s.wg.Done()
+			ticker := time.NewTicker(job.Interval)
+			defer ticker.Stop()
+
+			for {
+				select {
+				case <-ctx.Done():
+					return
+				case <-ticker.C:
+					s.runOnce(ctx, job)
+				}
+			}
+		}(job)
+	}
+	logf(ctx, "Scheduler started with %d periodic jobs", len(s.jobs))
+}
+
+func (s *Scheduler) Wait() {
+	s.wg.Wait()
+}
+
+// ErrJobRunning is returned by a run of a job that is already running,
+// whether on its schedule or triggered.
+var ErrJobRunning = errors.New("job is already running")
+
+// HandleTriggeredJob runs a job queued by TriggerJob on the worker that picks
+// it up. It takes the same lock as scheduled runs, so it never overlaps one:
+// while the job is running, the task fails with ErrJobRunning, which shows
+// among the failed tasks.
+func (s *Scheduler) HandleTriggeredJob(ctx context.Context, t *asynq.Task) error {
+	var payload struct {
+		Name string `json:"name"`
+	}
+	if err := json.Unmarshal(t.Payload(), &payload); err != nil {
+		return fmt.Errorf("invalid payload: %v: %w", err, asynq.SkipRetry)
+	}
+	i := slices.IndexFunc(s.jobs, func(job Job) bool { return job.Name == payload.Name })
+	if i < 0 {
+		return fmt.Errorf("unknown job %q: %w", payload.Name, asynq.SkipRetry)
+	}
+
+	start := time.Now()
+	if err := s.run(ctx, s.jobs[i]); err != nil {
+		if errors.Is(err, ErrJobRunning) {
+			logf(ctx, "Scheduler: triggered job %s skipped: already running", payload.Name)
+			return fmt.Errorf("triggered job %s: %w: %w", payload.Name, err, asynq.SkipRetry)
+		}
+		return fmt.Errorf("triggered job %s failed: %w", payload.Name, err)
+	}
+	logf(ctx, "Scheduler: triggered job %s completed in %s", payload.Name, time.Since(start))
+	return nil
+}
+
+// runOnce executes the job if this replica wins the tick for the current
+// interval. The tick lock is deliberately not released after the run: it
+// expires just before the next tick, which keeps a fast replica from
+// re-running a job another replica finished moments earlier.
+func (s *Scheduler) runOnce(ctx context.Context, job Job) {
+	ttl := job.Interval - job.Interval/10
+	if _, err := s.locker.Acquire(ctx, "job-tick:"+job.Name, ttl); err != nil {
+		if !errors.Is(err, lock.ErrNotAcquired) {
+			logf(ctx, "Scheduler: failed to lock tick of job %s: %v", job.Name, err)
+		}
+		return
+	}
+
+	start := time.Now()
+	if err := s.run(ctx, job); err != nil {
+		if errors.Is(err, ErrJobRunning) {
+			logf(ctx, "Scheduler: job %s skipped: already running", job.Name)
+			return
+		}
+		logf(ctx, "Scheduler: job %s failed: %v", job.Name, err)
+		return
+	}
+	logf(ctx, "Scheduler: job %s completed in %s", job.Name, time.Since(start))
+}
+
+// run executes the job under its lock, which is held for as long as it runs,
+// up to an interval should the replica die, and fails with ErrJobRunning
+// when the job is already running elsewhere.
+func (s *Scheduler) run(ctx context.Context, job Job) error {
+	held, err := s.locker.Acquire(ctx, "job:"+job.Name, job.Interval)
+	if err != nil {
+		if errors.Is(err, lock.ErrNotAcquired) {
+			return ErrJobRunning
+		}
+		return err
+	}
+	defer func() {
+		if err := held.Release(context.WithoutCancel(ctx)); err != nil {
+			logf(ctx, "Scheduler: failed to unlock job %s: %v", job.Name, err)
+		}
+	}()
+	return job.Run(ctx)
+}
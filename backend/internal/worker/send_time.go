@@ -0,0 +1,97 @@
+package worker
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"xpired/internal/db"
+
+	"github.com/hibiken/asynq"
+)
+
+const (
+	// minTrackedOpens is how many opened reminder emails it takes to tell
+	// when a user reads them; with fewer, reminders go out as they come due.
+	minTrackedOpens = 5
+	// maxTrackedOpens and sendTimeHistory bound the opens looked at, so the
+	// send time follows changes in the user's habits.
+	maxTrackedOpens = 200
+	sendTimeHistory = 90 * 24 * time.Hour
+)
+
+// optimizedSendTime returns when a reminder of userID that came due at now
+// is likeliest to be seen: the next start of the hour of day, in UTC, in
+// which they opened the most reminder emails, within the day after now. It
+// returns false to send right away: when the user did not opt in, has too
+// few tracked opens, or that hour is the current one.
+func (p *reminderProcessor) optimizedSendTime(ctx context.Context, userID string, now time.Time) (time.Time, bool) {
+	if !p.notificationPreferences(ctx, userID).OptimizeSendTime {
+		return time.Time{}, false
+	}
+
+	opens, err := p.repo.ListEmailOpenTimes(ctx, userID, now.Add(-sendTimeHistory), maxTrackedOpens)
+	if err != nil {
+		logf(ctx, "Failed to load email opens of user %s, sending now: %v", userID, err)
+		return time.Time{}, false
+	}
+	hour, ok := bestSendHour(opens)
+	if !ok {
+		return time.Time{}, false
+	}
+
+	now = now.UTC()
+	if hour == now.Hour() {
+		return time.Time{}, false
+	}
+	sendAt := time.Date(now.Year(), now.Month(), now.Day(), hour, 0, 0, 0, time.UTC)
+	if sendAt.Before(now) {
+		sendAt = sendAt.AddDate(0, 0, 1)
+	}
+	return sendAt, true
+}
+
+// bestSendHour returns the hour of day, in UTC, most of opens fall in; ties
+// go to the earliest. It returns false when there are too few opens to tell.
+func bestSendHour(opens []time.Time) (int, bool) {
+	if len(opens) < minTrackedOpens {
+		return 0, false
+	}
+
+	var counts [24]int
+	for _, open := range opens {
+		counts[open.UTC().Hour()]++
+	}
+	best := 0
+	for hour, count := range counts {
+		if count > counts[best] {
+			best = hour
+		}
+	}
+	return best, true
+}
+
+// delayToSendTime queues the reminder task of taskType with payload, for
+// documentIDs and intervalID, again for the user's optimized send time, if
+// they have one. It reports whether it did; if not, the caller sends the
+// reminders right away.
+func (p *reminderProcessor) delayToSendTime(ctx context.Context, taskType, userID string, documentIDs []string, intervalID int, payload interface{}) bool {
+	sendAt, ok := p.optimizedSendTime(ctx, userID, time.Now())
+	if !ok {
+		return false
+	}
+
+	data, err := json.Marshal(payload)
+	if err != nil {
+		logf(ctx, "Failed to encode %s task for user %s, sending now: %v", taskType, userID, err)
+		return false
+	}
+	if _, err := client.Enqueue(asynq.NewTask(taskType, data), asynq.ProcessAt(sendAt)); err != nil {
+		logf(ctx, "Failed to delay %s task for user %s, sending now: %v", taskType, userID, err)
+		return false
+	}
+
+	transitionReminders(ctx, p.repo, documentIDs, intervalID, db.ReminderQueued)
+	logf(ctx, "Delaying %s task for user %s to their send time %s", taskType, userID, sendAt.Format(time.RFC3339))
+	return true
+}
@@ -0,0 +1,75 @@
+package worker
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+
+	"xpired/internal/db"
+	"xpired/internal/tenant"
+
+	"github.com/hibiken/asynq"
+)
+
+// senderVerificationMaxRetry bounds how long a newly configured sender domain
+// is re-checked while its DNS record propagates; asynq's backoff spreads the
+// attempts over roughly a day.
+const senderVerificationMaxRetry = 12
+
+// SenderVerificationRecord returns the name and value of the DNS TXT record
+// that proves an organization owns its email sender's domain.
+func SenderVerificationRecord(sender *db.EmailSender) (name, value string) {
+	return "_xpired-verification." + sender.Domain(), "xpired-verification=" + sender.VerificationToken
+}
+
+type senderProcessor struct {
+	repo     db.ReminderRepository
+	resolver *net.Resolver
+}
+
+// handleVerifySenderDomain looks up the verification record of the email
+// sender of the organization in ctx and records whether it is published.
+// While it is missing the task fails so it is retried with backoff.
+func (p *senderProcessor) handleVerifySenderDomain(ctx context.Context, t *asynq.Task) error {
+	organizationID := tenant.OrganizationID(ctx)
+	if organizationID == "" {
+		return fmt.Errorf("sender verification without organization: %w", asynq.SkipRetry)
+	}
+
+	sender, err := p.repo.GetEmailSender(ctx, organizationID)
+	if err != nil {
+		if err.Error() == "email sender not found" {
+			return nil
+		}
+		return err
+	}
+
+	name, value := SenderVerificationRecord(sender)
+	records, err := p.resolver.LookupTXT(ctx, name)
+	if err != nil {
+		var dnsErr *net.DNSError
+		if !errors.As(err, &dnsErr) || !dnsErr.IsNotFound {
+			return fmt.Errorf("failed to look up %s: %w", name, err)
+		}
+	}
+
+	verified := false
+	for _, record := range records {
+		if record == value {
+			verified = true
+			break
+		}
+	}
+
+	if err := p.repo.RecordEmailSenderCheck(ctx, organizationID, sender.VerificationToken, verified); err != nil {
+		return err
+	}
+	if !verified {
+		return fmt.Errorf("verification record %s not found for organization %s", name, organizationID)
+	}
+	if sender.VerifiedAt == nil {
+		logf(ctx, "Verified email sender domain %s of organization %s", sender.Domain(), organizationID)
+	}
+	return nil
+}
@@ -1,17 +1,40 @@
 package worker
 
 import (
-	"context"
-	"encoding/json"
-	"log"
+	"net"
+	"time"
+
 	"xpired/internal/config"
 	"xpired/internal/db"
+	"xpired/internal/scanner"
+	"xpired/internal/storage"
 
 	"github.com/hibiken/asynq"
+	"github.com/redis/go-redis/v9"
 )
 
 const (
-	TaskSendReminder = "send_reminder"
+	TaskSendReminder       = "send_reminder"
+	TaskSendReminderBatch  = "send_reminder_batch"
+	TaskEscalateReminder   = "escalate_reminder"
+	TaskFlushHeldReminders = "flush_held_reminders"
+	TaskEmitWebhookEvent   = "emit_webhook_event"
+	TaskDeliverWebhook     = "deliver_webhook"
+	TaskScanAttachment     = "scan_attachment"
+	TaskSendAnnouncement   = "send_announcement"
+	TaskEscalateAssignment = "escalate_assignment"
+	TaskVerifySenderDomain = "verify_sender_domain"
+
+	TaskSendLeadTimeReminder = "send_lead_time_reminder"
+	TaskNotifyDependents     = "notify_dependents"
+
+	TaskSendDeferredNotification = "send_deferred_notification"
+	TaskRunJob                   = "run_job"
+	TaskImportChunk              = "import_chunk"
+	TaskRunScheduledJob          = "run_scheduled_job"
+	TaskRelayFeedback            = "relay_feedback"
+	TaskSendQuotaWarning         = "send_quota_warning"
+	TaskSendEmailConfirmation    = "send_email_confirmation"
 )
 
 func NewServer(cfg *config.Config) *asynq.Server {
@@ -25,48 +48,112 @@ func NewServer(cfg *config.Config) *asynq.Server {
 			Queues: map[string]int{
 				"default": 1,
 			},
+			GroupAggregator:  asynq.GroupAggregatorFunc(aggregateReminders),
+			GroupGracePeriod: 2 * time.Minute,
+			GroupMaxDelay:    15 * time.Minute,
+			GroupMaxSize:     50,
 		},
 	)
 }
 
-func NewMux(repo db.Repository) *asynq.ServeMux {
-	mux := asynq.NewServeMux()
-	mux.HandleFunc(TaskSendReminder, func(ctx context.Context, t *asynq.Task) error {
-		var payload struct {
-			UserID     string `json:"user_id"`
-			DocumentID string `json:"document_id"`
-			IntervalID int    `json:"interval_id"`
-		}
+// NewMux registers every task handler, and the handlers of the task types
+// they replaced (see taskAliases). scan may be nil when attachment scanning
+// is disabled.
+func NewMux(repo db.Repository, cfg *config.Config, store storage.Storage, scan scanner.Scanner) *asynq.ServeMux {
+	rdb := redis.NewClient(&redis.Options{
+		Addr:     cfg.Redis.Addr,
+		Password: cfg.Redis.Password,
+		DB:       cfg.Redis.DB,
+	})
+	throttle := newProviderThrottle(rdb, cfg)
+	dispatcher := NewDispatcher(repo, cfg.Notifications.DryRun)
+	dispatcher.throttle = throttle
+	dispatcher.breaker = &providerBreaker{rdb: rdb, admins: cfg.Admin.Emails}
+	dispatcher.smsQuota = &smsQuota{repo: repo}
+	reminders := &reminderProcessor{
+		repo:       repo,
+		cfg:        cfg,
+		dispatcher: dispatcher,
+	}
 
-		if err := json.Unmarshal(t.Payload(), &payload); err != nil {
-			return err
-		}
+	webhooks := &webhookProcessor{
+		repo:   repo,
+		client: PublicEgressClient(cfg.Egress, webhookTimeout),
+	}
 
-		userEmail, err := repo.GetUserEmail(ctx, payload.UserID)
-		if err != nil {
-			return err
-		}
+	announcements := &announcementProcessor{
+		repo:     repo,
+		dryRun:   cfg.Notifications.DryRun,
+		throttle: throttle,
+	}
 
-		doc, err := repo.GetDocumentByID(ctx, payload.DocumentID)
-		if err != nil {
-			return err
-		}
+	senders := &senderProcessor{
+		repo:     repo,
+		resolver: net.DefaultResolver,
+	}
 
-		email := EmailTemplate(userEmail, doc.Name, doc.ExpirationDate.Format("January 2, 2006"))
-		if err := SendEmail(userEmail, "Document Expiration Reminder", email); err != nil {
-			log.Printf("Failed to send email to %s: %v", userEmail, err)
-		}
+	jobs := &jobProcessor{
+		repo:    repo,
+		store:   store,
+		backlog: cfg.Alerts.QueueDepth,
+	}
 
-		userPhone, _ := repo.GetUserPhoneNumber(ctx, payload.UserID)
-		if userPhone != "" {
-			sms := SMSMessage(doc.Name, doc.ExpirationDate.Format("January 2, 2006"))
-			_ = SendSMS(userPhone, sms)
-		}
+	feedback := &feedbackProcessor{
+		repo:   repo,
+		cfg:    cfg.Feedback,
+		dryRun: cfg.Notifications.DryRun,
+		client: EgressClient(cfg.Egress, slackWebhookTimeout),
+	}
 
-		log.Printf("Reminder: User %s should be notified about document %s (interval=%d)",
-			userEmail, doc.Name, payload.IntervalID)
+	quotaWarnings := &quotaProcessor{
+		repo:        repo,
+		frontendURL: cfg.App.FrontendURL,
+		dryRun:      cfg.Notifications.DryRun,
+	}
 
-		return nil
-	})
+	emailChanges := &emailChangeProcessor{
+		repo:        repo,
+		frontendURL: cfg.App.FrontendURL,
+		dryRun:      cfg.Notifications.DryRun,
+	}
+
+	mux := asynq.NewServeMux()
+	mux.Use(requestIDMiddleware())
+	mux.Use(timeoutMiddleware(cfg.Worker))
+	mux.Use(tenantMiddleware(repo))
+	mux.Use(suspensionMiddleware(repo))
+	handlers := map[string]asynq.HandlerFunc{
+		TaskSendReminder:             reminders.handleSendReminder,
+		TaskSendReminderBatch:        reminders.handleSendReminderBatch,
+		TaskEscalateReminder:         reminders.handleEscalateReminder,
+		TaskFlushHeldReminders:       reminders.handleFlushHeldReminders,
+		TaskEscalateAssignment:       reminders.handleEscalateAssignment,
+		TaskSendLeadTimeReminder:     reminders.handleSendLeadTimeReminder,
+		TaskNotifyDependents:         reminders.handleNotifyDependents,
+		TaskSendDeferredNotification: dispatcher.handleSendDeferredNotification,
+		TaskEmitWebhookEvent:         webhooks.handleEmitWebhookEvent,
+		TaskDeliverWebhook:           webhooks.handleDeliverWebhook,
+		TaskSendAnnouncement:         announcements.handleSendAnnouncement,
+		TaskVerifySenderDomain:       senders.handleVerifySenderDomain,
+		TaskRunJob:                   jobs.handleRunJob,
+		TaskImportChunk:              jobs.handleImportChunk,
+		TaskRelayFeedback:            feedback.handleRelayFeedback,
+		TaskSendQuotaWarning:         quotaWarnings.handleSendQuotaWarning,
+		TaskSendEmailConfirmation:    emailChanges.handleSendEmailConfirmation,
+	}
+	if scan != nil {
+		attachments := &attachmentProcessor{
+			repo:       repo,
+			cfg:        cfg,
+			store:      store,
+			scanner:    scan,
+			dispatcher: dispatcher,
+		}
+		handlers[TaskScanAttachment] = attachments.handleScanAttachment
+	}
+	for taskType, handler := range handlers {
+		mux.Handle(taskType, handler)
+	}
+	registerTaskAliases(mux, handlers, taskAliases)
 	return mux
 }
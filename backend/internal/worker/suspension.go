@@ -0,0 +1,48 @@
+package worker
+
+import (
+	"context"
+	"encoding/json"
+
+	"xpired/internal/db"
+
+	"github.com/hibiken/asynq"
+)
+
+// suspensionMiddleware drops tasks queued for a suspended or deleted user,
+// so their reminders, escalations and webhook events are skipped rather than
+// retried. Tasks without a user_id in their payload always run.
+func suspensionMiddleware(repo db.UserRepository) asynq.MiddlewareFunc {
+	return func(next asynq.Handler) asynq.Handler {
+		return asynq.HandlerFunc(func(ctx context.Context, t *asynq.Task) error {
+			var payload struct {
+				UserID string `json:"user_id"`
+			}
+			if err := json.Unmarshal(t.Payload(), &payload); err != nil || payload.UserID == "" {
+				return next.ProcessTask(ctx, t)
+			}
+
+			suspended, err := repo.IsUserSuspended(ctx, payload.UserID)
+			if err != nil {
+				if err.Error() == "user does not exist" {
+					return next.ProcessTask(ctx, t)
+				}
+				return err
+			}
+			if suspended {
+				logf(ctx, "Skipping %s task: user %s is suspended", t.Type(), payload.UserID)
+				return nil
+			}
+
+			deactivated, err := repo.IsUserDeactivated(ctx, payload.UserID)
+			if err != nil {
+				return err
+			}
+			if deactivated {
+				logf(ctx, "Skipping %s task: user %s deleted their account", t.Type(), payload.UserID)
+				return nil
+			}
+			return next.ProcessTask(ctx, t)
+		})
+	}
+}
@@ -1,5 +1,14 @@
 package worker
 
+import (
+	"html"
+	"strconv"
+	"strings"
+	"time"
+
+	"xpired/internal/db"
+)
+
 var emailStyle = `
 		body {
 			font-family: Arial, sans-serif;
@@ -38,7 +47,51 @@ var emailStyle = `
 		}
 	`
 
-func EmailTemplate(userName, documentName, expirationDate string) string {
+// ReminderLinks are the signed action links embedded in a reminder.
+type ReminderLinks struct {
+	View    string
+	Renewed string
+	Snooze  string
+	// TrackOpen is the open-tracking pixel URL, if any.
+	TrackOpen string
+}
+
+func trackingPixel(url string) string {
+	if url == "" {
+		return ""
+	}
+	return `<img src="` + url + `" width="1" height="1" alt="" style="display:none">`
+}
+
+// processingTime describes a processing-time estimate in days the way people
+// talk about it: days for short ones, weeks beyond two.
+func processingTime(days int) string {
+	if days == 1 {
+		return "1 day"
+	}
+	if days < 14 {
+		return strconv.Itoa(days) + " days"
+	}
+	return "~" + strconv.Itoa((days+3)/7) + " weeks"
+}
+
+// issuerNotice tells the reader how long the issuer takes and where to
+// renew, so they start early enough; empty without an issuer.
+func issuerNotice(issuer *db.Issuer) string {
+	if issuer == nil {
+		return ""
+	}
+	notice := ""
+	if issuer.ProcessingDays != nil && *issuer.ProcessingDays > 0 {
+		notice += `<p><strong>Start now:</strong> processing by ` + html.EscapeString(issuer.Name) + ` takes ` + processingTime(*issuer.ProcessingDays) + `.</p>`
+	}
+	if issuer.RenewalURL != nil {
+		notice += `<p><a href="` + html.EscapeString(*issuer.RenewalURL) + `">Renew with ` + html.EscapeString(issuer.Name) + `</a></p>`
+	}
+	return notice
+}
+
+func EmailTemplate(userName, documentName, expirationDate string, links ReminderLinks, issuer *db.Issuer, theme *db.NotificationTheme) string {
 	return `
 		<!DOCTYPE html>
 		<html>
@@ -49,21 +102,673 @@ func EmailTemplate(userName, documentName, expirationDate string) string {
 			<style>
 				` + emailStyle + `
 			</style>
+			` + themeStyle(theme) + `
 		</head>
 		<body>
 			<div class="container">
+				` + themeLogo(theme) + `
 				<h1>Reminder: Your Document is Expiring Soon</h1>
 				<p>Hi ` + userName + `,</p>
 				<p>This is a friendly reminder that your document "<strong>` + documentName + `</strong>" is set to expire on <strong>` + expirationDate + `</strong>.</p>
 				<p>Please take the necessary actions to renew or update your document before the expiration date to avoid any disruptions.</p>
-				<a href="#" class="button">Manage Your Documents</a>
+				` + issuerNotice(issuer) + `
+				<a href="` + links.View + `" class="button">View Document</a>
+				<p>Already taken care of it? <a href="` + links.Renewed + `">Mark as renewed</a> &middot; <a href="` + links.Snooze + `">Remind me in a week</a></p>
+				<p class="footer">If you have any questions, feel free to contact our support team.</p>
+				` + themeFooter(theme) + `
+			</div>
+			` + trackingPixel(links.TrackOpen) + `
+		</body>
+		</html>
+	`
+}
+
+// LeadTimeEmailTemplate asks the reader to start renewing a document now,
+// because the renewal takes about leadTime and would not finish in time
+// otherwise.
+func LeadTimeEmailTemplate(userName, documentName, expirationDate, leadTime, viewURL string, issuer *db.Issuer, theme *db.NotificationTheme) string {
+	renewal := ""
+	if issuer != nil && issuer.RenewalURL != nil {
+		renewal = `<a href="` + html.EscapeString(*issuer.RenewalURL) + `" class="button">Renew with ` + html.EscapeString(issuer.Name) + `</a>`
+	}
+	return `
+		<!DOCTYPE html>
+		<html>
+		<head>
+			<meta charset="UTF-8">
+			<meta name="viewport" content="width=device-width, initial-scale=1.0">
+			<title>Start Your Renewal</title>
+			<style>
+				` + emailStyle + `
+			</style>
+			` + themeStyle(theme) + `
+		</head>
+		<body>
+			<div class="container">
+				` + themeLogo(theme) + `
+				<h1>Time to Start Your Renewal</h1>
+				<p>Hi ` + userName + `,</p>
+				<p>Your document "<strong>` + documentName + `</strong>" expires on <strong>` + expirationDate + `</strong>, and renewing it takes ` + leadTime + `.</p>
+				<p>Start the renewal now so the new document arrives before the old one expires.</p>
+				` + renewal + `
+				<p><a href="` + viewURL + `">View Document</a></p>
+				<p class="footer">If you have any questions, feel free to contact our support team.</p>
+				` + themeFooter(theme) + `
+			</div>
+		</body>
+		</html>
+	`
+}
+
+func LeadTimeSMSMessage(documentName, expirationDate, leadTime string) string {
+	return "Start renewing '" + documentName + "' now: it expires on " + expirationDate + " and renewal takes " + leadTime + "."
+}
+
+func SMSMessage(documentName, expirationDate, viewURL string) string {
+	return "Reminder: Your document '" + documentName + "' is expiring on " + expirationDate + ". Please take action to renew it. " + viewURL
+}
+
+func ContactEmailTemplate(ownerName, documentName, expirationDate, unsubscribeURL string, theme *db.NotificationTheme) string {
+	return `
+		<!DOCTYPE html>
+		<html>
+		<head>
+			<meta charset="UTF-8">
+			<meta name="viewport" content="width=device-width, initial-scale=1.0">
+			<title>Document Expiration Reminder</title>
+			<style>
+				` + emailStyle + `
+			</style>
+			` + themeStyle(theme) + `
+		</head>
+		<body>
+			<div class="container">
+				` + themeLogo(theme) + `
+				<h1>Reminder: A Document is Expiring Soon</h1>
+				<p>Hi,</p>
+				<p>` + ownerName + ` asked us to let you know that the document "<strong>` + documentName + `</strong>" is set to expire on <strong>` + expirationDate + `</strong>.</p>
+				<p>You are receiving this because you were added as a contact for this document.</p>
+				<p class="footer">Don't want these reminders? <a href="` + unsubscribeURL + `">Unsubscribe</a>.</p>
+				` + themeFooter(theme) + `
+			</div>
+		</body>
+		</html>
+	`
+}
+
+// DigestItem is one document listed in a multi-document reminder email.
+type DigestItem struct {
+	DocumentName   string
+	ExpirationDate string
+	ViewURL        string
+	// Issuer renews the document, if known.
+	Issuer *db.Issuer
+}
+
+// digestRenewal is the issuer cell of a digest row: the processing time and a
+// renewal link, when known.
+func digestRenewal(issuer *db.Issuer) string {
+	if issuer == nil {
+		return ""
+	}
+	cell := ""
+	if issuer.ProcessingDays != nil && *issuer.ProcessingDays > 0 {
+		cell += "Takes " + processingTime(*issuer.ProcessingDays)
+	}
+	if issuer.RenewalURL != nil {
+		if cell != "" {
+			cell += " &middot; "
+		}
+		cell += `<a href="` + html.EscapeString(*issuer.RenewalURL) + `">Renew</a>`
+	}
+	return cell
+}
+
+func DigestEmailTemplate(userName string, items []DigestItem, trackOpenURL string, theme *db.NotificationTheme) string {
+	rows := ""
+	for _, item := range items {
+		rows += `
+					<tr>
+						<td><strong>` + item.DocumentName + `</strong></td>
+						<td>` + item.ExpirationDate + `</td>
+						<td><a href="` + item.ViewURL + `">View</a></td>
+						<td>` + digestRenewal(item.Issuer) + `</td>
+					</tr>`
+	}
+
+	return `
+		<!DOCTYPE html>
+		<html>
+		<head>
+			<meta charset="UTF-8">
+			<meta name="viewport" content="width=device-width, initial-scale=1.0">
+			<title>Documents Expiring Soon</title>
+			<style>
+				` + emailStyle + `
+				table {
+					width: 100%;
+					border-collapse: collapse;
+				}
+				td {
+					padding: 8px 0;
+					border-bottom: 1px solid #eeeeee;
+					color: #555555;
+				}
+			</style>
+			` + themeStyle(theme) + `
+		</head>
+		<body>
+			<div class="container">
+				` + themeLogo(theme) + `
+				<h1>Reminder: Several Documents are Expiring Soon</h1>
+				<p>Hi ` + userName + `,</p>
+				<p>The following documents are coming up for renewal:</p>
+				<table>` + rows + `
+				</table>
+				<p>Please take the necessary actions to renew or update them before they expire to avoid any disruptions.</p>
 				<p class="footer">If you have any questions, feel free to contact our support team.</p>
+				` + themeFooter(theme) + `
 			</div>
+			` + trackingPixel(trackOpenURL) + `
 		</body>
 		</html>
 	`
 }
 
-func SMSMessage(documentName, expirationDate string) string {
-	return "Reminder: Your document '" + documentName + "' is expiring on " + expirationDate + ". Please take action to renew it."
+// CatchUpEmailTemplate sums up the reminders that came due while the user
+// paused notifications, once the pause ends.
+func CatchUpEmailTemplate(userName string, items []DigestItem, trackOpenURL string, theme *db.NotificationTheme) string {
+	rows := ""
+	for _, item := range items {
+		rows += `
+					<tr>
+						<td><strong>` + item.DocumentName + `</strong></td>
+						<td>` + item.ExpirationDate + `</td>
+						<td><a href="` + item.ViewURL + `">View</a></td>
+						<td>` + digestRenewal(item.Issuer) + `</td>
+					</tr>`
+	}
+
+	return `
+		<!DOCTYPE html>
+		<html>
+		<head>
+			<meta charset="UTF-8">
+			<meta name="viewport" content="width=device-width, initial-scale=1.0">
+			<title>While You Were Away</title>
+			<style>
+				` + emailStyle + `
+				table {
+					width: 100%;
+					border-collapse: collapse;
+				}
+				td {
+					padding: 8px 0;
+					border-bottom: 1px solid #eeeeee;
+					color: #555555;
+				}
+			</style>
+			` + themeStyle(theme) + `
+		</head>
+		<body>
+			<div class="container">
+				` + themeLogo(theme) + `
+				<h1>Welcome Back: Reminders You Missed</h1>
+				<p>Hi ` + userName + `,</p>
+				<p>While your notifications were paused, reminders came due for the following documents:</p>
+				<table>` + rows + `
+				</table>
+				<p>Please take the necessary actions to renew or update them before they expire to avoid any disruptions.</p>
+				<p class="footer">If you have any questions, feel free to contact our support team.</p>
+				` + themeFooter(theme) + `
+			</div>
+			` + trackingPixel(trackOpenURL) + `
+		</body>
+		</html>
+	`
+}
+
+// AssignmentEscalationEmailTemplate tells an organization admin that the
+// assignee of the listed documents has not acknowledged their reminder.
+func AssignmentEscalationEmailTemplate(adminName, assigneeName string, items []DigestItem, theme *db.NotificationTheme) string {
+	rows := ""
+	for _, item := range items {
+		rows += `
+					<tr>
+						<td><strong>` + item.DocumentName + `</strong></td>
+						<td>` + item.ExpirationDate + `</td>
+						<td><a href="` + item.ViewURL + `">View</a></td>
+					</tr>`
+	}
+
+	return `
+		<!DOCTYPE html>
+		<html>
+		<head>
+			<meta charset="UTF-8">
+			<meta name="viewport" content="width=device-width, initial-scale=1.0">
+			<title>Unacknowledged Document Reminder</title>
+			<style>
+				` + emailStyle + `
+				table {
+					width: 100%;
+					border-collapse: collapse;
+				}
+				td {
+					padding: 8px 0;
+					border-bottom: 1px solid #eeeeee;
+					color: #555555;
+				}
+			</style>
+			` + themeStyle(theme) + `
+		</head>
+		<body>
+			<div class="container">
+				` + themeLogo(theme) + `
+				<h1>A Document Reminder Went Unacknowledged</h1>
+				<p>Hi ` + adminName + `,</p>
+				<p>` + assigneeName + ` is responsible for the following documents but has not acknowledged their expiration reminder:</p>
+				<table>` + rows + `
+				</table>
+				<p>Please follow up with them, or reassign the documents to another member.</p>
+				<p class="footer">You are receiving this because you are an admin of the organization.</p>
+				` + themeFooter(theme) + `
+			</div>
+		</body>
+		</html>
+	`
+}
+
+// DependentItem is a document listed in a dependency impact email.
+type DependentItem struct {
+	DocumentName   string
+	ExpirationDate string
+	ViewURL        string
+	// Impacted marks a dependent that is valid for longer than the document
+	// it depends on, so it becomes unusable early.
+	Impacted bool
+}
+
+// DependencyImpactEmailTemplate tells the owner of the listed documents that
+// a document they depend on changed: change completes the sentence
+// "<documentName> ...", e.g. "expired on June 1, 2027".
+func DependencyImpactEmailTemplate(userName, documentName, change string, items []DependentItem, theme *db.NotificationTheme) string {
+	rows := ""
+	for _, item := range items {
+		impact := ""
+		if item.Impacted {
+			impact = "<strong>Affected</strong>"
+		}
+		rows += `
+					<tr>
+						<td><strong>` + item.DocumentName + `</strong></td>
+						<td>` + item.ExpirationDate + `</td>
+						<td>` + impact + `</td>
+						<td><a href="` + item.ViewURL + `">View</a></td>
+					</tr>`
+	}
+
+	return `
+		<!DOCTYPE html>
+		<html>
+		<head>
+			<meta charset="UTF-8">
+			<meta name="viewport" content="width=device-width, initial-scale=1.0">
+			<title>A Document Your Documents Depend On Changed</title>
+			<style>
+				` + emailStyle + `
+				table {
+					width: 100%;
+					border-collapse: collapse;
+				}
+				td {
+					padding: 8px 0;
+					border-bottom: 1px solid #eeeeee;
+					color: #555555;
+				}
+			</style>
+			` + themeStyle(theme) + `
+		</head>
+		<body>
+			<div class="container">
+				` + themeLogo(theme) + `
+				<h1>Dependent Documents Need Your Attention</h1>
+				<p>Hi ` + userName + `,</p>
+				<p>"<strong>` + documentName + `</strong>" ` + change + `. These documents depend on it:</p>
+				<table>` + rows + `
+				</table>
+				<p>Documents marked as affected are valid for longer than "` + documentName + `" and may not be usable past its expiration. Please check whether they need to be renewed or reissued.</p>
+				<p class="footer">If you have any questions, feel free to contact our support team.</p>
+				` + themeFooter(theme) + `
+			</div>
+		</body>
+		</html>
+	`
+}
+
+func DependencyImpactSMSMessage(documentName, change string, count int) string {
+	return "'" + documentName + "' " + change + ". " + strconv.Itoa(count) + " of your documents depend on it; please check them."
+}
+
+func BatchSMSMessage(count int) string {
+	return "Reminder: You have " + strconv.Itoa(count) + " documents expiring soon. Check your email or the xpired app for details."
+}
+
+func CatchUpSMSMessage(count int) string {
+	return "Welcome back: " + strconv.Itoa(count) + " of your documents had reminders while your notifications were paused. Check your email or the xpired app for details."
+}
+
+func AttachmentQuarantinedEmailTemplate(userName, documentName, threat, viewURL string, theme *db.NotificationTheme) string {
+	return `
+		<!DOCTYPE html>
+		<html>
+		<head>
+			<meta charset="UTF-8">
+			<meta name="viewport" content="width=device-width, initial-scale=1.0">
+			<title>Attachment Quarantined</title>
+			<style>
+				` + emailStyle + `
+			</style>
+			` + themeStyle(theme) + `
+		</head>
+		<body>
+			<div class="container">
+				` + themeLogo(theme) + `
+				<h1>We Quarantined an Attachment</h1>
+				<p>Hi ` + userName + `,</p>
+				<p>The file you attached to "<strong>` + documentName + `</strong>" was flagged as malware (<strong>` + threat + `</strong>) and has been quarantined. It is no longer available for download.</p>
+				<p>If you need the attachment, please upload a clean copy.</p>
+				<a href="` + viewURL + `" class="button">View Document</a>
+				<p class="footer">If you have any questions, feel free to contact our support team.</p>
+				` + themeFooter(theme) + `
+			</div>
+		</body>
+		</html>
+	`
+}
+
+func AttachmentQuarantinedSMSMessage(documentName string) string {
+	return "Security notice: the attachment on '" + documentName + "' was flagged as malware and quarantined. Please upload a clean copy."
+}
+
+// ProviderCircuitEmailTemplate tells an admin that the provider of channel
+// keeps failing and its sends are paused until retryAt, or, when open is
+// false, that it recovered.
+func ProviderCircuitEmailTemplate(channel string, open bool, retryAt time.Time, cause error) string {
+	title := "Notification provider recovered"
+	message := `<p>Sending ` + channel + ` notifications works again. Messages held back while the channel was paused are being delivered.</p>`
+	if open {
+		title = "Notification provider is failing"
+		message = `<p>The last ` + strconv.Itoa(breakerThreshold) + ` ` + channel + ` notifications all failed, so the channel is paused. Reminders are queued, not dropped, and a single message will test the provider again at ` + retryAt.Format("15:04 MST") + `.</p>`
+		if cause != nil {
+			message += `<p>Last error: ` + html.EscapeString(cause.Error()) + `</p>`
+		}
+	}
+	return `
+		<!DOCTYPE html>
+		<html>
+		<head>
+			<meta charset="UTF-8">
+			<meta name="viewport" content="width=device-width, initial-scale=1.0">
+			<title>` + title + `</title>
+			<style>
+				` + emailStyle + `
+			</style>
+		</head>
+		<body>
+			<div class="container">
+				<h1>` + title + `</h1>
+				` + message + `
+				<p class="footer">You are receiving this because you are an xpired admin.</p>
+			</div>
+		</body>
+		</html>
+	`
+}
+
+// NotificationAnomalyEmailTemplate tells an admin which users got more than
+// factor times their usual daily number of notifications in the last day.
+func NotificationAnomalyEmailTemplate(anomalies []NotificationAnomaly, factor int) string {
+	rows := ""
+	for _, anomaly := range anomalies {
+		rows += `
+					<tr>
+						<td><strong>` + html.EscapeString(anomaly.label()) + `</strong></td>
+						<td>` + strconv.Itoa(anomaly.Recent) + `</td>
+						<td>` + strconv.FormatFloat(anomaly.DailyBaseline, 'f', 1, 64) + `</td>
+					</tr>`
+	}
+	return `
+		<!DOCTYPE html>
+		<html>
+		<head>
+			<meta charset="UTF-8">
+			<meta name="viewport" content="width=device-width, initial-scale=1.0">
+			<title>Unusual notification volume</title>
+			<style>
+				` + emailStyle + `
+				table {
+					width: 100%;
+					border-collapse: collapse;
+				}
+				th, td {
+					padding: 8px 0;
+					border-bottom: 1px solid #eeeeee;
+					color: #555555;
+					text-align: left;
+				}
+			</style>
+		</head>
+		<body>
+			<div class="container">
+				<h1>Unusual notification volume</h1>
+				<p>These users got more than ` + strconv.Itoa(factor) + ` times their usual number of notifications in the last 24 hours, which can mean reminders are being scheduled more than once.</p>
+				<table>
+					<tr>
+						<th>User</th>
+						<th>Last 24 hours</th>
+						<th>Usual per day</th>
+					</tr>` + rows + `
+				</table>
+				<p class="footer">You are receiving this because you are an xpired admin.</p>
+			</div>
+		</body>
+		</html>
+	`
+}
+
+// UserNotificationAnomalyEmailTemplate tells a user that the team noticed
+// they got count notifications in a day and is looking into it.
+func UserNotificationAnomalyEmailTemplate(userName string, count int) string {
+	return `
+		<!DOCTYPE html>
+		<html>
+		<head>
+			<meta charset="UTF-8">
+			<meta name="viewport" content="width=device-width, initial-scale=1.0">
+			<title>You got more reminders than usual</title>
+			<style>
+				` + emailStyle + `
+			</style>
+		</head>
+		<body>
+			<div class="container">
+				<h1>You got more reminders than usual</h1>
+				<p>Hi ` + html.EscapeString(userName) + `,</p>
+				<p>We sent you ` + strconv.Itoa(count) + ` notifications in the last 24 hours, far more than usual. If that was not expected, we are sorry for the noise: our team has been alerted and is looking into it.</p>
+				<p class="footer">You are receiving this because you have an xpired account.</p>
+			</div>
+		</body>
+		</html>
+	`
+}
+
+// AttachmentRetentionEmailTemplate warns a user that the attachments of
+// documents that expired long ago will be deleted under their plan.
+func AttachmentRetentionEmailTemplate(userName string, attachments []RetainedAttachment, frontendURL string) string {
+	rows := ""
+	for _, attachment := range attachments {
+		rows += `
+					<tr>
+						<td><a href="` + frontendURL + `/documents/` + attachment.DocumentID + `">` + html.EscapeString(attachment.DocumentName) + `</a></td>
+						<td>` + attachment.DeleteAfter.Format("2 Jan 2006") + `</td>
+					</tr>`
+	}
+	return `
+		<!DOCTYPE html>
+		<html>
+		<head>
+			<meta charset="UTF-8">
+			<meta name="viewport" content="width=device-width, initial-scale=1.0">
+			<title>Attachments of expired documents will be deleted</title>
+			<style>
+				` + emailStyle + `
+				table {
+					width: 100%;
+					border-collapse: collapse;
+				}
+				th, td {
+					padding: 8px 0;
+					border-bottom: 1px solid #eeeeee;
+					color: #555555;
+					text-align: left;
+				}
+			</style>
+		</head>
+		<body>
+			<div class="container">
+				<h1>Attachments will be deleted</h1>
+				<p>Hi ` + html.EscapeString(userName) + `,</p>
+				<p>Your plan keeps the attachments of expired documents for a limited time. The attachments of these documents will be deleted on the date shown; the documents themselves stay. Download any file you want to keep, or renew the document by updating its expiration date.</p>
+				<table>
+					<tr>
+						<th>Document</th>
+						<th>Deleted on</th>
+					</tr>` + rows + `
+				</table>
+				<p class="footer">You are receiving this because you have an xpired account.</p>
+			</div>
+		</body>
+		</html>
+	`
+}
+
+// QuotaWarningEmailTemplate warns a user that they used most of a quota of
+// their plan, before they hit its limit.
+func QuotaWarningEmailTemplate(userName string, usage QuotaUsage, frontendURL string) string {
+	what := strconv.Itoa(usage.Used) + " of the " + strconv.Itoa(usage.Limit) + " documents"
+	after := "you cannot add more documents until you delete some or upgrade your plan"
+	if usage.Quota == QuotaMonthlySMS {
+		what = strconv.Itoa(usage.Used) + " of the " + strconv.Itoa(usage.Limit) + " text messages this month"
+		after = "reminders go out by email only until " + usage.ResetsAt.Format("2 Jan 2006") + ", unless you upgrade your plan"
+	}
+	return `
+		<!DOCTYPE html>
+		<html>
+		<head>
+			<meta charset="UTF-8">
+			<meta name="viewport" content="width=device-width, initial-scale=1.0">
+			<title>You are nearing a limit of your plan</title>
+			<style>
+				` + emailStyle + `
+			</style>
+		</head>
+		<body>
+			<div class="container">
+				<h1>You are nearing a limit of your plan</h1>
+				<p>Hi ` + html.EscapeString(userName) + `,</p>
+				<p>You have used ` + what + ` your plan includes. Once you reach the limit, ` + after + `.</p>
+				<a href="` + frontendURL + `" class="button">Open xpired</a>
+				<p class="footer">You are receiving this because you have an xpired account.</p>
+			</div>
+		</body>
+		</html>
+	`
+}
+
+// EmailConfirmationTemplate asks the reader of newEmail to confirm it as the
+// address of userName's account.
+func EmailConfirmationTemplate(userName, newEmail, confirmURL string) string {
+	return `
+		<!DOCTYPE html>
+		<html>
+		<head>
+			<meta charset="UTF-8">
+			<meta name="viewport" content="width=device-width, initial-scale=1.0">
+			<title>Confirm your new email address</title>
+			<style>
+				` + emailStyle + `
+			</style>
+		</head>
+		<body>
+			<div class="container">
+				<h1>Confirm your new email address</h1>
+				<p>Hi ` + html.EscapeString(userName) + `,</p>
+				<p>You asked to use ` + html.EscapeString(newEmail) + ` for your xpired account. Your reminders keep going to your current address until you confirm this one. The link is valid for 24 hours.</p>
+				<a href="` + confirmURL + `" class="button">Confirm email address</a>
+				<p class="footer">If you did not ask for this, ignore this email; nothing changes.</p>
+			</div>
+		</body>
+		</html>
+	`
+}
+
+// AnnouncementEmailTemplate renders an admin announcement. Its title and body
+// are plain text; line breaks in the body are kept.
+func AnnouncementEmailTemplate(userName, title, body string) string {
+	paragraphs := strings.ReplaceAll(html.EscapeString(body), "\n", "<br>")
+	return `
+		<!DOCTYPE html>
+		<html>
+		<head>
+			<meta charset="UTF-8">
+			<meta name="viewport" content="width=device-width, initial-scale=1.0">
+			<title>` + html.EscapeString(title) + `</title>
+			<style>
+				` + emailStyle + `
+			</style>
+		</head>
+		<body>
+			<div class="container">
+				<h1>` + html.EscapeString(title) + `</h1>
+				<p>Hi ` + userName + `,</p>
+				<p>` + paragraphs + `</p>
+				<p class="footer">You are receiving this because you have an xpired account.</p>
+			</div>
+		</body>
+		</html>
+	`
+}
+
+// FeedbackEmailTemplate renders feedback a user sent, from, for the team.
+func FeedbackEmailTemplate(from string, feedback *db.Feedback) string {
+	var details strings.Builder
+	for _, detail := range feedbackDetails(from, feedback) {
+		details.WriteString(`<p><strong>` + detail[0] + `:</strong> ` + html.EscapeString(detail[1]) + `</p>`)
+	}
+	message := strings.ReplaceAll(html.EscapeString(feedback.Message), "\n", "<br>")
+	if message == "" {
+		message = "<em>No message</em>"
+	}
+	return `
+		<!DOCTYPE html>
+		<html>
+		<head>
+			<meta charset="UTF-8">
+			<meta name="viewport" content="width=device-width, initial-scale=1.0">
+			<title>New feedback</title>
+			<style>
+				` + emailStyle + `
+			</style>
+		</head>
+		<body>
+			<div class="container">
+				<h1>New feedback</h1>
+				` + details.String() + `
+				<p>` + message + `</p>
+				<p class="footer">You are receiving this because this address is set as FEEDBACK_EMAIL.</p>
+			</div>
+		</body>
+		</html>
+	`
 }
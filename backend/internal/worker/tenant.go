@@ -0,0 +1,67 @@
+package worker
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"xpired/internal/db"
+	"xpired/internal/tenant"
+
+	"github.com/hibiken/asynq"
+)
+
+// OrganizationContext returns ctx acting for the organization, so repository
+// calls go to the database its data lives in.
+func OrganizationContext(ctx context.Context, repo db.UserRepository, organizationID string) (context.Context, error) {
+	org, err := repo.GetOrganization(ctx, organizationID)
+	if err != nil {
+		return nil, err
+	}
+	var region string
+	if org.DataRegion != nil {
+		region = *org.DataRegion
+	}
+	return tenant.WithOrganization(ctx, org.ID.String(), region), nil
+}
+
+// tenantMiddleware restores the organization or data region a task was
+// queued for (see withTenant) before its handler runs.
+func tenantMiddleware(repo db.UserRepository) asynq.MiddlewareFunc {
+	return func(next asynq.Handler) asynq.Handler {
+		return asynq.HandlerFunc(func(ctx context.Context, t *asynq.Task) error {
+			var payload struct {
+				OrganizationID string `json:"organization_id"`
+				DataRegion     string `json:"data_region"`
+			}
+			if err := json.Unmarshal(t.Payload(), &payload); err != nil {
+				return next.ProcessTask(ctx, t)
+			}
+
+			switch {
+			case payload.OrganizationID != "":
+				orgCtx, err := OrganizationContext(ctx, repo, payload.OrganizationID)
+				if err != nil {
+					if err.Error() == "organization not found" {
+						return fmt.Errorf("organization %s no longer exists: %w", payload.OrganizationID, asynq.SkipRetry)
+					}
+					return err
+				}
+				ctx = orgCtx
+			case payload.DataRegion != "":
+				ctx = tenant.WithRegion(ctx, payload.DataRegion)
+			}
+			return next.ProcessTask(ctx, t)
+		})
+	}
+}
+
+// regionContexts returns one context per database: the home one and each
+// data region. Sweeping jobs run once per context to cover every region.
+func regionContexts(ctx context.Context, repo db.DocumentRepository) []context.Context {
+	contexts := []context.Context{ctx}
+	for _, region := range repo.DataRegions() {
+		contexts = append(contexts, tenant.WithRegion(ctx, region))
+	}
+	return contexts
+}
@@ -0,0 +1,69 @@
+package worker
+
+import (
+	"context"
+	"html/template"
+	"log"
+	"strings"
+
+	"xpired/internal/db"
+	"xpired/internal/tenant"
+)
+
+// The theme fragments are rendered with html/template so an organization's
+// logo URL, colors and footer are escaped for the context they land in.
+var (
+	themeStyleTemplate = template.Must(template.New("style").Parse(`<style>
+				{{- with .BackgroundColor}} body { background-color: {{.}}; }{{end}}
+				{{- with .PrimaryColor}} h1 { color: {{.}}; } .button { background-color: {{.}}; }{{end}}
+			</style>`))
+	themeLogoTemplate = template.Must(template.New("logo").Parse(
+		`{{with .LogoURL}}<img src="{{.}}" alt="" style="max-height: 48px; margin-bottom: 10px;">{{end}}`))
+	themeFooterTemplate = template.Must(template.New("footer").Parse(
+		`{{with .FooterText}}<p class="footer">{{.}}</p>{{end}}`))
+)
+
+// loadTheme returns the notification theme of the organization in ctx, or nil
+// when there is none and the default look applies.
+func loadTheme(ctx context.Context, repo db.ReminderRepository) *db.NotificationTheme {
+	organizationID := tenant.OrganizationID(ctx)
+	if organizationID == "" {
+		return nil
+	}
+
+	theme, err := repo.GetNotificationTheme(ctx, organizationID)
+	if err != nil {
+		if err.Error() != "notification theme not found" {
+			logf(ctx, "Failed to load notification theme of organization %s: %v", organizationID, err)
+		}
+		return nil
+	}
+	return theme
+}
+
+func renderTheme(t *template.Template, theme *db.NotificationTheme) string {
+	if theme == nil {
+		return ""
+	}
+	var b strings.Builder
+	if err := t.Execute(&b, theme); err != nil {
+		log.Printf("Failed to render %s of notification theme: %v", t.Name(), err)
+		return ""
+	}
+	return b.String()
+}
+
+// themeStyle overrides the default colors of emailStyle.
+func themeStyle(theme *db.NotificationTheme) string {
+	return renderTheme(themeStyleTemplate, theme)
+}
+
+// themeLogo is shown at the top of the email.
+func themeLogo(theme *db.NotificationTheme) string {
+	return renderTheme(themeLogoTemplate, theme)
+}
+
+// themeFooter follows the email's own footer.
+func themeFooter(theme *db.NotificationTheme) string {
+	return renderTheme(themeFooterTemplate, theme)
+}
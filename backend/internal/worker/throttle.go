@@ -0,0 +1,55 @@
+package worker
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"xpired/internal/config"
+	"xpired/internal/ratelimit"
+
+	"github.com/redis/go-redis/v9"
+)
+
+// providerThrottle paces the sends to each notification provider with a
+// token bucket shared by all workers, so a burst of reminders coming due at
+// once stays within the provider's quota instead of being throttled by it.
+type providerThrottle struct {
+	limiter *ratelimit.Limiter
+	// rates are the messages per second allowed per channel; channels
+	// without a rate are not paced.
+	rates map[string]int
+}
+
+func newProviderThrottle(rdb *redis.Client, cfg *config.Config) *providerThrottle {
+	rates := map[string]int{}
+	for channel, rate := range map[string]int{
+		ChannelEmail: cfg.Notifications.EmailRate,
+		ChannelSMS:   cfg.Notifications.SMSRate,
+		ChannelPush:  cfg.Notifications.PushRate,
+	} {
+		if rate > 0 {
+			rates[channel] = rate
+		}
+	}
+	return &providerThrottle{limiter: ratelimit.NewLimiter(rdb), rates: rates}
+}
+
+// throttleRetryDelay is how long a message waits to be sent again when it
+// could not be paced.
+const throttleRetryDelay = time.Minute
+
+// wait blocks until the provider of channel may take another message. It
+// fails when the shared bucket cannot be read, such as while Redis is
+// unavailable; the message must then not go out, as unpaced sends from every
+// worker at once are what gets the provider to throttle or suspend us.
+func (t *providerThrottle) wait(ctx context.Context, channel string) error {
+	rate, ok := t.rates[channel]
+	if !ok {
+		return nil
+	}
+	if err := t.limiter.Wait(ctx, "provider:"+channel, rate, rate); err != nil {
+		return fmt.Errorf("failed to pace %s notification: %w", channel, err)
+	}
+	return nil
+}
@@ -0,0 +1,48 @@
+package worker
+
+import (
+	"context"
+	"time"
+
+	"xpired/internal/config"
+
+	"github.com/hibiken/asynq"
+)
+
+// defaultTaskTimeouts are the deadlines of task types that legitimately run
+// longer than WorkerConfig.TaskTimeout. WORKER_TASK_TIMEOUTS overrides them.
+var defaultTaskTimeouts = map[string]time.Duration{
+	// Announcements email every user, paced at the email provider's rate.
+	TaskSendAnnouncement: 30 * time.Minute,
+	// Scans stream the whole attachment from storage to clamd.
+	TaskScanAttachment: 10 * time.Minute,
+	// Jobs are the operations too slow to finish within an API request.
+	TaskRunJob: 30 * time.Minute,
+	// A chunk is importChunkSize documents, each with its reminders.
+	TaskImportChunk: 10 * time.Minute,
+}
+
+// taskTimeout returns the deadline of tasks of taskType.
+func taskTimeout(cfg config.WorkerConfig, taskType string) time.Duration {
+	if timeout, ok := cfg.TaskTimeouts[taskType]; ok {
+		return timeout
+	}
+	if timeout, ok := defaultTaskTimeouts[taskType]; ok {
+		return timeout
+	}
+	return cfg.TaskTimeout
+}
+
+// timeoutMiddleware bounds each task by the deadline of its type. The
+// deadline is carried by the task context into every query, provider call
+// and rate limiter wait, so a hung dependency fails the task, which asynq
+// then retries, instead of tying up a worker slot.
+func timeoutMiddleware(cfg config.WorkerConfig) asynq.MiddlewareFunc {
+	return func(next asynq.Handler) asynq.Handler {
+		return asynq.HandlerFunc(func(ctx context.Context, t *asynq.Task) error {
+			ctx, cancel := context.WithTimeout(ctx, taskTimeout(cfg, t.Type()))
+			defer cancel()
+			return next.ProcessTask(ctx, t)
+		})
+	}
+}
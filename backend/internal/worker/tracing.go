@@ -0,0 +1,96 @@
+package worker
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"sort"
+	"strings"
+
+	chiMiddleware "github.com/go-chi/chi/v5/middleware"
+	"github.com/hibiken/asynq"
+)
+
+type documentRequestIDsKey struct{}
+
+// RequestID returns the ID of the API request ctx serves or, in a task, of
+// the request that queued it, however many tasks ago; "" when there is
+// none, as for scheduled jobs.
+func RequestID(ctx context.Context) string {
+	return chiMiddleware.GetReqID(ctx)
+}
+
+// WithRequestID traces ctx to the request with ID id. The API router sets it
+// for HTTP requests; other entry points, like gRPC, call it themselves.
+func WithRequestID(ctx context.Context, id string) context.Context {
+	return context.WithValue(ctx, chiMiddleware.RequestIDKey, id)
+}
+
+// withRequestID tags a task payload with the request ID in ctx;
+// requestIDMiddleware restores it into the task context, so the tasks the
+// task queues in turn are tagged too.
+func withRequestID(ctx context.Context, payload map[string]interface{}) map[string]interface{} {
+	if id := RequestID(ctx); id != "" {
+		payload["request_id"] = id
+	}
+	return payload
+}
+
+// withDocumentRequestIDs traces the documents of a batched message each to
+// the request that scheduled its reminder, by document ID, rather than all
+// to the one request in ctx.
+func withDocumentRequestIDs(ctx context.Context, ids map[string]string) context.Context {
+	ctx = WithRequestID(ctx, "")
+	return context.WithValue(ctx, documentRequestIDsKey{}, ids)
+}
+
+// documentRequestID returns the request the notification about documentID
+// traces back to.
+func documentRequestID(ctx context.Context, documentID string) string {
+	if ids, _ := ctx.Value(documentRequestIDsKey{}).(map[string]string); ids[documentID] != "" {
+		return ids[documentID]
+	}
+	return RequestID(ctx)
+}
+
+// requestIDMiddleware restores the request ID a task was queued with (see
+// withRequestID) before its handler runs.
+func requestIDMiddleware() asynq.MiddlewareFunc {
+	return func(next asynq.Handler) asynq.Handler {
+		return asynq.HandlerFunc(func(ctx context.Context, t *asynq.Task) error {
+			var payload struct {
+				RequestID string `json:"request_id"`
+			}
+			if err := json.Unmarshal(t.Payload(), &payload); err == nil && payload.RequestID != "" {
+				ctx = WithRequestID(ctx, payload.RequestID)
+			}
+			return next.ProcessTask(ctx, t)
+		})
+	}
+}
+
+// logf logs like log.Printf, prefixed with the request ID in ctx the way the
+// API's request log is, so the lines of a task can be found from the API call
+// that queued it. A batch lists the requests of all its documents.
+func logf(ctx context.Context, format string, args ...interface{}) {
+	var ids []string
+	if id := RequestID(ctx); id != "" {
+		ids = append(ids, id)
+	} else if byDocument, _ := ctx.Value(documentRequestIDsKey{}).(map[string]string); len(byDocument) > 0 {
+		seen := map[string]bool{}
+		for _, id := range byDocument {
+			if !seen[id] {
+				seen[id] = true
+				ids = append(ids, id)
+			}
+		}
+		sort.Strings(ids)
+	}
+
+	if len(ids) == 0 {
+		log.Printf(format, args...)
+		return
+	}
+	log.Printf("[%s] %s", strings.Join(ids, " "), fmt.Sprintf(format, args...))
+}
@@ -0,0 +1,116 @@
+package worker
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"time"
+
+	"xpired/internal/db"
+	"xpired/internal/storage"
+
+	"github.com/google/uuid"
+)
+
+const purgeTrashBatchSize = 100
+
+// PurgeTrashJob permanently deletes documents that have been in the trash
+// for longer than retentionDays, along with their stored attachments unless
+// other documents share them. Documents under legal hold stay in the trash.
+func PurgeTrashJob(repo db.DocumentRepository, store storage.Storage, retentionDays int) Job {
+	return Job{
+		Name:     JobPurgeTrash,
+		Interval: time.Hour,
+		Run: func(ctx context.Context) error {
+			cutoff := time.Now().AddDate(0, 0, -retentionDays)
+			for _, regionCtx := range regionContexts(ctx, repo) {
+				if err := purgeTrash(regionCtx, repo, store, cutoff); err != nil {
+					return err
+				}
+			}
+			return nil
+		},
+	}
+}
+
+func purgeTrash(ctx context.Context, repo db.DocumentRepository, store storage.Storage, cutoff time.Time) error {
+	purged := 0
+	// Documents whose attachment cannot be deleted stay in the trash and are
+	// retried on the next run; skipped keeps them from stalling this one.
+	skipped := map[uuid.UUID]bool{}
+	for {
+		docs, err := repo.ListDocumentsTrashedBefore(ctx, cutoff, purgeTrashBatchSize+len(skipped))
+		if err != nil {
+			return err
+		}
+
+		progressed := false
+		for _, doc := range docs {
+			if skipped[doc.ID] {
+				continue
+			}
+			if err := purgeDocument(ctx, repo, store, doc); err != nil {
+				logf(ctx, "Failed to purge document %s: %v", doc.ID.String(), err)
+				skipped[doc.ID] = true
+				continue
+			}
+			purged++
+			progressed = true
+		}
+
+		if !progressed || len(docs) < purgeTrashBatchSize+len(skipped) {
+			break
+		}
+	}
+
+	if purged > 0 {
+		logf(ctx, "Purged %d trashed documents", purged)
+	}
+	return nil
+}
+
+func purgeDocument(ctx context.Context, repo db.DocumentRepository, store storage.Storage, doc *db.Document) error {
+	attachmentDeleted := false
+	if doc.AttachmentURL != nil && *doc.AttachmentURL != "" {
+		// The file stays while other documents share it.
+		unused, err := repo.ReleaseAttachmentBlob(ctx, *doc.AttachmentURL, doc.ID.String())
+		if err != nil {
+			return err
+		}
+		if unused {
+			err = store.Delete(ctx, *doc.AttachmentURL)
+		}
+		switch {
+		case err == nil:
+			attachmentDeleted = unused
+		case errors.Is(err, storage.ErrNotManaged):
+			// An external link; there is nothing of ours to reclaim.
+		default:
+			return err
+		}
+	}
+
+	if err := repo.PurgeDocument(ctx, doc.ID.String()); err != nil {
+		return err
+	}
+
+	ownerID := doc.UserID.String()
+	metadata, _ := json.Marshal(map[string]interface{}{
+		"name":              doc.Name,
+		"trashedAt":         doc.DeletedAt,
+		"attachmentDeleted": attachmentDeleted,
+	})
+	entry := &db.AuditLog{
+		ID:             uuid.New(),
+		UserID:         &ownerID,
+		OrganizationID: doc.OrganizationID,
+		Action:         db.AuditActionDocumentPurged,
+		EntityType:     "document",
+		EntityID:       doc.ID.String(),
+		Metadata:       metadata,
+	}
+	if err := repo.CreateAuditLog(ctx, entry); err != nil {
+		logf(ctx, "Failed to record purge of document %s in audit log: %v", doc.ID.String(), err)
+	}
+	return nil
+}
@@ -0,0 +1,183 @@
+package worker
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"xpired/internal/db"
+
+	"github.com/google/uuid"
+	"github.com/hibiken/asynq"
+)
+
+const (
+	webhookTimeout  = 10 * time.Second
+	webhookMaxRetry = 8
+)
+
+type webhookEventPayload struct {
+	UserID string          `json:"user_id"`
+	Event  string          `json:"event"`
+	Data   json.RawMessage `json:"data"`
+}
+
+type webhookDeliveryPayload struct {
+	DeliveryID string `json:"delivery_id"`
+}
+
+// webhookEnvelope is the JSON body POSTed to endpoints.
+type webhookEnvelope struct {
+	ID        string          `json:"id"`
+	Event     string          `json:"event"`
+	CreatedAt time.Time       `json:"createdAt"`
+	Data      json.RawMessage `json:"data"`
+}
+
+type webhookProcessor struct {
+	repo   db.WebhookRepository
+	client *http.Client
+}
+
+// SignWebhook computes the X-Xpired-Signature header for body sent at
+// timestamp: "t=<unix>" followed by one "v1=<hex hmac>" per secret, so a
+// receiver holding either the old or the new secret can verify it during a
+// rotation. The HMAC-SHA256 covers "<unix>.<body>".
+func SignWebhook(secrets []string, timestamp time.Time, body []byte) string {
+	ts := strconv.FormatInt(timestamp.Unix(), 10)
+	parts := []string{"t=" + ts}
+	for _, secret := range secrets {
+		mac := hmac.New(sha256.New, []byte(secret))
+		mac.Write([]byte(ts + "."))
+		mac.Write(body)
+		parts = append(parts, "v1="+hex.EncodeToString(mac.Sum(nil)))
+	}
+	return strings.Join(parts, ",")
+}
+
+// handleEmitWebhookEvent fans an event out to every endpoint of the user
+// subscribed to it, logging one delivery per endpoint.
+func (p *webhookProcessor) handleEmitWebhookEvent(ctx context.Context, t *asynq.Task) error {
+	var payload webhookEventPayload
+	if err := json.Unmarshal(t.Payload(), &payload); err != nil {
+		return err
+	}
+
+	endpoints, err := p.repo.ListWebhookEndpointsForEvent(ctx, payload.UserID, payload.Event)
+	if err != nil {
+		return err
+	}
+
+	for _, endpoint := range endpoints {
+		deliveryID := uuid.New()
+		body, _ := json.Marshal(webhookEnvelope{
+			ID:        deliveryID.String(),
+			Event:     payload.Event,
+			CreatedAt: time.Now().UTC(),
+			Data:      payload.Data,
+		})
+		delivery := &db.WebhookDelivery{
+			ID:         deliveryID,
+			EndpointID: endpoint.ID,
+			Event:      payload.Event,
+			Payload:    body,
+			Status:     db.WebhookDeliveryPending,
+		}
+		if err := p.repo.CreateWebhookDelivery(ctx, delivery); err != nil {
+			logf(ctx, "Failed to log %s delivery for webhook %s: %v", payload.Event, endpoint.ID.String(), err)
+			continue
+		}
+		if err := ScheduleWebhookDelivery(ctx, deliveryID.String()); err != nil {
+			logf(ctx, "Failed to enqueue webhook delivery %s: %v", deliveryID.String(), err)
+		}
+	}
+	return nil
+}
+
+// handleDeliverWebhook POSTs one logged delivery and records the response
+// code and latency. A failed attempt returns an error so asynq retries it
+// with backoff; every attempt is counted on the delivery.
+func (p *webhookProcessor) handleDeliverWebhook(ctx context.Context, t *asynq.Task) error {
+	var payload webhookDeliveryPayload
+	if err := json.Unmarshal(t.Payload(), &payload); err != nil {
+		return err
+	}
+
+	delivery, err := p.repo.GetWebhookDelivery(ctx, payload.DeliveryID)
+	if err != nil {
+		if err.Error() == "webhook delivery not found" {
+			return nil
+		}
+		return err
+	}
+	if delivery.Status == db.WebhookDeliverySucceeded {
+		return nil
+	}
+
+	endpoint, err := p.repo.GetWebhookEndpoint(ctx, delivery.EndpointID.String())
+	if err != nil {
+		if err.Error() == "webhook endpoint not found" {
+			return nil
+		}
+		return err
+	}
+	if !endpoint.Enabled {
+		logf(ctx, "Skipping delivery %s: webhook %s is disabled", payload.DeliveryID, endpoint.ID.String())
+		return nil
+	}
+
+	sendErr := p.send(ctx, endpoint, delivery)
+	if err := p.repo.RecordWebhookDeliveryAttempt(ctx, delivery); err != nil {
+		logf(ctx, "Failed to record attempt for webhook delivery %s: %v", payload.DeliveryID, err)
+	}
+	return sendErr
+}
+
+// send performs one attempt and fills in the delivery's outcome fields.
+func (p *webhookProcessor) send(ctx context.Context, endpoint *db.WebhookEndpoint, delivery *db.WebhookDelivery) error {
+	delivery.ResponseCode, delivery.LatencyMs, delivery.Error = nil, nil, nil
+	fail := func(err error) error {
+		msg := err.Error()
+		delivery.Status = db.WebhookDeliveryFailed
+		delivery.Error = &msg
+		return err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, endpoint.URL, bytes.NewReader(delivery.Payload))
+	if err != nil {
+		return fail(fmt.Errorf("invalid webhook request: %w", err))
+	}
+	now := time.Now()
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("User-Agent", "xpired-webhooks/1.0")
+	req.Header.Set("X-Xpired-Event", delivery.Event)
+	req.Header.Set("X-Xpired-Delivery", delivery.ID.String())
+	req.Header.Set("X-Xpired-Signature", SignWebhook(endpoint.SigningSecrets(now), now, delivery.Payload))
+
+	resp, err := p.client.Do(req)
+	latency := int(time.Since(now).Milliseconds())
+	delivery.LatencyMs = &latency
+	if err != nil {
+		return fail(fmt.Errorf("webhook request failed: %w", err))
+	}
+	defer resp.Body.Close()
+
+	code := resp.StatusCode
+	delivery.ResponseCode = &code
+	if code < 200 || code > 299 {
+		// the body is not kept: the delivery log is shown to the user, and
+		// should never relay what the target answered
+		return fail(fmt.Errorf("webhook endpoint responded %d", code))
+	}
+
+	delivery.Status = db.WebhookDeliverySucceeded
+	return nil
+}
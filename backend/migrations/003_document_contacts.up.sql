@@ -0,0 +1,13 @@
+-- document_contacts (extra people notified about a document who do not have accounts)
+CREATE TABLE IF NOT EXISTS document_contacts (
+    id uuid PRIMARY KEY DEFAULT gen_random_uuid(),
+    document_id uuid REFERENCES documents(id) ON DELETE CASCADE,
+    name text,
+    email text NOT NULL,
+    unsubscribe_token text UNIQUE NOT NULL,
+    unsubscribed_at timestamptz NULL,
+    created_at timestamptz DEFAULT now()
+);
+
+CREATE INDEX IF NOT EXISTS idx_document_contacts_document_id ON document_contacts(document_id);
+CREATE UNIQUE INDEX IF NOT EXISTS idx_document_contacts_document_email ON document_contacts(document_id, email);
@@ -0,0 +1,20 @@
+-- households (lightweight family grouping managed by a primary user)
+CREATE TABLE IF NOT EXISTS households (
+    id uuid PRIMARY KEY DEFAULT gen_random_uuid(),
+    name text NOT NULL,
+    primary_user_id uuid REFERENCES users(id) ON DELETE CASCADE,
+    created_at timestamptz DEFAULT now(),
+    updated_at timestamptz DEFAULT now()
+);
+
+-- household_members (a user belongs to at most one household)
+CREATE TABLE IF NOT EXISTS household_members (
+    household_id uuid REFERENCES households(id) ON DELETE CASCADE,
+    user_id uuid REFERENCES users(id) ON DELETE CASCADE,
+    role text NOT NULL DEFAULT 'member', -- 'primary' | 'member'
+    notification_routing text NOT NULL DEFAULT 'member', -- 'member' | 'primary' | 'both'
+    created_at timestamptz DEFAULT now(),
+    PRIMARY KEY (household_id, user_id)
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_household_members_user_id ON household_members(user_id);
@@ -0,0 +1,13 @@
+-- document_checklist_items (ordered renewal steps for a document)
+CREATE TABLE IF NOT EXISTS document_checklist_items (
+    id uuid PRIMARY KEY DEFAULT gen_random_uuid(),
+    document_id uuid REFERENCES documents(id) ON DELETE CASCADE,
+    position int NOT NULL DEFAULT 0,
+    title text NOT NULL,
+    done boolean NOT NULL DEFAULT false,
+    done_at timestamptz NULL,
+    created_at timestamptz DEFAULT now(),
+    updated_at timestamptz DEFAULT now()
+);
+
+CREATE INDEX IF NOT EXISTS idx_document_checklist_items_document_id ON document_checklist_items(document_id, position);
@@ -0,0 +1,46 @@
+-- document_categories (kinds of documents, each with a default reminder set)
+CREATE TABLE IF NOT EXISTS document_categories (
+    slug text PRIMARY KEY, -- e.g. 'passport'
+    name text NOT NULL
+);
+
+-- category_default_reminders (reminder intervals applied when a document of the category has none)
+CREATE TABLE IF NOT EXISTS category_default_reminders (
+    category_slug text REFERENCES document_categories(slug) ON DELETE CASCADE,
+    reminder_interval_id int REFERENCES reminder_intervals(id) ON DELETE CASCADE,
+    PRIMARY KEY (category_slug, reminder_interval_id)
+);
+
+ALTER TABLE documents ADD COLUMN IF NOT EXISTS category text REFERENCES document_categories(slug) ON DELETE SET NULL;
+
+CREATE INDEX IF NOT EXISTS idx_documents_category ON documents(category);
+
+INSERT INTO document_categories (slug, name) VALUES
+('passport', 'Passport'),
+('drivers_license', 'Driver''s License'),
+('national_id', 'National ID'),
+('visa', 'Visa / Residence Permit'),
+('insurance', 'Insurance Policy'),
+('vehicle_registration', 'Vehicle Registration'),
+('domain', 'Domain Name'),
+('certificate', 'TLS Certificate'),
+('lease', 'Lease / Rental Agreement'),
+('other', 'Other')
+ON CONFLICT (slug) DO NOTHING;
+
+INSERT INTO category_default_reminders (category_slug, reminder_interval_id)
+SELECT d.category_slug, ri.id
+FROM (VALUES
+    ('passport', '180d'), ('passport', '90d'), ('passport', '30d'),
+    ('drivers_license', '90d'), ('drivers_license', '30d'), ('drivers_license', '7d'),
+    ('national_id', '90d'), ('national_id', '30d'),
+    ('visa', '90d'), ('visa', '60d'), ('visa', '30d'), ('visa', '7d'),
+    ('insurance', '30d'), ('insurance', '7d'), ('insurance', '1d'),
+    ('vehicle_registration', '30d'), ('vehicle_registration', '7d'),
+    ('domain', '60d'), ('domain', '30d'), ('domain', '7d'), ('domain', '1d'),
+    ('certificate', '30d'), ('certificate', '14d'), ('certificate', '3d'), ('certificate', '1d'),
+    ('lease', '90d'), ('lease', '60d'), ('lease', '30d'),
+    ('other', '30d'), ('other', '7d'), ('other', '1d')
+) AS d(category_slug, id_label)
+JOIN reminder_intervals ri ON ri.id_label = d.id_label
+ON CONFLICT DO NOTHING;
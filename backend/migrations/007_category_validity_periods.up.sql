@@ -0,0 +1,26 @@
+-- category_validity_periods (knowledge base of typical validity periods used to suggest expiration dates)
+CREATE TABLE IF NOT EXISTS category_validity_periods (
+    id serial PRIMARY KEY,
+    category_slug text REFERENCES document_categories(slug) ON DELETE CASCADE,
+    country_code text NULL, -- ISO 3166-1 alpha-2; NULL means the general default
+    validity_months int NOT NULL,
+    notes text
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_category_validity_periods_category_country
+    ON category_validity_periods(category_slug, COALESCE(country_code, ''));
+
+INSERT INTO category_validity_periods (category_slug, country_code, validity_months, notes) VALUES
+('passport', NULL, 120, 'Most adult passports are valid for 10 years'),
+('passport', 'GH', 120, 'Ghanaian ordinary passports are valid for 10 years'),
+('passport', 'US', 120, 'US adult passports are valid for 10 years'),
+('passport', 'GB', 120, 'UK adult passports are valid for 10 years'),
+('drivers_license', NULL, 60, 'Driving licences are commonly renewed every 5 years'),
+('national_id', NULL, 120, 'National ID cards are commonly valid for 10 years'),
+('visa', NULL, 12, 'Visas vary widely; 1 year is a common default'),
+('insurance', NULL, 12, 'Insurance policies usually renew annually'),
+('vehicle_registration', NULL, 12, 'Vehicle registrations usually renew annually'),
+('domain', NULL, 12, 'Domain names are usually registered for 1 year'),
+('certificate', NULL, 3, 'Automated TLS certificates are typically valid for 90 days'),
+('lease', NULL, 12, 'Residential leases commonly run for 1 year')
+ON CONFLICT DO NOTHING;
@@ -0,0 +1,17 @@
+-- notification tracking (opens/bounces) and escalation bookkeeping
+ALTER TABLE notification_logs ADD COLUMN IF NOT EXISTS message_id uuid;
+ALTER TABLE notification_logs ADD COLUMN IF NOT EXISTS recipient_id uuid REFERENCES users(id) ON DELETE SET NULL;
+ALTER TABLE notification_logs ADD COLUMN IF NOT EXISTS opened_at timestamptz NULL;
+ALTER TABLE notification_logs ADD COLUMN IF NOT EXISTS bounced_at timestamptz NULL;
+ALTER TABLE notification_logs ADD COLUMN IF NOT EXISTS escalated_at timestamptz NULL;
+
+CREATE INDEX IF NOT EXISTS idx_notification_logs_message_id ON notification_logs(message_id);
+
+-- notification_preferences (per-user delivery policy; a missing row means defaults)
+CREATE TABLE IF NOT EXISTS notification_preferences (
+    user_id uuid PRIMARY KEY REFERENCES users(id) ON DELETE CASCADE,
+    escalation_channel text NOT NULL DEFAULT 'none', -- 'none' | 'sms'
+    escalation_after_days int NOT NULL DEFAULT 2,
+    created_at timestamptz DEFAULT now(),
+    updated_at timestamptz DEFAULT now()
+);
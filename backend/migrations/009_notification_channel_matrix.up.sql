@@ -0,0 +1,3 @@
+-- per-interval delivery channels, keyed by reminder interval id_label,
+-- e.g. {"30d": ["email"], "7d": ["email", "sms"], "1d": ["sms", "push"]}
+ALTER TABLE notification_preferences ADD COLUMN IF NOT EXISTS channel_matrix jsonb NOT NULL DEFAULT '{}';
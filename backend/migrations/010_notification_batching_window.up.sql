@@ -0,0 +1,14 @@
+-- per-user batching window: reminders are held for up to batch_window_hours
+-- and then sent together (0 disables holding)
+ALTER TABLE notification_preferences ADD COLUMN IF NOT EXISTS batch_window_hours int NOT NULL DEFAULT 0;
+
+-- held_reminders (reminders waiting for their owner's batching window to close)
+CREATE TABLE IF NOT EXISTS held_reminders (
+    document_id uuid NOT NULL REFERENCES documents(id) ON DELETE CASCADE,
+    reminder_interval_id int NOT NULL REFERENCES reminder_intervals(id),
+    user_id uuid NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    held_at timestamptz DEFAULT now(),
+    PRIMARY KEY (document_id, reminder_interval_id)
+);
+
+CREATE INDEX IF NOT EXISTS idx_held_reminders_user_id ON held_reminders(user_id);
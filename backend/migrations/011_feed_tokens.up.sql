@@ -0,0 +1,6 @@
+-- feed_tokens (secret token per user for the public expirations feed)
+CREATE TABLE IF NOT EXISTS feed_tokens (
+    user_id uuid PRIMARY KEY REFERENCES users(id) ON DELETE CASCADE,
+    token text NOT NULL UNIQUE,
+    created_at timestamptz DEFAULT now()
+);
@@ -0,0 +1,33 @@
+-- webhook_endpoints (outbound webhooks; previous_secret stays valid until it expires after a rotation)
+CREATE TABLE IF NOT EXISTS webhook_endpoints (
+    id uuid PRIMARY KEY,
+    user_id uuid NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    url text NOT NULL,
+    events text[] NOT NULL DEFAULT '{}', -- empty means every event
+    secret text NOT NULL,
+    previous_secret text NULL,
+    previous_secret_expires_at timestamptz NULL,
+    enabled boolean NOT NULL DEFAULT true,
+    created_at timestamptz DEFAULT now(),
+    updated_at timestamptz DEFAULT now()
+);
+
+CREATE INDEX IF NOT EXISTS idx_webhook_endpoints_user_id ON webhook_endpoints(user_id);
+
+-- webhook_deliveries (one row per event sent to an endpoint, updated on every attempt)
+CREATE TABLE IF NOT EXISTS webhook_deliveries (
+    id uuid PRIMARY KEY,
+    endpoint_id uuid NOT NULL REFERENCES webhook_endpoints(id) ON DELETE CASCADE,
+    event text NOT NULL,
+    payload jsonb NOT NULL,
+    status text NOT NULL DEFAULT 'pending', -- 'pending' | 'succeeded' | 'failed'
+    attempts int NOT NULL DEFAULT 0,
+    response_code int NULL,
+    latency_ms int NULL,
+    error text NULL,
+    redelivery_of uuid NULL,
+    created_at timestamptz DEFAULT now(),
+    last_attempt_at timestamptz NULL
+);
+
+CREATE INDEX IF NOT EXISTS idx_webhook_deliveries_endpoint_id ON webhook_deliveries(endpoint_id, created_at DESC);
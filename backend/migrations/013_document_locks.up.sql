@@ -0,0 +1,7 @@
+-- document_locks (advisory edit locks; a row past expires_at is free to take)
+CREATE TABLE IF NOT EXISTS document_locks (
+    document_id uuid PRIMARY KEY REFERENCES documents(id) ON DELETE CASCADE,
+    user_id uuid NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    locked_at timestamptz NOT NULL DEFAULT now(),
+    expires_at timestamptz NOT NULL
+);
@@ -0,0 +1,18 @@
+-- soft delete: deleted documents stay in the trash until the purge job removes them
+ALTER TABLE documents ADD COLUMN IF NOT EXISTS deleted_at timestamptz NULL;
+
+CREATE INDEX IF NOT EXISTS idx_documents_deleted_at ON documents(deleted_at) WHERE deleted_at IS NOT NULL;
+
+-- audit_logs (who did what to which entity; actor_id is NULL for system jobs)
+CREATE TABLE IF NOT EXISTS audit_logs (
+    id uuid PRIMARY KEY,
+    actor_id uuid NULL,
+    user_id uuid NULL, -- whose data the entry is about
+    action text NOT NULL,
+    entity_type text NOT NULL,
+    entity_id text NOT NULL,
+    metadata jsonb NULL,
+    created_at timestamptz DEFAULT now()
+);
+
+CREATE INDEX IF NOT EXISTS idx_audit_logs_user_id ON audit_logs(user_id, created_at DESC);
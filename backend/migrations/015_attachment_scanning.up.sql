@@ -0,0 +1,4 @@
+-- attachment scan state for uploaded attachments; NULL for external links that are not scanned
+ALTER TABLE documents ADD COLUMN IF NOT EXISTS attachment_status text NULL; -- 'pending' | 'clean' | 'infected' | 'unscanned' | 'error'
+ALTER TABLE documents ADD COLUMN IF NOT EXISTS attachment_threat text NULL;
+ALTER TABLE documents ADD COLUMN IF NOT EXISTS attachment_scanned_at timestamptz NULL;
@@ -0,0 +1,5 @@
+-- identifiers are stored AES-GCM encrypted ("enc:v1:..."); identifier_index is a keyed HMAC of the
+-- normalized plaintext so exact-match search works without decrypting
+ALTER TABLE documents ADD COLUMN IF NOT EXISTS identifier_index text NULL;
+
+CREATE INDEX IF NOT EXISTS idx_documents_identifier_index ON documents(user_id, identifier_index) WHERE identifier_index IS NOT NULL;
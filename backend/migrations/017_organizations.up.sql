@@ -0,0 +1,22 @@
+-- organizations; data_region names the regional database holding the org's documents (NULL = home database)
+CREATE TABLE IF NOT EXISTS organizations (
+    id uuid PRIMARY KEY,
+    name text NOT NULL,
+    data_region text NULL,
+    created_at timestamptz DEFAULT now()
+);
+
+CREATE TABLE IF NOT EXISTS organization_members (
+    organization_id uuid NOT NULL REFERENCES organizations(id) ON DELETE CASCADE,
+    user_id uuid NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    role text NOT NULL DEFAULT 'member', -- 'owner' | 'admin' | 'member'
+    created_at timestamptz DEFAULT now(),
+    PRIMARY KEY (organization_id, user_id)
+);
+
+CREATE INDEX IF NOT EXISTS idx_organization_members_user_id ON organization_members(user_id);
+
+-- no FK: in a regional database the organization row only exists in the home database
+ALTER TABLE documents ADD COLUMN IF NOT EXISTS organization_id uuid NULL;
+
+CREATE INDEX IF NOT EXISTS idx_documents_organization_id ON documents(organization_id) WHERE organization_id IS NOT NULL;
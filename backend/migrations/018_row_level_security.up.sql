@@ -0,0 +1,49 @@
+-- row-level security on document data. The API sets app.user_id, and app.organization_id when acting
+-- for an organization, on the connection before each statement; with app.user_id empty (background
+-- jobs, migrations, backups) every row is visible. FORCE makes the policies apply to the table owner,
+-- which is the role the application connects as.
+CREATE OR REPLACE FUNCTION app_user_id() RETURNS uuid
+    LANGUAGE sql STABLE AS $$ SELECT NULLIF(current_setting('app.user_id', true), '')::uuid $$;
+
+CREATE OR REPLACE FUNCTION app_organization_id() RETURNS uuid
+    LANGUAGE sql STABLE AS $$ SELECT NULLIF(current_setting('app.organization_id', true), '')::uuid $$;
+
+ALTER TABLE documents ENABLE ROW LEVEL SECURITY;
+ALTER TABLE documents FORCE ROW LEVEL SECURITY;
+
+-- a user sees the organization's documents when acting for it, otherwise their personal documents and
+-- those of the household members they are primary of
+DROP POLICY IF EXISTS documents_tenant ON documents;
+CREATE POLICY documents_tenant ON documents
+    USING (
+        app_user_id() IS NULL
+        OR (app_organization_id() IS NOT NULL AND organization_id = app_organization_id())
+        OR (app_organization_id() IS NULL AND organization_id IS NULL AND (
+            user_id = app_user_id()
+            OR EXISTS (
+                SELECT 1
+                FROM households h
+                JOIN household_members m ON m.household_id = h.id
+                WHERE h.primary_user_id = app_user_id() AND m.user_id = documents.user_id
+            )
+        ))
+    );
+
+-- rows hanging off a document follow it; the subquery is itself filtered by documents_tenant
+ALTER TABLE document_reminders ENABLE ROW LEVEL SECURITY;
+ALTER TABLE document_reminders FORCE ROW LEVEL SECURITY;
+DROP POLICY IF EXISTS document_reminders_tenant ON document_reminders;
+CREATE POLICY document_reminders_tenant ON document_reminders
+    USING (app_user_id() IS NULL OR document_id IN (SELECT id FROM documents));
+
+ALTER TABLE document_contacts ENABLE ROW LEVEL SECURITY;
+ALTER TABLE document_contacts FORCE ROW LEVEL SECURITY;
+DROP POLICY IF EXISTS document_contacts_tenant ON document_contacts;
+CREATE POLICY document_contacts_tenant ON document_contacts
+    USING (app_user_id() IS NULL OR document_id IN (SELECT id FROM documents));
+
+ALTER TABLE document_checklist_items ENABLE ROW LEVEL SECURITY;
+ALTER TABLE document_checklist_items FORCE ROW LEVEL SECURITY;
+DROP POLICY IF EXISTS document_checklist_items_tenant ON document_checklist_items;
+CREATE POLICY document_checklist_items_tenant ON document_checklist_items
+    USING (app_user_id() IS NULL OR document_id IN (SELECT id FROM documents));
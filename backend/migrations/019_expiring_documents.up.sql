@@ -0,0 +1,14 @@
+-- expiring_documents: live documents expiring from 30 days ago to 90 days ahead, so digest and sweeper
+-- jobs can ask "what expires in window X" without scanning documents. The refresh job rebuilds it with
+-- REFRESH ... CONCURRENTLY, which needs the unique index and applies only the rows that changed.
+-- Materialized views bypass row-level security: only background jobs read it, and the repository
+-- scopes reads to app.user_id when one is set.
+CREATE MATERIALIZED VIEW IF NOT EXISTS expiring_documents AS
+SELECT id AS document_id, user_id, organization_id, name, category, expiration_date, timezone
+FROM documents
+WHERE deleted_at IS NULL
+    AND expiration_date >= now() - interval '30 days'
+    AND expiration_date < now() + interval '90 days';
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_expiring_documents_document_id ON expiring_documents(document_id);
+CREATE INDEX IF NOT EXISTS idx_expiring_documents_expiration_date ON expiring_documents(expiration_date, user_id);
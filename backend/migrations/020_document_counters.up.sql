@@ -0,0 +1,41 @@
+-- document_counters: live documents per user (and organization), bucketed by UTC expiration day. The
+-- repository updates them in the same transaction as the document write, so summing a user's buckets
+-- gives total, expired and expiring-in-30-days counts without scanning documents, and the counts stay
+-- right as days pass.
+CREATE TABLE IF NOT EXISTS document_counters (
+    user_id uuid NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    organization_id uuid NULL,
+    expiration_day date NOT NULL,
+    documents integer NOT NULL DEFAULT 0
+);
+
+-- organization_id is NULL for personal documents, so the key coalesces it
+CREATE UNIQUE INDEX IF NOT EXISTS idx_document_counters_key
+    ON document_counters(user_id, COALESCE(organization_id, '00000000-0000-0000-0000-000000000000'::uuid), expiration_day);
+
+INSERT INTO document_counters (user_id, organization_id, expiration_day, documents)
+SELECT user_id, organization_id, (expiration_date AT TIME ZONE 'UTC')::date, count(*)
+FROM documents
+WHERE deleted_at IS NULL AND user_id IS NOT NULL
+GROUP BY user_id, organization_id, (expiration_date AT TIME ZONE 'UTC')::date
+ON CONFLICT DO NOTHING;
+
+-- the same tenants see the counters as see the documents they count
+ALTER TABLE document_counters ENABLE ROW LEVEL SECURITY;
+ALTER TABLE document_counters FORCE ROW LEVEL SECURITY;
+
+DROP POLICY IF EXISTS document_counters_tenant ON document_counters;
+CREATE POLICY document_counters_tenant ON document_counters
+    USING (
+        app_user_id() IS NULL
+        OR (app_organization_id() IS NOT NULL AND organization_id = app_organization_id())
+        OR (app_organization_id() IS NULL AND organization_id IS NULL AND (
+            user_id = app_user_id()
+            OR EXISTS (
+                SELECT 1
+                FROM households h
+                JOIN household_members m ON m.household_id = h.id
+                WHERE h.primary_user_id = app_user_id() AND m.user_id = document_counters.user_id
+            )
+        ))
+    );
@@ -0,0 +1,16 @@
+-- announcements: maintenance and feature notices admins publish to every user. They show in the
+-- frontend banner between starts_at and ends_at; emailed_at is set once the email send has started.
+CREATE TABLE IF NOT EXISTS announcements (
+    id uuid PRIMARY KEY,
+    title text NOT NULL,
+    body text NOT NULL,
+    kind text NOT NULL DEFAULT 'feature', -- 'maintenance' | 'feature'
+    starts_at timestamptz NOT NULL DEFAULT now(),
+    ends_at timestamptz NULL,
+    send_email boolean NOT NULL DEFAULT false,
+    emailed_at timestamptz NULL,
+    created_by uuid NULL REFERENCES users(id) ON DELETE SET NULL,
+    created_at timestamptz DEFAULT now()
+);
+
+CREATE INDEX IF NOT EXISTS idx_announcements_starts_at ON announcements(starts_at DESC);
@@ -0,0 +1,4 @@
+-- suspended accounts cannot sign in or use their tokens, and the worker skips their notifications
+-- and tasks until an admin reinstates them
+ALTER TABLE users ADD COLUMN IF NOT EXISTS suspended_at timestamptz NULL;
+ALTER TABLE users ADD COLUMN IF NOT EXISTS suspension_reason text NULL;
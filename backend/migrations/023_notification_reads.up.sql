@@ -0,0 +1,7 @@
+-- notifications stay unread until the user marks them read, which drives the frontend badge.
+-- history from before read tracking counts as read.
+ALTER TABLE notification_logs ADD COLUMN IF NOT EXISTS read_at timestamptz NULL;
+
+UPDATE notification_logs SET read_at = created_at WHERE read_at IS NULL;
+
+CREATE INDEX IF NOT EXISTS idx_notification_logs_unread ON notification_logs(user_id) WHERE read_at IS NULL;
@@ -0,0 +1,18 @@
+-- document_assignments: the organization member responsible for a team document. Its reminders go to
+-- the assignee instead of the owner, and an organization admin is emailed when the assignee does not
+-- acknowledge a reminder in time. acknowledged_at is reset whenever the document is reassigned.
+CREATE TABLE IF NOT EXISTS document_assignments (
+    document_id uuid PRIMARY KEY REFERENCES documents(id) ON DELETE CASCADE,
+    assignee_id uuid NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    assigned_by uuid NULL REFERENCES users(id) ON DELETE SET NULL,
+    assigned_at timestamptz NOT NULL DEFAULT now(),
+    acknowledged_at timestamptz NULL
+);
+
+CREATE INDEX IF NOT EXISTS idx_document_assignments_assignee_id ON document_assignments(assignee_id);
+
+ALTER TABLE document_assignments ENABLE ROW LEVEL SECURITY;
+ALTER TABLE document_assignments FORCE ROW LEVEL SECURITY;
+DROP POLICY IF EXISTS document_assignments_tenant ON document_assignments;
+CREATE POLICY document_assignments_tenant ON document_assignments
+    USING (app_user_id() IS NULL OR document_id IN (SELECT id FROM documents));
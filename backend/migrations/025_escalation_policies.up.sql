@@ -0,0 +1,9 @@
+-- escalation_policy_steps: an organization's escalation chain. A reminder sent days_before or fewer days
+-- before expiration also reaches the step's audience, on top of every earlier step's. Organizations
+-- without steps keep the per-user routing.
+CREATE TABLE IF NOT EXISTS escalation_policy_steps (
+    organization_id uuid NOT NULL REFERENCES organizations(id) ON DELETE CASCADE,
+    days_before integer NOT NULL CHECK (days_before >= 0),
+    audience text NOT NULL, -- 'owner' | 'managers' | 'everyone'
+    PRIMARY KEY (organization_id, days_before)
+);
@@ -0,0 +1,4 @@
+-- compliance mode: while enabled, reminders on the organization's documents in compliance_categories
+-- cannot be turned off
+ALTER TABLE organizations ADD COLUMN IF NOT EXISTS compliance_mode boolean NOT NULL DEFAULT false;
+ALTER TABLE organizations ADD COLUMN IF NOT EXISTS compliance_categories text[] NOT NULL DEFAULT '{}';
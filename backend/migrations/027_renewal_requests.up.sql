@@ -0,0 +1,25 @@
+-- renewal approval: while organizations.renewal_approval is on, a member renews a document by proposing
+-- a new expiration date with evidence; the date only changes once an owner or admin approves it
+ALTER TABLE organizations ADD COLUMN IF NOT EXISTS renewal_approval boolean NOT NULL DEFAULT false;
+
+CREATE TABLE IF NOT EXISTS renewal_requests (
+    id uuid PRIMARY KEY,
+    document_id uuid NOT NULL REFERENCES documents(id) ON DELETE CASCADE,
+    requested_by uuid NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    expiration_date date NOT NULL,
+    evidence_url text NOT NULL,
+    status text NOT NULL DEFAULT 'pending', -- 'pending' | 'approved' | 'rejected'
+    decided_by uuid NULL REFERENCES users(id) ON DELETE SET NULL,
+    decided_at timestamptz NULL,
+    note text NULL,
+    created_at timestamptz DEFAULT now()
+);
+
+-- at most one open proposal per document
+CREATE UNIQUE INDEX IF NOT EXISTS idx_renewal_requests_pending ON renewal_requests(document_id) WHERE status = 'pending';
+
+ALTER TABLE renewal_requests ENABLE ROW LEVEL SECURITY;
+ALTER TABLE renewal_requests FORCE ROW LEVEL SECURITY;
+DROP POLICY IF EXISTS renewal_requests_tenant ON renewal_requests;
+CREATE POLICY renewal_requests_tenant ON renewal_requests
+    USING (app_user_id() IS NULL OR document_id IN (SELECT id FROM documents));
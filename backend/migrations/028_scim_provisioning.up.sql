@@ -0,0 +1,9 @@
+-- scim_tokens (bearer token an organization's identity provider uses for SCIM provisioning)
+CREATE TABLE IF NOT EXISTS scim_tokens (
+    organization_id uuid PRIMARY KEY REFERENCES organizations(id) ON DELETE CASCADE,
+    token text NOT NULL UNIQUE,
+    created_at timestamptz DEFAULT now()
+);
+
+-- the identity provider's id for a provisioned member
+ALTER TABLE organization_members ADD COLUMN IF NOT EXISTS scim_external_id text;
@@ -0,0 +1,39 @@
+-- event_log: append-only record of reminder sends and acknowledgments on organization documents. rows
+-- are hash-chained per organization: each hash covers the row and the hash of the row before it, so
+-- editing or removing any row breaks the chain from there on. document_id has no foreign key because
+-- events outlive purged documents
+CREATE TABLE IF NOT EXISTS event_log (
+    organization_id uuid NOT NULL,
+    seq bigint NOT NULL,
+    event_type text NOT NULL, -- 'reminder.sent' | 'reminder.acknowledged'
+    document_id uuid NOT NULL,
+    actor_id uuid NULL,
+    recipient_id uuid NULL,
+    channel text NULL,
+    detail text NOT NULL DEFAULT '{}', -- JSON, kept as text so its hashed bytes are preserved
+    occurred_at timestamptz NOT NULL,
+    prev_hash text NOT NULL,
+    hash text NOT NULL,
+    PRIMARY KEY (organization_id, seq)
+);
+
+CREATE OR REPLACE FUNCTION event_log_append_only() RETURNS trigger
+    LANGUAGE plpgsql AS $$
+BEGIN
+    RAISE EXCEPTION 'event_log is append-only';
+END;
+$$;
+
+DROP TRIGGER IF EXISTS event_log_no_update ON event_log;
+CREATE TRIGGER event_log_no_update BEFORE UPDATE OR DELETE ON event_log
+    FOR EACH ROW EXECUTE FUNCTION event_log_append_only();
+
+DROP TRIGGER IF EXISTS event_log_no_truncate ON event_log;
+CREATE TRIGGER event_log_no_truncate BEFORE TRUNCATE ON event_log
+    FOR EACH STATEMENT EXECUTE FUNCTION event_log_append_only();
+
+ALTER TABLE event_log ENABLE ROW LEVEL SECURITY;
+ALTER TABLE event_log FORCE ROW LEVEL SECURITY;
+DROP POLICY IF EXISTS event_log_tenant ON event_log;
+CREATE POLICY event_log_tenant ON event_log
+    USING (app_user_id() IS NULL OR organization_id = app_organization_id());
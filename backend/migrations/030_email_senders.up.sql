@@ -0,0 +1,12 @@
+-- email_senders (custom From identity of an organization's reminder emails,
+-- used once the address's domain is verified through a dns txt record)
+CREATE TABLE IF NOT EXISTS email_senders (
+    organization_id uuid PRIMARY KEY REFERENCES organizations(id) ON DELETE CASCADE,
+    from_name text NOT NULL,
+    from_address text NOT NULL,
+    verification_token text NOT NULL,
+    verified_at timestamptz,
+    checked_at timestamptz,
+    created_at timestamptz DEFAULT now(),
+    updated_at timestamptz DEFAULT now()
+);
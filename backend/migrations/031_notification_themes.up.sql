@@ -0,0 +1,9 @@
+-- notification_themes (an organization's branding of the emails sent for its documents)
+CREATE TABLE IF NOT EXISTS notification_themes (
+    organization_id uuid PRIMARY KEY REFERENCES organizations(id) ON DELETE CASCADE,
+    logo_url text,
+    primary_color text,
+    background_color text,
+    footer_text text,
+    updated_at timestamptz DEFAULT now()
+);
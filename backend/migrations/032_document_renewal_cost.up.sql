@@ -0,0 +1,3 @@
+-- optional cost of renewing a document, in the iso 4217 currency next to it
+ALTER TABLE documents ADD COLUMN IF NOT EXISTS renewal_cost numeric(15, 3);
+ALTER TABLE documents ADD COLUMN IF NOT EXISTS currency text;
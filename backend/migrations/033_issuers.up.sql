@@ -0,0 +1,17 @@
+-- issuers (directory of the authorities that issue and renew documents, e.g.
+-- a passport office or vehicle registry)
+CREATE TABLE IF NOT EXISTS issuers (
+    id uuid PRIMARY KEY,
+    name text NOT NULL,
+    country_code text,
+    renewal_url text,
+    processing_days integer,
+    created_at timestamptz DEFAULT now(),
+    updated_at timestamptz DEFAULT now()
+);
+
+CREATE INDEX IF NOT EXISTS idx_issuers_country_code ON issuers(country_code);
+
+-- no foreign key: issuers live in the home database while documents may be
+-- kept in a data region
+ALTER TABLE documents ADD COLUMN IF NOT EXISTS issuer_id uuid;
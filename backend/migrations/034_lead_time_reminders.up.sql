@@ -0,0 +1,18 @@
+-- lead-time reminders: besides the standard intervals, a document gets a "start your renewal" reminder
+-- as long before expiry as the renewal takes: lead_time_days when set, otherwise its issuer's
+-- processing time
+ALTER TABLE documents ADD COLUMN IF NOT EXISTS lead_time_days integer;
+
+-- the expiration date the lead-time reminder was last sent for; renewing the document moves the date
+-- and so re-arms the reminder
+CREATE TABLE IF NOT EXISTS lead_time_reminders (
+    document_id uuid PRIMARY KEY REFERENCES documents(id) ON DELETE CASCADE,
+    expiration_date date NOT NULL,
+    sent_at timestamptz NOT NULL DEFAULT now()
+);
+
+ALTER TABLE lead_time_reminders ENABLE ROW LEVEL SECURITY;
+ALTER TABLE lead_time_reminders FORCE ROW LEVEL SECURITY;
+DROP POLICY IF EXISTS lead_time_reminders_tenant ON lead_time_reminders;
+CREATE POLICY lead_time_reminders_tenant ON lead_time_reminders
+    USING (app_user_id() IS NULL OR document_id IN (SELECT id FROM documents));
@@ -0,0 +1,17 @@
+-- document dependencies: a document can depend on others (a visa on the passport it is stamped in);
+-- when a document's expiration date changes or it expires, the owners of its dependents are told
+CREATE TABLE IF NOT EXISTS document_dependencies (
+    document_id uuid NOT NULL REFERENCES documents(id) ON DELETE CASCADE,
+    depends_on_id uuid NOT NULL REFERENCES documents(id) ON DELETE CASCADE,
+    created_at timestamptz DEFAULT now(),
+    PRIMARY KEY (document_id, depends_on_id),
+    CHECK (document_id <> depends_on_id)
+);
+
+CREATE INDEX IF NOT EXISTS idx_document_dependencies_depends_on_id ON document_dependencies(depends_on_id);
+
+ALTER TABLE document_dependencies ENABLE ROW LEVEL SECURITY;
+ALTER TABLE document_dependencies FORCE ROW LEVEL SECURITY;
+DROP POLICY IF EXISTS document_dependencies_tenant ON document_dependencies;
+CREATE POLICY document_dependencies_tenant ON document_dependencies
+    USING (app_user_id() IS NULL OR document_id IN (SELECT id FROM documents));
@@ -0,0 +1,4 @@
+-- duplicate detection: creating a document whose name is similar to one of the user's existing documents
+-- (trigram similarity) or whose identifier matches returns likely duplicates instead. The lookup is
+-- scoped to one user's documents, so it needs no trigram index.
+CREATE EXTENSION IF NOT EXISTS pg_trgm;
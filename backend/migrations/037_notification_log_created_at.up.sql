@@ -0,0 +1,3 @@
+-- delivery health alerts count the notifications sent and failed over the last hour and look up the
+-- most recent successful send every few minutes
+CREATE INDEX IF NOT EXISTS idx_notification_logs_created_at ON notification_logs(created_at);
@@ -0,0 +1,18 @@
+-- jobs: long-running operations a user started through the API (import, takeout). The worker runs
+-- them and the client polls for status; params hold the input until the job finishes, result the
+-- outcome, and result_url the file it produced, if any.
+CREATE TABLE IF NOT EXISTS jobs (
+    id uuid PRIMARY KEY,
+    user_id uuid NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    kind text NOT NULL, -- 'import' | 'takeout'
+    status text NOT NULL DEFAULT 'queued', -- 'queued' | 'running' | 'succeeded' | 'failed'
+    params jsonb NOT NULL DEFAULT '{}',
+    result jsonb NULL,
+    result_url text NULL,
+    error text NULL,
+    created_at timestamptz DEFAULT now(),
+    started_at timestamptz NULL,
+    finished_at timestamptz NULL
+);
+
+CREATE INDEX IF NOT EXISTS idx_jobs_user_id ON jobs(user_id, created_at DESC);
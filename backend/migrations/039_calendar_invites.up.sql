@@ -0,0 +1,3 @@
+-- calendar_invites: reminder emails carry an iCalendar invite for the expiration date, so it lands in
+-- the user's calendar with an alarm as well as in their inbox
+ALTER TABLE notification_preferences ADD COLUMN IF NOT EXISTS calendar_invites boolean NOT NULL DEFAULT FALSE;
@@ -0,0 +1,14 @@
+-- sample_documents (example documents onboarding created for a user, so they can be removed in one go)
+CREATE TABLE IF NOT EXISTS sample_documents (
+    document_id uuid PRIMARY KEY REFERENCES documents(id) ON DELETE CASCADE,
+    user_id uuid NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    created_at timestamptz DEFAULT now()
+);
+
+CREATE INDEX IF NOT EXISTS idx_sample_documents_user_id ON sample_documents(user_id);
+
+ALTER TABLE sample_documents ENABLE ROW LEVEL SECURITY;
+ALTER TABLE sample_documents FORCE ROW LEVEL SECURITY;
+DROP POLICY IF EXISTS sample_documents_tenant ON sample_documents;
+CREATE POLICY sample_documents_tenant ON sample_documents
+    USING (app_user_id() IS NULL OR document_id IN (SELECT id FROM documents));
@@ -0,0 +1,15 @@
+-- feedback: messages and NPS scores users send from the app, relayed to the team by email and/or
+-- Slack. relayed_at is set once the relay went out.
+CREATE TABLE IF NOT EXISTS feedback (
+    id uuid PRIMARY KEY,
+    user_id uuid NULL REFERENCES users(id) ON DELETE SET NULL,
+    message text NOT NULL DEFAULT '',
+    score int NULL CHECK (score BETWEEN 0 AND 10), -- "how likely are you to recommend xpired?"
+    page text NULL, -- where in the app it was sent from
+    request_id text NULL, -- X-Request-Id of a request that went wrong
+    user_agent text NULL,
+    relayed_at timestamptz NULL,
+    created_at timestamptz DEFAULT now()
+);
+
+CREATE INDEX IF NOT EXISTS idx_feedback_created_at ON feedback(created_at DESC);
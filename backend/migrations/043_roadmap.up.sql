@@ -0,0 +1,21 @@
+-- roadmap_features: proposed features admins publish for users to vote on, so the team can prioritize
+-- by in-product demand
+CREATE TABLE IF NOT EXISTS roadmap_features (
+    id uuid PRIMARY KEY,
+    title text NOT NULL,
+    description text NOT NULL DEFAULT '',
+    status text NOT NULL DEFAULT 'proposed', -- 'proposed' | 'planned' | 'in_progress' | 'shipped' | 'declined'
+    created_by uuid NULL REFERENCES users(id) ON DELETE SET NULL,
+    created_at timestamptz DEFAULT now(),
+    updated_at timestamptz DEFAULT now()
+);
+
+-- roadmap_votes: one vote per user per feature
+CREATE TABLE IF NOT EXISTS roadmap_votes (
+    feature_id uuid NOT NULL REFERENCES roadmap_features(id) ON DELETE CASCADE,
+    user_id uuid NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    created_at timestamptz DEFAULT now(),
+    PRIMARY KEY (feature_id, user_id)
+);
+
+CREATE INDEX IF NOT EXISTS idx_roadmap_votes_user_id ON roadmap_votes(user_id);
@@ -0,0 +1,16 @@
+-- sessions: the sign-ins of a user, keyed by the ID (jti) of the token each one issued, with the
+-- device and approximate location it came from so users can spot logins that were not theirs.
+-- country, region and city come from the offline GeoIP database and are NULL without one.
+CREATE TABLE IF NOT EXISTS sessions (
+    id uuid PRIMARY KEY,
+    user_id uuid NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    user_agent text NULL,
+    ip text NULL,
+    country text NULL, -- ISO 3166-1 alpha-2
+    region text NULL,
+    city text NULL,
+    created_at timestamptz DEFAULT now(),
+    expires_at timestamptz NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS idx_sessions_user_id ON sessions(user_id, created_at DESC);
@@ -0,0 +1,16 @@
+-- import jobs run in chunks of rows, one worker task each, so a large import neither holds a worker
+-- for long nor starts over after a restart. items_total and items_done report progress; they stay
+-- NULL and 0 for jobs that do not count items.
+ALTER TABLE jobs ADD COLUMN IF NOT EXISTS items_total int NULL;
+ALTER TABLE jobs ADD COLUMN IF NOT EXISTS items_done int NOT NULL DEFAULT 0;
+
+-- import_chunks: the parsed rows of an import job still to be imported, and the outcome of each chunk
+-- once done_at is set. dropped when the job finishes.
+CREATE TABLE IF NOT EXISTS import_chunks (
+    job_id uuid NOT NULL REFERENCES jobs(id) ON DELETE CASCADE,
+    chunk int NOT NULL,
+    rows jsonb NOT NULL,
+    result jsonb NULL, -- {"documentIds": [...], "errors": [...]}
+    done_at timestamptz NULL,
+    PRIMARY KEY (job_id, chunk)
+);
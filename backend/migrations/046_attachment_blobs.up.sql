@@ -0,0 +1,19 @@
+-- attachment_blobs: uploaded attachments by content, so identical files attached to several documents
+-- of the same owner are stored once at url. the documents whose attachment_url is the blob's url are its
+-- references; the blob and its stored object are deleted along with the last of them.
+CREATE TABLE IF NOT EXISTS attachment_blobs (
+    user_id uuid NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    checksum text NOT NULL, -- hex SHA-256 of the contents
+    url text NOT NULL UNIQUE,
+    size bigint NOT NULL,
+    created_at timestamptz DEFAULT now(),
+    PRIMARY KEY (user_id, checksum)
+);
+
+CREATE INDEX IF NOT EXISTS idx_documents_attachment_url ON documents(attachment_url) WHERE attachment_url IS NOT NULL;
+
+ALTER TABLE attachment_blobs ENABLE ROW LEVEL SECURITY;
+ALTER TABLE attachment_blobs FORCE ROW LEVEL SECURITY;
+DROP POLICY IF EXISTS attachment_blobs_tenant ON attachment_blobs;
+CREATE POLICY attachment_blobs_tenant ON attachment_blobs
+    USING (app_user_id() IS NULL OR user_id IN (SELECT user_id FROM documents));
@@ -0,0 +1,18 @@
+-- plans: the plan of each user decides how long the attachments of their expired documents are kept
+-- (ATTACHMENT_RETENTION_<PLAN>_DAYS). existing users start on the free plan.
+ALTER TABLE users ADD COLUMN IF NOT EXISTS plan text NOT NULL DEFAULT 'free'; -- 'free' | 'pro'
+
+-- attachment_retention_warnings: the owner was told the attachment at attachment_url will be deleted
+-- on delete_after, which is never sooner than the warning period after warned_at
+CREATE TABLE IF NOT EXISTS attachment_retention_warnings (
+    document_id uuid PRIMARY KEY REFERENCES documents(id) ON DELETE CASCADE,
+    attachment_url text NOT NULL,
+    delete_after date NOT NULL,
+    warned_at timestamptz DEFAULT now()
+);
+
+ALTER TABLE attachment_retention_warnings ENABLE ROW LEVEL SECURITY;
+ALTER TABLE attachment_retention_warnings FORCE ROW LEVEL SECURITY;
+DROP POLICY IF EXISTS attachment_retention_warnings_tenant ON attachment_retention_warnings;
+CREATE POLICY attachment_retention_warnings_tenant ON attachment_retention_warnings
+    USING (app_user_id() IS NULL OR document_id IN (SELECT id FROM documents));
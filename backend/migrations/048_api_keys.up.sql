@@ -0,0 +1,16 @@
+-- api_keys: long-lived keys users create for scripts and integrations, limited to the scopes they
+-- were granted ('documents:read' | 'documents:write' | 'reminders:manage'). only the SHA-256 of a key
+-- is stored; prefix is its first characters, shown so users can tell their keys apart.
+CREATE TABLE IF NOT EXISTS api_keys (
+    id uuid PRIMARY KEY,
+    user_id uuid NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    name text NOT NULL,
+    key_hash text NOT NULL UNIQUE,
+    prefix text NOT NULL,
+    scopes text[] NOT NULL DEFAULT '{}',
+    expires_at timestamptz NULL,
+    last_used_at timestamptz NULL,
+    created_at timestamptz DEFAULT now()
+);
+
+CREATE INDEX IF NOT EXISTS idx_api_keys_user_id ON api_keys(user_id, created_at);
@@ -0,0 +1,15 @@
+-- service_accounts: machine accounts an organization owns for its integrations. each is backed by a
+-- users row that cannot sign in (no password, an undeliverable email) and is a member of the
+-- organization; its token is an api_keys row of that user, so whatever the service account does is
+-- recorded with that user as audit_logs.actor_id. scopes are what every token it is issued gets.
+CREATE TABLE IF NOT EXISTS service_accounts (
+    id uuid PRIMARY KEY,
+    organization_id uuid NOT NULL REFERENCES organizations(id) ON DELETE CASCADE,
+    user_id uuid NOT NULL UNIQUE REFERENCES users(id) ON DELETE CASCADE,
+    name text NOT NULL,
+    scopes text[] NOT NULL DEFAULT '{}',
+    created_by uuid NULL REFERENCES users(id) ON DELETE SET NULL,
+    created_at timestamptz DEFAULT now()
+);
+
+CREATE INDEX IF NOT EXISTS idx_service_accounts_organization_id ON service_accounts(organization_id, created_at);
@@ -0,0 +1,7 @@
+-- the Google or GitHub account a user signs in with ('google' | 'github'); provider_id is the
+-- provider's id of the account, which stays the same when its email changes. users who signed up this
+-- way have no password.
+ALTER TABLE users ADD COLUMN IF NOT EXISTS provider text NULL;
+ALTER TABLE users ADD COLUMN IF NOT EXISTS provider_id text NULL;
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_users_provider ON users(provider, provider_id) WHERE provider IS NOT NULL;
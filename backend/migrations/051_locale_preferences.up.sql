@@ -0,0 +1,4 @@
+-- the locale responses are written for when a request names none (NULL = English), and the unit
+-- "expires in ..." strings count in: 'auto' picks days, weeks or months by distance, 'days' always days
+ALTER TABLE users ADD COLUMN IF NOT EXISTS locale text NULL;
+ALTER TABLE users ADD COLUMN IF NOT EXISTS duration_unit text NOT NULL DEFAULT 'auto'; -- 'auto' | 'days'
@@ -0,0 +1,19 @@
+-- document_positions: how each user arranges the documents they see. pinned documents come first in
+-- the ?sort=custom listing, then the others by position (NULL = never placed, after the placed ones).
+-- rows are per user, so members of an organization each arrange its documents their own way.
+CREATE TABLE IF NOT EXISTS document_positions (
+    user_id uuid NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    document_id uuid NOT NULL REFERENCES documents(id) ON DELETE CASCADE,
+    pinned boolean NOT NULL DEFAULT false,
+    position integer NULL,
+    updated_at timestamptz NOT NULL DEFAULT now(),
+    PRIMARY KEY (user_id, document_id)
+);
+
+CREATE INDEX IF NOT EXISTS idx_document_positions_document_id ON document_positions(document_id);
+
+ALTER TABLE document_positions ENABLE ROW LEVEL SECURITY;
+ALTER TABLE document_positions FORCE ROW LEVEL SECURITY;
+DROP POLICY IF EXISTS document_positions_tenant ON document_positions;
+CREATE POLICY document_positions_tenant ON document_positions
+    USING (app_user_id() IS NULL OR document_id IN (SELECT id FROM documents));
@@ -0,0 +1,3 @@
+-- sessions.revoked_at: when the user signed the session out from another device or logged out
+-- everywhere. tokens of revoked sessions are refused until they expire.
+ALTER TABLE sessions ADD COLUMN IF NOT EXISTS revoked_at timestamptz NULL;
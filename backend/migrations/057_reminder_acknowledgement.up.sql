@@ -0,0 +1,3 @@
+-- document_reminders.acknowledged_at: when the user last acted on a link of the reminder (viewed,
+-- renewed or snoozed); pending sends of the same reminder on other channels are dropped after it
+ALTER TABLE document_reminders ADD COLUMN IF NOT EXISTS acknowledged_at timestamptz NULL;
@@ -0,0 +1,12 @@
+-- document_reminders.status: where the reminder for the interval stands, one of scheduled, queued,
+-- sent, acknowledged, snoozed, canceled or failed; status_changed_at is when it last moved. Until
+-- now the status was implied by enabled, sent_at and acknowledged_at.
+ALTER TABLE document_reminders ADD COLUMN IF NOT EXISTS status text NOT NULL DEFAULT 'scheduled';
+ALTER TABLE document_reminders ADD COLUMN IF NOT EXISTS status_changed_at timestamptz NULL;
+
+UPDATE document_reminders SET status = CASE
+    WHEN NOT enabled THEN 'canceled'
+    WHEN sent_at IS NULL THEN 'scheduled'
+    WHEN acknowledged_at >= sent_at THEN 'acknowledged'
+    ELSE 'sent'
+END;
@@ -0,0 +1,9 @@
+-- quota_warnings: the months a user was warned that they are nearing a quota of their plan (see
+-- config.QuotasConfig), so each quota warns at most once a month
+CREATE TABLE IF NOT EXISTS quota_warnings (
+    user_id uuid NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    quota text NOT NULL,
+    month text NOT NULL,
+    warned_at timestamptz NOT NULL DEFAULT now(),
+    PRIMARY KEY (user_id, quota, month)
+);
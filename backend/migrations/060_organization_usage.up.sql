@@ -0,0 +1,17 @@
+-- organization_usage: metered usage of each organization per UTC month, for billing and chargeback
+-- reports. month is 'YYYY-MM'; quantity is the peak of the metric within the month: documents live,
+-- bytes of attachments stored, and text messages sent so far
+CREATE TABLE IF NOT EXISTS organization_usage (
+    organization_id uuid NOT NULL,
+    month text NOT NULL,
+    metric text NOT NULL, -- 'documents' | 'storage_bytes' | 'sms_sent'
+    quantity bigint NOT NULL,
+    recorded_at timestamptz NOT NULL DEFAULT now(),
+    PRIMARY KEY (organization_id, month, metric)
+);
+
+ALTER TABLE organization_usage ENABLE ROW LEVEL SECURITY;
+ALTER TABLE organization_usage FORCE ROW LEVEL SECURITY;
+DROP POLICY IF EXISTS organization_usage_tenant ON organization_usage;
+CREATE POLICY organization_usage_tenant ON organization_usage
+    USING (app_user_id() IS NULL OR organization_id = app_organization_id());
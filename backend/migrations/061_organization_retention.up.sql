@@ -0,0 +1,39 @@
+-- organization_retention: how long an organization keeps its data, in days. NULL keeps it forever.
+-- expired documents are purged that long after they expire; event log entries and notification
+-- history that long after they were recorded
+CREATE TABLE IF NOT EXISTS organization_retention (
+    organization_id uuid PRIMARY KEY REFERENCES organizations(id) ON DELETE CASCADE,
+    expired_documents_days integer NULL CHECK (expired_documents_days > 0),
+    event_log_days integer NULL CHECK (event_log_days > 0),
+    notification_history_days integer NULL CHECK (notification_history_days > 0),
+    updated_at timestamptz NOT NULL DEFAULT now()
+);
+
+-- event_log_prunes: where an organization's event log was cut by retention. entries up to
+-- through_seq are gone; through_hash, the hash of the last of them, anchors the rest of the chain
+CREATE TABLE IF NOT EXISTS event_log_prunes (
+    organization_id uuid PRIMARY KEY,
+    through_seq bigint NOT NULL,
+    through_hash text NOT NULL,
+    pruned_at timestamptz NOT NULL DEFAULT now()
+);
+
+ALTER TABLE event_log_prunes ENABLE ROW LEVEL SECURITY;
+ALTER TABLE event_log_prunes FORCE ROW LEVEL SECURITY;
+DROP POLICY IF EXISTS event_log_prunes_tenant ON event_log_prunes;
+CREATE POLICY event_log_prunes_tenant ON event_log_prunes
+    USING (app_user_id() IS NULL OR organization_id = app_organization_id());
+
+-- the event log stays append-only, except that entries a prune covers may be deleted
+CREATE OR REPLACE FUNCTION event_log_append_only() RETURNS trigger
+    LANGUAGE plpgsql AS $$
+BEGIN
+    IF TG_OP = 'DELETE' AND EXISTS (
+        SELECT 1 FROM event_log_prunes p
+        WHERE p.organization_id = OLD.organization_id AND OLD.seq <= p.through_seq
+    ) THEN
+        RETURN OLD;
+    END IF;
+    RAISE EXCEPTION 'event_log is append-only';
+END;
+$$;
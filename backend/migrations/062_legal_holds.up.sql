@@ -0,0 +1,18 @@
+-- legal_holds: documents, or whole organizations when document_id is NULL, that must be preserved.
+-- Held documents cannot be deleted and are exempt from trash and retention purges; the event log and
+-- notification history about them are kept as well. Kept in each data region next to the documents
+CREATE TABLE IF NOT EXISTS legal_holds (
+    organization_id uuid NOT NULL,
+    document_id uuid NULL REFERENCES documents(id) ON DELETE CASCADE,
+    placed_by uuid NOT NULL,
+    placed_at timestamptz NOT NULL DEFAULT now()
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_legal_holds_organization ON legal_holds(organization_id) WHERE document_id IS NULL;
+CREATE UNIQUE INDEX IF NOT EXISTS idx_legal_holds_document ON legal_holds(document_id) WHERE document_id IS NOT NULL;
+
+ALTER TABLE legal_holds ENABLE ROW LEVEL SECURITY;
+ALTER TABLE legal_holds FORCE ROW LEVEL SECURITY;
+DROP POLICY IF EXISTS legal_holds_tenant ON legal_holds;
+CREATE POLICY legal_holds_tenant ON legal_holds
+    USING (app_user_id() IS NULL OR organization_id = app_organization_id());
@@ -0,0 +1,3 @@
+-- roles: what each user may do across the service, carried in their tokens. admins reach the admin
+-- endpoints; users in ADMIN_EMAILS are admins whatever their role. existing users start as users
+ALTER TABLE users ADD COLUMN IF NOT EXISTS role text NOT NULL DEFAULT 'user'; -- 'user' | 'admin'
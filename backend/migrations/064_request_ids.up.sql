@@ -0,0 +1,4 @@
+-- request_id: the ID of the API request that scheduled the reminder, logged by the API and the
+-- worker alike, so a message can be traced back to the call behind it. NULL when a job scheduled it
+ALTER TABLE notification_logs ADD COLUMN IF NOT EXISTS request_id text NULL;
+ALTER TABLE held_reminders ADD COLUMN IF NOT EXISTS request_id text NULL;
@@ -0,0 +1,3 @@
+-- pending_email: the address a user asked to switch their account to, until they confirm it through
+-- the link sent there. the account email, and so reminders, stay on the old address until then
+ALTER TABLE users ADD COLUMN IF NOT EXISTS pending_email text NULL;
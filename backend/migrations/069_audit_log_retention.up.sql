@@ -0,0 +1,11 @@
+-- organization_id: the organization whose data an audit entry is about, so its retention
+-- (organization_retention.event_log_days) applies to the entry as it does to its event log.
+-- entries about documents in the home database are backfilled; those in data regions are not
+ALTER TABLE audit_logs ADD COLUMN IF NOT EXISTS organization_id uuid NULL;
+
+UPDATE audit_logs SET organization_id = d.organization_id
+FROM documents d
+WHERE audit_logs.organization_id IS NULL AND audit_logs.entity_type = 'document'
+    AND audit_logs.entity_id = d.id::text AND d.organization_id IS NOT NULL;
+
+CREATE INDEX IF NOT EXISTS idx_audit_logs_organization_id ON audit_logs (organization_id, created_at) WHERE organization_id IS NOT NULL;
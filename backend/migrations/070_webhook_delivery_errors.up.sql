@@ -0,0 +1,4 @@
+-- failed deliveries no longer keep what the endpoint answered; drop the response bodies
+-- already stored after "webhook endpoint responded <code>: "
+UPDATE webhook_deliveries SET error = split_part(error, ':', 1)
+WHERE error LIKE 'webhook endpoint responded %:%';
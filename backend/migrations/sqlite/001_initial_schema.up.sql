@@ -0,0 +1,508 @@
+-- the schema of migrations 001 to 038 for self-hosting on SQLite. uuids are text, json is text,
+-- text[] columns hold Postgres array literals and times are UTC text in the layout the application
+-- writes ('2006-01-02 15:04:05.000000+00:00'), so they compare in order. there is no row-level
+-- security, and expiring_documents is a plain view. new Postgres migrations need a counterpart here.
+
+-- users
+CREATE TABLE IF NOT EXISTS users (
+    id text PRIMARY KEY,
+    email text UNIQUE NOT NULL,
+    password text NOT NULL,
+    phone_number text,
+    name text NOT NULL,
+    suspended_at timestamp NULL,
+    suspension_reason text NULL,
+    created_at timestamp DEFAULT (strftime('%Y-%m-%d %H:%M:%f000+00:00', 'now')),
+    updated_at timestamp DEFAULT (strftime('%Y-%m-%d %H:%M:%f000+00:00', 'now'))
+);
+
+-- document_categories (kinds of documents, each with a default reminder set)
+CREATE TABLE IF NOT EXISTS document_categories (
+    slug text PRIMARY KEY,
+    name text NOT NULL
+);
+
+-- documents
+CREATE TABLE IF NOT EXISTS documents (
+    id text PRIMARY KEY,
+    user_id text REFERENCES users(id) ON DELETE CASCADE,
+    name text NOT NULL,
+    description text,
+    identifier text,
+    identifier_index text NULL,
+    expiration_date date NOT NULL,
+    timezone text DEFAULT 'UTC',
+    attachment_url text,
+    attachment_status text NULL,
+    attachment_threat text NULL,
+    attachment_scanned_at timestamp NULL,
+    category text REFERENCES document_categories(slug) ON DELETE SET NULL,
+    organization_id text NULL,
+    renewal_cost numeric,
+    currency text,
+    issuer_id text,
+    lead_time_days integer,
+    deleted_at timestamp NULL,
+    created_at timestamp DEFAULT (strftime('%Y-%m-%d %H:%M:%f000+00:00', 'now')),
+    updated_at timestamp DEFAULT (strftime('%Y-%m-%d %H:%M:%f000+00:00', 'now'))
+);
+
+CREATE INDEX IF NOT EXISTS idx_documents_user_id ON documents(user_id);
+CREATE INDEX IF NOT EXISTS idx_documents_category ON documents(category);
+CREATE INDEX IF NOT EXISTS idx_documents_deleted_at ON documents(deleted_at) WHERE deleted_at IS NOT NULL;
+CREATE INDEX IF NOT EXISTS idx_documents_identifier_index ON documents(user_id, identifier_index) WHERE identifier_index IS NOT NULL;
+CREATE INDEX IF NOT EXISTS idx_documents_organization_id ON documents(organization_id) WHERE organization_id IS NOT NULL;
+
+-- reminder_intervals (global list of supported intervals; used for presets)
+CREATE TABLE IF NOT EXISTS reminder_intervals (
+    id integer PRIMARY KEY AUTOINCREMENT,
+    label text NOT NULL,
+    days_before integer NOT NULL,
+    id_label text NOT NULL
+);
+
+-- document_reminders (what reminders are enabled for this document)
+CREATE TABLE IF NOT EXISTS document_reminders (
+    id text PRIMARY KEY,
+    document_id text REFERENCES documents(id) ON DELETE CASCADE,
+    reminder_interval_id integer REFERENCES reminder_intervals(id) ON DELETE CASCADE,
+    enabled boolean DEFAULT TRUE,
+    sent_at timestamp NULL
+);
+
+CREATE INDEX IF NOT EXISTS idx_document_reminders_document_id ON document_reminders(document_id);
+CREATE INDEX IF NOT EXISTS idx_document_reminders_interval_id ON document_reminders(reminder_interval_id);
+
+-- notification_logs
+CREATE TABLE IF NOT EXISTS notification_logs (
+    id text PRIMARY KEY,
+    message_id text,
+    user_id text REFERENCES users(id),
+    recipient_id text REFERENCES users(id) ON DELETE SET NULL,
+    document_id text REFERENCES documents(id),
+    reminder_interval_id integer,
+    channel text,
+    status text,
+    response text,
+    opened_at timestamp NULL,
+    bounced_at timestamp NULL,
+    escalated_at timestamp NULL,
+    read_at timestamp NULL,
+    created_at timestamp DEFAULT (strftime('%Y-%m-%d %H:%M:%f000+00:00', 'now'))
+);
+
+CREATE INDEX IF NOT EXISTS idx_notification_logs_user_id ON notification_logs(user_id);
+CREATE INDEX IF NOT EXISTS idx_notification_logs_document_id ON notification_logs(document_id);
+CREATE INDEX IF NOT EXISTS idx_notification_logs_interval_id ON notification_logs(reminder_interval_id);
+CREATE INDEX IF NOT EXISTS idx_notification_logs_status ON notification_logs(status);
+CREATE INDEX IF NOT EXISTS idx_notification_logs_message_id ON notification_logs(message_id);
+CREATE INDEX IF NOT EXISTS idx_notification_logs_unread ON notification_logs(user_id) WHERE read_at IS NULL;
+CREATE INDEX IF NOT EXISTS idx_notification_logs_created_at ON notification_logs(created_at);
+
+-- document_contacts (extra people notified about a document who do not have accounts)
+CREATE TABLE IF NOT EXISTS document_contacts (
+    id text PRIMARY KEY,
+    document_id text REFERENCES documents(id) ON DELETE CASCADE,
+    name text,
+    email text NOT NULL,
+    unsubscribe_token text UNIQUE NOT NULL,
+    unsubscribed_at timestamp NULL,
+    created_at timestamp DEFAULT (strftime('%Y-%m-%d %H:%M:%f000+00:00', 'now'))
+);
+
+CREATE INDEX IF NOT EXISTS idx_document_contacts_document_id ON document_contacts(document_id);
+CREATE UNIQUE INDEX IF NOT EXISTS idx_document_contacts_document_email ON document_contacts(document_id, email);
+
+-- households (lightweight family grouping managed by a primary user)
+CREATE TABLE IF NOT EXISTS households (
+    id text PRIMARY KEY,
+    name text NOT NULL,
+    primary_user_id text REFERENCES users(id) ON DELETE CASCADE,
+    created_at timestamp DEFAULT (strftime('%Y-%m-%d %H:%M:%f000+00:00', 'now')),
+    updated_at timestamp DEFAULT (strftime('%Y-%m-%d %H:%M:%f000+00:00', 'now'))
+);
+
+-- household_members (a user belongs to at most one household)
+CREATE TABLE IF NOT EXISTS household_members (
+    household_id text REFERENCES households(id) ON DELETE CASCADE,
+    user_id text REFERENCES users(id) ON DELETE CASCADE,
+    role text NOT NULL DEFAULT 'member',
+    notification_routing text NOT NULL DEFAULT 'member',
+    created_at timestamp DEFAULT (strftime('%Y-%m-%d %H:%M:%f000+00:00', 'now')),
+    PRIMARY KEY (household_id, user_id)
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_household_members_user_id ON household_members(user_id);
+
+-- document_checklist_items (ordered renewal steps for a document)
+CREATE TABLE IF NOT EXISTS document_checklist_items (
+    id text PRIMARY KEY,
+    document_id text REFERENCES documents(id) ON DELETE CASCADE,
+    position integer NOT NULL DEFAULT 0,
+    title text NOT NULL,
+    done boolean NOT NULL DEFAULT FALSE,
+    done_at timestamp NULL,
+    created_at timestamp DEFAULT (strftime('%Y-%m-%d %H:%M:%f000+00:00', 'now')),
+    updated_at timestamp DEFAULT (strftime('%Y-%m-%d %H:%M:%f000+00:00', 'now'))
+);
+
+CREATE INDEX IF NOT EXISTS idx_document_checklist_items_document_id ON document_checklist_items(document_id, position);
+
+-- category_default_reminders (reminder intervals applied when a document of the category has none)
+CREATE TABLE IF NOT EXISTS category_default_reminders (
+    category_slug text REFERENCES document_categories(slug) ON DELETE CASCADE,
+    reminder_interval_id integer REFERENCES reminder_intervals(id) ON DELETE CASCADE,
+    PRIMARY KEY (category_slug, reminder_interval_id)
+);
+
+-- category_validity_periods (knowledge base of typical validity periods used to suggest expiration dates)
+CREATE TABLE IF NOT EXISTS category_validity_periods (
+    id integer PRIMARY KEY AUTOINCREMENT,
+    category_slug text REFERENCES document_categories(slug) ON DELETE CASCADE,
+    country_code text NULL,
+    validity_months integer NOT NULL,
+    notes text
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_category_validity_periods_category_country
+    ON category_validity_periods(category_slug, COALESCE(country_code, ''));
+
+-- notification_preferences (per-user delivery policy; a missing row means defaults)
+CREATE TABLE IF NOT EXISTS notification_preferences (
+    user_id text PRIMARY KEY REFERENCES users(id) ON DELETE CASCADE,
+    escalation_channel text NOT NULL DEFAULT 'none',
+    escalation_after_days integer NOT NULL DEFAULT 2,
+    channel_matrix text NOT NULL DEFAULT '{}',
+    batch_window_hours integer NOT NULL DEFAULT 0,
+    created_at timestamp DEFAULT (strftime('%Y-%m-%d %H:%M:%f000+00:00', 'now')),
+    updated_at timestamp DEFAULT (strftime('%Y-%m-%d %H:%M:%f000+00:00', 'now'))
+);
+
+-- held_reminders (reminders waiting for their owner's batching window to close)
+CREATE TABLE IF NOT EXISTS held_reminders (
+    document_id text NOT NULL REFERENCES documents(id) ON DELETE CASCADE,
+    reminder_interval_id integer NOT NULL REFERENCES reminder_intervals(id),
+    user_id text NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    held_at timestamp DEFAULT (strftime('%Y-%m-%d %H:%M:%f000+00:00', 'now')),
+    PRIMARY KEY (document_id, reminder_interval_id)
+);
+
+CREATE INDEX IF NOT EXISTS idx_held_reminders_user_id ON held_reminders(user_id);
+
+-- feed_tokens (secret token per user for the public expirations feed)
+CREATE TABLE IF NOT EXISTS feed_tokens (
+    user_id text PRIMARY KEY REFERENCES users(id) ON DELETE CASCADE,
+    token text NOT NULL UNIQUE,
+    created_at timestamp DEFAULT (strftime('%Y-%m-%d %H:%M:%f000+00:00', 'now'))
+);
+
+-- webhook_endpoints (outbound webhooks; previous_secret stays valid until it expires after a rotation)
+CREATE TABLE IF NOT EXISTS webhook_endpoints (
+    id text PRIMARY KEY,
+    user_id text NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    url text NOT NULL,
+    events text NOT NULL DEFAULT '{}',
+    secret text NOT NULL,
+    previous_secret text NULL,
+    previous_secret_expires_at timestamp NULL,
+    enabled boolean NOT NULL DEFAULT TRUE,
+    created_at timestamp DEFAULT (strftime('%Y-%m-%d %H:%M:%f000+00:00', 'now')),
+    updated_at timestamp DEFAULT (strftime('%Y-%m-%d %H:%M:%f000+00:00', 'now'))
+);
+
+CREATE INDEX IF NOT EXISTS idx_webhook_endpoints_user_id ON webhook_endpoints(user_id);
+
+-- webhook_deliveries (one row per event sent to an endpoint, updated on every attempt)
+CREATE TABLE IF NOT EXISTS webhook_deliveries (
+    id text PRIMARY KEY,
+    endpoint_id text NOT NULL REFERENCES webhook_endpoints(id) ON DELETE CASCADE,
+    event text NOT NULL,
+    payload text NOT NULL,
+    status text NOT NULL DEFAULT 'pending',
+    attempts integer NOT NULL DEFAULT 0,
+    response_code integer NULL,
+    latency_ms integer NULL,
+    error text NULL,
+    redelivery_of text NULL,
+    created_at timestamp DEFAULT (strftime('%Y-%m-%d %H:%M:%f000+00:00', 'now')),
+    last_attempt_at timestamp NULL
+);
+
+CREATE INDEX IF NOT EXISTS idx_webhook_deliveries_endpoint_id ON webhook_deliveries(endpoint_id, created_at DESC);
+
+-- document_locks (advisory edit locks; a row past expires_at is free to take)
+CREATE TABLE IF NOT EXISTS document_locks (
+    document_id text PRIMARY KEY REFERENCES documents(id) ON DELETE CASCADE,
+    user_id text NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    locked_at timestamp NOT NULL DEFAULT (strftime('%Y-%m-%d %H:%M:%f000+00:00', 'now')),
+    expires_at timestamp NOT NULL
+);
+
+-- audit_logs (who did what to which entity; actor_id is NULL for system jobs)
+CREATE TABLE IF NOT EXISTS audit_logs (
+    id text PRIMARY KEY,
+    actor_id text NULL,
+    user_id text NULL,
+    action text NOT NULL,
+    entity_type text NOT NULL,
+    entity_id text NOT NULL,
+    metadata text NULL,
+    created_at timestamp DEFAULT (strftime('%Y-%m-%d %H:%M:%f000+00:00', 'now'))
+);
+
+CREATE INDEX IF NOT EXISTS idx_audit_logs_user_id ON audit_logs(user_id, created_at DESC);
+
+-- organizations; data_region is always NULL, as SQLite has no regional databases
+CREATE TABLE IF NOT EXISTS organizations (
+    id text PRIMARY KEY,
+    name text NOT NULL,
+    data_region text NULL,
+    compliance_mode boolean NOT NULL DEFAULT FALSE,
+    compliance_categories text NOT NULL DEFAULT '{}',
+    renewal_approval boolean NOT NULL DEFAULT FALSE,
+    created_at timestamp DEFAULT (strftime('%Y-%m-%d %H:%M:%f000+00:00', 'now'))
+);
+
+CREATE TABLE IF NOT EXISTS organization_members (
+    organization_id text NOT NULL REFERENCES organizations(id) ON DELETE CASCADE,
+    user_id text NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    role text NOT NULL DEFAULT 'member',
+    scim_external_id text,
+    created_at timestamp DEFAULT (strftime('%Y-%m-%d %H:%M:%f000+00:00', 'now')),
+    PRIMARY KEY (organization_id, user_id)
+);
+
+CREATE INDEX IF NOT EXISTS idx_organization_members_user_id ON organization_members(user_id);
+
+-- expiring_documents: live documents expiring from 30 days ago to 90 days ahead. a plain view here,
+-- so it is always current and the refresh job has nothing to do
+CREATE VIEW IF NOT EXISTS expiring_documents AS
+SELECT id AS document_id, user_id, organization_id, name, category, expiration_date, timezone
+FROM documents
+WHERE deleted_at IS NULL
+    AND expiration_date >= strftime('%Y-%m-%d %H:%M:%f000+00:00', 'now', '-30 days')
+    AND expiration_date < strftime('%Y-%m-%d %H:%M:%f000+00:00', 'now', '+90 days');
+
+-- document_counters: live documents per user (and organization), bucketed by UTC expiration day
+CREATE TABLE IF NOT EXISTS document_counters (
+    user_id text NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    organization_id text NULL,
+    expiration_day date NOT NULL,
+    documents integer NOT NULL DEFAULT 0
+);
+
+-- organization_id is NULL for personal documents, so the key coalesces it
+CREATE UNIQUE INDEX IF NOT EXISTS idx_document_counters_key
+    ON document_counters(user_id, COALESCE(organization_id, '00000000-0000-0000-0000-000000000000'), expiration_day);
+
+-- announcements: maintenance and feature notices admins publish to every user
+CREATE TABLE IF NOT EXISTS announcements (
+    id text PRIMARY KEY,
+    title text NOT NULL,
+    body text NOT NULL,
+    kind text NOT NULL DEFAULT 'feature',
+    starts_at timestamp NOT NULL DEFAULT (strftime('%Y-%m-%d %H:%M:%f000+00:00', 'now')),
+    ends_at timestamp NULL,
+    send_email boolean NOT NULL DEFAULT FALSE,
+    emailed_at timestamp NULL,
+    created_by text NULL REFERENCES users(id) ON DELETE SET NULL,
+    created_at timestamp DEFAULT (strftime('%Y-%m-%d %H:%M:%f000+00:00', 'now'))
+);
+
+CREATE INDEX IF NOT EXISTS idx_announcements_starts_at ON announcements(starts_at DESC);
+
+-- document_assignments: the organization member responsible for a team document
+CREATE TABLE IF NOT EXISTS document_assignments (
+    document_id text PRIMARY KEY REFERENCES documents(id) ON DELETE CASCADE,
+    assignee_id text NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    assigned_by text NULL REFERENCES users(id) ON DELETE SET NULL,
+    assigned_at timestamp NOT NULL DEFAULT (strftime('%Y-%m-%d %H:%M:%f000+00:00', 'now')),
+    acknowledged_at timestamp NULL
+);
+
+CREATE INDEX IF NOT EXISTS idx_document_assignments_assignee_id ON document_assignments(assignee_id);
+
+-- escalation_policy_steps: an organization's escalation chain
+CREATE TABLE IF NOT EXISTS escalation_policy_steps (
+    organization_id text NOT NULL REFERENCES organizations(id) ON DELETE CASCADE,
+    days_before integer NOT NULL CHECK (days_before >= 0),
+    audience text NOT NULL,
+    PRIMARY KEY (organization_id, days_before)
+);
+
+-- renewal_requests: proposed expiration dates waiting for an owner or admin's approval
+CREATE TABLE IF NOT EXISTS renewal_requests (
+    id text PRIMARY KEY,
+    document_id text NOT NULL REFERENCES documents(id) ON DELETE CASCADE,
+    requested_by text NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    expiration_date date NOT NULL,
+    evidence_url text NOT NULL,
+    status text NOT NULL DEFAULT 'pending',
+    decided_by text NULL REFERENCES users(id) ON DELETE SET NULL,
+    decided_at timestamp NULL,
+    note text NULL,
+    created_at timestamp DEFAULT (strftime('%Y-%m-%d %H:%M:%f000+00:00', 'now'))
+);
+
+-- at most one open proposal per document
+CREATE UNIQUE INDEX IF NOT EXISTS idx_renewal_requests_pending ON renewal_requests(document_id) WHERE status = 'pending';
+
+-- scim_tokens (bearer token an organization's identity provider uses for SCIM provisioning)
+CREATE TABLE IF NOT EXISTS scim_tokens (
+    organization_id text PRIMARY KEY REFERENCES organizations(id) ON DELETE CASCADE,
+    token text NOT NULL UNIQUE,
+    created_at timestamp DEFAULT (strftime('%Y-%m-%d %H:%M:%f000+00:00', 'now'))
+);
+
+-- event_log: append-only, hash-chained record of reminder sends and acknowledgments
+CREATE TABLE IF NOT EXISTS event_log (
+    organization_id text NOT NULL,
+    seq integer NOT NULL,
+    event_type text NOT NULL,
+    document_id text NOT NULL,
+    actor_id text NULL,
+    recipient_id text NULL,
+    channel text NULL,
+    detail text NOT NULL DEFAULT '{}',
+    occurred_at timestamp NOT NULL,
+    prev_hash text NOT NULL,
+    hash text NOT NULL,
+    PRIMARY KEY (organization_id, seq)
+);
+
+CREATE TRIGGER IF NOT EXISTS event_log_no_update BEFORE UPDATE ON event_log
+BEGIN
+    SELECT RAISE(ABORT, 'event_log is append-only');
+END;
+
+CREATE TRIGGER IF NOT EXISTS event_log_no_delete BEFORE DELETE ON event_log
+BEGIN
+    SELECT RAISE(ABORT, 'event_log is append-only');
+END;
+
+-- email_senders (custom From identity of an organization's reminder emails)
+CREATE TABLE IF NOT EXISTS email_senders (
+    organization_id text PRIMARY KEY REFERENCES organizations(id) ON DELETE CASCADE,
+    from_name text NOT NULL,
+    from_address text NOT NULL,
+    verification_token text NOT NULL,
+    verified_at timestamp,
+    checked_at timestamp,
+    created_at timestamp DEFAULT (strftime('%Y-%m-%d %H:%M:%f000+00:00', 'now')),
+    updated_at timestamp DEFAULT (strftime('%Y-%m-%d %H:%M:%f000+00:00', 'now'))
+);
+
+-- notification_themes (an organization's branding of the emails sent for its documents)
+CREATE TABLE IF NOT EXISTS notification_themes (
+    organization_id text PRIMARY KEY REFERENCES organizations(id) ON DELETE CASCADE,
+    logo_url text,
+    primary_color text,
+    background_color text,
+    footer_text text,
+    updated_at timestamp DEFAULT (strftime('%Y-%m-%d %H:%M:%f000+00:00', 'now'))
+);
+
+-- issuers (directory of the authorities that issue and renew documents)
+CREATE TABLE IF NOT EXISTS issuers (
+    id text PRIMARY KEY,
+    name text NOT NULL,
+    country_code text,
+    renewal_url text,
+    processing_days integer,
+    created_at timestamp DEFAULT (strftime('%Y-%m-%d %H:%M:%f000+00:00', 'now')),
+    updated_at timestamp DEFAULT (strftime('%Y-%m-%d %H:%M:%f000+00:00', 'now'))
+);
+
+CREATE INDEX IF NOT EXISTS idx_issuers_country_code ON issuers(country_code);
+
+-- the expiration date the lead-time reminder was last sent for
+CREATE TABLE IF NOT EXISTS lead_time_reminders (
+    document_id text PRIMARY KEY REFERENCES documents(id) ON DELETE CASCADE,
+    expiration_date date NOT NULL,
+    sent_at timestamp NOT NULL DEFAULT (strftime('%Y-%m-%d %H:%M:%f000+00:00', 'now'))
+);
+
+-- document dependencies: a document can depend on others (a visa on the passport it is stamped in)
+CREATE TABLE IF NOT EXISTS document_dependencies (
+    document_id text NOT NULL REFERENCES documents(id) ON DELETE CASCADE,
+    depends_on_id text NOT NULL REFERENCES documents(id) ON DELETE CASCADE,
+    created_at timestamp DEFAULT (strftime('%Y-%m-%d %H:%M:%f000+00:00', 'now')),
+    PRIMARY KEY (document_id, depends_on_id),
+    CHECK (document_id <> depends_on_id)
+);
+
+CREATE INDEX IF NOT EXISTS idx_document_dependencies_depends_on_id ON document_dependencies(depends_on_id);
+
+-- jobs: long-running operations a user started through the API (import, takeout)
+CREATE TABLE IF NOT EXISTS jobs (
+    id text PRIMARY KEY,
+    user_id text NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    kind text NOT NULL,
+    status text NOT NULL DEFAULT 'queued',
+    params text NOT NULL DEFAULT '{}',
+    result text NULL,
+    result_url text NULL,
+    error text NULL,
+    created_at timestamp DEFAULT (strftime('%Y-%m-%d %H:%M:%f000+00:00', 'now')),
+    started_at timestamp NULL,
+    finished_at timestamp NULL
+);
+
+CREATE INDEX IF NOT EXISTS idx_jobs_user_id ON jobs(user_id, created_at DESC);
+
+-- presets. Postgres installs seed reminder_intervals by hand (002_initial_reminder_intervals.sql);
+-- a single binary has nobody to do that, so it is seeded here
+INSERT INTO reminder_intervals (label, days_before, id_label) VALUES
+('6 months before', 180, '180d'),
+('3 months before', 90, '90d'),
+('2 months before', 60, '60d'),
+('1 month before', 30, '30d'),
+('3 weeks before', 21, '21d'),
+('2 weeks before', 14, '14d'),
+('1 week before', 7, '7d'),
+('3 days before', 3, '3d'),
+('1 day before', 1, '1d'),
+('On the day', 0, '0d');
+
+INSERT INTO document_categories (slug, name) VALUES
+('passport', 'Passport'),
+('drivers_license', 'Driver''s License'),
+('national_id', 'National ID'),
+('visa', 'Visa / Residence Permit'),
+('insurance', 'Insurance Policy'),
+('vehicle_registration', 'Vehicle Registration'),
+('domain', 'Domain Name'),
+('certificate', 'TLS Certificate'),
+('lease', 'Lease / Rental Agreement'),
+('other', 'Other');
+
+WITH d(category_slug, id_label) AS (VALUES
+    ('passport', '180d'), ('passport', '90d'), ('passport', '30d'),
+    ('drivers_license', '90d'), ('drivers_license', '30d'), ('drivers_license', '7d'),
+    ('national_id', '90d'), ('national_id', '30d'),
+    ('visa', '90d'), ('visa', '60d'), ('visa', '30d'), ('visa', '7d'),
+    ('insurance', '30d'), ('insurance', '7d'), ('insurance', '1d'),
+    ('vehicle_registration', '30d'), ('vehicle_registration', '7d'),
+    ('domain', '60d'), ('domain', '30d'), ('domain', '7d'), ('domain', '1d'),
+    ('certificate', '30d'), ('certificate', '14d'), ('certificate', '3d'), ('certificate', '1d'),
+    ('lease', '90d'), ('lease', '60d'), ('lease', '30d'),
+    ('other', '30d'), ('other', '7d'), ('other', '1d')
+)
+INSERT INTO category_default_reminders (category_slug, reminder_interval_id)
+SELECT d.category_slug, ri.id
+FROM d
+JOIN reminder_intervals ri ON ri.id_label = d.id_label;
+
+INSERT INTO category_validity_periods (category_slug, country_code, validity_months, notes) VALUES
+('passport', NULL, 120, 'Most adult passports are valid for 10 years'),
+('passport', 'GH', 120, 'Ghanaian ordinary passports are valid for 10 years'),
+('passport', 'US', 120, 'US adult passports are valid for 10 years'),
+('passport', 'GB', 120, 'UK adult passports are valid for 10 years'),
+('drivers_license', NULL, 60, 'Driving licences are commonly renewed every 5 years'),
+('national_id', NULL, 120, 'National ID cards are commonly valid for 10 years'),
+('visa', NULL, 12, 'Visas vary widely; 1 year is a common default'),
+('insurance', NULL, 12, 'Insurance policies usually renew annually'),
+('vehicle_registration', NULL, 12, 'Vehicle registrations usually renew annually'),
+('domain', NULL, 12, 'Domain names are usually registered for 1 year'),
+('certificate', NULL, 3, 'Automated TLS certificates are typically valid for 90 days'),
+('lease', NULL, 12, 'Residential leases commonly run for 1 year');
@@ -0,0 +1,2 @@
+-- 039_calendar_invites
+ALTER TABLE notification_preferences ADD COLUMN calendar_invites boolean NOT NULL DEFAULT FALSE;
@@ -0,0 +1,55 @@
+-- 040_document_templates
+-- document_templates (built-in gallery of common documents; creating a document from one fills in
+-- its category, reminders and custom fields)
+CREATE TABLE IF NOT EXISTS document_templates (
+    slug text PRIMARY KEY, -- e.g. 'passport'
+    name text NOT NULL,
+    description text NOT NULL DEFAULT '',
+    category text REFERENCES document_categories(slug) ON DELETE SET NULL,
+    identifier_label text NULL, -- what the identifier is called, e.g. 'Passport number'
+    reminders text[] NOT NULL DEFAULT '{}', -- reminder_intervals id_labels
+    position int NOT NULL DEFAULT 0
+);
+
+-- document_template_fields (the custom fields a document created from a template starts with)
+CREATE TABLE IF NOT EXISTS document_template_fields (
+    template_slug text REFERENCES document_templates(slug) ON DELETE CASCADE,
+    key text NOT NULL,
+    label text NOT NULL,
+    position int NOT NULL DEFAULT 0,
+    PRIMARY KEY (template_slug, key)
+);
+
+-- document_custom_fields (free-form key/value details of a document, such as a domain's registrar)
+CREATE TABLE IF NOT EXISTS document_custom_fields (
+    document_id text NOT NULL REFERENCES documents(id) ON DELETE CASCADE,
+    key text NOT NULL,
+    label text NOT NULL,
+    value text NOT NULL DEFAULT '',
+    position int NOT NULL DEFAULT 0,
+    PRIMARY KEY (document_id, key)
+);
+
+INSERT INTO document_templates (slug, name, description, category, identifier_label, reminders, position) VALUES
+('passport', 'Passport', 'Many countries refuse entry on a passport with less than six months left, so reminders start early.', 'passport', 'Passport number', '{180d,90d,30d}', 0),
+('drivers_license', 'Driver''s License', 'Renewals can need an appointment or a medical check.', 'drivers_license', 'License number', '{90d,30d,7d}', 1),
+('insurance', 'Insurance Policy', 'Compare quotes before the policy auto-renews.', 'insurance', 'Policy number', '{30d,7d,1d}', 2),
+('domain', 'Domain Name', 'An expired domain takes its website and email down and can be lost to someone else.', 'domain', 'Domain name', '{60d,30d,7d,1d}', 3),
+('certificate', 'TLS Certificate', 'Browsers reject a site whose certificate has expired.', 'certificate', 'Common name', '{30d,14d,3d,1d}', 4),
+('lease', 'Lease / Rental Agreement', 'Leases often need notice well before the end date to renew or move out.', 'lease', 'Property address', '{90d,60d,30d}', 5);
+
+INSERT INTO document_template_fields (template_slug, key, label, position) VALUES
+('passport', 'issuing_country', 'Issuing country', 0),
+('passport', 'place_of_issue', 'Place of issue', 1),
+('drivers_license', 'issuing_region', 'Issuing state or region', 0),
+('drivers_license', 'license_class', 'License class', 1),
+('insurance', 'insurer', 'Insurer', 0),
+('insurance', 'coverage', 'Coverage', 1),
+('insurance', 'agent_contact', 'Agent contact', 2),
+('domain', 'registrar', 'Registrar', 0),
+('domain', 'auto_renew', 'Auto-renew', 1),
+('certificate', 'issuer', 'Certificate authority', 0),
+('certificate', 'hosts', 'Hosts', 1),
+('lease', 'landlord', 'Landlord', 0),
+('lease', 'notice_period', 'Notice period', 1),
+('lease', 'monthly_rent', 'Monthly rent', 2);
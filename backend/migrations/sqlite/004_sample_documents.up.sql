@@ -0,0 +1,9 @@
+-- 041_sample_documents
+-- sample_documents (example documents onboarding created for a user, so they can be removed in one go)
+CREATE TABLE IF NOT EXISTS sample_documents (
+    document_id text PRIMARY KEY REFERENCES documents(id) ON DELETE CASCADE,
+    user_id text NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    created_at timestamp DEFAULT (strftime('%Y-%m-%d %H:%M:%f000+00:00', 'now'))
+);
+
+CREATE INDEX IF NOT EXISTS idx_sample_documents_user_id ON sample_documents(user_id);
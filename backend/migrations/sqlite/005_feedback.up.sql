@@ -0,0 +1,16 @@
+-- 042_feedback
+-- feedback: messages and NPS scores users send from the app, relayed to the team by email and/or
+-- Slack. relayed_at is set once the relay went out.
+CREATE TABLE IF NOT EXISTS feedback (
+    id text PRIMARY KEY,
+    user_id text NULL REFERENCES users(id) ON DELETE SET NULL,
+    message text NOT NULL DEFAULT '',
+    score int NULL CHECK (score BETWEEN 0 AND 10),
+    page text NULL,
+    request_id text NULL,
+    user_agent text NULL,
+    relayed_at timestamp NULL,
+    created_at timestamp DEFAULT (strftime('%Y-%m-%d %H:%M:%f000+00:00', 'now'))
+);
+
+CREATE INDEX IF NOT EXISTS idx_feedback_created_at ON feedback(created_at DESC);
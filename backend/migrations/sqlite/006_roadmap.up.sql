@@ -0,0 +1,22 @@
+-- 043_roadmap
+-- roadmap_features: proposed features admins publish for users to vote on, so the team can prioritize
+-- by in-product demand
+CREATE TABLE IF NOT EXISTS roadmap_features (
+    id text PRIMARY KEY,
+    title text NOT NULL,
+    description text NOT NULL DEFAULT '',
+    status text NOT NULL DEFAULT 'proposed',
+    created_by text NULL REFERENCES users(id) ON DELETE SET NULL,
+    created_at timestamp DEFAULT (strftime('%Y-%m-%d %H:%M:%f000+00:00', 'now')),
+    updated_at timestamp DEFAULT (strftime('%Y-%m-%d %H:%M:%f000+00:00', 'now'))
+);
+
+-- roadmap_votes: one vote per user per feature
+CREATE TABLE IF NOT EXISTS roadmap_votes (
+    feature_id text NOT NULL REFERENCES roadmap_features(id) ON DELETE CASCADE,
+    user_id text NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    created_at timestamp DEFAULT (strftime('%Y-%m-%d %H:%M:%f000+00:00', 'now')),
+    PRIMARY KEY (feature_id, user_id)
+);
+
+CREATE INDEX IF NOT EXISTS idx_roadmap_votes_user_id ON roadmap_votes(user_id);
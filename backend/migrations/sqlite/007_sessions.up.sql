@@ -0,0 +1,16 @@
+-- 044_sessions
+-- sessions: the sign-ins of a user, keyed by the ID (jti) of the token each one issued, with the
+-- device and approximate location it came from so users can spot logins that were not theirs.
+CREATE TABLE IF NOT EXISTS sessions (
+    id text PRIMARY KEY,
+    user_id text NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    user_agent text NULL,
+    ip text NULL,
+    country text NULL,
+    region text NULL,
+    city text NULL,
+    created_at timestamp DEFAULT (strftime('%Y-%m-%d %H:%M:%f000+00:00', 'now')),
+    expires_at timestamp NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS idx_sessions_user_id ON sessions(user_id, created_at DESC);
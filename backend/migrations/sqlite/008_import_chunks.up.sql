@@ -0,0 +1,14 @@
+-- 045_import_chunks
+-- import jobs run in chunks of rows, one worker task each, so a large import neither holds a worker
+-- for long nor starts over after a restart.
+ALTER TABLE jobs ADD COLUMN items_total int NULL;
+ALTER TABLE jobs ADD COLUMN items_done int NOT NULL DEFAULT 0;
+
+CREATE TABLE IF NOT EXISTS import_chunks (
+    job_id text NOT NULL REFERENCES jobs(id) ON DELETE CASCADE,
+    chunk int NOT NULL,
+    rows text NOT NULL,
+    result text NULL,
+    done_at timestamp NULL,
+    PRIMARY KEY (job_id, chunk)
+);
@@ -0,0 +1,14 @@
+-- 046_attachment_blobs
+-- attachment_blobs: uploaded attachments by content, so identical files attached to several documents
+-- of the same owner are stored once at url. the documents whose attachment_url is the blob's url are its
+-- references; the blob and its stored object are deleted along with the last of them.
+CREATE TABLE IF NOT EXISTS attachment_blobs (
+    user_id text NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    checksum text NOT NULL,
+    url text NOT NULL UNIQUE,
+    size integer NOT NULL,
+    created_at timestamp DEFAULT (strftime('%Y-%m-%d %H:%M:%f000+00:00', 'now')),
+    PRIMARY KEY (user_id, checksum)
+);
+
+CREATE INDEX IF NOT EXISTS idx_documents_attachment_url ON documents(attachment_url) WHERE attachment_url IS NOT NULL;
@@ -0,0 +1,13 @@
+-- 047_attachment_retention
+-- plans: the plan of each user decides how long the attachments of their expired documents are kept
+-- (ATTACHMENT_RETENTION_<PLAN>_DAYS). existing users start on the free plan.
+ALTER TABLE users ADD COLUMN plan text NOT NULL DEFAULT 'free';
+
+-- attachment_retention_warnings: the owner was told the attachment at attachment_url will be deleted
+-- on delete_after, which is never sooner than the warning period after warned_at
+CREATE TABLE IF NOT EXISTS attachment_retention_warnings (
+    document_id text PRIMARY KEY REFERENCES documents(id) ON DELETE CASCADE,
+    attachment_url text NOT NULL,
+    delete_after date NOT NULL,
+    warned_at timestamp DEFAULT (strftime('%Y-%m-%d %H:%M:%f000+00:00', 'now'))
+);
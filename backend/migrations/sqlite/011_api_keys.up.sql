@@ -0,0 +1,17 @@
+-- 048_api_keys
+-- api_keys: long-lived keys users create for scripts and integrations, limited to the scopes they
+-- were granted. only the SHA-256 of a key is stored; prefix is its first characters, shown so users
+-- can tell their keys apart.
+CREATE TABLE IF NOT EXISTS api_keys (
+    id text PRIMARY KEY,
+    user_id text NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    name text NOT NULL,
+    key_hash text NOT NULL UNIQUE,
+    prefix text NOT NULL,
+    scopes text NOT NULL DEFAULT '{}',
+    expires_at timestamp NULL,
+    last_used_at timestamp NULL,
+    created_at timestamp DEFAULT (strftime('%Y-%m-%d %H:%M:%f000+00:00', 'now'))
+);
+
+CREATE INDEX IF NOT EXISTS idx_api_keys_user_id ON api_keys(user_id, created_at);
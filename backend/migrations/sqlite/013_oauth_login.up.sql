@@ -0,0 +1,7 @@
+-- 050_oauth_login
+-- the Google or GitHub account a user signs in with ('google' | 'github'); provider_id is the
+-- provider's id of the account, which stays the same when its email changes.
+ALTER TABLE users ADD COLUMN provider text NULL;
+ALTER TABLE users ADD COLUMN provider_id text NULL;
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_users_provider ON users(provider, provider_id) WHERE provider IS NOT NULL;
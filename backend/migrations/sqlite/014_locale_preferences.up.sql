@@ -0,0 +1,5 @@
+-- 051_locale_preferences
+-- the locale responses are written for when a request names none (NULL = English), and the unit
+-- "expires in ..." strings count in: 'auto' picks days, weeks or months by distance, 'days' always days
+ALTER TABLE users ADD COLUMN locale text NULL;
+ALTER TABLE users ADD COLUMN duration_unit text NOT NULL DEFAULT 'auto';
@@ -0,0 +1,14 @@
+-- 052_document_positions
+-- document_positions: how each user arranges the documents they see. pinned documents come first in
+-- the ?sort=custom listing, then the others by position (NULL = never placed, after the placed ones).
+-- rows are per user, so members of an organization each arrange its documents their own way.
+CREATE TABLE IF NOT EXISTS document_positions (
+    user_id text NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    document_id text NOT NULL REFERENCES documents(id) ON DELETE CASCADE,
+    pinned boolean NOT NULL DEFAULT FALSE,
+    position integer NULL,
+    updated_at timestamp NOT NULL DEFAULT (strftime('%Y-%m-%d %H:%M:%f000+00:00', 'now')),
+    PRIMARY KEY (user_id, document_id)
+);
+
+CREATE INDEX IF NOT EXISTS idx_document_positions_document_id ON document_positions(document_id);
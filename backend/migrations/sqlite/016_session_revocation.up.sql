@@ -0,0 +1,4 @@
+-- 053_session_revocation
+-- sessions.revoked_at: when the user signed the session out from another device or logged out
+-- everywhere. tokens of revoked sessions are refused until they expire.
+ALTER TABLE sessions ADD COLUMN revoked_at timestamp NULL;
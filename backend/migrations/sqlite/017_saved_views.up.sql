@@ -0,0 +1,14 @@
+-- 054_saved_views
+-- saved_views: named document filters users save for one-click access, such as "Work docs expiring
+-- within 60 days". filters holds the criteria as JSON (see db.ViewFilters); they are applied to the
+-- documents listed in the context of the request, like /api/documents.
+CREATE TABLE IF NOT EXISTS saved_views (
+    id text PRIMARY KEY,
+    user_id text NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    name text NOT NULL,
+    filters text NOT NULL DEFAULT '{}',
+    created_at timestamp DEFAULT (strftime('%Y-%m-%d %H:%M:%f000+00:00', 'now')),
+    updated_at timestamp DEFAULT (strftime('%Y-%m-%d %H:%M:%f000+00:00', 'now'))
+);
+
+CREATE INDEX IF NOT EXISTS idx_saved_views_user_id ON saved_views(user_id, created_at);
@@ -0,0 +1,4 @@
+-- 055_notification_pause
+-- notification_preferences.paused_until: while set and in the future, reminders are deferred
+-- rather than sent, then summed up in one catch-up message when the pause ends (e.g. vacations)
+ALTER TABLE notification_preferences ADD COLUMN paused_until timestamp NULL;
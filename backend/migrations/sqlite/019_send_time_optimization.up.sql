@@ -0,0 +1,4 @@
+-- 056_send_time_optimization
+-- notification_preferences.optimize_send_time: reminders wait, within the day they come due, for
+-- the hour of day the user has opened the most reminder emails in, as tracked by open pixels
+ALTER TABLE notification_preferences ADD COLUMN optimize_send_time boolean NOT NULL DEFAULT FALSE;
@@ -0,0 +1,4 @@
+-- 057_reminder_acknowledgement
+-- document_reminders.acknowledged_at: when the user last acted on a link of the reminder (viewed,
+-- renewed or snoozed); pending sends of the same reminder on other channels are dropped after it
+ALTER TABLE document_reminders ADD COLUMN acknowledged_at timestamp NULL;
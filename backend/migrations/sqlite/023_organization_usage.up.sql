@@ -0,0 +1,11 @@
+-- 060_organization_usage
+-- organization_usage: metered usage of each organization per UTC month, for billing and chargeback
+-- reports. quantity is the peak of the metric within the month
+CREATE TABLE IF NOT EXISTS organization_usage (
+    organization_id text NOT NULL,
+    month text NOT NULL,
+    metric text NOT NULL,
+    quantity integer NOT NULL,
+    recorded_at timestamp NOT NULL DEFAULT (strftime('%Y-%m-%d %H:%M:%f000+00:00', 'now')),
+    PRIMARY KEY (organization_id, month, metric)
+);
@@ -0,0 +1,27 @@
+-- 061_organization_retention
+-- organization_retention: how long an organization keeps its data, in days. NULL keeps it forever
+CREATE TABLE IF NOT EXISTS organization_retention (
+    organization_id text PRIMARY KEY REFERENCES organizations(id) ON DELETE CASCADE,
+    expired_documents_days integer NULL CHECK (expired_documents_days > 0),
+    event_log_days integer NULL CHECK (event_log_days > 0),
+    notification_history_days integer NULL CHECK (notification_history_days > 0),
+    updated_at timestamp NOT NULL DEFAULT (strftime('%Y-%m-%d %H:%M:%f000+00:00', 'now'))
+);
+
+-- event_log_prunes: where an organization's event log was cut by retention
+CREATE TABLE IF NOT EXISTS event_log_prunes (
+    organization_id text PRIMARY KEY,
+    through_seq integer NOT NULL,
+    through_hash text NOT NULL,
+    pruned_at timestamp NOT NULL DEFAULT (strftime('%Y-%m-%d %H:%M:%f000+00:00', 'now'))
+);
+
+DROP TRIGGER IF EXISTS event_log_no_delete;
+CREATE TRIGGER IF NOT EXISTS event_log_no_delete BEFORE DELETE ON event_log
+WHEN NOT EXISTS (
+    SELECT 1 FROM event_log_prunes p
+    WHERE p.organization_id = OLD.organization_id AND OLD.seq <= p.through_seq
+)
+BEGIN
+    SELECT RAISE(ABORT, 'event_log is append-only');
+END;
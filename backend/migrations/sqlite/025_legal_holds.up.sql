@@ -0,0 +1,11 @@
+-- 062_legal_holds
+-- legal_holds: documents, or whole organizations when document_id is NULL, that must be preserved
+CREATE TABLE IF NOT EXISTS legal_holds (
+    organization_id text NOT NULL,
+    document_id text NULL REFERENCES documents(id) ON DELETE CASCADE,
+    placed_by text NOT NULL,
+    placed_at timestamp NOT NULL DEFAULT (strftime('%Y-%m-%d %H:%M:%f000+00:00', 'now'))
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_legal_holds_organization ON legal_holds(organization_id) WHERE document_id IS NULL;
+CREATE UNIQUE INDEX IF NOT EXISTS idx_legal_holds_document ON legal_holds(document_id) WHERE document_id IS NOT NULL;
@@ -0,0 +1,4 @@
+-- 063_user_roles
+-- roles: what each user may do across the service, carried in their tokens. existing users start
+-- as users
+ALTER TABLE users ADD COLUMN role text NOT NULL DEFAULT 'user';
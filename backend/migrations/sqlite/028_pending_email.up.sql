@@ -0,0 +1,4 @@
+-- 065_pending_email
+-- pending_email: the address a user asked to switch their account to, until they confirm it through
+-- the link sent there. the account email, and so reminders, stay on the old address until then
+ALTER TABLE users ADD COLUMN pending_email text NULL;
@@ -0,0 +1,6 @@
+-- 066_account_deletion
+-- deactivated_at: when the user deleted their account. it is refused sign-in right away, and once the
+-- grace period has passed the purge job removes its personal documents and their attachments
+ALTER TABLE users ADD COLUMN deactivated_at timestamp NULL;
+
+CREATE INDEX IF NOT EXISTS idx_users_deactivated_at ON users(deactivated_at) WHERE deactivated_at IS NOT NULL;
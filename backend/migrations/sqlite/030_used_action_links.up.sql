@@ -0,0 +1,10 @@
+-- 067_used_action_links
+-- used_action_links: the IDs (jti) of signed notification links whose action was carried out, so a
+-- renewal or snooze link works once. rows are kept until the link would have expired anyway
+CREATE TABLE IF NOT EXISTS used_action_links (
+    jti text PRIMARY KEY,
+    used_at timestamp NOT NULL DEFAULT (strftime('%Y-%m-%d %H:%M:%f000+00:00', 'now')),
+    expires_at timestamp NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS idx_used_action_links_expires_at ON used_action_links(expires_at);
@@ -0,0 +1,5 @@
+-- 068_hash_scim_tokens
+-- scim_tokens keeps the SHA-256 hash of each token (hex), as api_keys does, so a leaked database
+-- or backup does not hand out working tokens. the identity provider keeps using its token
+ALTER TABLE scim_tokens RENAME COLUMN token TO token_hash;
+UPDATE scim_tokens SET token_hash = sha256_hex(token_hash);
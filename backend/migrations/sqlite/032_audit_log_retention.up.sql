@@ -0,0 +1,11 @@
+-- 069_audit_log_retention
+-- organization_id: the organization whose data an audit entry is about, so its retention
+-- (organization_retention.event_log_days) applies to the entry as it does to its event log
+ALTER TABLE audit_logs ADD COLUMN organization_id text NULL;
+
+UPDATE audit_logs SET organization_id = (
+    SELECT d.organization_id FROM documents d WHERE d.id = audit_logs.entity_id
+)
+WHERE organization_id IS NULL AND entity_type = 'document';
+
+CREATE INDEX IF NOT EXISTS idx_audit_logs_organization_id ON audit_logs(organization_id, created_at) WHERE organization_id IS NOT NULL;
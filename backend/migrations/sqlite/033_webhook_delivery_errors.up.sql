@@ -0,0 +1,5 @@
+-- 070_webhook_delivery_errors
+-- failed deliveries no longer keep what the endpoint answered; drop the response bodies
+-- already stored after "webhook endpoint responded <code>: "
+UPDATE webhook_deliveries SET error = substr(error, 1, instr(error, ':') - 1)
+WHERE error LIKE 'webhook endpoint responded %:%';
@@ -0,0 +1,23 @@
+-- name: CreateDocumentContact :one
+INSERT INTO document_contacts (id, document_id, name, email, unsubscribe_token)
+VALUES ($1, $2, $3, $4, $5)
+RETURNING created_at;
+
+-- name: ListDocumentContacts :many
+SELECT * FROM document_contacts
+WHERE document_id = $1
+ORDER BY created_at;
+
+-- name: DeleteDocumentContact :execrows
+DELETE FROM document_contacts
+WHERE id = $1 AND document_id = $2;
+
+-- name: GetDocumentContactByUnsubscribeToken :one
+SELECT * FROM document_contacts
+WHERE unsubscribe_token = $1;
+
+-- name: UnsubscribeDocumentContact :one
+UPDATE document_contacts
+SET unsubscribed_at = COALESCE(unsubscribed_at, NOW())
+WHERE unsubscribe_token = $1
+RETURNING *;
@@ -0,0 +1,28 @@
+-- name: CreateDocument :one
+INSERT INTO documents (id, user_id, name, description, identifier, identifier_index, expiration_date, timezone, attachment_url, category, organization_id, renewal_cost, currency, issuer_id, lead_time_days)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15)
+RETURNING created_at, updated_at;
+
+-- name: ListDocumentsByUserID :many
+SELECT * FROM documents
+WHERE user_id = $1 AND deleted_at IS NULL AND organization_id IS NOT DISTINCT FROM sqlc.narg(organization_id)::uuid
+ORDER BY created_at DESC;
+
+-- name: GetDocumentByID :one
+SELECT * FROM documents
+WHERE id = $1 AND deleted_at IS NULL;
+
+-- name: UpdateDocument :one
+-- UpdateDocument clears the scan state when the attachment changes.
+UPDATE documents
+SET name = $1, description = $2, identifier = $3, identifier_index = $9, expiration_date = $4, timezone = $5, category = $7, renewal_cost = $10, currency = $11, issuer_id = $12, lead_time_days = $13, updated_at = NOW(),
+    attachment_status = CASE WHEN attachment_url IS DISTINCT FROM $6 THEN NULL ELSE attachment_status END,
+    attachment_threat = CASE WHEN attachment_url IS DISTINCT FROM $6 THEN NULL ELSE attachment_threat END,
+    attachment_url = $6
+WHERE id = $8 AND deleted_at IS NULL
+RETURNING updated_at, attachment_status, attachment_threat;
+
+-- name: TrashDocument :execrows
+UPDATE documents
+SET deleted_at = NOW()
+WHERE id = $1 AND deleted_at IS NULL;
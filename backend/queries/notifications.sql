@@ -0,0 +1,13 @@
+-- name: CreateNotificationLog :one
+INSERT INTO notification_logs (id, message_id, user_id, recipient_id, document_id, reminder_interval_id, channel, status, response, request_id)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)
+RETURNING created_at;
+
+-- name: GetLatestNotificationLog :one
+-- GetLatestNotificationLog skips notifications that are not about a
+-- reminder, such as quarantined attachment alerts.
+SELECT id, user_id, document_id, reminder_interval_id, channel, status, response, created_at
+FROM notification_logs
+WHERE user_id = $1 AND channel = $2 AND document_id IS NOT NULL AND reminder_interval_id > 0
+ORDER BY created_at DESC
+LIMIT 1;
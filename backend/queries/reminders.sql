@@ -0,0 +1,34 @@
+-- name: ListReminderIntervals :many
+SELECT * FROM reminder_intervals;
+
+-- name: ListReminderIntervalsByIDLabels :many
+SELECT * FROM reminder_intervals
+WHERE id_label = ANY(sqlc.arg(id_labels)::text[]);
+
+-- name: GetReminderIntervalByID :one
+SELECT * FROM reminder_intervals
+WHERE id = $1;
+
+-- name: CreateDocumentReminder :one
+INSERT INTO document_reminders (id, document_id, reminder_interval_id, enabled, status)
+VALUES ($1, $2, $3, $4, $5)
+RETURNING sent_at;
+
+-- name: ToggleDocumentReminder :execrows
+UPDATE document_reminders
+SET enabled = $1, sent_at = NULL, status = $2, status_changed_at = NOW()
+WHERE document_id = $3 AND reminder_interval_id = $4;
+
+-- name: ListDocumentReminders :many
+SELECT * FROM document_reminders
+WHERE document_id = $1;
+
+-- name: MarkDocumentReminderSent :exec
+UPDATE document_reminders
+SET sent_at = NOW()
+WHERE document_id = $1 AND reminder_interval_id = $2;
+
+-- name: ResetDocumentReminders :exec
+UPDATE document_reminders
+SET sent_at = NULL, status = 'scheduled', status_changed_at = NOW()
+WHERE document_id = $1 AND status <> 'canceled';
@@ -0,0 +1,49 @@
+-- name: CreateUser :one
+INSERT INTO users (id, email, password, phone_number, name)
+VALUES ($1, $2, $3, $4, $5)
+RETURNING created_at, updated_at;
+
+-- name: GetUserIDByEmail :one
+SELECT id FROM users WHERE email = $1;
+
+-- name: GetUserByID :one
+SELECT * FROM users WHERE id = $1;
+
+-- name: GetUserByEmail :one
+SELECT * FROM users WHERE email = $1;
+
+-- name: GetUserEmail :one
+SELECT email FROM users WHERE id = $1;
+
+-- name: ListUsersAfter :many
+-- ListUsersAfter pages through every user in ID order, leaving out deleted accounts.
+SELECT * FROM users
+WHERE id > $1 AND deactivated_at IS NULL
+ORDER BY id
+LIMIT $2;
+
+-- name: SuspendUser :execrows
+UPDATE users
+SET suspended_at = NOW(), suspension_reason = $2, updated_at = NOW()
+WHERE id = $1 AND suspended_at IS NULL;
+
+-- name: ReinstateUser :execrows
+UPDATE users
+SET suspended_at = NULL, suspension_reason = NULL, updated_at = NOW()
+WHERE id = $1 AND suspended_at IS NOT NULL;
+
+-- name: UserSuspended :one
+SELECT (suspended_at IS NOT NULL)::boolean AS suspended FROM users WHERE id = $1;
+
+-- name: UserExists :one
+SELECT EXISTS (SELECT 1 FROM users WHERE id = $1);
+
+-- name: GetUserPhoneNumber :one
+SELECT phone_number FROM users WHERE id = $1;
+
+-- name: GetUserByPhoneNumber :one
+-- GetUserByPhoneNumber matches on digits only, so "+233 50 474 6610" and
+-- "233504746610" refer to the same user.
+SELECT * FROM users
+WHERE regexp_replace(phone_number, '[^0-9]', '', 'g') = regexp_replace(sqlc.arg(phone_number)::text, '[^0-9]', '', 'g')
+LIMIT 1;
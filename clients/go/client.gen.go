@@ -0,0 +1,23950 @@
+// Package xpired provides primitives to interact with the openapi HTTP API.
+//
+// Code generated by github.com/oapi-codegen/oapi-codegen/v2 version v2.5.1 DO NOT EDIT.
+package xpired
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/oapi-codegen/runtime"
+	openapi_types "github.com/oapi-codegen/runtime/types"
+)
+
+const (
+	BearerAuthScopes = "BearerAuth.Scopes"
+	SCIMTokenScopes  = "SCIMToken.Scopes"
+)
+
+// Defines values for APIKeyScopes.
+const (
+	APIKeyScopesDocumentsRead   APIKeyScopes = "documents:read"
+	APIKeyScopesDocumentsWrite  APIKeyScopes = "documents:write"
+	APIKeyScopesRemindersManage APIKeyScopes = "reminders:manage"
+)
+
+// Defines values for AnnouncementKind.
+const (
+	AnnouncementKindFeature     AnnouncementKind = "feature"
+	AnnouncementKindMaintenance AnnouncementKind = "maintenance"
+)
+
+// Defines values for DocumentAttachmentStatus.
+const (
+	DocumentAttachmentStatusClean     DocumentAttachmentStatus = "clean"
+	DocumentAttachmentStatusError     DocumentAttachmentStatus = "error"
+	DocumentAttachmentStatusInfected  DocumentAttachmentStatus = "infected"
+	DocumentAttachmentStatusPending   DocumentAttachmentStatus = "pending"
+	DocumentAttachmentStatusUnscanned DocumentAttachmentStatus = "unscanned"
+)
+
+// Defines values for DocumentReminderIntervalStatus.
+const (
+	DocumentReminderIntervalStatusAcknowledged DocumentReminderIntervalStatus = "acknowledged"
+	DocumentReminderIntervalStatusCanceled     DocumentReminderIntervalStatus = "canceled"
+	DocumentReminderIntervalStatusFailed       DocumentReminderIntervalStatus = "failed"
+	DocumentReminderIntervalStatusQueued       DocumentReminderIntervalStatus = "queued"
+	DocumentReminderIntervalStatusScheduled    DocumentReminderIntervalStatus = "scheduled"
+	DocumentReminderIntervalStatusSent         DocumentReminderIntervalStatus = "sent"
+	DocumentReminderIntervalStatusSnoozed      DocumentReminderIntervalStatus = "snoozed"
+)
+
+// Defines values for DuplicateDocumentsDuplicatesMatch.
+const (
+	Identifier DuplicateDocumentsDuplicatesMatch = "identifier"
+	Name       DuplicateDocumentsDuplicatesMatch = "name"
+)
+
+// Defines values for EscalationStepAudience.
+const (
+	EscalationStepAudienceEveryone EscalationStepAudience = "everyone"
+	EscalationStepAudienceManagers EscalationStepAudience = "managers"
+	EscalationStepAudienceOwner    EscalationStepAudience = "owner"
+)
+
+// Defines values for EventLogEntryChannel.
+const (
+	EventLogEntryChannelEmail EventLogEntryChannel = "email"
+	EventLogEntryChannelPush  EventLogEntryChannel = "push"
+	EventLogEntryChannelSms   EventLogEntryChannel = "sms"
+)
+
+// Defines values for EventLogEntryEventType.
+const (
+	EventLogEntryEventTypeReminderAcknowledged EventLogEntryEventType = "reminder.acknowledged"
+	EventLogEntryEventTypeReminderSent         EventLogEntryEventType = "reminder.sent"
+)
+
+// Defines values for FailedTaskState.
+const (
+	Archived FailedTaskState = "archived"
+	Retry    FailedTaskState = "retry"
+)
+
+// Defines values for FiringAlertName.
+const (
+	NotificationAnomaly  FiringAlertName = "notification_anomaly"
+	NotificationFailures FiringAlertName = "notification_failures"
+	NotificationsSilent  FiringAlertName = "notifications_silent"
+	QueueBacklog         FiringAlertName = "queue_backlog"
+)
+
+// Defines values for HouseholdMembersNotificationRouting.
+const (
+	HouseholdMembersNotificationRoutingBoth    HouseholdMembersNotificationRouting = "both"
+	HouseholdMembersNotificationRoutingMember  HouseholdMembersNotificationRouting = "member"
+	HouseholdMembersNotificationRoutingPrimary HouseholdMembersNotificationRouting = "primary"
+)
+
+// Defines values for HouseholdMembersRole.
+const (
+	HouseholdMembersRoleMember  HouseholdMembersRole = "member"
+	HouseholdMembersRolePrimary HouseholdMembersRole = "primary"
+)
+
+// Defines values for JWKSetKeysAlg.
+const (
+	EdDSA JWKSetKeysAlg = "EdDSA"
+	RS256 JWKSetKeysAlg = "RS256"
+)
+
+// Defines values for JWKSetKeysKty.
+const (
+	OKP JWKSetKeysKty = "OKP"
+	RSA JWKSetKeysKty = "RSA"
+)
+
+// Defines values for JobKind.
+const (
+	Import  JobKind = "import"
+	Takeout JobKind = "takeout"
+)
+
+// Defines values for JobStatus.
+const (
+	JobStatusFailed    JobStatus = "failed"
+	JobStatusQueued    JobStatus = "queued"
+	JobStatusRunning   JobStatus = "running"
+	JobStatusSucceeded JobStatus = "succeeded"
+)
+
+// Defines values for LocalePreferencesDurationUnit.
+const (
+	LocalePreferencesDurationUnitAuto LocalePreferencesDurationUnit = "auto"
+	LocalePreferencesDurationUnitDays LocalePreferencesDurationUnit = "days"
+)
+
+// Defines values for NotificationPreferencesEscalationChannel.
+const (
+	NotificationPreferencesEscalationChannelNone NotificationPreferencesEscalationChannel = "none"
+	NotificationPreferencesEscalationChannelSms  NotificationPreferencesEscalationChannel = "sms"
+)
+
+// Defines values for NotificationProviderChannel.
+const (
+	NotificationProviderChannelEmail NotificationProviderChannel = "email"
+	NotificationProviderChannelPush  NotificationProviderChannel = "push"
+	NotificationProviderChannelSms   NotificationProviderChannel = "sms"
+)
+
+// Defines values for OrganizationRole.
+const (
+	OrganizationRoleAdmin  OrganizationRole = "admin"
+	OrganizationRoleMember OrganizationRole = "member"
+	OrganizationRoleOwner  OrganizationRole = "owner"
+)
+
+// Defines values for OrganizationMemberRole.
+const (
+	OrganizationMemberRoleAdmin  OrganizationMemberRole = "admin"
+	OrganizationMemberRoleMember OrganizationMemberRole = "member"
+	OrganizationMemberRoleOwner  OrganizationMemberRole = "owner"
+)
+
+// Defines values for OrganizationUsageMetric.
+const (
+	OrganizationUsageMetricDocuments    OrganizationUsageMetric = "documents"
+	OrganizationUsageMetricSmsSent      OrganizationUsageMetric = "sms_sent"
+	OrganizationUsageMetricStorageBytes OrganizationUsageMetric = "storage_bytes"
+)
+
+// Defines values for QuotaUsageQuota.
+const (
+	QuotaUsageQuotaDocuments  QuotaUsageQuota = "documents"
+	QuotaUsageQuotaMonthlySms QuotaUsageQuota = "monthly_sms"
+)
+
+// Defines values for RenewalRequestStatus.
+const (
+	RenewalRequestStatusApproved RenewalRequestStatus = "approved"
+	RenewalRequestStatusPending  RenewalRequestStatus = "pending"
+	RenewalRequestStatusRejected RenewalRequestStatus = "rejected"
+)
+
+// Defines values for RoadmapFeatureStatus.
+const (
+	RoadmapFeatureStatusDeclined   RoadmapFeatureStatus = "declined"
+	RoadmapFeatureStatusInProgress RoadmapFeatureStatus = "in_progress"
+	RoadmapFeatureStatusPlanned    RoadmapFeatureStatus = "planned"
+	RoadmapFeatureStatusProposed   RoadmapFeatureStatus = "proposed"
+	RoadmapFeatureStatusShipped    RoadmapFeatureStatus = "shipped"
+)
+
+// Defines values for ScimPatchRequestOperationsOp.
+const (
+	Add     ScimPatchRequestOperationsOp = "add"
+	Remove  ScimPatchRequestOperationsOp = "remove"
+	Replace ScimPatchRequestOperationsOp = "replace"
+)
+
+// Defines values for ServiceAccountScopes.
+const (
+	ServiceAccountScopesDocumentsRead   ServiceAccountScopes = "documents:read"
+	ServiceAccountScopesDocumentsWrite  ServiceAccountScopes = "documents:write"
+	ServiceAccountScopesRemindersManage ServiceAccountScopes = "reminders:manage"
+)
+
+// Defines values for ShedRequestsReason.
+const (
+	DbPool   ShedRequestsReason = "db_pool"
+	InFlight ShedRequestsReason = "in_flight"
+)
+
+// Defines values for ViewFiltersStatus.
+const (
+	Active  ViewFiltersStatus = "active"
+	Expired ViewFiltersStatus = "expired"
+)
+
+// Defines values for WebhookDeliveryStatus.
+const (
+	Failed    WebhookDeliveryStatus = "failed"
+	Pending   WebhookDeliveryStatus = "pending"
+	Succeeded WebhookDeliveryStatus = "succeeded"
+)
+
+// Defines values for WebhookEndpointRequestEvents.
+const (
+	WebhookEndpointRequestEventsDocumentCreated WebhookEndpointRequestEvents = "document.created"
+	WebhookEndpointRequestEventsDocumentDeleted WebhookEndpointRequestEvents = "document.deleted"
+	WebhookEndpointRequestEventsDocumentUpdated WebhookEndpointRequestEvents = "document.updated"
+	WebhookEndpointRequestEventsReminderSent    WebhookEndpointRequestEvents = "reminder.sent"
+)
+
+// Defines values for PostApiAdminAnnouncementsJSONBodyKind.
+const (
+	PostApiAdminAnnouncementsJSONBodyKindFeature     PostApiAdminAnnouncementsJSONBodyKind = "feature"
+	PostApiAdminAnnouncementsJSONBodyKindMaintenance PostApiAdminAnnouncementsJSONBodyKind = "maintenance"
+)
+
+// Defines values for PostApiAdminRoadmapJSONBodyStatus.
+const (
+	PostApiAdminRoadmapJSONBodyStatusDeclined   PostApiAdminRoadmapJSONBodyStatus = "declined"
+	PostApiAdminRoadmapJSONBodyStatusInProgress PostApiAdminRoadmapJSONBodyStatus = "in_progress"
+	PostApiAdminRoadmapJSONBodyStatusPlanned    PostApiAdminRoadmapJSONBodyStatus = "planned"
+	PostApiAdminRoadmapJSONBodyStatusProposed   PostApiAdminRoadmapJSONBodyStatus = "proposed"
+	PostApiAdminRoadmapJSONBodyStatusShipped    PostApiAdminRoadmapJSONBodyStatus = "shipped"
+)
+
+// Defines values for PutApiAdminRoadmapIdJSONBodyStatus.
+const (
+	Declined   PutApiAdminRoadmapIdJSONBodyStatus = "declined"
+	InProgress PutApiAdminRoadmapIdJSONBodyStatus = "in_progress"
+	Planned    PutApiAdminRoadmapIdJSONBodyStatus = "planned"
+	Proposed   PutApiAdminRoadmapIdJSONBodyStatus = "proposed"
+	Shipped    PutApiAdminRoadmapIdJSONBodyStatus = "shipped"
+)
+
+// Defines values for PostApiAdminScheduledJobsNameRunParamsName.
+const (
+	DetectNotificationAnomalies  PostApiAdminScheduledJobsNameRunParamsName = "detect_notification_anomalies"
+	EnforceAttachmentRetention   PostApiAdminScheduledJobsNameRunParamsName = "enforce_attachment_retention"
+	EnforceOrganizationRetention PostApiAdminScheduledJobsNameRunParamsName = "enforce_organization_retention"
+	MeterUsage                   PostApiAdminScheduledJobsNameRunParamsName = "meter_usage"
+	MonitorHealth                PostApiAdminScheduledJobsNameRunParamsName = "monitor_health"
+	PurgeDeletedAccounts         PostApiAdminScheduledJobsNameRunParamsName = "purge_deleted_accounts"
+	PurgeTrash                   PostApiAdminScheduledJobsNameRunParamsName = "purge_trash"
+	RefreshExpiringDocuments     PostApiAdminScheduledJobsNameRunParamsName = "refresh_expiring_documents"
+)
+
+// Defines values for GetApiAdminUsageExportParamsFormat.
+const (
+	GetApiAdminUsageExportParamsFormatCsv  GetApiAdminUsageExportParamsFormat = "csv"
+	GetApiAdminUsageExportParamsFormatJson GetApiAdminUsageExportParamsFormat = "json"
+)
+
+// Defines values for PutApiAdminUsersIdPlanJSONBodyPlan.
+const (
+	Free PutApiAdminUsersIdPlanJSONBodyPlan = "free"
+	Pro  PutApiAdminUsersIdPlanJSONBodyPlan = "pro"
+)
+
+// Defines values for PutApiAdminUsersIdRoleJSONBodyRole.
+const (
+	PutApiAdminUsersIdRoleJSONBodyRoleAdmin PutApiAdminUsersIdRoleJSONBodyRole = "admin"
+	PutApiAdminUsersIdRoleJSONBodyRoleUser  PutApiAdminUsersIdRoleJSONBodyRole = "user"
+)
+
+// Defines values for PostApiApiKeysJSONBodyScopes.
+const (
+	PostApiApiKeysJSONBodyScopesDocumentsRead   PostApiApiKeysJSONBodyScopes = "documents:read"
+	PostApiApiKeysJSONBodyScopesDocumentsWrite  PostApiApiKeysJSONBodyScopes = "documents:write"
+	PostApiApiKeysJSONBodyScopesRemindersManage PostApiApiKeysJSONBodyScopes = "reminders:manage"
+)
+
+// Defines values for GetApiAuthOauthProviderParamsProvider.
+const (
+	GetApiAuthOauthProviderParamsProviderGithub GetApiAuthOauthProviderParamsProvider = "github"
+	GetApiAuthOauthProviderParamsProviderGoogle GetApiAuthOauthProviderParamsProvider = "google"
+)
+
+// Defines values for GetApiAuthOauthProviderCallbackParamsProvider.
+const (
+	GetApiAuthOauthProviderCallbackParamsProviderGithub GetApiAuthOauthProviderCallbackParamsProvider = "github"
+	GetApiAuthOauthProviderCallbackParamsProviderGoogle GetApiAuthOauthProviderCallbackParamsProvider = "google"
+)
+
+// Defines values for GetApiDocumentsParamsSort.
+const (
+	GetApiDocumentsParamsSortCustom GetApiDocumentsParamsSort = "custom"
+)
+
+// Defines values for PostApiDocumentsImportParamsSource.
+const (
+	PostApiDocumentsImportParamsSourceCertificates PostApiDocumentsImportParamsSource = "certificates"
+	PostApiDocumentsImportParamsSourceGoogleKeep   PostApiDocumentsImportParamsSource = "google-keep"
+	PostApiDocumentsImportParamsSourceGoogleSheets PostApiDocumentsImportParamsSource = "google-sheets"
+	PostApiDocumentsImportParamsSourceN1password   PostApiDocumentsImportParamsSource = "1password"
+)
+
+// Defines values for GetApiDocumentsIdRemindersPreviewParamsChannel.
+const (
+	GetApiDocumentsIdRemindersPreviewParamsChannelEmail GetApiDocumentsIdRemindersPreviewParamsChannel = "email"
+	GetApiDocumentsIdRemindersPreviewParamsChannelPush  GetApiDocumentsIdRemindersPreviewParamsChannel = "push"
+	GetApiDocumentsIdRemindersPreviewParamsChannelSms   GetApiDocumentsIdRemindersPreviewParamsChannel = "sms"
+)
+
+// Defines values for PostApiHouseholdMembersJSONBodyNotificationRouting.
+const (
+	PostApiHouseholdMembersJSONBodyNotificationRoutingBoth    PostApiHouseholdMembersJSONBodyNotificationRouting = "both"
+	PostApiHouseholdMembersJSONBodyNotificationRoutingMember  PostApiHouseholdMembersJSONBodyNotificationRouting = "member"
+	PostApiHouseholdMembersJSONBodyNotificationRoutingPrimary PostApiHouseholdMembersJSONBodyNotificationRouting = "primary"
+)
+
+// Defines values for PutApiHouseholdMembersUserIdJSONBodyNotificationRouting.
+const (
+	PutApiHouseholdMembersUserIdJSONBodyNotificationRoutingBoth    PutApiHouseholdMembersUserIdJSONBodyNotificationRouting = "both"
+	PutApiHouseholdMembersUserIdJSONBodyNotificationRoutingMember  PutApiHouseholdMembersUserIdJSONBodyNotificationRouting = "member"
+	PutApiHouseholdMembersUserIdJSONBodyNotificationRoutingPrimary PutApiHouseholdMembersUserIdJSONBodyNotificationRouting = "primary"
+)
+
+// Defines values for PostApiJobsImportParamsSource.
+const (
+	PostApiJobsImportParamsSourceCertificates PostApiJobsImportParamsSource = "certificates"
+	PostApiJobsImportParamsSourceGoogleKeep   PostApiJobsImportParamsSource = "google-keep"
+	PostApiJobsImportParamsSourceGoogleSheets PostApiJobsImportParamsSource = "google-sheets"
+	PostApiJobsImportParamsSourceN1password   PostApiJobsImportParamsSource = "1password"
+)
+
+// Defines values for PostApiOrganizationsIdMembersJSONBodyRole.
+const (
+	Admin  PostApiOrganizationsIdMembersJSONBodyRole = "admin"
+	Member PostApiOrganizationsIdMembersJSONBodyRole = "member"
+)
+
+// Defines values for PostApiOrganizationsIdServiceAccountsJSONBodyScopes.
+const (
+	PostApiOrganizationsIdServiceAccountsJSONBodyScopesDocumentsRead   PostApiOrganizationsIdServiceAccountsJSONBodyScopes = "documents:read"
+	PostApiOrganizationsIdServiceAccountsJSONBodyScopesDocumentsWrite  PostApiOrganizationsIdServiceAccountsJSONBodyScopes = "documents:write"
+	PostApiOrganizationsIdServiceAccountsJSONBodyScopesRemindersManage PostApiOrganizationsIdServiceAccountsJSONBodyScopes = "reminders:manage"
+)
+
+// Defines values for GetApiOrganizationsIdUsageExportParamsFormat.
+const (
+	GetApiOrganizationsIdUsageExportParamsFormatCsv  GetApiOrganizationsIdUsageExportParamsFormat = "csv"
+	GetApiOrganizationsIdUsageExportParamsFormatJson GetApiOrganizationsIdUsageExportParamsFormat = "json"
+)
+
+// Defines values for PutApiPreferencesLocaleJSONBodyDurationUnit.
+const (
+	PutApiPreferencesLocaleJSONBodyDurationUnitAuto PutApiPreferencesLocaleJSONBodyDurationUnit = "auto"
+	PutApiPreferencesLocaleJSONBodyDurationUnitDays PutApiPreferencesLocaleJSONBodyDurationUnit = "days"
+)
+
+// Defines values for PutApiPreferencesNotificationsJSONBodyEscalationChannel.
+const (
+	None PutApiPreferencesNotificationsJSONBodyEscalationChannel = "none"
+	Sms  PutApiPreferencesNotificationsJSONBodyEscalationChannel = "sms"
+)
+
+// Defines values for GetApiViewsIdDocumentsParamsSort.
+const (
+	GetApiViewsIdDocumentsParamsSortCustom GetApiViewsIdDocumentsParamsSort = "custom"
+)
+
+// APIKey defines model for APIKey.
+type APIKey struct {
+	CreatedAt  *time.Time          `json:"createdAt,omitempty"`
+	ExpiresAt  *time.Time          `json:"expiresAt,omitempty"`
+	Id         *openapi_types.UUID `json:"id,omitempty"`
+	LastUsedAt *time.Time          `json:"lastUsedAt,omitempty"`
+	Name       *string             `json:"name,omitempty"`
+
+	// Prefix The first characters of the key, to tell keys apart.
+	Prefix *string             `json:"prefix,omitempty"`
+	Scopes *[]APIKeyScopes     `json:"scopes,omitempty"`
+	UserId *openapi_types.UUID `json:"userId,omitempty"`
+}
+
+// APIKeyScopes defines model for APIKey.Scopes.
+type APIKeyScopes string
+
+// Announcement defines model for Announcement.
+type Announcement struct {
+	Body      *string             `json:"body,omitempty"`
+	CreatedAt *time.Time          `json:"createdAt,omitempty"`
+	CreatedBy *openapi_types.UUID `json:"createdBy,omitempty"`
+	EmailedAt *time.Time          `json:"emailedAt,omitempty"`
+	EndsAt    *time.Time          `json:"endsAt,omitempty"`
+	Id        *openapi_types.UUID `json:"id,omitempty"`
+	Kind      *AnnouncementKind   `json:"kind,omitempty"`
+	SendEmail *bool               `json:"sendEmail,omitempty"`
+	StartsAt  *time.Time          `json:"startsAt,omitempty"`
+	Title     *string             `json:"title,omitempty"`
+}
+
+// AnnouncementKind defines model for Announcement.Kind.
+type AnnouncementKind string
+
+// ChannelMatrix Channels per reminder interval ID (e.g. "30d"). Intervals without an entry go by email, plus SMS unless escalationChannel is "sms". An empty list silences the interval.
+type ChannelMatrix map[string][]string
+
+// ChecklistItem defines model for ChecklistItem.
+type ChecklistItem struct {
+	Done     *bool               `json:"done,omitempty"`
+	DoneAt   *time.Time          `json:"doneAt"`
+	Id       *openapi_types.UUID `json:"id,omitempty"`
+	Position *int                `json:"position,omitempty"`
+	Title    *string             `json:"title,omitempty"`
+}
+
+// ChecklistProgress defines model for ChecklistProgress.
+type ChecklistProgress struct {
+	Done  *int `json:"done,omitempty"`
+	Total *int `json:"total,omitempty"`
+}
+
+// CustomField defines model for CustomField.
+type CustomField struct {
+	Key   *string `json:"key,omitempty"`
+	Label *string `json:"label,omitempty"`
+	Value *string `json:"value,omitempty"`
+}
+
+// DependencyDocument defines model for DependencyDocument.
+type DependencyDocument struct {
+	ExpirationDate *string             `json:"expirationDate,omitempty"`
+	Id             *openapi_types.UUID `json:"id,omitempty"`
+
+	// Impacted The dependent is valid for longer than the document it depends on.
+	Impacted *bool   `json:"impacted,omitempty"`
+	Name     *string `json:"name,omitempty"`
+}
+
+// DeprecatedEndpoint defines model for DeprecatedEndpoint.
+type DeprecatedEndpoint struct {
+	Calls *int64 `json:"calls,omitempty"`
+
+	// DailyCalls Calls per UTC date (YYYY-MM-DD).
+	DailyCalls *map[string]int64 `json:"dailyCalls,omitempty"`
+
+	// DistinctCallers Estimated number of distinct users or addresses calling the endpoint.
+	DistinctCallers *int64     `json:"distinctCallers,omitempty"`
+	Method          *string    `json:"method,omitempty"`
+	Route           *string    `json:"route,omitempty"`
+	Since           *time.Time `json:"since,omitempty"`
+	Successor       *string    `json:"successor,omitempty"`
+	Sunset          *time.Time `json:"sunset,omitempty"`
+}
+
+// Document defines model for Document.
+type Document struct {
+	// AttachmentStatus Virus scan state of an uploaded attachment; absent for external links.
+	AttachmentStatus *DocumentAttachmentStatus `json:"attachmentStatus"`
+
+	// AttachmentThreat Malware found in a quarantined attachment.
+	AttachmentThreat *string            `json:"attachmentThreat"`
+	AttachmentUrl    *string            `json:"attachmentUrl"`
+	Category         *string            `json:"category"`
+	Checklist        *ChecklistProgress `json:"checklist,omitempty"`
+	CreatedAt        *time.Time         `json:"createdAt,omitempty"`
+
+	// Currency ISO 4217 code of renewalCost.
+	Currency     *string        `json:"currency"`
+	CustomFields *[]CustomField `json:"customFields,omitempty"`
+	Description  *string        `json:"description"`
+
+	// ExpirationDate Formatted date string (e.g., 'Mon, 2 Jan, 2006')
+	ExpirationDate *string `json:"expirationDate,omitempty"`
+
+	// ExpiresIn How far off the expiration date is, such as "expires in 3 weeks", in the locale of the locale query parameter, else Accept-Language, else the user's locale preference, and in the duration unit of the units query parameter or the user's preference.
+	ExpiresIn  *string             `json:"expiresIn,omitempty"`
+	Id         *openapi_types.UUID `json:"id,omitempty"`
+	Identifier *string             `json:"identifier"`
+
+	// Issuer The linked issuer; only included when fetching a single document.
+	Issuer *struct {
+		Id             *openapi_types.UUID `json:"id,omitempty"`
+		Name           *string             `json:"name,omitempty"`
+		ProcessingDays *int                `json:"processingDays,omitempty"`
+		RenewalUrl     *string             `json:"renewalUrl,omitempty"`
+	} `json:"issuer,omitempty"`
+	IssuerId *openapi_types.UUID `json:"issuerId"`
+
+	// LeadTimeDays Days renewing the document takes, overriding the issuer's processing time.
+	LeadTimeDays *int `json:"leadTimeDays,omitempty"`
+
+	// Lock Present while someone holds the document's edit lock.
+	Lock *DocumentLock `json:"lock,omitempty"`
+	Name *string       `json:"name,omitempty"`
+
+	// OrganizationId Organization the document belongs to; absent for personal documents.
+	OrganizationId *openapi_types.UUID `json:"organizationId"`
+	Reminders      *[]ReminderInterval `json:"reminders,omitempty"`
+	RenewalCost    *float32            `json:"renewalCost"`
+	Timezone       *string             `json:"timezone,omitempty"`
+	UpdatedAt      *time.Time          `json:"updatedAt,omitempty"`
+	UserId         *openapi_types.UUID `json:"userId,omitempty"`
+}
+
+// DocumentAttachmentStatus Virus scan state of an uploaded attachment; absent for external links.
+type DocumentAttachmentStatus string
+
+// DocumentAssignment defines model for DocumentAssignment.
+type DocumentAssignment struct {
+	AcknowledgedAt *time.Time          `json:"acknowledgedAt"`
+	AssignedAt     *time.Time          `json:"assignedAt,omitempty"`
+	AssignedBy     *openapi_types.UUID `json:"assignedBy"`
+	AssigneeId     *openapi_types.UUID `json:"assigneeId,omitempty"`
+	AssigneeName   *string             `json:"assigneeName,omitempty"`
+	DocumentId     *openapi_types.UUID `json:"documentId,omitempty"`
+}
+
+// DocumentCategory defines model for DocumentCategory.
+type DocumentCategory struct {
+	DefaultReminders *[]string `json:"defaultReminders,omitempty"`
+	Name             *string   `json:"name,omitempty"`
+	Slug             *string   `json:"slug,omitempty"`
+}
+
+// DocumentContact defines model for DocumentContact.
+type DocumentContact struct {
+	CreatedAt    *time.Time           `json:"createdAt,omitempty"`
+	Email        *openapi_types.Email `json:"email,omitempty"`
+	Id           *openapi_types.UUID  `json:"id,omitempty"`
+	Name         *string              `json:"name"`
+	Unsubscribed *bool                `json:"unsubscribed,omitempty"`
+}
+
+// DocumentLock Present while someone holds the document's edit lock.
+type DocumentLock struct {
+	ExpiresAt *time.Time          `json:"expiresAt,omitempty"`
+	HeldByYou *bool               `json:"heldByYou,omitempty"`
+	LockedAt  *time.Time          `json:"lockedAt,omitempty"`
+	UserId    *openapi_types.UUID `json:"userId,omitempty"`
+	UserName  *string             `json:"userName,omitempty"`
+}
+
+// DocumentReminderInterval defines model for DocumentReminderInterval.
+type DocumentReminderInterval struct {
+	Enabled *bool `json:"enabled,omitempty"`
+
+	// Id Interval ID label (e.g., '7d', '30d', '90d')
+	Id *string `json:"id,omitempty"`
+
+	// Label Human-readable label
+	Label *string `json:"label,omitempty"`
+
+	// Status Where the reminder stands: scheduled until it comes due; queued while held back for a batching window, a notification pause, the user's send time or a paused channel; sent once it went out on a channel, or failed if it failed on all of them; acknowledged or snoozed from one of its links; canceled while turned off.
+	Status          *DocumentReminderIntervalStatus `json:"status,omitempty"`
+	StatusChangedAt *time.Time                      `json:"statusChangedAt,omitempty"`
+}
+
+// DocumentReminderIntervalStatus Where the reminder stands: scheduled until it comes due; queued while held back for a batching window, a notification pause, the user's send time or a paused channel; sent once it went out on a channel, or failed if it failed on all of them; acknowledged or snoozed from one of its links; canceled while turned off.
+type DocumentReminderIntervalStatus string
+
+// DocumentStats defines model for DocumentStats.
+type DocumentStats struct {
+	Expired          *int `json:"expired,omitempty"`
+	ExpiringIn30Days *int `json:"expiringIn30Days,omitempty"`
+	Total            *int `json:"total,omitempty"`
+}
+
+// DocumentTemplate defines model for DocumentTemplate.
+type DocumentTemplate struct {
+	// Category Category slug
+	Category    *string `json:"category,omitempty"`
+	Description *string `json:"description,omitempty"`
+	Fields      *[]struct {
+		Key   *string `json:"key,omitempty"`
+		Label *string `json:"label,omitempty"`
+	} `json:"fields,omitempty"`
+
+	// IdentifierLabel What the document's identifier is called, e.g. "Passport number".
+	IdentifierLabel *string   `json:"identifierLabel,omitempty"`
+	Name            *string   `json:"name,omitempty"`
+	Reminders       *[]string `json:"reminders,omitempty"`
+	Slug            *string   `json:"slug,omitempty"`
+}
+
+// DuplicateDocuments defines model for DuplicateDocuments.
+type DuplicateDocuments struct {
+	Code       *string `json:"code,omitempty"`
+	Duplicates *[]struct {
+		Category       *string                            `json:"category,omitempty"`
+		ExpirationDate *string                            `json:"expirationDate,omitempty"`
+		Id             *openapi_types.UUID                `json:"id,omitempty"`
+		Match          *DuplicateDocumentsDuplicatesMatch `json:"match,omitempty"`
+		Name           *string                            `json:"name,omitempty"`
+	} `json:"duplicates,omitempty"`
+	Message   *string    `json:"message,omitempty"`
+	Status    *int       `json:"status,omitempty"`
+	Timestamp *time.Time `json:"timestamp,omitempty"`
+}
+
+// DuplicateDocumentsDuplicatesMatch defines model for DuplicateDocuments.Duplicates.Match.
+type DuplicateDocumentsDuplicatesMatch string
+
+// EmailSender defines model for EmailSender.
+type EmailSender struct {
+	CheckedAt   *time.Time           `json:"checkedAt,omitempty"`
+	Domain      *string              `json:"domain,omitempty"`
+	FromAddress *openapi_types.Email `json:"fromAddress,omitempty"`
+	FromName    *string              `json:"fromName,omitempty"`
+
+	// VerificationRecord DNS record proving ownership of the domain.
+	VerificationRecord *struct {
+		Name  *string `json:"name,omitempty"`
+		Type  *string `json:"type,omitempty"`
+		Value *string `json:"value,omitempty"`
+	} `json:"verificationRecord,omitempty"`
+
+	// Verified Whether reminders are sent from this identity yet.
+	Verified   *bool      `json:"verified,omitempty"`
+	VerifiedAt *time.Time `json:"verifiedAt,omitempty"`
+}
+
+// EmailSenderRequest defines model for EmailSenderRequest.
+type EmailSenderRequest struct {
+	FromAddress openapi_types.Email `json:"fromAddress"`
+
+	// FromName Defaults to the organization's name.
+	FromName *string `json:"fromName,omitempty"`
+}
+
+// EscalationStep defines model for EscalationStep.
+type EscalationStep struct {
+	Audience   EscalationStepAudience `json:"audience"`
+	DaysBefore int                    `json:"daysBefore"`
+}
+
+// EscalationStepAudience defines model for EscalationStep.Audience.
+type EscalationStepAudience string
+
+// EventLogEntry defines model for EventLogEntry.
+type EventLogEntry struct {
+	// ActorId The user who acknowledged, for acknowledgments.
+	ActorId *openapi_types.UUID   `json:"actorId,omitempty"`
+	Channel *EventLogEntryChannel `json:"channel,omitempty"`
+
+	// Detail JSON with event specifics, as hashed.
+	Detail         *string                 `json:"detail,omitempty"`
+	DocumentId     *openapi_types.UUID     `json:"documentId,omitempty"`
+	EventType      *EventLogEntryEventType `json:"eventType,omitempty"`
+	Hash           *string                 `json:"hash,omitempty"`
+	OccurredAt     *time.Time              `json:"occurredAt,omitempty"`
+	OrganizationId *openapi_types.UUID     `json:"organizationId,omitempty"`
+	PrevHash       *string                 `json:"prevHash,omitempty"`
+	RecipientId    *openapi_types.UUID     `json:"recipientId,omitempty"`
+
+	// Seq Position in the organization's chain, starting at 1.
+	Seq *int64 `json:"seq,omitempty"`
+}
+
+// EventLogEntryChannel defines model for EventLogEntry.Channel.
+type EventLogEntryChannel string
+
+// EventLogEntryEventType defines model for EventLogEntry.EventType.
+type EventLogEntryEventType string
+
+// EventLogReport defines model for EventLogReport.
+type EventLogReport struct {
+	// Algorithm How each entry's hash is computed.
+	Algorithm *string `json:"algorithm,omitempty"`
+
+	// AnchorHash The prevHash of the first entry exported: the hash of the last entry deleted by retention, or genesisHash.
+	AnchorHash *string          `json:"anchorHash,omitempty"`
+	EventCount *int             `json:"eventCount,omitempty"`
+	Events     *[]EventLogEntry `json:"events,omitempty"`
+
+	// FirstInvalidSeq The first entry that breaks the chain, when verified is false.
+	FirstInvalidSeq *int64     `json:"firstInvalidSeq,omitempty"`
+	GeneratedAt     *time.Time `json:"generatedAt,omitempty"`
+
+	// GenesisHash The prevHash of the first entry of the log.
+	GenesisHash    *string             `json:"genesisHash,omitempty"`
+	HeadHash       *string             `json:"headHash,omitempty"`
+	OrganizationId *openapi_types.UUID `json:"organizationId,omitempty"`
+
+	// PrunedThroughSeq The last entry deleted by retention, if any.
+	PrunedThroughSeq *int64 `json:"prunedThroughSeq,omitempty"`
+
+	// Verified Whether the chain was intact when the report was generated.
+	Verified *bool `json:"verified,omitempty"`
+}
+
+// FailedTask defines model for FailedTask.
+type FailedTask struct {
+	Id           *string                 `json:"id,omitempty"`
+	LastError    *string                 `json:"lastError,omitempty"`
+	LastFailedAt *time.Time              `json:"lastFailedAt,omitempty"`
+	MaxRetry     *int                    `json:"maxRetry,omitempty"`
+	NextRetryAt  *time.Time              `json:"nextRetryAt,omitempty"`
+	Payload      *map[string]interface{} `json:"payload,omitempty"`
+	Queue        *string                 `json:"queue,omitempty"`
+	Retried      *int                    `json:"retried,omitempty"`
+	State        *FailedTaskState        `json:"state,omitempty"`
+	Type         *string                 `json:"type,omitempty"`
+}
+
+// FailedTaskState defines model for FailedTask.State.
+type FailedTaskState string
+
+// FeedURLsResponse defines model for FeedURLsResponse.
+type FeedURLsResponse struct {
+	Feeds *struct {
+		Atom *string `json:"atom,omitempty"`
+		Rss  *string `json:"rss,omitempty"`
+	} `json:"feeds,omitempty"`
+	Message *string `json:"message,omitempty"`
+}
+
+// Feedback defines model for Feedback.
+type Feedback struct {
+	CreatedAt *time.Time          `json:"createdAt,omitempty"`
+	Id        *openapi_types.UUID `json:"id,omitempty"`
+	Message   *string             `json:"message,omitempty"`
+	Page      *string             `json:"page,omitempty"`
+	RelayedAt *time.Time          `json:"relayedAt,omitempty"`
+	RequestId *string             `json:"requestId,omitempty"`
+	Score     *int                `json:"score,omitempty"`
+	UserAgent *string             `json:"userAgent,omitempty"`
+	UserId    *openapi_types.UUID `json:"userId,omitempty"`
+}
+
+// FiringAlert defines model for FiringAlert.
+type FiringAlert struct {
+	FiredAt *time.Time       `json:"firedAt,omitempty"`
+	Name    *FiringAlertName `json:"name,omitempty"`
+}
+
+// FiringAlertName defines model for FiringAlert.Name.
+type FiringAlertName string
+
+// Household defines model for Household.
+type Household struct {
+	CreatedAt *time.Time          `json:"createdAt,omitempty"`
+	Id        *openapi_types.UUID `json:"id,omitempty"`
+	Members   *[]struct {
+		Email               *string                              `json:"email,omitempty"`
+		JoinedAt            *time.Time                           `json:"joinedAt,omitempty"`
+		Name                *string                              `json:"name,omitempty"`
+		NotificationRouting *HouseholdMembersNotificationRouting `json:"notificationRouting,omitempty"`
+		Role                *HouseholdMembersRole                `json:"role,omitempty"`
+		UserId              *openapi_types.UUID                  `json:"userId,omitempty"`
+	} `json:"members,omitempty"`
+	Name          *string             `json:"name,omitempty"`
+	PrimaryUserId *openapi_types.UUID `json:"primaryUserId,omitempty"`
+}
+
+// HouseholdMembersNotificationRouting defines model for Household.Members.NotificationRouting.
+type HouseholdMembersNotificationRouting string
+
+// HouseholdMembersRole defines model for Household.Members.Role.
+type HouseholdMembersRole string
+
+// Issuer defines model for Issuer.
+type Issuer struct {
+	CountryCode    *string             `json:"countryCode,omitempty"`
+	CreatedAt      *time.Time          `json:"createdAt,omitempty"`
+	Id             *openapi_types.UUID `json:"id,omitempty"`
+	Name           *string             `json:"name,omitempty"`
+	ProcessingDays *int                `json:"processingDays,omitempty"`
+	RenewalUrl     *string             `json:"renewalUrl,omitempty"`
+	UpdatedAt      *time.Time          `json:"updatedAt,omitempty"`
+}
+
+// IssuerRequest defines model for IssuerRequest.
+type IssuerRequest struct {
+	// CountryCode ISO 3166-1 alpha-2 code
+	CountryCode *string `json:"countryCode,omitempty"`
+	Name        string  `json:"name"`
+
+	// ProcessingDays Estimated processing time of a renewal.
+	ProcessingDays *int    `json:"processingDays,omitempty"`
+	RenewalUrl     *string `json:"renewalUrl,omitempty"`
+}
+
+// JWKSet defines model for JWKSet.
+type JWKSet struct {
+	Keys *[]struct {
+		Alg *JWKSetKeysAlg `json:"alg,omitempty"`
+
+		// Crv Ed25519 for OKP keys.
+		Crv *string `json:"crv,omitempty"`
+
+		// E RSA exponent, base64url.
+		E   *string        `json:"e,omitempty"`
+		Kid *string        `json:"kid,omitempty"`
+		Kty *JWKSetKeysKty `json:"kty,omitempty"`
+
+		// N RSA modulus, base64url.
+		N   *string `json:"n,omitempty"`
+		Use *string `json:"use,omitempty"`
+
+		// X Ed25519 public key, base64url.
+		X *string `json:"x,omitempty"`
+	} `json:"keys,omitempty"`
+}
+
+// JWKSetKeysAlg defines model for JWKSet.Keys.Alg.
+type JWKSetKeysAlg string
+
+// JWKSetKeysKty defines model for JWKSet.Keys.Kty.
+type JWKSetKeysKty string
+
+// Job defines model for Job.
+type Job struct {
+	CreatedAt *time.Time `json:"createdAt,omitempty"`
+
+	// Error Why a failed job failed
+	Error      *string             `json:"error,omitempty"`
+	FinishedAt *time.Time          `json:"finishedAt,omitempty"`
+	Id         *openapi_types.UUID `json:"id,omitempty"`
+	Kind       *JobKind            `json:"kind,omitempty"`
+
+	// Progress Rows imported so far by a running import job
+	Progress *struct {
+		Done  *int `json:"done,omitempty"`
+		Total *int `json:"total,omitempty"`
+	} `json:"progress,omitempty"`
+
+	// Result Outcome of a succeeded job; its fields depend on the kind
+	Result *map[string]interface{} `json:"result,omitempty"`
+
+	// ResultUrl File produced by a succeeded job, if any
+	ResultUrl *string             `json:"resultUrl,omitempty"`
+	StartedAt *time.Time          `json:"startedAt,omitempty"`
+	Status    *JobStatus          `json:"status,omitempty"`
+	UserId    *openapi_types.UUID `json:"userId,omitempty"`
+}
+
+// JobKind defines model for Job.Kind.
+type JobKind string
+
+// JobStatus defines model for Job.Status.
+type JobStatus string
+
+// JobQueued defines model for JobQueued.
+type JobQueued struct {
+	Job     *Job    `json:"job,omitempty"`
+	Message *string `json:"message,omitempty"`
+}
+
+// LegalHold defines model for LegalHold.
+type LegalHold struct {
+	Held *bool `json:"held,omitempty"`
+
+	// OrganizationHeld Set on documents held only because their organization is.
+	OrganizationHeld *bool               `json:"organizationHeld,omitempty"`
+	PlacedAt         *time.Time          `json:"placedAt,omitempty"`
+	PlacedBy         *openapi_types.UUID `json:"placedBy,omitempty"`
+}
+
+// LegalHoldRequest defines model for LegalHoldRequest.
+type LegalHoldRequest struct {
+	Held bool `json:"held"`
+}
+
+// LocalePreferences defines model for LocalePreferences.
+type LocalePreferences struct {
+	// DurationUnit Unit of humanized durations when a request names none: auto picks days, weeks or months by size, days always counts days.
+	DurationUnit *LocalePreferencesDurationUnit `json:"durationUnit,omitempty"`
+
+	// Locale BCP 47 tag of the locale used when a request names none; English when unset.
+	Locale *string `json:"locale"`
+}
+
+// LocalePreferencesDurationUnit Unit of humanized durations when a request names none: auto picks days, weeks or months by size, days always counts days.
+type LocalePreferencesDurationUnit string
+
+// NotificationPreferences defines model for NotificationPreferences.
+type NotificationPreferences struct {
+	BatchWindowHours *int `json:"batchWindowHours,omitempty"`
+
+	// CalendarInvites Reminder emails about a single document are calendar invites for its expiration date
+	CalendarInvites *bool `json:"calendarInvites,omitempty"`
+
+	// ChannelMatrix Channels per reminder interval ID (e.g. "30d"). Intervals without an entry go by email, plus SMS unless escalationChannel is "sms". An empty list silences the interval.
+	ChannelMatrix       *ChannelMatrix                            `json:"channelMatrix,omitempty"`
+	CreatedAt           *time.Time                                `json:"createdAt,omitempty"`
+	EscalationAfterDays *int                                      `json:"escalationAfterDays,omitempty"`
+	EscalationChannel   *NotificationPreferencesEscalationChannel `json:"escalationChannel,omitempty"`
+
+	// OptimizeSendTime Reminders are delayed to the hour of day the user usually opens reminder emails in
+	OptimizeSendTime *bool `json:"optimizeSendTime,omitempty"`
+
+	// PausedUntil Reminders are deferred until then and sent as one catch-up summary
+	PausedUntil *time.Time          `json:"pausedUntil"`
+	UpdatedAt   *time.Time          `json:"updatedAt,omitempty"`
+	UserId      *openapi_types.UUID `json:"userId,omitempty"`
+}
+
+// NotificationPreferencesEscalationChannel defines model for NotificationPreferences.EscalationChannel.
+type NotificationPreferencesEscalationChannel string
+
+// NotificationProvider defines model for NotificationProvider.
+type NotificationProvider struct {
+	Channel             *NotificationProviderChannel `json:"channel,omitempty"`
+	ConsecutiveFailures *int64                       `json:"consecutiveFailures,omitempty"`
+	Paused              *bool                        `json:"paused,omitempty"`
+	RetryAt             *time.Time                   `json:"retryAt,omitempty"`
+}
+
+// NotificationProviderChannel defines model for NotificationProvider.Channel.
+type NotificationProviderChannel string
+
+// NotificationTheme defines model for NotificationTheme.
+type NotificationTheme struct {
+	BackgroundColor *string             `json:"backgroundColor,omitempty"`
+	FooterText      *string             `json:"footerText,omitempty"`
+	LogoUrl         *string             `json:"logoUrl,omitempty"`
+	OrganizationId  *openapi_types.UUID `json:"organizationId,omitempty"`
+	PrimaryColor    *string             `json:"primaryColor,omitempty"`
+	UpdatedAt       *time.Time          `json:"updatedAt,omitempty"`
+}
+
+// NotificationThemeRequest defines model for NotificationThemeRequest.
+type NotificationThemeRequest struct {
+	BackgroundColor *string `json:"backgroundColor,omitempty"`
+	FooterText      *string `json:"footerText,omitempty"`
+
+	// LogoUrl https URL of the logo shown at the top of emails.
+	LogoUrl *string `json:"logoUrl,omitempty"`
+
+	// PrimaryColor Color of headings and buttons.
+	PrimaryColor *string `json:"primaryColor,omitempty"`
+}
+
+// Organization defines model for Organization.
+type Organization struct {
+	// ComplianceCategories Document category slugs compliance mode covers.
+	ComplianceCategories *[]string `json:"complianceCategories,omitempty"`
+
+	// ComplianceMode While true, reminders on documents in complianceCategories cannot be turned off.
+	ComplianceMode *bool      `json:"complianceMode,omitempty"`
+	CreatedAt      *time.Time `json:"createdAt,omitempty"`
+
+	// DataRegion Region whose database holds the organization's documents; absent for the default database.
+	DataRegion *string             `json:"dataRegion"`
+	Id         *openapi_types.UUID `json:"id,omitempty"`
+	Name       *string             `json:"name,omitempty"`
+
+	// RenewalApproval While true, renewals must be proposed and approved through renewal requests.
+	RenewalApproval *bool `json:"renewalApproval,omitempty"`
+
+	// Role The current user's role in the organization.
+	Role *OrganizationRole `json:"role,omitempty"`
+}
+
+// OrganizationRole The current user's role in the organization.
+type OrganizationRole string
+
+// OrganizationMember defines model for OrganizationMember.
+type OrganizationMember struct {
+	Email    *openapi_types.Email    `json:"email,omitempty"`
+	JoinedAt *time.Time              `json:"joinedAt,omitempty"`
+	Name     *string                 `json:"name,omitempty"`
+	Role     *OrganizationMemberRole `json:"role,omitempty"`
+	UserId   *openapi_types.UUID     `json:"userId,omitempty"`
+}
+
+// OrganizationMemberRole defines model for OrganizationMember.Role.
+type OrganizationMemberRole string
+
+// OrganizationRetention defines model for OrganizationRetention.
+type OrganizationRetention struct {
+	// EventLogDays Days event log and audit log entries are kept; null keeps them.
+	EventLogDays *int `json:"eventLogDays"`
+
+	// ExpiredDocumentsDays Days after expiry documents are purged; null keeps them.
+	ExpiredDocumentsDays    *int                `json:"expiredDocumentsDays"`
+	NotificationHistoryDays *int                `json:"notificationHistoryDays"`
+	OrganizationId          *openapi_types.UUID `json:"organizationId,omitempty"`
+	UpdatedAt               *time.Time          `json:"updatedAt,omitempty"`
+}
+
+// OrganizationUsage defines model for OrganizationUsage.
+type OrganizationUsage struct {
+	Metric         *OrganizationUsageMetric `json:"metric,omitempty"`
+	Month          *string                  `json:"month,omitempty"`
+	OrganizationId *openapi_types.UUID      `json:"organizationId,omitempty"`
+
+	// Quantity The peak the metric reached within the month.
+	Quantity *int64 `json:"quantity,omitempty"`
+
+	// RecordedAt When quantity was last raised.
+	RecordedAt *time.Time `json:"recordedAt,omitempty"`
+}
+
+// OrganizationUsageMetric defines model for OrganizationUsage.Metric.
+type OrganizationUsageMetric string
+
+// QueueStats defines model for QueueStats.
+type QueueStats struct {
+	Active         *int     `json:"active,omitempty"`
+	Archived       *int     `json:"archived,omitempty"`
+	FailedToday    *int     `json:"failedToday,omitempty"`
+	LatencySeconds *float64 `json:"latencySeconds,omitempty"`
+	Paused         *bool    `json:"paused,omitempty"`
+	Pending        *int     `json:"pending,omitempty"`
+	ProcessedToday *int     `json:"processedToday,omitempty"`
+	Queue          *string  `json:"queue,omitempty"`
+	Retry          *int     `json:"retry,omitempty"`
+	Scheduled      *int     `json:"scheduled,omitempty"`
+}
+
+// QuotaUsage defines model for QuotaUsage.
+type QuotaUsage struct {
+	// Limit The cap of the user's plan; null when there is none.
+	Limit *int             `json:"limit"`
+	Quota *QuotaUsageQuota `json:"quota,omitempty"`
+
+	// ResetsAt When a monthly quota starts over.
+	ResetsAt *time.Time `json:"resetsAt,omitempty"`
+	Used     *int       `json:"used,omitempty"`
+
+	// Warning True once used reaches the warning percentage of limit.
+	Warning *bool `json:"warning,omitempty"`
+}
+
+// QuotaUsageQuota defines model for QuotaUsage.Quota.
+type QuotaUsageQuota string
+
+// ReminderInterval defines model for ReminderInterval.
+type ReminderInterval struct {
+	// Id Interval ID label (e.g., '7d', '30d', '90d')
+	Id *string `json:"id,omitempty"`
+
+	// Label Human-readable label
+	Label *string `json:"label,omitempty"`
+}
+
+// RenewalCost defines model for RenewalCost.
+type RenewalCost struct {
+	Currency  *string `json:"currency,omitempty"`
+	Documents *int    `json:"documents,omitempty"`
+	Formatted *string `json:"formatted,omitempty"`
+
+	// Month YYYY-MM; absent on totals.
+	Month *string  `json:"month,omitempty"`
+	Total *float32 `json:"total,omitempty"`
+}
+
+// RenewalRequest defines model for RenewalRequest.
+type RenewalRequest struct {
+	CreatedAt   *time.Time          `json:"createdAt,omitempty"`
+	DecidedAt   *time.Time          `json:"decidedAt"`
+	DecidedBy   *openapi_types.UUID `json:"decidedBy"`
+	DocumentId  *openapi_types.UUID `json:"documentId,omitempty"`
+	EvidenceUrl *string             `json:"evidenceUrl,omitempty"`
+
+	// ExpirationDate The proposed new expiration date.
+	ExpirationDate *time.Time            `json:"expirationDate,omitempty"`
+	Id             *openapi_types.UUID   `json:"id,omitempty"`
+	Note           *string               `json:"note"`
+	RequestedBy    *openapi_types.UUID   `json:"requestedBy,omitempty"`
+	Status         *RenewalRequestStatus `json:"status,omitempty"`
+}
+
+// RenewalRequestStatus defines model for RenewalRequest.Status.
+type RenewalRequestStatus string
+
+// RoadmapFeature defines model for RoadmapFeature.
+type RoadmapFeature struct {
+	CreatedAt   *time.Time            `json:"createdAt,omitempty"`
+	CreatedBy   *openapi_types.UUID   `json:"createdBy,omitempty"`
+	Description *string               `json:"description,omitempty"`
+	Id          *openapi_types.UUID   `json:"id,omitempty"`
+	Status      *RoadmapFeatureStatus `json:"status,omitempty"`
+	Title       *string               `json:"title,omitempty"`
+	UpdatedAt   *time.Time            `json:"updatedAt,omitempty"`
+	VoteCount   *int                  `json:"voteCount,omitempty"`
+
+	// Voted Whether the caller voted for the feature.
+	Voted *bool `json:"voted,omitempty"`
+}
+
+// RoadmapFeatureStatus defines model for RoadmapFeature.Status.
+type RoadmapFeatureStatus string
+
+// SavedView defines model for SavedView.
+type SavedView struct {
+	CreatedAt *time.Time `json:"createdAt,omitempty"`
+
+	// Filters Criteria of a saved view. Documents must meet all that are set.
+	Filters   *ViewFilters        `json:"filters,omitempty"`
+	Id        *openapi_types.UUID `json:"id,omitempty"`
+	Name      *string             `json:"name,omitempty"`
+	UpdatedAt *time.Time          `json:"updatedAt,omitempty"`
+	UserId    *openapi_types.UUID `json:"userId,omitempty"`
+}
+
+// ScimListResponse defines model for ScimListResponse.
+type ScimListResponse struct {
+	Resources    *[]ScimUser `json:"Resources,omitempty"`
+	ItemsPerPage *int        `json:"itemsPerPage,omitempty"`
+	Schemas      *[]string   `json:"schemas,omitempty"`
+	StartIndex   *int        `json:"startIndex,omitempty"`
+	TotalResults *int        `json:"totalResults,omitempty"`
+}
+
+// ScimPatchRequest defines model for ScimPatchRequest.
+type ScimPatchRequest struct {
+	Operations *[]struct {
+		Op    ScimPatchRequestOperationsOp `json:"op"`
+		Path  *string                      `json:"path,omitempty"`
+		Value interface{}                  `json:"value,omitempty"`
+	} `json:"Operations,omitempty"`
+	Schemas *[]string `json:"schemas,omitempty"`
+}
+
+// ScimPatchRequestOperationsOp defines model for ScimPatchRequest.Operations.Op.
+type ScimPatchRequestOperationsOp string
+
+// ScimUser defines model for ScimUser.
+type ScimUser struct {
+	Active      *bool   `json:"active,omitempty"`
+	DisplayName *string `json:"displayName,omitempty"`
+	Emails      *[]struct {
+		Primary *bool   `json:"primary,omitempty"`
+		Type    *string `json:"type,omitempty"`
+		Value   *string `json:"value,omitempty"`
+	} `json:"emails,omitempty"`
+	ExternalId *string             `json:"externalId,omitempty"`
+	Id         *openapi_types.UUID `json:"id,omitempty"`
+	Meta       *struct {
+		Created      *time.Time `json:"created,omitempty"`
+		Location     *string    `json:"location,omitempty"`
+		ResourceType *string    `json:"resourceType,omitempty"`
+	} `json:"meta,omitempty"`
+	Name *struct {
+		FamilyName *string `json:"familyName,omitempty"`
+		Formatted  *string `json:"formatted,omitempty"`
+		GivenName  *string `json:"givenName,omitempty"`
+	} `json:"name,omitempty"`
+	Schemas *[]string `json:"schemas,omitempty"`
+
+	// UserName The member's email address.
+	UserName string `json:"userName"`
+}
+
+// ServiceAccount defines model for ServiceAccount.
+type ServiceAccount struct {
+	CreatedAt      *time.Time              `json:"createdAt,omitempty"`
+	CreatedBy      *openapi_types.UUID     `json:"createdBy,omitempty"`
+	Id             *openapi_types.UUID     `json:"id,omitempty"`
+	Name           *string                 `json:"name,omitempty"`
+	OrganizationId *openapi_types.UUID     `json:"organizationId,omitempty"`
+	Scopes         *[]ServiceAccountScopes `json:"scopes,omitempty"`
+	Token          *APIKey                 `json:"token,omitempty"`
+
+	// UserId The user the service account acts as, e.g. in the audit log.
+	UserId *openapi_types.UUID `json:"userId,omitempty"`
+}
+
+// ServiceAccountScopes defines model for ServiceAccount.Scopes.
+type ServiceAccountScopes string
+
+// ServiceAccountTokenResponse defines model for ServiceAccountTokenResponse.
+type ServiceAccountTokenResponse struct {
+	Message        *string         `json:"message,omitempty"`
+	ServiceAccount *ServiceAccount `json:"serviceAccount,omitempty"`
+
+	// Token The token, shown only in this response.
+	Token *string `json:"token,omitempty"`
+}
+
+// Session defines model for Session.
+type Session struct {
+	City *string `json:"city,omitempty"`
+
+	// Country ISO 3166-1 alpha-2 country code.
+	Country   *string    `json:"country,omitempty"`
+	CreatedAt *time.Time `json:"createdAt,omitempty"`
+
+	// Current Whether this is the session the request was made with.
+	Current *bool `json:"current,omitempty"`
+
+	// Device Browser and operating system, such as "Firefox on Windows".
+	Device    *string    `json:"device,omitempty"`
+	ExpiresAt *time.Time `json:"expiresAt,omitempty"`
+
+	// Id ID (jti) of the session's token.
+	Id        *openapi_types.UUID `json:"id,omitempty"`
+	Ip        *string             `json:"ip,omitempty"`
+	Region    *string             `json:"region,omitempty"`
+	UserAgent *string             `json:"userAgent,omitempty"`
+}
+
+// ShedRequests defines model for ShedRequests.
+type ShedRequests struct {
+	DailyShed *map[string]int64   `json:"dailyShed,omitempty"`
+	Reason    *ShedRequestsReason `json:"reason,omitempty"`
+	Shed      *int64              `json:"shed,omitempty"`
+}
+
+// ShedRequestsReason defines model for ShedRequests.Reason.
+type ShedRequestsReason string
+
+// TrashedDocument defines model for TrashedDocument.
+type TrashedDocument struct {
+	Category       *string    `json:"category,omitempty"`
+	DeletedAt      *time.Time `json:"deletedAt,omitempty"`
+	ExpirationDate *string    `json:"expirationDate,omitempty"`
+
+	// ExpiresIn As Document.expiresIn.
+	ExpiresIn *string             `json:"expiresIn,omitempty"`
+	Id        *openapi_types.UUID `json:"id,omitempty"`
+	Name      *string             `json:"name,omitempty"`
+
+	// PurgeAt When the document is permanently deleted.
+	PurgeAt *time.Time          `json:"purgeAt,omitempty"`
+	UserId  *openapi_types.UUID `json:"userId,omitempty"`
+}
+
+// UsageExport defines model for UsageExport.
+type UsageExport struct {
+	From  *string              `json:"from,omitempty"`
+	To    *string              `json:"to,omitempty"`
+	Usage *[]OrganizationUsage `json:"usage,omitempty"`
+}
+
+// User defines model for User.
+type User struct {
+	Email *openapi_types.Email `json:"email,omitempty"`
+	Id    *openapi_types.UUID  `json:"id,omitempty"`
+	Name  *string              `json:"name,omitempty"`
+
+	// PendingEmail The address the user asked to switch to, until they confirm it.
+	PendingEmail *openapi_types.Email `json:"pendingEmail,omitempty"`
+	PhoneNumber  *string              `json:"phoneNumber"`
+}
+
+// ViewFilters Criteria of a saved view. Documents must meet all that are set.
+type ViewFilters struct {
+	// Categories Matches documents in any of these categories, by slug.
+	Categories *[]string `json:"categories,omitempty"`
+
+	// ExpiresWithinDays Matches documents expiring within this many days, expired ones included unless status is active.
+	ExpiresWithinDays *int `json:"expiresWithinDays,omitempty"`
+
+	// PinnedOnly Matches only documents the caller pinned.
+	PinnedOnly *bool `json:"pinnedOnly,omitempty"`
+
+	// Query Matches documents whose name contains it, ignoring case.
+	Query *string `json:"query,omitempty"`
+
+	// Status Matches only unexpired or only expired documents.
+	Status *ViewFiltersStatus `json:"status,omitempty"`
+}
+
+// ViewFiltersStatus Matches only unexpired or only expired documents.
+type ViewFiltersStatus string
+
+// WebhookDelivery defines model for WebhookDelivery.
+type WebhookDelivery struct {
+	Attempts  *int       `json:"attempts,omitempty"`
+	CreatedAt *time.Time `json:"createdAt,omitempty"`
+
+	// Error Why the attempt failed. For an error status this is only the status code; the endpoint's response body is not kept.
+	Error         *string                 `json:"error,omitempty"`
+	Event         *string                 `json:"event,omitempty"`
+	Id            *openapi_types.UUID     `json:"id,omitempty"`
+	LastAttemptAt *time.Time              `json:"lastAttemptAt,omitempty"`
+	LatencyMs     *int                    `json:"latencyMs,omitempty"`
+	Payload       *map[string]interface{} `json:"payload,omitempty"`
+	RedeliveryOf  *openapi_types.UUID     `json:"redeliveryOf,omitempty"`
+	ResponseCode  *int                    `json:"responseCode,omitempty"`
+	Status        *WebhookDeliveryStatus  `json:"status,omitempty"`
+}
+
+// WebhookDeliveryStatus defines model for WebhookDelivery.Status.
+type WebhookDeliveryStatus string
+
+// WebhookEndpoint defines model for WebhookEndpoint.
+type WebhookEndpoint struct {
+	CreatedAt *time.Time          `json:"createdAt,omitempty"`
+	Enabled   *bool               `json:"enabled,omitempty"`
+	Events    *[]string           `json:"events,omitempty"`
+	Id        *openapi_types.UUID `json:"id,omitempty"`
+
+	// PreviousSecretExpiresAt When the pre-rotation secret stops signing deliveries.
+	PreviousSecretExpiresAt *time.Time `json:"previousSecretExpiresAt,omitempty"`
+
+	// Secret Only present when the endpoint is created or its secret rotated.
+	Secret    *string    `json:"secret,omitempty"`
+	UpdatedAt *time.Time `json:"updatedAt,omitempty"`
+	Url       *string    `json:"url,omitempty"`
+}
+
+// WebhookEndpointEnvelope defines model for WebhookEndpointEnvelope.
+type WebhookEndpointEnvelope struct {
+	Endpoint *WebhookEndpoint `json:"endpoint,omitempty"`
+	Message  *string          `json:"message,omitempty"`
+}
+
+// WebhookEndpointRequest defines model for WebhookEndpointRequest.
+type WebhookEndpointRequest struct {
+	Enabled *bool `json:"enabled,omitempty"`
+
+	// Events Events to receive; empty means every event.
+	Events *[]WebhookEndpointRequestEvents `json:"events,omitempty"`
+	Url    string                          `json:"url"`
+}
+
+// WebhookEndpointRequestEvents defines model for WebhookEndpointRequest.Events.
+type WebhookEndpointRequestEvents string
+
+// OrganizationHeader defines model for OrganizationHeader.
+type OrganizationHeader = openapi_types.UUID
+
+// PostApiAdminAnnouncementsJSONBody defines parameters for PostApiAdminAnnouncements.
+type PostApiAdminAnnouncementsJSONBody struct {
+	Body      string                                 `json:"body"`
+	EndsAt    *time.Time                             `json:"endsAt,omitempty"`
+	Kind      *PostApiAdminAnnouncementsJSONBodyKind `json:"kind,omitempty"`
+	SendEmail *bool                                  `json:"sendEmail,omitempty"`
+	StartsAt  *time.Time                             `json:"startsAt,omitempty"`
+	Title     string                                 `json:"title"`
+}
+
+// PostApiAdminAnnouncementsJSONBodyKind defines parameters for PostApiAdminAnnouncements.
+type PostApiAdminAnnouncementsJSONBodyKind string
+
+// PostApiAdminImpersonateJSONBody defines parameters for PostApiAdminImpersonate.
+type PostApiAdminImpersonateJSONBody struct {
+	// Reason Why the user is impersonated, such as a support ticket reference
+	Reason string             `json:"reason"`
+	UserId openapi_types.UUID `json:"userId"`
+}
+
+// PostApiAdminRoadmapJSONBody defines parameters for PostApiAdminRoadmap.
+type PostApiAdminRoadmapJSONBody struct {
+	Description *string                            `json:"description,omitempty"`
+	Status      *PostApiAdminRoadmapJSONBodyStatus `json:"status,omitempty"`
+	Title       string                             `json:"title"`
+}
+
+// PostApiAdminRoadmapJSONBodyStatus defines parameters for PostApiAdminRoadmap.
+type PostApiAdminRoadmapJSONBodyStatus string
+
+// PutApiAdminRoadmapIdJSONBody defines parameters for PutApiAdminRoadmapId.
+type PutApiAdminRoadmapIdJSONBody struct {
+	Description *string                             `json:"description,omitempty"`
+	Status      *PutApiAdminRoadmapIdJSONBodyStatus `json:"status,omitempty"`
+	Title       *string                             `json:"title,omitempty"`
+}
+
+// PutApiAdminRoadmapIdJSONBodyStatus defines parameters for PutApiAdminRoadmapId.
+type PutApiAdminRoadmapIdJSONBodyStatus string
+
+// PostApiAdminScheduledJobsNameRunParamsName defines parameters for PostApiAdminScheduledJobsNameRun.
+type PostApiAdminScheduledJobsNameRunParamsName string
+
+// GetApiAdminTasksFailedParams defines parameters for GetApiAdminTasksFailed.
+type GetApiAdminTasksFailedParams struct {
+	Limit *int `form:"limit,omitempty" json:"limit,omitempty"`
+}
+
+// GetApiAdminUsageExportParams defines parameters for GetApiAdminUsageExport.
+type GetApiAdminUsageExportParams struct {
+	// From First month to export, YYYY-MM. Defaults to the current UTC month.
+	From *string `form:"from,omitempty" json:"from,omitempty"`
+
+	// To Last month to export, YYYY-MM. Defaults to the current UTC month.
+	To     *string                             `form:"to,omitempty" json:"to,omitempty"`
+	Format *GetApiAdminUsageExportParamsFormat `form:"format,omitempty" json:"format,omitempty"`
+}
+
+// GetApiAdminUsageExportParamsFormat defines parameters for GetApiAdminUsageExport.
+type GetApiAdminUsageExportParamsFormat string
+
+// PutApiAdminUsersIdPlanJSONBody defines parameters for PutApiAdminUsersIdPlan.
+type PutApiAdminUsersIdPlanJSONBody struct {
+	Plan PutApiAdminUsersIdPlanJSONBodyPlan `json:"plan"`
+}
+
+// PutApiAdminUsersIdPlanJSONBodyPlan defines parameters for PutApiAdminUsersIdPlan.
+type PutApiAdminUsersIdPlanJSONBodyPlan string
+
+// PutApiAdminUsersIdRoleJSONBody defines parameters for PutApiAdminUsersIdRole.
+type PutApiAdminUsersIdRoleJSONBody struct {
+	Role PutApiAdminUsersIdRoleJSONBodyRole `json:"role"`
+}
+
+// PutApiAdminUsersIdRoleJSONBodyRole defines parameters for PutApiAdminUsersIdRole.
+type PutApiAdminUsersIdRoleJSONBodyRole string
+
+// PostApiAdminUsersIdSuspendJSONBody defines parameters for PostApiAdminUsersIdSuspend.
+type PostApiAdminUsersIdSuspendJSONBody struct {
+	// Reason Why the account is suspended; recorded in the audit log
+	Reason string `json:"reason"`
+}
+
+// PostApiApiKeysJSONBody defines parameters for PostApiApiKeys.
+type PostApiApiKeysJSONBody struct {
+	// ExpiresAt When the key stops working; without it, only revoking it does.
+	ExpiresAt *time.Time                     `json:"expiresAt,omitempty"`
+	Name      string                         `json:"name"`
+	Scopes    []PostApiApiKeysJSONBodyScopes `json:"scopes"`
+}
+
+// PostApiApiKeysJSONBodyScopes defines parameters for PostApiApiKeys.
+type PostApiApiKeysJSONBodyScopes string
+
+// PutApiAuthEmailJSONBody defines parameters for PutApiAuthEmail.
+type PutApiAuthEmailJSONBody struct {
+	Email    openapi_types.Email `json:"email"`
+	Password *string             `json:"password,omitempty"`
+}
+
+// DeleteApiAuthMeJSONBody defines parameters for DeleteApiAuthMe.
+type DeleteApiAuthMeJSONBody struct {
+	Password *string `json:"password,omitempty"`
+}
+
+// GetApiAuthOauthProviderParamsProvider defines parameters for GetApiAuthOauthProvider.
+type GetApiAuthOauthProviderParamsProvider string
+
+// GetApiAuthOauthProviderCallbackParams defines parameters for GetApiAuthOauthProviderCallback.
+type GetApiAuthOauthProviderCallbackParams struct {
+	Code  *string `form:"code,omitempty" json:"code,omitempty"`
+	State *string `form:"state,omitempty" json:"state,omitempty"`
+}
+
+// GetApiAuthOauthProviderCallbackParamsProvider defines parameters for GetApiAuthOauthProviderCallback.
+type GetApiAuthOauthProviderCallbackParamsProvider string
+
+// PostApiAuthRegisterJSONBody defines parameters for PostApiAuthRegister.
+type PostApiAuthRegisterJSONBody struct {
+	// CaptchaToken The CAPTCHA widget's response; required when the server has CAPTCHA verification on.
+	CaptchaToken *string             `json:"captchaToken,omitempty"`
+	Email        openapi_types.Email `json:"email"`
+	Name         string              `json:"name"`
+
+	// Password Must be at least PASSWORD_MIN_LENGTH characters (8 by default), mix PASSWORD_MIN_CHARACTER_CLASSES of lowercase letters, uppercase letters, digits and symbols, and, when PASSWORD_BREACH_CHECK is on, not appear in a known data breach.
+	Password    string `json:"password"`
+	PhoneNumber string `json:"phoneNumber"`
+
+	// Website Honeypot. Keep it hidden and empty; sign-ups that fill it in are rejected.
+	Website *string `json:"website,omitempty"`
+}
+
+// DeleteApiAuthSessionsParams defines parameters for DeleteApiAuthSessions.
+type DeleteApiAuthSessionsParams struct {
+	// KeepCurrent Keep the session of this request signed in.
+	KeepCurrent *bool `form:"keepCurrent,omitempty" json:"keepCurrent,omitempty"`
+}
+
+// PostApiAuthSigninJSONBody defines parameters for PostApiAuthSignin.
+type PostApiAuthSigninJSONBody struct {
+	Email    openapi_types.Email `json:"email"`
+	Password string              `json:"password"`
+}
+
+// GetApiCategoriesSlugSuggestExpirationParams defines parameters for GetApiCategoriesSlugSuggestExpiration.
+type GetApiCategoriesSlugSuggestExpirationParams struct {
+	// IssueDate Date the document was issued; defaults to today
+	IssueDate *openapi_types.Date `form:"issueDate,omitempty" json:"issueDate,omitempty"`
+
+	// Country ISO 3166-1 alpha-2 country code for country-specific rules
+	Country *string `form:"country,omitempty" json:"country,omitempty"`
+}
+
+// GetApiDocumentsParams defines parameters for GetApiDocuments.
+type GetApiDocumentsParams struct {
+	// Identifier Only return documents whose identifier matches exactly. Case, spaces and dashes are ignored. Identifiers are stored encrypted, so partial matches are not supported.
+	Identifier *string `form:"identifier,omitempty" json:"identifier,omitempty"`
+
+	// Sort custom returns the documents the way the caller arranged them: pinned ones first, then the ones placed with PUT /api/documents/order in that order, then the rest newest first. Without it documents are listed newest first.
+	Sort *GetApiDocumentsParamsSort `form:"sort,omitempty" json:"sort,omitempty"`
+
+	// XOrganizationID Act on the documents of this organization instead of the caller's personal documents. Accepted by every /api/documents endpoint.
+	XOrganizationID *OrganizationHeader `json:"X-Organization-ID,omitempty"`
+}
+
+// GetApiDocumentsParamsSort defines parameters for GetApiDocuments.
+type GetApiDocumentsParamsSort string
+
+// PostApiDocumentsJSONBody defines parameters for PostApiDocuments.
+type PostApiDocumentsJSONBody struct {
+	AttachmentUrl *string `json:"attachmentUrl,omitempty"`
+
+	// Category Category slug (e.g., 'passport'); its default reminders apply when reminders is empty
+	Category *string `json:"category,omitempty"`
+
+	// Currency ISO 4217 code of renewalCost; required with it.
+	Currency *string `json:"currency,omitempty"`
+
+	// CustomFields Custom field values by key, at most 20 fields. Keys that are not yet fields are added, labelled with the key; a null value removes the field.
+	CustomFields   *map[string]*string `json:"customFields,omitempty"`
+	Description    *string             `json:"description,omitempty"`
+	ExpirationDate time.Time           `json:"expirationDate"`
+	Identifier     *string             `json:"identifier,omitempty"`
+
+	// IssuerId Issuer from the directory that renews the document; an empty string unlinks it.
+	IssuerId *string `json:"issuerId,omitempty"`
+
+	// LeadTimeDays Days renewing the document takes. A "start your renewal" reminder goes out this long before expiry, besides the standard reminders. Defaults to the issuer's processing time; 0 goes back to it.
+	LeadTimeDays *int      `json:"leadTimeDays,omitempty"`
+	Name         *string   `json:"name,omitempty"`
+	Reminders    *[]string `json:"reminders,omitempty"`
+
+	// RenewalCost Cost of renewing the document in currency, rounded to the currency's minor unit.
+	RenewalCost *float32 `json:"renewalCost,omitempty"`
+
+	// TemplateId Slug of a template from /api/templates. The template's name, category and reminders fill in those the request leaves out, and its custom fields are added with the values given in customFields.
+	TemplateId *string `json:"templateId,omitempty"`
+	Timezone   *string `json:"timezone,omitempty"`
+}
+
+// PostApiDocumentsParams defines parameters for PostApiDocuments.
+type PostApiDocumentsParams struct {
+	// Force Create the document even if it looks like a duplicate of an existing one.
+	Force *bool `form:"force,omitempty" json:"force,omitempty"`
+}
+
+// PostApiDocumentsImportMultipartBody defines parameters for PostApiDocumentsImport.
+type PostApiDocumentsImportMultipartBody struct {
+	File *openapi_types.File `json:"file,omitempty"`
+}
+
+// PostApiDocumentsImportParams defines parameters for PostApiDocumentsImport.
+type PostApiDocumentsImportParams struct {
+	Source   PostApiDocumentsImportParamsSource `form:"source" json:"source"`
+	Timezone *string                            `form:"timezone,omitempty" json:"timezone,omitempty"`
+
+	// Reminders Comma-separated reminder interval IDs; defaults to the category's presets
+	Reminders *string `form:"reminders,omitempty" json:"reminders,omitempty"`
+}
+
+// PostApiDocumentsImportParamsSource defines parameters for PostApiDocumentsImport.
+type PostApiDocumentsImportParamsSource string
+
+// PutApiDocumentsOrderJSONBody defines parameters for PutApiDocumentsOrder.
+type PutApiDocumentsOrderJSONBody struct {
+	DocumentIds []openapi_types.UUID `json:"documentIds"`
+}
+
+// PutApiDocumentsOrderParams defines parameters for PutApiDocumentsOrder.
+type PutApiDocumentsOrderParams struct {
+	// XOrganizationID Act on the documents of this organization instead of the caller's personal documents. Accepted by every /api/documents endpoint.
+	XOrganizationID *OrganizationHeader `json:"X-Organization-ID,omitempty"`
+}
+
+// GetApiDocumentsRenewalCostsParams defines parameters for GetApiDocumentsRenewalCosts.
+type GetApiDocumentsRenewalCostsParams struct {
+	Months *int    `form:"months,omitempty" json:"months,omitempty"`
+	Locale *string `form:"locale,omitempty" json:"locale,omitempty"`
+
+	// XOrganizationID Act on the documents of this organization instead of the caller's personal documents. Accepted by every /api/documents endpoint.
+	XOrganizationID *OrganizationHeader `json:"X-Organization-ID,omitempty"`
+}
+
+// GetApiDocumentsStatsParams defines parameters for GetApiDocumentsStats.
+type GetApiDocumentsStatsParams struct {
+	// XOrganizationID Act on the documents of this organization instead of the caller's personal documents. Accepted by every /api/documents endpoint.
+	XOrganizationID *OrganizationHeader `json:"X-Organization-ID,omitempty"`
+}
+
+// PutApiDocumentsIdJSONBody defines parameters for PutApiDocumentsId.
+type PutApiDocumentsIdJSONBody struct {
+	AttachmentUrl *string `json:"attachmentUrl,omitempty"`
+
+	// Category Category slug (e.g., 'passport'); its default reminders apply when reminders is empty
+	Category *string `json:"category,omitempty"`
+
+	// Currency ISO 4217 code of renewalCost; required with it.
+	Currency *string `json:"currency,omitempty"`
+
+	// CustomFields Custom field values by key, at most 20 fields. Keys that are not yet fields are added, labelled with the key; a null value removes the field.
+	CustomFields   *map[string]*string `json:"customFields,omitempty"`
+	Description    *string             `json:"description,omitempty"`
+	ExpirationDate *time.Time          `json:"expirationDate,omitempty"`
+	Identifier     *string             `json:"identifier,omitempty"`
+
+	// IssuerId Issuer from the directory that renews the document; an empty string unlinks it.
+	IssuerId *string `json:"issuerId,omitempty"`
+
+	// LeadTimeDays Days renewing the document takes. A "start your renewal" reminder goes out this long before expiry, besides the standard reminders. Defaults to the issuer's processing time; 0 goes back to it.
+	LeadTimeDays *int      `json:"leadTimeDays,omitempty"`
+	Name         *string   `json:"name,omitempty"`
+	Reminders    *[]string `json:"reminders,omitempty"`
+
+	// RenewalCost Cost of renewing the document in currency, rounded to the currency's minor unit.
+	RenewalCost *float32 `json:"renewalCost,omitempty"`
+	Timezone    *string  `json:"timezone,omitempty"`
+}
+
+// DeleteApiDocumentsIdAssigneeParams defines parameters for DeleteApiDocumentsIdAssignee.
+type DeleteApiDocumentsIdAssigneeParams struct {
+	// XOrganizationID Act on the documents of this organization instead of the caller's personal documents. Accepted by every /api/documents endpoint.
+	XOrganizationID *OrganizationHeader `json:"X-Organization-ID,omitempty"`
+}
+
+// GetApiDocumentsIdAssigneeParams defines parameters for GetApiDocumentsIdAssignee.
+type GetApiDocumentsIdAssigneeParams struct {
+	// XOrganizationID Act on the documents of this organization instead of the caller's personal documents. Accepted by every /api/documents endpoint.
+	XOrganizationID *OrganizationHeader `json:"X-Organization-ID,omitempty"`
+}
+
+// PutApiDocumentsIdAssigneeJSONBody defines parameters for PutApiDocumentsIdAssignee.
+type PutApiDocumentsIdAssigneeJSONBody struct {
+	AssigneeId openapi_types.UUID `json:"assigneeId"`
+}
+
+// PutApiDocumentsIdAssigneeParams defines parameters for PutApiDocumentsIdAssignee.
+type PutApiDocumentsIdAssigneeParams struct {
+	// XOrganizationID Act on the documents of this organization instead of the caller's personal documents. Accepted by every /api/documents endpoint.
+	XOrganizationID *OrganizationHeader `json:"X-Organization-ID,omitempty"`
+}
+
+// PostApiDocumentsIdAssigneeAcknowledgeParams defines parameters for PostApiDocumentsIdAssigneeAcknowledge.
+type PostApiDocumentsIdAssigneeAcknowledgeParams struct {
+	// XOrganizationID Act on the documents of this organization instead of the caller's personal documents. Accepted by every /api/documents endpoint.
+	XOrganizationID *OrganizationHeader `json:"X-Organization-ID,omitempty"`
+}
+
+// PutApiDocumentsIdAttachmentMultipartBody defines parameters for PutApiDocumentsIdAttachment.
+type PutApiDocumentsIdAttachmentMultipartBody struct {
+	File openapi_types.File `json:"file"`
+}
+
+// PostApiDocumentsIdChecklistJSONBody defines parameters for PostApiDocumentsIdChecklist.
+type PostApiDocumentsIdChecklistJSONBody struct {
+	Title string `json:"title"`
+}
+
+// PutApiDocumentsIdChecklistItemIdJSONBody defines parameters for PutApiDocumentsIdChecklistItemId.
+type PutApiDocumentsIdChecklistItemIdJSONBody struct {
+	Done     *bool   `json:"done,omitempty"`
+	Position *int    `json:"position,omitempty"`
+	Title    *string `json:"title,omitempty"`
+}
+
+// PostApiDocumentsIdContactsJSONBody defines parameters for PostApiDocumentsIdContacts.
+type PostApiDocumentsIdContactsJSONBody struct {
+	Email openapi_types.Email `json:"email"`
+	Name  *string             `json:"name,omitempty"`
+}
+
+// PostApiDocumentsIdDependenciesJSONBody defines parameters for PostApiDocumentsIdDependencies.
+type PostApiDocumentsIdDependenciesJSONBody struct {
+	DependsOnId openapi_types.UUID `json:"dependsOnId"`
+}
+
+// GetApiDocumentsIdLegalHoldParams defines parameters for GetApiDocumentsIdLegalHold.
+type GetApiDocumentsIdLegalHoldParams struct {
+	// XOrganizationID Act on the documents of this organization instead of the caller's personal documents. Accepted by every /api/documents endpoint.
+	XOrganizationID *OrganizationHeader `json:"X-Organization-ID,omitempty"`
+}
+
+// PutApiDocumentsIdLegalHoldParams defines parameters for PutApiDocumentsIdLegalHold.
+type PutApiDocumentsIdLegalHoldParams struct {
+	// XOrganizationID Act on the documents of this organization instead of the caller's personal documents. Accepted by every /api/documents endpoint.
+	XOrganizationID *OrganizationHeader `json:"X-Organization-ID,omitempty"`
+}
+
+// PostApiDocumentsIdLockJSONBody defines parameters for PostApiDocumentsIdLock.
+type PostApiDocumentsIdLockJSONBody struct {
+	TtlSeconds *int `json:"ttlSeconds,omitempty"`
+}
+
+// PutApiDocumentsIdRemindersJSONBody defines parameters for PutApiDocumentsIdReminders.
+type PutApiDocumentsIdRemindersJSONBody struct {
+	Enabled bool `json:"enabled"`
+
+	// IntervalId Reminder interval (e.g., '7d', '30d', '90d')
+	IntervalId string `json:"interval_id"`
+}
+
+// GetApiDocumentsIdRemindersPreviewParams defines parameters for GetApiDocumentsIdRemindersPreview.
+type GetApiDocumentsIdRemindersPreviewParams struct {
+	Channel *GetApiDocumentsIdRemindersPreviewParamsChannel `form:"channel,omitempty" json:"channel,omitempty"`
+}
+
+// GetApiDocumentsIdRemindersPreviewParamsChannel defines parameters for GetApiDocumentsIdRemindersPreview.
+type GetApiDocumentsIdRemindersPreviewParamsChannel string
+
+// GetApiDocumentsIdRenewalsParams defines parameters for GetApiDocumentsIdRenewals.
+type GetApiDocumentsIdRenewalsParams struct {
+	// XOrganizationID Act on the documents of this organization instead of the caller's personal documents. Accepted by every /api/documents endpoint.
+	XOrganizationID *OrganizationHeader `json:"X-Organization-ID,omitempty"`
+}
+
+// PostApiDocumentsIdRenewalsMultipartBody defines parameters for PostApiDocumentsIdRenewals.
+type PostApiDocumentsIdRenewalsMultipartBody struct {
+	// Evidence Proof of the renewal, e.g. a scan of the new document.
+	Evidence       openapi_types.File `json:"evidence"`
+	ExpirationDate openapi_types.Date `json:"expirationDate"`
+}
+
+// PostApiDocumentsIdRenewalsParams defines parameters for PostApiDocumentsIdRenewals.
+type PostApiDocumentsIdRenewalsParams struct {
+	// XOrganizationID Act on the documents of this organization instead of the caller's personal documents. Accepted by every /api/documents endpoint.
+	XOrganizationID *OrganizationHeader `json:"X-Organization-ID,omitempty"`
+}
+
+// PostApiDocumentsIdRenewalsRenewalIdApproveJSONBody defines parameters for PostApiDocumentsIdRenewalsRenewalIdApprove.
+type PostApiDocumentsIdRenewalsRenewalIdApproveJSONBody struct {
+	Note *string `json:"note,omitempty"`
+}
+
+// PostApiDocumentsIdRenewalsRenewalIdApproveParams defines parameters for PostApiDocumentsIdRenewalsRenewalIdApprove.
+type PostApiDocumentsIdRenewalsRenewalIdApproveParams struct {
+	// XOrganizationID Act on the documents of this organization instead of the caller's personal documents. Accepted by every /api/documents endpoint.
+	XOrganizationID *OrganizationHeader `json:"X-Organization-ID,omitempty"`
+}
+
+// PostApiDocumentsIdRenewalsRenewalIdRejectJSONBody defines parameters for PostApiDocumentsIdRenewalsRenewalIdReject.
+type PostApiDocumentsIdRenewalsRenewalIdRejectJSONBody struct {
+	Note *string `json:"note,omitempty"`
+}
+
+// PostApiDocumentsIdRenewalsRenewalIdRejectParams defines parameters for PostApiDocumentsIdRenewalsRenewalIdReject.
+type PostApiDocumentsIdRenewalsRenewalIdRejectParams struct {
+	// XOrganizationID Act on the documents of this organization instead of the caller's personal documents. Accepted by every /api/documents endpoint.
+	XOrganizationID *OrganizationHeader `json:"X-Organization-ID,omitempty"`
+}
+
+// PostApiFeedbackJSONBody defines parameters for PostApiFeedback.
+type PostApiFeedbackJSONBody struct {
+	Message *string `json:"message,omitempty"`
+
+	// Page Where in the app the feedback was sent from.
+	Page *string `json:"page,omitempty"`
+
+	// RequestId X-Request-Id of a request the feedback is about.
+	RequestId *string `json:"requestId,omitempty"`
+
+	// Score How likely the user is to recommend xpired.
+	Score *int `json:"score,omitempty"`
+}
+
+// PostApiGraphqlJSONBody defines parameters for PostApiGraphql.
+type PostApiGraphqlJSONBody struct {
+	OperationName *string                 `json:"operationName,omitempty"`
+	Query         string                  `json:"query"`
+	Variables     *map[string]interface{} `json:"variables,omitempty"`
+}
+
+// PostApiHouseholdJSONBody defines parameters for PostApiHousehold.
+type PostApiHouseholdJSONBody struct {
+	Name string `json:"name"`
+}
+
+// PostApiHouseholdMembersJSONBody defines parameters for PostApiHouseholdMembers.
+type PostApiHouseholdMembersJSONBody struct {
+	Email               openapi_types.Email                                 `json:"email"`
+	NotificationRouting *PostApiHouseholdMembersJSONBodyNotificationRouting `json:"notificationRouting,omitempty"`
+}
+
+// PostApiHouseholdMembersJSONBodyNotificationRouting defines parameters for PostApiHouseholdMembers.
+type PostApiHouseholdMembersJSONBodyNotificationRouting string
+
+// PutApiHouseholdMembersUserIdJSONBody defines parameters for PutApiHouseholdMembersUserId.
+type PutApiHouseholdMembersUserIdJSONBody struct {
+	NotificationRouting *PutApiHouseholdMembersUserIdJSONBodyNotificationRouting `json:"notificationRouting,omitempty"`
+}
+
+// PutApiHouseholdMembersUserIdJSONBodyNotificationRouting defines parameters for PutApiHouseholdMembersUserId.
+type PutApiHouseholdMembersUserIdJSONBodyNotificationRouting string
+
+// GetApiIssuersParams defines parameters for GetApiIssuers.
+type GetApiIssuersParams struct {
+	// Country ISO 3166-1 alpha-2 code; also includes issuers not tied to a country
+	Country *string `form:"country,omitempty" json:"country,omitempty"`
+}
+
+// PostApiJobsImportMultipartBody defines parameters for PostApiJobsImport.
+type PostApiJobsImportMultipartBody struct {
+	File *openapi_types.File `json:"file,omitempty"`
+}
+
+// PostApiJobsImportParams defines parameters for PostApiJobsImport.
+type PostApiJobsImportParams struct {
+	Source   PostApiJobsImportParamsSource `form:"source" json:"source"`
+	Timezone *string                       `form:"timezone,omitempty" json:"timezone,omitempty"`
+
+	// Reminders Comma-separated reminder interval IDs; defaults to the category's presets
+	Reminders *string `form:"reminders,omitempty" json:"reminders,omitempty"`
+}
+
+// PostApiJobsImportParamsSource defines parameters for PostApiJobsImport.
+type PostApiJobsImportParamsSource string
+
+// GetApiLinksTokenParams defines parameters for GetApiLinksToken.
+type GetApiLinksTokenParams struct {
+	// ExpirationDate New expiration date for "renewed" links; defaults to the category's typical validity
+	ExpirationDate *openapi_types.Date `form:"expirationDate,omitempty" json:"expirationDate,omitempty"`
+}
+
+// PostApiLinksTokenParams defines parameters for PostApiLinksToken.
+type PostApiLinksTokenParams struct {
+	// ExpirationDate New expiration date for "renewed" links; defaults to the category's typical validity
+	ExpirationDate *openapi_types.Date `form:"expirationDate,omitempty" json:"expirationDate,omitempty"`
+}
+
+// PostApiNotificationsReadParams defines parameters for PostApiNotificationsRead.
+type PostApiNotificationsReadParams struct {
+	// XOrganizationID Act on the documents of this organization instead of the caller's personal documents. Accepted by every /api/documents endpoint.
+	XOrganizationID *OrganizationHeader `json:"X-Organization-ID,omitempty"`
+}
+
+// GetApiNotificationsUnreadCountParams defines parameters for GetApiNotificationsUnreadCount.
+type GetApiNotificationsUnreadCountParams struct {
+	// XOrganizationID Act on the documents of this organization instead of the caller's personal documents. Accepted by every /api/documents endpoint.
+	XOrganizationID *OrganizationHeader `json:"X-Organization-ID,omitempty"`
+}
+
+// PostApiOrganizationsJSONBody defines parameters for PostApiOrganizations.
+type PostApiOrganizationsJSONBody struct {
+	DataRegion *string `json:"dataRegion,omitempty"`
+	Name       string  `json:"name"`
+}
+
+// PutApiOrganizationsIdComplianceJSONBody defines parameters for PutApiOrganizationsIdCompliance.
+type PutApiOrganizationsIdComplianceJSONBody struct {
+	// Categories Document category slugs; required when enabled.
+	Categories *[]string `json:"categories,omitempty"`
+	Enabled    bool      `json:"enabled"`
+}
+
+// PutApiOrganizationsIdEscalationPolicyJSONBody defines parameters for PutApiOrganizationsIdEscalationPolicy.
+type PutApiOrganizationsIdEscalationPolicyJSONBody struct {
+	Steps []EscalationStep `json:"steps"`
+}
+
+// PostApiOrganizationsIdMembersJSONBody defines parameters for PostApiOrganizationsIdMembers.
+type PostApiOrganizationsIdMembersJSONBody struct {
+	Email openapi_types.Email                       `json:"email"`
+	Role  PostApiOrganizationsIdMembersJSONBodyRole `json:"role"`
+}
+
+// PostApiOrganizationsIdMembersJSONBodyRole defines parameters for PostApiOrganizationsIdMembers.
+type PostApiOrganizationsIdMembersJSONBodyRole string
+
+// PutApiOrganizationsIdRenewalApprovalJSONBody defines parameters for PutApiOrganizationsIdRenewalApproval.
+type PutApiOrganizationsIdRenewalApprovalJSONBody struct {
+	Required bool `json:"required"`
+}
+
+// PutApiOrganizationsIdRetentionJSONBody defines parameters for PutApiOrganizationsIdRetention.
+type PutApiOrganizationsIdRetentionJSONBody struct {
+	EventLogDays            *int `json:"eventLogDays"`
+	ExpiredDocumentsDays    *int `json:"expiredDocumentsDays"`
+	NotificationHistoryDays *int `json:"notificationHistoryDays"`
+}
+
+// PostApiOrganizationsIdServiceAccountsJSONBody defines parameters for PostApiOrganizationsIdServiceAccounts.
+type PostApiOrganizationsIdServiceAccountsJSONBody struct {
+	Name   string                                                `json:"name"`
+	Scopes []PostApiOrganizationsIdServiceAccountsJSONBodyScopes `json:"scopes"`
+}
+
+// PostApiOrganizationsIdServiceAccountsJSONBodyScopes defines parameters for PostApiOrganizationsIdServiceAccounts.
+type PostApiOrganizationsIdServiceAccountsJSONBodyScopes string
+
+// GetApiOrganizationsIdUsageExportParams defines parameters for GetApiOrganizationsIdUsageExport.
+type GetApiOrganizationsIdUsageExportParams struct {
+	// From First month to export, YYYY-MM. Defaults to the current UTC month.
+	From *string `form:"from,omitempty" json:"from,omitempty"`
+
+	// To Last month to export, YYYY-MM. Defaults to the current UTC month.
+	To     *string                                       `form:"to,omitempty" json:"to,omitempty"`
+	Format *GetApiOrganizationsIdUsageExportParamsFormat `form:"format,omitempty" json:"format,omitempty"`
+}
+
+// GetApiOrganizationsIdUsageExportParamsFormat defines parameters for GetApiOrganizationsIdUsageExport.
+type GetApiOrganizationsIdUsageExportParamsFormat string
+
+// PutApiPreferencesLocaleJSONBody defines parameters for PutApiPreferencesLocale.
+type PutApiPreferencesLocaleJSONBody struct {
+	DurationUnit *PutApiPreferencesLocaleJSONBodyDurationUnit `json:"durationUnit,omitempty"`
+	Locale       *string                                      `json:"locale"`
+}
+
+// PutApiPreferencesLocaleJSONBodyDurationUnit defines parameters for PutApiPreferencesLocale.
+type PutApiPreferencesLocaleJSONBodyDurationUnit string
+
+// PutApiPreferencesNotificationsJSONBody defines parameters for PutApiPreferencesNotifications.
+type PutApiPreferencesNotificationsJSONBody struct {
+	// BatchWindowHours Hold reminders for up to this many hours and send them together; 0 disables
+	BatchWindowHours *int `json:"batchWindowHours,omitempty"`
+
+	// CalendarInvites Send reminder emails about a single document as iCalendar invites (METHOD:REQUEST) for its expiration date, with an alarm the day before, so the expiration also lands in the calendar. Digests of several documents stay plain emails.
+	CalendarInvites *bool `json:"calendarInvites,omitempty"`
+
+	// ChannelMatrix Channels per reminder interval ID (e.g. "30d"). Intervals without an entry go by email, plus SMS unless escalationChannel is "sms". An empty list silences the interval.
+	ChannelMatrix       *ChannelMatrix                                          `json:"channelMatrix,omitempty"`
+	EscalationAfterDays int                                                     `json:"escalationAfterDays"`
+	EscalationChannel   PutApiPreferencesNotificationsJSONBodyEscalationChannel `json:"escalationChannel"`
+
+	// OptimizeSendTime Delay each reminder, by up to a day after it comes due, to the start of the hour of day (UTC) in which the user opened the most reminder emails over the last 90 days, as tracked by open pixels. Reminders go out as they come due until at least 5 opens were tracked.
+	OptimizeSendTime *bool `json:"optimizeSendTime,omitempty"`
+
+	// PausedUntil Pause notifications until then, at most a year ahead, e.g. for a vacation. Reminders coming due meanwhile are deferred, not dropped, and sent as one catch-up summary when the pause ends. Null, or a time already past, sends reminders as they come due; ending a pause early sends the catch-up right away.
+	PausedUntil *time.Time `json:"pausedUntil"`
+}
+
+// PutApiPreferencesNotificationsJSONBodyEscalationChannel defines parameters for PutApiPreferencesNotifications.
+type PutApiPreferencesNotificationsJSONBodyEscalationChannel string
+
+// GetApiUsageParams defines parameters for GetApiUsage.
+type GetApiUsageParams struct {
+	// XOrganizationID Act on the documents of this organization instead of the caller's personal documents. Accepted by every /api/documents endpoint.
+	XOrganizationID *OrganizationHeader `json:"X-Organization-ID,omitempty"`
+}
+
+// PostApiViewsJSONBody defines parameters for PostApiViews.
+type PostApiViewsJSONBody struct {
+	// Filters Criteria of a saved view. Documents must meet all that are set.
+	Filters *ViewFilters `json:"filters,omitempty"`
+	Name    string       `json:"name"`
+}
+
+// PutApiViewsIdJSONBody defines parameters for PutApiViewsId.
+type PutApiViewsIdJSONBody struct {
+	// Filters Criteria of a saved view. Documents must meet all that are set.
+	Filters *ViewFilters `json:"filters,omitempty"`
+	Name    string       `json:"name"`
+}
+
+// GetApiViewsIdDocumentsParams defines parameters for GetApiViewsIdDocuments.
+type GetApiViewsIdDocumentsParams struct {
+	// Sort As for /api/documents.
+	Sort *GetApiViewsIdDocumentsParamsSort `form:"sort,omitempty" json:"sort,omitempty"`
+
+	// XOrganizationID Act on the documents of this organization instead of the caller's personal documents. Accepted by every /api/documents endpoint.
+	XOrganizationID *OrganizationHeader `json:"X-Organization-ID,omitempty"`
+}
+
+// GetApiViewsIdDocumentsParamsSort defines parameters for GetApiViewsIdDocuments.
+type GetApiViewsIdDocumentsParamsSort string
+
+// PostApiWebhooksEmailBounceJSONBody defines parameters for PostApiWebhooksEmailBounce.
+type PostApiWebhooksEmailBounceJSONBody struct {
+	MessageId openapi_types.UUID `json:"messageId"`
+	Reason    *string            `json:"reason,omitempty"`
+}
+
+// PostApiWebhooksEmailBounceParams defines parameters for PostApiWebhooksEmailBounce.
+type PostApiWebhooksEmailBounceParams struct {
+	// XWebhookSecret Verified with EMAIL_WEBHOOK_SECRET; bounce reports are refused while it is not configured
+	XWebhookSecret string `json:"X-Webhook-Secret"`
+}
+
+// GetApiWebhooksEndpointsIdDeliveriesParams defines parameters for GetApiWebhooksEndpointsIdDeliveries.
+type GetApiWebhooksEndpointsIdDeliveriesParams struct {
+	Limit *int `form:"limit,omitempty" json:"limit,omitempty"`
+}
+
+// PostApiWebhooksEndpointsIdRotateSecretJSONBody defines parameters for PostApiWebhooksEndpointsIdRotateSecret.
+type PostApiWebhooksEndpointsIdRotateSecretJSONBody struct {
+	GracePeriodHours *int `json:"gracePeriodHours,omitempty"`
+}
+
+// PostApiWebhooksTwilioSmsFormdataBody defines parameters for PostApiWebhooksTwilioSms.
+type PostApiWebhooksTwilioSmsFormdataBody struct {
+	Body *string `form:"Body,omitempty" json:"Body,omitempty"`
+	From *string `form:"From,omitempty" json:"From,omitempty"`
+}
+
+// PostApiWebhooksTwilioSmsParams defines parameters for PostApiWebhooksTwilioSms.
+type PostApiWebhooksTwilioSmsParams struct {
+	// XTwilioSignature Verified with TWILIO_AUTH_TOKEN; replies are refused while it is not configured
+	XTwilioSignature string `json:"X-Twilio-Signature"`
+}
+
+// GetScimV2UsersParams defines parameters for GetScimV2Users.
+type GetScimV2UsersParams struct {
+	Filter     *string `form:"filter,omitempty" json:"filter,omitempty"`
+	StartIndex *int    `form:"startIndex,omitempty" json:"startIndex,omitempty"`
+	Count      *int    `form:"count,omitempty" json:"count,omitempty"`
+}
+
+// PostApiAdminAnnouncementsJSONRequestBody defines body for PostApiAdminAnnouncements for application/json ContentType.
+type PostApiAdminAnnouncementsJSONRequestBody PostApiAdminAnnouncementsJSONBody
+
+// PostApiAdminImpersonateJSONRequestBody defines body for PostApiAdminImpersonate for application/json ContentType.
+type PostApiAdminImpersonateJSONRequestBody PostApiAdminImpersonateJSONBody
+
+// PostApiAdminIssuersJSONRequestBody defines body for PostApiAdminIssuers for application/json ContentType.
+type PostApiAdminIssuersJSONRequestBody = IssuerRequest
+
+// PutApiAdminIssuersIdJSONRequestBody defines body for PutApiAdminIssuersId for application/json ContentType.
+type PutApiAdminIssuersIdJSONRequestBody = IssuerRequest
+
+// PostApiAdminRoadmapJSONRequestBody defines body for PostApiAdminRoadmap for application/json ContentType.
+type PostApiAdminRoadmapJSONRequestBody PostApiAdminRoadmapJSONBody
+
+// PutApiAdminRoadmapIdJSONRequestBody defines body for PutApiAdminRoadmapId for application/json ContentType.
+type PutApiAdminRoadmapIdJSONRequestBody PutApiAdminRoadmapIdJSONBody
+
+// PutApiAdminUsersIdPlanJSONRequestBody defines body for PutApiAdminUsersIdPlan for application/json ContentType.
+type PutApiAdminUsersIdPlanJSONRequestBody PutApiAdminUsersIdPlanJSONBody
+
+// PutApiAdminUsersIdRoleJSONRequestBody defines body for PutApiAdminUsersIdRole for application/json ContentType.
+type PutApiAdminUsersIdRoleJSONRequestBody PutApiAdminUsersIdRoleJSONBody
+
+// PostApiAdminUsersIdSuspendJSONRequestBody defines body for PostApiAdminUsersIdSuspend for application/json ContentType.
+type PostApiAdminUsersIdSuspendJSONRequestBody PostApiAdminUsersIdSuspendJSONBody
+
+// PostApiApiKeysJSONRequestBody defines body for PostApiApiKeys for application/json ContentType.
+type PostApiApiKeysJSONRequestBody PostApiApiKeysJSONBody
+
+// PutApiAuthEmailJSONRequestBody defines body for PutApiAuthEmail for application/json ContentType.
+type PutApiAuthEmailJSONRequestBody PutApiAuthEmailJSONBody
+
+// DeleteApiAuthMeJSONRequestBody defines body for DeleteApiAuthMe for application/json ContentType.
+type DeleteApiAuthMeJSONRequestBody DeleteApiAuthMeJSONBody
+
+// PostApiAuthRegisterJSONRequestBody defines body for PostApiAuthRegister for application/json ContentType.
+type PostApiAuthRegisterJSONRequestBody PostApiAuthRegisterJSONBody
+
+// PostApiAuthSigninJSONRequestBody defines body for PostApiAuthSignin for application/json ContentType.
+type PostApiAuthSigninJSONRequestBody PostApiAuthSigninJSONBody
+
+// PostApiDocumentsJSONRequestBody defines body for PostApiDocuments for application/json ContentType.
+type PostApiDocumentsJSONRequestBody PostApiDocumentsJSONBody
+
+// PostApiDocumentsImportMultipartRequestBody defines body for PostApiDocumentsImport for multipart/form-data ContentType.
+type PostApiDocumentsImportMultipartRequestBody PostApiDocumentsImportMultipartBody
+
+// PutApiDocumentsOrderJSONRequestBody defines body for PutApiDocumentsOrder for application/json ContentType.
+type PutApiDocumentsOrderJSONRequestBody PutApiDocumentsOrderJSONBody
+
+// PutApiDocumentsIdJSONRequestBody defines body for PutApiDocumentsId for application/json ContentType.
+type PutApiDocumentsIdJSONRequestBody PutApiDocumentsIdJSONBody
+
+// PutApiDocumentsIdAssigneeJSONRequestBody defines body for PutApiDocumentsIdAssignee for application/json ContentType.
+type PutApiDocumentsIdAssigneeJSONRequestBody PutApiDocumentsIdAssigneeJSONBody
+
+// PutApiDocumentsIdAttachmentMultipartRequestBody defines body for PutApiDocumentsIdAttachment for multipart/form-data ContentType.
+type PutApiDocumentsIdAttachmentMultipartRequestBody PutApiDocumentsIdAttachmentMultipartBody
+
+// PostApiDocumentsIdChecklistJSONRequestBody defines body for PostApiDocumentsIdChecklist for application/json ContentType.
+type PostApiDocumentsIdChecklistJSONRequestBody PostApiDocumentsIdChecklistJSONBody
+
+// PutApiDocumentsIdChecklistItemIdJSONRequestBody defines body for PutApiDocumentsIdChecklistItemId for application/json ContentType.
+type PutApiDocumentsIdChecklistItemIdJSONRequestBody PutApiDocumentsIdChecklistItemIdJSONBody
+
+// PostApiDocumentsIdContactsJSONRequestBody defines body for PostApiDocumentsIdContacts for application/json ContentType.
+type PostApiDocumentsIdContactsJSONRequestBody PostApiDocumentsIdContactsJSONBody
+
+// PostApiDocumentsIdDependenciesJSONRequestBody defines body for PostApiDocumentsIdDependencies for application/json ContentType.
+type PostApiDocumentsIdDependenciesJSONRequestBody PostApiDocumentsIdDependenciesJSONBody
+
+// PutApiDocumentsIdLegalHoldJSONRequestBody defines body for PutApiDocumentsIdLegalHold for application/json ContentType.
+type PutApiDocumentsIdLegalHoldJSONRequestBody = LegalHoldRequest
+
+// PostApiDocumentsIdLockJSONRequestBody defines body for PostApiDocumentsIdLock for application/json ContentType.
+type PostApiDocumentsIdLockJSONRequestBody PostApiDocumentsIdLockJSONBody
+
+// PutApiDocumentsIdRemindersJSONRequestBody defines body for PutApiDocumentsIdReminders for application/json ContentType.
+type PutApiDocumentsIdRemindersJSONRequestBody PutApiDocumentsIdRemindersJSONBody
+
+// PostApiDocumentsIdRenewalsMultipartRequestBody defines body for PostApiDocumentsIdRenewals for multipart/form-data ContentType.
+type PostApiDocumentsIdRenewalsMultipartRequestBody PostApiDocumentsIdRenewalsMultipartBody
+
+// PostApiDocumentsIdRenewalsRenewalIdApproveJSONRequestBody defines body for PostApiDocumentsIdRenewalsRenewalIdApprove for application/json ContentType.
+type PostApiDocumentsIdRenewalsRenewalIdApproveJSONRequestBody PostApiDocumentsIdRenewalsRenewalIdApproveJSONBody
+
+// PostApiDocumentsIdRenewalsRenewalIdRejectJSONRequestBody defines body for PostApiDocumentsIdRenewalsRenewalIdReject for application/json ContentType.
+type PostApiDocumentsIdRenewalsRenewalIdRejectJSONRequestBody PostApiDocumentsIdRenewalsRenewalIdRejectJSONBody
+
+// PostApiFeedbackJSONRequestBody defines body for PostApiFeedback for application/json ContentType.
+type PostApiFeedbackJSONRequestBody PostApiFeedbackJSONBody
+
+// PostApiGraphqlJSONRequestBody defines body for PostApiGraphql for application/json ContentType.
+type PostApiGraphqlJSONRequestBody PostApiGraphqlJSONBody
+
+// PostApiHouseholdJSONRequestBody defines body for PostApiHousehold for application/json ContentType.
+type PostApiHouseholdJSONRequestBody PostApiHouseholdJSONBody
+
+// PostApiHouseholdMembersJSONRequestBody defines body for PostApiHouseholdMembers for application/json ContentType.
+type PostApiHouseholdMembersJSONRequestBody PostApiHouseholdMembersJSONBody
+
+// PutApiHouseholdMembersUserIdJSONRequestBody defines body for PutApiHouseholdMembersUserId for application/json ContentType.
+type PutApiHouseholdMembersUserIdJSONRequestBody PutApiHouseholdMembersUserIdJSONBody
+
+// PostApiJobsImportMultipartRequestBody defines body for PostApiJobsImport for multipart/form-data ContentType.
+type PostApiJobsImportMultipartRequestBody PostApiJobsImportMultipartBody
+
+// PostApiOrganizationsJSONRequestBody defines body for PostApiOrganizations for application/json ContentType.
+type PostApiOrganizationsJSONRequestBody PostApiOrganizationsJSONBody
+
+// PutApiOrganizationsIdComplianceJSONRequestBody defines body for PutApiOrganizationsIdCompliance for application/json ContentType.
+type PutApiOrganizationsIdComplianceJSONRequestBody PutApiOrganizationsIdComplianceJSONBody
+
+// PutApiOrganizationsIdEmailSenderJSONRequestBody defines body for PutApiOrganizationsIdEmailSender for application/json ContentType.
+type PutApiOrganizationsIdEmailSenderJSONRequestBody = EmailSenderRequest
+
+// PutApiOrganizationsIdEscalationPolicyJSONRequestBody defines body for PutApiOrganizationsIdEscalationPolicy for application/json ContentType.
+type PutApiOrganizationsIdEscalationPolicyJSONRequestBody PutApiOrganizationsIdEscalationPolicyJSONBody
+
+// PutApiOrganizationsIdLegalHoldJSONRequestBody defines body for PutApiOrganizationsIdLegalHold for application/json ContentType.
+type PutApiOrganizationsIdLegalHoldJSONRequestBody = LegalHoldRequest
+
+// PostApiOrganizationsIdMembersJSONRequestBody defines body for PostApiOrganizationsIdMembers for application/json ContentType.
+type PostApiOrganizationsIdMembersJSONRequestBody PostApiOrganizationsIdMembersJSONBody
+
+// PutApiOrganizationsIdNotificationThemeJSONRequestBody defines body for PutApiOrganizationsIdNotificationTheme for application/json ContentType.
+type PutApiOrganizationsIdNotificationThemeJSONRequestBody = NotificationThemeRequest
+
+// PutApiOrganizationsIdRenewalApprovalJSONRequestBody defines body for PutApiOrganizationsIdRenewalApproval for application/json ContentType.
+type PutApiOrganizationsIdRenewalApprovalJSONRequestBody PutApiOrganizationsIdRenewalApprovalJSONBody
+
+// PutApiOrganizationsIdRetentionJSONRequestBody defines body for PutApiOrganizationsIdRetention for application/json ContentType.
+type PutApiOrganizationsIdRetentionJSONRequestBody PutApiOrganizationsIdRetentionJSONBody
+
+// PostApiOrganizationsIdServiceAccountsJSONRequestBody defines body for PostApiOrganizationsIdServiceAccounts for application/json ContentType.
+type PostApiOrganizationsIdServiceAccountsJSONRequestBody PostApiOrganizationsIdServiceAccountsJSONBody
+
+// PutApiPreferencesLocaleJSONRequestBody defines body for PutApiPreferencesLocale for application/json ContentType.
+type PutApiPreferencesLocaleJSONRequestBody PutApiPreferencesLocaleJSONBody
+
+// PutApiPreferencesNotificationsJSONRequestBody defines body for PutApiPreferencesNotifications for application/json ContentType.
+type PutApiPreferencesNotificationsJSONRequestBody PutApiPreferencesNotificationsJSONBody
+
+// PostApiViewsJSONRequestBody defines body for PostApiViews for application/json ContentType.
+type PostApiViewsJSONRequestBody PostApiViewsJSONBody
+
+// PutApiViewsIdJSONRequestBody defines body for PutApiViewsId for application/json ContentType.
+type PutApiViewsIdJSONRequestBody PutApiViewsIdJSONBody
+
+// PostApiWebhooksEmailBounceJSONRequestBody defines body for PostApiWebhooksEmailBounce for application/json ContentType.
+type PostApiWebhooksEmailBounceJSONRequestBody PostApiWebhooksEmailBounceJSONBody
+
+// PostApiWebhooksEndpointsJSONRequestBody defines body for PostApiWebhooksEndpoints for application/json ContentType.
+type PostApiWebhooksEndpointsJSONRequestBody = WebhookEndpointRequest
+
+// PutApiWebhooksEndpointsIdJSONRequestBody defines body for PutApiWebhooksEndpointsId for application/json ContentType.
+type PutApiWebhooksEndpointsIdJSONRequestBody = WebhookEndpointRequest
+
+// PostApiWebhooksEndpointsIdRotateSecretJSONRequestBody defines body for PostApiWebhooksEndpointsIdRotateSecret for application/json ContentType.
+type PostApiWebhooksEndpointsIdRotateSecretJSONRequestBody PostApiWebhooksEndpointsIdRotateSecretJSONBody
+
+// PostApiWebhooksTwilioSmsFormdataRequestBody defines body for PostApiWebhooksTwilioSms for application/x-www-form-urlencoded ContentType.
+type PostApiWebhooksTwilioSmsFormdataRequestBody PostApiWebhooksTwilioSmsFormdataBody
+
+// PostScimV2UsersApplicationScimPlusJSONRequestBody defines body for PostScimV2Users for application/scim+json ContentType.
+type PostScimV2UsersApplicationScimPlusJSONRequestBody = ScimUser
+
+// PatchScimV2UsersUserIdApplicationScimPlusJSONRequestBody defines body for PatchScimV2UsersUserId for application/scim+json ContentType.
+type PatchScimV2UsersUserIdApplicationScimPlusJSONRequestBody = ScimPatchRequest
+
+// PutScimV2UsersUserIdApplicationScimPlusJSONRequestBody defines body for PutScimV2UsersUserId for application/scim+json ContentType.
+type PutScimV2UsersUserIdApplicationScimPlusJSONRequestBody = ScimUser
+
+// RequestEditorFn  is the function signature for the RequestEditor callback function
+type RequestEditorFn func(ctx context.Context, req *http.Request) error
+
+// Doer performs HTTP requests.
+//
+// The standard http.Client implements this interface.
+type HttpRequestDoer interface {
+	Do(req *http.Request) (*http.Response, error)
+}
+
+// Client which conforms to the OpenAPI3 specification for this service.
+type Client struct {
+	// The endpoint of the server conforming to this interface, with scheme,
+	// https://api.deepmap.com for example. This can contain a path relative
+	// to the server, such as https://api.deepmap.com/dev-test, and all the
+	// paths in the swagger spec will be appended to the server.
+	Server string
+
+	// Doer for performing requests, typically a *http.Client with any
+	// customized settings, such as certificate chains.
+	Client HttpRequestDoer
+
+	// A list of callbacks for modifying requests which are generated before sending over
+	// the network.
+	RequestEditors []RequestEditorFn
+}
+
+// ClientOption allows setting custom parameters during construction
+type ClientOption func(*Client) error
+
+// Creates a new Client, with reasonable defaults
+func NewClient(server string, opts ...ClientOption) (*Client, error) {
+	// create a client with sane default values
+	client := Client{
+		Server: server,
+	}
+	// mutate client and add all optional params
+	for _, o := range opts {
+		if err := o(&client); err != nil {
+			return nil, err
+		}
+	}
+	// ensure the server URL always has a trailing slash
+	if !strings.HasSuffix(client.Server, "/") {
+		client.Server += "/"
+	}
+	// create httpClient, if not already present
+	if client.Client == nil {
+		client.Client = &http.Client{}
+	}
+	return &client, nil
+}
+
+// WithHTTPClient allows overriding the default Doer, which is
+// automatically created using http.Client. This is useful for tests.
+func WithHTTPClient(doer HttpRequestDoer) ClientOption {
+	return func(c *Client) error {
+		c.Client = doer
+		return nil
+	}
+}
+
+// WithRequestEditorFn allows setting up a callback function, which will be
+// called right before sending the request. This can be used to mutate the request.
+func WithRequestEditorFn(fn RequestEditorFn) ClientOption {
+	return func(c *Client) error {
+		c.RequestEditors = append(c.RequestEditors, fn)
+		return nil
+	}
+}
+
+// The interface specification for the client above.
+type ClientInterface interface {
+	// GetWellKnownJwksJson request
+	GetWellKnownJwksJson(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetApiAdminAnnouncements request
+	GetApiAdminAnnouncements(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostApiAdminAnnouncementsWithBody request with any body
+	PostApiAdminAnnouncementsWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostApiAdminAnnouncements(ctx context.Context, body PostApiAdminAnnouncementsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DeleteApiAdminAnnouncementsId request
+	DeleteApiAdminAnnouncementsId(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetApiAdminDeprecations request
+	GetApiAdminDeprecations(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetApiAdminHealth request
+	GetApiAdminHealth(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostApiAdminImpersonateWithBody request with any body
+	PostApiAdminImpersonateWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostApiAdminImpersonate(ctx context.Context, body PostApiAdminImpersonateJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostApiAdminIssuersWithBody request with any body
+	PostApiAdminIssuersWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostApiAdminIssuers(ctx context.Context, body PostApiAdminIssuersJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DeleteApiAdminIssuersId request
+	DeleteApiAdminIssuersId(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PutApiAdminIssuersIdWithBody request with any body
+	PutApiAdminIssuersIdWithBody(ctx context.Context, id openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PutApiAdminIssuersId(ctx context.Context, id openapi_types.UUID, body PutApiAdminIssuersIdJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetApiAdminLoadShedding request
+	GetApiAdminLoadShedding(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetApiAdminNotificationProviders request
+	GetApiAdminNotificationProviders(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostApiAdminRoadmapWithBody request with any body
+	PostApiAdminRoadmapWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostApiAdminRoadmap(ctx context.Context, body PostApiAdminRoadmapJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DeleteApiAdminRoadmapId request
+	DeleteApiAdminRoadmapId(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PutApiAdminRoadmapIdWithBody request with any body
+	PutApiAdminRoadmapIdWithBody(ctx context.Context, id openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PutApiAdminRoadmapId(ctx context.Context, id openapi_types.UUID, body PutApiAdminRoadmapIdJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostApiAdminScheduledJobsNameRun request
+	PostApiAdminScheduledJobsNameRun(ctx context.Context, name PostApiAdminScheduledJobsNameRunParamsName, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetApiAdminTasksFailed request
+	GetApiAdminTasksFailed(ctx context.Context, params *GetApiAdminTasksFailedParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostApiAdminTasksQueueTaskIdRequeue request
+	PostApiAdminTasksQueueTaskIdRequeue(ctx context.Context, queue string, taskId string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetApiAdminUsageExport request
+	GetApiAdminUsageExport(ctx context.Context, params *GetApiAdminUsageExportParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PutApiAdminUsersIdPlanWithBody request with any body
+	PutApiAdminUsersIdPlanWithBody(ctx context.Context, id openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PutApiAdminUsersIdPlan(ctx context.Context, id openapi_types.UUID, body PutApiAdminUsersIdPlanJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostApiAdminUsersIdReinstate request
+	PostApiAdminUsersIdReinstate(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PutApiAdminUsersIdRoleWithBody request with any body
+	PutApiAdminUsersIdRoleWithBody(ctx context.Context, id openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PutApiAdminUsersIdRole(ctx context.Context, id openapi_types.UUID, body PutApiAdminUsersIdRoleJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostApiAdminUsersIdSuspendWithBody request with any body
+	PostApiAdminUsersIdSuspendWithBody(ctx context.Context, id openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostApiAdminUsersIdSuspend(ctx context.Context, id openapi_types.UUID, body PostApiAdminUsersIdSuspendJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetApiAnnouncements request
+	GetApiAnnouncements(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetApiApiKeys request
+	GetApiApiKeys(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostApiApiKeysWithBody request with any body
+	PostApiApiKeysWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostApiApiKeys(ctx context.Context, body PostApiApiKeysJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DeleteApiApiKeysId request
+	DeleteApiApiKeysId(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PutApiAuthEmailWithBody request with any body
+	PutApiAuthEmailWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PutApiAuthEmail(ctx context.Context, body PutApiAuthEmailJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetApiAuthEmailConfirmToken request
+	GetApiAuthEmailConfirmToken(ctx context.Context, token string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostApiAuthEmailConfirmToken request
+	PostApiAuthEmailConfirmToken(ctx context.Context, token string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostApiAuthLogout request
+	PostApiAuthLogout(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DeleteApiAuthMeWithBody request with any body
+	DeleteApiAuthMeWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	DeleteApiAuthMe(ctx context.Context, body DeleteApiAuthMeJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetApiAuthMe request
+	GetApiAuthMe(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetApiAuthOauthProvider request
+	GetApiAuthOauthProvider(ctx context.Context, provider GetApiAuthOauthProviderParamsProvider, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetApiAuthOauthProviderCallback request
+	GetApiAuthOauthProviderCallback(ctx context.Context, provider GetApiAuthOauthProviderCallbackParamsProvider, params *GetApiAuthOauthProviderCallbackParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostApiAuthRegisterWithBody request with any body
+	PostApiAuthRegisterWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostApiAuthRegister(ctx context.Context, body PostApiAuthRegisterJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DeleteApiAuthSessions request
+	DeleteApiAuthSessions(ctx context.Context, params *DeleteApiAuthSessionsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetApiAuthSessions request
+	GetApiAuthSessions(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DeleteApiAuthSessionsId request
+	DeleteApiAuthSessionsId(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostApiAuthSigninWithBody request with any body
+	PostApiAuthSigninWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostApiAuthSignin(ctx context.Context, body PostApiAuthSigninJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetApiCategories request
+	GetApiCategories(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetApiCategoriesSlugSuggestExpiration request
+	GetApiCategoriesSlugSuggestExpiration(ctx context.Context, slug string, params *GetApiCategoriesSlugSuggestExpirationParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetApiDocuments request
+	GetApiDocuments(ctx context.Context, params *GetApiDocumentsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostApiDocumentsWithBody request with any body
+	PostApiDocumentsWithBody(ctx context.Context, params *PostApiDocumentsParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostApiDocuments(ctx context.Context, params *PostApiDocumentsParams, body PostApiDocumentsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetApiDocumentsDependencyGraph request
+	GetApiDocumentsDependencyGraph(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostApiDocumentsImportWithBody request with any body
+	PostApiDocumentsImportWithBody(ctx context.Context, params *PostApiDocumentsImportParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PutApiDocumentsOrderWithBody request with any body
+	PutApiDocumentsOrderWithBody(ctx context.Context, params *PutApiDocumentsOrderParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PutApiDocumentsOrder(ctx context.Context, params *PutApiDocumentsOrderParams, body PutApiDocumentsOrderJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetApiDocumentsRenewalCosts request
+	GetApiDocumentsRenewalCosts(ctx context.Context, params *GetApiDocumentsRenewalCostsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetApiDocumentsStats request
+	GetApiDocumentsStats(ctx context.Context, params *GetApiDocumentsStatsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetApiDocumentsTrash request
+	GetApiDocumentsTrash(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DeleteApiDocumentsId request
+	DeleteApiDocumentsId(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetApiDocumentsId request
+	GetApiDocumentsId(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PutApiDocumentsIdWithBody request with any body
+	PutApiDocumentsIdWithBody(ctx context.Context, id openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PutApiDocumentsId(ctx context.Context, id openapi_types.UUID, body PutApiDocumentsIdJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DeleteApiDocumentsIdAssignee request
+	DeleteApiDocumentsIdAssignee(ctx context.Context, id openapi_types.UUID, params *DeleteApiDocumentsIdAssigneeParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetApiDocumentsIdAssignee request
+	GetApiDocumentsIdAssignee(ctx context.Context, id openapi_types.UUID, params *GetApiDocumentsIdAssigneeParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PutApiDocumentsIdAssigneeWithBody request with any body
+	PutApiDocumentsIdAssigneeWithBody(ctx context.Context, id openapi_types.UUID, params *PutApiDocumentsIdAssigneeParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PutApiDocumentsIdAssignee(ctx context.Context, id openapi_types.UUID, params *PutApiDocumentsIdAssigneeParams, body PutApiDocumentsIdAssigneeJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostApiDocumentsIdAssigneeAcknowledge request
+	PostApiDocumentsIdAssigneeAcknowledge(ctx context.Context, id openapi_types.UUID, params *PostApiDocumentsIdAssigneeAcknowledgeParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PutApiDocumentsIdAttachmentWithBody request with any body
+	PutApiDocumentsIdAttachmentWithBody(ctx context.Context, id openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetApiDocumentsIdChecklist request
+	GetApiDocumentsIdChecklist(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostApiDocumentsIdChecklistWithBody request with any body
+	PostApiDocumentsIdChecklistWithBody(ctx context.Context, id openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostApiDocumentsIdChecklist(ctx context.Context, id openapi_types.UUID, body PostApiDocumentsIdChecklistJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DeleteApiDocumentsIdChecklistItemId request
+	DeleteApiDocumentsIdChecklistItemId(ctx context.Context, id openapi_types.UUID, itemId openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PutApiDocumentsIdChecklistItemIdWithBody request with any body
+	PutApiDocumentsIdChecklistItemIdWithBody(ctx context.Context, id openapi_types.UUID, itemId openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PutApiDocumentsIdChecklistItemId(ctx context.Context, id openapi_types.UUID, itemId openapi_types.UUID, body PutApiDocumentsIdChecklistItemIdJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetApiDocumentsIdContacts request
+	GetApiDocumentsIdContacts(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostApiDocumentsIdContactsWithBody request with any body
+	PostApiDocumentsIdContactsWithBody(ctx context.Context, id openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostApiDocumentsIdContacts(ctx context.Context, id openapi_types.UUID, body PostApiDocumentsIdContactsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DeleteApiDocumentsIdContactsContactId request
+	DeleteApiDocumentsIdContactsContactId(ctx context.Context, id openapi_types.UUID, contactId openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetApiDocumentsIdDependencies request
+	GetApiDocumentsIdDependencies(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostApiDocumentsIdDependenciesWithBody request with any body
+	PostApiDocumentsIdDependenciesWithBody(ctx context.Context, id openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostApiDocumentsIdDependencies(ctx context.Context, id openapi_types.UUID, body PostApiDocumentsIdDependenciesJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DeleteApiDocumentsIdDependenciesDependsOnId request
+	DeleteApiDocumentsIdDependenciesDependsOnId(ctx context.Context, id openapi_types.UUID, dependsOnId openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetApiDocumentsIdLegalHold request
+	GetApiDocumentsIdLegalHold(ctx context.Context, id openapi_types.UUID, params *GetApiDocumentsIdLegalHoldParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PutApiDocumentsIdLegalHoldWithBody request with any body
+	PutApiDocumentsIdLegalHoldWithBody(ctx context.Context, id openapi_types.UUID, params *PutApiDocumentsIdLegalHoldParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PutApiDocumentsIdLegalHold(ctx context.Context, id openapi_types.UUID, params *PutApiDocumentsIdLegalHoldParams, body PutApiDocumentsIdLegalHoldJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DeleteApiDocumentsIdLock request
+	DeleteApiDocumentsIdLock(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostApiDocumentsIdLockWithBody request with any body
+	PostApiDocumentsIdLockWithBody(ctx context.Context, id openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostApiDocumentsIdLock(ctx context.Context, id openapi_types.UUID, body PostApiDocumentsIdLockJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DeleteApiDocumentsIdPin request
+	DeleteApiDocumentsIdPin(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostApiDocumentsIdPin request
+	PostApiDocumentsIdPin(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetApiDocumentsIdReminders request
+	GetApiDocumentsIdReminders(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PutApiDocumentsIdRemindersWithBody request with any body
+	PutApiDocumentsIdRemindersWithBody(ctx context.Context, id openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PutApiDocumentsIdReminders(ctx context.Context, id openapi_types.UUID, body PutApiDocumentsIdRemindersJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetApiDocumentsIdRemindersPreview request
+	GetApiDocumentsIdRemindersPreview(ctx context.Context, id openapi_types.UUID, params *GetApiDocumentsIdRemindersPreviewParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetApiDocumentsIdRenewals request
+	GetApiDocumentsIdRenewals(ctx context.Context, id openapi_types.UUID, params *GetApiDocumentsIdRenewalsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostApiDocumentsIdRenewalsWithBody request with any body
+	PostApiDocumentsIdRenewalsWithBody(ctx context.Context, id openapi_types.UUID, params *PostApiDocumentsIdRenewalsParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostApiDocumentsIdRenewalsRenewalIdApproveWithBody request with any body
+	PostApiDocumentsIdRenewalsRenewalIdApproveWithBody(ctx context.Context, id openapi_types.UUID, renewalId openapi_types.UUID, params *PostApiDocumentsIdRenewalsRenewalIdApproveParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostApiDocumentsIdRenewalsRenewalIdApprove(ctx context.Context, id openapi_types.UUID, renewalId openapi_types.UUID, params *PostApiDocumentsIdRenewalsRenewalIdApproveParams, body PostApiDocumentsIdRenewalsRenewalIdApproveJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostApiDocumentsIdRenewalsRenewalIdRejectWithBody request with any body
+	PostApiDocumentsIdRenewalsRenewalIdRejectWithBody(ctx context.Context, id openapi_types.UUID, renewalId openapi_types.UUID, params *PostApiDocumentsIdRenewalsRenewalIdRejectParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostApiDocumentsIdRenewalsRenewalIdReject(ctx context.Context, id openapi_types.UUID, renewalId openapi_types.UUID, params *PostApiDocumentsIdRenewalsRenewalIdRejectParams, body PostApiDocumentsIdRenewalsRenewalIdRejectJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostApiDocumentsIdRestore request
+	PostApiDocumentsIdRestore(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DeleteApiFeed request
+	DeleteApiFeed(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetApiFeed request
+	GetApiFeed(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostApiFeed request
+	PostApiFeed(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostApiFeedbackWithBody request with any body
+	PostApiFeedbackWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostApiFeedback(ctx context.Context, body PostApiFeedbackJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetApiFeedsTokenAtomXml request
+	GetApiFeedsTokenAtomXml(ctx context.Context, token string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetApiFeedsTokenRssXml request
+	GetApiFeedsTokenRssXml(ctx context.Context, token string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostApiGraphqlWithBody request with any body
+	PostApiGraphqlWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostApiGraphql(ctx context.Context, body PostApiGraphqlJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetApiHousehold request
+	GetApiHousehold(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostApiHouseholdWithBody request with any body
+	PostApiHouseholdWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostApiHousehold(ctx context.Context, body PostApiHouseholdJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostApiHouseholdMembersWithBody request with any body
+	PostApiHouseholdMembersWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostApiHouseholdMembers(ctx context.Context, body PostApiHouseholdMembersJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DeleteApiHouseholdMembersUserId request
+	DeleteApiHouseholdMembersUserId(ctx context.Context, userId openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PutApiHouseholdMembersUserIdWithBody request with any body
+	PutApiHouseholdMembersUserIdWithBody(ctx context.Context, userId openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PutApiHouseholdMembersUserId(ctx context.Context, userId openapi_types.UUID, body PutApiHouseholdMembersUserIdJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetApiHouseholdMembersUserIdDocuments request
+	GetApiHouseholdMembersUserIdDocuments(ctx context.Context, userId openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetApiIntegrationsEgressIps request
+	GetApiIntegrationsEgressIps(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetApiIssuers request
+	GetApiIssuers(ctx context.Context, params *GetApiIssuersParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetApiJobs request
+	GetApiJobs(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostApiJobsImportWithBody request with any body
+	PostApiJobsImportWithBody(ctx context.Context, params *PostApiJobsImportParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostApiJobsTakeout request
+	PostApiJobsTakeout(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetApiJobsId request
+	GetApiJobsId(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetApiLinksToken request
+	GetApiLinksToken(ctx context.Context, token string, params *GetApiLinksTokenParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostApiLinksToken request
+	PostApiLinksToken(ctx context.Context, token string, params *PostApiLinksTokenParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostApiNotificationsRead request
+	PostApiNotificationsRead(ctx context.Context, params *PostApiNotificationsReadParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetApiNotificationsUnreadCount request
+	GetApiNotificationsUnreadCount(ctx context.Context, params *GetApiNotificationsUnreadCountParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DeleteApiOnboardingSampleData request
+	DeleteApiOnboardingSampleData(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostApiOnboardingSampleData request
+	PostApiOnboardingSampleData(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetApiOrganizations request
+	GetApiOrganizations(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostApiOrganizationsWithBody request with any body
+	PostApiOrganizationsWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostApiOrganizations(ctx context.Context, body PostApiOrganizationsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PutApiOrganizationsIdComplianceWithBody request with any body
+	PutApiOrganizationsIdComplianceWithBody(ctx context.Context, id openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PutApiOrganizationsIdCompliance(ctx context.Context, id openapi_types.UUID, body PutApiOrganizationsIdComplianceJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DeleteApiOrganizationsIdEmailSender request
+	DeleteApiOrganizationsIdEmailSender(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetApiOrganizationsIdEmailSender request
+	GetApiOrganizationsIdEmailSender(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PutApiOrganizationsIdEmailSenderWithBody request with any body
+	PutApiOrganizationsIdEmailSenderWithBody(ctx context.Context, id openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PutApiOrganizationsIdEmailSender(ctx context.Context, id openapi_types.UUID, body PutApiOrganizationsIdEmailSenderJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostApiOrganizationsIdEmailSenderVerify request
+	PostApiOrganizationsIdEmailSenderVerify(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetApiOrganizationsIdEscalationPolicy request
+	GetApiOrganizationsIdEscalationPolicy(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PutApiOrganizationsIdEscalationPolicyWithBody request with any body
+	PutApiOrganizationsIdEscalationPolicyWithBody(ctx context.Context, id openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PutApiOrganizationsIdEscalationPolicy(ctx context.Context, id openapi_types.UUID, body PutApiOrganizationsIdEscalationPolicyJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetApiOrganizationsIdEventLogExport request
+	GetApiOrganizationsIdEventLogExport(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetApiOrganizationsIdLegalHold request
+	GetApiOrganizationsIdLegalHold(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PutApiOrganizationsIdLegalHoldWithBody request with any body
+	PutApiOrganizationsIdLegalHoldWithBody(ctx context.Context, id openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PutApiOrganizationsIdLegalHold(ctx context.Context, id openapi_types.UUID, body PutApiOrganizationsIdLegalHoldJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetApiOrganizationsIdMembers request
+	GetApiOrganizationsIdMembers(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostApiOrganizationsIdMembersWithBody request with any body
+	PostApiOrganizationsIdMembersWithBody(ctx context.Context, id openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostApiOrganizationsIdMembers(ctx context.Context, id openapi_types.UUID, body PostApiOrganizationsIdMembersJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DeleteApiOrganizationsIdMembersUserId request
+	DeleteApiOrganizationsIdMembersUserId(ctx context.Context, id openapi_types.UUID, userId openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DeleteApiOrganizationsIdNotificationTheme request
+	DeleteApiOrganizationsIdNotificationTheme(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetApiOrganizationsIdNotificationTheme request
+	GetApiOrganizationsIdNotificationTheme(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PutApiOrganizationsIdNotificationThemeWithBody request with any body
+	PutApiOrganizationsIdNotificationThemeWithBody(ctx context.Context, id openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PutApiOrganizationsIdNotificationTheme(ctx context.Context, id openapi_types.UUID, body PutApiOrganizationsIdNotificationThemeJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PutApiOrganizationsIdRenewalApprovalWithBody request with any body
+	PutApiOrganizationsIdRenewalApprovalWithBody(ctx context.Context, id openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PutApiOrganizationsIdRenewalApproval(ctx context.Context, id openapi_types.UUID, body PutApiOrganizationsIdRenewalApprovalJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetApiOrganizationsIdRetention request
+	GetApiOrganizationsIdRetention(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PutApiOrganizationsIdRetentionWithBody request with any body
+	PutApiOrganizationsIdRetentionWithBody(ctx context.Context, id openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PutApiOrganizationsIdRetention(ctx context.Context, id openapi_types.UUID, body PutApiOrganizationsIdRetentionJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DeleteApiOrganizationsIdScimToken request
+	DeleteApiOrganizationsIdScimToken(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostApiOrganizationsIdScimToken request
+	PostApiOrganizationsIdScimToken(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetApiOrganizationsIdServiceAccounts request
+	GetApiOrganizationsIdServiceAccounts(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostApiOrganizationsIdServiceAccountsWithBody request with any body
+	PostApiOrganizationsIdServiceAccountsWithBody(ctx context.Context, id openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostApiOrganizationsIdServiceAccounts(ctx context.Context, id openapi_types.UUID, body PostApiOrganizationsIdServiceAccountsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DeleteApiOrganizationsIdServiceAccountsAccountIdToken request
+	DeleteApiOrganizationsIdServiceAccountsAccountIdToken(ctx context.Context, id openapi_types.UUID, accountId openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostApiOrganizationsIdServiceAccountsAccountIdToken request
+	PostApiOrganizationsIdServiceAccountsAccountIdToken(ctx context.Context, id openapi_types.UUID, accountId openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetApiOrganizationsIdUsageExport request
+	GetApiOrganizationsIdUsageExport(ctx context.Context, id openapi_types.UUID, params *GetApiOrganizationsIdUsageExportParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetApiPreferencesLocale request
+	GetApiPreferencesLocale(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PutApiPreferencesLocaleWithBody request with any body
+	PutApiPreferencesLocaleWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PutApiPreferencesLocale(ctx context.Context, body PutApiPreferencesLocaleJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetApiPreferencesNotifications request
+	GetApiPreferencesNotifications(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PutApiPreferencesNotificationsWithBody request with any body
+	PutApiPreferencesNotificationsWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PutApiPreferencesNotifications(ctx context.Context, body PutApiPreferencesNotificationsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetApiRoadmap request
+	GetApiRoadmap(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DeleteApiRoadmapIdVote request
+	DeleteApiRoadmapIdVote(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostApiRoadmapIdVote request
+	PostApiRoadmapIdVote(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetApiTemplates request
+	GetApiTemplates(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetApiTrackOpenMessageId request
+	GetApiTrackOpenMessageId(ctx context.Context, messageId openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetApiUnsubscribeToken request
+	GetApiUnsubscribeToken(ctx context.Context, token string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostApiUnsubscribeToken request
+	PostApiUnsubscribeToken(ctx context.Context, token string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetApiUsage request
+	GetApiUsage(ctx context.Context, params *GetApiUsageParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetApiViews request
+	GetApiViews(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostApiViewsWithBody request with any body
+	PostApiViewsWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostApiViews(ctx context.Context, body PostApiViewsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DeleteApiViewsId request
+	DeleteApiViewsId(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetApiViewsId request
+	GetApiViewsId(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PutApiViewsIdWithBody request with any body
+	PutApiViewsIdWithBody(ctx context.Context, id openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PutApiViewsId(ctx context.Context, id openapi_types.UUID, body PutApiViewsIdJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetApiViewsIdDocuments request
+	GetApiViewsIdDocuments(ctx context.Context, id openapi_types.UUID, params *GetApiViewsIdDocumentsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostApiWebhooksEmailBounceWithBody request with any body
+	PostApiWebhooksEmailBounceWithBody(ctx context.Context, params *PostApiWebhooksEmailBounceParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostApiWebhooksEmailBounce(ctx context.Context, params *PostApiWebhooksEmailBounceParams, body PostApiWebhooksEmailBounceJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetApiWebhooksEndpoints request
+	GetApiWebhooksEndpoints(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostApiWebhooksEndpointsWithBody request with any body
+	PostApiWebhooksEndpointsWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostApiWebhooksEndpoints(ctx context.Context, body PostApiWebhooksEndpointsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DeleteApiWebhooksEndpointsId request
+	DeleteApiWebhooksEndpointsId(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PutApiWebhooksEndpointsIdWithBody request with any body
+	PutApiWebhooksEndpointsIdWithBody(ctx context.Context, id openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PutApiWebhooksEndpointsId(ctx context.Context, id openapi_types.UUID, body PutApiWebhooksEndpointsIdJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetApiWebhooksEndpointsIdDeliveries request
+	GetApiWebhooksEndpointsIdDeliveries(ctx context.Context, id openapi_types.UUID, params *GetApiWebhooksEndpointsIdDeliveriesParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostApiWebhooksEndpointsIdDeliveriesDeliveryIdRedeliver request
+	PostApiWebhooksEndpointsIdDeliveriesDeliveryIdRedeliver(ctx context.Context, id openapi_types.UUID, deliveryId openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostApiWebhooksEndpointsIdRotateSecretWithBody request with any body
+	PostApiWebhooksEndpointsIdRotateSecretWithBody(ctx context.Context, id openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostApiWebhooksEndpointsIdRotateSecret(ctx context.Context, id openapi_types.UUID, body PostApiWebhooksEndpointsIdRotateSecretJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostApiWebhooksTwilioSmsWithBody request with any body
+	PostApiWebhooksTwilioSmsWithBody(ctx context.Context, params *PostApiWebhooksTwilioSmsParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostApiWebhooksTwilioSmsWithFormdataBody(ctx context.Context, params *PostApiWebhooksTwilioSmsParams, body PostApiWebhooksTwilioSmsFormdataRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetHealth request
+	GetHealth(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetReady request
+	GetReady(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetReminderIntervals request
+	GetReminderIntervals(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetScimV2ServiceProviderConfig request
+	GetScimV2ServiceProviderConfig(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetScimV2Users request
+	GetScimV2Users(ctx context.Context, params *GetScimV2UsersParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostScimV2UsersWithBody request with any body
+	PostScimV2UsersWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostScimV2UsersWithApplicationScimPlusJSONBody(ctx context.Context, body PostScimV2UsersApplicationScimPlusJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DeleteScimV2UsersUserId request
+	DeleteScimV2UsersUserId(ctx context.Context, userId openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetScimV2UsersUserId request
+	GetScimV2UsersUserId(ctx context.Context, userId openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PatchScimV2UsersUserIdWithBody request with any body
+	PatchScimV2UsersUserIdWithBody(ctx context.Context, userId openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PatchScimV2UsersUserIdWithApplicationScimPlusJSONBody(ctx context.Context, userId openapi_types.UUID, body PatchScimV2UsersUserIdApplicationScimPlusJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PutScimV2UsersUserIdWithBody request with any body
+	PutScimV2UsersUserIdWithBody(ctx context.Context, userId openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PutScimV2UsersUserIdWithApplicationScimPlusJSONBody(ctx context.Context, userId openapi_types.UUID, body PutScimV2UsersUserIdApplicationScimPlusJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+}
+
+func (c *Client) GetWellKnownJwksJson(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetWellKnownJwksJsonRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetApiAdminAnnouncements(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetApiAdminAnnouncementsRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostApiAdminAnnouncementsWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostApiAdminAnnouncementsRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostApiAdminAnnouncements(ctx context.Context, body PostApiAdminAnnouncementsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostApiAdminAnnouncementsRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) DeleteApiAdminAnnouncementsId(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteApiAdminAnnouncementsIdRequest(c.Server, id)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetApiAdminDeprecations(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetApiAdminDeprecationsRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetApiAdminHealth(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetApiAdminHealthRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostApiAdminImpersonateWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostApiAdminImpersonateRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostApiAdminImpersonate(ctx context.Context, body PostApiAdminImpersonateJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostApiAdminImpersonateRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostApiAdminIssuersWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostApiAdminIssuersRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostApiAdminIssuers(ctx context.Context, body PostApiAdminIssuersJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostApiAdminIssuersRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) DeleteApiAdminIssuersId(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteApiAdminIssuersIdRequest(c.Server, id)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PutApiAdminIssuersIdWithBody(ctx context.Context, id openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPutApiAdminIssuersIdRequestWithBody(c.Server, id, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PutApiAdminIssuersId(ctx context.Context, id openapi_types.UUID, body PutApiAdminIssuersIdJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPutApiAdminIssuersIdRequest(c.Server, id, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetApiAdminLoadShedding(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetApiAdminLoadSheddingRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetApiAdminNotificationProviders(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetApiAdminNotificationProvidersRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostApiAdminRoadmapWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostApiAdminRoadmapRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostApiAdminRoadmap(ctx context.Context, body PostApiAdminRoadmapJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostApiAdminRoadmapRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) DeleteApiAdminRoadmapId(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteApiAdminRoadmapIdRequest(c.Server, id)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PutApiAdminRoadmapIdWithBody(ctx context.Context, id openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPutApiAdminRoadmapIdRequestWithBody(c.Server, id, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PutApiAdminRoadmapId(ctx context.Context, id openapi_types.UUID, body PutApiAdminRoadmapIdJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPutApiAdminRoadmapIdRequest(c.Server, id, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostApiAdminScheduledJobsNameRun(ctx context.Context, name PostApiAdminScheduledJobsNameRunParamsName, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostApiAdminScheduledJobsNameRunRequest(c.Server, name)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetApiAdminTasksFailed(ctx context.Context, params *GetApiAdminTasksFailedParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetApiAdminTasksFailedRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostApiAdminTasksQueueTaskIdRequeue(ctx context.Context, queue string, taskId string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostApiAdminTasksQueueTaskIdRequeueRequest(c.Server, queue, taskId)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetApiAdminUsageExport(ctx context.Context, params *GetApiAdminUsageExportParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetApiAdminUsageExportRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PutApiAdminUsersIdPlanWithBody(ctx context.Context, id openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPutApiAdminUsersIdPlanRequestWithBody(c.Server, id, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PutApiAdminUsersIdPlan(ctx context.Context, id openapi_types.UUID, body PutApiAdminUsersIdPlanJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPutApiAdminUsersIdPlanRequest(c.Server, id, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostApiAdminUsersIdReinstate(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostApiAdminUsersIdReinstateRequest(c.Server, id)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PutApiAdminUsersIdRoleWithBody(ctx context.Context, id openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPutApiAdminUsersIdRoleRequestWithBody(c.Server, id, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PutApiAdminUsersIdRole(ctx context.Context, id openapi_types.UUID, body PutApiAdminUsersIdRoleJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPutApiAdminUsersIdRoleRequest(c.Server, id, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostApiAdminUsersIdSuspendWithBody(ctx context.Context, id openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostApiAdminUsersIdSuspendRequestWithBody(c.Server, id, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostApiAdminUsersIdSuspend(ctx context.Context, id openapi_types.UUID, body PostApiAdminUsersIdSuspendJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostApiAdminUsersIdSuspendRequest(c.Server, id, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetApiAnnouncements(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetApiAnnouncementsRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetApiApiKeys(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetApiApiKeysRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostApiApiKeysWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostApiApiKeysRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostApiApiKeys(ctx context.Context, body PostApiApiKeysJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostApiApiKeysRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) DeleteApiApiKeysId(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteApiApiKeysIdRequest(c.Server, id)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PutApiAuthEmailWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPutApiAuthEmailRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PutApiAuthEmail(ctx context.Context, body PutApiAuthEmailJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPutApiAuthEmailRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetApiAuthEmailConfirmToken(ctx context.Context, token string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetApiAuthEmailConfirmTokenRequest(c.Server, token)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostApiAuthEmailConfirmToken(ctx context.Context, token string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostApiAuthEmailConfirmTokenRequest(c.Server, token)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostApiAuthLogout(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostApiAuthLogoutRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) DeleteApiAuthMeWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteApiAuthMeRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) DeleteApiAuthMe(ctx context.Context, body DeleteApiAuthMeJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteApiAuthMeRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetApiAuthMe(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetApiAuthMeRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetApiAuthOauthProvider(ctx context.Context, provider GetApiAuthOauthProviderParamsProvider, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetApiAuthOauthProviderRequest(c.Server, provider)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetApiAuthOauthProviderCallback(ctx context.Context, provider GetApiAuthOauthProviderCallbackParamsProvider, params *GetApiAuthOauthProviderCallbackParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetApiAuthOauthProviderCallbackRequest(c.Server, provider, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostApiAuthRegisterWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostApiAuthRegisterRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostApiAuthRegister(ctx context.Context, body PostApiAuthRegisterJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostApiAuthRegisterRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) DeleteApiAuthSessions(ctx context.Context, params *DeleteApiAuthSessionsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteApiAuthSessionsRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetApiAuthSessions(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetApiAuthSessionsRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) DeleteApiAuthSessionsId(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteApiAuthSessionsIdRequest(c.Server, id)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostApiAuthSigninWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostApiAuthSigninRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostApiAuthSignin(ctx context.Context, body PostApiAuthSigninJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostApiAuthSigninRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetApiCategories(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetApiCategoriesRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetApiCategoriesSlugSuggestExpiration(ctx context.Context, slug string, params *GetApiCategoriesSlugSuggestExpirationParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetApiCategoriesSlugSuggestExpirationRequest(c.Server, slug, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetApiDocuments(ctx context.Context, params *GetApiDocumentsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetApiDocumentsRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostApiDocumentsWithBody(ctx context.Context, params *PostApiDocumentsParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostApiDocumentsRequestWithBody(c.Server, params, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostApiDocuments(ctx context.Context, params *PostApiDocumentsParams, body PostApiDocumentsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostApiDocumentsRequest(c.Server, params, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetApiDocumentsDependencyGraph(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetApiDocumentsDependencyGraphRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostApiDocumentsImportWithBody(ctx context.Context, params *PostApiDocumentsImportParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostApiDocumentsImportRequestWithBody(c.Server, params, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PutApiDocumentsOrderWithBody(ctx context.Context, params *PutApiDocumentsOrderParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPutApiDocumentsOrderRequestWithBody(c.Server, params, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PutApiDocumentsOrder(ctx context.Context, params *PutApiDocumentsOrderParams, body PutApiDocumentsOrderJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPutApiDocumentsOrderRequest(c.Server, params, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetApiDocumentsRenewalCosts(ctx context.Context, params *GetApiDocumentsRenewalCostsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetApiDocumentsRenewalCostsRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetApiDocumentsStats(ctx context.Context, params *GetApiDocumentsStatsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetApiDocumentsStatsRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetApiDocumentsTrash(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetApiDocumentsTrashRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) DeleteApiDocumentsId(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteApiDocumentsIdRequest(c.Server, id)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetApiDocumentsId(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetApiDocumentsIdRequest(c.Server, id)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PutApiDocumentsIdWithBody(ctx context.Context, id openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPutApiDocumentsIdRequestWithBody(c.Server, id, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PutApiDocumentsId(ctx context.Context, id openapi_types.UUID, body PutApiDocumentsIdJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPutApiDocumentsIdRequest(c.Server, id, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) DeleteApiDocumentsIdAssignee(ctx context.Context, id openapi_types.UUID, params *DeleteApiDocumentsIdAssigneeParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteApiDocumentsIdAssigneeRequest(c.Server, id, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetApiDocumentsIdAssignee(ctx context.Context, id openapi_types.UUID, params *GetApiDocumentsIdAssigneeParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetApiDocumentsIdAssigneeRequest(c.Server, id, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PutApiDocumentsIdAssigneeWithBody(ctx context.Context, id openapi_types.UUID, params *PutApiDocumentsIdAssigneeParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPutApiDocumentsIdAssigneeRequestWithBody(c.Server, id, params, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PutApiDocumentsIdAssignee(ctx context.Context, id openapi_types.UUID, params *PutApiDocumentsIdAssigneeParams, body PutApiDocumentsIdAssigneeJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPutApiDocumentsIdAssigneeRequest(c.Server, id, params, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostApiDocumentsIdAssigneeAcknowledge(ctx context.Context, id openapi_types.UUID, params *PostApiDocumentsIdAssigneeAcknowledgeParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostApiDocumentsIdAssigneeAcknowledgeRequest(c.Server, id, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PutApiDocumentsIdAttachmentWithBody(ctx context.Context, id openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPutApiDocumentsIdAttachmentRequestWithBody(c.Server, id, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetApiDocumentsIdChecklist(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetApiDocumentsIdChecklistRequest(c.Server, id)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostApiDocumentsIdChecklistWithBody(ctx context.Context, id openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostApiDocumentsIdChecklistRequestWithBody(c.Server, id, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostApiDocumentsIdChecklist(ctx context.Context, id openapi_types.UUID, body PostApiDocumentsIdChecklistJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostApiDocumentsIdChecklistRequest(c.Server, id, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) DeleteApiDocumentsIdChecklistItemId(ctx context.Context, id openapi_types.UUID, itemId openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteApiDocumentsIdChecklistItemIdRequest(c.Server, id, itemId)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PutApiDocumentsIdChecklistItemIdWithBody(ctx context.Context, id openapi_types.UUID, itemId openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPutApiDocumentsIdChecklistItemIdRequestWithBody(c.Server, id, itemId, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PutApiDocumentsIdChecklistItemId(ctx context.Context, id openapi_types.UUID, itemId openapi_types.UUID, body PutApiDocumentsIdChecklistItemIdJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPutApiDocumentsIdChecklistItemIdRequest(c.Server, id, itemId, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetApiDocumentsIdContacts(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetApiDocumentsIdContactsRequest(c.Server, id)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostApiDocumentsIdContactsWithBody(ctx context.Context, id openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostApiDocumentsIdContactsRequestWithBody(c.Server, id, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostApiDocumentsIdContacts(ctx context.Context, id openapi_types.UUID, body PostApiDocumentsIdContactsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostApiDocumentsIdContactsRequest(c.Server, id, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) DeleteApiDocumentsIdContactsContactId(ctx context.Context, id openapi_types.UUID, contactId openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteApiDocumentsIdContactsContactIdRequest(c.Server, id, contactId)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetApiDocumentsIdDependencies(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetApiDocumentsIdDependenciesRequest(c.Server, id)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostApiDocumentsIdDependenciesWithBody(ctx context.Context, id openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostApiDocumentsIdDependenciesRequestWithBody(c.Server, id, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostApiDocumentsIdDependencies(ctx context.Context, id openapi_types.UUID, body PostApiDocumentsIdDependenciesJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostApiDocumentsIdDependenciesRequest(c.Server, id, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) DeleteApiDocumentsIdDependenciesDependsOnId(ctx context.Context, id openapi_types.UUID, dependsOnId openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteApiDocumentsIdDependenciesDependsOnIdRequest(c.Server, id, dependsOnId)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetApiDocumentsIdLegalHold(ctx context.Context, id openapi_types.UUID, params *GetApiDocumentsIdLegalHoldParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetApiDocumentsIdLegalHoldRequest(c.Server, id, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PutApiDocumentsIdLegalHoldWithBody(ctx context.Context, id openapi_types.UUID, params *PutApiDocumentsIdLegalHoldParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPutApiDocumentsIdLegalHoldRequestWithBody(c.Server, id, params, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PutApiDocumentsIdLegalHold(ctx context.Context, id openapi_types.UUID, params *PutApiDocumentsIdLegalHoldParams, body PutApiDocumentsIdLegalHoldJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPutApiDocumentsIdLegalHoldRequest(c.Server, id, params, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) DeleteApiDocumentsIdLock(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteApiDocumentsIdLockRequest(c.Server, id)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostApiDocumentsIdLockWithBody(ctx context.Context, id openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostApiDocumentsIdLockRequestWithBody(c.Server, id, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostApiDocumentsIdLock(ctx context.Context, id openapi_types.UUID, body PostApiDocumentsIdLockJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostApiDocumentsIdLockRequest(c.Server, id, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) DeleteApiDocumentsIdPin(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteApiDocumentsIdPinRequest(c.Server, id)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostApiDocumentsIdPin(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostApiDocumentsIdPinRequest(c.Server, id)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetApiDocumentsIdReminders(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetApiDocumentsIdRemindersRequest(c.Server, id)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PutApiDocumentsIdRemindersWithBody(ctx context.Context, id openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPutApiDocumentsIdRemindersRequestWithBody(c.Server, id, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PutApiDocumentsIdReminders(ctx context.Context, id openapi_types.UUID, body PutApiDocumentsIdRemindersJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPutApiDocumentsIdRemindersRequest(c.Server, id, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetApiDocumentsIdRemindersPreview(ctx context.Context, id openapi_types.UUID, params *GetApiDocumentsIdRemindersPreviewParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetApiDocumentsIdRemindersPreviewRequest(c.Server, id, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetApiDocumentsIdRenewals(ctx context.Context, id openapi_types.UUID, params *GetApiDocumentsIdRenewalsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetApiDocumentsIdRenewalsRequest(c.Server, id, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostApiDocumentsIdRenewalsWithBody(ctx context.Context, id openapi_types.UUID, params *PostApiDocumentsIdRenewalsParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostApiDocumentsIdRenewalsRequestWithBody(c.Server, id, params, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostApiDocumentsIdRenewalsRenewalIdApproveWithBody(ctx context.Context, id openapi_types.UUID, renewalId openapi_types.UUID, params *PostApiDocumentsIdRenewalsRenewalIdApproveParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostApiDocumentsIdRenewalsRenewalIdApproveRequestWithBody(c.Server, id, renewalId, params, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostApiDocumentsIdRenewalsRenewalIdApprove(ctx context.Context, id openapi_types.UUID, renewalId openapi_types.UUID, params *PostApiDocumentsIdRenewalsRenewalIdApproveParams, body PostApiDocumentsIdRenewalsRenewalIdApproveJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostApiDocumentsIdRenewalsRenewalIdApproveRequest(c.Server, id, renewalId, params, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostApiDocumentsIdRenewalsRenewalIdRejectWithBody(ctx context.Context, id openapi_types.UUID, renewalId openapi_types.UUID, params *PostApiDocumentsIdRenewalsRenewalIdRejectParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostApiDocumentsIdRenewalsRenewalIdRejectRequestWithBody(c.Server, id, renewalId, params, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostApiDocumentsIdRenewalsRenewalIdReject(ctx context.Context, id openapi_types.UUID, renewalId openapi_types.UUID, params *PostApiDocumentsIdRenewalsRenewalIdRejectParams, body PostApiDocumentsIdRenewalsRenewalIdRejectJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostApiDocumentsIdRenewalsRenewalIdRejectRequest(c.Server, id, renewalId, params, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostApiDocumentsIdRestore(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostApiDocumentsIdRestoreRequest(c.Server, id)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) DeleteApiFeed(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteApiFeedRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetApiFeed(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetApiFeedRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostApiFeed(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostApiFeedRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostApiFeedbackWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostApiFeedbackRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostApiFeedback(ctx context.Context, body PostApiFeedbackJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostApiFeedbackRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetApiFeedsTokenAtomXml(ctx context.Context, token string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetApiFeedsTokenAtomXmlRequest(c.Server, token)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetApiFeedsTokenRssXml(ctx context.Context, token string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetApiFeedsTokenRssXmlRequest(c.Server, token)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostApiGraphqlWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostApiGraphqlRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostApiGraphql(ctx context.Context, body PostApiGraphqlJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostApiGraphqlRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetApiHousehold(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetApiHouseholdRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostApiHouseholdWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostApiHouseholdRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostApiHousehold(ctx context.Context, body PostApiHouseholdJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostApiHouseholdRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostApiHouseholdMembersWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostApiHouseholdMembersRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostApiHouseholdMembers(ctx context.Context, body PostApiHouseholdMembersJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostApiHouseholdMembersRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) DeleteApiHouseholdMembersUserId(ctx context.Context, userId openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteApiHouseholdMembersUserIdRequest(c.Server, userId)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PutApiHouseholdMembersUserIdWithBody(ctx context.Context, userId openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPutApiHouseholdMembersUserIdRequestWithBody(c.Server, userId, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PutApiHouseholdMembersUserId(ctx context.Context, userId openapi_types.UUID, body PutApiHouseholdMembersUserIdJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPutApiHouseholdMembersUserIdRequest(c.Server, userId, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetApiHouseholdMembersUserIdDocuments(ctx context.Context, userId openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetApiHouseholdMembersUserIdDocumentsRequest(c.Server, userId)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetApiIntegrationsEgressIps(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetApiIntegrationsEgressIpsRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetApiIssuers(ctx context.Context, params *GetApiIssuersParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetApiIssuersRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetApiJobs(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetApiJobsRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostApiJobsImportWithBody(ctx context.Context, params *PostApiJobsImportParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostApiJobsImportRequestWithBody(c.Server, params, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostApiJobsTakeout(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostApiJobsTakeoutRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetApiJobsId(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetApiJobsIdRequest(c.Server, id)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetApiLinksToken(ctx context.Context, token string, params *GetApiLinksTokenParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetApiLinksTokenRequest(c.Server, token, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostApiLinksToken(ctx context.Context, token string, params *PostApiLinksTokenParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostApiLinksTokenRequest(c.Server, token, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostApiNotificationsRead(ctx context.Context, params *PostApiNotificationsReadParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostApiNotificationsReadRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetApiNotificationsUnreadCount(ctx context.Context, params *GetApiNotificationsUnreadCountParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetApiNotificationsUnreadCountRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) DeleteApiOnboardingSampleData(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteApiOnboardingSampleDataRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostApiOnboardingSampleData(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostApiOnboardingSampleDataRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetApiOrganizations(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetApiOrganizationsRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostApiOrganizationsWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostApiOrganizationsRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostApiOrganizations(ctx context.Context, body PostApiOrganizationsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostApiOrganizationsRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PutApiOrganizationsIdComplianceWithBody(ctx context.Context, id openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPutApiOrganizationsIdComplianceRequestWithBody(c.Server, id, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PutApiOrganizationsIdCompliance(ctx context.Context, id openapi_types.UUID, body PutApiOrganizationsIdComplianceJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPutApiOrganizationsIdComplianceRequest(c.Server, id, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) DeleteApiOrganizationsIdEmailSender(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteApiOrganizationsIdEmailSenderRequest(c.Server, id)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetApiOrganizationsIdEmailSender(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetApiOrganizationsIdEmailSenderRequest(c.Server, id)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PutApiOrganizationsIdEmailSenderWithBody(ctx context.Context, id openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPutApiOrganizationsIdEmailSenderRequestWithBody(c.Server, id, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PutApiOrganizationsIdEmailSender(ctx context.Context, id openapi_types.UUID, body PutApiOrganizationsIdEmailSenderJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPutApiOrganizationsIdEmailSenderRequest(c.Server, id, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostApiOrganizationsIdEmailSenderVerify(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostApiOrganizationsIdEmailSenderVerifyRequest(c.Server, id)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetApiOrganizationsIdEscalationPolicy(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetApiOrganizationsIdEscalationPolicyRequest(c.Server, id)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PutApiOrganizationsIdEscalationPolicyWithBody(ctx context.Context, id openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPutApiOrganizationsIdEscalationPolicyRequestWithBody(c.Server, id, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PutApiOrganizationsIdEscalationPolicy(ctx context.Context, id openapi_types.UUID, body PutApiOrganizationsIdEscalationPolicyJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPutApiOrganizationsIdEscalationPolicyRequest(c.Server, id, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetApiOrganizationsIdEventLogExport(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetApiOrganizationsIdEventLogExportRequest(c.Server, id)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetApiOrganizationsIdLegalHold(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetApiOrganizationsIdLegalHoldRequest(c.Server, id)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PutApiOrganizationsIdLegalHoldWithBody(ctx context.Context, id openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPutApiOrganizationsIdLegalHoldRequestWithBody(c.Server, id, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PutApiOrganizationsIdLegalHold(ctx context.Context, id openapi_types.UUID, body PutApiOrganizationsIdLegalHoldJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPutApiOrganizationsIdLegalHoldRequest(c.Server, id, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetApiOrganizationsIdMembers(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetApiOrganizationsIdMembersRequest(c.Server, id)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostApiOrganizationsIdMembersWithBody(ctx context.Context, id openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostApiOrganizationsIdMembersRequestWithBody(c.Server, id, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostApiOrganizationsIdMembers(ctx context.Context, id openapi_types.UUID, body PostApiOrganizationsIdMembersJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostApiOrganizationsIdMembersRequest(c.Server, id, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) DeleteApiOrganizationsIdMembersUserId(ctx context.Context, id openapi_types.UUID, userId openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteApiOrganizationsIdMembersUserIdRequest(c.Server, id, userId)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) DeleteApiOrganizationsIdNotificationTheme(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteApiOrganizationsIdNotificationThemeRequest(c.Server, id)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetApiOrganizationsIdNotificationTheme(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetApiOrganizationsIdNotificationThemeRequest(c.Server, id)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PutApiOrganizationsIdNotificationThemeWithBody(ctx context.Context, id openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPutApiOrganizationsIdNotificationThemeRequestWithBody(c.Server, id, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PutApiOrganizationsIdNotificationTheme(ctx context.Context, id openapi_types.UUID, body PutApiOrganizationsIdNotificationThemeJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPutApiOrganizationsIdNotificationThemeRequest(c.Server, id, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PutApiOrganizationsIdRenewalApprovalWithBody(ctx context.Context, id openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPutApiOrganizationsIdRenewalApprovalRequestWithBody(c.Server, id, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PutApiOrganizationsIdRenewalApproval(ctx context.Context, id openapi_types.UUID, body PutApiOrganizationsIdRenewalApprovalJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPutApiOrganizationsIdRenewalApprovalRequest(c.Server, id, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetApiOrganizationsIdRetention(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetApiOrganizationsIdRetentionRequest(c.Server, id)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PutApiOrganizationsIdRetentionWithBody(ctx context.Context, id openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPutApiOrganizationsIdRetentionRequestWithBody(c.Server, id, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PutApiOrganizationsIdRetention(ctx context.Context, id openapi_types.UUID, body PutApiOrganizationsIdRetentionJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPutApiOrganizationsIdRetentionRequest(c.Server, id, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) DeleteApiOrganizationsIdScimToken(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteApiOrganizationsIdScimTokenRequest(c.Server, id)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostApiOrganizationsIdScimToken(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostApiOrganizationsIdScimTokenRequest(c.Server, id)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetApiOrganizationsIdServiceAccounts(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetApiOrganizationsIdServiceAccountsRequest(c.Server, id)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostApiOrganizationsIdServiceAccountsWithBody(ctx context.Context, id openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostApiOrganizationsIdServiceAccountsRequestWithBody(c.Server, id, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostApiOrganizationsIdServiceAccounts(ctx context.Context, id openapi_types.UUID, body PostApiOrganizationsIdServiceAccountsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostApiOrganizationsIdServiceAccountsRequest(c.Server, id, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) DeleteApiOrganizationsIdServiceAccountsAccountIdToken(ctx context.Context, id openapi_types.UUID, accountId openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteApiOrganizationsIdServiceAccountsAccountIdTokenRequest(c.Server, id, accountId)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostApiOrganizationsIdServiceAccountsAccountIdToken(ctx context.Context, id openapi_types.UUID, accountId openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostApiOrganizationsIdServiceAccountsAccountIdTokenRequest(c.Server, id, accountId)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetApiOrganizationsIdUsageExport(ctx context.Context, id openapi_types.UUID, params *GetApiOrganizationsIdUsageExportParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetApiOrganizationsIdUsageExportRequest(c.Server, id, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetApiPreferencesLocale(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetApiPreferencesLocaleRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PutApiPreferencesLocaleWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPutApiPreferencesLocaleRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PutApiPreferencesLocale(ctx context.Context, body PutApiPreferencesLocaleJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPutApiPreferencesLocaleRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetApiPreferencesNotifications(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetApiPreferencesNotificationsRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PutApiPreferencesNotificationsWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPutApiPreferencesNotificationsRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PutApiPreferencesNotifications(ctx context.Context, body PutApiPreferencesNotificationsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPutApiPreferencesNotificationsRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetApiRoadmap(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetApiRoadmapRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) DeleteApiRoadmapIdVote(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteApiRoadmapIdVoteRequest(c.Server, id)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostApiRoadmapIdVote(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostApiRoadmapIdVoteRequest(c.Server, id)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetApiTemplates(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetApiTemplatesRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetApiTrackOpenMessageId(ctx context.Context, messageId openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetApiTrackOpenMessageIdRequest(c.Server, messageId)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetApiUnsubscribeToken(ctx context.Context, token string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetApiUnsubscribeTokenRequest(c.Server, token)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostApiUnsubscribeToken(ctx context.Context, token string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostApiUnsubscribeTokenRequest(c.Server, token)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetApiUsage(ctx context.Context, params *GetApiUsageParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetApiUsageRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetApiViews(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetApiViewsRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostApiViewsWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostApiViewsRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostApiViews(ctx context.Context, body PostApiViewsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostApiViewsRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) DeleteApiViewsId(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteApiViewsIdRequest(c.Server, id)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetApiViewsId(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetApiViewsIdRequest(c.Server, id)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PutApiViewsIdWithBody(ctx context.Context, id openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPutApiViewsIdRequestWithBody(c.Server, id, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PutApiViewsId(ctx context.Context, id openapi_types.UUID, body PutApiViewsIdJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPutApiViewsIdRequest(c.Server, id, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetApiViewsIdDocuments(ctx context.Context, id openapi_types.UUID, params *GetApiViewsIdDocumentsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetApiViewsIdDocumentsRequest(c.Server, id, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostApiWebhooksEmailBounceWithBody(ctx context.Context, params *PostApiWebhooksEmailBounceParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostApiWebhooksEmailBounceRequestWithBody(c.Server, params, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostApiWebhooksEmailBounce(ctx context.Context, params *PostApiWebhooksEmailBounceParams, body PostApiWebhooksEmailBounceJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostApiWebhooksEmailBounceRequest(c.Server, params, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetApiWebhooksEndpoints(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetApiWebhooksEndpointsRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostApiWebhooksEndpointsWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostApiWebhooksEndpointsRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostApiWebhooksEndpoints(ctx context.Context, body PostApiWebhooksEndpointsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostApiWebhooksEndpointsRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) DeleteApiWebhooksEndpointsId(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteApiWebhooksEndpointsIdRequest(c.Server, id)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PutApiWebhooksEndpointsIdWithBody(ctx context.Context, id openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPutApiWebhooksEndpointsIdRequestWithBody(c.Server, id, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PutApiWebhooksEndpointsId(ctx context.Context, id openapi_types.UUID, body PutApiWebhooksEndpointsIdJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPutApiWebhooksEndpointsIdRequest(c.Server, id, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetApiWebhooksEndpointsIdDeliveries(ctx context.Context, id openapi_types.UUID, params *GetApiWebhooksEndpointsIdDeliveriesParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetApiWebhooksEndpointsIdDeliveriesRequest(c.Server, id, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostApiWebhooksEndpointsIdDeliveriesDeliveryIdRedeliver(ctx context.Context, id openapi_types.UUID, deliveryId openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostApiWebhooksEndpointsIdDeliveriesDeliveryIdRedeliverRequest(c.Server, id, deliveryId)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostApiWebhooksEndpointsIdRotateSecretWithBody(ctx context.Context, id openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostApiWebhooksEndpointsIdRotateSecretRequestWithBody(c.Server, id, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostApiWebhooksEndpointsIdRotateSecret(ctx context.Context, id openapi_types.UUID, body PostApiWebhooksEndpointsIdRotateSecretJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostApiWebhooksEndpointsIdRotateSecretRequest(c.Server, id, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostApiWebhooksTwilioSmsWithBody(ctx context.Context, params *PostApiWebhooksTwilioSmsParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostApiWebhooksTwilioSmsRequestWithBody(c.Server, params, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostApiWebhooksTwilioSmsWithFormdataBody(ctx context.Context, params *PostApiWebhooksTwilioSmsParams, body PostApiWebhooksTwilioSmsFormdataRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostApiWebhooksTwilioSmsRequestWithFormdataBody(c.Server, params, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetHealth(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetHealthRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetReady(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetReadyRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetReminderIntervals(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetReminderIntervalsRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetScimV2ServiceProviderConfig(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetScimV2ServiceProviderConfigRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetScimV2Users(ctx context.Context, params *GetScimV2UsersParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetScimV2UsersRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostScimV2UsersWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostScimV2UsersRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostScimV2UsersWithApplicationScimPlusJSONBody(ctx context.Context, body PostScimV2UsersApplicationScimPlusJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostScimV2UsersRequestWithApplicationScimPlusJSONBody(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) DeleteScimV2UsersUserId(ctx context.Context, userId openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteScimV2UsersUserIdRequest(c.Server, userId)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetScimV2UsersUserId(ctx context.Context, userId openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetScimV2UsersUserIdRequest(c.Server, userId)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PatchScimV2UsersUserIdWithBody(ctx context.Context, userId openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPatchScimV2UsersUserIdRequestWithBody(c.Server, userId, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PatchScimV2UsersUserIdWithApplicationScimPlusJSONBody(ctx context.Context, userId openapi_types.UUID, body PatchScimV2UsersUserIdApplicationScimPlusJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPatchScimV2UsersUserIdRequestWithApplicationScimPlusJSONBody(c.Server, userId, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PutScimV2UsersUserIdWithBody(ctx context.Context, userId openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPutScimV2UsersUserIdRequestWithBody(c.Server, userId, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PutScimV2UsersUserIdWithApplicationScimPlusJSONBody(ctx context.Context, userId openapi_types.UUID, body PutScimV2UsersUserIdApplicationScimPlusJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPutScimV2UsersUserIdRequestWithApplicationScimPlusJSONBody(c.Server, userId, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+// NewGetWellKnownJwksJsonRequest generates requests for GetWellKnownJwksJson
+func NewGetWellKnownJwksJsonRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/.well-known/jwks.json")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetApiAdminAnnouncementsRequest generates requests for GetApiAdminAnnouncements
+func NewGetApiAdminAnnouncementsRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/admin/announcements")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPostApiAdminAnnouncementsRequest calls the generic PostApiAdminAnnouncements builder with application/json body
+func NewPostApiAdminAnnouncementsRequest(server string, body PostApiAdminAnnouncementsJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostApiAdminAnnouncementsRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewPostApiAdminAnnouncementsRequestWithBody generates requests for PostApiAdminAnnouncements with any type of body
+func NewPostApiAdminAnnouncementsRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/admin/announcements")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewDeleteApiAdminAnnouncementsIdRequest generates requests for DeleteApiAdminAnnouncementsId
+func NewDeleteApiAdminAnnouncementsIdRequest(server string, id openapi_types.UUID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/admin/announcements/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetApiAdminDeprecationsRequest generates requests for GetApiAdminDeprecations
+func NewGetApiAdminDeprecationsRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/admin/deprecations")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetApiAdminHealthRequest generates requests for GetApiAdminHealth
+func NewGetApiAdminHealthRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/admin/health")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPostApiAdminImpersonateRequest calls the generic PostApiAdminImpersonate builder with application/json body
+func NewPostApiAdminImpersonateRequest(server string, body PostApiAdminImpersonateJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostApiAdminImpersonateRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewPostApiAdminImpersonateRequestWithBody generates requests for PostApiAdminImpersonate with any type of body
+func NewPostApiAdminImpersonateRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/admin/impersonate")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewPostApiAdminIssuersRequest calls the generic PostApiAdminIssuers builder with application/json body
+func NewPostApiAdminIssuersRequest(server string, body PostApiAdminIssuersJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostApiAdminIssuersRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewPostApiAdminIssuersRequestWithBody generates requests for PostApiAdminIssuers with any type of body
+func NewPostApiAdminIssuersRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/admin/issuers")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewDeleteApiAdminIssuersIdRequest generates requests for DeleteApiAdminIssuersId
+func NewDeleteApiAdminIssuersIdRequest(server string, id openapi_types.UUID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/admin/issuers/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPutApiAdminIssuersIdRequest calls the generic PutApiAdminIssuersId builder with application/json body
+func NewPutApiAdminIssuersIdRequest(server string, id openapi_types.UUID, body PutApiAdminIssuersIdJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPutApiAdminIssuersIdRequestWithBody(server, id, "application/json", bodyReader)
+}
+
+// NewPutApiAdminIssuersIdRequestWithBody generates requests for PutApiAdminIssuersId with any type of body
+func NewPutApiAdminIssuersIdRequestWithBody(server string, id openapi_types.UUID, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/admin/issuers/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewGetApiAdminLoadSheddingRequest generates requests for GetApiAdminLoadShedding
+func NewGetApiAdminLoadSheddingRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/admin/load-shedding")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetApiAdminNotificationProvidersRequest generates requests for GetApiAdminNotificationProviders
+func NewGetApiAdminNotificationProvidersRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/admin/notification-providers")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPostApiAdminRoadmapRequest calls the generic PostApiAdminRoadmap builder with application/json body
+func NewPostApiAdminRoadmapRequest(server string, body PostApiAdminRoadmapJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostApiAdminRoadmapRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewPostApiAdminRoadmapRequestWithBody generates requests for PostApiAdminRoadmap with any type of body
+func NewPostApiAdminRoadmapRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/admin/roadmap")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewDeleteApiAdminRoadmapIdRequest generates requests for DeleteApiAdminRoadmapId
+func NewDeleteApiAdminRoadmapIdRequest(server string, id openapi_types.UUID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/admin/roadmap/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPutApiAdminRoadmapIdRequest calls the generic PutApiAdminRoadmapId builder with application/json body
+func NewPutApiAdminRoadmapIdRequest(server string, id openapi_types.UUID, body PutApiAdminRoadmapIdJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPutApiAdminRoadmapIdRequestWithBody(server, id, "application/json", bodyReader)
+}
+
+// NewPutApiAdminRoadmapIdRequestWithBody generates requests for PutApiAdminRoadmapId with any type of body
+func NewPutApiAdminRoadmapIdRequestWithBody(server string, id openapi_types.UUID, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/admin/roadmap/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewPostApiAdminScheduledJobsNameRunRequest generates requests for PostApiAdminScheduledJobsNameRun
+func NewPostApiAdminScheduledJobsNameRunRequest(server string, name PostApiAdminScheduledJobsNameRunParamsName) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "name", runtime.ParamLocationPath, name)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/admin/scheduled-jobs/%s/run", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetApiAdminTasksFailedRequest generates requests for GetApiAdminTasksFailed
+func NewGetApiAdminTasksFailedRequest(server string, params *GetApiAdminTasksFailedParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/admin/tasks/failed")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Limit != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPostApiAdminTasksQueueTaskIdRequeueRequest generates requests for PostApiAdminTasksQueueTaskIdRequeue
+func NewPostApiAdminTasksQueueTaskIdRequeueRequest(server string, queue string, taskId string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "queue", runtime.ParamLocationPath, queue)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "taskId", runtime.ParamLocationPath, taskId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/admin/tasks/%s/%s/requeue", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetApiAdminUsageExportRequest generates requests for GetApiAdminUsageExport
+func NewGetApiAdminUsageExportRequest(server string, params *GetApiAdminUsageExportParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/admin/usage/export")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.From != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "from", runtime.ParamLocationQuery, *params.From); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.To != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "to", runtime.ParamLocationQuery, *params.To); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Format != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "format", runtime.ParamLocationQuery, *params.Format); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPutApiAdminUsersIdPlanRequest calls the generic PutApiAdminUsersIdPlan builder with application/json body
+func NewPutApiAdminUsersIdPlanRequest(server string, id openapi_types.UUID, body PutApiAdminUsersIdPlanJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPutApiAdminUsersIdPlanRequestWithBody(server, id, "application/json", bodyReader)
+}
+
+// NewPutApiAdminUsersIdPlanRequestWithBody generates requests for PutApiAdminUsersIdPlan with any type of body
+func NewPutApiAdminUsersIdPlanRequestWithBody(server string, id openapi_types.UUID, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/admin/users/%s/plan", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewPostApiAdminUsersIdReinstateRequest generates requests for PostApiAdminUsersIdReinstate
+func NewPostApiAdminUsersIdReinstateRequest(server string, id openapi_types.UUID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/admin/users/%s/reinstate", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPutApiAdminUsersIdRoleRequest calls the generic PutApiAdminUsersIdRole builder with application/json body
+func NewPutApiAdminUsersIdRoleRequest(server string, id openapi_types.UUID, body PutApiAdminUsersIdRoleJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPutApiAdminUsersIdRoleRequestWithBody(server, id, "application/json", bodyReader)
+}
+
+// NewPutApiAdminUsersIdRoleRequestWithBody generates requests for PutApiAdminUsersIdRole with any type of body
+func NewPutApiAdminUsersIdRoleRequestWithBody(server string, id openapi_types.UUID, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/admin/users/%s/role", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewPostApiAdminUsersIdSuspendRequest calls the generic PostApiAdminUsersIdSuspend builder with application/json body
+func NewPostApiAdminUsersIdSuspendRequest(server string, id openapi_types.UUID, body PostApiAdminUsersIdSuspendJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostApiAdminUsersIdSuspendRequestWithBody(server, id, "application/json", bodyReader)
+}
+
+// NewPostApiAdminUsersIdSuspendRequestWithBody generates requests for PostApiAdminUsersIdSuspend with any type of body
+func NewPostApiAdminUsersIdSuspendRequestWithBody(server string, id openapi_types.UUID, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/admin/users/%s/suspend", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewGetApiAnnouncementsRequest generates requests for GetApiAnnouncements
+func NewGetApiAnnouncementsRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/announcements")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetApiApiKeysRequest generates requests for GetApiApiKeys
+func NewGetApiApiKeysRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/api-keys")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPostApiApiKeysRequest calls the generic PostApiApiKeys builder with application/json body
+func NewPostApiApiKeysRequest(server string, body PostApiApiKeysJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostApiApiKeysRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewPostApiApiKeysRequestWithBody generates requests for PostApiApiKeys with any type of body
+func NewPostApiApiKeysRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/api-keys")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewDeleteApiApiKeysIdRequest generates requests for DeleteApiApiKeysId
+func NewDeleteApiApiKeysIdRequest(server string, id openapi_types.UUID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/api-keys/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPutApiAuthEmailRequest calls the generic PutApiAuthEmail builder with application/json body
+func NewPutApiAuthEmailRequest(server string, body PutApiAuthEmailJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPutApiAuthEmailRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewPutApiAuthEmailRequestWithBody generates requests for PutApiAuthEmail with any type of body
+func NewPutApiAuthEmailRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/auth/email")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewGetApiAuthEmailConfirmTokenRequest generates requests for GetApiAuthEmailConfirmToken
+func NewGetApiAuthEmailConfirmTokenRequest(server string, token string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "token", runtime.ParamLocationPath, token)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/auth/email/confirm/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPostApiAuthEmailConfirmTokenRequest generates requests for PostApiAuthEmailConfirmToken
+func NewPostApiAuthEmailConfirmTokenRequest(server string, token string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "token", runtime.ParamLocationPath, token)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/auth/email/confirm/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPostApiAuthLogoutRequest generates requests for PostApiAuthLogout
+func NewPostApiAuthLogoutRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/auth/logout")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewDeleteApiAuthMeRequest calls the generic DeleteApiAuthMe builder with application/json body
+func NewDeleteApiAuthMeRequest(server string, body DeleteApiAuthMeJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewDeleteApiAuthMeRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewDeleteApiAuthMeRequestWithBody generates requests for DeleteApiAuthMe with any type of body
+func NewDeleteApiAuthMeRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/auth/me")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewGetApiAuthMeRequest generates requests for GetApiAuthMe
+func NewGetApiAuthMeRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/auth/me")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetApiAuthOauthProviderRequest generates requests for GetApiAuthOauthProvider
+func NewGetApiAuthOauthProviderRequest(server string, provider GetApiAuthOauthProviderParamsProvider) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "provider", runtime.ParamLocationPath, provider)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/auth/oauth/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetApiAuthOauthProviderCallbackRequest generates requests for GetApiAuthOauthProviderCallback
+func NewGetApiAuthOauthProviderCallbackRequest(server string, provider GetApiAuthOauthProviderCallbackParamsProvider, params *GetApiAuthOauthProviderCallbackParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "provider", runtime.ParamLocationPath, provider)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/auth/oauth/%s/callback", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Code != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "code", runtime.ParamLocationQuery, *params.Code); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.State != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "state", runtime.ParamLocationQuery, *params.State); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPostApiAuthRegisterRequest calls the generic PostApiAuthRegister builder with application/json body
+func NewPostApiAuthRegisterRequest(server string, body PostApiAuthRegisterJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostApiAuthRegisterRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewPostApiAuthRegisterRequestWithBody generates requests for PostApiAuthRegister with any type of body
+func NewPostApiAuthRegisterRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/auth/register")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewDeleteApiAuthSessionsRequest generates requests for DeleteApiAuthSessions
+func NewDeleteApiAuthSessionsRequest(server string, params *DeleteApiAuthSessionsParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/auth/sessions")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.KeepCurrent != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "keepCurrent", runtime.ParamLocationQuery, *params.KeepCurrent); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetApiAuthSessionsRequest generates requests for GetApiAuthSessions
+func NewGetApiAuthSessionsRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/auth/sessions")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewDeleteApiAuthSessionsIdRequest generates requests for DeleteApiAuthSessionsId
+func NewDeleteApiAuthSessionsIdRequest(server string, id openapi_types.UUID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/auth/sessions/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPostApiAuthSigninRequest calls the generic PostApiAuthSignin builder with application/json body
+func NewPostApiAuthSigninRequest(server string, body PostApiAuthSigninJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostApiAuthSigninRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewPostApiAuthSigninRequestWithBody generates requests for PostApiAuthSignin with any type of body
+func NewPostApiAuthSigninRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/auth/signin")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewGetApiCategoriesRequest generates requests for GetApiCategories
+func NewGetApiCategoriesRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/categories")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetApiCategoriesSlugSuggestExpirationRequest generates requests for GetApiCategoriesSlugSuggestExpiration
+func NewGetApiCategoriesSlugSuggestExpirationRequest(server string, slug string, params *GetApiCategoriesSlugSuggestExpirationParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "slug", runtime.ParamLocationPath, slug)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/categories/%s/suggest-expiration", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.IssueDate != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "issueDate", runtime.ParamLocationQuery, *params.IssueDate); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Country != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "country", runtime.ParamLocationQuery, *params.Country); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetApiDocumentsRequest generates requests for GetApiDocuments
+func NewGetApiDocumentsRequest(server string, params *GetApiDocumentsParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/documents")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Identifier != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "identifier", runtime.ParamLocationQuery, *params.Identifier); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Sort != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "sort", runtime.ParamLocationQuery, *params.Sort); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+
+		if params.XOrganizationID != nil {
+			var headerParam0 string
+
+			headerParam0, err = runtime.StyleParamWithLocation("simple", false, "X-Organization-ID", runtime.ParamLocationHeader, *params.XOrganizationID)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("X-Organization-ID", headerParam0)
+		}
+
+	}
+
+	return req, nil
+}
+
+// NewPostApiDocumentsRequest calls the generic PostApiDocuments builder with application/json body
+func NewPostApiDocumentsRequest(server string, params *PostApiDocumentsParams, body PostApiDocumentsJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostApiDocumentsRequestWithBody(server, params, "application/json", bodyReader)
+}
+
+// NewPostApiDocumentsRequestWithBody generates requests for PostApiDocuments with any type of body
+func NewPostApiDocumentsRequestWithBody(server string, params *PostApiDocumentsParams, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/documents")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Force != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "force", runtime.ParamLocationQuery, *params.Force); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewGetApiDocumentsDependencyGraphRequest generates requests for GetApiDocumentsDependencyGraph
+func NewGetApiDocumentsDependencyGraphRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/documents/dependency-graph")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPostApiDocumentsImportRequestWithBody generates requests for PostApiDocumentsImport with any type of body
+func NewPostApiDocumentsImportRequestWithBody(server string, params *PostApiDocumentsImportParams, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/documents/import")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "source", runtime.ParamLocationQuery, params.Source); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+		if params.Timezone != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "timezone", runtime.ParamLocationQuery, *params.Timezone); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Reminders != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "reminders", runtime.ParamLocationQuery, *params.Reminders); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewPutApiDocumentsOrderRequest calls the generic PutApiDocumentsOrder builder with application/json body
+func NewPutApiDocumentsOrderRequest(server string, params *PutApiDocumentsOrderParams, body PutApiDocumentsOrderJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPutApiDocumentsOrderRequestWithBody(server, params, "application/json", bodyReader)
+}
+
+// NewPutApiDocumentsOrderRequestWithBody generates requests for PutApiDocumentsOrder with any type of body
+func NewPutApiDocumentsOrderRequestWithBody(server string, params *PutApiDocumentsOrderParams, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/documents/order")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	if params != nil {
+
+		if params.XOrganizationID != nil {
+			var headerParam0 string
+
+			headerParam0, err = runtime.StyleParamWithLocation("simple", false, "X-Organization-ID", runtime.ParamLocationHeader, *params.XOrganizationID)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("X-Organization-ID", headerParam0)
+		}
+
+	}
+
+	return req, nil
+}
+
+// NewGetApiDocumentsRenewalCostsRequest generates requests for GetApiDocumentsRenewalCosts
+func NewGetApiDocumentsRenewalCostsRequest(server string, params *GetApiDocumentsRenewalCostsParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/documents/renewal-costs")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Months != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "months", runtime.ParamLocationQuery, *params.Months); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Locale != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "locale", runtime.ParamLocationQuery, *params.Locale); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+
+		if params.XOrganizationID != nil {
+			var headerParam0 string
+
+			headerParam0, err = runtime.StyleParamWithLocation("simple", false, "X-Organization-ID", runtime.ParamLocationHeader, *params.XOrganizationID)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("X-Organization-ID", headerParam0)
+		}
+
+	}
+
+	return req, nil
+}
+
+// NewGetApiDocumentsStatsRequest generates requests for GetApiDocumentsStats
+func NewGetApiDocumentsStatsRequest(server string, params *GetApiDocumentsStatsParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/documents/stats")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+
+		if params.XOrganizationID != nil {
+			var headerParam0 string
+
+			headerParam0, err = runtime.StyleParamWithLocation("simple", false, "X-Organization-ID", runtime.ParamLocationHeader, *params.XOrganizationID)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("X-Organization-ID", headerParam0)
+		}
+
+	}
+
+	return req, nil
+}
+
+// NewGetApiDocumentsTrashRequest generates requests for GetApiDocumentsTrash
+func NewGetApiDocumentsTrashRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/documents/trash")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewDeleteApiDocumentsIdRequest generates requests for DeleteApiDocumentsId
+func NewDeleteApiDocumentsIdRequest(server string, id openapi_types.UUID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/documents/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetApiDocumentsIdRequest generates requests for GetApiDocumentsId
+func NewGetApiDocumentsIdRequest(server string, id openapi_types.UUID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/documents/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPutApiDocumentsIdRequest calls the generic PutApiDocumentsId builder with application/json body
+func NewPutApiDocumentsIdRequest(server string, id openapi_types.UUID, body PutApiDocumentsIdJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPutApiDocumentsIdRequestWithBody(server, id, "application/json", bodyReader)
+}
+
+// NewPutApiDocumentsIdRequestWithBody generates requests for PutApiDocumentsId with any type of body
+func NewPutApiDocumentsIdRequestWithBody(server string, id openapi_types.UUID, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/documents/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewDeleteApiDocumentsIdAssigneeRequest generates requests for DeleteApiDocumentsIdAssignee
+func NewDeleteApiDocumentsIdAssigneeRequest(server string, id openapi_types.UUID, params *DeleteApiDocumentsIdAssigneeParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/documents/%s/assignee", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+
+		if params.XOrganizationID != nil {
+			var headerParam0 string
+
+			headerParam0, err = runtime.StyleParamWithLocation("simple", false, "X-Organization-ID", runtime.ParamLocationHeader, *params.XOrganizationID)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("X-Organization-ID", headerParam0)
+		}
+
+	}
+
+	return req, nil
+}
+
+// NewGetApiDocumentsIdAssigneeRequest generates requests for GetApiDocumentsIdAssignee
+func NewGetApiDocumentsIdAssigneeRequest(server string, id openapi_types.UUID, params *GetApiDocumentsIdAssigneeParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/documents/%s/assignee", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+
+		if params.XOrganizationID != nil {
+			var headerParam0 string
+
+			headerParam0, err = runtime.StyleParamWithLocation("simple", false, "X-Organization-ID", runtime.ParamLocationHeader, *params.XOrganizationID)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("X-Organization-ID", headerParam0)
+		}
+
+	}
+
+	return req, nil
+}
+
+// NewPutApiDocumentsIdAssigneeRequest calls the generic PutApiDocumentsIdAssignee builder with application/json body
+func NewPutApiDocumentsIdAssigneeRequest(server string, id openapi_types.UUID, params *PutApiDocumentsIdAssigneeParams, body PutApiDocumentsIdAssigneeJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPutApiDocumentsIdAssigneeRequestWithBody(server, id, params, "application/json", bodyReader)
+}
+
+// NewPutApiDocumentsIdAssigneeRequestWithBody generates requests for PutApiDocumentsIdAssignee with any type of body
+func NewPutApiDocumentsIdAssigneeRequestWithBody(server string, id openapi_types.UUID, params *PutApiDocumentsIdAssigneeParams, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/documents/%s/assignee", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	if params != nil {
+
+		if params.XOrganizationID != nil {
+			var headerParam0 string
+
+			headerParam0, err = runtime.StyleParamWithLocation("simple", false, "X-Organization-ID", runtime.ParamLocationHeader, *params.XOrganizationID)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("X-Organization-ID", headerParam0)
+		}
+
+	}
+
+	return req, nil
+}
+
+// NewPostApiDocumentsIdAssigneeAcknowledgeRequest generates requests for PostApiDocumentsIdAssigneeAcknowledge
+func NewPostApiDocumentsIdAssigneeAcknowledgeRequest(server string, id openapi_types.UUID, params *PostApiDocumentsIdAssigneeAcknowledgeParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/documents/%s/assignee/acknowledge", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+
+		if params.XOrganizationID != nil {
+			var headerParam0 string
+
+			headerParam0, err = runtime.StyleParamWithLocation("simple", false, "X-Organization-ID", runtime.ParamLocationHeader, *params.XOrganizationID)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("X-Organization-ID", headerParam0)
+		}
+
+	}
+
+	return req, nil
+}
+
+// NewPutApiDocumentsIdAttachmentRequestWithBody generates requests for PutApiDocumentsIdAttachment with any type of body
+func NewPutApiDocumentsIdAttachmentRequestWithBody(server string, id openapi_types.UUID, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/documents/%s/attachment", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewGetApiDocumentsIdChecklistRequest generates requests for GetApiDocumentsIdChecklist
+func NewGetApiDocumentsIdChecklistRequest(server string, id openapi_types.UUID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/documents/%s/checklist", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPostApiDocumentsIdChecklistRequest calls the generic PostApiDocumentsIdChecklist builder with application/json body
+func NewPostApiDocumentsIdChecklistRequest(server string, id openapi_types.UUID, body PostApiDocumentsIdChecklistJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostApiDocumentsIdChecklistRequestWithBody(server, id, "application/json", bodyReader)
+}
+
+// NewPostApiDocumentsIdChecklistRequestWithBody generates requests for PostApiDocumentsIdChecklist with any type of body
+func NewPostApiDocumentsIdChecklistRequestWithBody(server string, id openapi_types.UUID, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/documents/%s/checklist", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewDeleteApiDocumentsIdChecklistItemIdRequest generates requests for DeleteApiDocumentsIdChecklistItemId
+func NewDeleteApiDocumentsIdChecklistItemIdRequest(server string, id openapi_types.UUID, itemId openapi_types.UUID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "itemId", runtime.ParamLocationPath, itemId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/documents/%s/checklist/%s", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPutApiDocumentsIdChecklistItemIdRequest calls the generic PutApiDocumentsIdChecklistItemId builder with application/json body
+func NewPutApiDocumentsIdChecklistItemIdRequest(server string, id openapi_types.UUID, itemId openapi_types.UUID, body PutApiDocumentsIdChecklistItemIdJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPutApiDocumentsIdChecklistItemIdRequestWithBody(server, id, itemId, "application/json", bodyReader)
+}
+
+// NewPutApiDocumentsIdChecklistItemIdRequestWithBody generates requests for PutApiDocumentsIdChecklistItemId with any type of body
+func NewPutApiDocumentsIdChecklistItemIdRequestWithBody(server string, id openapi_types.UUID, itemId openapi_types.UUID, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "itemId", runtime.ParamLocationPath, itemId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/documents/%s/checklist/%s", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewGetApiDocumentsIdContactsRequest generates requests for GetApiDocumentsIdContacts
+func NewGetApiDocumentsIdContactsRequest(server string, id openapi_types.UUID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/documents/%s/contacts", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPostApiDocumentsIdContactsRequest calls the generic PostApiDocumentsIdContacts builder with application/json body
+func NewPostApiDocumentsIdContactsRequest(server string, id openapi_types.UUID, body PostApiDocumentsIdContactsJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostApiDocumentsIdContactsRequestWithBody(server, id, "application/json", bodyReader)
+}
+
+// NewPostApiDocumentsIdContactsRequestWithBody generates requests for PostApiDocumentsIdContacts with any type of body
+func NewPostApiDocumentsIdContactsRequestWithBody(server string, id openapi_types.UUID, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/documents/%s/contacts", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewDeleteApiDocumentsIdContactsContactIdRequest generates requests for DeleteApiDocumentsIdContactsContactId
+func NewDeleteApiDocumentsIdContactsContactIdRequest(server string, id openapi_types.UUID, contactId openapi_types.UUID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "contactId", runtime.ParamLocationPath, contactId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/documents/%s/contacts/%s", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetApiDocumentsIdDependenciesRequest generates requests for GetApiDocumentsIdDependencies
+func NewGetApiDocumentsIdDependenciesRequest(server string, id openapi_types.UUID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/documents/%s/dependencies", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPostApiDocumentsIdDependenciesRequest calls the generic PostApiDocumentsIdDependencies builder with application/json body
+func NewPostApiDocumentsIdDependenciesRequest(server string, id openapi_types.UUID, body PostApiDocumentsIdDependenciesJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostApiDocumentsIdDependenciesRequestWithBody(server, id, "application/json", bodyReader)
+}
+
+// NewPostApiDocumentsIdDependenciesRequestWithBody generates requests for PostApiDocumentsIdDependencies with any type of body
+func NewPostApiDocumentsIdDependenciesRequestWithBody(server string, id openapi_types.UUID, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/documents/%s/dependencies", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewDeleteApiDocumentsIdDependenciesDependsOnIdRequest generates requests for DeleteApiDocumentsIdDependenciesDependsOnId
+func NewDeleteApiDocumentsIdDependenciesDependsOnIdRequest(server string, id openapi_types.UUID, dependsOnId openapi_types.UUID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "dependsOnId", runtime.ParamLocationPath, dependsOnId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/documents/%s/dependencies/%s", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetApiDocumentsIdLegalHoldRequest generates requests for GetApiDocumentsIdLegalHold
+func NewGetApiDocumentsIdLegalHoldRequest(server string, id openapi_types.UUID, params *GetApiDocumentsIdLegalHoldParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/documents/%s/legal-hold", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+
+		if params.XOrganizationID != nil {
+			var headerParam0 string
+
+			headerParam0, err = runtime.StyleParamWithLocation("simple", false, "X-Organization-ID", runtime.ParamLocationHeader, *params.XOrganizationID)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("X-Organization-ID", headerParam0)
+		}
+
+	}
+
+	return req, nil
+}
+
+// NewPutApiDocumentsIdLegalHoldRequest calls the generic PutApiDocumentsIdLegalHold builder with application/json body
+func NewPutApiDocumentsIdLegalHoldRequest(server string, id openapi_types.UUID, params *PutApiDocumentsIdLegalHoldParams, body PutApiDocumentsIdLegalHoldJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPutApiDocumentsIdLegalHoldRequestWithBody(server, id, params, "application/json", bodyReader)
+}
+
+// NewPutApiDocumentsIdLegalHoldRequestWithBody generates requests for PutApiDocumentsIdLegalHold with any type of body
+func NewPutApiDocumentsIdLegalHoldRequestWithBody(server string, id openapi_types.UUID, params *PutApiDocumentsIdLegalHoldParams, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/documents/%s/legal-hold", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	if params != nil {
+
+		if params.XOrganizationID != nil {
+			var headerParam0 string
+
+			headerParam0, err = runtime.StyleParamWithLocation("simple", false, "X-Organization-ID", runtime.ParamLocationHeader, *params.XOrganizationID)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("X-Organization-ID", headerParam0)
+		}
+
+	}
+
+	return req, nil
+}
+
+// NewDeleteApiDocumentsIdLockRequest generates requests for DeleteApiDocumentsIdLock
+func NewDeleteApiDocumentsIdLockRequest(server string, id openapi_types.UUID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/documents/%s/lock", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPostApiDocumentsIdLockRequest calls the generic PostApiDocumentsIdLock builder with application/json body
+func NewPostApiDocumentsIdLockRequest(server string, id openapi_types.UUID, body PostApiDocumentsIdLockJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostApiDocumentsIdLockRequestWithBody(server, id, "application/json", bodyReader)
+}
+
+// NewPostApiDocumentsIdLockRequestWithBody generates requests for PostApiDocumentsIdLock with any type of body
+func NewPostApiDocumentsIdLockRequestWithBody(server string, id openapi_types.UUID, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/documents/%s/lock", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewDeleteApiDocumentsIdPinRequest generates requests for DeleteApiDocumentsIdPin
+func NewDeleteApiDocumentsIdPinRequest(server string, id openapi_types.UUID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/documents/%s/pin", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPostApiDocumentsIdPinRequest generates requests for PostApiDocumentsIdPin
+func NewPostApiDocumentsIdPinRequest(server string, id openapi_types.UUID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/documents/%s/pin", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetApiDocumentsIdRemindersRequest generates requests for GetApiDocumentsIdReminders
+func NewGetApiDocumentsIdRemindersRequest(server string, id openapi_types.UUID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/documents/%s/reminders", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPutApiDocumentsIdRemindersRequest calls the generic PutApiDocumentsIdReminders builder with application/json body
+func NewPutApiDocumentsIdRemindersRequest(server string, id openapi_types.UUID, body PutApiDocumentsIdRemindersJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPutApiDocumentsIdRemindersRequestWithBody(server, id, "application/json", bodyReader)
+}
+
+// NewPutApiDocumentsIdRemindersRequestWithBody generates requests for PutApiDocumentsIdReminders with any type of body
+func NewPutApiDocumentsIdRemindersRequestWithBody(server string, id openapi_types.UUID, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/documents/%s/reminders", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewGetApiDocumentsIdRemindersPreviewRequest generates requests for GetApiDocumentsIdRemindersPreview
+func NewGetApiDocumentsIdRemindersPreviewRequest(server string, id openapi_types.UUID, params *GetApiDocumentsIdRemindersPreviewParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/documents/%s/reminders/preview", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Channel != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "channel", runtime.ParamLocationQuery, *params.Channel); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetApiDocumentsIdRenewalsRequest generates requests for GetApiDocumentsIdRenewals
+func NewGetApiDocumentsIdRenewalsRequest(server string, id openapi_types.UUID, params *GetApiDocumentsIdRenewalsParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/documents/%s/renewals", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+
+		if params.XOrganizationID != nil {
+			var headerParam0 string
+
+			headerParam0, err = runtime.StyleParamWithLocation("simple", false, "X-Organization-ID", runtime.ParamLocationHeader, *params.XOrganizationID)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("X-Organization-ID", headerParam0)
+		}
+
+	}
+
+	return req, nil
+}
+
+// NewPostApiDocumentsIdRenewalsRequestWithBody generates requests for PostApiDocumentsIdRenewals with any type of body
+func NewPostApiDocumentsIdRenewalsRequestWithBody(server string, id openapi_types.UUID, params *PostApiDocumentsIdRenewalsParams, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/documents/%s/renewals", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	if params != nil {
+
+		if params.XOrganizationID != nil {
+			var headerParam0 string
+
+			headerParam0, err = runtime.StyleParamWithLocation("simple", false, "X-Organization-ID", runtime.ParamLocationHeader, *params.XOrganizationID)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("X-Organization-ID", headerParam0)
+		}
+
+	}
+
+	return req, nil
+}
+
+// NewPostApiDocumentsIdRenewalsRenewalIdApproveRequest calls the generic PostApiDocumentsIdRenewalsRenewalIdApprove builder with application/json body
+func NewPostApiDocumentsIdRenewalsRenewalIdApproveRequest(server string, id openapi_types.UUID, renewalId openapi_types.UUID, params *PostApiDocumentsIdRenewalsRenewalIdApproveParams, body PostApiDocumentsIdRenewalsRenewalIdApproveJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostApiDocumentsIdRenewalsRenewalIdApproveRequestWithBody(server, id, renewalId, params, "application/json", bodyReader)
+}
+
+// NewPostApiDocumentsIdRenewalsRenewalIdApproveRequestWithBody generates requests for PostApiDocumentsIdRenewalsRenewalIdApprove with any type of body
+func NewPostApiDocumentsIdRenewalsRenewalIdApproveRequestWithBody(server string, id openapi_types.UUID, renewalId openapi_types.UUID, params *PostApiDocumentsIdRenewalsRenewalIdApproveParams, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "renewalId", runtime.ParamLocationPath, renewalId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/documents/%s/renewals/%s/approve", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	if params != nil {
+
+		if params.XOrganizationID != nil {
+			var headerParam0 string
+
+			headerParam0, err = runtime.StyleParamWithLocation("simple", false, "X-Organization-ID", runtime.ParamLocationHeader, *params.XOrganizationID)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("X-Organization-ID", headerParam0)
+		}
+
+	}
+
+	return req, nil
+}
+
+// NewPostApiDocumentsIdRenewalsRenewalIdRejectRequest calls the generic PostApiDocumentsIdRenewalsRenewalIdReject builder with application/json body
+func NewPostApiDocumentsIdRenewalsRenewalIdRejectRequest(server string, id openapi_types.UUID, renewalId openapi_types.UUID, params *PostApiDocumentsIdRenewalsRenewalIdRejectParams, body PostApiDocumentsIdRenewalsRenewalIdRejectJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostApiDocumentsIdRenewalsRenewalIdRejectRequestWithBody(server, id, renewalId, params, "application/json", bodyReader)
+}
+
+// NewPostApiDocumentsIdRenewalsRenewalIdRejectRequestWithBody generates requests for PostApiDocumentsIdRenewalsRenewalIdReject with any type of body
+func NewPostApiDocumentsIdRenewalsRenewalIdRejectRequestWithBody(server string, id openapi_types.UUID, renewalId openapi_types.UUID, params *PostApiDocumentsIdRenewalsRenewalIdRejectParams, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "renewalId", runtime.ParamLocationPath, renewalId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/documents/%s/renewals/%s/reject", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	if params != nil {
+
+		if params.XOrganizationID != nil {
+			var headerParam0 string
+
+			headerParam0, err = runtime.StyleParamWithLocation("simple", false, "X-Organization-ID", runtime.ParamLocationHeader, *params.XOrganizationID)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("X-Organization-ID", headerParam0)
+		}
+
+	}
+
+	return req, nil
+}
+
+// NewPostApiDocumentsIdRestoreRequest generates requests for PostApiDocumentsIdRestore
+func NewPostApiDocumentsIdRestoreRequest(server string, id openapi_types.UUID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/documents/%s/restore", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewDeleteApiFeedRequest generates requests for DeleteApiFeed
+func NewDeleteApiFeedRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/feed")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetApiFeedRequest generates requests for GetApiFeed
+func NewGetApiFeedRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/feed")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPostApiFeedRequest generates requests for PostApiFeed
+func NewPostApiFeedRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/feed")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPostApiFeedbackRequest calls the generic PostApiFeedback builder with application/json body
+func NewPostApiFeedbackRequest(server string, body PostApiFeedbackJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostApiFeedbackRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewPostApiFeedbackRequestWithBody generates requests for PostApiFeedback with any type of body
+func NewPostApiFeedbackRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/feedback")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewGetApiFeedsTokenAtomXmlRequest generates requests for GetApiFeedsTokenAtomXml
+func NewGetApiFeedsTokenAtomXmlRequest(server string, token string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "token", runtime.ParamLocationPath, token)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/feeds/%s/atom.xml", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetApiFeedsTokenRssXmlRequest generates requests for GetApiFeedsTokenRssXml
+func NewGetApiFeedsTokenRssXmlRequest(server string, token string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "token", runtime.ParamLocationPath, token)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/feeds/%s/rss.xml", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPostApiGraphqlRequest calls the generic PostApiGraphql builder with application/json body
+func NewPostApiGraphqlRequest(server string, body PostApiGraphqlJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostApiGraphqlRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewPostApiGraphqlRequestWithBody generates requests for PostApiGraphql with any type of body
+func NewPostApiGraphqlRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/graphql")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewGetApiHouseholdRequest generates requests for GetApiHousehold
+func NewGetApiHouseholdRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/household")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPostApiHouseholdRequest calls the generic PostApiHousehold builder with application/json body
+func NewPostApiHouseholdRequest(server string, body PostApiHouseholdJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostApiHouseholdRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewPostApiHouseholdRequestWithBody generates requests for PostApiHousehold with any type of body
+func NewPostApiHouseholdRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/household")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewPostApiHouseholdMembersRequest calls the generic PostApiHouseholdMembers builder with application/json body
+func NewPostApiHouseholdMembersRequest(server string, body PostApiHouseholdMembersJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostApiHouseholdMembersRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewPostApiHouseholdMembersRequestWithBody generates requests for PostApiHouseholdMembers with any type of body
+func NewPostApiHouseholdMembersRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/household/members")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewDeleteApiHouseholdMembersUserIdRequest generates requests for DeleteApiHouseholdMembersUserId
+func NewDeleteApiHouseholdMembersUserIdRequest(server string, userId openapi_types.UUID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "userId", runtime.ParamLocationPath, userId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/household/members/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPutApiHouseholdMembersUserIdRequest calls the generic PutApiHouseholdMembersUserId builder with application/json body
+func NewPutApiHouseholdMembersUserIdRequest(server string, userId openapi_types.UUID, body PutApiHouseholdMembersUserIdJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPutApiHouseholdMembersUserIdRequestWithBody(server, userId, "application/json", bodyReader)
+}
+
+// NewPutApiHouseholdMembersUserIdRequestWithBody generates requests for PutApiHouseholdMembersUserId with any type of body
+func NewPutApiHouseholdMembersUserIdRequestWithBody(server string, userId openapi_types.UUID, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "userId", runtime.ParamLocationPath, userId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/household/members/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewGetApiHouseholdMembersUserIdDocumentsRequest generates requests for GetApiHouseholdMembersUserIdDocuments
+func NewGetApiHouseholdMembersUserIdDocumentsRequest(server string, userId openapi_types.UUID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "userId", runtime.ParamLocationPath, userId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/household/members/%s/documents", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetApiIntegrationsEgressIpsRequest generates requests for GetApiIntegrationsEgressIps
+func NewGetApiIntegrationsEgressIpsRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/integrations/egress-ips")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetApiIssuersRequest generates requests for GetApiIssuers
+func NewGetApiIssuersRequest(server string, params *GetApiIssuersParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/issuers")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Country != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "country", runtime.ParamLocationQuery, *params.Country); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetApiJobsRequest generates requests for GetApiJobs
+func NewGetApiJobsRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/jobs")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPostApiJobsImportRequestWithBody generates requests for PostApiJobsImport with any type of body
+func NewPostApiJobsImportRequestWithBody(server string, params *PostApiJobsImportParams, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/jobs/import")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "source", runtime.ParamLocationQuery, params.Source); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+		if params.Timezone != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "timezone", runtime.ParamLocationQuery, *params.Timezone); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Reminders != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "reminders", runtime.ParamLocationQuery, *params.Reminders); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewPostApiJobsTakeoutRequest generates requests for PostApiJobsTakeout
+func NewPostApiJobsTakeoutRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/jobs/takeout")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetApiJobsIdRequest generates requests for GetApiJobsId
+func NewGetApiJobsIdRequest(server string, id openapi_types.UUID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/jobs/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetApiLinksTokenRequest generates requests for GetApiLinksToken
+func NewGetApiLinksTokenRequest(server string, token string, params *GetApiLinksTokenParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "token", runtime.ParamLocationPath, token)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/links/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.ExpirationDate != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "expirationDate", runtime.ParamLocationQuery, *params.ExpirationDate); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPostApiLinksTokenRequest generates requests for PostApiLinksToken
+func NewPostApiLinksTokenRequest(server string, token string, params *PostApiLinksTokenParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "token", runtime.ParamLocationPath, token)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/links/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.ExpirationDate != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "expirationDate", runtime.ParamLocationQuery, *params.ExpirationDate); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPostApiNotificationsReadRequest generates requests for PostApiNotificationsRead
+func NewPostApiNotificationsReadRequest(server string, params *PostApiNotificationsReadParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/notifications/read")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+
+		if params.XOrganizationID != nil {
+			var headerParam0 string
+
+			headerParam0, err = runtime.StyleParamWithLocation("simple", false, "X-Organization-ID", runtime.ParamLocationHeader, *params.XOrganizationID)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("X-Organization-ID", headerParam0)
+		}
+
+	}
+
+	return req, nil
+}
+
+// NewGetApiNotificationsUnreadCountRequest generates requests for GetApiNotificationsUnreadCount
+func NewGetApiNotificationsUnreadCountRequest(server string, params *GetApiNotificationsUnreadCountParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/notifications/unread-count")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+
+		if params.XOrganizationID != nil {
+			var headerParam0 string
+
+			headerParam0, err = runtime.StyleParamWithLocation("simple", false, "X-Organization-ID", runtime.ParamLocationHeader, *params.XOrganizationID)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("X-Organization-ID", headerParam0)
+		}
+
+	}
+
+	return req, nil
+}
+
+// NewDeleteApiOnboardingSampleDataRequest generates requests for DeleteApiOnboardingSampleData
+func NewDeleteApiOnboardingSampleDataRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/onboarding/sample-data")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPostApiOnboardingSampleDataRequest generates requests for PostApiOnboardingSampleData
+func NewPostApiOnboardingSampleDataRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/onboarding/sample-data")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetApiOrganizationsRequest generates requests for GetApiOrganizations
+func NewGetApiOrganizationsRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/organizations")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPostApiOrganizationsRequest calls the generic PostApiOrganizations builder with application/json body
+func NewPostApiOrganizationsRequest(server string, body PostApiOrganizationsJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostApiOrganizationsRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewPostApiOrganizationsRequestWithBody generates requests for PostApiOrganizations with any type of body
+func NewPostApiOrganizationsRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/organizations")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewPutApiOrganizationsIdComplianceRequest calls the generic PutApiOrganizationsIdCompliance builder with application/json body
+func NewPutApiOrganizationsIdComplianceRequest(server string, id openapi_types.UUID, body PutApiOrganizationsIdComplianceJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPutApiOrganizationsIdComplianceRequestWithBody(server, id, "application/json", bodyReader)
+}
+
+// NewPutApiOrganizationsIdComplianceRequestWithBody generates requests for PutApiOrganizationsIdCompliance with any type of body
+func NewPutApiOrganizationsIdComplianceRequestWithBody(server string, id openapi_types.UUID, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/organizations/%s/compliance", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewDeleteApiOrganizationsIdEmailSenderRequest generates requests for DeleteApiOrganizationsIdEmailSender
+func NewDeleteApiOrganizationsIdEmailSenderRequest(server string, id openapi_types.UUID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/organizations/%s/email-sender", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetApiOrganizationsIdEmailSenderRequest generates requests for GetApiOrganizationsIdEmailSender
+func NewGetApiOrganizationsIdEmailSenderRequest(server string, id openapi_types.UUID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/organizations/%s/email-sender", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPutApiOrganizationsIdEmailSenderRequest calls the generic PutApiOrganizationsIdEmailSender builder with application/json body
+func NewPutApiOrganizationsIdEmailSenderRequest(server string, id openapi_types.UUID, body PutApiOrganizationsIdEmailSenderJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPutApiOrganizationsIdEmailSenderRequestWithBody(server, id, "application/json", bodyReader)
+}
+
+// NewPutApiOrganizationsIdEmailSenderRequestWithBody generates requests for PutApiOrganizationsIdEmailSender with any type of body
+func NewPutApiOrganizationsIdEmailSenderRequestWithBody(server string, id openapi_types.UUID, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/organizations/%s/email-sender", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewPostApiOrganizationsIdEmailSenderVerifyRequest generates requests for PostApiOrganizationsIdEmailSenderVerify
+func NewPostApiOrganizationsIdEmailSenderVerifyRequest(server string, id openapi_types.UUID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/organizations/%s/email-sender/verify", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetApiOrganizationsIdEscalationPolicyRequest generates requests for GetApiOrganizationsIdEscalationPolicy
+func NewGetApiOrganizationsIdEscalationPolicyRequest(server string, id openapi_types.UUID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/organizations/%s/escalation-policy", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPutApiOrganizationsIdEscalationPolicyRequest calls the generic PutApiOrganizationsIdEscalationPolicy builder with application/json body
+func NewPutApiOrganizationsIdEscalationPolicyRequest(server string, id openapi_types.UUID, body PutApiOrganizationsIdEscalationPolicyJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPutApiOrganizationsIdEscalationPolicyRequestWithBody(server, id, "application/json", bodyReader)
+}
+
+// NewPutApiOrganizationsIdEscalationPolicyRequestWithBody generates requests for PutApiOrganizationsIdEscalationPolicy with any type of body
+func NewPutApiOrganizationsIdEscalationPolicyRequestWithBody(server string, id openapi_types.UUID, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/organizations/%s/escalation-policy", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewGetApiOrganizationsIdEventLogExportRequest generates requests for GetApiOrganizationsIdEventLogExport
+func NewGetApiOrganizationsIdEventLogExportRequest(server string, id openapi_types.UUID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/organizations/%s/event-log/export", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetApiOrganizationsIdLegalHoldRequest generates requests for GetApiOrganizationsIdLegalHold
+func NewGetApiOrganizationsIdLegalHoldRequest(server string, id openapi_types.UUID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/organizations/%s/legal-hold", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPutApiOrganizationsIdLegalHoldRequest calls the generic PutApiOrganizationsIdLegalHold builder with application/json body
+func NewPutApiOrganizationsIdLegalHoldRequest(server string, id openapi_types.UUID, body PutApiOrganizationsIdLegalHoldJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPutApiOrganizationsIdLegalHoldRequestWithBody(server, id, "application/json", bodyReader)
+}
+
+// NewPutApiOrganizationsIdLegalHoldRequestWithBody generates requests for PutApiOrganizationsIdLegalHold with any type of body
+func NewPutApiOrganizationsIdLegalHoldRequestWithBody(server string, id openapi_types.UUID, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/organizations/%s/legal-hold", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewGetApiOrganizationsIdMembersRequest generates requests for GetApiOrganizationsIdMembers
+func NewGetApiOrganizationsIdMembersRequest(server string, id openapi_types.UUID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/organizations/%s/members", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPostApiOrganizationsIdMembersRequest calls the generic PostApiOrganizationsIdMembers builder with application/json body
+func NewPostApiOrganizationsIdMembersRequest(server string, id openapi_types.UUID, body PostApiOrganizationsIdMembersJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostApiOrganizationsIdMembersRequestWithBody(server, id, "application/json", bodyReader)
+}
+
+// NewPostApiOrganizationsIdMembersRequestWithBody generates requests for PostApiOrganizationsIdMembers with any type of body
+func NewPostApiOrganizationsIdMembersRequestWithBody(server string, id openapi_types.UUID, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/organizations/%s/members", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewDeleteApiOrganizationsIdMembersUserIdRequest generates requests for DeleteApiOrganizationsIdMembersUserId
+func NewDeleteApiOrganizationsIdMembersUserIdRequest(server string, id openapi_types.UUID, userId openapi_types.UUID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "userId", runtime.ParamLocationPath, userId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/organizations/%s/members/%s", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewDeleteApiOrganizationsIdNotificationThemeRequest generates requests for DeleteApiOrganizationsIdNotificationTheme
+func NewDeleteApiOrganizationsIdNotificationThemeRequest(server string, id openapi_types.UUID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/organizations/%s/notification-theme", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetApiOrganizationsIdNotificationThemeRequest generates requests for GetApiOrganizationsIdNotificationTheme
+func NewGetApiOrganizationsIdNotificationThemeRequest(server string, id openapi_types.UUID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/organizations/%s/notification-theme", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPutApiOrganizationsIdNotificationThemeRequest calls the generic PutApiOrganizationsIdNotificationTheme builder with application/json body
+func NewPutApiOrganizationsIdNotificationThemeRequest(server string, id openapi_types.UUID, body PutApiOrganizationsIdNotificationThemeJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPutApiOrganizationsIdNotificationThemeRequestWithBody(server, id, "application/json", bodyReader)
+}
+
+// NewPutApiOrganizationsIdNotificationThemeRequestWithBody generates requests for PutApiOrganizationsIdNotificationTheme with any type of body
+func NewPutApiOrganizationsIdNotificationThemeRequestWithBody(server string, id openapi_types.UUID, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/organizations/%s/notification-theme", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewPutApiOrganizationsIdRenewalApprovalRequest calls the generic PutApiOrganizationsIdRenewalApproval builder with application/json body
+func NewPutApiOrganizationsIdRenewalApprovalRequest(server string, id openapi_types.UUID, body PutApiOrganizationsIdRenewalApprovalJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPutApiOrganizationsIdRenewalApprovalRequestWithBody(server, id, "application/json", bodyReader)
+}
+
+// NewPutApiOrganizationsIdRenewalApprovalRequestWithBody generates requests for PutApiOrganizationsIdRenewalApproval with any type of body
+func NewPutApiOrganizationsIdRenewalApprovalRequestWithBody(server string, id openapi_types.UUID, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/organizations/%s/renewal-approval", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewGetApiOrganizationsIdRetentionRequest generates requests for GetApiOrganizationsIdRetention
+func NewGetApiOrganizationsIdRetentionRequest(server string, id openapi_types.UUID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/organizations/%s/retention", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPutApiOrganizationsIdRetentionRequest calls the generic PutApiOrganizationsIdRetention builder with application/json body
+func NewPutApiOrganizationsIdRetentionRequest(server string, id openapi_types.UUID, body PutApiOrganizationsIdRetentionJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPutApiOrganizationsIdRetentionRequestWithBody(server, id, "application/json", bodyReader)
+}
+
+// NewPutApiOrganizationsIdRetentionRequestWithBody generates requests for PutApiOrganizationsIdRetention with any type of body
+func NewPutApiOrganizationsIdRetentionRequestWithBody(server string, id openapi_types.UUID, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/organizations/%s/retention", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewDeleteApiOrganizationsIdScimTokenRequest generates requests for DeleteApiOrganizationsIdScimToken
+func NewDeleteApiOrganizationsIdScimTokenRequest(server string, id openapi_types.UUID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/organizations/%s/scim-token", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPostApiOrganizationsIdScimTokenRequest generates requests for PostApiOrganizationsIdScimToken
+func NewPostApiOrganizationsIdScimTokenRequest(server string, id openapi_types.UUID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/organizations/%s/scim-token", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetApiOrganizationsIdServiceAccountsRequest generates requests for GetApiOrganizationsIdServiceAccounts
+func NewGetApiOrganizationsIdServiceAccountsRequest(server string, id openapi_types.UUID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/organizations/%s/service-accounts", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPostApiOrganizationsIdServiceAccountsRequest calls the generic PostApiOrganizationsIdServiceAccounts builder with application/json body
+func NewPostApiOrganizationsIdServiceAccountsRequest(server string, id openapi_types.UUID, body PostApiOrganizationsIdServiceAccountsJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostApiOrganizationsIdServiceAccountsRequestWithBody(server, id, "application/json", bodyReader)
+}
+
+// NewPostApiOrganizationsIdServiceAccountsRequestWithBody generates requests for PostApiOrganizationsIdServiceAccounts with any type of body
+func NewPostApiOrganizationsIdServiceAccountsRequestWithBody(server string, id openapi_types.UUID, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/organizations/%s/service-accounts", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewDeleteApiOrganizationsIdServiceAccountsAccountIdTokenRequest generates requests for DeleteApiOrganizationsIdServiceAccountsAccountIdToken
+func NewDeleteApiOrganizationsIdServiceAccountsAccountIdTokenRequest(server string, id openapi_types.UUID, accountId openapi_types.UUID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "accountId", runtime.ParamLocationPath, accountId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/organizations/%s/service-accounts/%s/token", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPostApiOrganizationsIdServiceAccountsAccountIdTokenRequest generates requests for PostApiOrganizationsIdServiceAccountsAccountIdToken
+func NewPostApiOrganizationsIdServiceAccountsAccountIdTokenRequest(server string, id openapi_types.UUID, accountId openapi_types.UUID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "accountId", runtime.ParamLocationPath, accountId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/organizations/%s/service-accounts/%s/token", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetApiOrganizationsIdUsageExportRequest generates requests for GetApiOrganizationsIdUsageExport
+func NewGetApiOrganizationsIdUsageExportRequest(server string, id openapi_types.UUID, params *GetApiOrganizationsIdUsageExportParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/organizations/%s/usage/export", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.From != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "from", runtime.ParamLocationQuery, *params.From); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.To != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "to", runtime.ParamLocationQuery, *params.To); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Format != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "format", runtime.ParamLocationQuery, *params.Format); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetApiPreferencesLocaleRequest generates requests for GetApiPreferencesLocale
+func NewGetApiPreferencesLocaleRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/preferences/locale")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPutApiPreferencesLocaleRequest calls the generic PutApiPreferencesLocale builder with application/json body
+func NewPutApiPreferencesLocaleRequest(server string, body PutApiPreferencesLocaleJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPutApiPreferencesLocaleRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewPutApiPreferencesLocaleRequestWithBody generates requests for PutApiPreferencesLocale with any type of body
+func NewPutApiPreferencesLocaleRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/preferences/locale")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewGetApiPreferencesNotificationsRequest generates requests for GetApiPreferencesNotifications
+func NewGetApiPreferencesNotificationsRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/preferences/notifications")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPutApiPreferencesNotificationsRequest calls the generic PutApiPreferencesNotifications builder with application/json body
+func NewPutApiPreferencesNotificationsRequest(server string, body PutApiPreferencesNotificationsJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPutApiPreferencesNotificationsRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewPutApiPreferencesNotificationsRequestWithBody generates requests for PutApiPreferencesNotifications with any type of body
+func NewPutApiPreferencesNotificationsRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/preferences/notifications")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewGetApiRoadmapRequest generates requests for GetApiRoadmap
+func NewGetApiRoadmapRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/roadmap")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewDeleteApiRoadmapIdVoteRequest generates requests for DeleteApiRoadmapIdVote
+func NewDeleteApiRoadmapIdVoteRequest(server string, id openapi_types.UUID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/roadmap/%s/vote", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPostApiRoadmapIdVoteRequest generates requests for PostApiRoadmapIdVote
+func NewPostApiRoadmapIdVoteRequest(server string, id openapi_types.UUID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/roadmap/%s/vote", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetApiTemplatesRequest generates requests for GetApiTemplates
+func NewGetApiTemplatesRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/templates")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetApiTrackOpenMessageIdRequest generates requests for GetApiTrackOpenMessageId
+func NewGetApiTrackOpenMessageIdRequest(server string, messageId openapi_types.UUID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "messageId", runtime.ParamLocationPath, messageId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/track/open/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetApiUnsubscribeTokenRequest generates requests for GetApiUnsubscribeToken
+func NewGetApiUnsubscribeTokenRequest(server string, token string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "token", runtime.ParamLocationPath, token)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/unsubscribe/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPostApiUnsubscribeTokenRequest generates requests for PostApiUnsubscribeToken
+func NewPostApiUnsubscribeTokenRequest(server string, token string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "token", runtime.ParamLocationPath, token)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/unsubscribe/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetApiUsageRequest generates requests for GetApiUsage
+func NewGetApiUsageRequest(server string, params *GetApiUsageParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/usage")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+
+		if params.XOrganizationID != nil {
+			var headerParam0 string
+
+			headerParam0, err = runtime.StyleParamWithLocation("simple", false, "X-Organization-ID", runtime.ParamLocationHeader, *params.XOrganizationID)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("X-Organization-ID", headerParam0)
+		}
+
+	}
+
+	return req, nil
+}
+
+// NewGetApiViewsRequest generates requests for GetApiViews
+func NewGetApiViewsRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/views")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPostApiViewsRequest calls the generic PostApiViews builder with application/json body
+func NewPostApiViewsRequest(server string, body PostApiViewsJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostApiViewsRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewPostApiViewsRequestWithBody generates requests for PostApiViews with any type of body
+func NewPostApiViewsRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/views")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewDeleteApiViewsIdRequest generates requests for DeleteApiViewsId
+func NewDeleteApiViewsIdRequest(server string, id openapi_types.UUID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/views/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetApiViewsIdRequest generates requests for GetApiViewsId
+func NewGetApiViewsIdRequest(server string, id openapi_types.UUID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/views/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPutApiViewsIdRequest calls the generic PutApiViewsId builder with application/json body
+func NewPutApiViewsIdRequest(server string, id openapi_types.UUID, body PutApiViewsIdJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPutApiViewsIdRequestWithBody(server, id, "application/json", bodyReader)
+}
+
+// NewPutApiViewsIdRequestWithBody generates requests for PutApiViewsId with any type of body
+func NewPutApiViewsIdRequestWithBody(server string, id openapi_types.UUID, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/views/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewGetApiViewsIdDocumentsRequest generates requests for GetApiViewsIdDocuments
+func NewGetApiViewsIdDocumentsRequest(server string, id openapi_types.UUID, params *GetApiViewsIdDocumentsParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/views/%s/documents", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Sort != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "sort", runtime.ParamLocationQuery, *params.Sort); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+
+		if params.XOrganizationID != nil {
+			var headerParam0 string
+
+			headerParam0, err = runtime.StyleParamWithLocation("simple", false, "X-Organization-ID", runtime.ParamLocationHeader, *params.XOrganizationID)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("X-Organization-ID", headerParam0)
+		}
+
+	}
+
+	return req, nil
+}
+
+// NewPostApiWebhooksEmailBounceRequest calls the generic PostApiWebhooksEmailBounce builder with application/json body
+func NewPostApiWebhooksEmailBounceRequest(server string, params *PostApiWebhooksEmailBounceParams, body PostApiWebhooksEmailBounceJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostApiWebhooksEmailBounceRequestWithBody(server, params, "application/json", bodyReader)
+}
+
+// NewPostApiWebhooksEmailBounceRequestWithBody generates requests for PostApiWebhooksEmailBounce with any type of body
+func NewPostApiWebhooksEmailBounceRequestWithBody(server string, params *PostApiWebhooksEmailBounceParams, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/webhooks/email/bounce")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	if params != nil {
+
+		var headerParam0 string
+
+		headerParam0, err = runtime.StyleParamWithLocation("simple", false, "X-Webhook-Secret", runtime.ParamLocationHeader, params.XWebhookSecret)
+		if err != nil {
+			return nil, err
+		}
+
+		req.Header.Set("X-Webhook-Secret", headerParam0)
+
+	}
+
+	return req, nil
+}
+
+// NewGetApiWebhooksEndpointsRequest generates requests for GetApiWebhooksEndpoints
+func NewGetApiWebhooksEndpointsRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/webhooks/endpoints")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPostApiWebhooksEndpointsRequest calls the generic PostApiWebhooksEndpoints builder with application/json body
+func NewPostApiWebhooksEndpointsRequest(server string, body PostApiWebhooksEndpointsJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostApiWebhooksEndpointsRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewPostApiWebhooksEndpointsRequestWithBody generates requests for PostApiWebhooksEndpoints with any type of body
+func NewPostApiWebhooksEndpointsRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/webhooks/endpoints")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewDeleteApiWebhooksEndpointsIdRequest generates requests for DeleteApiWebhooksEndpointsId
+func NewDeleteApiWebhooksEndpointsIdRequest(server string, id openapi_types.UUID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/webhooks/endpoints/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPutApiWebhooksEndpointsIdRequest calls the generic PutApiWebhooksEndpointsId builder with application/json body
+func NewPutApiWebhooksEndpointsIdRequest(server string, id openapi_types.UUID, body PutApiWebhooksEndpointsIdJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPutApiWebhooksEndpointsIdRequestWithBody(server, id, "application/json", bodyReader)
+}
+
+// NewPutApiWebhooksEndpointsIdRequestWithBody generates requests for PutApiWebhooksEndpointsId with any type of body
+func NewPutApiWebhooksEndpointsIdRequestWithBody(server string, id openapi_types.UUID, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/webhooks/endpoints/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewGetApiWebhooksEndpointsIdDeliveriesRequest generates requests for GetApiWebhooksEndpointsIdDeliveries
+func NewGetApiWebhooksEndpointsIdDeliveriesRequest(server string, id openapi_types.UUID, params *GetApiWebhooksEndpointsIdDeliveriesParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/webhooks/endpoints/%s/deliveries", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Limit != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPostApiWebhooksEndpointsIdDeliveriesDeliveryIdRedeliverRequest generates requests for PostApiWebhooksEndpointsIdDeliveriesDeliveryIdRedeliver
+func NewPostApiWebhooksEndpointsIdDeliveriesDeliveryIdRedeliverRequest(server string, id openapi_types.UUID, deliveryId openapi_types.UUID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "deliveryId", runtime.ParamLocationPath, deliveryId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/webhooks/endpoints/%s/deliveries/%s/redeliver", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPostApiWebhooksEndpointsIdRotateSecretRequest calls the generic PostApiWebhooksEndpointsIdRotateSecret builder with application/json body
+func NewPostApiWebhooksEndpointsIdRotateSecretRequest(server string, id openapi_types.UUID, body PostApiWebhooksEndpointsIdRotateSecretJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostApiWebhooksEndpointsIdRotateSecretRequestWithBody(server, id, "application/json", bodyReader)
+}
+
+// NewPostApiWebhooksEndpointsIdRotateSecretRequestWithBody generates requests for PostApiWebhooksEndpointsIdRotateSecret with any type of body
+func NewPostApiWebhooksEndpointsIdRotateSecretRequestWithBody(server string, id openapi_types.UUID, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/webhooks/endpoints/%s/rotate-secret", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewPostApiWebhooksTwilioSmsRequestWithFormdataBody calls the generic PostApiWebhooksTwilioSms builder with application/x-www-form-urlencoded body
+func NewPostApiWebhooksTwilioSmsRequestWithFormdataBody(server string, params *PostApiWebhooksTwilioSmsParams, body PostApiWebhooksTwilioSmsFormdataRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	bodyStr, err := runtime.MarshalForm(body, nil)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = strings.NewReader(bodyStr.Encode())
+	return NewPostApiWebhooksTwilioSmsRequestWithBody(server, params, "application/x-www-form-urlencoded", bodyReader)
+}
+
+// NewPostApiWebhooksTwilioSmsRequestWithBody generates requests for PostApiWebhooksTwilioSms with any type of body
+func NewPostApiWebhooksTwilioSmsRequestWithBody(server string, params *PostApiWebhooksTwilioSmsParams, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/webhooks/twilio/sms")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	if params != nil {
+
+		var headerParam0 string
+
+		headerParam0, err = runtime.StyleParamWithLocation("simple", false, "X-Twilio-Signature", runtime.ParamLocationHeader, params.XTwilioSignature)
+		if err != nil {
+			return nil, err
+		}
+
+		req.Header.Set("X-Twilio-Signature", headerParam0)
+
+	}
+
+	return req, nil
+}
+
+// NewGetHealthRequest generates requests for GetHealth
+func NewGetHealthRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/health")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetReadyRequest generates requests for GetReady
+func NewGetReadyRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/ready")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetReminderIntervalsRequest generates requests for GetReminderIntervals
+func NewGetReminderIntervalsRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/reminder-intervals")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetScimV2ServiceProviderConfigRequest generates requests for GetScimV2ServiceProviderConfig
+func NewGetScimV2ServiceProviderConfigRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/scim/v2/ServiceProviderConfig")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetScimV2UsersRequest generates requests for GetScimV2Users
+func NewGetScimV2UsersRequest(server string, params *GetScimV2UsersParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/scim/v2/Users")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Filter != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "filter", runtime.ParamLocationQuery, *params.Filter); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.StartIndex != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "startIndex", runtime.ParamLocationQuery, *params.StartIndex); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Count != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "count", runtime.ParamLocationQuery, *params.Count); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPostScimV2UsersRequestWithApplicationScimPlusJSONBody calls the generic PostScimV2Users builder with application/scim+json body
+func NewPostScimV2UsersRequestWithApplicationScimPlusJSONBody(server string, body PostScimV2UsersApplicationScimPlusJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostScimV2UsersRequestWithBody(server, "application/scim+json", bodyReader)
+}
+
+// NewPostScimV2UsersRequestWithBody generates requests for PostScimV2Users with any type of body
+func NewPostScimV2UsersRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/scim/v2/Users")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewDeleteScimV2UsersUserIdRequest generates requests for DeleteScimV2UsersUserId
+func NewDeleteScimV2UsersUserIdRequest(server string, userId openapi_types.UUID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "userId", runtime.ParamLocationPath, userId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/scim/v2/Users/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetScimV2UsersUserIdRequest generates requests for GetScimV2UsersUserId
+func NewGetScimV2UsersUserIdRequest(server string, userId openapi_types.UUID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "userId", runtime.ParamLocationPath, userId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/scim/v2/Users/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPatchScimV2UsersUserIdRequestWithApplicationScimPlusJSONBody calls the generic PatchScimV2UsersUserId builder with application/scim+json body
+func NewPatchScimV2UsersUserIdRequestWithApplicationScimPlusJSONBody(server string, userId openapi_types.UUID, body PatchScimV2UsersUserIdApplicationScimPlusJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPatchScimV2UsersUserIdRequestWithBody(server, userId, "application/scim+json", bodyReader)
+}
+
+// NewPatchScimV2UsersUserIdRequestWithBody generates requests for PatchScimV2UsersUserId with any type of body
+func NewPatchScimV2UsersUserIdRequestWithBody(server string, userId openapi_types.UUID, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "userId", runtime.ParamLocationPath, userId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/scim/v2/Users/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PATCH", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewPutScimV2UsersUserIdRequestWithApplicationScimPlusJSONBody calls the generic PutScimV2UsersUserId builder with application/scim+json body
+func NewPutScimV2UsersUserIdRequestWithApplicationScimPlusJSONBody(server string, userId openapi_types.UUID, body PutScimV2UsersUserIdApplicationScimPlusJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPutScimV2UsersUserIdRequestWithBody(server, userId, "application/scim+json", bodyReader)
+}
+
+// NewPutScimV2UsersUserIdRequestWithBody generates requests for PutScimV2UsersUserId with any type of body
+func NewPutScimV2UsersUserIdRequestWithBody(server string, userId openapi_types.UUID, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "userId", runtime.ParamLocationPath, userId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/scim/v2/Users/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+func (c *Client) applyEditors(ctx context.Context, req *http.Request, additionalEditors []RequestEditorFn) error {
+	for _, r := range c.RequestEditors {
+		if err := r(ctx, req); err != nil {
+			return err
+		}
+	}
+	for _, r := range additionalEditors {
+		if err := r(ctx, req); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ClientWithResponses builds on ClientInterface to offer response payloads
+type ClientWithResponses struct {
+	ClientInterface
+}
+
+// NewClientWithResponses creates a new ClientWithResponses, which wraps
+// Client with return type handling
+func NewClientWithResponses(server string, opts ...ClientOption) (*ClientWithResponses, error) {
+	client, err := NewClient(server, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &ClientWithResponses{client}, nil
+}
+
+// WithBaseURL overrides the baseURL.
+func WithBaseURL(baseURL string) ClientOption {
+	return func(c *Client) error {
+		newBaseURL, err := url.Parse(baseURL)
+		if err != nil {
+			return err
+		}
+		c.Server = newBaseURL.String()
+		return nil
+	}
+}
+
+// ClientWithResponsesInterface is the interface specification for the client with responses above.
+type ClientWithResponsesInterface interface {
+	// GetWellKnownJwksJsonWithResponse request
+	GetWellKnownJwksJsonWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetWellKnownJwksJsonResponse, error)
+
+	// GetApiAdminAnnouncementsWithResponse request
+	GetApiAdminAnnouncementsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetApiAdminAnnouncementsResponse, error)
+
+	// PostApiAdminAnnouncementsWithBodyWithResponse request with any body
+	PostApiAdminAnnouncementsWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostApiAdminAnnouncementsResponse, error)
+
+	PostApiAdminAnnouncementsWithResponse(ctx context.Context, body PostApiAdminAnnouncementsJSONRequestBody, reqEditors ...RequestEditorFn) (*PostApiAdminAnnouncementsResponse, error)
+
+	// DeleteApiAdminAnnouncementsIdWithResponse request
+	DeleteApiAdminAnnouncementsIdWithResponse(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*DeleteApiAdminAnnouncementsIdResponse, error)
+
+	// GetApiAdminDeprecationsWithResponse request
+	GetApiAdminDeprecationsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetApiAdminDeprecationsResponse, error)
+
+	// GetApiAdminHealthWithResponse request
+	GetApiAdminHealthWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetApiAdminHealthResponse, error)
+
+	// PostApiAdminImpersonateWithBodyWithResponse request with any body
+	PostApiAdminImpersonateWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostApiAdminImpersonateResponse, error)
+
+	PostApiAdminImpersonateWithResponse(ctx context.Context, body PostApiAdminImpersonateJSONRequestBody, reqEditors ...RequestEditorFn) (*PostApiAdminImpersonateResponse, error)
+
+	// PostApiAdminIssuersWithBodyWithResponse request with any body
+	PostApiAdminIssuersWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostApiAdminIssuersResponse, error)
+
+	PostApiAdminIssuersWithResponse(ctx context.Context, body PostApiAdminIssuersJSONRequestBody, reqEditors ...RequestEditorFn) (*PostApiAdminIssuersResponse, error)
+
+	// DeleteApiAdminIssuersIdWithResponse request
+	DeleteApiAdminIssuersIdWithResponse(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*DeleteApiAdminIssuersIdResponse, error)
+
+	// PutApiAdminIssuersIdWithBodyWithResponse request with any body
+	PutApiAdminIssuersIdWithBodyWithResponse(ctx context.Context, id openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PutApiAdminIssuersIdResponse, error)
+
+	PutApiAdminIssuersIdWithResponse(ctx context.Context, id openapi_types.UUID, body PutApiAdminIssuersIdJSONRequestBody, reqEditors ...RequestEditorFn) (*PutApiAdminIssuersIdResponse, error)
+
+	// GetApiAdminLoadSheddingWithResponse request
+	GetApiAdminLoadSheddingWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetApiAdminLoadSheddingResponse, error)
+
+	// GetApiAdminNotificationProvidersWithResponse request
+	GetApiAdminNotificationProvidersWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetApiAdminNotificationProvidersResponse, error)
+
+	// PostApiAdminRoadmapWithBodyWithResponse request with any body
+	PostApiAdminRoadmapWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostApiAdminRoadmapResponse, error)
+
+	PostApiAdminRoadmapWithResponse(ctx context.Context, body PostApiAdminRoadmapJSONRequestBody, reqEditors ...RequestEditorFn) (*PostApiAdminRoadmapResponse, error)
+
+	// DeleteApiAdminRoadmapIdWithResponse request
+	DeleteApiAdminRoadmapIdWithResponse(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*DeleteApiAdminRoadmapIdResponse, error)
+
+	// PutApiAdminRoadmapIdWithBodyWithResponse request with any body
+	PutApiAdminRoadmapIdWithBodyWithResponse(ctx context.Context, id openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PutApiAdminRoadmapIdResponse, error)
+
+	PutApiAdminRoadmapIdWithResponse(ctx context.Context, id openapi_types.UUID, body PutApiAdminRoadmapIdJSONRequestBody, reqEditors ...RequestEditorFn) (*PutApiAdminRoadmapIdResponse, error)
+
+	// PostApiAdminScheduledJobsNameRunWithResponse request
+	PostApiAdminScheduledJobsNameRunWithResponse(ctx context.Context, name PostApiAdminScheduledJobsNameRunParamsName, reqEditors ...RequestEditorFn) (*PostApiAdminScheduledJobsNameRunResponse, error)
+
+	// GetApiAdminTasksFailedWithResponse request
+	GetApiAdminTasksFailedWithResponse(ctx context.Context, params *GetApiAdminTasksFailedParams, reqEditors ...RequestEditorFn) (*GetApiAdminTasksFailedResponse, error)
+
+	// PostApiAdminTasksQueueTaskIdRequeueWithResponse request
+	PostApiAdminTasksQueueTaskIdRequeueWithResponse(ctx context.Context, queue string, taskId string, reqEditors ...RequestEditorFn) (*PostApiAdminTasksQueueTaskIdRequeueResponse, error)
+
+	// GetApiAdminUsageExportWithResponse request
+	GetApiAdminUsageExportWithResponse(ctx context.Context, params *GetApiAdminUsageExportParams, reqEditors ...RequestEditorFn) (*GetApiAdminUsageExportResponse, error)
+
+	// PutApiAdminUsersIdPlanWithBodyWithResponse request with any body
+	PutApiAdminUsersIdPlanWithBodyWithResponse(ctx context.Context, id openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PutApiAdminUsersIdPlanResponse, error)
+
+	PutApiAdminUsersIdPlanWithResponse(ctx context.Context, id openapi_types.UUID, body PutApiAdminUsersIdPlanJSONRequestBody, reqEditors ...RequestEditorFn) (*PutApiAdminUsersIdPlanResponse, error)
+
+	// PostApiAdminUsersIdReinstateWithResponse request
+	PostApiAdminUsersIdReinstateWithResponse(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*PostApiAdminUsersIdReinstateResponse, error)
+
+	// PutApiAdminUsersIdRoleWithBodyWithResponse request with any body
+	PutApiAdminUsersIdRoleWithBodyWithResponse(ctx context.Context, id openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PutApiAdminUsersIdRoleResponse, error)
+
+	PutApiAdminUsersIdRoleWithResponse(ctx context.Context, id openapi_types.UUID, body PutApiAdminUsersIdRoleJSONRequestBody, reqEditors ...RequestEditorFn) (*PutApiAdminUsersIdRoleResponse, error)
+
+	// PostApiAdminUsersIdSuspendWithBodyWithResponse request with any body
+	PostApiAdminUsersIdSuspendWithBodyWithResponse(ctx context.Context, id openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostApiAdminUsersIdSuspendResponse, error)
+
+	PostApiAdminUsersIdSuspendWithResponse(ctx context.Context, id openapi_types.UUID, body PostApiAdminUsersIdSuspendJSONRequestBody, reqEditors ...RequestEditorFn) (*PostApiAdminUsersIdSuspendResponse, error)
+
+	// GetApiAnnouncementsWithResponse request
+	GetApiAnnouncementsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetApiAnnouncementsResponse, error)
+
+	// GetApiApiKeysWithResponse request
+	GetApiApiKeysWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetApiApiKeysResponse, error)
+
+	// PostApiApiKeysWithBodyWithResponse request with any body
+	PostApiApiKeysWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostApiApiKeysResponse, error)
+
+	PostApiApiKeysWithResponse(ctx context.Context, body PostApiApiKeysJSONRequestBody, reqEditors ...RequestEditorFn) (*PostApiApiKeysResponse, error)
+
+	// DeleteApiApiKeysIdWithResponse request
+	DeleteApiApiKeysIdWithResponse(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*DeleteApiApiKeysIdResponse, error)
+
+	// PutApiAuthEmailWithBodyWithResponse request with any body
+	PutApiAuthEmailWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PutApiAuthEmailResponse, error)
+
+	PutApiAuthEmailWithResponse(ctx context.Context, body PutApiAuthEmailJSONRequestBody, reqEditors ...RequestEditorFn) (*PutApiAuthEmailResponse, error)
+
+	// GetApiAuthEmailConfirmTokenWithResponse request
+	GetApiAuthEmailConfirmTokenWithResponse(ctx context.Context, token string, reqEditors ...RequestEditorFn) (*GetApiAuthEmailConfirmTokenResponse, error)
+
+	// PostApiAuthEmailConfirmTokenWithResponse request
+	PostApiAuthEmailConfirmTokenWithResponse(ctx context.Context, token string, reqEditors ...RequestEditorFn) (*PostApiAuthEmailConfirmTokenResponse, error)
+
+	// PostApiAuthLogoutWithResponse request
+	PostApiAuthLogoutWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*PostApiAuthLogoutResponse, error)
+
+	// DeleteApiAuthMeWithBodyWithResponse request with any body
+	DeleteApiAuthMeWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*DeleteApiAuthMeResponse, error)
+
+	DeleteApiAuthMeWithResponse(ctx context.Context, body DeleteApiAuthMeJSONRequestBody, reqEditors ...RequestEditorFn) (*DeleteApiAuthMeResponse, error)
+
+	// GetApiAuthMeWithResponse request
+	GetApiAuthMeWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetApiAuthMeResponse, error)
+
+	// GetApiAuthOauthProviderWithResponse request
+	GetApiAuthOauthProviderWithResponse(ctx context.Context, provider GetApiAuthOauthProviderParamsProvider, reqEditors ...RequestEditorFn) (*GetApiAuthOauthProviderResponse, error)
+
+	// GetApiAuthOauthProviderCallbackWithResponse request
+	GetApiAuthOauthProviderCallbackWithResponse(ctx context.Context, provider GetApiAuthOauthProviderCallbackParamsProvider, params *GetApiAuthOauthProviderCallbackParams, reqEditors ...RequestEditorFn) (*GetApiAuthOauthProviderCallbackResponse, error)
+
+	// PostApiAuthRegisterWithBodyWithResponse request with any body
+	PostApiAuthRegisterWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostApiAuthRegisterResponse, error)
+
+	PostApiAuthRegisterWithResponse(ctx context.Context, body PostApiAuthRegisterJSONRequestBody, reqEditors ...RequestEditorFn) (*PostApiAuthRegisterResponse, error)
+
+	// DeleteApiAuthSessionsWithResponse request
+	DeleteApiAuthSessionsWithResponse(ctx context.Context, params *DeleteApiAuthSessionsParams, reqEditors ...RequestEditorFn) (*DeleteApiAuthSessionsResponse, error)
+
+	// GetApiAuthSessionsWithResponse request
+	GetApiAuthSessionsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetApiAuthSessionsResponse, error)
+
+	// DeleteApiAuthSessionsIdWithResponse request
+	DeleteApiAuthSessionsIdWithResponse(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*DeleteApiAuthSessionsIdResponse, error)
+
+	// PostApiAuthSigninWithBodyWithResponse request with any body
+	PostApiAuthSigninWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostApiAuthSigninResponse, error)
+
+	PostApiAuthSigninWithResponse(ctx context.Context, body PostApiAuthSigninJSONRequestBody, reqEditors ...RequestEditorFn) (*PostApiAuthSigninResponse, error)
+
+	// GetApiCategoriesWithResponse request
+	GetApiCategoriesWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetApiCategoriesResponse, error)
+
+	// GetApiCategoriesSlugSuggestExpirationWithResponse request
+	GetApiCategoriesSlugSuggestExpirationWithResponse(ctx context.Context, slug string, params *GetApiCategoriesSlugSuggestExpirationParams, reqEditors ...RequestEditorFn) (*GetApiCategoriesSlugSuggestExpirationResponse, error)
+
+	// GetApiDocumentsWithResponse request
+	GetApiDocumentsWithResponse(ctx context.Context, params *GetApiDocumentsParams, reqEditors ...RequestEditorFn) (*GetApiDocumentsResponse, error)
+
+	// PostApiDocumentsWithBodyWithResponse request with any body
+	PostApiDocumentsWithBodyWithResponse(ctx context.Context, params *PostApiDocumentsParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostApiDocumentsResponse, error)
+
+	PostApiDocumentsWithResponse(ctx context.Context, params *PostApiDocumentsParams, body PostApiDocumentsJSONRequestBody, reqEditors ...RequestEditorFn) (*PostApiDocumentsResponse, error)
+
+	// GetApiDocumentsDependencyGraphWithResponse request
+	GetApiDocumentsDependencyGraphWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetApiDocumentsDependencyGraphResponse, error)
+
+	// PostApiDocumentsImportWithBodyWithResponse request with any body
+	PostApiDocumentsImportWithBodyWithResponse(ctx context.Context, params *PostApiDocumentsImportParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostApiDocumentsImportResponse, error)
+
+	// PutApiDocumentsOrderWithBodyWithResponse request with any body
+	PutApiDocumentsOrderWithBodyWithResponse(ctx context.Context, params *PutApiDocumentsOrderParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PutApiDocumentsOrderResponse, error)
+
+	PutApiDocumentsOrderWithResponse(ctx context.Context, params *PutApiDocumentsOrderParams, body PutApiDocumentsOrderJSONRequestBody, reqEditors ...RequestEditorFn) (*PutApiDocumentsOrderResponse, error)
+
+	// GetApiDocumentsRenewalCostsWithResponse request
+	GetApiDocumentsRenewalCostsWithResponse(ctx context.Context, params *GetApiDocumentsRenewalCostsParams, reqEditors ...RequestEditorFn) (*GetApiDocumentsRenewalCostsResponse, error)
+
+	// GetApiDocumentsStatsWithResponse request
+	GetApiDocumentsStatsWithResponse(ctx context.Context, params *GetApiDocumentsStatsParams, reqEditors ...RequestEditorFn) (*GetApiDocumentsStatsResponse, error)
+
+	// GetApiDocumentsTrashWithResponse request
+	GetApiDocumentsTrashWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetApiDocumentsTrashResponse, error)
+
+	// DeleteApiDocumentsIdWithResponse request
+	DeleteApiDocumentsIdWithResponse(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*DeleteApiDocumentsIdResponse, error)
+
+	// GetApiDocumentsIdWithResponse request
+	GetApiDocumentsIdWithResponse(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*GetApiDocumentsIdResponse, error)
+
+	// PutApiDocumentsIdWithBodyWithResponse request with any body
+	PutApiDocumentsIdWithBodyWithResponse(ctx context.Context, id openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PutApiDocumentsIdResponse, error)
+
+	PutApiDocumentsIdWithResponse(ctx context.Context, id openapi_types.UUID, body PutApiDocumentsIdJSONRequestBody, reqEditors ...RequestEditorFn) (*PutApiDocumentsIdResponse, error)
+
+	// DeleteApiDocumentsIdAssigneeWithResponse request
+	DeleteApiDocumentsIdAssigneeWithResponse(ctx context.Context, id openapi_types.UUID, params *DeleteApiDocumentsIdAssigneeParams, reqEditors ...RequestEditorFn) (*DeleteApiDocumentsIdAssigneeResponse, error)
+
+	// GetApiDocumentsIdAssigneeWithResponse request
+	GetApiDocumentsIdAssigneeWithResponse(ctx context.Context, id openapi_types.UUID, params *GetApiDocumentsIdAssigneeParams, reqEditors ...RequestEditorFn) (*GetApiDocumentsIdAssigneeResponse, error)
+
+	// PutApiDocumentsIdAssigneeWithBodyWithResponse request with any body
+	PutApiDocumentsIdAssigneeWithBodyWithResponse(ctx context.Context, id openapi_types.UUID, params *PutApiDocumentsIdAssigneeParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PutApiDocumentsIdAssigneeResponse, error)
+
+	PutApiDocumentsIdAssigneeWithResponse(ctx context.Context, id openapi_types.UUID, params *PutApiDocumentsIdAssigneeParams, body PutApiDocumentsIdAssigneeJSONRequestBody, reqEditors ...RequestEditorFn) (*PutApiDocumentsIdAssigneeResponse, error)
+
+	// PostApiDocumentsIdAssigneeAcknowledgeWithResponse request
+	PostApiDocumentsIdAssigneeAcknowledgeWithResponse(ctx context.Context, id openapi_types.UUID, params *PostApiDocumentsIdAssigneeAcknowledgeParams, reqEditors ...RequestEditorFn) (*PostApiDocumentsIdAssigneeAcknowledgeResponse, error)
+
+	// PutApiDocumentsIdAttachmentWithBodyWithResponse request with any body
+	PutApiDocumentsIdAttachmentWithBodyWithResponse(ctx context.Context, id openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PutApiDocumentsIdAttachmentResponse, error)
+
+	// GetApiDocumentsIdChecklistWithResponse request
+	GetApiDocumentsIdChecklistWithResponse(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*GetApiDocumentsIdChecklistResponse, error)
+
+	// PostApiDocumentsIdChecklistWithBodyWithResponse request with any body
+	PostApiDocumentsIdChecklistWithBodyWithResponse(ctx context.Context, id openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostApiDocumentsIdChecklistResponse, error)
+
+	PostApiDocumentsIdChecklistWithResponse(ctx context.Context, id openapi_types.UUID, body PostApiDocumentsIdChecklistJSONRequestBody, reqEditors ...RequestEditorFn) (*PostApiDocumentsIdChecklistResponse, error)
+
+	// DeleteApiDocumentsIdChecklistItemIdWithResponse request
+	DeleteApiDocumentsIdChecklistItemIdWithResponse(ctx context.Context, id openapi_types.UUID, itemId openapi_types.UUID, reqEditors ...RequestEditorFn) (*DeleteApiDocumentsIdChecklistItemIdResponse, error)
+
+	// PutApiDocumentsIdChecklistItemIdWithBodyWithResponse request with any body
+	PutApiDocumentsIdChecklistItemIdWithBodyWithResponse(ctx context.Context, id openapi_types.UUID, itemId openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PutApiDocumentsIdChecklistItemIdResponse, error)
+
+	PutApiDocumentsIdChecklistItemIdWithResponse(ctx context.Context, id openapi_types.UUID, itemId openapi_types.UUID, body PutApiDocumentsIdChecklistItemIdJSONRequestBody, reqEditors ...RequestEditorFn) (*PutApiDocumentsIdChecklistItemIdResponse, error)
+
+	// GetApiDocumentsIdContactsWithResponse request
+	GetApiDocumentsIdContactsWithResponse(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*GetApiDocumentsIdContactsResponse, error)
+
+	// PostApiDocumentsIdContactsWithBodyWithResponse request with any body
+	PostApiDocumentsIdContactsWithBodyWithResponse(ctx context.Context, id openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostApiDocumentsIdContactsResponse, error)
+
+	PostApiDocumentsIdContactsWithResponse(ctx context.Context, id openapi_types.UUID, body PostApiDocumentsIdContactsJSONRequestBody, reqEditors ...RequestEditorFn) (*PostApiDocumentsIdContactsResponse, error)
+
+	// DeleteApiDocumentsIdContactsContactIdWithResponse request
+	DeleteApiDocumentsIdContactsContactIdWithResponse(ctx context.Context, id openapi_types.UUID, contactId openapi_types.UUID, reqEditors ...RequestEditorFn) (*DeleteApiDocumentsIdContactsContactIdResponse, error)
+
+	// GetApiDocumentsIdDependenciesWithResponse request
+	GetApiDocumentsIdDependenciesWithResponse(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*GetApiDocumentsIdDependenciesResponse, error)
+
+	// PostApiDocumentsIdDependenciesWithBodyWithResponse request with any body
+	PostApiDocumentsIdDependenciesWithBodyWithResponse(ctx context.Context, id openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostApiDocumentsIdDependenciesResponse, error)
+
+	PostApiDocumentsIdDependenciesWithResponse(ctx context.Context, id openapi_types.UUID, body PostApiDocumentsIdDependenciesJSONRequestBody, reqEditors ...RequestEditorFn) (*PostApiDocumentsIdDependenciesResponse, error)
+
+	// DeleteApiDocumentsIdDependenciesDependsOnIdWithResponse request
+	DeleteApiDocumentsIdDependenciesDependsOnIdWithResponse(ctx context.Context, id openapi_types.UUID, dependsOnId openapi_types.UUID, reqEditors ...RequestEditorFn) (*DeleteApiDocumentsIdDependenciesDependsOnIdResponse, error)
+
+	// GetApiDocumentsIdLegalHoldWithResponse request
+	GetApiDocumentsIdLegalHoldWithResponse(ctx context.Context, id openapi_types.UUID, params *GetApiDocumentsIdLegalHoldParams, reqEditors ...RequestEditorFn) (*GetApiDocumentsIdLegalHoldResponse, error)
+
+	// PutApiDocumentsIdLegalHoldWithBodyWithResponse request with any body
+	PutApiDocumentsIdLegalHoldWithBodyWithResponse(ctx context.Context, id openapi_types.UUID, params *PutApiDocumentsIdLegalHoldParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PutApiDocumentsIdLegalHoldResponse, error)
+
+	PutApiDocumentsIdLegalHoldWithResponse(ctx context.Context, id openapi_types.UUID, params *PutApiDocumentsIdLegalHoldParams, body PutApiDocumentsIdLegalHoldJSONRequestBody, reqEditors ...RequestEditorFn) (*PutApiDocumentsIdLegalHoldResponse, error)
+
+	// DeleteApiDocumentsIdLockWithResponse request
+	DeleteApiDocumentsIdLockWithResponse(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*DeleteApiDocumentsIdLockResponse, error)
+
+	// PostApiDocumentsIdLockWithBodyWithResponse request with any body
+	PostApiDocumentsIdLockWithBodyWithResponse(ctx context.Context, id openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostApiDocumentsIdLockResponse, error)
+
+	PostApiDocumentsIdLockWithResponse(ctx context.Context, id openapi_types.UUID, body PostApiDocumentsIdLockJSONRequestBody, reqEditors ...RequestEditorFn) (*PostApiDocumentsIdLockResponse, error)
+
+	// DeleteApiDocumentsIdPinWithResponse request
+	DeleteApiDocumentsIdPinWithResponse(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*DeleteApiDocumentsIdPinResponse, error)
+
+	// PostApiDocumentsIdPinWithResponse request
+	PostApiDocumentsIdPinWithResponse(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*PostApiDocumentsIdPinResponse, error)
+
+	// GetApiDocumentsIdRemindersWithResponse request
+	GetApiDocumentsIdRemindersWithResponse(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*GetApiDocumentsIdRemindersResponse, error)
+
+	// PutApiDocumentsIdRemindersWithBodyWithResponse request with any body
+	PutApiDocumentsIdRemindersWithBodyWithResponse(ctx context.Context, id openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PutApiDocumentsIdRemindersResponse, error)
+
+	PutApiDocumentsIdRemindersWithResponse(ctx context.Context, id openapi_types.UUID, body PutApiDocumentsIdRemindersJSONRequestBody, reqEditors ...RequestEditorFn) (*PutApiDocumentsIdRemindersResponse, error)
+
+	// GetApiDocumentsIdRemindersPreviewWithResponse request
+	GetApiDocumentsIdRemindersPreviewWithResponse(ctx context.Context, id openapi_types.UUID, params *GetApiDocumentsIdRemindersPreviewParams, reqEditors ...RequestEditorFn) (*GetApiDocumentsIdRemindersPreviewResponse, error)
+
+	// GetApiDocumentsIdRenewalsWithResponse request
+	GetApiDocumentsIdRenewalsWithResponse(ctx context.Context, id openapi_types.UUID, params *GetApiDocumentsIdRenewalsParams, reqEditors ...RequestEditorFn) (*GetApiDocumentsIdRenewalsResponse, error)
+
+	// PostApiDocumentsIdRenewalsWithBodyWithResponse request with any body
+	PostApiDocumentsIdRenewalsWithBodyWithResponse(ctx context.Context, id openapi_types.UUID, params *PostApiDocumentsIdRenewalsParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostApiDocumentsIdRenewalsResponse, error)
+
+	// PostApiDocumentsIdRenewalsRenewalIdApproveWithBodyWithResponse request with any body
+	PostApiDocumentsIdRenewalsRenewalIdApproveWithBodyWithResponse(ctx context.Context, id openapi_types.UUID, renewalId openapi_types.UUID, params *PostApiDocumentsIdRenewalsRenewalIdApproveParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostApiDocumentsIdRenewalsRenewalIdApproveResponse, error)
+
+	PostApiDocumentsIdRenewalsRenewalIdApproveWithResponse(ctx context.Context, id openapi_types.UUID, renewalId openapi_types.UUID, params *PostApiDocumentsIdRenewalsRenewalIdApproveParams, body PostApiDocumentsIdRenewalsRenewalIdApproveJSONRequestBody, reqEditors ...RequestEditorFn) (*PostApiDocumentsIdRenewalsRenewalIdApproveResponse, error)
+
+	// PostApiDocumentsIdRenewalsRenewalIdRejectWithBodyWithResponse request with any body
+	PostApiDocumentsIdRenewalsRenewalIdRejectWithBodyWithResponse(ctx context.Context, id openapi_types.UUID, renewalId openapi_types.UUID, params *PostApiDocumentsIdRenewalsRenewalIdRejectParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostApiDocumentsIdRenewalsRenewalIdRejectResponse, error)
+
+	PostApiDocumentsIdRenewalsRenewalIdRejectWithResponse(ctx context.Context, id openapi_types.UUID, renewalId openapi_types.UUID, params *PostApiDocumentsIdRenewalsRenewalIdRejectParams, body PostApiDocumentsIdRenewalsRenewalIdRejectJSONRequestBody, reqEditors ...RequestEditorFn) (*PostApiDocumentsIdRenewalsRenewalIdRejectResponse, error)
+
+	// PostApiDocumentsIdRestoreWithResponse request
+	PostApiDocumentsIdRestoreWithResponse(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*PostApiDocumentsIdRestoreResponse, error)
+
+	// DeleteApiFeedWithResponse request
+	DeleteApiFeedWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*DeleteApiFeedResponse, error)
+
+	// GetApiFeedWithResponse request
+	GetApiFeedWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetApiFeedResponse, error)
+
+	// PostApiFeedWithResponse request
+	PostApiFeedWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*PostApiFeedResponse, error)
+
+	// PostApiFeedbackWithBodyWithResponse request with any body
+	PostApiFeedbackWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostApiFeedbackResponse, error)
+
+	PostApiFeedbackWithResponse(ctx context.Context, body PostApiFeedbackJSONRequestBody, reqEditors ...RequestEditorFn) (*PostApiFeedbackResponse, error)
+
+	// GetApiFeedsTokenAtomXmlWithResponse request
+	GetApiFeedsTokenAtomXmlWithResponse(ctx context.Context, token string, reqEditors ...RequestEditorFn) (*GetApiFeedsTokenAtomXmlResponse, error)
+
+	// GetApiFeedsTokenRssXmlWithResponse request
+	GetApiFeedsTokenRssXmlWithResponse(ctx context.Context, token string, reqEditors ...RequestEditorFn) (*GetApiFeedsTokenRssXmlResponse, error)
+
+	// PostApiGraphqlWithBodyWithResponse request with any body
+	PostApiGraphqlWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostApiGraphqlResponse, error)
+
+	PostApiGraphqlWithResponse(ctx context.Context, body PostApiGraphqlJSONRequestBody, reqEditors ...RequestEditorFn) (*PostApiGraphqlResponse, error)
+
+	// GetApiHouseholdWithResponse request
+	GetApiHouseholdWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetApiHouseholdResponse, error)
+
+	// PostApiHouseholdWithBodyWithResponse request with any body
+	PostApiHouseholdWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostApiHouseholdResponse, error)
+
+	PostApiHouseholdWithResponse(ctx context.Context, body PostApiHouseholdJSONRequestBody, reqEditors ...RequestEditorFn) (*PostApiHouseholdResponse, error)
+
+	// PostApiHouseholdMembersWithBodyWithResponse request with any body
+	PostApiHouseholdMembersWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostApiHouseholdMembersResponse, error)
+
+	PostApiHouseholdMembersWithResponse(ctx context.Context, body PostApiHouseholdMembersJSONRequestBody, reqEditors ...RequestEditorFn) (*PostApiHouseholdMembersResponse, error)
+
+	// DeleteApiHouseholdMembersUserIdWithResponse request
+	DeleteApiHouseholdMembersUserIdWithResponse(ctx context.Context, userId openapi_types.UUID, reqEditors ...RequestEditorFn) (*DeleteApiHouseholdMembersUserIdResponse, error)
+
+	// PutApiHouseholdMembersUserIdWithBodyWithResponse request with any body
+	PutApiHouseholdMembersUserIdWithBodyWithResponse(ctx context.Context, userId openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PutApiHouseholdMembersUserIdResponse, error)
+
+	PutApiHouseholdMembersUserIdWithResponse(ctx context.Context, userId openapi_types.UUID, body PutApiHouseholdMembersUserIdJSONRequestBody, reqEditors ...RequestEditorFn) (*PutApiHouseholdMembersUserIdResponse, error)
+
+	// GetApiHouseholdMembersUserIdDocumentsWithResponse request
+	GetApiHouseholdMembersUserIdDocumentsWithResponse(ctx context.Context, userId openapi_types.UUID, reqEditors ...RequestEditorFn) (*GetApiHouseholdMembersUserIdDocumentsResponse, error)
+
+	// GetApiIntegrationsEgressIpsWithResponse request
+	GetApiIntegrationsEgressIpsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetApiIntegrationsEgressIpsResponse, error)
+
+	// GetApiIssuersWithResponse request
+	GetApiIssuersWithResponse(ctx context.Context, params *GetApiIssuersParams, reqEditors ...RequestEditorFn) (*GetApiIssuersResponse, error)
+
+	// GetApiJobsWithResponse request
+	GetApiJobsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetApiJobsResponse, error)
+
+	// PostApiJobsImportWithBodyWithResponse request with any body
+	PostApiJobsImportWithBodyWithResponse(ctx context.Context, params *PostApiJobsImportParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostApiJobsImportResponse, error)
+
+	// PostApiJobsTakeoutWithResponse request
+	PostApiJobsTakeoutWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*PostApiJobsTakeoutResponse, error)
+
+	// GetApiJobsIdWithResponse request
+	GetApiJobsIdWithResponse(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*GetApiJobsIdResponse, error)
+
+	// GetApiLinksTokenWithResponse request
+	GetApiLinksTokenWithResponse(ctx context.Context, token string, params *GetApiLinksTokenParams, reqEditors ...RequestEditorFn) (*GetApiLinksTokenResponse, error)
+
+	// PostApiLinksTokenWithResponse request
+	PostApiLinksTokenWithResponse(ctx context.Context, token string, params *PostApiLinksTokenParams, reqEditors ...RequestEditorFn) (*PostApiLinksTokenResponse, error)
+
+	// PostApiNotificationsReadWithResponse request
+	PostApiNotificationsReadWithResponse(ctx context.Context, params *PostApiNotificationsReadParams, reqEditors ...RequestEditorFn) (*PostApiNotificationsReadResponse, error)
+
+	// GetApiNotificationsUnreadCountWithResponse request
+	GetApiNotificationsUnreadCountWithResponse(ctx context.Context, params *GetApiNotificationsUnreadCountParams, reqEditors ...RequestEditorFn) (*GetApiNotificationsUnreadCountResponse, error)
+
+	// DeleteApiOnboardingSampleDataWithResponse request
+	DeleteApiOnboardingSampleDataWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*DeleteApiOnboardingSampleDataResponse, error)
+
+	// PostApiOnboardingSampleDataWithResponse request
+	PostApiOnboardingSampleDataWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*PostApiOnboardingSampleDataResponse, error)
+
+	// GetApiOrganizationsWithResponse request
+	GetApiOrganizationsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetApiOrganizationsResponse, error)
+
+	// PostApiOrganizationsWithBodyWithResponse request with any body
+	PostApiOrganizationsWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostApiOrganizationsResponse, error)
+
+	PostApiOrganizationsWithResponse(ctx context.Context, body PostApiOrganizationsJSONRequestBody, reqEditors ...RequestEditorFn) (*PostApiOrganizationsResponse, error)
+
+	// PutApiOrganizationsIdComplianceWithBodyWithResponse request with any body
+	PutApiOrganizationsIdComplianceWithBodyWithResponse(ctx context.Context, id openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PutApiOrganizationsIdComplianceResponse, error)
+
+	PutApiOrganizationsIdComplianceWithResponse(ctx context.Context, id openapi_types.UUID, body PutApiOrganizationsIdComplianceJSONRequestBody, reqEditors ...RequestEditorFn) (*PutApiOrganizationsIdComplianceResponse, error)
+
+	// DeleteApiOrganizationsIdEmailSenderWithResponse request
+	DeleteApiOrganizationsIdEmailSenderWithResponse(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*DeleteApiOrganizationsIdEmailSenderResponse, error)
+
+	// GetApiOrganizationsIdEmailSenderWithResponse request
+	GetApiOrganizationsIdEmailSenderWithResponse(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*GetApiOrganizationsIdEmailSenderResponse, error)
+
+	// PutApiOrganizationsIdEmailSenderWithBodyWithResponse request with any body
+	PutApiOrganizationsIdEmailSenderWithBodyWithResponse(ctx context.Context, id openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PutApiOrganizationsIdEmailSenderResponse, error)
+
+	PutApiOrganizationsIdEmailSenderWithResponse(ctx context.Context, id openapi_types.UUID, body PutApiOrganizationsIdEmailSenderJSONRequestBody, reqEditors ...RequestEditorFn) (*PutApiOrganizationsIdEmailSenderResponse, error)
+
+	// PostApiOrganizationsIdEmailSenderVerifyWithResponse request
+	PostApiOrganizationsIdEmailSenderVerifyWithResponse(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*PostApiOrganizationsIdEmailSenderVerifyResponse, error)
+
+	// GetApiOrganizationsIdEscalationPolicyWithResponse request
+	GetApiOrganizationsIdEscalationPolicyWithResponse(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*GetApiOrganizationsIdEscalationPolicyResponse, error)
+
+	// PutApiOrganizationsIdEscalationPolicyWithBodyWithResponse request with any body
+	PutApiOrganizationsIdEscalationPolicyWithBodyWithResponse(ctx context.Context, id openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PutApiOrganizationsIdEscalationPolicyResponse, error)
+
+	PutApiOrganizationsIdEscalationPolicyWithResponse(ctx context.Context, id openapi_types.UUID, body PutApiOrganizationsIdEscalationPolicyJSONRequestBody, reqEditors ...RequestEditorFn) (*PutApiOrganizationsIdEscalationPolicyResponse, error)
+
+	// GetApiOrganizationsIdEventLogExportWithResponse request
+	GetApiOrganizationsIdEventLogExportWithResponse(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*GetApiOrganizationsIdEventLogExportResponse, error)
+
+	// GetApiOrganizationsIdLegalHoldWithResponse request
+	GetApiOrganizationsIdLegalHoldWithResponse(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*GetApiOrganizationsIdLegalHoldResponse, error)
+
+	// PutApiOrganizationsIdLegalHoldWithBodyWithResponse request with any body
+	PutApiOrganizationsIdLegalHoldWithBodyWithResponse(ctx context.Context, id openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PutApiOrganizationsIdLegalHoldResponse, error)
+
+	PutApiOrganizationsIdLegalHoldWithResponse(ctx context.Context, id openapi_types.UUID, body PutApiOrganizationsIdLegalHoldJSONRequestBody, reqEditors ...RequestEditorFn) (*PutApiOrganizationsIdLegalHoldResponse, error)
+
+	// GetApiOrganizationsIdMembersWithResponse request
+	GetApiOrganizationsIdMembersWithResponse(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*GetApiOrganizationsIdMembersResponse, error)
+
+	// PostApiOrganizationsIdMembersWithBodyWithResponse request with any body
+	PostApiOrganizationsIdMembersWithBodyWithResponse(ctx context.Context, id openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostApiOrganizationsIdMembersResponse, error)
+
+	PostApiOrganizationsIdMembersWithResponse(ctx context.Context, id openapi_types.UUID, body PostApiOrganizationsIdMembersJSONRequestBody, reqEditors ...RequestEditorFn) (*PostApiOrganizationsIdMembersResponse, error)
+
+	// DeleteApiOrganizationsIdMembersUserIdWithResponse request
+	DeleteApiOrganizationsIdMembersUserIdWithResponse(ctx context.Context, id openapi_types.UUID, userId openapi_types.UUID, reqEditors ...RequestEditorFn) (*DeleteApiOrganizationsIdMembersUserIdResponse, error)
+
+	// DeleteApiOrganizationsIdNotificationThemeWithResponse request
+	DeleteApiOrganizationsIdNotificationThemeWithResponse(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*DeleteApiOrganizationsIdNotificationThemeResponse, error)
+
+	// GetApiOrganizationsIdNotificationThemeWithResponse request
+	GetApiOrganizationsIdNotificationThemeWithResponse(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*GetApiOrganizationsIdNotificationThemeResponse, error)
+
+	// PutApiOrganizationsIdNotificationThemeWithBodyWithResponse request with any body
+	PutApiOrganizationsIdNotificationThemeWithBodyWithResponse(ctx context.Context, id openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PutApiOrganizationsIdNotificationThemeResponse, error)
+
+	PutApiOrganizationsIdNotificationThemeWithResponse(ctx context.Context, id openapi_types.UUID, body PutApiOrganizationsIdNotificationThemeJSONRequestBody, reqEditors ...RequestEditorFn) (*PutApiOrganizationsIdNotificationThemeResponse, error)
+
+	// PutApiOrganizationsIdRenewalApprovalWithBodyWithResponse request with any body
+	PutApiOrganizationsIdRenewalApprovalWithBodyWithResponse(ctx context.Context, id openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PutApiOrganizationsIdRenewalApprovalResponse, error)
+
+	PutApiOrganizationsIdRenewalApprovalWithResponse(ctx context.Context, id openapi_types.UUID, body PutApiOrganizationsIdRenewalApprovalJSONRequestBody, reqEditors ...RequestEditorFn) (*PutApiOrganizationsIdRenewalApprovalResponse, error)
+
+	// GetApiOrganizationsIdRetentionWithResponse request
+	GetApiOrganizationsIdRetentionWithResponse(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*GetApiOrganizationsIdRetentionResponse, error)
+
+	// PutApiOrganizationsIdRetentionWithBodyWithResponse request with any body
+	PutApiOrganizationsIdRetentionWithBodyWithResponse(ctx context.Context, id openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PutApiOrganizationsIdRetentionResponse, error)
+
+	PutApiOrganizationsIdRetentionWithResponse(ctx context.Context, id openapi_types.UUID, body PutApiOrganizationsIdRetentionJSONRequestBody, reqEditors ...RequestEditorFn) (*PutApiOrganizationsIdRetentionResponse, error)
+
+	// DeleteApiOrganizationsIdScimTokenWithResponse request
+	DeleteApiOrganizationsIdScimTokenWithResponse(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*DeleteApiOrganizationsIdScimTokenResponse, error)
+
+	// PostApiOrganizationsIdScimTokenWithResponse request
+	PostApiOrganizationsIdScimTokenWithResponse(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*PostApiOrganizationsIdScimTokenResponse, error)
+
+	// GetApiOrganizationsIdServiceAccountsWithResponse request
+	GetApiOrganizationsIdServiceAccountsWithResponse(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*GetApiOrganizationsIdServiceAccountsResponse, error)
+
+	// PostApiOrganizationsIdServiceAccountsWithBodyWithResponse request with any body
+	PostApiOrganizationsIdServiceAccountsWithBodyWithResponse(ctx context.Context, id openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostApiOrganizationsIdServiceAccountsResponse, error)
+
+	PostApiOrganizationsIdServiceAccountsWithResponse(ctx context.Context, id openapi_types.UUID, body PostApiOrganizationsIdServiceAccountsJSONRequestBody, reqEditors ...RequestEditorFn) (*PostApiOrganizationsIdServiceAccountsResponse, error)
+
+	// DeleteApiOrganizationsIdServiceAccountsAccountIdTokenWithResponse request
+	DeleteApiOrganizationsIdServiceAccountsAccountIdTokenWithResponse(ctx context.Context, id openapi_types.UUID, accountId openapi_types.UUID, reqEditors ...RequestEditorFn) (*DeleteApiOrganizationsIdServiceAccountsAccountIdTokenResponse, error)
+
+	// PostApiOrganizationsIdServiceAccountsAccountIdTokenWithResponse request
+	PostApiOrganizationsIdServiceAccountsAccountIdTokenWithResponse(ctx context.Context, id openapi_types.UUID, accountId openapi_types.UUID, reqEditors ...RequestEditorFn) (*PostApiOrganizationsIdServiceAccountsAccountIdTokenResponse, error)
+
+	// GetApiOrganizationsIdUsageExportWithResponse request
+	GetApiOrganizationsIdUsageExportWithResponse(ctx context.Context, id openapi_types.UUID, params *GetApiOrganizationsIdUsageExportParams, reqEditors ...RequestEditorFn) (*GetApiOrganizationsIdUsageExportResponse, error)
+
+	// GetApiPreferencesLocaleWithResponse request
+	GetApiPreferencesLocaleWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetApiPreferencesLocaleResponse, error)
+
+	// PutApiPreferencesLocaleWithBodyWithResponse request with any body
+	PutApiPreferencesLocaleWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PutApiPreferencesLocaleResponse, error)
+
+	PutApiPreferencesLocaleWithResponse(ctx context.Context, body PutApiPreferencesLocaleJSONRequestBody, reqEditors ...RequestEditorFn) (*PutApiPreferencesLocaleResponse, error)
+
+	// GetApiPreferencesNotificationsWithResponse request
+	GetApiPreferencesNotificationsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetApiPreferencesNotificationsResponse, error)
+
+	// PutApiPreferencesNotificationsWithBodyWithResponse request with any body
+	PutApiPreferencesNotificationsWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PutApiPreferencesNotificationsResponse, error)
+
+	PutApiPreferencesNotificationsWithResponse(ctx context.Context, body PutApiPreferencesNotificationsJSONRequestBody, reqEditors ...RequestEditorFn) (*PutApiPreferencesNotificationsResponse, error)
+
+	// GetApiRoadmapWithResponse request
+	GetApiRoadmapWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetApiRoadmapResponse, error)
+
+	// DeleteApiRoadmapIdVoteWithResponse request
+	DeleteApiRoadmapIdVoteWithResponse(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*DeleteApiRoadmapIdVoteResponse, error)
+
+	// PostApiRoadmapIdVoteWithResponse request
+	PostApiRoadmapIdVoteWithResponse(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*PostApiRoadmapIdVoteResponse, error)
+
+	// GetApiTemplatesWithResponse request
+	GetApiTemplatesWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetApiTemplatesResponse, error)
+
+	// GetApiTrackOpenMessageIdWithResponse request
+	GetApiTrackOpenMessageIdWithResponse(ctx context.Context, messageId openapi_types.UUID, reqEditors ...RequestEditorFn) (*GetApiTrackOpenMessageIdResponse, error)
+
+	// GetApiUnsubscribeTokenWithResponse request
+	GetApiUnsubscribeTokenWithResponse(ctx context.Context, token string, reqEditors ...RequestEditorFn) (*GetApiUnsubscribeTokenResponse, error)
+
+	// PostApiUnsubscribeTokenWithResponse request
+	PostApiUnsubscribeTokenWithResponse(ctx context.Context, token string, reqEditors ...RequestEditorFn) (*PostApiUnsubscribeTokenResponse, error)
+
+	// GetApiUsageWithResponse request
+	GetApiUsageWithResponse(ctx context.Context, params *GetApiUsageParams, reqEditors ...RequestEditorFn) (*GetApiUsageResponse, error)
+
+	// GetApiViewsWithResponse request
+	GetApiViewsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetApiViewsResponse, error)
+
+	// PostApiViewsWithBodyWithResponse request with any body
+	PostApiViewsWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostApiViewsResponse, error)
+
+	PostApiViewsWithResponse(ctx context.Context, body PostApiViewsJSONRequestBody, reqEditors ...RequestEditorFn) (*PostApiViewsResponse, error)
+
+	// DeleteApiViewsIdWithResponse request
+	DeleteApiViewsIdWithResponse(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*DeleteApiViewsIdResponse, error)
+
+	// GetApiViewsIdWithResponse request
+	GetApiViewsIdWithResponse(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*GetApiViewsIdResponse, error)
+
+	// PutApiViewsIdWithBodyWithResponse request with any body
+	PutApiViewsIdWithBodyWithResponse(ctx context.Context, id openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PutApiViewsIdResponse, error)
+
+	PutApiViewsIdWithResponse(ctx context.Context, id openapi_types.UUID, body PutApiViewsIdJSONRequestBody, reqEditors ...RequestEditorFn) (*PutApiViewsIdResponse, error)
+
+	// GetApiViewsIdDocumentsWithResponse request
+	GetApiViewsIdDocumentsWithResponse(ctx context.Context, id openapi_types.UUID, params *GetApiViewsIdDocumentsParams, reqEditors ...RequestEditorFn) (*GetApiViewsIdDocumentsResponse, error)
+
+	// PostApiWebhooksEmailBounceWithBodyWithResponse request with any body
+	PostApiWebhooksEmailBounceWithBodyWithResponse(ctx context.Context, params *PostApiWebhooksEmailBounceParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostApiWebhooksEmailBounceResponse, error)
+
+	PostApiWebhooksEmailBounceWithResponse(ctx context.Context, params *PostApiWebhooksEmailBounceParams, body PostApiWebhooksEmailBounceJSONRequestBody, reqEditors ...RequestEditorFn) (*PostApiWebhooksEmailBounceResponse, error)
+
+	// GetApiWebhooksEndpointsWithResponse request
+	GetApiWebhooksEndpointsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetApiWebhooksEndpointsResponse, error)
+
+	// PostApiWebhooksEndpointsWithBodyWithResponse request with any body
+	PostApiWebhooksEndpointsWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostApiWebhooksEndpointsResponse, error)
+
+	PostApiWebhooksEndpointsWithResponse(ctx context.Context, body PostApiWebhooksEndpointsJSONRequestBody, reqEditors ...RequestEditorFn) (*PostApiWebhooksEndpointsResponse, error)
+
+	// DeleteApiWebhooksEndpointsIdWithResponse request
+	DeleteApiWebhooksEndpointsIdWithResponse(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*DeleteApiWebhooksEndpointsIdResponse, error)
+
+	// PutApiWebhooksEndpointsIdWithBodyWithResponse request with any body
+	PutApiWebhooksEndpointsIdWithBodyWithResponse(ctx context.Context, id openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PutApiWebhooksEndpointsIdResponse, error)
+
+	PutApiWebhooksEndpointsIdWithResponse(ctx context.Context, id openapi_types.UUID, body PutApiWebhooksEndpointsIdJSONRequestBody, reqEditors ...RequestEditorFn) (*PutApiWebhooksEndpointsIdResponse, error)
+
+	// GetApiWebhooksEndpointsIdDeliveriesWithResponse request
+	GetApiWebhooksEndpointsIdDeliveriesWithResponse(ctx context.Context, id openapi_types.UUID, params *GetApiWebhooksEndpointsIdDeliveriesParams, reqEditors ...RequestEditorFn) (*GetApiWebhooksEndpointsIdDeliveriesResponse, error)
+
+	// PostApiWebhooksEndpointsIdDeliveriesDeliveryIdRedeliverWithResponse request
+	PostApiWebhooksEndpointsIdDeliveriesDeliveryIdRedeliverWithResponse(ctx context.Context, id openapi_types.UUID, deliveryId openapi_types.UUID, reqEditors ...RequestEditorFn) (*PostApiWebhooksEndpointsIdDeliveriesDeliveryIdRedeliverResponse, error)
+
+	// PostApiWebhooksEndpointsIdRotateSecretWithBodyWithResponse request with any body
+	PostApiWebhooksEndpointsIdRotateSecretWithBodyWithResponse(ctx context.Context, id openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostApiWebhooksEndpointsIdRotateSecretResponse, error)
+
+	PostApiWebhooksEndpointsIdRotateSecretWithResponse(ctx context.Context, id openapi_types.UUID, body PostApiWebhooksEndpointsIdRotateSecretJSONRequestBody, reqEditors ...RequestEditorFn) (*PostApiWebhooksEndpointsIdRotateSecretResponse, error)
+
+	// PostApiWebhooksTwilioSmsWithBodyWithResponse request with any body
+	PostApiWebhooksTwilioSmsWithBodyWithResponse(ctx context.Context, params *PostApiWebhooksTwilioSmsParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostApiWebhooksTwilioSmsResponse, error)
+
+	PostApiWebhooksTwilioSmsWithFormdataBodyWithResponse(ctx context.Context, params *PostApiWebhooksTwilioSmsParams, body PostApiWebhooksTwilioSmsFormdataRequestBody, reqEditors ...RequestEditorFn) (*PostApiWebhooksTwilioSmsResponse, error)
+
+	// GetHealthWithResponse request
+	GetHealthWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetHealthResponse, error)
+
+	// GetReadyWithResponse request
+	GetReadyWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetReadyResponse, error)
+
+	// GetReminderIntervalsWithResponse request
+	GetReminderIntervalsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetReminderIntervalsResponse, error)
+
+	// GetScimV2ServiceProviderConfigWithResponse request
+	GetScimV2ServiceProviderConfigWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetScimV2ServiceProviderConfigResponse, error)
+
+	// GetScimV2UsersWithResponse request
+	GetScimV2UsersWithResponse(ctx context.Context, params *GetScimV2UsersParams, reqEditors ...RequestEditorFn) (*GetScimV2UsersResponse, error)
+
+	// PostScimV2UsersWithBodyWithResponse request with any body
+	PostScimV2UsersWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostScimV2UsersResponse, error)
+
+	PostScimV2UsersWithApplicationScimPlusJSONBodyWithResponse(ctx context.Context, body PostScimV2UsersApplicationScimPlusJSONRequestBody, reqEditors ...RequestEditorFn) (*PostScimV2UsersResponse, error)
+
+	// DeleteScimV2UsersUserIdWithResponse request
+	DeleteScimV2UsersUserIdWithResponse(ctx context.Context, userId openapi_types.UUID, reqEditors ...RequestEditorFn) (*DeleteScimV2UsersUserIdResponse, error)
+
+	// GetScimV2UsersUserIdWithResponse request
+	GetScimV2UsersUserIdWithResponse(ctx context.Context, userId openapi_types.UUID, reqEditors ...RequestEditorFn) (*GetScimV2UsersUserIdResponse, error)
+
+	// PatchScimV2UsersUserIdWithBodyWithResponse request with any body
+	PatchScimV2UsersUserIdWithBodyWithResponse(ctx context.Context, userId openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PatchScimV2UsersUserIdResponse, error)
+
+	PatchScimV2UsersUserIdWithApplicationScimPlusJSONBodyWithResponse(ctx context.Context, userId openapi_types.UUID, body PatchScimV2UsersUserIdApplicationScimPlusJSONRequestBody, reqEditors ...RequestEditorFn) (*PatchScimV2UsersUserIdResponse, error)
+
+	// PutScimV2UsersUserIdWithBodyWithResponse request with any body
+	PutScimV2UsersUserIdWithBodyWithResponse(ctx context.Context, userId openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PutScimV2UsersUserIdResponse, error)
+
+	PutScimV2UsersUserIdWithApplicationScimPlusJSONBodyWithResponse(ctx context.Context, userId openapi_types.UUID, body PutScimV2UsersUserIdApplicationScimPlusJSONRequestBody, reqEditors ...RequestEditorFn) (*PutScimV2UsersUserIdResponse, error)
+}
+
+type GetWellKnownJwksJsonResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *JWKSet
+}
+
+// Status returns HTTPResponse.Status
+func (r GetWellKnownJwksJsonResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetWellKnownJwksJsonResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetApiAdminAnnouncementsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *struct {
+		Announcements *[]Announcement `json:"announcements,omitempty"`
+		Message       *string         `json:"message,omitempty"`
+	}
+}
+
+// Status returns HTTPResponse.Status
+func (r GetApiAdminAnnouncementsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetApiAdminAnnouncementsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostApiAdminAnnouncementsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *struct {
+		Announcement *Announcement `json:"announcement,omitempty"`
+		Message      *string       `json:"message,omitempty"`
+	}
+}
+
+// Status returns HTTPResponse.Status
+func (r PostApiAdminAnnouncementsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostApiAdminAnnouncementsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteApiAdminAnnouncementsIdResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteApiAdminAnnouncementsIdResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteApiAdminAnnouncementsIdResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetApiAdminDeprecationsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *struct {
+		Deprecations *[]DeprecatedEndpoint `json:"deprecations,omitempty"`
+		Message      *string               `json:"message,omitempty"`
+		WindowDays   *int                  `json:"windowDays,omitempty"`
+	}
+}
+
+// Status returns HTTPResponse.Status
+func (r GetApiAdminDeprecationsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetApiAdminDeprecationsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetApiAdminHealthResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *struct {
+		Alerts    *[]FiringAlert              `json:"alerts,omitempty"`
+		Database  *string                     `json:"database,omitempty"`
+		Message   *string                     `json:"message,omitempty"`
+		Providers *[]NotificationProvider     `json:"providers,omitempty"`
+		Queues    *[]QueueStats               `json:"queues,omitempty"`
+		Redis     *string                     `json:"redis,omitempty"`
+		Status    *GetApiAdminHealth200Status `json:"status,omitempty"`
+		Timestamp *time.Time                  `json:"timestamp,omitempty"`
+	}
+}
+type GetApiAdminHealth200Status string
+
+// Status returns HTTPResponse.Status
+func (r GetApiAdminHealthResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetApiAdminHealthResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostApiAdminImpersonateResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *struct {
+		ExpiresAt *time.Time `json:"expiresAt,omitempty"`
+		Message   *string    `json:"message,omitempty"`
+		Token     *string    `json:"token,omitempty"`
+	}
+}
+
+// Status returns HTTPResponse.Status
+func (r PostApiAdminImpersonateResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostApiAdminImpersonateResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostApiAdminIssuersResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *struct {
+		Issuer  *Issuer `json:"issuer,omitempty"`
+		Message *string `json:"message,omitempty"`
+	}
+}
+
+// Status returns HTTPResponse.Status
+func (r PostApiAdminIssuersResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostApiAdminIssuersResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteApiAdminIssuersIdResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteApiAdminIssuersIdResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteApiAdminIssuersIdResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PutApiAdminIssuersIdResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *struct {
+		Issuer  *Issuer `json:"issuer,omitempty"`
+		Message *string `json:"message,omitempty"`
+	}
+}
+
+// Status returns HTTPResponse.Status
+func (r PutApiAdminIssuersIdResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PutApiAdminIssuersIdResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetApiAdminLoadSheddingResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *struct {
+		Message    *string         `json:"message,omitempty"`
+		Reasons    *[]ShedRequests `json:"reasons,omitempty"`
+		WindowDays *int            `json:"windowDays,omitempty"`
+	}
+}
+
+// Status returns HTTPResponse.Status
+func (r GetApiAdminLoadSheddingResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetApiAdminLoadSheddingResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetApiAdminNotificationProvidersResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *struct {
+		Message   *string                 `json:"message,omitempty"`
+		Providers *[]NotificationProvider `json:"providers,omitempty"`
+	}
+}
+
+// Status returns HTTPResponse.Status
+func (r GetApiAdminNotificationProvidersResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetApiAdminNotificationProvidersResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostApiAdminRoadmapResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *struct {
+		Feature *RoadmapFeature `json:"feature,omitempty"`
+		Message *string         `json:"message,omitempty"`
+	}
+}
+
+// Status returns HTTPResponse.Status
+func (r PostApiAdminRoadmapResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostApiAdminRoadmapResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteApiAdminRoadmapIdResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteApiAdminRoadmapIdResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteApiAdminRoadmapIdResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PutApiAdminRoadmapIdResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *struct {
+		Feature *RoadmapFeature `json:"feature,omitempty"`
+		Message *string         `json:"message,omitempty"`
+	}
+}
+
+// Status returns HTTPResponse.Status
+func (r PutApiAdminRoadmapIdResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PutApiAdminRoadmapIdResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostApiAdminScheduledJobsNameRunResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r PostApiAdminScheduledJobsNameRunResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostApiAdminScheduledJobsNameRunResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetApiAdminTasksFailedResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *struct {
+		Message *string       `json:"message,omitempty"`
+		Tasks   *[]FailedTask `json:"tasks,omitempty"`
+	}
+}
+
+// Status returns HTTPResponse.Status
+func (r GetApiAdminTasksFailedResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetApiAdminTasksFailedResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostApiAdminTasksQueueTaskIdRequeueResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r PostApiAdminTasksQueueTaskIdRequeueResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostApiAdminTasksQueueTaskIdRequeueResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetApiAdminUsageExportResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *UsageExport
+}
+
+// Status returns HTTPResponse.Status
+func (r GetApiAdminUsageExportResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetApiAdminUsageExportResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PutApiAdminUsersIdPlanResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *struct {
+		Message *string `json:"message,omitempty"`
+		Plan    *string `json:"plan,omitempty"`
+	}
+}
+
+// Status returns HTTPResponse.Status
+func (r PutApiAdminUsersIdPlanResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PutApiAdminUsersIdPlanResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostApiAdminUsersIdReinstateResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r PostApiAdminUsersIdReinstateResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostApiAdminUsersIdReinstateResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PutApiAdminUsersIdRoleResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *struct {
+		Message *string `json:"message,omitempty"`
+		Role    *string `json:"role,omitempty"`
+	}
+}
+
+// Status returns HTTPResponse.Status
+func (r PutApiAdminUsersIdRoleResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PutApiAdminUsersIdRoleResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostApiAdminUsersIdSuspendResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r PostApiAdminUsersIdSuspendResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostApiAdminUsersIdSuspendResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetApiAnnouncementsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *struct {
+		Announcements *[]Announcement `json:"announcements,omitempty"`
+		Message       *string         `json:"message,omitempty"`
+	}
+}
+
+// Status returns HTTPResponse.Status
+func (r GetApiAnnouncementsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetApiAnnouncementsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetApiApiKeysResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *struct {
+		ApiKeys *[]APIKey `json:"apiKeys,omitempty"`
+		Message *string   `json:"message,omitempty"`
+	}
+}
+
+// Status returns HTTPResponse.Status
+func (r GetApiApiKeysResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetApiApiKeysResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostApiApiKeysResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *struct {
+		ApiKey *APIKey `json:"apiKey,omitempty"`
+
+		// Key The key, shown only this once.
+		Key     *string `json:"key,omitempty"`
+		Message *string `json:"message,omitempty"`
+	}
+}
+
+// Status returns HTTPResponse.Status
+func (r PostApiApiKeysResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostApiApiKeysResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteApiApiKeysIdResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteApiApiKeysIdResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteApiApiKeysIdResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PutApiAuthEmailResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON202      *struct {
+		Message      *string              `json:"message,omitempty"`
+		PendingEmail *openapi_types.Email `json:"pendingEmail,omitempty"`
+	}
+}
+
+// Status returns HTTPResponse.Status
+func (r PutApiAuthEmailResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PutApiAuthEmailResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetApiAuthEmailConfirmTokenResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *struct {
+		Email *openapi_types.Email `json:"email,omitempty"`
+
+		// Pending Whether this is still the change pending
+		Pending *bool `json:"pending,omitempty"`
+	}
+}
+
+// Status returns HTTPResponse.Status
+func (r GetApiAuthEmailConfirmTokenResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetApiAuthEmailConfirmTokenResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostApiAuthEmailConfirmTokenResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *struct {
+		Email   *openapi_types.Email `json:"email,omitempty"`
+		Message *string              `json:"message,omitempty"`
+	}
+}
+
+// Status returns HTTPResponse.Status
+func (r PostApiAuthEmailConfirmTokenResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostApiAuthEmailConfirmTokenResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostApiAuthLogoutResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *struct {
+		Message *string `json:"message,omitempty"`
+	}
+}
+
+// Status returns HTTPResponse.Status
+func (r PostApiAuthLogoutResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostApiAuthLogoutResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteApiAuthMeResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *struct {
+		Message *string `json:"message,omitempty"`
+
+		// PurgeAt When the account's documents are deleted for good
+		PurgeAt *time.Time `json:"purgeAt,omitempty"`
+	}
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteApiAuthMeResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteApiAuthMeResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetApiAuthMeResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *struct {
+		// ImpersonatedBy The admin acting as the user, when the request uses an impersonation token
+		ImpersonatedBy *openapi_types.UUID `json:"impersonatedBy,omitempty"`
+		Message        *string             `json:"message,omitempty"`
+		User           *User               `json:"user,omitempty"`
+	}
+}
+
+// Status returns HTTPResponse.Status
+func (r GetApiAuthMeResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetApiAuthMeResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetApiAuthOauthProviderResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r GetApiAuthOauthProviderResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetApiAuthOauthProviderResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetApiAuthOauthProviderCallbackResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r GetApiAuthOauthProviderCallbackResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetApiAuthOauthProviderCallbackResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostApiAuthRegisterResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *struct {
+		Message *string `json:"message,omitempty"`
+		User    *User   `json:"user,omitempty"`
+	}
+}
+
+// Status returns HTTPResponse.Status
+func (r PostApiAuthRegisterResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostApiAuthRegisterResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteApiAuthSessionsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *struct {
+		Message *string `json:"message,omitempty"`
+
+		// Revoked Number of sessions revoked.
+		Revoked *int `json:"revoked,omitempty"`
+	}
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteApiAuthSessionsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteApiAuthSessionsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetApiAuthSessionsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *struct {
+		Message  *string    `json:"message,omitempty"`
+		Sessions *[]Session `json:"sessions,omitempty"`
+	}
+}
+
+// Status returns HTTPResponse.Status
+func (r GetApiAuthSessionsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetApiAuthSessionsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteApiAuthSessionsIdResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteApiAuthSessionsIdResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteApiAuthSessionsIdResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostApiAuthSigninResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *struct {
+		Message *string `json:"message,omitempty"`
+		User    *User   `json:"user,omitempty"`
+	}
+}
+
+// Status returns HTTPResponse.Status
+func (r PostApiAuthSigninResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostApiAuthSigninResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetApiCategoriesResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *struct {
+		Categories *[]DocumentCategory `json:"categories,omitempty"`
+		Message    *string             `json:"message,omitempty"`
+	}
+}
+
+// Status returns HTTPResponse.Status
+func (r GetApiCategoriesResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetApiCategoriesResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetApiCategoriesSlugSuggestExpirationResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *struct {
+		Message    *string `json:"message,omitempty"`
+		Suggestion *struct {
+			Category                *string             `json:"category,omitempty"`
+			CountryCode             *string             `json:"countryCode"`
+			IssueDate               *openapi_types.Date `json:"issueDate,omitempty"`
+			Notes                   *string             `json:"notes"`
+			SuggestedExpirationDate *openapi_types.Date `json:"suggestedExpirationDate,omitempty"`
+			ValidityMonths          *int                `json:"validityMonths,omitempty"`
+		} `json:"suggestion,omitempty"`
+	}
+}
+
+// Status returns HTTPResponse.Status
+func (r GetApiCategoriesSlugSuggestExpirationResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetApiCategoriesSlugSuggestExpirationResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetApiDocumentsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *struct {
+		Documents *[]struct {
+			// AttachmentStatus Virus scan state of an uploaded attachment; absent for external links.
+			AttachmentStatus *GetApiDocuments200DocumentsAttachmentStatus `json:"attachmentStatus"`
+
+			// AttachmentThreat Malware found in a quarantined attachment.
+			AttachmentThreat *string            `json:"attachmentThreat"`
+			AttachmentUrl    *string            `json:"attachmentUrl"`
+			Category         *string            `json:"category"`
+			Checklist        *ChecklistProgress `json:"checklist,omitempty"`
+			CreatedAt        *time.Time         `json:"createdAt,omitempty"`
+
+			// Currency ISO 4217 code of renewalCost.
+			Currency     *string        `json:"currency"`
+			CustomFields *[]CustomField `json:"customFields,omitempty"`
+			Description  *string        `json:"description"`
+
+			// ExpirationDate Formatted date string (e.g., 'Mon, 2 Jan, 2006')
+			ExpirationDate *string `json:"expirationDate,omitempty"`
+
+			// ExpiresIn How far off the expiration date is, such as "expires in 3 weeks", in the locale of the locale query parameter, else Accept-Language, else the user's locale preference, and in the duration unit of the units query parameter or the user's preference.
+			ExpiresIn  *string             `json:"expiresIn,omitempty"`
+			Id         *openapi_types.UUID `json:"id,omitempty"`
+			Identifier *string             `json:"identifier"`
+
+			// Issuer The linked issuer; only included when fetching a single document.
+			Issuer *struct {
+				Id             *openapi_types.UUID `json:"id,omitempty"`
+				Name           *string             `json:"name,omitempty"`
+				ProcessingDays *int                `json:"processingDays,omitempty"`
+				RenewalUrl     *string             `json:"renewalUrl,omitempty"`
+			} `json:"issuer,omitempty"`
+			IssuerId *openapi_types.UUID `json:"issuerId"`
+
+			// LeadTimeDays Days renewing the document takes, overriding the issuer's processing time.
+			LeadTimeDays *int `json:"leadTimeDays,omitempty"`
+
+			// Lock Present while someone holds the document's edit lock.
+			Lock *DocumentLock `json:"lock,omitempty"`
+			Name *string       `json:"name,omitempty"`
+
+			// OrganizationId Organization the document belongs to; absent for personal documents.
+			OrganizationId *openapi_types.UUID `json:"organizationId"`
+
+			// Pinned Whether the caller pinned the document.
+			Pinned *bool `json:"pinned,omitempty"`
+
+			// Position Where the caller placed the document; absent when never placed.
+			Position    *int                `json:"position,omitempty"`
+			Reminders   *[]ReminderInterval `json:"reminders,omitempty"`
+			RenewalCost *float32            `json:"renewalCost"`
+			Timezone    *string             `json:"timezone,omitempty"`
+			UpdatedAt   *time.Time          `json:"updatedAt,omitempty"`
+			UserId      *openapi_types.UUID `json:"userId,omitempty"`
+		} `json:"documents,omitempty"`
+		Message *string `json:"message,omitempty"`
+	}
+}
+type GetApiDocuments200DocumentsAttachmentStatus string
+
+// Status returns HTTPResponse.Status
+func (r GetApiDocumentsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetApiDocumentsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostApiDocumentsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *struct {
+		Document *Document `json:"document,omitempty"`
+		Message  *string   `json:"message,omitempty"`
+	}
+	JSON409 *DuplicateDocuments
+}
+
+// Status returns HTTPResponse.Status
+func (r PostApiDocumentsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostApiDocumentsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetApiDocumentsDependencyGraphResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *struct {
+		Edges *[]struct {
+			DependsOnId *openapi_types.UUID `json:"dependsOnId,omitempty"`
+			DocumentId  *openapi_types.UUID `json:"documentId,omitempty"`
+		} `json:"edges,omitempty"`
+		Message *string               `json:"message,omitempty"`
+		Nodes   *[]DependencyDocument `json:"nodes,omitempty"`
+	}
+}
+
+// Status returns HTTPResponse.Status
+func (r GetApiDocumentsDependencyGraphResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetApiDocumentsDependencyGraphResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostApiDocumentsImportResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *struct {
+		Documents *[]Document `json:"documents,omitempty"`
+		Errors    *[]struct {
+			Error *string `json:"error,omitempty"`
+			Line  *int    `json:"line,omitempty"`
+		} `json:"errors,omitempty"`
+		Imported        *int      `json:"imported,omitempty"`
+		Message         *string   `json:"message,omitempty"`
+		Source          *string   `json:"source,omitempty"`
+		UnmappedColumns *[]string `json:"unmappedColumns,omitempty"`
+	}
+	JSON202 *JobQueued
+}
+
+// Status returns HTTPResponse.Status
+func (r PostApiDocumentsImportResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostApiDocumentsImportResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PutApiDocumentsOrderResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r PutApiDocumentsOrderResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PutApiDocumentsOrderResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetApiDocumentsRenewalCostsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *struct {
+		From    *openapi_types.Date `json:"from,omitempty"`
+		Locale  *string             `json:"locale,omitempty"`
+		Message *string             `json:"message,omitempty"`
+		Months  *[]RenewalCost      `json:"months,omitempty"`
+		To      *openapi_types.Date `json:"to,omitempty"`
+		Totals  *[]RenewalCost      `json:"totals,omitempty"`
+	}
+}
+
+// Status returns HTTPResponse.Status
+func (r GetApiDocumentsRenewalCostsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetApiDocumentsRenewalCostsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetApiDocumentsStatsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *struct {
+		Message *string        `json:"message,omitempty"`
+		Stats   *DocumentStats `json:"stats,omitempty"`
+	}
+}
+
+// Status returns HTTPResponse.Status
+func (r GetApiDocumentsStatsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetApiDocumentsStatsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetApiDocumentsTrashResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *struct {
+		Documents     *[]TrashedDocument `json:"documents,omitempty"`
+		Message       *string            `json:"message,omitempty"`
+		RetentionDays *int               `json:"retentionDays,omitempty"`
+	}
+}
+
+// Status returns HTTPResponse.Status
+func (r GetApiDocumentsTrashResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetApiDocumentsTrashResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteApiDocumentsIdResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteApiDocumentsIdResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteApiDocumentsIdResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetApiDocumentsIdResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *struct {
+		Document *Document `json:"document,omitempty"`
+		Message  *string   `json:"message,omitempty"`
+	}
+}
+
+// Status returns HTTPResponse.Status
+func (r GetApiDocumentsIdResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetApiDocumentsIdResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PutApiDocumentsIdResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *struct {
+		Document *Document `json:"document,omitempty"`
+		Message  *string   `json:"message,omitempty"`
+	}
+}
+
+// Status returns HTTPResponse.Status
+func (r PutApiDocumentsIdResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PutApiDocumentsIdResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteApiDocumentsIdAssigneeResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteApiDocumentsIdAssigneeResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteApiDocumentsIdAssigneeResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetApiDocumentsIdAssigneeResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *struct {
+		Assignment *DocumentAssignment `json:"assignment,omitempty"`
+		Message    *string             `json:"message,omitempty"`
+	}
+}
+
+// Status returns HTTPResponse.Status
+func (r GetApiDocumentsIdAssigneeResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetApiDocumentsIdAssigneeResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PutApiDocumentsIdAssigneeResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *struct {
+		Assignment *DocumentAssignment `json:"assignment,omitempty"`
+		Message    *string             `json:"message,omitempty"`
+	}
+}
+
+// Status returns HTTPResponse.Status
+func (r PutApiDocumentsIdAssigneeResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PutApiDocumentsIdAssigneeResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostApiDocumentsIdAssigneeAcknowledgeResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r PostApiDocumentsIdAssigneeAcknowledgeResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostApiDocumentsIdAssigneeAcknowledgeResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PutApiDocumentsIdAttachmentResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r PutApiDocumentsIdAttachmentResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PutApiDocumentsIdAttachmentResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetApiDocumentsIdChecklistResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *struct {
+		Items    *[]ChecklistItem   `json:"items,omitempty"`
+		Message  *string            `json:"message,omitempty"`
+		Progress *ChecklistProgress `json:"progress,omitempty"`
+	}
+}
+
+// Status returns HTTPResponse.Status
+func (r GetApiDocumentsIdChecklistResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetApiDocumentsIdChecklistResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostApiDocumentsIdChecklistResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r PostApiDocumentsIdChecklistResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostApiDocumentsIdChecklistResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteApiDocumentsIdChecklistItemIdResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteApiDocumentsIdChecklistItemIdResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteApiDocumentsIdChecklistItemIdResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PutApiDocumentsIdChecklistItemIdResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r PutApiDocumentsIdChecklistItemIdResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PutApiDocumentsIdChecklistItemIdResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetApiDocumentsIdContactsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *struct {
+		Contacts *[]DocumentContact `json:"contacts,omitempty"`
+		Message  *string            `json:"message,omitempty"`
+	}
+}
+
+// Status returns HTTPResponse.Status
+func (r GetApiDocumentsIdContactsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetApiDocumentsIdContactsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostApiDocumentsIdContactsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *struct {
+		Contact *DocumentContact `json:"contact,omitempty"`
+		Message *string          `json:"message,omitempty"`
+	}
+}
+
+// Status returns HTTPResponse.Status
+func (r PostApiDocumentsIdContactsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostApiDocumentsIdContactsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteApiDocumentsIdContactsContactIdResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteApiDocumentsIdContactsContactIdResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteApiDocumentsIdContactsContactIdResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetApiDocumentsIdDependenciesResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *struct {
+		Dependents *[]DependencyDocument `json:"dependents,omitempty"`
+		DependsOn  *[]DependencyDocument `json:"dependsOn,omitempty"`
+		Message    *string               `json:"message,omitempty"`
+	}
+}
+
+// Status returns HTTPResponse.Status
+func (r GetApiDocumentsIdDependenciesResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetApiDocumentsIdDependenciesResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostApiDocumentsIdDependenciesResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r PostApiDocumentsIdDependenciesResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostApiDocumentsIdDependenciesResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteApiDocumentsIdDependenciesDependsOnIdResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteApiDocumentsIdDependenciesDependsOnIdResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteApiDocumentsIdDependenciesDependsOnIdResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetApiDocumentsIdLegalHoldResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *struct {
+		LegalHold *LegalHold `json:"legalHold,omitempty"`
+		Message   *string    `json:"message,omitempty"`
+	}
+}
+
+// Status returns HTTPResponse.Status
+func (r GetApiDocumentsIdLegalHoldResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetApiDocumentsIdLegalHoldResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PutApiDocumentsIdLegalHoldResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *struct {
+		LegalHold *LegalHold `json:"legalHold,omitempty"`
+		Message   *string    `json:"message,omitempty"`
+	}
+}
+
+// Status returns HTTPResponse.Status
+func (r PutApiDocumentsIdLegalHoldResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PutApiDocumentsIdLegalHoldResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteApiDocumentsIdLockResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteApiDocumentsIdLockResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteApiDocumentsIdLockResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostApiDocumentsIdLockResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *struct {
+		// Lock Present while someone holds the document's edit lock.
+		Lock    *DocumentLock `json:"lock,omitempty"`
+		Message *string       `json:"message,omitempty"`
+	}
+}
+
+// Status returns HTTPResponse.Status
+func (r PostApiDocumentsIdLockResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostApiDocumentsIdLockResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteApiDocumentsIdPinResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteApiDocumentsIdPinResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteApiDocumentsIdPinResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostApiDocumentsIdPinResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r PostApiDocumentsIdPinResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostApiDocumentsIdPinResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetApiDocumentsIdRemindersResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *struct {
+		Data *struct {
+			Description *string                     `json:"description"`
+			Id          *openapi_types.UUID         `json:"id,omitempty"`
+			Name        *string                     `json:"name,omitempty"`
+			Reminders   *[]DocumentReminderInterval `json:"reminders,omitempty"`
+		} `json:"data,omitempty"`
+		Message *string `json:"message,omitempty"`
+	}
+}
+
+// Status returns HTTPResponse.Status
+func (r GetApiDocumentsIdRemindersResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetApiDocumentsIdRemindersResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PutApiDocumentsIdRemindersResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *struct {
+		Message *string `json:"message,omitempty"`
+	}
+	JSON403 *struct {
+		Code      *PutApiDocumentsIdReminders403Code `json:"code,omitempty"`
+		Message   *string                            `json:"message,omitempty"`
+		Status    *int                               `json:"status,omitempty"`
+		Timestamp *time.Time                         `json:"timestamp,omitempty"`
+	}
+}
+type PutApiDocumentsIdReminders403Code string
+
+// Status returns HTTPResponse.Status
+func (r PutApiDocumentsIdRemindersResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PutApiDocumentsIdRemindersResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetApiDocumentsIdRemindersPreviewResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *struct {
+		Message *string `json:"message,omitempty"`
+		Preview *struct {
+			Channel *string `json:"channel,omitempty"`
+
+			// Html HTML body of emails
+			Html *string `json:"html,omitempty"`
+
+			// Subject Subject of emails and push notifications
+			Subject *string `json:"subject,omitempty"`
+
+			// Text Text of SMS and push notifications
+			Text *string `json:"text,omitempty"`
+		} `json:"preview,omitempty"`
+	}
+}
+
+// Status returns HTTPResponse.Status
+func (r GetApiDocumentsIdRemindersPreviewResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetApiDocumentsIdRemindersPreviewResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetApiDocumentsIdRenewalsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *struct {
+		Message  *string           `json:"message,omitempty"`
+		Renewals *[]RenewalRequest `json:"renewals,omitempty"`
+	}
+}
+
+// Status returns HTTPResponse.Status
+func (r GetApiDocumentsIdRenewalsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetApiDocumentsIdRenewalsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostApiDocumentsIdRenewalsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *struct {
+		Message *string         `json:"message,omitempty"`
+		Renewal *RenewalRequest `json:"renewal,omitempty"`
+	}
+}
+
+// Status returns HTTPResponse.Status
+func (r PostApiDocumentsIdRenewalsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostApiDocumentsIdRenewalsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostApiDocumentsIdRenewalsRenewalIdApproveResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *struct {
+		Document *Document `json:"document,omitempty"`
+		Message  *string   `json:"message,omitempty"`
+	}
+}
+
+// Status returns HTTPResponse.Status
+func (r PostApiDocumentsIdRenewalsRenewalIdApproveResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostApiDocumentsIdRenewalsRenewalIdApproveResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostApiDocumentsIdRenewalsRenewalIdRejectResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r PostApiDocumentsIdRenewalsRenewalIdRejectResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostApiDocumentsIdRenewalsRenewalIdRejectResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostApiDocumentsIdRestoreResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r PostApiDocumentsIdRestoreResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostApiDocumentsIdRestoreResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteApiFeedResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteApiFeedResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteApiFeedResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetApiFeedResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *FeedURLsResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetApiFeedResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetApiFeedResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostApiFeedResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *FeedURLsResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r PostApiFeedResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostApiFeedResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostApiFeedbackResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *struct {
+		Feedback *Feedback `json:"feedback,omitempty"`
+		Message  *string   `json:"message,omitempty"`
+	}
+}
+
+// Status returns HTTPResponse.Status
+func (r PostApiFeedbackResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostApiFeedbackResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetApiFeedsTokenAtomXmlResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r GetApiFeedsTokenAtomXmlResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetApiFeedsTokenAtomXmlResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetApiFeedsTokenRssXmlResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r GetApiFeedsTokenRssXmlResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetApiFeedsTokenRssXmlResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostApiGraphqlResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *struct {
+		Data   *map[string]interface{}   `json:"data,omitempty"`
+		Errors *[]map[string]interface{} `json:"errors,omitempty"`
+	}
+}
+
+// Status returns HTTPResponse.Status
+func (r PostApiGraphqlResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostApiGraphqlResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetApiHouseholdResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *struct {
+		Household *Household `json:"household,omitempty"`
+		Message   *string    `json:"message,omitempty"`
+	}
+}
+
+// Status returns HTTPResponse.Status
+func (r GetApiHouseholdResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetApiHouseholdResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostApiHouseholdResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r PostApiHouseholdResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostApiHouseholdResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostApiHouseholdMembersResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r PostApiHouseholdMembersResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostApiHouseholdMembersResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteApiHouseholdMembersUserIdResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteApiHouseholdMembersUserIdResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteApiHouseholdMembersUserIdResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PutApiHouseholdMembersUserIdResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r PutApiHouseholdMembersUserIdResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PutApiHouseholdMembersUserIdResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetApiHouseholdMembersUserIdDocumentsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r GetApiHouseholdMembersUserIdDocumentsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetApiHouseholdMembersUserIdDocumentsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetApiIntegrationsEgressIpsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *struct {
+		Ips     *[]string `json:"ips,omitempty"`
+		Message *string   `json:"message,omitempty"`
+	}
+}
+
+// Status returns HTTPResponse.Status
+func (r GetApiIntegrationsEgressIpsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetApiIntegrationsEgressIpsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetApiIssuersResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *struct {
+		Issuers *[]Issuer `json:"issuers,omitempty"`
+		Message *string   `json:"message,omitempty"`
+	}
+}
+
+// Status returns HTTPResponse.Status
+func (r GetApiIssuersResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetApiIssuersResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetApiJobsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *struct {
+		Jobs    *[]Job  `json:"jobs,omitempty"`
+		Message *string `json:"message,omitempty"`
+	}
+}
+
+// Status returns HTTPResponse.Status
+func (r GetApiJobsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetApiJobsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostApiJobsImportResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON202      *JobQueued
+}
+
+// Status returns HTTPResponse.Status
+func (r PostApiJobsImportResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostApiJobsImportResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostApiJobsTakeoutResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON202      *JobQueued
+}
+
+// Status returns HTTPResponse.Status
+func (r PostApiJobsTakeoutResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostApiJobsTakeoutResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetApiJobsIdResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *struct {
+		Job     *Job    `json:"job,omitempty"`
+		Message *string `json:"message,omitempty"`
+	}
+}
+
+// Status returns HTTPResponse.Status
+func (r GetApiJobsIdResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetApiJobsIdResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetApiLinksTokenResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *struct {
+		Action     *GetApiLinksToken200Action `json:"action,omitempty"`
+		Document   *Document                  `json:"document,omitempty"`
+		SnoozeDays *int                       `json:"snoozeDays,omitempty"`
+
+		// Used Whether the renewed or snooze link was already used
+		Used *bool `json:"used,omitempty"`
+	}
+}
+type GetApiLinksToken200Action string
+
+// Status returns HTTPResponse.Status
+func (r GetApiLinksTokenResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetApiLinksTokenResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostApiLinksTokenResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r PostApiLinksTokenResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostApiLinksTokenResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostApiNotificationsReadResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *struct {
+		// Marked Number of notification log entries marked read
+		Marked  *int64  `json:"marked,omitempty"`
+		Message *string `json:"message,omitempty"`
+	}
+}
+
+// Status returns HTTPResponse.Status
+func (r PostApiNotificationsReadResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostApiNotificationsReadResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetApiNotificationsUnreadCountResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *struct {
+		UnreadCount *int `json:"unreadCount,omitempty"`
+	}
+}
+
+// Status returns HTTPResponse.Status
+func (r GetApiNotificationsUnreadCountResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetApiNotificationsUnreadCountResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteApiOnboardingSampleDataResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *struct {
+		Deleted *int    `json:"deleted,omitempty"`
+		Message *string `json:"message,omitempty"`
+	}
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteApiOnboardingSampleDataResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteApiOnboardingSampleDataResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostApiOnboardingSampleDataResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *struct {
+		Documents *[]Document `json:"documents,omitempty"`
+		Message   *string     `json:"message,omitempty"`
+	}
+}
+
+// Status returns HTTPResponse.Status
+func (r PostApiOnboardingSampleDataResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostApiOnboardingSampleDataResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetApiOrganizationsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *struct {
+		DataRegions   *[]string       `json:"dataRegions,omitempty"`
+		Message       *string         `json:"message,omitempty"`
+		Organizations *[]Organization `json:"organizations,omitempty"`
+	}
+}
+
+// Status returns HTTPResponse.Status
+func (r GetApiOrganizationsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetApiOrganizationsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostApiOrganizationsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *struct {
+		Message      *string       `json:"message,omitempty"`
+		Organization *Organization `json:"organization,omitempty"`
+	}
+}
+
+// Status returns HTTPResponse.Status
+func (r PostApiOrganizationsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostApiOrganizationsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PutApiOrganizationsIdComplianceResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *struct {
+		Message      *string       `json:"message,omitempty"`
+		Organization *Organization `json:"organization,omitempty"`
+	}
+}
+
+// Status returns HTTPResponse.Status
+func (r PutApiOrganizationsIdComplianceResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PutApiOrganizationsIdComplianceResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteApiOrganizationsIdEmailSenderResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteApiOrganizationsIdEmailSenderResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteApiOrganizationsIdEmailSenderResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetApiOrganizationsIdEmailSenderResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *struct {
+		Message *string      `json:"message,omitempty"`
+		Sender  *EmailSender `json:"sender,omitempty"`
+	}
+}
+
+// Status returns HTTPResponse.Status
+func (r GetApiOrganizationsIdEmailSenderResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetApiOrganizationsIdEmailSenderResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PutApiOrganizationsIdEmailSenderResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *struct {
+		Message *string      `json:"message,omitempty"`
+		Sender  *EmailSender `json:"sender,omitempty"`
+	}
+}
+
+// Status returns HTTPResponse.Status
+func (r PutApiOrganizationsIdEmailSenderResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PutApiOrganizationsIdEmailSenderResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostApiOrganizationsIdEmailSenderVerifyResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON202      *struct {
+		Message *string      `json:"message,omitempty"`
+		Sender  *EmailSender `json:"sender,omitempty"`
+	}
+}
+
+// Status returns HTTPResponse.Status
+func (r PostApiOrganizationsIdEmailSenderVerifyResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostApiOrganizationsIdEmailSenderVerifyResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetApiOrganizationsIdEscalationPolicyResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *struct {
+		Message *string           `json:"message,omitempty"`
+		Steps   *[]EscalationStep `json:"steps,omitempty"`
+	}
+}
+
+// Status returns HTTPResponse.Status
+func (r GetApiOrganizationsIdEscalationPolicyResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetApiOrganizationsIdEscalationPolicyResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PutApiOrganizationsIdEscalationPolicyResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *struct {
+		Message *string           `json:"message,omitempty"`
+		Steps   *[]EscalationStep `json:"steps,omitempty"`
+	}
+}
+
+// Status returns HTTPResponse.Status
+func (r PutApiOrganizationsIdEscalationPolicyResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PutApiOrganizationsIdEscalationPolicyResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetApiOrganizationsIdEventLogExportResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *EventLogReport
+}
+
+// Status returns HTTPResponse.Status
+func (r GetApiOrganizationsIdEventLogExportResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetApiOrganizationsIdEventLogExportResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetApiOrganizationsIdLegalHoldResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *struct {
+		LegalHold *LegalHold `json:"legalHold,omitempty"`
+		Message   *string    `json:"message,omitempty"`
+	}
+}
+
+// Status returns HTTPResponse.Status
+func (r GetApiOrganizationsIdLegalHoldResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetApiOrganizationsIdLegalHoldResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PutApiOrganizationsIdLegalHoldResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *struct {
+		LegalHold *LegalHold `json:"legalHold,omitempty"`
+		Message   *string    `json:"message,omitempty"`
+	}
+}
+
+// Status returns HTTPResponse.Status
+func (r PutApiOrganizationsIdLegalHoldResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PutApiOrganizationsIdLegalHoldResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetApiOrganizationsIdMembersResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *struct {
+		Members *[]OrganizationMember `json:"members,omitempty"`
+		Message *string               `json:"message,omitempty"`
+	}
+}
+
+// Status returns HTTPResponse.Status
+func (r GetApiOrganizationsIdMembersResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetApiOrganizationsIdMembersResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostApiOrganizationsIdMembersResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r PostApiOrganizationsIdMembersResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostApiOrganizationsIdMembersResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteApiOrganizationsIdMembersUserIdResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteApiOrganizationsIdMembersUserIdResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteApiOrganizationsIdMembersUserIdResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteApiOrganizationsIdNotificationThemeResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteApiOrganizationsIdNotificationThemeResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteApiOrganizationsIdNotificationThemeResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetApiOrganizationsIdNotificationThemeResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *struct {
+		Message *string            `json:"message,omitempty"`
+		Theme   *NotificationTheme `json:"theme,omitempty"`
+	}
+}
+
+// Status returns HTTPResponse.Status
+func (r GetApiOrganizationsIdNotificationThemeResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetApiOrganizationsIdNotificationThemeResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PutApiOrganizationsIdNotificationThemeResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *struct {
+		Message *string            `json:"message,omitempty"`
+		Theme   *NotificationTheme `json:"theme,omitempty"`
+	}
+}
+
+// Status returns HTTPResponse.Status
+func (r PutApiOrganizationsIdNotificationThemeResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PutApiOrganizationsIdNotificationThemeResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PutApiOrganizationsIdRenewalApprovalResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *struct {
+		Message      *string       `json:"message,omitempty"`
+		Organization *Organization `json:"organization,omitempty"`
+	}
+}
+
+// Status returns HTTPResponse.Status
+func (r PutApiOrganizationsIdRenewalApprovalResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PutApiOrganizationsIdRenewalApprovalResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetApiOrganizationsIdRetentionResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *struct {
+		Message   *string                `json:"message,omitempty"`
+		Retention *OrganizationRetention `json:"retention,omitempty"`
+	}
+}
+
+// Status returns HTTPResponse.Status
+func (r GetApiOrganizationsIdRetentionResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetApiOrganizationsIdRetentionResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PutApiOrganizationsIdRetentionResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *struct {
+		Message   *string                `json:"message,omitempty"`
+		Retention *OrganizationRetention `json:"retention,omitempty"`
+	}
+}
+
+// Status returns HTTPResponse.Status
+func (r PutApiOrganizationsIdRetentionResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PutApiOrganizationsIdRetentionResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteApiOrganizationsIdScimTokenResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteApiOrganizationsIdScimTokenResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteApiOrganizationsIdScimTokenResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostApiOrganizationsIdScimTokenResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *struct {
+		// BaseUrl SCIM base URL to configure in the identity provider.
+		BaseUrl *string `json:"baseUrl,omitempty"`
+		Message *string `json:"message,omitempty"`
+		Token   *string `json:"token,omitempty"`
+	}
+}
+
+// Status returns HTTPResponse.Status
+func (r PostApiOrganizationsIdScimTokenResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostApiOrganizationsIdScimTokenResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetApiOrganizationsIdServiceAccountsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *struct {
+		Message         *string           `json:"message,omitempty"`
+		ServiceAccounts *[]ServiceAccount `json:"serviceAccounts,omitempty"`
+	}
+}
+
+// Status returns HTTPResponse.Status
+func (r GetApiOrganizationsIdServiceAccountsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetApiOrganizationsIdServiceAccountsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostApiOrganizationsIdServiceAccountsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *ServiceAccountTokenResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r PostApiOrganizationsIdServiceAccountsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostApiOrganizationsIdServiceAccountsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteApiOrganizationsIdServiceAccountsAccountIdTokenResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteApiOrganizationsIdServiceAccountsAccountIdTokenResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteApiOrganizationsIdServiceAccountsAccountIdTokenResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostApiOrganizationsIdServiceAccountsAccountIdTokenResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *ServiceAccountTokenResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r PostApiOrganizationsIdServiceAccountsAccountIdTokenResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostApiOrganizationsIdServiceAccountsAccountIdTokenResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetApiOrganizationsIdUsageExportResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *UsageExport
+}
+
+// Status returns HTTPResponse.Status
+func (r GetApiOrganizationsIdUsageExportResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetApiOrganizationsIdUsageExportResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetApiPreferencesLocaleResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *struct {
+		Message     *string            `json:"message,omitempty"`
+		Preferences *LocalePreferences `json:"preferences,omitempty"`
+	}
+}
+
+// Status returns HTTPResponse.Status
+func (r GetApiPreferencesLocaleResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetApiPreferencesLocaleResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PutApiPreferencesLocaleResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *struct {
+		Message     *string            `json:"message,omitempty"`
+		Preferences *LocalePreferences `json:"preferences,omitempty"`
+	}
+}
+
+// Status returns HTTPResponse.Status
+func (r PutApiPreferencesLocaleResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PutApiPreferencesLocaleResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetApiPreferencesNotificationsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *struct {
+		Message     *string                  `json:"message,omitempty"`
+		Preferences *NotificationPreferences `json:"preferences,omitempty"`
+	}
+}
+
+// Status returns HTTPResponse.Status
+func (r GetApiPreferencesNotificationsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetApiPreferencesNotificationsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PutApiPreferencesNotificationsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *struct {
+		Message     *string                  `json:"message,omitempty"`
+		Preferences *NotificationPreferences `json:"preferences,omitempty"`
+	}
+}
+
+// Status returns HTTPResponse.Status
+func (r PutApiPreferencesNotificationsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PutApiPreferencesNotificationsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetApiRoadmapResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *struct {
+		Features *[]RoadmapFeature `json:"features,omitempty"`
+		Message  *string           `json:"message,omitempty"`
+	}
+}
+
+// Status returns HTTPResponse.Status
+func (r GetApiRoadmapResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetApiRoadmapResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteApiRoadmapIdVoteResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *struct {
+		Feature *RoadmapFeature `json:"feature,omitempty"`
+		Message *string         `json:"message,omitempty"`
+	}
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteApiRoadmapIdVoteResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteApiRoadmapIdVoteResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostApiRoadmapIdVoteResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *struct {
+		Feature *RoadmapFeature `json:"feature,omitempty"`
+		Message *string         `json:"message,omitempty"`
+	}
+}
+
+// Status returns HTTPResponse.Status
+func (r PostApiRoadmapIdVoteResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostApiRoadmapIdVoteResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetApiTemplatesResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *struct {
+		Message   *string             `json:"message,omitempty"`
+		Templates *[]DocumentTemplate `json:"templates,omitempty"`
+	}
+}
+
+// Status returns HTTPResponse.Status
+func (r GetApiTemplatesResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetApiTemplatesResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetApiTrackOpenMessageIdResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r GetApiTrackOpenMessageIdResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetApiTrackOpenMessageIdResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetApiUnsubscribeTokenResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *struct {
+		Email *string `json:"email,omitempty"`
+
+		// Unsubscribed Whether the contact is already unsubscribed
+		Unsubscribed *bool `json:"unsubscribed,omitempty"`
+	}
+}
+
+// Status returns HTTPResponse.Status
+func (r GetApiUnsubscribeTokenResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetApiUnsubscribeTokenResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostApiUnsubscribeTokenResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r PostApiUnsubscribeTokenResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostApiUnsubscribeTokenResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetApiUsageResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *struct {
+		Message        *string             `json:"message,omitempty"`
+		Plan           *GetApiUsage200Plan `json:"plan,omitempty"`
+		Usage          *[]QuotaUsage       `json:"usage,omitempty"`
+		WarningPercent *int                `json:"warningPercent,omitempty"`
+	}
+}
+type GetApiUsage200Plan string
+
+// Status returns HTTPResponse.Status
+func (r GetApiUsageResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetApiUsageResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetApiViewsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *struct {
+		Message *string      `json:"message,omitempty"`
+		Views   *[]SavedView `json:"views,omitempty"`
+	}
+}
+
+// Status returns HTTPResponse.Status
+func (r GetApiViewsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetApiViewsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostApiViewsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *struct {
+		Message *string    `json:"message,omitempty"`
+		View    *SavedView `json:"view,omitempty"`
+	}
+}
+
+// Status returns HTTPResponse.Status
+func (r PostApiViewsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostApiViewsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteApiViewsIdResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteApiViewsIdResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteApiViewsIdResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetApiViewsIdResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *struct {
+		Message *string    `json:"message,omitempty"`
+		View    *SavedView `json:"view,omitempty"`
+	}
+}
+
+// Status returns HTTPResponse.Status
+func (r GetApiViewsIdResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetApiViewsIdResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PutApiViewsIdResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *struct {
+		Message *string    `json:"message,omitempty"`
+		View    *SavedView `json:"view,omitempty"`
+	}
+}
+
+// Status returns HTTPResponse.Status
+func (r PutApiViewsIdResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PutApiViewsIdResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetApiViewsIdDocumentsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *struct {
+		Documents *[]Document `json:"documents,omitempty"`
+		Message   *string     `json:"message,omitempty"`
+		View      *SavedView  `json:"view,omitempty"`
+	}
+}
+
+// Status returns HTTPResponse.Status
+func (r GetApiViewsIdDocumentsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetApiViewsIdDocumentsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostApiWebhooksEmailBounceResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r PostApiWebhooksEmailBounceResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostApiWebhooksEmailBounceResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetApiWebhooksEndpointsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *struct {
+		Endpoints *[]WebhookEndpoint `json:"endpoints,omitempty"`
+		Events    *[]string          `json:"events,omitempty"`
+		Message   *string            `json:"message,omitempty"`
+	}
+}
+
+// Status returns HTTPResponse.Status
+func (r GetApiWebhooksEndpointsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetApiWebhooksEndpointsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostApiWebhooksEndpointsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *WebhookEndpointEnvelope
+}
+
+// Status returns HTTPResponse.Status
+func (r PostApiWebhooksEndpointsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostApiWebhooksEndpointsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteApiWebhooksEndpointsIdResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteApiWebhooksEndpointsIdResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteApiWebhooksEndpointsIdResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PutApiWebhooksEndpointsIdResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *WebhookEndpointEnvelope
+}
+
+// Status returns HTTPResponse.Status
+func (r PutApiWebhooksEndpointsIdResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PutApiWebhooksEndpointsIdResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetApiWebhooksEndpointsIdDeliveriesResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *struct {
+		Deliveries *[]WebhookDelivery `json:"deliveries,omitempty"`
+		Message    *string            `json:"message,omitempty"`
+	}
+}
+
+// Status returns HTTPResponse.Status
+func (r GetApiWebhooksEndpointsIdDeliveriesResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetApiWebhooksEndpointsIdDeliveriesResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostApiWebhooksEndpointsIdDeliveriesDeliveryIdRedeliverResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON202      *struct {
+		Delivery *WebhookDelivery `json:"delivery,omitempty"`
+		Message  *string          `json:"message,omitempty"`
+	}
+}
+
+// Status returns HTTPResponse.Status
+func (r PostApiWebhooksEndpointsIdDeliveriesDeliveryIdRedeliverResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostApiWebhooksEndpointsIdDeliveriesDeliveryIdRedeliverResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostApiWebhooksEndpointsIdRotateSecretResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *WebhookEndpointEnvelope
+}
+
+// Status returns HTTPResponse.Status
+func (r PostApiWebhooksEndpointsIdRotateSecretResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostApiWebhooksEndpointsIdRotateSecretResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostApiWebhooksTwilioSmsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	XML200       *string
+}
+
+// Status returns HTTPResponse.Status
+func (r PostApiWebhooksTwilioSmsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostApiWebhooksTwilioSmsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetHealthResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *struct {
+		Service   *string `json:"service,omitempty"`
+		Status    *string `json:"status,omitempty"`
+		Timestamp *string `json:"timestamp,omitempty"`
+	}
+}
+
+// Status returns HTTPResponse.Status
+func (r GetHealthResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetHealthResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetReadyResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *struct {
+		Database  *GetReady200Database `json:"database,omitempty"`
+		Status    *GetReady200Status   `json:"status,omitempty"`
+		Timestamp *string              `json:"timestamp,omitempty"`
+	}
+	JSON503 *struct {
+		Database  *GetReady503Database `json:"database,omitempty"`
+		Status    *GetReady503Status   `json:"status,omitempty"`
+		Timestamp *string              `json:"timestamp,omitempty"`
+	}
+}
+type GetReady200Database string
+type GetReady200Status string
+type GetReady503Database string
+type GetReady503Status string
+
+// Status returns HTTPResponse.Status
+func (r GetReadyResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetReadyResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetReminderIntervalsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *struct {
+		Message           *string             `json:"message,omitempty"`
+		ReminderIntervals *[]ReminderInterval `json:"reminderIntervals,omitempty"`
+	}
+}
+
+// Status returns HTTPResponse.Status
+func (r GetReminderIntervalsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetReminderIntervalsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetScimV2ServiceProviderConfigResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r GetScimV2ServiceProviderConfigResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetScimV2ServiceProviderConfigResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetScimV2UsersResponse struct {
+	Body                   []byte
+	HTTPResponse           *http.Response
+	ApplicationscimJSON200 *ScimListResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetScimV2UsersResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetScimV2UsersResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostScimV2UsersResponse struct {
+	Body                   []byte
+	HTTPResponse           *http.Response
+	ApplicationscimJSON201 *ScimUser
+}
+
+// Status returns HTTPResponse.Status
+func (r PostScimV2UsersResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostScimV2UsersResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteScimV2UsersUserIdResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteScimV2UsersUserIdResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteScimV2UsersUserIdResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetScimV2UsersUserIdResponse struct {
+	Body                   []byte
+	HTTPResponse           *http.Response
+	ApplicationscimJSON200 *ScimUser
+}
+
+// Status returns HTTPResponse.Status
+func (r GetScimV2UsersUserIdResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetScimV2UsersUserIdResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PatchScimV2UsersUserIdResponse struct {
+	Body                   []byte
+	HTTPResponse           *http.Response
+	ApplicationscimJSON200 *ScimUser
+}
+
+// Status returns HTTPResponse.Status
+func (r PatchScimV2UsersUserIdResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PatchScimV2UsersUserIdResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PutScimV2UsersUserIdResponse struct {
+	Body                   []byte
+	HTTPResponse           *http.Response
+	ApplicationscimJSON200 *ScimUser
+}
+
+// Status returns HTTPResponse.Status
+func (r PutScimV2UsersUserIdResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PutScimV2UsersUserIdResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// GetWellKnownJwksJsonWithResponse request returning *GetWellKnownJwksJsonResponse
+func (c *ClientWithResponses) GetWellKnownJwksJsonWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetWellKnownJwksJsonResponse, error) {
+	rsp, err := c.GetWellKnownJwksJson(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetWellKnownJwksJsonResponse(rsp)
+}
+
+// GetApiAdminAnnouncementsWithResponse request returning *GetApiAdminAnnouncementsResponse
+func (c *ClientWithResponses) GetApiAdminAnnouncementsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetApiAdminAnnouncementsResponse, error) {
+	rsp, err := c.GetApiAdminAnnouncements(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetApiAdminAnnouncementsResponse(rsp)
+}
+
+// PostApiAdminAnnouncementsWithBodyWithResponse request with arbitrary body returning *PostApiAdminAnnouncementsResponse
+func (c *ClientWithResponses) PostApiAdminAnnouncementsWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostApiAdminAnnouncementsResponse, error) {
+	rsp, err := c.PostApiAdminAnnouncementsWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostApiAdminAnnouncementsResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostApiAdminAnnouncementsWithResponse(ctx context.Context, body PostApiAdminAnnouncementsJSONRequestBody, reqEditors ...RequestEditorFn) (*PostApiAdminAnnouncementsResponse, error) {
+	rsp, err := c.PostApiAdminAnnouncements(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostApiAdminAnnouncementsResponse(rsp)
+}
+
+// DeleteApiAdminAnnouncementsIdWithResponse request returning *DeleteApiAdminAnnouncementsIdResponse
+func (c *ClientWithResponses) DeleteApiAdminAnnouncementsIdWithResponse(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*DeleteApiAdminAnnouncementsIdResponse, error) {
+	rsp, err := c.DeleteApiAdminAnnouncementsId(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteApiAdminAnnouncementsIdResponse(rsp)
+}
+
+// GetApiAdminDeprecationsWithResponse request returning *GetApiAdminDeprecationsResponse
+func (c *ClientWithResponses) GetApiAdminDeprecationsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetApiAdminDeprecationsResponse, error) {
+	rsp, err := c.GetApiAdminDeprecations(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetApiAdminDeprecationsResponse(rsp)
+}
+
+// GetApiAdminHealthWithResponse request returning *GetApiAdminHealthResponse
+func (c *ClientWithResponses) GetApiAdminHealthWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetApiAdminHealthResponse, error) {
+	rsp, err := c.GetApiAdminHealth(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetApiAdminHealthResponse(rsp)
+}
+
+// PostApiAdminImpersonateWithBodyWithResponse request with arbitrary body returning *PostApiAdminImpersonateResponse
+func (c *ClientWithResponses) PostApiAdminImpersonateWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostApiAdminImpersonateResponse, error) {
+	rsp, err := c.PostApiAdminImpersonateWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostApiAdminImpersonateResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostApiAdminImpersonateWithResponse(ctx context.Context, body PostApiAdminImpersonateJSONRequestBody, reqEditors ...RequestEditorFn) (*PostApiAdminImpersonateResponse, error) {
+	rsp, err := c.PostApiAdminImpersonate(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostApiAdminImpersonateResponse(rsp)
+}
+
+// PostApiAdminIssuersWithBodyWithResponse request with arbitrary body returning *PostApiAdminIssuersResponse
+func (c *ClientWithResponses) PostApiAdminIssuersWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostApiAdminIssuersResponse, error) {
+	rsp, err := c.PostApiAdminIssuersWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostApiAdminIssuersResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostApiAdminIssuersWithResponse(ctx context.Context, body PostApiAdminIssuersJSONRequestBody, reqEditors ...RequestEditorFn) (*PostApiAdminIssuersResponse, error) {
+	rsp, err := c.PostApiAdminIssuers(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostApiAdminIssuersResponse(rsp)
+}
+
+// DeleteApiAdminIssuersIdWithResponse request returning *DeleteApiAdminIssuersIdResponse
+func (c *ClientWithResponses) DeleteApiAdminIssuersIdWithResponse(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*DeleteApiAdminIssuersIdResponse, error) {
+	rsp, err := c.DeleteApiAdminIssuersId(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteApiAdminIssuersIdResponse(rsp)
+}
+
+// PutApiAdminIssuersIdWithBodyWithResponse request with arbitrary body returning *PutApiAdminIssuersIdResponse
+func (c *ClientWithResponses) PutApiAdminIssuersIdWithBodyWithResponse(ctx context.Context, id openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PutApiAdminIssuersIdResponse, error) {
+	rsp, err := c.PutApiAdminIssuersIdWithBody(ctx, id, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePutApiAdminIssuersIdResponse(rsp)
+}
+
+func (c *ClientWithResponses) PutApiAdminIssuersIdWithResponse(ctx context.Context, id openapi_types.UUID, body PutApiAdminIssuersIdJSONRequestBody, reqEditors ...RequestEditorFn) (*PutApiAdminIssuersIdResponse, error) {
+	rsp, err := c.PutApiAdminIssuersId(ctx, id, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePutApiAdminIssuersIdResponse(rsp)
+}
+
+// GetApiAdminLoadSheddingWithResponse request returning *GetApiAdminLoadSheddingResponse
+func (c *ClientWithResponses) GetApiAdminLoadSheddingWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetApiAdminLoadSheddingResponse, error) {
+	rsp, err := c.GetApiAdminLoadShedding(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetApiAdminLoadSheddingResponse(rsp)
+}
+
+// GetApiAdminNotificationProvidersWithResponse request returning *GetApiAdminNotificationProvidersResponse
+func (c *ClientWithResponses) GetApiAdminNotificationProvidersWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetApiAdminNotificationProvidersResponse, error) {
+	rsp, err := c.GetApiAdminNotificationProviders(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetApiAdminNotificationProvidersResponse(rsp)
+}
+
+// PostApiAdminRoadmapWithBodyWithResponse request with arbitrary body returning *PostApiAdminRoadmapResponse
+func (c *ClientWithResponses) PostApiAdminRoadmapWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostApiAdminRoadmapResponse, error) {
+	rsp, err := c.PostApiAdminRoadmapWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostApiAdminRoadmapResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostApiAdminRoadmapWithResponse(ctx context.Context, body PostApiAdminRoadmapJSONRequestBody, reqEditors ...RequestEditorFn) (*PostApiAdminRoadmapResponse, error) {
+	rsp, err := c.PostApiAdminRoadmap(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostApiAdminRoadmapResponse(rsp)
+}
+
+// DeleteApiAdminRoadmapIdWithResponse request returning *DeleteApiAdminRoadmapIdResponse
+func (c *ClientWithResponses) DeleteApiAdminRoadmapIdWithResponse(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*DeleteApiAdminRoadmapIdResponse, error) {
+	rsp, err := c.DeleteApiAdminRoadmapId(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteApiAdminRoadmapIdResponse(rsp)
+}
+
+// PutApiAdminRoadmapIdWithBodyWithResponse request with arbitrary body returning *PutApiAdminRoadmapIdResponse
+func (c *ClientWithResponses) PutApiAdminRoadmapIdWithBodyWithResponse(ctx context.Context, id openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PutApiAdminRoadmapIdResponse, error) {
+	rsp, err := c.PutApiAdminRoadmapIdWithBody(ctx, id, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePutApiAdminRoadmapIdResponse(rsp)
+}
+
+func (c *ClientWithResponses) PutApiAdminRoadmapIdWithResponse(ctx context.Context, id openapi_types.UUID, body PutApiAdminRoadmapIdJSONRequestBody, reqEditors ...RequestEditorFn) (*PutApiAdminRoadmapIdResponse, error) {
+	rsp, err := c.PutApiAdminRoadmapId(ctx, id, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePutApiAdminRoadmapIdResponse(rsp)
+}
+
+// PostApiAdminScheduledJobsNameRunWithResponse request returning *PostApiAdminScheduledJobsNameRunResponse
+func (c *ClientWithResponses) PostApiAdminScheduledJobsNameRunWithResponse(ctx context.Context, name PostApiAdminScheduledJobsNameRunParamsName, reqEditors ...RequestEditorFn) (*PostApiAdminScheduledJobsNameRunResponse, error) {
+	rsp, err := c.PostApiAdminScheduledJobsNameRun(ctx, name, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostApiAdminScheduledJobsNameRunResponse(rsp)
+}
+
+// GetApiAdminTasksFailedWithResponse request returning *GetApiAdminTasksFailedResponse
+func (c *ClientWithResponses) GetApiAdminTasksFailedWithResponse(ctx context.Context, params *GetApiAdminTasksFailedParams, reqEditors ...RequestEditorFn) (*GetApiAdminTasksFailedResponse, error) {
+	rsp, err := c.GetApiAdminTasksFailed(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetApiAdminTasksFailedResponse(rsp)
+}
+
+// PostApiAdminTasksQueueTaskIdRequeueWithResponse request returning *PostApiAdminTasksQueueTaskIdRequeueResponse
+func (c *ClientWithResponses) PostApiAdminTasksQueueTaskIdRequeueWithResponse(ctx context.Context, queue string, taskId string, reqEditors ...RequestEditorFn) (*PostApiAdminTasksQueueTaskIdRequeueResponse, error) {
+	rsp, err := c.PostApiAdminTasksQueueTaskIdRequeue(ctx, queue, taskId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostApiAdminTasksQueueTaskIdRequeueResponse(rsp)
+}
+
+// GetApiAdminUsageExportWithResponse request returning *GetApiAdminUsageExportResponse
+func (c *ClientWithResponses) GetApiAdminUsageExportWithResponse(ctx context.Context, params *GetApiAdminUsageExportParams, reqEditors ...RequestEditorFn) (*GetApiAdminUsageExportResponse, error) {
+	rsp, err := c.GetApiAdminUsageExport(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetApiAdminUsageExportResponse(rsp)
+}
+
+// PutApiAdminUsersIdPlanWithBodyWithResponse request with arbitrary body returning *PutApiAdminUsersIdPlanResponse
+func (c *ClientWithResponses) PutApiAdminUsersIdPlanWithBodyWithResponse(ctx context.Context, id openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PutApiAdminUsersIdPlanResponse, error) {
+	rsp, err := c.PutApiAdminUsersIdPlanWithBody(ctx, id, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePutApiAdminUsersIdPlanResponse(rsp)
+}
+
+func (c *ClientWithResponses) PutApiAdminUsersIdPlanWithResponse(ctx context.Context, id openapi_types.UUID, body PutApiAdminUsersIdPlanJSONRequestBody, reqEditors ...RequestEditorFn) (*PutApiAdminUsersIdPlanResponse, error) {
+	rsp, err := c.PutApiAdminUsersIdPlan(ctx, id, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePutApiAdminUsersIdPlanResponse(rsp)
+}
+
+// PostApiAdminUsersIdReinstateWithResponse request returning *PostApiAdminUsersIdReinstateResponse
+func (c *ClientWithResponses) PostApiAdminUsersIdReinstateWithResponse(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*PostApiAdminUsersIdReinstateResponse, error) {
+	rsp, err := c.PostApiAdminUsersIdReinstate(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostApiAdminUsersIdReinstateResponse(rsp)
+}
+
+// PutApiAdminUsersIdRoleWithBodyWithResponse request with arbitrary body returning *PutApiAdminUsersIdRoleResponse
+func (c *ClientWithResponses) PutApiAdminUsersIdRoleWithBodyWithResponse(ctx context.Context, id openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PutApiAdminUsersIdRoleResponse, error) {
+	rsp, err := c.PutApiAdminUsersIdRoleWithBody(ctx, id, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePutApiAdminUsersIdRoleResponse(rsp)
+}
+
+func (c *ClientWithResponses) PutApiAdminUsersIdRoleWithResponse(ctx context.Context, id openapi_types.UUID, body PutApiAdminUsersIdRoleJSONRequestBody, reqEditors ...RequestEditorFn) (*PutApiAdminUsersIdRoleResponse, error) {
+	rsp, err := c.PutApiAdminUsersIdRole(ctx, id, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePutApiAdminUsersIdRoleResponse(rsp)
+}
+
+// PostApiAdminUsersIdSuspendWithBodyWithResponse request with arbitrary body returning *PostApiAdminUsersIdSuspendResponse
+func (c *ClientWithResponses) PostApiAdminUsersIdSuspendWithBodyWithResponse(ctx context.Context, id openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostApiAdminUsersIdSuspendResponse, error) {
+	rsp, err := c.PostApiAdminUsersIdSuspendWithBody(ctx, id, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostApiAdminUsersIdSuspendResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostApiAdminUsersIdSuspendWithResponse(ctx context.Context, id openapi_types.UUID, body PostApiAdminUsersIdSuspendJSONRequestBody, reqEditors ...RequestEditorFn) (*PostApiAdminUsersIdSuspendResponse, error) {
+	rsp, err := c.PostApiAdminUsersIdSuspend(ctx, id, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostApiAdminUsersIdSuspendResponse(rsp)
+}
+
+// GetApiAnnouncementsWithResponse request returning *GetApiAnnouncementsResponse
+func (c *ClientWithResponses) GetApiAnnouncementsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetApiAnnouncementsResponse, error) {
+	rsp, err := c.GetApiAnnouncements(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetApiAnnouncementsResponse(rsp)
+}
+
+// GetApiApiKeysWithResponse request returning *GetApiApiKeysResponse
+func (c *ClientWithResponses) GetApiApiKeysWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetApiApiKeysResponse, error) {
+	rsp, err := c.GetApiApiKeys(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetApiApiKeysResponse(rsp)
+}
+
+// PostApiApiKeysWithBodyWithResponse request with arbitrary body returning *PostApiApiKeysResponse
+func (c *ClientWithResponses) PostApiApiKeysWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostApiApiKeysResponse, error) {
+	rsp, err := c.PostApiApiKeysWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostApiApiKeysResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostApiApiKeysWithResponse(ctx context.Context, body PostApiApiKeysJSONRequestBody, reqEditors ...RequestEditorFn) (*PostApiApiKeysResponse, error) {
+	rsp, err := c.PostApiApiKeys(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostApiApiKeysResponse(rsp)
+}
+
+// DeleteApiApiKeysIdWithResponse request returning *DeleteApiApiKeysIdResponse
+func (c *ClientWithResponses) DeleteApiApiKeysIdWithResponse(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*DeleteApiApiKeysIdResponse, error) {
+	rsp, err := c.DeleteApiApiKeysId(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteApiApiKeysIdResponse(rsp)
+}
+
+// PutApiAuthEmailWithBodyWithResponse request with arbitrary body returning *PutApiAuthEmailResponse
+func (c *ClientWithResponses) PutApiAuthEmailWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PutApiAuthEmailResponse, error) {
+	rsp, err := c.PutApiAuthEmailWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePutApiAuthEmailResponse(rsp)
+}
+
+func (c *ClientWithResponses) PutApiAuthEmailWithResponse(ctx context.Context, body PutApiAuthEmailJSONRequestBody, reqEditors ...RequestEditorFn) (*PutApiAuthEmailResponse, error) {
+	rsp, err := c.PutApiAuthEmail(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePutApiAuthEmailResponse(rsp)
+}
+
+// GetApiAuthEmailConfirmTokenWithResponse request returning *GetApiAuthEmailConfirmTokenResponse
+func (c *ClientWithResponses) GetApiAuthEmailConfirmTokenWithResponse(ctx context.Context, token string, reqEditors ...RequestEditorFn) (*GetApiAuthEmailConfirmTokenResponse, error) {
+	rsp, err := c.GetApiAuthEmailConfirmToken(ctx, token, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetApiAuthEmailConfirmTokenResponse(rsp)
+}
+
+// PostApiAuthEmailConfirmTokenWithResponse request returning *PostApiAuthEmailConfirmTokenResponse
+func (c *ClientWithResponses) PostApiAuthEmailConfirmTokenWithResponse(ctx context.Context, token string, reqEditors ...RequestEditorFn) (*PostApiAuthEmailConfirmTokenResponse, error) {
+	rsp, err := c.PostApiAuthEmailConfirmToken(ctx, token, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostApiAuthEmailConfirmTokenResponse(rsp)
+}
+
+// PostApiAuthLogoutWithResponse request returning *PostApiAuthLogoutResponse
+func (c *ClientWithResponses) PostApiAuthLogoutWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*PostApiAuthLogoutResponse, error) {
+	rsp, err := c.PostApiAuthLogout(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostApiAuthLogoutResponse(rsp)
+}
+
+// DeleteApiAuthMeWithBodyWithResponse request with arbitrary body returning *DeleteApiAuthMeResponse
+func (c *ClientWithResponses) DeleteApiAuthMeWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*DeleteApiAuthMeResponse, error) {
+	rsp, err := c.DeleteApiAuthMeWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteApiAuthMeResponse(rsp)
+}
+
+func (c *ClientWithResponses) DeleteApiAuthMeWithResponse(ctx context.Context, body DeleteApiAuthMeJSONRequestBody, reqEditors ...RequestEditorFn) (*DeleteApiAuthMeResponse, error) {
+	rsp, err := c.DeleteApiAuthMe(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteApiAuthMeResponse(rsp)
+}
+
+// GetApiAuthMeWithResponse request returning *GetApiAuthMeResponse
+func (c *ClientWithResponses) GetApiAuthMeWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetApiAuthMeResponse, error) {
+	rsp, err := c.GetApiAuthMe(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetApiAuthMeResponse(rsp)
+}
+
+// GetApiAuthOauthProviderWithResponse request returning *GetApiAuthOauthProviderResponse
+func (c *ClientWithResponses) GetApiAuthOauthProviderWithResponse(ctx context.Context, provider GetApiAuthOauthProviderParamsProvider, reqEditors ...RequestEditorFn) (*GetApiAuthOauthProviderResponse, error) {
+	rsp, err := c.GetApiAuthOauthProvider(ctx, provider, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetApiAuthOauthProviderResponse(rsp)
+}
+
+// GetApiAuthOauthProviderCallbackWithResponse request returning *GetApiAuthOauthProviderCallbackResponse
+func (c *ClientWithResponses) GetApiAuthOauthProviderCallbackWithResponse(ctx context.Context, provider GetApiAuthOauthProviderCallbackParamsProvider, params *GetApiAuthOauthProviderCallbackParams, reqEditors ...RequestEditorFn) (*GetApiAuthOauthProviderCallbackResponse, error) {
+	rsp, err := c.GetApiAuthOauthProviderCallback(ctx, provider, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetApiAuthOauthProviderCallbackResponse(rsp)
+}
+
+// PostApiAuthRegisterWithBodyWithResponse request with arbitrary body returning *PostApiAuthRegisterResponse
+func (c *ClientWithResponses) PostApiAuthRegisterWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostApiAuthRegisterResponse, error) {
+	rsp, err := c.PostApiAuthRegisterWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostApiAuthRegisterResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostApiAuthRegisterWithResponse(ctx context.Context, body PostApiAuthRegisterJSONRequestBody, reqEditors ...RequestEditorFn) (*PostApiAuthRegisterResponse, error) {
+	rsp, err := c.PostApiAuthRegister(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostApiAuthRegisterResponse(rsp)
+}
+
+// DeleteApiAuthSessionsWithResponse request returning *DeleteApiAuthSessionsResponse
+func (c *ClientWithResponses) DeleteApiAuthSessionsWithResponse(ctx context.Context, params *DeleteApiAuthSessionsParams, reqEditors ...RequestEditorFn) (*DeleteApiAuthSessionsResponse, error) {
+	rsp, err := c.DeleteApiAuthSessions(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteApiAuthSessionsResponse(rsp)
+}
+
+// GetApiAuthSessionsWithResponse request returning *GetApiAuthSessionsResponse
+func (c *ClientWithResponses) GetApiAuthSessionsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetApiAuthSessionsResponse, error) {
+	rsp, err := c.GetApiAuthSessions(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetApiAuthSessionsResponse(rsp)
+}
+
+// DeleteApiAuthSessionsIdWithResponse request returning *DeleteApiAuthSessionsIdResponse
+func (c *ClientWithResponses) DeleteApiAuthSessionsIdWithResponse(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*DeleteApiAuthSessionsIdResponse, error) {
+	rsp, err := c.DeleteApiAuthSessionsId(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteApiAuthSessionsIdResponse(rsp)
+}
+
+// PostApiAuthSigninWithBodyWithResponse request with arbitrary body returning *PostApiAuthSigninResponse
+func (c *ClientWithResponses) PostApiAuthSigninWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostApiAuthSigninResponse, error) {
+	rsp, err := c.PostApiAuthSigninWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostApiAuthSigninResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostApiAuthSigninWithResponse(ctx context.Context, body PostApiAuthSigninJSONRequestBody, reqEditors ...RequestEditorFn) (*PostApiAuthSigninResponse, error) {
+	rsp, err := c.PostApiAuthSignin(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostApiAuthSigninResponse(rsp)
+}
+
+// GetApiCategoriesWithResponse request returning *GetApiCategoriesResponse
+func (c *ClientWithResponses) GetApiCategoriesWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetApiCategoriesResponse, error) {
+	rsp, err := c.GetApiCategories(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetApiCategoriesResponse(rsp)
+}
+
+// GetApiCategoriesSlugSuggestExpirationWithResponse request returning *GetApiCategoriesSlugSuggestExpirationResponse
+func (c *ClientWithResponses) GetApiCategoriesSlugSuggestExpirationWithResponse(ctx context.Context, slug string, params *GetApiCategoriesSlugSuggestExpirationParams, reqEditors ...RequestEditorFn) (*GetApiCategoriesSlugSuggestExpirationResponse, error) {
+	rsp, err := c.GetApiCategoriesSlugSuggestExpiration(ctx, slug, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetApiCategoriesSlugSuggestExpirationResponse(rsp)
+}
+
+// GetApiDocumentsWithResponse request returning *GetApiDocumentsResponse
+func (c *ClientWithResponses) GetApiDocumentsWithResponse(ctx context.Context, params *GetApiDocumentsParams, reqEditors ...RequestEditorFn) (*GetApiDocumentsResponse, error) {
+	rsp, err := c.GetApiDocuments(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetApiDocumentsResponse(rsp)
+}
+
+// PostApiDocumentsWithBodyWithResponse request with arbitrary body returning *PostApiDocumentsResponse
+func (c *ClientWithResponses) PostApiDocumentsWithBodyWithResponse(ctx context.Context, params *PostApiDocumentsParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostApiDocumentsResponse, error) {
+	rsp, err := c.PostApiDocumentsWithBody(ctx, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostApiDocumentsResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostApiDocumentsWithResponse(ctx context.Context, params *PostApiDocumentsParams, body PostApiDocumentsJSONRequestBody, reqEditors ...RequestEditorFn) (*PostApiDocumentsResponse, error) {
+	rsp, err := c.PostApiDocuments(ctx, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostApiDocumentsResponse(rsp)
+}
+
+// GetApiDocumentsDependencyGraphWithResponse request returning *GetApiDocumentsDependencyGraphResponse
+func (c *ClientWithResponses) GetApiDocumentsDependencyGraphWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetApiDocumentsDependencyGraphResponse, error) {
+	rsp, err := c.GetApiDocumentsDependencyGraph(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetApiDocumentsDependencyGraphResponse(rsp)
+}
+
+// PostApiDocumentsImportWithBodyWithResponse request with arbitrary body returning *PostApiDocumentsImportResponse
+func (c *ClientWithResponses) PostApiDocumentsImportWithBodyWithResponse(ctx context.Context, params *PostApiDocumentsImportParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostApiDocumentsImportResponse, error) {
+	rsp, err := c.PostApiDocumentsImportWithBody(ctx, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostApiDocumentsImportResponse(rsp)
+}
+
+// PutApiDocumentsOrderWithBodyWithResponse request with arbitrary body returning *PutApiDocumentsOrderResponse
+func (c *ClientWithResponses) PutApiDocumentsOrderWithBodyWithResponse(ctx context.Context, params *PutApiDocumentsOrderParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PutApiDocumentsOrderResponse, error) {
+	rsp, err := c.PutApiDocumentsOrderWithBody(ctx, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePutApiDocumentsOrderResponse(rsp)
+}
+
+func (c *ClientWithResponses) PutApiDocumentsOrderWithResponse(ctx context.Context, params *PutApiDocumentsOrderParams, body PutApiDocumentsOrderJSONRequestBody, reqEditors ...RequestEditorFn) (*PutApiDocumentsOrderResponse, error) {
+	rsp, err := c.PutApiDocumentsOrder(ctx, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePutApiDocumentsOrderResponse(rsp)
+}
+
+// GetApiDocumentsRenewalCostsWithResponse request returning *GetApiDocumentsRenewalCostsResponse
+func (c *ClientWithResponses) GetApiDocumentsRenewalCostsWithResponse(ctx context.Context, params *GetApiDocumentsRenewalCostsParams, reqEditors ...RequestEditorFn) (*GetApiDocumentsRenewalCostsResponse, error) {
+	rsp, err := c.GetApiDocumentsRenewalCosts(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetApiDocumentsRenewalCostsResponse(rsp)
+}
+
+// GetApiDocumentsStatsWithResponse request returning *GetApiDocumentsStatsResponse
+func (c *ClientWithResponses) GetApiDocumentsStatsWithResponse(ctx context.Context, params *GetApiDocumentsStatsParams, reqEditors ...RequestEditorFn) (*GetApiDocumentsStatsResponse, error) {
+	rsp, err := c.GetApiDocumentsStats(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetApiDocumentsStatsResponse(rsp)
+}
+
+// GetApiDocumentsTrashWithResponse request returning *GetApiDocumentsTrashResponse
+func (c *ClientWithResponses) GetApiDocumentsTrashWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetApiDocumentsTrashResponse, error) {
+	rsp, err := c.GetApiDocumentsTrash(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetApiDocumentsTrashResponse(rsp)
+}
+
+// DeleteApiDocumentsIdWithResponse request returning *DeleteApiDocumentsIdResponse
+func (c *ClientWithResponses) DeleteApiDocumentsIdWithResponse(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*DeleteApiDocumentsIdResponse, error) {
+	rsp, err := c.DeleteApiDocumentsId(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteApiDocumentsIdResponse(rsp)
+}
+
+// GetApiDocumentsIdWithResponse request returning *GetApiDocumentsIdResponse
+func (c *ClientWithResponses) GetApiDocumentsIdWithResponse(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*GetApiDocumentsIdResponse, error) {
+	rsp, err := c.GetApiDocumentsId(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetApiDocumentsIdResponse(rsp)
+}
+
+// PutApiDocumentsIdWithBodyWithResponse request with arbitrary body returning *PutApiDocumentsIdResponse
+func (c *ClientWithResponses) PutApiDocumentsIdWithBodyWithResponse(ctx context.Context, id openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PutApiDocumentsIdResponse, error) {
+	rsp, err := c.PutApiDocumentsIdWithBody(ctx, id, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePutApiDocumentsIdResponse(rsp)
+}
+
+func (c *ClientWithResponses) PutApiDocumentsIdWithResponse(ctx context.Context, id openapi_types.UUID, body PutApiDocumentsIdJSONRequestBody, reqEditors ...RequestEditorFn) (*PutApiDocumentsIdResponse, error) {
+	rsp, err := c.PutApiDocumentsId(ctx, id, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePutApiDocumentsIdResponse(rsp)
+}
+
+// DeleteApiDocumentsIdAssigneeWithResponse request returning *DeleteApiDocumentsIdAssigneeResponse
+func (c *ClientWithResponses) DeleteApiDocumentsIdAssigneeWithResponse(ctx context.Context, id openapi_types.UUID, params *DeleteApiDocumentsIdAssigneeParams, reqEditors ...RequestEditorFn) (*DeleteApiDocumentsIdAssigneeResponse, error) {
+	rsp, err := c.DeleteApiDocumentsIdAssignee(ctx, id, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteApiDocumentsIdAssigneeResponse(rsp)
+}
+
+// GetApiDocumentsIdAssigneeWithResponse request returning *GetApiDocumentsIdAssigneeResponse
+func (c *ClientWithResponses) GetApiDocumentsIdAssigneeWithResponse(ctx context.Context, id openapi_types.UUID, params *GetApiDocumentsIdAssigneeParams, reqEditors ...RequestEditorFn) (*GetApiDocumentsIdAssigneeResponse, error) {
+	rsp, err := c.GetApiDocumentsIdAssignee(ctx, id, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetApiDocumentsIdAssigneeResponse(rsp)
+}
+
+// PutApiDocumentsIdAssigneeWithBodyWithResponse request with arbitrary body returning *PutApiDocumentsIdAssigneeResponse
+func (c *ClientWithResponses) PutApiDocumentsIdAssigneeWithBodyWithResponse(ctx context.Context, id openapi_types.UUID, params *PutApiDocumentsIdAssigneeParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PutApiDocumentsIdAssigneeResponse, error) {
+	rsp, err := c.PutApiDocumentsIdAssigneeWithBody(ctx, id, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePutApiDocumentsIdAssigneeResponse(rsp)
+}
+
+func (c *ClientWithResponses) PutApiDocumentsIdAssigneeWithResponse(ctx context.Context, id openapi_types.UUID, params *PutApiDocumentsIdAssigneeParams, body PutApiDocumentsIdAssigneeJSONRequestBody, reqEditors ...RequestEditorFn) (*PutApiDocumentsIdAssigneeResponse, error) {
+	rsp, err := c.PutApiDocumentsIdAssignee(ctx, id, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePutApiDocumentsIdAssigneeResponse(rsp)
+}
+
+// PostApiDocumentsIdAssigneeAcknowledgeWithResponse request returning *PostApiDocumentsIdAssigneeAcknowledgeResponse
+func (c *ClientWithResponses) PostApiDocumentsIdAssigneeAcknowledgeWithResponse(ctx context.Context, id openapi_types.UUID, params *PostApiDocumentsIdAssigneeAcknowledgeParams, reqEditors ...RequestEditorFn) (*PostApiDocumentsIdAssigneeAcknowledgeResponse, error) {
+	rsp, err := c.PostApiDocumentsIdAssigneeAcknowledge(ctx, id, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostApiDocumentsIdAssigneeAcknowledgeResponse(rsp)
+}
+
+// PutApiDocumentsIdAttachmentWithBodyWithResponse request with arbitrary body returning *PutApiDocumentsIdAttachmentResponse
+func (c *ClientWithResponses) PutApiDocumentsIdAttachmentWithBodyWithResponse(ctx context.Context, id openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PutApiDocumentsIdAttachmentResponse, error) {
+	rsp, err := c.PutApiDocumentsIdAttachmentWithBody(ctx, id, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePutApiDocumentsIdAttachmentResponse(rsp)
+}
+
+// GetApiDocumentsIdChecklistWithResponse request returning *GetApiDocumentsIdChecklistResponse
+func (c *ClientWithResponses) GetApiDocumentsIdChecklistWithResponse(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*GetApiDocumentsIdChecklistResponse, error) {
+	rsp, err := c.GetApiDocumentsIdChecklist(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetApiDocumentsIdChecklistResponse(rsp)
+}
+
+// PostApiDocumentsIdChecklistWithBodyWithResponse request with arbitrary body returning *PostApiDocumentsIdChecklistResponse
+func (c *ClientWithResponses) PostApiDocumentsIdChecklistWithBodyWithResponse(ctx context.Context, id openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostApiDocumentsIdChecklistResponse, error) {
+	rsp, err := c.PostApiDocumentsIdChecklistWithBody(ctx, id, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostApiDocumentsIdChecklistResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostApiDocumentsIdChecklistWithResponse(ctx context.Context, id openapi_types.UUID, body PostApiDocumentsIdChecklistJSONRequestBody, reqEditors ...RequestEditorFn) (*PostApiDocumentsIdChecklistResponse, error) {
+	rsp, err := c.PostApiDocumentsIdChecklist(ctx, id, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostApiDocumentsIdChecklistResponse(rsp)
+}
+
+// DeleteApiDocumentsIdChecklistItemIdWithResponse request returning *DeleteApiDocumentsIdChecklistItemIdResponse
+func (c *ClientWithResponses) DeleteApiDocumentsIdChecklistItemIdWithResponse(ctx context.Context, id openapi_types.UUID, itemId openapi_types.UUID, reqEditors ...RequestEditorFn) (*DeleteApiDocumentsIdChecklistItemIdResponse, error) {
+	rsp, err := c.DeleteApiDocumentsIdChecklistItemId(ctx, id, itemId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteApiDocumentsIdChecklistItemIdResponse(rsp)
+}
+
+// PutApiDocumentsIdChecklistItemIdWithBodyWithResponse request with arbitrary body returning *PutApiDocumentsIdChecklistItemIdResponse
+func (c *ClientWithResponses) PutApiDocumentsIdChecklistItemIdWithBodyWithResponse(ctx context.Context, id openapi_types.UUID, itemId openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PutApiDocumentsIdChecklistItemIdResponse, error) {
+	rsp, err := c.PutApiDocumentsIdChecklistItemIdWithBody(ctx, id, itemId, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePutApiDocumentsIdChecklistItemIdResponse(rsp)
+}
+
+func (c *ClientWithResponses) PutApiDocumentsIdChecklistItemIdWithResponse(ctx context.Context, id openapi_types.UUID, itemId openapi_types.UUID, body PutApiDocumentsIdChecklistItemIdJSONRequestBody, reqEditors ...RequestEditorFn) (*PutApiDocumentsIdChecklistItemIdResponse, error) {
+	rsp, err := c.PutApiDocumentsIdChecklistItemId(ctx, id, itemId, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePutApiDocumentsIdChecklistItemIdResponse(rsp)
+}
+
+// GetApiDocumentsIdContactsWithResponse request returning *GetApiDocumentsIdContactsResponse
+func (c *ClientWithResponses) GetApiDocumentsIdContactsWithResponse(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*GetApiDocumentsIdContactsResponse, error) {
+	rsp, err := c.GetApiDocumentsIdContacts(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetApiDocumentsIdContactsResponse(rsp)
+}
+
+// PostApiDocumentsIdContactsWithBodyWithResponse request with arbitrary body returning *PostApiDocumentsIdContactsResponse
+func (c *ClientWithResponses) PostApiDocumentsIdContactsWithBodyWithResponse(ctx context.Context, id openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostApiDocumentsIdContactsResponse, error) {
+	rsp, err := c.PostApiDocumentsIdContactsWithBody(ctx, id, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostApiDocumentsIdContactsResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostApiDocumentsIdContactsWithResponse(ctx context.Context, id openapi_types.UUID, body PostApiDocumentsIdContactsJSONRequestBody, reqEditors ...RequestEditorFn) (*PostApiDocumentsIdContactsResponse, error) {
+	rsp, err := c.PostApiDocumentsIdContacts(ctx, id, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostApiDocumentsIdContactsResponse(rsp)
+}
+
+// DeleteApiDocumentsIdContactsContactIdWithResponse request returning *DeleteApiDocumentsIdContactsContactIdResponse
+func (c *ClientWithResponses) DeleteApiDocumentsIdContactsContactIdWithResponse(ctx context.Context, id openapi_types.UUID, contactId openapi_types.UUID, reqEditors ...RequestEditorFn) (*DeleteApiDocumentsIdContactsContactIdResponse, error) {
+	rsp, err := c.DeleteApiDocumentsIdContactsContactId(ctx, id, contactId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteApiDocumentsIdContactsContactIdResponse(rsp)
+}
+
+// GetApiDocumentsIdDependenciesWithResponse request returning *GetApiDocumentsIdDependenciesResponse
+func (c *ClientWithResponses) GetApiDocumentsIdDependenciesWithResponse(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*GetApiDocumentsIdDependenciesResponse, error) {
+	rsp, err := c.GetApiDocumentsIdDependencies(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetApiDocumentsIdDependenciesResponse(rsp)
+}
+
+// PostApiDocumentsIdDependenciesWithBodyWithResponse request with arbitrary body returning *PostApiDocumentsIdDependenciesResponse
+func (c *ClientWithResponses) PostApiDocumentsIdDependenciesWithBodyWithResponse(ctx context.Context, id openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostApiDocumentsIdDependenciesResponse, error) {
+	rsp, err := c.PostApiDocumentsIdDependenciesWithBody(ctx, id, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostApiDocumentsIdDependenciesResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostApiDocumentsIdDependenciesWithResponse(ctx context.Context, id openapi_types.UUID, body PostApiDocumentsIdDependenciesJSONRequestBody, reqEditors ...RequestEditorFn) (*PostApiDocumentsIdDependenciesResponse, error) {
+	rsp, err := c.PostApiDocumentsIdDependencies(ctx, id, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostApiDocumentsIdDependenciesResponse(rsp)
+}
+
+// DeleteApiDocumentsIdDependenciesDependsOnIdWithResponse request returning *DeleteApiDocumentsIdDependenciesDependsOnIdResponse
+func (c *ClientWithResponses) DeleteApiDocumentsIdDependenciesDependsOnIdWithResponse(ctx context.Context, id openapi_types.UUID, dependsOnId openapi_types.UUID, reqEditors ...RequestEditorFn) (*DeleteApiDocumentsIdDependenciesDependsOnIdResponse, error) {
+	rsp, err := c.DeleteApiDocumentsIdDependenciesDependsOnId(ctx, id, dependsOnId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteApiDocumentsIdDependenciesDependsOnIdResponse(rsp)
+}
+
+// GetApiDocumentsIdLegalHoldWithResponse request returning *GetApiDocumentsIdLegalHoldResponse
+func (c *ClientWithResponses) GetApiDocumentsIdLegalHoldWithResponse(ctx context.Context, id openapi_types.UUID, params *GetApiDocumentsIdLegalHoldParams, reqEditors ...RequestEditorFn) (*GetApiDocumentsIdLegalHoldResponse, error) {
+	rsp, err := c.GetApiDocumentsIdLegalHold(ctx, id, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetApiDocumentsIdLegalHoldResponse(rsp)
+}
+
+// PutApiDocumentsIdLegalHoldWithBodyWithResponse request with arbitrary body returning *PutApiDocumentsIdLegalHoldResponse
+func (c *ClientWithResponses) PutApiDocumentsIdLegalHoldWithBodyWithResponse(ctx context.Context, id openapi_types.UUID, params *PutApiDocumentsIdLegalHoldParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PutApiDocumentsIdLegalHoldResponse, error) {
+	rsp, err := c.PutApiDocumentsIdLegalHoldWithBody(ctx, id, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePutApiDocumentsIdLegalHoldResponse(rsp)
+}
+
+func (c *ClientWithResponses) PutApiDocumentsIdLegalHoldWithResponse(ctx context.Context, id openapi_types.UUID, params *PutApiDocumentsIdLegalHoldParams, body PutApiDocumentsIdLegalHoldJSONRequestBody, reqEditors ...RequestEditorFn) (*PutApiDocumentsIdLegalHoldResponse, error) {
+	rsp, err := c.PutApiDocumentsIdLegalHold(ctx, id, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePutApiDocumentsIdLegalHoldResponse(rsp)
+}
+
+// DeleteApiDocumentsIdLockWithResponse request returning *DeleteApiDocumentsIdLockResponse
+func (c *ClientWithResponses) DeleteApiDocumentsIdLockWithResponse(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*DeleteApiDocumentsIdLockResponse, error) {
+	rsp, err := c.DeleteApiDocumentsIdLock(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteApiDocumentsIdLockResponse(rsp)
+}
+
+// PostApiDocumentsIdLockWithBodyWithResponse request with arbitrary body returning *PostApiDocumentsIdLockResponse
+func (c *ClientWithResponses) PostApiDocumentsIdLockWithBodyWithResponse(ctx context.Context, id openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostApiDocumentsIdLockResponse, error) {
+	rsp, err := c.PostApiDocumentsIdLockWithBody(ctx, id, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostApiDocumentsIdLockResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostApiDocumentsIdLockWithResponse(ctx context.Context, id openapi_types.UUID, body PostApiDocumentsIdLockJSONRequestBody, reqEditors ...RequestEditorFn) (*PostApiDocumentsIdLockResponse, error) {
+	rsp, err := c.PostApiDocumentsIdLock(ctx, id, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostApiDocumentsIdLockResponse(rsp)
+}
+
+// DeleteApiDocumentsIdPinWithResponse request returning *DeleteApiDocumentsIdPinResponse
+func (c *ClientWithResponses) DeleteApiDocumentsIdPinWithResponse(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*DeleteApiDocumentsIdPinResponse, error) {
+	rsp, err := c.DeleteApiDocumentsIdPin(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteApiDocumentsIdPinResponse(rsp)
+}
+
+// PostApiDocumentsIdPinWithResponse request returning *PostApiDocumentsIdPinResponse
+func (c *ClientWithResponses) PostApiDocumentsIdPinWithResponse(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*PostApiDocumentsIdPinResponse, error) {
+	rsp, err := c.PostApiDocumentsIdPin(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostApiDocumentsIdPinResponse(rsp)
+}
+
+// GetApiDocumentsIdRemindersWithResponse request returning *GetApiDocumentsIdRemindersResponse
+func (c *ClientWithResponses) GetApiDocumentsIdRemindersWithResponse(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*GetApiDocumentsIdRemindersResponse, error) {
+	rsp, err := c.GetApiDocumentsIdReminders(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetApiDocumentsIdRemindersResponse(rsp)
+}
+
+// PutApiDocumentsIdRemindersWithBodyWithResponse request with arbitrary body returning *PutApiDocumentsIdRemindersResponse
+func (c *ClientWithResponses) PutApiDocumentsIdRemindersWithBodyWithResponse(ctx context.Context, id openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PutApiDocumentsIdRemindersResponse, error) {
+	rsp, err := c.PutApiDocumentsIdRemindersWithBody(ctx, id, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePutApiDocumentsIdRemindersResponse(rsp)
+}
+
+func (c *ClientWithResponses) PutApiDocumentsIdRemindersWithResponse(ctx context.Context, id openapi_types.UUID, body PutApiDocumentsIdRemindersJSONRequestBody, reqEditors ...RequestEditorFn) (*PutApiDocumentsIdRemindersResponse, error) {
+	rsp, err := c.PutApiDocumentsIdReminders(ctx, id, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePutApiDocumentsIdRemindersResponse(rsp)
+}
+
+// GetApiDocumentsIdRemindersPreviewWithResponse request returning *GetApiDocumentsIdRemindersPreviewResponse
+func (c *ClientWithResponses) GetApiDocumentsIdRemindersPreviewWithResponse(ctx context.Context, id openapi_types.UUID, params *GetApiDocumentsIdRemindersPreviewParams, reqEditors ...RequestEditorFn) (*GetApiDocumentsIdRemindersPreviewResponse, error) {
+	rsp, err := c.GetApiDocumentsIdRemindersPreview(ctx, id, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetApiDocumentsIdRemindersPreviewResponse(rsp)
+}
+
+// GetApiDocumentsIdRenewalsWithResponse request returning *GetApiDocumentsIdRenewalsResponse
+func (c *ClientWithResponses) GetApiDocumentsIdRenewalsWithResponse(ctx context.Context, id openapi_types.UUID, params *GetApiDocumentsIdRenewalsParams, reqEditors ...RequestEditorFn) (*GetApiDocumentsIdRenewalsResponse, error) {
+	rsp, err := c.GetApiDocumentsIdRenewals(ctx, id, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetApiDocumentsIdRenewalsResponse(rsp)
+}
+
+// PostApiDocumentsIdRenewalsWithBodyWithResponse request with arbitrary body returning *PostApiDocumentsIdRenewalsResponse
+func (c *ClientWithResponses) PostApiDocumentsIdRenewalsWithBodyWithResponse(ctx context.Context, id openapi_types.UUID, params *PostApiDocumentsIdRenewalsParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostApiDocumentsIdRenewalsResponse, error) {
+	rsp, err := c.PostApiDocumentsIdRenewalsWithBody(ctx, id, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostApiDocumentsIdRenewalsResponse(rsp)
+}
+
+// PostApiDocumentsIdRenewalsRenewalIdApproveWithBodyWithResponse request with arbitrary body returning *PostApiDocumentsIdRenewalsRenewalIdApproveResponse
+func (c *ClientWithResponses) PostApiDocumentsIdRenewalsRenewalIdApproveWithBodyWithResponse(ctx context.Context, id openapi_types.UUID, renewalId openapi_types.UUID, params *PostApiDocumentsIdRenewalsRenewalIdApproveParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostApiDocumentsIdRenewalsRenewalIdApproveResponse, error) {
+	rsp, err := c.PostApiDocumentsIdRenewalsRenewalIdApproveWithBody(ctx, id, renewalId, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostApiDocumentsIdRenewalsRenewalIdApproveResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostApiDocumentsIdRenewalsRenewalIdApproveWithResponse(ctx context.Context, id openapi_types.UUID, renewalId openapi_types.UUID, params *PostApiDocumentsIdRenewalsRenewalIdApproveParams, body PostApiDocumentsIdRenewalsRenewalIdApproveJSONRequestBody, reqEditors ...RequestEditorFn) (*PostApiDocumentsIdRenewalsRenewalIdApproveResponse, error) {
+	rsp, err := c.PostApiDocumentsIdRenewalsRenewalIdApprove(ctx, id, renewalId, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostApiDocumentsIdRenewalsRenewalIdApproveResponse(rsp)
+}
+
+// PostApiDocumentsIdRenewalsRenewalIdRejectWithBodyWithResponse request with arbitrary body returning *PostApiDocumentsIdRenewalsRenewalIdRejectResponse
+func (c *ClientWithResponses) PostApiDocumentsIdRenewalsRenewalIdRejectWithBodyWithResponse(ctx context.Context, id openapi_types.UUID, renewalId openapi_types.UUID, params *PostApiDocumentsIdRenewalsRenewalIdRejectParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostApiDocumentsIdRenewalsRenewalIdRejectResponse, error) {
+	rsp, err := c.PostApiDocumentsIdRenewalsRenewalIdRejectWithBody(ctx, id, renewalId, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostApiDocumentsIdRenewalsRenewalIdRejectResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostApiDocumentsIdRenewalsRenewalIdRejectWithResponse(ctx context.Context, id openapi_types.UUID, renewalId openapi_types.UUID, params *PostApiDocumentsIdRenewalsRenewalIdRejectParams, body PostApiDocumentsIdRenewalsRenewalIdRejectJSONRequestBody, reqEditors ...RequestEditorFn) (*PostApiDocumentsIdRenewalsRenewalIdRejectResponse, error) {
+	rsp, err := c.PostApiDocumentsIdRenewalsRenewalIdReject(ctx, id, renewalId, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostApiDocumentsIdRenewalsRenewalIdRejectResponse(rsp)
+}
+
+// PostApiDocumentsIdRestoreWithResponse request returning *PostApiDocumentsIdRestoreResponse
+func (c *ClientWithResponses) PostApiDocumentsIdRestoreWithResponse(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*PostApiDocumentsIdRestoreResponse, error) {
+	rsp, err := c.PostApiDocumentsIdRestore(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostApiDocumentsIdRestoreResponse(rsp)
+}
+
+// DeleteApiFeedWithResponse request returning *DeleteApiFeedResponse
+func (c *ClientWithResponses) DeleteApiFeedWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*DeleteApiFeedResponse, error) {
+	rsp, err := c.DeleteApiFeed(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteApiFeedResponse(rsp)
+}
+
+// GetApiFeedWithResponse request returning *GetApiFeedResponse
+func (c *ClientWithResponses) GetApiFeedWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetApiFeedResponse, error) {
+	rsp, err := c.GetApiFeed(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetApiFeedResponse(rsp)
+}
+
+// PostApiFeedWithResponse request returning *PostApiFeedResponse
+func (c *ClientWithResponses) PostApiFeedWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*PostApiFeedResponse, error) {
+	rsp, err := c.PostApiFeed(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostApiFeedResponse(rsp)
+}
+
+// PostApiFeedbackWithBodyWithResponse request with arbitrary body returning *PostApiFeedbackResponse
+func (c *ClientWithResponses) PostApiFeedbackWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostApiFeedbackResponse, error) {
+	rsp, err := c.PostApiFeedbackWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostApiFeedbackResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostApiFeedbackWithResponse(ctx context.Context, body PostApiFeedbackJSONRequestBody, reqEditors ...RequestEditorFn) (*PostApiFeedbackResponse, error) {
+	rsp, err := c.PostApiFeedback(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostApiFeedbackResponse(rsp)
+}
+
+// GetApiFeedsTokenAtomXmlWithResponse request returning *GetApiFeedsTokenAtomXmlResponse
+func (c *ClientWithResponses) GetApiFeedsTokenAtomXmlWithResponse(ctx context.Context, token string, reqEditors ...RequestEditorFn) (*GetApiFeedsTokenAtomXmlResponse, error) {
+	rsp, err := c.GetApiFeedsTokenAtomXml(ctx, token, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetApiFeedsTokenAtomXmlResponse(rsp)
+}
+
+// GetApiFeedsTokenRssXmlWithResponse request returning *GetApiFeedsTokenRssXmlResponse
+func (c *ClientWithResponses) GetApiFeedsTokenRssXmlWithResponse(ctx context.Context, token string, reqEditors ...RequestEditorFn) (*GetApiFeedsTokenRssXmlResponse, error) {
+	rsp, err := c.GetApiFeedsTokenRssXml(ctx, token, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetApiFeedsTokenRssXmlResponse(rsp)
+}
+
+// PostApiGraphqlWithBodyWithResponse request with arbitrary body returning *PostApiGraphqlResponse
+func (c *ClientWithResponses) PostApiGraphqlWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostApiGraphqlResponse, error) {
+	rsp, err := c.PostApiGraphqlWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostApiGraphqlResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostApiGraphqlWithResponse(ctx context.Context, body PostApiGraphqlJSONRequestBody, reqEditors ...RequestEditorFn) (*PostApiGraphqlResponse, error) {
+	rsp, err := c.PostApiGraphql(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostApiGraphqlResponse(rsp)
+}
+
+// GetApiHouseholdWithResponse request returning *GetApiHouseholdResponse
+func (c *ClientWithResponses) GetApiHouseholdWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetApiHouseholdResponse, error) {
+	rsp, err := c.GetApiHousehold(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetApiHouseholdResponse(rsp)
+}
+
+// PostApiHouseholdWithBodyWithResponse request with arbitrary body returning *PostApiHouseholdResponse
+func (c *ClientWithResponses) PostApiHouseholdWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostApiHouseholdResponse, error) {
+	rsp, err := c.PostApiHouseholdWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostApiHouseholdResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostApiHouseholdWithResponse(ctx context.Context, body PostApiHouseholdJSONRequestBody, reqEditors ...RequestEditorFn) (*PostApiHouseholdResponse, error) {
+	rsp, err := c.PostApiHousehold(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostApiHouseholdResponse(rsp)
+}
+
+// PostApiHouseholdMembersWithBodyWithResponse request with arbitrary body returning *PostApiHouseholdMembersResponse
+func (c *ClientWithResponses) PostApiHouseholdMembersWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostApiHouseholdMembersResponse, error) {
+	rsp, err := c.PostApiHouseholdMembersWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostApiHouseholdMembersResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostApiHouseholdMembersWithResponse(ctx context.Context, body PostApiHouseholdMembersJSONRequestBody, reqEditors ...RequestEditorFn) (*PostApiHouseholdMembersResponse, error) {
+	rsp, err := c.PostApiHouseholdMembers(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostApiHouseholdMembersResponse(rsp)
+}
+
+// DeleteApiHouseholdMembersUserIdWithResponse request returning *DeleteApiHouseholdMembersUserIdResponse
+func (c *ClientWithResponses) DeleteApiHouseholdMembersUserIdWithResponse(ctx context.Context, userId openapi_types.UUID, reqEditors ...RequestEditorFn) (*DeleteApiHouseholdMembersUserIdResponse, error) {
+	rsp, err := c.DeleteApiHouseholdMembersUserId(ctx, userId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteApiHouseholdMembersUserIdResponse(rsp)
+}
+
+// PutApiHouseholdMembersUserIdWithBodyWithResponse request with arbitrary body returning *PutApiHouseholdMembersUserIdResponse
+func (c *ClientWithResponses) PutApiHouseholdMembersUserIdWithBodyWithResponse(ctx context.Context, userId openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PutApiHouseholdMembersUserIdResponse, error) {
+	rsp, err := c.PutApiHouseholdMembersUserIdWithBody(ctx, userId, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePutApiHouseholdMembersUserIdResponse(rsp)
+}
+
+func (c *ClientWithResponses) PutApiHouseholdMembersUserIdWithResponse(ctx context.Context, userId openapi_types.UUID, body PutApiHouseholdMembersUserIdJSONRequestBody, reqEditors ...RequestEditorFn) (*PutApiHouseholdMembersUserIdResponse, error) {
+	rsp, err := c.PutApiHouseholdMembersUserId(ctx, userId, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePutApiHouseholdMembersUserIdResponse(rsp)
+}
+
+// GetApiHouseholdMembersUserIdDocumentsWithResponse request returning *GetApiHouseholdMembersUserIdDocumentsResponse
+func (c *ClientWithResponses) GetApiHouseholdMembersUserIdDocumentsWithResponse(ctx context.Context, userId openapi_types.UUID, reqEditors ...RequestEditorFn) (*GetApiHouseholdMembersUserIdDocumentsResponse, error) {
+	rsp, err := c.GetApiHouseholdMembersUserIdDocuments(ctx, userId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetApiHouseholdMembersUserIdDocumentsResponse(rsp)
+}
+
+// GetApiIntegrationsEgressIpsWithResponse request returning *GetApiIntegrationsEgressIpsResponse
+func (c *ClientWithResponses) GetApiIntegrationsEgressIpsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetApiIntegrationsEgressIpsResponse, error) {
+	rsp, err := c.GetApiIntegrationsEgressIps(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetApiIntegrationsEgressIpsResponse(rsp)
+}
+
+// GetApiIssuersWithResponse request returning *GetApiIssuersResponse
+func (c *ClientWithResponses) GetApiIssuersWithResponse(ctx context.Context, params *GetApiIssuersParams, reqEditors ...RequestEditorFn) (*GetApiIssuersResponse, error) {
+	rsp, err := c.GetApiIssuers(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetApiIssuersResponse(rsp)
+}
+
+// GetApiJobsWithResponse request returning *GetApiJobsResponse
+func (c *ClientWithResponses) GetApiJobsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetApiJobsResponse, error) {
+	rsp, err := c.GetApiJobs(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetApiJobsResponse(rsp)
+}
+
+// PostApiJobsImportWithBodyWithResponse request with arbitrary body returning *PostApiJobsImportResponse
+func (c *ClientWithResponses) PostApiJobsImportWithBodyWithResponse(ctx context.Context, params *PostApiJobsImportParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostApiJobsImportResponse, error) {
+	rsp, err := c.PostApiJobsImportWithBody(ctx, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostApiJobsImportResponse(rsp)
+}
+
+// PostApiJobsTakeoutWithResponse request returning *PostApiJobsTakeoutResponse
+func (c *ClientWithResponses) PostApiJobsTakeoutWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*PostApiJobsTakeoutResponse, error) {
+	rsp, err := c.PostApiJobsTakeout(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostApiJobsTakeoutResponse(rsp)
+}
+
+// GetApiJobsIdWithResponse request returning *GetApiJobsIdResponse
+func (c *ClientWithResponses) GetApiJobsIdWithResponse(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*GetApiJobsIdResponse, error) {
+	rsp, err := c.GetApiJobsId(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetApiJobsIdResponse(rsp)
+}
+
+// GetApiLinksTokenWithResponse request returning *GetApiLinksTokenResponse
+func (c *ClientWithResponses) GetApiLinksTokenWithResponse(ctx context.Context, token string, params *GetApiLinksTokenParams, reqEditors ...RequestEditorFn) (*GetApiLinksTokenResponse, error) {
+	rsp, err := c.GetApiLinksToken(ctx, token, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetApiLinksTokenResponse(rsp)
+}
+
+// PostApiLinksTokenWithResponse request returning *PostApiLinksTokenResponse
+func (c *ClientWithResponses) PostApiLinksTokenWithResponse(ctx context.Context, token string, params *PostApiLinksTokenParams, reqEditors ...RequestEditorFn) (*PostApiLinksTokenResponse, error) {
+	rsp, err := c.PostApiLinksToken(ctx, token, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostApiLinksTokenResponse(rsp)
+}
+
+// PostApiNotificationsReadWithResponse request returning *PostApiNotificationsReadResponse
+func (c *ClientWithResponses) PostApiNotificationsReadWithResponse(ctx context.Context, params *PostApiNotificationsReadParams, reqEditors ...RequestEditorFn) (*PostApiNotificationsReadResponse, error) {
+	rsp, err := c.PostApiNotificationsRead(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostApiNotificationsReadResponse(rsp)
+}
+
+// GetApiNotificationsUnreadCountWithResponse request returning *GetApiNotificationsUnreadCountResponse
+func (c *ClientWithResponses) GetApiNotificationsUnreadCountWithResponse(ctx context.Context, params *GetApiNotificationsUnreadCountParams, reqEditors ...RequestEditorFn) (*GetApiNotificationsUnreadCountResponse, error) {
+	rsp, err := c.GetApiNotificationsUnreadCount(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetApiNotificationsUnreadCountResponse(rsp)
+}
+
+// DeleteApiOnboardingSampleDataWithResponse request returning *DeleteApiOnboardingSampleDataResponse
+func (c *ClientWithResponses) DeleteApiOnboardingSampleDataWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*DeleteApiOnboardingSampleDataResponse, error) {
+	rsp, err := c.DeleteApiOnboardingSampleData(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteApiOnboardingSampleDataResponse(rsp)
+}
+
+// PostApiOnboardingSampleDataWithResponse request returning *PostApiOnboardingSampleDataResponse
+func (c *ClientWithResponses) PostApiOnboardingSampleDataWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*PostApiOnboardingSampleDataResponse, error) {
+	rsp, err := c.PostApiOnboardingSampleData(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostApiOnboardingSampleDataResponse(rsp)
+}
+
+// GetApiOrganizationsWithResponse request returning *GetApiOrganizationsResponse
+func (c *ClientWithResponses) GetApiOrganizationsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetApiOrganizationsResponse, error) {
+	rsp, err := c.GetApiOrganizations(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetApiOrganizationsResponse(rsp)
+}
+
+// PostApiOrganizationsWithBodyWithResponse request with arbitrary body returning *PostApiOrganizationsResponse
+func (c *ClientWithResponses) PostApiOrganizationsWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostApiOrganizationsResponse, error) {
+	rsp, err := c.PostApiOrganizationsWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostApiOrganizationsResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostApiOrganizationsWithResponse(ctx context.Context, body PostApiOrganizationsJSONRequestBody, reqEditors ...RequestEditorFn) (*PostApiOrganizationsResponse, error) {
+	rsp, err := c.PostApiOrganizations(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostApiOrganizationsResponse(rsp)
+}
+
+// PutApiOrganizationsIdComplianceWithBodyWithResponse request with arbitrary body returning *PutApiOrganizationsIdComplianceResponse
+func (c *ClientWithResponses) PutApiOrganizationsIdComplianceWithBodyWithResponse(ctx context.Context, id openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PutApiOrganizationsIdComplianceResponse, error) {
+	rsp, err := c.PutApiOrganizationsIdComplianceWithBody(ctx, id, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePutApiOrganizationsIdComplianceResponse(rsp)
+}
+
+func (c *ClientWithResponses) PutApiOrganizationsIdComplianceWithResponse(ctx context.Context, id openapi_types.UUID, body PutApiOrganizationsIdComplianceJSONRequestBody, reqEditors ...RequestEditorFn) (*PutApiOrganizationsIdComplianceResponse, error) {
+	rsp, err := c.PutApiOrganizationsIdCompliance(ctx, id, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePutApiOrganizationsIdComplianceResponse(rsp)
+}
+
+// DeleteApiOrganizationsIdEmailSenderWithResponse request returning *DeleteApiOrganizationsIdEmailSenderResponse
+func (c *ClientWithResponses) DeleteApiOrganizationsIdEmailSenderWithResponse(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*DeleteApiOrganizationsIdEmailSenderResponse, error) {
+	rsp, err := c.DeleteApiOrganizationsIdEmailSender(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteApiOrganizationsIdEmailSenderResponse(rsp)
+}
+
+// GetApiOrganizationsIdEmailSenderWithResponse request returning *GetApiOrganizationsIdEmailSenderResponse
+func (c *ClientWithResponses) GetApiOrganizationsIdEmailSenderWithResponse(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*GetApiOrganizationsIdEmailSenderResponse, error) {
+	rsp, err := c.GetApiOrganizationsIdEmailSender(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetApiOrganizationsIdEmailSenderResponse(rsp)
+}
+
+// PutApiOrganizationsIdEmailSenderWithBodyWithResponse request with arbitrary body returning *PutApiOrganizationsIdEmailSenderResponse
+func (c *ClientWithResponses) PutApiOrganizationsIdEmailSenderWithBodyWithResponse(ctx context.Context, id openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PutApiOrganizationsIdEmailSenderResponse, error) {
+	rsp, err := c.PutApiOrganizationsIdEmailSenderWithBody(ctx, id, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePutApiOrganizationsIdEmailSenderResponse(rsp)
+}
+
+func (c *ClientWithResponses) PutApiOrganizationsIdEmailSenderWithResponse(ctx context.Context, id openapi_types.UUID, body PutApiOrganizationsIdEmailSenderJSONRequestBody, reqEditors ...RequestEditorFn) (*PutApiOrganizationsIdEmailSenderResponse, error) {
+	rsp, err := c.PutApiOrganizationsIdEmailSender(ctx, id, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePutApiOrganizationsIdEmailSenderResponse(rsp)
+}
+
+// PostApiOrganizationsIdEmailSenderVerifyWithResponse request returning *PostApiOrganizationsIdEmailSenderVerifyResponse
+func (c *ClientWithResponses) PostApiOrganizationsIdEmailSenderVerifyWithResponse(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*PostApiOrganizationsIdEmailSenderVerifyResponse, error) {
+	rsp, err := c.PostApiOrganizationsIdEmailSenderVerify(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostApiOrganizationsIdEmailSenderVerifyResponse(rsp)
+}
+
+// GetApiOrganizationsIdEscalationPolicyWithResponse request returning *GetApiOrganizationsIdEscalationPolicyResponse
+func (c *ClientWithResponses) GetApiOrganizationsIdEscalationPolicyWithResponse(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*GetApiOrganizationsIdEscalationPolicyResponse, error) {
+	rsp, err := c.GetApiOrganizationsIdEscalationPolicy(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetApiOrganizationsIdEscalationPolicyResponse(rsp)
+}
+
+// PutApiOrganizationsIdEscalationPolicyWithBodyWithResponse request with arbitrary body returning *PutApiOrganizationsIdEscalationPolicyResponse
+func (c *ClientWithResponses) PutApiOrganizationsIdEscalationPolicyWithBodyWithResponse(ctx context.Context, id openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PutApiOrganizationsIdEscalationPolicyResponse, error) {
+	rsp, err := c.PutApiOrganizationsIdEscalationPolicyWithBody(ctx, id, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePutApiOrganizationsIdEscalationPolicyResponse(rsp)
+}
+
+func (c *ClientWithResponses) PutApiOrganizationsIdEscalationPolicyWithResponse(ctx context.Context, id openapi_types.UUID, body PutApiOrganizationsIdEscalationPolicyJSONRequestBody, reqEditors ...RequestEditorFn) (*PutApiOrganizationsIdEscalationPolicyResponse, error) {
+	rsp, err := c.PutApiOrganizationsIdEscalationPolicy(ctx, id, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePutApiOrganizationsIdEscalationPolicyResponse(rsp)
+}
+
+// GetApiOrganizationsIdEventLogExportWithResponse request returning *GetApiOrganizationsIdEventLogExportResponse
+func (c *ClientWithResponses) GetApiOrganizationsIdEventLogExportWithResponse(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*GetApiOrganizationsIdEventLogExportResponse, error) {
+	rsp, err := c.GetApiOrganizationsIdEventLogExport(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetApiOrganizationsIdEventLogExportResponse(rsp)
+}
+
+// GetApiOrganizationsIdLegalHoldWithResponse request returning *GetApiOrganizationsIdLegalHoldResponse
+func (c *ClientWithResponses) GetApiOrganizationsIdLegalHoldWithResponse(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*GetApiOrganizationsIdLegalHoldResponse, error) {
+	rsp, err := c.GetApiOrganizationsIdLegalHold(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetApiOrganizationsIdLegalHoldResponse(rsp)
+}
+
+// PutApiOrganizationsIdLegalHoldWithBodyWithResponse request with arbitrary body returning *PutApiOrganizationsIdLegalHoldResponse
+func (c *ClientWithResponses) PutApiOrganizationsIdLegalHoldWithBodyWithResponse(ctx context.Context, id openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PutApiOrganizationsIdLegalHoldResponse, error) {
+	rsp, err := c.PutApiOrganizationsIdLegalHoldWithBody(ctx, id, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePutApiOrganizationsIdLegalHoldResponse(rsp)
+}
+
+func (c *ClientWithResponses) PutApiOrganizationsIdLegalHoldWithResponse(ctx context.Context, id openapi_types.UUID, body PutApiOrganizationsIdLegalHoldJSONRequestBody, reqEditors ...RequestEditorFn) (*PutApiOrganizationsIdLegalHoldResponse, error) {
+	rsp, err := c.PutApiOrganizationsIdLegalHold(ctx, id, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePutApiOrganizationsIdLegalHoldResponse(rsp)
+}
+
+// GetApiOrganizationsIdMembersWithResponse request returning *GetApiOrganizationsIdMembersResponse
+func (c *ClientWithResponses) GetApiOrganizationsIdMembersWithResponse(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*GetApiOrganizationsIdMembersResponse, error) {
+	rsp, err := c.GetApiOrganizationsIdMembers(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetApiOrganizationsIdMembersResponse(rsp)
+}
+
+// PostApiOrganizationsIdMembersWithBodyWithResponse request with arbitrary body returning *PostApiOrganizationsIdMembersResponse
+func (c *ClientWithResponses) PostApiOrganizationsIdMembersWithBodyWithResponse(ctx context.Context, id openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostApiOrganizationsIdMembersResponse, error) {
+	rsp, err := c.PostApiOrganizationsIdMembersWithBody(ctx, id, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostApiOrganizationsIdMembersResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostApiOrganizationsIdMembersWithResponse(ctx context.Context, id openapi_types.UUID, body PostApiOrganizationsIdMembersJSONRequestBody, reqEditors ...RequestEditorFn) (*PostApiOrganizationsIdMembersResponse, error) {
+	rsp, err := c.PostApiOrganizationsIdMembers(ctx, id, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostApiOrganizationsIdMembersResponse(rsp)
+}
+
+// DeleteApiOrganizationsIdMembersUserIdWithResponse request returning *DeleteApiOrganizationsIdMembersUserIdResponse
+func (c *ClientWithResponses) DeleteApiOrganizationsIdMembersUserIdWithResponse(ctx context.Context, id openapi_types.UUID, userId openapi_types.UUID, reqEditors ...RequestEditorFn) (*DeleteApiOrganizationsIdMembersUserIdResponse, error) {
+	rsp, err := c.DeleteApiOrganizationsIdMembersUserId(ctx, id, userId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteApiOrganizationsIdMembersUserIdResponse(rsp)
+}
+
+// DeleteApiOrganizationsIdNotificationThemeWithResponse request returning *DeleteApiOrganizationsIdNotificationThemeResponse
+func (c *ClientWithResponses) DeleteApiOrganizationsIdNotificationThemeWithResponse(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*DeleteApiOrganizationsIdNotificationThemeResponse, error) {
+	rsp, err := c.DeleteApiOrganizationsIdNotificationTheme(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteApiOrganizationsIdNotificationThemeResponse(rsp)
+}
+
+// GetApiOrganizationsIdNotificationThemeWithResponse request returning *GetApiOrganizationsIdNotificationThemeResponse
+func (c *ClientWithResponses) GetApiOrganizationsIdNotificationThemeWithResponse(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*GetApiOrganizationsIdNotificationThemeResponse, error) {
+	rsp, err := c.GetApiOrganizationsIdNotificationTheme(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetApiOrganizationsIdNotificationThemeResponse(rsp)
+}
+
+// PutApiOrganizationsIdNotificationThemeWithBodyWithResponse request with arbitrary body returning *PutApiOrganizationsIdNotificationThemeResponse
+func (c *ClientWithResponses) PutApiOrganizationsIdNotificationThemeWithBodyWithResponse(ctx context.Context, id openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PutApiOrganizationsIdNotificationThemeResponse, error) {
+	rsp, err := c.PutApiOrganizationsIdNotificationThemeWithBody(ctx, id, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePutApiOrganizationsIdNotificationThemeResponse(rsp)
+}
+
+func (c *ClientWithResponses) PutApiOrganizationsIdNotificationThemeWithResponse(ctx context.Context, id openapi_types.UUID, body PutApiOrganizationsIdNotificationThemeJSONRequestBody, reqEditors ...RequestEditorFn) (*PutApiOrganizationsIdNotificationThemeResponse, error) {
+	rsp, err := c.PutApiOrganizationsIdNotificationTheme(ctx, id, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePutApiOrganizationsIdNotificationThemeResponse(rsp)
+}
+
+// PutApiOrganizationsIdRenewalApprovalWithBodyWithResponse request with arbitrary body returning *PutApiOrganizationsIdRenewalApprovalResponse
+func (c *ClientWithResponses) PutApiOrganizationsIdRenewalApprovalWithBodyWithResponse(ctx context.Context, id openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PutApiOrganizationsIdRenewalApprovalResponse, error) {
+	rsp, err := c.PutApiOrganizationsIdRenewalApprovalWithBody(ctx, id, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePutApiOrganizationsIdRenewalApprovalResponse(rsp)
+}
+
+func (c *ClientWithResponses) PutApiOrganizationsIdRenewalApprovalWithResponse(ctx context.Context, id openapi_types.UUID, body PutApiOrganizationsIdRenewalApprovalJSONRequestBody, reqEditors ...RequestEditorFn) (*PutApiOrganizationsIdRenewalApprovalResponse, error) {
+	rsp, err := c.PutApiOrganizationsIdRenewalApproval(ctx, id, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePutApiOrganizationsIdRenewalApprovalResponse(rsp)
+}
+
+// GetApiOrganizationsIdRetentionWithResponse request returning *GetApiOrganizationsIdRetentionResponse
+func (c *ClientWithResponses) GetApiOrganizationsIdRetentionWithResponse(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*GetApiOrganizationsIdRetentionResponse, error) {
+	rsp, err := c.GetApiOrganizationsIdRetention(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetApiOrganizationsIdRetentionResponse(rsp)
+}
+
+// PutApiOrganizationsIdRetentionWithBodyWithResponse request with arbitrary body returning *PutApiOrganizationsIdRetentionResponse
+func (c *ClientWithResponses) PutApiOrganizationsIdRetentionWithBodyWithResponse(ctx context.Context, id openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PutApiOrganizationsIdRetentionResponse, error) {
+	rsp, err := c.PutApiOrganizationsIdRetentionWithBody(ctx, id, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePutApiOrganizationsIdRetentionResponse(rsp)
+}
+
+func (c *ClientWithResponses) PutApiOrganizationsIdRetentionWithResponse(ctx context.Context, id openapi_types.UUID, body PutApiOrganizationsIdRetentionJSONRequestBody, reqEditors ...RequestEditorFn) (*PutApiOrganizationsIdRetentionResponse, error) {
+	rsp, err := c.PutApiOrganizationsIdRetention(ctx, id, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePutApiOrganizationsIdRetentionResponse(rsp)
+}
+
+// DeleteApiOrganizationsIdScimTokenWithResponse request returning *DeleteApiOrganizationsIdScimTokenResponse
+func (c *ClientWithResponses) DeleteApiOrganizationsIdScimTokenWithResponse(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*DeleteApiOrganizationsIdScimTokenResponse, error) {
+	rsp, err := c.DeleteApiOrganizationsIdScimToken(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteApiOrganizationsIdScimTokenResponse(rsp)
+}
+
+// PostApiOrganizationsIdScimTokenWithResponse request returning *PostApiOrganizationsIdScimTokenResponse
+func (c *ClientWithResponses) PostApiOrganizationsIdScimTokenWithResponse(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*PostApiOrganizationsIdScimTokenResponse, error) {
+	rsp, err := c.PostApiOrganizationsIdScimToken(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostApiOrganizationsIdScimTokenResponse(rsp)
+}
+
+// GetApiOrganizationsIdServiceAccountsWithResponse request returning *GetApiOrganizationsIdServiceAccountsResponse
+func (c *ClientWithResponses) GetApiOrganizationsIdServiceAccountsWithResponse(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*GetApiOrganizationsIdServiceAccountsResponse, error) {
+	rsp, err := c.GetApiOrganizationsIdServiceAccounts(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetApiOrganizationsIdServiceAccountsResponse(rsp)
+}
+
+// PostApiOrganizationsIdServiceAccountsWithBodyWithResponse request with arbitrary body returning *PostApiOrganizationsIdServiceAccountsResponse
+func (c *ClientWithResponses) PostApiOrganizationsIdServiceAccountsWithBodyWithResponse(ctx context.Context, id openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostApiOrganizationsIdServiceAccountsResponse, error) {
+	rsp, err := c.PostApiOrganizationsIdServiceAccountsWithBody(ctx, id, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostApiOrganizationsIdServiceAccountsResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostApiOrganizationsIdServiceAccountsWithResponse(ctx context.Context, id openapi_types.UUID, body PostApiOrganizationsIdServiceAccountsJSONRequestBody, reqEditors ...RequestEditorFn) (*PostApiOrganizationsIdServiceAccountsResponse, error) {
+	rsp, err := c.PostApiOrganizationsIdServiceAccounts(ctx, id, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostApiOrganizationsIdServiceAccountsResponse(rsp)
+}
+
+// DeleteApiOrganizationsIdServiceAccountsAccountIdTokenWithResponse request returning *DeleteApiOrganizationsIdServiceAccountsAccountIdTokenResponse
+func (c *ClientWithResponses) DeleteApiOrganizationsIdServiceAccountsAccountIdTokenWithResponse(ctx context.Context, id openapi_types.UUID, accountId openapi_types.UUID, reqEditors ...RequestEditorFn) (*DeleteApiOrganizationsIdServiceAccountsAccountIdTokenResponse, error) {
+	rsp, err := c.DeleteApiOrganizationsIdServiceAccountsAccountIdToken(ctx, id, accountId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteApiOrganizationsIdServiceAccountsAccountIdTokenResponse(rsp)
+}
+
+// PostApiOrganizationsIdServiceAccountsAccountIdTokenWithResponse request returning *PostApiOrganizationsIdServiceAccountsAccountIdTokenResponse
+func (c *ClientWithResponses) PostApiOrganizationsIdServiceAccountsAccountIdTokenWithResponse(ctx context.Context, id openapi_types.UUID, accountId openapi_types.UUID, reqEditors ...RequestEditorFn) (*PostApiOrganizationsIdServiceAccountsAccountIdTokenResponse, error) {
+	rsp, err := c.PostApiOrganizationsIdServiceAccountsAccountIdToken(ctx, id, accountId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostApiOrganizationsIdServiceAccountsAccountIdTokenResponse(rsp)
+}
+
+// GetApiOrganizationsIdUsageExportWithResponse request returning *GetApiOrganizationsIdUsageExportResponse
+func (c *ClientWithResponses) GetApiOrganizationsIdUsageExportWithResponse(ctx context.Context, id openapi_types.UUID, params *GetApiOrganizationsIdUsageExportParams, reqEditors ...RequestEditorFn) (*GetApiOrganizationsIdUsageExportResponse, error) {
+	rsp, err := c.GetApiOrganizationsIdUsageExport(ctx, id, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetApiOrganizationsIdUsageExportResponse(rsp)
+}
+
+// GetApiPreferencesLocaleWithResponse request returning *GetApiPreferencesLocaleResponse
+func (c *ClientWithResponses) GetApiPreferencesLocaleWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetApiPreferencesLocaleResponse, error) {
+	rsp, err := c.GetApiPreferencesLocale(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetApiPreferencesLocaleResponse(rsp)
+}
+
+// PutApiPreferencesLocaleWithBodyWithResponse request with arbitrary body returning *PutApiPreferencesLocaleResponse
+func (c *ClientWithResponses) PutApiPreferencesLocaleWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PutApiPreferencesLocaleResponse, error) {
+	rsp, err := c.PutApiPreferencesLocaleWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePutApiPreferencesLocaleResponse(rsp)
+}
+
+func (c *ClientWithResponses) PutApiPreferencesLocaleWithResponse(ctx context.Context, body PutApiPreferencesLocaleJSONRequestBody, reqEditors ...RequestEditorFn) (*PutApiPreferencesLocaleResponse, error) {
+	rsp, err := c.PutApiPreferencesLocale(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePutApiPreferencesLocaleResponse(rsp)
+}
+
+// GetApiPreferencesNotificationsWithResponse request returning *GetApiPreferencesNotificationsResponse
+func (c *ClientWithResponses) GetApiPreferencesNotificationsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetApiPreferencesNotificationsResponse, error) {
+	rsp, err := c.GetApiPreferencesNotifications(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetApiPreferencesNotificationsResponse(rsp)
+}
+
+// PutApiPreferencesNotificationsWithBodyWithResponse request with arbitrary body returning *PutApiPreferencesNotificationsResponse
+func (c *ClientWithResponses) PutApiPreferencesNotificationsWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PutApiPreferencesNotificationsResponse, error) {
+	rsp, err := c.PutApiPreferencesNotificationsWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePutApiPreferencesNotificationsResponse(rsp)
+}
+
+func (c *ClientWithResponses) PutApiPreferencesNotificationsWithResponse(ctx context.Context, body PutApiPreferencesNotificationsJSONRequestBody, reqEditors ...RequestEditorFn) (*PutApiPreferencesNotificationsResponse, error) {
+	rsp, err := c.PutApiPreferencesNotifications(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePutApiPreferencesNotificationsResponse(rsp)
+}
+
+// GetApiRoadmapWithResponse request returning *GetApiRoadmapResponse
+func (c *ClientWithResponses) GetApiRoadmapWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetApiRoadmapResponse, error) {
+	rsp, err := c.GetApiRoadmap(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetApiRoadmapResponse(rsp)
+}
+
+// DeleteApiRoadmapIdVoteWithResponse request returning *DeleteApiRoadmapIdVoteResponse
+func (c *ClientWithResponses) DeleteApiRoadmapIdVoteWithResponse(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*DeleteApiRoadmapIdVoteResponse, error) {
+	rsp, err := c.DeleteApiRoadmapIdVote(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteApiRoadmapIdVoteResponse(rsp)
+}
+
+// PostApiRoadmapIdVoteWithResponse request returning *PostApiRoadmapIdVoteResponse
+func (c *ClientWithResponses) PostApiRoadmapIdVoteWithResponse(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*PostApiRoadmapIdVoteResponse, error) {
+	rsp, err := c.PostApiRoadmapIdVote(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostApiRoadmapIdVoteResponse(rsp)
+}
+
+// GetApiTemplatesWithResponse request returning *GetApiTemplatesResponse
+func (c *ClientWithResponses) GetApiTemplatesWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetApiTemplatesResponse, error) {
+	rsp, err := c.GetApiTemplates(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetApiTemplatesResponse(rsp)
+}
+
+// GetApiTrackOpenMessageIdWithResponse request returning *GetApiTrackOpenMessageIdResponse
+func (c *ClientWithResponses) GetApiTrackOpenMessageIdWithResponse(ctx context.Context, messageId openapi_types.UUID, reqEditors ...RequestEditorFn) (*GetApiTrackOpenMessageIdResponse, error) {
+	rsp, err := c.GetApiTrackOpenMessageId(ctx, messageId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetApiTrackOpenMessageIdResponse(rsp)
+}
+
+// GetApiUnsubscribeTokenWithResponse request returning *GetApiUnsubscribeTokenResponse
+func (c *ClientWithResponses) GetApiUnsubscribeTokenWithResponse(ctx context.Context, token string, reqEditors ...RequestEditorFn) (*GetApiUnsubscribeTokenResponse, error) {
+	rsp, err := c.GetApiUnsubscribeToken(ctx, token, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetApiUnsubscribeTokenResponse(rsp)
+}
+
+// PostApiUnsubscribeTokenWithResponse request returning *PostApiUnsubscribeTokenResponse
+func (c *ClientWithResponses) PostApiUnsubscribeTokenWithResponse(ctx context.Context, token string, reqEditors ...RequestEditorFn) (*PostApiUnsubscribeTokenResponse, error) {
+	rsp, err := c.PostApiUnsubscribeToken(ctx, token, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostApiUnsubscribeTokenResponse(rsp)
+}
+
+// GetApiUsageWithResponse request returning *GetApiUsageResponse
+func (c *ClientWithResponses) GetApiUsageWithResponse(ctx context.Context, params *GetApiUsageParams, reqEditors ...RequestEditorFn) (*GetApiUsageResponse, error) {
+	rsp, err := c.GetApiUsage(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetApiUsageResponse(rsp)
+}
+
+// GetApiViewsWithResponse request returning *GetApiViewsResponse
+func (c *ClientWithResponses) GetApiViewsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetApiViewsResponse, error) {
+	rsp, err := c.GetApiViews(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetApiViewsResponse(rsp)
+}
+
+// PostApiViewsWithBodyWithResponse request with arbitrary body returning *PostApiViewsResponse
+func (c *ClientWithResponses) PostApiViewsWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostApiViewsResponse, error) {
+	rsp, err := c.PostApiViewsWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostApiViewsResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostApiViewsWithResponse(ctx context.Context, body PostApiViewsJSONRequestBody, reqEditors ...RequestEditorFn) (*PostApiViewsResponse, error) {
+	rsp, err := c.PostApiViews(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostApiViewsResponse(rsp)
+}
+
+// DeleteApiViewsIdWithResponse request returning *DeleteApiViewsIdResponse
+func (c *ClientWithResponses) DeleteApiViewsIdWithResponse(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*DeleteApiViewsIdResponse, error) {
+	rsp, err := c.DeleteApiViewsId(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteApiViewsIdResponse(rsp)
+}
+
+// GetApiViewsIdWithResponse request returning *GetApiViewsIdResponse
+func (c *ClientWithResponses) GetApiViewsIdWithResponse(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*GetApiViewsIdResponse, error) {
+	rsp, err := c.GetApiViewsId(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetApiViewsIdResponse(rsp)
+}
+
+// PutApiViewsIdWithBodyWithResponse request with arbitrary body returning *PutApiViewsIdResponse
+func (c *ClientWithResponses) PutApiViewsIdWithBodyWithResponse(ctx context.Context, id openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PutApiViewsIdResponse, error) {
+	rsp, err := c.PutApiViewsIdWithBody(ctx, id, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePutApiViewsIdResponse(rsp)
+}
+
+func (c *ClientWithResponses) PutApiViewsIdWithResponse(ctx context.Context, id openapi_types.UUID, body PutApiViewsIdJSONRequestBody, reqEditors ...RequestEditorFn) (*PutApiViewsIdResponse, error) {
+	rsp, err := c.PutApiViewsId(ctx, id, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePutApiViewsIdResponse(rsp)
+}
+
+// GetApiViewsIdDocumentsWithResponse request returning *GetApiViewsIdDocumentsResponse
+func (c *ClientWithResponses) GetApiViewsIdDocumentsWithResponse(ctx context.Context, id openapi_types.UUID, params *GetApiViewsIdDocumentsParams, reqEditors ...RequestEditorFn) (*GetApiViewsIdDocumentsResponse, error) {
+	rsp, err := c.GetApiViewsIdDocuments(ctx, id, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetApiViewsIdDocumentsResponse(rsp)
+}
+
+// PostApiWebhooksEmailBounceWithBodyWithResponse request with arbitrary body returning *PostApiWebhooksEmailBounceResponse
+func (c *ClientWithResponses) PostApiWebhooksEmailBounceWithBodyWithResponse(ctx context.Context, params *PostApiWebhooksEmailBounceParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostApiWebhooksEmailBounceResponse, error) {
+	rsp, err := c.PostApiWebhooksEmailBounceWithBody(ctx, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostApiWebhooksEmailBounceResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostApiWebhooksEmailBounceWithResponse(ctx context.Context, params *PostApiWebhooksEmailBounceParams, body PostApiWebhooksEmailBounceJSONRequestBody, reqEditors ...RequestEditorFn) (*PostApiWebhooksEmailBounceResponse, error) {
+	rsp, err := c.PostApiWebhooksEmailBounce(ctx, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostApiWebhooksEmailBounceResponse(rsp)
+}
+
+// GetApiWebhooksEndpointsWithResponse request returning *GetApiWebhooksEndpointsResponse
+func (c *ClientWithResponses) GetApiWebhooksEndpointsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetApiWebhooksEndpointsResponse, error) {
+	rsp, err := c.GetApiWebhooksEndpoints(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetApiWebhooksEndpointsResponse(rsp)
+}
+
+// PostApiWebhooksEndpointsWithBodyWithResponse request with arbitrary body returning *PostApiWebhooksEndpointsResponse
+func (c *ClientWithResponses) PostApiWebhooksEndpointsWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostApiWebhooksEndpointsResponse, error) {
+	rsp, err := c.PostApiWebhooksEndpointsWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostApiWebhooksEndpointsResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostApiWebhooksEndpointsWithResponse(ctx context.Context, body PostApiWebhooksEndpointsJSONRequestBody, reqEditors ...RequestEditorFn) (*PostApiWebhooksEndpointsResponse, error) {
+	rsp, err := c.PostApiWebhooksEndpoints(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostApiWebhooksEndpointsResponse(rsp)
+}
+
+// DeleteApiWebhooksEndpointsIdWithResponse request returning *DeleteApiWebhooksEndpointsIdResponse
+func (c *ClientWithResponses) DeleteApiWebhooksEndpointsIdWithResponse(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*DeleteApiWebhooksEndpointsIdResponse, error) {
+	rsp, err := c.DeleteApiWebhooksEndpointsId(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteApiWebhooksEndpointsIdResponse(rsp)
+}
+
+// PutApiWebhooksEndpointsIdWithBodyWithResponse request with arbitrary body returning *PutApiWebhooksEndpointsIdResponse
+func (c *ClientWithResponses) PutApiWebhooksEndpointsIdWithBodyWithResponse(ctx context.Context, id openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PutApiWebhooksEndpointsIdResponse, error) {
+	rsp, err := c.PutApiWebhooksEndpointsIdWithBody(ctx, id, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePutApiWebhooksEndpointsIdResponse(rsp)
+}
+
+func (c *ClientWithResponses) PutApiWebhooksEndpointsIdWithResponse(ctx context.Context, id openapi_types.UUID, body PutApiWebhooksEndpointsIdJSONRequestBody, reqEditors ...RequestEditorFn) (*PutApiWebhooksEndpointsIdResponse, error) {
+	rsp, err := c.PutApiWebhooksEndpointsId(ctx, id, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePutApiWebhooksEndpointsIdResponse(rsp)
+}
+
+// GetApiWebhooksEndpointsIdDeliveriesWithResponse request returning *GetApiWebhooksEndpointsIdDeliveriesResponse
+func (c *ClientWithResponses) GetApiWebhooksEndpointsIdDeliveriesWithResponse(ctx context.Context, id openapi_types.UUID, params *GetApiWebhooksEndpointsIdDeliveriesParams, reqEditors ...RequestEditorFn) (*GetApiWebhooksEndpointsIdDeliveriesResponse, error) {
+	rsp, err := c.GetApiWebhooksEndpointsIdDeliveries(ctx, id, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetApiWebhooksEndpointsIdDeliveriesResponse(rsp)
+}
+
+// PostApiWebhooksEndpointsIdDeliveriesDeliveryIdRedeliverWithResponse request returning *PostApiWebhooksEndpointsIdDeliveriesDeliveryIdRedeliverResponse
+func (c *ClientWithResponses) PostApiWebhooksEndpointsIdDeliveriesDeliveryIdRedeliverWithResponse(ctx context.Context, id openapi_types.UUID, deliveryId openapi_types.UUID, reqEditors ...RequestEditorFn) (*PostApiWebhooksEndpointsIdDeliveriesDeliveryIdRedeliverResponse, error) {
+	rsp, err := c.PostApiWebhooksEndpointsIdDeliveriesDeliveryIdRedeliver(ctx, id, deliveryId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostApiWebhooksEndpointsIdDeliveriesDeliveryIdRedeliverResponse(rsp)
+}
+
+// PostApiWebhooksEndpointsIdRotateSecretWithBodyWithResponse request with arbitrary body returning *PostApiWebhooksEndpointsIdRotateSecretResponse
+func (c *ClientWithResponses) PostApiWebhooksEndpointsIdRotateSecretWithBodyWithResponse(ctx context.Context, id openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostApiWebhooksEndpointsIdRotateSecretResponse, error) {
+	rsp, err := c.PostApiWebhooksEndpointsIdRotateSecretWithBody(ctx, id, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostApiWebhooksEndpointsIdRotateSecretResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostApiWebhooksEndpointsIdRotateSecretWithResponse(ctx context.Context, id openapi_types.UUID, body PostApiWebhooksEndpointsIdRotateSecretJSONRequestBody, reqEditors ...RequestEditorFn) (*PostApiWebhooksEndpointsIdRotateSecretResponse, error) {
+	rsp, err := c.PostApiWebhooksEndpointsIdRotateSecret(ctx, id, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostApiWebhooksEndpointsIdRotateSecretResponse(rsp)
+}
+
+// PostApiWebhooksTwilioSmsWithBodyWithResponse request with arbitrary body returning *PostApiWebhooksTwilioSmsResponse
+func (c *ClientWithResponses) PostApiWebhooksTwilioSmsWithBodyWithResponse(ctx context.Context, params *PostApiWebhooksTwilioSmsParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostApiWebhooksTwilioSmsResponse, error) {
+	rsp, err := c.PostApiWebhooksTwilioSmsWithBody(ctx, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostApiWebhooksTwilioSmsResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostApiWebhooksTwilioSmsWithFormdataBodyWithResponse(ctx context.Context, params *PostApiWebhooksTwilioSmsParams, body PostApiWebhooksTwilioSmsFormdataRequestBody, reqEditors ...RequestEditorFn) (*PostApiWebhooksTwilioSmsResponse, error) {
+	rsp, err := c.PostApiWebhooksTwilioSmsWithFormdataBody(ctx, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostApiWebhooksTwilioSmsResponse(rsp)
+}
+
+// GetHealthWithResponse request returning *GetHealthResponse
+func (c *ClientWithResponses) GetHealthWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetHealthResponse, error) {
+	rsp, err := c.GetHealth(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetHealthResponse(rsp)
+}
+
+// GetReadyWithResponse request returning *GetReadyResponse
+func (c *ClientWithResponses) GetReadyWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetReadyResponse, error) {
+	rsp, err := c.GetReady(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetReadyResponse(rsp)
+}
+
+// GetReminderIntervalsWithResponse request returning *GetReminderIntervalsResponse
+func (c *ClientWithResponses) GetReminderIntervalsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetReminderIntervalsResponse, error) {
+	rsp, err := c.GetReminderIntervals(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetReminderIntervalsResponse(rsp)
+}
+
+// GetScimV2ServiceProviderConfigWithResponse request returning *GetScimV2ServiceProviderConfigResponse
+func (c *ClientWithResponses) GetScimV2ServiceProviderConfigWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetScimV2ServiceProviderConfigResponse, error) {
+	rsp, err := c.GetScimV2ServiceProviderConfig(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetScimV2ServiceProviderConfigResponse(rsp)
+}
+
+// GetScimV2UsersWithResponse request returning *GetScimV2UsersResponse
+func (c *ClientWithResponses) GetScimV2UsersWithResponse(ctx context.Context, params *GetScimV2UsersParams, reqEditors ...RequestEditorFn) (*GetScimV2UsersResponse, error) {
+	rsp, err := c.GetScimV2Users(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetScimV2UsersResponse(rsp)
+}
+
+// PostScimV2UsersWithBodyWithResponse request with arbitrary body returning *PostScimV2UsersResponse
+func (c *ClientWithResponses) PostScimV2UsersWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostScimV2UsersResponse, error) {
+	rsp, err := c.PostScimV2UsersWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostScimV2UsersResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostScimV2UsersWithApplicationScimPlusJSONBodyWithResponse(ctx context.Context, body PostScimV2UsersApplicationScimPlusJSONRequestBody, reqEditors ...RequestEditorFn) (*PostScimV2UsersResponse, error) {
+	rsp, err := c.PostScimV2UsersWithApplicationScimPlusJSONBody(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostScimV2UsersResponse(rsp)
+}
+
+// DeleteScimV2UsersUserIdWithResponse request returning *DeleteScimV2UsersUserIdResponse
+func (c *ClientWithResponses) DeleteScimV2UsersUserIdWithResponse(ctx context.Context, userId openapi_types.UUID, reqEditors ...RequestEditorFn) (*DeleteScimV2UsersUserIdResponse, error) {
+	rsp, err := c.DeleteScimV2UsersUserId(ctx, userId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteScimV2UsersUserIdResponse(rsp)
+}
+
+// GetScimV2UsersUserIdWithResponse request returning *GetScimV2UsersUserIdResponse
+func (c *ClientWithResponses) GetScimV2UsersUserIdWithResponse(ctx context.Context, userId openapi_types.UUID, reqEditors ...RequestEditorFn) (*GetScimV2UsersUserIdResponse, error) {
+	rsp, err := c.GetScimV2UsersUserId(ctx, userId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetScimV2UsersUserIdResponse(rsp)
+}
+
+// PatchScimV2UsersUserIdWithBodyWithResponse request with arbitrary body returning *PatchScimV2UsersUserIdResponse
+func (c *ClientWithResponses) PatchScimV2UsersUserIdWithBodyWithResponse(ctx context.Context, userId openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PatchScimV2UsersUserIdResponse, error) {
+	rsp, err := c.PatchScimV2UsersUserIdWithBody(ctx, userId, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePatchScimV2UsersUserIdResponse(rsp)
+}
+
+func (c *ClientWithResponses) PatchScimV2UsersUserIdWithApplicationScimPlusJSONBodyWithResponse(ctx context.Context, userId openapi_types.UUID, body PatchScimV2UsersUserIdApplicationScimPlusJSONRequestBody, reqEditors ...RequestEditorFn) (*PatchScimV2UsersUserIdResponse, error) {
+	rsp, err := c.PatchScimV2UsersUserIdWithApplicationScimPlusJSONBody(ctx, userId, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePatchScimV2UsersUserIdResponse(rsp)
+}
+
+// PutScimV2UsersUserIdWithBodyWithResponse request with arbitrary body returning *PutScimV2UsersUserIdResponse
+func (c *ClientWithResponses) PutScimV2UsersUserIdWithBodyWithResponse(ctx context.Context, userId openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PutScimV2UsersUserIdResponse, error) {
+	rsp, err := c.PutScimV2UsersUserIdWithBody(ctx, userId, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePutScimV2UsersUserIdResponse(rsp)
+}
+
+func (c *ClientWithResponses) PutScimV2UsersUserIdWithApplicationScimPlusJSONBodyWithResponse(ctx context.Context, userId openapi_types.UUID, body PutScimV2UsersUserIdApplicationScimPlusJSONRequestBody, reqEditors ...RequestEditorFn) (*PutScimV2UsersUserIdResponse, error) {
+	rsp, err := c.PutScimV2UsersUserIdWithApplicationScimPlusJSONBody(ctx, userId, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePutScimV2UsersUserIdResponse(rsp)
+}
+
+// ParseGetWellKnownJwksJsonResponse parses an HTTP response from a GetWellKnownJwksJsonWithResponse call
+func ParseGetWellKnownJwksJsonResponse(rsp *http.Response) (*GetWellKnownJwksJsonResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetWellKnownJwksJsonResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest JWKSet
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetApiAdminAnnouncementsResponse parses an HTTP response from a GetApiAdminAnnouncementsWithResponse call
+func ParseGetApiAdminAnnouncementsResponse(rsp *http.Response) (*GetApiAdminAnnouncementsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetApiAdminAnnouncementsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest struct {
+			Announcements *[]Announcement `json:"announcements,omitempty"`
+			Message       *string         `json:"message,omitempty"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostApiAdminAnnouncementsResponse parses an HTTP response from a PostApiAdminAnnouncementsWithResponse call
+func ParsePostApiAdminAnnouncementsResponse(rsp *http.Response) (*PostApiAdminAnnouncementsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostApiAdminAnnouncementsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest struct {
+			Announcement *Announcement `json:"announcement,omitempty"`
+			Message      *string       `json:"message,omitempty"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseDeleteApiAdminAnnouncementsIdResponse parses an HTTP response from a DeleteApiAdminAnnouncementsIdWithResponse call
+func ParseDeleteApiAdminAnnouncementsIdResponse(rsp *http.Response) (*DeleteApiAdminAnnouncementsIdResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DeleteApiAdminAnnouncementsIdResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	return response, nil
+}
+
+// ParseGetApiAdminDeprecationsResponse parses an HTTP response from a GetApiAdminDeprecationsWithResponse call
+func ParseGetApiAdminDeprecationsResponse(rsp *http.Response) (*GetApiAdminDeprecationsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetApiAdminDeprecationsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest struct {
+			Deprecations *[]DeprecatedEndpoint `json:"deprecations,omitempty"`
+			Message      *string               `json:"message,omitempty"`
+			WindowDays   *int                  `json:"windowDays,omitempty"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetApiAdminHealthResponse parses an HTTP response from a GetApiAdminHealthWithResponse call
+func ParseGetApiAdminHealthResponse(rsp *http.Response) (*GetApiAdminHealthResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetApiAdminHealthResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest struct {
+			Alerts    *[]FiringAlert              `json:"alerts,omitempty"`
+			Database  *string                     `json:"database,omitempty"`
+			Message   *string                     `json:"message,omitempty"`
+			Providers *[]NotificationProvider     `json:"providers,omitempty"`
+			Queues    *[]QueueStats               `json:"queues,omitempty"`
+			Redis     *string                     `json:"redis,omitempty"`
+			Status    *GetApiAdminHealth200Status `json:"status,omitempty"`
+			Timestamp *time.Time                  `json:"timestamp,omitempty"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostApiAdminImpersonateResponse parses an HTTP response from a PostApiAdminImpersonateWithResponse call
+func ParsePostApiAdminImpersonateResponse(rsp *http.Response) (*PostApiAdminImpersonateResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostApiAdminImpersonateResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest struct {
+			ExpiresAt *time.Time `json:"expiresAt,omitempty"`
+			Message   *string    `json:"message,omitempty"`
+			Token     *string    `json:"token,omitempty"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostApiAdminIssuersResponse parses an HTTP response from a PostApiAdminIssuersWithResponse call
+func ParsePostApiAdminIssuersResponse(rsp *http.Response) (*PostApiAdminIssuersResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostApiAdminIssuersResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest struct {
+			Issuer  *Issuer `json:"issuer,omitempty"`
+			Message *string `json:"message,omitempty"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseDeleteApiAdminIssuersIdResponse parses an HTTP response from a DeleteApiAdminIssuersIdWithResponse call
+func ParseDeleteApiAdminIssuersIdResponse(rsp *http.Response) (*DeleteApiAdminIssuersIdResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DeleteApiAdminIssuersIdResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	return response, nil
+}
+
+// ParsePutApiAdminIssuersIdResponse parses an HTTP response from a PutApiAdminIssuersIdWithResponse call
+func ParsePutApiAdminIssuersIdResponse(rsp *http.Response) (*PutApiAdminIssuersIdResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PutApiAdminIssuersIdResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest struct {
+			Issuer  *Issuer `json:"issuer,omitempty"`
+			Message *string `json:"message,omitempty"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetApiAdminLoadSheddingResponse parses an HTTP response from a GetApiAdminLoadSheddingWithResponse call
+func ParseGetApiAdminLoadSheddingResponse(rsp *http.Response) (*GetApiAdminLoadSheddingResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetApiAdminLoadSheddingResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest struct {
+			Message    *string         `json:"message,omitempty"`
+			Reasons    *[]ShedRequests `json:"reasons,omitempty"`
+			WindowDays *int            `json:"windowDays,omitempty"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetApiAdminNotificationProvidersResponse parses an HTTP response from a GetApiAdminNotificationProvidersWithResponse call
+func ParseGetApiAdminNotificationProvidersResponse(rsp *http.Response) (*GetApiAdminNotificationProvidersResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetApiAdminNotificationProvidersResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest struct {
+			Message   *string                 `json:"message,omitempty"`
+			Providers *[]NotificationProvider `json:"providers,omitempty"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostApiAdminRoadmapResponse parses an HTTP response from a PostApiAdminRoadmapWithResponse call
+func ParsePostApiAdminRoadmapResponse(rsp *http.Response) (*PostApiAdminRoadmapResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostApiAdminRoadmapResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest struct {
+			Feature *RoadmapFeature `json:"feature,omitempty"`
+			Message *string         `json:"message,omitempty"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseDeleteApiAdminRoadmapIdResponse parses an HTTP response from a DeleteApiAdminRoadmapIdWithResponse call
+func ParseDeleteApiAdminRoadmapIdResponse(rsp *http.Response) (*DeleteApiAdminRoadmapIdResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DeleteApiAdminRoadmapIdResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	return response, nil
+}
+
+// ParsePutApiAdminRoadmapIdResponse parses an HTTP response from a PutApiAdminRoadmapIdWithResponse call
+func ParsePutApiAdminRoadmapIdResponse(rsp *http.Response) (*PutApiAdminRoadmapIdResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PutApiAdminRoadmapIdResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest struct {
+			Feature *RoadmapFeature `json:"feature,omitempty"`
+			Message *string         `json:"message,omitempty"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostApiAdminScheduledJobsNameRunResponse parses an HTTP response from a PostApiAdminScheduledJobsNameRunWithResponse call
+func ParsePostApiAdminScheduledJobsNameRunResponse(rsp *http.Response) (*PostApiAdminScheduledJobsNameRunResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostApiAdminScheduledJobsNameRunResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	return response, nil
+}
+
+// ParseGetApiAdminTasksFailedResponse parses an HTTP response from a GetApiAdminTasksFailedWithResponse call
+func ParseGetApiAdminTasksFailedResponse(rsp *http.Response) (*GetApiAdminTasksFailedResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetApiAdminTasksFailedResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest struct {
+			Message *string       `json:"message,omitempty"`
+			Tasks   *[]FailedTask `json:"tasks,omitempty"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostApiAdminTasksQueueTaskIdRequeueResponse parses an HTTP response from a PostApiAdminTasksQueueTaskIdRequeueWithResponse call
+func ParsePostApiAdminTasksQueueTaskIdRequeueResponse(rsp *http.Response) (*PostApiAdminTasksQueueTaskIdRequeueResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostApiAdminTasksQueueTaskIdRequeueResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	return response, nil
+}
+
+// ParseGetApiAdminUsageExportResponse parses an HTTP response from a GetApiAdminUsageExportWithResponse call
+func ParseGetApiAdminUsageExportResponse(rsp *http.Response) (*GetApiAdminUsageExportResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetApiAdminUsageExportResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest UsageExport
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case rsp.StatusCode == 200:
+		// Content-type (text/csv) unsupported
+
+	}
+
+	return response, nil
+}
+
+// ParsePutApiAdminUsersIdPlanResponse parses an HTTP response from a PutApiAdminUsersIdPlanWithResponse call
+func ParsePutApiAdminUsersIdPlanResponse(rsp *http.Response) (*PutApiAdminUsersIdPlanResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PutApiAdminUsersIdPlanResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest struct {
+			Message *string `json:"message,omitempty"`
+			Plan    *string `json:"plan,omitempty"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostApiAdminUsersIdReinstateResponse parses an HTTP response from a PostApiAdminUsersIdReinstateWithResponse call
+func ParsePostApiAdminUsersIdReinstateResponse(rsp *http.Response) (*PostApiAdminUsersIdReinstateResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostApiAdminUsersIdReinstateResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	return response, nil
+}
+
+// ParsePutApiAdminUsersIdRoleResponse parses an HTTP response from a PutApiAdminUsersIdRoleWithResponse call
+func ParsePutApiAdminUsersIdRoleResponse(rsp *http.Response) (*PutApiAdminUsersIdRoleResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PutApiAdminUsersIdRoleResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest struct {
+			Message *string `json:"message,omitempty"`
+			Role    *string `json:"role,omitempty"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostApiAdminUsersIdSuspendResponse parses an HTTP response from a PostApiAdminUsersIdSuspendWithResponse call
+func ParsePostApiAdminUsersIdSuspendResponse(rsp *http.Response) (*PostApiAdminUsersIdSuspendResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostApiAdminUsersIdSuspendResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	return response, nil
+}
+
+// ParseGetApiAnnouncementsResponse parses an HTTP response from a GetApiAnnouncementsWithResponse call
+func ParseGetApiAnnouncementsResponse(rsp *http.Response) (*GetApiAnnouncementsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetApiAnnouncementsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest struct {
+			Announcements *[]Announcement `json:"announcements,omitempty"`
+			Message       *string         `json:"message,omitempty"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetApiApiKeysResponse parses an HTTP response from a GetApiApiKeysWithResponse call
+func ParseGetApiApiKeysResponse(rsp *http.Response) (*GetApiApiKeysResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetApiApiKeysResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest struct {
+			ApiKeys *[]APIKey `json:"apiKeys,omitempty"`
+			Message *string   `json:"message,omitempty"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostApiApiKeysResponse parses an HTTP response from a PostApiApiKeysWithResponse call
+func ParsePostApiApiKeysResponse(rsp *http.Response) (*PostApiApiKeysResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostApiApiKeysResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest struct {
+			ApiKey *APIKey `json:"apiKey,omitempty"`
+
+			// Key The key, shown only this once.
+			Key     *string `json:"key,omitempty"`
+			Message *string `json:"message,omitempty"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseDeleteApiApiKeysIdResponse parses an HTTP response from a DeleteApiApiKeysIdWithResponse call
+func ParseDeleteApiApiKeysIdResponse(rsp *http.Response) (*DeleteApiApiKeysIdResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DeleteApiApiKeysIdResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	return response, nil
+}
+
+// ParsePutApiAuthEmailResponse parses an HTTP response from a PutApiAuthEmailWithResponse call
+func ParsePutApiAuthEmailResponse(rsp *http.Response) (*PutApiAuthEmailResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PutApiAuthEmailResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 202:
+		var dest struct {
+			Message      *string              `json:"message,omitempty"`
+			PendingEmail *openapi_types.Email `json:"pendingEmail,omitempty"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON202 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetApiAuthEmailConfirmTokenResponse parses an HTTP response from a GetApiAuthEmailConfirmTokenWithResponse call
+func ParseGetApiAuthEmailConfirmTokenResponse(rsp *http.Response) (*GetApiAuthEmailConfirmTokenResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetApiAuthEmailConfirmTokenResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest struct {
+			Email *openapi_types.Email `json:"email,omitempty"`
+
+			// Pending Whether this is still the change pending
+			Pending *bool `json:"pending,omitempty"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostApiAuthEmailConfirmTokenResponse parses an HTTP response from a PostApiAuthEmailConfirmTokenWithResponse call
+func ParsePostApiAuthEmailConfirmTokenResponse(rsp *http.Response) (*PostApiAuthEmailConfirmTokenResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostApiAuthEmailConfirmTokenResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest struct {
+			Email   *openapi_types.Email `json:"email,omitempty"`
+			Message *string              `json:"message,omitempty"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostApiAuthLogoutResponse parses an HTTP response from a PostApiAuthLogoutWithResponse call
+func ParsePostApiAuthLogoutResponse(rsp *http.Response) (*PostApiAuthLogoutResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostApiAuthLogoutResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest struct {
+			Message *string `json:"message,omitempty"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseDeleteApiAuthMeResponse parses an HTTP response from a DeleteApiAuthMeWithResponse call
+func ParseDeleteApiAuthMeResponse(rsp *http.Response) (*DeleteApiAuthMeResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DeleteApiAuthMeResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest struct {
+			Message *string `json:"message,omitempty"`
+
+			// PurgeAt When the account's documents are deleted for good
+			PurgeAt *time.Time `json:"purgeAt,omitempty"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetApiAuthMeResponse parses an HTTP response from a GetApiAuthMeWithResponse call
+func ParseGetApiAuthMeResponse(rsp *http.Response) (*GetApiAuthMeResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetApiAuthMeResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest struct {
+			// ImpersonatedBy The admin acting as the user, when the request uses an impersonation token
+			ImpersonatedBy *openapi_types.UUID `json:"impersonatedBy,omitempty"`
+			Message        *string             `json:"message,omitempty"`
+			User           *User               `json:"user,omitempty"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetApiAuthOauthProviderResponse parses an HTTP response from a GetApiAuthOauthProviderWithResponse call
+func ParseGetApiAuthOauthProviderResponse(rsp *http.Response) (*GetApiAuthOauthProviderResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetApiAuthOauthProviderResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	return response, nil
+}
+
+// ParseGetApiAuthOauthProviderCallbackResponse parses an HTTP response from a GetApiAuthOauthProviderCallbackWithResponse call
+func ParseGetApiAuthOauthProviderCallbackResponse(rsp *http.Response) (*GetApiAuthOauthProviderCallbackResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetApiAuthOauthProviderCallbackResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	return response, nil
+}
+
+// ParsePostApiAuthRegisterResponse parses an HTTP response from a PostApiAuthRegisterWithResponse call
+func ParsePostApiAuthRegisterResponse(rsp *http.Response) (*PostApiAuthRegisterResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostApiAuthRegisterResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest struct {
+			Message *string `json:"message,omitempty"`
+			User    *User   `json:"user,omitempty"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseDeleteApiAuthSessionsResponse parses an HTTP response from a DeleteApiAuthSessionsWithResponse call
+func ParseDeleteApiAuthSessionsResponse(rsp *http.Response) (*DeleteApiAuthSessionsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DeleteApiAuthSessionsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest struct {
+			Message *string `json:"message,omitempty"`
+
+			// Revoked Number of sessions revoked.
+			Revoked *int `json:"revoked,omitempty"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetApiAuthSessionsResponse parses an HTTP response from a GetApiAuthSessionsWithResponse call
+func ParseGetApiAuthSessionsResponse(rsp *http.Response) (*GetApiAuthSessionsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetApiAuthSessionsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest struct {
+			Message  *string    `json:"message,omitempty"`
+			Sessions *[]Session `json:"sessions,omitempty"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseDeleteApiAuthSessionsIdResponse parses an HTTP response from a DeleteApiAuthSessionsIdWithResponse call
+func ParseDeleteApiAuthSessionsIdResponse(rsp *http.Response) (*DeleteApiAuthSessionsIdResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DeleteApiAuthSessionsIdResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	return response, nil
+}
+
+// ParsePostApiAuthSigninResponse parses an HTTP response from a PostApiAuthSigninWithResponse call
+func ParsePostApiAuthSigninResponse(rsp *http.Response) (*PostApiAuthSigninResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostApiAuthSigninResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest struct {
+			Message *string `json:"message,omitempty"`
+			User    *User   `json:"user,omitempty"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetApiCategoriesResponse parses an HTTP response from a GetApiCategoriesWithResponse call
+func ParseGetApiCategoriesResponse(rsp *http.Response) (*GetApiCategoriesResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetApiCategoriesResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest struct {
+			Categories *[]DocumentCategory `json:"categories,omitempty"`
+			Message    *string             `json:"message,omitempty"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetApiCategoriesSlugSuggestExpirationResponse parses an HTTP response from a GetApiCategoriesSlugSuggestExpirationWithResponse call
+func ParseGetApiCategoriesSlugSuggestExpirationResponse(rsp *http.Response) (*GetApiCategoriesSlugSuggestExpirationResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetApiCategoriesSlugSuggestExpirationResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest struct {
+			Message    *string `json:"message,omitempty"`
+			Suggestion *struct {
+				Category                *string             `json:"category,omitempty"`
+				CountryCode             *string             `json:"countryCode"`
+				IssueDate               *openapi_types.Date `json:"issueDate,omitempty"`
+				Notes                   *string             `json:"notes"`
+				SuggestedExpirationDate *openapi_types.Date `json:"suggestedExpirationDate,omitempty"`
+				ValidityMonths          *int                `json:"validityMonths,omitempty"`
+			} `json:"suggestion,omitempty"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetApiDocumentsResponse parses an HTTP response from a GetApiDocumentsWithResponse call
+func ParseGetApiDocumentsResponse(rsp *http.Response) (*GetApiDocumentsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetApiDocumentsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest struct {
+			Documents *[]struct {
+				// AttachmentStatus Virus scan state of an uploaded attachment; absent for external links.
+				AttachmentStatus *GetApiDocuments200DocumentsAttachmentStatus `json:"attachmentStatus"`
+
+				// AttachmentThreat Malware found in a quarantined attachment.
+				AttachmentThreat *string            `json:"attachmentThreat"`
+				AttachmentUrl    *string            `json:"attachmentUrl"`
+				Category         *string            `json:"category"`
+				Checklist        *ChecklistProgress `json:"checklist,omitempty"`
+				CreatedAt        *time.Time         `json:"createdAt,omitempty"`
+
+				// Currency ISO 4217 code of renewalCost.
+				Currency     *string        `json:"currency"`
+				CustomFields *[]CustomField `json:"customFields,omitempty"`
+				Description  *string        `json:"description"`
+
+				// ExpirationDate Formatted date string (e.g., 'Mon, 2 Jan, 2006')
+				ExpirationDate *string `json:"expirationDate,omitempty"`
+
+				// ExpiresIn How far off the expiration date is, such as "expires in 3 weeks", in the locale of the locale query parameter, else Accept-Language, else the user's locale preference, and in the duration unit of the units query parameter or the user's preference.
+				ExpiresIn  *string             `json:"expiresIn,omitempty"`
+				Id         *openapi_types.UUID `json:"id,omitempty"`
+				Identifier *string             `json:"identifier"`
+
+				// Issuer The linked issuer; only included when fetching a single document.
+				Issuer *struct {
+					Id             *openapi_types.UUID `json:"id,omitempty"`
+					Name           *string             `json:"name,omitempty"`
+					ProcessingDays *int                `json:"processingDays,omitempty"`
+					RenewalUrl     *string             `json:"renewalUrl,omitempty"`
+				} `json:"issuer,omitempty"`
+				IssuerId *openapi_types.UUID `json:"issuerId"`
+
+				// LeadTimeDays Days renewing the document takes, overriding the issuer's processing time.
+				LeadTimeDays *int `json:"leadTimeDays,omitempty"`
+
+				// Lock Present while someone holds the document's edit lock.
+				Lock *DocumentLock `json:"lock,omitempty"`
+				Name *string       `json:"name,omitempty"`
+
+				// OrganizationId Organization the document belongs to; absent for personal documents.
+				OrganizationId *openapi_types.UUID `json:"organizationId"`
+
+				// Pinned Whether the caller pinned the document.
+				Pinned *bool `json:"pinned,omitempty"`
+
+				// Position Where the caller placed the document; absent when never placed.
+				Position    *int                `json:"position,omitempty"`
+				Reminders   *[]ReminderInterval `json:"reminders,omitempty"`
+				RenewalCost *float32            `json:"renewalCost"`
+				Timezone    *string             `json:"timezone,omitempty"`
+				UpdatedAt   *time.Time          `json:"updatedAt,omitempty"`
+				UserId      *openapi_types.UUID `json:"userId,omitempty"`
+			} `json:"documents,omitempty"`
+			Message *string `json:"message,omitempty"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostApiDocumentsResponse parses an HTTP response from a PostApiDocumentsWithResponse call
+func ParsePostApiDocumentsResponse(rsp *http.Response) (*PostApiDocumentsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostApiDocumentsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest struct {
+			Document *Document `json:"document,omitempty"`
+			Message  *string   `json:"message,omitempty"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest DuplicateDocuments
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetApiDocumentsDependencyGraphResponse parses an HTTP response from a GetApiDocumentsDependencyGraphWithResponse call
+func ParseGetApiDocumentsDependencyGraphResponse(rsp *http.Response) (*GetApiDocumentsDependencyGraphResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetApiDocumentsDependencyGraphResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest struct {
+			Edges *[]struct {
+				DependsOnId *openapi_types.UUID `json:"dependsOnId,omitempty"`
+				DocumentId  *openapi_types.UUID `json:"documentId,omitempty"`
+			} `json:"edges,omitempty"`
+			Message *string               `json:"message,omitempty"`
+			Nodes   *[]DependencyDocument `json:"nodes,omitempty"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostApiDocumentsImportResponse parses an HTTP response from a PostApiDocumentsImportWithResponse call
+func ParsePostApiDocumentsImportResponse(rsp *http.Response) (*PostApiDocumentsImportResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostApiDocumentsImportResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest struct {
+			Documents *[]Document `json:"documents,omitempty"`
+			Errors    *[]struct {
+				Error *string `json:"error,omitempty"`
+				Line  *int    `json:"line,omitempty"`
+			} `json:"errors,omitempty"`
+			Imported        *int      `json:"imported,omitempty"`
+			Message         *string   `json:"message,omitempty"`
+			Source          *string   `json:"source,omitempty"`
+			UnmappedColumns *[]string `json:"unmappedColumns,omitempty"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 202:
+		var dest JobQueued
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON202 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePutApiDocumentsOrderResponse parses an HTTP response from a PutApiDocumentsOrderWithResponse call
+func ParsePutApiDocumentsOrderResponse(rsp *http.Response) (*PutApiDocumentsOrderResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PutApiDocumentsOrderResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	return response, nil
+}
+
+// ParseGetApiDocumentsRenewalCostsResponse parses an HTTP response from a GetApiDocumentsRenewalCostsWithResponse call
+func ParseGetApiDocumentsRenewalCostsResponse(rsp *http.Response) (*GetApiDocumentsRenewalCostsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetApiDocumentsRenewalCostsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest struct {
+			From    *openapi_types.Date `json:"from,omitempty"`
+			Locale  *string             `json:"locale,omitempty"`
+			Message *string             `json:"message,omitempty"`
+			Months  *[]RenewalCost      `json:"months,omitempty"`
+			To      *openapi_types.Date `json:"to,omitempty"`
+			Totals  *[]RenewalCost      `json:"totals,omitempty"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetApiDocumentsStatsResponse parses an HTTP response from a GetApiDocumentsStatsWithResponse call
+func ParseGetApiDocumentsStatsResponse(rsp *http.Response) (*GetApiDocumentsStatsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetApiDocumentsStatsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest struct {
+			Message *string        `json:"message,omitempty"`
+			Stats   *DocumentStats `json:"stats,omitempty"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetApiDocumentsTrashResponse parses an HTTP response from a GetApiDocumentsTrashWithResponse call
+func ParseGetApiDocumentsTrashResponse(rsp *http.Response) (*GetApiDocumentsTrashResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetApiDocumentsTrashResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest struct {
+			Documents     *[]TrashedDocument `json:"documents,omitempty"`
+			Message       *string            `json:"message,omitempty"`
+			RetentionDays *int               `json:"retentionDays,omitempty"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseDeleteApiDocumentsIdResponse parses an HTTP response from a DeleteApiDocumentsIdWithResponse call
+func ParseDeleteApiDocumentsIdResponse(rsp *http.Response) (*DeleteApiDocumentsIdResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DeleteApiDocumentsIdResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	return response, nil
+}
+
+// ParseGetApiDocumentsIdResponse parses an HTTP response from a GetApiDocumentsIdWithResponse call
+func ParseGetApiDocumentsIdResponse(rsp *http.Response) (*GetApiDocumentsIdResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetApiDocumentsIdResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest struct {
+			Document *Document `json:"document,omitempty"`
+			Message  *string   `json:"message,omitempty"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePutApiDocumentsIdResponse parses an HTTP response from a PutApiDocumentsIdWithResponse call
+func ParsePutApiDocumentsIdResponse(rsp *http.Response) (*PutApiDocumentsIdResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PutApiDocumentsIdResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest struct {
+			Document *Document `json:"document,omitempty"`
+			Message  *string   `json:"message,omitempty"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseDeleteApiDocumentsIdAssigneeResponse parses an HTTP response from a DeleteApiDocumentsIdAssigneeWithResponse call
+func ParseDeleteApiDocumentsIdAssigneeResponse(rsp *http.Response) (*DeleteApiDocumentsIdAssigneeResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DeleteApiDocumentsIdAssigneeResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	return response, nil
+}
+
+// ParseGetApiDocumentsIdAssigneeResponse parses an HTTP response from a GetApiDocumentsIdAssigneeWithResponse call
+func ParseGetApiDocumentsIdAssigneeResponse(rsp *http.Response) (*GetApiDocumentsIdAssigneeResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetApiDocumentsIdAssigneeResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest struct {
+			Assignment *DocumentAssignment `json:"assignment,omitempty"`
+			Message    *string             `json:"message,omitempty"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePutApiDocumentsIdAssigneeResponse parses an HTTP response from a PutApiDocumentsIdAssigneeWithResponse call
+func ParsePutApiDocumentsIdAssigneeResponse(rsp *http.Response) (*PutApiDocumentsIdAssigneeResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PutApiDocumentsIdAssigneeResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest struct {
+			Assignment *DocumentAssignment `json:"assignment,omitempty"`
+			Message    *string             `json:"message,omitempty"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostApiDocumentsIdAssigneeAcknowledgeResponse parses an HTTP response from a PostApiDocumentsIdAssigneeAcknowledgeWithResponse call
+func ParsePostApiDocumentsIdAssigneeAcknowledgeResponse(rsp *http.Response) (*PostApiDocumentsIdAssigneeAcknowledgeResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostApiDocumentsIdAssigneeAcknowledgeResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	return response, nil
+}
+
+// ParsePutApiDocumentsIdAttachmentResponse parses an HTTP response from a PutApiDocumentsIdAttachmentWithResponse call
+func ParsePutApiDocumentsIdAttachmentResponse(rsp *http.Response) (*PutApiDocumentsIdAttachmentResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PutApiDocumentsIdAttachmentResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	return response, nil
+}
+
+// ParseGetApiDocumentsIdChecklistResponse parses an HTTP response from a GetApiDocumentsIdChecklistWithResponse call
+func ParseGetApiDocumentsIdChecklistResponse(rsp *http.Response) (*GetApiDocumentsIdChecklistResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetApiDocumentsIdChecklistResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest struct {
+			Items    *[]ChecklistItem   `json:"items,omitempty"`
+			Message  *string            `json:"message,omitempty"`
+			Progress *ChecklistProgress `json:"progress,omitempty"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostApiDocumentsIdChecklistResponse parses an HTTP response from a PostApiDocumentsIdChecklistWithResponse call
+func ParsePostApiDocumentsIdChecklistResponse(rsp *http.Response) (*PostApiDocumentsIdChecklistResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostApiDocumentsIdChecklistResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	return response, nil
+}
+
+// ParseDeleteApiDocumentsIdChecklistItemIdResponse parses an HTTP response from a DeleteApiDocumentsIdChecklistItemIdWithResponse call
+func ParseDeleteApiDocumentsIdChecklistItemIdResponse(rsp *http.Response) (*DeleteApiDocumentsIdChecklistItemIdResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DeleteApiDocumentsIdChecklistItemIdResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	return response, nil
+}
+
+// ParsePutApiDocumentsIdChecklistItemIdResponse parses an HTTP response from a PutApiDocumentsIdChecklistItemIdWithResponse call
+func ParsePutApiDocumentsIdChecklistItemIdResponse(rsp *http.Response) (*PutApiDocumentsIdChecklistItemIdResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PutApiDocumentsIdChecklistItemIdResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	return response, nil
+}
+
+// ParseGetApiDocumentsIdContactsResponse parses an HTTP response from a GetApiDocumentsIdContactsWithResponse call
+func ParseGetApiDocumentsIdContactsResponse(rsp *http.Response) (*GetApiDocumentsIdContactsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetApiDocumentsIdContactsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest struct {
+			Contacts *[]DocumentContact `json:"contacts,omitempty"`
+			Message  *string            `json:"message,omitempty"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostApiDocumentsIdContactsResponse parses an HTTP response from a PostApiDocumentsIdContactsWithResponse call
+func ParsePostApiDocumentsIdContactsResponse(rsp *http.Response) (*PostApiDocumentsIdContactsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostApiDocumentsIdContactsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest struct {
+			Contact *DocumentContact `json:"contact,omitempty"`
+			Message *string          `json:"message,omitempty"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseDeleteApiDocumentsIdContactsContactIdResponse parses an HTTP response from a DeleteApiDocumentsIdContactsContactIdWithResponse call
+func ParseDeleteApiDocumentsIdContactsContactIdResponse(rsp *http.Response) (*DeleteApiDocumentsIdContactsContactIdResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DeleteApiDocumentsIdContactsContactIdResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	return response, nil
+}
+
+// ParseGetApiDocumentsIdDependenciesResponse parses an HTTP response from a GetApiDocumentsIdDependenciesWithResponse call
+func ParseGetApiDocumentsIdDependenciesResponse(rsp *http.Response) (*GetApiDocumentsIdDependenciesResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetApiDocumentsIdDependenciesResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest struct {
+			Dependents *[]DependencyDocument `json:"dependents,omitempty"`
+			DependsOn  *[]DependencyDocument `json:"dependsOn,omitempty"`
+			Message    *string               `json:"message,omitempty"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostApiDocumentsIdDependenciesResponse parses an HTTP response from a PostApiDocumentsIdDependenciesWithResponse call
+func ParsePostApiDocumentsIdDependenciesResponse(rsp *http.Response) (*PostApiDocumentsIdDependenciesResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostApiDocumentsIdDependenciesResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	return response, nil
+}
+
+// ParseDeleteApiDocumentsIdDependenciesDependsOnIdResponse parses an HTTP response from a DeleteApiDocumentsIdDependenciesDependsOnIdWithResponse call
+func ParseDeleteApiDocumentsIdDependenciesDependsOnIdResponse(rsp *http.Response) (*DeleteApiDocumentsIdDependenciesDependsOnIdResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DeleteApiDocumentsIdDependenciesDependsOnIdResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	return response, nil
+}
+
+// ParseGetApiDocumentsIdLegalHoldResponse parses an HTTP response from a GetApiDocumentsIdLegalHoldWithResponse call
+func ParseGetApiDocumentsIdLegalHoldResponse(rsp *http.Response) (*GetApiDocumentsIdLegalHoldResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetApiDocumentsIdLegalHoldResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest struct {
+			LegalHold *LegalHold `json:"legalHold,omitempty"`
+			Message   *string    `json:"message,omitempty"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePutApiDocumentsIdLegalHoldResponse parses an HTTP response from a PutApiDocumentsIdLegalHoldWithResponse call
+func ParsePutApiDocumentsIdLegalHoldResponse(rsp *http.Response) (*PutApiDocumentsIdLegalHoldResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PutApiDocumentsIdLegalHoldResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest struct {
+			LegalHold *LegalHold `json:"legalHold,omitempty"`
+			Message   *string    `json:"message,omitempty"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseDeleteApiDocumentsIdLockResponse parses an HTTP response from a DeleteApiDocumentsIdLockWithResponse call
+func ParseDeleteApiDocumentsIdLockResponse(rsp *http.Response) (*DeleteApiDocumentsIdLockResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DeleteApiDocumentsIdLockResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	return response, nil
+}
+
+// ParsePostApiDocumentsIdLockResponse parses an HTTP response from a PostApiDocumentsIdLockWithResponse call
+func ParsePostApiDocumentsIdLockResponse(rsp *http.Response) (*PostApiDocumentsIdLockResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostApiDocumentsIdLockResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest struct {
+			// Lock Present while someone holds the document's edit lock.
+			Lock    *DocumentLock `json:"lock,omitempty"`
+			Message *string       `json:"message,omitempty"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseDeleteApiDocumentsIdPinResponse parses an HTTP response from a DeleteApiDocumentsIdPinWithResponse call
+func ParseDeleteApiDocumentsIdPinResponse(rsp *http.Response) (*DeleteApiDocumentsIdPinResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DeleteApiDocumentsIdPinResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	return response, nil
+}
+
+// ParsePostApiDocumentsIdPinResponse parses an HTTP response from a PostApiDocumentsIdPinWithResponse call
+func ParsePostApiDocumentsIdPinResponse(rsp *http.Response) (*PostApiDocumentsIdPinResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostApiDocumentsIdPinResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	return response, nil
+}
+
+// ParseGetApiDocumentsIdRemindersResponse parses an HTTP response from a GetApiDocumentsIdRemindersWithResponse call
+func ParseGetApiDocumentsIdRemindersResponse(rsp *http.Response) (*GetApiDocumentsIdRemindersResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetApiDocumentsIdRemindersResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest struct {
+			Data *struct {
+				Description *string                     `json:"description"`
+				Id          *openapi_types.UUID         `json:"id,omitempty"`
+				Name        *string                     `json:"name,omitempty"`
+				Reminders   *[]DocumentReminderInterval `json:"reminders,omitempty"`
+			} `json:"data,omitempty"`
+			Message *string `json:"message,omitempty"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePutApiDocumentsIdRemindersResponse parses an HTTP response from a PutApiDocumentsIdRemindersWithResponse call
+func ParsePutApiDocumentsIdRemindersResponse(rsp *http.Response) (*PutApiDocumentsIdRemindersResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PutApiDocumentsIdRemindersResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest struct {
+			Message *string `json:"message,omitempty"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest struct {
+			Code      *PutApiDocumentsIdReminders403Code `json:"code,omitempty"`
+			Message   *string                            `json:"message,omitempty"`
+			Status    *int                               `json:"status,omitempty"`
+			Timestamp *time.Time                         `json:"timestamp,omitempty"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetApiDocumentsIdRemindersPreviewResponse parses an HTTP response from a GetApiDocumentsIdRemindersPreviewWithResponse call
+func ParseGetApiDocumentsIdRemindersPreviewResponse(rsp *http.Response) (*GetApiDocumentsIdRemindersPreviewResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetApiDocumentsIdRemindersPreviewResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest struct {
+			Message *string `json:"message,omitempty"`
+			Preview *struct {
+				Channel *string `json:"channel,omitempty"`
+
+				// Html HTML body of emails
+				Html *string `json:"html,omitempty"`
+
+				// Subject Subject of emails and push notifications
+				Subject *string `json:"subject,omitempty"`
+
+				// Text Text of SMS and push notifications
+				Text *string `json:"text,omitempty"`
+			} `json:"preview,omitempty"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetApiDocumentsIdRenewalsResponse parses an HTTP response from a GetApiDocumentsIdRenewalsWithResponse call
+func ParseGetApiDocumentsIdRenewalsResponse(rsp *http.Response) (*GetApiDocumentsIdRenewalsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetApiDocumentsIdRenewalsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest struct {
+			Message  *string           `json:"message,omitempty"`
+			Renewals *[]RenewalRequest `json:"renewals,omitempty"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostApiDocumentsIdRenewalsResponse parses an HTTP response from a PostApiDocumentsIdRenewalsWithResponse call
+func ParsePostApiDocumentsIdRenewalsResponse(rsp *http.Response) (*PostApiDocumentsIdRenewalsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostApiDocumentsIdRenewalsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest struct {
+			Message *string         `json:"message,omitempty"`
+			Renewal *RenewalRequest `json:"renewal,omitempty"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostApiDocumentsIdRenewalsRenewalIdApproveResponse parses an HTTP response from a PostApiDocumentsIdRenewalsRenewalIdApproveWithResponse call
+func ParsePostApiDocumentsIdRenewalsRenewalIdApproveResponse(rsp *http.Response) (*PostApiDocumentsIdRenewalsRenewalIdApproveResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostApiDocumentsIdRenewalsRenewalIdApproveResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest struct {
+			Document *Document `json:"document,omitempty"`
+			Message  *string   `json:"message,omitempty"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostApiDocumentsIdRenewalsRenewalIdRejectResponse parses an HTTP response from a PostApiDocumentsIdRenewalsRenewalIdRejectWithResponse call
+func ParsePostApiDocumentsIdRenewalsRenewalIdRejectResponse(rsp *http.Response) (*PostApiDocumentsIdRenewalsRenewalIdRejectResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostApiDocumentsIdRenewalsRenewalIdRejectResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	return response, nil
+}
+
+// ParsePostApiDocumentsIdRestoreResponse parses an HTTP response from a PostApiDocumentsIdRestoreWithResponse call
+func ParsePostApiDocumentsIdRestoreResponse(rsp *http.Response) (*PostApiDocumentsIdRestoreResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostApiDocumentsIdRestoreResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	return response, nil
+}
+
+// ParseDeleteApiFeedResponse parses an HTTP response from a DeleteApiFeedWithResponse call
+func ParseDeleteApiFeedResponse(rsp *http.Response) (*DeleteApiFeedResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DeleteApiFeedResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	return response, nil
+}
+
+// ParseGetApiFeedResponse parses an HTTP response from a GetApiFeedWithResponse call
+func ParseGetApiFeedResponse(rsp *http.Response) (*GetApiFeedResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetApiFeedResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest FeedURLsResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostApiFeedResponse parses an HTTP response from a PostApiFeedWithResponse call
+func ParsePostApiFeedResponse(rsp *http.Response) (*PostApiFeedResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostApiFeedResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest FeedURLsResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostApiFeedbackResponse parses an HTTP response from a PostApiFeedbackWithResponse call
+func ParsePostApiFeedbackResponse(rsp *http.Response) (*PostApiFeedbackResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostApiFeedbackResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest struct {
+			Feedback *Feedback `json:"feedback,omitempty"`
+			Message  *string   `json:"message,omitempty"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetApiFeedsTokenAtomXmlResponse parses an HTTP response from a GetApiFeedsTokenAtomXmlWithResponse call
+func ParseGetApiFeedsTokenAtomXmlResponse(rsp *http.Response) (*GetApiFeedsTokenAtomXmlResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetApiFeedsTokenAtomXmlResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	return response, nil
+}
+
+// ParseGetApiFeedsTokenRssXmlResponse parses an HTTP response from a GetApiFeedsTokenRssXmlWithResponse call
+func ParseGetApiFeedsTokenRssXmlResponse(rsp *http.Response) (*GetApiFeedsTokenRssXmlResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetApiFeedsTokenRssXmlResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	return response, nil
+}
+
+// ParsePostApiGraphqlResponse parses an HTTP response from a PostApiGraphqlWithResponse call
+func ParsePostApiGraphqlResponse(rsp *http.Response) (*PostApiGraphqlResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostApiGraphqlResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest struct {
+			Data   *map[string]interface{}   `json:"data,omitempty"`
+			Errors *[]map[string]interface{} `json:"errors,omitempty"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetApiHouseholdResponse parses an HTTP response from a GetApiHouseholdWithResponse call
+func ParseGetApiHouseholdResponse(rsp *http.Response) (*GetApiHouseholdResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetApiHouseholdResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest struct {
+			Household *Household `json:"household,omitempty"`
+			Message   *string    `json:"message,omitempty"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostApiHouseholdResponse parses an HTTP response from a PostApiHouseholdWithResponse call
+func ParsePostApiHouseholdResponse(rsp *http.Response) (*PostApiHouseholdResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostApiHouseholdResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	return response, nil
+}
+
+// ParsePostApiHouseholdMembersResponse parses an HTTP response from a PostApiHouseholdMembersWithResponse call
+func ParsePostApiHouseholdMembersResponse(rsp *http.Response) (*PostApiHouseholdMembersResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostApiHouseholdMembersResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	return response, nil
+}
+
+// ParseDeleteApiHouseholdMembersUserIdResponse parses an HTTP response from a DeleteApiHouseholdMembersUserIdWithResponse call
+func ParseDeleteApiHouseholdMembersUserIdResponse(rsp *http.Response) (*DeleteApiHouseholdMembersUserIdResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DeleteApiHouseholdMembersUserIdResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	return response, nil
+}
+
+// ParsePutApiHouseholdMembersUserIdResponse parses an HTTP response from a PutApiHouseholdMembersUserIdWithResponse call
+func ParsePutApiHouseholdMembersUserIdResponse(rsp *http.Response) (*PutApiHouseholdMembersUserIdResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PutApiHouseholdMembersUserIdResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	return response, nil
+}
+
+// ParseGetApiHouseholdMembersUserIdDocumentsResponse parses an HTTP response from a GetApiHouseholdMembersUserIdDocumentsWithResponse call
+func ParseGetApiHouseholdMembersUserIdDocumentsResponse(rsp *http.Response) (*GetApiHouseholdMembersUserIdDocumentsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetApiHouseholdMembersUserIdDocumentsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	return response, nil
+}
+
+// ParseGetApiIntegrationsEgressIpsResponse parses an HTTP response from a GetApiIntegrationsEgressIpsWithResponse call
+func ParseGetApiIntegrationsEgressIpsResponse(rsp *http.Response) (*GetApiIntegrationsEgressIpsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetApiIntegrationsEgressIpsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest struct {
+			Ips     *[]string `json:"ips,omitempty"`
+			Message *string   `json:"message,omitempty"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetApiIssuersResponse parses an HTTP response from a GetApiIssuersWithResponse call
+func ParseGetApiIssuersResponse(rsp *http.Response) (*GetApiIssuersResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetApiIssuersResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest struct {
+			Issuers *[]Issuer `json:"issuers,omitempty"`
+			Message *string   `json:"message,omitempty"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetApiJobsResponse parses an HTTP response from a GetApiJobsWithResponse call
+func ParseGetApiJobsResponse(rsp *http.Response) (*GetApiJobsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetApiJobsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest struct {
+			Jobs    *[]Job  `json:"jobs,omitempty"`
+			Message *string `json:"message,omitempty"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostApiJobsImportResponse parses an HTTP response from a PostApiJobsImportWithResponse call
+func ParsePostApiJobsImportResponse(rsp *http.Response) (*PostApiJobsImportResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostApiJobsImportResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 202:
+		var dest JobQueued
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON202 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostApiJobsTakeoutResponse parses an HTTP response from a PostApiJobsTakeoutWithResponse call
+func ParsePostApiJobsTakeoutResponse(rsp *http.Response) (*PostApiJobsTakeoutResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostApiJobsTakeoutResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 202:
+		var dest JobQueued
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON202 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetApiJobsIdResponse parses an HTTP response from a GetApiJobsIdWithResponse call
+func ParseGetApiJobsIdResponse(rsp *http.Response) (*GetApiJobsIdResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetApiJobsIdResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest struct {
+			Job     *Job    `json:"job,omitempty"`
+			Message *string `json:"message,omitempty"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetApiLinksTokenResponse parses an HTTP response from a GetApiLinksTokenWithResponse call
+func ParseGetApiLinksTokenResponse(rsp *http.Response) (*GetApiLinksTokenResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetApiLinksTokenResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest struct {
+			Action     *GetApiLinksToken200Action `json:"action,omitempty"`
+			Document   *Document                  `json:"document,omitempty"`
+			SnoozeDays *int                       `json:"snoozeDays,omitempty"`
+
+			// Used Whether the renewed or snooze link was already used
+			Used *bool `json:"used,omitempty"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostApiLinksTokenResponse parses an HTTP response from a PostApiLinksTokenWithResponse call
+func ParsePostApiLinksTokenResponse(rsp *http.Response) (*PostApiLinksTokenResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostApiLinksTokenResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	return response, nil
+}
+
+// ParsePostApiNotificationsReadResponse parses an HTTP response from a PostApiNotificationsReadWithResponse call
+func ParsePostApiNotificationsReadResponse(rsp *http.Response) (*PostApiNotificationsReadResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostApiNotificationsReadResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest struct {
+			// Marked Number of notification log entries marked read
+			Marked  *int64  `json:"marked,omitempty"`
+			Message *string `json:"message,omitempty"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetApiNotificationsUnreadCountResponse parses an HTTP response from a GetApiNotificationsUnreadCountWithResponse call
+func ParseGetApiNotificationsUnreadCountResponse(rsp *http.Response) (*GetApiNotificationsUnreadCountResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetApiNotificationsUnreadCountResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest struct {
+			UnreadCount *int `json:"unreadCount,omitempty"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseDeleteApiOnboardingSampleDataResponse parses an HTTP response from a DeleteApiOnboardingSampleDataWithResponse call
+func ParseDeleteApiOnboardingSampleDataResponse(rsp *http.Response) (*DeleteApiOnboardingSampleDataResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DeleteApiOnboardingSampleDataResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest struct {
+			Deleted *int    `json:"deleted,omitempty"`
+			Message *string `json:"message,omitempty"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostApiOnboardingSampleDataResponse parses an HTTP response from a PostApiOnboardingSampleDataWithResponse call
+func ParsePostApiOnboardingSampleDataResponse(rsp *http.Response) (*PostApiOnboardingSampleDataResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostApiOnboardingSampleDataResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest struct {
+			Documents *[]Document `json:"documents,omitempty"`
+			Message   *string     `json:"message,omitempty"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetApiOrganizationsResponse parses an HTTP response from a GetApiOrganizationsWithResponse call
+func ParseGetApiOrganizationsResponse(rsp *http.Response) (*GetApiOrganizationsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetApiOrganizationsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest struct {
+			DataRegions   *[]string       `json:"dataRegions,omitempty"`
+			Message       *string         `json:"message,omitempty"`
+			Organizations *[]Organization `json:"organizations,omitempty"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostApiOrganizationsResponse parses an HTTP response from a PostApiOrganizationsWithResponse call
+func ParsePostApiOrganizationsResponse(rsp *http.Response) (*PostApiOrganizationsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostApiOrganizationsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest struct {
+			Message      *string       `json:"message,omitempty"`
+			Organization *Organization `json:"organization,omitempty"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePutApiOrganizationsIdComplianceResponse parses an HTTP response from a PutApiOrganizationsIdComplianceWithResponse call
+func ParsePutApiOrganizationsIdComplianceResponse(rsp *http.Response) (*PutApiOrganizationsIdComplianceResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PutApiOrganizationsIdComplianceResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest struct {
+			Message      *string       `json:"message,omitempty"`
+			Organization *Organization `json:"organization,omitempty"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseDeleteApiOrganizationsIdEmailSenderResponse parses an HTTP response from a DeleteApiOrganizationsIdEmailSenderWithResponse call
+func ParseDeleteApiOrganizationsIdEmailSenderResponse(rsp *http.Response) (*DeleteApiOrganizationsIdEmailSenderResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DeleteApiOrganizationsIdEmailSenderResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	return response, nil
+}
+
+// ParseGetApiOrganizationsIdEmailSenderResponse parses an HTTP response from a GetApiOrganizationsIdEmailSenderWithResponse call
+func ParseGetApiOrganizationsIdEmailSenderResponse(rsp *http.Response) (*GetApiOrganizationsIdEmailSenderResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetApiOrganizationsIdEmailSenderResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest struct {
+			Message *string      `json:"message,omitempty"`
+			Sender  *EmailSender `json:"sender,omitempty"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePutApiOrganizationsIdEmailSenderResponse parses an HTTP response from a PutApiOrganizationsIdEmailSenderWithResponse call
+func ParsePutApiOrganizationsIdEmailSenderResponse(rsp *http.Response) (*PutApiOrganizationsIdEmailSenderResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PutApiOrganizationsIdEmailSenderResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest struct {
+			Message *string      `json:"message,omitempty"`
+			Sender  *EmailSender `json:"sender,omitempty"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostApiOrganizationsIdEmailSenderVerifyResponse parses an HTTP response from a PostApiOrganizationsIdEmailSenderVerifyWithResponse call
+func ParsePostApiOrganizationsIdEmailSenderVerifyResponse(rsp *http.Response) (*PostApiOrganizationsIdEmailSenderVerifyResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostApiOrganizationsIdEmailSenderVerifyResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 202:
+		var dest struct {
+			Message *string      `json:"message,omitempty"`
+			Sender  *EmailSender `json:"sender,omitempty"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON202 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetApiOrganizationsIdEscalationPolicyResponse parses an HTTP response from a GetApiOrganizationsIdEscalationPolicyWithResponse call
+func ParseGetApiOrganizationsIdEscalationPolicyResponse(rsp *http.Response) (*GetApiOrganizationsIdEscalationPolicyResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetApiOrganizationsIdEscalationPolicyResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest struct {
+			Message *string           `json:"message,omitempty"`
+			Steps   *[]EscalationStep `json:"steps,omitempty"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePutApiOrganizationsIdEscalationPolicyResponse parses an HTTP response from a PutApiOrganizationsIdEscalationPolicyWithResponse call
+func ParsePutApiOrganizationsIdEscalationPolicyResponse(rsp *http.Response) (*PutApiOrganizationsIdEscalationPolicyResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PutApiOrganizationsIdEscalationPolicyResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest struct {
+			Message *string           `json:"message,omitempty"`
+			Steps   *[]EscalationStep `json:"steps,omitempty"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetApiOrganizationsIdEventLogExportResponse parses an HTTP response from a GetApiOrganizationsIdEventLogExportWithResponse call
+func ParseGetApiOrganizationsIdEventLogExportResponse(rsp *http.Response) (*GetApiOrganizationsIdEventLogExportResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetApiOrganizationsIdEventLogExportResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest EventLogReport
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetApiOrganizationsIdLegalHoldResponse parses an HTTP response from a GetApiOrganizationsIdLegalHoldWithResponse call
+func ParseGetApiOrganizationsIdLegalHoldResponse(rsp *http.Response) (*GetApiOrganizationsIdLegalHoldResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetApiOrganizationsIdLegalHoldResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest struct {
+			LegalHold *LegalHold `json:"legalHold,omitempty"`
+			Message   *string    `json:"message,omitempty"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePutApiOrganizationsIdLegalHoldResponse parses an HTTP response from a PutApiOrganizationsIdLegalHoldWithResponse call
+func ParsePutApiOrganizationsIdLegalHoldResponse(rsp *http.Response) (*PutApiOrganizationsIdLegalHoldResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PutApiOrganizationsIdLegalHoldResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest struct {
+			LegalHold *LegalHold `json:"legalHold,omitempty"`
+			Message   *string    `json:"message,omitempty"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetApiOrganizationsIdMembersResponse parses an HTTP response from a GetApiOrganizationsIdMembersWithResponse call
+func ParseGetApiOrganizationsIdMembersResponse(rsp *http.Response) (*GetApiOrganizationsIdMembersResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetApiOrganizationsIdMembersResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest struct {
+			Members *[]OrganizationMember `json:"members,omitempty"`
+			Message *string               `json:"message,omitempty"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostApiOrganizationsIdMembersResponse parses an HTTP response from a PostApiOrganizationsIdMembersWithResponse call
+func ParsePostApiOrganizationsIdMembersResponse(rsp *http.Response) (*PostApiOrganizationsIdMembersResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostApiOrganizationsIdMembersResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	return response, nil
+}
+
+// ParseDeleteApiOrganizationsIdMembersUserIdResponse parses an HTTP response from a DeleteApiOrganizationsIdMembersUserIdWithResponse call
+func ParseDeleteApiOrganizationsIdMembersUserIdResponse(rsp *http.Response) (*DeleteApiOrganizationsIdMembersUserIdResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DeleteApiOrganizationsIdMembersUserIdResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	return response, nil
+}
+
+// ParseDeleteApiOrganizationsIdNotificationThemeResponse parses an HTTP response from a DeleteApiOrganizationsIdNotificationThemeWithResponse call
+func ParseDeleteApiOrganizationsIdNotificationThemeResponse(rsp *http.Response) (*DeleteApiOrganizationsIdNotificationThemeResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DeleteApiOrganizationsIdNotificationThemeResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	return response, nil
+}
+
+// ParseGetApiOrganizationsIdNotificationThemeResponse parses an HTTP response from a GetApiOrganizationsIdNotificationThemeWithResponse call
+func ParseGetApiOrganizationsIdNotificationThemeResponse(rsp *http.Response) (*GetApiOrganizationsIdNotificationThemeResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetApiOrganizationsIdNotificationThemeResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest struct {
+			Message *string            `json:"message,omitempty"`
+			Theme   *NotificationTheme `json:"theme,omitempty"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePutApiOrganizationsIdNotificationThemeResponse parses an HTTP response from a PutApiOrganizationsIdNotificationThemeWithResponse call
+func ParsePutApiOrganizationsIdNotificationThemeResponse(rsp *http.Response) (*PutApiOrganizationsIdNotificationThemeResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PutApiOrganizationsIdNotificationThemeResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest struct {
+			Message *string            `json:"message,omitempty"`
+			Theme   *NotificationTheme `json:"theme,omitempty"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePutApiOrganizationsIdRenewalApprovalResponse parses an HTTP response from a PutApiOrganizationsIdRenewalApprovalWithResponse call
+func ParsePutApiOrganizationsIdRenewalApprovalResponse(rsp *http.Response) (*PutApiOrganizationsIdRenewalApprovalResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PutApiOrganizationsIdRenewalApprovalResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest struct {
+			Message      *string       `json:"message,omitempty"`
+			Organization *Organization `json:"organization,omitempty"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetApiOrganizationsIdRetentionResponse parses an HTTP response from a GetApiOrganizationsIdRetentionWithResponse call
+func ParseGetApiOrganizationsIdRetentionResponse(rsp *http.Response) (*GetApiOrganizationsIdRetentionResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetApiOrganizationsIdRetentionResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest struct {
+			Message   *string                `json:"message,omitempty"`
+			Retention *OrganizationRetention `json:"retention,omitempty"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePutApiOrganizationsIdRetentionResponse parses an HTTP response from a PutApiOrganizationsIdRetentionWithResponse call
+func ParsePutApiOrganizationsIdRetentionResponse(rsp *http.Response) (*PutApiOrganizationsIdRetentionResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PutApiOrganizationsIdRetentionResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest struct {
+			Message   *string                `json:"message,omitempty"`
+			Retention *OrganizationRetention `json:"retention,omitempty"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseDeleteApiOrganizationsIdScimTokenResponse parses an HTTP response from a DeleteApiOrganizationsIdScimTokenWithResponse call
+func ParseDeleteApiOrganizationsIdScimTokenResponse(rsp *http.Response) (*DeleteApiOrganizationsIdScimTokenResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DeleteApiOrganizationsIdScimTokenResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	return response, nil
+}
+
+// ParsePostApiOrganizationsIdScimTokenResponse parses an HTTP response from a PostApiOrganizationsIdScimTokenWithResponse call
+func ParsePostApiOrganizationsIdScimTokenResponse(rsp *http.Response) (*PostApiOrganizationsIdScimTokenResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostApiOrganizationsIdScimTokenResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest struct {
+			// BaseUrl SCIM base URL to configure in the identity provider.
+			BaseUrl *string `json:"baseUrl,omitempty"`
+			Message *string `json:"message,omitempty"`
+			Token   *string `json:"token,omitempty"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetApiOrganizationsIdServiceAccountsResponse parses an HTTP response from a GetApiOrganizationsIdServiceAccountsWithResponse call
+func ParseGetApiOrganizationsIdServiceAccountsResponse(rsp *http.Response) (*GetApiOrganizationsIdServiceAccountsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetApiOrganizationsIdServiceAccountsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest struct {
+			Message         *string           `json:"message,omitempty"`
+			ServiceAccounts *[]ServiceAccount `json:"serviceAccounts,omitempty"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostApiOrganizationsIdServiceAccountsResponse parses an HTTP response from a PostApiOrganizationsIdServiceAccountsWithResponse call
+func ParsePostApiOrganizationsIdServiceAccountsResponse(rsp *http.Response) (*PostApiOrganizationsIdServiceAccountsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostApiOrganizationsIdServiceAccountsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest ServiceAccountTokenResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseDeleteApiOrganizationsIdServiceAccountsAccountIdTokenResponse parses an HTTP response from a DeleteApiOrganizationsIdServiceAccountsAccountIdTokenWithResponse call
+func ParseDeleteApiOrganizationsIdServiceAccountsAccountIdTokenResponse(rsp *http.Response) (*DeleteApiOrganizationsIdServiceAccountsAccountIdTokenResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DeleteApiOrganizationsIdServiceAccountsAccountIdTokenResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	return response, nil
+}
+
+// ParsePostApiOrganizationsIdServiceAccountsAccountIdTokenResponse parses an HTTP response from a PostApiOrganizationsIdServiceAccountsAccountIdTokenWithResponse call
+func ParsePostApiOrganizationsIdServiceAccountsAccountIdTokenResponse(rsp *http.Response) (*PostApiOrganizationsIdServiceAccountsAccountIdTokenResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostApiOrganizationsIdServiceAccountsAccountIdTokenResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ServiceAccountTokenResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetApiOrganizationsIdUsageExportResponse parses an HTTP response from a GetApiOrganizationsIdUsageExportWithResponse call
+func ParseGetApiOrganizationsIdUsageExportResponse(rsp *http.Response) (*GetApiOrganizationsIdUsageExportResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetApiOrganizationsIdUsageExportResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest UsageExport
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case rsp.StatusCode == 200:
+		// Content-type (text/csv) unsupported
+
+	}
+
+	return response, nil
+}
+
+// ParseGetApiPreferencesLocaleResponse parses an HTTP response from a GetApiPreferencesLocaleWithResponse call
+func ParseGetApiPreferencesLocaleResponse(rsp *http.Response) (*GetApiPreferencesLocaleResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetApiPreferencesLocaleResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest struct {
+			Message     *string            `json:"message,omitempty"`
+			Preferences *LocalePreferences `json:"preferences,omitempty"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePutApiPreferencesLocaleResponse parses an HTTP response from a PutApiPreferencesLocaleWithResponse call
+func ParsePutApiPreferencesLocaleResponse(rsp *http.Response) (*PutApiPreferencesLocaleResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PutApiPreferencesLocaleResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest struct {
+			Message     *string            `json:"message,omitempty"`
+			Preferences *LocalePreferences `json:"preferences,omitempty"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetApiPreferencesNotificationsResponse parses an HTTP response from a GetApiPreferencesNotificationsWithResponse call
+func ParseGetApiPreferencesNotificationsResponse(rsp *http.Response) (*GetApiPreferencesNotificationsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetApiPreferencesNotificationsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest struct {
+			Message     *string                  `json:"message,omitempty"`
+			Preferences *NotificationPreferences `json:"preferences,omitempty"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePutApiPreferencesNotificationsResponse parses an HTTP response from a PutApiPreferencesNotificationsWithResponse call
+func ParsePutApiPreferencesNotificationsResponse(rsp *http.Response) (*PutApiPreferencesNotificationsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PutApiPreferencesNotificationsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest struct {
+			Message     *string                  `json:"message,omitempty"`
+			Preferences *NotificationPreferences `json:"preferences,omitempty"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetApiRoadmapResponse parses an HTTP response from a GetApiRoadmapWithResponse call
+func ParseGetApiRoadmapResponse(rsp *http.Response) (*GetApiRoadmapResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetApiRoadmapResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest struct {
+			Features *[]RoadmapFeature `json:"features,omitempty"`
+			Message  *string           `json:"message,omitempty"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseDeleteApiRoadmapIdVoteResponse parses an HTTP response from a DeleteApiRoadmapIdVoteWithResponse call
+func ParseDeleteApiRoadmapIdVoteResponse(rsp *http.Response) (*DeleteApiRoadmapIdVoteResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DeleteApiRoadmapIdVoteResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest struct {
+			Feature *RoadmapFeature `json:"feature,omitempty"`
+			Message *string         `json:"message,omitempty"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostApiRoadmapIdVoteResponse parses an HTTP response from a PostApiRoadmapIdVoteWithResponse call
+func ParsePostApiRoadmapIdVoteResponse(rsp *http.Response) (*PostApiRoadmapIdVoteResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostApiRoadmapIdVoteResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest struct {
+			Feature *RoadmapFeature `json:"feature,omitempty"`
+			Message *string         `json:"message,omitempty"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetApiTemplatesResponse parses an HTTP response from a GetApiTemplatesWithResponse call
+func ParseGetApiTemplatesResponse(rsp *http.Response) (*GetApiTemplatesResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetApiTemplatesResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest struct {
+			Message   *string             `json:"message,omitempty"`
+			Templates *[]DocumentTemplate `json:"templates,omitempty"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetApiTrackOpenMessageIdResponse parses an HTTP response from a GetApiTrackOpenMessageIdWithResponse call
+func ParseGetApiTrackOpenMessageIdResponse(rsp *http.Response) (*GetApiTrackOpenMessageIdResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetApiTrackOpenMessageIdResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	return response, nil
+}
+
+// ParseGetApiUnsubscribeTokenResponse parses an HTTP response from a GetApiUnsubscribeTokenWithResponse call
+func ParseGetApiUnsubscribeTokenResponse(rsp *http.Response) (*GetApiUnsubscribeTokenResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetApiUnsubscribeTokenResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest struct {
+			Email *string `json:"email,omitempty"`
+
+			// Unsubscribed Whether the contact is already unsubscribed
+			Unsubscribed *bool `json:"unsubscribed,omitempty"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostApiUnsubscribeTokenResponse parses an HTTP response from a PostApiUnsubscribeTokenWithResponse call
+func ParsePostApiUnsubscribeTokenResponse(rsp *http.Response) (*PostApiUnsubscribeTokenResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostApiUnsubscribeTokenResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	return response, nil
+}
+
+// ParseGetApiUsageResponse parses an HTTP response from a GetApiUsageWithResponse call
+func ParseGetApiUsageResponse(rsp *http.Response) (*GetApiUsageResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetApiUsageResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest struct {
+			Message        *string             `json:"message,omitempty"`
+			Plan           *GetApiUsage200Plan `json:"plan,omitempty"`
+			Usage          *[]QuotaUsage       `json:"usage,omitempty"`
+			WarningPercent *int                `json:"warningPercent,omitempty"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetApiViewsResponse parses an HTTP response from a GetApiViewsWithResponse call
+func ParseGetApiViewsResponse(rsp *http.Response) (*GetApiViewsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetApiViewsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest struct {
+			Message *string      `json:"message,omitempty"`
+			Views   *[]SavedView `json:"views,omitempty"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostApiViewsResponse parses an HTTP response from a PostApiViewsWithResponse call
+func ParsePostApiViewsResponse(rsp *http.Response) (*PostApiViewsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostApiViewsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest struct {
+			Message *string    `json:"message,omitempty"`
+			View    *SavedView `json:"view,omitempty"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseDeleteApiViewsIdResponse parses an HTTP response from a DeleteApiViewsIdWithResponse call
+func ParseDeleteApiViewsIdResponse(rsp *http.Response) (*DeleteApiViewsIdResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DeleteApiViewsIdResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	return response, nil
+}
+
+// ParseGetApiViewsIdResponse parses an HTTP response from a GetApiViewsIdWithResponse call
+func ParseGetApiViewsIdResponse(rsp *http.Response) (*GetApiViewsIdResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetApiViewsIdResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest struct {
+			Message *string    `json:"message,omitempty"`
+			View    *SavedView `json:"view,omitempty"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePutApiViewsIdResponse parses an HTTP response from a PutApiViewsIdWithResponse call
+func ParsePutApiViewsIdResponse(rsp *http.Response) (*PutApiViewsIdResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PutApiViewsIdResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest struct {
+			Message *string    `json:"message,omitempty"`
+			View    *SavedView `json:"view,omitempty"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetApiViewsIdDocumentsResponse parses an HTTP response from a GetApiViewsIdDocumentsWithResponse call
+func ParseGetApiViewsIdDocumentsResponse(rsp *http.Response) (*GetApiViewsIdDocumentsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetApiViewsIdDocumentsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest struct {
+			Documents *[]Document `json:"documents,omitempty"`
+			Message   *string     `json:"message,omitempty"`
+			View      *SavedView  `json:"view,omitempty"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostApiWebhooksEmailBounceResponse parses an HTTP response from a PostApiWebhooksEmailBounceWithResponse call
+func ParsePostApiWebhooksEmailBounceResponse(rsp *http.Response) (*PostApiWebhooksEmailBounceResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostApiWebhooksEmailBounceResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	return response, nil
+}
+
+// ParseGetApiWebhooksEndpointsResponse parses an HTTP response from a GetApiWebhooksEndpointsWithResponse call
+func ParseGetApiWebhooksEndpointsResponse(rsp *http.Response) (*GetApiWebhooksEndpointsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetApiWebhooksEndpointsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest struct {
+			Endpoints *[]WebhookEndpoint `json:"endpoints,omitempty"`
+			Events    *[]string          `json:"events,omitempty"`
+			Message   *string            `json:"message,omitempty"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostApiWebhooksEndpointsResponse parses an HTTP response from a PostApiWebhooksEndpointsWithResponse call
+func ParsePostApiWebhooksEndpointsResponse(rsp *http.Response) (*PostApiWebhooksEndpointsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostApiWebhooksEndpointsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest WebhookEndpointEnvelope
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseDeleteApiWebhooksEndpointsIdResponse parses an HTTP response from a DeleteApiWebhooksEndpointsIdWithResponse call
+func ParseDeleteApiWebhooksEndpointsIdResponse(rsp *http.Response) (*DeleteApiWebhooksEndpointsIdResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DeleteApiWebhooksEndpointsIdResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	return response, nil
+}
+
+// ParsePutApiWebhooksEndpointsIdResponse parses an HTTP response from a PutApiWebhooksEndpointsIdWithResponse call
+func ParsePutApiWebhooksEndpointsIdResponse(rsp *http.Response) (*PutApiWebhooksEndpointsIdResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PutApiWebhooksEndpointsIdResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest WebhookEndpointEnvelope
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetApiWebhooksEndpointsIdDeliveriesResponse parses an HTTP response from a GetApiWebhooksEndpointsIdDeliveriesWithResponse call
+func ParseGetApiWebhooksEndpointsIdDeliveriesResponse(rsp *http.Response) (*GetApiWebhooksEndpointsIdDeliveriesResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetApiWebhooksEndpointsIdDeliveriesResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest struct {
+			Deliveries *[]WebhookDelivery `json:"deliveries,omitempty"`
+			Message    *string            `json:"message,omitempty"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostApiWebhooksEndpointsIdDeliveriesDeliveryIdRedeliverResponse parses an HTTP response from a PostApiWebhooksEndpointsIdDeliveriesDeliveryIdRedeliverWithResponse call
+func ParsePostApiWebhooksEndpointsIdDeliveriesDeliveryIdRedeliverResponse(rsp *http.Response) (*PostApiWebhooksEndpointsIdDeliveriesDeliveryIdRedeliverResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostApiWebhooksEndpointsIdDeliveriesDeliveryIdRedeliverResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 202:
+		var dest struct {
+			Delivery *WebhookDelivery `json:"delivery,omitempty"`
+			Message  *string          `json:"message,omitempty"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON202 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostApiWebhooksEndpointsIdRotateSecretResponse parses an HTTP response from a PostApiWebhooksEndpointsIdRotateSecretWithResponse call
+func ParsePostApiWebhooksEndpointsIdRotateSecretResponse(rsp *http.Response) (*PostApiWebhooksEndpointsIdRotateSecretResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostApiWebhooksEndpointsIdRotateSecretResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest WebhookEndpointEnvelope
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostApiWebhooksTwilioSmsResponse parses an HTTP response from a PostApiWebhooksTwilioSmsWithResponse call
+func ParsePostApiWebhooksTwilioSmsResponse(rsp *http.Response) (*PostApiWebhooksTwilioSmsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostApiWebhooksTwilioSmsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "xml") && rsp.StatusCode == 200:
+		var dest string
+		if err := xml.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.XML200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetHealthResponse parses an HTTP response from a GetHealthWithResponse call
+func ParseGetHealthResponse(rsp *http.Response) (*GetHealthResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetHealthResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest struct {
+			Service   *string `json:"service,omitempty"`
+			Status    *string `json:"status,omitempty"`
+			Timestamp *string `json:"timestamp,omitempty"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetReadyResponse parses an HTTP response from a GetReadyWithResponse call
+func ParseGetReadyResponse(rsp *http.Response) (*GetReadyResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetReadyResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest struct {
+			Database  *GetReady200Database `json:"database,omitempty"`
+			Status    *GetReady200Status   `json:"status,omitempty"`
+			Timestamp *string              `json:"timestamp,omitempty"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 503:
+		var dest struct {
+			Database  *GetReady503Database `json:"database,omitempty"`
+			Status    *GetReady503Status   `json:"status,omitempty"`
+			Timestamp *string              `json:"timestamp,omitempty"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON503 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetReminderIntervalsResponse parses an HTTP response from a GetReminderIntervalsWithResponse call
+func ParseGetReminderIntervalsResponse(rsp *http.Response) (*GetReminderIntervalsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetReminderIntervalsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest struct {
+			Message           *string             `json:"message,omitempty"`
+			ReminderIntervals *[]ReminderInterval `json:"reminderIntervals,omitempty"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetScimV2ServiceProviderConfigResponse parses an HTTP response from a GetScimV2ServiceProviderConfigWithResponse call
+func ParseGetScimV2ServiceProviderConfigResponse(rsp *http.Response) (*GetScimV2ServiceProviderConfigResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetScimV2ServiceProviderConfigResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	return response, nil
+}
+
+// ParseGetScimV2UsersResponse parses an HTTP response from a GetScimV2UsersWithResponse call
+func ParseGetScimV2UsersResponse(rsp *http.Response) (*GetScimV2UsersResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetScimV2UsersResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ScimListResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationscimJSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostScimV2UsersResponse parses an HTTP response from a PostScimV2UsersWithResponse call
+func ParsePostScimV2UsersResponse(rsp *http.Response) (*PostScimV2UsersResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostScimV2UsersResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest ScimUser
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationscimJSON201 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseDeleteScimV2UsersUserIdResponse parses an HTTP response from a DeleteScimV2UsersUserIdWithResponse call
+func ParseDeleteScimV2UsersUserIdResponse(rsp *http.Response) (*DeleteScimV2UsersUserIdResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DeleteScimV2UsersUserIdResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	return response, nil
+}
+
+// ParseGetScimV2UsersUserIdResponse parses an HTTP response from a GetScimV2UsersUserIdWithResponse call
+func ParseGetScimV2UsersUserIdResponse(rsp *http.Response) (*GetScimV2UsersUserIdResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetScimV2UsersUserIdResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ScimUser
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationscimJSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePatchScimV2UsersUserIdResponse parses an HTTP response from a PatchScimV2UsersUserIdWithResponse call
+func ParsePatchScimV2UsersUserIdResponse(rsp *http.Response) (*PatchScimV2UsersUserIdResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PatchScimV2UsersUserIdResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ScimUser
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationscimJSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePutScimV2UsersUserIdResponse parses an HTTP response from a PutScimV2UsersUserIdWithResponse call
+func ParsePutScimV2UsersUserIdResponse(rsp *http.Response) (*PutScimV2UsersUserIdResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PutScimV2UsersUserIdResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ScimUser
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationscimJSON200 = &dest
+
+	}
+
+	return response, nil
+}
@@ -0,0 +1,6 @@
+// Package xpired is the Go client for the xpired REST API. Everything in
+// client.gen.go is generated from backend/openapi.yml; do not edit it by
+// hand, run `make -C clients generate` instead.
+package xpired
+
+//go:generate go run github.com/oapi-codegen/oapi-codegen/v2/cmd/oapi-codegen@v2.5.1 -config oapi-codegen.yaml ../../backend/openapi.yml
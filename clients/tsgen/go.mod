@@ -0,0 +1,5 @@
+module github.com/bograh/xpired/clients/tsgen
+
+go 1.24.1
+
+require gopkg.in/yaml.v3 v3.0.1
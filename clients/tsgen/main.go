@@ -0,0 +1,455 @@
+// Command tsgen generates the TypeScript client in clients/typescript from
+// the OpenAPI spec: one interface per component schema and one method per
+// operation, on a dependency-free fetch-based client.
+//
+//	go run . -spec ../../backend/openapi.yml -out ../typescript/src/client.gen.ts
+package main
+
+import (
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"sort"
+	"strings"
+	"unicode"
+
+	"gopkg.in/yaml.v3"
+)
+
+type schema map[string]interface{}
+
+func main() {
+	specPath := flag.String("spec", "../../backend/openapi.yml", "OpenAPI spec to generate from")
+	outPath := flag.String("out", "../typescript/src/client.gen.ts", "TypeScript file to write")
+	flag.Parse()
+
+	raw, err := os.ReadFile(*specPath)
+	if err != nil {
+		log.Fatalf("Failed to read spec: %v", err)
+	}
+
+	var spec map[string]interface{}
+	if err := yaml.Unmarshal(raw, &spec); err != nil {
+		log.Fatalf("Failed to parse spec: %v", err)
+	}
+
+	out := generate(spec)
+	if err := os.WriteFile(*outPath, []byte(out), 0o644); err != nil {
+		log.Fatalf("Failed to write %s: %v", *outPath, err)
+	}
+}
+
+func asMap(v interface{}) map[string]interface{} {
+	m, _ := v.(map[string]interface{})
+	return m
+}
+
+func asSlice(v interface{}) []interface{} {
+	s, _ := v.([]interface{})
+	return s
+}
+
+func asString(v interface{}) string {
+	s, _ := v.(string)
+	return s
+}
+
+func sortedKeys(m map[string]interface{}) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// identifier turns "/api/documents/{id}" style fragments into PascalCase.
+func identifier(s string) string {
+	var b strings.Builder
+	upper := true
+	for _, r := range s {
+		if !unicode.IsLetter(r) && !unicode.IsDigit(r) {
+			upper = true
+			continue
+		}
+		if upper {
+			b.WriteRune(unicode.ToUpper(r))
+			upper = false
+		} else {
+			b.WriteRune(r)
+		}
+	}
+	return b.String()
+}
+
+func quoteKey(key string) string {
+	for i, r := range key {
+		if !(unicode.IsLetter(r) || r == '_' || r == '$' || (i > 0 && unicode.IsDigit(r))) {
+			return fmt.Sprintf("%q", key)
+		}
+	}
+	return key
+}
+
+// tsType renders a schema as a TypeScript type expression.
+func tsType(s map[string]interface{}, indent string) string {
+	if s == nil {
+		return "unknown"
+	}
+	if ref := asString(s["$ref"]); ref != "" {
+		return ref[strings.LastIndex(ref, "/")+1:]
+	}
+
+	var t string
+	switch {
+	case s["allOf"] != nil:
+		t = joinTypes(asSlice(s["allOf"]), " & ", indent)
+	case s["oneOf"] != nil:
+		t = joinTypes(asSlice(s["oneOf"]), " | ", indent)
+	case s["anyOf"] != nil:
+		t = joinTypes(asSlice(s["anyOf"]), " | ", indent)
+	case s["enum"] != nil:
+		var values []string
+		for _, v := range asSlice(s["enum"]) {
+			values = append(values, fmt.Sprintf("%q", fmt.Sprint(v)))
+		}
+		t = strings.Join(values, " | ")
+	default:
+		switch asString(s["type"]) {
+		case "string":
+			t = "string"
+		case "integer", "number":
+			t = "number"
+		case "boolean":
+			t = "boolean"
+		case "array":
+			item := tsType(asMap(s["items"]), indent)
+			if strings.ContainsAny(item, " |&") {
+				item = "(" + item + ")"
+			}
+			t = item + "[]"
+		case "object", "":
+			t = objectType(s, indent)
+		default:
+			t = "unknown"
+		}
+	}
+
+	if nullable, _ := s["nullable"].(bool); nullable {
+		t += " | null"
+	}
+	return t
+}
+
+func joinTypes(schemas []interface{}, sep, indent string) string {
+	var parts []string
+	for _, sub := range schemas {
+		parts = append(parts, tsType(asMap(sub), indent))
+	}
+	return strings.Join(parts, sep)
+}
+
+func objectType(s map[string]interface{}, indent string) string {
+	props := asMap(s["properties"])
+	additional := s["additionalProperties"]
+	if len(props) == 0 {
+		if additional != nil {
+			if sub := asMap(additional); sub != nil {
+				return "Record<string, " + tsType(sub, indent) + ">"
+			}
+			return "Record<string, unknown>"
+		}
+		if s["type"] == "object" {
+			return "Record<string, unknown>"
+		}
+		return "unknown"
+	}
+
+	required := map[string]bool{}
+	for _, name := range asSlice(s["required"]) {
+		required[asString(name)] = true
+	}
+
+	var b strings.Builder
+	b.WriteString("{\n")
+	for _, name := range sortedKeys(props) {
+		prop := asMap(props[name])
+		if desc := asString(prop["description"]); desc != "" {
+			b.WriteString(indent + "  /** " + strings.TrimSpace(strings.ReplaceAll(desc, "\n", " ")) + " */\n")
+		}
+		optional := "?"
+		if required[name] {
+			optional = ""
+		}
+		b.WriteString(indent + "  " + quoteKey(name) + optional + ": " + tsType(prop, indent+"  ") + ";\n")
+	}
+	b.WriteString(indent + "}")
+	return b.String()
+}
+
+type operation struct {
+	name        string
+	method      string
+	path        string
+	summary     string
+	pathParams  []string
+	queryType   string
+	queryNeeded bool
+	bodyType    string
+	bodyKind    string
+	bodyNeeded  bool
+	resultType  string
+	resultKind  string
+}
+
+func collectOperations(spec map[string]interface{}) []operation {
+	paths := asMap(spec["paths"])
+	var ops []operation
+	for _, path := range sortedKeys(paths) {
+		item := asMap(paths[path])
+		for _, method := range []string{"get", "post", "put", "patch", "delete"} {
+			op := asMap(item[method])
+			if op == nil {
+				continue
+			}
+			ops = append(ops, buildOperation(path, method, item, op))
+		}
+	}
+	return ops
+}
+
+func buildOperation(path, method string, item, op map[string]interface{}) operation {
+	name := identifier(method + " " + path)
+	if id := asString(op["operationId"]); id != "" {
+		name = identifier(id)
+	}
+	o := operation{
+		name:    strings.ToLower(name[:1]) + name[1:],
+		method:  strings.ToUpper(method),
+		path:    path,
+		summary: asString(op["summary"]),
+	}
+
+	params := append(asSlice(item["parameters"]), asSlice(op["parameters"])...)
+	query := map[string]interface{}{}
+	var queryRequired []interface{}
+	for _, p := range params {
+		param := asMap(p)
+		switch asString(param["in"]) {
+		case "path":
+			o.pathParams = append(o.pathParams, asString(param["name"]))
+		case "query":
+			query[asString(param["name"])] = param["schema"]
+			if req, _ := param["required"].(bool); req {
+				queryRequired = append(queryRequired, param["name"])
+				o.queryNeeded = true
+			}
+		}
+	}
+	if len(query) > 0 {
+		o.queryType = tsType(map[string]interface{}{"type": "object", "properties": query, "required": queryRequired}, "  ")
+	}
+
+	if body := asMap(op["requestBody"]); body != nil {
+		o.bodyNeeded, _ = body["required"].(bool)
+		content := asMap(body["content"])
+		switch {
+		case content["application/json"] != nil:
+			o.bodyKind = "json"
+			o.bodyType = tsType(asMap(asMap(content["application/json"])["schema"]), "  ")
+		case content["multipart/form-data"] != nil:
+			o.bodyKind = "form"
+			o.bodyType = "FormData"
+		case content["application/x-www-form-urlencoded"] != nil:
+			o.bodyKind = "urlencoded"
+			o.bodyType = "Record<string, string>"
+		default:
+			o.bodyKind = "text"
+			o.bodyType = "string"
+		}
+	}
+
+	o.resultType, o.resultKind = "void", "none"
+	responses := asMap(op["responses"])
+	for _, code := range sortedKeys(responses) {
+		if !strings.HasPrefix(code, "2") {
+			continue
+		}
+		content := asMap(asMap(responses[code])["content"])
+		switch {
+		case content["application/json"] != nil:
+			o.resultType = tsType(asMap(asMap(content["application/json"])["schema"]), "  ")
+			o.resultKind = "json"
+		case len(content) > 0:
+			o.resultType, o.resultKind = "string", "text"
+		}
+		break
+	}
+	return o
+}
+
+const runtime = `export class XpiredApiError extends Error {
+  constructor(
+    public readonly status: number,
+    public readonly body: unknown,
+  ) {
+    super(` + "`xpired API request failed with status ${status}`" + `);
+    this.name = "XpiredApiError";
+  }
+}
+
+export interface XpiredClientOptions {
+  /** Base URL of the API, e.g. "http://localhost:8080". */
+  baseUrl: string;
+  /** JWT sent as a Bearer token on every request. */
+  token?: string;
+  /** Override for environments without a global fetch. */
+  fetch?: typeof fetch;
+}
+
+type QueryValue = string | number | boolean | undefined | null;
+
+interface RequestOptions {
+  query?: Record<string, QueryValue>;
+  body?: unknown;
+  bodyKind?: "json" | "form" | "urlencoded" | "text";
+  resultKind: "json" | "text" | "none";
+}
+
+export class XpiredClient {
+  private readonly baseUrl: string;
+  private readonly fetchFn: typeof fetch;
+  token?: string;
+
+  constructor(options: XpiredClientOptions) {
+    this.baseUrl = options.baseUrl.replace(/\/+$/, "");
+    this.token = options.token;
+    this.fetchFn = options.fetch ?? fetch;
+  }
+
+  private async request<T>(method: string, path: string, options: RequestOptions): Promise<T> {
+    const url = new URL(this.baseUrl + path);
+    for (const [key, value] of Object.entries(options.query ?? {})) {
+      if (value !== undefined && value !== null) {
+        url.searchParams.set(key, String(value));
+      }
+    }
+
+    const headers: Record<string, string> = {};
+    if (this.token) {
+      headers["Authorization"] = ` + "`Bearer ${this.token}`" + `;
+    }
+
+    let body: BodyInit | undefined;
+    if (options.body !== undefined) {
+      switch (options.bodyKind) {
+        case "json":
+          headers["Content-Type"] = "application/json";
+          body = JSON.stringify(options.body);
+          break;
+        case "urlencoded":
+          body = new URLSearchParams(options.body as Record<string, string>);
+          break;
+        default:
+          body = options.body as BodyInit;
+      }
+    }
+
+    const response = await this.fetchFn(url, { method, headers, body });
+    const isJSON = response.headers.get("Content-Type")?.includes("application/json") ?? false;
+    if (!response.ok) {
+      throw new XpiredApiError(response.status, isJSON ? await response.json() : await response.text());
+    }
+
+    if (options.resultKind === "none" || response.status === 204) {
+      return undefined as T;
+    }
+    if (options.resultKind === "json" && isJSON) {
+      return (await response.json()) as T;
+    }
+    return (await response.text()) as T;
+  }
+`
+
+func generate(spec map[string]interface{}) string {
+	var b strings.Builder
+	info := asMap(spec["info"])
+	b.WriteString("// Code generated by clients/tsgen from backend/openapi.yml. DO NOT EDIT.\n\n")
+	b.WriteString(fmt.Sprintf("export const API_VERSION = %q;\n\n", asString(info["version"])))
+
+	schemas := asMap(asMap(spec["components"])["schemas"])
+	for _, name := range sortedKeys(schemas) {
+		s := asMap(schemas[name])
+		if desc := asString(s["description"]); desc != "" {
+			b.WriteString("/** " + strings.TrimSpace(strings.ReplaceAll(desc, "\n", " ")) + " */\n")
+		}
+		t := tsType(s, "")
+		if strings.HasPrefix(t, "{") {
+			b.WriteString("export interface " + name + " " + t + "\n\n")
+		} else {
+			b.WriteString("export type " + name + " = " + t + ";\n\n")
+		}
+	}
+
+	b.WriteString(runtime)
+	for _, op := range collectOperations(spec) {
+		writeOperation(&b, op)
+	}
+	b.WriteString("}\n")
+	return b.String()
+}
+
+func writeOperation(b *strings.Builder, op operation) {
+	var args []string
+	for _, p := range op.pathParams {
+		args = append(args, identifierArg(p)+": string")
+	}
+	if op.bodyKind != "" {
+		switch {
+		case op.bodyNeeded:
+			args = append(args, "body: "+op.bodyType)
+		case op.queryNeeded:
+			// A required query cannot follow an optional parameter.
+			args = append(args, "body: "+op.bodyType+" | undefined")
+		default:
+			args = append(args, "body?: "+op.bodyType)
+		}
+	}
+	if op.queryType != "" {
+		opt := "?"
+		if op.queryNeeded {
+			opt = ""
+		}
+		args = append(args, "query"+opt+": "+op.queryType)
+	}
+
+	path := "\"" + op.path + "\""
+	if len(op.pathParams) > 0 {
+		path = "`" + op.path + "`"
+		for _, p := range op.pathParams {
+			path = strings.ReplaceAll(path, "{"+p+"}", "${encodeURIComponent("+identifierArg(p)+")}")
+		}
+	}
+
+	b.WriteString("\n")
+	if op.summary != "" {
+		b.WriteString("  /** " + op.summary + " */\n")
+	}
+	b.WriteString(fmt.Sprintf("  %s(%s): Promise<%s> {\n", op.name, strings.Join(args, ", "), op.resultType))
+	b.WriteString(fmt.Sprintf("    return this.request(%q, %s, {\n", op.method, path))
+	if op.queryType != "" {
+		b.WriteString("      query,\n")
+	}
+	if op.bodyKind != "" {
+		b.WriteString(fmt.Sprintf("      body,\n      bodyKind: %q,\n", op.bodyKind))
+	}
+	b.WriteString(fmt.Sprintf("      resultKind: %q,\n", op.resultKind))
+	b.WriteString("    });\n")
+	b.WriteString("  }\n")
+}
+
+func identifierArg(name string) string {
+	id := identifier(name)
+	return strings.ToLower(id[:1]) + id[1:]
+}